@@ -0,0 +1,11 @@
+package db
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var DbCmd = &cobra.Command{
+	Use:     "db",
+	Aliases: []string{"database"},
+	Short:   "Database maintenance commands",
+}
@@ -0,0 +1,115 @@
+package db
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/getarcaneapp/arcane/backend/internal/config"
+	"github.com/getarcaneapp/arcane/backend/internal/database"
+)
+
+var (
+	migrateFrom   string
+	migrateTo     string
+	migrateDryRun bool
+	migrateForce  bool
+	migrateYes    bool
+)
+
+var migratePostgresCmd = &cobra.Command{
+	Use:   "migrate-to-postgres",
+	Short: "Copy an Arcane SQLite database into PostgreSQL",
+	Long: `Copy all data from the embedded SQLite database into an external PostgreSQL database.
+
+The destination schema is created with Arcane's regular migrations before any
+rows are copied, and the copy runs in a single transaction. Stop Arcane and
+take a backup of the SQLite file before running this command.`,
+	Example: `  # Copy the default SQLite database into PostgreSQL
+  arcane db migrate-to-postgres --to postgres://arcane:secret@db:5432/arcane
+
+  # Preview row counts without writing anything
+  arcane db migrate-to-postgres --from file:/app/data/arcane.db --to postgres://... --dry-run`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runMigrateToPostgres(cmd)
+	},
+}
+
+func init() {
+	DbCmd.AddCommand(migratePostgresCmd)
+	migratePostgresCmd.Flags().StringVar(&migrateFrom, "from", "", "source SQLite database URL (default: DATABASE_URL)")
+	migratePostgresCmd.Flags().StringVar(&migrateTo, "to", "", "destination PostgreSQL database URL")
+	migratePostgresCmd.Flags().BoolVar(&migrateDryRun, "dry-run", false, "only report how many rows would be copied")
+	migratePostgresCmd.Flags().BoolVar(&migrateForce, "force", false, "overwrite data already present in the destination")
+	migratePostgresCmd.Flags().BoolVarP(&migrateYes, "yes", "y", false, "skip the confirmation prompt")
+	_ = migratePostgresCmd.MarkFlagRequired("to")
+}
+
+func runMigrateToPostgres(cmd *cobra.Command) error {
+	ctx := cmd.Context()
+	out := cmd.OutOrStdout()
+
+	if migrateFrom == "" {
+		migrateFrom = config.Load().DatabaseURL
+	}
+
+	if provider, err := database.ProviderFromURL(migrateFrom); err != nil || provider != database.ProviderSQLite {
+		return fmt.Errorf("--from must be a SQLite database URL (file:...)")
+	}
+	if provider, err := database.ProviderFromURL(migrateTo); err != nil || provider != database.ProviderPostgres {
+		return fmt.Errorf("--to must be a PostgreSQL database URL (postgres://...)")
+	}
+
+	_, _ = fmt.Fprintln(out, "Step 1/4: Opening source SQLite database")
+	src, err := database.Initialize(ctx, migrateFrom, database.MigrationOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to open source database: %w", err)
+	}
+
+	_, _ = fmt.Fprintln(out, "Step 2/4: Connecting to PostgreSQL and applying migrations")
+	dst, err := database.Initialize(ctx, migrateTo, database.MigrationOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to prepare destination database: %w", err)
+	}
+
+	if !migrateDryRun && !migrateYes {
+		_, _ = fmt.Fprint(out, "Make sure Arcane is stopped and the SQLite file is backed up. Continue? [y/N]: ")
+		answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+		if a := strings.ToLower(strings.TrimSpace(answer)); a != "y" && a != "yes" {
+			return fmt.Errorf("aborted")
+		}
+	}
+
+	_, _ = fmt.Fprintln(out, "Step 3/4: Copying data")
+	result, err := database.CopyData(ctx, src, dst, database.CopyOptions{
+		DryRun: migrateDryRun,
+		Force:  migrateForce,
+		Progress: func(table string, rows int64) {
+			_, _ = fmt.Fprintf(out, "  %-32s %d rows\n", table, rows)
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to copy data: %w", err)
+	}
+
+	if migrateDryRun {
+		var total int64
+		for _, t := range result.Tables {
+			_, _ = fmt.Fprintf(out, "  %-32s %d rows\n", t.Table, t.SourceRows)
+			total += t.SourceRows
+		}
+		_, _ = fmt.Fprintf(out, "Dry run complete: %d rows in %d tables would be copied.\n", total, len(result.Tables))
+		return nil
+	}
+
+	_, _ = fmt.Fprintf(out, "Step 4/4: Verified %d rows in %d tables\n\n", result.TotalRows(), len(result.Tables))
+	_, _ = fmt.Fprintln(out, "Migration complete. To switch Arcane to PostgreSQL:")
+	_, _ = fmt.Fprintln(out, "  1. Set DATABASE_URL to the destination URL")
+	_, _ = fmt.Fprintln(out, "  2. Keep ENCRYPTION_KEY unchanged so stored credentials remain readable")
+	_, _ = fmt.Fprintln(out, "  3. Start Arcane and confirm your environments and settings")
+	_, _ = fmt.Fprintln(out, "  4. Optionally tune DB_MAX_OPEN_CONNS per replica")
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/getarcaneapp/arcane/backend/cli/db"
 	"github.com/getarcaneapp/arcane/backend/cli/generate"
 	"github.com/getarcaneapp/arcane/backend/cli/upgrade"
 	"github.com/getarcaneapp/arcane/backend/internal/bootstrap"
@@ -40,6 +41,7 @@ func Execute() {
 func init() {
 	rootCmd.AddCommand(upgrade.UpgradeCmd)
 	rootCmd.AddCommand(generate.GenerateCmd)
+	rootCmd.AddCommand(db.DbCmd)
 }
 
 func getVersion() string {
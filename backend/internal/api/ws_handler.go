@@ -53,6 +53,7 @@ type WebSocketMetrics struct {
 	containerStats      atomic.Int64
 	containerExec       atomic.Int64
 	systemStats         atomic.Int64
+	events              atomic.Int64
 	seq                 atomic.Uint64
 	mu                  sync.RWMutex
 	connections         map[string]systemtypes.WebSocketConnectionInfo
@@ -73,6 +74,7 @@ func (m *WebSocketMetrics) Snapshot() systemtypes.WebSocketMetricsSnapshot {
 		ContainerStats:      m.containerStats.Load(),
 		ContainerExec:       m.containerExec.Load(),
 		SystemStats:         m.systemStats.Load(),
+		Events:              m.events.Load(),
 	}
 }
 
@@ -133,6 +135,8 @@ func (m *WebSocketMetrics) applyDelta(kind string, delta int64) {
 		m.containerExec.Add(delta)
 	case systemtypes.WSKindSystemStats:
 		m.systemStats.Add(delta)
+	case systemtypes.WSKindEvents:
+		m.events.Add(delta)
 	}
 }
 
@@ -153,6 +157,7 @@ type WebSocketHandler struct {
 	projectService    *services.ProjectService
 	containerService  *services.ContainerService
 	systemService     *services.SystemService
+	eventService      *services.EventService
 	wsUpgrader        websocket.Upgrader
 	wsMetrics         *WebSocketMetrics
 	activeConnections sync.Map
@@ -211,6 +216,7 @@ func NewWebSocketHandler(
 	projectService *services.ProjectService,
 	containerService *services.ContainerService,
 	systemService *services.SystemService,
+	eventService *services.EventService,
 	authMiddleware *middleware.AuthMiddleware,
 	cfg *config.Config,
 ) {
@@ -218,6 +224,7 @@ func NewWebSocketHandler(
 		projectService:       projectService,
 		containerService:     containerService,
 		systemService:        systemService,
+		eventService:         eventService,
 		wsMetrics:            defaultWebSocketMetrics,
 		gpuMonitoringEnabled: cfg.GPUMonitoringEnabled,
 		gpuType:              cfg.GPUType,
@@ -238,6 +245,98 @@ func NewWebSocketHandler(
 		wsGroup.GET("/containers/:containerId/terminal", handler.ContainerExec)
 		wsGroup.GET("/system/stats", handler.SystemStats)
 	}
+
+	eventsGroup := group.Group("/events")
+	eventsGroup.Use(authMiddleware.WithAdminNotRequired().Add())
+	{
+		eventsGroup.GET("/ws", handler.Events)
+	}
+}
+
+// ============================================================================
+// Event WebSocket Endpoints
+// ============================================================================
+
+// Events streams newly created events over WebSocket.
+//
+//	@Summary		Subscribe to events via WebSocket
+//	@Description	Stream newly created events as JSON messages, optionally filtered by type, severity and environment
+//	@Tags			WebSocket
+//	@Param			type			query	string	false	"Comma separated event types; a trailing * matches a prefix (e.g. container.*)"
+//	@Param			severity		query	string	false	"Comma separated severities (info, success, warning, error)"
+//	@Param			environmentId	query	string	false	"Only stream events from this environment"
+//	@Router			/api/events/ws [get]
+func (h *WebSocketHandler) Events(c *gin.Context) {
+	filter := services.EventSubscriptionFilter{
+		Types:         splitQueryListInternal(c.QueryArray("type")),
+		Severities:    splitQueryListInternal(c.QueryArray("severity")),
+		EnvironmentID: strings.TrimSpace(c.Query("environmentId")),
+	}
+
+	conn, err := h.wsUpgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		return
+	}
+
+	info := buildWSConnectionInfoInternal(c, systemtypes.WSKindEvents, "")
+	info.EnvID = filter.EnvironmentID
+	connID := h.wsMetrics.RegisterConnection(info)
+	hub := h.startEventHub(filter, func() {
+		h.wsMetrics.UnregisterConnection(connID)
+	})
+	// WebSocket connections use context.Background() because they are long-lived and should not
+	// be tied to the HTTP request context. Cleanup is handled via the hub's OnEmpty callback
+	// which triggers when all clients disconnect.
+	ws.ServeClient(context.Background(), hub, conn)
+}
+
+func (h *WebSocketHandler) startEventHub(filter services.EventSubscriptionFilter, onEmptyHook func()) *ws.Hub {
+	hub := ws.NewHub(64)
+
+	ctx, cancel := context.WithCancel(context.Background()) //nolint:gosec // cancel is intentionally retained and invoked by the hub OnEmpty callback.
+
+	hub.SetOnEmpty(func() {
+		if onEmptyHook != nil {
+			onEmptyHook()
+		}
+		slog.Debug("client disconnected, cleaning up event hub")
+		cancel()
+	})
+
+	go hub.Run(ctx)
+
+	events, unsubscribe := h.eventService.Subscribe(filter)
+	go func() {
+		defer unsubscribe()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case evt, ok := <-events:
+				if !ok {
+					return
+				}
+				if b, err := json.Marshal(evt); err == nil {
+					hub.Broadcast(b)
+				}
+			}
+		}
+	}()
+
+	return hub
+}
+
+// splitQueryListInternal flattens repeated and comma separated query values.
+func splitQueryListInternal(values []string) []string {
+	var out []string
+	for _, v := range values {
+		for part := range strings.SplitSeq(v, ",") {
+			if part = strings.TrimSpace(part); part != "" {
+				out = append(out, part)
+			}
+		}
+	}
+	return out
 }
 
 // ============================================================================
@@ -1,7 +1,9 @@
 package bootstrap
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -21,9 +23,11 @@ import (
 	"github.com/getarcaneapp/arcane/backend/internal/utils"
 	"github.com/getarcaneapp/arcane/backend/internal/utils/crypto"
 	httputils "github.com/getarcaneapp/arcane/backend/internal/utils/http"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/truststore"
 	"github.com/getarcaneapp/arcane/backend/pkg/libarcane/edge"
 	tunnelpb "github.com/getarcaneapp/arcane/backend/pkg/libarcane/edge/proto/tunnel/v1"
 	"github.com/getarcaneapp/arcane/backend/pkg/scheduler"
+	"github.com/getarcaneapp/arcane/types/environment"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 	"google.golang.org/grpc"
@@ -53,6 +57,13 @@ func Bootstrap(ctx context.Context) error {
 		}
 	}(appCtx)
 
+	// Custom CAs are loaded before any outbound client is built so that
+	// transports configured now start out trusting them.
+	if err := services.NewCACertificateService(db).LoadTrustStore(appCtx); err != nil {
+		slog.WarnContext(appCtx, "Failed to load custom CA certificates", "error", err)
+	}
+	truststore.InstallDefaultTransport()
+	httputils.InstallDefaultTransportProxy()
 	httpClient := newConfiguredHTTPClient(cfg)
 
 	appServices, dockerClientService, err := initializeServices(appCtx, db, cfg, httpClient)
@@ -68,6 +79,7 @@ func Bootstrap(ctx context.Context) error {
 		}
 	}(appCtx)
 
+	applyOutboundProxy(appCtx, appServices.Settings)
 	initializeStartupState(appCtx, cfg, appServices, dockerClientService, httpClient)
 
 	cronLocation := cfg.GetLocation()
@@ -88,6 +100,19 @@ func Bootstrap(ctx context.Context) error {
 	return nil
 }
 
+// applyOutboundProxy routes outbound HTTP through the proxy from settings.
+func applyOutboundProxy(ctx context.Context, settingsService *services.SettingsService) {
+	proxyURL := settingsService.GetStringSetting(ctx, "outboundProxyUrl", "")
+	noProxy := settingsService.GetStringSetting(ctx, "outboundNoProxy", "")
+	if err := httputils.SetOutboundProxy(proxyURL, noProxy); err != nil {
+		slog.ErrorContext(ctx, "Ignoring invalid outbound proxy setting", "proxy", httputils.RedactProxyURL(proxyURL), "error", err)
+		return
+	}
+	if proxyURL != "" {
+		slog.InfoContext(ctx, "Outbound proxy configured", "proxy", httputils.RedactProxyURL(proxyURL))
+	}
+}
+
 func newConfiguredHTTPClient(cfg *config.Config) *http.Client {
 	if cfg.HTTPClientTimeout > 0 {
 		return httputils.NewHTTPClientWithTimeout(time.Duration(cfg.HTTPClientTimeout) * time.Second)
@@ -168,9 +193,17 @@ func initializeStartupState(appCtx context.Context, cfg *config.Config, appServi
 	)
 	utils.CleanupUnknownSettings(appCtx, appServices.Settings)
 
+	if !cfg.AgentMode {
+		if err := appServices.Setup.MarkExistingInstallComplete(appCtx); err != nil {
+			slog.WarnContext(appCtx, "Failed to check first-run setup state", "error", err.Error())
+		}
+	}
+
 	// Handle agent auto-pairing with API key.
 	if cfg.AgentMode && cfg.AgentToken != "" && cfg.ManagerApiUrl != "" {
-		if err := handleAgentBootstrapPairing(appCtx, cfg, httpClient); err != nil {
+		capabilities := appServices.Environment.LocalCapabilities(appCtx)
+		fingerprint := appServices.Environment.LocalFingerprint(appCtx)
+		if err := handleAgentBootstrapPairing(appCtx, cfg, httpClient, capabilities, fingerprint); err != nil {
 			slog.WarnContext(appCtx, "Failed to auto-pair agent with manager", "error", err)
 		}
 	}
@@ -202,7 +235,10 @@ func startEdgeTunnelClientIfConfigured(appCtx context.Context, cfg *config.Confi
 	}()
 }
 
-func handleAgentBootstrapPairing(ctx context.Context, cfg *config.Config, httpClient *http.Client) error {
+// handleAgentBootstrapPairing pairs the agent with its manager and advertises
+// the agent's capabilities. It runs on every start so the manager learns about
+// upgrades even when the agent was paired before.
+func handleAgentBootstrapPairing(ctx context.Context, cfg *config.Config, httpClient *http.Client, capabilities *environment.Capabilities, fingerprint string) error {
 	slog.InfoContext(ctx, "Agent mode detected with token, attempting auto-pairing", "managerUrl", cfg.ManagerApiUrl)
 
 	pairURL := strings.TrimRight(cfg.GetManagerBaseURL(), "/") + "/api/environments/pair"
@@ -210,12 +246,25 @@ func handleAgentBootstrapPairing(ctx context.Context, cfg *config.Config, httpCl
 	reqCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
-	req, err := http.NewRequestWithContext(reqCtx, http.MethodPost, pairURL, nil)
+	var payload io.Reader
+	if capabilities != nil {
+		data, err := json.Marshal(capabilities)
+		if err != nil {
+			return fmt.Errorf("failed to encode agent capabilities: %w", err)
+		}
+		payload = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(reqCtx, http.MethodPost, pairURL, payload)
 	if err != nil {
 		return fmt.Errorf("failed to create pairing request: %w", err)
 	}
 
 	req.Header.Set("X-API-Key", cfg.AgentToken)
+	req.Header.Set("X-Agent-Fingerprint", fingerprint)
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
 
 	resp, err := httpClient.Do(req) //nolint:gosec // intentional request to configured manager pairing endpoint
 	if err != nil {
@@ -229,6 +278,11 @@ func handleAgentBootstrapPairing(ctx context.Context, cfg *config.Config, httpCl
 	case http.StatusOK:
 		slog.InfoContext(ctx, "Successfully paired agent with manager", "managerUrl", cfg.ManagerApiUrl)
 		return nil
+	case http.StatusAccepted:
+		slog.InfoContext(ctx, "Pairing request sent; approve it in the manager after checking the fingerprint", "managerUrl", cfg.ManagerApiUrl, "fingerprint", fingerprint)
+		return nil
+	case http.StatusConflict:
+		return fmt.Errorf("pairing refused: another agent's pairing request is waiting for approval; reject it in the manager first (fingerprint of this agent: %s)", fingerprint)
 	case http.StatusBadRequest:
 		// Environment is not in pending status - already paired, this is fine
 		if strings.Contains(string(body), "not in pending status") {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/getarcaneapp/arcane/backend/internal/config"
 	"github.com/getarcaneapp/arcane/backend/internal/database"
@@ -12,11 +13,16 @@ import (
 func initializeDBAndMigrate(ctx context.Context, cfg *config.Config) (*database.DB, error) {
 	db, err := database.Initialize(ctx, cfg.DatabaseURL, database.MigrationOptions{
 		AllowDowngrade: cfg.AllowDowngrade,
+		Pool: database.PoolOptions{
+			MaxOpenConns:    cfg.DBMaxOpenConns,
+			MaxIdleConns:    cfg.DBMaxIdleConns,
+			ConnMaxLifetime: time.Duration(cfg.DBConnLifetime) * time.Second,
+		},
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize database: %w", err)
 	}
 
-	slog.Info("Database initialized successfully")
+	slog.Info("Database initialized successfully", "provider", db.Provider())
 	return db, nil
 }
@@ -33,6 +33,14 @@ func registerEdgeTunnelRoutes(
 		if envID == nil {
 			return "", errors.New("API key is not linked to an environment")
 		}
+		// Agents may only connect once an admin approved their pairing request
+		env, err := appServices.Environment.GetEnvironmentByID(ctx, *envID)
+		if err != nil {
+			return "", err
+		}
+		if env.Status == string(models.EnvironmentStatusPending) {
+			return "", errors.New("environment pairing is waiting for approval")
+		}
 		return *envID, nil
 	}
 
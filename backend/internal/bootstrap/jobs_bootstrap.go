@@ -12,10 +12,10 @@ import (
 )
 
 func registerJobs(appCtx context.Context, newScheduler *pkg_scheduler.JobScheduler, appServices *Services, appConfig *config.Config) {
-	autoUpdateJob := pkg_scheduler.NewAutoUpdateJob(appServices.Updater, appServices.Settings)
+	autoUpdateJob := pkg_scheduler.NewAutoUpdateJob(appServices.Updater, appServices.Rollout, appServices.Settings)
 	newScheduler.RegisterJob(autoUpdateJob)
 
-	imagePollingJob := pkg_scheduler.NewImagePollingJob(appServices.ImageUpdate, appServices.Settings, appServices.Environment)
+	imagePollingJob := pkg_scheduler.NewImagePollingJob(appServices.ImageUpdate, appServices.Settings, appServices.Environment, appServices.GitOpsSync)
 	newScheduler.RegisterJob(imagePollingJob)
 
 	environmentHealthJob := pkg_scheduler.NewEnvironmentHealthJob(appServices.Environment, appServices.Settings)
@@ -45,9 +45,50 @@ func registerJobs(appCtx context.Context, newScheduler *pkg_scheduler.JobSchedul
 	vulnerabilityScanJob := pkg_scheduler.NewVulnerabilityScanJob(appServices.Vulnerability, appServices.Settings)
 	newScheduler.RegisterJob(vulnerabilityScanJob)
 
+	imageRetentionJob := pkg_scheduler.NewImageRetentionJob(appServices.Updater, appServices.Settings)
+	newScheduler.RegisterJob(imageRetentionJob)
+
+	staleResourceJob := pkg_scheduler.NewStaleResourceJob(appServices.StaleResource, appServices.Settings)
+	newScheduler.RegisterJob(staleResourceJob)
+
 	autoHealJob := pkg_scheduler.NewAutoHealJob(appServices.Docker, appServices.Settings, appServices.Event, appServices.Notification)
 	newScheduler.RegisterJob(autoHealJob)
 
+	pkg_scheduler.RegisterContainerCrashWatcherJob(appCtx, appServices.Docker, appServices.Settings, appServices.Event, appServices.Notification)
+	pkg_scheduler.RegisterUnmanagedChangeWatcherJob(appCtx, appServices.Docker, appServices.Settings, appServices.Event, appServices.Notification)
+
+	uptimeMonitorJob := pkg_scheduler.NewUptimeMonitorJob(appServices.Monitor)
+	newScheduler.RegisterJob(uptimeMonitorJob)
+
+	logForwardingJob := pkg_scheduler.NewLogForwardingJob(appServices.LogForwarding)
+	newScheduler.RegisterJob(logForwardingJob)
+
+	logAlertJob := pkg_scheduler.NewLogAlertJob(appServices.LogAlert)
+	newScheduler.RegisterJob(logAlertJob)
+
+	containerTaskJob := pkg_scheduler.NewContainerTaskJob(appServices.ContainerTask)
+	newScheduler.RegisterJob(containerTaskJob)
+
+	var configBackupJob *pkg_scheduler.ConfigBackupJob
+	if !appConfig.AgentMode {
+		hostMetricsJob := pkg_scheduler.NewHostMetricsJob(appServices.HostMetrics)
+		newScheduler.RegisterJob(hostMetricsJob)
+
+		environmentHeartbeatJob := pkg_scheduler.NewEnvironmentHeartbeatJob(appServices.EnvironmentWatch)
+		newScheduler.RegisterJob(environmentHeartbeatJob)
+
+		notificationCredentialJob := pkg_scheduler.NewNotificationCredentialJob(appServices.CredentialCheck)
+		newScheduler.RegisterJob(notificationCredentialJob)
+
+		if !appConfig.UpdateCheckDisabled {
+			arcaneUpdateCheckJob := pkg_scheduler.NewArcaneUpdateCheckJob(appServices.Version, appServices.Settings, appServices.Notification)
+			newScheduler.RegisterJob(arcaneUpdateCheckJob)
+		}
+
+		configBackupJob = pkg_scheduler.NewConfigBackupJob(appServices.Backup, appServices.Settings)
+		newScheduler.RegisterJob(configBackupJob)
+	}
+
 	setupJobScheduleCallbacks(
 		appCtx,
 		appServices,
@@ -62,6 +103,9 @@ func registerJobs(appCtx context.Context, newScheduler *pkg_scheduler.JobSchedul
 		gitOpsSyncJob,
 		vulnerabilityScanJob,
 		autoHealJob,
+		imageRetentionJob,
+		staleResourceJob,
+		configBackupJob,
 	)
 	setupSettingsCallbacks(appCtx, appServices, appConfig, newScheduler, imagePollingJob, autoUpdateJob, environmentHealthJob, fsWatcherJob, scheduledPruneJob, vulnerabilityScanJob, autoHealJob)
 }
@@ -80,6 +124,9 @@ func setupJobScheduleCallbacks(
 	gitOpsSyncJob *pkg_scheduler.GitOpsSyncJob,
 	vulnerabilityScanJob *pkg_scheduler.VulnerabilityScanJob,
 	autoHealJob *pkg_scheduler.AutoHealJob,
+	imageRetentionJob *pkg_scheduler.ImageRetentionJob,
+	staleResourceJob *pkg_scheduler.StaleResourceJob,
+	configBackupJob *pkg_scheduler.ConfigBackupJob,
 ) {
 	if appServices.JobSchedule == nil {
 		return
@@ -102,6 +149,9 @@ func setupJobScheduleCallbacks(
 				gitOpsSyncJob,
 				vulnerabilityScanJob,
 				autoHealJob,
+				imageRetentionJob,
+				staleResourceJob,
+				configBackupJob,
 			)
 		}
 	}
@@ -121,6 +171,9 @@ func handleJobScheduleChangeInternal(
 	gitOpsSyncJob *pkg_scheduler.GitOpsSyncJob,
 	vulnerabilityScanJob *pkg_scheduler.VulnerabilityScanJob,
 	autoHealJob *pkg_scheduler.AutoHealJob,
+	imageRetentionJob *pkg_scheduler.ImageRetentionJob,
+	staleResourceJob *pkg_scheduler.StaleResourceJob,
+	configBackupJob *pkg_scheduler.ConfigBackupJob,
 ) {
 	switch key {
 	case "pollingInterval":
@@ -162,6 +215,21 @@ func handleJobScheduleChangeInternal(
 		if err := newScheduler.RescheduleJob(ctx, autoHealJob); err != nil {
 			slog.WarnContext(ctx, "Failed to reschedule auto-heal job", "error", err)
 		}
+	case "imageRetentionInterval":
+		if err := newScheduler.RescheduleJob(ctx, imageRetentionJob); err != nil {
+			slog.WarnContext(ctx, "Failed to reschedule image-retention job", "error", err)
+		}
+	case "staleResourcesInterval":
+		if err := newScheduler.RescheduleJob(ctx, staleResourceJob); err != nil {
+			slog.WarnContext(ctx, "Failed to reschedule stale-resources job", "error", err)
+		}
+	case "configBackupInterval":
+		if configBackupJob == nil {
+			return
+		}
+		if err := newScheduler.RescheduleJob(ctx, configBackupJob); err != nil {
+			slog.WarnContext(ctx, "Failed to reschedule config-backup job", "error", err)
+		}
 	}
 }
 
@@ -202,6 +270,9 @@ func setupSettingsCallbacks(lifecycleCtx context.Context, appServices *Services,
 			slog.WarnContext(lifecycleCtx, "Failed to reschedule vulnerability-scan job", "error", err)
 		}
 	}
+	appServices.Settings.OnOutboundProxyChanged = func(ctx context.Context) {
+		applyOutboundProxy(ctx, appServices.Settings)
+	}
 	appServices.Settings.OnAutoHealSettingsChanged = func(ctx context.Context) {
 		if err := newScheduler.RescheduleJob(ctx, autoHealJob); err != nil {
 			slog.WarnContext(ctx, "Failed to reschedule auto-heal job", "error", err)
@@ -2,7 +2,9 @@ package bootstrap
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
+	"net/http"
 	"path"
 	"strings"
 
@@ -14,9 +16,14 @@ import (
 	"github.com/getarcaneapp/arcane/backend/internal/config"
 	"github.com/getarcaneapp/arcane/backend/internal/huma"
 	"github.com/getarcaneapp/arcane/backend/internal/middleware"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
 	"github.com/getarcaneapp/arcane/backend/internal/utils/cookie"
+	httputils "github.com/getarcaneapp/arcane/backend/internal/utils/http"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/remenv"
 	"github.com/getarcaneapp/arcane/backend/pkg/libarcane/edge"
 	"github.com/getarcaneapp/arcane/types"
+	"github.com/getarcaneapp/arcane/types/apikey"
+	"github.com/getarcaneapp/arcane/types/rbac"
 )
 
 var (
@@ -30,6 +37,7 @@ var loggerSkipPatterns = []string{
 	"GET /api/environments/*/ws/containers/*/terminal",
 	"GET /api/environments/*/ws/projects/*/logs",
 	"GET /api/environments/*/ws/system/stats",
+	"GET /api/events/ws",
 	"GET /_app/*",
 	"GET /img",
 	"GET /api/fonts/sans",
@@ -37,6 +45,13 @@ var loggerSkipPatterns = []string{
 	"GET /api/fonts/serif",
 	"GET /api/health",
 	"HEAD /api/health",
+	"GET /api/healthz",
+	"GET /api/readyz",
+	// Webhook and notification action tokens are part of the path.
+	"POST /api/hooks/commands/*",
+	"GET /api/notification-actions/*",
+	"POST /api/notification-actions/*",
+	"GET /notification-actions/*",
 }
 
 func shouldLogRequest(c *gin.Context) bool {
@@ -60,29 +75,112 @@ func shouldLogRequest(c *gin.Context) bool {
 	return true
 }
 
+// resolveRequestUser authenticates the request. When an API key is used its
+// scopes are returned as well; they are nil for session authentication.
+func resolveRequestUser(ctx context.Context, c *gin.Context, appServices *Services) (*models.User, []string, bool) {
+	// Check for API key authentication
+	if apiKey := c.GetHeader("X-API-Key"); apiKey != "" {
+		user, scopes, err := appServices.ApiKey.AuthenticateApiKey(ctx, apiKey)
+		return user, scopes, err == nil && user != nil
+	}
+
+	// Check for Bearer token authentication
+	token := ""
+	if auth := c.GetHeader("Authorization"); strings.HasPrefix(auth, "Bearer ") {
+		token = strings.TrimPrefix(auth, "Bearer ")
+	} else if cookieToken, err := cookie.GetTokenCookie(c); err == nil && cookieToken != "" {
+		token = cookieToken
+	}
+
+	if token == "" {
+		return nil, nil, false
+	}
+
+	user, err := appServices.Auth.VerifyToken(ctx, token)
+	return user, nil, err == nil && user != nil
+}
+
 func createAuthValidator(appServices *Services) middleware.AuthValidator {
 	return func(ctx context.Context, c *gin.Context) bool {
-		// Check for API key authentication
-		if apiKey := c.GetHeader("X-API-Key"); apiKey != "" {
-			user, err := appServices.ApiKey.ValidateApiKey(ctx, apiKey)
-			return err == nil && user != nil
+		_, _, ok := resolveRequestUser(ctx, c, appServices)
+		return ok
+	}
+}
+
+// createAccessValidator enforces API key scopes and role bindings for requests
+// proxied to remote environments, which never reach the local Huma middleware.
+func createAccessValidator(appServices *Services) middleware.AccessValidator {
+	return func(ctx context.Context, c *gin.Context, envID string) bool {
+		user, scopes, ok := resolveRequestUser(ctx, c, appServices)
+		if !ok {
+			return false
+		}
+		if !apikey.ScopesAllow(scopes, apikey.RequiredScope(c.Request.Method, c.Request.URL.Path)) {
+			return false
 		}
 
-		// Check for Bearer token authentication
-		token := ""
-		if auth := c.GetHeader("Authorization"); strings.HasPrefix(auth, "Bearer ") {
-			token = strings.TrimPrefix(auth, "Bearer ")
-		} else if cookieToken, err := cookie.GetTokenCookie(c); err == nil && cookieToken != "" {
-			token = cookieToken
+		required := rbac.RoleOperator
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			required = rbac.RoleViewer
 		}
 
-		if token == "" {
-			return false
+		_, err := appServices.Rbac.Authorize(ctx, user, envID, projectIDFromPath(c.Request.URL.Path), required)
+		return err == nil
+	}
+}
+
+// createMaintenanceGuard blocks mutating requests proxied to remote environments
+// while read-only maintenance mode is active on the manager.
+func createMaintenanceGuard(appServices *Services) middleware.MaintenanceGuard {
+	return func(c *gin.Context) (bool, string) {
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			return false, ""
+		}
+
+		cfg := appServices.Settings.GetSettingsConfig()
+		if !cfg.MaintenanceModeEnabled.IsTrue() {
+			return false, ""
 		}
 
-		user, err := appServices.Auth.VerifyToken(ctx, token)
-		return err == nil && user != nil
+		message := "Arcane is in read-only maintenance mode"
+		if custom := strings.TrimSpace(cfg.MaintenanceModeMessage.Value); custom != "" {
+			message += ": " + custom
+		}
+		return true, message
+	}
+}
+
+// createCapabilityGuard rejects requests proxied to agents that advertised they
+// do not serve the requested feature, instead of forwarding them into a 404.
+func createCapabilityGuard(appServices *Services) middleware.CapabilityGuard {
+	return func(ctx context.Context, envID, resourcePath string) (bool, string) {
+		env, err := appServices.Environment.GetEnvironmentByID(ctx, envID)
+		if err != nil {
+			return false, ""
+		}
+		supported, feature := remenv.SupportsPath(env.Capabilities, resourcePath)
+		if supported {
+			return false, ""
+		}
+
+		agentVersion := env.Capabilities.Version
+		if agentVersion == "" {
+			agentVersion = "unknown"
+		}
+		return true, fmt.Sprintf("The agent of environment '%s' (version %s) does not support %s; upgrade the agent to use it", env.Name, agentVersion, feature)
+	}
+}
+
+// projectIDFromPath extracts the project ID from /api/environments/{id}/projects/{projectId}/... paths.
+func projectIDFromPath(requestPath string) string {
+	_, rest, ok := strings.Cut(requestPath, "/projects/")
+	if !ok {
+		return ""
 	}
+	projectID, _, _ := strings.Cut(rest, "/")
+	return projectID
 }
 
 func setupRouter(ctx context.Context, cfg *config.Config, appServices *Services) (*gin.Engine, *edge.TunnelServer) {
@@ -99,8 +197,13 @@ func setupRouter(ctx context.Context, cfg *config.Config, appServices *Services)
 	}))
 
 	authMiddleware := middleware.NewAuthMiddleware(appServices.Auth, cfg).WithApiKeyValidator(appServices.ApiKey)
-	corsMiddleware := middleware.NewCORSMiddleware(cfg).Add()
+	corsMiddleware := middleware.NewCORSMiddleware(cfg).WithAllowedOrigins(func() []string {
+		// Saved values are validated, so a parse error cannot occur here.
+		origins, _ := httputils.ParseAllowedOrigins(appServices.Settings.GetSettingsConfig().CorsAllowedOrigins.Value)
+		return origins
+	}).Add()
 	router.Use(corsMiddleware)
+	router.Use(middleware.NewCSRFMiddleware(cfg).Add())
 
 	apiGroup := router.Group("/api")
 	tunnelRegistry := edge.NewTunnelRegistry()
@@ -119,43 +222,73 @@ func setupRouter(ctx context.Context, cfg *config.Config, appServices *Services)
 		envResolver,
 		appServices.Environment,
 		createAuthValidator(appServices),
+		middleware.WithAccessValidator(createAccessValidator(appServices)),
+		middleware.WithMaintenanceGuard(createMaintenanceGuard(appServices)),
+		middleware.WithCapabilityGuard(createCapabilityGuard(appServices)),
 	))
 
 	humaServices := &huma.Services{
-		User:              appServices.User,
-		Auth:              appServices.Auth,
-		Oidc:              appServices.Oidc,
-		ApiKey:            appServices.ApiKey,
-		AppImages:         appServices.AppImages,
-		Font:              appServices.Font,
-		Project:           appServices.Project,
-		Event:             appServices.Event,
-		Version:           appServices.Version,
-		Environment:       appServices.Environment,
-		Settings:          appServices.Settings,
-		JobSchedule:       appServices.JobSchedule,
-		SettingsSearch:    appServices.SettingsSearch,
-		ContainerRegistry: appServices.ContainerRegistry,
-		Template:          appServices.Template,
-		Docker:            appServices.Docker,
-		Image:             appServices.Image,
-		ImageUpdate:       appServices.ImageUpdate,
-		Build:             appServices.Build,
-		BuildWorkspace:    appServices.BuildWorkspace,
-		Volume:            appServices.Volume,
-		Container:         appServices.Container,
-		Network:           appServices.Network,
-		Notification:      appServices.Notification,
-		Apprise:           appServices.Apprise,
-		Updater:           appServices.Updater,
-		CustomizeSearch:   appServices.CustomizeSearch,
-		System:            appServices.System,
-		SystemUpgrade:     appServices.SystemUpgrade,
-		GitRepository:     appServices.GitRepository,
-		GitOpsSync:        appServices.GitOpsSync,
-		Vulnerability:     appServices.Vulnerability,
-		Dashboard:         appServices.Dashboard,
-		Config:            cfg,
+		User:               appServices.User,
+		Auth:               appServices.Auth,
+		Oidc:               appServices.Oidc,
+		ApiKey:             appServices.ApiKey,
+		AppImages:          appServices.AppImages,
+		Font:               appServices.Font,
+		Project:            appServices.Project,
+		Event:              appServices.Event,
+		Version:            appServices.Version,
+		Environment:        appServices.Environment,
+		Settings:           appServices.Settings,
+		JobSchedule:        appServices.JobSchedule,
+		SettingsSearch:     appServices.SettingsSearch,
+		ContainerRegistry:  appServices.ContainerRegistry,
+		Template:           appServices.Template,
+		Docker:             appServices.Docker,
+		Image:              appServices.Image,
+		ImageUpdate:        appServices.ImageUpdate,
+		Build:              appServices.Build,
+		BuildWorkspace:     appServices.BuildWorkspace,
+		Volume:             appServices.Volume,
+		Container:          appServices.Container,
+		Network:            appServices.Network,
+		Notification:       appServices.Notification,
+		Apprise:            appServices.Apprise,
+		Updater:            appServices.Updater,
+		CustomizeSearch:    appServices.CustomizeSearch,
+		System:             appServices.System,
+		SystemUpgrade:      appServices.SystemUpgrade,
+		GitRepository:      appServices.GitRepository,
+		GitOpsSync:         appServices.GitOpsSync,
+		Vulnerability:      appServices.Vulnerability,
+		Dashboard:          appServices.Dashboard,
+		Rbac:               appServices.Rbac,
+		Health:             appServices.Health,
+		Monitor:            appServices.Monitor,
+		HostMetrics:        appServices.HostMetrics,
+		Aggregation:        appServices.Aggregation,
+		Backup:             appServices.Backup,
+		Secrets:            appServices.Secrets,
+		CACertificate:      appServices.CACertificate,
+		UserNotification:   appServices.UserNotification,
+		Topology:           appServices.Topology,
+		ProjectAdoption:    appServices.ProjectAdoption,
+		LogForwarding:      appServices.LogForwarding,
+		LogAlert:           appServices.LogAlert,
+		Ingress:            appServices.Ingress,
+		ContainerTask:      appServices.ContainerTask,
+		ProjectMaintenance: appServices.ProjectMaintenance,
+		SecurityAudit:      appServices.SecurityAudit,
+		StaleResource:      appServices.StaleResource,
+		DaemonConfig:       appServices.DaemonConfig,
+		ImageDistribution:  appServices.ImageDistribution,
+		ContainerClone:     appServices.ContainerClone,
+		Rollout:            appServices.Rollout,
+		ContainerSnapshot:  appServices.ContainerSnapshot,
+		Tag:                appServices.Tag,
+		CommandWebhook:     appServices.CommandWebhook,
+		NotificationAction: appServices.NotificationAction,
+		Setup:              appServices.Setup,
+		Config:             cfg,
 	}
 
 	_ = huma.SetupAPI(router, apiGroup, cfg, humaServices)
@@ -167,7 +300,7 @@ func setupRouter(ctx context.Context, cfg *config.Config, appServices *Services)
 	api.RegisterDiagnosticsRoutes(apiGroup, authMiddleware, api.DefaultWebSocketMetrics()) //nolint:contextcheck
 
 	// Remaining Gin handlers (WebSocket/streaming)
-	api.NewWebSocketHandler(apiGroup, appServices.Project, appServices.Container, appServices.System, authMiddleware, cfg) //nolint:contextcheck
+	api.NewWebSocketHandler(apiGroup, appServices.Project, appServices.Container, appServices.System, appServices.Event, authMiddleware, cfg) //nolint:contextcheck
 
 	// Register edge tunnel endpoint for manager to accept agent connections
 	// This is only registered when NOT in agent mode (i.e., running as manager)
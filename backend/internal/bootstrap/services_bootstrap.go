@@ -8,43 +8,73 @@ import (
 	"github.com/getarcaneapp/arcane/backend/internal/config"
 	"github.com/getarcaneapp/arcane/backend/internal/database"
 	"github.com/getarcaneapp/arcane/backend/internal/services"
+	"github.com/getarcaneapp/arcane/backend/pkg/projects"
 	"github.com/getarcaneapp/arcane/backend/resources"
 )
 
 type Services struct {
-	AppImages         *services.ApplicationImagesService
-	User              *services.UserService
-	Project           *services.ProjectService
-	Environment       *services.EnvironmentService
-	Settings          *services.SettingsService
-	JobSchedule       *services.JobService
-	SettingsSearch    *services.SettingsSearchService
-	CustomizeSearch   *services.CustomizeSearchService
-	Container         *services.ContainerService
-	Image             *services.ImageService
-	Build             *services.BuildService
-	BuildWorkspace    *services.BuildWorkspaceService
-	Volume            *services.VolumeService
-	Network           *services.NetworkService
-	ImageUpdate       *services.ImageUpdateService
-	Auth              *services.AuthService
-	Oidc              *services.OidcService
-	Docker            *services.DockerClientService
-	Template          *services.TemplateService
-	ContainerRegistry *services.ContainerRegistryService
-	System            *services.SystemService
-	SystemUpgrade     *services.SystemUpgradeService
-	Updater           *services.UpdaterService
-	Event             *services.EventService
-	Version           *services.VersionService
-	Notification      *services.NotificationService
-	Apprise           *services.AppriseService //nolint:staticcheck // Apprise still functional, deprecated in favor of Shoutrrr
-	ApiKey            *services.ApiKeyService
-	GitRepository     *services.GitRepositoryService
-	GitOpsSync        *services.GitOpsSyncService
-	Font              *services.FontService
-	Vulnerability     *services.VulnerabilityService
-	Dashboard         *services.DashboardService
+	AppImages          *services.ApplicationImagesService
+	User               *services.UserService
+	Project            *services.ProjectService
+	Environment        *services.EnvironmentService
+	Settings           *services.SettingsService
+	JobSchedule        *services.JobService
+	SettingsSearch     *services.SettingsSearchService
+	CustomizeSearch    *services.CustomizeSearchService
+	Container          *services.ContainerService
+	Image              *services.ImageService
+	Build              *services.BuildService
+	BuildWorkspace     *services.BuildWorkspaceService
+	Volume             *services.VolumeService
+	Network            *services.NetworkService
+	ImageUpdate        *services.ImageUpdateService
+	Auth               *services.AuthService
+	Oidc               *services.OidcService
+	Docker             *services.DockerClientService
+	Template           *services.TemplateService
+	ContainerRegistry  *services.ContainerRegistryService
+	System             *services.SystemService
+	SystemUpgrade      *services.SystemUpgradeService
+	Updater            *services.UpdaterService
+	Event              *services.EventService
+	Version            *services.VersionService
+	Notification       *services.NotificationService
+	Apprise            *services.AppriseService //nolint:staticcheck // Apprise still functional, deprecated in favor of Shoutrrr
+	ApiKey             *services.ApiKeyService
+	GitRepository      *services.GitRepositoryService
+	GitOpsSync         *services.GitOpsSyncService
+	Font               *services.FontService
+	Vulnerability      *services.VulnerabilityService
+	Dashboard          *services.DashboardService
+	Rbac               *services.RbacService
+	Health             *services.HealthService
+	Monitor            *services.MonitorService
+	HostMetrics        *services.HostMetricsService
+	EnvironmentWatch   *services.EnvironmentWatchService
+	Backup             *services.BackupService
+	Secrets            *services.SecretsService
+	CACertificate      *services.CACertificateService
+	UserNotification   *services.UserNotificationService
+	Topology           *services.TopologyService
+	ProjectAdoption    *services.ProjectAdoptionService
+	LogForwarding      *services.LogForwardingService
+	LogAlert           *services.LogAlertService
+	Ingress            *services.IngressService
+	ContainerTask      *services.ContainerTaskService
+	ProjectMaintenance *services.ProjectMaintenanceService
+	SecurityAudit      *services.SecurityAuditService
+	StaleResource      *services.StaleResourceService
+	DaemonConfig       *services.DaemonConfigService
+	ImageDistribution  *services.ImageDistributionService
+	ContainerClone     *services.ContainerCloneService
+	Rollout            *services.RolloutService
+	ContainerSnapshot  *services.ContainerSnapshotService
+	Tag                *services.TagService
+	CommandWebhook     *services.CommandWebhookService
+	NotificationAction *services.NotificationActionService
+	Setup              *services.SetupService
+	CredentialCheck    *services.NotificationCredentialService
+	Aggregation        *services.AggregationService
 }
 
 func initializeServices(ctx context.Context, db *database.DB, cfg *config.Config, httpClient *http.Client) (svcs *Services, dockerSrvice *services.DockerClientService, err error) {
@@ -55,6 +85,7 @@ func initializeServices(ctx context.Context, db *database.DB, cfg *config.Config
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to settings service: %w", err)
 	}
+	svcs.Event.SetSettingsService(svcs.Settings)
 	svcs.JobSchedule = services.NewJobService(db, svcs.Settings, cfg)
 	svcs.SettingsSearch = services.NewSettingsSearchService()
 	svcs.CustomizeSearch = services.NewCustomizeSearchService()
@@ -67,13 +98,14 @@ func initializeServices(ctx context.Context, db *database.DB, cfg *config.Config
 	svcs.Notification = services.NewNotificationService(db, cfg)
 	svcs.Apprise = services.NewAppriseService(db, cfg)
 	svcs.Vulnerability = services.NewVulnerabilityService(db, svcs.Docker, svcs.Event, svcs.Settings, svcs.Notification)
-	svcs.Dashboard = services.NewDashboardService(db, svcs.Docker, svcs.Vulnerability)
+	svcs.Dashboard = services.NewDashboardService(db, svcs.Docker, svcs.Vulnerability, svcs.Settings)
 	svcs.ImageUpdate = services.NewImageUpdateService(db, svcs.Settings, svcs.ContainerRegistry, svcs.Docker, svcs.Event, svcs.Notification)
 	svcs.Image = services.NewImageService(db, svcs.Docker, svcs.ContainerRegistry, svcs.ImageUpdate, svcs.Vulnerability, svcs.Event)
 	svcs.GitRepository = services.NewGitRepositoryService(db, cfg.GitWorkDir, svcs.Event, svcs.Settings)
 	svcs.Build = services.NewBuildService(db, svcs.Settings, svcs.Docker, svcs.ContainerRegistry, svcs.GitRepository)
 	svcs.BuildWorkspace = services.NewBuildWorkspaceService(svcs.Settings)
-	svcs.Project = services.NewProjectService(db, svcs.Settings, svcs.Event, svcs.Image, svcs.Docker, svcs.Build)
+	svcs.Project = services.NewProjectService(db, svcs.Settings, svcs.Event, svcs.Image, svcs.Docker, svcs.Build, svcs.ContainerRegistry)
+	svcs.Settings.ResolveProjectsPathMapping = svcs.Project.ResolvePathMapping
 	svcs.Environment = services.NewEnvironmentService(db, httpClient, svcs.Docker, svcs.Event, svcs.Settings)
 	svcs.Container = services.NewContainerService(db, svcs.Event, svcs.Docker, svcs.Image, svcs.Settings)
 	svcs.Volume = services.NewVolumeService(db, svcs.Docker, svcs.Event, svcs.Settings, svcs.Container, svcs.Image, cfg.BackupVolumeName)
@@ -82,11 +114,46 @@ func initializeServices(ctx context.Context, db *database.DB, cfg *config.Config
 	svcs.Auth = services.NewAuthService(svcs.User, svcs.Settings, svcs.Event, cfg.JWTSecret, cfg)
 	svcs.Oidc = services.NewOidcService(svcs.Auth, cfg, httpClient)
 	svcs.ApiKey = services.NewApiKeyService(db, svcs.User)
+	svcs.Rbac = services.NewRbacService(db)
 	svcs.System = services.NewSystemService(db, svcs.Docker, svcs.Container, svcs.Image, svcs.Volume, svcs.Network, svcs.Settings)
 	svcs.Version = services.NewVersionService(httpClient, cfg.UpdateCheckDisabled, config.Version, config.Revision, svcs.ContainerRegistry, svcs.Docker)
 	svcs.SystemUpgrade = services.NewSystemUpgradeService(svcs.Docker, svcs.Version, svcs.Event, svcs.Settings)
 	svcs.Updater = services.NewUpdaterService(db, svcs.Settings, svcs.Docker, svcs.Project, svcs.ImageUpdate, svcs.ContainerRegistry, svcs.Event, svcs.Image, svcs.Notification, svcs.SystemUpgrade)
-	svcs.GitOpsSync = services.NewGitOpsSyncService(db, svcs.GitRepository, svcs.Project, svcs.Event)
+	svcs.GitOpsSync = services.NewGitOpsSyncService(db, svcs.GitRepository, svcs.Project, svcs.ImageUpdate, svcs.Event)
+	svcs.Health = services.NewHealthService(db, svcs.Docker, svcs.Settings, svcs.Environment)
+	svcs.Monitor = services.NewMonitorService(db, svcs.Event, svcs.Notification)
+	svcs.HostMetrics = services.NewHostMetricsService(db, svcs.Settings, svcs.Environment, svcs.System, svcs.Event, svcs.Notification)
+	svcs.EnvironmentWatch = services.NewEnvironmentWatchService(db, svcs.Settings, svcs.Event, svcs.Notification)
+	svcs.CredentialCheck = services.NewNotificationCredentialService(db, svcs.Event, svcs.Notification)
+	svcs.Backup = services.NewBackupService(db, svcs.Settings)
+	svcs.Secrets = services.NewSecretsService(db)
+	projects.SetSecretResolver(svcs.Secrets.ResolveSecret)
+	projects.SetTemplateVariableProvider(func(ctx context.Context) (map[string]string, error) {
+		return projects.ParseTemplateVariables(svcs.Settings.GetStringSetting(ctx, "composeTemplateVariables", ""))
+	})
+	projects.SetResourceQuotaProvider(svcs.Project.GetResourceQuotaForComposeName)
+	svcs.CACertificate = services.NewCACertificateService(db)
+	svcs.UserNotification = services.NewUserNotificationService(db)
+	svcs.Topology = services.NewTopologyService(svcs.Docker, svcs.Project)
+	svcs.ProjectAdoption = services.NewProjectAdoptionService(svcs.Docker, svcs.Project)
+	svcs.LogForwarding = services.NewLogForwardingService(db, svcs.Docker, svcs.Project)
+	svcs.LogAlert = services.NewLogAlertService(db, svcs.Docker, svcs.Project, svcs.Event, svcs.Notification)
+	svcs.Ingress = services.NewIngressService(svcs.Docker)
+	svcs.ContainerTask = services.NewContainerTaskService(db, svcs.Docker, svcs.Event, svcs.Notification)
+	svcs.ProjectMaintenance = services.NewProjectMaintenanceService(db, svcs.Project, svcs.Container, svcs.Docker, svcs.Event)
+	svcs.SecurityAudit = services.NewSecurityAuditService(svcs.Docker, svcs.Project)
+	svcs.StaleResource = services.NewStaleResourceService(svcs.Docker, svcs.Settings, svcs.Container, svcs.Volume, svcs.Network, svcs.Image)
+	svcs.DaemonConfig = services.NewDaemonConfigService(svcs.Docker, svcs.Event, cfg)
+	svcs.ContainerSnapshot = services.NewContainerSnapshotService(svcs.Docker, svcs.ContainerRegistry, svcs.Event, svcs.Settings)
+	svcs.Tag = services.NewTagService(db, svcs.Docker)
+	svcs.ImageDistribution = services.NewImageDistributionService(svcs.Docker, svcs.Environment, svcs.Image, svcs.ContainerRegistry, svcs.Event)
+	svcs.ContainerClone = services.NewContainerCloneService(svcs.Docker, svcs.Environment, svcs.ImageDistribution, svcs.Container, svcs.Volume, svcs.Event)
+	svcs.Aggregation = services.NewAggregationService(svcs.Environment, svcs.Container, svcs.Project, svcs.Image)
+	svcs.Rollout = services.NewRolloutService(svcs.Updater, svcs.Environment, svcs.Aggregation, svcs.Settings, svcs.Event, svcs.Notification)
+	svcs.CommandWebhook = services.NewCommandWebhookService(db, svcs.User, svcs.Project, svcs.Updater, svcs.System, svcs.Settings, svcs.Docker, svcs.Event)
+	svcs.NotificationAction = services.NewNotificationActionService(cfg, svcs.Settings, svcs.ImageUpdate, svcs.Updater, svcs.Event)
+	svcs.Notification.ImageUpdateActions = svcs.NotificationAction.ImageUpdateActions
+	svcs.Setup = services.NewSetupService(db, svcs.User, svcs.Settings, svcs.Docker)
 
 	return svcs, dockerClient, nil
 }
@@ -188,6 +188,14 @@ func (e *ContainerStatusCountsError) Error() string {
 	return fmt.Sprintf("Failed to get container counts: %v", e.Err)
 }
 
+type ContainerLogsError struct {
+	Err error
+}
+
+func (e *ContainerLogsError) Error() string {
+	return fmt.Sprintf("Failed to read container logs: %v", e.Err)
+}
+
 type InvalidPortFormatError struct {
 	Err error
 }
@@ -506,6 +514,30 @@ func (e *UpdateSummaryError) Error() string {
 	return fmt.Sprintf("Failed to get update summary: %v", e.Err)
 }
 
+type ImageUpdateSnoozeError struct {
+	Err error
+}
+
+func (e *ImageUpdateSnoozeError) Error() string {
+	return fmt.Sprintf("Failed to snooze image updates: %v", e.Err)
+}
+
+type ImageUpdateUnsnoozeError struct {
+	Err error
+}
+
+func (e *ImageUpdateUnsnoozeError) Error() string {
+	return fmt.Sprintf("Failed to unsnooze image updates: %v", e.Err)
+}
+
+type ImageUpdateSnoozeListError struct {
+	Err error
+}
+
+func (e *ImageUpdateSnoozeListError) Error() string {
+	return fmt.Sprintf("Failed to list snoozed image updates: %v", e.Err)
+}
+
 type NetworkListError struct {
 	Err error
 }
@@ -598,6 +630,22 @@ func (e *NotificationSettingsDeletionError) Error() string {
 	return fmt.Sprintf("Failed to delete notification settings: %v", e.Err)
 }
 
+type NotificationSettingsExportError struct {
+	Err error
+}
+
+func (e *NotificationSettingsExportError) Error() string {
+	return fmt.Sprintf("Failed to export notification settings: %v", e.Err)
+}
+
+type NotificationSettingsImportError struct {
+	Err error
+}
+
+func (e *NotificationSettingsImportError) Error() string {
+	return fmt.Sprintf("Failed to import notification settings: %v", e.Err)
+}
+
 type NotificationTestError struct {
 	Err error
 }
@@ -714,6 +762,14 @@ func (e *ProjectDetailsError) Error() string {
 	return fmt.Sprintf("Failed to get project details: %v", e.Err)
 }
 
+type ProjectEnvCheckError struct {
+	Err error
+}
+
+func (e *ProjectEnvCheckError) Error() string {
+	return fmt.Sprintf("Failed to check project environment: %v", e.Err)
+}
+
 type ProjectRedeploymentError struct {
 	Err error
 }
@@ -776,6 +832,14 @@ func (e *SettingsUpdateError) Error() string {
 	return "Failed to update settings"
 }
 
+type SettingsDryApplyError struct {
+	Err error
+}
+
+func (e *SettingsDryApplyError) Error() string {
+	return fmt.Sprintf("Failed to dry-apply settings: %v", e.Err)
+}
+
 type DockerConnectionError struct {
 	Err error
 }
@@ -816,6 +880,14 @@ func (e *SystemPruneError) Error() string {
 	return fmt.Sprintf("Failed to prune resources: %v", e.Err)
 }
 
+type SystemPrunePreviewError struct {
+	Err error
+}
+
+func (e *SystemPrunePreviewError) Error() string {
+	return fmt.Sprintf("Failed to preview prune: %v", e.Err)
+}
+
 type ContainerStartAllError struct {
 	Err error
 }
@@ -856,6 +928,30 @@ func (e *DockerComposeConversionError) Error() string {
 	return "Failed to convert to Docker Compose format."
 }
 
+type RemoteTagListError struct {
+	Err error
+}
+
+func (e *RemoteTagListError) Error() string {
+	return fmt.Sprintf("Failed to list registry tags: %v", e.Err)
+}
+
+type ProjectAdoptionError struct {
+	Err error
+}
+
+func (e *ProjectAdoptionError) Error() string {
+	return fmt.Sprintf("Failed to adopt containers into project: %v", e.Err)
+}
+
+type DockerRunGenerationError struct {
+	Err error
+}
+
+func (e *DockerRunGenerationError) Error() string {
+	return fmt.Sprintf("Failed to generate docker run command: %v", e.Err)
+}
+
 type UpgradeCheckError struct {
 	Err error
 }
@@ -1032,6 +1128,30 @@ func (e *UpdaterHistoryError) Error() string {
 	return fmt.Sprintf("Failed to get updater history: %v", e.Err)
 }
 
+type UpdaterRetryError struct {
+	Err error
+}
+
+func (e *UpdaterRetryError) Error() string {
+	return fmt.Sprintf("Failed to retry updater run: %v", e.Err)
+}
+
+type UpdaterPreviewError struct {
+	Err error
+}
+
+func (e *UpdaterPreviewError) Error() string {
+	return fmt.Sprintf("Failed to preview container update: %v", e.Err)
+}
+
+type UpdaterRollbackError struct {
+	Err error
+}
+
+func (e *UpdaterRollbackError) Error() string {
+	return fmt.Sprintf("Failed to roll back container: %v", e.Err)
+}
+
 type UserListError struct {
 	Err error
 }
@@ -1287,6 +1407,14 @@ func (e *GitOpsSyncPerformError) Error() string {
 	return "Failed to perform GitOps sync"
 }
 
+type GitOpsTagBumpError struct {
+	Err error
+}
+
+func (e *GitOpsTagBumpError) Error() string {
+	return fmt.Sprintf("Failed to propose image tag bumps: %v", e.Err)
+}
+
 type GitOpsSyncStatusError struct {
 	Err error
 }
@@ -1332,3 +1460,401 @@ type VulnerabilityScanRetrievalError struct {
 func (e *VulnerabilityScanRetrievalError) Error() string {
 	return fmt.Sprintf("Failed to retrieve vulnerability scan: %v", e.Err)
 }
+
+type EventExportError struct {
+	Err error
+}
+
+func (e *EventExportError) Error() string {
+	return fmt.Sprintf("Failed to export events: %v", e.Err)
+}
+
+type EventForwardingError struct {
+	Err error
+}
+
+func (e *EventForwardingError) Error() string {
+	return fmt.Sprintf("Failed to forward event: %v", e.Err)
+}
+
+type BackupExportError struct {
+	Err error
+}
+
+func (e *BackupExportError) Error() string {
+	return fmt.Sprintf("Failed to export configuration: %v", e.Err)
+}
+
+type BackupImportError struct {
+	Err error
+}
+
+func (e *BackupImportError) Error() string {
+	return fmt.Sprintf("Failed to import configuration: %v", e.Err)
+}
+
+type SecretListError struct {
+	Err error
+}
+
+func (e *SecretListError) Error() string {
+	return fmt.Sprintf("Failed to list secrets: %v", e.Err)
+}
+
+type SecretCreationError struct {
+	Err error
+}
+
+func (e *SecretCreationError) Error() string {
+	return fmt.Sprintf("Failed to create secret: %v", e.Err)
+}
+
+type SecretUpdateError struct {
+	Err error
+}
+
+func (e *SecretUpdateError) Error() string {
+	return fmt.Sprintf("Failed to update secret: %v", e.Err)
+}
+
+type SecretDeletionError struct {
+	Err error
+}
+
+func (e *SecretDeletionError) Error() string {
+	return fmt.Sprintf("Failed to delete secret: %v", e.Err)
+}
+
+type CACertificateListError struct {
+	Err error
+}
+
+func (e *CACertificateListError) Error() string {
+	return fmt.Sprintf("Failed to list CA certificates: %v", e.Err)
+}
+
+type CACertificateCreationError struct {
+	Err error
+}
+
+func (e *CACertificateCreationError) Error() string {
+	return fmt.Sprintf("Failed to add CA certificate: %v", e.Err)
+}
+
+type CACertificateDeletionError struct {
+	Err error
+}
+
+func (e *CACertificateDeletionError) Error() string {
+	return fmt.Sprintf("Failed to delete CA certificate: %v", e.Err)
+}
+
+type NotificationSubscriptionListError struct {
+	Err error
+}
+
+func (e *NotificationSubscriptionListError) Error() string {
+	return fmt.Sprintf("Failed to list notification subscriptions: %v", e.Err)
+}
+
+type NotificationSubscriptionUpdateError struct {
+	Err error
+}
+
+func (e *NotificationSubscriptionUpdateError) Error() string {
+	return fmt.Sprintf("Failed to update notification subscription: %v", e.Err)
+}
+
+type NotificationSubscriptionDeletionError struct {
+	Err error
+}
+
+func (e *NotificationSubscriptionDeletionError) Error() string {
+	return fmt.Sprintf("Failed to delete notification subscription: %v", e.Err)
+}
+
+type TopologyRetrievalError struct {
+	Err error
+}
+
+func (e *TopologyRetrievalError) Error() string {
+	return fmt.Sprintf("Failed to build topology: %v", e.Err)
+}
+
+type LogForwarderRetrievalError struct {
+	Err error
+}
+
+func (e *LogForwarderRetrievalError) Error() string {
+	return fmt.Sprintf("Failed to get log forwarding configuration: %v", e.Err)
+}
+
+type LogForwarderUpdateError struct {
+	Err error
+}
+
+func (e *LogForwarderUpdateError) Error() string {
+	return fmt.Sprintf("Failed to save log forwarding configuration: %v", e.Err)
+}
+
+type LogForwarderDeletionError struct {
+	Err error
+}
+
+func (e *LogForwarderDeletionError) Error() string {
+	return fmt.Sprintf("Failed to delete log forwarding configuration: %v", e.Err)
+}
+
+type DashboardLayoutRetrievalError struct {
+	Err error
+}
+
+func (e *DashboardLayoutRetrievalError) Error() string {
+	return fmt.Sprintf("Failed to get dashboard layout: %v", e.Err)
+}
+
+type DashboardLayoutUpdateError struct {
+	Err error
+}
+
+func (e *DashboardLayoutUpdateError) Error() string {
+	return fmt.Sprintf("Failed to save dashboard layout: %v", e.Err)
+}
+
+type DashboardWidgetDataError struct {
+	Err error
+}
+
+func (e *DashboardWidgetDataError) Error() string {
+	return fmt.Sprintf("Failed to load dashboard widgets: %v", e.Err)
+}
+
+type IngressRoutesRetrievalError struct {
+	Err error
+}
+
+func (e *IngressRoutesRetrievalError) Error() string {
+	return fmt.Sprintf("Failed to analyze reverse proxy labels: %v", e.Err)
+}
+
+type IngressLabelsGenerationError struct {
+	Err error
+}
+
+func (e *IngressLabelsGenerationError) Error() string {
+	return fmt.Sprintf("Failed to generate reverse proxy labels: %v", e.Err)
+}
+
+type ProjectQuotaRetrievalError struct {
+	Err error
+}
+
+func (e *ProjectQuotaRetrievalError) Error() string {
+	return fmt.Sprintf("Failed to get project quota: %v", e.Err)
+}
+
+type ProjectQuotaUpdateError struct {
+	Err error
+}
+
+func (e *ProjectQuotaUpdateError) Error() string {
+	return fmt.Sprintf("Failed to update project quota: %v", e.Err)
+}
+
+type ProjectQuotaDeleteError struct {
+	Err error
+}
+
+func (e *ProjectQuotaDeleteError) Error() string {
+	return fmt.Sprintf("Failed to delete project quota: %v", e.Err)
+}
+
+type ProjectLockError struct {
+	Err error
+}
+
+func (e *ProjectLockError) Error() string {
+	return fmt.Sprintf("Failed to lock project: %v", e.Err)
+}
+
+type ProjectUnlockError struct {
+	Err error
+}
+
+func (e *ProjectUnlockError) Error() string {
+	return fmt.Sprintf("Failed to unlock project: %v", e.Err)
+}
+
+type ProjectMaintenanceStartError struct {
+	Err error
+}
+
+func (e *ProjectMaintenanceStartError) Error() string {
+	return fmt.Sprintf("Failed to start project maintenance: %v", e.Err)
+}
+
+type ProjectMaintenanceEndError struct {
+	Err error
+}
+
+func (e *ProjectMaintenanceEndError) Error() string {
+	return fmt.Sprintf("Failed to end project maintenance: %v", e.Err)
+}
+
+type ProjectCloneError struct {
+	Err error
+}
+
+func (e *ProjectCloneError) Error() string {
+	return fmt.Sprintf("Failed to clone project: %v", e.Err)
+}
+
+type ProjectArchiveError struct {
+	Err error
+}
+
+func (e *ProjectArchiveError) Error() string {
+	return fmt.Sprintf("Failed to archive project: %v", e.Err)
+}
+
+type ProjectUnarchiveError struct {
+	Err error
+}
+
+func (e *ProjectUnarchiveError) Error() string {
+	return fmt.Sprintf("Failed to unarchive project: %v", e.Err)
+}
+
+type PullHistoryListError struct {
+	Err error
+}
+
+func (e *PullHistoryListError) Error() string {
+	return fmt.Sprintf("Failed to list image pull history: %v", e.Err)
+}
+
+type SecurityAuditError struct {
+	Err error
+}
+
+func (e *SecurityAuditError) Error() string {
+	return fmt.Sprintf("Failed to audit containers: %v", e.Err)
+}
+
+type DaemonConfigRetrievalError struct {
+	Err error
+}
+
+func (e *DaemonConfigRetrievalError) Error() string {
+	return fmt.Sprintf("Failed to get Docker daemon configuration: %v", e.Err)
+}
+
+type DaemonJSONUpdateError struct {
+	Err error
+}
+
+func (e *DaemonJSONUpdateError) Error() string {
+	return fmt.Sprintf("Failed to update daemon.json: %v", e.Err)
+}
+
+type ImageDistributionError struct {
+	Err error
+}
+
+func (e *ImageDistributionError) Error() string {
+	return fmt.Sprintf("Failed to distribute image: %v", e.Err)
+}
+
+type ImagePromotionError struct {
+	Err error
+}
+
+func (e *ImagePromotionError) Error() string {
+	return fmt.Sprintf("Failed to promote image: %v", e.Err)
+}
+
+type ContainerCloneError struct {
+	Err error
+}
+
+func (e *ContainerCloneError) Error() string {
+	return fmt.Sprintf("Failed to clone container: %v", e.Err)
+}
+
+type ContainerImportError struct {
+	Err error
+}
+
+func (e *ContainerImportError) Error() string {
+	return fmt.Sprintf("Failed to import container: %v", e.Err)
+}
+
+type RolloutError struct {
+	Err error
+}
+
+func (e *RolloutError) Error() string {
+	return fmt.Sprintf("Failed to start rollout: %v", e.Err)
+}
+
+type ContainerSnapshotError struct {
+	Err error
+}
+
+func (e *ContainerSnapshotError) Error() string {
+	return fmt.Sprintf("Container snapshot failed: %v", e.Err)
+}
+
+type ProjectFileDeleteError struct {
+	Err error
+}
+
+func (e *ProjectFileDeleteError) Error() string {
+	return fmt.Sprintf("Failed to delete project file: %v", e.Err)
+}
+
+type ContainerEditError struct {
+	Err error
+}
+
+func (e *ContainerEditError) Error() string {
+	return fmt.Sprintf("Failed to edit container: %v", e.Err)
+}
+
+type ProjectRegistriesError struct {
+	Err error
+}
+
+func (e *ProjectRegistriesError) Error() string {
+	return fmt.Sprintf("Failed to update project registries: %v", e.Err)
+}
+
+type SetupStatusError struct {
+	Err error
+}
+
+func (e *SetupStatusError) Error() string {
+	return fmt.Sprintf("Failed to get setup status: %v", e.Err)
+}
+
+type SetupStepError struct {
+	Err error
+}
+
+func (e *SetupStepError) Error() string {
+	return fmt.Sprintf("Setup failed: %v", e.Err)
+}
+
+type SetupClosedError struct{}
+
+func (e *SetupClosedError) Error() string {
+	return "Setup has already been completed"
+}
+
+type ChangelogFetchError struct {
+	Err error
+}
+
+func (e *ChangelogFetchError) Error() string {
+	return fmt.Sprintf("Failed to fetch changelog: %v", e.Err)
+}
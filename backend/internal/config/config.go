@@ -30,6 +30,9 @@ type Config struct {
 	AppUrl           string         `env:"APP_URL" default:"http://localhost:3552"`
 	DatabaseURL      string         `env:"DATABASE_URL" default:"file:data/arcane.db?_pragma=journal_mode(WAL)&_pragma=busy_timeout(2500)&_txlock=immediate" options:"file"`
 	AllowDowngrade   bool           `env:"ALLOW_DOWNGRADE" default:"false"`
+	DBMaxOpenConns   int            `env:"DB_MAX_OPEN_CONNS" default:"0"`
+	DBMaxIdleConns   int            `env:"DB_MAX_IDLE_CONNS" default:"0"`
+	DBConnLifetime   int            `env:"DB_CONN_MAX_LIFETIME" default:"0"` // seconds
 	Port             string         `env:"PORT" default:"3552"`
 	Listen           string         `env:"LISTEN" default:""`
 	TLSEnabled       bool           `env:"TLS_ENABLED" default:"false"`
@@ -53,6 +56,7 @@ type Config struct {
 	OidcProviderLogoUrl        string `env:"OIDC_PROVIDER_LOGO_URL" default:""`
 
 	DockerHost              string `env:"DOCKER_HOST" default:"unix:///var/run/docker.sock"`
+	DockerDaemonConfigPath  string `env:"DOCKER_DAEMON_CONFIG_PATH" default:"/etc/docker/daemon.json"`
 	ProjectsDirectory       string `env:"PROJECTS_DIRECTORY" default:"/app/data/projects"`
 	LogJson                 bool   `env:"LOG_JSON" default:"false"`
 	LogLevel                string `env:"LOG_LEVEL" default:"info" options:"toLower"`
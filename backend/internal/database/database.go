@@ -35,9 +35,49 @@ type DB struct {
 
 type MigrationOptions struct {
 	AllowDowngrade bool
+	Pool           PoolOptions
 	githubRef      string
 }
 
+// PoolOptions tunes the connection pool. Zero values keep the defaults, which
+// suit the embedded SQLite database; external PostgreSQL servers shared by
+// several replicas usually want a lower MaxOpenConns per replica.
+type PoolOptions struct {
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+	ConnMaxIdleTime time.Duration
+}
+
+func (o PoolOptions) withDefaultsInternal() PoolOptions {
+	if o.MaxOpenConns <= 0 {
+		o.MaxOpenConns = defaultMaxOpenConns
+	}
+	if o.MaxIdleConns <= 0 {
+		o.MaxIdleConns = defaultMaxIdleConns
+	}
+	if o.MaxIdleConns > o.MaxOpenConns {
+		o.MaxIdleConns = o.MaxOpenConns
+	}
+	if o.ConnMaxLifetime <= 0 {
+		o.ConnMaxLifetime = defaultConnMaxLifetime
+	}
+	if o.ConnMaxIdleTime <= 0 {
+		o.ConnMaxIdleTime = defaultConnMaxIdleTime
+	}
+	return o
+}
+
+const (
+	ProviderSQLite   = "sqlite"
+	ProviderPostgres = "postgres"
+
+	defaultMaxOpenConns    = 20
+	defaultMaxIdleConns    = 5
+	defaultConnMaxLifetime = 5 * time.Minute
+	defaultConnMaxIdleTime = 3 * time.Minute
+)
+
 const (
 	migrationRepositoryOwner       = "getarcaneapp"
 	migrationRepositoryName        = "arcane"
@@ -84,22 +124,17 @@ func Initialize(ctx context.Context, databaseURL string, options MigrationOption
 	}
 
 	// Determine database provider for migrations
-	var dbProvider string
-	switch {
-	case strings.HasPrefix(databaseURL, "file:"):
-		dbProvider = "sqlite"
-	case strings.HasPrefix(databaseURL, "postgres"):
-		dbProvider = "postgres"
-	default:
-		return nil, fmt.Errorf("unsupported database type in URL: %s", databaseURL)
+	dbProvider, err := ProviderFromURL(databaseURL)
+	if err != nil {
+		return nil, err
 	}
 
 	// Choose the correct driver for migrations
 	var driver database.Driver
 	switch dbProvider {
-	case "sqlite":
+	case ProviderSQLite:
 		driver, err = sqliteMigrate.WithInstance(sqlDB, &sqliteMigrate.Config{})
-	case "postgres":
+	case ProviderPostgres:
 		driver, err = postgresMigrate.WithInstance(sqlDB, &postgresMigrate.Config{})
 	default:
 		return nil, fmt.Errorf("unsupported database provider: %s", dbProvider)
@@ -115,19 +150,58 @@ func Initialize(ctx context.Context, databaseURL string, options MigrationOption
 	}
 
 	// Set connection pool settings
-	sqlDB.SetMaxIdleConns(5)
-	sqlDB.SetMaxOpenConns(20)
-	sqlDB.SetConnMaxLifetime(5 * time.Minute)
-	sqlDB.SetConnMaxIdleTime(3 * time.Minute)
+	pool := options.Pool.withDefaultsInternal()
+	sqlDB.SetMaxIdleConns(pool.MaxIdleConns)
+	sqlDB.SetMaxOpenConns(pool.MaxOpenConns)
+	sqlDB.SetConnMaxLifetime(pool.ConnMaxLifetime)
+	sqlDB.SetConnMaxIdleTime(pool.ConnMaxIdleTime)
 
 	return db, nil
 }
 
+// ProviderFromURL returns the migration provider ("sqlite" or "postgres") for
+// a database URL. Both postgres:// and postgresql:// URLs are accepted.
+func ProviderFromURL(databaseURL string) (string, error) {
+	switch {
+	case strings.HasPrefix(databaseURL, "file:"):
+		return ProviderSQLite, nil
+	case strings.HasPrefix(databaseURL, "postgres://"), strings.HasPrefix(databaseURL, "postgresql://"):
+		return ProviderPostgres, nil
+	default:
+		return "", fmt.Errorf("unsupported database type in URL: %s", redactDatabaseURL(databaseURL))
+	}
+}
+
+// Provider returns the migration provider of an open database.
+func (db *DB) Provider() string {
+	if db.Dialector.Name() == "postgres" {
+		return ProviderPostgres
+	}
+	return ProviderSQLite
+}
+
+// redactDatabaseURL hides the password of a database URL so it can be logged.
+func redactDatabaseURL(databaseURL string) string {
+	u, err := url.Parse(databaseURL)
+	if err != nil || u.User == nil {
+		return databaseURL
+	}
+	if _, ok := u.User.Password(); ok {
+		u.User = url.UserPassword(u.User.Username(), "xxxxx")
+	}
+	return u.String()
+}
+
 func connectDatabase(ctx context.Context, databaseURL string) (*DB, error) {
 	var dialector gorm.Dialector
 
-	switch {
-	case strings.HasPrefix(databaseURL, "file:"):
+	provider, err := ProviderFromURL(databaseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	switch provider {
+	case ProviderSQLite:
 		connString, err := parseSqliteConnectionString(databaseURL)
 		if err != nil {
 			return nil, fmt.Errorf("failed to parse SQLite connection string: %w", err)
@@ -136,15 +210,12 @@ func connectDatabase(ctx context.Context, databaseURL string) (*DB, error) {
 			return nil, fmt.Errorf("failed to prepare SQLite directory: %w", err)
 		}
 		dialector = glsqlite.Open(connString)
-	case strings.HasPrefix(databaseURL, "postgres"):
+	case ProviderPostgres:
 		dialector = postgres.Open(databaseURL)
-	default:
-		return nil, fmt.Errorf("unsupported database type in URL: %s", databaseURL)
 	}
 
 	// Retry connection up to 3 times
 	var db *gorm.DB
-	var err error
 	for i := 1; i <= 3; i++ {
 		if err := ctx.Err(); err != nil {
 			return nil, err
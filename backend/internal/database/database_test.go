@@ -114,9 +114,13 @@ func TestMigrateDatabase_DirtyCurrentVersionRequiresResolution(t *testing.T) {
 
 func TestMigrateDatabase_DirtyOlderVersionRequiresResolution(t *testing.T) {
 	dbDir := t.TempDir()
-	driver := newSQLiteMigrationDriverInternal(t, dbDir, "arcane-test.db")
-	require.NoError(t, migrateDatabase(driver, "sqlite", MigrationOptions{}))
 	targetVersion := downgradeTargetVersionInternal(t)
+	// Only apply migrations up to the target so re-applying the next one
+	// starts from the schema it expects.
+	setup, setupSource, err := newEmbeddedMigrateInstanceInternal(newSQLiteMigrationDriverInternal(t, dbDir, "arcane-test.db"), "sqlite")
+	require.NoError(t, err)
+	require.NoError(t, setup.Migrate(targetVersion))
+	closeMigrateSourceInternal(setupSource, "test embedded migrate source")
 	targetVersionInt, err := safeUintToIntInternal(targetVersion)
 	require.NoError(t, err)
 	require.NoError(t, newSQLiteMigrationDriverInternal(t, dbDir, "arcane-test.db").SetVersion(targetVersionInt, true))
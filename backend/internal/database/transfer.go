@@ -0,0 +1,330 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+const defaultCopyBatchSize = 500
+
+var safeTableNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// ErrDestinationNotEmpty is returned by CopyData when the destination already
+// holds application data and CopyOptions.Force is not set.
+var ErrDestinationNotEmpty = errors.New("destination database is not empty")
+
+// migrationSeededTables are filled with defaults by the migrations themselves,
+// so a freshly migrated destination is still empty when only these tables
+// hold rows. CopyData replaces their rows with those of the source.
+var migrationSeededTables = map[string]bool{
+	"settings": true,
+}
+
+// CopyOptions controls CopyData.
+type CopyOptions struct {
+	// BatchSize is the number of rows read and written per statement.
+	BatchSize int
+	// Force allows copying into a destination that already contains rows.
+	// Existing rows in the copied tables are deleted first. Rows seeded by
+	// the migrations are always replaced.
+	Force bool
+	// DryRun only counts the rows that would be copied.
+	DryRun bool
+	// Progress, when set, is called after each table has been copied.
+	Progress func(table string, rows int64)
+}
+
+// TableCopyResult reports the row counts for a single table.
+type TableCopyResult struct {
+	Table      string
+	SourceRows int64
+	CopiedRows int64
+}
+
+// CopyResult summarises a CopyData run.
+type CopyResult struct {
+	Tables []TableCopyResult
+}
+
+// TotalRows returns the number of rows copied across all tables.
+func (r *CopyResult) TotalRows() int64 {
+	var total int64
+	for _, t := range r.Tables {
+		total += t.CopiedRows
+	}
+	return total
+}
+
+// CopyData copies every application table from src into dst. Both databases
+// must already be migrated to the same schema version, which Initialize
+// guarantees. The copy runs in a single destination transaction so a failure
+// leaves the destination untouched.
+func CopyData(ctx context.Context, src, dst *DB, opts CopyOptions) (*CopyResult, error) {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = defaultCopyBatchSize
+	}
+
+	tables, err := copyableTablesInternal(ctx, src)
+	if err != nil {
+		return nil, err
+	}
+
+	dstTables, err := dst.WithContext(ctx).Migrator().GetTables()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list destination tables: %w", err)
+	}
+	for _, table := range tables {
+		if !containsFoldInternal(dstTables, table) {
+			return nil, fmt.Errorf("table %s is missing in the destination; run migrations on the destination first", table)
+		}
+	}
+
+	if !opts.Force {
+		for _, table := range tables {
+			if migrationSeededTables[table] {
+				continue
+			}
+			var count int64
+			if err := dst.WithContext(ctx).Table(table).Count(&count).Error; err != nil {
+				return nil, fmt.Errorf("failed to count destination table %s: %w", table, err)
+			}
+			if count > 0 {
+				return nil, fmt.Errorf("%w: table %s has %d rows", ErrDestinationNotEmpty, table, count)
+			}
+		}
+	}
+
+	result := &CopyResult{Tables: make([]TableCopyResult, 0, len(tables))}
+
+	if opts.DryRun {
+		for _, table := range tables {
+			var count int64
+			if err := src.WithContext(ctx).Table(table).Count(&count).Error; err != nil {
+				return nil, fmt.Errorf("failed to count source table %s: %w", table, err)
+			}
+			result.Tables = append(result.Tables, TableCopyResult{Table: table, SourceRows: count})
+		}
+		return result, nil
+	}
+
+	err = dst.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := disableForeignKeysInternal(tx); err != nil {
+			return err
+		}
+
+		for _, table := range tables {
+			if !opts.Force && !migrationSeededTables[table] {
+				continue
+			}
+			if err := tx.Exec("DELETE FROM " + quoteIdentInternal(table)).Error; err != nil {
+				return fmt.Errorf("failed to clear destination table %s: %w", table, err)
+			}
+		}
+
+		for _, table := range tables {
+			tableResult, err := copyTableInternal(ctx, src, tx, table, opts.BatchSize)
+			if err != nil {
+				return err
+			}
+			result.Tables = append(result.Tables, tableResult)
+			if opts.Progress != nil {
+				opts.Progress(table, tableResult.CopiedRows)
+			}
+		}
+
+		if dst.Provider() == ProviderPostgres {
+			for _, table := range tables {
+				if err := resetPostgresSequencesInternal(tx, table); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, table := range result.Tables {
+		var count int64
+		if err := dst.WithContext(ctx).Table(table.Table).Count(&count).Error; err != nil {
+			return nil, fmt.Errorf("failed to verify destination table %s: %w", table.Table, err)
+		}
+		if count != table.SourceRows {
+			return result, fmt.Errorf("row count mismatch for table %s: source has %d rows, destination has %d", table.Table, table.SourceRows, count)
+		}
+	}
+
+	return result, nil
+}
+
+func copyableTablesInternal(ctx context.Context, src *DB) ([]string, error) {
+	all, err := src.WithContext(ctx).Migrator().GetTables()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list source tables: %w", err)
+	}
+
+	tables := make([]string, 0, len(all))
+	for _, table := range all {
+		if table == "schema_migrations" || strings.HasPrefix(table, "sqlite_") {
+			continue
+		}
+		if !safeTableNameRegex.MatchString(table) {
+			return nil, fmt.Errorf("refusing to copy table with unexpected name %q", table)
+		}
+		tables = append(tables, table)
+	}
+	return tables, nil
+}
+
+func copyTableInternal(ctx context.Context, src *DB, tx *gorm.DB, table string, batchSize int) (TableCopyResult, error) {
+	result := TableCopyResult{Table: table}
+
+	if err := src.WithContext(ctx).Table(table).Count(&result.SourceRows).Error; err != nil {
+		return result, fmt.Errorf("failed to count source table %s: %w", table, err)
+	}
+	if result.SourceRows == 0 {
+		return result, nil
+	}
+
+	columnTypes, err := tx.Migrator().ColumnTypes(table)
+	if err != nil {
+		return result, fmt.Errorf("failed to read destination columns for %s: %w", table, err)
+	}
+	boolColumns := make(map[string]bool, len(columnTypes))
+	orderBy := make([]string, 0, 1)
+	for _, ct := range columnTypes {
+		typeName := strings.ToLower(ct.DatabaseTypeName())
+		if typeName == "bool" || typeName == "boolean" {
+			boolColumns[ct.Name()] = true
+		}
+		if pk, ok := ct.PrimaryKey(); ok && pk {
+			orderBy = append(orderBy, quoteIdentInternal(ct.Name()))
+		}
+	}
+
+	for offset := 0; int64(offset) < result.SourceRows; offset += batchSize {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		query := src.WithContext(ctx).Table(table).Limit(batchSize).Offset(offset)
+		if len(orderBy) > 0 {
+			query = query.Order(strings.Join(orderBy, ", "))
+		}
+
+		var rows []map[string]any
+		if err := query.Find(&rows).Error; err != nil {
+			return result, fmt.Errorf("failed to read %s: %w", table, err)
+		}
+		if len(rows) == 0 {
+			break
+		}
+
+		for _, row := range rows {
+			for column, value := range row {
+				row[column] = convertCopyValueInternal(value, boolColumns[column])
+			}
+		}
+
+		if err := tx.Table(table).Create(&rows).Error; err != nil {
+			return result, fmt.Errorf("failed to write %s: %w", table, err)
+		}
+		result.CopiedRows += int64(len(rows))
+	}
+
+	slog.Debug("Copied table", "table", table, "rows", result.CopiedRows)
+	return result, nil
+}
+
+// convertCopyValueInternal adapts SQLite's loosely typed values to what a
+// strictly typed destination expects.
+func convertCopyValueInternal(value any, isBool bool) any {
+	if bytes, ok := value.([]byte); ok && !isBool {
+		return string(bytes)
+	}
+	if !isBool {
+		return value
+	}
+
+	switch v := value.(type) {
+	case int64:
+		return v != 0
+	case int:
+		return v != 0
+	case []byte:
+		parsed, err := strconv.ParseBool(string(v))
+		if err != nil {
+			return value
+		}
+		return parsed
+	case string:
+		parsed, err := strconv.ParseBool(v)
+		if err != nil {
+			return value
+		}
+		return parsed
+	default:
+		return value
+	}
+}
+
+func disableForeignKeysInternal(tx *gorm.DB) error {
+	switch tx.Dialector.Name() {
+	case "postgres":
+		// Tables reference each other (environments <-> api_keys), so no
+		// insertion order satisfies every constraint. Replica mode skips FK
+		// triggers for this transaction only; it requires superuser or the
+		// SET privilege on session_replication_role.
+		if err := tx.Exec("SET LOCAL session_replication_role = 'replica'").Error; err != nil {
+			return fmt.Errorf("failed to defer foreign key checks (the destination user needs permission to set session_replication_role): %w", err)
+		}
+	default:
+		if err := tx.Exec("PRAGMA defer_foreign_keys = ON").Error; err != nil {
+			return fmt.Errorf("failed to defer foreign key checks: %w", err)
+		}
+	}
+	return nil
+}
+
+func resetPostgresSequencesInternal(tx *gorm.DB, table string) error {
+	var columns []string
+	err := tx.Raw(`SELECT column_name FROM information_schema.columns
+		WHERE table_schema = current_schema() AND table_name = ? AND column_default LIKE 'nextval(%'`, table).
+		Scan(&columns).Error
+	if err != nil {
+		return fmt.Errorf("failed to inspect sequences for %s: %w", table, err)
+	}
+
+	for _, column := range columns {
+		stmt := fmt.Sprintf(
+			"SELECT setval(pg_get_serial_sequence(?, ?), COALESCE((SELECT MAX(%s) FROM %s), 0) + 1, false)",
+			quoteIdentInternal(column), quoteIdentInternal(table),
+		)
+		if err := tx.Exec(stmt, table, column).Error; err != nil {
+			return fmt.Errorf("failed to reset sequence for %s.%s: %w", table, column, err)
+		}
+	}
+	return nil
+}
+
+func quoteIdentInternal(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+func containsFoldInternal(values []string, target string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, target) {
+			return true
+		}
+	}
+	return false
+}
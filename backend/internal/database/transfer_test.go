@@ -0,0 +1,76 @@
+package database
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProviderFromURL(t *testing.T) {
+	tests := []struct {
+		url      string
+		expected string
+		wantErr  bool
+	}{
+		{url: "file:data/arcane.db?_pragma=journal_mode(WAL)", expected: ProviderSQLite},
+		{url: "postgres://arcane:secret@db:5432/arcane", expected: ProviderPostgres},
+		{url: "postgresql://arcane:secret@db:5432/arcane?sslmode=require", expected: ProviderPostgres},
+		{url: "mysql://arcane:secret@db/arcane", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		provider, err := ProviderFromURL(tt.url)
+		if tt.wantErr {
+			require.Error(t, err)
+			assert.NotContains(t, err.Error(), "secret")
+			continue
+		}
+		require.NoError(t, err)
+		assert.Equal(t, tt.expected, provider)
+	}
+}
+
+func TestCopyData_SQLiteToSQLite(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	src, err := Initialize(ctx, "file:"+filepath.Join(dir, "src.db"), MigrationOptions{})
+	require.NoError(t, err)
+	dst, err := Initialize(ctx, "file:"+filepath.Join(dir, "dst.db"), MigrationOptions{})
+	require.NoError(t, err)
+
+	require.NoError(t, src.Exec("INSERT INTO settings (key, value) VALUES (?, ?), (?, ?)", "copyTestA", "1", "copyTestB", "two").Error)
+	require.NoError(t, src.Exec("INSERT INTO container_registries (id, url, username, token) VALUES (?, ?, ?, ?)", "reg-1", "ghcr.io", "user", "token").Error)
+
+	// The migrations seed default settings, so the fresh destination is not
+	// empty in the strict sense but must still be accepted.
+	var seeded int64
+	require.NoError(t, dst.Table("settings").Count(&seeded).Error)
+	require.Positive(t, seeded)
+
+	dry, err := CopyData(ctx, src, dst, CopyOptions{DryRun: true})
+	require.NoError(t, err)
+	assert.Zero(t, dry.TotalRows())
+
+	result, err := CopyData(ctx, src, dst, CopyOptions{BatchSize: 1})
+	require.NoError(t, err)
+	assert.Positive(t, result.TotalRows())
+
+	var value string
+	require.NoError(t, dst.Raw("SELECT value FROM settings WHERE key = ?", "copyTestB").Scan(&value).Error)
+	assert.Equal(t, "two", value)
+
+	var srcSettings, dstSettings int64
+	require.NoError(t, src.Table("settings").Count(&srcSettings).Error)
+	require.NoError(t, dst.Table("settings").Count(&dstSettings).Error)
+	assert.Equal(t, srcSettings, dstSettings)
+
+	_, err = CopyData(ctx, src, dst, CopyOptions{})
+	require.ErrorIs(t, err, ErrDestinationNotEmpty)
+
+	_, err = CopyData(ctx, src, dst, CopyOptions{Force: true})
+	require.NoError(t, err)
+}
@@ -0,0 +1,211 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/getarcaneapp/arcane/backend/internal/services"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/pagination"
+	"github.com/getarcaneapp/arcane/types/base"
+	containertypes "github.com/getarcaneapp/arcane/types/container"
+	"github.com/getarcaneapp/arcane/types/environment"
+	imagetypes "github.com/getarcaneapp/arcane/types/image"
+	"github.com/getarcaneapp/arcane/types/project"
+)
+
+// AggregateHandler provides all-environments list endpoints.
+type AggregateHandler struct {
+	aggregationService *services.AggregationService
+}
+
+// AggregatedPaginatedResponse is a merged list across environments, with the
+// outcome of each environment's query.
+type AggregatedPaginatedResponse[T any] struct {
+	Success      bool                            `json:"success"`
+	Data         []environment.AggregatedItem[T] `json:"data"`
+	Environments []environment.AggregateSource   `json:"environments"`
+	Pagination   base.PaginationResponse         `json:"pagination"`
+}
+
+// --- Huma Input/Output Wrappers ---
+
+type ListAllContainersInput struct {
+	Search          string `query:"search" doc:"Search query, forwarded to every environment"`
+	Sort            string `query:"sort" doc:"Column to sort by (environment or name)"`
+	Order           string `query:"order" default:"asc" doc:"Sort direction"`
+	Start           int    `query:"start" default:"0" doc:"Start index"`
+	Limit           int    `query:"limit" default:"20" doc:"Limit"`
+	EnvironmentIDs  string `query:"environmentIds" doc:"Comma-separated environment IDs to include (default: all enabled environments)"`
+	IncludeInternal bool   `query:"includeInternal" default:"false" doc:"Include internal containers"`
+	Updates         string `query:"updates" doc:"Filter by update status (has_update, up_to_date, error, unknown)"`
+}
+
+type ListAllContainersOutput struct {
+	Body AggregatedPaginatedResponse[containertypes.Summary]
+}
+
+type ListAllProjectsInput struct {
+	Search         string `query:"search" doc:"Search query, forwarded to every environment"`
+	Sort           string `query:"sort" doc:"Column to sort by (environment or name)"`
+	Order          string `query:"order" default:"asc" doc:"Sort direction"`
+	Start          int    `query:"start" default:"0" doc:"Start index"`
+	Limit          int    `query:"limit" default:"20" doc:"Limit"`
+	EnvironmentIDs string `query:"environmentIds" doc:"Comma-separated environment IDs to include (default: all enabled environments)"`
+	Status         string `query:"status" doc:"Filter by project status"`
+}
+
+type ListAllProjectsOutput struct {
+	Body AggregatedPaginatedResponse[project.Details]
+}
+
+type ListAllImagesInput struct {
+	Search         string `query:"search" doc:"Search query, forwarded to every environment"`
+	Sort           string `query:"sort" doc:"Column to sort by (environment or name)"`
+	Order          string `query:"order" default:"asc" doc:"Sort direction"`
+	Start          int    `query:"start" default:"0" doc:"Start index"`
+	Limit          int    `query:"limit" default:"20" doc:"Limit"`
+	EnvironmentIDs string `query:"environmentIds" doc:"Comma-separated environment IDs to include (default: all enabled environments)"`
+}
+
+type ListAllImagesOutput struct {
+	Body AggregatedPaginatedResponse[imagetypes.Summary]
+}
+
+// RegisterAggregate registers the all-environments list routes using Huma.
+func RegisterAggregate(api huma.API, aggregationService *services.AggregationService) {
+	h := &AggregateHandler{
+		aggregationService: aggregationService,
+	}
+
+	huma.Register(api, huma.Operation{
+		OperationID: "list-all-containers",
+		Method:      http.MethodGet,
+		Path:        "/aggregate/containers",
+		Summary:     "List containers across environments",
+		Description: "List the containers of every enabled environment as one merged, paginated list",
+		Tags:        []string{"Aggregate"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.ListAllContainers)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "list-all-projects",
+		Method:      http.MethodGet,
+		Path:        "/aggregate/projects",
+		Summary:     "List projects across environments",
+		Description: "List the projects of every enabled environment as one merged, paginated list",
+		Tags:        []string{"Aggregate"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.ListAllProjects)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "list-all-images",
+		Method:      http.MethodGet,
+		Path:        "/aggregate/images",
+		Summary:     "List images across environments",
+		Description: "List the images of every enabled environment as one merged, paginated list",
+		Tags:        []string{"Aggregate"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.ListAllImages)
+}
+
+// ListAllContainers returns containers from every environment.
+func (h *AggregateHandler) ListAllContainers(ctx context.Context, input *ListAllContainersInput) (*ListAllContainersOutput, error) {
+	if h.aggregationService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	query := buildAggregateQuery(input.Search, input.Sort, input.Order, input.Start, input.Limit, input.EnvironmentIDs)
+	if input.Updates != "" {
+		query.Params.Filters = map[string]string{"updates": input.Updates}
+	}
+
+	result, err := h.aggregationService.ListContainers(ctx, query, input.IncludeInternal)
+	if err != nil {
+		return nil, huma.Error500InternalServerError(err.Error())
+	}
+
+	return &ListAllContainersOutput{Body: toAggregatedResponse(result)}, nil
+}
+
+// ListAllProjects returns projects from every environment.
+func (h *AggregateHandler) ListAllProjects(ctx context.Context, input *ListAllProjectsInput) (*ListAllProjectsOutput, error) {
+	if h.aggregationService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	query := buildAggregateQuery(input.Search, input.Sort, input.Order, input.Start, input.Limit, input.EnvironmentIDs)
+	if input.Status != "" {
+		query.Params.Filters = map[string]string{"status": input.Status}
+	}
+
+	result, err := h.aggregationService.ListProjects(ctx, query)
+	if err != nil {
+		return nil, huma.Error500InternalServerError(err.Error())
+	}
+
+	return &ListAllProjectsOutput{Body: toAggregatedResponse(result)}, nil
+}
+
+// ListAllImages returns images from every environment.
+func (h *AggregateHandler) ListAllImages(ctx context.Context, input *ListAllImagesInput) (*ListAllImagesOutput, error) {
+	if h.aggregationService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	result, err := h.aggregationService.ListImages(ctx, buildAggregateQuery(input.Search, input.Sort, input.Order, input.Start, input.Limit, input.EnvironmentIDs))
+	if err != nil {
+		return nil, huma.Error500InternalServerError(err.Error())
+	}
+
+	return &ListAllImagesOutput{Body: toAggregatedResponse(result)}, nil
+}
+
+func buildAggregateQuery(search, sort, order string, start, limit int, environmentIDs string) services.AggregateQuery {
+	var envIDs []string
+	for id := range strings.SplitSeq(environmentIDs, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			envIDs = append(envIDs, id)
+		}
+	}
+
+	return services.AggregateQuery{
+		Params: pagination.QueryParams{
+			SearchQuery: pagination.SearchQuery{Search: search},
+			SortParams: pagination.SortParams{
+				Sort:  sort,
+				Order: pagination.SortOrder(order),
+			},
+			PaginationParams: pagination.PaginationParams{
+				Start: start,
+				Limit: limit,
+			},
+		},
+		EnvironmentIDs: envIDs,
+	}
+}
+
+func toAggregatedResponse[T any](result *services.AggregateResult[T]) AggregatedPaginatedResponse[T] {
+	return AggregatedPaginatedResponse[T]{
+		Success:      true,
+		Data:         result.Items,
+		Environments: result.Sources,
+		Pagination: base.PaginationResponse{
+			TotalPages:      result.Pagination.TotalPages,
+			TotalItems:      result.Pagination.TotalItems,
+			CurrentPage:     result.Pagination.CurrentPage,
+			ItemsPerPage:    result.Pagination.ItemsPerPage,
+			GrandTotalItems: result.Pagination.GrandTotalItems,
+		},
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/getarcaneapp/arcane/backend/internal/utils/pagination"
 	"github.com/getarcaneapp/arcane/types/apikey"
 	"github.com/getarcaneapp/arcane/types/base"
+	"github.com/getarcaneapp/arcane/types/rbac"
 )
 
 // ApiKeyHandler provides Huma-based API key management endpoints.
@@ -152,7 +153,7 @@ func (h *ApiKeyHandler) ListApiKeys(ctx context.Context, input *ListApiKeysInput
 	}
 
 	// Check admin access
-	if err := checkAdmin(ctx); err != nil {
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
 		return nil, err
 	}
 
@@ -202,12 +203,15 @@ func (h *ApiKeyHandler) CreateApiKey(ctx context.Context, input *CreateApiKeyInp
 	}
 
 	// Check admin access
-	if err := checkAdmin(ctx); err != nil {
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
 		return nil, err
 	}
 
 	apiKey, err := h.apiKeyService.CreateApiKey(ctx, user.ID, input.Body)
 	if err != nil {
+		if errors.Is(err, services.ErrApiKeyInvalidScope) {
+			return nil, huma.Error400BadRequest(err.Error())
+		}
 		return nil, huma.Error500InternalServerError((&common.ApiKeyCreationError{Err: err}).Error())
 	}
 
@@ -226,7 +230,7 @@ func (h *ApiKeyHandler) GetApiKey(ctx context.Context, input *GetApiKeyInput) (*
 	}
 
 	// Check admin access
-	if err := checkAdmin(ctx); err != nil {
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
 		return nil, err
 	}
 
@@ -250,7 +254,7 @@ func (h *ApiKeyHandler) UpdateApiKey(ctx context.Context, input *UpdateApiKeyInp
 	}
 
 	// Check admin access
-	if err := checkAdmin(ctx); err != nil {
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
 		return nil, err
 	}
 
@@ -259,6 +263,9 @@ func (h *ApiKeyHandler) UpdateApiKey(ctx context.Context, input *UpdateApiKeyInp
 		if errors.Is(err, services.ErrApiKeyNotFound) {
 			return nil, huma.Error404NotFound((&common.ApiKeyNotFoundError{}).Error())
 		}
+		if errors.Is(err, services.ErrApiKeyInvalidScope) {
+			return nil, huma.Error400BadRequest(err.Error())
+		}
 		return nil, huma.Error500InternalServerError((&common.ApiKeyUpdateError{Err: err}).Error())
 	}
 
@@ -277,7 +284,7 @@ func (h *ApiKeyHandler) DeleteApiKey(ctx context.Context, input *DeleteApiKeyInp
 	}
 
 	// Check admin access
-	if err := checkAdmin(ctx); err != nil {
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,134 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/getarcaneapp/arcane/backend/internal/common"
+	"github.com/getarcaneapp/arcane/backend/internal/services"
+	"github.com/getarcaneapp/arcane/types/backup"
+	"github.com/getarcaneapp/arcane/types/base"
+	"github.com/getarcaneapp/arcane/types/rbac"
+)
+
+// BackupHandler provides Huma-based configuration backup endpoints.
+type BackupHandler struct {
+	backupService *services.BackupService
+}
+
+// --- Huma Input/Output Wrappers ---
+
+type ExportBackupInput struct {
+	Body backup.ExportRequest
+}
+
+type ExportBackupOutput struct {
+	ContentType        string `header:"Content-Type"`
+	ContentDisposition string `header:"Content-Disposition"`
+	BackupCreatedAt    string `header:"X-Arcane-Backup-Created-At" doc:"When the archive was produced (RFC 3339)"`
+	BackupEnvironments string `header:"X-Arcane-Backup-Environments" doc:"Number of environments in the archive"`
+	Body               []byte
+}
+
+type ImportBackupInput struct {
+	Body backup.ImportRequest
+}
+
+type ImportBackupOutput struct {
+	Body base.ApiResponse[backup.ImportResult]
+}
+
+// RegisterBackup registers configuration backup routes using Huma.
+func RegisterBackup(api huma.API, backupService *services.BackupService) {
+	h := &BackupHandler{
+		backupService: backupService,
+	}
+
+	huma.Register(api, huma.Operation{
+		OperationID: "export-config-backup",
+		Method:      http.MethodPost,
+		Path:        "/system/backup/export",
+		Summary:     "Export configuration backup",
+		Description: "Export settings, notification providers, environments, registries and project metadata as a passphrase-encrypted archive",
+		Tags:        []string{"System"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.ExportBackup)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "import-config-backup",
+		Method:      http.MethodPost,
+		Path:        "/system/backup/import",
+		Summary:     "Import configuration backup",
+		Description: "Restore a configuration archive produced by the export endpoint",
+		Tags:        []string{"System"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.ImportBackup)
+}
+
+// ExportBackup returns an encrypted archive of the current configuration.
+func (h *BackupHandler) ExportBackup(ctx context.Context, input *ExportBackupInput) (*ExportBackupOutput, error) {
+	if h.backupService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	archive, manifest, err := h.backupService.Export(ctx, input.Body.Passphrase)
+	if err != nil {
+		if errors.Is(err, services.ErrBackupPassphraseRequired) {
+			return nil, huma.Error400BadRequest(err.Error())
+		}
+		return nil, huma.Error500InternalServerError((&common.BackupExportError{Err: err}).Error())
+	}
+
+	filename := "arcane-config-" + manifest.CreatedAt.Format("20060102T150405Z") + ".arcbak"
+
+	return &ExportBackupOutput{
+		ContentType:        "application/octet-stream",
+		ContentDisposition: "attachment; filename=" + filename,
+		BackupCreatedAt:    manifest.CreatedAt.Format(time.RFC3339),
+		BackupEnvironments: strconv.Itoa(manifest.Environments),
+		Body:               archive,
+	}, nil
+}
+
+// ImportBackup restores a configuration archive.
+func (h *BackupHandler) ImportBackup(ctx context.Context, input *ImportBackupInput) (*ImportBackupOutput, error) {
+	if h.backupService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	result, err := h.backupService.Import(ctx, input.Body.Archive, input.Body.Passphrase)
+	if err != nil {
+		apiErr := (&common.BackupImportError{Err: err}).Error()
+		if errors.Is(err, services.ErrBackupPassphraseRequired) ||
+			errors.Is(err, services.ErrBackupInvalidArchive) ||
+			errors.Is(err, services.ErrBackupDecryptFailed) {
+			return nil, huma.Error400BadRequest(apiErr)
+		}
+		return nil, huma.Error500InternalServerError(apiErr)
+	}
+
+	return &ImportBackupOutput{
+		Body: base.ApiResponse[backup.ImportResult]{
+			Success: true,
+			Data:    *result,
+		},
+	}, nil
+}
@@ -0,0 +1,161 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/getarcaneapp/arcane/backend/internal/common"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/services"
+	"github.com/getarcaneapp/arcane/types/base"
+	"github.com/getarcaneapp/arcane/types/cacertificate"
+	"github.com/getarcaneapp/arcane/types/rbac"
+)
+
+// CACertificatesHandler handles custom CA certificate endpoints.
+type CACertificatesHandler struct {
+	caCertificateService *services.CACertificateService
+}
+
+// --- Huma Input/Output Wrappers ---
+
+type ListCACertificatesInput struct{}
+
+type ListCACertificatesOutput struct {
+	Body base.ApiResponse[[]cacertificate.CACertificate]
+}
+
+type CreateCACertificateInput struct {
+	Body cacertificate.CreateRequest
+}
+
+type CreateCACertificateOutput struct {
+	Body base.ApiResponse[cacertificate.CACertificate]
+}
+
+type DeleteCACertificateInput struct {
+	ID string `path:"id" doc:"CA certificate ID"`
+}
+
+type DeleteCACertificateOutput struct {
+	Body base.ApiResponse[base.MessageResponse]
+}
+
+// RegisterCACertificates registers custom CA certificate routes using Huma.
+func RegisterCACertificates(api huma.API, caCertificateService *services.CACertificateService) {
+	h := &CACertificatesHandler{
+		caCertificateService: caCertificateService,
+	}
+
+	huma.Register(api, huma.Operation{
+		OperationID: "list-ca-certificates",
+		Method:      http.MethodGet,
+		Path:        "/ca-certificates",
+		Summary:     "List CA certificates",
+		Description: "List custom CA certificates trusted for outbound TLS connections",
+		Tags:        []string{"CA Certificates"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.ListCACertificates)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "create-ca-certificate",
+		Method:      http.MethodPost,
+		Path:        "/ca-certificates",
+		Summary:     "Upload a CA certificate",
+		Description: "Trust a PEM encoded CA certificate for registry, notification, SMTP and agent connections",
+		Tags:        []string{"CA Certificates"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.CreateCACertificate)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "delete-ca-certificate",
+		Method:      http.MethodDelete,
+		Path:        "/ca-certificates/{id}",
+		Summary:     "Delete a CA certificate",
+		Description: "Stop trusting a custom CA certificate",
+		Tags:        []string{"CA Certificates"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.DeleteCACertificate)
+}
+
+// ListCACertificates returns all custom CA certificates.
+func (h *CACertificatesHandler) ListCACertificates(ctx context.Context, _ *ListCACertificatesInput) (*ListCACertificatesOutput, error) {
+	if h.caCertificateService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	certs, err := h.caCertificateService.ListCertificates(ctx)
+	if err != nil {
+		return nil, huma.Error500InternalServerError((&common.CACertificateListError{Err: err}).Error())
+	}
+
+	return &ListCACertificatesOutput{
+		Body: base.ApiResponse[[]cacertificate.CACertificate]{
+			Success: true,
+			Data:    certs,
+		},
+	}, nil
+}
+
+// CreateCACertificate stores a custom CA certificate and adds it to the trust store.
+func (h *CACertificatesHandler) CreateCACertificate(ctx context.Context, input *CreateCACertificateInput) (*CreateCACertificateOutput, error) {
+	if h.caCertificateService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	created, err := h.caCertificateService.CreateCertificate(ctx, input.Body)
+	if err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.CACertificateCreationError{Err: err}).Error())
+	}
+
+	return &CreateCACertificateOutput{
+		Body: base.ApiResponse[cacertificate.CACertificate]{
+			Success: true,
+			Data:    *created,
+		},
+	}, nil
+}
+
+// DeleteCACertificate removes a custom CA certificate from the trust store.
+func (h *CACertificatesHandler) DeleteCACertificate(ctx context.Context, input *DeleteCACertificateInput) (*DeleteCACertificateOutput, error) {
+	if h.caCertificateService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if err := h.caCertificateService.DeleteCertificate(ctx, input.ID); err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.CACertificateDeletionError{Err: err}).Error())
+	}
+
+	return &DeleteCACertificateOutput{
+		Body: base.ApiResponse[base.MessageResponse]{
+			Success: true,
+			Data: base.MessageResponse{
+				Message: "CA certificate deleted successfully",
+			},
+		},
+	}, nil
+}
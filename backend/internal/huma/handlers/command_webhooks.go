@@ -0,0 +1,269 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/getarcaneapp/arcane/backend/internal/common"
+	humamw "github.com/getarcaneapp/arcane/backend/internal/huma/middleware"
+	"github.com/getarcaneapp/arcane/backend/internal/services"
+	"github.com/getarcaneapp/arcane/types/base"
+	"github.com/getarcaneapp/arcane/types/commandwebhook"
+	"github.com/getarcaneapp/arcane/types/rbac"
+)
+
+// CommandWebhookHandler provides Huma-based endpoints for inbound webhooks
+// that run actions, such as chat slash commands.
+type CommandWebhookHandler struct {
+	commandWebhookService *services.CommandWebhookService
+}
+
+// --- Huma Input/Output Wrappers ---
+
+type ListCommandWebhooksInput struct{}
+
+type ListCommandWebhooksOutput struct {
+	Body base.ApiResponse[[]commandwebhook.Webhook]
+}
+
+type CreateCommandWebhookInput struct {
+	Body commandwebhook.CreateWebhook
+}
+
+type CreateCommandWebhookOutput struct {
+	Body base.ApiResponse[commandwebhook.WebhookCreated]
+}
+
+type UpdateCommandWebhookInput struct {
+	WebhookID string `path:"webhookId" doc:"Webhook ID"`
+	Body      commandwebhook.UpdateWebhook
+}
+
+type UpdateCommandWebhookOutput struct {
+	Body base.ApiResponse[commandwebhook.Webhook]
+}
+
+type DeleteCommandWebhookInput struct {
+	WebhookID string `path:"webhookId" doc:"Webhook ID"`
+}
+
+type DeleteCommandWebhookOutput struct {
+	Body base.ApiResponse[base.MessageResponse]
+}
+
+type TriggerCommandWebhookInput struct {
+	Token       string `path:"token" doc:"Webhook token"`
+	ContentType string `header:"Content-Type"`
+	RawBody     []byte
+}
+
+type TriggerCommandWebhookOutput struct {
+	Body commandwebhook.TriggerResult
+}
+
+// RegisterCommandWebhooks registers command webhook routes using Huma.
+func RegisterCommandWebhooks(api huma.API, commandWebhookService *services.CommandWebhookService) {
+	h := &CommandWebhookHandler{
+		commandWebhookService: commandWebhookService,
+	}
+
+	huma.Register(api, huma.Operation{
+		OperationID: "list-command-webhooks",
+		Method:      http.MethodGet,
+		Path:        "/command-webhooks",
+		Summary:     "List command webhooks",
+		Description: "List inbound webhooks with the actions they may run",
+		Tags:        []string{"Command Webhooks"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.ListWebhooks)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "create-command-webhook",
+		Method:      http.MethodPost,
+		Path:        "/command-webhooks",
+		Summary:     "Create a command webhook",
+		Description: "Create an inbound webhook; its token is only returned in this response",
+		Tags:        []string{"Command Webhooks"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.CreateWebhook)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "update-command-webhook",
+		Method:      http.MethodPut,
+		Path:        "/command-webhooks/{webhookId}",
+		Summary:     "Update a command webhook",
+		Description: "Rename a webhook, change its allowed actions or disable it",
+		Tags:        []string{"Command Webhooks"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.UpdateWebhook)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "delete-command-webhook",
+		Method:      http.MethodDelete,
+		Path:        "/command-webhooks/{webhookId}",
+		Summary:     "Delete a command webhook",
+		Description: "Delete a webhook; requests with its token are rejected afterwards",
+		Tags:        []string{"Command Webhooks"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.DeleteWebhook)
+
+	// The token in the path authenticates the request, since chat platforms
+	// cannot send custom headers with slash commands.
+	huma.Register(api, huma.Operation{
+		OperationID: "trigger-command-webhook",
+		Method:      http.MethodPost,
+		Path:        "/hooks/commands/{token}",
+		Summary:     "Run a webhook command",
+		Description: "Start an action allowed for the webhook. Accepts a JSON body with action and target, or a Slack slash command form whose text is \"<action> [target]\"",
+		Tags:        []string{"Command Webhooks"},
+	}, h.TriggerWebhook)
+}
+
+// ListWebhooks returns all command webhooks.
+func (h *CommandWebhookHandler) ListWebhooks(ctx context.Context, input *ListCommandWebhooksInput) (*ListCommandWebhooksOutput, error) {
+	if h.commandWebhookService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	hooks, err := h.commandWebhookService.ListWebhooks(ctx)
+	if err != nil {
+		return nil, huma.Error500InternalServerError(err.Error())
+	}
+
+	return &ListCommandWebhooksOutput{
+		Body: base.ApiResponse[[]commandwebhook.Webhook]{
+			Success: true,
+			Data:    hooks,
+		},
+	}, nil
+}
+
+// CreateWebhook creates a command webhook. Its actions run as the creating
+// user, so only admins can manage webhooks.
+func (h *CommandWebhookHandler) CreateWebhook(ctx context.Context, input *CreateCommandWebhookInput) (*CreateCommandWebhookOutput, error) {
+	if h.commandWebhookService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	user, exists := humamw.GetCurrentUserFromContext(ctx)
+	if !exists {
+		return nil, huma.Error401Unauthorized((&common.NotAuthenticatedError{}).Error())
+	}
+
+	hook, err := h.commandWebhookService.CreateWebhook(ctx, user.ID, input.Body)
+	if err != nil {
+		return nil, commandWebhookError(err)
+	}
+
+	return &CreateCommandWebhookOutput{
+		Body: base.ApiResponse[commandwebhook.WebhookCreated]{
+			Success: true,
+			Data:    *hook,
+		},
+	}, nil
+}
+
+// UpdateWebhook updates an existing command webhook.
+func (h *CommandWebhookHandler) UpdateWebhook(ctx context.Context, input *UpdateCommandWebhookInput) (*UpdateCommandWebhookOutput, error) {
+	if h.commandWebhookService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	hook, err := h.commandWebhookService.UpdateWebhook(ctx, input.WebhookID, input.Body)
+	if err != nil {
+		return nil, commandWebhookError(err)
+	}
+
+	return &UpdateCommandWebhookOutput{
+		Body: base.ApiResponse[commandwebhook.Webhook]{
+			Success: true,
+			Data:    *hook,
+		},
+	}, nil
+}
+
+// DeleteWebhook removes a command webhook.
+func (h *CommandWebhookHandler) DeleteWebhook(ctx context.Context, input *DeleteCommandWebhookInput) (*DeleteCommandWebhookOutput, error) {
+	if h.commandWebhookService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if err := h.commandWebhookService.DeleteWebhook(ctx, input.WebhookID); err != nil {
+		return nil, commandWebhookError(err)
+	}
+
+	return &DeleteCommandWebhookOutput{
+		Body: base.ApiResponse[base.MessageResponse]{
+			Success: true,
+			Data: base.MessageResponse{
+				Message: "Command webhook deleted successfully",
+			},
+		},
+	}, nil
+}
+
+// TriggerWebhook starts the command sent to a webhook.
+func (h *CommandWebhookHandler) TriggerWebhook(ctx context.Context, input *TriggerCommandWebhookInput) (*TriggerCommandWebhookOutput, error) {
+	if h.commandWebhookService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	cmd, err := services.ParseCommandWebhookRequest(input.ContentType, input.RawBody)
+	if err != nil {
+		return nil, commandWebhookError(err)
+	}
+
+	result, err := h.commandWebhookService.Trigger(ctx, input.Token, cmd)
+	if err != nil {
+		return nil, commandWebhookError(err)
+	}
+
+	return &TriggerCommandWebhookOutput{Body: *result}, nil
+}
+
+func commandWebhookError(err error) error {
+	switch {
+	case errors.Is(err, services.ErrCommandWebhookNotFound), errors.Is(err, services.ErrCommandWebhookTargetNotFound):
+		return huma.Error404NotFound(err.Error())
+	case errors.Is(err, services.ErrCommandWebhookInvalid), errors.Is(err, services.ErrCommandWebhookInvalidCommand):
+		return huma.Error400BadRequest(err.Error())
+	case errors.Is(err, services.ErrCommandWebhookUnauthorized):
+		return huma.Error401Unauthorized(err.Error())
+	case errors.Is(err, services.ErrCommandWebhookForbidden):
+		return huma.Error403Forbidden(err.Error())
+	case errors.Is(err, services.ErrCommandWebhookBusy):
+		return huma.Error409Conflict(err.Error())
+	default:
+		return huma.Error500InternalServerError(err.Error())
+	}
+}
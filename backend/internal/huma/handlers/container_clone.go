@@ -0,0 +1,132 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/getarcaneapp/arcane/backend/internal/common"
+	humamw "github.com/getarcaneapp/arcane/backend/internal/huma/middleware"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/services"
+	"github.com/getarcaneapp/arcane/types/base"
+	containertypes "github.com/getarcaneapp/arcane/types/container"
+	"github.com/getarcaneapp/arcane/types/rbac"
+)
+
+// ContainerCloneHandler provides the endpoints that recreate containers on
+// other environments.
+type ContainerCloneHandler struct {
+	containerCloneService *services.ContainerCloneService
+}
+
+// --- Huma Input/Output Wrappers ---
+
+type CloneContainerInput struct {
+	Body containertypes.CloneRequest
+}
+
+type CloneContainerOutput struct {
+	Body base.ApiResponse[containertypes.ClonedContainer]
+}
+
+type ImportContainerInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	Body          containertypes.ImportRequest
+}
+
+type ImportContainerOutput struct {
+	Body base.ApiResponse[containertypes.ImportedContainer]
+}
+
+// RegisterContainerClone registers the container clone routes using Huma.
+func RegisterContainerClone(api huma.API, containerCloneService *services.ContainerCloneService) {
+	h := &ContainerCloneHandler{
+		containerCloneService: containerCloneService,
+	}
+
+	huma.Register(api, huma.Operation{
+		OperationID: "clone-container-to-environment",
+		Method:      http.MethodPost,
+		Path:        "/containers/clone",
+		Summary:     "Clone a container to another environment",
+		Description: "Recreate a standalone container of the local or an SSH environment on another environment, pulling or copying its image and optionally copying its named volumes",
+		Tags:        []string{"Containers"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.CloneContainer)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "import-container",
+		Method:      http.MethodPost,
+		Path:        "/environments/{id}/containers/import",
+		Summary:     "Import a container",
+		Description: "Create a container from the configuration of a container on another host, creating missing networks and pulling the image when needed",
+		Tags:        []string{"Containers"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.ImportContainer)
+}
+
+// CloneContainer recreates a container on another environment.
+func (h *ContainerCloneHandler) CloneContainer(ctx context.Context, input *CloneContainerInput) (*CloneContainerOutput, error) {
+	if h.containerCloneService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	user, exists := humamw.GetCurrentUserFromContext(ctx)
+	if !exists {
+		return nil, huma.Error401Unauthorized((&common.NotAuthenticatedError{}).Error())
+	}
+
+	result, err := h.containerCloneService.CloneContainer(ctx, input.Body, *user)
+	if err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.ContainerCloneError{Err: err}).Error())
+	}
+
+	return &CloneContainerOutput{
+		Body: base.ApiResponse[containertypes.ClonedContainer]{
+			Success: true,
+			Data:    *result,
+		},
+	}, nil
+}
+
+// ImportContainer creates a container from a configuration read on another
+// host.
+func (h *ContainerCloneHandler) ImportContainer(ctx context.Context, input *ImportContainerInput) (*ImportContainerOutput, error) {
+	if h.containerCloneService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	user, exists := humamw.GetCurrentUserFromContext(ctx)
+	if !exists {
+		return nil, huma.Error401Unauthorized((&common.NotAuthenticatedError{}).Error())
+	}
+
+	result, err := h.containerCloneService.ImportContainer(ctx, input.Body, *user)
+	if err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.ContainerImportError{Err: err}).Error())
+	}
+
+	return &ImportContainerOutput{
+		Body: base.ApiResponse[containertypes.ImportedContainer]{
+			Success: true,
+			Data:    *result,
+		},
+	}, nil
+}
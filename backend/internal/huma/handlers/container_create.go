@@ -0,0 +1,430 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net/netip"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/converter"
+	"github.com/getarcaneapp/arcane/types/base"
+	containertypes "github.com/getarcaneapp/arcane/types/container"
+	dockercontainer "github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/api/types/network"
+	ref "go.podman.io/image/v5/docker/reference"
+)
+
+// minContainerMemory is the smallest memory limit the Docker daemon accepts.
+const minContainerMemory = 6 * 1024 * 1024
+
+var (
+	containerNamePattern = regexp.MustCompile(`^/?[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+	envKeyPattern        = regexp.MustCompile(`^[^=\s]+$`)
+)
+
+// sensitiveHostPaths are bind sources that hand a container control over the
+// host when mounted.
+var sensitiveHostPaths = []string{"/", "/etc", "/proc", "/sys", "/dev", "/boot", "/root", "/var/run/docker.sock", "/run/docker.sock"}
+
+type PreviewContainerInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	Body          containertypes.Create
+}
+
+type PreviewContainerOutput struct {
+	Body base.ApiResponse[containertypes.CreatePreview]
+}
+
+// PreviewContainer validates a create request and renders its docker run
+// equivalent without creating anything.
+func (h *ContainerHandler) PreviewContainer(ctx context.Context, input *PreviewContainerInput) (*PreviewContainerOutput, error) {
+	errs, warnings := validateCreateContainerInternal(input.Body)
+	preview := containertypes.CreatePreview{
+		Valid:    len(errs) == 0,
+		Errors:   errs,
+		Warnings: warnings,
+	}
+
+	if preview.Valid {
+		config, hostConfig, networkingConfig, err := buildCreateContainerConfigInternal(input.Body)
+		if err != nil {
+			preview.Valid = false
+			preview.Errors = append(preview.Errors, containertypes.CreateIssue{Field: "body", Message: err.Error()})
+		} else {
+			preview.DockerRun = converter.BuildRunCommand(converter.FromCreateConfig(input.Body.Name, config, hostConfig, networkingConfig))
+		}
+	}
+
+	return &PreviewContainerOutput{
+		Body: base.ApiResponse[containertypes.CreatePreview]{
+			Success: true,
+			Data:    preview,
+		},
+	}, nil
+}
+
+// buildCreateContainerConfigInternal turns a create request into the Docker
+// container, host and networking configs.
+func buildCreateContainerConfigInternal(body containertypes.Create) (*dockercontainer.Config, *dockercontainer.HostConfig, *network.NetworkingConfig, error) {
+	config := buildContainerConfig(body)
+	portBindings := network.PortMap{}
+	if err := applyLegacyPortBindings(body, config, portBindings); err != nil {
+		return nil, nil, nil, err
+	}
+	if err := applyExposedPorts(body.ExposedPorts, config); err != nil {
+		return nil, nil, nil, err
+	}
+
+	hostConfig := buildHostConfigBase(body, portBindings)
+	if err := applyHostConfigOverrides(body, config, hostConfig, portBindings); err != nil {
+		return nil, nil, nil, err
+	}
+	applyLegacyResourceLimits(body, hostConfig)
+
+	if body.Healthcheck != nil {
+		healthcheck, err := buildHealthConfigInternal(body.Healthcheck)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		config.Healthcheck = healthcheck
+	}
+
+	return config, hostConfig, buildNetworkingConfig(body), nil
+}
+
+func buildHealthConfigInternal(input *containertypes.HealthcheckCreate) (*dockercontainer.HealthConfig, error) {
+	healthcheck := &dockercontainer.HealthConfig{
+		Test:    input.Test,
+		Retries: input.Retries,
+	}
+	for _, d := range []struct {
+		value  string
+		target *time.Duration
+	}{
+		{input.Interval, &healthcheck.Interval},
+		{input.Timeout, &healthcheck.Timeout},
+		{input.StartPeriod, &healthcheck.StartPeriod},
+	} {
+		if d.value == "" {
+			continue
+		}
+		parsed, err := time.ParseDuration(d.value)
+		if err != nil {
+			return nil, err
+		}
+		*d.target = parsed
+	}
+	return healthcheck, nil
+}
+
+// validateCreateContainerInternal checks a create request before it reaches
+// Docker, so mistakes are reported per field instead of as one daemon error.
+// Warnings flag settings that are valid but usually unintended or unsafe.
+func validateCreateContainerInternal(body containertypes.Create) (errs, warnings []containertypes.CreateIssue) {
+	fail := func(field, format string, args ...any) {
+		errs = append(errs, containertypes.CreateIssue{Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+	warn := func(field, format string, args ...any) {
+		warnings = append(warnings, containertypes.CreateIssue{Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if body.Name != "" && !containerNamePattern.MatchString(body.Name) {
+		fail("name", "name %q may only contain letters, digits, '_', '.' and '-' and must start with a letter or digit", body.Name)
+	}
+
+	if strings.TrimSpace(body.Image) == "" {
+		fail("image", "image is required")
+	} else if _, err := ref.ParseNormalizedNamed(body.Image); err != nil {
+		fail("image", "invalid image reference %q: %v", body.Image, err)
+	}
+
+	envField := "environment"
+	if len(body.Environment) == 0 {
+		envField = "env"
+	}
+	for i, entry := range resolveCreateEnv(body) {
+		key, _, _ := strings.Cut(entry, "=")
+		if !envKeyPattern.MatchString(key) {
+			fail(fmt.Sprintf("%s[%d]", envField, i), "invalid environment variable %q", entry)
+		}
+	}
+
+	for key := range body.Labels {
+		if strings.TrimSpace(key) == "" {
+			fail("labels", "label keys cannot be empty")
+		}
+	}
+
+	validateCreatePortsInternal(body, fail, warn)
+	validateCreateBindsInternal(body, fail, warn)
+	validateCreateHostSettingsInternal(body, fail, warn)
+	if body.Healthcheck != nil {
+		validateCreateHealthcheckInternal(body.Healthcheck, fail)
+	}
+
+	return errs, warnings
+}
+
+type createIssueFunc func(field, format string, args ...any)
+
+func validateCreatePortsInternal(body containertypes.Create, fail, warn createIssueFunc) {
+	hostPorts := map[string]string{}
+	claimHostPort := func(field, hostIP, hostPort, proto string) {
+		if hostPort == "" {
+			return
+		}
+		if err := validateHostPortInternal(hostPort); err != nil {
+			fail(field, "invalid host port %q: %v", hostPort, err)
+			return
+		}
+		key := hostIP + ":" + hostPort + "/" + proto
+		if other, dup := hostPorts[key]; dup {
+			fail(field, "host port %s/%s is already bound by %s", hostPort, proto, other)
+			return
+		}
+		hostPorts[key] = field
+	}
+
+	for _, containerPort := range slices.Sorted(maps.Keys(body.Ports)) {
+		field := "ports." + containerPort
+		if _, err := network.ParsePort(containerPort + "/tcp"); err != nil {
+			fail(field, "invalid container port %q: %v", containerPort, err)
+			continue
+		}
+		claimHostPort(field, "", body.Ports[containerPort], "tcp")
+	}
+
+	for _, spec := range slices.Sorted(maps.Keys(body.ExposedPorts)) {
+		if _, err := parsePortSpec(spec); err != nil {
+			fail("exposedPorts."+spec, "invalid port %q: %v", spec, err)
+		}
+	}
+
+	if body.HostConfig == nil {
+		return
+	}
+	for _, spec := range slices.Sorted(maps.Keys(body.HostConfig.PortBindings)) {
+		field := "hostConfig.portBindings." + spec
+		if _, err := parsePortSpec(spec); err != nil {
+			fail(field, "invalid container port %q: %v", spec, err)
+			continue
+		}
+		_, proto, found := strings.Cut(spec, "/")
+		if !found {
+			proto = "tcp"
+		}
+		for _, binding := range body.HostConfig.PortBindings[spec] {
+			hostIP := strings.TrimSpace(binding.HostIP)
+			if hostIP != "" {
+				if _, err := netip.ParseAddr(hostIP); err != nil {
+					fail(field, "invalid host IP %q", binding.HostIP)
+					continue
+				}
+			}
+			claimHostPort(field, hostIP, binding.HostPort, proto)
+		}
+	}
+
+	if dockercontainer.NetworkMode(body.HostConfig.NetworkMode).IsHost() && (len(body.HostConfig.PortBindings) > 0 || len(body.Ports) > 0) {
+		warn("hostConfig.portBindings", "port bindings are ignored in host network mode")
+	}
+}
+
+func validateHostPortInternal(hostPort string) error {
+	start, end, isRange := strings.Cut(hostPort, "-")
+	for _, p := range []string{start, end} {
+		if p == "" && !isRange {
+			continue
+		}
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			return errors.New("not a number")
+		}
+		if n < 1 || n > 65535 {
+			return errors.New("must be between 1 and 65535")
+		}
+	}
+	if isRange {
+		s, _ := strconv.Atoi(start)
+		e, _ := strconv.Atoi(end)
+		if e < s {
+			return errors.New("range end is before its start")
+		}
+	}
+	return nil
+}
+
+func validateCreateBindsInternal(body containertypes.Create, fail, warn createIssueFunc) {
+	field := "volumes"
+	binds := body.Volumes
+	if body.HostConfig != nil && len(body.HostConfig.Binds) > 0 {
+		field, binds = "hostConfig.binds", body.HostConfig.Binds
+	}
+
+	destinations := map[string]struct{}{}
+	for i, bind := range binds {
+		itemField := fmt.Sprintf("%s[%d]", field, i)
+		parts := strings.Split(bind, ":")
+		if len(parts) < 2 || len(parts) > 3 {
+			fail(itemField, "volume %q must be source:destination[:options]", bind)
+			continue
+		}
+		source, destination := parts[0], parts[1]
+		if source == "" {
+			fail(itemField, "volume %q has no source", bind)
+			continue
+		}
+		if strings.HasPrefix(source, ".") || strings.HasPrefix(source, "~") {
+			fail(itemField, "bind source %q must be an absolute path", source)
+		}
+		if !path.IsAbs(destination) {
+			fail(itemField, "mount destination %q must be an absolute path", destination)
+		} else if _, dup := destinations[path.Clean(destination)]; dup {
+			fail(itemField, "mount destination %q is used more than once", destination)
+		} else {
+			destinations[path.Clean(destination)] = struct{}{}
+		}
+		if len(parts) == 3 {
+			for opt := range strings.SplitSeq(parts[2], ",") {
+				if !isValidBindOptionInternal(opt) {
+					fail(itemField, "unknown volume option %q", opt)
+				}
+			}
+		}
+		if strings.HasPrefix(source, "/") && slices.Contains(sensitiveHostPaths, path.Clean(source)) {
+			warn(itemField, "mounting %s gives the container access to the host", path.Clean(source))
+		}
+	}
+}
+
+func isValidBindOptionInternal(opt string) bool {
+	switch opt {
+	case "ro", "rw", "z", "Z", "nocopy", "consistent", "cached", "delegated",
+		"private", "rprivate", "shared", "rshared", "slave", "rslave":
+		return true
+	}
+	return false
+}
+
+func validateCreateHostSettingsInternal(body containertypes.Create, fail, warn createIssueFunc) {
+	restart := dockercontainer.RestartPolicy{Name: dockercontainer.RestartPolicyMode(body.RestartPolicy)}
+	privileged, autoRemove := body.Privileged, body.AutoRemove
+	memory, nanoCPUs := body.Memory, int64(body.CPUs*1e9)
+	var memorySwap, cpuShares int64
+	restartField := "restartPolicy"
+
+	if hc := body.HostConfig; hc != nil {
+		if hc.RestartPolicy != nil {
+			restart = dockercontainer.RestartPolicy{
+				Name:              dockercontainer.RestartPolicyMode(hc.RestartPolicy.Name),
+				MaximumRetryCount: hc.RestartPolicy.MaximumRetryCount,
+			}
+			restartField = "hostConfig.restartPolicy"
+		}
+		if hc.Privileged != nil {
+			privileged = *hc.Privileged
+		}
+		if hc.AutoRemove != nil {
+			autoRemove = *hc.AutoRemove
+		}
+		if hc.Memory != 0 {
+			memory = hc.Memory
+		}
+		if hc.NanoCPUs != 0 {
+			nanoCPUs = hc.NanoCPUs
+		}
+		memorySwap, cpuShares = hc.MemorySwap, hc.CPUShares
+	}
+
+	switch restart.Name {
+	case "", dockercontainer.RestartPolicyDisabled, dockercontainer.RestartPolicyAlways,
+		dockercontainer.RestartPolicyUnlessStopped, dockercontainer.RestartPolicyOnFailure:
+	default:
+		fail(restartField, "unknown restart policy %q", restart.Name)
+	}
+	if restart.MaximumRetryCount < 0 {
+		fail(restartField, "maximum retry count cannot be negative")
+	} else if restart.MaximumRetryCount > 0 && restart.Name != dockercontainer.RestartPolicyOnFailure {
+		fail(restartField, "maximum retry count is only allowed with the on-failure policy")
+	}
+	if autoRemove && restart.Name != "" && restart.Name != dockercontainer.RestartPolicyDisabled {
+		fail(restartField, "auto-remove cannot be combined with restart policy %q", restart.Name)
+	}
+
+	switch {
+	case memory < 0:
+		fail("memory", "memory limit cannot be negative")
+	case memory > 0 && memory < minContainerMemory:
+		fail("memory", "memory limit must be at least 6MB")
+	}
+	if memorySwap > 0 && memory > 0 && memorySwap < memory {
+		fail("hostConfig.memorySwap", "memory+swap limit must be at least the memory limit")
+	}
+	if nanoCPUs < 0 {
+		fail("cpus", "CPU limit cannot be negative")
+	}
+	if cpuShares < 0 {
+		fail("hostConfig.cpuShares", "CPU shares cannot be negative")
+	}
+
+	if privileged {
+		warn("privileged", "privileged containers have full access to the host")
+	}
+}
+
+func validateCreateHealthcheckInternal(hc *containertypes.HealthcheckCreate, fail createIssueFunc) {
+	if len(hc.Test) == 0 {
+		fail("healthcheck.test", "healthcheck test is required")
+	} else {
+		switch hc.Test[0] {
+		case "NONE":
+			if len(hc.Test) > 1 {
+				fail("healthcheck.test", "NONE takes no arguments")
+			}
+		case "CMD", "CMD-SHELL":
+			if len(hc.Test) < 2 || strings.TrimSpace(strings.Join(hc.Test[1:], "")) == "" {
+				fail("healthcheck.test", "%s needs a command", hc.Test[0])
+			}
+		default:
+			fail("healthcheck.test", "healthcheck test must start with CMD, CMD-SHELL or NONE")
+		}
+	}
+
+	for _, d := range []struct{ field, value string }{
+		{"healthcheck.interval", hc.Interval},
+		{"healthcheck.timeout", hc.Timeout},
+		{"healthcheck.startPeriod", hc.StartPeriod},
+	} {
+		if d.value == "" {
+			continue
+		}
+		parsed, err := time.ParseDuration(d.value)
+		switch {
+		case err != nil:
+			fail(d.field, "invalid duration %q", d.value)
+		case parsed != 0 && parsed < time.Millisecond:
+			fail(d.field, "duration must be at least 1ms")
+		}
+	}
+	if hc.Retries < 0 {
+		fail("healthcheck.retries", "retries cannot be negative")
+	}
+}
+
+// createIssueDetailsInternal converts validation errors into huma error
+// details so clients can attach them to form fields.
+func createIssueDetailsInternal(issues []containertypes.CreateIssue) []error {
+	details := make([]error, len(issues))
+	for i, issue := range issues {
+		details[i] = &huma.ErrorDetail{Message: issue.Message, Location: "body." + issue.Field}
+	}
+	return details
+}
@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"testing"
+	"time"
+
+	containertypes "github.com/getarcaneapp/arcane/types/container"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func issueFields(issues []containertypes.CreateIssue) []string {
+	fields := make([]string, len(issues))
+	for i, issue := range issues {
+		fields[i] = issue.Field
+	}
+	return fields
+}
+
+func TestValidateCreateContainer(t *testing.T) {
+	t.Run("valid request", func(t *testing.T) {
+		errs, warnings := validateCreateContainerInternal(containertypes.Create{
+			Name:        "web",
+			Image:       "nginx:alpine",
+			Environment: []string{"MODE=prod"},
+			Volumes:     []string{"web_data:/data", "/srv/web:/srv:ro"},
+			HostConfig: &containertypes.HostConfigCreate{
+				PortBindings: map[string][]containertypes.PortBindingCreate{
+					"80/tcp": {{HostIP: "127.0.0.1", HostPort: "8080"}},
+				},
+				RestartPolicy: &containertypes.RestartPolicyCreate{Name: "on-failure", MaximumRetryCount: 3},
+				Memory:        256 * 1024 * 1024,
+			},
+			Healthcheck: &containertypes.HealthcheckCreate{
+				Test:     []string{"CMD-SHELL", "wget -qO- http://localhost/ || exit 1"},
+				Interval: "30s",
+				Retries:  3,
+			},
+		})
+		assert.Empty(t, errs)
+		assert.Empty(t, warnings)
+	})
+
+	t.Run("reports each invalid field", func(t *testing.T) {
+		errs, _ := validateCreateContainerInternal(containertypes.Create{
+			Name:          "-web",
+			Image:         "Not A Ref",
+			Environment:   []string{"=oops"},
+			Volumes:       []string{"./data:/data", "web:relative", "web2:/x:bogus"},
+			RestartPolicy: "sometimes",
+			Memory:        1024,
+			Healthcheck:   &containertypes.HealthcheckCreate{Test: []string{"curl"}, Timeout: "soon"},
+		})
+		assert.ElementsMatch(t, []string{
+			"name",
+			"image",
+			"environment[0]",
+			"volumes[0]",
+			"volumes[1]",
+			"volumes[2]",
+			"restartPolicy",
+			"memory",
+			"healthcheck.test",
+			"healthcheck.timeout",
+		}, issueFields(errs))
+	})
+
+	t.Run("duplicate host ports", func(t *testing.T) {
+		errs, _ := validateCreateContainerInternal(containertypes.Create{
+			Image: "nginx",
+			HostConfig: &containertypes.HostConfigCreate{
+				PortBindings: map[string][]containertypes.PortBindingCreate{
+					"80/tcp":  {{HostPort: "8080"}},
+					"443/tcp": {{HostPort: "8080"}},
+					"53/udp":  {{HostPort: "8080"}},
+				},
+			},
+		})
+		require.Len(t, errs, 1)
+		assert.Equal(t, "hostConfig.portBindings.80/tcp", errs[0].Field)
+	})
+
+	t.Run("auto-remove with restart policy", func(t *testing.T) {
+		errs, _ := validateCreateContainerInternal(containertypes.Create{Image: "nginx", AutoRemove: true, RestartPolicy: "always"})
+		assert.Equal(t, []string{"restartPolicy"}, issueFields(errs))
+	})
+
+	t.Run("risky settings warn", func(t *testing.T) {
+		errs, warnings := validateCreateContainerInternal(containertypes.Create{
+			Image:      "portainer/agent",
+			Privileged: true,
+			Volumes:    []string{"/var/run/docker.sock:/var/run/docker.sock"},
+		})
+		assert.Empty(t, errs)
+		assert.ElementsMatch(t, []string{"privileged", "volumes[0]"}, issueFields(warnings))
+	})
+}
+
+func TestBuildCreateContainerConfigHealthcheck(t *testing.T) {
+	config, _, _, err := buildCreateContainerConfigInternal(containertypes.Create{
+		Image: "nginx",
+		Healthcheck: &containertypes.HealthcheckCreate{
+			Test:        []string{"CMD", "curl", "-f", "http://localhost/"},
+			Interval:    "10s",
+			StartPeriod: "1m",
+			Retries:     5,
+		},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, config.Healthcheck)
+	assert.Equal(t, 10*time.Second, config.Healthcheck.Interval)
+	assert.Equal(t, time.Minute, config.Healthcheck.StartPeriod)
+	assert.Equal(t, 5, config.Healthcheck.Retries)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/getarcaneapp/arcane/backend/internal/utils/registry"
 	"github.com/getarcaneapp/arcane/types/base"
 	"github.com/getarcaneapp/arcane/types/containerregistry"
+	"github.com/getarcaneapp/arcane/types/rbac"
 )
 
 // ContainerRegistryHandler handles container registry management endpoints.
@@ -235,7 +236,7 @@ func (h *ContainerRegistryHandler) CreateRegistry(ctx context.Context, input *Cr
 		return nil, huma.Error500InternalServerError("service not available")
 	}
 
-	if err := checkAdmin(ctx); err != nil {
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
 		return nil, err
 	}
 
@@ -291,7 +292,7 @@ func (h *ContainerRegistryHandler) UpdateRegistry(ctx context.Context, input *Up
 		return nil, huma.Error500InternalServerError("service not available")
 	}
 
-	if err := checkAdmin(ctx); err != nil {
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
 		return nil, err
 	}
 
@@ -322,7 +323,7 @@ func (h *ContainerRegistryHandler) DeleteRegistry(ctx context.Context, input *De
 		return nil, huma.Error500InternalServerError("service not available")
 	}
 
-	if err := checkAdmin(ctx); err != nil {
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
 		return nil, err
 	}
 
@@ -349,7 +350,7 @@ func (h *ContainerRegistryHandler) TestRegistry(ctx context.Context, input *Test
 		return nil, huma.Error500InternalServerError("service not available")
 	}
 
-	if err := checkAdmin(ctx); err != nil {
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
 		return nil, err
 	}
 
@@ -385,7 +386,7 @@ func (h *ContainerRegistryHandler) SyncRegistries(ctx context.Context, input *Sy
 		return nil, huma.Error500InternalServerError("service not available")
 	}
 
-	if err := checkAdmin(ctx); err != nil {
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
 		return nil, err
 	}
 
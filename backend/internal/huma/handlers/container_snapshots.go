@@ -0,0 +1,243 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/getarcaneapp/arcane/backend/internal/common"
+	humamw "github.com/getarcaneapp/arcane/backend/internal/huma/middleware"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/services"
+	"github.com/getarcaneapp/arcane/types/base"
+	containertypes "github.com/getarcaneapp/arcane/types/container"
+)
+
+// ContainerSnapshotHandler provides the container snapshot endpoints.
+type ContainerSnapshotHandler struct {
+	snapshotService *services.ContainerSnapshotService
+}
+
+// --- Huma Input/Output Wrappers ---
+
+type ListSnapshotsInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+}
+
+type ListContainerSnapshotsInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	ContainerID   string `path:"containerId" doc:"Container ID or name"`
+}
+
+type ListSnapshotsOutput struct {
+	Body base.ApiResponse[[]containertypes.Snapshot]
+}
+
+type CreateSnapshotInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	ContainerID   string `path:"containerId" doc:"Container ID"`
+	Body          containertypes.CreateSnapshotRequest
+}
+
+type CreateSnapshotOutput struct {
+	Body base.ApiResponse[containertypes.Snapshot]
+}
+
+type SnapshotInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	SnapshotID    string `path:"snapshotId" doc:"Snapshot image ID"`
+}
+
+type DeleteSnapshotOutput struct {
+	Body base.ApiResponse[base.MessageResponse]
+}
+
+type RestoreSnapshotOutput struct {
+	Body base.ApiResponse[containertypes.RestoreSnapshotResult]
+}
+
+// RegisterContainerSnapshots registers the container snapshot routes using Huma.
+func RegisterContainerSnapshots(api huma.API, snapshotService *services.ContainerSnapshotService) {
+	h := &ContainerSnapshotHandler{
+		snapshotService: snapshotService,
+	}
+
+	huma.Register(api, huma.Operation{
+		OperationID: "list-snapshots",
+		Method:      http.MethodGet,
+		Path:        "/environments/{id}/snapshots",
+		Summary:     "List container snapshots",
+		Description: "List the snapshots of every container, newest first",
+		Tags:        []string{"Containers"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.ListSnapshots)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "list-container-snapshots",
+		Method:      http.MethodGet,
+		Path:        "/environments/{id}/containers/{containerId}/snapshots",
+		Summary:     "List snapshots of a container",
+		Description: "List the snapshots taken of a container, newest first",
+		Tags:        []string{"Containers"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.ListContainerSnapshots)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "create-container-snapshot",
+		Method:      http.MethodPost,
+		Path:        "/environments/{id}/containers/{containerId}/snapshots",
+		Summary:     "Snapshot a container",
+		Description: "Commit a container's filesystem to a tagged image, optionally pushing it to its registry",
+		Tags:        []string{"Containers"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.CreateSnapshot)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "delete-snapshot",
+		Method:      http.MethodDelete,
+		Path:        "/environments/{id}/snapshots/{snapshotId}",
+		Summary:     "Delete a container snapshot",
+		Description: "Remove a snapshot image",
+		Tags:        []string{"Containers"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.DeleteSnapshot)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "restore-snapshot",
+		Method:      http.MethodPost,
+		Path:        "/environments/{id}/snapshots/{snapshotId}/restore",
+		Summary:     "Restore a container snapshot",
+		Description: "Recreate the snapshot's container from the snapshot image, keeping its current configuration",
+		Tags:        []string{"Containers"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.RestoreSnapshot)
+}
+
+// ListSnapshots returns every snapshot.
+func (h *ContainerSnapshotHandler) ListSnapshots(ctx context.Context, _ *ListSnapshotsInput) (*ListSnapshotsOutput, error) {
+	if h.snapshotService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	snapshots, err := h.snapshotService.ListSnapshots(ctx, "")
+	if err != nil {
+		return nil, huma.Error500InternalServerError((&common.ContainerSnapshotError{Err: err}).Error())
+	}
+
+	return &ListSnapshotsOutput{
+		Body: base.ApiResponse[[]containertypes.Snapshot]{
+			Success: true,
+			Data:    snapshots,
+		},
+	}, nil
+}
+
+// ListContainerSnapshots returns the snapshots of one container.
+func (h *ContainerSnapshotHandler) ListContainerSnapshots(ctx context.Context, input *ListContainerSnapshotsInput) (*ListSnapshotsOutput, error) {
+	if h.snapshotService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	snapshots, err := h.snapshotService.ListSnapshots(ctx, input.ContainerID)
+	if err != nil {
+		return nil, huma.Error500InternalServerError((&common.ContainerSnapshotError{Err: err}).Error())
+	}
+
+	return &ListSnapshotsOutput{
+		Body: base.ApiResponse[[]containertypes.Snapshot]{
+			Success: true,
+			Data:    snapshots,
+		},
+	}, nil
+}
+
+// CreateSnapshot commits a container to an image.
+func (h *ContainerSnapshotHandler) CreateSnapshot(ctx context.Context, input *CreateSnapshotInput) (*CreateSnapshotOutput, error) {
+	if h.snapshotService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	user, exists := humamw.GetCurrentUserFromContext(ctx)
+	if !exists {
+		return nil, huma.Error401Unauthorized((&common.NotAuthenticatedError{}).Error())
+	}
+
+	snapshot, err := h.snapshotService.CreateSnapshot(ctx, input.ContainerID, input.Body, *user)
+	if err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.ContainerSnapshotError{Err: err}).Error())
+	}
+
+	return &CreateSnapshotOutput{
+		Body: base.ApiResponse[containertypes.Snapshot]{
+			Success: true,
+			Data:    *snapshot,
+		},
+	}, nil
+}
+
+// DeleteSnapshot removes a snapshot.
+func (h *ContainerSnapshotHandler) DeleteSnapshot(ctx context.Context, input *SnapshotInput) (*DeleteSnapshotOutput, error) {
+	if h.snapshotService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	user, exists := humamw.GetCurrentUserFromContext(ctx)
+	if !exists {
+		return nil, huma.Error401Unauthorized((&common.NotAuthenticatedError{}).Error())
+	}
+
+	if err := h.snapshotService.DeleteSnapshot(ctx, input.SnapshotID, *user); err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.ContainerSnapshotError{Err: err}).Error())
+	}
+
+	return &DeleteSnapshotOutput{
+		Body: base.ApiResponse[base.MessageResponse]{
+			Success: true,
+			Data: base.MessageResponse{
+				Message: "Snapshot deleted successfully",
+			},
+		},
+	}, nil
+}
+
+// RestoreSnapshot recreates a container from a snapshot.
+func (h *ContainerSnapshotHandler) RestoreSnapshot(ctx context.Context, input *SnapshotInput) (*RestoreSnapshotOutput, error) {
+	if h.snapshotService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	user, exists := humamw.GetCurrentUserFromContext(ctx)
+	if !exists {
+		return nil, huma.Error401Unauthorized((&common.NotAuthenticatedError{}).Error())
+	}
+
+	result, err := h.snapshotService.RestoreSnapshot(ctx, input.SnapshotID, *user)
+	if err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.ContainerSnapshotError{Err: err}).Error())
+	}
+
+	return &RestoreSnapshotOutput{
+		Body: base.ApiResponse[containertypes.RestoreSnapshotResult]{
+			Success: true,
+			Data:    *result,
+		},
+	}, nil
+}
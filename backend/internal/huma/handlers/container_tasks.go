@@ -0,0 +1,347 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/getarcaneapp/arcane/backend/internal/services"
+	"github.com/getarcaneapp/arcane/types/base"
+	"github.com/getarcaneapp/arcane/types/containertask"
+	"github.com/getarcaneapp/arcane/types/rbac"
+)
+
+// ContainerTaskHandler provides Huma-based endpoints for commands scheduled
+// to run inside containers.
+type ContainerTaskHandler struct {
+	containerTaskService *services.ContainerTaskService
+}
+
+// --- Huma Input/Output Wrappers ---
+
+type ListContainerTasksInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+}
+
+type ListContainerTasksOutput struct {
+	Body base.ApiResponse[[]containertask.Task]
+}
+
+type GetContainerTaskInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	TaskID        string `path:"taskId" doc:"Task ID"`
+}
+
+type GetContainerTaskOutput struct {
+	Body base.ApiResponse[containertask.Task]
+}
+
+type CreateContainerTaskInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	Body          containertask.CreateTask
+}
+
+type CreateContainerTaskOutput struct {
+	Body base.ApiResponse[containertask.Task]
+}
+
+type UpdateContainerTaskInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	TaskID        string `path:"taskId" doc:"Task ID"`
+	Body          containertask.UpdateTask
+}
+
+type UpdateContainerTaskOutput struct {
+	Body base.ApiResponse[containertask.Task]
+}
+
+type DeleteContainerTaskInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	TaskID        string `path:"taskId" doc:"Task ID"`
+}
+
+type DeleteContainerTaskOutput struct {
+	Body base.ApiResponse[base.MessageResponse]
+}
+
+type RunContainerTaskInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	TaskID        string `path:"taskId" doc:"Task ID"`
+}
+
+type RunContainerTaskOutput struct {
+	Body base.ApiResponse[containertask.Run]
+}
+
+type ListContainerTaskRunsInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	TaskID        string `path:"taskId" doc:"Task ID"`
+	Limit         int    `query:"limit" default:"20" minimum:"1" maximum:"50" doc:"Maximum number of runs to return"`
+}
+
+type ListContainerTaskRunsOutput struct {
+	Body base.ApiResponse[[]containertask.Run]
+}
+
+// RegisterContainerTasks registers container task routes using Huma.
+func RegisterContainerTasks(api huma.API, containerTaskService *services.ContainerTaskService) {
+	h := &ContainerTaskHandler{
+		containerTaskService: containerTaskService,
+	}
+
+	huma.Register(api, huma.Operation{
+		OperationID: "list-container-tasks",
+		Method:      http.MethodGet,
+		Path:        "/environments/{id}/container-tasks",
+		Summary:     "List container tasks",
+		Description: "List commands scheduled to run inside containers with their last status",
+		Tags:        []string{"Container Tasks"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.ListTasks)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-container-task",
+		Method:      http.MethodGet,
+		Path:        "/environments/{id}/container-tasks/{taskId}",
+		Summary:     "Get a container task",
+		Description: "Get a container task by ID",
+		Tags:        []string{"Container Tasks"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.GetTask)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "create-container-task",
+		Method:      http.MethodPost,
+		Path:        "/environments/{id}/container-tasks",
+		Summary:     "Create a container task",
+		Description: "Schedule a command to run inside a container with docker exec",
+		Tags:        []string{"Container Tasks"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.CreateTask)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "update-container-task",
+		Method:      http.MethodPut,
+		Path:        "/environments/{id}/container-tasks/{taskId}",
+		Summary:     "Update a container task",
+		Description: "Update a container task's command, schedule or timeout",
+		Tags:        []string{"Container Tasks"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.UpdateTask)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "delete-container-task",
+		Method:      http.MethodDelete,
+		Path:        "/environments/{id}/container-tasks/{taskId}",
+		Summary:     "Delete a container task",
+		Description: "Delete a container task and its run history",
+		Tags:        []string{"Container Tasks"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.DeleteTask)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "run-container-task",
+		Method:      http.MethodPost,
+		Path:        "/environments/{id}/container-tasks/{taskId}/run",
+		Summary:     "Run a container task now",
+		Description: "Start a run of the task in the background; its result is available from the run history",
+		Tags:        []string{"Container Tasks"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.RunTask)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "list-container-task-runs",
+		Method:      http.MethodGet,
+		Path:        "/environments/{id}/container-tasks/{taskId}/runs",
+		Summary:     "List container task runs",
+		Description: "List the most recent runs of a task with their exit code and output",
+		Tags:        []string{"Container Tasks"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.ListRuns)
+}
+
+// ListTasks returns all container tasks.
+func (h *ContainerTaskHandler) ListTasks(ctx context.Context, input *ListContainerTasksInput) (*ListContainerTasksOutput, error) {
+	if h.containerTaskService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	tasks, err := h.containerTaskService.ListTasks(ctx)
+	if err != nil {
+		return nil, huma.Error500InternalServerError(err.Error())
+	}
+
+	return &ListContainerTasksOutput{
+		Body: base.ApiResponse[[]containertask.Task]{
+			Success: true,
+			Data:    tasks,
+		},
+	}, nil
+}
+
+// GetTask returns a single container task.
+func (h *ContainerTaskHandler) GetTask(ctx context.Context, input *GetContainerTaskInput) (*GetContainerTaskOutput, error) {
+	if h.containerTaskService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	task, err := h.containerTaskService.GetTask(ctx, input.TaskID)
+	if err != nil {
+		return nil, containerTaskError(err)
+	}
+
+	return &GetContainerTaskOutput{
+		Body: base.ApiResponse[containertask.Task]{
+			Success: true,
+			Data:    *task,
+		},
+	}, nil
+}
+
+// CreateTask schedules a new container task. Tasks run arbitrary commands in
+// containers, so only admins can manage them.
+func (h *ContainerTaskHandler) CreateTask(ctx context.Context, input *CreateContainerTaskInput) (*CreateContainerTaskOutput, error) {
+	if h.containerTaskService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	task, err := h.containerTaskService.CreateTask(ctx, input.Body)
+	if err != nil {
+		return nil, containerTaskError(err)
+	}
+
+	return &CreateContainerTaskOutput{
+		Body: base.ApiResponse[containertask.Task]{
+			Success: true,
+			Data:    *task,
+		},
+	}, nil
+}
+
+// UpdateTask updates an existing container task.
+func (h *ContainerTaskHandler) UpdateTask(ctx context.Context, input *UpdateContainerTaskInput) (*UpdateContainerTaskOutput, error) {
+	if h.containerTaskService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	task, err := h.containerTaskService.UpdateTask(ctx, input.TaskID, input.Body)
+	if err != nil {
+		return nil, containerTaskError(err)
+	}
+
+	return &UpdateContainerTaskOutput{
+		Body: base.ApiResponse[containertask.Task]{
+			Success: true,
+			Data:    *task,
+		},
+	}, nil
+}
+
+// DeleteTask removes a container task.
+func (h *ContainerTaskHandler) DeleteTask(ctx context.Context, input *DeleteContainerTaskInput) (*DeleteContainerTaskOutput, error) {
+	if h.containerTaskService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if err := h.containerTaskService.DeleteTask(ctx, input.TaskID); err != nil {
+		return nil, containerTaskError(err)
+	}
+
+	return &DeleteContainerTaskOutput{
+		Body: base.ApiResponse[base.MessageResponse]{
+			Success: true,
+			Data: base.MessageResponse{
+				Message: "Container task deleted successfully",
+			},
+		},
+	}, nil
+}
+
+// RunTask starts a container task immediately.
+func (h *ContainerTaskHandler) RunTask(ctx context.Context, input *RunContainerTaskInput) (*RunContainerTaskOutput, error) {
+	if h.containerTaskService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleOperator); err != nil {
+		return nil, err
+	}
+
+	run, err := h.containerTaskService.RunTaskNow(ctx, input.TaskID)
+	if err != nil {
+		return nil, containerTaskError(err)
+	}
+
+	return &RunContainerTaskOutput{
+		Body: base.ApiResponse[containertask.Run]{
+			Success: true,
+			Data:    *run,
+		},
+	}, nil
+}
+
+// ListRuns returns the run history of a container task.
+func (h *ContainerTaskHandler) ListRuns(ctx context.Context, input *ListContainerTaskRunsInput) (*ListContainerTaskRunsOutput, error) {
+	if h.containerTaskService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	runs, err := h.containerTaskService.ListRuns(ctx, input.TaskID, input.Limit)
+	if err != nil {
+		return nil, containerTaskError(err)
+	}
+
+	return &ListContainerTaskRunsOutput{
+		Body: base.ApiResponse[[]containertask.Run]{
+			Success: true,
+			Data:    runs,
+		},
+	}, nil
+}
+
+func containerTaskError(err error) error {
+	switch {
+	case errors.Is(err, services.ErrContainerTaskNotFound):
+		return huma.Error404NotFound(err.Error())
+	case errors.Is(err, services.ErrContainerTaskInvalid):
+		return huma.Error400BadRequest(err.Error())
+	case errors.Is(err, services.ErrContainerTaskRunning):
+		return huma.Error409Conflict(err.Error())
+	default:
+		return huma.Error500InternalServerError(err.Error())
+	}
+}
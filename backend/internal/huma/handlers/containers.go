@@ -1,16 +1,23 @@
 package handlers
 
 import (
+	"compress/gzip"
 	"context"
+	"errors"
+	"fmt"
+	"log/slog"
 	"maps"
 	"net/http"
 	"net/netip"
 	"strings"
+	"time"
 
 	"github.com/danielgtaylor/huma/v2"
 	"github.com/getarcaneapp/arcane/backend/internal/common"
 	humamw "github.com/getarcaneapp/arcane/backend/internal/huma/middleware"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
 	"github.com/getarcaneapp/arcane/backend/internal/services"
+	dockerutils "github.com/getarcaneapp/arcane/backend/internal/utils/docker"
 	"github.com/getarcaneapp/arcane/backend/internal/utils/pagination"
 	"github.com/getarcaneapp/arcane/backend/pkg/libarcane"
 	"github.com/getarcaneapp/arcane/types/base"
@@ -41,6 +48,7 @@ type ListContainersInput struct {
 	Limit           int    `query:"limit" default:"20" doc:"Limit"`
 	IncludeInternal bool   `query:"includeInternal" default:"false" doc:"Include internal containers"`
 	Updates         string `query:"updates" doc:"Filter by update status (has_update, up_to_date, error, unknown)"`
+	Tags            string `query:"tags" doc:"Only list containers with every tag, comma-separated as key or key=value"`
 }
 
 type ListContainersOutput struct {
@@ -118,6 +126,43 @@ type DeleteContainerOutput struct {
 	Body ContainerActionResponse
 }
 
+type EditContainerInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	ContainerID   string `path:"containerId" doc:"Container ID"`
+	DryRun        bool   `query:"dryRun" default:"false" doc:"Only return the diff, without recreating the container"`
+	Body          containertypes.EditRequest
+}
+
+type EditContainerOutput struct {
+	Body base.ApiResponse[containertypes.EditResult]
+}
+
+type ContainerLogsInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	ContainerID   string `path:"containerId" doc:"Container ID"`
+	Query         string `query:"q" doc:"Only return lines containing this text"`
+	Regex         bool   `query:"regex" default:"false" doc:"Treat q as a regular expression"`
+	CaseSensitive bool   `query:"caseSensitive" default:"false" doc:"Match q case sensitively"`
+	Stream        string `query:"stream" default:"all" enum:"all,stdout,stderr" doc:"Output stream to include"`
+	Since         string `query:"since" doc:"Only return lines written at or after this time (RFC 3339)"`
+	Until         string `query:"until" doc:"Only return lines written before this time (RFC 3339)"`
+}
+
+type SearchContainerLogsInput struct {
+	ContainerLogsInput
+	Limit int `query:"limit" default:"1000" minimum:"1" maximum:"10000" doc:"Maximum number of lines to return, keeping the most recent"`
+}
+
+// ContainerLogsResponse is a dedicated response type
+type ContainerLogsResponse struct {
+	Success bool                           `json:"success"`
+	Data    containertypes.LogSearchResult `json:"data"`
+}
+
+type SearchContainerLogsOutput struct {
+	Body ContainerLogsResponse
+}
+
 // RegisterContainers registers container endpoints.
 func RegisterContainers(api huma.API, containerSvc *services.ContainerService, dockerSvc *services.DockerClientService) {
 	h := &ContainerHandler{
@@ -153,6 +198,16 @@ func RegisterContainers(api huma.API, containerSvc *services.ContainerService, d
 		Security:    []map[string][]string{{"BearerAuth": {}}, {"ApiKeyAuth": {}}},
 	}, h.CreateContainer)
 
+	huma.Register(api, huma.Operation{
+		OperationID: "preview-container",
+		Method:      http.MethodPost,
+		Path:        "/environments/{id}/containers/preview",
+		Summary:     "Preview container",
+		Description: "Validate a container create request and show the equivalent docker run command without creating it",
+		Tags:        []string{"Containers"},
+		Security:    []map[string][]string{{"BearerAuth": {}}, {"ApiKeyAuth": {}}},
+	}, h.PreviewContainer)
+
 	huma.Register(api, huma.Operation{
 		OperationID: "get-container",
 		Method:      http.MethodGet,
@@ -197,6 +252,36 @@ func RegisterContainers(api huma.API, containerSvc *services.ContainerService, d
 		Tags:        []string{"Containers"},
 		Security:    []map[string][]string{{"BearerAuth": {}}, {"ApiKeyAuth": {}}},
 	}, h.DeleteContainer)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "edit-container",
+		Method:      http.MethodPost,
+		Path:        "/environments/{id}/containers/{containerId}/edit",
+		Summary:     "Edit container",
+		Description: "Change a standalone container's image, environment, ports or mounts and recreate it, or only preview the diff with dryRun",
+		Tags:        []string{"Containers"},
+		Security:    []map[string][]string{{"BearerAuth": {}}, {"ApiKeyAuth": {}}},
+	}, h.EditContainer)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "search-container-logs",
+		Method:      http.MethodGet,
+		Path:        "/environments/{id}/containers/{containerId}/logs",
+		Summary:     "Search container logs",
+		Description: "Return container log lines in a time range, filtered by substring or regular expression",
+		Tags:        []string{"Containers"},
+		Security:    []map[string][]string{{"BearerAuth": {}}, {"ApiKeyAuth": {}}},
+	}, h.SearchContainerLogs)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "download-container-logs",
+		Method:      http.MethodGet,
+		Path:        "/environments/{id}/containers/{containerId}/logs/download",
+		Summary:     "Download container logs",
+		Description: "Download the filtered container logs as a gzip compressed text file",
+		Tags:        []string{"Containers"},
+		Security:    []map[string][]string{{"BearerAuth": {}}, {"ApiKeyAuth": {}}},
+	}, h.DownloadContainerLogs)
 }
 
 func (h *ContainerHandler) ListContainers(ctx context.Context, input *ListContainersInput) (*ListContainersOutput, error) {
@@ -208,6 +293,9 @@ func (h *ContainerHandler) ListContainers(ctx context.Context, input *ListContai
 	if input.Updates != "" {
 		filters["updates"] = input.Updates
 	}
+	if input.Tags != "" {
+		filters["tags"] = input.Tags
+	}
 
 	params := pagination.QueryParams{
 		SearchQuery: pagination.SearchQuery{Search: input.Search},
@@ -224,6 +312,9 @@ func (h *ContainerHandler) ListContainers(ctx context.Context, input *ListContai
 
 	containers, paginationResp, counts, err := h.containerService.ListContainersPaginated(ctx, params, true, input.IncludeInternal)
 	if err != nil {
+		if errors.Is(err, services.ErrTagInvalid) {
+			return nil, huma.Error400BadRequest((&common.ContainerListError{Err: err}).Error())
+		}
 		return nil, huma.Error500InternalServerError((&common.ContainerListError{Err: err}).Error())
 	}
 
@@ -497,22 +588,14 @@ func (h *ContainerHandler) CreateContainer(ctx context.Context, input *CreateCon
 		return nil, huma.Error401Unauthorized("not authenticated")
 	}
 
-	config := buildContainerConfig(input.Body)
-	portBindings := network.PortMap{}
-	if err := applyLegacyPortBindings(input.Body, config, portBindings); err != nil {
-		return nil, huma.Error400BadRequest((&common.InvalidPortFormatError{Err: err}).Error())
-	}
-	if err := applyExposedPorts(input.Body.ExposedPorts, config); err != nil {
-		return nil, huma.Error400BadRequest((&common.InvalidPortFormatError{Err: err}).Error())
+	if errs, _ := validateCreateContainerInternal(input.Body); len(errs) > 0 {
+		return nil, huma.Error422UnprocessableEntity("invalid container configuration", createIssueDetailsInternal(errs)...)
 	}
 
-	hostConfig := buildHostConfigBase(input.Body, portBindings)
-	if err := applyHostConfigOverrides(input.Body, config, hostConfig, portBindings); err != nil {
+	config, hostConfig, networkingConfig, err := buildCreateContainerConfigInternal(input.Body)
+	if err != nil {
 		return nil, huma.Error400BadRequest((&common.InvalidPortFormatError{Err: err}).Error())
 	}
-	applyLegacyResourceLimits(input.Body, hostConfig)
-
-	networkingConfig := buildNetworkingConfig(input.Body)
 
 	containerJSON, err := h.containerService.CreateContainer(ctx, config, hostConfig, networkingConfig, input.Body.Name, *user, input.Body.Credentials)
 	if err != nil {
@@ -642,3 +725,129 @@ func (h *ContainerHandler) DeleteContainer(ctx context.Context, input *DeleteCon
 		},
 	}, nil
 }
+
+// EditContainer applies configuration changes to a standalone container by
+// recreating it.
+func (h *ContainerHandler) EditContainer(ctx context.Context, input *EditContainerInput) (*EditContainerOutput, error) {
+	if h.containerService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	user, exists := humamw.GetCurrentUserFromContext(ctx)
+	if !exists {
+		return nil, huma.Error401Unauthorized("not authenticated")
+	}
+
+	result, err := h.containerService.EditContainer(ctx, input.ContainerID, input.Body, input.DryRun, *user)
+	if err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.ContainerEditError{Err: err}).Error())
+	}
+
+	return &EditContainerOutput{
+		Body: base.ApiResponse[containertypes.EditResult]{
+			Success: true,
+			Data:    *result,
+		},
+	}, nil
+}
+
+func (h *ContainerHandler) SearchContainerLogs(ctx context.Context, input *SearchContainerLogsInput) (*SearchContainerLogsOutput, error) {
+	if h.containerService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	opts, err := input.searchOptions()
+	if err != nil {
+		return nil, err
+	}
+	opts.Limit = input.Limit
+
+	result, err := h.containerService.SearchLogs(ctx, input.ContainerID, opts)
+	if err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.ContainerLogsError{Err: err}).Error())
+	}
+
+	return &SearchContainerLogsOutput{
+		Body: ContainerLogsResponse{
+			Success: true,
+			Data:    *result,
+		},
+	}, nil
+}
+
+func (h *ContainerHandler) DownloadContainerLogs(ctx context.Context, input *ContainerLogsInput) (*huma.StreamResponse, error) {
+	if h.containerService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	opts, err := input.searchOptions()
+	if err != nil {
+		return nil, err
+	}
+	// Errors can no longer be reported once the body is streaming, so check
+	// the filter and the container up front.
+	if _, err := dockerutils.NewLogFilter(opts.Query, opts.Regex, opts.CaseSensitive, opts.Stream); err != nil {
+		return nil, huma.Error400BadRequest((&common.ContainerLogsError{Err: err}).Error())
+	}
+	containerInspect, err := h.containerService.GetContainerByID(ctx, input.ContainerID)
+	if err != nil {
+		return nil, huma.Error404NotFound((&common.ContainerRetrievalError{Err: err}).Error())
+	}
+
+	name := strings.TrimPrefix(containerInspect.Name, "/")
+	if name == "" {
+		name = input.ContainerID
+	}
+	filename := fmt.Sprintf("%s-logs-%s.log.gz", name, time.Now().UTC().Format("20060102T150405Z"))
+
+	return &huma.StreamResponse{
+		Body: func(humaCtx huma.Context) {
+			humaCtx.SetHeader("Content-Type", "application/gzip")
+			humaCtx.SetHeader("Content-Disposition", "attachment; filename="+filename)
+
+			gz := gzip.NewWriter(humaCtx.BodyWriter())
+			if err := h.containerService.ExportLogs(humaCtx.Context(), input.ContainerID, opts, gz); err != nil {
+				slog.WarnContext(humaCtx.Context(), "Failed to export container logs", "container", input.ContainerID, "error", err)
+			}
+			_ = gz.Close()
+		},
+	}, nil
+}
+
+// searchOptions converts the query parameters to service options. Times are
+// passed to Docker as Unix timestamps with nanosecond precision.
+func (input *ContainerLogsInput) searchOptions() (services.ContainerLogSearchOptions, error) {
+	opts := services.ContainerLogSearchOptions{
+		Query:         input.Query,
+		Regex:         input.Regex,
+		CaseSensitive: input.CaseSensitive,
+	}
+	if input.Stream != "all" {
+		opts.Stream = input.Stream
+	}
+
+	since, err := parseOptionalRFC3339(input.Since)
+	if err != nil {
+		return opts, huma.Error400BadRequest("invalid since: " + err.Error())
+	}
+	until, err := parseOptionalRFC3339(input.Until)
+	if err != nil {
+		return opts, huma.Error400BadRequest("invalid until: " + err.Error())
+	}
+	if since != nil && until != nil && !until.After(*since) {
+		return opts, huma.Error400BadRequest("until must be after since")
+	}
+	if since != nil {
+		opts.Since = dockerTimestamp(*since)
+	}
+	if until != nil {
+		opts.Until = dockerTimestamp(*until)
+	}
+	return opts, nil
+}
+
+func dockerTimestamp(t time.Time) string {
+	return fmt.Sprintf("%d.%09d", t.Unix(), t.Nanosecond())
+}
@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/getarcaneapp/arcane/backend/internal/common"
+	humamw "github.com/getarcaneapp/arcane/backend/internal/huma/middleware"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/services"
+	"github.com/getarcaneapp/arcane/types/base"
+	"github.com/getarcaneapp/arcane/types/dockerinfo"
+	"github.com/getarcaneapp/arcane/types/rbac"
+)
+
+// DaemonConfigHandler provides the Docker daemon configuration endpoints.
+type DaemonConfigHandler struct {
+	daemonConfigService *services.DaemonConfigService
+}
+
+// --- Huma Input/Output Wrappers ---
+
+type GetDaemonConfigInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+}
+
+type GetDaemonConfigOutput struct {
+	Body base.ApiResponse[dockerinfo.DaemonConfig]
+}
+
+type UpdateDaemonJSONInput struct {
+	EnvironmentID string                             `path:"id" doc:"Environment ID"`
+	Body          dockerinfo.UpdateDaemonJSONRequest `doc:"New daemon.json content"`
+}
+
+type UpdateDaemonJSONOutput struct {
+	Body base.ApiResponse[dockerinfo.UpdateDaemonJSONResult]
+}
+
+// RegisterDaemonConfig registers the daemon configuration routes using Huma.
+func RegisterDaemonConfig(api huma.API, daemonConfigService *services.DaemonConfigService) {
+	h := &DaemonConfigHandler{
+		daemonConfigService: daemonConfigService,
+	}
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-daemon-config",
+		Method:      http.MethodGet,
+		Path:        "/environments/{id}/system/daemon-config",
+		Summary:     "Get Docker daemon configuration",
+		Description: "Get the storage, cgroup, logging and registry configuration of the Docker daemon and the risky settings found in it",
+		Tags:        []string{"System"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.GetDaemonConfig)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "update-daemon-json",
+		Method:      http.MethodPut,
+		Path:        "/environments/{id}/system/daemon-config",
+		Summary:     "Update daemon.json",
+		Description: "Validate and write daemon.json on an agent, and report whether the daemon needs a reload or a restart to apply it",
+		Tags:        []string{"System"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.UpdateDaemonJSON)
+}
+
+// GetDaemonConfig returns the Docker daemon configuration.
+func (h *DaemonConfigHandler) GetDaemonConfig(ctx context.Context, _ *GetDaemonConfigInput) (*GetDaemonConfigOutput, error) {
+	if h.daemonConfigService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	cfg, err := h.daemonConfigService.GetDaemonConfig(ctx)
+	if err != nil {
+		return nil, huma.Error500InternalServerError((&common.DaemonConfigRetrievalError{Err: err}).Error())
+	}
+
+	return &GetDaemonConfigOutput{
+		Body: base.ApiResponse[dockerinfo.DaemonConfig]{
+			Success: true,
+			Data:    *cfg,
+		},
+	}, nil
+}
+
+// UpdateDaemonJSON writes daemon.json.
+func (h *DaemonConfigHandler) UpdateDaemonJSON(ctx context.Context, input *UpdateDaemonJSONInput) (*UpdateDaemonJSONOutput, error) {
+	if h.daemonConfigService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	user, exists := humamw.GetCurrentUserFromContext(ctx)
+	if !exists {
+		return nil, huma.Error401Unauthorized((&common.NotAuthenticatedError{}).Error())
+	}
+
+	result, err := h.daemonConfigService.UpdateDaemonJSON(ctx, input.Body.Content, input.Body.DryRun, *user)
+	if err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.DaemonJSONUpdateError{Err: err}).Error())
+	}
+
+	return &UpdateDaemonJSONOutput{
+		Body: base.ApiResponse[dockerinfo.UpdateDaemonJSONResult]{
+			Success: true,
+			Data:    *result,
+		},
+	}, nil
+}
@@ -3,8 +3,12 @@ package handlers
 import (
 	"context"
 	"net/http"
+	"strings"
 
 	"github.com/danielgtaylor/huma/v2"
+	"github.com/getarcaneapp/arcane/backend/internal/common"
+	humamw "github.com/getarcaneapp/arcane/backend/internal/huma/middleware"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
 	"github.com/getarcaneapp/arcane/backend/internal/services"
 	"github.com/getarcaneapp/arcane/types/base"
 	dashboardtypes "github.com/getarcaneapp/arcane/types/dashboard"
@@ -23,6 +27,32 @@ type GetDashboardActionItemsOutput struct {
 	Body base.ApiResponse[dashboardtypes.ActionItems]
 }
 
+type GetDashboardLayoutOutput struct {
+	Body base.ApiResponse[dashboardtypes.Layout]
+}
+
+type UpdateDashboardLayoutInput struct {
+	Body dashboardtypes.UpdateLayout
+}
+
+type UpdateDashboardLayoutOutput struct {
+	Body base.ApiResponse[dashboardtypes.Layout]
+}
+
+type ResetDashboardLayoutOutput struct {
+	Body base.ApiResponse[dashboardtypes.Layout]
+}
+
+type GetDashboardWidgetsInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	Widgets       string `query:"widgets" doc:"Comma separated widget kinds to load; defaults to the widgets in the caller's layout"`
+	Limit         int    `query:"limit" default:"5" minimum:"1" maximum:"50" doc:"Maximum number of rows returned by list widgets"`
+}
+
+type GetDashboardWidgetsOutput struct {
+	Body base.ApiResponse[dashboardtypes.WidgetData]
+}
+
 func RegisterDashboard(api huma.API, dashboardService *services.DashboardService) {
 	h := &DashboardHandler{dashboardService: dashboardService}
 
@@ -38,6 +68,58 @@ func RegisterDashboard(api huma.API, dashboardService *services.DashboardService
 			{"ApiKeyAuth": {}},
 		},
 	}, h.GetActionItems)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-dashboard-widgets",
+		Method:      http.MethodGet,
+		Path:        "/environments/{id}/dashboard/widgets",
+		Summary:     "Get dashboard widget data",
+		Description: "Returns the data for several dashboard widgets in one request",
+		Tags:        []string{"Dashboard"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.GetWidgets)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-dashboard-layout",
+		Method:      http.MethodGet,
+		Path:        "/dashboard/layout",
+		Summary:     "Get dashboard layout",
+		Description: "Returns the current user's dashboard layout, or the default layout if none is saved",
+		Tags:        []string{"Dashboard"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.GetLayout)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "update-dashboard-layout",
+		Method:      http.MethodPut,
+		Path:        "/dashboard/layout",
+		Summary:     "Update dashboard layout",
+		Description: "Saves the current user's dashboard layout",
+		Tags:        []string{"Dashboard"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.UpdateLayout)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "reset-dashboard-layout",
+		Method:      http.MethodDelete,
+		Path:        "/dashboard/layout",
+		Summary:     "Reset dashboard layout",
+		Description: "Deletes the current user's saved layout and returns the default layout",
+		Tags:        []string{"Dashboard"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.ResetLayout)
 }
 
 func (h *DashboardHandler) GetActionItems(ctx context.Context, input *GetDashboardActionItemsInput) (*GetDashboardActionItemsOutput, error) {
@@ -68,3 +150,106 @@ func (h *DashboardHandler) GetActionItems(ctx context.Context, input *GetDashboa
 		},
 	}, nil
 }
+
+func (h *DashboardHandler) GetWidgets(ctx context.Context, input *GetDashboardWidgetsInput) (*GetDashboardWidgetsOutput, error) {
+	if h.dashboardService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	var userID string
+	if user, exists := humamw.GetCurrentUserFromContext(ctx); exists {
+		userID = user.ID
+	}
+
+	var kinds []dashboardtypes.WidgetKind
+	for kind := range strings.SplitSeq(input.Widgets, ",") {
+		if kind = strings.TrimSpace(kind); kind != "" {
+			kinds = append(kinds, dashboardtypes.WidgetKind(kind))
+		}
+	}
+
+	data, err := h.dashboardService.GetWidgetData(ctx, input.EnvironmentID, userID, services.DashboardWidgetDataOptions{
+		Widgets: kinds,
+		Limit:   input.Limit,
+	})
+	if err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.DashboardWidgetDataError{Err: err}).Error())
+	}
+
+	return &GetDashboardWidgetsOutput{
+		Body: base.ApiResponse[dashboardtypes.WidgetData]{
+			Success: true,
+			Data:    *data,
+		},
+	}, nil
+}
+
+func (h *DashboardHandler) GetLayout(ctx context.Context, input *struct{}) (*GetDashboardLayoutOutput, error) {
+	if h.dashboardService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	user, exists := humamw.GetCurrentUserFromContext(ctx)
+	if !exists {
+		return nil, huma.Error401Unauthorized((&common.NotAuthenticatedError{}).Error())
+	}
+
+	layout, err := h.dashboardService.GetLayout(ctx, user.ID)
+	if err != nil {
+		return nil, huma.Error500InternalServerError((&common.DashboardLayoutRetrievalError{Err: err}).Error())
+	}
+
+	return &GetDashboardLayoutOutput{
+		Body: base.ApiResponse[dashboardtypes.Layout]{
+			Success: true,
+			Data:    *layout,
+		},
+	}, nil
+}
+
+func (h *DashboardHandler) UpdateLayout(ctx context.Context, input *UpdateDashboardLayoutInput) (*UpdateDashboardLayoutOutput, error) {
+	if h.dashboardService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	user, exists := humamw.GetCurrentUserFromContext(ctx)
+	if !exists {
+		return nil, huma.Error401Unauthorized((&common.NotAuthenticatedError{}).Error())
+	}
+
+	layout, err := h.dashboardService.SaveLayout(ctx, user.ID, input.Body)
+	if err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.DashboardLayoutUpdateError{Err: err}).Error())
+	}
+
+	return &UpdateDashboardLayoutOutput{
+		Body: base.ApiResponse[dashboardtypes.Layout]{
+			Success: true,
+			Data:    *layout,
+		},
+	}, nil
+}
+
+func (h *DashboardHandler) ResetLayout(ctx context.Context, input *struct{}) (*ResetDashboardLayoutOutput, error) {
+	if h.dashboardService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	user, exists := humamw.GetCurrentUserFromContext(ctx)
+	if !exists {
+		return nil, huma.Error401Unauthorized((&common.NotAuthenticatedError{}).Error())
+	}
+
+	if err := h.dashboardService.ResetLayout(ctx, user.ID); err != nil {
+		return nil, huma.Error500InternalServerError((&common.DashboardLayoutUpdateError{Err: err}).Error())
+	}
+
+	return &ResetDashboardLayoutOutput{
+		Body: base.ApiResponse[dashboardtypes.Layout]{
+			Success: true,
+			Data:    dashboardtypes.Layout{Widgets: services.DefaultDashboardLayout()},
+		},
+	}, nil
+}
@@ -3,8 +3,10 @@ package handlers
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
+	"net"
 	"net/http"
 	"strings"
 	"time"
@@ -21,6 +23,7 @@ import (
 	"github.com/getarcaneapp/arcane/backend/pkg/libarcane/edge"
 	"github.com/getarcaneapp/arcane/types/base"
 	"github.com/getarcaneapp/arcane/types/environment"
+	"github.com/getarcaneapp/arcane/types/rbac"
 	"github.com/getarcaneapp/arcane/types/version"
 )
 
@@ -52,6 +55,7 @@ type ListEnvironmentsInput struct {
 	Order  string `query:"order" default:"asc" doc:"Sort direction (asc or desc)"`
 	Start  int    `query:"start" default:"0" doc:"Start index for pagination"`
 	Limit  int    `query:"limit" default:"20" doc:"Items per page"`
+	Tags   string `query:"tags" doc:"Only list environments with every tag, comma-separated as key or key=value"`
 }
 
 type ListEnvironmentsOutput struct {
@@ -127,24 +131,91 @@ type SyncEnvironmentInput struct {
 }
 
 type SyncEnvironmentOutput struct {
-	Body base.ApiResponse[base.MessageResponse]
+	Body base.ApiResponse[environment.ConfigSyncStatus]
+}
+
+type GetEnvironmentSyncStatusInput struct {
+	ID string `path:"id" doc:"Environment ID"`
+}
+
+type GetEnvironmentSyncStatusOutput struct {
+	Body base.ApiResponse[environment.ConfigSyncStatus]
 }
 
 type PairEnvironmentInput struct {
-	XAPIKey string `header:"X-API-Key" doc:"API key for environment pairing"`
+	XAPIKey           string                    `header:"X-API-Key" doc:"API key for environment pairing"`
+	XAgentFingerprint string                    `header:"X-Agent-Fingerprint" doc:"Fingerprint of the agent host shown to admins for approval"`
+	Body              *environment.Capabilities `doc:"Capabilities of the agent; omitted by agents that predate capability negotiation"`
+
+	// OriginIP is resolved from the request, not read from a parameter.
+	OriginIP string
 }
 
-type PairEnvironmentOutput struct {
+// Resolve records the address the pairing request came from. Like gin's
+// ClientIP with its default trusted proxies, it prefers forwarding headers.
+func (i *PairEnvironmentInput) Resolve(ctx huma.Context) []error {
+	if forwarded := ctx.Header("X-Forwarded-For"); forwarded != "" {
+		first, _, _ := strings.Cut(forwarded, ",")
+		if ip := strings.TrimSpace(first); ip != "" {
+			i.OriginIP = ip
+			return nil
+		}
+	}
+	if ip := strings.TrimSpace(ctx.Header("X-Real-IP")); ip != "" {
+		i.OriginIP = ip
+		return nil
+	}
+	host, _, err := net.SplitHostPort(ctx.RemoteAddr())
+	if err != nil {
+		host = ctx.RemoteAddr()
+	}
+	i.OriginIP = host
+	return nil
+}
+
+type ApproveEnvironmentPairingInput struct {
+	ID string `path:"id" doc:"Environment ID"`
+}
+
+type ApproveEnvironmentPairingOutput struct {
+	Body base.ApiResponse[environment.Environment]
+}
+
+type RejectEnvironmentPairingInput struct {
+	ID string `path:"id" doc:"Environment ID"`
+}
+
+type RejectEnvironmentPairingOutput struct {
 	Body base.ApiResponse[base.MessageResponse]
 }
 
+type GetCapabilitiesOutput struct {
+	Body environment.Capabilities
+}
+
+type PairEnvironmentOutput struct {
+	Status int
+	Body   base.ApiResponse[base.MessageResponse]
+}
+
 type DeploymentSnippet struct {
 	DockerRun     string `json:"dockerRun" doc:"Docker run command snippet"`
 	DockerCompose string `json:"dockerCompose" doc:"Docker compose YAML snippet"`
+	SystemdUnit   string `json:"systemdUnit" doc:"systemd unit running the agent container"`
 }
 
 type GetDeploymentSnippetsInput struct {
-	ID string `path:"id" doc:"Environment ID"`
+	ID           string   `path:"id" doc:"Environment ID"`
+	ImageTag     string   `query:"imageTag" doc:"Agent image tag (default latest)"`
+	Port         int      `query:"port" minimum:"0" maximum:"65535" doc:"Host port the agent is published on (default 3553, ignored for edge agents)"`
+	DataVolume   string   `query:"dataVolume" doc:"Named volume or absolute host path for the agent data directory (default arcane-data)"`
+	ProjectsPath string   `query:"projectsPath" doc:"Absolute host path of the projects directory, mounted at the same path"`
+	DockerSocket string   `query:"dockerSocket" doc:"Host path of the Docker socket (default /var/run/docker.sock)"`
+	Network      string   `query:"network" doc:"Existing Docker network to attach the agent to"`
+	TLS          bool     `query:"tls" doc:"Serve the agent API over HTTPS (ignored for edge agents)"`
+	TLSCertFile  string   `query:"tlsCertFile" doc:"Absolute host path of the TLS certificate, required with tls"`
+	TLSKeyFile   string   `query:"tlsKeyFile" doc:"Absolute host path of the TLS private key, required with tls"`
+	Env          []string `query:"env,explode" doc:"Additional KEY=VALUE environment variables"`
 }
 
 type GetDeploymentSnippetsOutput struct {
@@ -282,7 +353,7 @@ func RegisterEnvironments(api huma.API, environmentService *services.Environment
 		Method:      "POST",
 		Path:        "/environments/{id}/sync",
 		Summary:     "Sync environment",
-		Description: "Sync container registries and git repositories to a remote environment",
+		Description: "Start syncing container registries and git repositories to a remote environment. Failed targets are retried; poll the sync status for the result",
 		Tags:        []string{"Environments"},
 		Security: []map[string][]string{
 			{"BearerAuth": {}},
@@ -290,22 +361,70 @@ func RegisterEnvironments(api huma.API, environmentService *services.Environment
 		},
 	}, h.SyncEnvironment)
 
+	huma.Register(api, huma.Operation{
+		OperationID: "getEnvironmentSyncStatus",
+		Method:      "GET",
+		Path:        "/environments/{id}/sync",
+		Summary:     "Get environment sync status",
+		Description: "Get the progress and result of the latest sync of container registries and git repositories to a remote environment",
+		Tags:        []string{"Environments"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.GetEnvironmentSyncStatus)
+
 	huma.Register(api, huma.Operation{
 		OperationID:  "pairEnvironment",
 		Method:       "POST",
 		Path:         "/environments/pair",
 		Summary:      "Pair agent with manager",
-		Description:  "Agent sends API key to complete environment pairing",
+		Description:  "Agent sends API key to request environment pairing; the request waits for admin approval",
 		Tags:         []string{"Environments"},
-		MaxBodyBytes: 1024,
+		MaxBodyBytes: 4096,
 	}, h.PairEnvironment)
 
+	huma.Register(api, huma.Operation{
+		OperationID: "approveEnvironmentPairing",
+		Method:      "POST",
+		Path:        "/environments/{id}/pairing/approve",
+		Summary:     "Approve pairing request",
+		Description: "Approve the pairing request an agent sent and bring the environment online",
+		Tags:        []string{"Environments"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.ApproveEnvironmentPairing)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "rejectEnvironmentPairing",
+		Method:      "POST",
+		Path:        "/environments/{id}/pairing/reject",
+		Summary:     "Reject pairing request",
+		Description: "Discard the pairing request an agent sent; the environment stays pending",
+		Tags:        []string{"Environments"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.RejectEnvironmentPairing)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "getCapabilities",
+		Method:      "GET",
+		Path:        "/capabilities",
+		Summary:     "Get capabilities",
+		Description: "Get the version, compose version, swarm state and features this instance serves as an agent",
+		Tags:        []string{"Environments"},
+	}, h.GetCapabilities)
+
 	huma.Register(api, huma.Operation{
 		OperationID: "getDeploymentSnippets",
 		Method:      "GET",
 		Path:        "/environments/{id}/deployment",
 		Summary:     "Get deployment snippets",
-		Description: "Get Docker run and compose snippets for environment deployment",
+		Description: "Get Docker run, compose and systemd snippets for environment deployment, customized by the query options",
 		Tags:        []string{"Environments"},
 		Security: []map[string][]string{
 			{"BearerAuth": {}},
@@ -349,14 +468,21 @@ func (h *EnvironmentHandler) ListEnvironments(ctx context.Context, input *ListEn
 			Start: input.Start,
 			Limit: input.Limit,
 		},
+		Filters: map[string]string{
+			"tags": input.Tags,
+		},
 	}
 
 	envs, paginationResp, err := h.environmentService.ListEnvironmentsPaginated(ctx, params)
 	if err != nil {
+		if errors.Is(err, services.ErrTagInvalid) {
+			return nil, huma.Error400BadRequest((&common.EnvironmentListError{Err: err}).Error())
+		}
 		return nil, huma.Error500InternalServerError((&common.EnvironmentListError{Err: err}).Error())
 	}
 	for i := range envs {
 		h.applyEdgeRuntimeState(&envs[i])
+		envs[i].Sync = h.environmentService.EnvironmentSyncStatus(envs[i].ID)
 	}
 
 	return &ListEnvironmentsOutput{
@@ -380,7 +506,7 @@ func (h *EnvironmentHandler) CreateEnvironment(ctx context.Context, input *Creat
 		return nil, huma.Error500InternalServerError("service not available")
 	}
 
-	if err := checkAdmin(ctx); err != nil {
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
 		return nil, err
 	}
 
@@ -403,6 +529,11 @@ func (h *EnvironmentHandler) CreateEnvironment(ctx context.Context, input *Creat
 		env.IsEdge = *input.Body.IsEdge
 	}
 
+	// SSH environments talk to the Docker socket directly and need no agent pairing
+	if services.IsSSHEnvironmentURL(env.ApiUrl) {
+		return h.createSSHEnvironment(ctx, env, user, input.Body)
+	}
+
 	// Determine pairing method
 	useApiKey := input.Body.UseApiKey != nil && *input.Body.UseApiKey
 
@@ -460,6 +591,49 @@ func (h *EnvironmentHandler) createEnvironmentWithApiKey(ctx context.Context, en
 	}, nil
 }
 
+func (h *EnvironmentHandler) createSSHEnvironment(ctx context.Context, env *models.Environment, user *models.User, body environment.Create) (*CreateEnvironmentOutput, error) {
+	if err := services.ValidateSSHEnvironmentURL(env.ApiUrl); err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+	if body.SSHKey == nil || *body.SSHKey == "" {
+		return nil, huma.Error400BadRequest("sshKey is required for SSH environments")
+	}
+
+	sshKey, err := services.EncryptSSHKey(*body.SSHKey)
+	if err != nil {
+		return nil, huma.Error400BadRequest(err.Error())
+	}
+	env.SSHKey = sshKey
+	env.SSHHostKeyVerification = services.SSHHostKeyVerificationAcceptNew
+	if body.SSHHostKeyVerification != nil && *body.SSHHostKeyVerification != "" {
+		env.SSHHostKeyVerification = *body.SSHHostKeyVerification
+	}
+	if body.SSHHostKey != nil && *body.SSHHostKey != "" {
+		env.SSHHostKey = body.SSHHostKey
+	}
+	env.IsEdge = false
+	env.Status = string(models.EnvironmentStatusPending)
+
+	created, err := h.environmentService.CreateEnvironment(ctx, env, &user.ID, &user.Username)
+	if err != nil {
+		return nil, huma.Error500InternalServerError((&common.EnvironmentCreationError{Err: err}).Error())
+	}
+
+	out, mapErr := mapper.MapOne[*models.Environment, environment.Environment](created)
+	if mapErr != nil {
+		return nil, huma.Error500InternalServerError((&common.EnvironmentMappingError{Err: mapErr}).Error())
+	}
+
+	return &CreateEnvironmentOutput{
+		Body: base.ApiResponse[EnvironmentWithApiKey]{
+			Success: true,
+			Data: EnvironmentWithApiKey{
+				Environment: out,
+			},
+		},
+	}, nil
+}
+
 func (h *EnvironmentHandler) createEnvironmentLegacy(ctx context.Context, env *models.Environment, user *models.User, body environment.Create) (*CreateEnvironmentOutput, error) {
 	// Legacy pairing flows
 	if (body.AccessToken == nil || *body.AccessToken == "") && body.BootstrapToken != nil && *body.BootstrapToken != "" {
@@ -478,7 +652,7 @@ func (h *EnvironmentHandler) createEnvironmentLegacy(ctx context.Context, env *m
 		return nil, huma.Error500InternalServerError((&common.EnvironmentCreationError{Err: err}).Error())
 	}
 
-	// Sync registries and git repositories in background (intentionally detached from request context)
+	// Sync registries and git repositories in the background; the sync status shows whether they arrived
 	if created.AccessToken != nil && *created.AccessToken != "" {
 		h.triggerEnvironmentResourceSync(ctx, created.ID, created.Name, "environment creation")
 	}
@@ -515,6 +689,8 @@ func (h *EnvironmentHandler) GetEnvironment(ctx context.Context, input *GetEnvir
 		return nil, huma.Error500InternalServerError((&common.EnvironmentMappingError{Err: mapErr}).Error())
 	}
 	h.applyEdgeRuntimeState(&out)
+	out.Breaker = h.environmentService.BreakerState(out.ID)
+	out.Sync = h.environmentService.EnvironmentSyncStatus(out.ID)
 
 	return &GetEnvironmentOutput{
 		Body: base.ApiResponse[environment.Environment]{
@@ -530,12 +706,15 @@ func (h *EnvironmentHandler) UpdateEnvironment(ctx context.Context, input *Updat
 		return nil, huma.Error500InternalServerError("service not available")
 	}
 
-	if err := checkAdmin(ctx); err != nil {
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
 		return nil, err
 	}
 
 	isLocalEnv := input.ID == localDockerEnvironmentID
 	updates := h.buildUpdateMap(&input.Body, isLocalEnv)
+	if err := h.applySSHUpdates(&input.Body, updates, isLocalEnv); err != nil {
+		return nil, err
+	}
 
 	pairingSucceeded, err := h.handleEnvironmentPairing(ctx, input.ID, &input.Body, updates, isLocalEnv)
 	if err != nil {
@@ -651,7 +830,7 @@ func (h *EnvironmentHandler) DeleteEnvironment(ctx context.Context, input *Delet
 		return nil, huma.Error500InternalServerError("service not available")
 	}
 
-	if err := checkAdmin(ctx); err != nil {
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
 		return nil, err
 	}
 
@@ -685,7 +864,7 @@ func (h *EnvironmentHandler) TestConnection(ctx context.Context, input *TestConn
 		return nil, huma.Error500InternalServerError("service not available")
 	}
 
-	if err := checkAdmin(ctx); err != nil {
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
 		return nil, err
 	}
 
@@ -741,7 +920,7 @@ func (h *EnvironmentHandler) PairAgent(ctx context.Context, input *PairAgentInpu
 		return nil, huma.Error500InternalServerError("service not available")
 	}
 
-	if err := checkAdmin(ctx); err != nil {
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
 		return nil, err
 	}
 
@@ -774,30 +953,49 @@ func (h *EnvironmentHandler) SyncEnvironment(ctx context.Context, input *SyncEnv
 		return nil, huma.Error500InternalServerError("service not available")
 	}
 
-	if err := checkAdmin(ctx); err != nil {
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
 		return nil, err
 	}
 
-	// Sync registries
-	if err := h.environmentService.SyncRegistriesToEnvironment(ctx, input.ID); err != nil {
-		slog.WarnContext(ctx, "Failed to sync registries", "environmentID", input.ID, "error", err.Error())
+	status, err := h.environmentService.StartEnvironmentSync(ctx, input.ID, "manual sync")
+	if err != nil {
+		return nil, environmentSyncError(err)
 	}
 
-	// Sync git repositories
-	if err := h.environmentService.SyncRepositoriesToEnvironment(ctx, input.ID); err != nil {
-		slog.WarnContext(ctx, "Failed to sync git repositories", "environmentID", input.ID, "error", err.Error())
+	return &SyncEnvironmentOutput{
+		Body: base.ApiResponse[environment.ConfigSyncStatus]{
+			Success: true,
+			Data:    *status,
+		},
+	}, nil
+}
+
+// GetEnvironmentSyncStatus returns the latest sync of a remote environment.
+func (h *EnvironmentHandler) GetEnvironmentSyncStatus(ctx context.Context, input *GetEnvironmentSyncStatusInput) (*GetEnvironmentSyncStatusOutput, error) {
+	if h.environmentService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
 	}
 
-	return &SyncEnvironmentOutput{
-		Body: base.ApiResponse[base.MessageResponse]{
+	status := h.environmentService.EnvironmentSyncStatus(input.ID)
+	if status == nil {
+		return nil, huma.Error404NotFound("environment has not been synced since the manager started")
+	}
+
+	return &GetEnvironmentSyncStatusOutput{
+		Body: base.ApiResponse[environment.ConfigSyncStatus]{
 			Success: true,
-			Data: base.MessageResponse{
-				Message: "Environment synced successfully",
-			},
+			Data:    *status,
 		},
 	}, nil
 }
 
+func environmentSyncError(err error) error {
+	if errors.Is(err, services.ErrEnvironmentSyncUnsupported) {
+		return huma.Error400BadRequest(err.Error())
+	}
+	return huma.Error404NotFound((&common.EnvironmentNotFoundError{}).Error())
+}
+
 // ============================================================================
 // Helper Methods
 // ============================================================================
@@ -821,6 +1019,38 @@ func (h *EnvironmentHandler) buildUpdateMap(req *environment.Update, isLocalEnv
 	return updates
 }
 
+func (h *EnvironmentHandler) applySSHUpdates(req *environment.Update, updates map[string]any, isLocalEnv bool) error {
+	if isLocalEnv {
+		return nil
+	}
+
+	if req.ApiUrl != nil && services.IsSSHEnvironmentURL(*req.ApiUrl) {
+		if err := services.ValidateSSHEnvironmentURL(*req.ApiUrl); err != nil {
+			return huma.Error400BadRequest(err.Error())
+		}
+	}
+	if req.SSHKey != nil {
+		sshKey, err := services.EncryptSSHKey(*req.SSHKey)
+		if err != nil {
+			return huma.Error400BadRequest(err.Error())
+		}
+		updates["ssh_key"] = sshKey
+	}
+	if req.SSHHostKeyVerification != nil && *req.SSHHostKeyVerification != "" {
+		updates["ssh_host_key_verification"] = *req.SSHHostKeyVerification
+	}
+	if req.SSHHostKey != nil {
+		// An empty host key clears the pin so accept_new can learn it again
+		if *req.SSHHostKey == "" {
+			updates["ssh_host_key"] = nil
+		} else {
+			updates["ssh_host_key"] = *req.SSHHostKey
+		}
+	}
+
+	return nil
+}
+
 func (h *EnvironmentHandler) handleEnvironmentPairing(ctx context.Context, environmentID string, req *environment.Update, updates map[string]any, isLocalEnv bool) (bool, error) {
 	pairingSucceeded := false
 
@@ -867,28 +1097,14 @@ func (h *EnvironmentHandler) triggerPostUpdateTasks(ctx context.Context, environ
 	}
 }
 
-func (h *EnvironmentHandler) triggerEnvironmentResourceSync(ctx context.Context, environmentID string, environmentName string, reason string) { //nolint:contextcheck // intentionally spawns background tasks
-	detachedCtx := context.WithoutCancel(ctx)
-
-	go func(syncCtx context.Context, envID string, envName string, syncReason string) {
-		if err := h.environmentService.SyncRegistriesToEnvironment(syncCtx, envID); err != nil {
-			slog.WarnContext(syncCtx, "Failed to sync registries to environment",
-				"environmentID", envID,
-				"environmentName", envName,
-				"reason", syncReason,
-				"error", err.Error())
-		}
-	}(detachedCtx, environmentID, environmentName, reason)
-
-	go func(syncCtx context.Context, envID string, envName string, syncReason string) {
-		if err := h.environmentService.SyncRepositoriesToEnvironment(syncCtx, envID); err != nil {
-			slog.WarnContext(syncCtx, "Failed to sync git repositories to environment",
-				"environmentID", envID,
-				"environmentName", envName,
-				"reason", syncReason,
-				"error", err.Error())
-		}
-	}(detachedCtx, environmentID, environmentName, reason)
+func (h *EnvironmentHandler) triggerEnvironmentResourceSync(ctx context.Context, environmentID string, environmentName string, reason string) {
+	if _, err := h.environmentService.StartEnvironmentSync(ctx, environmentID, reason); err != nil {
+		slog.WarnContext(ctx, "Failed to start environment sync",
+			"environmentID", environmentID,
+			"environmentName", environmentName,
+			"reason", reason,
+			"error", err.Error())
+	}
 }
 
 // PairEnvironment handles agent pairing callback with API key.
@@ -917,39 +1133,132 @@ func (h *EnvironmentHandler) PairEnvironment(ctx context.Context, input *PairEnv
 		return nil, huma.Error404NotFound("Environment not found")
 	}
 
+	// Agents send their capabilities every time they start, so record them
+	// even when the environment was paired before.
+	if err := h.environmentService.SaveCapabilities(ctx, *envID, input.Body); err != nil {
+		slog.WarnContext(ctx, "Failed to save agent capabilities", "environmentID", *envID, "error", err.Error())
+	}
+
 	if env.Status != string(models.EnvironmentStatusPending) {
 		return nil, huma.Error400BadRequest("Environment is not in pending status")
 	}
 
-	updates := map[string]any{
-		"status": string(models.EnvironmentStatusOnline),
+	if err := h.environmentService.RequestPairing(ctx, *envID, input.XAgentFingerprint, input.OriginIP); err != nil {
+		if errors.Is(err, services.ErrPairingFingerprintMismatch) {
+			slog.WarnContext(ctx, "Refused pairing request from a second agent", "environmentID", *envID, "fingerprint", input.XAgentFingerprint, "originIP", input.OriginIP)
+			return nil, huma.Error409Conflict(err.Error())
+		}
+		slog.ErrorContext(ctx, "Failed to queue pairing request", "environmentID", *envID, "error", err.Error())
+		return nil, huma.Error500InternalServerError("Failed to request pairing")
 	}
-	_, err = h.environmentService.UpdateEnvironment(ctx, *envID, updates, nil, nil)
+
+	slog.InfoContext(ctx, "Environment pairing request waits for approval", "environmentID", *envID, "environmentName", env.Name, "fingerprint", input.XAgentFingerprint, "originIP", input.OriginIP)
+
+	return &PairEnvironmentOutput{
+		Status: http.StatusAccepted,
+		Body: base.ApiResponse[base.MessageResponse]{
+			Success: true,
+			Data: base.MessageResponse{
+				Message: "Pairing request is waiting for approval",
+			},
+		},
+	}, nil
+}
+
+// ApproveEnvironmentPairing approves the pairing request an agent sent.
+func (h *EnvironmentHandler) ApproveEnvironmentPairing(ctx context.Context, input *ApproveEnvironmentPairingInput) (*ApproveEnvironmentPairingOutput, error) {
+	if h.environmentService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	user, _ := humamw.GetCurrentUserFromContext(ctx)
+	var userID, username *string
+	if user != nil {
+		userID = new(user.ID)
+		username = new(user.Username)
+	}
+
+	env, err := h.environmentService.ApprovePairing(ctx, input.ID, userID, username)
 	if err != nil {
-		slog.ErrorContext(ctx, "Failed to update environment status", "environmentID", *envID, "error", err.Error())
-		return nil, huma.Error500InternalServerError("Failed to complete pairing")
+		if errors.Is(err, services.ErrPairingRequestNotFound) {
+			return nil, huma.Error409Conflict(err.Error())
+		}
+		return nil, huma.Error404NotFound((&common.EnvironmentNotFoundError{}).Error())
 	}
 
-	slog.InfoContext(ctx, "Environment pairing completed", "environmentID", *envID, "environmentName", env.Name)
-	h.triggerEnvironmentResourceSync(ctx, *envID, env.Name, "environment pairing")
+	slog.InfoContext(ctx, "Environment pairing approved", "environmentID", env.ID, "environmentName", env.Name)
+	h.triggerEnvironmentResourceSync(ctx, env.ID, env.Name, "environment pairing")
 
-	return &PairEnvironmentOutput{
+	out, mapErr := mapper.MapOne[*models.Environment, environment.Environment](env)
+	if mapErr != nil {
+		return nil, huma.Error500InternalServerError((&common.EnvironmentMappingError{Err: mapErr}).Error())
+	}
+	h.applyEdgeRuntimeState(&out)
+
+	return &ApproveEnvironmentPairingOutput{
+		Body: base.ApiResponse[environment.Environment]{
+			Success: true,
+			Data:    out,
+		},
+	}, nil
+}
+
+// RejectEnvironmentPairing discards the pairing request an agent sent.
+func (h *EnvironmentHandler) RejectEnvironmentPairing(ctx context.Context, input *RejectEnvironmentPairingInput) (*RejectEnvironmentPairingOutput, error) {
+	if h.environmentService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	user, _ := humamw.GetCurrentUserFromContext(ctx)
+	var userID, username *string
+	if user != nil {
+		userID = new(user.ID)
+		username = new(user.Username)
+	}
+
+	if err := h.environmentService.RejectPairing(ctx, input.ID, userID, username); err != nil {
+		if errors.Is(err, services.ErrPairingRequestNotFound) {
+			return nil, huma.Error409Conflict(err.Error())
+		}
+		return nil, huma.Error404NotFound((&common.EnvironmentNotFoundError{}).Error())
+	}
+
+	return &RejectEnvironmentPairingOutput{
 		Body: base.ApiResponse[base.MessageResponse]{
 			Success: true,
 			Data: base.MessageResponse{
-				Message: "Environment pairing completed successfully",
+				Message: "Pairing request rejected",
 			},
 		},
 	}, nil
 }
 
+// GetCapabilities returns what this instance serves when a manager proxies to it.
+func (h *EnvironmentHandler) GetCapabilities(ctx context.Context, _ *struct{}) (*GetCapabilitiesOutput, error) {
+	if h.environmentService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	return &GetCapabilitiesOutput{
+		Body: *h.environmentService.LocalCapabilities(ctx),
+	}, nil
+}
+
 // GetDeploymentSnippets returns deployment snippets for an environment.
 func (h *EnvironmentHandler) GetDeploymentSnippets(ctx context.Context, input *GetDeploymentSnippetsInput) (*GetDeploymentSnippetsOutput, error) {
 	if h.environmentService == nil {
 		return nil, huma.Error500InternalServerError("service not available")
 	}
 
-	if err := checkAdmin(ctx); err != nil {
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
 		return nil, err
 	}
 
@@ -966,13 +1275,29 @@ func (h *EnvironmentHandler) GetDeploymentSnippets(ctx context.Context, input *G
 		return nil, huma.Error400BadRequest("Environment is missing access token")
 	}
 
+	opts := services.DeploymentOptions{
+		ImageTag:     input.ImageTag,
+		Port:         input.Port,
+		DataVolume:   input.DataVolume,
+		ProjectsPath: input.ProjectsPath,
+		DockerSocket: input.DockerSocket,
+		Network:      input.Network,
+		TLS:          input.TLS,
+		TLSCertFile:  input.TLSCertFile,
+		TLSKeyFile:   input.TLSKeyFile,
+		ExtraEnv:     input.Env,
+	}
+
 	// Generate snippets with API key
 	// Use edge snippets for edge environments
 	var snippets *services.DeploymentSnippets
 	if env.IsEdge {
-		snippets, err = h.environmentService.GenerateEdgeDeploymentSnippets(ctx, env.ID, h.cfg.GetAppURL(), *env.AccessToken)
+		snippets, err = h.environmentService.GenerateEdgeDeploymentSnippets(ctx, env.ID, h.cfg.GetAppURL(), *env.AccessToken, opts)
 	} else {
-		snippets, err = h.environmentService.GenerateDeploymentSnippets(ctx, env.ID, h.cfg.GetAppURL(), *env.AccessToken)
+		snippets, err = h.environmentService.GenerateDeploymentSnippets(ctx, env.ID, h.cfg.GetAppURL(), *env.AccessToken, opts)
+	}
+	if errors.Is(err, services.ErrInvalidDeploymentOptions) {
+		return nil, huma.Error400BadRequest(err.Error())
 	}
 	if err != nil {
 		slog.ErrorContext(ctx, "Failed to generate deployment snippets", "environmentID", input.ID, "error", err.Error())
@@ -985,6 +1310,7 @@ func (h *EnvironmentHandler) GetDeploymentSnippets(ctx context.Context, input *G
 			Data: DeploymentSnippet{
 				DockerRun:     snippets.DockerRun,
 				DockerCompose: snippets.DockerCompose,
+				SystemdUnit:   snippets.SystemdUnit,
 			},
 		},
 	}, nil
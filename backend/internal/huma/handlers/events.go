@@ -2,12 +2,16 @@ package handlers
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/danielgtaylor/huma/v2"
 	"github.com/getarcaneapp/arcane/backend/internal/common"
 	"github.com/getarcaneapp/arcane/backend/internal/services"
 	"github.com/getarcaneapp/arcane/types/base"
 	"github.com/getarcaneapp/arcane/types/event"
+	"github.com/getarcaneapp/arcane/types/rbac"
 )
 
 // EventHandler handles event management endpoints.
@@ -73,6 +77,25 @@ type DeleteEventOutput struct {
 	Body base.ApiResponse[base.MessageResponse]
 }
 
+type ExportEventsInput struct {
+	Format        string `query:"format" default:"jsonl" enum:"jsonl,csv" doc:"Export format"`
+	From          string `query:"from" doc:"Inclusive start of the range (RFC 3339)"`
+	To            string `query:"to" doc:"Exclusive end of the range (RFC 3339)"`
+	EnvironmentID string `query:"environmentId" doc:"Only export events for this environment"`
+}
+
+type ExportEventsOutput struct {
+	ContentType        string `header:"Content-Type"`
+	ContentDisposition string `header:"Content-Disposition"`
+	ExportSHA256       string `header:"X-Arcane-Export-Sha256" doc:"Hex SHA-256 digest of the response body"`
+	ExportCount        int    `header:"X-Arcane-Export-Count" doc:"Number of events in the export"`
+	Body               []byte
+}
+
+type TestEventForwardingOutput struct {
+	Body base.ApiResponse[base.MessageResponse]
+}
+
 // ============================================================================
 // Registration
 // ============================================================================
@@ -135,6 +158,32 @@ func RegisterEvents(api huma.API, eventService *services.EventService, apiKeySvc
 			{"ApiKeyAuth": {}},
 		},
 	}, h.GetEventsByEnvironment)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "exportEvents",
+		Method:      "GET",
+		Path:        "/events/export",
+		Summary:     "Export events",
+		Description: "Export events in a date range as JSONL or CSV with a SHA-256 integrity digest",
+		Tags:        []string{"Events"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.ExportEvents)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "testEventForwarding",
+		Method:      "POST",
+		Path:        "/events/forwarding/test",
+		Summary:     "Test audit forwarding",
+		Description: "Send a test event to the configured syslog or HTTP collector",
+		Tags:        []string{"Events"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.TestEventForwarding)
 }
 
 // ============================================================================
@@ -147,7 +196,7 @@ func (h *EventHandler) ListEvents(ctx context.Context, input *ListEventsInput) (
 		return nil, huma.Error500InternalServerError("service not available")
 	}
 
-	if err := checkAdmin(ctx); err != nil {
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
 		return nil, err
 	}
 
@@ -186,7 +235,7 @@ func (h *EventHandler) GetEventsByEnvironment(ctx context.Context, input *GetEve
 		return nil, huma.Error500InternalServerError("service not available")
 	}
 
-	if err := checkAdmin(ctx); err != nil {
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
 		return nil, err
 	}
 
@@ -240,7 +289,7 @@ func (h *EventHandler) CreateEvent(ctx context.Context, input *CreateEventInput)
 		}
 	}
 
-	if err := checkAdmin(ctx); err != nil {
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
 		return nil, err
 	}
 
@@ -263,7 +312,7 @@ func (h *EventHandler) DeleteEvent(ctx context.Context, input *DeleteEventInput)
 		return nil, huma.Error500InternalServerError("service not available")
 	}
 
-	if err := checkAdmin(ctx); err != nil {
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
 		return nil, err
 	}
 
@@ -284,3 +333,78 @@ func (h *EventHandler) DeleteEvent(ctx context.Context, input *DeleteEventInput)
 		},
 	}, nil
 }
+
+// ExportEvents exports events for a date range.
+func (h *EventHandler) ExportEvents(ctx context.Context, input *ExportEventsInput) (*ExportEventsOutput, error) {
+	if h.eventService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	from, err := parseOptionalRFC3339(input.From)
+	if err != nil {
+		return nil, huma.Error400BadRequest("invalid from: " + err.Error())
+	}
+	to, err := parseOptionalRFC3339(input.To)
+	if err != nil {
+		return nil, huma.Error400BadRequest("invalid to: " + err.Error())
+	}
+
+	export, err := h.eventService.ExportEvents(ctx, input.Format, from, to, input.EnvironmentID)
+	if err != nil {
+		return nil, huma.Error400BadRequest((&common.EventExportError{Err: err}).Error())
+	}
+
+	contentType := "application/x-ndjson"
+	if export.Manifest.Format == event.ExportFormatCSV {
+		contentType = "text/csv"
+	}
+	filename := fmt.Sprintf("arcane-events-%s.%s", export.Manifest.GeneratedAt.Format("20060102T150405Z"), export.Manifest.Format)
+
+	return &ExportEventsOutput{
+		ContentType:        contentType,
+		ContentDisposition: "attachment; filename=" + filename,
+		ExportSHA256:       export.Manifest.SHA256,
+		ExportCount:        export.Manifest.Count,
+		Body:               export.Data,
+	}, nil
+}
+
+// TestEventForwarding sends a test event through the configured SIEM forwarder.
+func (h *EventHandler) TestEventForwarding(ctx context.Context, input *struct{}) (*TestEventForwardingOutput, error) {
+	if h.eventService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if err := h.eventService.TestSIEMForwarding(ctx); err != nil {
+		return nil, huma.Error502BadGateway((&common.EventForwardingError{Err: err}).Error())
+	}
+
+	return &TestEventForwardingOutput{
+		Body: base.ApiResponse[base.MessageResponse]{
+			Success: true,
+			Data: base.MessageResponse{
+				Message: "Test event forwarded successfully",
+			},
+		},
+	}, nil
+}
+
+func parseOptionalRFC3339(value string) (*time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
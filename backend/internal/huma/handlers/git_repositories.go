@@ -11,6 +11,7 @@ import (
 	"github.com/getarcaneapp/arcane/backend/internal/utils/mapper"
 	"github.com/getarcaneapp/arcane/types/base"
 	"github.com/getarcaneapp/arcane/types/gitops"
+	"github.com/getarcaneapp/arcane/types/rbac"
 )
 
 // GitRepositoryHandler handles git repository management endpoints.
@@ -453,7 +454,7 @@ func (h *GitRepositoryHandler) SyncRepositories(ctx context.Context, input *Sync
 		return nil, huma.Error500InternalServerError("service not available")
 	}
 
-	if err := checkAdmin(ctx); err != nil {
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
 		return nil, err
 	}
 
@@ -89,6 +89,15 @@ type PerformSyncOutput struct {
 	Body base.ApiResponse[gitops.SyncResult]
 }
 
+type ProposeTagBumpsInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	SyncID        string `path:"syncId" doc:"Sync ID"`
+}
+
+type ProposeTagBumpsOutput struct {
+	Body base.ApiResponse[gitops.TagBumpResult]
+}
+
 type GetSyncStatusInput struct {
 	EnvironmentID string `path:"id" doc:"Environment ID"`
 	SyncID        string `path:"syncId" doc:"Sync ID"`
@@ -216,6 +225,19 @@ func RegisterGitOpsSyncs(api huma.API, syncService *services.GitOpsSyncService)
 		},
 	}, h.PerformSync)
 
+	huma.Register(api, huma.Operation{
+		OperationID: "proposeGitOpsTagBumps",
+		Method:      "POST",
+		Path:        "/environments/{id}/gitops-syncs/{syncId}/tag-bump",
+		Summary:     "Propose image tag bumps",
+		Description: "Check the sync's compose file for newer image tags and commit them or open a pull request, depending on the sync's tag bump mode",
+		Tags:        []string{"GitOps Syncs"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.ProposeTagBumps)
+
 	huma.Register(api, huma.Operation{
 		OperationID: "getGitOpsSyncStatus",
 		Method:      "GET",
@@ -436,6 +458,32 @@ func (h *GitOpsSyncHandler) PerformSync(ctx context.Context, input *PerformSyncI
 	}, nil
 }
 
+// ProposeTagBumps checks a sync for newer image tags and pushes them to its
+// repository.
+func (h *GitOpsSyncHandler) ProposeTagBumps(ctx context.Context, input *ProposeTagBumpsInput) (*ProposeTagBumpsOutput, error) {
+	if h.syncService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	actor := models.User{}
+	if currentUser, exists := humamw.GetCurrentUserFromContext(ctx); exists && currentUser != nil {
+		actor = *currentUser
+	}
+
+	result, err := h.syncService.ProposeTagBumps(ctx, input.EnvironmentID, input.SyncID, actor)
+	if err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.GitOpsTagBumpError{Err: err}).Error())
+	}
+
+	return &ProposeTagBumpsOutput{
+		Body: base.ApiResponse[gitops.TagBumpResult]{
+			Success: result.Status != gitops.TagBumpStatusFailed,
+			Data:    *result,
+		},
+	}, nil
+}
+
 // GetStatus returns the current status of a GitOps sync.
 func (h *GitOpsSyncHandler) GetStatus(ctx context.Context, input *GetSyncStatusInput) (*GetSyncStatusOutput, error) {
 	if h.syncService == nil {
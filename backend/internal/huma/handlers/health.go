@@ -5,6 +5,8 @@ import (
 	"net/http"
 
 	"github.com/danielgtaylor/huma/v2"
+	"github.com/getarcaneapp/arcane/backend/internal/services"
+	"github.com/getarcaneapp/arcane/types/rbac"
 	"github.com/getarcaneapp/arcane/types/system"
 )
 
@@ -13,8 +15,19 @@ type HealthOutput struct {
 	Body system.HealthResponse
 }
 
+// ReadinessOutput is the response for the readiness probe
+type ReadinessOutput struct {
+	Status int
+	Body   system.ReadinessResponse
+}
+
+// EnvironmentsHealthOutput is the response for the remote environment probe
+type EnvironmentsHealthOutput struct {
+	Body system.EnvironmentsHealthResponse
+}
+
 // RegisterHealth registers health check routes using Huma.
-func RegisterHealth(api huma.API) {
+func RegisterHealth(api huma.API, healthService *services.HealthService) {
 	huma.Register(api, huma.Operation{
 		OperationID: "health-check",
 		Method:      http.MethodGet,
@@ -40,4 +53,69 @@ func RegisterHealth(api huma.API) {
 	}, func(ctx context.Context, input *struct{}) (*struct{}, error) {
 		return nil, nil
 	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "liveness-check",
+		Method:      http.MethodGet,
+		Path:        "/healthz",
+		Summary:     "Liveness probe",
+		Description: "Report that the API process is running without checking dependencies",
+		Tags:        []string{"Health"},
+	}, func(ctx context.Context, input *struct{}) (*HealthOutput, error) {
+		return &HealthOutput{
+			Body: system.HealthResponse{
+				Status: "UP",
+			},
+		}, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "readiness-check",
+		Method:      http.MethodGet,
+		Path:        "/readyz",
+		Summary:     "Readiness probe",
+		Description: "Check database connectivity, Docker socket reachability and projects directory write access. Returns 503 when a required component is down",
+		Tags:        []string{"Health"},
+	}, func(ctx context.Context, input *struct{}) (*ReadinessOutput, error) {
+		if healthService == nil {
+			return nil, huma.Error500InternalServerError("service not available")
+		}
+
+		readiness := healthService.Readiness(ctx)
+		status := http.StatusOK
+		if readiness.Status != "UP" {
+			status = http.StatusServiceUnavailable
+		}
+
+		return &ReadinessOutput{
+			Status: status,
+			Body:   readiness,
+		}, nil
+	})
+
+	huma.Register(api, huma.Operation{
+		OperationID: "environments-health-check",
+		Method:      http.MethodGet,
+		Path:        "/health/environments",
+		Summary:     "Remote environment probe",
+		Description: "Test the connection to every enabled remote environment agent",
+		Tags:        []string{"Health"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, func(ctx context.Context, input *struct{}) (*EnvironmentsHealthOutput, error) {
+		if healthService == nil {
+			return nil, huma.Error500InternalServerError("service not available")
+		}
+		if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+			return nil, err
+		}
+
+		return &EnvironmentsHealthOutput{
+			Body: system.EnvironmentsHealthResponse{
+				Components: healthService.CheckEnvironments(ctx),
+			},
+		}, nil
+	})
 }
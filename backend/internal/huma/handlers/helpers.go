@@ -6,12 +6,24 @@ import (
 	"github.com/danielgtaylor/huma/v2"
 	humamw "github.com/getarcaneapp/arcane/backend/internal/huma/middleware"
 	"github.com/getarcaneapp/arcane/backend/internal/utils/pagination"
+	"github.com/getarcaneapp/arcane/types/rbac"
 )
 
-// checkAdmin checks if the current user is an admin and returns a 403 error if not.
-func checkAdmin(ctx context.Context) error {
-	if !humamw.IsAdminFromContext(ctx) {
-		return huma.Error403Forbidden("admin access required")
+// requireRole checks that the current user's effective role for the request
+// scope satisfies the given role and returns a 403 error if not.
+// The role is resolved by the RBAC middleware; when it has not run, only
+// global admins are treated as privileged.
+func requireRole(ctx context.Context, role string) error {
+	granted, ok := humamw.GetUserRoleFromContext(ctx)
+	if !ok {
+		if humamw.IsAdminFromContext(ctx) {
+			granted = rbac.RoleAdmin
+		} else {
+			granted = rbac.RoleViewer
+		}
+	}
+	if !rbac.RoleSatisfies(granted, role) {
+		return huma.Error403Forbidden(role + " access required")
 	}
 	return nil
 }
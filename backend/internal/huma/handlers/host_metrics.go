@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/getarcaneapp/arcane/backend/internal/services"
+	"github.com/getarcaneapp/arcane/types/base"
+	"github.com/getarcaneapp/arcane/types/system"
+)
+
+// HostMetricsHandler provides Huma-based host metrics endpoints.
+type HostMetricsHandler struct {
+	hostMetricsService *services.HostMetricsService
+}
+
+// --- Huma Input/Output Wrappers ---
+
+type GetHostMetricsInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+}
+
+type GetHostMetricsOutput struct {
+	Body base.ApiResponse[system.HostMetrics]
+}
+
+type GetHostMetricsHistoryInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	Hours         int    `query:"hours" default:"24" minimum:"1" maximum:"744" doc:"How many hours of history to return"`
+}
+
+type GetHostMetricsHistoryOutput struct {
+	Body base.ApiResponse[[]system.HostMetrics]
+}
+
+type ListHostMetricsInput struct{}
+
+type ListHostMetricsOutput struct {
+	Body base.ApiResponse[[]system.EnvironmentHostMetrics]
+}
+
+// RegisterHostMetrics registers host metrics routes using Huma.
+func RegisterHostMetrics(api huma.API, hostMetricsService *services.HostMetricsService) {
+	h := &HostMetricsHandler{
+		hostMetricsService: hostMetricsService,
+	}
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-host-metrics",
+		Method:      http.MethodGet,
+		Path:        "/environments/{id}/system/host-metrics",
+		Summary:     "Get live host metrics",
+		Description: "Take a live sample of CPU, memory, disk and load on the environment's host",
+		Tags:        []string{"System"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.GetHostMetrics)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-host-metrics-history",
+		Method:      http.MethodGet,
+		Path:        "/environments/{id}/host-metrics",
+		Summary:     "Get host metrics history",
+		Description: "Get the host metrics collected by the manager for an environment over time",
+		Tags:        []string{"System"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.GetHostMetricsHistory)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "list-host-metrics",
+		Method:      http.MethodGet,
+		Path:        "/host-metrics",
+		Summary:     "List latest host metrics",
+		Description: "Get the latest host metrics sample of every enabled environment",
+		Tags:        []string{"System"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.ListHostMetrics)
+}
+
+// GetHostMetrics returns a live sample of this host's resource usage.
+func (h *HostMetricsHandler) GetHostMetrics(ctx context.Context, input *GetHostMetricsInput) (*GetHostMetricsOutput, error) {
+	if h.hostMetricsService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	sample, err := h.hostMetricsService.CollectLocal(ctx)
+	if err != nil {
+		return nil, huma.Error500InternalServerError(err.Error())
+	}
+	sample.EnvironmentID = input.EnvironmentID
+
+	return &GetHostMetricsOutput{
+		Body: base.ApiResponse[system.HostMetrics]{
+			Success: true,
+			Data:    *sample,
+		},
+	}, nil
+}
+
+// GetHostMetricsHistory returns stored samples for an environment.
+func (h *HostMetricsHandler) GetHostMetricsHistory(ctx context.Context, input *GetHostMetricsHistoryInput) (*GetHostMetricsHistoryOutput, error) {
+	if h.hostMetricsService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	history, err := h.hostMetricsService.GetHistory(ctx, input.EnvironmentID, input.Hours)
+	if err != nil {
+		return nil, huma.Error500InternalServerError(err.Error())
+	}
+
+	return &GetHostMetricsHistoryOutput{
+		Body: base.ApiResponse[[]system.HostMetrics]{
+			Success: true,
+			Data:    history,
+		},
+	}, nil
+}
+
+// ListHostMetrics returns the latest sample of every environment.
+func (h *HostMetricsHandler) ListHostMetrics(ctx context.Context, _ *ListHostMetricsInput) (*ListHostMetricsOutput, error) {
+	if h.hostMetricsService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	latest, err := h.hostMetricsService.ListLatest(ctx)
+	if err != nil {
+		return nil, huma.Error500InternalServerError(err.Error())
+	}
+
+	return &ListHostMetricsOutput{
+		Body: base.ApiResponse[[]system.EnvironmentHostMetrics]{
+			Success: true,
+			Data:    latest,
+		},
+	}, nil
+}
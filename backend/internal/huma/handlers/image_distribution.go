@@ -0,0 +1,130 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/getarcaneapp/arcane/backend/internal/common"
+	humamw "github.com/getarcaneapp/arcane/backend/internal/huma/middleware"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/services"
+	"github.com/getarcaneapp/arcane/types/base"
+	"github.com/getarcaneapp/arcane/types/image"
+	"github.com/getarcaneapp/arcane/types/rbac"
+)
+
+// ImageDistributionHandler provides the endpoints that copy images between
+// environments and registries.
+type ImageDistributionHandler struct {
+	imageDistributionService *services.ImageDistributionService
+}
+
+// --- Huma Input/Output Wrappers ---
+
+type DistributeImageInput struct {
+	Body image.DistributeRequest
+}
+
+type DistributeImageOutput struct {
+	Body base.ApiResponse[image.DistributeResult]
+}
+
+type PromoteImageInput struct {
+	Body image.PromoteRequest
+}
+
+type PromoteImageOutput struct {
+	Body base.ApiResponse[image.PromoteResult]
+}
+
+// RegisterImageDistribution registers the image distribution routes using Huma.
+func RegisterImageDistribution(api huma.API, imageDistributionService *services.ImageDistributionService) {
+	h := &ImageDistributionHandler{
+		imageDistributionService: imageDistributionService,
+	}
+
+	huma.Register(api, huma.Operation{
+		OperationID: "distribute-image",
+		Method:      http.MethodPost,
+		Path:        "/images/distribute",
+		Summary:     "Distribute an image",
+		Description: "Copy an image from the local or an SSH environment to other environments, streamed directly or through its registry",
+		Tags:        []string{"Images"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.DistributeImage)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "promote-image",
+		Method:      http.MethodPost,
+		Path:        "/images/promote",
+		Summary:     "Promote an image",
+		Description: "Pull an image from its registry and push it, retagged, to a configured registry, verifying the pushed digest",
+		Tags:        []string{"Images"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.PromoteImage)
+}
+
+// DistributeImage copies an image to other environments.
+func (h *ImageDistributionHandler) DistributeImage(ctx context.Context, input *DistributeImageInput) (*DistributeImageOutput, error) {
+	if h.imageDistributionService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	user, exists := humamw.GetCurrentUserFromContext(ctx)
+	if !exists {
+		return nil, huma.Error401Unauthorized((&common.NotAuthenticatedError{}).Error())
+	}
+
+	result, err := h.imageDistributionService.DistributeImage(ctx, input.Body, *user)
+	if err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.ImageDistributionError{Err: err}).Error())
+	}
+
+	return &DistributeImageOutput{
+		Body: base.ApiResponse[image.DistributeResult]{
+			Success: true,
+			Data:    *result,
+		},
+	}, nil
+}
+
+// PromoteImage pushes an image to another registry.
+func (h *ImageDistributionHandler) PromoteImage(ctx context.Context, input *PromoteImageInput) (*PromoteImageOutput, error) {
+	if h.imageDistributionService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	user, exists := humamw.GetCurrentUserFromContext(ctx)
+	if !exists {
+		return nil, huma.Error401Unauthorized((&common.NotAuthenticatedError{}).Error())
+	}
+
+	result, err := h.imageDistributionService.PromoteImage(ctx, input.Body, *user)
+	if err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.ImagePromotionError{Err: err}).Error())
+	}
+
+	return &PromoteImageOutput{
+		Body: base.ApiResponse[image.PromoteResult]{
+			Success: true,
+			Data:    *result,
+		},
+	}, nil
+}
@@ -6,9 +6,12 @@ import (
 
 	"github.com/danielgtaylor/huma/v2"
 	"github.com/getarcaneapp/arcane/backend/internal/common"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
 	"github.com/getarcaneapp/arcane/backend/internal/services"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/pagination"
 	"github.com/getarcaneapp/arcane/types/base"
 	"github.com/getarcaneapp/arcane/types/imageupdate"
+	"github.com/getarcaneapp/arcane/types/rbac"
 )
 
 type ImageUpdateHandler struct {
@@ -59,6 +62,52 @@ type GetUpdateSummaryOutput struct {
 	Body base.ApiResponse[imageupdate.Summary]
 }
 
+type ListRemoteTagsInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	ImageRef      string `query:"imageRef" doc:"Image reference; its tag is ignored"`
+	Search        string `query:"search" doc:"Only return tags containing this text"`
+	Sort          string `query:"sort" default:"version" enum:"version,tag" doc:"Sort by semantic version or tag name"`
+	Order         string `query:"order" default:"desc" doc:"Sort direction (asc or desc)"`
+	Start         int    `query:"start" default:"0" doc:"Start index for pagination"`
+	Limit         int    `query:"limit" default:"50" doc:"Number of items per page"`
+}
+
+type RemoteTagPaginatedResponse struct {
+	Success    bool                    `json:"success"`
+	Data       []imageupdate.RemoteTag `json:"data"`
+	Pagination base.PaginationResponse `json:"pagination"`
+}
+
+type ListRemoteTagsOutput struct {
+	Body RemoteTagPaginatedResponse
+}
+
+type ListImageUpdateSnoozesInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+}
+
+type ListImageUpdateSnoozesOutput struct {
+	Body base.ApiResponse[[]imageupdate.Snooze]
+}
+
+type SnoozeImageUpdatesInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	Body          imageupdate.SnoozeRequest
+}
+
+type SnoozeImageUpdatesOutput struct {
+	Body base.ApiResponse[imageupdate.Snooze]
+}
+
+type UnsnoozeImageUpdatesInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	ImageRef      string `query:"imageRef" doc:"Repository and tag to unsnooze"`
+}
+
+type UnsnoozeImageUpdatesOutput struct {
+	Body base.ApiResponse[base.MessageResponse]
+}
+
 // RegisterImageUpdates registers image update endpoints.
 func RegisterImageUpdates(api huma.API, imageUpdateSvc *services.ImageUpdateService) {
 	h := &ImageUpdateHandler{imageUpdateService: imageUpdateSvc}
@@ -116,6 +165,46 @@ func RegisterImageUpdates(api huma.API, imageUpdateSvc *services.ImageUpdateServ
 		Tags:        []string{"Image Updates"},
 		Security:    []map[string][]string{{"BearerAuth": {}}, {"ApiKeyAuth": {}}},
 	}, h.GetUpdateSummary)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "list-remote-tags",
+		Method:      http.MethodGet,
+		Path:        "/environments/{id}/image-updates/tags",
+		Summary:     "List registry tags",
+		Description: "List the tags available in the registry for an image's repository, newest version first",
+		Tags:        []string{"Image Updates"},
+		Security:    []map[string][]string{{"BearerAuth": {}}, {"ApiKeyAuth": {}}},
+	}, h.ListRemoteTags)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "list-image-update-snoozes",
+		Method:      http.MethodGet,
+		Path:        "/environments/{id}/image-updates/snoozes",
+		Summary:     "List snoozed image updates",
+		Description: "List the repositories and tags whose update notifications and automatic updates are snoozed",
+		Tags:        []string{"Image Updates"},
+		Security:    []map[string][]string{{"BearerAuth": {}}, {"ApiKeyAuth": {}}},
+	}, h.ListSnoozes)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "snooze-image-updates",
+		Method:      http.MethodPut,
+		Path:        "/environments/{id}/image-updates/snoozes",
+		Summary:     "Snooze image updates",
+		Description: "Hold back update notifications and automatic updates for a repository and tag until a chosen time",
+		Tags:        []string{"Image Updates"},
+		Security:    []map[string][]string{{"BearerAuth": {}}, {"ApiKeyAuth": {}}},
+	}, h.Snooze)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "unsnooze-image-updates",
+		Method:      http.MethodDelete,
+		Path:        "/environments/{id}/image-updates/snoozes",
+		Summary:     "Unsnooze image updates",
+		Description: "Resume update notifications and automatic updates for a repository and tag",
+		Tags:        []string{"Image Updates"},
+		Security:    []map[string][]string{{"BearerAuth": {}}, {"ApiKeyAuth": {}}},
+	}, h.Unsnooze)
 }
 
 func (h *ImageUpdateHandler) CheckImageUpdate(ctx context.Context, input *CheckImageUpdateInput) (*CheckImageUpdateOutput, error) {
@@ -205,3 +294,108 @@ func (h *ImageUpdateHandler) GetUpdateSummary(ctx context.Context, input *GetUpd
 		},
 	}, nil
 }
+
+func (h *ImageUpdateHandler) ListRemoteTags(ctx context.Context, input *ListRemoteTagsInput) (*ListRemoteTagsOutput, error) {
+	if input.ImageRef == "" {
+		return nil, huma.Error400BadRequest((&common.ImageRefRequiredError{}).Error())
+	}
+
+	params := pagination.QueryParams{
+		SearchQuery: pagination.SearchQuery{
+			Search: input.Search,
+		},
+		SortParams: pagination.SortParams{
+			Sort:  input.Sort,
+			Order: pagination.SortOrder(input.Order),
+		},
+		PaginationParams: pagination.PaginationParams{
+			Start: input.Start,
+			Limit: input.Limit,
+		},
+	}
+	if params.Limit == 0 {
+		params.Limit = 50
+	}
+
+	tags, paginationResp, err := h.imageUpdateService.ListRemoteTags(ctx, input.ImageRef, params)
+	if err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.RemoteTagListError{Err: err}).Error())
+	}
+	if tags == nil {
+		tags = []imageupdate.RemoteTag{}
+	}
+
+	return &ListRemoteTagsOutput{
+		Body: RemoteTagPaginatedResponse{
+			Success: true,
+			Data:    tags,
+			Pagination: base.PaginationResponse{
+				TotalPages:      paginationResp.TotalPages,
+				TotalItems:      paginationResp.TotalItems,
+				CurrentPage:     paginationResp.CurrentPage,
+				ItemsPerPage:    paginationResp.ItemsPerPage,
+				GrandTotalItems: paginationResp.GrandTotalItems,
+			},
+		},
+	}, nil
+}
+
+func (h *ImageUpdateHandler) ListSnoozes(ctx context.Context, input *ListImageUpdateSnoozesInput) (*ListImageUpdateSnoozesOutput, error) {
+	snoozes, err := h.imageUpdateService.ListSnoozedUpdates(ctx)
+	if err != nil {
+		return nil, huma.Error500InternalServerError((&common.ImageUpdateSnoozeListError{Err: err}).Error())
+	}
+
+	return &ListImageUpdateSnoozesOutput{
+		Body: base.ApiResponse[[]imageupdate.Snooze]{
+			Success: true,
+			Data:    snoozes,
+		},
+	}, nil
+}
+
+func (h *ImageUpdateHandler) Snooze(ctx context.Context, input *SnoozeImageUpdatesInput) (*SnoozeImageUpdatesOutput, error) {
+	if err := requireRole(ctx, rbac.RoleOperator); err != nil {
+		return nil, err
+	}
+	if input.Body.ImageRef == "" {
+		return nil, huma.Error400BadRequest((&common.ImageRefRequiredError{}).Error())
+	}
+
+	snooze, err := h.imageUpdateService.SnoozeUpdates(ctx, input.Body.ImageRef, input.Body.Until)
+	if err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.ImageUpdateSnoozeError{Err: err}).Error())
+	}
+
+	return &SnoozeImageUpdatesOutput{
+		Body: base.ApiResponse[imageupdate.Snooze]{
+			Success: true,
+			Data:    *snooze,
+		},
+	}, nil
+}
+
+func (h *ImageUpdateHandler) Unsnooze(ctx context.Context, input *UnsnoozeImageUpdatesInput) (*UnsnoozeImageUpdatesOutput, error) {
+	if err := requireRole(ctx, rbac.RoleOperator); err != nil {
+		return nil, err
+	}
+	if input.ImageRef == "" {
+		return nil, huma.Error400BadRequest((&common.ImageRefRequiredError{}).Error())
+	}
+
+	if err := h.imageUpdateService.UnsnoozeUpdates(ctx, input.ImageRef); err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.ImageUpdateUnsnoozeError{Err: err}).Error())
+	}
+
+	return &UnsnoozeImageUpdatesOutput{
+		Body: base.ApiResponse[base.MessageResponse]{
+			Success: true,
+			Data: base.MessageResponse{
+				Message: "Image updates unsnoozed",
+			},
+		},
+	}, nil
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/getarcaneapp/arcane/backend/internal/utils/pagination"
 	"github.com/getarcaneapp/arcane/types/base"
 	"github.com/getarcaneapp/arcane/types/image"
+	"github.com/getarcaneapp/arcane/types/rbac"
 	"gorm.io/gorm"
 )
 
@@ -101,6 +102,27 @@ type ListImageBuildsOutput struct {
 	Body ImageBuildPaginatedResponse
 }
 
+type ImagePullPaginatedResponse struct {
+	Success    bool                    `json:"success"`
+	Data       []image.PullRecord      `json:"data"`
+	Pagination base.PaginationResponse `json:"pagination"`
+}
+
+type ListImagePullsInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	Search        string `query:"search" doc:"Search query"`
+	Sort          string `query:"sort" doc:"Column to sort by"`
+	Order         string `query:"order" default:"desc" doc:"Sort direction (asc or desc)"`
+	Start         int    `query:"start" default:"0" doc:"Start index for pagination"`
+	Limit         int    `query:"limit" default:"20" doc:"Number of items per page"`
+	Status        string `query:"status" doc:"Filter by status"`
+	Trigger       string `query:"trigger" doc:"Filter by trigger (user, updater or system)"`
+}
+
+type ListImagePullsOutput struct {
+	Body ImagePullPaginatedResponse
+}
+
 type GetImageBuildInput struct {
 	EnvironmentID string `path:"id" doc:"Environment ID"`
 	BuildID       string `path:"buildId" doc:"Build ID"`
@@ -259,6 +281,19 @@ func RegisterImages(api huma.API, dockerService *services.DockerClientService, i
 		},
 	}, h.GetImageBuild)
 
+	huma.Register(api, huma.Operation{
+		OperationID: "list-image-pulls",
+		Method:      http.MethodGet,
+		Path:        "/environments/{id}/images/pulls",
+		Summary:     "List image pulls",
+		Description: "Get a paginated history of image pulls with who or what started them, their duration and the layers and bytes downloaded",
+		Tags:        []string{"Images"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.ListImagePulls)
+
 	huma.Register(api, huma.Operation{
 		OperationID: "prune-images",
 		Method:      http.MethodPost,
@@ -513,6 +548,48 @@ func (h *ImageHandler) ListImageBuilds(ctx context.Context, input *ListImageBuil
 	}, nil
 }
 
+// ListImagePulls returns a paginated list of image pull history entries.
+func (h *ImageHandler) ListImagePulls(ctx context.Context, input *ListImagePullsInput) (*ListImagePullsOutput, error) {
+	if h.imageService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	params := buildPaginationParams(0, input.Start, input.Limit, input.Sort, input.Order, input.Search)
+	if input.Status != "" {
+		params.Filters["status"] = input.Status
+	}
+	if input.Trigger != "" {
+		params.Filters["trigger"] = input.Trigger
+	}
+
+	pulls, paginationResp, err := h.imageService.ListImagePullsPaginated(ctx, params)
+	if err != nil {
+		return nil, huma.Error500InternalServerError((&common.PullHistoryListError{Err: err}).Error())
+	}
+
+	if pulls == nil {
+		pulls = []image.PullRecord{}
+	}
+
+	return &ListImagePullsOutput{
+		Body: ImagePullPaginatedResponse{
+			Success: true,
+			Data:    pulls,
+			Pagination: base.PaginationResponse{
+				TotalPages:      paginationResp.TotalPages,
+				TotalItems:      paginationResp.TotalItems,
+				CurrentPage:     paginationResp.CurrentPage,
+				ItemsPerPage:    paginationResp.ItemsPerPage,
+				GrandTotalItems: paginationResp.GrandTotalItems,
+			},
+		},
+	}, nil
+}
+
 // GetImageBuild returns a single build history entry.
 func (h *ImageHandler) GetImageBuild(ctx context.Context, input *GetImageBuildInput) (*GetImageBuildOutput, error) {
 	if h.buildService == nil {
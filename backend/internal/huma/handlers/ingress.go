@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/getarcaneapp/arcane/backend/internal/common"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/services"
+	"github.com/getarcaneapp/arcane/types/base"
+	"github.com/getarcaneapp/arcane/types/ingress"
+)
+
+// IngressHandler provides the reverse proxy label endpoints.
+type IngressHandler struct {
+	ingressService *services.IngressService
+}
+
+// --- Huma Input/Output Wrappers ---
+
+type GetIngressRoutesInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+}
+
+type GetIngressRoutesOutput struct {
+	Body base.ApiResponse[ingress.Analysis]
+}
+
+type GenerateIngressLabelsInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	Body          ingress.GenerateLabelsRequest
+}
+
+type GenerateIngressLabelsOutput struct {
+	Body base.ApiResponse[ingress.GeneratedLabels]
+}
+
+// RegisterIngress registers the reverse proxy label routes using Huma.
+func RegisterIngress(api huma.API, ingressService *services.IngressService) {
+	h := &IngressHandler{
+		ingressService: ingressService,
+	}
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-ingress-routes",
+		Method:      http.MethodGet,
+		Path:        "/environments/{id}/ingress/routes",
+		Summary:     "Get reverse proxy routes",
+		Description: "Get the Traefik and Caddy routes declared by running containers and the conflicts between them",
+		Tags:        []string{"Containers"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.GetRoutes)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "generate-ingress-labels",
+		Method:      http.MethodPost,
+		Path:        "/environments/{id}/ingress/labels",
+		Summary:     "Generate reverse proxy labels",
+		Description: "Generate Traefik or Caddy labels that route a domain to a service port, and report conflicts with running containers",
+		Tags:        []string{"Containers"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.GenerateLabels)
+}
+
+// GetRoutes returns the reverse proxy routes of running containers.
+func (h *IngressHandler) GetRoutes(ctx context.Context, input *GetIngressRoutesInput) (*GetIngressRoutesOutput, error) {
+	if h.ingressService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	analysis, err := h.ingressService.AnalyzeRoutes(ctx)
+	if err != nil {
+		return nil, huma.Error500InternalServerError((&common.IngressRoutesRetrievalError{Err: err}).Error())
+	}
+
+	return &GetIngressRoutesOutput{
+		Body: base.ApiResponse[ingress.Analysis]{
+			Success: true,
+			Data:    *analysis,
+		},
+	}, nil
+}
+
+// GenerateLabels returns labels for a new reverse proxy route.
+func (h *IngressHandler) GenerateLabels(ctx context.Context, input *GenerateIngressLabelsInput) (*GenerateIngressLabelsOutput, error) {
+	if h.ingressService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	generated, err := h.ingressService.GenerateLabels(ctx, input.Body)
+	if err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.IngressLabelsGenerationError{Err: err}).Error())
+	}
+
+	return &GenerateIngressLabelsOutput{
+		Body: base.ApiResponse[ingress.GeneratedLabels]{
+			Success: true,
+			Data:    *generated,
+		},
+	}, nil
+}
@@ -0,0 +1,258 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/getarcaneapp/arcane/backend/internal/services"
+	"github.com/getarcaneapp/arcane/types/base"
+	"github.com/getarcaneapp/arcane/types/logalert"
+	"github.com/getarcaneapp/arcane/types/rbac"
+)
+
+// LogAlertHandler provides Huma-based endpoints for alert rules that match
+// container log lines.
+type LogAlertHandler struct {
+	logAlertService *services.LogAlertService
+}
+
+// --- Huma Input/Output Wrappers ---
+
+type ListLogAlertRulesInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+}
+
+type ListLogAlertRulesOutput struct {
+	Body base.ApiResponse[[]logalert.Rule]
+}
+
+type GetLogAlertRuleInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	RuleID        string `path:"ruleId" doc:"Rule ID"`
+}
+
+type GetLogAlertRuleOutput struct {
+	Body base.ApiResponse[logalert.Rule]
+}
+
+type CreateLogAlertRuleInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	Body          logalert.CreateRule
+}
+
+type CreateLogAlertRuleOutput struct {
+	Body base.ApiResponse[logalert.Rule]
+}
+
+type UpdateLogAlertRuleInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	RuleID        string `path:"ruleId" doc:"Rule ID"`
+	Body          logalert.UpdateRule
+}
+
+type UpdateLogAlertRuleOutput struct {
+	Body base.ApiResponse[logalert.Rule]
+}
+
+type DeleteLogAlertRuleInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	RuleID        string `path:"ruleId" doc:"Rule ID"`
+}
+
+type DeleteLogAlertRuleOutput struct {
+	Body base.ApiResponse[base.MessageResponse]
+}
+
+// RegisterLogAlerts registers log alert routes using Huma.
+func RegisterLogAlerts(api huma.API, logAlertService *services.LogAlertService) {
+	h := &LogAlertHandler{
+		logAlertService: logAlertService,
+	}
+
+	huma.Register(api, huma.Operation{
+		OperationID: "list-log-alert-rules",
+		Method:      http.MethodGet,
+		Path:        "/environments/{id}/log-alerts",
+		Summary:     "List log alert rules",
+		Description: "List the rules that alert on matching container log lines, with the line that last triggered them",
+		Tags:        []string{"Log Alerts"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.ListRules)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-log-alert-rule",
+		Method:      http.MethodGet,
+		Path:        "/environments/{id}/log-alerts/{ruleId}",
+		Summary:     "Get a log alert rule",
+		Description: "Get a log alert rule by ID",
+		Tags:        []string{"Log Alerts"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.GetRule)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "create-log-alert-rule",
+		Method:      http.MethodPost,
+		Path:        "/environments/{id}/log-alerts",
+		Summary:     "Create a log alert rule",
+		Description: "Send a notification when a log line of a container or compose project matches a regular expression",
+		Tags:        []string{"Log Alerts"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.CreateRule)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "update-log-alert-rule",
+		Method:      http.MethodPut,
+		Path:        "/environments/{id}/log-alerts/{ruleId}",
+		Summary:     "Update a log alert rule",
+		Description: "Update a log alert rule's pattern, watched containers or cooldown",
+		Tags:        []string{"Log Alerts"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.UpdateRule)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "delete-log-alert-rule",
+		Method:      http.MethodDelete,
+		Path:        "/environments/{id}/log-alerts/{ruleId}",
+		Summary:     "Delete a log alert rule",
+		Description: "Delete a log alert rule and stop watching its containers",
+		Tags:        []string{"Log Alerts"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.DeleteRule)
+}
+
+// ListRules returns all log alert rules.
+func (h *LogAlertHandler) ListRules(ctx context.Context, input *ListLogAlertRulesInput) (*ListLogAlertRulesOutput, error) {
+	if h.logAlertService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	rules, err := h.logAlertService.ListRules(ctx)
+	if err != nil {
+		return nil, huma.Error500InternalServerError(err.Error())
+	}
+
+	return &ListLogAlertRulesOutput{
+		Body: base.ApiResponse[[]logalert.Rule]{
+			Success: true,
+			Data:    rules,
+		},
+	}, nil
+}
+
+// GetRule returns a single log alert rule.
+func (h *LogAlertHandler) GetRule(ctx context.Context, input *GetLogAlertRuleInput) (*GetLogAlertRuleOutput, error) {
+	if h.logAlertService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	rule, err := h.logAlertService.GetRule(ctx, input.RuleID)
+	if err != nil {
+		return nil, logAlertError(err)
+	}
+
+	return &GetLogAlertRuleOutput{
+		Body: base.ApiResponse[logalert.Rule]{
+			Success: true,
+			Data:    *rule,
+		},
+	}, nil
+}
+
+// CreateRule creates a log alert rule. Alerts include excerpts of container
+// logs, so only admins can manage rules.
+func (h *LogAlertHandler) CreateRule(ctx context.Context, input *CreateLogAlertRuleInput) (*CreateLogAlertRuleOutput, error) {
+	if h.logAlertService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	rule, err := h.logAlertService.CreateRule(ctx, input.Body)
+	if err != nil {
+		return nil, logAlertError(err)
+	}
+
+	return &CreateLogAlertRuleOutput{
+		Body: base.ApiResponse[logalert.Rule]{
+			Success: true,
+			Data:    *rule,
+		},
+	}, nil
+}
+
+// UpdateRule updates an existing log alert rule.
+func (h *LogAlertHandler) UpdateRule(ctx context.Context, input *UpdateLogAlertRuleInput) (*UpdateLogAlertRuleOutput, error) {
+	if h.logAlertService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	rule, err := h.logAlertService.UpdateRule(ctx, input.RuleID, input.Body)
+	if err != nil {
+		return nil, logAlertError(err)
+	}
+
+	return &UpdateLogAlertRuleOutput{
+		Body: base.ApiResponse[logalert.Rule]{
+			Success: true,
+			Data:    *rule,
+		},
+	}, nil
+}
+
+// DeleteRule removes a log alert rule.
+func (h *LogAlertHandler) DeleteRule(ctx context.Context, input *DeleteLogAlertRuleInput) (*DeleteLogAlertRuleOutput, error) {
+	if h.logAlertService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if err := h.logAlertService.DeleteRule(ctx, input.RuleID); err != nil {
+		return nil, logAlertError(err)
+	}
+
+	return &DeleteLogAlertRuleOutput{
+		Body: base.ApiResponse[base.MessageResponse]{
+			Success: true,
+			Data: base.MessageResponse{
+				Message: "Log alert rule deleted successfully",
+			},
+		},
+	}, nil
+}
+
+func logAlertError(err error) error {
+	switch {
+	case errors.Is(err, services.ErrLogAlertRuleNotFound):
+		return huma.Error404NotFound(err.Error())
+	case errors.Is(err, services.ErrLogAlertRuleInvalid):
+		return huma.Error400BadRequest(err.Error())
+	default:
+		return huma.Error500InternalServerError(err.Error())
+	}
+}
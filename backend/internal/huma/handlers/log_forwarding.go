@@ -0,0 +1,164 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/getarcaneapp/arcane/backend/internal/common"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/services"
+	"github.com/getarcaneapp/arcane/types/base"
+	"github.com/getarcaneapp/arcane/types/logforward"
+	"github.com/getarcaneapp/arcane/types/rbac"
+)
+
+// LogForwardingHandler provides the per-project log forwarding endpoints.
+type LogForwardingHandler struct {
+	logForwardingService *services.LogForwardingService
+}
+
+// --- Huma Input/Output Wrappers ---
+
+type GetLogForwarderInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	ProjectID     string `path:"projectId" doc:"Project ID"`
+}
+
+type GetLogForwarderOutput struct {
+	Body base.ApiResponse[logforward.LogForwarder]
+}
+
+type UpsertLogForwarderInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	ProjectID     string `path:"projectId" doc:"Project ID"`
+	Body          logforward.UpsertLogForwarder
+}
+
+type UpsertLogForwarderOutput struct {
+	Body base.ApiResponse[logforward.LogForwarder]
+}
+
+type DeleteLogForwarderInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	ProjectID     string `path:"projectId" doc:"Project ID"`
+}
+
+type DeleteLogForwarderOutput struct {
+	Body base.ApiResponse[base.MessageResponse]
+}
+
+// RegisterLogForwarding registers the log forwarding routes using Huma.
+func RegisterLogForwarding(api huma.API, logForwardingService *services.LogForwardingService) {
+	h := &LogForwardingHandler{
+		logForwardingService: logForwardingService,
+	}
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-project-log-forwarding",
+		Method:      http.MethodGet,
+		Path:        "/environments/{id}/projects/{projectId}/log-forwarding",
+		Summary:     "Get project log forwarding",
+		Description: "Get where the logs of a project's containers are forwarded and the delivery status",
+		Tags:        []string{"Projects"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.GetLogForwarder)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "update-project-log-forwarding",
+		Method:      http.MethodPut,
+		Path:        "/environments/{id}/projects/{projectId}/log-forwarding",
+		Summary:     "Configure project log forwarding",
+		Description: "Forward the logs of a project's containers to Loki, a syslog collector or a rotated file",
+		Tags:        []string{"Projects"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.UpsertLogForwarder)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "delete-project-log-forwarding",
+		Method:      http.MethodDelete,
+		Path:        "/environments/{id}/projects/{projectId}/log-forwarding",
+		Summary:     "Remove project log forwarding",
+		Description: "Stop forwarding the logs of a project's containers",
+		Tags:        []string{"Projects"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.DeleteLogForwarder)
+}
+
+// GetLogForwarder returns the log forwarding configuration of a project.
+func (h *LogForwardingHandler) GetLogForwarder(ctx context.Context, input *GetLogForwarderInput) (*GetLogForwarderOutput, error) {
+	if h.logForwardingService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	fw, err := h.logForwardingService.GetProjectForwarder(ctx, input.ProjectID)
+	if err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.LogForwarderRetrievalError{Err: err}).Error())
+	}
+
+	return &GetLogForwarderOutput{
+		Body: base.ApiResponse[logforward.LogForwarder]{
+			Success: true,
+			Data:    *fw,
+		},
+	}, nil
+}
+
+// UpsertLogForwarder creates or replaces the log forwarding configuration of a project.
+func (h *LogForwardingHandler) UpsertLogForwarder(ctx context.Context, input *UpsertLogForwarderInput) (*UpsertLogForwarderOutput, error) {
+	if h.logForwardingService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	fw, err := h.logForwardingService.UpsertProjectForwarder(ctx, input.ProjectID, input.Body)
+	if err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.LogForwarderUpdateError{Err: err}).Error())
+	}
+
+	return &UpsertLogForwarderOutput{
+		Body: base.ApiResponse[logforward.LogForwarder]{
+			Success: true,
+			Data:    *fw,
+		},
+	}, nil
+}
+
+// DeleteLogForwarder removes the log forwarding configuration of a project.
+func (h *LogForwardingHandler) DeleteLogForwarder(ctx context.Context, input *DeleteLogForwarderInput) (*DeleteLogForwarderOutput, error) {
+	if h.logForwardingService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if err := h.logForwardingService.DeleteProjectForwarder(ctx, input.ProjectID); err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.LogForwarderDeletionError{Err: err}).Error())
+	}
+
+	return &DeleteLogForwarderOutput{
+		Body: base.ApiResponse[base.MessageResponse]{
+			Success: true,
+			Data: base.MessageResponse{
+				Message: "Log forwarding removed successfully",
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,296 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/getarcaneapp/arcane/backend/internal/services"
+	"github.com/getarcaneapp/arcane/types/base"
+	"github.com/getarcaneapp/arcane/types/monitor"
+	"github.com/getarcaneapp/arcane/types/rbac"
+)
+
+// MonitorHandler provides Huma-based uptime monitor endpoints.
+type MonitorHandler struct {
+	monitorService *services.MonitorService
+}
+
+// --- Huma Input/Output Wrappers ---
+
+type ListMonitorsInput struct {
+	EnvironmentID string `query:"environmentId" doc:"Only return monitors for this environment"`
+}
+
+type ListMonitorsOutput struct {
+	Body base.ApiResponse[[]monitor.Monitor]
+}
+
+type GetMonitorInput struct {
+	MonitorID string `path:"monitorId" doc:"Monitor ID"`
+}
+
+type GetMonitorOutput struct {
+	Body base.ApiResponse[monitor.Monitor]
+}
+
+type CreateMonitorInput struct {
+	Body monitor.CreateMonitor
+}
+
+type CreateMonitorOutput struct {
+	Body base.ApiResponse[monitor.Monitor]
+}
+
+type UpdateMonitorInput struct {
+	MonitorID string `path:"monitorId" doc:"Monitor ID"`
+	Body      monitor.UpdateMonitor
+}
+
+type UpdateMonitorOutput struct {
+	Body base.ApiResponse[monitor.Monitor]
+}
+
+type DeleteMonitorInput struct {
+	MonitorID string `path:"monitorId" doc:"Monitor ID"`
+}
+
+type DeleteMonitorOutput struct {
+	Body base.ApiResponse[base.MessageResponse]
+}
+
+type CheckMonitorInput struct {
+	MonitorID string `path:"monitorId" doc:"Monitor ID"`
+}
+
+type CheckMonitorOutput struct {
+	Body base.ApiResponse[monitor.CheckResult]
+}
+
+// RegisterMonitors registers uptime monitor routes using Huma.
+func RegisterMonitors(api huma.API, monitorService *services.MonitorService) {
+	h := &MonitorHandler{
+		monitorService: monitorService,
+	}
+
+	huma.Register(api, huma.Operation{
+		OperationID: "list-monitors",
+		Method:      http.MethodGet,
+		Path:        "/monitors",
+		Summary:     "List uptime monitors",
+		Description: "List HTTP and TCP uptime monitors with their current status",
+		Tags:        []string{"Monitors"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.ListMonitors)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-monitor",
+		Method:      http.MethodGet,
+		Path:        "/monitors/{monitorId}",
+		Summary:     "Get an uptime monitor",
+		Description: "Get an uptime monitor by ID",
+		Tags:        []string{"Monitors"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.GetMonitor)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "create-monitor",
+		Method:      http.MethodPost,
+		Path:        "/monitors",
+		Summary:     "Create an uptime monitor",
+		Description: "Register an HTTP or TCP check, optionally tied to a container or compose service",
+		Tags:        []string{"Monitors"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.CreateMonitor)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "update-monitor",
+		Method:      http.MethodPut,
+		Path:        "/monitors/{monitorId}",
+		Summary:     "Update an uptime monitor",
+		Description: "Update an uptime monitor's target, interval or thresholds",
+		Tags:        []string{"Monitors"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.UpdateMonitor)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "delete-monitor",
+		Method:      http.MethodDelete,
+		Path:        "/monitors/{monitorId}",
+		Summary:     "Delete an uptime monitor",
+		Description: "Delete an uptime monitor by ID",
+		Tags:        []string{"Monitors"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.DeleteMonitor)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "check-monitor",
+		Method:      http.MethodPost,
+		Path:        "/monitors/{monitorId}/check",
+		Summary:     "Run an uptime check now",
+		Description: "Run the monitor's check immediately and record the result",
+		Tags:        []string{"Monitors"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.CheckMonitor)
+}
+
+// ListMonitors returns all monitors, optionally filtered by environment.
+func (h *MonitorHandler) ListMonitors(ctx context.Context, input *ListMonitorsInput) (*ListMonitorsOutput, error) {
+	if h.monitorService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	monitors, err := h.monitorService.ListMonitors(ctx, input.EnvironmentID)
+	if err != nil {
+		return nil, huma.Error500InternalServerError(err.Error())
+	}
+
+	return &ListMonitorsOutput{
+		Body: base.ApiResponse[[]monitor.Monitor]{
+			Success: true,
+			Data:    monitors,
+		},
+	}, nil
+}
+
+// GetMonitor returns a single monitor.
+func (h *MonitorHandler) GetMonitor(ctx context.Context, input *GetMonitorInput) (*GetMonitorOutput, error) {
+	if h.monitorService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	m, err := h.monitorService.GetMonitor(ctx, input.MonitorID)
+	if err != nil {
+		return nil, monitorError(err)
+	}
+
+	return &GetMonitorOutput{
+		Body: base.ApiResponse[monitor.Monitor]{
+			Success: true,
+			Data:    *m,
+		},
+	}, nil
+}
+
+// CreateMonitor registers a new monitor.
+func (h *MonitorHandler) CreateMonitor(ctx context.Context, input *CreateMonitorInput) (*CreateMonitorOutput, error) {
+	if h.monitorService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	m, err := h.monitorService.CreateMonitor(ctx, input.Body)
+	if err != nil {
+		return nil, monitorError(err)
+	}
+
+	return &CreateMonitorOutput{
+		Body: base.ApiResponse[monitor.Monitor]{
+			Success: true,
+			Data:    *m,
+		},
+	}, nil
+}
+
+// UpdateMonitor updates an existing monitor.
+func (h *MonitorHandler) UpdateMonitor(ctx context.Context, input *UpdateMonitorInput) (*UpdateMonitorOutput, error) {
+	if h.monitorService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	m, err := h.monitorService.UpdateMonitor(ctx, input.MonitorID, input.Body)
+	if err != nil {
+		return nil, monitorError(err)
+	}
+
+	return &UpdateMonitorOutput{
+		Body: base.ApiResponse[monitor.Monitor]{
+			Success: true,
+			Data:    *m,
+		},
+	}, nil
+}
+
+// DeleteMonitor removes a monitor.
+func (h *MonitorHandler) DeleteMonitor(ctx context.Context, input *DeleteMonitorInput) (*DeleteMonitorOutput, error) {
+	if h.monitorService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if err := h.monitorService.DeleteMonitor(ctx, input.MonitorID); err != nil {
+		return nil, monitorError(err)
+	}
+
+	return &DeleteMonitorOutput{
+		Body: base.ApiResponse[base.MessageResponse]{
+			Success: true,
+			Data: base.MessageResponse{
+				Message: "Monitor deleted successfully",
+			},
+		},
+	}, nil
+}
+
+// CheckMonitor runs a monitor's check immediately.
+func (h *MonitorHandler) CheckMonitor(ctx context.Context, input *CheckMonitorInput) (*CheckMonitorOutput, error) {
+	if h.monitorService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleOperator); err != nil {
+		return nil, err
+	}
+
+	result, err := h.monitorService.CheckMonitorNow(ctx, input.MonitorID)
+	if err != nil {
+		return nil, monitorError(err)
+	}
+
+	return &CheckMonitorOutput{
+		Body: base.ApiResponse[monitor.CheckResult]{
+			Success: true,
+			Data:    *result,
+		},
+	}, nil
+}
+
+func monitorError(err error) error {
+	switch {
+	case errors.Is(err, services.ErrMonitorNotFound):
+		return huma.Error404NotFound(err.Error())
+	case errors.Is(err, services.ErrMonitorInvalid):
+		return huma.Error400BadRequest(err.Error())
+	default:
+		return huma.Error500InternalServerError(err.Error())
+	}
+}
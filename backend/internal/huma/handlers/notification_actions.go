@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/getarcaneapp/arcane/backend/internal/services"
+	"github.com/getarcaneapp/arcane/types/base"
+	"github.com/getarcaneapp/arcane/types/notificationaction"
+)
+
+// NotificationActionHandler provides the endpoints behind the action links in
+// notifications.
+type NotificationActionHandler struct {
+	notificationActionService *services.NotificationActionService
+}
+
+// --- Huma Input/Output Wrappers ---
+
+type DescribeNotificationActionInput struct {
+	Token string `path:"token" doc:"Signed action token"`
+}
+
+type DescribeNotificationActionOutput struct {
+	Body base.ApiResponse[notificationaction.Action]
+}
+
+type PerformNotificationActionInput struct {
+	Token string `path:"token" doc:"Signed action token"`
+}
+
+type PerformNotificationActionOutput struct {
+	Body base.ApiResponse[notificationaction.Outcome]
+}
+
+// RegisterNotificationActions registers the notification action routes using Huma.
+func RegisterNotificationActions(api huma.API, notificationActionService *services.NotificationActionService) {
+	h := &NotificationActionHandler{
+		notificationActionService: notificationActionService,
+	}
+
+	// The signed token in the path authenticates the request, so the links
+	// work without logging in.
+	huma.Register(api, huma.Operation{
+		OperationID: "describe-notification-action",
+		Method:      http.MethodGet,
+		Path:        "/notification-actions/{token}",
+		Summary:     "Describe a notification action",
+		Description: "Return the action of a link from a notification without performing it",
+		Tags:        []string{"Notifications"},
+	}, h.DescribeAction)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "perform-notification-action",
+		Method:      http.MethodPost,
+		Path:        "/notification-actions/{token}",
+		Summary:     "Perform a notification action",
+		Description: "Apply the image update or snooze the update notifications named by a link from a notification",
+		Tags:        []string{"Notifications"},
+	}, h.PerformAction)
+}
+
+// DescribeAction returns the action of a notification link.
+func (h *NotificationActionHandler) DescribeAction(ctx context.Context, input *DescribeNotificationActionInput) (*DescribeNotificationActionOutput, error) {
+	if h.notificationActionService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	action, err := h.notificationActionService.Describe(ctx, input.Token)
+	if err != nil {
+		return nil, notificationActionError(err)
+	}
+
+	return &DescribeNotificationActionOutput{
+		Body: base.ApiResponse[notificationaction.Action]{
+			Success: true,
+			Data:    *action,
+		},
+	}, nil
+}
+
+// PerformAction performs the action of a notification link.
+func (h *NotificationActionHandler) PerformAction(ctx context.Context, input *PerformNotificationActionInput) (*PerformNotificationActionOutput, error) {
+	if h.notificationActionService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	result, err := h.notificationActionService.Perform(ctx, input.Token)
+	if err != nil {
+		return nil, notificationActionError(err)
+	}
+
+	return &PerformNotificationActionOutput{
+		Body: base.ApiResponse[notificationaction.Outcome]{
+			Success: true,
+			Data:    *result,
+		},
+	}, nil
+}
+
+func notificationActionError(err error) error {
+	switch {
+	case errors.Is(err, services.ErrNotificationActionInvalid):
+		// Not 401, which would send the browser to the login page.
+		return huma.NewError(http.StatusGone, err.Error())
+	case errors.Is(err, services.ErrNotificationActionDisabled):
+		return huma.Error403Forbidden(err.Error())
+	case errors.Is(err, services.ErrNotificationActionBusy):
+		return huma.Error409Conflict(err.Error())
+	default:
+		return huma.Error500InternalServerError(err.Error())
+	}
+}
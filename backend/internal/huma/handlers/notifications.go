@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"strings"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/getarcaneapp/arcane/backend/internal/services"
 	"github.com/getarcaneapp/arcane/types/base"
 	"github.com/getarcaneapp/arcane/types/notification"
+	"github.com/getarcaneapp/arcane/types/rbac"
 )
 
 type NotificationHandler struct {
@@ -53,6 +55,24 @@ type DeleteNotificationSettingsOutput struct {
 	Body base.ApiResponse[base.MessageResponse]
 }
 
+type ExportNotificationSettingsInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	Body          notification.ExportRequest
+}
+
+type ExportNotificationSettingsOutput struct {
+	Body base.ApiResponse[notification.Bundle]
+}
+
+type ImportNotificationSettingsInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	Body          notification.ImportRequest
+}
+
+type ImportNotificationSettingsOutput struct {
+	Body base.ApiResponse[notification.SettingsImportResult]
+}
+
 type TestNotificationInput struct {
 	EnvironmentID string `path:"id" doc:"Environment ID"`
 	Provider      string `path:"provider" doc:"Provider"`
@@ -156,6 +176,26 @@ func RegisterNotifications(api huma.API, notificationSvc *services.NotificationS
 		Security:    []map[string][]string{{"BearerAuth": {}}, {"ApiKeyAuth": {}}},
 	}, h.DeleteNotificationSettings)
 
+	huma.Register(api, huma.Operation{
+		OperationID: "export-notification-settings",
+		Method:      http.MethodPost,
+		Path:        "/environments/{id}/notifications/settings/export",
+		Summary:     "Export notification settings",
+		Description: "Export all notification provider settings. Secrets are encrypted with the passphrase, or left out when none is given.",
+		Tags:        []string{"Notifications"},
+		Security:    []map[string][]string{{"BearerAuth": {}}, {"ApiKeyAuth": {}}},
+	}, h.ExportNotificationSettings)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "import-notification-settings",
+		Method:      http.MethodPost,
+		Path:        "/environments/{id}/notifications/settings/import",
+		Summary:     "Import notification settings",
+		Description: "Create or update notification providers from an exported bundle",
+		Tags:        []string{"Notifications"},
+		Security:    []map[string][]string{{"BearerAuth": {}}, {"ApiKeyAuth": {}}},
+	}, h.ImportNotificationSettings)
+
 	huma.Register(api, huma.Operation{
 		OperationID: "test-notification",
 		Method:      http.MethodPost,
@@ -194,7 +234,7 @@ func RegisterNotifications(api huma.API, notificationSvc *services.NotificationS
 }
 
 func (h *NotificationHandler) GetAllNotificationSettings(ctx context.Context, input *GetAllNotificationSettingsInput) (*GetAllNotificationSettingsOutput, error) {
-	if err := checkAdmin(ctx); err != nil {
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
 		return nil, err
 	}
 	settings, err := h.notificationService.GetAllSettings(ctx)
@@ -205,10 +245,13 @@ func (h *NotificationHandler) GetAllNotificationSettings(ctx context.Context, in
 	responses := make([]notification.Response, len(settings))
 	for i, setting := range settings {
 		responses[i] = notification.Response{
-			ID:       setting.ID,
-			Provider: notification.Provider(setting.Provider),
-			Enabled:  setting.Enabled,
-			Config:   base.JsonObject(setting.Config),
+			ID:                  setting.ID,
+			Provider:            notification.Provider(setting.Provider),
+			Enabled:             setting.Enabled,
+			Config:              base.JsonObject(setting.Config),
+			CredentialStatus:    setting.CredentialStatus,
+			CredentialError:     setting.CredentialError,
+			CredentialCheckedAt: setting.CredentialCheckedAt,
 		}
 	}
 
@@ -216,7 +259,7 @@ func (h *NotificationHandler) GetAllNotificationSettings(ctx context.Context, in
 }
 
 func (h *NotificationHandler) GetNotificationSettings(ctx context.Context, input *GetNotificationSettingsInput) (*GetNotificationSettingsOutput, error) {
-	if err := checkAdmin(ctx); err != nil {
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
 		return nil, err
 	}
 	provider := models.NotificationProvider(input.Provider)
@@ -227,17 +270,20 @@ func (h *NotificationHandler) GetNotificationSettings(ctx context.Context, input
 	}
 
 	response := notification.Response{
-		ID:       settings.ID,
-		Provider: notification.Provider(settings.Provider),
-		Enabled:  settings.Enabled,
-		Config:   base.JsonObject(settings.Config),
+		ID:                  settings.ID,
+		Provider:            notification.Provider(settings.Provider),
+		Enabled:             settings.Enabled,
+		Config:              base.JsonObject(settings.Config),
+		CredentialStatus:    settings.CredentialStatus,
+		CredentialError:     settings.CredentialError,
+		CredentialCheckedAt: settings.CredentialCheckedAt,
 	}
 
 	return &GetNotificationSettingsOutput{Body: response}, nil
 }
 
 func (h *NotificationHandler) CreateOrUpdateNotificationSettings(ctx context.Context, input *CreateOrUpdateNotificationSettingsInput) (*CreateOrUpdateNotificationSettingsOutput, error) {
-	if err := checkAdmin(ctx); err != nil {
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
 		return nil, err
 	}
 	provider := models.NotificationProvider(input.Body.Provider)
@@ -252,21 +298,28 @@ func (h *NotificationHandler) CreateOrUpdateNotificationSettings(ctx context.Con
 		models.JSON(input.Body.Config),
 	)
 	if err != nil {
-		return nil, huma.Error500InternalServerError((&common.NotificationSettingsUpdateError{Err: err}).Error())
+		apiErr := (&common.NotificationSettingsUpdateError{Err: err}).Error()
+		if errors.Is(err, services.ErrNotificationTLSInvalid) {
+			return nil, huma.Error400BadRequest(apiErr)
+		}
+		return nil, huma.Error500InternalServerError(apiErr)
 	}
 
 	response := notification.Response{
-		ID:       settings.ID,
-		Provider: notification.Provider(settings.Provider),
-		Enabled:  settings.Enabled,
-		Config:   base.JsonObject(settings.Config),
+		ID:                  settings.ID,
+		Provider:            notification.Provider(settings.Provider),
+		Enabled:             settings.Enabled,
+		Config:              base.JsonObject(settings.Config),
+		CredentialStatus:    settings.CredentialStatus,
+		CredentialError:     settings.CredentialError,
+		CredentialCheckedAt: settings.CredentialCheckedAt,
 	}
 
 	return &CreateOrUpdateNotificationSettingsOutput{Body: response}, nil
 }
 
 func (h *NotificationHandler) DeleteNotificationSettings(ctx context.Context, input *DeleteNotificationSettingsInput) (*DeleteNotificationSettingsOutput, error) {
-	if err := checkAdmin(ctx); err != nil {
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
 		return nil, err
 	}
 	provider := models.NotificationProvider(input.Provider)
@@ -283,8 +336,50 @@ func (h *NotificationHandler) DeleteNotificationSettings(ctx context.Context, in
 	}, nil
 }
 
+func (h *NotificationHandler) ExportNotificationSettings(ctx context.Context, input *ExportNotificationSettingsInput) (*ExportNotificationSettingsOutput, error) {
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	bundle, err := h.notificationService.ExportSettings(ctx, input.Body.Passphrase)
+	if err != nil {
+		return nil, huma.Error500InternalServerError((&common.NotificationSettingsExportError{Err: err}).Error())
+	}
+
+	return &ExportNotificationSettingsOutput{
+		Body: base.ApiResponse[notification.Bundle]{
+			Success: true,
+			Data:    *bundle,
+		},
+	}, nil
+}
+
+func (h *NotificationHandler) ImportNotificationSettings(ctx context.Context, input *ImportNotificationSettingsInput) (*ImportNotificationSettingsOutput, error) {
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	result, err := h.notificationService.ImportSettings(ctx, input.Body.Bundle, input.Body.Passphrase)
+	if err != nil {
+		apiErr := (&common.NotificationSettingsImportError{Err: err}).Error()
+		if errors.Is(err, services.ErrNotificationBundleInvalid) ||
+			errors.Is(err, services.ErrNotificationBundlePassphraseRequired) ||
+			errors.Is(err, services.ErrNotificationBundleDecryptFailed) {
+			return nil, huma.Error400BadRequest(apiErr)
+		}
+		return nil, huma.Error500InternalServerError(apiErr)
+	}
+
+	return &ImportNotificationSettingsOutput{
+		Body: base.ApiResponse[notification.SettingsImportResult]{
+			Success: true,
+			Data:    *result,
+		},
+	}, nil
+}
+
 func (h *NotificationHandler) TestNotification(ctx context.Context, input *TestNotificationInput) (*TestNotificationOutput, error) {
-	if err := checkAdmin(ctx); err != nil {
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
 		return nil, err
 	}
 	provider := models.NotificationProvider(input.Provider)
@@ -306,7 +401,7 @@ func (h *NotificationHandler) TestNotification(ctx context.Context, input *TestN
 }
 
 func (h *NotificationHandler) GetAppriseSettings(ctx context.Context, input *GetAppriseSettingsInput) (*GetAppriseSettingsOutput, error) {
-	if err := checkAdmin(ctx); err != nil {
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
 		return nil, err
 	}
 	settings, err := h.appriseService.GetSettings(ctx)
@@ -329,7 +424,7 @@ func (h *NotificationHandler) GetAppriseSettings(ctx context.Context, input *Get
 }
 
 func (h *NotificationHandler) CreateOrUpdateAppriseSettings(ctx context.Context, input *CreateOrUpdateAppriseSettingsInput) (*CreateOrUpdateAppriseSettingsOutput, error) {
-	if err := checkAdmin(ctx); err != nil {
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
 		return nil, err
 	}
 	if input.Body.Enabled && input.Body.APIURL == "" {
@@ -359,7 +454,7 @@ func (h *NotificationHandler) CreateOrUpdateAppriseSettings(ctx context.Context,
 }
 
 func (h *NotificationHandler) TestAppriseNotification(ctx context.Context, input *TestAppriseNotificationInput) (*TestAppriseNotificationOutput, error) {
-	if err := checkAdmin(ctx); err != nil {
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
 		return nil, err
 	}
 	testType := normalizeNotificationTestType(input.Type)
@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/getarcaneapp/arcane/backend/internal/common"
+	humamw "github.com/getarcaneapp/arcane/backend/internal/huma/middleware"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/services"
+	"github.com/getarcaneapp/arcane/types/base"
+	"github.com/getarcaneapp/arcane/types/project"
+)
+
+// ProjectAdoptionHandler provides the endpoint that moves standalone
+// containers into a new project.
+type ProjectAdoptionHandler struct {
+	adoptionService *services.ProjectAdoptionService
+}
+
+// --- Huma Input/Output Wrappers ---
+
+type AdoptContainersInput struct {
+	EnvironmentID string                  `path:"id" doc:"Environment ID"`
+	Body          project.AdoptContainers `doc:"Containers to adopt"`
+}
+
+type AdoptContainersOutput struct {
+	Body base.ApiResponse[project.CreateReponse]
+}
+
+// RegisterProjectAdoption registers the container adoption route using Huma.
+func RegisterProjectAdoption(api huma.API, adoptionService *services.ProjectAdoptionService) {
+	h := &ProjectAdoptionHandler{
+		adoptionService: adoptionService,
+	}
+
+	huma.Register(api, huma.Operation{
+		OperationID: "adopt-containers",
+		Method:      http.MethodPost,
+		Path:        "/environments/{id}/projects/adopt",
+		Summary:     "Adopt containers into a project",
+		Description: "Create a project from the configuration of standalone containers and optionally recreate them under compose management",
+		Tags:        []string{"Projects"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.AdoptContainers)
+}
+
+// AdoptContainers creates a project from existing standalone containers.
+func (h *ProjectAdoptionHandler) AdoptContainers(ctx context.Context, input *AdoptContainersInput) (*AdoptContainersOutput, error) {
+	if h.adoptionService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	user, exists := humamw.GetCurrentUserFromContext(ctx)
+	if !exists {
+		return nil, huma.Error401Unauthorized((&common.NotAuthenticatedError{}).Error())
+	}
+
+	proj, err := h.adoptionService.AdoptContainers(ctx, input.Body, *user)
+	if err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.ProjectAdoptionError{Err: err}).Error())
+	}
+
+	response, err := toCreateProjectResponse(proj)
+	if err != nil {
+		return nil, huma.Error500InternalServerError("failed to map response")
+	}
+
+	return &AdoptContainersOutput{
+		Body: base.ApiResponse[project.CreateReponse]{
+			Success: true,
+			Data:    response,
+		},
+	}, nil
+}
@@ -0,0 +1,147 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/getarcaneapp/arcane/backend/internal/common"
+	humamw "github.com/getarcaneapp/arcane/backend/internal/huma/middleware"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/services"
+	"github.com/getarcaneapp/arcane/types/base"
+	"github.com/getarcaneapp/arcane/types/project"
+)
+
+// ProjectMaintenanceHandler provides the endpoints that put projects into and
+// out of maintenance mode.
+type ProjectMaintenanceHandler struct {
+	maintenanceService *services.ProjectMaintenanceService
+	projectService     *services.ProjectService
+}
+
+// --- Huma Input/Output Wrappers ---
+
+type StartProjectMaintenanceInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	ProjectID     string `path:"projectId" doc:"Project ID"`
+	Body          *project.StartMaintenance
+}
+
+type StartProjectMaintenanceOutput struct {
+	Body base.ApiResponse[project.Details]
+}
+
+type EndProjectMaintenanceInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	ProjectID     string `path:"projectId" doc:"Project ID"`
+}
+
+type EndProjectMaintenanceOutput struct {
+	Body base.ApiResponse[project.Details]
+}
+
+// RegisterProjectMaintenance registers the project maintenance routes using Huma.
+func RegisterProjectMaintenance(api huma.API, maintenanceService *services.ProjectMaintenanceService, projectService *services.ProjectService) {
+	h := &ProjectMaintenanceHandler{
+		maintenanceService: maintenanceService,
+		projectService:     projectService,
+	}
+
+	huma.Register(api, huma.Operation{
+		OperationID: "start-project-maintenance",
+		Method:      http.MethodPost,
+		Path:        "/environments/{id}/projects/{projectId}/maintenance",
+		Summary:     "Start project maintenance",
+		Description: "Stop the project's services and serve a maintenance page on their published ports and reverse proxy labels",
+		Tags:        []string{"Projects"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.StartMaintenance)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "end-project-maintenance",
+		Method:      http.MethodDelete,
+		Path:        "/environments/{id}/projects/{projectId}/maintenance",
+		Summary:     "End project maintenance",
+		Description: "Remove the maintenance page and deploy the project's services again",
+		Tags:        []string{"Projects"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.EndMaintenance)
+}
+
+// StartMaintenance puts a project into maintenance mode.
+func (h *ProjectMaintenanceHandler) StartMaintenance(ctx context.Context, input *StartProjectMaintenanceInput) (*StartProjectMaintenanceOutput, error) {
+	if h.maintenanceService == nil || h.projectService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if input.ProjectID == "" {
+		return nil, huma.Error400BadRequest((&common.ProjectIDRequiredError{}).Error())
+	}
+
+	user, exists := humamw.GetCurrentUserFromContext(ctx)
+	if !exists {
+		return nil, huma.Error401Unauthorized((&common.NotAuthenticatedError{}).Error())
+	}
+
+	message := ""
+	if input.Body != nil {
+		message = input.Body.Message
+	}
+
+	if _, err := h.maintenanceService.StartMaintenance(ctx, input.ProjectID, message, *user); err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.ProjectMaintenanceStartError{Err: err}).Error())
+	}
+
+	details, err := h.projectService.GetProjectDetails(ctx, input.ProjectID)
+	if err != nil {
+		return nil, huma.Error500InternalServerError((&common.ProjectDetailsError{Err: err}).Error())
+	}
+
+	return &StartProjectMaintenanceOutput{
+		Body: base.ApiResponse[project.Details]{
+			Success: true,
+			Data:    details,
+		},
+	}, nil
+}
+
+// EndMaintenance takes a project out of maintenance mode.
+func (h *ProjectMaintenanceHandler) EndMaintenance(ctx context.Context, input *EndProjectMaintenanceInput) (*EndProjectMaintenanceOutput, error) {
+	if h.maintenanceService == nil || h.projectService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if input.ProjectID == "" {
+		return nil, huma.Error400BadRequest((&common.ProjectIDRequiredError{}).Error())
+	}
+
+	user, exists := humamw.GetCurrentUserFromContext(ctx)
+	if !exists {
+		return nil, huma.Error401Unauthorized((&common.NotAuthenticatedError{}).Error())
+	}
+
+	if _, err := h.maintenanceService.EndMaintenance(ctx, input.ProjectID, *user); err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.ProjectMaintenanceEndError{Err: err}).Error())
+	}
+
+	details, err := h.projectService.GetProjectDetails(ctx, input.ProjectID)
+	if err != nil {
+		return nil, huma.Error500InternalServerError((&common.ProjectDetailsError{Err: err}).Error())
+	}
+
+	return &EndProjectMaintenanceOutput{
+		Body: base.ApiResponse[project.Details]{
+			Success: true,
+			Data:    details,
+		},
+	}, nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/danielgtaylor/huma/v2"
 	"github.com/getarcaneapp/arcane/backend/internal/common"
 	humamw "github.com/getarcaneapp/arcane/backend/internal/huma/middleware"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
 	"github.com/getarcaneapp/arcane/backend/internal/services"
 	"github.com/getarcaneapp/arcane/backend/internal/utils"
 	"github.com/getarcaneapp/arcane/backend/internal/utils/mapper"
@@ -42,6 +43,7 @@ type ListProjectsInput struct {
 	Start         int    `query:"start" default:"0" doc:"Start index for pagination"`
 	Limit         int    `query:"limit" default:"20" doc:"Number of items per page"`
 	Status        string `query:"status" doc:"Filter by status (comma-separated: running,stopped,partially running)"`
+	Tags          string `query:"tags" doc:"Only list projects with every tag, comma-separated as key or key=value"`
 }
 
 type ListProjectsOutput struct {
@@ -56,6 +58,14 @@ type GetProjectStatusCountsOutput struct {
 	Body base.ApiResponse[project.StatusCounts]
 }
 
+type GetProjectPathMappingInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+}
+
+type GetProjectPathMappingOutput struct {
+	Body base.ApiResponse[project.PathMappingDiagnostics]
+}
+
 type DeployProjectInput struct {
 	EnvironmentID string `path:"id" doc:"Environment ID"`
 	ProjectID     string `path:"projectId" doc:"Project ID"`
@@ -93,6 +103,106 @@ type GetProjectOutput struct {
 	Body base.ApiResponse[project.Details]
 }
 
+type CheckProjectEnvInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	ProjectID     string `path:"projectId" doc:"Project ID"`
+}
+
+type CheckProjectEnvOutput struct {
+	Body base.ApiResponse[project.EnvCheck]
+}
+
+type GetProjectQuotaInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	ProjectID     string `path:"projectId" doc:"Project ID"`
+}
+
+type GetProjectQuotaOutput struct {
+	Body base.ApiResponse[project.QuotaStatus]
+}
+
+type UpdateProjectQuotaInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	ProjectID     string `path:"projectId" doc:"Project ID"`
+	Body          project.ResourceQuota
+}
+
+type UpdateProjectQuotaOutput struct {
+	Body base.ApiResponse[project.QuotaStatus]
+}
+
+type DeleteProjectQuotaInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	ProjectID     string `path:"projectId" doc:"Project ID"`
+}
+
+type DeleteProjectQuotaOutput struct {
+	Body base.ApiResponse[base.MessageResponse]
+}
+
+type GetProjectRegistriesInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	ProjectID     string `path:"projectId" doc:"Project ID"`
+}
+
+type UpdateProjectRegistriesInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	ProjectID     string `path:"projectId" doc:"Project ID"`
+	Body          project.Registries
+}
+
+type ProjectRegistriesOutput struct {
+	Body base.ApiResponse[project.Registries]
+}
+
+type LockProjectInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	ProjectID     string `path:"projectId" doc:"Project ID"`
+	Body          *project.Lock
+}
+
+type LockProjectOutput struct {
+	Body base.ApiResponse[project.Details]
+}
+
+type UnlockProjectInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	ProjectID     string `path:"projectId" doc:"Project ID"`
+	Body          project.Unlock
+}
+
+type UnlockProjectOutput struct {
+	Body base.ApiResponse[project.Details]
+}
+
+type ArchiveProjectInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	ProjectID     string `path:"projectId" doc:"Project ID"`
+}
+
+type ArchiveProjectOutput struct {
+	Body base.ApiResponse[project.Details]
+}
+
+type UnarchiveProjectInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	ProjectID     string `path:"projectId" doc:"Project ID"`
+}
+
+type UnarchiveProjectOutput struct {
+	Body base.ApiResponse[project.Details]
+}
+
+type CloneProjectInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	ProjectID     string `path:"projectId" doc:"Project ID"`
+	Body          project.Clone
+}
+
+type CloneProjectOutput struct {
+	Body base.ApiResponse[project.CloneResult]
+}
+
 type RedeployProjectInput struct {
 	EnvironmentID string `path:"id" doc:"Environment ID"`
 	ProjectID     string `path:"projectId" doc:"Project ID"`
@@ -113,25 +223,70 @@ type DestroyProjectOutput struct {
 }
 
 type UpdateProjectInput struct {
-	EnvironmentID string `path:"id" doc:"Environment ID"`
-	ProjectID     string `path:"projectId" doc:"Project ID"`
-	Body          project.UpdateProject
+	EnvironmentID  string `path:"id" doc:"Environment ID"`
+	ProjectID      string `path:"projectId" doc:"Project ID"`
+	OverrideGitOps bool   `query:"overrideGitOps" default:"false" doc:"Edit the project even though it is managed by GitOps"`
+	Body           project.UpdateProject
 }
 
 type UpdateProjectOutput struct {
 	Body base.ApiResponse[project.Details]
 }
 
+type PatchProjectServiceInput struct {
+	EnvironmentID  string `path:"id" doc:"Environment ID"`
+	ProjectID      string `path:"projectId" doc:"Project ID"`
+	ServiceName    string `path:"serviceName" doc:"Service name"`
+	OverrideGitOps bool   `query:"overrideGitOps" default:"false" doc:"Edit the project even though it is managed by GitOps"`
+	Body           project.ServicePatch
+}
+
+type PatchProjectServiceOutput struct {
+	Body base.ApiResponse[project.ServicePatchResult]
+}
+
 type UpdateProjectIncludeInput struct {
-	EnvironmentID string `path:"id" doc:"Environment ID"`
-	ProjectID     string `path:"projectId" doc:"Project ID"`
-	Body          project.UpdateIncludeFile
+	EnvironmentID  string `path:"id" doc:"Environment ID"`
+	ProjectID      string `path:"projectId" doc:"Project ID"`
+	OverrideGitOps bool   `query:"overrideGitOps" default:"false" doc:"Edit the project even though it is managed by GitOps"`
+	Body           project.UpdateIncludeFile
 }
 
 type UpdateProjectIncludeOutput struct {
 	Body base.ApiResponse[project.Details]
 }
 
+type ListCustomFileTemplatesInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+}
+
+type ListCustomFileTemplatesOutput struct {
+	Body base.ApiResponse[[]project.CustomFileTemplate]
+}
+
+type CreateProjectCustomFileInput struct {
+	EnvironmentID  string `path:"id" doc:"Environment ID"`
+	ProjectID      string `path:"projectId" doc:"Project ID"`
+	OverrideGitOps bool   `query:"overrideGitOps" default:"false" doc:"Edit the project even though it is managed by GitOps"`
+	Body           project.CreateCustomFile
+}
+
+type CreateProjectCustomFileOutput struct {
+	Body base.ApiResponse[project.Details]
+}
+
+type DeleteProjectFileInput struct {
+	EnvironmentID  string `path:"id" doc:"Environment ID"`
+	ProjectID      string `path:"projectId" doc:"Project ID"`
+	Path           string `query:"path" required:"true" doc:"File path relative to the project directory"`
+	Force          bool   `query:"force" default:"false" doc:"Delete the file even if the compose configuration references it"`
+	OverrideGitOps bool   `query:"overrideGitOps" default:"false" doc:"Edit the project even though it is managed by GitOps"`
+}
+
+type DeleteProjectFileOutput struct {
+	Body base.ApiResponse[project.DeleteFileResult]
+}
+
 type RestartProjectInput struct {
 	EnvironmentID string `path:"id" doc:"Environment ID"`
 	ProjectID     string `path:"projectId" doc:"Project ID"`
@@ -202,6 +357,19 @@ func RegisterProjects(api huma.API, projectService *services.ProjectService) {
 		},
 	}, h.GetProjectStatusCounts)
 
+	huma.Register(api, huma.Operation{
+		OperationID: "get-project-path-mapping",
+		Method:      http.MethodGet,
+		Path:        "/environments/{id}/projects/path-mapping",
+		Summary:     "Get project path mapping diagnostics",
+		Description: "Show how the projects directory resolves inside Arcane and on the Docker host, and whether compose paths are translated",
+		Tags:        []string{"Projects"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.GetProjectPathMapping)
+
 	huma.Register(api, huma.Operation{
 		OperationID: "deploy-project",
 		Method:      http.MethodPost,
@@ -254,6 +422,149 @@ func RegisterProjects(api huma.API, projectService *services.ProjectService) {
 		},
 	}, h.GetProject)
 
+	huma.Register(api, huma.Operation{
+		OperationID: "check-project-env",
+		Method:      http.MethodGet,
+		Path:        "/environments/{id}/projects/{projectId}/env-check",
+		Summary:     "Check project environment variables",
+		Description: "Compare the variables referenced by the project's compose files with its .env, the global env file and the process environment",
+		Tags:        []string{"Projects"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.CheckProjectEnv)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-project-quota",
+		Method:      http.MethodGet,
+		Path:        "/environments/{id}/projects/{projectId}/quota",
+		Summary:     "Get project resource quota",
+		Description: "Get the project's CPU and memory quota, how it is divided between services and the current usage",
+		Tags:        []string{"Projects"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.GetProjectQuota)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "update-project-quota",
+		Method:      http.MethodPut,
+		Path:        "/environments/{id}/projects/{projectId}/quota",
+		Summary:     "Update project resource quota",
+		Description: "Set the project's CPU and memory quota; it is enforced on the next deploy",
+		Tags:        []string{"Projects"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.UpdateProjectQuota)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "delete-project-quota",
+		Method:      http.MethodDelete,
+		Path:        "/environments/{id}/projects/{projectId}/quota",
+		Summary:     "Delete project resource quota",
+		Description: "Remove the project's CPU and memory quota",
+		Tags:        []string{"Projects"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.DeleteProjectQuota)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-project-registries",
+		Method:      http.MethodGet,
+		Path:        "/environments/{id}/projects/{projectId}/registries",
+		Summary:     "Get project registries",
+		Description: "List the container registries whose credentials are scoped to the project",
+		Tags:        []string{"Projects"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.GetProjectRegistries)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "update-project-registries",
+		Method:      http.MethodPut,
+		Path:        "/environments/{id}/projects/{projectId}/registries",
+		Summary:     "Update project registries",
+		Description: "Scope container registry credentials to the project; scoped credentials are only used for its pulls",
+		Tags:        []string{"Projects"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.UpdateProjectRegistries)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "lock-project",
+		Method:      http.MethodPost,
+		Path:        "/environments/{id}/projects/{projectId}/lock",
+		Summary:     "Lock a project",
+		Description: "Block deploy, down, destroy and update operations on the project for everyone but admins",
+		Tags:        []string{"Projects"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.LockProject)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "unlock-project",
+		Method:      http.MethodPost,
+		Path:        "/environments/{id}/projects/{projectId}/unlock",
+		Summary:     "Unlock a project",
+		Description: "Remove the project's lock; the reason is recorded in the project's events",
+		Tags:        []string{"Projects"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.UnlockProject)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "archive-project",
+		Method:      http.MethodPost,
+		Path:        "/environments/{id}/projects/{projectId}/archive",
+		Summary:     "Archive a project",
+		Description: "Bring the project down and leave it out of update checks, status polling and dashboard counts; its files and record are kept",
+		Tags:        []string{"Projects"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.ArchiveProject)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "unarchive-project",
+		Method:      http.MethodPost,
+		Path:        "/environments/{id}/projects/{projectId}/unarchive",
+		Summary:     "Unarchive a project",
+		Description: "Return an archived project to the stopped state so it can be deployed again",
+		Tags:        []string{"Projects"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.UnarchiveProject)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "clone-project",
+		Method:      http.MethodPost,
+		Path:        "/environments/{id}/projects/{projectId}/clone",
+		Summary:     "Clone a project",
+		Description: "Copy the project's files into a new project, rewriting its name, container names and published ports so both can run",
+		Tags:        []string{"Projects"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.CloneProject)
+
 	huma.Register(api, huma.Operation{
 		OperationID: "redeploy-project",
 		Method:      http.MethodPost,
@@ -293,6 +604,19 @@ func RegisterProjects(api huma.API, projectService *services.ProjectService) {
 		},
 	}, h.UpdateProject)
 
+	huma.Register(api, huma.Operation{
+		OperationID: "patch-project-service",
+		Method:      http.MethodPatch,
+		Path:        "/environments/{id}/projects/{projectId}/services/{serviceName}",
+		Summary:     "Patch a project service",
+		Description: "Change the image, tag, environment variables, replica count or published ports of one service in the compose file, keeping the rest of the file as written, and optionally redeploy just that service",
+		Tags:        []string{"Projects"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.PatchProjectService)
+
 	huma.Register(api, huma.Operation{
 		OperationID: "update-project-include",
 		Method:      http.MethodPut,
@@ -306,6 +630,45 @@ func RegisterProjects(api huma.API, projectService *services.ProjectService) {
 		},
 	}, h.UpdateProjectInclude)
 
+	huma.Register(api, huma.Operation{
+		OperationID: "list-custom-file-templates",
+		Method:      http.MethodGet,
+		Path:        "/environments/{id}/projects/custom-file-templates",
+		Summary:     "List custom file templates",
+		Description: "List the custom file types Arcane can template and validate, with their starting content",
+		Tags:        []string{"Projects"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.ListCustomFileTemplates)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "create-project-custom-file",
+		Method:      http.MethodPost,
+		Path:        "/environments/{id}/projects/{projectId}/files",
+		Summary:     "Create a project custom file",
+		Description: "Create a custom file in a project from a type template, validating its syntax",
+		Tags:        []string{"Projects"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.CreateProjectCustomFile)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "delete-project-file",
+		Method:      http.MethodDelete,
+		Path:        "/environments/{id}/projects/{projectId}/files",
+		Summary:     "Delete a project file",
+		Description: "Delete an include or custom file from a project. Files referenced by the compose configuration (include, env_file, volumes, configs, secrets) are only deleted with force",
+		Tags:        []string{"Projects"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.DeleteProjectFile)
+
 	huma.Register(api, huma.Operation{
 		OperationID: "restart-project",
 		Method:      http.MethodPost,
@@ -366,6 +729,7 @@ func (h *ProjectHandler) ListProjects(ctx context.Context, input *ListProjectsIn
 		},
 		Filters: map[string]string{
 			"status": input.Status,
+			"tags":   input.Tags,
 		},
 	}
 
@@ -374,6 +738,9 @@ func (h *ProjectHandler) ListProjects(ctx context.Context, input *ListProjectsIn
 		if errors.Is(err, context.Canceled) {
 			return nil, huma.Error500InternalServerError("Request was canceled")
 		}
+		if errors.Is(err, services.ErrTagInvalid) {
+			return nil, huma.Error400BadRequest((&common.ProjectListError{Err: err}).Error())
+		}
 		return nil, huma.Error500InternalServerError((&common.ProjectListError{Err: err}).Error())
 	}
 
@@ -419,6 +786,20 @@ func (h *ProjectHandler) GetProjectStatusCounts(ctx context.Context, input *GetP
 	}, nil
 }
 
+// GetProjectPathMapping returns diagnostics for the projects directory path mapping.
+func (h *ProjectHandler) GetProjectPathMapping(ctx context.Context, _ *GetProjectPathMappingInput) (*GetProjectPathMappingOutput, error) {
+	if h.projectService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	return &GetProjectPathMappingOutput{
+		Body: base.ApiResponse[project.PathMappingDiagnostics]{
+			Success: true,
+			Data:    *h.projectService.DiagnosePathMapping(ctx),
+		},
+	}, nil
+}
+
 // DeployProject deploys a Docker Compose project.
 func (h *ProjectHandler) DeployProject(ctx context.Context, input *DeployProjectInput) (*huma.StreamResponse, error) {
 	if h.projectService == nil {
@@ -477,7 +858,7 @@ func (h *ProjectHandler) DownProject(ctx context.Context, input *DownProjectInpu
 	}
 
 	if err := h.projectService.DownProject(ctx, input.ProjectID, *user); err != nil {
-		return nil, huma.Error500InternalServerError((&common.ProjectDownError{Err: err}).Error())
+		return nil, huma.NewError(projectActionStatusInternal(err, http.StatusInternalServerError), (&common.ProjectDownError{Err: err}).Error())
 	}
 
 	return &DownProjectOutput{
@@ -503,19 +884,13 @@ func (h *ProjectHandler) CreateProject(ctx context.Context, input *CreateProject
 
 	proj, err := h.projectService.CreateProject(ctx, input.Body.Name, input.Body.ComposeContent, input.Body.EnvContent, *user)
 	if err != nil {
-		return nil, huma.Error500InternalServerError((&common.ProjectCreationError{Err: err}).Error())
+		return nil, huma.NewError(projectActionStatusInternal(err, http.StatusInternalServerError), (&common.ProjectCreationError{Err: err}).Error())
 	}
 
-	var response project.CreateReponse
-	if err := mapper.MapStruct(proj, &response); err != nil {
+	response, err := toCreateProjectResponse(proj)
+	if err != nil {
 		return nil, huma.Error500InternalServerError("failed to map response")
 	}
-	response.Status = string(proj.Status)
-	response.StatusReason = proj.StatusReason
-	response.CreatedAt = proj.CreatedAt.Format(time.RFC3339)
-	response.UpdatedAt = proj.UpdatedAt.Format(time.RFC3339)
-	response.DirName = utils.DerefString(proj.DirName)
-	response.GitOpsManagedBy = proj.GitOpsManagedBy
 
 	return &CreateProjectOutput{
 		Body: base.ApiResponse[project.CreateReponse]{
@@ -525,6 +900,20 @@ func (h *ProjectHandler) CreateProject(ctx context.Context, input *CreateProject
 	}, nil
 }
 
+func toCreateProjectResponse(proj *models.Project) (project.CreateReponse, error) {
+	var response project.CreateReponse
+	if err := mapper.MapStruct(proj, &response); err != nil {
+		return response, err
+	}
+	response.Status = string(proj.Status)
+	response.StatusReason = proj.StatusReason
+	response.CreatedAt = proj.CreatedAt.Format(time.RFC3339)
+	response.UpdatedAt = proj.UpdatedAt.Format(time.RFC3339)
+	response.DirName = utils.DerefString(proj.DirName)
+	response.GitOpsManagedBy = proj.GitOpsManagedBy
+	return response, nil
+}
+
 // GetProject returns a project by ID.
 func (h *ProjectHandler) GetProject(ctx context.Context, input *GetProjectInput) (*GetProjectOutput, error) {
 	if h.projectService == nil {
@@ -548,8 +937,8 @@ func (h *ProjectHandler) GetProject(ctx context.Context, input *GetProjectInput)
 	}, nil
 }
 
-// RedeployProject redeploys a Docker Compose project.
-func (h *ProjectHandler) RedeployProject(ctx context.Context, input *RedeployProjectInput) (*RedeployProjectOutput, error) {
+// CheckProjectEnv reports missing, empty and unused project variables.
+func (h *ProjectHandler) CheckProjectEnv(ctx context.Context, input *CheckProjectEnvInput) (*CheckProjectEnvOutput, error) {
 	if h.projectService == nil {
 		return nil, huma.Error500InternalServerError("service not available")
 	}
@@ -558,13 +947,378 @@ func (h *ProjectHandler) RedeployProject(ctx context.Context, input *RedeployPro
 		return nil, huma.Error400BadRequest((&common.ProjectIDRequiredError{}).Error())
 	}
 
-	user, exists := humamw.GetCurrentUserFromContext(ctx)
+	check, err := h.projectService.CheckProjectEnv(ctx, input.ProjectID)
+	if err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.ProjectEnvCheckError{Err: err}).Error())
+	}
+
+	return &CheckProjectEnvOutput{
+		Body: base.ApiResponse[project.EnvCheck]{
+			Success: true,
+			Data:    *check,
+		},
+	}, nil
+}
+
+// GetProjectQuota returns a project's resource quota and usage.
+func (h *ProjectHandler) GetProjectQuota(ctx context.Context, input *GetProjectQuotaInput) (*GetProjectQuotaOutput, error) {
+	if h.projectService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if input.ProjectID == "" {
+		return nil, huma.Error400BadRequest((&common.ProjectIDRequiredError{}).Error())
+	}
+
+	status, err := h.projectService.GetProjectQuota(ctx, input.ProjectID)
+	if err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.ProjectQuotaRetrievalError{Err: err}).Error())
+	}
+
+	return &GetProjectQuotaOutput{
+		Body: base.ApiResponse[project.QuotaStatus]{
+			Success: true,
+			Data:    *status,
+		},
+	}, nil
+}
+
+// UpdateProjectQuota sets a project's resource quota.
+func (h *ProjectHandler) UpdateProjectQuota(ctx context.Context, input *UpdateProjectQuotaInput) (*UpdateProjectQuotaOutput, error) {
+	if h.projectService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if input.ProjectID == "" {
+		return nil, huma.Error400BadRequest((&common.ProjectIDRequiredError{}).Error())
+	}
+
+	status, err := h.projectService.UpdateProjectQuota(ctx, input.ProjectID, input.Body)
+	if err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.ProjectQuotaUpdateError{Err: err}).Error())
+	}
+
+	return &UpdateProjectQuotaOutput{
+		Body: base.ApiResponse[project.QuotaStatus]{
+			Success: true,
+			Data:    *status,
+		},
+	}, nil
+}
+
+// DeleteProjectQuota removes a project's resource quota.
+func (h *ProjectHandler) DeleteProjectQuota(ctx context.Context, input *DeleteProjectQuotaInput) (*DeleteProjectQuotaOutput, error) {
+	if h.projectService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if input.ProjectID == "" {
+		return nil, huma.Error400BadRequest((&common.ProjectIDRequiredError{}).Error())
+	}
+
+	if err := h.projectService.DeleteProjectQuota(ctx, input.ProjectID); err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.ProjectQuotaDeleteError{Err: err}).Error())
+	}
+
+	return &DeleteProjectQuotaOutput{
+		Body: base.ApiResponse[base.MessageResponse]{
+			Success: true,
+			Data: base.MessageResponse{
+				Message: "Project quota removed",
+			},
+		},
+	}, nil
+}
+
+// GetProjectRegistries returns the registries scoped to a project.
+func (h *ProjectHandler) GetProjectRegistries(ctx context.Context, input *GetProjectRegistriesInput) (*ProjectRegistriesOutput, error) {
+	if h.projectService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if input.ProjectID == "" {
+		return nil, huma.Error400BadRequest((&common.ProjectIDRequiredError{}).Error())
+	}
+
+	registries, err := h.projectService.GetProjectRegistries(ctx, input.ProjectID)
+	if err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.ProjectRegistriesError{Err: err}).Error())
+	}
+
+	return &ProjectRegistriesOutput{
+		Body: base.ApiResponse[project.Registries]{
+			Success: true,
+			Data:    *registries,
+		},
+	}, nil
+}
+
+// UpdateProjectRegistries replaces the registries scoped to a project.
+func (h *ProjectHandler) UpdateProjectRegistries(ctx context.Context, input *UpdateProjectRegistriesInput) (*ProjectRegistriesOutput, error) {
+	if h.projectService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if input.ProjectID == "" {
+		return nil, huma.Error400BadRequest((&common.ProjectIDRequiredError{}).Error())
+	}
+
+	user, exists := humamw.GetCurrentUserFromContext(ctx)
+	if !exists {
+		return nil, huma.Error401Unauthorized((&common.NotAuthenticatedError{}).Error())
+	}
+
+	registries, err := h.projectService.UpdateProjectRegistries(ctx, input.ProjectID, input.Body, *user)
+	if err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.ProjectRegistriesError{Err: err}).Error())
+	}
+
+	return &ProjectRegistriesOutput{
+		Body: base.ApiResponse[project.Registries]{
+			Success: true,
+			Data:    *registries,
+		},
+	}, nil
+}
+
+// LockProject locks a project against changes.
+func (h *ProjectHandler) LockProject(ctx context.Context, input *LockProjectInput) (*LockProjectOutput, error) {
+	if h.projectService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if input.ProjectID == "" {
+		return nil, huma.Error400BadRequest((&common.ProjectIDRequiredError{}).Error())
+	}
+
+	user, exists := humamw.GetCurrentUserFromContext(ctx)
+	if !exists {
+		return nil, huma.Error401Unauthorized((&common.NotAuthenticatedError{}).Error())
+	}
+
+	reason := ""
+	if input.Body != nil {
+		reason = input.Body.Reason
+	}
+
+	if _, err := h.projectService.LockProject(ctx, input.ProjectID, reason, *user); err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.ProjectLockError{Err: err}).Error())
+	}
+
+	details, err := h.projectService.GetProjectDetails(ctx, input.ProjectID)
+	if err != nil {
+		return nil, huma.Error500InternalServerError((&common.ProjectDetailsError{Err: err}).Error())
+	}
+
+	return &LockProjectOutput{
+		Body: base.ApiResponse[project.Details]{
+			Success: true,
+			Data:    details,
+		},
+	}, nil
+}
+
+// UnlockProject removes a project's lock.
+func (h *ProjectHandler) UnlockProject(ctx context.Context, input *UnlockProjectInput) (*UnlockProjectOutput, error) {
+	if h.projectService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if input.ProjectID == "" {
+		return nil, huma.Error400BadRequest((&common.ProjectIDRequiredError{}).Error())
+	}
+
+	user, exists := humamw.GetCurrentUserFromContext(ctx)
+	if !exists {
+		return nil, huma.Error401Unauthorized((&common.NotAuthenticatedError{}).Error())
+	}
+
+	if _, err := h.projectService.UnlockProject(ctx, input.ProjectID, input.Body.Reason, *user); err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.ProjectUnlockError{Err: err}).Error())
+	}
+
+	details, err := h.projectService.GetProjectDetails(ctx, input.ProjectID)
+	if err != nil {
+		return nil, huma.Error500InternalServerError((&common.ProjectDetailsError{Err: err}).Error())
+	}
+
+	return &UnlockProjectOutput{
+		Body: base.ApiResponse[project.Details]{
+			Success: true,
+			Data:    details,
+		},
+	}, nil
+}
+
+// ArchiveProject brings a project down and archives it.
+func (h *ProjectHandler) ArchiveProject(ctx context.Context, input *ArchiveProjectInput) (*ArchiveProjectOutput, error) {
+	if h.projectService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if input.ProjectID == "" {
+		return nil, huma.Error400BadRequest((&common.ProjectIDRequiredError{}).Error())
+	}
+
+	user, exists := humamw.GetCurrentUserFromContext(ctx)
+	if !exists {
+		return nil, huma.Error401Unauthorized((&common.NotAuthenticatedError{}).Error())
+	}
+
+	if _, err := h.projectService.ArchiveProject(ctx, input.ProjectID, *user); err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.ProjectArchiveError{Err: err}).Error())
+	}
+
+	details, err := h.projectService.GetProjectDetails(ctx, input.ProjectID)
+	if err != nil {
+		return nil, huma.Error500InternalServerError((&common.ProjectDetailsError{Err: err}).Error())
+	}
+
+	return &ArchiveProjectOutput{
+		Body: base.ApiResponse[project.Details]{
+			Success: true,
+			Data:    details,
+		},
+	}, nil
+}
+
+// UnarchiveProject returns an archived project to the stopped state.
+func (h *ProjectHandler) UnarchiveProject(ctx context.Context, input *UnarchiveProjectInput) (*UnarchiveProjectOutput, error) {
+	if h.projectService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if input.ProjectID == "" {
+		return nil, huma.Error400BadRequest((&common.ProjectIDRequiredError{}).Error())
+	}
+
+	user, exists := humamw.GetCurrentUserFromContext(ctx)
+	if !exists {
+		return nil, huma.Error401Unauthorized((&common.NotAuthenticatedError{}).Error())
+	}
+
+	if _, err := h.projectService.UnarchiveProject(ctx, input.ProjectID, *user); err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.ProjectUnarchiveError{Err: err}).Error())
+	}
+
+	details, err := h.projectService.GetProjectDetails(ctx, input.ProjectID)
+	if err != nil {
+		return nil, huma.Error500InternalServerError((&common.ProjectDetailsError{Err: err}).Error())
+	}
+
+	return &UnarchiveProjectOutput{
+		Body: base.ApiResponse[project.Details]{
+			Success: true,
+			Data:    details,
+		},
+	}, nil
+}
+
+// CloneProject copies a project into a new one.
+func (h *ProjectHandler) CloneProject(ctx context.Context, input *CloneProjectInput) (*CloneProjectOutput, error) {
+	if h.projectService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if input.ProjectID == "" {
+		return nil, huma.Error400BadRequest((&common.ProjectIDRequiredError{}).Error())
+	}
+
+	user, exists := humamw.GetCurrentUserFromContext(ctx)
+	if !exists {
+		return nil, huma.Error401Unauthorized((&common.NotAuthenticatedError{}).Error())
+	}
+
+	clone, changes, err := h.projectService.CloneProject(ctx, input.ProjectID, input.Body.Name, input.Body.CopyData, *user)
+	if err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.ProjectCloneError{Err: err}).Error())
+	}
+
+	details, err := h.projectService.GetProjectDetails(ctx, clone.ID)
+	if err != nil {
+		return nil, huma.Error500InternalServerError((&common.ProjectDetailsError{Err: err}).Error())
+	}
+
+	return &CloneProjectOutput{
+		Body: base.ApiResponse[project.CloneResult]{
+			Success: true,
+			Data: project.CloneResult{
+				Project: details,
+				Changes: changes,
+			},
+		},
+	}, nil
+}
+
+// projectActionStatusInternal returns 409 when the project's state, such as
+// a lock or the archived status, rejects the action, 422 when the compose
+// file or the host's devices reject it, and fallback otherwise.
+func projectActionStatusInternal(err error, fallback int) int {
+	var conflictErr *models.ConflictError
+	if errors.As(err, &conflictErr) {
+		return http.StatusConflict
+	}
+	var lintErr *projects.LintError
+	if errors.As(err, &lintErr) {
+		return http.StatusUnprocessableEntity
+	}
+	var syntaxErr *projects.FileSyntaxError
+	if errors.As(err, &syntaxErr) {
+		return http.StatusUnprocessableEntity
+	}
+	var deviceErr *projects.DeviceError
+	if errors.As(err, &deviceErr) {
+		return http.StatusUnprocessableEntity
+	}
+	return fallback
+}
+
+// fileSyntaxErrorDetailsInternal turns the issues of a *projects.FileSyntaxError
+// into error details, so clients can point at the offending lines.
+func fileSyntaxErrorDetailsInternal(err error) []error {
+	var syntaxErr *projects.FileSyntaxError
+	if !errors.As(err, &syntaxErr) {
+		return nil
+	}
+	details := make([]error, 0, len(syntaxErr.Issues))
+	for _, issue := range syntaxErr.Issues {
+		details = append(details, &huma.ErrorDetail{
+			Message:  issue.Message,
+			Location: fmt.Sprintf("body.content:%d:%d", issue.Line, issue.Column),
+			Value:    issue,
+		})
+	}
+	return details
+}
+
+// RedeployProject redeploys a Docker Compose project.
+func (h *ProjectHandler) RedeployProject(ctx context.Context, input *RedeployProjectInput) (*RedeployProjectOutput, error) {
+	if h.projectService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if input.ProjectID == "" {
+		return nil, huma.Error400BadRequest((&common.ProjectIDRequiredError{}).Error())
+	}
+
+	user, exists := humamw.GetCurrentUserFromContext(ctx)
 	if !exists {
 		return nil, huma.Error401Unauthorized((&common.NotAuthenticatedError{}).Error())
 	}
 
 	if err := h.projectService.RedeployProject(ctx, input.ProjectID, *user); err != nil {
-		return nil, huma.Error400BadRequest((&common.ProjectRedeploymentError{Err: err}).Error())
+		return nil, huma.NewError(projectActionStatusInternal(err, http.StatusBadRequest), (&common.ProjectRedeploymentError{Err: err}).Error())
 	}
 
 	return &RedeployProjectOutput{
@@ -603,7 +1357,7 @@ func (h *ProjectHandler) DestroyProject(ctx context.Context, input *DestroyProje
 	}
 
 	if err := h.projectService.DestroyProject(ctx, input.ProjectID, removeFiles, removeVolumes, *user); err != nil {
-		return nil, huma.Error500InternalServerError((&common.ProjectDestroyError{Err: err}).Error())
+		return nil, huma.NewError(projectActionStatusInternal(err, http.StatusInternalServerError), (&common.ProjectDestroyError{Err: err}).Error())
 	}
 
 	return &DestroyProjectOutput{
@@ -631,8 +1385,12 @@ func (h *ProjectHandler) UpdateProject(ctx context.Context, input *UpdateProject
 		return nil, huma.Error401Unauthorized((&common.NotAuthenticatedError{}).Error())
 	}
 
+	if input.OverrideGitOps {
+		ctx = services.WithGitOpsOverride(ctx)
+	}
+
 	if _, err := h.projectService.UpdateProject(ctx, input.ProjectID, input.Body.Name, input.Body.ComposeContent, input.Body.EnvContent, *user); err != nil {
-		return nil, huma.Error400BadRequest((&common.ProjectUpdateError{Err: err}).Error())
+		return nil, huma.NewError(projectActionStatusInternal(err, http.StatusBadRequest), (&common.ProjectUpdateError{Err: err}).Error())
 	}
 
 	details, err := h.projectService.GetProjectDetails(ctx, input.ProjectID)
@@ -648,6 +1406,44 @@ func (h *ProjectHandler) UpdateProject(ctx context.Context, input *UpdateProject
 	}, nil
 }
 
+// PatchProjectService changes single fields of one service in a project's
+// compose file.
+func (h *ProjectHandler) PatchProjectService(ctx context.Context, input *PatchProjectServiceInput) (*PatchProjectServiceOutput, error) {
+	if h.projectService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if input.ProjectID == "" {
+		return nil, huma.Error400BadRequest((&common.ProjectIDRequiredError{}).Error())
+	}
+
+	user, exists := humamw.GetCurrentUserFromContext(ctx)
+	if !exists {
+		return nil, huma.Error401Unauthorized((&common.NotAuthenticatedError{}).Error())
+	}
+
+	if input.OverrideGitOps {
+		ctx = services.WithGitOpsOverride(ctx)
+	}
+
+	result, err := h.projectService.PatchProjectService(ctx, input.ProjectID, input.ServiceName, input.Body, *user)
+	if err != nil {
+		status := projectActionStatusInternal(err, http.StatusBadRequest)
+		var notFoundErr *models.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			status = http.StatusNotFound
+		}
+		return nil, huma.NewError(status, (&common.ProjectUpdateError{Err: err}).Error())
+	}
+
+	return &PatchProjectServiceOutput{
+		Body: base.ApiResponse[project.ServicePatchResult]{
+			Success: true,
+			Data:    *result,
+		},
+	}, nil
+}
+
 // UpdateProjectInclude updates an include file within a project.
 func (h *ProjectHandler) UpdateProjectInclude(ctx context.Context, input *UpdateProjectIncludeInput) (*UpdateProjectIncludeOutput, error) {
 	if h.projectService == nil {
@@ -663,8 +1459,12 @@ func (h *ProjectHandler) UpdateProjectInclude(ctx context.Context, input *Update
 		return nil, huma.Error401Unauthorized((&common.NotAuthenticatedError{}).Error())
 	}
 
+	if input.OverrideGitOps {
+		ctx = services.WithGitOpsOverride(ctx)
+	}
+
 	if err := h.projectService.UpdateProjectIncludeFile(ctx, input.ProjectID, input.Body.RelativePath, input.Body.Content, *user); err != nil {
-		return nil, huma.Error400BadRequest((&common.ProjectUpdateError{Err: err}).Error())
+		return nil, huma.NewError(projectActionStatusInternal(err, http.StatusBadRequest), (&common.ProjectUpdateError{Err: err}).Error(), fileSyntaxErrorDetailsInternal(err)...)
 	}
 
 	details, err := h.projectService.GetProjectDetails(ctx, input.ProjectID)
@@ -680,6 +1480,86 @@ func (h *ProjectHandler) UpdateProjectInclude(ctx context.Context, input *Update
 	}, nil
 }
 
+// ListCustomFileTemplates returns the custom file templates.
+func (h *ProjectHandler) ListCustomFileTemplates(_ context.Context, _ *ListCustomFileTemplatesInput) (*ListCustomFileTemplatesOutput, error) {
+	return &ListCustomFileTemplatesOutput{
+		Body: base.ApiResponse[[]project.CustomFileTemplate]{
+			Success: true,
+			Data:    projects.CustomFileTemplates(),
+		},
+	}, nil
+}
+
+// CreateProjectCustomFile creates a custom file within a project.
+func (h *ProjectHandler) CreateProjectCustomFile(ctx context.Context, input *CreateProjectCustomFileInput) (*CreateProjectCustomFileOutput, error) {
+	if h.projectService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if input.ProjectID == "" {
+		return nil, huma.Error400BadRequest((&common.ProjectIDRequiredError{}).Error())
+	}
+
+	user, exists := humamw.GetCurrentUserFromContext(ctx)
+	if !exists {
+		return nil, huma.Error401Unauthorized((&common.NotAuthenticatedError{}).Error())
+	}
+
+	if input.OverrideGitOps {
+		ctx = services.WithGitOpsOverride(ctx)
+	}
+
+	if err := h.projectService.CreateProjectCustomFile(ctx, input.ProjectID, input.Body, *user); err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(projectActionStatusInternal(err, apiErr.HTTPStatus()), (&common.ProjectUpdateError{Err: err}).Error(), fileSyntaxErrorDetailsInternal(err)...)
+	}
+
+	details, err := h.projectService.GetProjectDetails(ctx, input.ProjectID)
+	if err != nil {
+		return nil, huma.Error500InternalServerError((&common.ProjectDetailsError{Err: err}).Error())
+	}
+
+	return &CreateProjectCustomFileOutput{
+		Body: base.ApiResponse[project.Details]{
+			Success: true,
+			Data:    details,
+		},
+	}, nil
+}
+
+// DeleteProjectFile deletes a file from a project after checking compose references to it.
+func (h *ProjectHandler) DeleteProjectFile(ctx context.Context, input *DeleteProjectFileInput) (*DeleteProjectFileOutput, error) {
+	if h.projectService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if input.ProjectID == "" {
+		return nil, huma.Error400BadRequest((&common.ProjectIDRequiredError{}).Error())
+	}
+
+	user, exists := humamw.GetCurrentUserFromContext(ctx)
+	if !exists {
+		return nil, huma.Error401Unauthorized((&common.NotAuthenticatedError{}).Error())
+	}
+
+	if input.OverrideGitOps {
+		ctx = services.WithGitOpsOverride(ctx)
+	}
+
+	result, err := h.projectService.DeleteProjectFile(ctx, input.ProjectID, input.Path, input.Force, *user)
+	if err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.ProjectFileDeleteError{Err: err}).Error())
+	}
+
+	return &DeleteProjectFileOutput{
+		Body: base.ApiResponse[project.DeleteFileResult]{
+			Success: true,
+			Data:    *result,
+		},
+	}, nil
+}
+
 // RestartProject restarts all containers in a project.
 func (h *ProjectHandler) RestartProject(ctx context.Context, input *RestartProjectInput) (*RestartProjectOutput, error) {
 	if h.projectService == nil {
@@ -696,7 +1576,7 @@ func (h *ProjectHandler) RestartProject(ctx context.Context, input *RestartProje
 	}
 
 	if err := h.projectService.RestartProject(ctx, input.ProjectID, *user); err != nil {
-		return nil, huma.Error400BadRequest((&common.ProjectRestartError{Err: err}).Error())
+		return nil, huma.NewError(projectActionStatusInternal(err, http.StatusBadRequest), (&common.ProjectRestartError{Err: err}).Error())
 	}
 
 	return &RestartProjectOutput{
@@ -0,0 +1,212 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	humamw "github.com/getarcaneapp/arcane/backend/internal/huma/middleware"
+	"github.com/getarcaneapp/arcane/backend/internal/services"
+	"github.com/getarcaneapp/arcane/types/base"
+	"github.com/getarcaneapp/arcane/types/rbac"
+)
+
+// RbacHandler provides Huma-based role binding management endpoints.
+type RbacHandler struct {
+	rbacService *services.RbacService
+}
+
+// --- Huma Input/Output Wrappers ---
+
+type ListRoleBindingsInput struct {
+	UserID string `query:"userId" doc:"Only return bindings for this user"`
+}
+
+type ListRoleBindingsOutput struct {
+	Body base.ApiResponse[[]rbac.RoleBinding]
+}
+
+type CreateRoleBindingInput struct {
+	Body rbac.CreateRoleBinding
+}
+
+type CreateRoleBindingOutput struct {
+	Body base.ApiResponse[rbac.RoleBinding]
+}
+
+type DeleteRoleBindingInput struct {
+	BindingID string `path:"bindingId" doc:"Role binding ID"`
+}
+
+type DeleteRoleBindingOutput struct {
+	Body base.ApiResponse[base.MessageResponse]
+}
+
+type GetEffectiveRoleInput struct {
+	EnvironmentID string `query:"environmentId" doc:"Environment to evaluate (global scope when empty)"`
+	ProjectID     string `query:"projectId" doc:"Project to evaluate within the environment"`
+}
+
+type GetEffectiveRoleOutput struct {
+	Body base.ApiResponse[rbac.EffectiveRole]
+}
+
+// RegisterRbac registers role binding management routes using Huma.
+func RegisterRbac(api huma.API, rbacService *services.RbacService) {
+	h := &RbacHandler{
+		rbacService: rbacService,
+	}
+
+	huma.Register(api, huma.Operation{
+		OperationID: "list-role-bindings",
+		Method:      http.MethodGet,
+		Path:        "/role-bindings",
+		Summary:     "List role bindings",
+		Description: "List environment and project scoped role assignments",
+		Tags:        []string{"Access Control"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.ListRoleBindings)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "create-role-binding",
+		Method:      http.MethodPost,
+		Path:        "/role-bindings",
+		Summary:     "Assign a role",
+		Description: "Assign a viewer, operator or admin role to a user globally, per environment or per project",
+		Tags:        []string{"Access Control"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.CreateRoleBinding)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "delete-role-binding",
+		Method:      http.MethodDelete,
+		Path:        "/role-bindings/{bindingId}",
+		Summary:     "Remove a role assignment",
+		Description: "Delete a role binding by ID",
+		Tags:        []string{"Access Control"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.DeleteRoleBinding)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-effective-role",
+		Method:      http.MethodGet,
+		Path:        "/role-bindings/me",
+		Summary:     "Get my effective role",
+		Description: "Resolve the current user's role for an environment and optional project",
+		Tags:        []string{"Access Control"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.GetEffectiveRole)
+}
+
+// ListRoleBindings returns all role bindings, optionally filtered by user.
+func (h *RbacHandler) ListRoleBindings(ctx context.Context, input *ListRoleBindingsInput) (*ListRoleBindingsOutput, error) {
+	if h.rbacService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	bindings, err := h.rbacService.ListRoleBindings(ctx, input.UserID)
+	if err != nil {
+		return nil, huma.Error500InternalServerError(err.Error())
+	}
+
+	return &ListRoleBindingsOutput{
+		Body: base.ApiResponse[[]rbac.RoleBinding]{
+			Success: true,
+			Data:    bindings,
+		},
+	}, nil
+}
+
+// CreateRoleBinding assigns a role to a user.
+func (h *RbacHandler) CreateRoleBinding(ctx context.Context, input *CreateRoleBindingInput) (*CreateRoleBindingOutput, error) {
+	if h.rbacService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	binding, err := h.rbacService.CreateRoleBinding(ctx, input.Body)
+	if err != nil {
+		if errors.Is(err, services.ErrRoleBindingInvalid) {
+			return nil, huma.Error400BadRequest(err.Error())
+		}
+		return nil, huma.Error500InternalServerError(err.Error())
+	}
+
+	return &CreateRoleBindingOutput{
+		Body: base.ApiResponse[rbac.RoleBinding]{
+			Success: true,
+			Data:    *binding,
+		},
+	}, nil
+}
+
+// DeleteRoleBinding removes a role binding.
+func (h *RbacHandler) DeleteRoleBinding(ctx context.Context, input *DeleteRoleBindingInput) (*DeleteRoleBindingOutput, error) {
+	if h.rbacService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if err := h.rbacService.DeleteRoleBinding(ctx, input.BindingID); err != nil {
+		if errors.Is(err, services.ErrRoleBindingNotFound) {
+			return nil, huma.Error404NotFound(err.Error())
+		}
+		return nil, huma.Error500InternalServerError(err.Error())
+	}
+
+	return &DeleteRoleBindingOutput{
+		Body: base.ApiResponse[base.MessageResponse]{
+			Success: true,
+			Data: base.MessageResponse{
+				Message: "Role binding deleted successfully",
+			},
+		},
+	}, nil
+}
+
+// GetEffectiveRole resolves the current user's role for the requested scope.
+func (h *RbacHandler) GetEffectiveRole(ctx context.Context, input *GetEffectiveRoleInput) (*GetEffectiveRoleOutput, error) {
+	if h.rbacService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	user, ok := humamw.GetCurrentUserFromContext(ctx)
+	if !ok {
+		return nil, huma.Error401Unauthorized("not authenticated")
+	}
+
+	effective, err := h.rbacService.ResolveRole(ctx, user, input.EnvironmentID, input.ProjectID)
+	if err != nil {
+		return nil, huma.Error500InternalServerError(err.Error())
+	}
+
+	return &GetEffectiveRoleOutput{
+		Body: base.ApiResponse[rbac.EffectiveRole]{
+			Success: true,
+			Data:    effective,
+		},
+	}, nil
+}
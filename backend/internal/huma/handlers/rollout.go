@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/getarcaneapp/arcane/backend/internal/common"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/services"
+	"github.com/getarcaneapp/arcane/types/base"
+	"github.com/getarcaneapp/arcane/types/rbac"
+	"github.com/getarcaneapp/arcane/types/updater"
+)
+
+// RolloutHandler provides the staged update rollout endpoints.
+type RolloutHandler struct {
+	rolloutService *services.RolloutService
+}
+
+// --- Huma Input/Output Wrappers ---
+
+type GetRolloutInput struct{}
+
+type GetRolloutOutput struct {
+	Body base.ApiResponse[*updater.Rollout]
+}
+
+type StartRolloutInput struct{}
+
+type StartRolloutOutput struct {
+	Body base.ApiResponse[*updater.Rollout]
+}
+
+// RegisterRollout registers the staged rollout routes using Huma.
+func RegisterRollout(api huma.API, rolloutService *services.RolloutService) {
+	h := &RolloutHandler{
+		rolloutService: rolloutService,
+	}
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-update-rollout",
+		Method:      http.MethodGet,
+		Path:        "/updater/rollout",
+		Summary:     "Get update rollout",
+		Description: "Get the running or most recent staged update rollout across environments",
+		Tags:        []string{"Updater"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.GetRollout)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "start-update-rollout",
+		Method:      http.MethodPost,
+		Path:        "/updater/rollout",
+		Summary:     "Start update rollout",
+		Description: "Apply pending updates to the configured environment stages in order, halting if a stage fails or is unhealthy after its soak period",
+		Tags:        []string{"Updater"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.StartRollout)
+}
+
+// GetRollout returns the running or most recent rollout.
+func (h *RolloutHandler) GetRollout(ctx context.Context, _ *GetRolloutInput) (*GetRolloutOutput, error) {
+	if h.rolloutService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	return &GetRolloutOutput{
+		Body: base.ApiResponse[*updater.Rollout]{
+			Success: true,
+			Data:    h.rolloutService.Current(),
+		},
+	}, nil
+}
+
+// StartRollout starts a rollout in the background.
+func (h *RolloutHandler) StartRollout(ctx context.Context, _ *StartRolloutInput) (*StartRolloutOutput, error) {
+	if h.rolloutService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	rollout, err := h.rolloutService.Start(updaterTriggerContextInternal(ctx), "manual")
+	if err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.RolloutError{Err: err}).Error())
+	}
+
+	return &StartRolloutOutput{
+		Body: base.ApiResponse[*updater.Rollout]{
+			Success: true,
+			Data:    rollout,
+		},
+	}, nil
+}
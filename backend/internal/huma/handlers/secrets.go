@@ -0,0 +1,207 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/getarcaneapp/arcane/backend/internal/common"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/services"
+	"github.com/getarcaneapp/arcane/types/base"
+	"github.com/getarcaneapp/arcane/types/rbac"
+	"github.com/getarcaneapp/arcane/types/secret"
+)
+
+// SecretsHandler handles shared secret management endpoints.
+type SecretsHandler struct {
+	secretsService *services.SecretsService
+}
+
+// --- Huma Input/Output Wrappers ---
+
+type ListSecretsInput struct{}
+
+type ListSecretsOutput struct {
+	Body base.ApiResponse[[]secret.Secret]
+}
+
+type CreateSecretInput struct {
+	Body secret.CreateRequest
+}
+
+type CreateSecretOutput struct {
+	Body base.ApiResponse[secret.Secret]
+}
+
+type UpdateSecretInput struct {
+	ID   string `path:"id" doc:"Secret ID"`
+	Body secret.UpdateRequest
+}
+
+type UpdateSecretOutput struct {
+	Body base.ApiResponse[secret.Secret]
+}
+
+type DeleteSecretInput struct {
+	ID string `path:"id" doc:"Secret ID"`
+}
+
+type DeleteSecretOutput struct {
+	Body base.ApiResponse[base.MessageResponse]
+}
+
+// RegisterSecrets registers shared secret routes using Huma.
+func RegisterSecrets(api huma.API, secretsService *services.SecretsService) {
+	h := &SecretsHandler{
+		secretsService: secretsService,
+	}
+
+	huma.Register(api, huma.Operation{
+		OperationID: "list-secrets",
+		Method:      http.MethodGet,
+		Path:        "/secrets",
+		Summary:     "List secrets",
+		Description: "List shared secrets. Values are never returned.",
+		Tags:        []string{"Secrets"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.ListSecrets)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "create-secret",
+		Method:      http.MethodPost,
+		Path:        "/secrets",
+		Summary:     "Create a secret",
+		Description: "Create a shared secret that project env files can reference as ${arcane:name}",
+		Tags:        []string{"Secrets"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.CreateSecret)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "update-secret",
+		Method:      http.MethodPut,
+		Path:        "/secrets/{id}",
+		Summary:     "Update a secret",
+		Description: "Replace the value or description of a shared secret",
+		Tags:        []string{"Secrets"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.UpdateSecret)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "delete-secret",
+		Method:      http.MethodDelete,
+		Path:        "/secrets/{id}",
+		Summary:     "Delete a secret",
+		Description: "Delete a shared secret",
+		Tags:        []string{"Secrets"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.DeleteSecret)
+}
+
+// ListSecrets returns all shared secrets without their values.
+func (h *SecretsHandler) ListSecrets(ctx context.Context, _ *ListSecretsInput) (*ListSecretsOutput, error) {
+	if h.secretsService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	secrets, err := h.secretsService.ListSecrets(ctx)
+	if err != nil {
+		return nil, huma.Error500InternalServerError((&common.SecretListError{Err: err}).Error())
+	}
+
+	return &ListSecretsOutput{
+		Body: base.ApiResponse[[]secret.Secret]{
+			Success: true,
+			Data:    secrets,
+		},
+	}, nil
+}
+
+// CreateSecret stores a new shared secret.
+func (h *SecretsHandler) CreateSecret(ctx context.Context, input *CreateSecretInput) (*CreateSecretOutput, error) {
+	if h.secretsService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	created, err := h.secretsService.CreateSecret(ctx, input.Body)
+	if err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.SecretCreationError{Err: err}).Error())
+	}
+
+	return &CreateSecretOutput{
+		Body: base.ApiResponse[secret.Secret]{
+			Success: true,
+			Data:    *created,
+		},
+	}, nil
+}
+
+// UpdateSecret updates a shared secret.
+func (h *SecretsHandler) UpdateSecret(ctx context.Context, input *UpdateSecretInput) (*UpdateSecretOutput, error) {
+	if h.secretsService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	updated, err := h.secretsService.UpdateSecret(ctx, input.ID, input.Body)
+	if err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.SecretUpdateError{Err: err}).Error())
+	}
+
+	return &UpdateSecretOutput{
+		Body: base.ApiResponse[secret.Secret]{
+			Success: true,
+			Data:    *updated,
+		},
+	}, nil
+}
+
+// DeleteSecret deletes a shared secret.
+func (h *SecretsHandler) DeleteSecret(ctx context.Context, input *DeleteSecretInput) (*DeleteSecretOutput, error) {
+	if h.secretsService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if err := h.secretsService.DeleteSecret(ctx, input.ID); err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.SecretDeletionError{Err: err}).Error())
+	}
+
+	return &DeleteSecretOutput{
+		Body: base.ApiResponse[base.MessageResponse]{
+			Success: true,
+			Data: base.MessageResponse{
+				Message: "Secret deleted successfully",
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,171 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/getarcaneapp/arcane/backend/internal/common"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/services"
+	"github.com/getarcaneapp/arcane/types/base"
+	"github.com/getarcaneapp/arcane/types/rbac"
+	"github.com/getarcaneapp/arcane/types/securityaudit"
+)
+
+// SecurityAuditHandler provides the container security audit endpoints.
+type SecurityAuditHandler struct {
+	securityAuditService *services.SecurityAuditService
+}
+
+// --- Huma Input/Output Wrappers ---
+
+type GetHostSecurityAuditInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+}
+
+type GetHostSecurityAuditOutput struct {
+	Body base.ApiResponse[securityaudit.Report]
+}
+
+type GetProjectSecurityAuditInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	ProjectID     string `path:"projectId" doc:"Project ID"`
+}
+
+type GetProjectSecurityAuditOutput struct {
+	Body base.ApiResponse[securityaudit.Report]
+}
+
+type ExportSecurityAuditInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	Format        string `query:"format" default:"json" enum:"json,csv" doc:"Export format"`
+}
+
+type ExportSecurityAuditOutput struct {
+	ContentType        string `header:"Content-Type"`
+	ContentDisposition string `header:"Content-Disposition"`
+	Body               []byte
+}
+
+// RegisterSecurityAudit registers the security audit routes using Huma.
+func RegisterSecurityAudit(api huma.API, securityAuditService *services.SecurityAuditService) {
+	h := &SecurityAuditHandler{
+		securityAuditService: securityAuditService,
+	}
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-host-security-audit",
+		Method:      http.MethodGet,
+		Path:        "/environments/{id}/security/audit",
+		Summary:     "Audit container security",
+		Description: "Check every container on the host against hardening best practices and score them",
+		Tags:        []string{"Security"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.GetHostSecurityAudit)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-project-security-audit",
+		Method:      http.MethodGet,
+		Path:        "/environments/{id}/projects/{projectId}/security/audit",
+		Summary:     "Audit project security",
+		Description: "Check the containers of a project against hardening best practices and score them",
+		Tags:        []string{"Projects"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.GetProjectSecurityAudit)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "export-security-audit",
+		Method:      http.MethodGet,
+		Path:        "/environments/{id}/security/audit/export",
+		Summary:     "Export container security audit",
+		Description: "Export the host security audit as JSON, or as CSV with one row per finding",
+		Tags:        []string{"Security"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.ExportSecurityAudit)
+}
+
+// GetHostSecurityAudit audits every container on the host.
+func (h *SecurityAuditHandler) GetHostSecurityAudit(ctx context.Context, _ *GetHostSecurityAuditInput) (*GetHostSecurityAuditOutput, error) {
+	if h.securityAuditService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	report, err := h.securityAuditService.AuditHost(ctx)
+	if err != nil {
+		return nil, huma.Error500InternalServerError((&common.SecurityAuditError{Err: err}).Error())
+	}
+
+	return &GetHostSecurityAuditOutput{
+		Body: base.ApiResponse[securityaudit.Report]{
+			Success: true,
+			Data:    *report,
+		},
+	}, nil
+}
+
+// GetProjectSecurityAudit audits the containers of a project.
+func (h *SecurityAuditHandler) GetProjectSecurityAudit(ctx context.Context, input *GetProjectSecurityAuditInput) (*GetProjectSecurityAuditOutput, error) {
+	if h.securityAuditService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if input.ProjectID == "" {
+		return nil, huma.Error400BadRequest((&common.ProjectIDRequiredError{}).Error())
+	}
+
+	report, err := h.securityAuditService.AuditProject(ctx, input.ProjectID)
+	if err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.SecurityAuditError{Err: err}).Error())
+	}
+
+	return &GetProjectSecurityAuditOutput{
+		Body: base.ApiResponse[securityaudit.Report]{
+			Success: true,
+			Data:    *report,
+		},
+	}, nil
+}
+
+// ExportSecurityAudit returns the host audit as a downloadable file.
+func (h *SecurityAuditHandler) ExportSecurityAudit(ctx context.Context, input *ExportSecurityAuditInput) (*ExportSecurityAuditOutput, error) {
+	if h.securityAuditService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	format := securityaudit.ExportFormat(input.Format)
+	data, report, err := h.securityAuditService.ExportHostAudit(ctx, format)
+	if err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.SecurityAuditError{Err: err}).Error())
+	}
+
+	contentType := "application/json"
+	if format == securityaudit.ExportFormatCSV {
+		contentType = "text/csv"
+	} else {
+		format = securityaudit.ExportFormatJSON
+	}
+	filename := fmt.Sprintf("arcane-security-audit-%s.%s", report.GeneratedAt.Format("20060102T150405Z"), format)
+
+	return &ExportSecurityAuditOutput{
+		ContentType:        contentType,
+		ContentDisposition: "attachment; filename=" + filename,
+		Body:               data,
+	}, nil
+}
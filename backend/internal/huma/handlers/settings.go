@@ -3,7 +3,6 @@ package handlers
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"net/http"
 	"strconv"
 	"strings"
@@ -14,9 +13,9 @@ import (
 	humamw "github.com/getarcaneapp/arcane/backend/internal/huma/middleware"
 	"github.com/getarcaneapp/arcane/backend/internal/services"
 	"github.com/getarcaneapp/arcane/backend/internal/utils/mapper"
-	"github.com/getarcaneapp/arcane/backend/internal/utils/pathmapper"
 	"github.com/getarcaneapp/arcane/types/base"
 	"github.com/getarcaneapp/arcane/types/category"
+	"github.com/getarcaneapp/arcane/types/rbac"
 	"github.com/getarcaneapp/arcane/types/search"
 	"github.com/getarcaneapp/arcane/types/settings"
 )
@@ -56,6 +55,15 @@ type UpdateSettingsOutput struct {
 	Body base.ApiResponse[[]settings.SettingDto]
 }
 
+type DryApplySettingsInput struct {
+	EnvironmentID string          `path:"id" doc:"Environment ID"`
+	Body          settings.Update `doc:"Settings update data to check"`
+}
+
+type DryApplySettingsOutput struct {
+	Body base.ApiResponse[settings.DryApplyResult]
+}
+
 type SearchSettingsInput struct {
 	Body search.Request `doc:"Search query"`
 }
@@ -68,32 +76,6 @@ type GetCategoriesOutput struct {
 	Body []category.Category
 }
 
-// validateProjectsDirectoryValue validates a projects directory value allowing:
-// - Unix absolute paths (/...)
-// - Windows drive paths (C:/..., C:\...)
-// - Mapping format "container:host" where container is absolute Unix or Windows path
-func validateProjectsDirectoryValue(path string) error {
-	switch {
-	case pathmapper.IsWindowsDrivePath(path):
-		return nil
-	case strings.Contains(path, ":"):
-		parts := strings.SplitN(path, ":", 2)
-		if len(parts) != 2 {
-			return errors.New("projectsDirectory must be an absolute path or valid mapping format")
-		}
-		container := parts[0]
-		if !strings.HasPrefix(container, "/") && !pathmapper.IsWindowsDrivePath(container) {
-			return errors.New("projectsDirectory mapping format: container path must be absolute")
-		}
-		return nil
-	default:
-		if !strings.HasPrefix(path, "/") {
-			return errors.New("projectsDirectory must be an absolute path starting with '/'")
-		}
-		return nil
-	}
-}
-
 // RegisterSettings registers settings management routes using Huma.
 func RegisterSettings(api huma.API, settingsService *services.SettingsService, settingsSearchService *services.SettingsSearchService, environmentService *services.EnvironmentService, cfg *config.Config) {
 	h := &SettingsHandler{
@@ -139,6 +121,19 @@ func RegisterSettings(api huma.API, settingsService *services.SettingsService, s
 		},
 	}, h.UpdateSettings)
 
+	huma.Register(api, huma.Operation{
+		OperationID: "dry-apply-settings",
+		Method:      http.MethodPost,
+		Path:        "/environments/{id}/settings/dry-apply",
+		Summary:     "Dry-apply settings",
+		Description: "Validate a settings update and report which settings would change and what would be affected, without saving",
+		Tags:        []string{"Settings"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.DryApplySettings)
+
 	// Top-level settings endpoints (not environment-scoped)
 	huma.Register(api, huma.Operation{
 		OperationID: "search-settings",
@@ -302,22 +297,10 @@ func (h *SettingsHandler) UpdateSettings(ctx context.Context, input *UpdateSetti
 		return nil, huma.Error500InternalServerError("service not available")
 	}
 
-	if err := checkAdmin(ctx); err != nil {
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
 		return nil, err
 	}
 
-	// Validate projects directory if provided and changed from current value.
-	// Skip validation when the value matches the current (possibly env-overridden) setting
-	// so that saving unrelated settings doesn't fail due to env-provided directory formats.
-	if input.Body.ProjectsDirectory != nil && *input.Body.ProjectsDirectory != "" {
-		currentDir := h.settingsService.GetSettingsConfig().ProjectsDirectory.Value
-		if *input.Body.ProjectsDirectory != currentDir {
-			if err := validateProjectsDirectoryValue(*input.Body.ProjectsDirectory); err != nil {
-				return nil, huma.Error400BadRequest(err.Error())
-			}
-		}
-	}
-
 	if input.EnvironmentID != "0" {
 		if h.environmentService == nil {
 			return nil, huma.Error500InternalServerError("environment service not available")
@@ -357,6 +340,11 @@ func (h *SettingsHandler) UpdateSettings(ctx context.Context, input *UpdateSetti
 		return &UpdateSettingsOutput{Body: apiResp}, nil
 	}
 
+	// Remote environments validate against their own filesystem and settings.
+	if issues := h.settingsService.ValidateSettings(input.Body); services.HasSettingsValidationErrors(issues) {
+		return nil, huma.Error400BadRequest(validationErrorMessageInternal(issues))
+	}
+
 	updatedSettings, err := h.settingsService.UpdateSettings(ctx, input.Body)
 	if err != nil {
 		return nil, huma.Error500InternalServerError((&common.SettingsUpdateError{Err: err}).Error())
@@ -381,13 +369,74 @@ func (h *SettingsHandler) UpdateSettings(ctx context.Context, input *UpdateSetti
 	}, nil
 }
 
+// DryApplySettings validates a settings update for an environment and reports
+// its effects without saving it.
+func (h *SettingsHandler) DryApplySettings(ctx context.Context, input *DryApplySettingsInput) (*DryApplySettingsOutput, error) {
+	if h.settingsService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if input.EnvironmentID != "0" {
+		if h.environmentService == nil {
+			return nil, huma.Error500InternalServerError("environment service not available")
+		}
+
+		body, err := json.Marshal(input.Body)
+		if err != nil {
+			return nil, huma.Error500InternalServerError("failed to marshal request body: " + err.Error())
+		}
+
+		respBody, statusCode, err := h.environmentService.ProxyRequest(ctx, input.EnvironmentID, http.MethodPost, "/api/environments/0/settings/dry-apply", body)
+		if err != nil {
+			return nil, huma.Error502BadGateway("failed to proxy request to environment: " + err.Error())
+		}
+		if statusCode != http.StatusOK {
+			return nil, huma.NewError(statusCode, "environment returned error: "+string(respBody), nil)
+		}
+
+		var apiResp base.ApiResponse[settings.DryApplyResult]
+		if err := json.Unmarshal(respBody, &apiResp); err != nil {
+			return nil, huma.Error500InternalServerError("failed to decode environment response: " + err.Error())
+		}
+
+		return &DryApplySettingsOutput{Body: apiResp}, nil
+	}
+
+	result, err := h.settingsService.DryApplySettings(ctx, input.Body)
+	if err != nil {
+		return nil, huma.Error500InternalServerError((&common.SettingsDryApplyError{Err: err}).Error())
+	}
+
+	return &DryApplySettingsOutput{
+		Body: base.ApiResponse[settings.DryApplyResult]{
+			Success: true,
+			Data:    *result,
+		},
+	}, nil
+}
+
+// validationErrorMessageInternal joins the error-level issues into one message.
+func validationErrorMessageInternal(issues []settings.ValidationIssue) string {
+	messages := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		if issue.Severity == settings.IssueSeverityError {
+			messages = append(messages, issue.Message)
+		}
+	}
+	return strings.Join(messages, "; ")
+}
+
 // Search searches settings by query.
 func (h *SettingsHandler) Search(ctx context.Context, input *SearchSettingsInput) (*SearchSettingsOutput, error) {
 	if h.settingsSearchService == nil {
 		return nil, huma.Error500InternalServerError("service not available")
 	}
 
-	if err := checkAdmin(ctx); err != nil {
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
 		return nil, err
 	}
 
@@ -405,7 +454,7 @@ func (h *SettingsHandler) GetCategories(ctx context.Context, input *struct{}) (*
 		return nil, huma.Error500InternalServerError("service not available")
 	}
 
-	if err := checkAdmin(ctx); err != nil {
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
 		return nil, err
 	}
 
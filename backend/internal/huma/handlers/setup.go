@@ -0,0 +1,422 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/getarcaneapp/arcane/backend/internal/common"
+	"github.com/getarcaneapp/arcane/backend/internal/config"
+	"github.com/getarcaneapp/arcane/backend/internal/services"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/cookie"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/mapper"
+	"github.com/getarcaneapp/arcane/types/auth"
+	"github.com/getarcaneapp/arcane/types/base"
+	"github.com/getarcaneapp/arcane/types/notification"
+	"github.com/getarcaneapp/arcane/types/rbac"
+	"github.com/getarcaneapp/arcane/types/setup"
+	"github.com/getarcaneapp/arcane/types/user"
+)
+
+// SetupHandler serves the first-run setup. The notification and environment
+// steps reuse the regular handlers so they behave exactly like their
+// settings pages.
+type SetupHandler struct {
+	setupService        *services.SetupService
+	authService         *services.AuthService
+	environmentHandler  *EnvironmentHandler
+	notificationHandler *NotificationHandler
+	cfg                 *config.Config
+}
+
+type SetupAdminInput struct {
+	Body setup.Admin
+}
+
+type SetupAdminOutput struct {
+	SetCookie string `header:"Set-Cookie" doc:"Session cookie"`
+	Body      base.ApiResponse[auth.LoginResponse]
+}
+
+type SetupProjectsDirectoryInput struct {
+	Body setup.ProjectsDirectory
+}
+
+type SetupProjectsDirectoryOutput struct {
+	Body base.ApiResponse[setup.ProjectsDirectoryResult]
+}
+
+type SetupDockerCheckOutput struct {
+	Body base.ApiResponse[setup.DockerCheck]
+}
+
+type SetupNotificationInput struct {
+	Body notification.Update
+}
+
+type SetupStepInput struct {
+	Step string `path:"step" enum:"notification,environment" doc:"Optional setup step to skip"`
+}
+
+type SetupStatusOutput struct {
+	Body base.ApiResponse[setup.Progress]
+}
+
+// RegisterSetup registers the first-run setup routes using Huma.
+func RegisterSetup(api huma.API, setupService *services.SetupService, authService *services.AuthService, environmentService *services.EnvironmentService, settingsService *services.SettingsService, apiKeyService *services.ApiKeyService, eventService *services.EventService, notificationService *services.NotificationService, appriseService *services.AppriseService, cfg *config.Config) {
+	h := &SetupHandler{
+		setupService: setupService,
+		authService:  authService,
+		environmentHandler: &EnvironmentHandler{
+			environmentService: environmentService,
+			settingsService:    settingsService,
+			apiKeyService:      apiKeyService,
+			eventService:       eventService,
+			cfg:                cfg,
+		},
+		notificationHandler: &NotificationHandler{
+			notificationService: notificationService,
+			appriseService:      appriseService,
+		},
+		cfg: cfg,
+	}
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-setup-status",
+		Method:      http.MethodGet,
+		Path:        "/setup/status",
+		Summary:     "Get setup status",
+		Description: "Get the progress of the first-run setup",
+		Tags:        []string{"Setup"},
+	}, h.GetStatus)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "setup-admin",
+		Method:      http.MethodPost,
+		Path:        "/setup/admin",
+		Summary:     "Create administrator",
+		Description: "Replace the default administrator with a new account and log in as it. Only available until the administrator is created.",
+		Tags:        []string{"Setup"},
+	}, h.CreateAdmin)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "setup-projects-directory",
+		Method:      http.MethodPost,
+		Path:        "/setup/projects-directory",
+		Summary:     "Set projects directory",
+		Description: "Validate and save the directory where projects are stored",
+		Tags:        []string{"Setup"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.SetProjectsDirectory)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "setup-docker-check",
+		Method:      http.MethodPost,
+		Path:        "/setup/docker-check",
+		Summary:     "Check Docker connection",
+		Description: "Check that Arcane can reach the Docker daemon",
+		Tags:        []string{"Setup"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.CheckDocker)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "setup-notification",
+		Method:      http.MethodPost,
+		Path:        "/setup/notification",
+		Summary:     "Add notification provider",
+		Description: "Configure a notification provider as part of setup",
+		Tags:        []string{"Setup"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.CreateNotification)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "setup-environment",
+		Method:      http.MethodPost,
+		Path:        "/setup/environment",
+		Summary:     "Add environment",
+		Description: "Add a first remote environment as part of setup",
+		Tags:        []string{"Setup"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.CreateEnvironment)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "setup-skip-step",
+		Method:      http.MethodPost,
+		Path:        "/setup/steps/{step}/skip",
+		Summary:     "Skip setup step",
+		Description: "Skip an optional setup step",
+		Tags:        []string{"Setup"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.SkipStep)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "setup-complete",
+		Method:      http.MethodPost,
+		Path:        "/setup/complete",
+		Summary:     "Complete setup",
+		Description: "Finish the first-run setup once all required steps are done. The setup endpoints are closed afterwards.",
+		Tags:        []string{"Setup"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.Complete)
+}
+
+// GetStatus returns the progress of the first-run setup.
+func (h *SetupHandler) GetStatus(ctx context.Context, input *struct{}) (*SetupStatusOutput, error) {
+	if h.setupService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if h.cfg != nil && h.cfg.AgentMode {
+		return &SetupStatusOutput{
+			Body: base.ApiResponse[setup.Progress]{
+				Success: true,
+				Data:    setup.Progress{Steps: []setup.Step{}},
+			},
+		}, nil
+	}
+
+	status, err := h.setupService.GetStatus(ctx)
+	if err != nil {
+		return nil, huma.Error500InternalServerError((&common.SetupStatusError{Err: err}).Error())
+	}
+
+	return &SetupStatusOutput{
+		Body: base.ApiResponse[setup.Progress]{
+			Success: true,
+			Data:    *status,
+		},
+	}, nil
+}
+
+// CreateAdmin creates the administrator account and logs in as it.
+func (h *SetupHandler) CreateAdmin(ctx context.Context, input *SetupAdminInput) (*SetupAdminOutput, error) {
+	if h.setupService == nil || h.authService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+	if err := h.ensureSetupOpenInternal(ctx); err != nil {
+		return nil, err
+	}
+
+	userModel, err := h.setupService.CreateAdmin(ctx, input.Body)
+	if err != nil {
+		return nil, setupErrorInternal(err)
+	}
+
+	loggedIn, tokenPair, err := h.authService.Login(ctx, userModel.Username, input.Body.Password)
+	if err != nil {
+		return nil, huma.Error500InternalServerError((&common.AuthFailedError{Err: err}).Error())
+	}
+
+	var userResp user.User
+	if mapErr := mapper.MapStruct(loggedIn, &userResp); mapErr != nil {
+		return nil, huma.Error500InternalServerError((&common.UserMappingError{Err: mapErr}).Error())
+	}
+
+	maxAge := max(int(time.Until(tokenPair.ExpiresAt).Seconds()), 0)
+	maxAge += 60
+
+	return &SetupAdminOutput{
+		SetCookie: cookie.BuildTokenCookieString(maxAge, tokenPair.AccessToken),
+		Body: base.ApiResponse[auth.LoginResponse]{
+			Success: true,
+			Data: auth.LoginResponse{
+				Token:        tokenPair.AccessToken,
+				RefreshToken: tokenPair.RefreshToken,
+				ExpiresAt:    tokenPair.ExpiresAt,
+				User:         userResp,
+			},
+		},
+	}, nil
+}
+
+// SetProjectsDirectory validates and saves the projects directory.
+func (h *SetupHandler) SetProjectsDirectory(ctx context.Context, input *SetupProjectsDirectoryInput) (*SetupProjectsDirectoryOutput, error) {
+	if h.setupService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+	if err := h.ensureSetupOpenInternal(ctx); err != nil {
+		return nil, err
+	}
+
+	result, err := h.setupService.SetProjectsDirectory(ctx, input.Body.ProjectsDirectory)
+	if err != nil {
+		return nil, setupErrorInternal(err)
+	}
+
+	return &SetupProjectsDirectoryOutput{
+		Body: base.ApiResponse[setup.ProjectsDirectoryResult]{
+			Success: true,
+			Data:    *result,
+		},
+	}, nil
+}
+
+// CheckDocker checks the connection to the Docker daemon.
+func (h *SetupHandler) CheckDocker(ctx context.Context, input *struct{}) (*SetupDockerCheckOutput, error) {
+	if h.setupService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+	if err := h.ensureSetupOpenInternal(ctx); err != nil {
+		return nil, err
+	}
+
+	result, err := h.setupService.CheckDocker(ctx)
+	if err != nil {
+		return nil, setupErrorInternal(err)
+	}
+
+	return &SetupDockerCheckOutput{
+		Body: base.ApiResponse[setup.DockerCheck]{
+			Success: true,
+			Data:    *result,
+		},
+	}, nil
+}
+
+// CreateNotification configures a notification provider for the local
+// environment and completes the notification step.
+func (h *SetupHandler) CreateNotification(ctx context.Context, input *SetupNotificationInput) (*CreateOrUpdateNotificationSettingsOutput, error) {
+	if h.setupService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+	if err := h.ensureSetupOpenInternal(ctx); err != nil {
+		return nil, err
+	}
+
+	out, err := h.notificationHandler.CreateOrUpdateNotificationSettings(ctx, &CreateOrUpdateNotificationSettingsInput{
+		EnvironmentID: "0",
+		Body:          input.Body,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.setupService.CompleteStep(ctx, setup.StepNotification); err != nil {
+		return nil, setupErrorInternal(err)
+	}
+	return out, nil
+}
+
+// CreateEnvironment adds a first remote environment and completes the
+// environment step.
+func (h *SetupHandler) CreateEnvironment(ctx context.Context, input *CreateEnvironmentInput) (*CreateEnvironmentOutput, error) {
+	if h.setupService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+	if err := h.ensureSetupOpenInternal(ctx); err != nil {
+		return nil, err
+	}
+
+	out, err := h.environmentHandler.CreateEnvironment(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.setupService.CompleteStep(ctx, setup.StepEnvironment); err != nil {
+		return nil, setupErrorInternal(err)
+	}
+	return out, nil
+}
+
+// SkipStep skips an optional setup step.
+func (h *SetupHandler) SkipStep(ctx context.Context, input *SetupStepInput) (*SetupStatusOutput, error) {
+	if h.setupService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+	if err := h.ensureSetupOpenInternal(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := h.setupService.SkipStep(ctx, input.Step); err != nil {
+		return nil, setupErrorInternal(err)
+	}
+	return h.statusOutputInternal(ctx)
+}
+
+// Complete finishes the first-run setup.
+func (h *SetupHandler) Complete(ctx context.Context, input *struct{}) (*SetupStatusOutput, error) {
+	if h.setupService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+	if err := h.ensureSetupOpenInternal(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := h.setupService.Complete(ctx); err != nil {
+		return nil, setupErrorInternal(err)
+	}
+	return h.statusOutputInternal(ctx)
+}
+
+func (h *SetupHandler) statusOutputInternal(ctx context.Context) (*SetupStatusOutput, error) {
+	status, err := h.setupService.GetStatus(ctx)
+	if err != nil {
+		return nil, huma.Error500InternalServerError((&common.SetupStatusError{Err: err}).Error())
+	}
+	return &SetupStatusOutput{
+		Body: base.ApiResponse[setup.Progress]{
+			Success: true,
+			Data:    *status,
+		},
+	}, nil
+}
+
+// ensureSetupOpenInternal rejects setup requests once setup is completed, and
+// on agents, which are configured by the manager they are paired with.
+func (h *SetupHandler) ensureSetupOpenInternal(ctx context.Context) error {
+	if h.cfg != nil && h.cfg.AgentMode {
+		return huma.Error409Conflict((&common.SetupClosedError{}).Error())
+	}
+	if !h.setupService.IsRequired(ctx) {
+		return huma.Error409Conflict((&common.SetupClosedError{}).Error())
+	}
+	return nil
+}
+
+// setupErrorInternal maps setup service errors to API errors.
+func setupErrorInternal(err error) error {
+	apiErr := (&common.SetupStepError{Err: err}).Error()
+	switch {
+	case errors.Is(err, services.ErrSetupCompleted), errors.Is(err, services.ErrSetupAdminCreated):
+		return huma.Error409Conflict(apiErr)
+	case errors.Is(err, services.ErrSetupInvalidValue),
+		errors.Is(err, services.ErrSetupStepNotOptional),
+		errors.Is(err, services.ErrSetupStepUnknown),
+		errors.Is(err, services.ErrSetupIncomplete):
+		return huma.Error400BadRequest(apiErr)
+	default:
+		return huma.Error500InternalServerError(apiErr)
+	}
+}
@@ -0,0 +1,162 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	humamw "github.com/getarcaneapp/arcane/backend/internal/huma/middleware"
+	"github.com/getarcaneapp/arcane/backend/internal/services"
+	"github.com/getarcaneapp/arcane/types/base"
+	"github.com/getarcaneapp/arcane/types/rbac"
+	"github.com/getarcaneapp/arcane/types/staleresource"
+)
+
+// StaleResourceHandler provides the stale resource report endpoints.
+type StaleResourceHandler struct {
+	staleResourceService *services.StaleResourceService
+}
+
+// --- Huma Input/Output Wrappers ---
+
+type GetStaleResourceReportInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+}
+
+type GetStaleResourceReportOutput struct {
+	Body base.ApiResponse[staleresource.Inventory]
+}
+
+type AnalyzeStaleResourcesInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+}
+
+type AnalyzeStaleResourcesOutput struct {
+	Body base.ApiResponse[staleresource.Inventory]
+}
+
+type CleanupStaleResourcesInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	Body          staleresource.CleanupRequest
+}
+
+type CleanupStaleResourcesOutput struct {
+	Body base.ApiResponse[staleresource.CleanupResult]
+}
+
+// RegisterStaleResources registers the stale resource report routes using Huma.
+func RegisterStaleResources(api huma.API, staleResourceService *services.StaleResourceService) {
+	h := &StaleResourceHandler{
+		staleResourceService: staleResourceService,
+	}
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-stale-resource-report",
+		Method:      http.MethodGet,
+		Path:        "/environments/{id}/stale-resources",
+		Summary:     "Get stale resource report",
+		Description: "List containers stopped for a long time, unmounted volumes, networks without containers and old unused images",
+		Tags:        []string{"System"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.GetReport)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "analyze-stale-resources",
+		Method:      http.MethodPost,
+		Path:        "/environments/{id}/stale-resources/analyze",
+		Summary:     "Refresh stale resource report",
+		Description: "Inspect the host again and replace the stale resource report",
+		Tags:        []string{"System"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.Analyze)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "cleanup-stale-resources",
+		Method:      http.MethodPost,
+		Path:        "/environments/{id}/stale-resources/cleanup",
+		Summary:     "Remove stale resources",
+		Description: "Remove selected resources from the stale resource report. Resources that are in use again are not removed",
+		Tags:        []string{"System"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.Cleanup)
+}
+
+// GetReport returns the current stale resource report.
+func (h *StaleResourceHandler) GetReport(ctx context.Context, input *GetStaleResourceReportInput) (*GetStaleResourceReportOutput, error) {
+	if h.staleResourceService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	report, err := h.staleResourceService.GetReport(ctx)
+	if err != nil {
+		return nil, huma.Error500InternalServerError(err.Error())
+	}
+
+	return &GetStaleResourceReportOutput{
+		Body: base.ApiResponse[staleresource.Inventory]{
+			Success: true,
+			Data:    *report,
+		},
+	}, nil
+}
+
+// Analyze refreshes the stale resource report.
+func (h *StaleResourceHandler) Analyze(ctx context.Context, input *AnalyzeStaleResourcesInput) (*AnalyzeStaleResourcesOutput, error) {
+	if h.staleResourceService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleOperator); err != nil {
+		return nil, err
+	}
+
+	report, err := h.staleResourceService.Analyze(ctx)
+	if err != nil {
+		return nil, huma.Error500InternalServerError(err.Error())
+	}
+
+	return &AnalyzeStaleResourcesOutput{
+		Body: base.ApiResponse[staleresource.Inventory]{
+			Success: true,
+			Data:    *report,
+		},
+	}, nil
+}
+
+// Cleanup removes resources picked from the report. Failures are reported per
+// item, so the request succeeds even if some resources could not be removed.
+func (h *StaleResourceHandler) Cleanup(ctx context.Context, input *CleanupStaleResourcesInput) (*CleanupStaleResourcesOutput, error) {
+	if h.staleResourceService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	user, exists := humamw.GetCurrentUserFromContext(ctx)
+	if !exists {
+		return nil, huma.Error401Unauthorized("not authenticated")
+	}
+
+	result, err := h.staleResourceService.Cleanup(ctx, input.Body.Items, *user)
+	if err != nil {
+		return nil, huma.Error500InternalServerError(err.Error())
+	}
+
+	return &CleanupStaleResourcesOutput{
+		Body: base.ApiResponse[staleresource.CleanupResult]{
+			Success: true,
+			Data:    *result,
+		},
+	}, nil
+}
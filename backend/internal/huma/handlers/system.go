@@ -17,6 +17,7 @@ import (
 	"github.com/getarcaneapp/arcane/types/base"
 	containertypes "github.com/getarcaneapp/arcane/types/container"
 	"github.com/getarcaneapp/arcane/types/dockerinfo"
+	"github.com/getarcaneapp/arcane/types/rbac"
 	"github.com/getarcaneapp/arcane/types/system"
 	dockersystem "github.com/moby/moby/api/types/system"
 	"github.com/moby/moby/client"
@@ -90,6 +91,15 @@ type ConvertDockerRunOutput struct {
 	Body models.ConvertDockerRunResponse
 }
 
+type ConvertContainerToDockerRunInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	ContainerID   string `path:"containerId" doc:"Container ID or name"`
+}
+
+type ConvertContainerToDockerRunOutput struct {
+	Body models.ContainerToDockerRunResponse
+}
+
 type CheckUpgradeInput struct {
 	EnvironmentID string `path:"id" doc:"Environment ID"`
 }
@@ -163,6 +173,19 @@ func RegisterSystem(api huma.API, dockerService *services.DockerClientService, s
 		},
 	}, h.PruneAll)
 
+	huma.Register(api, huma.Operation{
+		OperationID: "preview-prune-all",
+		Method:      http.MethodPost,
+		Path:        "/environments/{id}/system/prune/preview",
+		Summary:     "Preview a Docker prune",
+		Description: "List the Docker resources a prune with the same options would remove, and the space it would reclaim, without removing anything",
+		Tags:        []string{"System"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.PreviewPruneAll)
+
 	huma.Register(api, huma.Operation{
 		OperationID: "start-all-containers",
 		Method:      http.MethodPost,
@@ -215,6 +238,19 @@ func RegisterSystem(api huma.API, dockerService *services.DockerClientService, s
 		},
 	}, h.ConvertDockerRun)
 
+	huma.Register(api, huma.Operation{
+		OperationID: "convert-container-to-docker-run",
+		Method:      http.MethodGet,
+		Path:        "/environments/{id}/system/convert/containers/{containerId}",
+		Summary:     "Convert container to docker run command",
+		Description: "Generate the docker run command and equivalent docker-compose service for an existing container",
+		Tags:        []string{"System"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.ConvertContainerToDockerRun)
+
 	huma.Register(api, huma.Operation{
 		OperationID: "check-upgrade",
 		Method:      http.MethodGet,
@@ -352,7 +388,7 @@ func (h *SystemHandler) PruneAll(ctx context.Context, input *PruneAllInput) (*Pr
 		return nil, huma.Error500InternalServerError("service not available")
 	}
 
-	if err := checkAdmin(ctx); err != nil {
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
 		return nil, err
 	}
 
@@ -385,13 +421,36 @@ func (h *SystemHandler) PruneAll(ctx context.Context, input *PruneAllInput) (*Pr
 	}, nil
 }
 
+// PreviewPruneAll reports what PruneAll would remove without removing it.
+func (h *SystemHandler) PreviewPruneAll(ctx context.Context, input *PruneAllInput) (*PruneAllOutput, error) {
+	if h.systemService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	result, err := h.systemService.PreviewPruneAll(ctx, input.Body)
+	if err != nil {
+		return nil, huma.Error500InternalServerError((&common.SystemPrunePreviewError{Err: err}).Error())
+	}
+
+	return &PruneAllOutput{
+		Body: base.ApiResponse[system.PruneAllResult]{
+			Success: true,
+			Data:    *result,
+		},
+	}, nil
+}
+
 // StartAllContainers starts all Docker containers.
 func (h *SystemHandler) StartAllContainers(ctx context.Context, input *StartAllContainersInput) (*StartAllContainersOutput, error) {
 	if h.systemService == nil {
 		return nil, huma.Error500InternalServerError("service not available")
 	}
 
-	if err := checkAdmin(ctx); err != nil {
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
 		return nil, err
 	}
 
@@ -414,7 +473,7 @@ func (h *SystemHandler) StartAllStoppedContainers(ctx context.Context, input *St
 		return nil, huma.Error500InternalServerError("service not available")
 	}
 
-	if err := checkAdmin(ctx); err != nil {
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
 		return nil, err
 	}
 
@@ -437,7 +496,7 @@ func (h *SystemHandler) StopAllContainers(ctx context.Context, input *StopAllCon
 		return nil, huma.Error500InternalServerError("service not available")
 	}
 
-	if err := checkAdmin(ctx); err != nil {
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
 		return nil, err
 	}
 
@@ -480,13 +539,42 @@ func (h *SystemHandler) ConvertDockerRun(ctx context.Context, input *ConvertDock
 	}, nil
 }
 
+// ConvertContainerToDockerRun generates the docker run command and compose
+// service for an existing container.
+func (h *SystemHandler) ConvertContainerToDockerRun(ctx context.Context, input *ConvertContainerToDockerRunInput) (*ConvertContainerToDockerRunOutput, error) {
+	if h.systemService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	parsed, runCommand, err := h.systemService.GenerateDockerRunCommand(ctx, input.ContainerID)
+	if err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.DockerRunGenerationError{Err: err}).Error())
+	}
+
+	dockerCompose, envVars, serviceName, err := h.systemService.ConvertToDockerCompose(parsed)
+	if err != nil {
+		return nil, huma.Error500InternalServerError((&common.DockerComposeConversionError{Err: err}).Error())
+	}
+
+	return &ConvertContainerToDockerRunOutput{
+		Body: models.ContainerToDockerRunResponse{
+			Success:          true,
+			DockerRunCommand: runCommand,
+			DockerCompose:    dockerCompose,
+			EnvVars:          envVars,
+			ServiceName:      serviceName,
+		},
+	}, nil
+}
+
 // CheckUpgradeAvailable checks if a system upgrade is available.
 func (h *SystemHandler) CheckUpgradeAvailable(ctx context.Context, input *CheckUpgradeInput) (*CheckUpgradeOutput, error) {
 	if h.upgradeService == nil {
 		return nil, huma.Error500InternalServerError("service not available")
 	}
 
-	if err := checkAdmin(ctx); err != nil {
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
 		return nil, err
 	}
 
@@ -517,7 +605,7 @@ func (h *SystemHandler) TriggerUpgrade(ctx context.Context, input *TriggerUpgrad
 		return nil, huma.Error500InternalServerError("service not available")
 	}
 
-	if err := checkAdmin(ctx); err != nil {
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
 		return nil, err
 	}
 
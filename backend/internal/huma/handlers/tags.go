@@ -0,0 +1,457 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/services"
+	"github.com/getarcaneapp/arcane/types/base"
+	"github.com/getarcaneapp/arcane/types/rbac"
+	"github.com/getarcaneapp/arcane/types/tag"
+)
+
+// TagHandler provides Huma-based resource tag and saved filter endpoints.
+type TagHandler struct {
+	tagService *services.TagService
+}
+
+// --- Huma Input/Output Wrappers ---
+
+type GetContainerTagsInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	ContainerID   string `path:"containerId" doc:"Container ID or name"`
+}
+
+type UpdateContainerTagsInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	ContainerID   string `path:"containerId" doc:"Container ID or name"`
+	Body          tag.UpdateTags
+}
+
+type GetProjectTagsInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	ProjectID     string `path:"projectId" doc:"Project ID"`
+}
+
+type UpdateProjectTagsInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	ProjectID     string `path:"projectId" doc:"Project ID"`
+	Body          tag.UpdateTags
+}
+
+type GetEnvironmentTagsInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+}
+
+type UpdateEnvironmentTagsInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	Body          tag.UpdateTags
+}
+
+type ResourceTagsOutput struct {
+	Body base.ApiResponse[map[string]string]
+}
+
+type ListTagKeysInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	ResourceType  string `query:"resourceType" enum:"project,container" doc:"Resource type to list tag keys for"`
+}
+
+type ListTagKeysOutput struct {
+	Body base.ApiResponse[[]tag.KeySummary]
+}
+
+type ListSavedFiltersInput struct {
+	ResourceType string `query:"resourceType" doc:"Only return saved filters for this resource type"`
+}
+
+type ListSavedFiltersOutput struct {
+	Body base.ApiResponse[[]tag.SavedFilter]
+}
+
+type CreateSavedFilterInput struct {
+	Body tag.CreateSavedFilter
+}
+
+type UpdateSavedFilterInput struct {
+	FilterID string `path:"filterId" doc:"Saved filter ID"`
+	Body     tag.UpdateSavedFilter
+}
+
+type SavedFilterOutput struct {
+	Body base.ApiResponse[tag.SavedFilter]
+}
+
+type DeleteSavedFilterInput struct {
+	FilterID string `path:"filterId" doc:"Saved filter ID"`
+}
+
+type DeleteSavedFilterOutput struct {
+	Body base.ApiResponse[base.MessageResponse]
+}
+
+// RegisterTags registers resource tag and saved filter routes using Huma.
+func RegisterTags(api huma.API, tagService *services.TagService) {
+	h := &TagHandler{
+		tagService: tagService,
+	}
+
+	security := []map[string][]string{
+		{"BearerAuth": {}},
+		{"ApiKeyAuth": {}},
+	}
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-container-tags",
+		Method:      http.MethodGet,
+		Path:        "/environments/{id}/containers/{containerId}/tags",
+		Summary:     "Get container tags",
+		Description: "Get the user-defined tags on a container. Tags are stored by container name, so they survive the container being recreated",
+		Tags:        []string{"Tags", "Containers"},
+		Security:    security,
+	}, h.GetContainerTags)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "update-container-tags",
+		Method:      http.MethodPut,
+		Path:        "/environments/{id}/containers/{containerId}/tags",
+		Summary:     "Set container tags",
+		Description: "Replace the user-defined tags on a container",
+		Tags:        []string{"Tags", "Containers"},
+		Security:    security,
+	}, h.UpdateContainerTags)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-project-tags",
+		Method:      http.MethodGet,
+		Path:        "/environments/{id}/projects/{projectId}/tags",
+		Summary:     "Get project tags",
+		Description: "Get the user-defined tags on a project",
+		Tags:        []string{"Tags", "Projects"},
+		Security:    security,
+	}, h.GetProjectTags)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "update-project-tags",
+		Method:      http.MethodPut,
+		Path:        "/environments/{id}/projects/{projectId}/tags",
+		Summary:     "Set project tags",
+		Description: "Replace the user-defined tags on a project",
+		Tags:        []string{"Tags", "Projects"},
+		Security:    security,
+	}, h.UpdateProjectTags)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-environment-tags",
+		Method:      http.MethodGet,
+		Path:        "/environments/{id}/tags",
+		Summary:     "Get environment tags",
+		Description: "Get the user-defined tags on an environment",
+		Tags:        []string{"Tags", "Environments"},
+		Security:    security,
+	}, h.GetEnvironmentTags)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "update-environment-tags",
+		Method:      http.MethodPut,
+		Path:        "/environments/{id}/tags",
+		Summary:     "Set environment tags",
+		Description: "Replace the user-defined tags on an environment",
+		Tags:        []string{"Tags", "Environments"},
+		Security:    security,
+	}, h.UpdateEnvironmentTags)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "list-tag-keys",
+		Method:      http.MethodGet,
+		Path:        "/environments/{id}/tag-keys",
+		Summary:     "List tag keys",
+		Description: "List the tag keys used on projects or containers in an environment, with their values",
+		Tags:        []string{"Tags"},
+		Security:    security,
+	}, h.ListTagKeys)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "list-saved-filters",
+		Method:      http.MethodGet,
+		Path:        "/saved-filters",
+		Summary:     "List saved filters",
+		Description: "List saved filters for the project, container and environment lists",
+		Tags:        []string{"Tags"},
+		Security:    security,
+	}, h.ListSavedFilters)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "create-saved-filter",
+		Method:      http.MethodPost,
+		Path:        "/saved-filters",
+		Summary:     "Create a saved filter",
+		Description: "Save a search and list filters, such as tags, under a name",
+		Tags:        []string{"Tags"},
+		Security:    security,
+	}, h.CreateSavedFilter)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "update-saved-filter",
+		Method:      http.MethodPut,
+		Path:        "/saved-filters/{filterId}",
+		Summary:     "Update a saved filter",
+		Description: "Rename a saved filter or change its search and filters",
+		Tags:        []string{"Tags"},
+		Security:    security,
+	}, h.UpdateSavedFilter)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "delete-saved-filter",
+		Method:      http.MethodDelete,
+		Path:        "/saved-filters/{filterId}",
+		Summary:     "Delete a saved filter",
+		Description: "Delete a saved filter by ID",
+		Tags:        []string{"Tags"},
+		Security:    security,
+	}, h.DeleteSavedFilter)
+}
+
+// GetContainerTags returns the tags on a container.
+func (h *TagHandler) GetContainerTags(ctx context.Context, input *GetContainerTagsInput) (*ResourceTagsOutput, error) {
+	if h.tagService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	name, err := h.tagService.ContainerTagID(ctx, input.ContainerID)
+	if err != nil {
+		return nil, tagError(err)
+	}
+	return h.getTagsInternal(ctx, models.TagResourceContainer, name)
+}
+
+// UpdateContainerTags replaces the tags on a container.
+func (h *TagHandler) UpdateContainerTags(ctx context.Context, input *UpdateContainerTagsInput) (*ResourceTagsOutput, error) {
+	if h.tagService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleOperator); err != nil {
+		return nil, err
+	}
+
+	name, err := h.tagService.ContainerTagID(ctx, input.ContainerID)
+	if err != nil {
+		return nil, tagError(err)
+	}
+	return h.setTagsInternal(ctx, models.TagResourceContainer, name, input.Body.Tags)
+}
+
+// GetProjectTags returns the tags on a project.
+func (h *TagHandler) GetProjectTags(ctx context.Context, input *GetProjectTagsInput) (*ResourceTagsOutput, error) {
+	if h.tagService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	id, err := h.tagService.ProjectTagID(ctx, input.ProjectID)
+	if err != nil {
+		return nil, tagError(err)
+	}
+	return h.getTagsInternal(ctx, models.TagResourceProject, id)
+}
+
+// UpdateProjectTags replaces the tags on a project.
+func (h *TagHandler) UpdateProjectTags(ctx context.Context, input *UpdateProjectTagsInput) (*ResourceTagsOutput, error) {
+	if h.tagService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleOperator); err != nil {
+		return nil, err
+	}
+
+	id, err := h.tagService.ProjectTagID(ctx, input.ProjectID)
+	if err != nil {
+		return nil, tagError(err)
+	}
+	return h.setTagsInternal(ctx, models.TagResourceProject, id, input.Body.Tags)
+}
+
+// GetEnvironmentTags returns the tags on an environment.
+func (h *TagHandler) GetEnvironmentTags(ctx context.Context, input *GetEnvironmentTagsInput) (*ResourceTagsOutput, error) {
+	if h.tagService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	id, err := h.tagService.EnvironmentTagID(ctx, input.EnvironmentID)
+	if err != nil {
+		return nil, tagError(err)
+	}
+	return h.getTagsInternal(ctx, models.TagResourceEnvironment, id)
+}
+
+// UpdateEnvironmentTags replaces the tags on an environment.
+func (h *TagHandler) UpdateEnvironmentTags(ctx context.Context, input *UpdateEnvironmentTagsInput) (*ResourceTagsOutput, error) {
+	if h.tagService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	id, err := h.tagService.EnvironmentTagID(ctx, input.EnvironmentID)
+	if err != nil {
+		return nil, tagError(err)
+	}
+	return h.setTagsInternal(ctx, models.TagResourceEnvironment, id, input.Body.Tags)
+}
+
+// ListTagKeys returns the tag keys used on a resource type.
+func (h *TagHandler) ListTagKeys(ctx context.Context, input *ListTagKeysInput) (*ListTagKeysOutput, error) {
+	if h.tagService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	resourceType := models.TagResourceType(input.ResourceType)
+	if resourceType == "" {
+		resourceType = models.TagResourceContainer
+	}
+	keys, err := h.tagService.ListTagKeys(ctx, resourceType)
+	if err != nil {
+		return nil, tagError(err)
+	}
+
+	return &ListTagKeysOutput{
+		Body: base.ApiResponse[[]tag.KeySummary]{
+			Success: true,
+			Data:    keys,
+		},
+	}, nil
+}
+
+// ListSavedFilters returns the saved filters.
+func (h *TagHandler) ListSavedFilters(ctx context.Context, input *ListSavedFiltersInput) (*ListSavedFiltersOutput, error) {
+	if h.tagService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	filters, err := h.tagService.ListSavedFilters(ctx, input.ResourceType)
+	if err != nil {
+		return nil, tagError(err)
+	}
+
+	return &ListSavedFiltersOutput{
+		Body: base.ApiResponse[[]tag.SavedFilter]{
+			Success: true,
+			Data:    filters,
+		},
+	}, nil
+}
+
+// CreateSavedFilter saves a new filter.
+func (h *TagHandler) CreateSavedFilter(ctx context.Context, input *CreateSavedFilterInput) (*SavedFilterOutput, error) {
+	if h.tagService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleOperator); err != nil {
+		return nil, err
+	}
+
+	f, err := h.tagService.CreateSavedFilter(ctx, input.Body)
+	if err != nil {
+		return nil, tagError(err)
+	}
+
+	return &SavedFilterOutput{
+		Body: base.ApiResponse[tag.SavedFilter]{
+			Success: true,
+			Data:    *f,
+		},
+	}, nil
+}
+
+// UpdateSavedFilter updates an existing saved filter.
+func (h *TagHandler) UpdateSavedFilter(ctx context.Context, input *UpdateSavedFilterInput) (*SavedFilterOutput, error) {
+	if h.tagService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleOperator); err != nil {
+		return nil, err
+	}
+
+	f, err := h.tagService.UpdateSavedFilter(ctx, input.FilterID, input.Body)
+	if err != nil {
+		return nil, tagError(err)
+	}
+
+	return &SavedFilterOutput{
+		Body: base.ApiResponse[tag.SavedFilter]{
+			Success: true,
+			Data:    *f,
+		},
+	}, nil
+}
+
+// DeleteSavedFilter removes a saved filter.
+func (h *TagHandler) DeleteSavedFilter(ctx context.Context, input *DeleteSavedFilterInput) (*DeleteSavedFilterOutput, error) {
+	if h.tagService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if err := requireRole(ctx, rbac.RoleOperator); err != nil {
+		return nil, err
+	}
+
+	if err := h.tagService.DeleteSavedFilter(ctx, input.FilterID); err != nil {
+		return nil, tagError(err)
+	}
+
+	return &DeleteSavedFilterOutput{
+		Body: base.ApiResponse[base.MessageResponse]{
+			Success: true,
+			Data: base.MessageResponse{
+				Message: "Saved filter deleted successfully",
+			},
+		},
+	}, nil
+}
+
+func (h *TagHandler) getTagsInternal(ctx context.Context, resourceType models.TagResourceType, resourceID string) (*ResourceTagsOutput, error) {
+	tags, err := h.tagService.GetTags(ctx, resourceType, resourceID)
+	if err != nil {
+		return nil, tagError(err)
+	}
+
+	return &ResourceTagsOutput{
+		Body: base.ApiResponse[map[string]string]{
+			Success: true,
+			Data:    tags,
+		},
+	}, nil
+}
+
+func (h *TagHandler) setTagsInternal(ctx context.Context, resourceType models.TagResourceType, resourceID string, tags map[string]string) (*ResourceTagsOutput, error) {
+	saved, err := h.tagService.SetTags(ctx, resourceType, resourceID, tags)
+	if err != nil {
+		return nil, tagError(err)
+	}
+
+	return &ResourceTagsOutput{
+		Body: base.ApiResponse[map[string]string]{
+			Success: true,
+			Data:    saved,
+		},
+	}, nil
+}
+
+func tagError(err error) error {
+	switch {
+	case errors.Is(err, services.ErrTaggedResourceNotFound), errors.Is(err, services.ErrSavedFilterNotFound):
+		return huma.Error404NotFound(err.Error())
+	case errors.Is(err, services.ErrTagInvalid), errors.Is(err, services.ErrSavedFilterInvalid):
+		return huma.Error400BadRequest(err.Error())
+	default:
+		return huma.Error500InternalServerError(err.Error())
+	}
+}
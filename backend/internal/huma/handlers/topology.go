@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/getarcaneapp/arcane/backend/internal/common"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/services"
+	"github.com/getarcaneapp/arcane/types/base"
+	"github.com/getarcaneapp/arcane/types/topology"
+)
+
+// TopologyHandler provides the container topology graph endpoints.
+type TopologyHandler struct {
+	topologyService *services.TopologyService
+}
+
+// --- Huma Input/Output Wrappers ---
+
+type GetProjectTopologyInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	ProjectID     string `path:"projectId" doc:"Project ID"`
+}
+
+type GetProjectTopologyOutput struct {
+	Body base.ApiResponse[topology.Graph]
+}
+
+type GetHostTopologyInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+}
+
+type GetHostTopologyOutput struct {
+	Body base.ApiResponse[topology.Graph]
+}
+
+// RegisterTopology registers the topology routes using Huma.
+func RegisterTopology(api huma.API, topologyService *services.TopologyService) {
+	h := &TopologyHandler{
+		topologyService: topologyService,
+	}
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-project-topology",
+		Method:      http.MethodGet,
+		Path:        "/environments/{id}/projects/{projectId}/topology",
+		Summary:     "Get project topology",
+		Description: "Get the containers, networks and volumes of a project and the relationships between them as a graph",
+		Tags:        []string{"Projects"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.GetProjectTopology)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-host-topology",
+		Method:      http.MethodGet,
+		Path:        "/environments/{id}/topology",
+		Summary:     "Get host topology",
+		Description: "Get every container, network and volume on the host and the relationships between them as a graph",
+		Tags:        []string{"Containers"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.GetHostTopology)
+}
+
+// GetProjectTopology returns the topology graph of a project.
+func (h *TopologyHandler) GetProjectTopology(ctx context.Context, input *GetProjectTopologyInput) (*GetProjectTopologyOutput, error) {
+	if h.topologyService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	if input.ProjectID == "" {
+		return nil, huma.Error400BadRequest((&common.ProjectIDRequiredError{}).Error())
+	}
+
+	graph, err := h.topologyService.GetProjectTopology(ctx, input.ProjectID)
+	if err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.TopologyRetrievalError{Err: err}).Error())
+	}
+
+	return &GetProjectTopologyOutput{
+		Body: base.ApiResponse[topology.Graph]{
+			Success: true,
+			Data:    *graph,
+		},
+	}, nil
+}
+
+// GetHostTopology returns the topology graph of every container on the host.
+func (h *TopologyHandler) GetHostTopology(ctx context.Context, _ *GetHostTopologyInput) (*GetHostTopologyOutput, error) {
+	if h.topologyService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	graph, err := h.topologyService.GetHostTopology(ctx)
+	if err != nil {
+		return nil, huma.Error500InternalServerError((&common.TopologyRetrievalError{Err: err}).Error())
+	}
+
+	return &GetHostTopologyOutput{
+		Body: base.ApiResponse[topology.Graph]{
+			Success: true,
+			Data:    *graph,
+		},
+	}, nil
+}
@@ -2,10 +2,12 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
 	"github.com/danielgtaylor/huma/v2"
 	"github.com/getarcaneapp/arcane/backend/internal/common"
+	humamw "github.com/getarcaneapp/arcane/backend/internal/huma/middleware"
 	"github.com/getarcaneapp/arcane/backend/internal/models"
 	"github.com/getarcaneapp/arcane/backend/internal/services"
 	"github.com/getarcaneapp/arcane/types/base"
@@ -37,6 +39,24 @@ type UpdateContainerOutput struct {
 	Body base.ApiResponse[*updater.Result]
 }
 
+type PreviewContainerUpdateInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	ContainerID   string `path:"containerId" doc:"Container ID to preview the update for"`
+}
+
+type PreviewContainerUpdateOutput struct {
+	Body base.ApiResponse[*updater.ContainerUpdatePreview]
+}
+
+type RollbackContainerInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	ContainerID   string `path:"containerId" doc:"Container ID to roll back"`
+}
+
+type RollbackContainerOutput struct {
+	Body base.ApiResponse[*updater.ContainerRollback]
+}
+
 type GetUpdaterStatusInput struct {
 	EnvironmentID string `path:"id" doc:"Environment ID"`
 }
@@ -54,6 +74,44 @@ type GetUpdaterHistoryOutput struct {
 	Body base.ApiResponse[[]models.AutoUpdateRecord]
 }
 
+type UpdaterRunPaginatedResponse struct {
+	Success    bool                    `json:"success"`
+	Data       []updater.Run           `json:"data"`
+	Pagination base.PaginationResponse `json:"pagination"`
+}
+
+type ListUpdaterRunsInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	Sort          string `query:"sort" doc:"Column to sort by"`
+	Order         string `query:"order" default:"desc" doc:"Sort direction (asc or desc)"`
+	Start         int    `query:"start" default:"0" doc:"Start index for pagination"`
+	Limit         int    `query:"limit" default:"20" doc:"Number of items per page"`
+	Trigger       string `query:"trigger" doc:"Filter by trigger (schedule, manual or webhook)"`
+	Status        string `query:"status" doc:"Filter by status"`
+}
+
+type ListUpdaterRunsOutput struct {
+	Body UpdaterRunPaginatedResponse
+}
+
+type GetUpdaterRunInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	RunID         string `path:"runId" doc:"Updater run ID"`
+}
+
+type GetUpdaterRunOutput struct {
+	Body base.ApiResponse[*updater.RunDetail]
+}
+
+type RetryUpdaterRunInput struct {
+	EnvironmentID string `path:"id" doc:"Environment ID"`
+	RunID         string `path:"runId" doc:"ID of the updater run to retry"`
+}
+
+type RetryUpdaterRunOutput struct {
+	Body base.ApiResponse[*updater.RunDetail]
+}
+
 // RegisterUpdater registers updater management routes using Huma.
 func RegisterUpdater(api huma.API, updaterService *services.UpdaterService) {
 	h := &UpdaterHandler{
@@ -99,6 +157,45 @@ func RegisterUpdater(api huma.API, updaterService *services.UpdaterService) {
 		},
 	}, h.GetUpdaterHistory)
 
+	huma.Register(api, huma.Operation{
+		OperationID: "list-updater-runs",
+		Method:      http.MethodGet,
+		Path:        "/environments/{id}/updater/runs",
+		Summary:     "List updater runs",
+		Description: "Get a paginated list of updater runs with what started them and their aggregate counts",
+		Tags:        []string{"Updater"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.ListUpdaterRuns)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "get-updater-run",
+		Method:      http.MethodGet,
+		Path:        "/environments/{id}/updater/runs/{runId}",
+		Summary:     "Get updater run",
+		Description: "Get an updater run with the result for each resource it checked",
+		Tags:        []string{"Updater"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.GetUpdaterRun)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "retry-updater-run",
+		Method:      http.MethodPost,
+		Path:        "/environments/{id}/updater/runs/{runId}/retry",
+		Summary:     "Retry failed items of an updater run",
+		Description: "Re-attempt the failed image pulls and container recreations of a finished updater run in a new run linked to it",
+		Tags:        []string{"Updater"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.RetryUpdaterRun)
+
 	huma.Register(api, huma.Operation{
 		OperationID: "update-container",
 		Method:      http.MethodPost,
@@ -111,6 +208,32 @@ func RegisterUpdater(api huma.API, updaterService *services.UpdaterService) {
 			{"ApiKeyAuth": {}},
 		},
 	}, h.UpdateContainer)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "preview-container-update",
+		Method:      http.MethodGet,
+		Path:        "/environments/{id}/containers/{containerId}/update/preview",
+		Summary:     "Preview a single container update",
+		Description: "Check the registry digest for a container's image and report whether updating it would recreate it, the digest it would move to and which dependent containers would restart, without pulling or changing anything",
+		Tags:        []string{"Updater", "Containers"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.PreviewContainerUpdate)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "rollback-container",
+		Method:      http.MethodPost,
+		Path:        "/environments/{id}/containers/{containerId}/rollback",
+		Summary:     "Roll back a container update",
+		Description: "Recreate a container from the image it used before its most recent update by Arcane, pulling it again by digest if it is no longer present",
+		Tags:        []string{"Updater", "Containers"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.RollbackContainer)
 }
 
 // RunUpdater applies pending container updates.
@@ -124,7 +247,11 @@ func (h *UpdaterHandler) RunUpdater(ctx context.Context, input *RunUpdaterInput)
 		dryRun = input.Body.DryRun
 	}
 
-	out, err := h.updaterService.ApplyPending(ctx, dryRun)
+	out, err := h.updaterService.ApplyPending(updaterTriggerContextInternal(ctx), dryRun)
+	var preconditionErr *services.UpdaterPreconditionError
+	if errors.As(err, &preconditionErr) {
+		return nil, huma.NewError(http.StatusPreconditionFailed, (&common.UpdaterRunError{Err: err}).Error())
+	}
 	if err != nil {
 		return nil, huma.Error500InternalServerError((&common.UpdaterRunError{Err: err}).Error())
 	}
@@ -177,13 +304,87 @@ func (h *UpdaterHandler) GetUpdaterHistory(ctx context.Context, input *GetUpdate
 	}, nil
 }
 
+// ListUpdaterRuns returns a paginated list of updater runs.
+func (h *UpdaterHandler) ListUpdaterRuns(ctx context.Context, input *ListUpdaterRunsInput) (*ListUpdaterRunsOutput, error) {
+	if h.updaterService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	params := buildPaginationParams(0, input.Start, input.Limit, input.Sort, input.Order, "")
+	if input.Trigger != "" {
+		params.Filters["trigger"] = input.Trigger
+	}
+	if input.Status != "" {
+		params.Filters["status"] = input.Status
+	}
+
+	runs, paginationResp, err := h.updaterService.ListRunsPaginated(ctx, params)
+	if err != nil {
+		return nil, huma.Error500InternalServerError((&common.UpdaterHistoryError{Err: err}).Error())
+	}
+
+	return &ListUpdaterRunsOutput{
+		Body: UpdaterRunPaginatedResponse{
+			Success: true,
+			Data:    runs,
+			Pagination: base.PaginationResponse{
+				TotalPages:      paginationResp.TotalPages,
+				TotalItems:      paginationResp.TotalItems,
+				CurrentPage:     paginationResp.CurrentPage,
+				ItemsPerPage:    paginationResp.ItemsPerPage,
+				GrandTotalItems: paginationResp.GrandTotalItems,
+			},
+		},
+	}, nil
+}
+
+// GetUpdaterRun returns an updater run with its per-resource results.
+func (h *UpdaterHandler) GetUpdaterRun(ctx context.Context, input *GetUpdaterRunInput) (*GetUpdaterRunOutput, error) {
+	if h.updaterService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	run, err := h.updaterService.GetRun(ctx, input.RunID)
+	if err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.UpdaterHistoryError{Err: err}).Error())
+	}
+
+	return &GetUpdaterRunOutput{
+		Body: base.ApiResponse[*updater.RunDetail]{
+			Success: true,
+			Data:    run,
+		},
+	}, nil
+}
+
+// RetryUpdaterRun re-attempts the failed items of an updater run.
+func (h *UpdaterHandler) RetryUpdaterRun(ctx context.Context, input *RetryUpdaterRunInput) (*RetryUpdaterRunOutput, error) {
+	if h.updaterService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	run, err := h.updaterService.RetryRun(updaterTriggerContextInternal(ctx), input.RunID)
+	if err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.UpdaterRetryError{Err: err}).Error())
+	}
+
+	return &RetryUpdaterRunOutput{
+		Body: base.ApiResponse[*updater.RunDetail]{
+			Success: true,
+			Data:    run,
+		},
+	}, nil
+}
+
 // UpdateContainer updates a single container by pulling the latest image and recreating it.
 func (h *UpdaterHandler) UpdateContainer(ctx context.Context, input *UpdateContainerInput) (*UpdateContainerOutput, error) {
 	if h.updaterService == nil {
 		return nil, huma.Error500InternalServerError("service not available")
 	}
 
-	out, err := h.updaterService.UpdateSingleContainer(ctx, input.ContainerID)
+	out, err := h.updaterService.UpdateSingleContainer(updaterTriggerContextInternal(ctx), input.ContainerID)
 	if err != nil {
 		return nil, huma.Error500InternalServerError((&common.UpdaterRunError{Err: err}).Error())
 	}
@@ -195,3 +396,58 @@ func (h *UpdaterHandler) UpdateContainer(ctx context.Context, input *UpdateConta
 		},
 	}, nil
 }
+
+// PreviewContainerUpdate reports what updating a single container would do.
+func (h *UpdaterHandler) PreviewContainerUpdate(ctx context.Context, input *PreviewContainerUpdateInput) (*PreviewContainerUpdateOutput, error) {
+	if h.updaterService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	preview, err := h.updaterService.PreviewContainerUpdate(ctx, input.ContainerID)
+	if err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.UpdaterPreviewError{Err: err}).Error())
+	}
+
+	return &PreviewContainerUpdateOutput{
+		Body: base.ApiResponse[*updater.ContainerUpdatePreview]{
+			Success: true,
+			Data:    preview,
+		},
+	}, nil
+}
+
+// RollbackContainer recreates a container from the image it used before its
+// most recent update.
+func (h *UpdaterHandler) RollbackContainer(ctx context.Context, input *RollbackContainerInput) (*RollbackContainerOutput, error) {
+	if h.updaterService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	user, exists := humamw.GetCurrentUserFromContext(ctx)
+	if !exists {
+		return nil, huma.Error401Unauthorized("not authenticated")
+	}
+
+	rollback, err := h.updaterService.RollbackContainer(ctx, input.ContainerID, *user)
+	if err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.UpdaterRollbackError{Err: err}).Error())
+	}
+
+	return &RollbackContainerOutput{
+		Body: base.ApiResponse[*updater.ContainerRollback]{
+			Success: true,
+			Data:    rollback,
+		},
+	}, nil
+}
+
+// updaterTriggerContextInternal records runs started with an API key, such as
+// from a CI webhook, apart from runs started in the UI.
+func updaterTriggerContextInternal(ctx context.Context) context.Context {
+	if _, ok := humamw.GetApiKeyScopesFromContext(ctx); ok {
+		return services.WithAutoUpdateTrigger(ctx, models.AutoUpdateTriggerWebhook)
+	}
+	return ctx
+}
@@ -0,0 +1,167 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/getarcaneapp/arcane/backend/internal/common"
+	humamw "github.com/getarcaneapp/arcane/backend/internal/huma/middleware"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/services"
+	"github.com/getarcaneapp/arcane/types/base"
+	"github.com/getarcaneapp/arcane/types/notification"
+)
+
+// UserNotificationHandler handles the current user's notification subscriptions.
+type UserNotificationHandler struct {
+	userNotificationService *services.UserNotificationService
+}
+
+// --- Huma Input/Output Wrappers ---
+
+type ListNotificationSubscriptionsInput struct{}
+
+type ListNotificationSubscriptionsOutput struct {
+	Body base.ApiResponse[[]notification.Subscription]
+}
+
+type SetNotificationSubscriptionInput struct {
+	Provider string `path:"provider" doc:"Provider (email or telegram)"`
+	Body     notification.SubscriptionUpdate
+}
+
+type SetNotificationSubscriptionOutput struct {
+	Body base.ApiResponse[notification.Subscription]
+}
+
+type DeleteNotificationSubscriptionInput struct {
+	Provider string `path:"provider" doc:"Provider (email or telegram)"`
+}
+
+type DeleteNotificationSubscriptionOutput struct {
+	Body base.ApiResponse[base.MessageResponse]
+}
+
+// RegisterUserNotifications registers the personal notification subscription
+// routes. They are available to every authenticated user.
+func RegisterUserNotifications(api huma.API, userNotificationService *services.UserNotificationService) {
+	h := &UserNotificationHandler{
+		userNotificationService: userNotificationService,
+	}
+
+	huma.Register(api, huma.Operation{
+		OperationID: "list-notification-subscriptions",
+		Method:      http.MethodGet,
+		Path:        "/notifications/subscriptions",
+		Summary:     "List my notification subscriptions",
+		Description: "List the current user's personal notification subscriptions",
+		Tags:        []string{"Notifications"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.ListNotificationSubscriptions)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "set-notification-subscription",
+		Method:      http.MethodPut,
+		Path:        "/notifications/subscriptions/{provider}",
+		Summary:     "Set my notification subscription",
+		Description: "Subscribe the current user's email address or Telegram chat to notification events, delivered through the global provider",
+		Tags:        []string{"Notifications"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.SetNotificationSubscription)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "delete-notification-subscription",
+		Method:      http.MethodDelete,
+		Path:        "/notifications/subscriptions/{provider}",
+		Summary:     "Delete my notification subscription",
+		Tags:        []string{"Notifications"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.DeleteNotificationSubscription)
+}
+
+// ListNotificationSubscriptions returns the current user's subscriptions.
+func (h *UserNotificationHandler) ListNotificationSubscriptions(ctx context.Context, _ *ListNotificationSubscriptionsInput) (*ListNotificationSubscriptionsOutput, error) {
+	if h.userNotificationService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	user, exists := humamw.GetCurrentUserFromContext(ctx)
+	if !exists {
+		return nil, huma.Error401Unauthorized((&common.NotAuthenticatedError{}).Error())
+	}
+
+	subs, err := h.userNotificationService.ListSubscriptions(ctx, user.ID)
+	if err != nil {
+		return nil, huma.Error500InternalServerError((&common.NotificationSubscriptionListError{Err: err}).Error())
+	}
+
+	return &ListNotificationSubscriptionsOutput{
+		Body: base.ApiResponse[[]notification.Subscription]{
+			Success: true,
+			Data:    subs,
+		},
+	}, nil
+}
+
+// SetNotificationSubscription creates or replaces the current user's
+// subscription for a provider.
+func (h *UserNotificationHandler) SetNotificationSubscription(ctx context.Context, input *SetNotificationSubscriptionInput) (*SetNotificationSubscriptionOutput, error) {
+	if h.userNotificationService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	user, exists := humamw.GetCurrentUserFromContext(ctx)
+	if !exists {
+		return nil, huma.Error401Unauthorized((&common.NotAuthenticatedError{}).Error())
+	}
+
+	sub, err := h.userNotificationService.SetSubscription(ctx, user.ID, models.NotificationProvider(input.Provider), input.Body)
+	if err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.NotificationSubscriptionUpdateError{Err: err}).Error())
+	}
+
+	return &SetNotificationSubscriptionOutput{
+		Body: base.ApiResponse[notification.Subscription]{
+			Success: true,
+			Data:    *sub,
+		},
+	}, nil
+}
+
+// DeleteNotificationSubscription removes the current user's subscription for a
+// provider.
+func (h *UserNotificationHandler) DeleteNotificationSubscription(ctx context.Context, input *DeleteNotificationSubscriptionInput) (*DeleteNotificationSubscriptionOutput, error) {
+	if h.userNotificationService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	user, exists := humamw.GetCurrentUserFromContext(ctx)
+	if !exists {
+		return nil, huma.Error401Unauthorized((&common.NotAuthenticatedError{}).Error())
+	}
+
+	if err := h.userNotificationService.DeleteSubscription(ctx, user.ID, models.NotificationProvider(input.Provider)); err != nil {
+		apiErr := models.ToAPIError(err)
+		return nil, huma.NewError(apiErr.HTTPStatus(), (&common.NotificationSubscriptionDeletionError{Err: err}).Error())
+	}
+
+	return &DeleteNotificationSubscriptionOutput{
+		Body: base.ApiResponse[base.MessageResponse]{
+			Success: true,
+			Data: base.MessageResponse{
+				Message: "Notification subscription deleted successfully",
+			},
+		},
+	}, nil
+}
@@ -5,13 +5,16 @@ import (
 	"strings"
 
 	"github.com/danielgtaylor/huma/v2"
+	"github.com/getarcaneapp/arcane/backend/internal/common"
 	"github.com/getarcaneapp/arcane/backend/internal/services"
+	"github.com/getarcaneapp/arcane/types/base"
 	"github.com/getarcaneapp/arcane/types/version"
 )
 
 // VersionHandler handles version information endpoints.
 type VersionHandler struct {
 	versionService *services.VersionService
+	upgradeService *services.SystemUpgradeService
 }
 
 // ============================================================================
@@ -32,13 +35,17 @@ type GetAppVersionOutput struct {
 	Body version.Info
 }
 
+type GetChangelogOutput struct {
+	Body base.ApiResponse[version.Changelog]
+}
+
 // ============================================================================
 // Registration
 // ============================================================================
 
 // RegisterVersion registers version endpoints.
-func RegisterVersion(api huma.API, versionService *services.VersionService) {
-	h := &VersionHandler{versionService: versionService}
+func RegisterVersion(api huma.API, versionService *services.VersionService, upgradeService *services.SystemUpgradeService) {
+	h := &VersionHandler{versionService: versionService, upgradeService: upgradeService}
 
 	huma.Register(api, huma.Operation{
 		OperationID: "getVersion",
@@ -57,6 +64,19 @@ func RegisterVersion(api huma.API, versionService *services.VersionService) {
 		Description: "Get the current application version",
 		Tags:        []string{"Version"},
 	}, h.GetAppVersion)
+
+	huma.Register(api, huma.Operation{
+		OperationID: "getChangelog",
+		Method:      "GET",
+		Path:        "/version/changelog",
+		Summary:     "Get changelog",
+		Description: "Get the release notes of every Arcane release newer than the running version and whether Arcane can upgrade itself",
+		Tags:        []string{"Version"},
+		Security: []map[string][]string{
+			{"BearerAuth": {}},
+			{"ApiKeyAuth": {}},
+		},
+	}, h.GetChangelog)
 }
 
 // ============================================================================
@@ -89,3 +109,30 @@ func (h *VersionHandler) GetAppVersion(ctx context.Context, _ *GetAppVersionInpu
 		Body: *info,
 	}, nil
 }
+
+// GetChangelog returns the releases published since the running version.
+func (h *VersionHandler) GetChangelog(ctx context.Context, _ *struct{}) (*GetChangelogOutput, error) {
+	if h.versionService == nil {
+		return nil, huma.Error500InternalServerError("service not available")
+	}
+
+	changelog, err := h.versionService.GetChangelog(ctx)
+	if err != nil {
+		return nil, huma.Error502BadGateway((&common.ChangelogFetchError{Err: err}).Error())
+	}
+
+	if changelog.UpdateAvailable && h.upgradeService != nil {
+		canUpgrade, err := h.upgradeService.CanUpgrade(ctx)
+		changelog.CanUpgrade = canUpgrade
+		if err != nil {
+			changelog.UpgradeMessage = (&common.UpgradeCheckError{Err: err}).Error()
+		}
+	}
+
+	return &GetChangelogOutput{
+		Body: base.ApiResponse[version.Changelog]{
+			Success: true,
+			Data:    *changelog,
+		},
+	}, nil
+}
@@ -51,6 +51,9 @@ func customSchemaNamer(t reflect.Type, hint string) string {
 	if innerPkg, ok := genericInnerPackageName(pkgPath, typeStr); ok {
 		return strings.Replace(name, "UsageCounts", innerPkg+"UsageCounts", 1)
 	}
+	if innerPkg, innerName, ok := genericItemTypeName(pkgPath, typeStr); ok && strings.HasSuffix(name, innerName) {
+		return strings.TrimSuffix(name, innerName) + innerPkg + innerName
+	}
 
 	return name
 }
@@ -127,6 +130,30 @@ func genericInnerPackageName(pkgPath, typeName string) (string, bool) {
 	return capitalizeFirst(before), true
 }
 
+// genericItemTypeName returns the package and name of the Arcane type that a
+// generic wrapper outside the base package is instantiated with, such as the
+// item type of the all-environments lists. Several packages name their list
+// items Summary, so the package keeps the schema names apart.
+func genericItemTypeName(pkgPath, typeName string) (string, string, bool) {
+	if strings.HasPrefix(pkgPath, arcaneTypesPrefix+"base") {
+		return "", "", false
+	}
+	_, inner, ok := strings.Cut(typeName, "[")
+	if !ok {
+		return "", "", false
+	}
+	_, after, ok := strings.Cut(inner, arcaneTypesPrefix)
+	if !ok {
+		return "", "", false
+	}
+	pkg, item, ok := strings.Cut(strings.TrimSuffix(after, "]"), ".")
+	if !ok || pkg == "" || item == "" || strings.ContainsAny(item, "[],") {
+		return "", "", false
+	}
+
+	return capitalizeFirst(pkg), item, true
+}
+
 func capitalizeFirst(s string) string {
 	if s == "" {
 		return s
@@ -137,40 +164,67 @@ func capitalizeFirst(s string) string {
 
 // Services holds all service dependencies needed by Huma handlers.
 type Services struct {
-	User              *services.UserService
-	Auth              *services.AuthService
-	Oidc              *services.OidcService
-	ApiKey            *services.ApiKeyService
-	AppImages         *services.ApplicationImagesService
-	Font              *services.FontService
-	Project           *services.ProjectService
-	Event             *services.EventService
-	Version           *services.VersionService
-	Environment       *services.EnvironmentService
-	Settings          *services.SettingsService
-	JobSchedule       *services.JobService
-	SettingsSearch    *services.SettingsSearchService
-	ContainerRegistry *services.ContainerRegistryService
-	Template          *services.TemplateService
-	Docker            *services.DockerClientService
-	Image             *services.ImageService
-	ImageUpdate       *services.ImageUpdateService
-	Build             *services.BuildService
-	BuildWorkspace    *services.BuildWorkspaceService
-	Volume            *services.VolumeService
-	Container         *services.ContainerService
-	Network           *services.NetworkService
-	Notification      *services.NotificationService
-	Apprise           *services.AppriseService //nolint:staticcheck // Apprise still functional, deprecated in favor of Shoutrrr
-	Updater           *services.UpdaterService
-	CustomizeSearch   *services.CustomizeSearchService
-	System            *services.SystemService
-	SystemUpgrade     *services.SystemUpgradeService
-	GitRepository     *services.GitRepositoryService
-	GitOpsSync        *services.GitOpsSyncService
-	Vulnerability     *services.VulnerabilityService
-	Dashboard         *services.DashboardService
-	Config            *config.Config
+	User               *services.UserService
+	Auth               *services.AuthService
+	Oidc               *services.OidcService
+	ApiKey             *services.ApiKeyService
+	AppImages          *services.ApplicationImagesService
+	Font               *services.FontService
+	Project            *services.ProjectService
+	Event              *services.EventService
+	Version            *services.VersionService
+	Environment        *services.EnvironmentService
+	Settings           *services.SettingsService
+	JobSchedule        *services.JobService
+	SettingsSearch     *services.SettingsSearchService
+	ContainerRegistry  *services.ContainerRegistryService
+	Template           *services.TemplateService
+	Docker             *services.DockerClientService
+	Image              *services.ImageService
+	ImageUpdate        *services.ImageUpdateService
+	Build              *services.BuildService
+	BuildWorkspace     *services.BuildWorkspaceService
+	Volume             *services.VolumeService
+	Container          *services.ContainerService
+	Network            *services.NetworkService
+	Notification       *services.NotificationService
+	Apprise            *services.AppriseService //nolint:staticcheck // Apprise still functional, deprecated in favor of Shoutrrr
+	Updater            *services.UpdaterService
+	CustomizeSearch    *services.CustomizeSearchService
+	System             *services.SystemService
+	SystemUpgrade      *services.SystemUpgradeService
+	GitRepository      *services.GitRepositoryService
+	GitOpsSync         *services.GitOpsSyncService
+	Vulnerability      *services.VulnerabilityService
+	Dashboard          *services.DashboardService
+	Rbac               *services.RbacService
+	Health             *services.HealthService
+	Monitor            *services.MonitorService
+	HostMetrics        *services.HostMetricsService
+	Aggregation        *services.AggregationService
+	Backup             *services.BackupService
+	Secrets            *services.SecretsService
+	CACertificate      *services.CACertificateService
+	UserNotification   *services.UserNotificationService
+	Topology           *services.TopologyService
+	ProjectAdoption    *services.ProjectAdoptionService
+	LogForwarding      *services.LogForwardingService
+	LogAlert           *services.LogAlertService
+	Ingress            *services.IngressService
+	ContainerTask      *services.ContainerTaskService
+	ProjectMaintenance *services.ProjectMaintenanceService
+	SecurityAudit      *services.SecurityAuditService
+	StaleResource      *services.StaleResourceService
+	DaemonConfig       *services.DaemonConfigService
+	ImageDistribution  *services.ImageDistributionService
+	ContainerClone     *services.ContainerCloneService
+	Rollout            *services.RolloutService
+	ContainerSnapshot  *services.ContainerSnapshotService
+	Tag                *services.TagService
+	CommandWebhook     *services.CommandWebhookService
+	NotificationAction *services.NotificationActionService
+	Setup              *services.SetupService
+	Config             *config.Config
 }
 
 // SetupAPI creates and configures the Huma API alongside the existing Gin router.
@@ -218,6 +272,12 @@ func SetupAPI(router *gin.Engine, apiGroup *gin.RouterGroup, cfg *config.Config,
 	// Add authentication middleware
 	api.UseMiddleware(middleware.NewAuthBridge(api, svc.Auth, svc.ApiKey, cfg))
 
+	// Resolve environment/project scoped roles for authenticated users
+	api.UseMiddleware(middleware.NewRbacBridge(api, svc.Rbac))
+
+	// Reject mutating requests while read-only maintenance mode is enabled
+	api.UseMiddleware(middleware.NewMaintenanceBridge(api, svc.Settings))
+
 	// Register all Huma handlers
 	registerHandlers(api, svc)
 
@@ -332,6 +392,33 @@ func registerHandlers(api huma.API, svc *Services) {
 	var gitOpsSyncSvc *services.GitOpsSyncService
 	var vulnerabilitySvc *services.VulnerabilityService
 	var dashboardSvc *services.DashboardService
+	var rbacSvc *services.RbacService
+	var healthSvc *services.HealthService
+	var monitorSvc *services.MonitorService
+	var hostMetricsSvc *services.HostMetricsService
+	var aggregationSvc *services.AggregationService
+	var backupSvc *services.BackupService
+	var secretsSvc *services.SecretsService
+	var caCertificateSvc *services.CACertificateService
+	var userNotificationSvc *services.UserNotificationService
+	var topologySvc *services.TopologyService
+	var projectAdoptionSvc *services.ProjectAdoptionService
+	var logForwardingSvc *services.LogForwardingService
+	var logAlertSvc *services.LogAlertService
+	var ingressSvc *services.IngressService
+	var containerTaskSvc *services.ContainerTaskService
+	var projectMaintenanceSvc *services.ProjectMaintenanceService
+	var securityAuditSvc *services.SecurityAuditService
+	var staleResourceSvc *services.StaleResourceService
+	var daemonConfigSvc *services.DaemonConfigService
+	var imageDistributionSvc *services.ImageDistributionService
+	var containerCloneSvc *services.ContainerCloneService
+	var rolloutSvc *services.RolloutService
+	var containerSnapshotSvc *services.ContainerSnapshotService
+	var tagSvc *services.TagService
+	var commandWebhookSvc *services.CommandWebhookService
+	var notificationActionSvc *services.NotificationActionService
+	var setupSvc *services.SetupService
 	var cfg *config.Config
 
 	if svc != nil {
@@ -368,16 +455,43 @@ func registerHandlers(api huma.API, svc *Services) {
 		gitOpsSyncSvc = svc.GitOpsSync
 		vulnerabilitySvc = svc.Vulnerability
 		dashboardSvc = svc.Dashboard
+		rbacSvc = svc.Rbac
+		healthSvc = svc.Health
+		monitorSvc = svc.Monitor
+		hostMetricsSvc = svc.HostMetrics
+		aggregationSvc = svc.Aggregation
+		backupSvc = svc.Backup
+		secretsSvc = svc.Secrets
+		caCertificateSvc = svc.CACertificate
+		userNotificationSvc = svc.UserNotification
+		topologySvc = svc.Topology
+		projectAdoptionSvc = svc.ProjectAdoption
+		logForwardingSvc = svc.LogForwarding
+		logAlertSvc = svc.LogAlert
+		ingressSvc = svc.Ingress
+		containerTaskSvc = svc.ContainerTask
+		projectMaintenanceSvc = svc.ProjectMaintenance
+		securityAuditSvc = svc.SecurityAudit
+		staleResourceSvc = svc.StaleResource
+		daemonConfigSvc = svc.DaemonConfig
+		imageDistributionSvc = svc.ImageDistribution
+		containerCloneSvc = svc.ContainerClone
+		rolloutSvc = svc.Rollout
+		containerSnapshotSvc = svc.ContainerSnapshot
+		tagSvc = svc.Tag
+		commandWebhookSvc = svc.CommandWebhook
+		notificationActionSvc = svc.NotificationAction
+		setupSvc = svc.Setup
 		cfg = svc.Config
 	}
-	handlers.RegisterHealth(api)
+	handlers.RegisterHealth(api, healthSvc)
 	handlers.RegisterAuth(api, userSvc, authSvc, oidcSvc)
 	handlers.RegisterApiKeys(api, apiKeySvc)
 	handlers.RegisterAppImages(api, appImagesSvc)
 	handlers.RegisterFonts(api, fontSvc)
 	handlers.RegisterProjects(api, projectSvc)
 	handlers.RegisterUsers(api, userSvc)
-	handlers.RegisterVersion(api, versionSvc)
+	handlers.RegisterVersion(api, versionSvc, systemUpgradeSvc)
 	handlers.RegisterEvents(api, eventSvc, apiKeySvc)
 	handlers.RegisterOidc(api, authSvc, oidcSvc, cfg)
 	handlers.RegisterEnvironments(api, environmentSvc, settingsSvc, apiKeySvc, eventSvc, cfg)
@@ -399,4 +513,30 @@ func registerHandlers(api huma.API, svc *Services) {
 	handlers.RegisterGitOpsSyncs(api, gitOpsSyncSvc)
 	handlers.RegisterVulnerability(api, vulnerabilitySvc)
 	handlers.RegisterDashboard(api, dashboardSvc)
+	handlers.RegisterRbac(api, rbacSvc)
+	handlers.RegisterMonitors(api, monitorSvc)
+	handlers.RegisterHostMetrics(api, hostMetricsSvc)
+	handlers.RegisterAggregate(api, aggregationSvc)
+	handlers.RegisterBackup(api, backupSvc)
+	handlers.RegisterSecrets(api, secretsSvc)
+	handlers.RegisterCACertificates(api, caCertificateSvc)
+	handlers.RegisterUserNotifications(api, userNotificationSvc)
+	handlers.RegisterTopology(api, topologySvc)
+	handlers.RegisterProjectAdoption(api, projectAdoptionSvc)
+	handlers.RegisterLogForwarding(api, logForwardingSvc)
+	handlers.RegisterLogAlerts(api, logAlertSvc)
+	handlers.RegisterIngress(api, ingressSvc)
+	handlers.RegisterContainerTasks(api, containerTaskSvc)
+	handlers.RegisterProjectMaintenance(api, projectMaintenanceSvc, projectSvc)
+	handlers.RegisterSecurityAudit(api, securityAuditSvc)
+	handlers.RegisterStaleResources(api, staleResourceSvc)
+	handlers.RegisterDaemonConfig(api, daemonConfigSvc)
+	handlers.RegisterImageDistribution(api, imageDistributionSvc)
+	handlers.RegisterContainerClone(api, containerCloneSvc)
+	handlers.RegisterRollout(api, rolloutSvc)
+	handlers.RegisterContainerSnapshots(api, containerSnapshotSvc)
+	handlers.RegisterTags(api, tagSvc)
+	handlers.RegisterCommandWebhooks(api, commandWebhookSvc)
+	handlers.RegisterNotificationActions(api, notificationActionSvc)
+	handlers.RegisterSetup(api, setupSvc, authSvc, environmentSvc, settingsSvc, apiKeySvc, eventSvc, notificationSvc, appriseSvc, cfg)
 }
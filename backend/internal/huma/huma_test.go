@@ -6,7 +6,9 @@ import (
 	"testing"
 
 	basetypes "github.com/getarcaneapp/arcane/types/base"
+	containertypes "github.com/getarcaneapp/arcane/types/container"
 	envtypes "github.com/getarcaneapp/arcane/types/env"
+	environmenttypes "github.com/getarcaneapp/arcane/types/environment"
 	imagetypes "github.com/getarcaneapp/arcane/types/image"
 	volumetypes "github.com/getarcaneapp/arcane/types/volume"
 	dockernetwork "github.com/moby/moby/api/types/network"
@@ -57,3 +59,24 @@ func TestCustomSchemaNamer_DisambiguatesGenericUsageCounts(t *testing.T) {
 		t.Fatalf("expected unique generic schema names, got %q", volumeResp)
 	}
 }
+
+func TestCustomSchemaNamer_QualifiesAggregatedItemTypes(t *testing.T) {
+	containerItem := customSchemaNamer(reflect.TypeFor[environmenttypes.AggregatedItem[containertypes.Summary]](), "")
+	imageItem := customSchemaNamer(reflect.TypeFor[environmenttypes.AggregatedItem[imagetypes.Summary]](), "")
+
+	if containerItem != "EnvironmentAggregatedItemContainerSummary" {
+		t.Fatalf("expected EnvironmentAggregatedItemContainerSummary, got %q", containerItem)
+	}
+	if imageItem != "EnvironmentAggregatedItemImageSummary" {
+		t.Fatalf("expected EnvironmentAggregatedItemImageSummary, got %q", imageItem)
+	}
+}
+
+func TestSetupAPIForSpec_RegistersEveryOperation(t *testing.T) {
+	// Registration panics when two response types map to the same schema name.
+	api := SetupAPIForSpec()
+
+	if len(api.OpenAPI().Paths) == 0 {
+		t.Fatal("expected the spec to contain paths")
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/getarcaneapp/arcane/backend/internal/models"
 	"github.com/getarcaneapp/arcane/backend/internal/services"
 	pkgutils "github.com/getarcaneapp/arcane/backend/pkg/utils"
+	"github.com/getarcaneapp/arcane/types/apikey"
 )
 
 const (
@@ -29,6 +30,8 @@ const (
 	ContextKeyCurrentUser ContextKey = "currentUser"
 	// ContextKeyUserIsAdmin is the context key for whether the user is an admin.
 	ContextKeyUserIsAdmin ContextKey = "userIsAdmin"
+	// ContextKeyApiKeyScopes is the context key for the scopes of the API key used to authenticate.
+	ContextKeyApiKeyScopes ContextKey = "apiKeyScopes"
 )
 
 // GetUserIDFromContext retrieves the user ID from the context.
@@ -43,6 +46,13 @@ func GetCurrentUserFromContext(ctx context.Context) (*models.User, bool) {
 	return u, ok
 }
 
+// GetApiKeyScopesFromContext returns the scopes of the API key used for the
+// request. ok is false when the request was not authenticated with an API key.
+func GetApiKeyScopesFromContext(ctx context.Context) ([]string, bool) {
+	scopes, ok := ctx.Value(ContextKeyApiKeyScopes).([]string)
+	return scopes, ok
+}
+
 // IsAdminFromContext checks if the current user is an admin.
 func IsAdminFromContext(ctx context.Context) bool {
 	isAdmin, ok := ctx.Value(ContextKeyUserIsAdmin).(bool)
@@ -93,18 +103,29 @@ func tryBearerAuth(ctx huma.Context, authService *services.AuthService) (*models
 }
 
 // tryApiKeyAuth checks if API key authentication should be allowed through.
-func tryApiKeyAuth(ctx huma.Context, apiKeyService *services.ApiKeyService) (*models.User, bool) {
+func tryApiKeyAuth(ctx huma.Context, apiKeyService *services.ApiKeyService) (*models.User, []string, bool) {
 	apiKey := ctx.Header(headerApiKey)
 	if apiKey == "" {
-		return nil, false
+		return nil, nil, false
 	}
 
-	user, err := apiKeyService.ValidateApiKey(ctx.Context(), apiKey)
+	user, scopes, err := apiKeyService.AuthenticateApiKey(ctx.Context(), apiKey)
 	if err != nil || user == nil {
-		return nil, false
+		return nil, nil, false
 	}
 
-	return user, true
+	if scopes == nil {
+		scopes = []string{}
+	}
+	return user, scopes, true
+}
+
+// apiKeyScopeForOperation returns the scope required to call the current operation.
+func apiKeyScopeForOperation(ctx huma.Context) string {
+	if op := ctx.Operation(); op != nil {
+		return apikey.RequiredScope(op.Method, op.Path)
+	}
+	return apikey.RequiredScope(ctx.Method(), ctx.URL().Path)
 }
 
 // tryAgentAuth checks if the request is from an authenticated agent.
@@ -174,8 +195,14 @@ func NewAuthBridge(api huma.API, authService *services.AuthService, apiKeyServic
 		// If API key header is present and API key auth is allowed, prioritize it.
 		// If validation fails, do NOT fall back to Bearer auth.
 		if reqs.apiKeyAuth && ctx.Header(headerApiKey) != "" {
-			if user, ok := tryApiKeyAuth(ctx, apiKeyService); ok {
+			if user, scopes, ok := tryApiKeyAuth(ctx, apiKeyService); ok {
+				required := apiKeyScopeForOperation(ctx)
+				if !apikey.ScopesAllow(scopes, required) {
+					_ = huma.WriteErr(api, ctx, http.StatusForbidden, "Forbidden: API key lacks the "+required+" scope")
+					return
+				}
 				newCtx := setUserInContext(ctx.Context(), user)
+				newCtx = context.WithValue(newCtx, ContextKeyApiKeyScopes, scopes)
 				ctx = huma.WithContext(ctx, newCtx)
 				next(ctx)
 				return
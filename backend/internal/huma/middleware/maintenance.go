@@ -0,0 +1,76 @@
+package middleware
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/getarcaneapp/arcane/backend/internal/services"
+)
+
+const (
+	// MetadataAllowDuringMaintenance is the operation metadata key that lets a
+	// mutating operation run while read-only maintenance mode is active.
+	MetadataAllowDuringMaintenance = "allowDuringMaintenance"
+
+	defaultMaintenanceMessage = "Arcane is in read-only maintenance mode"
+)
+
+// maintenanceAllowedOperations are mutating operations that must keep working
+// in maintenance mode so users can sign in and admins can turn it off again.
+var maintenanceAllowedOperations = []string{
+	"login",
+	"logout",
+	"refresh-token",
+	"handle-oidc-callback",
+	"initiate-oidc-device-auth",
+	"exchange-oidc-device-token",
+	"update-settings",
+}
+
+// AllowDuringMaintenance returns operation metadata that exempts an operation
+// from read-only maintenance mode.
+func AllowDuringMaintenance() map[string]any {
+	return map[string]any{MetadataAllowDuringMaintenance: true}
+}
+
+// allowedDuringMaintenance reports whether an operation may run while
+// maintenance mode is active. Safe methods are always allowed.
+func allowedDuringMaintenance(op *huma.Operation, method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	if op == nil {
+		return false
+	}
+	if allowed, ok := op.Metadata[MetadataAllowDuringMaintenance].(bool); ok && allowed {
+		return true
+	}
+	return slices.Contains(maintenanceAllowedOperations, op.OperationID)
+}
+
+// NewMaintenanceBridge creates a Huma middleware that rejects mutating
+// requests with 503 Service Unavailable while the maintenanceModeEnabled
+// setting is on.
+func NewMaintenanceBridge(api huma.API, settingsService *services.SettingsService) func(ctx huma.Context, next func(huma.Context)) {
+	return func(ctx huma.Context, next func(huma.Context)) {
+		if settingsService == nil {
+			next(ctx)
+			return
+		}
+
+		cfg := settingsService.GetSettingsConfig()
+		if !cfg.MaintenanceModeEnabled.IsTrue() || allowedDuringMaintenance(ctx.Operation(), ctx.Method()) {
+			next(ctx)
+			return
+		}
+
+		message := defaultMaintenanceMessage
+		if custom := strings.TrimSpace(cfg.MaintenanceModeMessage.Value); custom != "" {
+			message += ": " + custom
+		}
+		_ = huma.WriteErr(api, ctx, http.StatusServiceUnavailable, message)
+	}
+}
@@ -0,0 +1,94 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/getarcaneapp/arcane/backend/internal/services"
+	"github.com/getarcaneapp/arcane/types/rbac"
+)
+
+const (
+	// ContextKeyUserRole is the context key for the user's effective role within the request scope.
+	ContextKeyUserRole ContextKey = "userRole"
+
+	// MetadataRequiredRole is the operation metadata key used to override the
+	// role required to call an operation.
+	MetadataRequiredRole = "requiredRole"
+
+	environmentScopedPathPrefix = "/environments/{id}/"
+)
+
+// GetUserRoleFromContext retrieves the effective role resolved for the request.
+func GetUserRoleFromContext(ctx context.Context) (string, bool) {
+	role, ok := ctx.Value(ContextKeyUserRole).(string)
+	return role, ok && role != ""
+}
+
+// RequiredRole returns operation metadata declaring the role needed to call it.
+func RequiredRole(role string) map[string]any {
+	return map[string]any{MetadataRequiredRole: role}
+}
+
+// requiredRoleForOperation determines which role an operation needs.
+// Explicit metadata wins; otherwise environment-scoped reads need viewer and
+// environment-scoped writes need operator. Other operations are not gated here.
+func requiredRoleForOperation(op *huma.Operation) string {
+	if op == nil {
+		return ""
+	}
+	if role, ok := op.Metadata[MetadataRequiredRole].(string); ok && role != "" {
+		return role
+	}
+	if !strings.HasPrefix(op.Path, environmentScopedPathPrefix) {
+		return ""
+	}
+	switch op.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return rbac.RoleViewer
+	default:
+		return rbac.RoleOperator
+	}
+}
+
+// NewRbacBridge creates a Huma middleware that resolves the authenticated
+// user's role for the environment/project in the request path and rejects
+// requests whose operation requires a higher role. It must run after the auth bridge.
+func NewRbacBridge(api huma.API, rbacService *services.RbacService) func(ctx huma.Context, next func(huma.Context)) {
+	return func(ctx huma.Context, next func(huma.Context)) {
+		user, ok := GetCurrentUserFromContext(ctx.Context())
+		if rbacService == nil || !ok || user == nil {
+			next(ctx)
+			return
+		}
+
+		envID := ctx.Param("id")
+		op := ctx.Operation()
+		if op == nil || !strings.HasPrefix(op.Path, "/environments/{id}") {
+			envID = ""
+		}
+		projectID := ctx.Param("projectId")
+
+		required := requiredRoleForOperation(op)
+		if required == "" {
+			required = rbac.RoleViewer
+		}
+
+		role, err := rbacService.Authorize(ctx.Context(), user, envID, projectID, required)
+		if err != nil {
+			if errors.Is(err, services.ErrAccessDenied) {
+				_ = huma.WriteErr(api, ctx, http.StatusForbidden, "Forbidden: "+required+" role required")
+				return
+			}
+			_ = huma.WriteErr(api, ctx, http.StatusInternalServerError, "Failed to resolve permissions")
+			return
+		}
+
+		newCtx := context.WithValue(ctx.Context(), ContextKeyUserRole, role)
+		newCtx = context.WithValue(newCtx, ContextKeyUserIsAdmin, role == rbac.RoleAdmin)
+		next(huma.WithContext(ctx, newCtx))
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/getarcaneapp/arcane/backend/internal/services"
 	"github.com/getarcaneapp/arcane/backend/internal/utils/cookie"
 	pkgutils "github.com/getarcaneapp/arcane/backend/pkg/utils"
+	"github.com/getarcaneapp/arcane/types/apikey"
 	"github.com/gin-gonic/gin"
 )
 
@@ -28,7 +29,7 @@ type AuthOptions struct {
 }
 
 type ApiKeyValidator interface {
-	ValidateApiKey(ctx context.Context, rawKey string) (*models.User, error)
+	AuthenticateApiKey(ctx context.Context, rawKey string) (*models.User, []string, error)
 }
 
 type AuthMiddleware struct {
@@ -110,8 +111,16 @@ func (m *AuthMiddleware) agentAuth(ctx context.Context, c *gin.Context) {
 func (m *AuthMiddleware) managerAuth(ctx context.Context, c *gin.Context) {
 	// First, check for API key in X-API-Key header
 	if apiKey := c.GetHeader(headerApiKey); apiKey != "" && m.apiKeyValidator != nil {
-		user, err := m.apiKeyValidator.ValidateApiKey(ctx, apiKey)
+		user, scopes, err := m.apiKeyValidator.AuthenticateApiKey(ctx, apiKey)
 		if err == nil && user != nil {
+			if required := apikey.RequiredScope(c.Request.Method, c.Request.URL.Path); !apikey.ScopesAllow(scopes, required) {
+				c.JSON(http.StatusForbidden, models.APIError{
+					Code:    "FORBIDDEN",
+					Message: "API key lacks the " + required + " scope",
+				})
+				c.Abort()
+				return
+			}
 			isAdmin := pkgutils.UserHasRole(user.Roles, "admin")
 			if m.options.AdminRequired && !isAdmin {
 				c.JSON(http.StatusForbidden, models.APIError{
@@ -3,6 +3,7 @@ package middleware
 import (
 	"log/slog"
 	"net/url"
+	"slices"
 	"strings"
 
 	"github.com/getarcaneapp/arcane/backend/internal/config"
@@ -10,15 +11,26 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
+// AllowedOriginsProvider returns the extra origins an administrator allows to
+// make cross-origin requests. It is called per request so changes apply
+// without a restart.
+type AllowedOriginsProvider func() []string
+
 type CORSMiddleware struct {
-	cfg           *config.Config
-	customOrigins []string
+	cfg            *config.Config
+	allowedOrigins AllowedOriginsProvider
 }
 
 func NewCORSMiddleware(cfg *config.Config) *CORSMiddleware {
 	return &CORSMiddleware{cfg: cfg}
 }
 
+func (m *CORSMiddleware) WithAllowedOrigins(provider AllowedOriginsProvider) *CORSMiddleware {
+	clone := *m
+	clone.allowedOrigins = provider
+	return &clone
+}
+
 func (m *CORSMiddleware) Add() gin.HandlerFunc {
 	// Edge Agent mode: skip CORS entirely. The agent only receives server-to-server
 	// requests through the edge tunnel from the manager — never from browsers.
@@ -29,7 +41,13 @@ func (m *CORSMiddleware) Add() gin.HandlerFunc {
 	}
 
 	conf := cors.DefaultConfig()
-	conf.AllowOrigins = deriveAllowedOrigins(m.cfg, m.customOrigins)
+	conf.AllowOrigins = deriveAllowedOrigins(m.cfg)
+	if m.allowedOrigins != nil {
+		provider := m.allowedOrigins
+		conf.AllowOriginFunc = func(origin string) bool {
+			return slices.Contains(provider(), origin)
+		}
+	}
 	conf.AllowCredentials = true
 	conf.AllowMethods = []string{"GET", "POST", "PUT", "DELETE", "OPTIONS", "PATCH", "HEAD"}
 	conf.AllowHeaders = []string{
@@ -59,11 +77,9 @@ func (m *CORSMiddleware) Add() gin.HandlerFunc {
 	return cors.New(conf)
 }
 
-func deriveAllowedOrigins(cfg *config.Config, custom []string) []string {
-	if len(custom) > 0 {
-		return dedupe(custom)
-	}
-
+// deriveAllowedOrigins returns the origins that are always allowed: the
+// application URL, plus the local dev servers in development.
+func deriveAllowedOrigins(cfg *config.Config) []string {
 	var origins []string
 	if cfg != nil {
 		appURL := cfg.GetAppURL()
@@ -79,7 +95,7 @@ func deriveAllowedOrigins(cfg *config.Config, custom []string) []string {
 		}
 	}
 
-	if cfg == nil || cfg.Environment != "production" {
+	if cfg == nil || cfg.Environment == config.AppEnvironmentDevelopment {
 		origins = append(origins,
 			"http://localhost:3000", "http://127.0.0.1:3000",
 			"http://localhost:3552", "http://127.0.0.1:3552",
@@ -89,12 +105,9 @@ func deriveAllowedOrigins(cfg *config.Config, custom []string) []string {
 	origins = dedupe(origins)
 
 	if len(origins) == 0 {
-		if cfg != nil && cfg.Environment == "production" {
-			slog.Warn("CORS: No origins specified for production - defaulting to https://localhost")
-			return []string{"https://localhost"}
-		}
-		// Fallback in dev (avoid "*" with credentials=true)
-		return []string{"http://localhost:3000"}
+		// Never fall back to "*", which cannot be combined with credentials.
+		slog.Warn("CORS: No origins specified - defaulting to https://localhost")
+		return []string{"https://localhost"}
 	}
 
 	return origins
@@ -0,0 +1,82 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/getarcaneapp/arcane/backend/internal/config"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/cookie"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/stringutils"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	headerCSRFToken = "X-CSRF-Token"
+	csrfTokenLength = 32
+)
+
+// CSRFMiddleware protects browser sessions with a double-submit token. Every
+// client receives a random token in a cookie, and state-changing requests
+// authenticated by the session cookie must echo it in the X-CSRF-Token header.
+// A cross-site page can make the browser send the cookie but cannot read it.
+// Requests authenticated with an API key or bearer token are not affected.
+type CSRFMiddleware struct {
+	cfg *config.Config
+}
+
+func NewCSRFMiddleware(cfg *config.Config) *CSRFMiddleware {
+	return &CSRFMiddleware{cfg: cfg}
+}
+
+func (m *CSRFMiddleware) Add() gin.HandlerFunc {
+	// Agents only receive server-to-server requests from the manager, which
+	// has already checked the token for browser sessions.
+	if m.cfg != nil && m.cfg.AgentMode {
+		return func(c *gin.Context) { c.Next() }
+	}
+
+	return func(c *gin.Context) {
+		token, err := cookie.GetCsrfCookie(c)
+		if err != nil || token == "" {
+			cookie.SetCsrfCookie(c, stringutils.GenerateRandomString(csrfTokenLength))
+			// A token issued with this response cannot have been echoed yet.
+			token = ""
+		}
+
+		if isSafeMethod(c.Request.Method) || !usesSessionCookie(c) {
+			c.Next()
+			return
+		}
+
+		header := c.GetHeader(headerCSRFToken)
+		if token == "" || header == "" || subtle.ConstantTimeCompare([]byte(header), []byte(token)) != 1 {
+			c.JSON(http.StatusForbidden, models.APIError{
+				Code:    models.APIErrorCodeCSRFInvalid,
+				Message: "Missing or invalid CSRF token",
+			})
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+func isSafeMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace:
+		return true
+	}
+	return false
+}
+
+// usesSessionCookie reports whether the request would be authenticated by the
+// session cookie, the only credential a browser attaches on its own.
+func usesSessionCookie(c *gin.Context) bool {
+	if c.GetHeader(headerApiKey) != "" || strings.HasPrefix(c.GetHeader("Authorization"), "Bearer ") {
+		return false
+	}
+	tok, err := cookie.GetTokenCookie(c)
+	return err == nil && tok != ""
+}
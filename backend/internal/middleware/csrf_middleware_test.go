@@ -0,0 +1,117 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/getarcaneapp/arcane/backend/internal/config"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newCSRFTestRouter(cfg *config.Config) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(NewCSRFMiddleware(cfg).Add())
+	router.GET("/api/version", func(c *gin.Context) { c.Status(http.StatusOK) })
+	router.POST("/api/projects", func(c *gin.Context) { c.Status(http.StatusCreated) })
+	return router
+}
+
+func serveCSRF(router *gin.Engine, method string, cookies map[string]string, headers map[string]string) *httptest.ResponseRecorder {
+	path := "/api/projects"
+	if method == http.MethodGet {
+		path = "/api/version"
+	}
+	req := httptest.NewRequest(method, path, nil)
+	for name, value := range cookies {
+		req.AddCookie(&http.Cookie{Name: name, Value: value})
+	}
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+	return recorder
+}
+
+func TestCSRFMiddleware_IssuesTokenCookie(t *testing.T) {
+	router := newCSRFTestRouter(&config.Config{})
+
+	recorder := serveCSRF(router, http.MethodGet, nil, nil)
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	cookies := recorder.Result().Cookies()
+	require.Len(t, cookies, 1)
+	assert.Equal(t, "csrf_token", cookies[0].Name)
+	assert.Len(t, cookies[0].Value, csrfTokenLength)
+	assert.False(t, cookies[0].HttpOnly)
+
+	recorder = serveCSRF(router, http.MethodGet, map[string]string{"csrf_token": cookies[0].Value}, nil)
+	assert.Empty(t, recorder.Result().Cookies(), "an existing token is kept")
+}
+
+func TestCSRFMiddleware_EnforcesTokenForSessionCookie(t *testing.T) {
+	router := newCSRFTestRouter(&config.Config{})
+	session := map[string]string{"token": "jwt", "csrf_token": "expected-token"}
+
+	recorder := serveCSRF(router, http.MethodPost, session, nil)
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "CSRF_INVALID")
+
+	recorder = serveCSRF(router, http.MethodPost, session, map[string]string{"X-CSRF-Token": "other-token"})
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+
+	recorder = serveCSRF(router, http.MethodPost, session, map[string]string{"X-CSRF-Token": "expected-token"})
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+
+	// Without the CSRF cookie a new token is issued, but the request is still rejected.
+	recorder = serveCSRF(router, http.MethodPost, map[string]string{"token": "jwt"}, map[string]string{"X-CSRF-Token": "guess"})
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+	assert.NotEmpty(t, recorder.Result().Cookies())
+}
+
+func TestCSRFMiddleware_SkipsOtherCredentials(t *testing.T) {
+	router := newCSRFTestRouter(&config.Config{})
+
+	assert.Equal(t, http.StatusCreated, serveCSRF(router, http.MethodPost, nil, nil).Code, "no session cookie")
+	assert.Equal(t, http.StatusCreated, serveCSRF(router, http.MethodPost, map[string]string{"token": "jwt"}, map[string]string{"X-API-Key": "arc_key"}).Code)
+	assert.Equal(t, http.StatusCreated, serveCSRF(router, http.MethodPost, map[string]string{"token": "jwt"}, map[string]string{"Authorization": "Bearer jwt"}).Code)
+
+	agentRouter := newCSRFTestRouter(&config.Config{AgentMode: true})
+	recorder := serveCSRF(agentRouter, http.MethodPost, map[string]string{"token": "jwt"}, nil)
+	assert.Equal(t, http.StatusCreated, recorder.Code)
+	assert.Empty(t, recorder.Result().Cookies())
+}
+
+func TestCORSMiddleware_AllowsConfiguredOrigins(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	allowed := []string{"https://proxy.example.com"}
+	router := gin.New()
+	router.Use(NewCORSMiddleware(&config.Config{AppUrl: "https://arcane.example.com", Environment: config.AppEnvironmentProduction}).
+		WithAllowedOrigins(func() []string { return allowed }).
+		Add())
+	router.GET("/api/version", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	serve := func(origin string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/version", nil)
+		req.Host = "arcane:3552"
+		req.Header.Set("Origin", origin)
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, req)
+		return recorder
+	}
+
+	assert.Equal(t, http.StatusOK, serve("https://arcane.example.com").Code)
+	recorder := serve("https://proxy.example.com")
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "https://proxy.example.com", recorder.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, http.StatusForbidden, serve("http://localhost:3000").Code, "dev origins are not allowed in production")
+	assert.Equal(t, http.StatusForbidden, serve("https://evil.example.com").Code)
+
+	allowed = nil
+	assert.Equal(t, http.StatusForbidden, serve("https://proxy.example.com").Code, "removed origins stop working without a restart")
+}
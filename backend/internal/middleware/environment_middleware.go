@@ -8,9 +8,11 @@ import (
 	"log/slog"
 	"net/http"
 	"path"
+	"slices"
 	"strings"
 	"time"
 
+	"github.com/cenkalti/backoff/v5"
 	"github.com/getarcaneapp/arcane/backend/internal/services"
 	"github.com/getarcaneapp/arcane/backend/internal/utils/remenv"
 	wsutil "github.com/getarcaneapp/arcane/backend/internal/utils/ws"
@@ -28,6 +30,8 @@ const (
 	errFailedCreateProxyRequest = "Failed to create proxy request"
 	errProxyRequestFailedPrefix = "Proxy request failed:"
 	errUnauthorized             = "Authentication required to access remote environments"
+	errForbidden                = "Insufficient permissions for this environment"
+	errSSHUnsupported           = "This operation is not supported for SSH environments"
 
 	// proxyTimeout is intentionally generous because some proxied operations
 	// (e.g., image pulls with progress streaming) can take multiple minutes.
@@ -49,6 +53,19 @@ var managementEndpointSet = map[string]struct{}{
 	"/settings":        {},
 	"/job-schedules":   {},
 	"/jobs":            {},
+	"/host-metrics":    {},
+	"/tags":            {},
+}
+
+// sshResourcePrefixes lists the resource paths served locally for SSH
+// environments by tunnelling the Docker client; everything else needs an agent.
+var sshResourcePrefixes = []string{
+	"/containers",
+	"/images",
+	"/volumes",
+	"/networks",
+	"/system/docker/info",
+	"/ws/containers",
 }
 
 // EnvResolver resolves an environment ID to its connection details.
@@ -59,15 +76,58 @@ type EnvResolver func(ctx context.Context, id string) (string, *string, bool, er
 // Returns true if the request is authenticated, false otherwise.
 type AuthValidator func(ctx context.Context, c *gin.Context) bool
 
+// AccessValidator authorizes an authenticated request against the target environment.
+// Returns true if the caller may perform the request, false otherwise.
+type AccessValidator func(ctx context.Context, c *gin.Context, envID string) bool
+
+// MaintenanceGuard reports whether a proxied request must be rejected because
+// the manager is in read-only maintenance mode, and the message to return.
+type MaintenanceGuard func(c *gin.Context) (bool, string)
+
+// CapabilityGuard reports whether the agent of a remote environment lacks the
+// feature serving resourcePath, and the message to return instead of
+// proxying the request.
+type CapabilityGuard func(ctx context.Context, envID, resourcePath string) (bool, string)
+
+// EnvProxyOption configures optional behavior of the environment proxy middleware.
+type EnvProxyOption func(*EnvironmentMiddleware)
+
+// WithAccessValidator enforces per-environment authorization before proxying.
+func WithAccessValidator(validator AccessValidator) EnvProxyOption {
+	return func(m *EnvironmentMiddleware) {
+		m.accessValidator = validator
+	}
+}
+
+// WithMaintenanceGuard rejects proxied requests while maintenance mode is active.
+func WithMaintenanceGuard(guard MaintenanceGuard) EnvProxyOption {
+	return func(m *EnvironmentMiddleware) {
+		m.maintenanceGuard = guard
+	}
+}
+
+// WithCapabilityGuard rejects proxied requests the target agent does not support.
+func WithCapabilityGuard(guard CapabilityGuard) EnvProxyOption {
+	return func(m *EnvironmentMiddleware) {
+		m.capabilityGuard = guard
+	}
+}
+
 // EnvironmentMiddleware proxies requests for remote environments to their respective agents.
 type EnvironmentMiddleware struct {
 	localID       string
 	paramName     string
 	resolver      EnvResolver
 	authValidator AuthValidator
-	envService    *services.EnvironmentService
-	httpClient    *http.Client
-	registry      *edge.TunnelRegistry
+	// accessValidator is optional; when set it runs after authValidator succeeds.
+	accessValidator AccessValidator
+	// maintenanceGuard is optional; when set it runs after accessValidator succeeds.
+	maintenanceGuard MaintenanceGuard
+	// capabilityGuard is optional; when set it runs after maintenanceGuard.
+	capabilityGuard CapabilityGuard
+	envService      *services.EnvironmentService
+	httpClient      *http.Client
+	registry        *edge.TunnelRegistry
 }
 
 // NewEnvProxyMiddlewareWithParam creates middleware that proxies requests to remote environments.
@@ -76,8 +136,8 @@ type EnvironmentMiddleware struct {
 // - resolver: function to resolve environment ID to connection details
 // - envService: environment service for additional lookups
 // - authValidator: function to validate authentication before proxying (required for security)
-func NewEnvProxyMiddlewareWithParam(localID, paramName string, resolver EnvResolver, envService *services.EnvironmentService, authValidator AuthValidator) gin.HandlerFunc {
-	return NewEnvProxyMiddlewareWithParamAndRegistry(localID, paramName, resolver, envService, authValidator, edge.GetRegistry())
+func NewEnvProxyMiddlewareWithParam(localID, paramName string, resolver EnvResolver, envService *services.EnvironmentService, authValidator AuthValidator, opts ...EnvProxyOption) gin.HandlerFunc {
+	return NewEnvProxyMiddlewareWithParamAndRegistry(localID, paramName, resolver, envService, authValidator, edge.GetRegistry(), opts...)
 }
 
 // NewEnvProxyMiddlewareWithParamAndRegistry creates middleware with an injected tunnel registry.
@@ -88,6 +148,7 @@ func NewEnvProxyMiddlewareWithParamAndRegistry(
 	envService *services.EnvironmentService,
 	authValidator AuthValidator,
 	registry *edge.TunnelRegistry,
+	opts ...EnvProxyOption,
 ) gin.HandlerFunc {
 	if registry == nil {
 		registry = edge.NewTunnelRegistry()
@@ -102,6 +163,9 @@ func NewEnvProxyMiddlewareWithParamAndRegistry(
 		httpClient:    &http.Client{Timeout: proxyTimeout},
 		registry:      registry,
 	}
+	for _, opt := range opts {
+		opt(m)
+	}
 	return m.Handle
 }
 
@@ -136,6 +200,38 @@ func (m *EnvironmentMiddleware) Handle(c *gin.Context) {
 		return
 	}
 
+	if m.accessValidator != nil && !m.accessValidator(c.Request.Context(), c, envID) {
+		c.JSON(http.StatusForbidden, gin.H{
+			"success": false,
+			"data":    gin.H{"error": errForbidden},
+		})
+		c.Abort()
+		return
+	}
+
+	if m.maintenanceGuard != nil {
+		if blocked, message := m.maintenanceGuard(c); blocked {
+			c.JSON(http.StatusServiceUnavailable, gin.H{
+				"success": false,
+				"data":    gin.H{"error": message},
+			})
+			c.Abort()
+			return
+		}
+	}
+
+	if m.capabilityGuard != nil {
+		resourcePath := m.buildResourceSuffix(c.Request.URL.Path, envID)
+		if unsupported, message := m.capabilityGuard(c.Request.Context(), envID, resourcePath); unsupported {
+			c.JSON(http.StatusNotImplemented, gin.H{
+				"success": false,
+				"data":    gin.H{"error": message},
+			})
+			c.Abort()
+			return
+		}
+	}
+
 	// Resolve remote environment
 	apiURL, accessToken, enabled, err := m.resolver(c.Request.Context(), envID)
 	if err != nil || apiURL == "" {
@@ -156,6 +252,11 @@ func (m *EnvironmentMiddleware) Handle(c *gin.Context) {
 		return
 	}
 
+	if services.IsSSHEnvironmentURL(apiURL) {
+		m.handleSSHEnvironmentInternal(c, envID)
+		return
+	}
+
 	isEdgeEnvironment := isEdgeEnvironmentURLInternal(apiURL)
 
 	// Check if this environment has an active edge tunnel
@@ -213,7 +314,7 @@ func (m *EnvironmentMiddleware) Handle(c *gin.Context) {
 	if m.isWebSocketUpgrade(c) {
 		m.proxyWebSocket(c, target, accessToken, envID)
 	} else {
-		m.proxyHTTP(c, target, accessToken)
+		m.proxyHTTP(c, envID, target, accessToken)
 	}
 }
 
@@ -289,6 +390,26 @@ func (m *EnvironmentMiddleware) isWebSocketUpgrade(c *gin.Context) bool {
 		c.GetHeader("Sec-Websocket-Key") != ""
 }
 
+// handleSSHEnvironmentInternal serves Docker resource requests for an SSH
+// environment with the local handlers, pointed at the tunnelled Docker client.
+func (m *EnvironmentMiddleware) handleSSHEnvironmentInternal(c *gin.Context, envID string) {
+	suffix := m.buildResourceSuffix(c.Request.URL.Path, envID)
+	supported := slices.ContainsFunc(sshResourcePrefixes, func(prefix string) bool {
+		return suffix == prefix || strings.HasPrefix(suffix, prefix+"/")
+	})
+	if !supported {
+		c.JSON(http.StatusNotImplemented, gin.H{
+			"success": false,
+			"data":    gin.H{"error": errSSHUnsupported},
+		})
+		c.Abort()
+		return
+	}
+
+	c.Request = c.Request.WithContext(services.WithDockerEnvironment(c.Request.Context(), envID))
+	c.Next()
+}
+
 func isEdgeEnvironmentURLInternal(apiURL string) bool {
 	normalized := strings.ToLower(strings.TrimSpace(apiURL))
 	return strings.HasPrefix(normalized, "edge://")
@@ -365,13 +486,24 @@ func (m *EnvironmentMiddleware) proxyWebSocket(c *gin.Context, target string, ac
 }
 
 // proxyHTTP handles standard HTTP proxy requests.
-func (m *EnvironmentMiddleware) proxyHTTP(c *gin.Context, target string, accessToken *string) {
+func (m *EnvironmentMiddleware) proxyHTTP(c *gin.Context, envID, target string, accessToken *string) {
 	if isEdgeEnvironmentURLInternal(target) {
 		slog.WarnContext(c.Request.Context(), "Refusing direct HTTP proxy to edge environment without active tunnel", "target", target)
 		m.abortEdgeTunnelUnavailable(c)
 		return
 	}
 
+	if m.envService != nil {
+		if err := m.envService.AllowRequest(envID); err != nil {
+			c.JSON(http.StatusServiceUnavailable, gin.H{
+				"success": false,
+				"data":    gin.H{"error": err.Error()},
+			})
+			c.Abort()
+			return
+		}
+	}
+
 	req, err := m.createProxyRequest(c, target, accessToken)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
@@ -382,7 +514,17 @@ func (m *EnvironmentMiddleware) proxyHTTP(c *gin.Context, target string, accessT
 		return
 	}
 
-	resp, err := m.httpClient.Do(req) //nolint:gosec // intentional proxy request to resolved remote environment URL
+	// Only bodiless read requests are retried, so cloning the request is safe.
+	resp, err := backoff.Retry(c.Request.Context(), func() (*http.Response, error) {
+		return m.httpClient.Do(req.Clone(req.Context())) //nolint:gosec // intentional proxy request to resolved remote environment URL
+	}, backoff.WithBackOff(backoff.NewExponentialBackOff()), backoff.WithMaxTries(m.proxyTriesInternal(c.Request.Method)))
+	if m.envService != nil {
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		}
+		m.envService.RecordRequestResult(envID, statusCode, err)
+	}
 	if err != nil {
 		c.JSON(http.StatusBadGateway, gin.H{
 			"success": false,
@@ -397,6 +539,15 @@ func (m *EnvironmentMiddleware) proxyHTTP(c *gin.Context, target string, accessT
 	c.Abort()
 }
 
+// proxyTriesInternal returns how many attempts a proxied request gets. Only
+// read requests are retried when the agent cannot be reached.
+func (m *EnvironmentMiddleware) proxyTriesInternal(method string) uint {
+	if m.envService == nil || (method != http.MethodGet && method != http.MethodHead) {
+		return 1
+	}
+	return 1 + uint(m.envService.ProxyRetries()) //nolint:gosec // ProxyRetries is never negative
+}
+
 // createProxyRequest builds the HTTP request to forward to the remote environment.
 func (m *EnvironmentMiddleware) createProxyRequest(c *gin.Context, target string, accessToken *string) (*http.Request, error) {
 	var bodyBytes []byte
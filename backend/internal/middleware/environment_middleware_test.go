@@ -99,8 +99,98 @@ func TestEnvironmentMiddleware_ProxyHTTPRejectsEdgeTargetsWithoutTunnel(t *testi
 	c, _ := gin.CreateTestContext(recorder)
 	c.Request = httptest.NewRequest(http.MethodGet, "/api/environments/env-edge/containers", nil)
 
-	middleware.proxyHTTP(c, "edge://oracle-1/api/environments/0/containers", nil)
+	middleware.proxyHTTP(c, "env-edge", "edge://oracle-1/api/environments/0/containers", nil)
 
 	assert.Equal(t, http.StatusBadGateway, recorder.Code)
 	assert.Contains(t, recorder.Body.String(), "Edge agent is not connected")
 }
+
+func TestEnvironmentMiddleware_MaintenanceGuardBlocksProxiedRequests(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	middleware := newTestEnvironmentMiddleware()
+	middleware.maintenanceGuard = func(c *gin.Context) (bool, string) {
+		return c.Request.Method != http.MethodGet, "Arcane is in read-only maintenance mode"
+	}
+	router := gin.New()
+	api := router.Group("/api")
+	api.Use(middleware.Handle)
+
+	localHandlerHit := false
+	api.POST("/environments/:id/containers/:containerId/restart", func(c *gin.Context) {
+		localHandlerHit = true
+		c.JSON(http.StatusOK, gin.H{"success": true})
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/api/environments/env-edge/containers/abc/restart", nil)
+	recorder := httptest.NewRecorder()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "read-only maintenance mode")
+	assert.False(t, localHandlerHit)
+}
+
+func TestEnvironmentMiddleware_CapabilityGuardBlocksUnsupportedFeatures(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	middleware := newTestEnvironmentMiddleware()
+	var guardedPath string
+	middleware.capabilityGuard = func(ctx context.Context, envID, resourcePath string) (bool, string) {
+		_ = ctx
+		guardedPath = resourcePath
+		return true, "Agent version 1.0.0 does not support snapshots"
+	}
+	router := gin.New()
+	api := router.Group("/api")
+	api.Use(middleware.Handle)
+
+	localHandlerHit := false
+	api.GET("/environments/:id/snapshots/:snapshotId", func(c *gin.Context) {
+		localHandlerHit = true
+		c.JSON(http.StatusOK, gin.H{"success": true})
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/environments/env-edge/snapshots/abc", nil)
+	recorder := httptest.NewRecorder()
+
+	router.ServeHTTP(recorder, req)
+
+	assert.Equal(t, http.StatusNotImplemented, recorder.Code)
+	assert.Equal(t, "/snapshots/abc", guardedPath)
+	assert.Contains(t, recorder.Body.String(), "does not support snapshots")
+	assert.False(t, localHandlerHit)
+}
+
+func TestEnvironmentMiddleware_ServesSSHEnvironmentDockerResourcesLocally(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	middleware := newTestEnvironmentMiddleware()
+	middleware.resolver = func(ctx context.Context, id string) (string, *string, bool, error) {
+		_ = ctx
+		return "ssh://deploy@docker-host", nil, true, nil
+	}
+	router := gin.New()
+	api := router.Group("/api")
+	api.Use(middleware.Handle)
+
+	localHandlerHit := false
+	api.GET("/environments/:id/containers", func(c *gin.Context) {
+		localHandlerHit = true
+		c.JSON(http.StatusOK, gin.H{"success": true})
+	})
+	api.GET("/environments/:id/projects", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"success": true})
+	})
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/environments/env-ssh/containers", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.True(t, localHandlerHit)
+
+	recorder = httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/environments/env-ssh/projects", nil))
+	assert.Equal(t, http.StatusNotImplemented, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), errSSHUnsupported)
+}
@@ -5,14 +5,15 @@ import (
 )
 
 type ApiKey struct {
-	Name          string     `json:"name" gorm:"column:name;not null" sortable:"true"`
-	Description   *string    `json:"description,omitempty" gorm:"column:description"`
-	KeyHash       string     `json:"-" gorm:"column:key_hash;not null"`
-	KeyPrefix     string     `json:"keyPrefix" gorm:"column:key_prefix;not null"`
-	UserID        string     `json:"userId" gorm:"column:user_id;not null"`
-	EnvironmentID *string    `json:"environmentId,omitempty" gorm:"column:environment_id"`
-	ExpiresAt     *time.Time `json:"expiresAt,omitempty" gorm:"column:expires_at" sortable:"true"`
-	LastUsedAt    *time.Time `json:"lastUsedAt,omitempty" gorm:"column:last_used_at" sortable:"true"`
+	Name          string      `json:"name" gorm:"column:name;not null" sortable:"true"`
+	Description   *string     `json:"description,omitempty" gorm:"column:description"`
+	KeyHash       string      `json:"-" gorm:"column:key_hash;not null"`
+	KeyPrefix     string      `json:"keyPrefix" gorm:"column:key_prefix;not null"`
+	UserID        string      `json:"userId" gorm:"column:user_id;not null"`
+	EnvironmentID *string     `json:"environmentId,omitempty" gorm:"column:environment_id"`
+	ExpiresAt     *time.Time  `json:"expiresAt,omitempty" gorm:"column:expires_at" sortable:"true"`
+	LastUsedAt    *time.Time  `json:"lastUsedAt,omitempty" gorm:"column:last_used_at" sortable:"true"`
+	Scopes        StringSlice `json:"scopes,omitempty" gorm:"column:scopes;type:text"`
 	BaseModel
 }
 
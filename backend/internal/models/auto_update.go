@@ -13,9 +13,52 @@ const (
 	AutoUpdateStatusCompleted AutoUpdateStatus = "completed"
 	AutoUpdateStatusFailed    AutoUpdateStatus = "failed"
 	AutoUpdateStatusSkipped   AutoUpdateStatus = "skipped"
+	// AutoUpdateStatusMonitorOnly marks an update that was found but not
+	// applied because the resource is in monitor-only mode.
+	AutoUpdateStatusMonitorOnly AutoUpdateStatus = "monitor_only"
+	// AutoUpdateStatusRolledBack marks the record of a manual rollback of an
+	// update. It is added to the run of the update it rolled back.
+	AutoUpdateStatusRolledBack AutoUpdateStatus = "rolled_back"
 )
 
+// AutoUpdateTrigger records what started an updater run.
+type AutoUpdateTrigger string
+
+const (
+	AutoUpdateTriggerSchedule     AutoUpdateTrigger = "schedule"
+	AutoUpdateTriggerManual       AutoUpdateTrigger = "manual"
+	AutoUpdateTriggerWebhook      AutoUpdateTrigger = "webhook"
+	AutoUpdateTriggerNotification AutoUpdateTrigger = "notification"
+)
+
+// AutoUpdateRun groups the records written by one updater run.
+type AutoUpdateRun struct {
+	Trigger      AutoUpdateTrigger `json:"trigger" gorm:"column:triggered_by"`
+	Status       AutoUpdateStatus  `json:"status"`
+	DryRun       bool              `json:"dryRun" gorm:"column:dry_run"`
+	RetryOfRunID *string           `json:"retryOfRunId,omitempty" gorm:"column:retry_of_run_id"`
+	StartTime    time.Time         `json:"startTime" sortable:"true"`
+	EndTime      *time.Time        `json:"endTime,omitempty"`
+	Checked      int               `json:"checked"`
+	Updated      int               `json:"updated" sortable:"true"`
+	Skipped      int               `json:"skipped"`
+	Failed       int               `json:"failed" sortable:"true"`
+	Blocked      int               `json:"blocked"`
+	Error        *string           `json:"error,omitempty"`
+	// RetainedImages maps the IDs of the previous images the run kept for
+	// rollback to the references they were used by. It is cleared once
+	// RetainedUntil has passed and the images were released for pruning.
+	RetainedImages JSON       `json:"retainedImages,omitempty" gorm:"type:text"`
+	RetainedUntil  *time.Time `json:"retainedUntil,omitempty"`
+	BaseModel
+}
+
+func (AutoUpdateRun) TableName() string {
+	return "auto_update_runs"
+}
+
 type AutoUpdateRecord struct {
+	RunID            *string          `json:"runId,omitempty" gorm:"column:run_id"`
 	ResourceID       string           `json:"resourceId"`
 	ResourceType     string           `json:"resourceType"`
 	ResourceName     string           `json:"resourceName"`
@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// CACertificate is a PEM encoded certificate trusted for outbound TLS in
+// addition to the system roots.
+type CACertificate struct {
+	Name        string    `json:"name" gorm:"uniqueIndex" sortable:"true"`
+	Certificate string    `json:"certificate"`
+	Subject     string    `json:"subject" sortable:"true"`
+	Fingerprint string    `json:"fingerprint" gorm:"uniqueIndex"`
+	NotAfter    time.Time `json:"notAfter" sortable:"true"`
+	BaseModel
+}
+
+func (CACertificate) TableName() string {
+	return "ca_certificates"
+}
@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+// CommandWebhook is an inbound endpoint that lets external systems, such as
+// chat slash commands, run an allowlisted set of actions. Actions run as the
+// user who created the webhook.
+type CommandWebhook struct {
+	Name        string      `json:"name" gorm:"column:name;not null" sortable:"true"`
+	TokenHash   string      `json:"-" gorm:"column:token_hash;not null"`
+	TokenPrefix string      `json:"tokenPrefix" gorm:"column:token_prefix;not null"`
+	Actions     StringSlice `json:"actions" gorm:"column:actions;type:text;not null"`
+	UserID      string      `json:"userId" gorm:"column:user_id;not null"`
+	Enabled     bool        `json:"enabled" gorm:"column:enabled;not null"`
+	LastUsedAt  *time.Time  `json:"lastUsedAt,omitempty" gorm:"column:last_used_at"`
+	BaseModel
+}
+
+func (CommandWebhook) TableName() string {
+	return "command_webhooks"
+}
@@ -13,6 +13,12 @@ type ContainerRegistry struct {
 	Enabled     bool      `json:"enabled" sortable:"true"`
 	CreatedAt   time.Time `json:"createdAt" sortable:"true"`
 	UpdatedAt   time.Time `json:"updatedAt" sortable:"true"`
+
+	// Signature verification policy applied before updates from this registry.
+	SignatureMode     string  `json:"signatureMode" gorm:"column:signature_mode"`
+	SignatureTrust    *string `json:"signatureTrust,omitempty" gorm:"column:signature_trust"`
+	SignatureIdentity *string `json:"signatureIdentity,omitempty" gorm:"column:signature_identity"`
+	SignatureIssuer   *string `json:"signatureIssuer,omitempty" gorm:"column:signature_issuer"`
 	BaseModel
 }
 
@@ -20,6 +26,18 @@ func (ContainerRegistry) TableName() string {
 	return "container_registries"
 }
 
+// ProjectRegistry scopes a registry's credentials to a project. A registry
+// with any ProjectRegistry rows is only used for those projects' pulls.
+type ProjectRegistry struct {
+	ProjectID  string    `json:"projectId" gorm:"column:project_id;primaryKey"`
+	RegistryID string    `json:"registryId" gorm:"column:registry_id;primaryKey"`
+	CreatedAt  time.Time `json:"createdAt" gorm:"column:created_at"`
+}
+
+func (ProjectRegistry) TableName() string {
+	return "project_registries"
+}
+
 type CreateContainerRegistryRequest struct {
 	URL         string  `json:"url" binding:"required"`
 	Username    string  `json:"username" binding:"required"`
@@ -27,6 +45,11 @@ type CreateContainerRegistryRequest struct {
 	Description *string `json:"description"`
 	Insecure    *bool   `json:"insecure"`
 	Enabled     *bool   `json:"enabled"`
+
+	SignatureMode     *string `json:"signatureMode,omitempty"`
+	SignatureTrust    *string `json:"signatureTrust,omitempty"`
+	SignatureIdentity *string `json:"signatureIdentity,omitempty"`
+	SignatureIssuer   *string `json:"signatureIssuer,omitempty"`
 }
 
 type UpdateContainerRegistryRequest struct {
@@ -36,4 +59,9 @@ type UpdateContainerRegistryRequest struct {
 	Description *string `json:"description"`
 	Insecure    *bool   `json:"insecure"`
 	Enabled     *bool   `json:"enabled"`
+
+	SignatureMode     *string `json:"signatureMode,omitempty"`
+	SignatureTrust    *string `json:"signatureTrust,omitempty"`
+	SignatureIdentity *string `json:"signatureIdentity,omitempty"`
+	SignatureIssuer   *string `json:"signatureIssuer,omitempty"`
 }
@@ -0,0 +1,42 @@
+package models
+
+import "time"
+
+// ContainerTask is a user-defined command run inside a container with docker
+// exec on a cron schedule.
+type ContainerTask struct {
+	Name            string     `json:"name" gorm:"column:name;not null" sortable:"true"`
+	ContainerName   string     `json:"containerName" gorm:"column:container_name;not null"`
+	Command         string     `json:"command" gorm:"column:command;not null"`
+	User            *string    `json:"user,omitempty" gorm:"column:user"`
+	Schedule        string     `json:"schedule" gorm:"column:schedule;not null"`
+	TimeoutSeconds  int        `json:"timeoutSeconds" gorm:"column:timeout_seconds;not null;default:300"`
+	NotifyOnFailure bool       `json:"notifyOnFailure" gorm:"column:notify_on_failure;not null"`
+	Enabled         bool       `json:"enabled" gorm:"column:enabled;not null"`
+	LastRunAt       *time.Time `json:"lastRunAt,omitempty" gorm:"column:last_run_at"`
+	LastStatus      *string    `json:"lastStatus,omitempty" gorm:"column:last_status"`
+	NextRunAt       *time.Time `json:"nextRunAt,omitempty" gorm:"column:next_run_at"`
+	BaseModel
+}
+
+func (ContainerTask) TableName() string {
+	return "container_tasks"
+}
+
+// ContainerTaskRun records one execution of a container task and its output.
+type ContainerTaskRun struct {
+	TaskID     string     `json:"taskId" gorm:"column:task_id;not null"`
+	Trigger    string     `json:"trigger" gorm:"column:trigger;not null"`
+	Status     string     `json:"status" gorm:"column:status;not null"`
+	ExitCode   *int       `json:"exitCode,omitempty" gorm:"column:exit_code"`
+	Output     string     `json:"output" gorm:"column:output;not null;default:''"`
+	Error      *string    `json:"error,omitempty" gorm:"column:error"`
+	StartedAt  time.Time  `json:"startedAt" gorm:"column:started_at;not null"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty" gorm:"column:finished_at"`
+	DurationMs *int64     `json:"durationMs,omitempty" gorm:"column:duration_ms"`
+	BaseModel
+}
+
+func (ContainerTaskRun) TableName() string {
+	return "container_task_runs"
+}
@@ -47,6 +47,7 @@ type DockerComposeService struct {
 	Volumes       []string                  `yaml:"volumes,omitempty" json:"volumes,omitempty"`
 	Environment   []string                  `yaml:"environment,omitempty" json:"environment,omitempty"`
 	Networks      []string                  `yaml:"networks,omitempty" json:"networks,omitempty"`
+	NetworkMode   string                    `yaml:"network_mode,omitempty" json:"network_mode,omitempty"`
 	Restart       string                    `yaml:"restart,omitempty" json:"restart,omitempty"`
 	WorkingDir    string                    `yaml:"working_dir,omitempty" json:"working_dir,omitempty"`
 	User          string                    `yaml:"user,omitempty" json:"user,omitempty"`
@@ -60,14 +61,30 @@ type DockerComposeService struct {
 	Deploy        *DockerComposeDeploy      `yaml:"deploy,omitempty" json:"deploy,omitempty"`
 }
 
+// DockerComposeExternalResource declares a network or volume that already
+// exists and is not managed by the project.
+type DockerComposeExternalResource struct {
+	External bool `yaml:"external" json:"external"`
+}
+
 type DockerComposeConfig struct {
-	Services map[string]DockerComposeService `yaml:"services" json:"services"`
+	Services map[string]DockerComposeService          `yaml:"services" json:"services"`
+	Networks map[string]DockerComposeExternalResource `yaml:"networks,omitempty" json:"networks,omitempty"`
+	Volumes  map[string]DockerComposeExternalResource `yaml:"volumes,omitempty" json:"volumes,omitempty"`
 }
 
 type ConvertDockerRunRequest struct {
 	DockerRunCommand string `json:"dockerRunCommand" binding:"required"`
 }
 
+type ContainerToDockerRunResponse struct {
+	Success          bool   `json:"success"`
+	DockerRunCommand string `json:"dockerRunCommand"`
+	DockerCompose    string `json:"dockerCompose"`
+	EnvVars          string `json:"envVars"`
+	ServiceName      string `json:"serviceName"`
+}
+
 type ConvertDockerRunResponse struct {
 	Success       bool   `json:"success"`
 	DockerCompose string `json:"dockerCompose"`
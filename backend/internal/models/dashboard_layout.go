@@ -0,0 +1,14 @@
+package models
+
+import "github.com/getarcaneapp/arcane/types/dashboard"
+
+// DashboardLayout is a user's saved dashboard widget arrangement.
+type DashboardLayout struct {
+	UserID  string             `json:"userId" gorm:"column:user_id;not null;uniqueIndex:idx_dashboard_layouts_user"`
+	Widgets []dashboard.Widget `json:"widgets" gorm:"column:widgets;type:text;serializer:json"`
+	BaseModel
+}
+
+func (DashboardLayout) TableName() string {
+	return "dashboard_layouts"
+}
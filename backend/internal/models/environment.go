@@ -1,6 +1,10 @@
 package models
 
-import "time"
+import (
+	"time"
+
+	"github.com/getarcaneapp/arcane/types/environment"
+)
 
 type Environment struct {
 	Name        string     `json:"name" sortable:"true"`
@@ -12,6 +16,22 @@ type Environment struct {
 	AccessToken *string    `json:"-" gorm:"column:access_token"`
 	ApiKeyID    *string    `json:"-" gorm:"column:api_key_id"`
 
+	// SSH environments (ApiUrl "ssh://user@host[:port][/socket]") reach the
+	// Docker socket through an SSH tunnel instead of an agent.
+	SSHKey                 *string `json:"-" gorm:"column:ssh_key"` // encrypted
+	SSHHostKey             *string `json:"sshHostKey,omitempty" gorm:"column:ssh_host_key"`
+	SSHHostKeyVerification string  `json:"sshHostKeyVerification" gorm:"column:ssh_host_key_verification;default:accept_new"` // strict, accept_new, skip
+
+	// Capabilities are advertised by the agent on pairing and refreshed by
+	// the health check; nil until the agent was reached.
+	Capabilities *environment.Capabilities `json:"capabilities,omitempty" gorm:"column:capabilities;type:text;serializer:json"`
+
+	// A pairing request an agent sent with the environment API key. It waits
+	// for an admin to approve it before the environment leaves pending.
+	PairingFingerprint *string    `json:"pairingFingerprint,omitempty" gorm:"column:pairing_fingerprint"`
+	PairingOriginIP    *string    `json:"pairingOriginIp,omitempty" gorm:"column:pairing_origin_ip"`
+	PairingRequestedAt *time.Time `json:"pairingRequestedAt,omitempty" gorm:"column:pairing_requested_at"`
+
 	BaseModel
 }
 
@@ -17,6 +17,7 @@ const (
 	APIErrorCodeDockerAPIError      APIErrorCode = "DOCKER_API_ERROR"
 	APIErrorCodeValidationError     APIErrorCode = "VALIDATION_ERROR"
 	APIErrorCodeTimeout             APIErrorCode = "TIMEOUT"
+	APIErrorCodeCSRFInvalid         APIErrorCode = "CSRF_INVALID"
 )
 
 type APIErrorResponse struct {
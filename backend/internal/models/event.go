@@ -26,6 +26,8 @@ const (
 	EventTypeImageScan              EventType = "image.scan"
 	EventTypeImageError             EventType = "image.error"
 	EventTypeImageVulnerabilityScan EventType = "image.vulnerability_scan"
+	EventTypeImageDistribute        EventType = "image.distribute"
+	EventTypeImagePromote           EventType = "image.promote"
 
 	EventTypeProjectDeploy EventType = "project.deploy"
 	EventTypeProjectDelete EventType = "project.delete"
@@ -34,6 +36,13 @@ const (
 	EventTypeProjectCreate EventType = "project.create"
 	EventTypeProjectUpdate EventType = "project.update"
 	EventTypeProjectError  EventType = "project.error"
+	EventTypeProjectLock   EventType = "project.lock"
+	EventTypeProjectUnlock EventType = "project.unlock"
+
+	EventTypeProjectMaintenanceStart EventType = "project.maintenance_start"
+	EventTypeProjectMaintenanceEnd   EventType = "project.maintenance_end"
+	EventTypeProjectArchive          EventType = "project.archive"
+	EventTypeProjectUnarchive        EventType = "project.unarchive"
 
 	EventTypeGitRepositoryCreate EventType = "git.repository.create"
 	EventTypeGitRepositoryUpdate EventType = "git.repository.update"
@@ -41,11 +50,12 @@ const (
 	EventTypeGitRepositoryTest   EventType = "git.repository.test"
 	EventTypeGitRepositoryError  EventType = "git.repository.error"
 
-	EventTypeGitSyncCreate EventType = "git.sync.create"
-	EventTypeGitSyncUpdate EventType = "git.sync.update"
-	EventTypeGitSyncDelete EventType = "git.sync.delete"
-	EventTypeGitSyncRun    EventType = "git.sync.run"
-	EventTypeGitSyncError  EventType = "git.sync.error"
+	EventTypeGitSyncCreate  EventType = "git.sync.create"
+	EventTypeGitSyncUpdate  EventType = "git.sync.update"
+	EventTypeGitSyncDelete  EventType = "git.sync.delete"
+	EventTypeGitSyncRun     EventType = "git.sync.run"
+	EventTypeGitSyncError   EventType = "git.sync.error"
+	EventTypeGitSyncTagBump EventType = "git.sync.tag_bump"
 
 	EventTypeVolumeCreate EventType = "volume.create"
 	EventTypeVolumeDelete EventType = "volume.delete"
@@ -70,6 +80,7 @@ const (
 	EventTypeUserLogout       EventType = "user.logout"
 	EventTypeSystemAutoUpdate EventType = "system.auto_update"
 	EventTypeSystemUpgrade    EventType = "system.upgrade"
+	EventTypeSystemDaemonJSON EventType = "system.daemon_json"
 
 	EventTypeEnvironmentCreate            EventType = "environment.create"
 	EventTypeEnvironmentConnect           EventType = "environment.connect"
@@ -77,6 +88,42 @@ const (
 	EventTypeEnvironmentUpdate            EventType = "environment.update"
 	EventTypeEnvironmentDelete            EventType = "environment.delete"
 	EventTypeEnvironmentApiKeyRegenerated EventType = "environment.api_key.regenerated"
+	EventTypeEnvironmentPairingRequested  EventType = "environment.pairing.requested"
+	EventTypeEnvironmentPairingApproved   EventType = "environment.pairing.approved"
+	EventTypeEnvironmentPairingRejected   EventType = "environment.pairing.rejected"
+
+	EventTypeMonitorDown EventType = "monitor.down"
+	EventTypeMonitorUp   EventType = "monitor.up"
+
+	EventTypeLogAlertTriggered EventType = "log_alert.triggered"
+
+	EventTypeHostThresholdExceeded  EventType = "host.threshold_exceeded"
+	EventTypeHostThresholdRecovered EventType = "host.threshold_recovered"
+
+	EventTypeEnvironmentOffline EventType = "environment.offline"
+	EventTypeEnvironmentOnline  EventType = "environment.online"
+
+	EventTypeNotificationCredentialInvalid EventType = "notification.credential_invalid"
+
+	EventTypeContainerCrashLoop EventType = "container.crash_loop"
+
+	EventTypeContainerUnmanagedChange EventType = "container.unmanaged_change"
+
+	EventTypeContainerTaskFailed EventType = "container_task.failed"
+
+	EventTypeContainerSnapshot        EventType = "container.snapshot"
+	EventTypeContainerSnapshotRestore EventType = "container.snapshot_restore"
+	EventTypeContainerClone           EventType = "container.clone"
+	EventTypeContainerRollback        EventType = "container.rollback"
+
+	EventTypeRolloutCompleted EventType = "rollout.completed"
+	EventTypeRolloutHalted    EventType = "rollout.halted"
+
+	EventTypeCommandWebhookTriggered EventType = "command_webhook.triggered"
+	EventTypeCommandWebhookFailed    EventType = "command_webhook.failed"
+
+	EventTypeNotificationActionCompleted EventType = "notification_action.completed"
+	EventTypeNotificationActionFailed    EventType = "notification_action.failed"
 
 	// Event severities
 	EventSeverityInfo    EventSeverity = "info"
@@ -21,9 +21,23 @@ type GitOpsSync struct {
 	LastSyncStatus *string        `json:"lastSyncStatus,omitempty" search:"status,success,failed,pending,error"`
 	LastSyncError  *string        `json:"lastSyncError,omitempty"`
 	LastSyncCommit *string        `json:"lastSyncCommit,omitempty" search:"commit,hash,sha,revision"`
+	// TagBumpMode is how newer image tags are proposed: "" (off), "commit"
+	// or "pull_request", see the GitOpsTagBump constants.
+	TagBumpMode        string     `json:"tagBumpMode" gorm:"default:''"`
+	LastTagBumpAt      *time.Time `json:"lastTagBumpAt,omitempty"`
+	LastTagBumpStatus  *string    `json:"lastTagBumpStatus,omitempty"`
+	LastTagBumpMessage *string    `json:"lastTagBumpMessage,omitempty"`
+	LastTagBumpURL     *string    `json:"lastTagBumpUrl,omitempty" gorm:"column:last_tag_bump_url"`
 	BaseModel
 }
 
 func (GitOpsSync) TableName() string {
 	return "gitops_syncs"
 }
+
+// Values of GitOpsSync.TagBumpMode.
+const (
+	GitOpsTagBumpOff         = ""
+	GitOpsTagBumpCommit      = "commit"
+	GitOpsTagBumpPullRequest = "pull_request"
+)
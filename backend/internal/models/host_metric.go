@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+// HostMetricSample is a host resource usage sample collected by the manager
+// for one environment.
+type HostMetricSample struct {
+	EnvironmentID string    `json:"environmentId" gorm:"column:environment_id;not null"`
+	CPUPercent    float64   `json:"cpuPercent" gorm:"column:cpu_percent;not null;default:0"`
+	CPUCount      int       `json:"cpuCount" gorm:"column:cpu_count;not null;default:0"`
+	MemoryUsed    uint64    `json:"memoryUsed" gorm:"column:memory_used;not null;default:0"`
+	MemoryTotal   uint64    `json:"memoryTotal" gorm:"column:memory_total;not null;default:0"`
+	DiskUsed      uint64    `json:"diskUsed" gorm:"column:disk_used;not null;default:0"`
+	DiskTotal     uint64    `json:"diskTotal" gorm:"column:disk_total;not null;default:0"`
+	Load1         float64   `json:"load1" gorm:"column:load1;not null;default:0"`
+	Load5         float64   `json:"load5" gorm:"column:load5;not null;default:0"`
+	Load15        float64   `json:"load15" gorm:"column:load15;not null;default:0"`
+	CollectedAt   time.Time `json:"collectedAt" gorm:"column:collected_at;not null"`
+	BaseModel
+}
+
+func (HostMetricSample) TableName() string {
+	return "host_metric_samples"
+}
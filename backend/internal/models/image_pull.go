@@ -0,0 +1,39 @@
+package models
+
+import "time"
+
+type ImagePullStatus string
+
+const (
+	ImagePullStatusSuccess  ImagePullStatus = "success"
+	ImagePullStatusFailed   ImagePullStatus = "failed"
+	ImagePullStatusCanceled ImagePullStatus = "canceled"
+)
+
+// ImagePullTrigger records what started an image pull.
+type ImagePullTrigger string
+
+const (
+	ImagePullTriggerUser    ImagePullTrigger = "user"
+	ImagePullTriggerUpdater ImagePullTrigger = "updater"
+	ImagePullTriggerSystem  ImagePullTrigger = "system"
+)
+
+type ImagePull struct {
+	ImageName        string           `json:"imageName" gorm:"column:image_name;index" sortable:"true"`
+	UserID           *string          `json:"userId,omitempty" gorm:"column:user_id"`
+	Username         *string          `json:"username,omitempty" gorm:"column:username"`
+	Trigger          ImagePullTrigger `json:"trigger" gorm:"column:triggered_by;index"`
+	Status           ImagePullStatus  `json:"status" gorm:"column:status;index" sortable:"true"`
+	Layers           int              `json:"layers" gorm:"column:layers"`
+	LayersDownloaded int              `json:"layersDownloaded" gorm:"column:layers_downloaded"`
+	Bytes            int64            `json:"bytes" gorm:"column:bytes" sortable:"true"`
+	ErrorMessage     *string          `json:"errorMessage,omitempty" gorm:"column:error_message"`
+	CompletedAt      *time.Time       `json:"completedAt,omitempty" gorm:"column:completed_at" sortable:"true"`
+	DurationMs       int64            `json:"durationMs" gorm:"column:duration_ms" sortable:"true"`
+	BaseModel
+}
+
+func (ImagePull) TableName() string {
+	return "image_pulls"
+}
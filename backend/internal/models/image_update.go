@@ -25,6 +25,10 @@ type ImageUpdateRecord struct {
 
 	NotificationSent bool `json:"notificationSent" gorm:"column:notification_sent;default:false"`
 
+	// SnoozedUntil holds back notifications and automatic updates for the
+	// repository and tag until this time.
+	SnoozedUntil *time.Time `json:"snoozedUntil,omitempty" gorm:"column:snoozed_until"`
+
 	BaseModel
 }
 
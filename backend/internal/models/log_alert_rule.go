@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+// LogAlertRule sends an alert when a log line of a container, or of the
+// containers of a compose project, matches a regular expression.
+type LogAlertRule struct {
+	Name    string `json:"name" gorm:"column:name;not null" sortable:"true"`
+	Pattern string `json:"pattern" gorm:"column:pattern;not null"`
+	// ProjectID and ContainerName select the watched containers; exactly one
+	// of them is set.
+	ProjectID     *string `json:"projectId,omitempty" gorm:"column:project_id"`
+	ContainerName *string `json:"containerName,omitempty" gorm:"column:container_name"`
+	// Services limits a project rule to these compose services; empty means all.
+	Services        StringSlice `json:"services" gorm:"column:services;type:text"`
+	CooldownSeconds int         `json:"cooldownSeconds" gorm:"column:cooldown_seconds;not null;default:300"`
+	Enabled         bool        `json:"enabled" gorm:"column:enabled;not null"`
+	LastFiredAt     *time.Time  `json:"lastFiredAt,omitempty" gorm:"column:last_fired_at"`
+	LastExcerpt     *string     `json:"lastExcerpt,omitempty" gorm:"column:last_excerpt"`
+	LastError       *string     `json:"lastError,omitempty" gorm:"column:last_error"`
+	BaseModel
+}
+
+func (LogAlertRule) TableName() string {
+	return "log_alert_rules"
+}
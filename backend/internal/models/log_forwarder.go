@@ -0,0 +1,25 @@
+package models
+
+import "time"
+
+// LogForwarder ships the logs of a project's containers to an external sink.
+// Each project has at most one forwarder.
+type LogForwarder struct {
+	ProjectID string `json:"projectId" gorm:"column:project_id;not null;uniqueIndex:idx_log_forwarders_project"`
+	SinkType  string `json:"sinkType" gorm:"column:sink_type;not null"`
+	Target    string `json:"target" gorm:"column:target;not null"`
+	// Token is the encrypted Loki credential.
+	Token *string `json:"-" gorm:"column:token"`
+	// Services limits forwarding to these compose services; empty means all.
+	Services      StringSlice `json:"services" gorm:"column:services;type:text"`
+	FileMaxSizeMB int         `json:"fileMaxSizeMb" gorm:"column:file_max_size_mb;not null;default:10"`
+	FileMaxFiles  int         `json:"fileMaxFiles" gorm:"column:file_max_files;not null;default:5"`
+	Enabled       bool        `json:"enabled" gorm:"column:enabled;not null"`
+	LastError     *string     `json:"lastError,omitempty" gorm:"column:last_error"`
+	LastShippedAt *time.Time  `json:"lastShippedAt,omitempty" gorm:"column:last_shipped_at"`
+	BaseModel
+}
+
+func (LogForwarder) TableName() string {
+	return "log_forwarders"
+}
@@ -0,0 +1,13 @@
+package models
+
+// MatrixSession is the device and end-to-end encryption state Arcane keeps for
+// a Matrix account, so encrypted rooms keep getting room keys from the same
+// device. ID identifies the account and State is the encrypted JSON state.
+type MatrixSession struct {
+	State string `json:"-"`
+	BaseModel
+}
+
+func (MatrixSession) TableName() string {
+	return "matrix_sessions"
+}
@@ -0,0 +1,31 @@
+package models
+
+import "time"
+
+// Monitor is a user-defined HTTP or TCP uptime check, optionally tied to a
+// container or compose service.
+type Monitor struct {
+	Name                string     `json:"name" gorm:"column:name;not null" sortable:"true"`
+	Type                string     `json:"type" gorm:"column:type;not null"`
+	Target              string     `json:"target" gorm:"column:target;not null"`
+	ExpectedStatus      int        `json:"expectedStatus" gorm:"column:expected_status;not null;default:0"`
+	IntervalSeconds     int        `json:"intervalSeconds" gorm:"column:interval_seconds;not null;default:60"`
+	TimeoutSeconds      int        `json:"timeoutSeconds" gorm:"column:timeout_seconds;not null;default:10"`
+	FailureThreshold    int        `json:"failureThreshold" gorm:"column:failure_threshold;not null;default:1"`
+	EnvironmentID       string     `json:"environmentId" gorm:"column:environment_id;not null;default:'0'"`
+	ContainerID         *string    `json:"containerId,omitempty" gorm:"column:container_id"`
+	ProjectID           *string    `json:"projectId,omitempty" gorm:"column:project_id"`
+	ServiceName         *string    `json:"serviceName,omitempty" gorm:"column:service_name"`
+	Enabled             bool       `json:"enabled" gorm:"column:enabled;not null"`
+	Status              string     `json:"status" gorm:"column:status;not null;default:'unknown'" sortable:"true"`
+	ConsecutiveFailures int        `json:"consecutiveFailures" gorm:"column:consecutive_failures;not null;default:0"`
+	LastCheckedAt       *time.Time `json:"lastCheckedAt,omitempty" gorm:"column:last_checked_at"`
+	LastLatencyMs       *int64     `json:"lastLatencyMs,omitempty" gorm:"column:last_latency_ms"`
+	LastError           *string    `json:"lastError,omitempty" gorm:"column:last_error"`
+	LastStatusChangeAt  *time.Time `json:"lastStatusChangeAt,omitempty" gorm:"column:last_status_change_at"`
+	BaseModel
+}
+
+func (Monitor) TableName() string {
+	return "monitors"
+}
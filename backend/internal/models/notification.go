@@ -1,6 +1,7 @@
 package models
 
 import (
+	"slices"
 	"time"
 )
 
@@ -40,13 +41,52 @@ func IsValidNotificationProvider(provider NotificationProvider) bool {
 type NotificationEventType string
 
 const (
-	NotificationEventImageUpdate        NotificationEventType = "image_update"
-	NotificationEventContainerUpdate    NotificationEventType = "container_update"
-	NotificationEventVulnerabilityFound NotificationEventType = "vulnerability_found"
-	NotificationEventPruneReport        NotificationEventType = "prune_report"
-	NotificationEventAutoHeal           NotificationEventType = "auto_heal"
+	NotificationEventImageUpdate         NotificationEventType = "image_update"
+	NotificationEventContainerUpdate     NotificationEventType = "container_update"
+	NotificationEventVulnerabilityFound  NotificationEventType = "vulnerability_found"
+	NotificationEventPruneReport         NotificationEventType = "prune_report"
+	NotificationEventAutoHeal            NotificationEventType = "auto_heal"
+	NotificationEventMonitorDown         NotificationEventType = "monitor_down"
+	NotificationEventMonitorUp           NotificationEventType = "monitor_up"
+	NotificationEventHostThreshold       NotificationEventType = "host_threshold"
+	NotificationEventEnvironmentOffline  NotificationEventType = "environment_offline"
+	NotificationEventEnvironmentOnline   NotificationEventType = "environment_online"
+	NotificationEventCredentialInvalid   NotificationEventType = "credential_invalid"
+	NotificationEventContainerCrash      NotificationEventType = "container_crash"
+	NotificationEventUpdateBlocked       NotificationEventType = "update_blocked"
+	NotificationEventContainerTaskFailed NotificationEventType = "container_task_failed"
+	NotificationEventRolloutHalted       NotificationEventType = "rollout_halted"
+	NotificationEventUnmanagedChange     NotificationEventType = "unmanaged_change"
+	NotificationEventArcaneUpdate        NotificationEventType = "arcane_update_available"
+	NotificationEventLogAlert            NotificationEventType = "log_alert"
 )
 
+var validNotificationEvents = map[NotificationEventType]struct{}{
+	NotificationEventImageUpdate:         {},
+	NotificationEventContainerUpdate:     {},
+	NotificationEventVulnerabilityFound:  {},
+	NotificationEventPruneReport:         {},
+	NotificationEventAutoHeal:            {},
+	NotificationEventMonitorDown:         {},
+	NotificationEventMonitorUp:           {},
+	NotificationEventHostThreshold:       {},
+	NotificationEventEnvironmentOffline:  {},
+	NotificationEventEnvironmentOnline:   {},
+	NotificationEventCredentialInvalid:   {},
+	NotificationEventContainerCrash:      {},
+	NotificationEventUpdateBlocked:       {},
+	NotificationEventContainerTaskFailed: {},
+	NotificationEventRolloutHalted:       {},
+	NotificationEventUnmanagedChange:     {},
+	NotificationEventArcaneUpdate:        {},
+	NotificationEventLogAlert:            {},
+}
+
+func IsValidNotificationEvent(eventType NotificationEventType) bool {
+	_, ok := validNotificationEvents[eventType]
+	return ok
+}
+
 type EmailTLSMode string
 
 const (
@@ -55,15 +95,44 @@ const (
 	EmailTLSModeSSL      EmailTLSMode = "ssl"
 )
 
+// NotificationTLSConfig holds explicit TLS settings for providers that are
+// often self-hosted behind private certificates.
+type NotificationTLSConfig struct {
+	// CACertificate is a PEM bundle trusted in addition to Arcane's trust store.
+	CACertificate      string `json:"caCertificate,omitempty"`
+	InsecureSkipVerify bool   `json:"insecureSkipVerify,omitempty"`
+	// MinVersion is "1.2" or "1.3"; empty means TLS 1.2.
+	MinVersion string `json:"minVersion,omitempty"`
+}
+
 type NotificationSettings struct {
-	ID        uint                 `json:"id" gorm:"primaryKey"`
-	Provider  NotificationProvider `json:"provider" gorm:"not null;index;type:varchar(50)"`
-	Enabled   bool                 `json:"enabled" gorm:"default:false"`
-	Config    JSON                 `json:"config" gorm:"type:jsonb"`
-	CreatedAt time.Time            `json:"createdAt"`
-	UpdatedAt time.Time            `json:"updatedAt"`
+	ID                  uint                 `json:"id" gorm:"primaryKey"`
+	Provider            NotificationProvider `json:"provider" gorm:"not null;index;type:varchar(50)"`
+	Enabled             bool                 `json:"enabled" gorm:"default:false"`
+	Config              JSON                 `json:"config" gorm:"type:jsonb"`
+	CredentialStatus    *string              `json:"credentialStatus,omitempty"`
+	CredentialError     *string              `json:"credentialError,omitempty"`
+	CredentialCheckedAt *time.Time           `json:"credentialCheckedAt,omitempty"`
+	CreatedAt           time.Time            `json:"createdAt"`
+	UpdatedAt           time.Time            `json:"updatedAt"`
 }
 
+// Credential check results stored in NotificationSettings.CredentialStatus.
+const (
+	CredentialStatusValid       = "valid"
+	CredentialStatusInvalid     = "invalid"
+	CredentialStatusError       = "error"
+	CredentialStatusUnsupported = "unsupported"
+)
+
+// Delivery roles of a provider, stored under "role" in its config. Providers
+// without a role are primary. Fallback providers only receive a notification
+// when a primary provider failed to deliver it.
+const (
+	NotificationRolePrimary  = "primary"
+	NotificationRoleFallback = "fallback"
+)
+
 func (NotificationSettings) TableName() string {
 	return "notification_settings"
 }
@@ -85,10 +154,12 @@ func (NotificationLog) TableName() string {
 }
 
 type DiscordConfig struct {
-	WebhookID string                         `json:"webhookId"`
-	Token     string                         `json:"token"`
-	Username  string                         `json:"username,omitempty"`
-	AvatarURL string                         `json:"avatarUrl,omitempty"`
+	WebhookID string `json:"webhookId"`
+	Token     string `json:"token"`
+	Username  string `json:"username,omitempty"`
+	AvatarURL string `json:"avatarUrl,omitempty"`
+	// PlainText sends markdown messages instead of embeds.
+	PlainText bool                           `json:"plainText,omitempty"`
 	Events    map[NotificationEventType]bool `json:"events,omitempty"`
 }
 
@@ -100,6 +171,7 @@ type EmailConfig struct {
 	FromAddress  string                         `json:"fromAddress"`
 	ToAddresses  []string                       `json:"toAddresses"`
 	TLSMode      EmailTLSMode                   `json:"tlsMode"`
+	TLS          *NotificationTLSConfig         `json:"tls,omitempty"`
 	Events       map[NotificationEventType]bool `json:"events,omitempty"`
 }
 
@@ -111,6 +183,25 @@ type TelegramConfig struct {
 	ParseMode    string                         `json:"parseMode,omitempty"`
 	Title        string                         `json:"title,omitempty"`
 	Events       map[NotificationEventType]bool `json:"events,omitempty"`
+	// Topics maps chat IDs of forum supergroups to the topic (message thread)
+	// messages are posted in.
+	Topics map[string]int64 `json:"topics,omitempty"`
+	// EventSettings override Notification for single event types.
+	EventSettings map[NotificationEventType]TelegramEventSettings `json:"eventSettings,omitempty"`
+}
+
+// TelegramEventSettings customizes Telegram messages of one event type.
+// Silent messages arrive without a notification sound.
+type TelegramEventSettings struct {
+	Silent *bool `json:"silent,omitempty"`
+}
+
+// ForEvent returns the configuration with the settings of event applied.
+func (c TelegramConfig) ForEvent(event NotificationEventType) TelegramConfig {
+	if settings, ok := c.EventSettings[event]; ok && settings.Silent != nil {
+		c.Notification = !*settings.Silent
+	}
+	return c
 }
 
 type SignalConfig struct {
@@ -126,14 +217,16 @@ type SignalConfig struct {
 }
 
 type SlackConfig struct {
-	Token    string                         `json:"token"`
-	BotName  string                         `json:"botName,omitempty"`
-	Icon     string                         `json:"icon,omitempty"`
-	Color    string                         `json:"color,omitempty"`
-	Title    string                         `json:"title,omitempty"`
-	Channel  string                         `json:"channel,omitempty"`
-	ThreadTS string                         `json:"threadTs,omitempty"`
-	Events   map[NotificationEventType]bool `json:"events,omitempty"`
+	Token    string `json:"token"`
+	BotName  string `json:"botName,omitempty"`
+	Icon     string `json:"icon,omitempty"`
+	Color    string `json:"color,omitempty"`
+	Title    string `json:"title,omitempty"`
+	Channel  string `json:"channel,omitempty"`
+	ThreadTS string `json:"threadTs,omitempty"`
+	// PlainText sends mrkdwn text instead of Block Kit blocks.
+	PlainText bool                           `json:"plainText,omitempty"`
+	Events    map[NotificationEventType]bool `json:"events,omitempty"`
 }
 
 type NtfyConfig struct {
@@ -149,7 +242,38 @@ type NtfyConfig struct {
 	Cache                  bool                           `json:"cache"`
 	Firebase               bool                           `json:"firebase"`
 	DisableTLSVerification bool                           `json:"disableTlsVerification"`
+	TLS                    *NotificationTLSConfig         `json:"tls,omitempty"`
 	Events                 map[NotificationEventType]bool `json:"events,omitempty"`
+	// EventSettings override Priority and add Tags for single event types.
+	EventSettings map[NotificationEventType]NtfyEventSettings `json:"eventSettings,omitempty"`
+}
+
+// NtfyEventSettings customizes ntfy messages of one event type. Tags are
+// added to the provider's tags; on supported clients they show as emoji.
+type NtfyEventSettings struct {
+	Priority string   `json:"priority,omitempty"`
+	Tags     []string `json:"tags,omitempty"`
+}
+
+// ForEvent returns the configuration with the settings of event applied.
+func (c NtfyConfig) ForEvent(event NotificationEventType) NtfyConfig {
+	settings, ok := c.EventSettings[event]
+	if !ok {
+		return c
+	}
+	if settings.Priority != "" {
+		c.Priority = settings.Priority
+	}
+	if len(settings.Tags) > 0 {
+		tags := slices.Clone(c.Tags)
+		for _, tag := range settings.Tags {
+			if !slices.Contains(tags, tag) {
+				tags = append(tags, tag)
+			}
+		}
+		c.Tags = tags
+	}
+	return c
 }
 
 type PushoverConfig struct {
@@ -157,8 +281,32 @@ type PushoverConfig struct {
 	User     string                         `json:"user"`
 	Devices  []string                       `json:"devices,omitempty"`
 	Priority int8                           `json:"priority"`
+	Sound    string                         `json:"sound,omitempty"`
 	Title    string                         `json:"title,omitempty"`
 	Events   map[NotificationEventType]bool `json:"events,omitempty"`
+	// EventSettings override Priority and Sound for single event types.
+	EventSettings map[NotificationEventType]PushoverEventSettings `json:"eventSettings,omitempty"`
+}
+
+// PushoverEventSettings customizes Pushover messages of one event type.
+type PushoverEventSettings struct {
+	Priority *int8  `json:"priority,omitempty"`
+	Sound    string `json:"sound,omitempty"`
+}
+
+// ForEvent returns the configuration with the settings of event applied.
+func (c PushoverConfig) ForEvent(event NotificationEventType) PushoverConfig {
+	settings, ok := c.EventSettings[event]
+	if !ok {
+		return c
+	}
+	if settings.Priority != nil {
+		c.Priority = *settings.Priority
+	}
+	if settings.Sound != "" {
+		c.Sound = settings.Sound
+	}
+	return c
 }
 
 type GotifyConfig struct {
@@ -169,16 +317,39 @@ type GotifyConfig struct {
 	Priority   int                            `json:"priority,omitempty"`
 	Title      string                         `json:"title,omitempty"`
 	DisableTLS bool                           `json:"disableTls"`
+	TLS        *NotificationTLSConfig         `json:"tls,omitempty"`
 	Events     map[NotificationEventType]bool `json:"events,omitempty"`
+	// EventSettings override Priority for single event types.
+	EventSettings map[NotificationEventType]GotifyEventSettings `json:"eventSettings,omitempty"`
 }
 
+// GotifyEventSettings customizes Gotify messages of one event type.
+type GotifyEventSettings struct {
+	Priority *int `json:"priority,omitempty"`
+}
+
+// ForEvent returns the configuration with the settings of event applied.
+func (c GotifyConfig) ForEvent(event NotificationEventType) GotifyConfig {
+	if settings, ok := c.EventSettings[event]; ok && settings.Priority != nil {
+		c.Priority = *settings.Priority
+	}
+	return c
+}
+
+// MatrixConfig authenticates with AccessToken when set, otherwise with
+// Username and Password. With Encryption, messages to rooms that have
+// end-to-end encryption enabled are encrypted from a device Arcane keeps
+// across sends.
 type MatrixConfig struct {
 	Host                   string                         `json:"host"`
 	Port                   int                            `json:"port,omitempty"`
 	Rooms                  string                         `json:"rooms"`
 	Username               string                         `json:"username,omitempty"`
-	Password               string                         `json:"password,omitempty"` //nolint:gosec // JSON schema compatibility with external provider config
+	Password               string                         `json:"password,omitempty"`    //nolint:gosec // JSON schema compatibility with external provider config
+	AccessToken            string                         `json:"accessToken,omitempty"` //nolint:gosec // JSON schema compatibility with external provider config
+	Encryption             bool                           `json:"encryption"`
 	DisableTLSVerification bool                           `json:"disableTlsVerification"`
+	TLS                    *NotificationTLSConfig         `json:"tls,omitempty"`
 	Events                 map[NotificationEventType]bool `json:"events,omitempty"`
 }
 
@@ -190,6 +361,7 @@ type GenericConfig struct {
 	MessageKey    string                         `json:"messageKey,omitempty"`
 	CustomHeaders map[string]string              `json:"customHeaders,omitempty"`
 	DisableTLS    bool                           `json:"disableTls"`
+	TLS           *NotificationTLSConfig         `json:"tls,omitempty"`
 	Events        map[NotificationEventType]bool `json:"events,omitempty"`
 }
 
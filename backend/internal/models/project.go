@@ -1,5 +1,7 @@
 package models
 
+import "time"
+
 type ProjectStatus string
 
 const (
@@ -10,17 +12,28 @@ const (
 	ProjectStatusDeploying        ProjectStatus = "deploying"
 	ProjectStatusStopping         ProjectStatus = "stopping"
 	ProjectStatusRestarting       ProjectStatus = "restarting"
+	ProjectStatusArchived         ProjectStatus = "archived"
 )
 
 type Project struct {
-	Name            string        `json:"name" sortable:"true"`
-	DirName         *string       `json:"dir_name"`
-	Path            string        `json:"path"`
-	Status          ProjectStatus `json:"status" sortable:"true"`
-	StatusReason    *string       `json:"status_reason"`
-	ServiceCount    int           `json:"service_count" sortable:"true"`
-	RunningCount    int           `json:"running_count" sortable:"true"`
-	GitOpsManagedBy *string       `json:"gitops_managed_by,omitempty" gorm:"column:gitops_managed_by"`
+	Name             string        `json:"name" sortable:"true"`
+	DirName          *string       `json:"dir_name"`
+	Path             string        `json:"path"`
+	Status           ProjectStatus `json:"status" sortable:"true"`
+	StatusReason     *string       `json:"status_reason"`
+	ServiceCount     int           `json:"service_count" sortable:"true"`
+	RunningCount     int           `json:"running_count" sortable:"true"`
+	GitOpsManagedBy  *string       `json:"gitops_managed_by,omitempty" gorm:"column:gitops_managed_by"`
+	QuotaCPUs        *float64      `json:"quota_cpus,omitempty" gorm:"column:quota_cpus"`
+	QuotaMemoryBytes *int64        `json:"quota_memory_bytes,omitempty" gorm:"column:quota_memory_bytes"`
+	Locked           bool          `json:"locked" gorm:"column:locked"`
+	LockReason       *string       `json:"lock_reason,omitempty" gorm:"column:lock_reason"`
+	LockedBy         *string       `json:"locked_by,omitempty" gorm:"column:locked_by"`
+	LockedAt         *time.Time    `json:"locked_at,omitempty" gorm:"column:locked_at"`
+
+	Maintenance          bool       `json:"maintenance" gorm:"column:maintenance"`
+	MaintenanceMessage   *string    `json:"maintenance_message,omitempty" gorm:"column:maintenance_message"`
+	MaintenanceStartedAt *time.Time `json:"maintenance_started_at,omitempty" gorm:"column:maintenance_started_at"`
 
 	BaseModel
 }
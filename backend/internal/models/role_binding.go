@@ -0,0 +1,16 @@
+package models
+
+// RoleBinding assigns a role to a user. A binding without an environment is
+// global; a binding with a project applies only to that project within the
+// environment.
+type RoleBinding struct {
+	UserID        string  `json:"userId" gorm:"column:user_id;not null"`
+	Role          string  `json:"role" gorm:"column:role;not null" sortable:"true"`
+	EnvironmentID *string `json:"environmentId,omitempty" gorm:"column:environment_id"`
+	ProjectID     *string `json:"projectId,omitempty" gorm:"column:project_id"`
+	BaseModel
+}
+
+func (RoleBinding) TableName() string {
+	return "role_bindings"
+}
@@ -0,0 +1,14 @@
+package models
+
+// Secret is a named value stored encrypted at rest and referenced from
+// project env files as ${arcane:name}.
+type Secret struct {
+	Name        string  `json:"name" gorm:"uniqueIndex" sortable:"true"`
+	Value       string  `json:"-"`
+	Description *string `json:"description,omitempty" sortable:"true"`
+	BaseModel
+}
+
+func (Secret) TableName() string {
+	return "secrets"
+}
@@ -49,6 +49,10 @@ type Settings struct {
 	BaseServerURL             SettingVariable `key:"baseServerUrl" meta:"label=Base Server URL;type=text;keywords=base,url,server,domain,host,endpoint,address,link;category=general;description=Set the base URL for the application"`
 	EnableGravatar            SettingVariable `key:"enableGravatar" meta:"label=Enable Gravatar;type=boolean;keywords=gravatar,avatar,profile,picture,image,user,photo;category=general;description=Enable Gravatar profile pictures for users"`
 	DefaultShell              SettingVariable `key:"defaultShell" meta:"label=Default Shell;type=text;keywords=shell,default,shellpath,path,login;category=general;description=Default shell to use for commands"`
+	OutboundProxyUrl          SettingVariable `key:"outboundProxyUrl" meta:"label=Outbound Proxy;type=text;keywords=proxy,http,https,socks,socks5,outbound,network,corporate,firewall;category=general;description=HTTP(S) or SOCKS5 proxy for outbound requests such as registries, notifications and Git (empty uses HTTP_PROXY/HTTPS_PROXY)"`
+	OutboundNoProxy           SettingVariable `key:"outboundNoProxy" meta:"label=Proxy Exclusions;type=textarea;keywords=proxy,no_proxy,noproxy,bypass,exclude,hosts,domains;category=general;description=Hosts, domains or CIDR ranges that bypass the outbound proxy, separated by commas or new lines"`
+	MaintenanceModeEnabled    SettingVariable `key:"maintenanceModeEnabled,public" meta:"label=Read-only Maintenance Mode;type=boolean;keywords=maintenance,read-only,readonly,freeze,lock,downtime,host;category=general;description=Reject all changes while the host is under maintenance"`
+	MaintenanceModeMessage    SettingVariable `key:"maintenanceModeMessage,public" meta:"label=Maintenance Message;type=text;keywords=maintenance,message,banner,notice,read-only,downtime;category=general;description=Message returned to clients while maintenance mode is active"`
 	EnvironmentHealthInterval SettingVariable `key:"environmentHealthInterval" meta:"label=Environment Health Check Interval;type=cron;keywords=environment,health,check,interval,frequency,heartbeat,status,monitoring,uptime,jobs,schedule;description=How often to check environment connectivity (cron expression)" catmeta:"id=jobschedule;title=Job Schedule;icon=jobs;url=/settings/jobs;description=Configure how often Arcane background jobs run"`
 	AccentColor               SettingVariable `key:"accentColor,public,local" meta:"label=Accent Color;type=text;keywords=color,accent,theme,css,appearance,ui;category=general;description=Primary accent color for UI"`
 	OledMode                  SettingVariable `key:"oledMode,public,local" meta:"label=OLED Mode;type=boolean;keywords=oled,dark,theme,black,amoled,appearance,display;category=general;description=Use true-black backgrounds for OLED displays (only active in dark mode)"`
@@ -57,12 +61,24 @@ type Settings struct {
 	AutoUpdate                   SettingVariable `key:"autoUpdate" meta:"label=Auto Update;type=boolean;keywords=auto,update,automatic,upgrade,refresh,restart,deploy;category=internal;description=Automatically update containers when new images are available"`
 	AutoUpdateInterval           SettingVariable `key:"autoUpdateInterval" meta:"label=Auto Update Interval;type=cron;keywords=auto,update,interval,frequency,schedule,automatic,timing;category=internal;description=How often to check for automatic updates (cron expression)"`
 	AutoUpdateExcludedContainers SettingVariable `key:"autoUpdateExcludedContainers" meta:"label=Excluded Containers;type=text;keywords=exclude,containers,ignore,skip;category=internal;description=Comma-separated list of containers to exclude from auto-update"`
+	AutoUpdateRolloutStages      SettingVariable `key:"autoUpdateRolloutStages" meta:"label=Rollout Stages;type=textarea;keywords=rollout,stages,canary,staged,soak,environments,groups;category=internal;description=JSON list of environment stages that scheduled updates roll out to in order, waiting for each to stay healthy for its soak period"`
+	AutoUpdateMonitorOnly        SettingVariable `key:"autoUpdateMonitorOnly" meta:"label=Monitor-only Containers;type=text;keywords=monitor,only,notify,detect,containers,projects;category=internal;description=Comma-separated list of containers or projects whose updates are reported but never applied"`
+	AutoUpdateMinFreeDisk        SettingVariable `key:"autoUpdateMinFreeDisk" meta:"label=Minimum Free Disk Space;type=number;keywords=auto,update,disk,space,free,precondition,check,megabytes,mb;category=internal;description=Free space in MB the Docker data directory needs before an update run pulls images (0 disables)"`
+	AutoUpdateRegistryCheck      SettingVariable `key:"autoUpdateRegistryCheck" meta:"label=Check Registries Before Updating;type=boolean;keywords=auto,update,registry,reachable,precondition,check,network;category=internal;description=Check that the registries of pending updates are reachable before an update run pulls images"`
+	AutoUpdateMaxLoad            SettingVariable `key:"autoUpdateMaxLoad" meta:"label=Maximum Host Load;type=number;keywords=auto,update,load,cpu,busy,precondition,check,percent;category=internal;description=Host load average, as a percentage of the CPU count, above which update runs do not start (0 disables)"`
+	AutoUpdateRollbackWindow     SettingVariable `key:"autoUpdateRollbackWindow" meta:"label=Rollback Window;type=number;keywords=auto,update,rollback,window,keep,previous,image,prune,hours;category=internal;description=Hours to keep the previous image of each updated container before it may be pruned (0 disables)"`
+	ImageRetentionEnabled        SettingVariable `key:"imageRetentionEnabled" meta:"label=Image Retention;type=boolean;keywords=image,retention,keep,rollback,cleanup,old,versions,tags;category=internal;description=Keep a limited number of previous image versions for updated repositories and remove older ones on a schedule"`
+	ImageRetentionKeepCount      SettingVariable `key:"imageRetentionKeepCount" meta:"label=Image Versions to Keep;type=number;keywords=image,retention,keep,count,versions,rollback;category=internal;description=Number of previous image versions to keep per repository (default: 2)"`
+	ImageRetentionInterval       SettingVariable `key:"imageRetentionInterval" meta:"label=Image Retention Interval;type=cron;keywords=image,retention,interval,schedule,frequency,cleanup,jobs;description=How often to apply the image retention policy (cron expression)" catmeta:"id=jobschedule"`
 	PollingEnabled               SettingVariable `key:"pollingEnabled" meta:"label=Enable Polling;type=boolean;keywords=polling,check,monitor,watch,scan,detection,automatic;category=internal;description=Enable automatic checking for image updates"`
 	PollingInterval              SettingVariable `key:"pollingInterval" meta:"label=Polling Interval;type=cron;keywords=interval,frequency,schedule,time,minutes,period,delay;category=internal;description=How often to check for image updates (cron expression)"`
 	EventCleanupInterval         SettingVariable `key:"eventCleanupInterval" meta:"label=Event Cleanup Interval;type=cron;keywords=events,cleanup,retention,interval,frequency,schedule,history,logs,jobs;description=How often to delete old events (cron expression)"`
 	AnalyticsHeartbeatInterval   SettingVariable `key:"analyticsHeartbeatInterval" meta:"label=Analytics Heartbeat Interval;type=cron;keywords=analytics,heartbeat,interval,frequency,schedule,telemetry,jobs;description=How often to send the anonymous analytics heartbeat (cron expression)"`
 	AutoInjectEnv                SettingVariable `key:"autoInjectEnv" meta:"label=Auto Inject Env Variables;type=boolean;keywords=auto,inject,env,environment,variables,interpolation;category=internal;description=Automatically inject project .env variables into all containers (default: false)"`
+	ComposeTemplateVariables     SettingVariable `key:"composeTemplateVariables" meta:"label=Compose Template Variables;type=textarea;keywords=template,variables,compose,substitution,placeholder,host,domain;category=internal;description=NAME=value pairs, one per line, that compose files can reference as {{arcane.NAME}}"`
+	ComposeLintRules             SettingVariable `key:"composeLintRules" meta:"label=Compose Lint Rules;type=textarea;keywords=lint,rules,compose,policy,latest,healthcheck,limits,privileged,labels;category=internal;description=JSON rules checked when projects are saved or synced, each set to off, warning or error"`
 	PruneMode                    SettingVariable `key:"dockerPruneMode" meta:"label=Docker Prune Action;type=select;keywords=prune,cleanup,clean,remove,delete,unused,dangling,space,disk;category=internal;description=Configure how unused Docker images are cleaned up"`
+	PruneProtectionLabel         SettingVariable `key:"pruneProtectionLabel" meta:"label=Prune Protection Label;type=text;keywords=prune,protect,protection,keep,exclude,label,cleanup,volume,image,network;category=internal;description=Containers, images, volumes and networks with this label are kept when pruning (empty disables)"`
 	DefaultDeployPullPolicy      SettingVariable `key:"defaultDeployPullPolicy" meta:"label=Default Deploy Pull Policy;type=select;keywords=deploy,pull,policy,compose,up,missing,always;category=internal;description=Default image pull policy when deploying projects"`
 	ScheduledPruneEnabled        SettingVariable `key:"scheduledPruneEnabled" meta:"label=Scheduled Prune Enabled;type=boolean;keywords=prune,cleanup,maintenance,schedule,automatic;category=internal;description=Enable scheduled pruning of unused Docker resources"`
 	ScheduledPruneInterval       SettingVariable `key:"scheduledPruneInterval" meta:"label=Scheduled Prune Interval;type=cron;keywords=prune,cleanup,interval,minutes,schedule;category=internal;description=How often to run scheduled prunes (cron expression)"`
@@ -72,11 +88,40 @@ type Settings struct {
 	ScheduledPruneVolumes        SettingVariable `key:"scheduledPruneVolumes" meta:"label=Scheduled Prune Volumes;type=boolean;keywords=prune,volumes,cleanup,maintenance;category=internal;description=Remove unused volumes during scheduled prune"`
 	ScheduledPruneNetworks       SettingVariable `key:"scheduledPruneNetworks" meta:"label=Scheduled Prune Networks;type=boolean;keywords=prune,networks,cleanup,maintenance;category=internal;description=Remove unused networks during scheduled prune"`
 	ScheduledPruneBuildCache     SettingVariable `key:"scheduledPruneBuildCache" meta:"label=Scheduled Prune Build Cache;type=boolean;keywords=prune,build cache,cleanup,maintenance;category=internal;description=Remove Docker build cache during scheduled prune"`
+	StaleResourcesEnabled        SettingVariable `key:"staleResourcesEnabled" meta:"label=Stale Resource Report;type=boolean;keywords=stale,unused,abandoned,orphaned,report,cleanup,containers,volumes,networks,images;category=internal;description=Regularly look for stopped containers, unmounted volumes, empty networks and old unused images to review for cleanup"`
+	StaleResourcesInterval       SettingVariable `key:"staleResourcesInterval" meta:"label=Stale Resource Report Interval;type=cron;keywords=stale,unused,report,interval,schedule,frequency,jobs;description=How often to refresh the stale resource report (cron expression)" catmeta:"id=jobschedule"`
+	StaleContainerDays           SettingVariable `key:"staleContainerDays" meta:"label=Stale Container Days;type=number;keywords=stale,stopped,containers,days,threshold,report;category=internal;description=Days a container must have been stopped before it is reported as stale (default: 30)"`
+	StaleImageDays               SettingVariable `key:"staleImageDays" meta:"label=Stale Image Days;type=number;keywords=stale,unused,images,days,threshold,report;category=internal;description=Days an unused image must have existed before it is reported as stale (default: 90)"`
 	AutoHealEnabled              SettingVariable `key:"autoHealEnabled" meta:"label=Auto Heal;type=boolean;keywords=auto,heal,health,restart,unhealthy,recovery,container,healthcheck;category=internal;description=Automatically restart containers that become unhealthy"`
 	AutoHealInterval             SettingVariable `key:"autoHealInterval" meta:"label=Auto Heal Interval;type=cron;keywords=auto,heal,interval,frequency,schedule,health,jobs;description=How often to check container health (cron expression)" catmeta:"id=jobschedule"`
 	AutoHealExcludedContainers   SettingVariable `key:"autoHealExcludedContainers" meta:"label=Auto Heal Excluded Containers;type=text;keywords=auto,heal,exclude,containers,ignore,skip,health;category=internal;description=Comma-separated list of containers to exclude from auto-heal"`
+	ContainerSnapshotRetention   SettingVariable `key:"containerSnapshotRetention" meta:"label=Snapshots Kept Per Container;type=number;keywords=snapshot,commit,retention,keep,backup,restore,container;category=internal;description=Number of snapshots kept per container; older ones are removed when a new snapshot is taken. 0 keeps all (default: 5)"`
 	AutoHealMaxRestarts          SettingVariable `key:"autoHealMaxRestarts" meta:"label=Auto Heal Max Restarts;type=number;keywords=auto,heal,max,restarts,limit,loop,protection;category=internal;description=Maximum auto-heal restarts per container within the restart window (default: 5)"`
 	AutoHealRestartWindow        SettingVariable `key:"autoHealRestartWindow" meta:"label=Auto Heal Restart Window;type=number;keywords=auto,heal,restart,window,minutes,cooldown,protection;category=internal;description=Time window in minutes for counting auto-heal restarts (default: 30)"`
+	ContainerCrashThreshold      SettingVariable `key:"containerCrashThreshold" meta:"label=Crash Alert Threshold;type=number;keywords=crash,loop,exit,restart,alert,notification,container;category=internal;description=Non-zero exits within the crash window before a container crash alert is sent (0 disables, default: 3)"`
+	ContainerCrashWindow         SettingVariable `key:"containerCrashWindow" meta:"label=Crash Alert Window;type=number;keywords=crash,loop,window,minutes,alert,container;category=internal;description=Time window in minutes for counting container crashes (default: 10)"`
+	ContainerCrashCooldown       SettingVariable `key:"containerCrashCooldown" meta:"label=Crash Alert Cooldown;type=number;keywords=crash,alert,cooldown,minutes,notification,container;category=internal;description=Minutes to wait before alerting again for the same container (default: 60)"`
+	UnmanagedChangeDetection     SettingVariable `key:"unmanagedChangeDetection" meta:"label=Unmanaged Change Detection;type=boolean;keywords=unmanaged,change,outside,anomaly,security,audit,compromise,container;category=internal;description=Alert when containers are created, changed or removed outside Arcane"`
+	NotificationActionLinks      SettingVariable `key:"notificationActionLinks" meta:"label=Notification Action Links;type=boolean;keywords=notification,action,link,apply,snooze,update,slack,email,discord;category=internal;description=Add signed links to image update notifications that apply the update or snooze its notifications without logging in"`
+	NotificationActionLinkHours  SettingVariable `key:"notificationActionLinkHours" meta:"label=Notification Action Link Lifetime;type=number;keywords=notification,action,link,expiry,expire,hours,token;category=internal;description=Hours a notification action link stays valid (default: 72)"`
+	HostMetricsCpuThreshold      SettingVariable `key:"hostMetricsCpuThreshold" meta:"label=Host CPU Alert Threshold;type=number;keywords=host,metrics,cpu,threshold,alert,usage,percent;category=internal;description=Notify when host CPU usage exceeds this percentage (0 disables)"`
+	HostMetricsMemoryThreshold   SettingVariable `key:"hostMetricsMemoryThreshold" meta:"label=Host Memory Alert Threshold;type=number;keywords=host,metrics,memory,ram,threshold,alert,usage,percent;category=internal;description=Notify when host memory usage exceeds this percentage (0 disables)"`
+	HostMetricsDiskThreshold     SettingVariable `key:"hostMetricsDiskThreshold" meta:"label=Host Disk Alert Threshold;type=number;keywords=host,metrics,disk,storage,threshold,alert,usage,percent;category=internal;description=Notify when host disk usage exceeds this percentage (0 disables)"`
+	HostMetricsRetentionHours    SettingVariable `key:"hostMetricsRetentionHours" meta:"label=Host Metrics Retention;type=number;keywords=host,metrics,retention,history,hours,cleanup;category=internal;description=How many hours of host metrics history to keep (default: 168)"`
+	EnvironmentHeartbeatTimeout  SettingVariable `key:"environmentHeartbeatTimeout" meta:"label=Environment Heartbeat Timeout;type=number;keywords=environment,heartbeat,timeout,offline,alert,agent,seconds;category=internal;description=Seconds without a heartbeat before an environment is marked offline (0 disables)"`
+	EnvironmentFlapWindow        SettingVariable `key:"environmentFlapWindow" meta:"label=Environment Flap Window;type=number;keywords=environment,flap,flapping,suppression,offline,online,alert,minutes;category=internal;description=Minutes over which repeated offline/online changes are treated as flapping and not notified (default: 10)"`
+	ConfigBackupEnabled          SettingVariable `key:"configBackupEnabled" meta:"label=Scheduled Configuration Backup;type=boolean;keywords=backup,configuration,export,schedule,restore,disaster,recovery;category=internal;description=Periodically write an encrypted backup of the Arcane configuration"`
+	ConfigBackupInterval         SettingVariable `key:"configBackupInterval" meta:"label=Configuration Backup Interval;type=cron;keywords=backup,configuration,interval,schedule,frequency,jobs;description=How often to write a configuration backup (cron expression)" catmeta:"id=jobschedule"`
+	ConfigBackupDestination      SettingVariable `key:"configBackupDestination" meta:"label=Configuration Backup Destination;type=select;keywords=backup,destination,local,s3,bucket,storage;category=internal;description=Where scheduled backups are written (local or s3)"`
+	ConfigBackupPath             SettingVariable `key:"configBackupPath" meta:"label=Configuration Backup Path;type=text;keywords=backup,path,directory,folder,local,storage;category=internal;description=Directory scheduled backups are written to when the destination is local"`
+	ConfigBackupRetention        SettingVariable `key:"configBackupRetention" meta:"label=Configuration Backup Retention;type=number;keywords=backup,retention,keep,count,cleanup;category=internal;description=Number of local backups to keep (0 keeps all)"`
+	ConfigBackupPassphrase       SettingVariable `key:"configBackupPassphrase,sensitive" meta:"label=Configuration Backup Passphrase;type=password;keywords=backup,passphrase,password,encryption,secret;category=internal;description=Passphrase used to encrypt scheduled backups"`
+	ConfigBackupS3Endpoint       SettingVariable `key:"configBackupS3Endpoint" meta:"label=Backup S3 Endpoint;type=text;keywords=backup,s3,endpoint,url,minio,bucket;category=internal;description=Base URL of the S3-compatible service"`
+	ConfigBackupS3Region         SettingVariable `key:"configBackupS3Region" meta:"label=Backup S3 Region;type=text;keywords=backup,s3,region,aws;category=internal;description=Region of the S3 bucket (default: us-east-1)"`
+	ConfigBackupS3Bucket         SettingVariable `key:"configBackupS3Bucket" meta:"label=Backup S3 Bucket;type=text;keywords=backup,s3,bucket,storage;category=internal;description=Bucket scheduled backups are uploaded to"`
+	ConfigBackupS3Prefix         SettingVariable `key:"configBackupS3Prefix" meta:"label=Backup S3 Prefix;type=text;keywords=backup,s3,prefix,folder,key;category=internal;description=Object key prefix for uploaded backups"`
+	ConfigBackupS3AccessKey      SettingVariable `key:"configBackupS3AccessKey" meta:"label=Backup S3 Access Key ID;type=text;keywords=backup,s3,access,key,credentials;category=internal;description=Access key ID used to upload backups"`
+	ConfigBackupS3SecretKey      SettingVariable `key:"configBackupS3SecretKey,sensitive" meta:"label=Backup S3 Secret Access Key;type=password;keywords=backup,s3,secret,key,credentials;category=internal;description=Secret access key used to upload backups"`
 	MaxImageUploadSize           SettingVariable `key:"maxImageUploadSize" meta:"label=Max Image Upload Size;type=number;keywords=upload,size,limit,maximum,image,tar,file,megabytes,mb,storage;category=internal;description=Maximum size in MB for image archive uploads (default: 500)"`
 	DockerHost                   SettingVariable `key:"dockerHost,public,envOverride" meta:"label=Docker Host;type=text;keywords=docker,host,daemon,socket,unix,remote;category=internal;description=URI for Docker daemon"`
 	BuildProvider                SettingVariable `key:"buildProvider,envOverride" meta:"label=Build Provider;type=select;keywords=build,buildkit,depot,provider,remote,local;category=build;description=Default build provider (local or depot)" catmeta:"id=build;title=Build;icon=code;url=/settings/builds;description=Configure BuildKit and Depot build settings"`
@@ -88,6 +133,7 @@ type Settings struct {
 	// Security category
 	AuthLocalEnabled                SettingVariable `key:"authLocalEnabled,public" meta:"label=Local Authentication;type=boolean;keywords=local,auth,authentication,username,password,login,credentials;category=security;description=Enable local username/password authentication" catmeta:"id=security;title=Security;icon=shield;url=/settings/security;description=Manage authentication and security settings"`
 	AuthSessionTimeout              SettingVariable `key:"authSessionTimeout" meta:"label=Session Timeout;type=number;keywords=session,timeout,expire,duration,lifetime,minutes,logout;category=security;description=How long user sessions remain active"`
+	CorsAllowedOrigins              SettingVariable `key:"corsAllowedOrigins" meta:"label=Allowed CORS Origins;type=textarea;keywords=cors,origin,origins,cross-origin,allowlist,reverse,proxy,domain,browser;category=security;description=Extra origins browsers may call the API from, such as https://arcane.example.com, separated by commas or new lines (the application URL is always allowed)"`
 	AuthPasswordPolicy              SettingVariable `key:"authPasswordPolicy" meta:"label=Password Policy;type=select;keywords=password,policy,strength,complexity,requirements,security,rules;category=security;description=Set password strength requirements"`
 	VulnerabilityScanEnabled        SettingVariable `key:"vulnerabilityScanEnabled" meta:"label=Scheduled Vulnerability Scan;type=boolean;keywords=vulnerability,scan,security,trivy,schedule,automatic,cve;category=security;description=Enable scheduled vulnerability scanning of all Docker images"`
 	VulnerabilityScanInterval       SettingVariable `key:"vulnerabilityScanInterval" meta:"label=Vulnerability Scan Interval;type=cron;keywords=vulnerability,scan,interval,schedule,frequency,trivy,cve;category=security;description=How often to run scheduled vulnerability scans (cron expression)"`
@@ -117,6 +163,10 @@ type Settings struct {
 	OidcMergeAccounts               SettingVariable `key:"oidcMergeAccounts,public,envOverride" meta:"label=OIDC Account Merging;type=boolean;keywords=oidc,merge,link,accounts,email,match,existing,users,combine;category=security;description=Allow OIDC logins to merge with existing accounts by email"`
 	OidcProviderName                SettingVariable `key:"oidcProviderName,public,envOverride" meta:"label=OIDC Provider Name;type=text;keywords=oidc,provider,name,display,label,sso;category=security;description=Custom name for the OIDC provider (e.g., Authentik, Keycloak)"`
 	OidcProviderLogoUrl             SettingVariable `key:"oidcProviderLogoUrl,public,envOverride" meta:"label=OIDC Provider Logo URL;type=text;keywords=oidc,provider,logo,url,image,icon,sso;category=security;description=Custom logo URL for the OIDC provider"`
+	AuditForwardEnabled             SettingVariable `key:"auditForwardEnabled" meta:"label=Audit Log Forwarding;type=boolean;keywords=audit,siem,syslog,forward,export,events,logging,compliance;category=security;description=Forward all events to an external SIEM or log collector"`
+	AuditForwardProtocol            SettingVariable `key:"auditForwardProtocol" meta:"label=Audit Forwarding Protocol;type=select;keywords=audit,siem,syslog,udp,tcp,http,protocol;category=security;description=Transport used to forward events (syslog-udp, syslog-tcp or http)"`
+	AuditForwardTarget              SettingVariable `key:"auditForwardTarget" meta:"label=Audit Forwarding Target;type=text;keywords=audit,siem,syslog,collector,host,url,endpoint;category=security;description=Syslog host:port or HTTP collector URL"`
+	AuditForwardToken               SettingVariable `key:"auditForwardToken,sensitive" meta:"label=Audit Forwarding Token;type=password;keywords=audit,siem,token,secret,authorization,http;category=security;description=Bearer token sent to HTTP collectors"`
 
 	// Appearance category
 	MobileNavigationMode       SettingVariable `key:"mobileNavigationMode,public,local" meta:"label=Mobile Navigation Mode;type=select;keywords=mode,style,type,floating,docked,position,layout,design,appearance,bottom;category=appearance;description=Choose between floating or docked navigation on mobile" catmeta:"id=appearance;title=Appearance;icon=appearance;url=/settings/appearance;description=Customize navigation, theme, and interface behavior"`
@@ -129,6 +179,10 @@ type Settings struct {
 
 	AgentToken SettingVariable `key:"agentToken,internal,sensitive"`
 	InstanceID SettingVariable `key:"instanceId,internal"`
+	SetupState SettingVariable `key:"setupState,internal"`
+
+	// NotifiedRelease is the newest Arcane release a notification was sent for.
+	NotifiedRelease SettingVariable `key:"notifiedRelease,internal"`
 
 	// Users category (admin management page - no actual settings)
 	UsersCategoryPlaceholder SettingVariable `key:"usersCategory,internal" meta:"label=Users;type=internal;keywords=users,accounts,management,admin,access,permissions,roles;category=users;description=Manage user accounts and permissions" catmeta:"id=users;title=Users;icon=user;url=/settings/users;description=Manage user accounts and access control"`
@@ -144,6 +198,13 @@ type Settings struct {
 	HTTPClientTimeout      SettingVariable `key:"httpClientTimeout,envOverride" meta:"label=HTTP Client Timeout;type=number;keywords=http,client,timeout,seconds,api,request;category=timeouts;description=Default timeout for HTTP requests in seconds (default: 30)"`
 	RegistryTimeout        SettingVariable `key:"registryTimeout,envOverride" meta:"label=Registry Timeout;type=number;keywords=registry,timeout,seconds,docker,auth;category=timeouts;description=Timeout for container registry operations in seconds (default: 30)"`
 	ProxyRequestTimeout    SettingVariable `key:"proxyRequestTimeout,envOverride" meta:"label=Proxy Request Timeout;type=number;keywords=proxy,request,timeout,seconds,forward;category=timeouts;description=Timeout for proxied requests in seconds (default: 60)"`
+
+	// Remote environment requests; the breaker fails requests to an unreachable
+	// agent fast instead of waiting for a timeout on every call.
+	AggregateRequestTimeout     SettingVariable `key:"aggregateRequestTimeout,envOverride" meta:"label=Aggregated Request Timeout;type=number;keywords=aggregate,all,environments,remote,timeout,seconds;category=timeouts;description=Timeout per environment in seconds when listing resources across all environments (default: 10)"`
+	ProxyRequestRetries         SettingVariable `key:"proxyRequestRetries,envOverride" meta:"label=Proxy Request Retries;type=number;keywords=proxy,retry,retries,remote,environment;category=timeouts;description=Retries for read requests to remote environments that fail to connect (default: 2)"`
+	EnvironmentBreakerThreshold SettingVariable `key:"environmentBreakerThreshold,envOverride" meta:"label=Circuit Breaker Threshold;type=number;keywords=circuit,breaker,failures,remote,environment,unreachable;category=timeouts;description=Consecutive failed requests before requests to a remote environment are paused, 0 disables the breaker (default: 5)"`
+	EnvironmentBreakerCooldown  SettingVariable `key:"environmentBreakerCooldown,envOverride" meta:"label=Circuit Breaker Cooldown;type=number;keywords=circuit,breaker,cooldown,remote,environment,seconds;category=timeouts;description=Seconds requests to an unreachable remote environment stay paused before Arcane tries again (default: 30)"`
 }
 
 func (SettingVariable) TableName() string {
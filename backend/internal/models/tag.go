@@ -0,0 +1,38 @@
+package models
+
+// TagResourceType is the kind of resource a tag or saved filter applies to.
+type TagResourceType string
+
+const (
+	TagResourceProject     TagResourceType = "project"
+	TagResourceContainer   TagResourceType = "container"
+	TagResourceEnvironment TagResourceType = "environment"
+)
+
+// ResourceTag is a user-defined key/value stored in Arcane and attached to a
+// project, container or environment. Containers are tagged by name so that
+// tags survive the container being recreated.
+type ResourceTag struct {
+	ResourceType TagResourceType `json:"resourceType" gorm:"column:resource_type;not null"`
+	ResourceID   string          `json:"resourceId" gorm:"column:resource_id;not null"`
+	Key          string          `json:"key" gorm:"column:tag_key;not null"`
+	Value        string          `json:"value" gorm:"column:tag_value;not null"`
+	BaseModel
+}
+
+func (ResourceTag) TableName() string {
+	return "resource_tags"
+}
+
+// SavedFilter is a named set of list query parameters for one resource type.
+type SavedFilter struct {
+	Name         string            `json:"name" gorm:"column:name;not null" sortable:"true"`
+	ResourceType TagResourceType   `json:"resourceType" gorm:"column:resource_type;not null"`
+	Search       string            `json:"search" gorm:"column:search;not null"`
+	Filters      map[string]string `json:"filters" gorm:"column:filters;type:text;serializer:json"`
+	BaseModel
+}
+
+func (SavedFilter) TableName() string {
+	return "saved_filters"
+}
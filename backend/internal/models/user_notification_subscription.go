@@ -0,0 +1,24 @@
+package models
+
+// UserNotificationSubscription sends selected events to a user's own address
+// or chat through a globally configured provider. Only the email and Telegram
+// providers support personal targets.
+type UserNotificationSubscription struct {
+	UserID   string               `json:"userId" gorm:"not null;uniqueIndex:idx_user_notification_subscriptions_user_provider"`
+	Provider NotificationProvider `json:"provider" gorm:"not null;uniqueIndex:idx_user_notification_subscriptions_user_provider"`
+	// Target is an email address or a Telegram chat ID.
+	Target  string      `json:"target" gorm:"not null"`
+	Events  StringSlice `json:"events" gorm:"type:text"`
+	Enabled bool        `json:"enabled"`
+	BaseModel
+}
+
+func (UserNotificationSubscription) TableName() string {
+	return "user_notification_subscriptions"
+}
+
+// SupportsPersonalTarget reports whether a provider can deliver to a per-user
+// target while reusing the global provider's credentials.
+func SupportsPersonalTarget(provider NotificationProvider) bool {
+	return provider == NotificationProviderEmail || provider == NotificationProviderTelegram
+}
@@ -0,0 +1,269 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/pagination"
+	"github.com/getarcaneapp/arcane/types/base"
+	containertypes "github.com/getarcaneapp/arcane/types/container"
+	"github.com/getarcaneapp/arcane/types/environment"
+	imagetypes "github.com/getarcaneapp/arcane/types/image"
+	"github.com/getarcaneapp/arcane/types/project"
+)
+
+// AggregationService fans list requests out to every enabled environment and
+// merges the results so multi-host users can see everything in one place.
+type AggregationService struct {
+	environmentService *EnvironmentService
+	containerService   *ContainerService
+	projectService     *ProjectService
+	imageService       *ImageService
+}
+
+func NewAggregationService(environmentService *EnvironmentService, containerService *ContainerService, projectService *ProjectService, imageService *ImageService) *AggregationService {
+	return &AggregationService{
+		environmentService: environmentService,
+		containerService:   containerService,
+		projectService:     projectService,
+		imageService:       imageService,
+	}
+}
+
+// AggregateQuery selects and orders the items of an all-environments list.
+// Search and Filters are forwarded to every environment; Sort accepts
+// "environment" (the default) or "name".
+type AggregateQuery struct {
+	Params         pagination.QueryParams
+	EnvironmentIDs []string
+}
+
+// AggregateResult is a merged, paginated all-environments list.
+type AggregateResult[T any] struct {
+	Items      []environment.AggregatedItem[T]
+	Sources    []environment.AggregateSource
+	Pagination pagination.Response
+}
+
+// ListContainers returns the containers of every selected environment.
+func (s *AggregationService) ListContainers(ctx context.Context, query AggregateQuery, includeInternal bool) (*AggregateResult[containertypes.Summary], error) {
+	local := func(ctx context.Context, params pagination.QueryParams) ([]containertypes.Summary, error) {
+		items, _, _, err := s.containerService.ListContainersPaginated(ctx, params, true, includeInternal)
+		return items, err
+	}
+	extra := url.Values{}
+	if includeInternal {
+		extra.Set("includeInternal", "true")
+	}
+	nameFn := func(c containertypes.Summary) string {
+		if len(c.Names) == 0 {
+			return c.ID
+		}
+		return strings.TrimPrefix(c.Names[0], "/")
+	}
+	return aggregateInternal(ctx, s.environmentService, query, "containers", extra, local, nameFn)
+}
+
+// ListProjects returns the projects of every selected environment.
+func (s *AggregationService) ListProjects(ctx context.Context, query AggregateQuery) (*AggregateResult[project.Details], error) {
+	local := func(ctx context.Context, params pagination.QueryParams) ([]project.Details, error) {
+		items, _, err := s.projectService.ListProjects(ctx, params)
+		return items, err
+	}
+	nameFn := func(p project.Details) string { return p.Name }
+	return aggregateInternal(ctx, s.environmentService, query, "projects", nil, local, nameFn)
+}
+
+// ListImages returns the images of every selected environment.
+func (s *AggregationService) ListImages(ctx context.Context, query AggregateQuery) (*AggregateResult[imagetypes.Summary], error) {
+	local := func(ctx context.Context, params pagination.QueryParams) ([]imagetypes.Summary, error) {
+		items, _, err := s.imageService.ListImagesPaginated(ctx, params)
+		return items, err
+	}
+	nameFn := func(i imagetypes.Summary) string {
+		if len(i.RepoTags) == 0 {
+			return i.ID
+		}
+		return i.RepoTags[0]
+	}
+	return aggregateInternal(ctx, s.environmentService, query, "images", nil, local, nameFn)
+}
+
+// aggregateSSHResources are the resources listed for SSH environments by
+// running the local list against the tunnelled Docker client.
+var aggregateSSHResources = map[string]struct{}{
+	"containers": {},
+	"images":     {},
+}
+
+type aggregateLocalFn[T any] func(ctx context.Context, params pagination.QueryParams) ([]T, error)
+
+func aggregateInternal[T any](
+	ctx context.Context,
+	environmentService *EnvironmentService,
+	query AggregateQuery,
+	resource string,
+	extra url.Values,
+	local aggregateLocalFn[T],
+	nameFn func(T) string,
+) (*AggregateResult[T], error) {
+	envs, err := listAggregateEnvironmentsInternal(ctx, environmentService, query.EnvironmentIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Each environment returns everything that matches; paging happens after the merge.
+	fetchParams := query.Params
+	fetchParams.Start = 0
+	fetchParams.Limit = -1
+
+	remoteTimeout := environmentService.AggregateRequestTimeout()
+	perEnv := make([][]T, len(envs))
+	sources := make([]environment.AggregateSource, len(envs))
+	var wg sync.WaitGroup
+	for i := range envs {
+		env := envs[i]
+		wg.Go(func() {
+			var items []T
+			var fetchErr error
+			_, sshSupported := aggregateSSHResources[resource]
+			switch {
+			case env.ID == "0":
+				items, fetchErr = local(ctx, fetchParams)
+			case IsSSHEnvironmentURL(env.ApiUrl) && sshSupported:
+				items, fetchErr = local(WithDockerEnvironment(ctx, env.ID), fetchParams)
+			case IsSSHEnvironmentURL(env.ApiUrl):
+				fetchErr = fmt.Errorf("%s are not available for SSH environments", resource)
+			default:
+				// Bound each agent so an unreachable one cannot hold up the page.
+				envCtx, cancel := context.WithTimeout(ctx, remoteTimeout)
+				items, fetchErr = fetchRemoteListInternal[T](envCtx, environmentService, env.ID, resource, fetchParams, extra)
+				cancel()
+			}
+
+			sources[i] = environment.AggregateSource{
+				EnvironmentID:   env.ID,
+				EnvironmentName: env.Name,
+				Status:          environment.AggregateSourceOK,
+				ItemCount:       len(items),
+			}
+			if fetchErr != nil {
+				sources[i].Status = environment.AggregateSourceError
+				sources[i].Error = fetchErr.Error()
+				return
+			}
+			perEnv[i] = items
+		})
+	}
+	wg.Wait()
+
+	var merged []environment.AggregatedItem[T]
+	for i, items := range perEnv {
+		for _, item := range items {
+			merged = append(merged, environment.AggregatedItem[T]{
+				EnvironmentID:   envs[i].ID,
+				EnvironmentName: envs[i].Name,
+				Item:            item,
+			})
+		}
+	}
+
+	sortParams := query.Params.SortParams
+	if sortParams.Sort != "name" {
+		sortParams.Sort = "environment"
+	}
+	params := pagination.QueryParams{
+		SortParams:       sortParams,
+		PaginationParams: query.Params.PaginationParams,
+	}
+	result := pagination.SearchOrderAndPaginate(merged, params, pagination.Config[environment.AggregatedItem[T]]{
+		SortBindings: []pagination.SortBinding[environment.AggregatedItem[T]]{
+			{
+				Key: "environment",
+				Fn: func(a, b environment.AggregatedItem[T]) int {
+					return cmp.Compare(strings.ToLower(a.EnvironmentName), strings.ToLower(b.EnvironmentName))
+				},
+			},
+			{
+				Key: "name",
+				Fn: func(a, b environment.AggregatedItem[T]) int {
+					return cmp.Compare(strings.ToLower(nameFn(a.Item)), strings.ToLower(nameFn(b.Item)))
+				},
+			},
+		},
+	})
+
+	items := result.Items
+	if items == nil {
+		items = []environment.AggregatedItem[T]{}
+	}
+
+	return &AggregateResult[T]{
+		Items:      items,
+		Sources:    sources,
+		Pagination: pagination.BuildResponseFromFilterResult(result, params),
+	}, nil
+}
+
+func listAggregateEnvironmentsInternal(ctx context.Context, environmentService *EnvironmentService, ids []string) ([]models.Environment, error) {
+	if environmentService == nil {
+		return nil, fmt.Errorf("environment service not available")
+	}
+
+	remote, err := environmentService.ListRemoteEnvironments(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	envs := make([]models.Environment, 0, len(remote)+1)
+	if local, err := environmentService.GetEnvironmentByID(ctx, "0"); err == nil && local != nil {
+		envs = append(envs, *local)
+	}
+	envs = append(envs, remote...)
+
+	if len(ids) > 0 {
+		envs = slices.DeleteFunc(envs, func(env models.Environment) bool {
+			return !slices.Contains(ids, env.ID)
+		})
+	}
+	return envs, nil
+}
+
+func fetchRemoteListInternal[T any](ctx context.Context, environmentService *EnvironmentService, envID, resource string, params pagination.QueryParams, extra url.Values) ([]T, error) {
+	query := url.Values{}
+	for k, v := range extra {
+		query[k] = v
+	}
+	query.Set("limit", "-1")
+	if params.Search != "" {
+		query.Set("search", params.Search)
+	}
+	for k, v := range params.Filters {
+		if v != "" {
+			query.Set(k, v)
+		}
+	}
+
+	path := "/api/environments/0/" + resource + "?" + query.Encode()
+	body, statusCode, err := environmentService.ProxyRequest(ctx, envID, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != http.StatusOK {
+		return nil, fmt.Errorf("environment returned status %d", statusCode)
+	}
+
+	var resp base.Paginated[T]
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", resource, err)
+	}
+	return resp.Data, nil
+}
@@ -0,0 +1,62 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	glsqlite "github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/getarcaneapp/arcane/backend/internal/database"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/pagination"
+	"github.com/getarcaneapp/arcane/types/environment"
+)
+
+func TestAggregateInternal_MergesAndReportsFailedEnvironments(t *testing.T) {
+	ctx := context.Background()
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared", strings.NewReplacer("/", "_", " ", "_").Replace(t.Name()))
+	gdb, err := gorm.Open(glsqlite.Open(dsn), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, gdb.AutoMigrate(&models.SettingVariable{}, &models.Environment{}))
+	db := &database.DB{DB: gdb}
+
+	settingsSvc, err := NewSettingsService(ctx, db)
+	require.NoError(t, err)
+	require.NoError(t, settingsSvc.EnsureDefaultSettings(ctx))
+
+	require.NoError(t, gdb.Create(&models.Environment{BaseModel: models.BaseModel{ID: "0"}, Name: "local", ApiUrl: "http://localhost:3552", Enabled: true}).Error)
+	require.NoError(t, gdb.Create(&models.Environment{BaseModel: models.BaseModel{ID: "remote-1"}, Name: "edge-box", ApiUrl: "http://127.0.0.1:1", Enabled: true}).Error)
+
+	envSvc := NewEnvironmentService(db, nil, nil, nil, settingsSvc)
+	local := func(context.Context, pagination.QueryParams) ([]string, error) {
+		return []string{"zeta", "alpha", "mid"}, nil
+	}
+
+	result, err := aggregateInternal(ctx, envSvc, AggregateQuery{
+		Params: pagination.QueryParams{
+			SortParams:       pagination.SortParams{Sort: "name", Order: pagination.SortAsc},
+			PaginationParams: pagination.PaginationParams{Start: 0, Limit: 2},
+		},
+	}, "containers", nil, local, func(s string) string { return s })
+	require.NoError(t, err)
+
+	require.Len(t, result.Items, 2)
+	require.Equal(t, "alpha", result.Items[0].Item)
+	require.Equal(t, "mid", result.Items[1].Item)
+	require.Equal(t, "local", result.Items[0].EnvironmentName)
+	require.EqualValues(t, 3, result.Pagination.TotalItems)
+
+	require.Len(t, result.Sources, 2)
+	byID := map[string]environment.AggregateSource{}
+	for _, src := range result.Sources {
+		byID[src.EnvironmentID] = src
+	}
+	require.Equal(t, environment.AggregateSourceOK, byID["0"].Status)
+	require.Equal(t, 3, byID["0"].ItemCount)
+	require.Equal(t, environment.AggregateSourceError, byID["remote-1"].Status)
+	require.NotEmpty(t, byID["remote-1"].Error)
+}
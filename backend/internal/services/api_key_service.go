@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -20,6 +21,8 @@ var (
 	ErrApiKeyNotFound = errors.New("API key not found")
 	ErrApiKeyExpired  = errors.New("API key has expired")
 	ErrApiKeyInvalid  = errors.New("invalid API key")
+
+	ErrApiKeyInvalidScope = errors.New("invalid API key scope")
 )
 
 const (
@@ -66,7 +69,38 @@ func (s *ApiKeyService) markApiKeyUsedAsync(ctx context.Context, keyID string) {
 	}(keyID)
 }
 
+// normalizeApiKeyScopes validates and de-duplicates requested scopes.
+// A list containing the full-access scope collapses to just that scope.
+func normalizeApiKeyScopes(scopes []string) (models.StringSlice, error) {
+	result := make(models.StringSlice, 0, len(scopes))
+	for _, scope := range scopes {
+		scope = strings.TrimSpace(scope)
+		if !apikey.IsValidScope(scope) {
+			return nil, fmt.Errorf("%w: %q", ErrApiKeyInvalidScope, scope)
+		}
+		if scope == apikey.ScopeAll {
+			return models.StringSlice{apikey.ScopeAll}, nil
+		}
+		if !slices.Contains(result, scope) {
+			result = append(result, scope)
+		}
+	}
+	return result, nil
+}
+
+func apiKeyScopesOrEmpty(scopes models.StringSlice) []string {
+	if scopes == nil {
+		return []string{}
+	}
+	return scopes
+}
+
 func (s *ApiKeyService) CreateApiKey(ctx context.Context, userID string, req apikey.CreateApiKey) (*apikey.ApiKeyCreatedDto, error) {
+	scopes, err := normalizeApiKeyScopes(req.Scopes)
+	if err != nil {
+		return nil, err
+	}
+
 	rawKey, err := s.generateApiKey()
 	if err != nil {
 		return nil, err
@@ -86,6 +120,7 @@ func (s *ApiKeyService) CreateApiKey(ctx context.Context, userID string, req api
 		KeyPrefix:   keyPrefix,
 		UserID:      userID,
 		ExpiresAt:   req.ExpiresAt,
+		Scopes:      scopes,
 	}
 
 	if err := s.db.WithContext(ctx).Create(ak).Error; err != nil {
@@ -100,6 +135,7 @@ func (s *ApiKeyService) CreateApiKey(ctx context.Context, userID string, req api
 			KeyPrefix:   ak.KeyPrefix,
 			UserID:      ak.UserID,
 			ExpiresAt:   ak.ExpiresAt,
+			Scopes:      apiKeyScopesOrEmpty(ak.Scopes),
 			LastUsedAt:  ak.LastUsedAt,
 			CreatedAt:   ak.CreatedAt,
 			UpdatedAt:   ak.UpdatedAt,
@@ -149,6 +185,7 @@ func (s *ApiKeyService) CreateEnvironmentApiKey(ctx context.Context, environment
 			KeyPrefix:   ak.KeyPrefix,
 			UserID:      ak.UserID,
 			ExpiresAt:   ak.ExpiresAt,
+			Scopes:      apiKeyScopesOrEmpty(ak.Scopes),
 			LastUsedAt:  ak.LastUsedAt,
 			CreatedAt:   ak.CreatedAt,
 			UpdatedAt:   ak.UpdatedAt,
@@ -173,6 +210,7 @@ func (s *ApiKeyService) GetApiKey(ctx context.Context, id string) (*apikey.ApiKe
 		KeyPrefix:   ak.KeyPrefix,
 		UserID:      ak.UserID,
 		ExpiresAt:   ak.ExpiresAt,
+		Scopes:      apiKeyScopesOrEmpty(ak.Scopes),
 		LastUsedAt:  ak.LastUsedAt,
 		CreatedAt:   ak.CreatedAt,
 		UpdatedAt:   ak.UpdatedAt,
@@ -205,6 +243,7 @@ func (s *ApiKeyService) ListApiKeys(ctx context.Context, params pagination.Query
 			KeyPrefix:   ak.KeyPrefix,
 			UserID:      ak.UserID,
 			ExpiresAt:   ak.ExpiresAt,
+			Scopes:      apiKeyScopesOrEmpty(ak.Scopes),
 			LastUsedAt:  ak.LastUsedAt,
 			CreatedAt:   ak.CreatedAt,
 			UpdatedAt:   ak.UpdatedAt,
@@ -232,6 +271,13 @@ func (s *ApiKeyService) UpdateApiKey(ctx context.Context, id string, req apikey.
 	if req.ExpiresAt != nil {
 		ak.ExpiresAt = req.ExpiresAt
 	}
+	if req.Scopes != nil {
+		scopes, err := normalizeApiKeyScopes(*req.Scopes)
+		if err != nil {
+			return nil, err
+		}
+		ak.Scopes = scopes
+	}
 
 	if err := s.db.WithContext(ctx).Save(&ak).Error; err != nil {
 		return nil, fmt.Errorf("failed to update API key: %w", err)
@@ -244,6 +290,7 @@ func (s *ApiKeyService) UpdateApiKey(ctx context.Context, id string, req apikey.
 		KeyPrefix:   ak.KeyPrefix,
 		UserID:      ak.UserID,
 		ExpiresAt:   ak.ExpiresAt,
+		Scopes:      apiKeyScopesOrEmpty(ak.Scopes),
 		LastUsedAt:  ak.LastUsedAt,
 		CreatedAt:   ak.CreatedAt,
 		UpdatedAt:   ak.UpdatedAt,
@@ -262,35 +309,42 @@ func (s *ApiKeyService) DeleteApiKey(ctx context.Context, id string) error {
 }
 
 func (s *ApiKeyService) ValidateApiKey(ctx context.Context, rawKey string) (*models.User, error) {
+	user, _, err := s.AuthenticateApiKey(ctx, rawKey)
+	return user, err
+}
+
+// AuthenticateApiKey validates a raw API key and returns its owner together
+// with the scopes granted to the key. An empty scope list means full access.
+func (s *ApiKeyService) AuthenticateApiKey(ctx context.Context, rawKey string) (*models.User, []string, error) {
 	if !strings.HasPrefix(rawKey, apiKeyPrefix) {
-		return nil, ErrApiKeyInvalid
+		return nil, nil, ErrApiKeyInvalid
 	}
 
 	keyPrefix := rawKey[:len(apiKeyPrefix)+apiKeyPrefixLen]
 
 	var apiKeys []models.ApiKey
 	if err := s.db.WithContext(ctx).Where("key_prefix = ?", keyPrefix).Find(&apiKeys).Error; err != nil {
-		return nil, fmt.Errorf("failed to find API keys: %w", err)
+		return nil, nil, fmt.Errorf("failed to find API keys: %w", err)
 	}
 
 	for _, apiKey := range apiKeys {
 		if err := s.validateApiKeyHash(apiKey.KeyHash, rawKey); err == nil {
 			if apiKey.ExpiresAt != nil && apiKey.ExpiresAt.Before(time.Now()) {
-				return nil, ErrApiKeyExpired
+				return nil, nil, ErrApiKeyExpired
 			}
 
 			s.markApiKeyUsedAsync(ctx, apiKey.ID)
 
 			user, err := s.userService.GetUserByID(ctx, apiKey.UserID)
 			if err != nil {
-				return nil, fmt.Errorf("failed to get user for API key: %w", err)
+				return nil, nil, fmt.Errorf("failed to get user for API key: %w", err)
 			}
 
-			return user, nil
+			return user, apiKey.Scopes, nil
 		}
 	}
 
-	return nil, ErrApiKeyInvalid
+	return nil, nil, ErrApiKeyInvalid
 }
 
 func (s *ApiKeyService) GetEnvironmentByApiKey(ctx context.Context, rawKey string) (*string, error) {
@@ -157,3 +157,31 @@ func TestGetEnvironmentByAPIKeyExpiredDoesNotUpdateLastUsedAt(t *testing.T) {
 	apiKey := fetchAPIKey(t, db, created.ApiKey.ID)
 	require.Nil(t, apiKey.LastUsedAt)
 }
+
+func TestAuthenticateAPIKeyReturnsScopes(t *testing.T) {
+	ctx := context.Background()
+	service, _, userService := setupAPIKeyService(t)
+	user := createTestAPIKeyUser(t, ctx, userService, "user-scopes")
+
+	created, err := service.CreateApiKey(ctx, user.ID, apikey.CreateApiKey{
+		Name:   "ci-key",
+		Scopes: []string{apikey.ScopeProjectsDeploy, apikey.ScopeRead, apikey.ScopeProjectsDeploy},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{apikey.ScopeProjectsDeploy, apikey.ScopeRead}, created.Scopes)
+
+	_, scopes, err := service.AuthenticateApiKey(ctx, created.Key)
+	require.NoError(t, err)
+	require.True(t, apikey.ScopesAllow(scopes, apikey.RequiredScope("POST", "/api/environments/0/projects/p1/up")))
+	require.False(t, apikey.ScopesAllow(scopes, apikey.RequiredScope("DELETE", "/api/environments/0")))
+	require.False(t, apikey.ScopesAllow(scopes, apikey.RequiredScope("POST", "/api/users")))
+
+	_, err = service.UpdateApiKey(ctx, created.ApiKey.ID, apikey.UpdateApiKey{Scopes: &[]string{}})
+	require.NoError(t, err)
+	_, scopes, err = service.AuthenticateApiKey(ctx, created.Key)
+	require.NoError(t, err)
+	require.True(t, apikey.ScopesAllow(scopes, apikey.RequiredScope("POST", "/api/users")))
+
+	_, err = service.CreateApiKey(ctx, user.ID, apikey.CreateApiKey{Name: "bad", Scopes: []string{"containers:nuke"}})
+	require.ErrorIs(t, err, ErrApiKeyInvalidScope)
+}
@@ -116,6 +116,27 @@ func (s *AppriseService) SendNotification(ctx context.Context, title, body, form
 
 	case models.NotificationEventAutoHeal:
 		// No dedicated tag in AppriseSettings; notification is sent without a tag
+
+	case models.NotificationEventContainerCrash:
+		// No dedicated tag in AppriseSettings; notification is sent without a tag
+
+	case models.NotificationEventUpdateBlocked:
+		// No dedicated tag in AppriseSettings; notification is sent without a tag
+
+	case models.NotificationEventContainerTaskFailed:
+		// No dedicated tag in AppriseSettings; notification is sent without a tag
+
+	case models.NotificationEventRolloutHalted:
+		// No dedicated tag in AppriseSettings; notification is sent without a tag
+
+	case models.NotificationEventUnmanagedChange:
+		// No dedicated tag in AppriseSettings; notification is sent without a tag
+
+	case models.NotificationEventArcaneUpdate:
+		// No dedicated tag in AppriseSettings; notification is sent without a tag
+
+	case models.NotificationEventLogAlert:
+		// No dedicated tag in AppriseSettings; notification is sent without a tag
 	}
 
 	payload := AppriseNotificationPayload{
@@ -184,6 +205,50 @@ func (s *AppriseService) SendContainerUpdateNotification(ctx context.Context, co
 	return s.SendNotification(ctx, title, body, "text", models.NotificationEventContainerUpdate)
 }
 
+func (s *AppriseService) SendContainerCrashNotification(ctx context.Context, containerName, exitCode string, crashes int, window time.Duration) error {
+	title := fmt.Sprintf("Container Crash Loop: %s", containerName)
+	body := fmt.Sprintf(
+		"Container: %s\nExit Code: %s\nCrashes: %d in the last %s",
+		containerName,
+		exitCode,
+		crashes,
+		window,
+	)
+	return s.SendNotification(ctx, title, body, "text", models.NotificationEventContainerCrash)
+}
+
+func (s *AppriseService) SendUnmanagedChangeNotification(ctx context.Context, containerName, actions, image string) error {
+	title := fmt.Sprintf("Unmanaged Change Detected: %s", containerName)
+	body := fmt.Sprintf(
+		"Container: %s\nImage: %s\nChange: %s\nNo matching Arcane action was recorded",
+		containerName,
+		image,
+		actions,
+	)
+	return s.SendNotification(ctx, title, body, "text", models.NotificationEventUnmanagedChange)
+}
+
+func (s *AppriseService) SendArcaneUpdateNotification(ctx context.Context, currentVersion, newestVersion, releaseURL string) error {
+	title := fmt.Sprintf("Arcane %s Available", newestVersion)
+	body := fmt.Sprintf(
+		"Current Version: %s\nNew Version: %s\nRelease Notes: %s",
+		currentVersion,
+		newestVersion,
+		releaseURL,
+	)
+	return s.SendNotification(ctx, title, body, "text", models.NotificationEventArcaneUpdate)
+}
+
+func (s *AppriseService) SendUpdateBlockedNotification(ctx context.Context, imageRef, reason string) error {
+	title := fmt.Sprintf("Image Update Blocked: %s", imageRef)
+	body := fmt.Sprintf(
+		"Image: %s\nReason: %s\nStatus: Update not applied",
+		imageRef,
+		reason,
+	)
+	return s.SendNotification(ctx, title, body, "text", models.NotificationEventUpdateBlocked)
+}
+
 func (s *AppriseService) SendBatchImageUpdateNotification(ctx context.Context, updates map[string]*imageupdate.Response) error {
 	if len(updates) == 0 {
 		return nil
@@ -0,0 +1,664 @@
+package services
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	crand "crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/getarcaneapp/arcane/backend/internal/config"
+	"github.com/getarcaneapp/arcane/backend/internal/database"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/crypto"
+	httputils "github.com/getarcaneapp/arcane/backend/internal/utils/http"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/s3"
+	"github.com/getarcaneapp/arcane/types/backup"
+	"golang.org/x/crypto/argon2"
+	"gorm.io/gorm"
+)
+
+const (
+	backupArchiveVersion = 1
+	backupFilePrefix     = "arcane-config-"
+	backupFileExt        = ".arcbak"
+
+	defaultBackupPath      = "/app/data/backups"
+	defaultBackupRetention = 7
+
+	backupSaltSize   = 16
+	backupKeySize    = 32
+	backupArgonTime  = 3
+	backupArgonMem   = 64 * 1024
+	backupArgonLanes = 2
+)
+
+// backupMagic prefixes every archive so imports can reject foreign files early.
+var backupMagic = []byte("ARCBAK1\n")
+
+var (
+	ErrBackupPassphraseRequired = errors.New("backup passphrase is required")
+	ErrBackupInvalidArchive     = errors.New("invalid backup archive")
+	ErrBackupDecryptFailed      = errors.New("failed to decrypt backup archive: wrong passphrase or corrupted archive")
+)
+
+// backupExcludedSettings are instance-specific keys that must not be carried
+// over to another installation.
+var backupExcludedSettings = map[string]struct{}{
+	"instanceId": {},
+	"agentToken": {},
+}
+
+// backupPayload is the decrypted content of a configuration archive. Secrets
+// that are encrypted with the instance key are stored in plaintext here and
+// re-encrypted with the target instance key on import; the archive itself is
+// encrypted with a key derived from the user passphrase.
+type backupPayload struct {
+	Manifest              backup.Manifest              `json:"manifest"`
+	Settings              []models.SettingVariable     `json:"settings"`
+	NotificationProviders []backupNotificationProvider `json:"notificationProviders"`
+	Environments          []backupEnvironment          `json:"environments"`
+	Registries            []backupRegistry             `json:"registries"`
+	Projects              []backupProject              `json:"projects"`
+}
+
+type backupNotificationProvider struct {
+	Provider models.NotificationProvider `json:"provider"`
+	Enabled  bool                        `json:"enabled"`
+	Config   models.JSON                 `json:"config"`
+	// SecretKeys lists the config keys whose values were encrypted at rest.
+	SecretKeys []string `json:"secretKeys,omitempty"`
+}
+
+type backupEnvironment struct {
+	ID                     string  `json:"id"`
+	Name                   string  `json:"name"`
+	ApiUrl                 string  `json:"apiUrl"`
+	Enabled                bool    `json:"enabled"`
+	IsEdge                 bool    `json:"isEdge"`
+	AccessToken            *string `json:"accessToken,omitempty"`
+	SSHKey                 string  `json:"sshKey,omitempty"`
+	SSHHostKey             *string `json:"sshHostKey,omitempty"`
+	SSHHostKeyVerification string  `json:"sshHostKeyVerification,omitempty"`
+}
+
+type backupRegistry struct {
+	ID          string  `json:"id"`
+	URL         string  `json:"url"`
+	Username    string  `json:"username"`
+	Token       string  `json:"token"`
+	Description *string `json:"description,omitempty"`
+	Insecure    bool    `json:"insecure"`
+	Enabled     bool    `json:"enabled"`
+}
+
+type backupProject struct {
+	ID              string  `json:"id"`
+	Name            string  `json:"name"`
+	DirName         *string `json:"dirName,omitempty"`
+	Path            string  `json:"path"`
+	GitOpsManagedBy *string `json:"gitopsManagedBy,omitempty"`
+}
+
+// BackupService exports the Arcane configuration to passphrase-encrypted
+// archives, restores them, and writes scheduled backups to a local directory
+// or an S3-compatible bucket.
+type BackupService struct {
+	db              *database.DB
+	settingsService *SettingsService
+}
+
+func NewBackupService(db *database.DB, settingsService *SettingsService) *BackupService {
+	return &BackupService{
+		db:              db,
+		settingsService: settingsService,
+	}
+}
+
+// Export builds an encrypted archive of the current configuration.
+func (s *BackupService) Export(ctx context.Context, passphrase string) ([]byte, *backup.Manifest, error) {
+	if strings.TrimSpace(passphrase) == "" {
+		return nil, nil, ErrBackupPassphraseRequired
+	}
+
+	payload, err := s.collectPayloadInternal(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	plain, err := json.Marshal(payload)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encode backup: %w", err)
+	}
+
+	archive, err := sealBackupArchive(plain, passphrase)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return archive, &payload.Manifest, nil
+}
+
+// Import decrypts an archive and restores its content, replacing existing
+// items that share the same identity.
+func (s *BackupService) Import(ctx context.Context, archive []byte, passphrase string) (*backup.ImportResult, error) {
+	if strings.TrimSpace(passphrase) == "" {
+		return nil, ErrBackupPassphraseRequired
+	}
+
+	plain, err := openBackupArchive(archive, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	var payload backupPayload
+	if err := json.Unmarshal(plain, &payload); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrBackupInvalidArchive, err)
+	}
+	if payload.Manifest.Version > backupArchiveVersion {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrBackupInvalidArchive, payload.Manifest.Version)
+	}
+
+	result := &backup.ImportResult{Manifest: payload.Manifest}
+
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := s.restoreSettingsInternal(tx, payload.Settings); err != nil {
+			return err
+		}
+		if err := s.restoreNotificationProvidersInternal(tx, payload.NotificationProviders, result); err != nil {
+			return err
+		}
+		if err := s.restoreEnvironmentsInternal(tx, payload.Environments, result); err != nil {
+			return err
+		}
+		if err := s.restoreRegistriesInternal(tx, payload.Registries); err != nil {
+			return err
+		}
+		return s.restoreProjectsInternal(tx, payload.Projects)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore backup: %w", err)
+	}
+
+	if s.settingsService != nil {
+		if err := s.settingsService.LoadDatabaseSettings(ctx); err != nil {
+			return nil, fmt.Errorf("failed to reload settings after restore: %w", err)
+		}
+	}
+
+	slog.InfoContext(ctx, "Configuration restored from backup",
+		"createdAt", payload.Manifest.CreatedAt,
+		"arcaneVersion", payload.Manifest.ArcaneVersion,
+		"settings", len(payload.Settings),
+		"environments", len(payload.Environments),
+		"warnings", len(result.Warnings))
+
+	return result, nil
+}
+
+// RunScheduledBackup writes a backup to the configured destination when
+// scheduled backups are enabled.
+func (s *BackupService) RunScheduledBackup(ctx context.Context) error {
+	if !s.settingsService.GetBoolSetting(ctx, "configBackupEnabled", false) {
+		return nil
+	}
+
+	passphrase := s.settingsService.GetStringSetting(ctx, "configBackupPassphrase", "")
+	if passphrase == "" {
+		return ErrBackupPassphraseRequired
+	}
+
+	archive, manifest, err := s.Export(ctx, passphrase)
+	if err != nil {
+		return err
+	}
+	filename := backupFilePrefix + manifest.CreatedAt.UTC().Format("20060102T150405Z") + backupFileExt
+
+	destination := s.settingsService.GetStringSetting(ctx, "configBackupDestination", backup.DestinationLocal)
+	switch destination {
+	case backup.DestinationS3:
+		return s.uploadBackupInternal(ctx, filename, archive)
+	case backup.DestinationLocal, "":
+		return s.writeLocalBackupInternal(ctx, filename, archive)
+	default:
+		return fmt.Errorf("unsupported backup destination: %q", destination)
+	}
+}
+
+func (s *BackupService) writeLocalBackupInternal(ctx context.Context, filename string, archive []byte) error {
+	dir := s.settingsService.GetStringSetting(ctx, "configBackupPath", defaultBackupPath)
+	if dir == "" {
+		dir = defaultBackupPath
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("failed to create backup directory: %w", err)
+	}
+
+	target := filepath.Join(dir, filename)
+	if err := os.WriteFile(target, archive, 0o600); err != nil {
+		return fmt.Errorf("failed to write backup: %w", err)
+	}
+	slog.InfoContext(ctx, "Configuration backup written", "path", target, "bytes", len(archive))
+
+	retention := s.settingsService.GetIntSetting(ctx, "configBackupRetention", defaultBackupRetention)
+	if retention > 0 {
+		s.pruneLocalBackupsInternal(ctx, dir, retention)
+	}
+	return nil
+}
+
+// pruneLocalBackupsInternal keeps the newest retention archives in dir. File
+// names embed a sortable timestamp, so lexical order is chronological.
+func (s *BackupService) pruneLocalBackupsInternal(ctx context.Context, dir string, retention int) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to list backup directory", "path", dir, "error", err)
+		return
+	}
+
+	var archives []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.Type().IsRegular() && strings.HasPrefix(name, backupFilePrefix) && strings.HasSuffix(name, backupFileExt) {
+			archives = append(archives, name)
+		}
+	}
+	if len(archives) <= retention {
+		return
+	}
+
+	sort.Strings(archives)
+	for _, name := range archives[:len(archives)-retention] {
+		if err := os.Remove(filepath.Join(dir, name)); err != nil {
+			slog.WarnContext(ctx, "Failed to remove old backup", "file", name, "error", err)
+		}
+	}
+}
+
+func (s *BackupService) uploadBackupInternal(ctx context.Context, filename string, archive []byte) error {
+	uploader, err := s3.NewUploader(s3.Config{
+		Endpoint:        s.settingsService.GetStringSetting(ctx, "configBackupS3Endpoint", ""),
+		Region:          s.settingsService.GetStringSetting(ctx, "configBackupS3Region", ""),
+		Bucket:          s.settingsService.GetStringSetting(ctx, "configBackupS3Bucket", ""),
+		AccessKeyID:     s.settingsService.GetStringSetting(ctx, "configBackupS3AccessKey", ""),
+		SecretAccessKey: s.settingsService.GetStringSetting(ctx, "configBackupS3SecretKey", ""),
+	}, httputils.NewHTTPClientWithTimeout(2*time.Minute))
+	if err != nil {
+		return err
+	}
+
+	key := filename
+	if prefix := strings.Trim(s.settingsService.GetStringSetting(ctx, "configBackupS3Prefix", ""), "/"); prefix != "" {
+		key = prefix + "/" + filename
+	}
+	if err := uploader.PutObject(ctx, key, archive, "application/octet-stream"); err != nil {
+		return err
+	}
+	slog.InfoContext(ctx, "Configuration backup uploaded", "key", key, "bytes", len(archive))
+	return nil
+}
+
+func (s *BackupService) collectPayloadInternal(ctx context.Context) (*backupPayload, error) {
+	db := s.db.WithContext(ctx)
+	payload := &backupPayload{}
+
+	var settingRows []models.SettingVariable
+	if err := db.Order("key").Find(&settingRows).Error; err != nil {
+		return nil, fmt.Errorf("failed to read settings: %w", err)
+	}
+	for _, row := range settingRows {
+		if _, skip := backupExcludedSettings[row.Key]; skip {
+			continue
+		}
+		payload.Settings = append(payload.Settings, row)
+	}
+
+	var providers []models.NotificationSettings
+	if err := db.Order("provider").Find(&providers).Error; err != nil {
+		return nil, fmt.Errorf("failed to read notification providers: %w", err)
+	}
+	for _, p := range providers {
+		cfg, secretKeys := decryptNotificationSecrets(p.Config)
+		payload.NotificationProviders = append(payload.NotificationProviders, backupNotificationProvider{
+			Provider:   p.Provider,
+			Enabled:    p.Enabled,
+			Config:     cfg,
+			SecretKeys: secretKeys,
+		})
+	}
+
+	var envs []models.Environment
+	if err := db.Where("id != ?", "0").Order("name").Find(&envs).Error; err != nil {
+		return nil, fmt.Errorf("failed to read environments: %w", err)
+	}
+	for _, env := range envs {
+		item := backupEnvironment{
+			ID:                     env.ID,
+			Name:                   env.Name,
+			ApiUrl:                 env.ApiUrl,
+			Enabled:                env.Enabled,
+			IsEdge:                 env.IsEdge,
+			AccessToken:            env.AccessToken,
+			SSHHostKey:             env.SSHHostKey,
+			SSHHostKeyVerification: env.SSHHostKeyVerification,
+		}
+		if env.SSHKey != nil && *env.SSHKey != "" {
+			key, err := crypto.Decrypt(*env.SSHKey)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decrypt SSH key of environment %s: %w", env.Name, err)
+			}
+			item.SSHKey = key
+		}
+		payload.Environments = append(payload.Environments, item)
+	}
+
+	var registries []models.ContainerRegistry
+	if err := db.Order("url").Find(&registries).Error; err != nil {
+		return nil, fmt.Errorf("failed to read registries: %w", err)
+	}
+	for _, reg := range registries {
+		token, err := crypto.Decrypt(reg.Token)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt token of registry %s: %w", reg.URL, err)
+		}
+		payload.Registries = append(payload.Registries, backupRegistry{
+			ID:          reg.ID,
+			URL:         reg.URL,
+			Username:    reg.Username,
+			Token:       token,
+			Description: reg.Description,
+			Insecure:    reg.Insecure,
+			Enabled:     reg.Enabled,
+		})
+	}
+
+	var projects []models.Project
+	if err := db.Order("name").Find(&projects).Error; err != nil {
+		return nil, fmt.Errorf("failed to read projects: %w", err)
+	}
+	for _, p := range projects {
+		payload.Projects = append(payload.Projects, backupProject{
+			ID:              p.ID,
+			Name:            p.Name,
+			DirName:         p.DirName,
+			Path:            p.Path,
+			GitOpsManagedBy: p.GitOpsManagedBy,
+		})
+	}
+
+	payload.Manifest = backup.Manifest{
+		Version:               backupArchiveVersion,
+		ArcaneVersion:         config.Version,
+		CreatedAt:             time.Now().UTC(),
+		Settings:              len(payload.Settings),
+		NotificationProviders: len(payload.NotificationProviders),
+		Environments:          len(payload.Environments),
+		Registries:            len(payload.Registries),
+		Projects:              len(payload.Projects),
+	}
+
+	return payload, nil
+}
+
+func (s *BackupService) restoreSettingsInternal(tx *gorm.DB, settings []models.SettingVariable) error {
+	for _, setting := range settings {
+		if _, skip := backupExcludedSettings[setting.Key]; skip {
+			continue
+		}
+		if err := tx.Save(&models.SettingVariable{Key: setting.Key, Value: setting.Value}).Error; err != nil {
+			return fmt.Errorf("failed to restore setting %s: %w", setting.Key, err)
+		}
+	}
+	return nil
+}
+
+func (s *BackupService) restoreNotificationProvidersInternal(tx *gorm.DB, providers []backupNotificationProvider, result *backup.ImportResult) error {
+	for _, p := range providers {
+		if !models.IsValidNotificationProvider(p.Provider) {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("skipped unknown notification provider %q", p.Provider))
+			continue
+		}
+
+		cfg, err := encryptNotificationSecrets(p.Config, p.SecretKeys)
+		if err != nil {
+			return fmt.Errorf("failed to encrypt secrets of notification provider %s: %w", p.Provider, err)
+		}
+
+		var existing models.NotificationSettings
+		err = tx.Where("provider = ?", p.Provider).First(&existing).Error
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			existing = models.NotificationSettings{Provider: p.Provider}
+		case err != nil:
+			return fmt.Errorf("failed to load notification provider %s: %w", p.Provider, err)
+		}
+		existing.Enabled = p.Enabled
+		existing.Config = cfg
+
+		if err := tx.Save(&existing).Error; err != nil {
+			return fmt.Errorf("failed to restore notification provider %s: %w", p.Provider, err)
+		}
+	}
+	return nil
+}
+
+func (s *BackupService) restoreEnvironmentsInternal(tx *gorm.DB, envs []backupEnvironment, result *backup.ImportResult) error {
+	for _, item := range envs {
+		if item.ID == "" || item.ID == "0" {
+			continue
+		}
+
+		env := models.Environment{
+			Name:                   item.Name,
+			ApiUrl:                 item.ApiUrl,
+			Status:                 string(models.EnvironmentStatusOffline),
+			Enabled:                item.Enabled,
+			IsEdge:                 item.IsEdge,
+			AccessToken:            item.AccessToken,
+			SSHHostKey:             item.SSHHostKey,
+			SSHHostKeyVerification: item.SSHHostKeyVerification,
+			BaseModel:              models.BaseModel{ID: item.ID},
+		}
+		if env.SSHHostKeyVerification == "" {
+			env.SSHHostKeyVerification = SSHHostKeyVerificationAcceptNew
+		}
+		if item.SSHKey != "" {
+			encrypted, err := EncryptSSHKey(item.SSHKey)
+			if err != nil {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("environment %s: SSH key not restored: %v", item.Name, err))
+			} else {
+				env.SSHKey = encrypted
+			}
+		}
+
+		if err := tx.Save(&env).Error; err != nil {
+			return fmt.Errorf("failed to restore environment %s: %w", item.Name, err)
+		}
+	}
+	return nil
+}
+
+func (s *BackupService) restoreRegistriesInternal(tx *gorm.DB, registries []backupRegistry) error {
+	for _, item := range registries {
+		token, err := crypto.Encrypt(item.Token)
+		if err != nil {
+			return fmt.Errorf("failed to encrypt token of registry %s: %w", item.URL, err)
+		}
+
+		reg := models.ContainerRegistry{
+			URL:         item.URL,
+			Username:    item.Username,
+			Token:       token,
+			Description: item.Description,
+			Insecure:    item.Insecure,
+			Enabled:     item.Enabled,
+			BaseModel:   models.BaseModel{ID: item.ID},
+		}
+		if err := tx.Save(&reg).Error; err != nil {
+			return fmt.Errorf("failed to restore registry %s: %w", item.URL, err)
+		}
+	}
+	return nil
+}
+
+func (s *BackupService) restoreProjectsInternal(tx *gorm.DB, projects []backupProject) error {
+	for _, item := range projects {
+		var existing models.Project
+		err := tx.Where("id = ?", item.ID).First(&existing).Error
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			existing = models.Project{
+				Status:    models.ProjectStatusUnknown,
+				BaseModel: models.BaseModel{ID: item.ID},
+			}
+		case err != nil:
+			return fmt.Errorf("failed to load project %s: %w", item.Name, err)
+		}
+
+		existing.Name = item.Name
+		existing.DirName = item.DirName
+		existing.Path = item.Path
+		existing.GitOpsManagedBy = item.GitOpsManagedBy
+
+		if err := tx.Save(&existing).Error; err != nil {
+			return fmt.Errorf("failed to restore project %s: %w", item.Name, err)
+		}
+	}
+	return nil
+}
+
+// decryptNotificationSecrets returns a copy of a provider config with every
+// top-level value that was encrypted with the instance key decrypted, plus
+// the list of keys that were secrets.
+func decryptNotificationSecrets(cfg models.JSON) (models.JSON, []string) {
+	out := make(models.JSON, len(cfg))
+	var secretKeys []string
+	for key, value := range cfg {
+		out[key] = value
+		str, ok := value.(string)
+		if !ok || str == "" {
+			continue
+		}
+		// AES-GCM authentication makes a successful decrypt a reliable signal
+		// that the value was stored encrypted.
+		if plain, err := crypto.Decrypt(str); err == nil {
+			out[key] = plain
+			secretKeys = append(secretKeys, key)
+		}
+	}
+	sort.Strings(secretKeys)
+	return out, secretKeys
+}
+
+func encryptNotificationSecrets(cfg models.JSON, secretKeys []string) (models.JSON, error) {
+	out := make(models.JSON, len(cfg))
+	for key, value := range cfg {
+		out[key] = value
+	}
+	for _, key := range secretKeys {
+		str, ok := out[key].(string)
+		if !ok || str == "" {
+			continue
+		}
+		encrypted, err := crypto.Encrypt(str)
+		if err != nil {
+			return nil, err
+		}
+		out[key] = encrypted
+	}
+	return out, nil
+}
+
+// sealBackupArchive gzips and encrypts plain with a key derived from
+// passphrase. Layout: magic | salt | nonce | AES-GCM ciphertext.
+func sealBackupArchive(plain []byte, passphrase string) ([]byte, error) {
+	var compressed bytes.Buffer
+	zw := gzip.NewWriter(&compressed)
+	if _, err := zw.Write(plain); err != nil {
+		return nil, fmt.Errorf("failed to compress backup: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to compress backup: %w", err)
+	}
+
+	salt := make([]byte, backupSaltSize)
+	if _, err := io.ReadFull(crand.Reader, salt); err != nil {
+		return nil, fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	gcm, err := newBackupCipher(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(crand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	out := make([]byte, 0, len(backupMagic)+len(salt)+len(nonce)+compressed.Len()+gcm.Overhead())
+	out = append(out, backupMagic...)
+	out = append(out, salt...)
+	out = append(out, nonce...)
+	return gcm.Seal(out, nonce, compressed.Bytes(), backupMagic), nil
+}
+
+func openBackupArchive(archive []byte, passphrase string) ([]byte, error) {
+	if !bytes.HasPrefix(archive, backupMagic) {
+		return nil, ErrBackupInvalidArchive
+	}
+	rest := archive[len(backupMagic):]
+	if len(rest) < backupSaltSize {
+		return nil, ErrBackupInvalidArchive
+	}
+	salt, rest := rest[:backupSaltSize], rest[backupSaltSize:]
+
+	gcm, err := newBackupCipher(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) < gcm.NonceSize() {
+		return nil, ErrBackupInvalidArchive
+	}
+	nonce, ciphertext := rest[:gcm.NonceSize()], rest[gcm.NonceSize():]
+
+	compressed, err := gcm.Open(nil, nonce, ciphertext, backupMagic)
+	if err != nil {
+		return nil, ErrBackupDecryptFailed
+	}
+
+	zr, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrBackupInvalidArchive, err)
+	}
+	defer func() { _ = zr.Close() }()
+
+	plain, err := io.ReadAll(zr)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrBackupInvalidArchive, err)
+	}
+	return plain, nil
+}
+
+func newBackupCipher(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key := argon2.IDKey([]byte(passphrase), salt, backupArgonTime, backupArgonMem, backupArgonLanes, backupKeySize)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCM: %w", err)
+	}
+	return gcm, nil
+}
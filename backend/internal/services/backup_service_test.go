@@ -0,0 +1,97 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	glsqlite "github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/getarcaneapp/arcane/backend/internal/config"
+	"github.com/getarcaneapp/arcane/backend/internal/database"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/crypto"
+)
+
+func setupBackupServiceTest(t *testing.T) (*BackupService, *gorm.DB) {
+	t.Helper()
+	ctx := context.Background()
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared", strings.NewReplacer("/", "_", " ", "_").Replace(t.Name()))
+	db, err := gorm.Open(glsqlite.Open(dsn), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(
+		&models.SettingVariable{},
+		&models.NotificationSettings{},
+		&models.Environment{},
+		&models.ContainerRegistry{},
+		&models.Project{},
+	))
+
+	crypto.InitEncryption(&config.Config{
+		EncryptionKey: "test-encryption-key-for-testing-32bytes-min",
+		Environment:   "test",
+	})
+
+	settingsSvc, err := NewSettingsService(ctx, &database.DB{DB: db})
+	require.NoError(t, err)
+	require.NoError(t, settingsSvc.EnsureDefaultSettings(ctx))
+
+	return NewBackupService(&database.DB{DB: db}, settingsSvc), db
+}
+
+func TestBackupService_ExportImportRoundTrip(t *testing.T) {
+	svc, db := setupBackupServiceTest(t)
+	ctx := context.Background()
+
+	token, err := crypto.Encrypt("registry-secret")
+	require.NoError(t, err)
+	require.NoError(t, db.Create(&models.ContainerRegistry{URL: "ghcr.io", Username: "bot", Token: token, Enabled: true, BaseModel: models.BaseModel{ID: "reg-1"}}).Error)
+
+	botToken, err := crypto.Encrypt("telegram-secret")
+	require.NoError(t, err)
+	require.NoError(t, db.Create(&models.NotificationSettings{Provider: models.NotificationProviderTelegram, Enabled: true, Config: models.JSON{"botToken": botToken, "chatIds": "42"}}).Error)
+
+	require.NoError(t, db.Save(&models.SettingVariable{Key: "baseServerUrl", Value: "https://arcane.example.com"}).Error)
+
+	archive, manifest, err := svc.Export(ctx, "correct horse")
+	require.NoError(t, err)
+	require.Equal(t, 1, manifest.Registries)
+	require.Equal(t, 1, manifest.NotificationProviders)
+	require.NotContains(t, string(archive), "registry-secret")
+
+	_, err = svc.Import(ctx, archive, "wrong passphrase")
+	require.ErrorIs(t, err, ErrBackupDecryptFailed)
+
+	require.NoError(t, db.Where("1 = 1").Delete(&models.ContainerRegistry{}).Error)
+	require.NoError(t, db.Where("1 = 1").Delete(&models.NotificationSettings{}).Error)
+	require.NoError(t, db.Save(&models.SettingVariable{Key: "baseServerUrl", Value: "http://localhost"}).Error)
+
+	result, err := svc.Import(ctx, archive, "correct horse")
+	require.NoError(t, err)
+	require.Empty(t, result.Warnings)
+
+	var reg models.ContainerRegistry
+	require.NoError(t, db.First(&reg, "id = ?", "reg-1").Error)
+	plain, err := crypto.Decrypt(reg.Token)
+	require.NoError(t, err)
+	require.Equal(t, "registry-secret", plain)
+
+	var provider models.NotificationSettings
+	require.NoError(t, db.First(&provider, "provider = ?", models.NotificationProviderTelegram).Error)
+	plain, err = crypto.Decrypt(provider.Config["botToken"].(string))
+	require.NoError(t, err)
+	require.Equal(t, "telegram-secret", plain)
+	require.Equal(t, "42", provider.Config["chatIds"])
+
+	require.Equal(t, "https://arcane.example.com", svc.settingsService.GetStringSetting(ctx, "baseServerUrl", ""))
+}
+
+func TestBackupService_ImportRejectsForeignArchive(t *testing.T) {
+	svc, _ := setupBackupServiceTest(t)
+
+	_, err := svc.Import(context.Background(), []byte("not an archive"), "passphrase")
+	require.ErrorIs(t, err, ErrBackupInvalidArchive)
+}
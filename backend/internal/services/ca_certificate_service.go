@@ -0,0 +1,142 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/getarcaneapp/arcane/backend/internal/database"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/mapper"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/truststore"
+	"github.com/getarcaneapp/arcane/types/cacertificate"
+)
+
+// CACertificateService manages custom CA certificates and keeps the outbound
+// trust store in sync with them, so internal registries and notification
+// servers with private certificates can be verified.
+type CACertificateService struct {
+	db *database.DB
+}
+
+func NewCACertificateService(db *database.DB) *CACertificateService {
+	return &CACertificateService{db: db}
+}
+
+func (s *CACertificateService) ListCertificates(ctx context.Context) ([]cacertificate.CACertificate, error) {
+	var rows []models.CACertificate
+	if err := s.db.WithContext(ctx).Order("name ASC").Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to list CA certificates: %w", err)
+	}
+
+	out, err := mapper.MapSlice[models.CACertificate, cacertificate.CACertificate](rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to map CA certificates: %w", err)
+	}
+	return out, nil
+}
+
+func (s *CACertificateService) CreateCertificate(ctx context.Context, req cacertificate.CreateRequest) (*cacertificate.CACertificate, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, &models.ValidationError{Message: "certificate name is required", Field: "name"}
+	}
+
+	cert, err := parseSingleCertificateInternal(req.Certificate)
+	if err != nil {
+		return nil, &models.ValidationError{Message: err.Error(), Field: "certificate"}
+	}
+	fingerprint := certificateFingerprintInternal(cert)
+
+	var count int64
+	if err := s.db.WithContext(ctx).Model(&models.CACertificate{}).Where("name = ? OR fingerprint = ?", name, fingerprint).Count(&count).Error; err != nil {
+		return nil, fmt.Errorf("failed to check CA certificate: %w", err)
+	}
+	if count > 0 {
+		return nil, &models.ConflictError{Message: fmt.Sprintf("a certificate named %q or with the same fingerprint already exists", name)}
+	}
+
+	row := &models.CACertificate{
+		Name:        name,
+		Certificate: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})),
+		Subject:     cert.Subject.String(),
+		Fingerprint: fingerprint,
+		NotAfter:    cert.NotAfter,
+	}
+	if err := s.db.WithContext(ctx).Create(row).Error; err != nil {
+		return nil, fmt.Errorf("failed to create CA certificate: %w", err)
+	}
+
+	if err := s.LoadTrustStore(ctx); err != nil {
+		return nil, err
+	}
+
+	out, err := mapper.MapOne[*models.CACertificate, cacertificate.CACertificate](row)
+	if err != nil {
+		return nil, fmt.Errorf("failed to map CA certificate: %w", err)
+	}
+	return &out, nil
+}
+
+func (s *CACertificateService) DeleteCertificate(ctx context.Context, id string) error {
+	result := s.db.WithContext(ctx).Where("id = ?", id).Delete(&models.CACertificate{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete CA certificate: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return &models.NotFoundError{Message: "CA certificate not found"}
+	}
+
+	return s.LoadTrustStore(ctx)
+}
+
+// LoadTrustStore replaces the custom CAs in the outbound trust store with the
+// stored certificates.
+func (s *CACertificateService) LoadTrustStore(ctx context.Context) error {
+	var rows []models.CACertificate
+	if err := s.db.WithContext(ctx).Find(&rows).Error; err != nil {
+		return fmt.Errorf("failed to load CA certificates: %w", err)
+	}
+
+	certs := make([]*x509.Certificate, 0, len(rows))
+	for _, row := range rows {
+		cert, err := parseSingleCertificateInternal(row.Certificate)
+		if err != nil {
+			slog.WarnContext(ctx, "Skipping unreadable CA certificate", "name", row.Name, "error", err)
+			continue
+		}
+		certs = append(certs, cert)
+	}
+
+	truststore.SetCustomCAs(certs)
+	return nil
+}
+
+// parseSingleCertificateInternal decodes exactly one PEM certificate.
+func parseSingleCertificateInternal(data string) (*x509.Certificate, error) {
+	rest := bytes.TrimSpace([]byte(data))
+	block, rest := pem.Decode(rest)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("certificate must be PEM encoded")
+	}
+	if next, _ := pem.Decode(bytes.TrimSpace(rest)); next != nil {
+		return nil, fmt.Errorf("upload one certificate at a time")
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid certificate: %w", err)
+	}
+	return cert, nil
+}
+
+func certificateFingerprintInternal(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,91 @@
+package services
+
+import (
+	"context"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	glsqlite "github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/getarcaneapp/arcane/backend/internal/database"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/truststore"
+	"github.com/getarcaneapp/arcane/types/cacertificate"
+)
+
+func setupCACertificateService(t *testing.T) *CACertificateService {
+	t.Helper()
+
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared", strings.NewReplacer("/", "_", " ", "_").Replace(t.Name()))
+	db, err := gorm.Open(glsqlite.Open(dsn), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.CACertificate{}))
+
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+	sqlDB.SetMaxIdleConns(1)
+
+	t.Cleanup(func() { truststore.SetCustomCAs(nil) })
+	return NewCACertificateService(&database.DB{DB: db})
+}
+
+func testCACertificatePEM(t *testing.T) string {
+	t.Helper()
+
+	srv := httptest.NewTLSServer(nil)
+	t.Cleanup(srv.Close)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}))
+}
+
+func TestCACertificateService_CreateListDelete(t *testing.T) {
+	svc := setupCACertificateService(t)
+	ctx := context.Background()
+
+	created, err := svc.CreateCertificate(ctx, cacertificate.CreateRequest{Name: " internal ", Certificate: testCACertificatePEM(t)})
+	require.NoError(t, err)
+	require.Equal(t, "internal", created.Name)
+	require.Len(t, created.Fingerprint, 64)
+	require.NotEmpty(t, created.Subject)
+	require.NotNil(t, truststore.RootCAs(), "trust store should include the new certificate")
+
+	list, err := svc.ListCertificates(ctx)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+
+	require.NoError(t, svc.DeleteCertificate(ctx, created.ID))
+	require.Nil(t, truststore.RootCAs())
+
+	var notFound *models.NotFoundError
+	require.True(t, errors.As(svc.DeleteCertificate(ctx, created.ID), &notFound))
+}
+
+func TestCACertificateService_CreateValidation(t *testing.T) {
+	svc := setupCACertificateService(t)
+	ctx := context.Background()
+	certPEM := testCACertificatePEM(t)
+
+	var validationErr *models.ValidationError
+	_, err := svc.CreateCertificate(ctx, cacertificate.CreateRequest{Name: "bad", Certificate: "not a certificate"})
+	require.True(t, errors.As(err, &validationErr))
+
+	_, err = svc.CreateCertificate(ctx, cacertificate.CreateRequest{Name: "bundle", Certificate: certPEM + testCACertificatePEM(t)})
+	require.True(t, errors.As(err, &validationErr))
+	require.Contains(t, err.Error(), "one certificate")
+
+	_, err = svc.CreateCertificate(ctx, cacertificate.CreateRequest{Name: "", Certificate: certPEM})
+	require.True(t, errors.As(err, &validationErr))
+
+	_, err = svc.CreateCertificate(ctx, cacertificate.CreateRequest{Name: "first", Certificate: certPEM})
+	require.NoError(t, err)
+
+	var conflictErr *models.ConflictError
+	_, err = svc.CreateCertificate(ctx, cacertificate.CreateRequest{Name: "second", Certificate: certPEM})
+	require.True(t, errors.As(err, &conflictErr), "duplicate fingerprint should conflict")
+}
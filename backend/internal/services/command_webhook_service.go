@@ -0,0 +1,461 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"mime"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/getarcaneapp/arcane/backend/internal/database"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/types/commandwebhook"
+	"github.com/getarcaneapp/arcane/types/system"
+	"github.com/moby/moby/client"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrCommandWebhookNotFound       = errors.New("command webhook not found")
+	ErrCommandWebhookInvalid        = errors.New("invalid command webhook")
+	ErrCommandWebhookUnauthorized   = errors.New("invalid or disabled webhook token")
+	ErrCommandWebhookForbidden      = errors.New("action is not allowed for this webhook")
+	ErrCommandWebhookInvalidCommand = errors.New("invalid webhook command")
+	ErrCommandWebhookTargetNotFound = errors.New("webhook command target not found")
+	ErrCommandWebhookBusy           = errors.New("the same command is still running")
+)
+
+const (
+	commandWebhookTokenPrefix    = "arcwh_"
+	commandWebhookTokenLength    = 32
+	commandWebhookTokenPrefixLen = 8
+)
+
+// commandWebhookAliases are the short verbs accepted in chat commands such as
+// "/arcane redeploy web".
+var commandWebhookAliases = map[string]string{
+	"redeploy":      commandwebhook.ActionProjectRedeploy,
+	"update":        commandwebhook.ActionContainerUpdate,
+	"apply-updates": commandwebhook.ActionUpdaterApply,
+	"prune":         commandwebhook.ActionSystemPrune,
+}
+
+// CommandWebhookService manages inbound webhooks that run an allowlisted set
+// of actions, and runs the commands they receive.
+type CommandWebhookService struct {
+	db              *database.DB
+	userService     *UserService
+	projectService  *ProjectService
+	updaterService  *UpdaterService
+	systemService   *SystemService
+	settingsService *SettingsService
+	dockerService   *DockerClientService
+	eventService    *EventService
+	running         sync.Map // action and target -> struct{}; prevents overlapping runs
+}
+
+func NewCommandWebhookService(db *database.DB, userService *UserService, projectService *ProjectService, updaterService *UpdaterService, systemService *SystemService, settingsService *SettingsService, dockerService *DockerClientService, eventService *EventService) *CommandWebhookService {
+	return &CommandWebhookService{
+		db:              db,
+		userService:     userService,
+		projectService:  projectService,
+		updaterService:  updaterService,
+		systemService:   systemService,
+		settingsService: settingsService,
+		dockerService:   dockerService,
+		eventService:    eventService,
+	}
+}
+
+func (s *CommandWebhookService) ListWebhooks(ctx context.Context) ([]commandwebhook.Webhook, error) {
+	var hooks []models.CommandWebhook
+	if err := s.db.WithContext(ctx).Order("name ASC").Find(&hooks).Error; err != nil {
+		return nil, fmt.Errorf("failed to list command webhooks: %w", err)
+	}
+
+	result := make([]commandwebhook.Webhook, len(hooks))
+	for i := range hooks {
+		result[i] = toCommandWebhookDto(&hooks[i])
+	}
+	return result, nil
+}
+
+// CreateWebhook creates a webhook owned by userID. The returned token is not
+// stored and cannot be retrieved later.
+func (s *CommandWebhookService) CreateWebhook(ctx context.Context, userID string, req commandwebhook.CreateWebhook) (*commandwebhook.WebhookCreated, error) {
+	actions, err := normalizeCommandWebhookActions(req.Actions)
+	if err != nil {
+		return nil, err
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, fmt.Errorf("%w: name is required", ErrCommandWebhookInvalid)
+	}
+
+	bytes := make([]byte, commandWebhookTokenLength)
+	if _, err := rand.Read(bytes); err != nil {
+		return nil, fmt.Errorf("failed to generate webhook token: %w", err)
+	}
+	token := commandWebhookTokenPrefix + hex.EncodeToString(bytes)
+	tokenHash, err := s.userService.HashPassword(token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash webhook token: %w", err)
+	}
+
+	hook := &models.CommandWebhook{
+		Name:        name,
+		TokenHash:   tokenHash,
+		TokenPrefix: token[:len(commandWebhookTokenPrefix)+commandWebhookTokenPrefixLen],
+		Actions:     actions,
+		UserID:      userID,
+		Enabled:     req.Enabled == nil || *req.Enabled,
+	}
+	if err := s.db.WithContext(ctx).Create(hook).Error; err != nil {
+		return nil, fmt.Errorf("failed to create command webhook: %w", err)
+	}
+
+	return &commandwebhook.WebhookCreated{Webhook: toCommandWebhookDto(hook), Token: token}, nil
+}
+
+func (s *CommandWebhookService) UpdateWebhook(ctx context.Context, id string, req commandwebhook.UpdateWebhook) (*commandwebhook.Webhook, error) {
+	hook, err := s.getWebhookModel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		hook.Name = strings.TrimSpace(*req.Name)
+		if hook.Name == "" {
+			return nil, fmt.Errorf("%w: name is required", ErrCommandWebhookInvalid)
+		}
+	}
+	if req.Actions != nil {
+		if hook.Actions, err = normalizeCommandWebhookActions(req.Actions); err != nil {
+			return nil, err
+		}
+	}
+	if req.Enabled != nil {
+		hook.Enabled = *req.Enabled
+	}
+
+	if err := s.db.WithContext(ctx).Save(hook).Error; err != nil {
+		return nil, fmt.Errorf("failed to update command webhook: %w", err)
+	}
+
+	dto := toCommandWebhookDto(hook)
+	return &dto, nil
+}
+
+func (s *CommandWebhookService) DeleteWebhook(ctx context.Context, id string) error {
+	result := s.db.WithContext(ctx).Delete(&models.CommandWebhook{}, "id = ?", id)
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete command webhook: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrCommandWebhookNotFound
+	}
+	return nil
+}
+
+// ParseCommandWebhookRequest reads the command from a webhook request body.
+// JSON bodies carry an action and target; form bodies may do the same or,
+// as Slack slash commands do, send the command as text like "redeploy web".
+func ParseCommandWebhookRequest(contentType string, body []byte) (commandwebhook.Command, error) {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if mediaType == "application/x-www-form-urlencoded" {
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return commandwebhook.Command{}, fmt.Errorf("%w: %v", ErrCommandWebhookInvalidCommand, err)
+		}
+		if action := values.Get("action"); action != "" {
+			return commandwebhook.Command{Action: action, Target: values.Get("target")}, nil
+		}
+		return parseCommandWebhookTextInternal(values.Get("text"))
+	}
+
+	var cmd commandwebhook.Command
+	if err := json.Unmarshal(body, &cmd); err != nil {
+		return commandwebhook.Command{}, fmt.Errorf("%w: %v", ErrCommandWebhookInvalidCommand, err)
+	}
+	return cmd, nil
+}
+
+// parseCommandWebhookTextInternal splits "<action> [target]", where action is
+// an action name or one of its short aliases.
+func parseCommandWebhookTextInternal(text string) (commandwebhook.Command, error) {
+	fields := strings.Fields(text)
+	if len(fields) == 0 || len(fields) > 2 {
+		return commandwebhook.Command{}, fmt.Errorf("%w: expected \"<action> [target]\"", ErrCommandWebhookInvalidCommand)
+	}
+	action := strings.ToLower(fields[0])
+	if alias, ok := commandWebhookAliases[action]; ok {
+		action = alias
+	}
+	cmd := commandwebhook.Command{Action: action}
+	if len(fields) == 2 {
+		cmd.Target = fields[1]
+	}
+	return cmd, nil
+}
+
+// commandWebhookRun performs a prepared command and describes its outcome.
+type commandWebhookRun func(ctx context.Context) (string, error)
+
+// Trigger checks the token and the webhook's allowlist, then starts the
+// command in the background. Chat platforms expect a reply within a few
+// seconds, so the outcome is recorded as an event instead of returned.
+func (s *CommandWebhookService) Trigger(ctx context.Context, token string, cmd commandwebhook.Command) (*commandwebhook.TriggerResult, error) {
+	hook, err := s.authenticateInternal(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd.Action = strings.TrimSpace(cmd.Action)
+	cmd.Target = strings.TrimPrefix(strings.TrimSpace(cmd.Target), "/")
+	if !commandwebhook.IsValidAction(cmd.Action) {
+		return nil, fmt.Errorf("%w: unknown action %q", ErrCommandWebhookInvalidCommand, cmd.Action)
+	}
+	if !slices.Contains(hook.Actions, cmd.Action) {
+		return nil, fmt.Errorf("%w: %s", ErrCommandWebhookForbidden, cmd.Action)
+	}
+	switch needsTarget := commandwebhook.ActionNeedsTarget(cmd.Action); {
+	case needsTarget && cmd.Target == "":
+		return nil, fmt.Errorf("%w: %s needs a target", ErrCommandWebhookInvalidCommand, cmd.Action)
+	case !needsTarget && cmd.Target != "":
+		return nil, fmt.Errorf("%w: %s does not take a target", ErrCommandWebhookInvalidCommand, cmd.Action)
+	}
+
+	// Actions run as the webhook's owner, who must still be an admin.
+	user, err := s.userService.GetUserByID(ctx, hook.UserID)
+	if errors.Is(err, ErrUserNotFound) {
+		return nil, fmt.Errorf("%w: the webhook owner no longer exists", ErrCommandWebhookUnauthorized)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load webhook owner: %w", err)
+	}
+	if !hasRole(user.Roles, "admin") {
+		return nil, fmt.Errorf("%w: the webhook owner is no longer an admin", ErrCommandWebhookUnauthorized)
+	}
+
+	run, targetName, err := s.prepareInternal(ctx, cmd, *user)
+	if err != nil {
+		return nil, err
+	}
+
+	key := cmd.Action + ":" + targetName
+	if _, busy := s.running.LoadOrStore(key, struct{}{}); busy {
+		return nil, ErrCommandWebhookBusy
+	}
+
+	now := time.Now()
+	if err := s.db.WithContext(ctx).Model(&models.CommandWebhook{}).Where("id = ?", hook.ID).Update("last_used_at", now).Error; err != nil {
+		slog.WarnContext(ctx, "Failed to record command webhook use", "webhook", hook.Name, "error", err)
+	}
+
+	slog.InfoContext(ctx, "Command webhook triggered", "webhook", hook.Name, "action", cmd.Action, "target", targetName)
+
+	// The command outlives the request that started it.
+	runCtx := context.WithoutCancel(ctx)
+	go func() {
+		defer s.running.Delete(key)
+		summary, err := run(runCtx)
+		s.recordResultInternal(runCtx, hook, user, cmd.Action, targetName, summary, err)
+	}()
+
+	text := "Started " + cmd.Action
+	if targetName != "" {
+		text += " for " + targetName
+	}
+	return &commandwebhook.TriggerResult{
+		Action:       cmd.Action,
+		Target:       targetName,
+		Text:         text,
+		ResponseType: "in_channel",
+	}, nil
+}
+
+// prepareInternal resolves the command's target so unknown projects and
+// containers are reported to the caller, and returns the work to run.
+func (s *CommandWebhookService) prepareInternal(ctx context.Context, cmd commandwebhook.Command, user models.User) (commandWebhookRun, string, error) {
+	switch cmd.Action {
+	case commandwebhook.ActionProjectRedeploy:
+		var proj models.Project
+		if err := s.db.WithContext(ctx).Where("id = ? OR name = ?", cmd.Target, cmd.Target).First(&proj).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, "", fmt.Errorf("%w: project %q", ErrCommandWebhookTargetNotFound, cmd.Target)
+			}
+			return nil, "", fmt.Errorf("failed to find project: %w", err)
+		}
+		return func(ctx context.Context) (string, error) {
+			if err := s.projectService.RedeployProject(ctx, proj.ID, user); err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("Project %s was redeployed", proj.Name), nil
+		}, proj.Name, nil
+
+	case commandwebhook.ActionContainerUpdate:
+		dockerClient, err := s.dockerService.GetClient(ctx)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to connect to Docker: %w", err)
+		}
+		inspect, err := dockerClient.ContainerInspect(ctx, cmd.Target, client.ContainerInspectOptions{})
+		if err != nil {
+			return nil, "", fmt.Errorf("%w: container %q", ErrCommandWebhookTargetNotFound, cmd.Target)
+		}
+		name := strings.TrimPrefix(inspect.Container.Name, "/")
+		return func(ctx context.Context) (string, error) {
+			result, err := s.updaterService.UpdateSingleContainer(WithAutoUpdateTrigger(ctx, models.AutoUpdateTriggerWebhook), inspect.Container.ID)
+			if err != nil {
+				return "", err
+			}
+			if result.Updated == 0 {
+				return fmt.Sprintf("Container %s is already up to date", name), nil
+			}
+			return fmt.Sprintf("Container %s was updated", name), nil
+		}, name, nil
+
+	case commandwebhook.ActionUpdaterApply:
+		return func(ctx context.Context) (string, error) {
+			result, err := s.updaterService.ApplyPending(WithAutoUpdateTrigger(ctx, models.AutoUpdateTriggerWebhook), false)
+			if err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("%d updated, %d skipped, %d failed", result.Updated, result.Skipped, result.Failed), nil
+		}, "", nil
+
+	case commandwebhook.ActionSystemPrune:
+		req := s.pruneRequestInternal(ctx)
+		if !req.Containers && !req.Images && !req.Volumes && !req.Networks && !req.BuildCache {
+			return nil, "", fmt.Errorf("%w: no resource types are selected for scheduled prunes", ErrCommandWebhookInvalidCommand)
+		}
+		return func(ctx context.Context) (string, error) {
+			result, err := s.systemService.PruneAll(ctx, req)
+			if err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("Removed %d containers, %d images, %d volumes and %d networks",
+				len(result.ContainersPruned), len(result.ImagesDeleted), len(result.VolumesDeleted), len(result.NetworksDeleted)), nil
+		}, "", nil
+	}
+
+	return nil, "", fmt.Errorf("%w: unknown action %q", ErrCommandWebhookInvalidCommand, cmd.Action)
+}
+
+// pruneRequestInternal prunes the same resource types as scheduled prunes.
+func (s *CommandWebhookService) pruneRequestInternal(ctx context.Context) system.PruneAllRequest {
+	return system.PruneAllRequest{
+		Containers: s.settingsService.GetBoolSetting(ctx, "scheduledPruneContainers", true),
+		Images:     s.settingsService.GetBoolSetting(ctx, "scheduledPruneImages", true),
+		Volumes:    s.settingsService.GetBoolSetting(ctx, "scheduledPruneVolumes", false),
+		Networks:   s.settingsService.GetBoolSetting(ctx, "scheduledPruneNetworks", true),
+		BuildCache: s.settingsService.GetBoolSetting(ctx, "scheduledPruneBuildCache", false),
+		Dangling:   s.settingsService.GetStringSetting(ctx, "dockerPruneMode", "dangling") != "all",
+	}
+}
+
+func (s *CommandWebhookService) recordResultInternal(ctx context.Context, hook *models.CommandWebhook, user *models.User, action, target, summary string, runErr error) {
+	eventType := models.EventTypeCommandWebhookTriggered
+	title := fmt.Sprintf("Webhook command completed: %s", action)
+	description := summary
+	metadata := models.JSON{"webhookId": hook.ID, "action": action}
+	if target != "" {
+		metadata["target"] = target
+	}
+	if runErr != nil {
+		eventType = models.EventTypeCommandWebhookFailed
+		title = fmt.Sprintf("Webhook command failed: %s", action)
+		description = runErr.Error()
+		slog.WarnContext(ctx, "Command webhook action failed", "webhook", hook.Name, "action", action, "target", target, "error", runErr)
+	}
+
+	if s.eventService == nil {
+		return
+	}
+	resourceType := "command_webhook"
+	_, err := s.eventService.CreateEvent(ctx, CreateEventRequest{
+		Type:         eventType,
+		Severity:     s.eventService.getEventSeverity(eventType),
+		Title:        title,
+		Description:  description,
+		ResourceType: &resourceType,
+		ResourceID:   &hook.ID,
+		ResourceName: &hook.Name,
+		UserID:       &user.ID,
+		Username:     &user.Username,
+		Metadata:     metadata,
+	})
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to record command webhook event", "webhook", hook.Name, "error", err)
+	}
+}
+
+func (s *CommandWebhookService) authenticateInternal(ctx context.Context, token string) (*models.CommandWebhook, error) {
+	if !strings.HasPrefix(token, commandWebhookTokenPrefix) || len(token) < len(commandWebhookTokenPrefix)+commandWebhookTokenPrefixLen {
+		return nil, ErrCommandWebhookUnauthorized
+	}
+
+	var hooks []models.CommandWebhook
+	prefix := token[:len(commandWebhookTokenPrefix)+commandWebhookTokenPrefixLen]
+	if err := s.db.WithContext(ctx).Where("token_prefix = ?", prefix).Find(&hooks).Error; err != nil {
+		return nil, fmt.Errorf("failed to find command webhooks: %w", err)
+	}
+	for i := range hooks {
+		if err := s.userService.ValidatePassword(hooks[i].TokenHash, token); err == nil {
+			if !hooks[i].Enabled {
+				return nil, ErrCommandWebhookUnauthorized
+			}
+			return &hooks[i], nil
+		}
+	}
+	return nil, ErrCommandWebhookUnauthorized
+}
+
+func (s *CommandWebhookService) getWebhookModel(ctx context.Context, id string) (*models.CommandWebhook, error) {
+	var hook models.CommandWebhook
+	if err := s.db.WithContext(ctx).Where("id = ?", id).First(&hook).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrCommandWebhookNotFound
+		}
+		return nil, fmt.Errorf("failed to get command webhook: %w", err)
+	}
+	return &hook, nil
+}
+
+// normalizeCommandWebhookActions validates and de-duplicates an action allowlist.
+func normalizeCommandWebhookActions(actions []string) (models.StringSlice, error) {
+	result := make(models.StringSlice, 0, len(actions))
+	for _, action := range actions {
+		action = strings.TrimSpace(action)
+		if !commandwebhook.IsValidAction(action) {
+			return nil, fmt.Errorf("%w: unknown action %q", ErrCommandWebhookInvalid, action)
+		}
+		if !slices.Contains(result, action) {
+			result = append(result, action)
+		}
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("%w: at least one action is required", ErrCommandWebhookInvalid)
+	}
+	return result, nil
+}
+
+func toCommandWebhookDto(hook *models.CommandWebhook) commandwebhook.Webhook {
+	return commandwebhook.Webhook{
+		ID:          hook.ID,
+		Name:        hook.Name,
+		TokenPrefix: hook.TokenPrefix,
+		Actions:     hook.Actions,
+		Enabled:     hook.Enabled,
+		LastUsedAt:  hook.LastUsedAt,
+		CreatedAt:   hook.CreatedAt,
+		UpdatedAt:   hook.UpdatedAt,
+	}
+}
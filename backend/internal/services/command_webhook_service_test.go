@@ -0,0 +1,83 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	glsqlite "github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/getarcaneapp/arcane/backend/internal/database"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/types/commandwebhook"
+)
+
+func setupCommandWebhookServiceTest(t *testing.T) *CommandWebhookService {
+	t.Helper()
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared", strings.NewReplacer("/", "_", " ", "_").Replace(t.Name()))
+	gormDB, err := gorm.Open(glsqlite.Open(dsn), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, gormDB.AutoMigrate(&models.CommandWebhook{}, &models.User{}))
+
+	db := &database.DB{DB: gormDB}
+	return NewCommandWebhookService(db, NewUserService(db), nil, nil, nil, nil, nil, nil)
+}
+
+func TestCommandWebhookService_TriggerChecksTokenAndAllowlist(t *testing.T) {
+	svc := setupCommandWebhookServiceTest(t)
+	ctx := context.Background()
+
+	_, err := svc.CreateWebhook(ctx, "user-1", commandwebhook.CreateWebhook{Name: "slack", Actions: []string{"system.reboot"}})
+	require.ErrorIs(t, err, ErrCommandWebhookInvalid)
+
+	created, err := svc.CreateWebhook(ctx, "user-1", commandwebhook.CreateWebhook{
+		Name:    "slack",
+		Actions: []string{commandwebhook.ActionProjectRedeploy, commandwebhook.ActionProjectRedeploy},
+	})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(created.Token, created.TokenPrefix))
+	require.Equal(t, []string{commandwebhook.ActionProjectRedeploy}, created.Actions)
+	require.True(t, created.Enabled)
+
+	_, err = svc.Trigger(ctx, created.Token+"x", commandwebhook.Command{Action: commandwebhook.ActionProjectRedeploy, Target: "web"})
+	require.ErrorIs(t, err, ErrCommandWebhookUnauthorized)
+
+	_, err = svc.Trigger(ctx, created.Token, commandwebhook.Command{Action: commandwebhook.ActionSystemPrune})
+	require.ErrorIs(t, err, ErrCommandWebhookForbidden)
+
+	_, err = svc.Trigger(ctx, created.Token, commandwebhook.Command{Action: commandwebhook.ActionProjectRedeploy})
+	require.ErrorIs(t, err, ErrCommandWebhookInvalidCommand)
+
+	// The owner does not exist, so nothing runs.
+	_, err = svc.Trigger(ctx, created.Token, commandwebhook.Command{Action: commandwebhook.ActionProjectRedeploy, Target: "web"})
+	require.ErrorIs(t, err, ErrCommandWebhookUnauthorized)
+
+	disabled := false
+	_, err = svc.UpdateWebhook(ctx, created.ID, commandwebhook.UpdateWebhook{Enabled: &disabled})
+	require.NoError(t, err)
+	_, err = svc.Trigger(ctx, created.Token, commandwebhook.Command{Action: commandwebhook.ActionProjectRedeploy, Target: "web"})
+	require.ErrorIs(t, err, ErrCommandWebhookUnauthorized)
+}
+
+func TestParseCommandWebhookRequest(t *testing.T) {
+	cmd, err := ParseCommandWebhookRequest("application/json", []byte(`{"action":"project.redeploy","target":"web"}`))
+	require.NoError(t, err)
+	require.Equal(t, commandwebhook.Command{Action: commandwebhook.ActionProjectRedeploy, Target: "web"}, cmd)
+
+	cmd, err = ParseCommandWebhookRequest("application/x-www-form-urlencoded; charset=utf-8", []byte("command=%2Farcane&text=Redeploy+web&user_name=alice"))
+	require.NoError(t, err)
+	require.Equal(t, commandwebhook.Command{Action: commandwebhook.ActionProjectRedeploy, Target: "web"}, cmd)
+
+	cmd, err = ParseCommandWebhookRequest("application/x-www-form-urlencoded", []byte("text=prune"))
+	require.NoError(t, err)
+	require.Equal(t, commandwebhook.Command{Action: commandwebhook.ActionSystemPrune}, cmd)
+
+	_, err = ParseCommandWebhookRequest("application/x-www-form-urlencoded", []byte("text=redeploy+web+api"))
+	require.ErrorIs(t, err, ErrCommandWebhookInvalidCommand)
+
+	_, err = ParseCommandWebhookRequest("application/json", []byte("redeploy"))
+	require.ErrorIs(t, err, ErrCommandWebhookInvalidCommand)
+}
@@ -0,0 +1,507 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/remenv"
+	"github.com/getarcaneapp/arcane/backend/pkg/libarcane"
+	containertypes "github.com/getarcaneapp/arcane/types/container"
+	"github.com/getarcaneapp/arcane/types/image"
+	volumetypes "github.com/getarcaneapp/arcane/types/volume"
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/api/types/mount"
+	"github.com/moby/moby/api/types/network"
+	"github.com/moby/moby/client"
+)
+
+// containerImportPath is the agent endpoint that creates a cloned container.
+const containerImportPath = "/containers/import"
+
+// ContainerCloneService recreates standalone containers on other
+// environments, so workloads can move between hosts without retyping their
+// configuration.
+type ContainerCloneService struct {
+	dockerService            *DockerClientService
+	environmentService       *EnvironmentService
+	imageDistributionService *ImageDistributionService
+	containerService         *ContainerService
+	volumeService            *VolumeService
+	eventService             *EventService
+}
+
+func NewContainerCloneService(dockerService *DockerClientService, environmentService *EnvironmentService, imageDistributionService *ImageDistributionService, containerService *ContainerService, volumeService *VolumeService, eventService *EventService) *ContainerCloneService {
+	return &ContainerCloneService{
+		dockerService:            dockerService,
+		environmentService:       environmentService,
+		imageDistributionService: imageDistributionService,
+		containerService:         containerService,
+		volumeService:            volumeService,
+		eventService:             eventService,
+	}
+}
+
+// CloneContainer recreates a container of the source environment on the
+// target environment. The image is pulled on the target, or copied from the
+// source when it cannot be pulled. Named volumes are created on the target
+// and, with req.CopyVolumes, filled from a backup of the source volume;
+// volumes that already exist on the target are used as they are. Like image
+// distribution, the source must be the local environment or an SSH
+// environment.
+func (s *ContainerCloneService) CloneContainer(ctx context.Context, req containertypes.CloneRequest, user models.User) (*containertypes.ClonedContainer, error) {
+	req, err := normalizeCloneRequestInternal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.SourceEnvironmentID != "0" {
+		source, err := s.environmentService.GetEnvironmentByID(ctx, req.SourceEnvironmentID)
+		if err != nil {
+			return nil, &models.NotFoundError{Message: "source environment not found"}
+		}
+		if !IsSSHEnvironmentURL(source.ApiUrl) {
+			return nil, &models.ValidationError{Message: "containers can only be cloned from the local environment or an SSH environment", Field: "sourceEnvironmentId"}
+		}
+	}
+	sourceCtx := WithDockerEnvironment(ctx, req.SourceEnvironmentID)
+	sourceClient, err := s.dockerService.GetClient(sourceCtx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to Docker: %w", err)
+	}
+
+	inspectResult, err := sourceClient.ContainerInspect(ctx, req.ContainerID, client.ContainerInspectOptions{})
+	if err != nil {
+		return nil, &models.NotFoundError{Message: fmt.Sprintf("container %s not found in the source environment", req.ContainerID)}
+	}
+	inspect := inspectResult.Container
+	if inspect.Config == nil || inspect.HostConfig == nil {
+		return nil, fmt.Errorf("container %s has no configuration", req.ContainerID)
+	}
+	if project := inspect.Config.Labels["com.docker.compose.project"]; project != "" {
+		return nil, &models.ValidationError{Message: fmt.Sprintf("container belongs to compose project %s; clone the project instead", project), Field: "containerId"}
+	}
+	if inspect.HostConfig.NetworkMode.IsContainer() {
+		return nil, &models.ValidationError{Message: "container shares the network of another container and cannot be cloned on its own", Field: "containerId"}
+	}
+
+	name := req.Name
+	if name == "" {
+		name = strings.TrimPrefix(inspect.Name, "/")
+	}
+
+	target, err := s.cloneTargetInternal(ctx, req.TargetEnvironmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &containertypes.ClonedContainer{
+		EnvironmentID:   target.envID,
+		EnvironmentName: target.name,
+		ContainerName:   name,
+		Image:           inspect.Config.Image,
+		Volumes:         []string{},
+		Warnings:        []string{},
+	}
+
+	if err := s.copyImageInternal(ctx, sourceClient, target, inspect.Config.Image, user); err != nil {
+		return nil, err
+	}
+
+	for _, m := range inspect.Mounts {
+		switch {
+		case m.Type == mount.TypeBind:
+			result.Warnings = append(result.Warnings, fmt.Sprintf("bind mount %s is not copied; make sure it exists on the target", m.Source))
+		case m.Type != mount.TypeVolume || m.Name == "":
+		case !namedVolumeInternal(inspect, m.Name):
+			if req.CopyVolumes {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("anonymous volume at %s is created empty", m.Destination))
+			}
+		default:
+			created, err := s.cloneVolumeInternal(ctx, sourceCtx, sourceClient, target, m.Name, req.CopyVolumes, user)
+			if err != nil {
+				return nil, fmt.Errorf("failed to clone volume %s: %w", m.Name, err)
+			}
+			if created {
+				result.Volumes = append(result.Volumes, m.Name)
+			} else {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("volume %s already exists on the target and is used as it is", m.Name))
+			}
+		}
+	}
+
+	importReq, warnings := buildImportRequestInternal(inspect, name, req.Start)
+	result.Warnings = append(result.Warnings, warnings...)
+
+	imported, err := target.importContainer(ctx, importReq, user)
+	if err != nil {
+		return nil, err
+	}
+	result.ContainerID = imported.ContainerID
+	result.Warnings = append(result.Warnings, imported.Warnings...)
+
+	_, _ = s.eventService.CreateEvent(ctx, CreateEventRequest{
+		Type:          models.EventTypeContainerClone,
+		Title:         "Container cloned: " + strings.TrimPrefix(inspect.Name, "/"),
+		Description:   fmt.Sprintf("Recreated %s as %s on %s", strings.TrimPrefix(inspect.Name, "/"), name, target.name),
+		ResourceType:  new("container"),
+		ResourceID:    new(inspect.ID),
+		ResourceName:  new(strings.TrimPrefix(inspect.Name, "/")),
+		UserID:        new(user.ID),
+		Username:      new(user.Username),
+		EnvironmentID: new(req.SourceEnvironmentID),
+		Metadata: models.JSON{
+			"action":              "clone",
+			"targetEnvironmentId": target.envID,
+			"containerId":         imported.ContainerID,
+			"volumes":             result.Volumes,
+			"copyVolumes":         req.CopyVolumes,
+		},
+	})
+
+	return result, nil
+}
+
+// ImportContainer creates a container from a configuration read on another
+// host. Missing networks are created with the bridge driver and the image
+// is pulled when it is missing. It runs on the target of a clone: directly
+// for the local and SSH environments, and on agents through their import
+// endpoint.
+func (s *ContainerCloneService) ImportContainer(ctx context.Context, req containertypes.ImportRequest, user models.User) (*containertypes.ImportedContainer, error) {
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" {
+		return nil, &models.ValidationError{Message: "container name is required", Field: "name"}
+	}
+	if req.Config == nil || req.HostConfig == nil || strings.TrimSpace(req.Config.Image) == "" {
+		return nil, &models.ValidationError{Message: "container configuration with an image is required", Field: "config"}
+	}
+
+	dockerClient, err := s.dockerService.GetClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to Docker: %w", err)
+	}
+	if _, err := s.containerService.pullImageIfMissingInternal(ctx, dockerClient, req.Config.Image, nil); err != nil {
+		return nil, err
+	}
+
+	var networkingConfig *network.NetworkingConfig
+	if req.NetworkingConfig != nil && len(req.NetworkingConfig.EndpointsConfig) > 0 {
+		for name := range req.NetworkingConfig.EndpointsConfig {
+			if err := ensureImportNetworkInternal(ctx, dockerClient, name); err != nil {
+				return nil, err
+			}
+		}
+		apiVersion := libarcane.DetectDockerAPIVersion(ctx, dockerClient)
+		networkingConfig = &network.NetworkingConfig{
+			EndpointsConfig: libarcane.SanitizeContainerCreateEndpointSettingsForDockerAPI(req.NetworkingConfig.EndpointsConfig, apiVersion),
+		}
+	}
+
+	created, err := dockerClient.ContainerCreate(ctx, client.ContainerCreateOptions{
+		Config:           req.Config,
+		HostConfig:       req.HostConfig,
+		NetworkingConfig: networkingConfig,
+		Name:             req.Name,
+	})
+	if err != nil {
+		s.eventService.LogErrorEvent(ctx, models.EventTypeContainerError, "container", "", req.Name, user.ID, user.Username, "0", err, models.JSON{"action": "import", "image": req.Config.Image, "step": "create"})
+		return nil, fmt.Errorf("failed to create container: %w", err)
+	}
+
+	result := &containertypes.ImportedContainer{ContainerID: created.ID, ContainerName: req.Name, Warnings: append([]string{}, created.Warnings...)}
+	if req.Start {
+		// The container is kept when it does not start, since that usually
+		// takes a change on the host, such as freeing a port.
+		if _, err := dockerClient.ContainerStart(ctx, created.ID, client.ContainerStartOptions{}); err != nil {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("container was created but could not be started: %v", err))
+		}
+	}
+
+	metadata := models.JSON{"action": "import", "containerId": created.ID, "image": req.Config.Image}
+	if logErr := s.eventService.LogContainerEvent(ctx, models.EventTypeContainerCreate, created.ID, req.Name, user.ID, user.Username, "0", metadata); logErr != nil {
+		slog.WarnContext(ctx, "Failed to log container import event", "containerId", created.ID, "error", logErr.Error())
+	}
+
+	return result, nil
+}
+
+// cloneTargetInternal is the environment a container is cloned to, reached
+// through its agent's API or directly through Docker.
+type cloneTargetInternal struct {
+	s      *ContainerCloneService
+	envID  string
+	name   string
+	remote bool
+}
+
+func (s *ContainerCloneService) cloneTargetInternal(ctx context.Context, envID string) (cloneTargetInternal, error) {
+	target := cloneTargetInternal{s: s, envID: envID, name: "Local"}
+	if envID == "0" {
+		return target, nil
+	}
+
+	env, err := s.environmentService.GetEnvironmentByID(ctx, envID)
+	if err != nil {
+		return target, &models.NotFoundError{Message: "target environment not found"}
+	}
+	target.name = env.Name
+	target.remote = !IsSSHEnvironmentURL(env.ApiUrl)
+	if target.remote {
+		if supported, _ := remenv.SupportsPath(env.Capabilities, containerImportPath); !supported {
+			return target, &models.ValidationError{Message: fmt.Sprintf("the agent of environment %s cannot import containers; upgrade the agent to clone containers to it", env.Name), Field: "targetEnvironmentId"}
+		}
+	}
+	return target, nil
+}
+
+// copyImageInternal pulls the image on the target and falls back to copying
+// it from the source, for images that were built locally or whose registry
+// the target cannot reach.
+func (s *ContainerCloneService) copyImageInternal(ctx context.Context, sourceClient *client.Client, target cloneTargetInternal, ref string, user models.User) error {
+	var outcome image.DistributeTargetResult
+	pullReq := image.DistributeRequest{Image: ref, Method: image.DistributeMethodRegistry}
+	pullErr := s.imageDistributionService.distributeToTargetInternal(ctx, sourceClient, pullReq, target.envID, &outcome, user)
+	if pullErr == nil {
+		return nil
+	}
+	slog.InfoContext(ctx, "Image could not be pulled on clone target; copying it from the source", "image", ref, "environment", target.envID, "error", pullErr)
+
+	streamReq := image.DistributeRequest{Image: ref, Method: image.DistributeMethodStream}
+	if err := s.imageDistributionService.distributeToTargetInternal(ctx, sourceClient, streamReq, target.envID, &outcome, user); err != nil {
+		return fmt.Errorf("failed to copy image %s to the target: %w", ref, errors.Join(pullErr, err))
+	}
+	return nil
+}
+
+// cloneVolumeInternal creates a named volume on the target with the
+// source volume's driver, options and labels, and fills it from a backup of
+// the source volume when copyData is set. It reports false when the volume
+// already exists on the target and was left alone.
+func (s *ContainerCloneService) cloneVolumeInternal(ctx, sourceCtx context.Context, sourceClient *client.Client, target cloneTargetInternal, name string, copyData bool, user models.User) (bool, error) {
+	exists, err := target.volumeExists(ctx, name)
+	if err != nil || exists {
+		return false, err
+	}
+
+	source, err := sourceClient.VolumeInspect(ctx, name, client.VolumeInspectOptions{})
+	if err != nil {
+		return false, fmt.Errorf("failed to inspect volume: %w", err)
+	}
+	if err := target.createVolume(ctx, volumetypes.Create{
+		Name:       name,
+		Driver:     source.Volume.Driver,
+		DriverOpts: source.Volume.Options,
+		Labels:     source.Volume.Labels,
+	}, user); err != nil {
+		return false, err
+	}
+	if !copyData {
+		return true, nil
+	}
+
+	backup, err := s.volumeService.CreateBackup(sourceCtx, name, user)
+	if err != nil {
+		return true, fmt.Errorf("failed to back up source volume: %w", err)
+	}
+	defer func() {
+		if err := s.volumeService.DeleteBackup(sourceCtx, backup.ID, &user); err != nil {
+			slog.WarnContext(ctx, "Failed to remove backup taken for container clone", "backupId", backup.ID, "error", err)
+		}
+	}()
+
+	archive, _, err := s.volumeService.DownloadBackup(sourceCtx, backup.ID, &user)
+	if err != nil {
+		return true, fmt.Errorf("failed to read source volume backup: %w", err)
+	}
+	defer func() { _ = archive.Close() }()
+
+	if err := target.restoreVolume(ctx, name, backup.ID+".tar.gz", archive, user); err != nil {
+		return true, fmt.Errorf("failed to restore volume on the target: %w", err)
+	}
+	return true, nil
+}
+
+func (t cloneTargetInternal) volumeExists(ctx context.Context, name string) (bool, error) {
+	if !t.remote {
+		dockerClient, err := t.s.dockerService.GetClient(WithDockerEnvironment(ctx, t.envID))
+		if err != nil {
+			return false, fmt.Errorf("failed to connect to Docker: %w", err)
+		}
+		_, err = dockerClient.VolumeInspect(ctx, name, client.VolumeInspectOptions{})
+		return err == nil, nil
+	}
+
+	_, statusCode, err := t.s.environmentService.ProxyRequest(ctx, t.envID, http.MethodGet, "/api/environments/0/volumes/"+url.PathEscape(name), nil)
+	if err != nil {
+		return false, err
+	}
+	return statusCode == http.StatusOK, nil
+}
+
+func (t cloneTargetInternal) createVolume(ctx context.Context, req volumetypes.Create, user models.User) error {
+	if !t.remote {
+		_, err := t.s.volumeService.CreateVolume(WithDockerEnvironment(ctx, t.envID), client.VolumeCreateOptions{
+			Name:       req.Name,
+			Driver:     req.Driver,
+			DriverOpts: req.DriverOpts,
+			Labels:     req.Labels,
+		}, user)
+		return err
+	}
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("failed to encode volume: %w", err)
+	}
+	body, statusCode, err := t.s.environmentService.ProxyRequest(ctx, t.envID, http.MethodPost, "/api/environments/0/volumes", payload)
+	if err != nil {
+		return err
+	}
+	return remoteResponseErrorInternal(statusCode, body)
+}
+
+func (t cloneTargetInternal) restoreVolume(ctx context.Context, name, filename string, archive io.Reader, user models.User) error {
+	if !t.remote {
+		return t.s.volumeService.UploadAndRestore(WithDockerEnvironment(ctx, t.envID), name, archive, filename, user)
+	}
+
+	pr, pw := io.Pipe()
+	defer func() { _ = pr.Close() }()
+	form := multipart.NewWriter(pw)
+
+	go func() {
+		part, err := form.CreateFormFile("file", filename)
+		if err == nil {
+			_, err = io.Copy(part, archive)
+		}
+		if err == nil {
+			err = form.Close()
+		}
+		_ = pw.CloseWithError(err)
+	}()
+
+	path := "/api/environments/0/volumes/" + url.PathEscape(name) + "/backups/upload"
+	body, statusCode, err := t.s.environmentService.UploadToEnvironment(ctx, t.envID, http.MethodPost, path, form.FormDataContentType(), pr)
+	if err != nil {
+		return err
+	}
+	return remoteResponseErrorInternal(statusCode, body)
+}
+
+func (t cloneTargetInternal) importContainer(ctx context.Context, req containertypes.ImportRequest, user models.User) (*containertypes.ImportedContainer, error) {
+	if !t.remote {
+		return t.s.ImportContainer(WithDockerEnvironment(ctx, t.envID), req, user)
+	}
+
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode container: %w", err)
+	}
+	body, statusCode, err := t.s.environmentService.ProxyRequest(ctx, t.envID, http.MethodPost, "/api/environments/0"+containerImportPath, payload)
+	if err != nil {
+		return nil, err
+	}
+	if err := remoteResponseErrorInternal(statusCode, body); err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Data containertypes.ImportedContainer `json:"data"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to decode import response: %w", err)
+	}
+	return &resp.Data, nil
+}
+
+// buildImportRequestInternal turns the inspected container into an import
+// request, dropping what only makes sense on the source host: the
+// hostname derived from the container ID, links to other containers, and
+// the addresses the container had on its networks.
+func buildImportRequestInternal(inspect container.InspectResponse, name string, start bool) (containertypes.ImportRequest, []string) {
+	var warnings []string
+	cfg := *inspect.Config
+	hostConfig := *inspect.HostConfig
+
+	shortID := inspect.ID[:min(len(inspect.ID), 12)]
+	if cfg.Hostname == shortID {
+		cfg.Hostname = ""
+	}
+	if len(hostConfig.Links) > 0 {
+		warnings = append(warnings, "links to other containers are not cloned")
+		hostConfig.Links = nil
+	}
+
+	req := containertypes.ImportRequest{Name: name, Config: &cfg, HostConfig: &hostConfig, Start: start}
+	if inspect.NetworkSettings == nil || len(inspect.NetworkSettings.Networks) == 0 {
+		return req, warnings
+	}
+	endpoints := make(map[string]*network.EndpointSettings, len(inspect.NetworkSettings.Networks))
+	for networkName, endpoint := range inspect.NetworkSettings.Networks {
+		settings := &network.EndpointSettings{}
+		if endpoint != nil {
+			settings.Aliases = slices.DeleteFunc(slices.Clone(endpoint.Aliases), func(alias string) bool { return alias == shortID })
+			settings.DriverOpts = maps.Clone(endpoint.DriverOpts)
+			settings.GwPriority = endpoint.GwPriority
+		}
+		endpoints[networkName] = settings
+	}
+	req.NetworkingConfig = &network.NetworkingConfig{EndpointsConfig: endpoints}
+	return req, warnings
+}
+
+// namedVolumeInternal reports whether the container mounts the volume by
+// name, as opposed to an anonymous volume created for it by Docker.
+func namedVolumeInternal(inspect container.InspectResponse, name string) bool {
+	for _, bind := range inspect.HostConfig.Binds {
+		if source, _, ok := strings.Cut(bind, ":"); ok && source == name {
+			return true
+		}
+	}
+	return slices.ContainsFunc(inspect.HostConfig.Mounts, func(m mount.Mount) bool { return m.Source == name })
+}
+
+func ensureImportNetworkInternal(ctx context.Context, dockerClient *client.Client, name string) error {
+	if mode := container.NetworkMode(name); mode.IsDefault() || mode.IsBridge() || mode.IsHost() || mode.IsNone() {
+		return nil
+	}
+	if _, err := dockerClient.NetworkInspect(ctx, name, client.NetworkInspectOptions{}); err == nil {
+		return nil
+	}
+	if _, err := dockerClient.NetworkCreate(ctx, name, client.NetworkCreateOptions{Driver: "bridge"}); err != nil {
+		return fmt.Errorf("failed to create network %s: %w", name, err)
+	}
+	return nil
+}
+
+// normalizeCloneRequestInternal fills in defaults and checks that the
+// target differs from the source.
+func normalizeCloneRequestInternal(req containertypes.CloneRequest) (containertypes.CloneRequest, error) {
+	req.ContainerID = strings.TrimSpace(req.ContainerID)
+	if req.ContainerID == "" {
+		return req, &models.ValidationError{Message: "container is required", Field: "containerId"}
+	}
+	req.SourceEnvironmentID = strings.TrimSpace(req.SourceEnvironmentID)
+	if req.SourceEnvironmentID == "" {
+		req.SourceEnvironmentID = "0"
+	}
+	req.TargetEnvironmentID = strings.TrimSpace(req.TargetEnvironmentID)
+	if req.TargetEnvironmentID == "" {
+		return req, &models.ValidationError{Message: "target environment is required", Field: "targetEnvironmentId"}
+	}
+	if req.TargetEnvironmentID == req.SourceEnvironmentID {
+		return req, &models.ValidationError{Message: "target environment must differ from the source", Field: "targetEnvironmentId"}
+	}
+	req.Name = strings.TrimPrefix(strings.TrimSpace(req.Name), "/")
+	return req, nil
+}
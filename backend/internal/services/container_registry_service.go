@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -16,12 +17,15 @@ import (
 	"github.com/getarcaneapp/arcane/backend/internal/utils"
 	"github.com/getarcaneapp/arcane/backend/internal/utils/cache"
 	"github.com/getarcaneapp/arcane/backend/internal/utils/crypto"
+	httputils "github.com/getarcaneapp/arcane/backend/internal/utils/http"
 	"github.com/getarcaneapp/arcane/backend/internal/utils/mapper"
 	"github.com/getarcaneapp/arcane/backend/internal/utils/pagination"
 	utilsregistry "github.com/getarcaneapp/arcane/backend/internal/utils/registry"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/signature"
 	"github.com/getarcaneapp/arcane/types/containerregistry"
 	dockerregistry "github.com/moby/moby/api/types/registry"
 	ref "go.podman.io/image/v5/docker/reference"
+	"gorm.io/gorm"
 )
 
 const (
@@ -47,7 +51,7 @@ type ContainerRegistryService struct {
 
 func NewContainerRegistryService(db *database.DB) *ContainerRegistryService {
 	transport := http.DefaultTransport.(*http.Transport).Clone()
-	transport.Proxy = http.ProxyFromEnvironment
+	transport.Proxy = httputils.ProxyFromSettings
 
 	return &ContainerRegistryService{
 		db: db,
@@ -104,6 +108,10 @@ func (s *ContainerRegistryService) GetRegistryByID(ctx context.Context, id strin
 }
 
 func (s *ContainerRegistryService) CreateRegistry(ctx context.Context, req models.CreateContainerRegistryRequest) (*models.ContainerRegistry, error) {
+	if err := validateSignaturePolicyInternal(stringPtrValue(req.SignatureMode), stringPtrValue(req.SignatureTrust), stringPtrValue(req.SignatureIdentity), stringPtrValue(req.SignatureIssuer)); err != nil {
+		return nil, err
+	}
+
 	// Encrypt the token before storing
 	encryptedToken, err := crypto.Encrypt(req.Token)
 	if err != nil {
@@ -119,6 +127,11 @@ func (s *ContainerRegistryService) CreateRegistry(ctx context.Context, req model
 		Enabled:     req.Enabled == nil || *req.Enabled,
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
+
+		SignatureMode:     stringPtrValue(req.SignatureMode),
+		SignatureTrust:    req.SignatureTrust,
+		SignatureIdentity: req.SignatureIdentity,
+		SignatureIssuer:   req.SignatureIssuer,
 	}
 
 	if err := s.db.WithContext(ctx).Create(registry).Error; err != nil {
@@ -150,6 +163,14 @@ func (s *ContainerRegistryService) UpdateRegistry(ctx context.Context, id string
 	utils.UpdateIfChanged(&registry.Description, req.Description)
 	utils.UpdateIfChanged(&registry.Insecure, req.Insecure)
 	utils.UpdateIfChanged(&registry.Enabled, req.Enabled)
+	utils.UpdateIfChanged(&registry.SignatureMode, req.SignatureMode)
+	utils.UpdateIfChanged(&registry.SignatureTrust, req.SignatureTrust)
+	utils.UpdateIfChanged(&registry.SignatureIdentity, req.SignatureIdentity)
+	utils.UpdateIfChanged(&registry.SignatureIssuer, req.SignatureIssuer)
+
+	if err := validateSignaturePolicyInternal(registry.SignatureMode, stringPtrValue(registry.SignatureTrust), stringPtrValue(registry.SignatureIdentity), stringPtrValue(registry.SignatureIssuer)); err != nil {
+		return nil, err
+	}
 
 	registry.UpdatedAt = time.Now()
 
@@ -161,12 +182,122 @@ func (s *ContainerRegistryService) UpdateRegistry(ctx context.Context, id string
 }
 
 func (s *ContainerRegistryService) DeleteRegistry(ctx context.Context, id string) error {
-	if err := s.db.WithContext(ctx).Where("id = ?", id).Delete(&models.ContainerRegistry{}).Error; err != nil {
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("registry_id = ?", id).Delete(&models.ProjectRegistry{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("id = ?", id).Delete(&models.ContainerRegistry{}).Error
+	})
+	if err != nil {
 		return fmt.Errorf("failed to delete container registry: %w", err)
 	}
 	return nil
 }
 
+// GetProjectRegistryIDs returns the IDs of the registries scoped to projectID.
+func (s *ContainerRegistryService) GetProjectRegistryIDs(ctx context.Context, projectID string) ([]string, error) {
+	ids := []string{}
+	if err := s.db.WithContext(ctx).Model(&models.ProjectRegistry{}).
+		Where("project_id = ?", projectID).
+		Order("registry_id").
+		Pluck("registry_id", &ids).Error; err != nil {
+		return nil, fmt.Errorf("failed to get project registries: %w", err)
+	}
+	return ids, nil
+}
+
+// SetProjectRegistries replaces the registries scoped to projectID. Scoped
+// registries stop being used for pulls outside the projects they belong to.
+func (s *ContainerRegistryService) SetProjectRegistries(ctx context.Context, projectID string, registryIDs []string) error {
+	registryIDs = slices.Compact(slices.Sorted(slices.Values(registryIDs)))
+
+	var found int64
+	if len(registryIDs) > 0 {
+		if err := s.db.WithContext(ctx).Model(&models.ContainerRegistry{}).Where("id IN ?", registryIDs).Count(&found).Error; err != nil {
+			return fmt.Errorf("failed to look up registries: %w", err)
+		}
+	}
+	if int(found) != len(registryIDs) {
+		return &models.ValidationError{Message: "one or more registries do not exist", Field: "registryIds"}
+	}
+
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("project_id = ?", projectID).Delete(&models.ProjectRegistry{}).Error; err != nil {
+			return err
+		}
+		now := time.Now()
+		for _, id := range registryIDs {
+			if err := tx.Create(&models.ProjectRegistry{ProjectID: projectID, RegistryID: id, CreatedAt: now}).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update project registries: %w", err)
+	}
+	return nil
+}
+
+// GetProjectRegistries returns the enabled registries scoped to projectID.
+func (s *ContainerRegistryService) GetProjectRegistries(ctx context.Context, projectID string) ([]models.ContainerRegistry, error) {
+	var registries []models.ContainerRegistry
+	if err := s.db.WithContext(ctx).
+		Where("enabled = ? AND id IN (?)", true, s.db.Model(&models.ProjectRegistry{}).Select("registry_id").Where("project_id = ?", projectID)).
+		Order("url").
+		Find(&registries).Error; err != nil {
+		return nil, fmt.Errorf("failed to get project registries: %w", err)
+	}
+	return registries, nil
+}
+
+// GetComposeProjectRegistries returns the enabled registries scoped to the
+// project whose compose name is composeName, or none when no project with
+// scoped registries has that name.
+func (s *ContainerRegistryService) GetComposeProjectRegistries(ctx context.Context, composeName string) ([]models.ContainerRegistry, error) {
+	if composeName == "" {
+		return nil, nil
+	}
+
+	var candidates []models.Project
+	if err := s.db.WithContext(ctx).
+		Where("id IN (?)", s.db.Model(&models.ProjectRegistry{}).Select("project_id")).
+		Find(&candidates).Error; err != nil {
+		return nil, fmt.Errorf("failed to list projects with registries: %w", err)
+	}
+
+	for _, p := range candidates {
+		if normalizeComposeProjectName(p.Name) == composeName {
+			return s.GetProjectRegistries(ctx, p.ID)
+		}
+	}
+	return nil, nil
+}
+
+// GetProjectCredentials returns the enabled registries scoped to projectID as
+// pull credentials with their tokens decrypted.
+func (s *ContainerRegistryService) GetProjectCredentials(ctx context.Context, projectID string) ([]containerregistry.Credential, error) {
+	registries, err := s.GetProjectRegistries(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return registryCredentialsInternal(registries)
+}
+
+// registryCredentialsInternal returns registries as pull credentials with
+// their tokens decrypted.
+func registryCredentialsInternal(registries []models.ContainerRegistry) ([]containerregistry.Credential, error) {
+	creds := make([]containerregistry.Credential, 0, len(registries))
+	for _, reg := range registries {
+		token, err := crypto.Decrypt(reg.Token)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt token for registry %s: %w", reg.URL, err)
+		}
+		creds = append(creds, containerregistry.Credential{URL: reg.URL, Username: reg.Username, Token: token, Enabled: true})
+	}
+	return creds, nil
+}
+
 // GetDecryptedToken returns the decrypted token for a registry
 func (s *ContainerRegistryService) GetDecryptedToken(ctx context.Context, id string) (string, error) {
 	registry, err := s.GetRegistryByID(ctx, id)
@@ -182,10 +313,14 @@ func (s *ContainerRegistryService) GetDecryptedToken(ctx context.Context, id str
 	return decryptedToken, nil
 }
 
-// GetEnabledRegistries returns all enabled registries
+// GetEnabledRegistries returns the enabled registries that aren't scoped to
+// projects. Scoped registries are only used where the project is known; see
+// GetProjectRegistries.
 func (s *ContainerRegistryService) GetEnabledRegistries(ctx context.Context) ([]models.ContainerRegistry, error) {
 	var registries []models.ContainerRegistry
-	if err := s.db.WithContext(ctx).Where("enabled = ?", true).Find(&registries).Error; err != nil {
+	if err := s.db.WithContext(ctx).
+		Where("enabled = ? AND id NOT IN (?)", true, s.db.Model(&models.ProjectRegistry{}).Select("registry_id")).
+		Find(&registries).Error; err != nil {
 		return nil, fmt.Errorf("failed to get enabled container registries: %w", err)
 	}
 	return registries, nil
@@ -223,6 +358,25 @@ func (s *ContainerRegistryService) GetRegistryAuthForHost(ctx context.Context, r
 	return utilsregistry.EncodeAuthHeader(cfg.Username, cfg.Password, cfg.ServerAddress)
 }
 
+// GetRegistryAuth returns X-Registry-Auth for a specific registry, including
+// registries scoped to projects. Registries without credentials get an empty
+// header.
+func (s *ContainerRegistryService) GetRegistryAuth(registry *models.ContainerRegistry) (string, error) {
+	username := strings.TrimSpace(registry.Username)
+	if username == "" || registry.Token == "" {
+		return "", nil
+	}
+
+	decryptedToken, err := crypto.Decrypt(registry.Token)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt token for registry %s: %w", registry.URL, err)
+	}
+
+	return utilsregistry.EncodeAuthHeader(username, strings.TrimSpace(decryptedToken), utilsregistry.NormalizeRegistryURL(registry.URL))
+}
+
+// GetAllRegistryAuthConfigs returns the auth configs of the enabled
+// registries that aren't scoped to projects, keyed by registry host.
 func (s *ContainerRegistryService) GetAllRegistryAuthConfigs(ctx context.Context) (map[string]dockerregistry.AuthConfig, error) {
 	registries, err := s.GetEnabledRegistries(ctx)
 	if err != nil {
@@ -536,6 +690,10 @@ func (s *ContainerRegistryService) checkRegistryNeedsUpdateInternal(item contain
 	needsUpdate = utils.UpdateIfChanged(&existing.Description, item.Description) || needsUpdate
 	needsUpdate = utils.UpdateIfChanged(&existing.Insecure, item.Insecure) || needsUpdate
 	needsUpdate = utils.UpdateIfChanged(&existing.Enabled, item.Enabled) || needsUpdate
+	needsUpdate = utils.UpdateIfChanged(&existing.SignatureMode, item.SignatureMode) || needsUpdate
+	needsUpdate = utils.UpdateIfChanged(&existing.SignatureTrust, item.SignatureTrust) || needsUpdate
+	needsUpdate = utils.UpdateIfChanged(&existing.SignatureIdentity, item.SignatureIdentity) || needsUpdate
+	needsUpdate = utils.UpdateIfChanged(&existing.SignatureIssuer, item.SignatureIssuer) || needsUpdate
 
 	return needsUpdate
 }
@@ -558,6 +716,11 @@ func (s *ContainerRegistryService) createNewRegistryInternal(ctx context.Context
 		Enabled:     item.Enabled,
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
+
+		SignatureMode:     item.SignatureMode,
+		SignatureTrust:    item.SignatureTrust,
+		SignatureIdentity: item.SignatureIdentity,
+		SignatureIssuer:   item.SignatureIssuer,
 	}
 
 	if err := s.db.WithContext(ctx).Create(newRegistry).Error; err != nil {
@@ -620,3 +783,12 @@ func parseWWWAuth(header string) (realm, service string) {
 	c := utilsregistry.NewClient()
 	return c.ParseAuthChallenge(header)
 }
+
+// validateSignaturePolicyInternal rejects a signature verification policy that
+// could never accept an image, such as a key mode without keys.
+func validateSignaturePolicyInternal(mode, trust, identity, issuer string) error {
+	if _, err := signature.ParsePolicy(mode, trust, identity, issuer); err != nil {
+		return &models.ValidationError{Message: err.Error(), Field: "signatureMode"}
+	}
+	return nil
+}
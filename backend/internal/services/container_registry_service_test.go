@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/getarcaneapp/arcane/backend/internal/models"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -54,3 +55,39 @@ func TestContainerRegistryService_GetAllRegistryAuthConfigs_SkipsInvalidEntries(
 	assert.Equal(t, "example-token", exampleCfg.Password)
 	assert.Equal(t, "registry.example.com", exampleCfg.ServerAddress)
 }
+
+func TestContainerRegistryService_ProjectRegistriesAreScoped(t *testing.T) {
+	_, db := setupImageServiceAuthTest(t)
+	createTestPullRegistry(t, db, "https://ghcr.io", "gh-user", "gh-token")
+	createTestPullRegistry(t, db, "https://registry.example.com", "example-user", "example-token")
+
+	var scoped models.ContainerRegistry
+	require.NoError(t, db.Where("url = ?", "https://registry.example.com").First(&scoped).Error)
+
+	ctx := context.Background()
+	svc := NewContainerRegistryService(db)
+	require.NoError(t, svc.SetProjectRegistries(ctx, "project-1", []string{scoped.ID, scoped.ID}))
+
+	ids, err := svc.GetProjectRegistryIDs(ctx, "project-1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{scoped.ID}, ids)
+
+	authConfigs, err := svc.GetAllRegistryAuthConfigs(ctx)
+	require.NoError(t, err)
+	assert.Contains(t, authConfigs, "ghcr.io")
+	assert.NotContains(t, authConfigs, "registry.example.com")
+
+	creds, err := svc.GetProjectCredentials(ctx, "project-1")
+	require.NoError(t, err)
+	require.Len(t, creds, 1)
+	assert.Equal(t, "example-user", creds[0].Username)
+	assert.Equal(t, "example-token", creds[0].Token)
+
+	creds, err = svc.GetProjectCredentials(ctx, "project-2")
+	require.NoError(t, err)
+	assert.Empty(t, creds)
+
+	var validationErr *models.ValidationError
+	require.ErrorAs(t, svc.SetProjectRegistries(ctx, "project-1", []string{"missing"}), &validationErr)
+	assert.Equal(t, "registryIds", validationErr.Field)
+}
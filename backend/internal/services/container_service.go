@@ -8,23 +8,32 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"maps"
+	"net/netip"
+	"path"
+	"slices"
 	"strings"
 	"sync"
 	"time"
 
+	cerrdefs "github.com/containerd/errdefs"
 	"github.com/getarcaneapp/arcane/backend/internal/database"
 	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/arcaneupdater"
 	dockerutils "github.com/getarcaneapp/arcane/backend/internal/utils/docker"
 	"github.com/getarcaneapp/arcane/backend/internal/utils/pagination"
 	"github.com/getarcaneapp/arcane/backend/internal/utils/timeouts"
 	"github.com/getarcaneapp/arcane/backend/pkg/libarcane"
+	"github.com/getarcaneapp/arcane/backend/pkg/projects"
 	"github.com/getarcaneapp/arcane/backend/pkg/utils/stdcopy"
 	containertypes "github.com/getarcaneapp/arcane/types/container"
 	"github.com/getarcaneapp/arcane/types/containerregistry"
 	imagetypes "github.com/getarcaneapp/arcane/types/image"
 	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/api/types/mount"
 	"github.com/moby/moby/api/types/network"
 	"github.com/moby/moby/client"
+	ref "go.podman.io/image/v5/docker/reference"
 )
 
 type ContainerService struct {
@@ -66,7 +75,7 @@ func (s *ContainerService) StartContainer(ctx context.Context, containerID strin
 	if err != nil {
 		s.eventService.LogErrorEvent(ctx, models.EventTypeContainerError, "container", containerID, "", user.ID, user.Username, "0", err, models.JSON{"action": "start"})
 	}
-	return err
+	return projects.ExplainDeviceError(err)
 }
 
 func (s *ContainerService) StopContainer(ctx context.Context, containerID string, user models.User) error {
@@ -115,7 +124,7 @@ func (s *ContainerService) RestartContainer(ctx context.Context, containerID str
 	if err != nil {
 		s.eventService.LogErrorEvent(ctx, models.EventTypeContainerError, "container", containerID, "", user.ID, user.Username, "0", err, models.JSON{"action": "restart"})
 	}
-	return err
+	return projects.ExplainDeviceError(err)
 }
 
 func (s *ContainerService) GetContainerByID(ctx context.Context, id string) (*container.InspectResponse, error) {
@@ -194,37 +203,9 @@ func (s *ContainerService) CreateContainer(ctx context.Context, config *containe
 		return nil, fmt.Errorf("failed to connect to Docker: %w", err)
 	}
 
-	_, err = dockerClient.ImageInspect(ctx, config.Image)
-	if err != nil {
-		// Image not found locally, need to pull it
-		pullOptions, authErr := s.imageService.getPullOptionsWithAuth(ctx, config.Image, credentials)
-		if authErr != nil {
-			slog.WarnContext(ctx, "Failed to get registry authentication for container image; proceeding without auth",
-				"image", config.Image,
-				"error", authErr.Error())
-			pullOptions = client.ImagePullOptions{}
-		}
-
-		settings := s.settingsService.GetSettingsConfig()
-		pullCtx, pullCancel := timeouts.WithTimeout(ctx, settings.DockerImagePullTimeout.AsInt(), timeouts.DefaultDockerImagePull)
-		defer pullCancel()
-
-		reader, pullErr := dockerClient.ImagePull(pullCtx, config.Image, pullOptions)
-		if pullErr != nil {
-			if errors.Is(pullCtx.Err(), context.DeadlineExceeded) {
-				s.eventService.LogErrorEvent(ctx, models.EventTypeContainerError, "container", "", containerName, user.ID, user.Username, "0", pullErr, models.JSON{"action": "create", "image": config.Image, "step": "pull_image_timeout"})
-				return nil, fmt.Errorf("image pull timed out for %s (increase DOCKER_IMAGE_PULL_TIMEOUT or setting)", config.Image)
-			}
-			s.eventService.LogErrorEvent(ctx, models.EventTypeContainerError, "container", "", containerName, user.ID, user.Username, "0", pullErr, models.JSON{"action": "create", "image": config.Image, "step": "pull_image"})
-			return nil, fmt.Errorf("failed to pull image %s: %w", config.Image, pullErr)
-		}
-		defer func() { _ = reader.Close() }()
-
-		streamErr := dockerutils.ConsumeJSONMessageStream(reader, nil)
-		if streamErr != nil {
-			s.eventService.LogErrorEvent(ctx, models.EventTypeContainerError, "container", "", containerName, user.ID, user.Username, "0", streamErr, models.JSON{"action": "create", "image": config.Image, "step": "complete_pull"})
-			return nil, fmt.Errorf("failed to complete image pull: %w", streamErr)
-		}
+	if step, err := s.pullImageIfMissingInternal(ctx, dockerClient, config.Image, credentials); err != nil {
+		s.eventService.LogErrorEvent(ctx, models.EventTypeContainerError, "container", "", containerName, user.ID, user.Username, "0", err, models.JSON{"action": "create", "image": config.Image, "step": step})
+		return nil, err
 	}
 
 	resp, err := dockerClient.ContainerCreate(ctx, client.ContainerCreateOptions{
@@ -235,22 +216,23 @@ func (s *ContainerService) CreateContainer(ctx context.Context, config *containe
 	})
 	if err != nil {
 		s.eventService.LogErrorEvent(ctx, models.EventTypeContainerError, "container", "", containerName, user.ID, user.Username, "0", err, models.JSON{"action": "create", "image": config.Image, "step": "create"})
-		return nil, fmt.Errorf("failed to create container: %w", err)
+		return nil, fmt.Errorf("failed to create container: %w", projects.ExplainDeviceError(err))
 	}
 
 	metadata := models.JSON{
 		"action":      "create",
 		"containerId": resp.ID,
+		"image":       config.Image,
 	}
 
-	if logErr := s.eventService.LogContainerEvent(ctx, models.EventTypeContainerCreate, resp.ID, "name", user.ID, user.Username, "0", metadata); logErr != nil {
-		fmt.Printf("Could not log container stop action: %s\n", logErr)
+	if logErr := s.eventService.LogContainerEvent(ctx, models.EventTypeContainerCreate, resp.ID, containerName, user.ID, user.Username, "0", metadata); logErr != nil {
+		slog.WarnContext(ctx, "Failed to log container create event", "containerId", resp.ID, "error", logErr.Error())
 	}
 
 	if _, err := dockerClient.ContainerStart(ctx, resp.ID, client.ContainerStartOptions{}); err != nil {
 		_, _ = dockerClient.ContainerRemove(ctx, resp.ID, client.ContainerRemoveOptions{Force: true})
 		s.eventService.LogErrorEvent(ctx, models.EventTypeContainerError, "container", resp.ID, containerName, user.ID, user.Username, "0", err, models.JSON{"action": "create", "image": config.Image, "step": "start"})
-		return nil, fmt.Errorf("failed to start container: %w", err)
+		return nil, fmt.Errorf("failed to start container: %w", projects.ExplainDeviceError(err))
 	}
 
 	containerJSON, err := dockerClient.ContainerInspect(ctx, resp.ID, client.ContainerInspectOptions{})
@@ -263,6 +245,312 @@ func (s *ContainerService) CreateContainer(ctx context.Context, config *containe
 	return &containerInfo, nil
 }
 
+// pullImageIfMissingInternal pulls image unless it is already present. On
+// failure it returns the step that failed, for error events.
+func (s *ContainerService) pullImageIfMissingInternal(ctx context.Context, dockerClient *client.Client, image string, credentials []containerregistry.Credential) (string, error) {
+	if _, err := dockerClient.ImageInspect(ctx, image); err == nil {
+		return "", nil
+	}
+
+	pullOptions, authErr := s.imageService.getPullOptionsWithAuth(ctx, image, credentials)
+	if authErr != nil {
+		slog.WarnContext(ctx, "Failed to get registry authentication for container image; proceeding without auth",
+			"image", image,
+			"error", authErr.Error())
+		pullOptions = client.ImagePullOptions{}
+	}
+
+	settings := s.settingsService.GetSettingsConfig()
+	pullCtx, pullCancel := timeouts.WithTimeout(ctx, settings.DockerImagePullTimeout.AsInt(), timeouts.DefaultDockerImagePull)
+	defer pullCancel()
+
+	reader, pullErr := dockerClient.ImagePull(pullCtx, image, pullOptions)
+	if pullErr != nil {
+		if errors.Is(pullCtx.Err(), context.DeadlineExceeded) {
+			return "pull_image_timeout", fmt.Errorf("image pull timed out for %s (increase DOCKER_IMAGE_PULL_TIMEOUT or setting)", image)
+		}
+		return "pull_image", fmt.Errorf("failed to pull image %s: %w", image, pullErr)
+	}
+	defer func() { _ = reader.Close() }()
+
+	if streamErr := dockerutils.ConsumeJSONMessageStream(reader, nil); streamErr != nil {
+		return "complete_pull", fmt.Errorf("failed to complete image pull: %w", streamErr)
+	}
+	return "", nil
+}
+
+// EditContainer recreates a standalone container with the changes in req and
+// returns the diff against its current configuration. With dryRun, or when
+// nothing changes, the container is left alone. The old container is kept
+// until its replacement has started, and its anonymous volumes, networks,
+// aliases and addresses carry over to the new one.
+func (s *ContainerService) EditContainer(ctx context.Context, containerID string, req containertypes.EditRequest, dryRun bool, user models.User) (*containertypes.EditResult, error) {
+	dockerClient, err := s.dockerService.GetClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to Docker: %w", err)
+	}
+
+	current, err := dockerClient.ContainerInspect(ctx, containerID, client.ContainerInspectOptions{})
+	if err != nil {
+		if cerrdefs.IsNotFound(err) {
+			return nil, &models.NotFoundError{Message: fmt.Sprintf("container %s not found", containerID)}
+		}
+		return nil, fmt.Errorf("failed to inspect container: %w", err)
+	}
+	inspect := current.Container
+	if inspect.Config == nil || inspect.HostConfig == nil {
+		return nil, fmt.Errorf("container %s has no configuration", containerID)
+	}
+	name := strings.TrimPrefix(inspect.Name, "/")
+	if p := inspect.Config.Labels["com.docker.compose.project"]; p != "" {
+		return nil, &models.ConflictError{Message: fmt.Sprintf("container %s belongs to compose project %q; edit the project instead", name, p)}
+	}
+	if arcaneupdater.IsArcaneContainer(inspect.Config.Labels) {
+		return nil, &models.ConflictError{Message: "the Arcane container cannot be edited"}
+	}
+
+	cfg, hostConfig, err := applyContainerEditInternal(inspect, req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &containertypes.EditResult{
+		ContainerID:   inspect.ID,
+		ContainerName: name,
+		Changes:       diffContainerConfigInternal(inspect.Config, inspect.HostConfig, cfg, hostConfig),
+	}
+	if dryRun || len(result.Changes) == 0 {
+		return result, nil
+	}
+	// Stopping an auto-remove container deletes it, so it could not be
+	// put back if the recreate fails.
+	if inspect.HostConfig.AutoRemove {
+		return nil, &models.ConflictError{Message: fmt.Sprintf("container %s is started with --rm and cannot be recreated", name)}
+	}
+
+	metadata := models.JSON{"action": "edit", "containerId": inspect.ID, "image": cfg.Image}
+	fail := func(step string, err error) error {
+		s.eventService.LogErrorEvent(ctx, models.EventTypeContainerError, "container", inspect.ID, name, user.ID, user.Username, "0", err, models.JSON{"action": "edit", "step": step})
+		return err
+	}
+
+	if cfg.Image != inspect.Config.Image {
+		if step, err := s.pullImageIfMissingInternal(ctx, dockerClient, cfg.Image, nil); err != nil {
+			return nil, fail(step, err)
+		}
+	}
+
+	wasRunning := inspect.State != nil && inspect.State.Running
+	if wasRunning {
+		stopOpts := client.ContainerStopOptions{}
+		if signal := arcaneupdater.GetStopSignal(inspect.Config.Labels); signal != "" {
+			stopOpts.Signal = signal
+		}
+		if _, err := dockerClient.ContainerStop(ctx, inspect.ID, stopOpts); err != nil {
+			return nil, fail("stop", fmt.Errorf("failed to stop container: %w", err))
+		}
+	}
+	backupName := fmt.Sprintf("%s-pre-edit-%d", name, time.Now().Unix())
+	if _, err := dockerClient.ContainerRename(ctx, inspect.ID, client.ContainerRenameOptions{NewName: backupName}); err != nil {
+		rollbackRecreateInternal(ctx, dockerClient, inspect.ID, "", name, wasRunning)
+		return nil, fail("rename", fmt.Errorf("failed to rename container: %w", err))
+	}
+
+	// Anonymous volumes are mounted by name so the new container keeps their data.
+	hostConfig.Binds = append(slices.Clone(hostConfig.Binds), anonymousVolumeBindsInternal(inspect, *hostConfig)...)
+	edited := inspect
+	edited.Config, edited.HostConfig = cfg, hostConfig
+	apiVersion := libarcane.DetectDockerAPIVersion(ctx, dockerClient)
+	created, err := dockerClient.ContainerCreate(ctx, buildRecreateCreateOptionsInternal(edited, name, apiVersion))
+	if err != nil {
+		rollbackRecreateInternal(ctx, dockerClient, inspect.ID, "", name, wasRunning)
+		return nil, fail("create", fmt.Errorf("failed to create container: %w", err))
+	}
+	if wasRunning {
+		if _, err := dockerClient.ContainerStart(ctx, created.ID, client.ContainerStartOptions{}); err != nil {
+			rollbackRecreateInternal(ctx, dockerClient, inspect.ID, created.ID, name, wasRunning)
+			return nil, fail("start", fmt.Errorf("failed to start edited container: %w", err))
+		}
+	}
+
+	if _, err := dockerClient.ContainerRemove(ctx, inspect.ID, client.ContainerRemoveOptions{}); err != nil {
+		slog.WarnContext(ctx, "Failed to remove container replaced by edit", "container", backupName, "error", err)
+	}
+
+	changed := make([]string, len(result.Changes))
+	for i, c := range result.Changes {
+		changed[i] = c.Field
+	}
+	metadata["newContainerId"] = created.ID
+	metadata["changes"] = changed
+	if logErr := s.eventService.LogContainerEvent(ctx, models.EventTypeContainerUpdate, created.ID, name, user.ID, user.Username, "0", metadata); logErr != nil {
+		slog.WarnContext(ctx, "Failed to log container edit event", "containerId", created.ID, "error", logErr.Error())
+	}
+
+	result.ContainerID = created.ID
+	result.Applied = true
+	return result, nil
+}
+
+// applyContainerEditInternal returns copies of inspect's config and host
+// config with req applied.
+func applyContainerEditInternal(inspect container.InspectResponse, req containertypes.EditRequest) (*container.Config, *container.HostConfig, error) {
+	cfg := *inspect.Config
+	hostConfig := *inspect.HostConfig
+
+	if req.Image != nil {
+		image := strings.TrimSpace(*req.Image)
+		if _, err := ref.ParseNormalizedNamed(image); err != nil {
+			return nil, nil, &models.ValidationError{Message: fmt.Sprintf("invalid image reference %q: %v", image, err), Field: "image"}
+		}
+		cfg.Image = image
+	}
+
+	if req.Env != nil {
+		for _, entry := range req.Env {
+			if key, _, _ := strings.Cut(entry, "="); strings.TrimSpace(key) == "" {
+				return nil, nil, &models.ValidationError{Message: fmt.Sprintf("invalid environment variable %q", entry), Field: "env"}
+			}
+		}
+		cfg.Env = slices.Clone(req.Env)
+	}
+
+	if req.PortBindings != nil {
+		if hostConfig.NetworkMode.IsHost() || hostConfig.NetworkMode.IsContainer() {
+			return nil, nil, &models.ValidationError{Message: fmt.Sprintf("ports cannot be published in network mode %s", hostConfig.NetworkMode), Field: "portBindings"}
+		}
+		portBindings := network.PortMap{}
+		exposed := maps.Clone(cfg.ExposedPorts)
+		if exposed == nil {
+			exposed = network.PortSet{}
+		}
+		for spec, bindings := range req.PortBindings {
+			if !strings.Contains(spec, "/") {
+				spec += "/tcp"
+			}
+			port, err := network.ParsePort(spec)
+			if err != nil {
+				return nil, nil, &models.ValidationError{Message: fmt.Sprintf("invalid port %q: %v", spec, err), Field: "portBindings"}
+			}
+			exposed[port] = struct{}{}
+			for _, b := range bindings {
+				pb := network.PortBinding{HostPort: b.HostPort}
+				if hostIP := strings.TrimSpace(b.HostIP); hostIP != "" {
+					addr, err := netip.ParseAddr(hostIP)
+					if err != nil {
+						return nil, nil, &models.ValidationError{Message: fmt.Sprintf("invalid host IP %q", b.HostIP), Field: "portBindings"}
+					}
+					pb.HostIP = addr
+				}
+				portBindings[port] = append(portBindings[port], pb)
+			}
+		}
+		cfg.ExposedPorts = exposed
+		hostConfig.PortBindings = portBindings
+	}
+
+	if req.Binds != nil {
+		for _, bind := range req.Binds {
+			parts := strings.Split(bind, ":")
+			if len(parts) < 2 || parts[0] == "" || !path.IsAbs(parts[1]) {
+				return nil, nil, &models.ValidationError{Message: fmt.Sprintf("volume %q must be source:/destination[:options]", bind), Field: "binds"}
+			}
+		}
+		hostConfig.Binds = slices.Clone(req.Binds)
+	}
+
+	return &cfg, &hostConfig, nil
+}
+
+// anonymousVolumeBindsInternal returns name:destination binds for the volumes
+// of inspect that were created without a name, skipping destinations that
+// hostConfig already mounts something else at.
+func anonymousVolumeBindsInternal(inspect container.InspectResponse, hostConfig container.HostConfig) []string {
+	named := map[string]struct{}{}
+	taken := map[string]struct{}{}
+	for _, bind := range inspect.HostConfig.Binds {
+		if source, _, ok := strings.Cut(bind, ":"); ok {
+			named[source] = struct{}{}
+		}
+	}
+	for _, m := range inspect.HostConfig.Mounts {
+		named[m.Source] = struct{}{}
+	}
+	for _, bind := range hostConfig.Binds {
+		if parts := strings.Split(bind, ":"); len(parts) >= 2 {
+			taken[path.Clean(parts[1])] = struct{}{}
+		}
+	}
+	for _, m := range hostConfig.Mounts {
+		taken[path.Clean(m.Target)] = struct{}{}
+	}
+
+	var binds []string
+	for _, m := range inspect.Mounts {
+		if m.Type != mount.TypeVolume || m.Name == "" {
+			continue
+		}
+		if _, ok := named[m.Name]; ok {
+			continue
+		}
+		if _, ok := taken[path.Clean(m.Destination)]; ok {
+			continue
+		}
+		binds = append(binds, m.Name+":"+m.Destination)
+	}
+	return binds
+}
+
+// diffContainerConfigInternal compares the image, environment, published
+// ports and mounts of two container configurations.
+func diffContainerConfigInternal(beforeCfg *container.Config, beforeHost *container.HostConfig, afterCfg *container.Config, afterHost *container.HostConfig) []containertypes.ConfigChange {
+	fields := []struct {
+		name          string
+		before, after []string
+	}{
+		{"image", []string{beforeCfg.Image}, []string{afterCfg.Image}},
+		{"env", beforeCfg.Env, afterCfg.Env},
+		{"ports", describePortBindingsInternal(beforeHost.PortBindings), describePortBindingsInternal(afterHost.PortBindings)},
+		{"mounts", beforeHost.Binds, afterHost.Binds},
+	}
+
+	changes := []containertypes.ConfigChange{}
+	for _, f := range fields {
+		removed := differenceInternal(f.before, f.after)
+		added := differenceInternal(f.after, f.before)
+		if len(removed) > 0 || len(added) > 0 {
+			changes = append(changes, containertypes.ConfigChange{Field: f.name, Removed: removed, Added: added})
+		}
+	}
+	return changes
+}
+
+func describePortBindingsInternal(portBindings network.PortMap) []string {
+	var out []string
+	for port, bindings := range portBindings {
+		for _, b := range bindings {
+			host := b.HostPort
+			if b.HostIP.IsValid() && !b.HostIP.IsUnspecified() {
+				host = b.HostIP.String() + ":" + host
+			}
+			out = append(out, host+"->"+port.String())
+		}
+	}
+	slices.Sort(out)
+	return out
+}
+
+// differenceInternal returns the values of a that are not in b, in order.
+func differenceInternal(a, b []string) []string {
+	var out []string
+	for _, v := range a {
+		if v != "" && !slices.Contains(b, v) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
 func (s *ContainerService) StreamStats(ctx context.Context, containerID string, statsChan chan<- any) error {
 	dockerClient, err := s.dockerService.GetClient(ctx)
 	if err != nil {
@@ -436,6 +724,127 @@ func (s *ContainerService) readAllLogs(logs io.ReadCloser, logsChan chan<- strin
 	return nil
 }
 
+// ContainerLogSearchOptions selects the container log lines returned by
+// SearchLogs and ExportLogs.
+type ContainerLogSearchOptions struct {
+	// Query is a substring, or a regular expression when Regex is set.
+	Query         string
+	Regex         bool
+	CaseSensitive bool
+	// Stream is "stdout", "stderr" or empty for both.
+	Stream string
+	// Since and Until bound the time range and are passed to Docker as is.
+	Since string
+	Until string
+	// Limit caps the number of lines SearchLogs returns, keeping the most
+	// recent ones.
+	Limit int
+}
+
+const defaultLogSearchLimit = 1000
+
+// SearchLogs reads the logs of a container in the requested time range and
+// returns the lines that match the filter.
+func (s *ContainerService) SearchLogs(ctx context.Context, containerID string, opts ContainerLogSearchOptions) (*containertypes.LogSearchResult, error) {
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = defaultLogSearchLimit
+	}
+
+	result := &containertypes.LogSearchResult{Lines: []containertypes.LogLine{}}
+	err := s.scanLogsInternal(ctx, containerID, opts, &result.Scanned, func(line containertypes.LogLine) error {
+		result.Matched++
+		if len(result.Lines) == limit {
+			result.Lines = append(result.Lines[1:], line)
+			result.Truncated = true
+			return nil
+		}
+		result.Lines = append(result.Lines, line)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ExportLogs writes every matching log line to w as plain text. Limit is
+// ignored.
+func (s *ContainerService) ExportLogs(ctx context.Context, containerID string, opts ContainerLogSearchOptions, w io.Writer) error {
+	var scanned int
+	return s.scanLogsInternal(ctx, containerID, opts, &scanned, func(line containertypes.LogLine) error {
+		_, err := io.WriteString(w, dockerutils.FormatLogLine(line))
+		return err
+	})
+}
+
+// scanLogsInternal reads the container's logs with timestamps and calls
+// onMatch for every line that passes the filter, in the order Docker returns
+// them. scanned counts all lines read.
+func (s *ContainerService) scanLogsInternal(ctx context.Context, containerID string, opts ContainerLogSearchOptions, scanned *int, onMatch func(containertypes.LogLine) error) error {
+	filter, err := dockerutils.NewLogFilter(opts.Query, opts.Regex, opts.CaseSensitive, opts.Stream)
+	if err != nil {
+		return &models.ValidationError{Message: err.Error(), Field: "query"}
+	}
+
+	dockerClient, err := s.dockerService.GetClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to connect to Docker: %w", err)
+	}
+
+	inspect, err := dockerClient.ContainerInspect(ctx, containerID, client.ContainerInspectOptions{})
+	if err != nil {
+		if cerrdefs.IsNotFound(err) {
+			return &models.NotFoundError{Message: fmt.Sprintf("container %s not found", containerID)}
+		}
+		return fmt.Errorf("failed to inspect container: %w", err)
+	}
+
+	logs, err := dockerClient.ContainerLogs(ctx, containerID, client.ContainerLogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Since:      opts.Since,
+		Until:      opts.Until,
+		Timestamps: true,
+	})
+	if err != nil {
+		if cerrdefs.IsInvalidArgument(err) {
+			return &models.ValidationError{Message: err.Error(), Field: "since"}
+		}
+		return fmt.Errorf("failed to get container logs: %w", err)
+	}
+	defer func() { _ = logs.Close() }()
+
+	onLine := func(line containertypes.LogLine) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		*scanned++
+		if !filter.Match(line) {
+			return nil
+		}
+		return onMatch(line)
+	}
+	stdout := dockerutils.NewLogLineWriter(dockerutils.LogStreamStdout, onLine)
+	stderr := dockerutils.NewLogLineWriter(dockerutils.LogStreamStderr, onLine)
+
+	// Containers with a TTY have a single raw stream instead of the
+	// multiplexed stdout/stderr format.
+	if inspect.Container.Config != nil && inspect.Container.Config.Tty {
+		_, err = io.Copy(stdout, logs)
+	} else {
+		_, err = stdcopy.StdCopy(stdout, stderr, logs)
+	}
+	if err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("failed to read container logs: %w", err)
+	}
+
+	if err := stdout.Flush(); err != nil {
+		return err
+	}
+	return stderr.Flush()
+}
+
 func (s *ContainerService) ListContainersPaginated(ctx context.Context, params pagination.QueryParams, includeAll bool, includeInternal bool) ([]containertypes.Summary, pagination.Response, containertypes.StatusCounts, error) {
 	dockerClient, err := s.dockerService.GetClient(ctx)
 	if err != nil {
@@ -452,15 +861,57 @@ func (s *ContainerService) ListContainersPaginated(ctx context.Context, params p
 	imageIDs := collectImageIDs(dockerContainers)
 	updateInfoMap := s.getUpdateInfoMap(ctx, imageIDs)
 	items := s.buildContainerSummaries(dockerContainers, updateInfoMap)
+	counts := s.calculateContainerStatusCounts(items)
+
+	var tagFilter string
+	params.Filters, tagFilter = popTagFilterInternal(params.Filters)
+	if tagFilter != "" {
+		tagged, err := taggedResourceIDsInternal(ctx, s.db, models.TagResourceContainer, tagFilter)
+		if err != nil {
+			return nil, pagination.Response{}, containertypes.StatusCounts{}, err
+		}
+		items = slices.DeleteFunc(items, func(c containertypes.Summary) bool {
+			_, ok := tagged[containerTagNameInternal(c)]
+			return !ok
+		})
+	}
 
 	config := s.buildContainerPaginationConfig()
 	result := pagination.SearchOrderAndPaginate(items, params, config)
-	counts := s.calculateContainerStatusCounts(items)
 	paginationResp := pagination.BuildResponseFromFilterResult(result, params)
 
+	if err := s.attachContainerTagsInternal(ctx, result.Items); err != nil {
+		slog.WarnContext(ctx, "Failed to load container tags", "error", err)
+	}
+
 	return result.Items, paginationResp, counts, nil
 }
 
+// attachContainerTagsInternal fills in the tags of a page of containers.
+func (s *ContainerService) attachContainerTagsInternal(ctx context.Context, items []containertypes.Summary) error {
+	names := make([]string, 0, len(items))
+	for _, c := range items {
+		names = append(names, containerTagNameInternal(c))
+	}
+	tags, err := resourceTagsInternal(ctx, s.db, models.TagResourceContainer, names)
+	if err != nil {
+		return err
+	}
+	for i := range items {
+		items[i].Tags = tags[containerTagNameInternal(items[i])]
+	}
+	return nil
+}
+
+// containerTagNameInternal returns the name a container's tags are stored
+// under.
+func containerTagNameInternal(c containertypes.Summary) string {
+	if len(c.Names) == 0 {
+		return c.ID
+	}
+	return strings.TrimPrefix(c.Names[0], "/")
+}
+
 func filterInternalContainers(containers []container.Summary, includeInternal bool) []container.Summary {
 	if includeInternal {
 		return containers
@@ -694,3 +1145,21 @@ func (s *ContainerService) AttachExec(ctx context.Context, containerID, execID s
 		dockerClient: dockerClient,
 	}, nil
 }
+
+// rollbackRecreateInternal removes a half-created replacement and puts the
+// original container back under its name.
+func rollbackRecreateInternal(ctx context.Context, dockerClient *client.Client, originalID, createdID, name string, wasRunning bool) {
+	if createdID != "" {
+		if _, err := dockerClient.ContainerRemove(ctx, createdID, client.ContainerRemoveOptions{Force: true}); err != nil {
+			slog.WarnContext(ctx, "Failed to remove container created by failed recreate", "container", createdID, "error", err)
+		}
+	}
+	if _, err := dockerClient.ContainerRename(ctx, originalID, client.ContainerRenameOptions{NewName: name}); err != nil {
+		slog.WarnContext(ctx, "Failed to rename container back after failed recreate", "container", originalID, "error", err)
+	}
+	if wasRunning {
+		if _, err := dockerClient.ContainerStart(ctx, originalID, client.ContainerStartOptions{}); err != nil {
+			slog.WarnContext(ctx, "Failed to restart container after failed recreate", "container", originalID, "error", err)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	containertypes "github.com/getarcaneapp/arcane/types/container"
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/api/types/mount"
+	"github.com/moby/moby/api/types/network"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func editTestInspect(t *testing.T) container.InspectResponse {
+	t.Helper()
+	port, err := network.ParsePort("80/tcp")
+	require.NoError(t, err)
+
+	return container.InspectResponse{
+		ID:   "abc",
+		Name: "/web",
+		Config: &container.Config{
+			Image:        "nginx:1.25",
+			Env:          []string{"MODE=prod", "PATH=/usr/bin"},
+			ExposedPorts: network.PortSet{port: {}},
+		},
+		HostConfig: &container.HostConfig{
+			Binds:        []string{"web_data:/data"},
+			PortBindings: network.PortMap{port: {{HostPort: "8080"}}},
+		},
+		Mounts: []container.MountPoint{
+			{Type: mount.TypeVolume, Name: "web_data", Destination: "/data"},
+			{Type: mount.TypeVolume, Name: "3f1c0e6b2a", Destination: "/var/cache/nginx"},
+		},
+	}
+}
+
+func TestApplyContainerEditInternal(t *testing.T) {
+	inspect := editTestInspect(t)
+
+	cfg, hostConfig, err := applyContainerEditInternal(inspect, containertypes.EditRequest{
+		Image: new("nginx:1.27"),
+		Env:   []string{"MODE=staging", "PATH=/usr/bin"},
+		PortBindings: map[string][]containertypes.PortBindingCreate{
+			"80": {{HostIP: "127.0.0.1", HostPort: "9090"}},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "nginx:1.25", inspect.Config.Image, "the original config is left alone")
+	assert.Equal(t, []containertypes.ConfigChange{
+		{Field: "image", Removed: []string{"nginx:1.25"}, Added: []string{"nginx:1.27"}},
+		{Field: "env", Removed: []string{"MODE=prod"}, Added: []string{"MODE=staging"}},
+		{Field: "ports", Removed: []string{"8080->80/tcp"}, Added: []string{"127.0.0.1:9090->80/tcp"}},
+	}, diffContainerConfigInternal(inspect.Config, inspect.HostConfig, cfg, hostConfig))
+}
+
+func TestApplyContainerEditInternal_Invalid(t *testing.T) {
+	inspect := editTestInspect(t)
+
+	_, _, err := applyContainerEditInternal(inspect, containertypes.EditRequest{Binds: []string{"data:relative"}})
+	var validationErr *models.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "binds", validationErr.Field)
+
+	_, _, err = applyContainerEditInternal(inspect, containertypes.EditRequest{Image: new("Not An Image")})
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "image", validationErr.Field)
+}
+
+func TestAnonymousVolumeBindsInternal(t *testing.T) {
+	inspect := editTestInspect(t)
+
+	assert.Equal(t, []string{"3f1c0e6b2a:/var/cache/nginx"}, anonymousVolumeBindsInternal(inspect, *inspect.HostConfig))
+
+	edited := *inspect.HostConfig
+	edited.Binds = []string{"/srv/cache:/var/cache/nginx"}
+	assert.Empty(t, anonymousVolumeBindsInternal(inspect, edited), "a new mount at the same destination wins")
+}
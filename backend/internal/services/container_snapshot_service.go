@@ -0,0 +1,361 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	dockerutils "github.com/getarcaneapp/arcane/backend/internal/utils/docker"
+	"github.com/getarcaneapp/arcane/backend/pkg/libarcane"
+	containertypes "github.com/getarcaneapp/arcane/types/container"
+	"github.com/moby/moby/api/types/image"
+	"github.com/moby/moby/client"
+)
+
+const (
+	snapshotLabel            = "com.getarcaneapp.arcane.snapshot"
+	snapshotContainerLabel   = "com.getarcaneapp.arcane.snapshot.container"
+	snapshotContainerIDLabel = "com.getarcaneapp.arcane.snapshot.container-id"
+	snapshotSourceImageLabel = "com.getarcaneapp.arcane.snapshot.source-image"
+	snapshotCommentLabel     = "com.getarcaneapp.arcane.snapshot.comment"
+
+	snapshotRepositoryPrefix = "arcane-snapshot/"
+)
+
+var snapshotRepositoryInvalidChars = regexp.MustCompile(`[^a-z0-9._-]+`)
+
+// ContainerSnapshotService commits containers to images and recreates
+// containers from those images, as a safety net before risky changes made
+// inside a container. Snapshots are plain images marked with Arcane labels,
+// so they live on the Docker host and need no database records.
+type ContainerSnapshotService struct {
+	dockerService   *DockerClientService
+	registryService *ContainerRegistryService
+	eventService    *EventService
+	settingsService *SettingsService
+}
+
+func NewContainerSnapshotService(dockerService *DockerClientService, registryService *ContainerRegistryService, eventService *EventService, settingsService *SettingsService) *ContainerSnapshotService {
+	return &ContainerSnapshotService{
+		dockerService:   dockerService,
+		registryService: registryService,
+		eventService:    eventService,
+		settingsService: settingsService,
+	}
+}
+
+// CreateSnapshot commits a container to an image, optionally pushes it, and
+// then removes the container's oldest snapshots beyond the retention limit.
+func (s *ContainerSnapshotService) CreateSnapshot(ctx context.Context, containerID string, req containertypes.CreateSnapshotRequest, user models.User) (*containertypes.Snapshot, error) {
+	dockerClient, err := s.dockerService.GetClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to Docker: %w", err)
+	}
+
+	inspect, err := dockerClient.ContainerInspect(ctx, containerID, client.ContainerInspectOptions{})
+	if err != nil {
+		return nil, &models.NotFoundError{Message: fmt.Sprintf("container %s not found", containerID)}
+	}
+	name := strings.TrimPrefix(inspect.Container.Name, "/")
+
+	reference := strings.TrimSpace(req.Reference)
+	if reference == "" {
+		reference = defaultSnapshotReferenceInternal(name, time.Now().UTC())
+	}
+
+	sourceImage := ""
+	if inspect.Container.Config != nil {
+		sourceImage = inspect.Container.Config.Image
+	}
+	labels := map[string]string{
+		snapshotLabel:            "true",
+		snapshotContainerLabel:   name,
+		snapshotContainerIDLabel: inspect.Container.ID,
+		snapshotSourceImageLabel: sourceImage,
+	}
+	if req.Comment != "" {
+		labels[snapshotCommentLabel] = req.Comment
+	}
+
+	result, err := dockerClient.ContainerCommit(ctx, inspect.Container.ID, client.ContainerCommitOptions{
+		Reference: reference,
+		Comment:   req.Comment,
+		Author:    user.Username,
+		Changes:   snapshotLabelChangesInternal(labels),
+		NoPause:   req.NoPause,
+	})
+	if err != nil {
+		s.eventService.LogErrorEvent(ctx, models.EventTypeContainerError, "container", inspect.Container.ID, name, user.ID, user.Username, "0", err, models.JSON{"action": "snapshot"})
+		return nil, fmt.Errorf("failed to commit container: %w", err)
+	}
+
+	if req.Push {
+		if err := s.pushSnapshotInternal(ctx, dockerClient, reference); err != nil {
+			// The snapshot itself was taken; report the push failure without
+			// discarding it.
+			return nil, fmt.Errorf("snapshot %s was created but could not be pushed: %w", reference, err)
+		}
+	}
+
+	_, _ = s.eventService.CreateEvent(ctx, CreateEventRequest{
+		Type:         models.EventTypeContainerSnapshot,
+		Title:        "Container snapshot created: " + name,
+		Description:  fmt.Sprintf("Committed %s to %s", name, reference),
+		ResourceType: new("container"),
+		ResourceID:   new(inspect.Container.ID),
+		ResourceName: new(name),
+		UserID:       new(user.ID),
+		Username:     new(user.Username),
+		Metadata:     models.JSON{"action": "snapshot", "imageId": result.ID, "reference": reference, "pushed": req.Push},
+	})
+
+	s.enforceRetentionInternal(ctx, dockerClient, name)
+
+	snapshot, err := s.GetSnapshot(ctx, result.ID)
+	if err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// ListSnapshots returns snapshots, newest first. A non-empty container, given
+// by ID or name, limits them to snapshots of that container. Snapshots are
+// matched by name, so those of a removed container can still be listed.
+func (s *ContainerSnapshotService) ListSnapshots(ctx context.Context, container string) ([]containertypes.Snapshot, error) {
+	dockerClient, err := s.dockerService.GetClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to Docker: %w", err)
+	}
+
+	name := strings.TrimPrefix(container, "/")
+	if name != "" {
+		if inspect, err := dockerClient.ContainerInspect(ctx, name, client.ContainerInspectOptions{}); err == nil {
+			name = strings.TrimPrefix(inspect.Container.Name, "/")
+		}
+	}
+	return listSnapshotsInternal(ctx, dockerClient, name)
+}
+
+// GetSnapshot returns the snapshot with the given image ID.
+func (s *ContainerSnapshotService) GetSnapshot(ctx context.Context, snapshotID string) (*containertypes.Snapshot, error) {
+	snapshots, err := s.ListSnapshots(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	id := strings.TrimPrefix(snapshotID, "sha256:")
+	for i := range snapshots {
+		if strings.HasPrefix(strings.TrimPrefix(snapshots[i].ID, "sha256:"), id) {
+			return &snapshots[i], nil
+		}
+	}
+	return nil, &models.NotFoundError{Message: fmt.Sprintf("snapshot %s not found", snapshotID)}
+}
+
+// DeleteSnapshot removes a snapshot image.
+func (s *ContainerSnapshotService) DeleteSnapshot(ctx context.Context, snapshotID string, user models.User) error {
+	snapshot, err := s.GetSnapshot(ctx, snapshotID)
+	if err != nil {
+		return err
+	}
+
+	dockerClient, err := s.dockerService.GetClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to connect to Docker: %w", err)
+	}
+	if _, err := dockerClient.ImageRemove(ctx, snapshot.ID, client.ImageRemoveOptions{Force: true, PruneChildren: true}); err != nil {
+		return fmt.Errorf("failed to remove snapshot: %w", err)
+	}
+
+	if logErr := s.eventService.LogImageEvent(ctx, models.EventTypeImageDelete, snapshot.ID, snapshot.Reference, user.ID, user.Username, "0", models.JSON{"action": "delete_snapshot", "container": snapshot.ContainerName}); logErr != nil {
+		slog.WarnContext(ctx, "could not log snapshot deletion", "err", logErr, "snapshot", snapshot.Reference)
+	}
+	return nil
+}
+
+// RestoreSnapshot recreates the snapshot's container from the snapshot
+// image, keeping the container's current configuration. The old container is
+// kept until the new one has started, and is put back if it fails to.
+func (s *ContainerSnapshotService) RestoreSnapshot(ctx context.Context, snapshotID string, user models.User) (*containertypes.RestoreSnapshotResult, error) {
+	snapshot, err := s.GetSnapshot(ctx, snapshotID)
+	if err != nil {
+		return nil, err
+	}
+
+	dockerClient, err := s.dockerService.GetClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to Docker: %w", err)
+	}
+
+	current, err := dockerClient.ContainerInspect(ctx, snapshot.ContainerName, client.ContainerInspectOptions{})
+	if err != nil {
+		return nil, &models.NotFoundError{Message: fmt.Sprintf("container %s no longer exists; create it from image %s instead", snapshot.ContainerName, snapshot.Reference)}
+	}
+	inspect := current.Container
+	if inspect.Config == nil || inspect.HostConfig == nil {
+		return nil, fmt.Errorf("container %s has no configuration", snapshot.ContainerName)
+	}
+	wasRunning := inspect.State != nil && inspect.State.Running
+
+	if _, err := dockerClient.ContainerStop(ctx, inspect.ID, client.ContainerStopOptions{}); err != nil {
+		return nil, fmt.Errorf("failed to stop container: %w", err)
+	}
+	backupName := fmt.Sprintf("%s-pre-restore-%d", snapshot.ContainerName, time.Now().Unix())
+	if _, err := dockerClient.ContainerRename(ctx, inspect.ID, client.ContainerRenameOptions{NewName: backupName}); err != nil {
+		rollbackRecreateInternal(ctx, dockerClient, inspect.ID, "", snapshot.ContainerName, wasRunning)
+		return nil, fmt.Errorf("failed to rename container: %w", err)
+	}
+
+	inspect.Config.Image = snapshot.ID
+	apiVersion := libarcane.DetectDockerAPIVersion(ctx, dockerClient)
+
+	created, err := dockerClient.ContainerCreate(ctx, buildRecreateCreateOptionsInternal(inspect, snapshot.ContainerName, apiVersion))
+	if err != nil {
+		rollbackRecreateInternal(ctx, dockerClient, inspect.ID, "", snapshot.ContainerName, wasRunning)
+		return nil, fmt.Errorf("failed to create container from snapshot: %w", err)
+	}
+	if wasRunning {
+		if _, err := dockerClient.ContainerStart(ctx, created.ID, client.ContainerStartOptions{}); err != nil {
+			rollbackRecreateInternal(ctx, dockerClient, inspect.ID, created.ID, snapshot.ContainerName, wasRunning)
+			return nil, fmt.Errorf("failed to start container from snapshot: %w", err)
+		}
+	}
+
+	if _, err := dockerClient.ContainerRemove(ctx, inspect.ID, client.ContainerRemoveOptions{}); err != nil {
+		slog.WarnContext(ctx, "Failed to remove container replaced by snapshot restore", "container", backupName, "error", err)
+	}
+
+	_, _ = s.eventService.CreateEvent(ctx, CreateEventRequest{
+		Type:         models.EventTypeContainerSnapshotRestore,
+		Title:        "Container restored from snapshot: " + snapshot.ContainerName,
+		Description:  fmt.Sprintf("Recreated %s from %s", snapshot.ContainerName, snapshot.Reference),
+		ResourceType: new("container"),
+		ResourceID:   new(created.ID),
+		ResourceName: new(snapshot.ContainerName),
+		UserID:       new(user.ID),
+		Username:     new(user.Username),
+		Metadata:     models.JSON{"action": "restore_snapshot", "oldContainerId": inspect.ID, "snapshotId": snapshot.ID, "reference": snapshot.Reference},
+	})
+
+	return &containertypes.RestoreSnapshotResult{
+		ContainerID:   created.ID,
+		ContainerName: snapshot.ContainerName,
+		Snapshot:      *snapshot,
+	}, nil
+}
+
+func (s *ContainerSnapshotService) pushSnapshotInternal(ctx context.Context, dockerClient *client.Client, reference string) error {
+	pushOptions := client.ImagePushOptions{}
+	if s.registryService != nil {
+		if authHeader, err := s.registryService.GetRegistryAuthForImage(ctx, reference); err != nil {
+			slog.WarnContext(ctx, "Failed to get registry authentication for snapshot; pushing without auth", "image", reference, "error", err)
+		} else {
+			pushOptions.RegistryAuth = authHeader
+		}
+	}
+
+	pushResp, err := dockerClient.ImagePush(ctx, reference, pushOptions)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = pushResp.Close() }()
+	return dockerutils.ConsumeJSONMessageStream(pushResp, nil)
+}
+
+// enforceRetentionInternal removes a container's oldest snapshots beyond the
+// containerSnapshotRetention setting. Zero keeps every snapshot.
+func (s *ContainerSnapshotService) enforceRetentionInternal(ctx context.Context, dockerClient *client.Client, containerName string) {
+	keep := s.settingsService.GetIntSetting(ctx, "containerSnapshotRetention", 5)
+	if keep <= 0 {
+		return
+	}
+
+	snapshots, err := listSnapshotsInternal(ctx, dockerClient, containerName)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to list snapshots for retention", "container", containerName, "error", err)
+		return
+	}
+	for _, snapshot := range snapshotsBeyondRetentionInternal(snapshots, keep) {
+		if _, err := dockerClient.ImageRemove(ctx, snapshot.ID, client.ImageRemoveOptions{Force: true, PruneChildren: true}); err != nil {
+			slog.WarnContext(ctx, "Failed to remove expired snapshot", "snapshot", snapshot.Reference, "error", err)
+			continue
+		}
+		slog.InfoContext(ctx, "Removed expired snapshot", "snapshot", snapshot.Reference, "container", containerName)
+	}
+}
+
+func listSnapshotsInternal(ctx context.Context, dockerClient *client.Client, containerName string) ([]containertypes.Snapshot, error) {
+	filters := make(client.Filters).Add("label", snapshotLabel+"=true")
+	if containerName != "" {
+		filters = filters.Add("label", snapshotContainerLabel+"="+containerName)
+	}
+
+	list, err := dockerClient.ImageList(ctx, client.ImageListOptions{Filters: filters})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list snapshots: %w", err)
+	}
+
+	snapshots := make([]containertypes.Snapshot, 0, len(list.Items))
+	for _, summary := range list.Items {
+		snapshots = append(snapshots, snapshotFromImageInternal(summary))
+	}
+	slices.SortFunc(snapshots, func(a, b containertypes.Snapshot) int {
+		return cmp.Or(b.CreatedAt.Compare(a.CreatedAt), strings.Compare(a.Reference, b.Reference))
+	})
+	return snapshots, nil
+}
+
+func snapshotFromImageInternal(summary image.Summary) containertypes.Snapshot {
+	snapshot := containertypes.Snapshot{
+		ID:            summary.ID,
+		Reference:     summary.ID,
+		ContainerName: summary.Labels[snapshotContainerLabel],
+		ContainerID:   summary.Labels[snapshotContainerIDLabel],
+		SourceImage:   summary.Labels[snapshotSourceImageLabel],
+		Comment:       summary.Labels[snapshotCommentLabel],
+		Pushed:        len(summary.RepoDigests) > 0,
+		Size:          summary.Size,
+		CreatedAt:     time.Unix(summary.Created, 0).UTC(),
+	}
+	if len(summary.RepoTags) > 0 {
+		snapshot.Reference = summary.RepoTags[0]
+	}
+	return snapshot
+}
+
+// snapshotsBeyondRetentionInternal returns the snapshots to remove so that
+// only the newest keep remain. snapshots must be sorted newest first.
+func snapshotsBeyondRetentionInternal(snapshots []containertypes.Snapshot, keep int) []containertypes.Snapshot {
+	if keep <= 0 || len(snapshots) <= keep {
+		return nil
+	}
+	return snapshots[keep:]
+}
+
+// defaultSnapshotReferenceInternal names a snapshot after its container and
+// the time it was taken, e.g. arcane-snapshot/web:20240102-150405.
+func defaultSnapshotReferenceInternal(containerName string, at time.Time) string {
+	repository := strings.Trim(snapshotRepositoryInvalidChars.ReplaceAllString(strings.ToLower(containerName), "-"), "-._")
+	if repository == "" {
+		repository = "container"
+	}
+	return snapshotRepositoryPrefix + repository + ":" + at.Format("20060102-150405")
+}
+
+// snapshotLabelChangesInternal turns labels into LABEL instructions for the
+// commit, in a stable order.
+func snapshotLabelChangesInternal(labels map[string]string) []string {
+	keys := slices.Sorted(maps.Keys(labels))
+	changes := make([]string, len(keys))
+	for i, key := range keys {
+		changes[i] = "LABEL " + key + "=" + strconv.Quote(labels[key])
+	}
+	return changes
+}
@@ -0,0 +1,58 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	containertypes "github.com/getarcaneapp/arcane/types/container"
+	"github.com/moby/moby/api/types/image"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultSnapshotReferenceInternal(t *testing.T) {
+	at := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+	assert.Equal(t, "arcane-snapshot/web:20240102-150405", defaultSnapshotReferenceInternal("web", at))
+	assert.Equal(t, "arcane-snapshot/my-app_db-1:20240102-150405", defaultSnapshotReferenceInternal("My App_db-1", at))
+	assert.Equal(t, "arcane-snapshot/container:20240102-150405", defaultSnapshotReferenceInternal("!!!", at))
+}
+
+func TestSnapshotLabelChangesInternal(t *testing.T) {
+	changes := snapshotLabelChangesInternal(map[string]string{
+		snapshotLabel:        "true",
+		snapshotCommentLabel: `before "upgrade"`,
+	})
+	assert.Equal(t, []string{
+		`LABEL com.getarcaneapp.arcane.snapshot="true"`,
+		`LABEL com.getarcaneapp.arcane.snapshot.comment="before \"upgrade\""`,
+	}, changes)
+}
+
+func TestSnapshotFromImageInternal(t *testing.T) {
+	snapshot := snapshotFromImageInternal(image.Summary{
+		ID:       "sha256:abc",
+		RepoTags: []string{"arcane-snapshot/web:20240102-150405"},
+		Created:  1704207845,
+		Size:     42,
+		Labels: map[string]string{
+			snapshotContainerLabel:   "web",
+			snapshotContainerIDLabel: "c1",
+			snapshotSourceImageLabel: "nginx:1.27",
+		},
+	})
+	assert.Equal(t, "arcane-snapshot/web:20240102-150405", snapshot.Reference)
+	assert.Equal(t, "web", snapshot.ContainerName)
+	assert.Equal(t, "nginx:1.27", snapshot.SourceImage)
+	assert.False(t, snapshot.Pushed)
+	assert.Equal(t, time.Unix(1704207845, 0).UTC(), snapshot.CreatedAt)
+
+	untagged := snapshotFromImageInternal(image.Summary{ID: "sha256:def", RepoDigests: []string{"registry/web@sha256:1"}})
+	assert.Equal(t, "sha256:def", untagged.Reference)
+	assert.True(t, untagged.Pushed)
+}
+
+func TestSnapshotsBeyondRetentionInternal(t *testing.T) {
+	snapshots := []containertypes.Snapshot{{ID: "3"}, {ID: "2"}, {ID: "1"}}
+	assert.Nil(t, snapshotsBeyondRetentionInternal(snapshots, 0))
+	assert.Nil(t, snapshotsBeyondRetentionInternal(snapshots, 3))
+	assert.Equal(t, []containertypes.Snapshot{{ID: "1"}}, snapshotsBeyondRetentionInternal(snapshots, 2))
+}
@@ -0,0 +1,584 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/getarcaneapp/arcane/backend/internal/database"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/pkg/utils/stdcopy"
+	"github.com/getarcaneapp/arcane/types/containertask"
+	"github.com/moby/moby/client"
+	"github.com/robfig/cron/v3"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrContainerTaskNotFound = errors.New("container task not found")
+	ErrContainerTaskInvalid  = errors.New("invalid container task")
+	ErrContainerTaskRunning  = errors.New("container task is already running")
+)
+
+const (
+	defaultContainerTaskTimeoutSeconds = 300
+	maxContainerTaskTimeoutSeconds     = 24 * 60 * 60
+	maxContainerTaskConcurrentRuns     = 4
+	maxContainerTaskOutputBytes        = 64 * 1024
+	containerTaskRunsToKeep            = 50
+)
+
+// containerTaskScheduleParser accepts both the five field cron format used by
+// host crontabs and the six field format with seconds used by Arcane's own
+// jobs, as well as descriptors such as @daily.
+var containerTaskScheduleParser = cron.NewParser(cron.SecondOptional | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// ContainerTaskService manages commands that run inside containers on a cron
+// schedule, and records the output of each run.
+type ContainerTaskService struct {
+	db                  *database.DB
+	dockerService       *DockerClientService
+	eventService        *EventService
+	notificationService *NotificationService
+	running             sync.Map // task ID -> struct{}; prevents overlapping runs
+}
+
+func NewContainerTaskService(db *database.DB, dockerService *DockerClientService, eventService *EventService, notificationService *NotificationService) *ContainerTaskService {
+	return &ContainerTaskService{
+		db:                  db,
+		dockerService:       dockerService,
+		eventService:        eventService,
+		notificationService: notificationService,
+	}
+}
+
+func (s *ContainerTaskService) ListTasks(ctx context.Context) ([]containertask.Task, error) {
+	var tasks []models.ContainerTask
+	if err := s.db.WithContext(ctx).Order("name ASC").Find(&tasks).Error; err != nil {
+		return nil, fmt.Errorf("failed to list container tasks: %w", err)
+	}
+
+	result := make([]containertask.Task, len(tasks))
+	for i := range tasks {
+		result[i] = toContainerTaskDto(&tasks[i])
+	}
+	return result, nil
+}
+
+func (s *ContainerTaskService) GetTask(ctx context.Context, id string) (*containertask.Task, error) {
+	t, err := s.getTaskModel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	dto := toContainerTaskDto(t)
+	return &dto, nil
+}
+
+func (s *ContainerTaskService) CreateTask(ctx context.Context, req containertask.CreateTask) (*containertask.Task, error) {
+	t := &models.ContainerTask{
+		Name:            strings.TrimSpace(req.Name),
+		ContainerName:   strings.TrimPrefix(strings.TrimSpace(req.ContainerName), "/"),
+		Command:         strings.TrimSpace(req.Command),
+		User:            normalizeScopeID(req.User),
+		Schedule:        strings.TrimSpace(req.Schedule),
+		TimeoutSeconds:  req.TimeoutSeconds,
+		NotifyOnFailure: req.NotifyOnFailure == nil || *req.NotifyOnFailure,
+		Enabled:         req.Enabled == nil || *req.Enabled,
+	}
+	if t.TimeoutSeconds <= 0 {
+		t.TimeoutSeconds = defaultContainerTaskTimeoutSeconds
+	}
+	schedule, err := validateContainerTask(t)
+	if err != nil {
+		return nil, err
+	}
+	t.NextRunAt = nextContainerTaskRun(t, schedule, time.Now())
+
+	if err := s.db.WithContext(ctx).Create(t).Error; err != nil {
+		return nil, fmt.Errorf("failed to create container task: %w", err)
+	}
+
+	dto := toContainerTaskDto(t)
+	return &dto, nil
+}
+
+func (s *ContainerTaskService) UpdateTask(ctx context.Context, id string, req containertask.UpdateTask) (*containertask.Task, error) {
+	t, err := s.getTaskModel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		t.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.ContainerName != nil {
+		t.ContainerName = strings.TrimPrefix(strings.TrimSpace(*req.ContainerName), "/")
+	}
+	if req.Command != nil {
+		t.Command = strings.TrimSpace(*req.Command)
+	}
+	if req.User != nil {
+		t.User = normalizeScopeID(req.User)
+	}
+	if req.Schedule != nil {
+		t.Schedule = strings.TrimSpace(*req.Schedule)
+	}
+	if req.TimeoutSeconds != nil && *req.TimeoutSeconds > 0 {
+		t.TimeoutSeconds = *req.TimeoutSeconds
+	}
+	if req.NotifyOnFailure != nil {
+		t.NotifyOnFailure = *req.NotifyOnFailure
+	}
+	if req.Enabled != nil {
+		t.Enabled = *req.Enabled
+	}
+	schedule, err := validateContainerTask(t)
+	if err != nil {
+		return nil, err
+	}
+	t.NextRunAt = nextContainerTaskRun(t, schedule, time.Now())
+
+	if err := s.db.WithContext(ctx).Save(t).Error; err != nil {
+		return nil, fmt.Errorf("failed to update container task: %w", err)
+	}
+
+	dto := toContainerTaskDto(t)
+	return &dto, nil
+}
+
+func (s *ContainerTaskService) DeleteTask(ctx context.Context, id string) error {
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("task_id = ?", id).Delete(&models.ContainerTaskRun{}).Error; err != nil {
+			return fmt.Errorf("failed to delete container task runs: %w", err)
+		}
+		result := tx.Delete(&models.ContainerTask{}, "id = ?", id)
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete container task: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return ErrContainerTaskNotFound
+		}
+		return nil
+	})
+	return err
+}
+
+// ListRuns returns the most recent runs of a task, newest first.
+func (s *ContainerTaskService) ListRuns(ctx context.Context, taskID string, limit int) ([]containertask.Run, error) {
+	if _, err := s.getTaskModel(ctx, taskID); err != nil {
+		return nil, err
+	}
+	if limit <= 0 || limit > containerTaskRunsToKeep {
+		limit = containerTaskRunsToKeep
+	}
+
+	var runs []models.ContainerTaskRun
+	if err := s.db.WithContext(ctx).Where("task_id = ?", taskID).Order("started_at DESC").Limit(limit).Find(&runs).Error; err != nil {
+		return nil, fmt.Errorf("failed to list container task runs: %w", err)
+	}
+
+	result := make([]containertask.Run, len(runs))
+	for i := range runs {
+		result[i] = toContainerTaskRunDto(&runs[i])
+	}
+	return result, nil
+}
+
+// RunTaskNow starts a run of the task in the background and returns it while
+// it is still running. Its outcome is available from ListRuns.
+func (s *ContainerTaskService) RunTaskNow(ctx context.Context, id string) (*containertask.Run, error) {
+	t, err := s.getTaskModel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if _, busy := s.running.LoadOrStore(t.ID, struct{}{}); busy {
+		return nil, ErrContainerTaskRunning
+	}
+
+	run, err := s.startRunInternal(ctx, t, containertask.TriggerManual)
+	if err != nil {
+		s.running.Delete(t.ID)
+		return nil, err
+	}
+
+	dto := toContainerTaskRunDto(run)
+
+	// The run outlives the request that started it.
+	runCtx := context.WithoutCancel(ctx)
+	go func() {
+		defer s.running.Delete(t.ID)
+		s.executeInternal(runCtx, t, run)
+	}()
+
+	return &dto, nil
+}
+
+// RunDueTasks runs every enabled task whose next run time has passed.
+func (s *ContainerTaskService) RunDueTasks(ctx context.Context) error {
+	now := time.Now()
+	var tasks []models.ContainerTask
+	err := s.db.WithContext(ctx).
+		Where("enabled = ? AND next_run_at IS NOT NULL AND next_run_at <= ?", true, now).
+		Find(&tasks).Error
+	if err != nil {
+		return fmt.Errorf("failed to load container tasks: %w", err)
+	}
+
+	sem := make(chan struct{}, maxContainerTaskConcurrentRuns)
+	var wg sync.WaitGroup
+	for i := range tasks {
+		t := &tasks[i]
+
+		// Advance the schedule before running so a slow run is not started
+		// again by the next tick. Runs missed while Arcane was down collapse
+		// into this one.
+		schedule, err := containerTaskScheduleParser.Parse(t.Schedule)
+		if err != nil {
+			slog.WarnContext(ctx, "Skipping container task with invalid schedule", "task", t.Name, "schedule", t.Schedule, "error", err)
+			continue
+		}
+		t.NextRunAt = nextContainerTaskRun(t, schedule, now)
+		if err := s.db.WithContext(ctx).Model(&models.ContainerTask{}).Where("id = ?", t.ID).Update("next_run_at", t.NextRunAt).Error; err != nil {
+			slog.WarnContext(ctx, "Failed to advance container task schedule", "task", t.Name, "error", err)
+			continue
+		}
+
+		if _, busy := s.running.LoadOrStore(t.ID, struct{}{}); busy {
+			slog.InfoContext(ctx, "Skipping container task run, previous run still in progress", "task", t.Name)
+			continue
+		}
+
+		wg.Go(func() {
+			defer s.running.Delete(t.ID)
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			run, err := s.startRunInternal(ctx, t, containertask.TriggerSchedule)
+			if err != nil {
+				slog.WarnContext(ctx, "Failed to start container task run", "task", t.Name, "error", err)
+				return
+			}
+			s.executeInternal(ctx, t, run)
+		})
+	}
+	wg.Wait()
+
+	return nil
+}
+
+func (s *ContainerTaskService) startRunInternal(ctx context.Context, t *models.ContainerTask, trigger string) (*models.ContainerTaskRun, error) {
+	run := &models.ContainerTaskRun{
+		TaskID:    t.ID,
+		Trigger:   trigger,
+		Status:    containertask.RunStatusRunning,
+		StartedAt: time.Now(),
+	}
+	if err := s.db.WithContext(ctx).Create(run).Error; err != nil {
+		return nil, fmt.Errorf("failed to record container task run: %w", err)
+	}
+
+	status := containertask.RunStatusRunning
+	t.LastRunAt = &run.StartedAt
+	t.LastStatus = &status
+	err := s.db.WithContext(ctx).Model(&models.ContainerTask{}).Where("id = ?", t.ID).
+		Updates(map[string]any{"last_run_at": t.LastRunAt, "last_status": t.LastStatus}).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to update container task: %w", err)
+	}
+	return run, nil
+}
+
+func (s *ContainerTaskService) executeInternal(ctx context.Context, t *models.ContainerTask, run *models.ContainerTaskRun) {
+	result := s.execInternal(ctx, t)
+	if err := s.finishRunInternal(ctx, t, run, result); err != nil {
+		slog.WarnContext(ctx, "Failed to record container task result", "task", t.Name, "error", err)
+	}
+}
+
+// containerTaskResult is the outcome of executing a task's command.
+type containerTaskResult struct {
+	status   string
+	exitCode *int
+	output   string
+	err      error
+}
+
+// execInternal runs the task's command with docker exec and waits for it to
+// exit or time out. Docker cannot stop an exec'd process, so on timeout the
+// command is left running in the container and only its output is detached.
+func (s *ContainerTaskService) execInternal(ctx context.Context, t *models.ContainerTask) containerTaskResult {
+	dockerClient, err := s.dockerService.GetClient(ctx)
+	if err != nil {
+		return containerTaskResult{status: containertask.RunStatusFailed, err: fmt.Errorf("failed to connect to Docker: %w", err)}
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx, time.Duration(t.TimeoutSeconds)*time.Second)
+	defer cancel()
+
+	execOptions := client.ExecCreateOptions{
+		Cmd:          []string{"/bin/sh", "-c", t.Command},
+		AttachStdout: true,
+		AttachStderr: true,
+	}
+	if t.User != nil {
+		execOptions.User = *t.User
+	}
+
+	execResp, err := dockerClient.ExecCreate(runCtx, t.ContainerName, execOptions)
+	if err != nil {
+		return containerTaskResult{status: containertask.RunStatusFailed, err: fmt.Errorf("failed to create exec in container %s: %w", t.ContainerName, err)}
+	}
+
+	attachResp, err := dockerClient.ExecAttach(runCtx, execResp.ID, client.ExecAttachOptions{})
+	if err != nil {
+		return containerTaskResult{status: containertask.RunStatusFailed, err: fmt.Errorf("failed to attach to exec: %w", err)}
+	}
+	defer attachResp.Close()
+
+	output := newTailBuffer(maxContainerTaskOutputBytes)
+	copyDone := make(chan error, 1)
+	go func() {
+		_, err := stdcopy.StdCopy(output, output, attachResp.Reader)
+		copyDone <- err
+	}()
+
+	select {
+	case err := <-copyDone:
+		if err != nil {
+			return containerTaskResult{status: containertask.RunStatusFailed, output: output.String(), err: fmt.Errorf("failed to read command output: %w", err)}
+		}
+	case <-runCtx.Done():
+		attachResp.Close()
+		return containerTaskResult{
+			status: containertask.RunStatusTimeout,
+			output: output.String(),
+			err:    fmt.Errorf("command did not finish within %d seconds", t.TimeoutSeconds),
+		}
+	}
+
+	inspect, err := dockerClient.ExecInspect(ctx, execResp.ID, client.ExecInspectOptions{})
+	if err != nil {
+		return containerTaskResult{status: containertask.RunStatusFailed, output: output.String(), err: fmt.Errorf("failed to inspect exec: %w", err)}
+	}
+
+	exitCode := inspect.ExitCode
+	result := containerTaskResult{status: containertask.RunStatusSuccess, exitCode: &exitCode, output: output.String()}
+	if exitCode != 0 {
+		result.status = containertask.RunStatusFailed
+	}
+	return result
+}
+
+// finishRunInternal stores the result of a run, prunes old runs of the task
+// and reports failures.
+func (s *ContainerTaskService) finishRunInternal(ctx context.Context, t *models.ContainerTask, run *models.ContainerTaskRun, result containerTaskResult) error {
+	finishedAt := time.Now()
+	durationMs := finishedAt.Sub(run.StartedAt).Milliseconds()
+	run.Status = result.status
+	run.ExitCode = result.exitCode
+	run.Output = result.output
+	run.FinishedAt = &finishedAt
+	run.DurationMs = &durationMs
+	run.Error = nil
+	if result.err != nil {
+		msg := result.err.Error()
+		run.Error = &msg
+	}
+
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(run).Error; err != nil {
+			return fmt.Errorf("failed to update container task run: %w", err)
+		}
+		t.LastStatus = &run.Status
+		if err := tx.Model(&models.ContainerTask{}).Where("id = ?", t.ID).Update("last_status", run.Status).Error; err != nil {
+			return fmt.Errorf("failed to update container task: %w", err)
+		}
+
+		// Keep only the most recent runs of each task.
+		keep := tx.Model(&models.ContainerTaskRun{}).Select("id").Where("task_id = ?", t.ID).
+			Order("started_at DESC").Limit(containerTaskRunsToKeep)
+		if err := tx.Where("task_id = ? AND id NOT IN (?)", t.ID, keep).Delete(&models.ContainerTaskRun{}).Error; err != nil {
+			return fmt.Errorf("failed to prune container task runs: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if run.Status != containertask.RunStatusSuccess {
+		s.notifyFailureInternal(ctx, t, run)
+	}
+	return nil
+}
+
+func (s *ContainerTaskService) notifyFailureInternal(ctx context.Context, t *models.ContainerTask, run *models.ContainerTaskRun) {
+	title := fmt.Sprintf("Task '%s' failed", t.Name)
+	var message string
+	switch {
+	case run.Error != nil:
+		message = fmt.Sprintf("%s in container %s: %s", t.Command, t.ContainerName, *run.Error)
+	case run.ExitCode != nil:
+		message = fmt.Sprintf("%s in container %s exited with code %d", t.Command, t.ContainerName, *run.ExitCode)
+	default:
+		message = fmt.Sprintf("%s in container %s failed", t.Command, t.ContainerName)
+	}
+
+	metadata := models.JSON{
+		"taskId":        t.ID,
+		"runId":         run.ID,
+		"containerName": t.ContainerName,
+		"status":        run.Status,
+		"trigger":       run.Trigger,
+	}
+	if run.ExitCode != nil {
+		metadata["exitCode"] = *run.ExitCode
+	}
+
+	if s.eventService != nil {
+		resourceType := "container_task"
+		_, err := s.eventService.CreateEvent(ctx, CreateEventRequest{
+			Type:         models.EventTypeContainerTaskFailed,
+			Severity:     s.eventService.getEventSeverity(models.EventTypeContainerTaskFailed),
+			Title:        title,
+			Description:  message,
+			ResourceType: &resourceType,
+			ResourceID:   &t.ID,
+			ResourceName: &t.Name,
+			Metadata:     metadata,
+		})
+		if err != nil {
+			slog.WarnContext(ctx, "Failed to record container task event", "task", t.Name, "error", err)
+		}
+	}
+
+	if s.notificationService != nil && t.NotifyOnFailure {
+		err := s.notificationService.SendAlertNotification(ctx, AlertNotification{
+			EventType: models.NotificationEventContainerTaskFailed,
+			Subject:   t.Name,
+			Title:     title,
+			Message:   message,
+			Metadata:  metadata,
+		})
+		if err != nil {
+			slog.WarnContext(ctx, "Failed to send container task notification", "task", t.Name, "error", err)
+		}
+	}
+}
+
+func (s *ContainerTaskService) getTaskModel(ctx context.Context, id string) (*models.ContainerTask, error) {
+	var t models.ContainerTask
+	if err := s.db.WithContext(ctx).Where("id = ?", id).First(&t).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrContainerTaskNotFound
+		}
+		return nil, fmt.Errorf("failed to get container task: %w", err)
+	}
+	return &t, nil
+}
+
+func validateContainerTask(t *models.ContainerTask) (cron.Schedule, error) {
+	if t.Name == "" {
+		return nil, fmt.Errorf("%w: name is required", ErrContainerTaskInvalid)
+	}
+	if t.ContainerName == "" || strings.ContainsAny(t.ContainerName, " \t\n") {
+		return nil, fmt.Errorf("%w: a container name is required", ErrContainerTaskInvalid)
+	}
+	if t.Command == "" {
+		return nil, fmt.Errorf("%w: command is required", ErrContainerTaskInvalid)
+	}
+	if t.TimeoutSeconds > maxContainerTaskTimeoutSeconds {
+		return nil, fmt.Errorf("%w: timeout must be at most %d seconds", ErrContainerTaskInvalid, maxContainerTaskTimeoutSeconds)
+	}
+	schedule, err := containerTaskScheduleParser.Parse(t.Schedule)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid schedule %q: %v", ErrContainerTaskInvalid, t.Schedule, err)
+	}
+	return schedule, nil
+}
+
+// nextContainerTaskRun returns when an enabled task next runs after now, or
+// nil for disabled tasks.
+func nextContainerTaskRun(t *models.ContainerTask, schedule cron.Schedule, now time.Time) *time.Time {
+	if !t.Enabled {
+		return nil
+	}
+	next := schedule.Next(now)
+	if next.IsZero() {
+		return nil
+	}
+	return &next
+}
+
+func toContainerTaskDto(t *models.ContainerTask) containertask.Task {
+	return containertask.Task{
+		ID:              t.ID,
+		Name:            t.Name,
+		ContainerName:   t.ContainerName,
+		Command:         t.Command,
+		User:            t.User,
+		Schedule:        t.Schedule,
+		TimeoutSeconds:  t.TimeoutSeconds,
+		NotifyOnFailure: t.NotifyOnFailure,
+		Enabled:         t.Enabled,
+		LastRunAt:       t.LastRunAt,
+		LastStatus:      t.LastStatus,
+		NextRunAt:       t.NextRunAt,
+		CreatedAt:       t.CreatedAt,
+		UpdatedAt:       t.UpdatedAt,
+	}
+}
+
+func toContainerTaskRunDto(r *models.ContainerTaskRun) containertask.Run {
+	return containertask.Run{
+		ID:         r.ID,
+		TaskID:     r.TaskID,
+		Trigger:    r.Trigger,
+		Status:     r.Status,
+		ExitCode:   r.ExitCode,
+		Output:     r.Output,
+		Error:      r.Error,
+		StartedAt:  r.StartedAt,
+		FinishedAt: r.FinishedAt,
+		DurationMs: r.DurationMs,
+	}
+}
+
+// tailBuffer keeps the last limit bytes written to it, so long running
+// commands keep the end of their output, which usually holds any error.
+type tailBuffer struct {
+	mu        sync.Mutex
+	buf       []byte
+	limit     int
+	truncated bool
+}
+
+func newTailBuffer(limit int) *tailBuffer {
+	return &tailBuffer{limit: limit}
+}
+
+func (b *tailBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.buf = append(b.buf, p...)
+	if over := len(b.buf) - b.limit; over > 0 {
+		b.buf = append(b.buf[:0], b.buf[over:]...)
+		b.truncated = true
+	}
+	return len(p), nil
+}
+
+func (b *tailBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.truncated {
+		return "[output truncated]\n" + string(b.buf)
+	}
+	return string(b.buf)
+}
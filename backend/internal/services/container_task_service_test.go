@@ -0,0 +1,127 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	glsqlite "github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/getarcaneapp/arcane/backend/internal/database"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/types/containertask"
+)
+
+func setupContainerTaskServiceTest(t *testing.T) (*ContainerTaskService, *gorm.DB) {
+	t.Helper()
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared", strings.NewReplacer("/", "_", " ", "_").Replace(t.Name()))
+	db, err := gorm.Open(glsqlite.Open(dsn), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.ContainerTask{}, &models.ContainerTaskRun{}))
+
+	return NewContainerTaskService(&database.DB{DB: db}, nil, nil, nil), db
+}
+
+func TestContainerTaskService_CreateTaskValidatesSchedule(t *testing.T) {
+	svc, _ := setupContainerTaskServiceTest(t)
+	ctx := context.Background()
+
+	created, err := svc.CreateTask(ctx, containertask.CreateTask{
+		Name:          "db backup",
+		ContainerName: "/postgres",
+		Command:       "pg_dumpall -U postgres > /backups/all.sql",
+		Schedule:      "0 3 * * *",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "postgres", created.ContainerName)
+	require.Equal(t, defaultContainerTaskTimeoutSeconds, created.TimeoutSeconds)
+	require.True(t, created.Enabled)
+	require.True(t, created.NotifyOnFailure)
+	require.NotNil(t, created.NextRunAt)
+	require.Equal(t, 3, created.NextRunAt.Hour())
+	require.Equal(t, 0, created.NextRunAt.Minute())
+
+	_, err = svc.CreateTask(ctx, containertask.CreateTask{
+		Name:          "bad",
+		ContainerName: "postgres",
+		Command:       "true",
+		Schedule:      "every day",
+	})
+	require.ErrorIs(t, err, ErrContainerTaskInvalid)
+
+	disabled := false
+	updated, err := svc.UpdateTask(ctx, created.ID, containertask.UpdateTask{Enabled: &disabled})
+	require.NoError(t, err)
+	require.False(t, updated.Enabled)
+	require.Nil(t, updated.NextRunAt)
+}
+
+func TestContainerTaskService_FinishRunRecordsResultAndPrunesHistory(t *testing.T) {
+	svc, db := setupContainerTaskServiceTest(t)
+	ctx := context.Background()
+
+	created, err := svc.CreateTask(ctx, containertask.CreateTask{
+		Name:          "cleanup",
+		ContainerName: "app",
+		Command:       "php artisan schedule:run",
+		Schedule:      "*/5 * * * *",
+	})
+	require.NoError(t, err)
+
+	task, err := svc.getTaskModel(ctx, created.ID)
+	require.NoError(t, err)
+
+	start := time.Now().Add(-time.Hour)
+	for i := range containerTaskRunsToKeep + 5 {
+		require.NoError(t, db.Create(&models.ContainerTaskRun{
+			TaskID:    task.ID,
+			Trigger:   containertask.TriggerSchedule,
+			Status:    containertask.RunStatusSuccess,
+			StartedAt: start.Add(time.Duration(i) * time.Second),
+		}).Error)
+	}
+
+	run, err := svc.startRunInternal(ctx, task, containertask.TriggerManual)
+	require.NoError(t, err)
+
+	exitCode := 2
+	require.NoError(t, svc.finishRunInternal(ctx, task, run, containerTaskResult{
+		status:   containertask.RunStatusFailed,
+		exitCode: &exitCode,
+		output:   "boom\n",
+	}))
+
+	runs, err := svc.ListRuns(ctx, task.ID, 0)
+	require.NoError(t, err)
+	require.Len(t, runs, containerTaskRunsToKeep)
+	require.Equal(t, run.ID, runs[0].ID)
+	require.Equal(t, containertask.RunStatusFailed, runs[0].Status)
+	require.Equal(t, containertask.TriggerManual, runs[0].Trigger)
+	require.Equal(t, "boom\n", runs[0].Output)
+	require.NotNil(t, runs[0].ExitCode)
+	require.Equal(t, 2, *runs[0].ExitCode)
+
+	got, err := svc.GetTask(ctx, task.ID)
+	require.NoError(t, err)
+	require.NotNil(t, got.LastStatus)
+	require.Equal(t, containertask.RunStatusFailed, *got.LastStatus)
+
+	require.NoError(t, svc.DeleteTask(ctx, task.ID))
+	var remaining int64
+	require.NoError(t, db.Model(&models.ContainerTaskRun{}).Count(&remaining).Error)
+	require.Zero(t, remaining)
+	require.ErrorIs(t, svc.DeleteTask(ctx, task.ID), ErrContainerTaskNotFound)
+}
+
+func TestTailBufferKeepsEndOfOutput(t *testing.T) {
+	buf := newTailBuffer(8)
+	_, _ = buf.Write([]byte("hello "))
+	require.Equal(t, "hello ", buf.String())
+
+	_, _ = buf.Write([]byte("world!"))
+	require.Equal(t, "[output truncated]\no world!", buf.String())
+}
@@ -0,0 +1,244 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/getarcaneapp/arcane/backend/internal/common"
+	"github.com/getarcaneapp/arcane/backend/internal/config"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/docker"
+	"github.com/getarcaneapp/arcane/types/dockerinfo"
+	"github.com/moby/moby/api/types/system"
+	"github.com/moby/moby/client"
+)
+
+// DaemonConfigService reports the Docker daemon configuration, flags risky
+// settings and, on agents with daemon.json mounted, edits daemon.json.
+type DaemonConfigService struct {
+	dockerService *DockerClientService
+	eventService  *EventService
+	cfg           *config.Config
+}
+
+func NewDaemonConfigService(dockerService *DockerClientService, eventService *EventService, cfg *config.Config) *DaemonConfigService {
+	return &DaemonConfigService{
+		dockerService: dockerService,
+		eventService:  eventService,
+		cfg:           cfg,
+	}
+}
+
+func (s *DaemonConfigService) daemonJSONPathInternal() string {
+	if s.cfg != nil && strings.TrimSpace(s.cfg.DockerDaemonConfigPath) != "" {
+		return s.cfg.DockerDaemonConfigPath
+	}
+	return "/etc/docker/daemon.json"
+}
+
+// editableInternal reports whether daemon.json may be written. Only agents
+// run on the Docker host, and the directory must be mounted into them.
+func (s *DaemonConfigService) editableInternal() bool {
+	if s.cfg == nil || !s.cfg.AgentMode {
+		return false
+	}
+	info, err := os.Stat(filepath.Dir(s.daemonJSONPathInternal()))
+	return err == nil && info.IsDir()
+}
+
+// GetDaemonConfig returns the daemon configuration and the risks found in it.
+func (s *DaemonConfigService) GetDaemonConfig(ctx context.Context) (*dockerinfo.DaemonConfig, error) {
+	dockerClient, err := s.dockerService.GetClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to Docker: %w", err)
+	}
+	infoResult, err := dockerClient.Info(ctx, client.InfoOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Docker info: %w", err)
+	}
+
+	path := s.daemonJSONPathInternal()
+	var daemonJSON *string
+	var parsed map[string]any
+	if content, readErr := os.ReadFile(path); readErr == nil {
+		daemonJSON = new(string(content))
+		// A broken file is still shown so it can be fixed; only the checks
+		// that need it are skipped.
+		parsed, _ = docker.ParseDaemonJSON(content)
+	}
+
+	result := buildDaemonConfigInternal(infoResult.Info, parsed)
+	result.DaemonJSONPath = path
+	result.DaemonJSON = daemonJSON
+	result.Editable = s.editableInternal()
+	return &result, nil
+}
+
+// UpdateDaemonJSON validates content and, unless dryRun is set, replaces
+// daemon.json with it after saving a backup of the current file. The daemon
+// is not reloaded; the result says which command applies the change.
+func (s *DaemonConfigService) UpdateDaemonJSON(ctx context.Context, content string, dryRun bool, user models.User) (*dockerinfo.UpdateDaemonJSONResult, error) {
+	if !s.editableInternal() {
+		return nil, &models.ValidationError{Message: "daemon.json can only be edited on agents with " + filepath.Dir(s.daemonJSONPathInternal()) + " mounted"}
+	}
+
+	next, err := docker.ParseDaemonJSON([]byte(content))
+	if err != nil {
+		return nil, &models.ValidationError{Message: err.Error(), Field: "content"}
+	}
+
+	path := s.daemonJSONPathInternal()
+	current, readErr := os.ReadFile(path)
+	if readErr != nil && !errors.Is(readErr, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read daemon.json: %w", readErr)
+	}
+	// An unparsable current file counts as empty, so every key shows as
+	// changed.
+	previous, _ := docker.ParseDaemonJSON(current)
+	if previous == nil {
+		previous = map[string]any{}
+	}
+
+	result := &dockerinfo.UpdateDaemonJSONResult{
+		ChangedKeys:    docker.DaemonChangedKeys(previous, next),
+		ReloadableKeys: []string{},
+		RestartKeys:    []string{},
+	}
+	for _, key := range result.ChangedKeys {
+		if docker.DaemonKeyReloadable(key) {
+			result.ReloadableKeys = append(result.ReloadableKeys, key)
+		} else {
+			result.RestartKeys = append(result.RestartKeys, key)
+		}
+	}
+	switch {
+	case len(result.RestartKeys) > 0:
+		result.ApplyCommand = "sudo systemctl restart docker"
+	case len(result.ReloadableKeys) > 0:
+		result.ApplyCommand = "sudo systemctl reload docker"
+	}
+
+	if dryRun || len(result.ChangedKeys) == 0 {
+		return result, nil
+	}
+
+	if readErr == nil {
+		result.BackupPath = fmt.Sprintf("%s.%s.bak", path, time.Now().UTC().Format("20060102T150405Z"))
+		if err := os.WriteFile(result.BackupPath, current, common.FilePerm); err != nil {
+			return nil, fmt.Errorf("failed to back up daemon.json: %w", err)
+		}
+	}
+
+	tmp := path + ".arcane.tmp"
+	if err := os.WriteFile(tmp, []byte(content), common.FilePerm); err != nil {
+		return nil, fmt.Errorf("failed to write daemon.json: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		_ = os.Remove(tmp)
+		return nil, fmt.Errorf("failed to write daemon.json: %w", err)
+	}
+	result.Written = true
+
+	_, _ = s.eventService.CreateEvent(ctx, CreateEventRequest{
+		Type:        models.EventTypeSystemDaemonJSON,
+		Title:       "Docker daemon configuration updated",
+		Description: "Changed " + strings.Join(result.ChangedKeys, ", ") + " in " + path,
+		UserID:      new(user.ID),
+		Username:    new(user.Username),
+		Metadata:    models.JSON{"changedKeys": result.ChangedKeys, "backupPath": result.BackupPath},
+	})
+
+	return result, nil
+}
+
+// buildDaemonConfigInternal summarises daemon info. parsed is the daemon.json
+// content, or nil when it could not be read.
+func buildDaemonConfigInternal(info system.Info, parsed map[string]any) dockerinfo.DaemonConfig {
+	result := dockerinfo.DaemonConfig{
+		ServerVersion:      info.ServerVersion,
+		StorageDriver:      info.Driver,
+		CgroupVersion:      info.CgroupVersion,
+		CgroupDriver:       info.CgroupDriver,
+		LoggingDriver:      info.LoggingDriver,
+		LiveRestoreEnabled: info.LiveRestoreEnabled,
+		DockerRootDir:      info.DockerRootDir,
+		RegistryMirrors:    []string{},
+		InsecureRegistries: []string{},
+		Risks:              []dockerinfo.DaemonRisk{},
+	}
+
+	if rc := info.RegistryConfig; rc != nil {
+		result.RegistryMirrors = append(result.RegistryMirrors, rc.Mirrors...)
+		for _, cidr := range rc.InsecureRegistryCIDRs {
+			// The daemon always lists loopback as insecure.
+			if value := fmt.Sprint(cidr); value != "127.0.0.0/8" && value != "::1/128" {
+				result.InsecureRegistries = append(result.InsecureRegistries, value)
+			}
+		}
+		for name, index := range rc.IndexConfigs {
+			if index != nil && !index.Secure {
+				result.InsecureRegistries = append(result.InsecureRegistries, name)
+			}
+		}
+		slices.Sort(result.InsecureRegistries)
+	}
+
+	risk := func(id string, severity dockerinfo.DaemonRiskSeverity, message, remediation string) {
+		result.Risks = append(result.Risks, dockerinfo.DaemonRisk{ID: id, Severity: severity, Message: message, Remediation: remediation})
+	}
+
+	switch info.LoggingDriver {
+	case "json-file":
+		switch {
+		case parsed == nil:
+			risk("log-rotation-unknown", dockerinfo.DaemonRiskInfo,
+				"The json-file log driver only rotates logs when max-size is set, and daemon.json could not be read to check it",
+				`Mount daemon.json into Arcane, or make sure it sets "log-opts": {"max-size": "10m", "max-file": "3"}`)
+		case docker.DaemonLogOption(parsed, "max-size") == "":
+			risk("no-log-rotation", dockerinfo.DaemonRiskWarning,
+				"Container logs are never rotated and can fill the disk",
+				`Set "log-opts": {"max-size": "10m", "max-file": "3"}, or use "log-driver": "local"`)
+		}
+	case "none":
+		risk("logging-disabled", dockerinfo.DaemonRiskInfo,
+			"Container logs are discarded",
+			`Set "log-driver": "local" to keep rotated logs`)
+	}
+
+	if !info.LiveRestoreEnabled {
+		risk("no-live-restore", dockerinfo.DaemonRiskInfo,
+			"Containers stop whenever the Docker daemon restarts or is upgraded",
+			`Set "live-restore": true`)
+	}
+
+	switch info.Driver {
+	case "vfs", "devicemapper", "aufs", "overlay":
+		risk("legacy-storage-driver", dockerinfo.DaemonRiskWarning,
+			"The "+info.Driver+" storage driver is deprecated or slow",
+			`Switch to "storage-driver": "overlay2"; existing images and containers must be recreated`)
+	}
+
+	if info.CgroupVersion == "1" {
+		risk("cgroup-v1", dockerinfo.DaemonRiskInfo,
+			"The host uses cgroup v1, which is deprecated and does not support all resource limits",
+			"Boot the host with the unified cgroup v2 hierarchy")
+	}
+
+	if len(result.InsecureRegistries) > 0 {
+		risk("insecure-registries", dockerinfo.DaemonRiskWarning,
+			"Images are pulled without TLS verification from "+strings.Join(result.InsecureRegistries, ", "),
+			`Give these registries TLS certificates and remove them from "insecure-registries"`)
+	}
+
+	for _, warning := range info.Warnings {
+		risk("daemon-warning", dockerinfo.DaemonRiskWarning, strings.TrimPrefix(warning, "WARNING: "), "")
+	}
+
+	return result
+}
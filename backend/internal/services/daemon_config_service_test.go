@@ -0,0 +1,43 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/getarcaneapp/arcane/types/dockerinfo"
+	"github.com/moby/moby/api/types/system"
+	"github.com/stretchr/testify/assert"
+)
+
+func daemonRiskIDsInternal(risks []dockerinfo.DaemonRisk) []string {
+	ids := make([]string, len(risks))
+	for i, r := range risks {
+		ids[i] = r.ID
+	}
+	return ids
+}
+
+func TestBuildDaemonConfigInternal(t *testing.T) {
+	info := system.Info{
+		Driver:        "overlay2",
+		CgroupVersion: "2",
+		LoggingDriver: "json-file",
+		Warnings:      []string{"WARNING: bridge-nf-call-iptables is disabled"},
+	}
+
+	cfg := buildDaemonConfigInternal(info, map[string]any{})
+	assert.Equal(t, []string{"no-log-rotation", "no-live-restore", "daemon-warning"}, daemonRiskIDsInternal(cfg.Risks))
+	assert.Equal(t, "bridge-nf-call-iptables is disabled", cfg.Risks[2].Message)
+
+	cfg = buildDaemonConfigInternal(info, nil)
+	assert.Equal(t, "log-rotation-unknown", cfg.Risks[0].ID)
+
+	info = system.Info{
+		Driver:             "devicemapper",
+		CgroupVersion:      "1",
+		LoggingDriver:      "json-file",
+		LiveRestoreEnabled: true,
+	}
+	cfg = buildDaemonConfigInternal(info, map[string]any{"log-opts": map[string]any{"max-size": "10m"}})
+	assert.Equal(t, []string{"legacy-storage-driver", "cgroup-v1"}, daemonRiskIDsInternal(cfg.Risks))
+	assert.Empty(t, cfg.InsecureRegistries)
+}
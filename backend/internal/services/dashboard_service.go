@@ -2,22 +2,36 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/getarcaneapp/arcane/backend/internal/database"
 	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/mapper"
 	"github.com/getarcaneapp/arcane/backend/pkg/libarcane"
 	dashboardtypes "github.com/getarcaneapp/arcane/types/dashboard"
+	"github.com/getarcaneapp/arcane/types/event"
+	"github.com/shirou/gopsutil/v4/disk"
 	"golang.org/x/sync/errgroup"
+	"gorm.io/gorm"
 )
 
-const defaultDashboardAPIKeyExpiryWindow = 14 * 24 * time.Hour
+const (
+	defaultDashboardAPIKeyExpiryWindow = 14 * 24 * time.Hour
+	defaultDashboardWidgetLimit        = 5
+	maxDashboardWidgetLimit            = 50
+	maxDashboardWidgets                = 50
+)
 
 type DashboardService struct {
 	db                   *database.DB
 	dockerService        *DockerClientService
 	vulnerabilityService *VulnerabilityService
+	settingsService      *SettingsService
 }
 
 type DashboardActionItemsOptions struct {
@@ -28,11 +42,13 @@ func NewDashboardService(
 	db *database.DB,
 	dockerService *DockerClientService,
 	vulnerabilityService *VulnerabilityService,
+	settingsService *SettingsService,
 ) *DashboardService {
 	return &DashboardService{
 		db:                   db,
 		dockerService:        dockerService,
 		vulnerabilityService: vulnerabilityService,
+		settingsService:      settingsService,
 	}
 }
 
@@ -216,3 +232,389 @@ func (s *DashboardService) getExpiringAPIKeysCountInternal(ctx context.Context)
 
 	return int(count), nil
 }
+
+// DefaultDashboardLayout is the layout shown to users who have not saved
+// their own: every widget in a two column arrangement.
+func DefaultDashboardLayout() []dashboardtypes.Widget {
+	const width, height = dashboardtypes.LayoutColumns / 2, 4
+	widgets := make([]dashboardtypes.Widget, 0, len(dashboardtypes.WidgetKinds))
+	for i, kind := range dashboardtypes.WidgetKinds {
+		widgets = append(widgets, dashboardtypes.Widget{
+			ID:     string(kind),
+			Kind:   kind,
+			X:      (i % 2) * width,
+			Y:      (i / 2) * height,
+			Width:  width,
+			Height: height,
+		})
+	}
+	return widgets
+}
+
+// GetLayout returns the user's saved dashboard layout, or the default layout
+// if they have not saved one.
+func (s *DashboardService) GetLayout(ctx context.Context, userID string) (*dashboardtypes.Layout, error) {
+	var layout models.DashboardLayout
+	err := s.db.WithContext(ctx).Where("user_id = ?", userID).First(&layout).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &dashboardtypes.Layout{Widgets: DefaultDashboardLayout()}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load dashboard layout: %w", err)
+	}
+	return toDashboardLayoutDto(&layout), nil
+}
+
+// SaveLayout validates and stores the user's dashboard layout.
+func (s *DashboardService) SaveLayout(ctx context.Context, userID string, req dashboardtypes.UpdateLayout) (*dashboardtypes.Layout, error) {
+	widgets, err := normalizeDashboardWidgets(req.Widgets)
+	if err != nil {
+		return nil, err
+	}
+
+	var layout models.DashboardLayout
+	err = s.db.WithContext(ctx).Where("user_id = ?", userID).First(&layout).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("failed to load dashboard layout: %w", err)
+	}
+	layout.UserID = userID
+	layout.Widgets = widgets
+
+	if err := s.db.WithContext(ctx).Save(&layout).Error; err != nil {
+		return nil, fmt.Errorf("failed to save dashboard layout: %w", err)
+	}
+	return toDashboardLayoutDto(&layout), nil
+}
+
+// ResetLayout deletes the user's saved layout so the default is used again.
+func (s *DashboardService) ResetLayout(ctx context.Context, userID string) error {
+	if err := s.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&models.DashboardLayout{}).Error; err != nil {
+		return fmt.Errorf("failed to reset dashboard layout: %w", err)
+	}
+	return nil
+}
+
+func toDashboardLayoutDto(layout *models.DashboardLayout) *dashboardtypes.Layout {
+	widgets := layout.Widgets
+	if widgets == nil {
+		widgets = []dashboardtypes.Widget{}
+	}
+	updatedAt := layout.CreatedAt
+	if layout.UpdatedAt != nil {
+		updatedAt = *layout.UpdatedAt
+	}
+	return &dashboardtypes.Layout{Widgets: widgets, Custom: true, UpdatedAt: &updatedAt}
+}
+
+// normalizeDashboardWidgets checks that widgets have known kinds, unique IDs
+// and fit inside the grid. Missing IDs default to the widget kind.
+func normalizeDashboardWidgets(widgets []dashboardtypes.Widget) ([]dashboardtypes.Widget, error) {
+	if len(widgets) > maxDashboardWidgets {
+		return nil, &models.ValidationError{Message: fmt.Sprintf("a dashboard can have at most %d widgets", maxDashboardWidgets), Field: "widgets"}
+	}
+
+	out := make([]dashboardtypes.Widget, 0, len(widgets))
+	seen := make(map[string]struct{}, len(widgets))
+	for _, w := range widgets {
+		if !slices.Contains(dashboardtypes.WidgetKinds, w.Kind) {
+			return nil, &models.ValidationError{Message: fmt.Sprintf("unknown widget kind %q", w.Kind), Field: "widgets"}
+		}
+		w.ID = strings.TrimSpace(w.ID)
+		if w.ID == "" {
+			w.ID = string(w.Kind)
+		}
+		if _, ok := seen[w.ID]; ok {
+			return nil, &models.ValidationError{Message: fmt.Sprintf("duplicate widget id %q", w.ID), Field: "widgets"}
+		}
+		seen[w.ID] = struct{}{}
+
+		if w.X < 0 || w.Y < 0 || w.Width < 1 || w.Height < 1 || w.X+w.Width > dashboardtypes.LayoutColumns {
+			return nil, &models.ValidationError{Message: fmt.Sprintf("widget %q does not fit the %d column grid", w.ID, dashboardtypes.LayoutColumns), Field: "widgets"}
+		}
+		out = append(out, w)
+	}
+	return out, nil
+}
+
+type DashboardWidgetDataOptions struct {
+	// Widgets selects the widgets to load. When empty, the widgets in the
+	// user's layout are loaded.
+	Widgets []dashboardtypes.WidgetKind
+	// Limit caps the number of rows returned by list widgets.
+	Limit int
+}
+
+// GetWidgetData loads the data for several widgets concurrently. A widget
+// that fails is reported in the result's Errors so the rest of the dashboard
+// still renders.
+func (s *DashboardService) GetWidgetData(ctx context.Context, environmentID, userID string, options DashboardWidgetDataOptions) (*dashboardtypes.WidgetData, error) {
+	kinds := options.Widgets
+	if len(kinds) == 0 {
+		layout, err := s.GetLayout(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		for _, w := range layout.Widgets {
+			kinds = append(kinds, w.Kind)
+		}
+	}
+	for _, kind := range kinds {
+		if !slices.Contains(dashboardtypes.WidgetKinds, kind) {
+			return nil, &models.ValidationError{Message: fmt.Sprintf("unknown widget kind %q", kind), Field: "widgets"}
+		}
+	}
+
+	limit := options.Limit
+	if limit <= 0 {
+		limit = defaultDashboardWidgetLimit
+	}
+	limit = min(limit, maxDashboardWidgetLimit)
+
+	data := &dashboardtypes.WidgetData{}
+	var mu sync.Mutex
+	fail := func(kind dashboardtypes.WidgetKind, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if data.Errors == nil {
+			data.Errors = make(map[dashboardtypes.WidgetKind]string)
+		}
+		data.Errors[kind] = err.Error()
+	}
+
+	var wg sync.WaitGroup
+	for _, kind := range slices.Compact(slices.Sorted(slices.Values(kinds))) {
+		wg.Go(func() {
+			var err error
+			switch kind {
+			case dashboardtypes.WidgetKindActionItems:
+				var items *dashboardtypes.ActionItems
+				if items, err = s.GetActionItems(ctx, DashboardActionItemsOptions{}); err == nil {
+					mu.Lock()
+					data.ActionItems = items
+					mu.Unlock()
+				}
+			case dashboardtypes.WidgetKindUpdatesPending:
+				var widget *dashboardtypes.UpdatesPendingWidget
+				if widget, err = s.getUpdatesPendingWidgetInternal(ctx, limit); err == nil {
+					mu.Lock()
+					data.UpdatesPending = widget
+					mu.Unlock()
+				}
+			case dashboardtypes.WidgetKindVulnerableImages:
+				var widget *dashboardtypes.VulnerableImagesWidget
+				if widget, err = s.getVulnerableImagesWidgetInternal(ctx, limit); err == nil {
+					mu.Lock()
+					data.VulnerableImages = widget
+					mu.Unlock()
+				}
+			case dashboardtypes.WidgetKindDiskUsage:
+				var widget *dashboardtypes.DiskUsageWidget
+				if widget, err = s.getDiskUsageWidgetInternal(ctx); err == nil {
+					mu.Lock()
+					data.DiskUsage = widget
+					mu.Unlock()
+				}
+			case dashboardtypes.WidgetKindRecentEvents:
+				var widget *dashboardtypes.RecentEventsWidget
+				if widget, err = s.getRecentEventsWidgetInternal(ctx, environmentID, limit); err == nil {
+					mu.Lock()
+					data.RecentEvents = widget
+					mu.Unlock()
+				}
+			case dashboardtypes.WidgetKindEnvironmentHealth:
+				var widget *dashboardtypes.EnvironmentHealthWidget
+				if widget, err = s.getEnvironmentHealthWidgetInternal(ctx, limit); err == nil {
+					mu.Lock()
+					data.EnvironmentHealth = widget
+					mu.Unlock()
+				}
+			}
+			if err != nil {
+				fail(kind, err)
+			}
+		})
+	}
+	wg.Wait()
+
+	return data, nil
+}
+
+// currentImageIDsInternal returns the IDs of images present on the Docker
+// host, or nil when Docker is not configured.
+func (s *DashboardService) currentImageIDsInternal(ctx context.Context) ([]string, error) {
+	if s.dockerService == nil {
+		return nil, nil
+	}
+	images, _, _, _, err := s.dockerService.GetAllImages(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load images: %w", err)
+	}
+	imageIDs := make([]string, 0, len(images))
+	for _, img := range images {
+		imageIDs = append(imageIDs, img.ID)
+	}
+	return imageIDs, nil
+}
+
+func (s *DashboardService) getUpdatesPendingWidgetInternal(ctx context.Context, limit int) (*dashboardtypes.UpdatesPendingWidget, error) {
+	widget := &dashboardtypes.UpdatesPendingWidget{Images: []dashboardtypes.PendingUpdate{}}
+
+	imageIDs, err := s.currentImageIDsInternal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if s.dockerService != nil && len(imageIDs) == 0 {
+		return widget, nil
+	}
+
+	q := s.db.WithContext(ctx).Model(&models.ImageUpdateRecord{}).Where("has_update = ?", true)
+	if s.dockerService != nil {
+		q = q.Where("id IN ?", imageIDs)
+	}
+
+	var count int64
+	if err := q.Session(&gorm.Session{}).Count(&count).Error; err != nil {
+		return nil, fmt.Errorf("failed to count pending image updates: %w", err)
+	}
+	widget.Count = int(count)
+
+	var records []models.ImageUpdateRecord
+	if err := q.Order("check_time DESC").Limit(limit).Find(&records).Error; err != nil {
+		return nil, fmt.Errorf("failed to list pending image updates: %w", err)
+	}
+	for _, r := range records {
+		update := dashboardtypes.PendingUpdate{
+			ImageID:        r.ID,
+			Repository:     r.Repository,
+			Tag:            r.Tag,
+			UpdateType:     r.UpdateType,
+			CurrentVersion: r.CurrentVersion,
+		}
+		if r.LatestVersion != nil {
+			update.LatestVersion = *r.LatestVersion
+		}
+		widget.Images = append(widget.Images, update)
+	}
+	return widget, nil
+}
+
+func (s *DashboardService) getVulnerableImagesWidgetInternal(ctx context.Context, limit int) (*dashboardtypes.VulnerableImagesWidget, error) {
+	widget := &dashboardtypes.VulnerableImagesWidget{Images: []dashboardtypes.VulnerableImage{}}
+
+	imageIDs, err := s.currentImageIDsInternal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if s.dockerService != nil && len(imageIDs) == 0 {
+		return widget, nil
+	}
+
+	q := s.db.WithContext(ctx).
+		Model(&models.VulnerabilityScanRecord{}).
+		Where("status = ?", models.ScanStatusCompleted).
+		Where("critical_count > 0 OR high_count > 0")
+	if s.dockerService != nil {
+		q = q.Where("id IN ?", imageIDs)
+	}
+
+	var count int64
+	if err := q.Session(&gorm.Session{}).Count(&count).Error; err != nil {
+		return nil, fmt.Errorf("failed to count vulnerable images: %w", err)
+	}
+	widget.Count = int(count)
+
+	var records []models.VulnerabilityScanRecord
+	err = q.Select("id", "image_name", "critical_count", "high_count").
+		Order("critical_count DESC, high_count DESC, image_name ASC").
+		Limit(limit).
+		Find(&records).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list vulnerable images: %w", err)
+	}
+	for _, r := range records {
+		widget.Images = append(widget.Images, dashboardtypes.VulnerableImage{
+			ImageID:   r.ID,
+			ImageName: r.ImageName,
+			Critical:  r.CriticalCount,
+			High:      r.HighCount,
+		})
+	}
+	return widget, nil
+}
+
+func (s *DashboardService) getDiskUsageWidgetInternal(ctx context.Context) (*dashboardtypes.DiskUsageWidget, error) {
+	path := "/"
+	if s.settingsService != nil {
+		if cfg := s.settingsService.GetSettingsConfig(); cfg != nil && cfg.DiskUsagePath.Value != "" {
+			path = cfg.DiskUsagePath.Value
+		}
+	}
+
+	usage, err := disk.UsageWithContext(ctx, path)
+	if (err != nil || usage == nil || usage.Total == 0) && path != "/" {
+		path = "/"
+		usage, err = disk.UsageWithContext(ctx, path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read disk usage: %w", err)
+	}
+
+	return &dashboardtypes.DiskUsageWidget{
+		Path:        path,
+		Used:        usage.Used,
+		Total:       usage.Total,
+		UsedPercent: usage.UsedPercent,
+	}, nil
+}
+
+func (s *DashboardService) getRecentEventsWidgetInternal(ctx context.Context, environmentID string, limit int) (*dashboardtypes.RecentEventsWidget, error) {
+	var events []models.Event
+	err := s.db.WithContext(ctx).
+		Where("environment_id = ?", environmentID).
+		Order("timestamp DESC").
+		Limit(limit).
+		Find(&events).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recent events: %w", err)
+	}
+
+	dtos, err := mapper.MapSlice[models.Event, event.Event](events)
+	if err != nil {
+		return nil, fmt.Errorf("failed to map events: %w", err)
+	}
+	if dtos == nil {
+		dtos = []event.Event{}
+	}
+	return &dashboardtypes.RecentEventsWidget{Events: dtos}, nil
+}
+
+func (s *DashboardService) getEnvironmentHealthWidgetInternal(ctx context.Context, limit int) (*dashboardtypes.EnvironmentHealthWidget, error) {
+	var environments []models.Environment
+	err := s.db.WithContext(ctx).
+		Where("enabled = ?", true).
+		Order("name ASC").
+		Find(&environments).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list environments: %w", err)
+	}
+
+	widget := &dashboardtypes.EnvironmentHealthWidget{
+		Total:     len(environments),
+		Unhealthy: []dashboardtypes.EnvironmentHealth{},
+	}
+	for _, env := range environments {
+		if env.Status == string(models.EnvironmentStatusOnline) {
+			widget.Online++
+			continue
+		}
+		if len(widget.Unhealthy) < limit {
+			widget.Unhealthy = append(widget.Unhealthy, dashboardtypes.EnvironmentHealth{
+				ID:       env.ID,
+				Name:     env.Name,
+				Status:   env.Status,
+				LastSeen: env.LastSeen,
+			})
+		}
+	}
+	return widget, nil
+}
@@ -30,7 +30,7 @@ func createDashboardTestAPIKey(t *testing.T, db *database.DB, key models.ApiKey)
 
 func TestDashboardService_GetActionItems_IncludesExpiringAPIKeys(t *testing.T) {
 	db := setupDashboardServiceTestDB(t)
-	svc := NewDashboardService(db, nil, nil)
+	svc := NewDashboardService(db, nil, nil, nil)
 
 	now := time.Now()
 	expiringSoon := now.Add(24 * time.Hour)
@@ -78,7 +78,7 @@ func TestDashboardService_GetActionItems_IncludesExpiringAPIKeys(t *testing.T) {
 
 func TestDashboardService_GetActionItems_DebugAllGoodReturnsNoItems(t *testing.T) {
 	db := setupDashboardServiceTestDB(t)
-	svc := NewDashboardService(db, nil, nil)
+	svc := NewDashboardService(db, nil, nil, nil)
 
 	expiresAt := time.Now().Add(2 * time.Hour)
 	createDashboardTestAPIKey(t, db, models.ApiKey{
@@ -96,3 +96,127 @@ func TestDashboardService_GetActionItems_DebugAllGoodReturnsNoItems(t *testing.T
 	require.NotNil(t, actionItems)
 	require.Empty(t, actionItems.Items)
 }
+
+func TestDashboardService_Layout(t *testing.T) {
+	db := setupDashboardServiceTestDB(t)
+	require.NoError(t, db.AutoMigrate(&models.DashboardLayout{}))
+	svc := NewDashboardService(db, nil, nil, nil)
+	ctx := context.Background()
+
+	layout, err := svc.GetLayout(ctx, "user-1")
+	require.NoError(t, err)
+	require.False(t, layout.Custom)
+	require.Len(t, layout.Widgets, len(dashboardtypes.WidgetKinds))
+
+	saved, err := svc.SaveLayout(ctx, "user-1", dashboardtypes.UpdateLayout{
+		Widgets: []dashboardtypes.Widget{
+			{Kind: dashboardtypes.WidgetKindDiskUsage, X: 0, Y: 0, Width: 4, Height: 2},
+			{ID: "events", Kind: dashboardtypes.WidgetKindRecentEvents, X: 4, Y: 0, Width: 8, Height: 6},
+		},
+	})
+	require.NoError(t, err)
+	require.True(t, saved.Custom)
+	require.Equal(t, "disk_usage", saved.Widgets[0].ID)
+
+	saved, err = svc.SaveLayout(ctx, "user-1", dashboardtypes.UpdateLayout{
+		Widgets: []dashboardtypes.Widget{{Kind: dashboardtypes.WidgetKindRecentEvents, Width: 12, Height: 4}},
+	})
+	require.NoError(t, err)
+	require.Len(t, saved.Widgets, 1)
+
+	var count int64
+	require.NoError(t, db.Model(&models.DashboardLayout{}).Count(&count).Error)
+	require.Equal(t, int64(1), count)
+
+	other, err := svc.GetLayout(ctx, "user-2")
+	require.NoError(t, err)
+	require.False(t, other.Custom)
+
+	require.NoError(t, svc.ResetLayout(ctx, "user-1"))
+	layout, err = svc.GetLayout(ctx, "user-1")
+	require.NoError(t, err)
+	require.False(t, layout.Custom)
+}
+
+func TestDashboardService_SaveLayoutValidation(t *testing.T) {
+	db := setupDashboardServiceTestDB(t)
+	require.NoError(t, db.AutoMigrate(&models.DashboardLayout{}))
+	svc := NewDashboardService(db, nil, nil, nil)
+
+	tests := []struct {
+		name    string
+		widgets []dashboardtypes.Widget
+	}{
+		{"unknown kind", []dashboardtypes.Widget{{Kind: "weather", Width: 1, Height: 1}}},
+		{"duplicate id", []dashboardtypes.Widget{
+			{ID: "a", Kind: dashboardtypes.WidgetKindDiskUsage, Width: 1, Height: 1},
+			{ID: "a", Kind: dashboardtypes.WidgetKindRecentEvents, Width: 1, Height: 1},
+		}},
+		{"overflows grid", []dashboardtypes.Widget{{Kind: dashboardtypes.WidgetKindDiskUsage, X: 8, Width: 6, Height: 1}}},
+		{"zero size", []dashboardtypes.Widget{{Kind: dashboardtypes.WidgetKindDiskUsage}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.SaveLayout(context.Background(), "user-1", dashboardtypes.UpdateLayout{Widgets: tt.widgets})
+			var validationErr *models.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+		})
+	}
+}
+
+func TestDashboardService_GetWidgetData(t *testing.T) {
+	db := setupDashboardServiceTestDB(t)
+	require.NoError(t, db.AutoMigrate(&models.DashboardLayout{}, &models.Event{}, &models.Environment{}, &models.ImageUpdateRecord{}))
+	svc := NewDashboardService(db, nil, nil, nil)
+	ctx := context.Background()
+
+	envID := "0"
+	otherEnvID := "env-2"
+	now := time.Now()
+	for i, env := range []*string{&envID, &envID, &otherEnvID} {
+		require.NoError(t, db.Create(&models.Event{
+			Type:          models.EventTypeContainerStart,
+			Severity:      models.EventSeverityInfo,
+			Title:         "event",
+			EnvironmentID: env,
+			Timestamp:     now.Add(time.Duration(i) * time.Minute),
+		}).Error)
+	}
+	require.NoError(t, db.Create(&models.Environment{Name: "local", Status: string(models.EnvironmentStatusOnline), Enabled: true}).Error)
+	require.NoError(t, db.Create(&models.Environment{Name: "remote", Status: string(models.EnvironmentStatusOffline), Enabled: true}).Error)
+	require.NoError(t, db.Create(&models.Environment{Name: "disabled", Status: string(models.EnvironmentStatusOffline)}).Error)
+	require.NoError(t, db.Create(&models.ImageUpdateRecord{ID: "sha256:a", Repository: "nginx", Tag: "latest", HasUpdate: true, CheckTime: now}).Error)
+	require.NoError(t, db.Create(&models.ImageUpdateRecord{ID: "sha256:b", Repository: "redis", Tag: "7", CheckTime: now}).Error)
+
+	data, err := svc.GetWidgetData(ctx, envID, "user-1", DashboardWidgetDataOptions{
+		Widgets: []dashboardtypes.WidgetKind{
+			dashboardtypes.WidgetKindRecentEvents,
+			dashboardtypes.WidgetKindEnvironmentHealth,
+			dashboardtypes.WidgetKindUpdatesPending,
+			dashboardtypes.WidgetKindRecentEvents,
+		},
+	})
+	require.NoError(t, err)
+	require.Empty(t, data.Errors)
+	require.Nil(t, data.DiskUsage)
+
+	require.NotNil(t, data.RecentEvents)
+	require.Len(t, data.RecentEvents.Events, 2)
+	require.True(t, data.RecentEvents.Events[0].Timestamp.After(data.RecentEvents.Events[1].Timestamp))
+
+	require.NotNil(t, data.EnvironmentHealth)
+	require.Equal(t, 2, data.EnvironmentHealth.Total)
+	require.Equal(t, 1, data.EnvironmentHealth.Online)
+	require.Len(t, data.EnvironmentHealth.Unhealthy, 1)
+	require.Equal(t, "remote", data.EnvironmentHealth.Unhealthy[0].Name)
+
+	require.NotNil(t, data.UpdatesPending)
+	require.Equal(t, 1, data.UpdatesPending.Count)
+	require.Equal(t, "nginx", data.UpdatesPending.Images[0].Repository)
+
+	_, err = svc.GetWidgetData(ctx, envID, "user-1", DashboardWidgetDataOptions{
+		Widgets: []dashboardtypes.WidgetKind{"weather"},
+	})
+	var validationErr *models.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/moby/moby/api/types/network"
 	"github.com/moby/moby/api/types/volume"
 	"github.com/moby/moby/client"
+	"golang.org/x/sync/singleflight"
 )
 
 const dockerClientNegotiationTimeout = 5 * time.Second
@@ -28,6 +29,12 @@ type DockerClientService struct {
 	settingsService *SettingsService
 	client          *client.Client
 	mu              sync.Mutex
+
+	// SSH clients are guarded separately so that dialling a slow remote host
+	// never holds up the local Docker client.
+	sshClients map[string]*sshDockerClient
+	sshMu      sync.Mutex
+	sshGroup   singleflight.Group
 }
 
 func NewDockerClientService(db *database.DB, cfg *config.Config, settingsService *SettingsService) *DockerClientService {
@@ -38,9 +45,9 @@ func NewDockerClientService(db *database.DB, cfg *config.Config, settingsService
 	}
 }
 
-func newDockerClientInternal(ctx context.Context, host string) (*client.Client, error) {
+func newDockerClientInternal(ctx context.Context, host string, extraOpts ...client.Opt) (*client.Client, error) {
 	probeClient, err := client.New(
-		client.WithHost(host),
+		append([]client.Opt{client.WithHost(host)}, extraOpts...)...,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create Docker probe client: %w", err)
@@ -65,8 +72,7 @@ func newDockerClientInternal(ctx context.Context, host string) (*client.Client,
 	_ = probeClient.Close()
 
 	configuredClient, err := client.New(
-		client.WithHost(host),
-		client.WithAPIVersion(apiVersion),
+		append([]client.Opt{client.WithHost(host), client.WithAPIVersion(apiVersion)}, extraOpts...)...,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to configure Docker client API version %s: %w", apiVersion, err)
@@ -76,8 +82,14 @@ func newDockerClientInternal(ctx context.Context, host string) (*client.Client,
 }
 
 // GetClient returns a singleton Docker client instance.
-// It initializes the client on the first call.
+// It initializes the client on the first call. When the context carries an
+// SSH environment (see WithDockerEnvironment), that environment's client is
+// returned instead.
 func (s *DockerClientService) GetClient(ctx context.Context) (*client.Client, error) {
+	if envID, ok := dockerEnvironmentFromContext(ctx); ok {
+		return s.getSSHClientInternal(ctx, envID)
+	}
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
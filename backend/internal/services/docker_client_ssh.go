@@ -0,0 +1,292 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/crypto"
+	"github.com/moby/moby/client"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	sshDefaultPort         = "22"
+	sshDefaultDockerSocket = "/var/run/docker.sock"
+	sshDialTimeout         = 10 * time.Second
+	sshKeepaliveTimeout    = 5 * time.Second
+
+	SSHHostKeyVerificationStrict    = "strict"
+	SSHHostKeyVerificationAcceptNew = "accept_new"
+	SSHHostKeyVerificationSkip      = "skip"
+)
+
+type dockerEnvironmentContextKey struct{}
+
+// WithDockerEnvironment returns a context that makes GetClient return the
+// Docker client of the given SSH environment instead of the local one.
+func WithDockerEnvironment(ctx context.Context, environmentID string) context.Context {
+	return context.WithValue(ctx, dockerEnvironmentContextKey{}, environmentID)
+}
+
+func dockerEnvironmentFromContext(ctx context.Context) (string, bool) {
+	envID, ok := ctx.Value(dockerEnvironmentContextKey{}).(string)
+	return envID, ok && envID != "" && envID != "0"
+}
+
+// IsSSHEnvironmentURL reports whether an environment API URL points to a
+// Docker host reached over SSH rather than an Arcane agent.
+func IsSSHEnvironmentURL(apiURL string) bool {
+	return strings.HasPrefix(strings.ToLower(strings.TrimSpace(apiURL)), "ssh://")
+}
+
+// ValidateSSHEnvironmentURL checks that an ssh:// environment URL names a
+// user and host.
+func ValidateSSHEnvironmentURL(apiURL string) error {
+	_, err := parseSSHTarget(apiURL)
+	return err
+}
+
+// EncryptSSHKey encrypts an SSH private key for storage on an environment.
+// An empty key yields nil so the stored key is cleared.
+func EncryptSSHKey(key string) (*string, error) {
+	if strings.TrimSpace(key) == "" {
+		return nil, nil
+	}
+	if _, err := ssh.ParsePrivateKey([]byte(key)); err != nil {
+		return nil, fmt.Errorf("failed to parse SSH key: %w", err)
+	}
+	encrypted, err := crypto.Encrypt(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt SSH key: %w", err)
+	}
+	return &encrypted, nil
+}
+
+// sshDockerClient is a Docker client whose connections are tunnelled to the
+// remote Docker socket over a single SSH connection.
+type sshDockerClient struct {
+	docker *client.Client
+	ssh    *ssh.Client
+}
+
+func (c *sshDockerClient) close() {
+	if c.docker != nil {
+		_ = c.docker.Close()
+	}
+	if c.ssh != nil {
+		_ = c.ssh.Close()
+	}
+}
+
+// alive reports whether the SSH connection still answers a keepalive. A
+// connection that does not answer within sshKeepaliveTimeout counts as dead.
+func (c *sshDockerClient) alive() bool {
+	done := make(chan error, 1)
+	go func() {
+		_, _, err := c.ssh.SendRequest("keepalive@openssh.com", true, nil)
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		return err == nil
+	case <-time.After(sshKeepaliveTimeout):
+		return false
+	}
+}
+
+type sshTarget struct {
+	user   string
+	addr   string
+	socket string
+}
+
+func parseSSHTarget(apiURL string) (sshTarget, error) {
+	u, err := url.Parse(strings.TrimSpace(apiURL))
+	if err != nil || !strings.EqualFold(u.Scheme, "ssh") || u.Hostname() == "" {
+		return sshTarget{}, fmt.Errorf("invalid SSH URL %q: expected ssh://user@host[:port][/path/to/docker.sock]", apiURL)
+	}
+
+	target := sshTarget{
+		user:   u.User.Username(),
+		addr:   net.JoinHostPort(u.Hostname(), sshDefaultPort),
+		socket: sshDefaultDockerSocket,
+	}
+	if target.user == "" {
+		return sshTarget{}, fmt.Errorf("invalid SSH URL %q: a user is required", apiURL)
+	}
+	if port := u.Port(); port != "" {
+		target.addr = net.JoinHostPort(u.Hostname(), port)
+	}
+	if u.Path != "" && u.Path != "/" {
+		target.socket = u.Path
+	}
+	return target, nil
+}
+
+// getSSHClientInternal returns the cached Docker client for an SSH
+// environment, reconnecting if the SSH connection has dropped. Concurrent
+// reconnects to the same environment share a single dial.
+func (s *DockerClientService) getSSHClientInternal(ctx context.Context, envID string) (*client.Client, error) {
+	s.sshMu.Lock()
+	cached := s.sshClients[envID]
+	s.sshMu.Unlock()
+
+	if cached != nil && cached.alive() {
+		return cached.docker, nil
+	}
+
+	conn, err, _ := s.sshGroup.Do(envID, func() (any, error) {
+		s.sshMu.Lock()
+		current := s.sshClients[envID]
+		if current != nil && current == cached {
+			delete(s.sshClients, envID)
+			current = nil
+		}
+		s.sshMu.Unlock()
+
+		if cached != nil && current == nil {
+			cached.close()
+		}
+		// Another caller reconnected while this one was probing.
+		if current != nil {
+			return current, nil
+		}
+
+		var env models.Environment
+		if err := s.db.WithContext(ctx).Where("id = ?", envID).First(&env).Error; err != nil {
+			return nil, fmt.Errorf("failed to load environment: %w", err)
+		}
+		if !IsSSHEnvironmentURL(env.ApiUrl) {
+			return nil, fmt.Errorf("environment %s is not an SSH environment", envID)
+		}
+
+		dialed, err := s.dialSSHEnvironmentInternal(ctx, &env)
+		if err != nil {
+			return nil, err
+		}
+
+		s.sshMu.Lock()
+		defer s.sshMu.Unlock()
+		if s.sshClients == nil {
+			s.sshClients = make(map[string]*sshDockerClient)
+		}
+		s.sshClients[envID] = dialed
+		return dialed, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return conn.(*sshDockerClient).docker, nil
+}
+
+func (s *DockerClientService) dialSSHEnvironmentInternal(ctx context.Context, env *models.Environment) (*sshDockerClient, error) {
+	target, err := parseSSHTarget(env.ApiUrl)
+	if err != nil {
+		return nil, err
+	}
+	if env.SSHKey == nil || *env.SSHKey == "" {
+		return nil, errors.New("no SSH key configured for environment")
+	}
+
+	privateKey, err := crypto.Decrypt(*env.SSHKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt SSH key: %w", err)
+	}
+	signer, err := ssh.ParsePrivateKey([]byte(privateKey))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse SSH key: %w", err)
+	}
+
+	hostKeyCallback, err := s.sshHostKeyCallbackInternal(ctx, env)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &ssh.ClientConfig{
+		User:            target.user,
+		Auth:            []ssh.AuthMethod{ssh.PublicKeys(signer)},
+		HostKeyCallback: hostKeyCallback,
+		Timeout:         sshDialTimeout,
+	}
+
+	dialer := net.Dialer{Timeout: sshDialTimeout}
+	rawConn, err := dialer.DialContext(ctx, "tcp", target.addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", target.addr, err)
+	}
+	sshConn, chans, reqs, err := ssh.NewClientConn(rawConn, target.addr, config)
+	if err != nil {
+		_ = rawConn.Close()
+		return nil, fmt.Errorf("SSH handshake with %s failed: %w", target.addr, err)
+	}
+	sshClient := ssh.NewClient(sshConn, chans, reqs)
+
+	socket := target.socket
+	dockerClient, err := newDockerClientInternal(ctx, "http://docker", client.WithDialContext(func(ctx context.Context, _, _ string) (net.Conn, error) {
+		return sshClient.DialContext(ctx, "unix", socket)
+	}))
+	if err != nil {
+		_ = sshClient.Close()
+		return nil, fmt.Errorf("failed to reach Docker over SSH: %w", err)
+	}
+
+	slog.InfoContext(ctx, "Connected to Docker over SSH", "environmentID", env.ID, "address", target.addr, "socket", socket)
+	return &sshDockerClient{docker: dockerClient, ssh: sshClient}, nil
+}
+
+// sshHostKeyCallbackInternal verifies the remote host key according to the
+// environment's verification mode. In accept_new mode the first key seen is
+// pinned on the environment.
+func (s *DockerClientService) sshHostKeyCallbackInternal(ctx context.Context, env *models.Environment) (ssh.HostKeyCallback, error) {
+	mode := env.SSHHostKeyVerification
+	if mode == "" {
+		mode = SSHHostKeyVerificationAcceptNew
+	}
+
+	if mode == SSHHostKeyVerificationSkip {
+		return ssh.InsecureIgnoreHostKey(), nil //nolint:gosec // explicitly requested by the environment's configuration
+	}
+
+	if env.SSHHostKey != nil && strings.TrimSpace(*env.SSHHostKey) != "" {
+		pinned, _, _, _, err := ssh.ParseAuthorizedKey([]byte(*env.SSHHostKey))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse pinned SSH host key: %w", err)
+		}
+		return ssh.FixedHostKey(pinned), nil
+	}
+
+	if mode == SSHHostKeyVerificationStrict {
+		return nil, errors.New("strict host key verification requires a pinned SSH host key")
+	}
+
+	envID := env.ID
+	return func(_ string, _ net.Addr, key ssh.PublicKey) error {
+		authorized := strings.TrimSpace(string(ssh.MarshalAuthorizedKey(key)))
+		if err := s.db.WithContext(ctx).Model(&models.Environment{}).Where("id = ?", envID).Update("ssh_host_key", authorized).Error; err != nil {
+			return fmt.Errorf("failed to pin SSH host key: %w", err)
+		}
+		slog.InfoContext(ctx, "Pinned SSH host key for environment", "environmentID", envID, "fingerprint", ssh.FingerprintSHA256(key))
+		return nil
+	}, nil
+}
+
+// CloseEnvironmentClient drops the cached SSH Docker client of an
+// environment so the next request reconnects with its current settings.
+func (s *DockerClientService) CloseEnvironmentClient(envID string) {
+	s.sshMu.Lock()
+	cached, ok := s.sshClients[envID]
+	delete(s.sshClients, envID)
+	s.sshMu.Unlock()
+
+	if ok {
+		cached.close()
+	}
+}
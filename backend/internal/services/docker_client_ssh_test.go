@@ -0,0 +1,63 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+)
+
+func TestParseSSHTarget(t *testing.T) {
+	target, err := parseSSHTarget("ssh://deploy@docker-host")
+	require.NoError(t, err)
+	require.Equal(t, "deploy", target.user)
+	require.Equal(t, "docker-host:22", target.addr)
+	require.Equal(t, sshDefaultDockerSocket, target.socket)
+
+	target, err = parseSSHTarget("ssh://deploy@10.0.0.5:2222/run/user/1000/docker.sock")
+	require.NoError(t, err)
+	require.Equal(t, "10.0.0.5:2222", target.addr)
+	require.Equal(t, "/run/user/1000/docker.sock", target.socket)
+
+	_, err = parseSSHTarget("ssh://docker-host")
+	require.Error(t, err)
+
+	_, err = parseSSHTarget("http://deploy@docker-host")
+	require.Error(t, err)
+}
+
+func TestIsSSHEnvironmentURL(t *testing.T) {
+	require.True(t, IsSSHEnvironmentURL(" SSH://deploy@docker-host"))
+	require.False(t, IsSSHEnvironmentURL("http://agent:3553"))
+	require.False(t, IsSSHEnvironmentURL("edge://agent"))
+}
+
+func TestDockerClientService_SSHClientDoesNotHoldLocalClientLock(t *testing.T) {
+	db := setupEnvironmentServiceTestDB(t)
+	require.NoError(t, db.Create(&models.Environment{
+		BaseModel: models.BaseModel{ID: "ssh-env"},
+		Name:      "ssh",
+		ApiUrl:    "ssh://deploy@docker-host",
+	}).Error)
+
+	svc := &DockerClientService{db: db}
+	// Hold the local client's lock, as a slow local Docker negotiation would.
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := svc.getSSHClientInternal(context.Background(), "ssh-env")
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		require.ErrorContains(t, err, "no SSH key configured")
+	case <-time.After(5 * time.Second):
+		t.Fatal("SSH client lookup blocked on the local Docker client lock")
+	}
+}
@@ -0,0 +1,362 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	agentImageRepository = "ghcr.io/getarcaneapp/arcane-headless"
+	agentContainerPort   = 3553
+	agentDataPath        = "/app/data"
+	agentTLSCertPath     = "/app/certs/tls.crt"
+	agentTLSKeyPath      = "/app/certs/tls.key"
+
+	defaultAgentImageTag    = "latest"
+	defaultAgentDataVolume  = "arcane-data"
+	defaultAgentDockerSock  = "/var/run/docker.sock"
+	defaultAgentPublishPort = agentContainerPort
+)
+
+// ErrInvalidDeploymentOptions is returned when deployment snippet options
+// cannot produce a working agent deployment.
+var ErrInvalidDeploymentOptions = errors.New("invalid deployment options")
+
+var (
+	imageTagPattern    = regexp.MustCompile(`^[A-Za-z0-9_][A-Za-z0-9._-]{0,127}$`)
+	dockerNamePattern  = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]*$`)
+	envVarNamePattern  = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	plainShellArgument = regexp.MustCompile(`^[A-Za-z0-9_./:=@,+-]+$`)
+)
+
+// DeploymentOptions customizes the generated agent deployment snippets. Zero
+// values fall back to the defaults of the published agent image.
+type DeploymentOptions struct {
+	// ImageTag is the tag of the agent image, "latest" by default.
+	ImageTag string
+	// Port is the host port the agent is published on. Ignored for edge
+	// agents, which only connect outbound.
+	Port int
+	// DataVolume is a named volume or an absolute host path mounted as the
+	// agent data directory.
+	DataVolume string
+	// ProjectsPath is an absolute host path for compose projects. It is
+	// mounted at the same path so relative paths in projects resolve on the
+	// host as well.
+	ProjectsPath string
+	// DockerSocket is the host path of the Docker socket.
+	DockerSocket string
+	// Network is an existing Docker network to attach the agent to.
+	Network string
+	// TLS serves the agent API over HTTPS using TLSCertFile and TLSKeyFile,
+	// absolute host paths mounted read-only. Ignored for edge agents.
+	TLS         bool
+	TLSCertFile string
+	TLSKeyFile  string
+	// ExtraEnv are additional KEY=VALUE environment variables.
+	ExtraEnv []string
+}
+
+// DeploymentSnippets contains deployment configuration snippets for an environment.
+type DeploymentSnippets struct {
+	DockerRun     string
+	DockerCompose string
+	SystemdUnit   string
+}
+
+// agentContainerSpec is the resolved container definition every snippet
+// format is rendered from, so the formats cannot drift apart.
+type agentContainerSpec struct {
+	name         string
+	description  string
+	image        string
+	env          []string
+	ports        []string
+	volumes      []string
+	namedVolumes []string
+	network      string
+}
+
+// buildAgentContainerSpec validates opts and resolves them into a container
+// definition for a direct (edge=false) or edge agent.
+func buildAgentContainerSpec(edge bool, managerURL string, apiKey string, opts DeploymentOptions) (*agentContainerSpec, error) {
+	spec := &agentContainerSpec{
+		name:        "arcane-agent",
+		description: "Arcane agent",
+	}
+	if edge {
+		spec.name = "arcane-edge-agent"
+		spec.description = "Arcane edge agent"
+	}
+
+	tag := strings.TrimSpace(opts.ImageTag)
+	if tag == "" {
+		tag = defaultAgentImageTag
+	}
+	if !imageTagPattern.MatchString(tag) {
+		return nil, fmt.Errorf("%w: image tag %q is not a valid Docker tag", ErrInvalidDeploymentOptions, tag)
+	}
+	spec.image = agentImageRepository + ":" + tag
+
+	if edge {
+		spec.env = append(spec.env, "EDGE_AGENT=true")
+	} else {
+		spec.env = append(spec.env, "AGENT_MODE=true")
+	}
+	spec.env = append(spec.env,
+		"AGENT_TOKEN="+apiKey,
+		"MANAGER_API_URL="+strings.TrimRight(managerURL, "/"),
+	)
+
+	if !edge {
+		port := opts.Port
+		if port == 0 {
+			port = defaultAgentPublishPort
+		}
+		if port < 1 || port > 65535 {
+			return nil, fmt.Errorf("%w: port %d is out of range", ErrInvalidDeploymentOptions, port)
+		}
+		spec.ports = append(spec.ports, fmt.Sprintf("%d:%d", port, agentContainerPort))
+	}
+
+	socket := strings.TrimSpace(opts.DockerSocket)
+	if socket == "" {
+		socket = defaultAgentDockerSock
+	}
+	socket, err := cleanHostPathInternal("docker socket", socket)
+	if err != nil {
+		return nil, err
+	}
+	spec.volumes = append(spec.volumes, socket+":/var/run/docker.sock")
+
+	data := strings.TrimSpace(opts.DataVolume)
+	if data == "" {
+		data = defaultAgentDataVolume
+	}
+	if strings.HasPrefix(data, "/") {
+		if data, err = cleanHostPathInternal("data volume", data); err != nil {
+			return nil, err
+		}
+	} else {
+		if !dockerNamePattern.MatchString(data) {
+			return nil, fmt.Errorf("%w: data volume %q must be a volume name or an absolute host path", ErrInvalidDeploymentOptions, data)
+		}
+		spec.namedVolumes = append(spec.namedVolumes, data)
+	}
+	spec.volumes = append(spec.volumes, data+":"+agentDataPath)
+
+	if projects := strings.TrimSpace(opts.ProjectsPath); projects != "" {
+		if projects, err = cleanHostPathInternal("projects path", projects); err != nil {
+			return nil, err
+		}
+		spec.env = append(spec.env, "PROJECTS_DIRECTORY="+projects)
+		spec.volumes = append(spec.volumes, projects+":"+projects)
+	}
+
+	if !edge && opts.TLS {
+		cert, err := cleanHostPathInternal("TLS certificate file", strings.TrimSpace(opts.TLSCertFile))
+		if err != nil {
+			return nil, err
+		}
+		key, err := cleanHostPathInternal("TLS key file", strings.TrimSpace(opts.TLSKeyFile))
+		if err != nil {
+			return nil, err
+		}
+		spec.env = append(spec.env,
+			"TLS_ENABLED=true",
+			"TLS_CERT_FILE="+agentTLSCertPath,
+			"TLS_KEY_FILE="+agentTLSKeyPath,
+		)
+		spec.volumes = append(spec.volumes, cert+":"+agentTLSCertPath+":ro", key+":"+agentTLSKeyPath+":ro")
+	}
+
+	if network := strings.TrimSpace(opts.Network); network != "" {
+		if !dockerNamePattern.MatchString(network) {
+			return nil, fmt.Errorf("%w: network %q is not a valid Docker network name", ErrInvalidDeploymentOptions, network)
+		}
+		spec.network = network
+	}
+
+	reserved := make([]string, 0, len(spec.env))
+	for _, kv := range spec.env {
+		name, _, _ := strings.Cut(kv, "=")
+		reserved = append(reserved, name)
+	}
+	for _, kv := range opts.ExtraEnv {
+		name, _, ok := strings.Cut(kv, "=")
+		if !ok || !envVarNamePattern.MatchString(name) {
+			return nil, fmt.Errorf("%w: environment variable %q must have the form KEY=VALUE", ErrInvalidDeploymentOptions, kv)
+		}
+		if strings.ContainsAny(kv, "\r\n") {
+			return nil, fmt.Errorf("%w: environment variable %s must not contain line breaks", ErrInvalidDeploymentOptions, name)
+		}
+		if slices.Contains(reserved, name) {
+			return nil, fmt.Errorf("%w: environment variable %s is already set by the snippet", ErrInvalidDeploymentOptions, name)
+		}
+		reserved = append(reserved, name)
+		spec.env = append(spec.env, kv)
+	}
+
+	return spec, nil
+}
+
+// cleanHostPathInternal validates an absolute host path usable on both sides
+// of a "-v src:dst" mount.
+func cleanHostPathInternal(field string, p string) (string, error) {
+	if p == "" {
+		return "", fmt.Errorf("%w: %s is required", ErrInvalidDeploymentOptions, field)
+	}
+	if !path.IsAbs(p) {
+		return "", fmt.Errorf("%w: %s %q must be an absolute path", ErrInvalidDeploymentOptions, field, p)
+	}
+	if strings.ContainsAny(p, ":\r\n") {
+		return "", fmt.Errorf("%w: %s %q must not contain ':' or line breaks", ErrInvalidDeploymentOptions, field, p)
+	}
+	return path.Clean(p), nil
+}
+
+func (spec *agentContainerSpec) snippets() *DeploymentSnippets {
+	return &DeploymentSnippets{
+		DockerRun:     spec.dockerRun(),
+		DockerCompose: spec.dockerCompose(),
+		SystemdUnit:   spec.systemdUnit(),
+	}
+}
+
+// runArgs returns the docker run flags shared by the shell and systemd
+// snippets, one flag with its value per entry.
+func (spec *agentContainerSpec) runArgs(quote func(string) string) []string {
+	var args []string
+	if spec.network != "" {
+		args = append(args, "--network "+quote(spec.network))
+	}
+	for _, kv := range spec.env {
+		args = append(args, "-e "+quote(kv))
+	}
+	for _, p := range spec.ports {
+		args = append(args, "-p "+quote(p))
+	}
+	for _, v := range spec.volumes {
+		args = append(args, "-v "+quote(v))
+	}
+	return append(args, quote(spec.image))
+}
+
+func (spec *agentContainerSpec) dockerRun() string {
+	lines := []string{
+		"docker run -d",
+		"--name " + spec.name,
+		"--restart unless-stopped",
+	}
+	lines = append(lines, spec.runArgs(shellQuoteInternal)...)
+	return strings.Join(lines, " \\\n  ")
+}
+
+func (spec *agentContainerSpec) dockerCompose() string {
+	var b strings.Builder
+	if len(spec.ports) == 0 {
+		b.WriteString("# Edge agent - connects outbound, no exposed ports required\n")
+	}
+	b.WriteString("services:\n")
+	fmt.Fprintf(&b, "  %s:\n", spec.name)
+	fmt.Fprintf(&b, "    image: %s\n", spec.image)
+	fmt.Fprintf(&b, "    container_name: %s\n", spec.name)
+	b.WriteString("    restart: unless-stopped\n")
+
+	b.WriteString("    environment:\n")
+	for _, kv := range spec.env {
+		fmt.Fprintf(&b, "      - %s\n", composeQuoteInternal(kv))
+	}
+	if len(spec.ports) > 0 {
+		b.WriteString("    ports:\n")
+		for _, p := range spec.ports {
+			fmt.Fprintf(&b, "      - %s\n", strconv.Quote(p))
+		}
+	}
+	b.WriteString("    volumes:\n")
+	for _, v := range spec.volumes {
+		fmt.Fprintf(&b, "      - %s\n", composeQuoteInternal(v))
+	}
+	if spec.network != "" {
+		b.WriteString("    networks:\n")
+		fmt.Fprintf(&b, "      - %s\n", spec.network)
+	}
+
+	if len(spec.namedVolumes) > 0 {
+		b.WriteString("\nvolumes:\n")
+		for _, v := range spec.namedVolumes {
+			fmt.Fprintf(&b, "  %s:\n", v)
+		}
+	}
+	if spec.network != "" {
+		b.WriteString("\nnetworks:\n")
+		fmt.Fprintf(&b, "  %s:\n", spec.network)
+		b.WriteString("    external: true\n")
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func (spec *agentContainerSpec) systemdUnit() string {
+	var b strings.Builder
+	b.WriteString("[Unit]\n")
+	fmt.Fprintf(&b, "Description=%s\n", spec.description)
+	b.WriteString("Requires=docker.service\n")
+	b.WriteString("After=docker.service network-online.target\n")
+	b.WriteString("Wants=network-online.target\n\n")
+
+	b.WriteString("[Service]\n")
+	b.WriteString("Restart=always\n")
+	b.WriteString("RestartSec=10\n")
+	fmt.Fprintf(&b, "ExecStartPre=-/usr/bin/docker rm -f %s\n", spec.name)
+	fmt.Fprintf(&b, "ExecStartPre=/usr/bin/docker pull %s\n", systemdQuoteInternal(spec.image))
+
+	lines := []string{
+		"ExecStart=/usr/bin/docker run --rm",
+		"--name " + spec.name,
+	}
+	lines = append(lines, spec.runArgs(systemdQuoteInternal)...)
+	b.WriteString(strings.Join(lines, " \\\n  "))
+	b.WriteString("\n")
+	fmt.Fprintf(&b, "ExecStop=/usr/bin/docker stop %s\n\n", spec.name)
+
+	b.WriteString("[Install]\n")
+	b.WriteString("WantedBy=multi-user.target")
+	return b.String()
+}
+
+// shellQuoteInternal quotes s for a POSIX shell unless it is made of
+// characters the shell never interprets.
+func shellQuoteInternal(s string) string {
+	if plainShellArgument.MatchString(s) {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// composeQuoteInternal escapes "$" against compose variable interpolation and
+// quotes s when plain YAML would misread it.
+func composeQuoteInternal(s string) string {
+	s = strings.ReplaceAll(s, "$", "$$")
+	if plainShellArgument.MatchString(s) {
+		return s
+	}
+	return strconv.Quote(s)
+}
+
+// systemdQuoteInternal escapes systemd specifiers and variable expansion and
+// quotes s when it contains characters systemd would split on.
+func systemdQuoteInternal(s string) string {
+	s = strings.ReplaceAll(s, "%", "%%")
+	s = strings.ReplaceAll(s, "$", "$$")
+	if plainShellArgument.MatchString(s) {
+		return s
+	}
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	return `"` + strings.ReplaceAll(s, `"`, `\"`) + `"`
+}
@@ -0,0 +1,134 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildAgentContainerSpec_Defaults(t *testing.T) {
+	spec, err := buildAgentContainerSpec(false, "https://arcane.example.com/", "arc_token", DeploymentOptions{})
+	require.NoError(t, err)
+
+	snippets := spec.snippets()
+	require.Equal(t, `docker run -d \
+  --name arcane-agent \
+  --restart unless-stopped \
+  -e AGENT_MODE=true \
+  -e AGENT_TOKEN=arc_token \
+  -e MANAGER_API_URL=https://arcane.example.com \
+  -p 3553:3553 \
+  -v /var/run/docker.sock:/var/run/docker.sock \
+  -v arcane-data:/app/data \
+  ghcr.io/getarcaneapp/arcane-headless:latest`, snippets.DockerRun)
+
+	require.Equal(t, `services:
+  arcane-agent:
+    image: ghcr.io/getarcaneapp/arcane-headless:latest
+    container_name: arcane-agent
+    restart: unless-stopped
+    environment:
+      - AGENT_MODE=true
+      - AGENT_TOKEN=arc_token
+      - MANAGER_API_URL=https://arcane.example.com
+    ports:
+      - "3553:3553"
+    volumes:
+      - /var/run/docker.sock:/var/run/docker.sock
+      - arcane-data:/app/data
+
+volumes:
+  arcane-data:`, snippets.DockerCompose)
+
+	require.Contains(t, snippets.SystemdUnit, "ExecStartPre=/usr/bin/docker pull ghcr.io/getarcaneapp/arcane-headless:latest\n")
+	require.Contains(t, snippets.SystemdUnit, "ExecStart=/usr/bin/docker run --rm \\\n  --name arcane-agent \\\n")
+	require.Contains(t, snippets.SystemdUnit, "ExecStop=/usr/bin/docker stop arcane-agent\n")
+}
+
+func TestBuildAgentContainerSpec_Options(t *testing.T) {
+	spec, err := buildAgentContainerSpec(false, "https://arcane.example.com", "arc_token", DeploymentOptions{
+		ImageTag:     "v1.9.0",
+		Port:         8443,
+		DataVolume:   "/srv/arcane/data/",
+		ProjectsPath: "/srv/projects",
+		DockerSocket: "/run/user/1000/docker.sock",
+		Network:      "proxy",
+		TLS:          true,
+		TLSCertFile:  "/etc/ssl/agent.crt",
+		TLSKeyFile:   "/etc/ssl/agent.key",
+		ExtraEnv:     []string{"TZ=Europe/Berlin", "LOG_FORMAT=json text"},
+	})
+	require.NoError(t, err)
+	require.Empty(t, spec.namedVolumes)
+
+	snippets := spec.snippets()
+	require.Contains(t, snippets.DockerRun, "--network proxy \\\n")
+	require.Contains(t, snippets.DockerRun, "-p 8443:3553 \\\n")
+	require.Contains(t, snippets.DockerRun, "-v /run/user/1000/docker.sock:/var/run/docker.sock \\\n")
+	require.Contains(t, snippets.DockerRun, "-v /srv/arcane/data:/app/data \\\n")
+	require.Contains(t, snippets.DockerRun, "-e PROJECTS_DIRECTORY=/srv/projects \\\n  -e TLS_ENABLED=true")
+	require.Contains(t, snippets.DockerRun, "-v /srv/projects:/srv/projects \\\n")
+	require.Contains(t, snippets.DockerRun, "-v /etc/ssl/agent.crt:/app/certs/tls.crt:ro \\\n")
+	require.Contains(t, snippets.DockerRun, "-e 'LOG_FORMAT=json text' \\\n")
+	require.Contains(t, snippets.DockerRun, "ghcr.io/getarcaneapp/arcane-headless:v1.9.0")
+
+	require.Contains(t, snippets.DockerCompose, "      - \"LOG_FORMAT=json text\"\n")
+	require.Contains(t, snippets.DockerCompose, "    networks:\n      - proxy\n")
+	require.Contains(t, snippets.DockerCompose, "\nnetworks:\n  proxy:\n    external: true")
+	require.NotContains(t, snippets.DockerCompose, "\nvolumes:\n")
+
+	require.Contains(t, snippets.SystemdUnit, "-e \"LOG_FORMAT=json text\" \\\n")
+}
+
+func TestBuildAgentContainerSpec_EdgeOmitsListenerOptions(t *testing.T) {
+	spec, err := buildAgentContainerSpec(true, "https://arcane.example.com", "arc_token", DeploymentOptions{
+		Port: 9000,
+		TLS:  true,
+	})
+	require.NoError(t, err)
+
+	snippets := spec.snippets()
+	require.Contains(t, snippets.DockerRun, "--name arcane-edge-agent")
+	require.Contains(t, snippets.DockerRun, "-e EDGE_AGENT=true")
+	require.NotContains(t, snippets.DockerRun, "-p ")
+	require.NotContains(t, snippets.DockerRun, "TLS_ENABLED")
+	require.Contains(t, snippets.DockerCompose, "# Edge agent - connects outbound, no exposed ports required\n")
+	require.NotContains(t, snippets.DockerCompose, "ports:")
+	require.Contains(t, snippets.SystemdUnit, "Description=Arcane edge agent\n")
+}
+
+func TestBuildAgentContainerSpec_EscapesInterpolation(t *testing.T) {
+	spec, err := buildAgentContainerSpec(false, "https://arcane.example.com", "arc_token", DeploymentOptions{
+		ExtraEnv: []string{"PASSWORD=p$ss%1'x"},
+	})
+	require.NoError(t, err)
+
+	snippets := spec.snippets()
+	require.Contains(t, snippets.DockerRun, `-e 'PASSWORD=p$ss%1'\''x'`)
+	require.Contains(t, snippets.DockerCompose, `- "PASSWORD=p$$ss%1'x"`)
+	require.Contains(t, snippets.SystemdUnit, `-e "PASSWORD=p$$ss%%1'x"`)
+}
+
+func TestBuildAgentContainerSpec_RejectsInvalidOptions(t *testing.T) {
+	tests := map[string]DeploymentOptions{
+		"image tag":         {ImageTag: "latest; rm -rf /"},
+		"port":              {Port: 70000},
+		"data volume":       {DataVolume: "data dir"},
+		"relative projects": {ProjectsPath: "projects"},
+		"socket with colon": {DockerSocket: "/var/run/docker.sock:/x"},
+		"network":           {Network: "-bad"},
+		"tls without cert":  {TLS: true, TLSKeyFile: "/etc/ssl/agent.key"},
+		"malformed env":     {ExtraEnv: []string{"NOVALUE"}},
+		"env name":          {ExtraEnv: []string{"1BAD=x"}},
+		"reserved env":      {ExtraEnv: []string{"AGENT_TOKEN=other"}},
+		"duplicate env":     {ExtraEnv: []string{"TZ=UTC", "TZ=CET"}},
+		"multiline env":     {ExtraEnv: []string{"TZ=UTC\nAGENT_MODE=false"}},
+	}
+
+	for name, opts := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := buildAgentContainerSpec(false, "https://arcane.example.com", "arc_token", opts)
+			require.ErrorIs(t, err, ErrInvalidDeploymentOptions)
+		})
+	}
+}
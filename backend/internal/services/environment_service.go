@@ -8,43 +8,75 @@ import (
 	"io"
 	"log/slog"
 	"net/http"
+	"os"
 	"strings"
 	"time"
 
+	"github.com/cenkalti/backoff/v5"
+	"github.com/docker/compose/v5/pkg/api"
+	"github.com/getarcaneapp/arcane/backend/internal/config"
 	"github.com/getarcaneapp/arcane/backend/internal/database"
 	"github.com/getarcaneapp/arcane/backend/internal/models"
 	"github.com/getarcaneapp/arcane/backend/internal/utils/crypto"
 	"github.com/getarcaneapp/arcane/backend/internal/utils/mapper"
 	"github.com/getarcaneapp/arcane/backend/internal/utils/pagination"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/remenv"
 	"github.com/getarcaneapp/arcane/backend/internal/utils/timeouts"
 	"github.com/getarcaneapp/arcane/backend/pkg/libarcane/edge"
 	"github.com/getarcaneapp/arcane/types/containerregistry"
 	"github.com/getarcaneapp/arcane/types/environment"
 	"github.com/getarcaneapp/arcane/types/gitops"
+	"github.com/getarcaneapp/arcane/types/version"
 	"github.com/google/uuid"
+	"github.com/moby/moby/api/types/swarm"
 	"github.com/moby/moby/client"
 	"gorm.io/gorm"
 )
 
+// capabilitiesRefreshInterval is how long the capabilities an agent advertised
+// are trusted before the health check asks for them again.
+const capabilitiesRefreshInterval = 15 * time.Minute
+
+// ErrEnvironmentUnavailable is returned without contacting the agent while the
+// circuit breaker of a remote environment is open.
+var ErrEnvironmentUnavailable = errors.New("environment is unreachable; requests are paused until the circuit breaker retries")
+
+var (
+	// ErrPairingRequestNotFound is returned when approving or rejecting an
+	// environment that has no pairing request waiting.
+	ErrPairingRequestNotFound = errors.New("environment has no pending pairing request")
+	// ErrPairingFingerprintMismatch is returned when an agent requests pairing
+	// while a request from an agent with another fingerprint is waiting.
+	ErrPairingFingerprintMismatch = errors.New("another agent already requested pairing for this environment")
+)
+
+// errRetryableStatus marks agent responses that are worth retrying.
+var errRetryableStatus = errors.New("retryable status")
+
 type EnvironmentService struct {
 	db              *database.DB
 	httpClient      *http.Client
 	dockerService   *DockerClientService
 	eventService    *EventService
 	settingsService *SettingsService
+	breakers        *remenv.Breakers
+	syncs           *remenv.SyncTracker
 }
 
 func NewEnvironmentService(db *database.DB, httpClient *http.Client, dockerService *DockerClientService, eventService *EventService, settingsService *SettingsService) *EnvironmentService {
 	if httpClient == nil {
 		httpClient = http.DefaultClient
 	}
-	return &EnvironmentService{
+	s := &EnvironmentService{
 		db:              db,
 		httpClient:      httpClient,
 		dockerService:   dockerService,
 		eventService:    eventService,
 		settingsService: settingsService,
 	}
+	s.breakers = remenv.NewBreakers(s.breakerConfigInternal)
+	s.syncs = remenv.NewSyncTracker()
+	return s
 }
 
 func (s *EnvironmentService) EnsureLocalEnvironment(ctx context.Context, appUrl string) error {
@@ -137,6 +169,12 @@ func (s *EnvironmentService) ListEnvironmentsPaginated(ctx context.Context, para
 
 	q = pagination.ApplyFilter(q, "status", params.Filters["status"])
 	q = pagination.ApplyBooleanFilter(q, "enabled", params.Filters["enabled"])
+	if tagFilter := strings.TrimSpace(params.Filters["tags"]); tagFilter != "" {
+		var err error
+		if q, err = applyTagFilterInternal(q, models.TagResourceEnvironment, "id", tagFilter); err != nil {
+			return nil, pagination.Response{}, err
+		}
+	}
 
 	paginationResp, err := pagination.PaginateAndSortDB(params, q, &envs)
 	if err != nil {
@@ -148,6 +186,19 @@ func (s *EnvironmentService) ListEnvironmentsPaginated(ctx context.Context, para
 		return nil, pagination.Response{}, fmt.Errorf("failed to map environments: %w", mapErr)
 	}
 
+	ids := make([]string, len(out))
+	for i, env := range out {
+		ids[i] = env.ID
+	}
+	tags, err := resourceTagsInternal(ctx, s.db, models.TagResourceEnvironment, ids)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to load environment tags", "error", err)
+	}
+	for i := range out {
+		out[i].Tags = tags[out[i].ID]
+		out[i].Breaker = s.BreakerState(out[i].ID)
+	}
+
 	return out, paginationResp, nil
 }
 
@@ -209,6 +260,9 @@ func (s *EnvironmentService) UpdateEnvironment(ctx context.Context, id string, u
 	if err := s.db.WithContext(ctx).Model(&models.Environment{}).Where("id = ?", id).Updates(updates).Error; err != nil {
 		return nil, fmt.Errorf("failed to update environment: %w", err)
 	}
+	if s.dockerService != nil {
+		s.dockerService.CloseEnvironmentClient(id)
+	}
 
 	updated, err := s.GetEnvironmentByID(ctx, id)
 	if err != nil {
@@ -233,6 +287,11 @@ func (s *EnvironmentService) DeleteEnvironment(ctx context.Context, id string, u
 	if err := s.db.WithContext(ctx).Delete(&models.Environment{}, "id = ?", id).Error; err != nil {
 		return fmt.Errorf("failed to delete environment: %w", err)
 	}
+	deleteResourceTagsInternal(ctx, s.db, models.TagResourceEnvironment, id)
+	if s.dockerService != nil {
+		s.dockerService.CloseEnvironmentClient(id)
+	}
+	s.syncs.Forget(id)
 
 	// Create event in background
 	go s.createEnvironmentEvent(context.WithoutCancel(ctx), id, env.Name, models.EventTypeEnvironmentDelete, "Environment Deleted", fmt.Sprintf("Environment '%s' was deleted", env.Name), models.EventSeverityWarning, userID, username)
@@ -253,7 +312,19 @@ func (s *EnvironmentService) TestConnection(ctx context.Context, id string, cust
 
 	// For edge environments, check if there's an active tunnel and route through it
 	if environment.IsEdge && customApiUrl == nil {
-		return s.testEdgeConnection(ctx, id)
+		status, err := s.testEdgeConnection(ctx, id)
+		if status == "online" {
+			s.refreshStaleCapabilitiesInternal(ctx, environment)
+		}
+		return status, err
+	}
+
+	// SSH environments have no agent API; ping their Docker daemon through the tunnel instead
+	if customApiUrl == nil && IsSSHEnvironmentURL(environment.ApiUrl) {
+		return s.testLocalDockerConnection(WithDockerEnvironment(ctx, id), id)
+	}
+	if customApiUrl != nil && IsSSHEnvironmentURL(*customApiUrl) {
+		return "error", fmt.Errorf("SSH environments can only be tested after they are saved")
 	}
 
 	apiUrl := environment.ApiUrl
@@ -283,6 +354,7 @@ func (s *EnvironmentService) TestConnection(ctx context.Context, id string, cust
 	if resp.StatusCode == http.StatusOK {
 		if customApiUrl == nil {
 			_ = s.updateEnvironmentStatusInternal(ctx, id, string(models.EnvironmentStatusOnline))
+			s.refreshStaleCapabilitiesInternal(ctx, environment)
 		}
 		return "online", nil
 	}
@@ -320,6 +392,108 @@ func (s *EnvironmentService) testEdgeConnection(ctx context.Context, id string)
 	return "error", fmt.Errorf("unexpected status code: %d", statusCode)
 }
 
+// LocalCapabilities describes what this instance serves to a manager when it
+// runs as an agent.
+func (s *EnvironmentService) LocalCapabilities(ctx context.Context) *environment.Capabilities {
+	capabilities := &environment.Capabilities{
+		Version:        config.Version,
+		ComposeVersion: api.ComposeVersion,
+		Features:       remenv.Features(),
+	}
+	if s.dockerService == nil {
+		return capabilities
+	}
+
+	dockerClient, err := s.dockerService.GetClient(ctx)
+	if err != nil {
+		return capabilities
+	}
+	info, err := dockerClient.Info(ctx, client.InfoOptions{})
+	if err != nil {
+		slog.DebugContext(ctx, "Failed to read swarm state for capabilities", "error", err)
+		return capabilities
+	}
+	capabilities.SwarmEnabled = info.Info.Swarm.LocalNodeState == swarm.LocalNodeStateActive
+	return capabilities
+}
+
+// SaveCapabilities stores the capabilities the agent of an environment advertised.
+func (s *EnvironmentService) SaveCapabilities(ctx context.Context, id string, capabilities *environment.Capabilities) error {
+	if capabilities == nil {
+		return nil
+	}
+	stored := *capabilities
+	stored.CheckedAt = new(time.Now())
+
+	data, err := json.Marshal(stored)
+	if err != nil {
+		return fmt.Errorf("failed to encode environment capabilities: %w", err)
+	}
+	if err := s.db.WithContext(ctx).Model(&models.Environment{}).Where("id = ?", id).Update("capabilities", string(data)).Error; err != nil {
+		return fmt.Errorf("failed to save environment capabilities: %w", err)
+	}
+	return nil
+}
+
+// RefreshCapabilities asks the agent of a remote environment for its
+// capabilities and stores them. Agents that predate capability negotiation
+// answer 404 and are recorded with the legacy feature set.
+func (s *EnvironmentService) RefreshCapabilities(ctx context.Context, id string) (*environment.Capabilities, error) {
+	body, statusCode, err := s.ProxyRequest(ctx, id, http.MethodGet, "/api/capabilities", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var capabilities environment.Capabilities
+	switch statusCode {
+	case http.StatusOK:
+		if err := json.Unmarshal(body, &capabilities); err != nil {
+			return nil, fmt.Errorf("failed to decode capabilities: %w", err)
+		}
+	case http.StatusNotFound:
+		capabilities = environment.Capabilities{
+			Version:  s.agentVersionInternal(ctx, id),
+			Features: remenv.LegacyFeatures(),
+			Legacy:   true,
+		}
+	default:
+		return nil, fmt.Errorf("unexpected status code: %d", statusCode)
+	}
+
+	if err := s.SaveCapabilities(ctx, id, &capabilities); err != nil {
+		return nil, err
+	}
+	return &capabilities, nil
+}
+
+// refreshStaleCapabilitiesInternal refreshes the capabilities of a reachable
+// agent when they are missing or older than capabilitiesRefreshInterval.
+func (s *EnvironmentService) refreshStaleCapabilitiesInternal(ctx context.Context, env *models.Environment) {
+	if env.ID == "0" || IsSSHEnvironmentURL(env.ApiUrl) {
+		return
+	}
+	if c := env.Capabilities; c != nil && c.CheckedAt != nil && time.Since(*c.CheckedAt) < capabilitiesRefreshInterval {
+		return
+	}
+	if _, err := s.RefreshCapabilities(ctx, env.ID); err != nil {
+		slog.WarnContext(ctx, "Failed to refresh environment capabilities", "environment_id", env.ID, "error", err)
+	}
+}
+
+// agentVersionInternal returns the version a legacy agent reports, or an empty
+// string when it cannot be read.
+func (s *EnvironmentService) agentVersionInternal(ctx context.Context, id string) string {
+	body, statusCode, err := s.ProxyRequest(ctx, id, http.MethodGet, "/api/app-version", nil)
+	if err != nil || statusCode != http.StatusOK {
+		return ""
+	}
+	var info version.Info
+	if err := json.Unmarshal(body, &info); err != nil {
+		return ""
+	}
+	return info.CurrentVersion
+}
+
 func (s *EnvironmentService) testLocalDockerConnection(ctx context.Context, id string) (string, error) {
 	// Test local Docker socket by pinging Docker
 	reqCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
@@ -432,6 +606,9 @@ func (s *EnvironmentService) ReconcileEdgeStatusesOnStartup(ctx context.Context)
 }
 
 func (s *EnvironmentService) createEnvironmentEvent(ctx context.Context, envID, envName string, eventType models.EventType, title, description string, severity models.EventSeverity, userID, username *string) {
+	if s.eventService == nil {
+		return
+	}
 	_, _ = s.eventService.CreateEvent(ctx, CreateEventRequest{
 		Type:          eventType,
 		Severity:      severity,
@@ -453,6 +630,10 @@ func (s *EnvironmentService) RegenerateEnvironmentApiKey(ctx context.Context, en
 		"access_token": encryptedKey,
 		"status":       string(models.EnvironmentStatusPending),
 		"last_seen":    nil, // Clear last seen time
+		// Requests queued with the old key must not be approved for the new one
+		"pairing_fingerprint":  nil,
+		"pairing_origin_ip":    nil,
+		"pairing_requested_at": nil,
 	}
 
 	if err := s.db.WithContext(ctx).Model(&models.Environment{}).Where("id = ?", envID).Updates(updates).Error; err != nil {
@@ -465,6 +646,112 @@ func (s *EnvironmentService) RegenerateEnvironmentApiKey(ctx context.Context, en
 	return nil
 }
 
+// LocalFingerprint is the fingerprint this instance presents when it requests
+// pairing as an agent. It derives from the Docker engine ID, or the hostname
+// when the engine can't be reached.
+func (s *EnvironmentService) LocalFingerprint(ctx context.Context) string {
+	identity, _ := os.Hostname()
+	if s.dockerService != nil {
+		if dockerClient, err := s.dockerService.GetClient(ctx); err == nil {
+			if info, err := dockerClient.Info(ctx, client.InfoOptions{}); err == nil && info.Info.ID != "" {
+				identity = info.Info.ID
+			}
+		}
+	}
+	return remenv.Fingerprint(identity)
+}
+
+// RequestPairing queues the pairing request of an agent for admin approval.
+// Repeated requests from the same agent refresh the queued one; a request
+// from an agent with another fingerprint is refused until the queued one is
+// rejected, so a leaked key can't replace the request of the real agent.
+func (s *EnvironmentService) RequestPairing(ctx context.Context, id, fingerprint, originIP string) error {
+	env, err := s.GetEnvironmentByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if env.PairingRequestedAt != nil && derefString(env.PairingFingerprint) != fingerprint {
+		return ErrPairingFingerprintMismatch
+	}
+
+	now := time.Now()
+	updates := map[string]any{
+		"pairing_fingerprint":  stringPtr(fingerprint),
+		"pairing_origin_ip":    stringPtr(originIP),
+		"pairing_requested_at": &now,
+	}
+	if err := s.db.WithContext(ctx).Model(&models.Environment{}).Where("id = ?", id).Updates(updates).Error; err != nil {
+		return fmt.Errorf("failed to queue pairing request: %w", err)
+	}
+
+	if env.PairingRequestedAt == nil {
+		description := fmt.Sprintf("Agent with fingerprint %s requested pairing from %s and waits for approval", valueOrUnknownInternal(fingerprint), valueOrUnknownInternal(originIP))
+		go s.createEnvironmentEvent(context.WithoutCancel(ctx), id, env.Name, models.EventTypeEnvironmentPairingRequested, "Pairing Requested", description, models.EventSeverityWarning, nil, nil)
+	}
+	return nil
+}
+
+// ApprovePairing approves the queued pairing request of an environment and
+// brings it online.
+func (s *EnvironmentService) ApprovePairing(ctx context.Context, id string, userID, username *string) (*models.Environment, error) {
+	env, err := s.GetEnvironmentByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if env.Status != string(models.EnvironmentStatusPending) || env.PairingRequestedAt == nil {
+		return nil, ErrPairingRequestNotFound
+	}
+
+	now := time.Now()
+	updates := map[string]any{
+		"status":               string(models.EnvironmentStatusOnline),
+		"last_seen":            &now,
+		"pairing_fingerprint":  nil,
+		"pairing_origin_ip":    nil,
+		"pairing_requested_at": nil,
+	}
+	if err := s.db.WithContext(ctx).Model(&models.Environment{}).Where("id = ?", id).Updates(updates).Error; err != nil {
+		return nil, fmt.Errorf("failed to approve pairing request: %w", err)
+	}
+
+	description := fmt.Sprintf("Pairing of agent with fingerprint %s was approved", valueOrUnknownInternal(derefString(env.PairingFingerprint)))
+	go s.createEnvironmentEvent(context.WithoutCancel(ctx), id, env.Name, models.EventTypeEnvironmentPairingApproved, "Pairing Approved", description, models.EventSeveritySuccess, userID, username)
+
+	return s.GetEnvironmentByID(ctx, id)
+}
+
+// RejectPairing discards the queued pairing request of an environment. The
+// environment stays pending, so the agent can request pairing again.
+func (s *EnvironmentService) RejectPairing(ctx context.Context, id string, userID, username *string) error {
+	env, err := s.GetEnvironmentByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if env.PairingRequestedAt == nil {
+		return ErrPairingRequestNotFound
+	}
+
+	updates := map[string]any{
+		"pairing_fingerprint":  nil,
+		"pairing_origin_ip":    nil,
+		"pairing_requested_at": nil,
+	}
+	if err := s.db.WithContext(ctx).Model(&models.Environment{}).Where("id = ?", id).Updates(updates).Error; err != nil {
+		return fmt.Errorf("failed to reject pairing request: %w", err)
+	}
+
+	description := fmt.Sprintf("Pairing request of agent with fingerprint %s from %s was rejected", valueOrUnknownInternal(derefString(env.PairingFingerprint)), valueOrUnknownInternal(derefString(env.PairingOriginIP)))
+	go s.createEnvironmentEvent(context.WithoutCancel(ctx), id, env.Name, models.EventTypeEnvironmentPairingRejected, "Pairing Rejected", description, models.EventSeverityWarning, userID, username)
+	return nil
+}
+
+func valueOrUnknownInternal(value string) string {
+	if value == "" {
+		return "unknown"
+	}
+	return value
+}
+
 // Deprecated - Use the Api Key flow
 func (s *EnvironmentService) PairAgentWithBootstrap(ctx context.Context, apiUrl, bootstrapToken string) (string, error) {
 	reqCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
@@ -550,87 +837,23 @@ func (s *EnvironmentService) GetEnabledRegistryCredentials(ctx context.Context)
 	return creds, nil
 }
 
-// DeploymentSnippets contains deployment configuration snippets for an environment.
-type DeploymentSnippets struct {
-	DockerRun     string
-	DockerCompose string
-}
-
 // GenerateDeploymentSnippets generates Docker deployment snippets for an environment.
-func (s *EnvironmentService) GenerateDeploymentSnippets(ctx context.Context, envID string, envAddress string, apiKey string) (*DeploymentSnippets, error) {
-	managerURL := strings.TrimRight(envAddress, "/")
-
-	dockerRun := fmt.Sprintf(`docker run -d \
-  --name arcane-agent \
-  --restart unless-stopped \
-  -e AGENT_MODE=true \
-  -e AGENT_TOKEN=%s \
-  -e MANAGER_API_URL=%s \
-  -p 3553:3553 \
-  -v /var/run/docker.sock:/var/run/docker.sock \
-  -v arcane-data:/data \
-  ghcr.io/getarcaneapp/arcane-headless:latest`, apiKey, managerURL)
-
-	dockerCompose := fmt.Sprintf(`services:
-  arcane-agent:
-    image: ghcr.io/getarcaneapp/arcane-headless:latest
-    container_name: arcane-agent
-    restart: unless-stopped
-    environment:
-      - AGENT_MODE=true
-      - AGENT_TOKEN=%s
-      - MANAGER_API_URL=%s
-    ports:
-      - "3553:3553"
-    volumes:
-      - /var/run/docker.sock:/var/run/docker.sock
-      - arcane-data:/app/data
-
-volumes:
-  arcane-data:`, apiKey, managerURL)
-
-	return &DeploymentSnippets{
-		DockerRun:     dockerRun,
-		DockerCompose: dockerCompose,
-	}, nil
+func (s *EnvironmentService) GenerateDeploymentSnippets(ctx context.Context, envID string, envAddress string, apiKey string, opts DeploymentOptions) (*DeploymentSnippets, error) {
+	spec, err := buildAgentContainerSpec(false, envAddress, apiKey, opts)
+	if err != nil {
+		return nil, err
+	}
+	return spec.snippets(), nil
 }
 
 // GenerateEdgeDeploymentSnippets generates Docker deployment snippets for an edge agent.
 // Edge agents connect outbound to the manager and don't require exposed ports.
-func (s *EnvironmentService) GenerateEdgeDeploymentSnippets(ctx context.Context, envID string, managerURL string, apiKey string) (*DeploymentSnippets, error) {
-	managerURL = strings.TrimRight(managerURL, "/")
-
-	dockerRun := fmt.Sprintf(`docker run -d \
-  --name arcane-edge-agent \
-  --restart unless-stopped \
-  -e EDGE_AGENT=true \
-  -e AGENT_TOKEN=%s \
-  -e MANAGER_API_URL=%s \
-  -v /var/run/docker.sock:/var/run/docker.sock \
-  -v arcane-data:/app/data \
-  ghcr.io/getarcaneapp/arcane-headless:latest`, apiKey, managerURL)
-
-	dockerCompose := fmt.Sprintf(`# Edge agent - connects outbound, no exposed ports required
-services:
-  arcane-edge-agent:
-    image: ghcr.io/getarcaneapp/arcane-headless:latest
-    container_name: arcane-edge-agent
-    restart: unless-stopped
-    environment:
-      - EDGE_AGENT=true
-      - AGENT_TOKEN=%s
-      - MANAGER_API_URL=%s
-    volumes:
-      - /var/run/docker.sock:/var/run/docker.sock
-      - arcane-data:/app/data
-
-volumes:
-  arcane-data:`, apiKey, managerURL)
-
-	return &DeploymentSnippets{
-		DockerRun:     dockerRun,
-		DockerCompose: dockerCompose,
-	}, nil
+func (s *EnvironmentService) GenerateEdgeDeploymentSnippets(ctx context.Context, envID string, managerURL string, apiKey string, opts DeploymentOptions) (*DeploymentSnippets, error) {
+	spec, err := buildAgentContainerSpec(true, managerURL, apiKey, opts)
+	if err != nil {
+		return nil, err
+	}
+	return spec.snippets(), nil
 }
 
 // SyncRegistriesToEnvironment syncs all registries from this manager to a remote environment
@@ -675,6 +898,11 @@ func (s *EnvironmentService) SyncRegistriesToEnvironment(ctx context.Context, en
 			Enabled:     reg.Enabled,
 			CreatedAt:   reg.CreatedAt,
 			UpdatedAt:   reg.UpdatedAt,
+
+			SignatureMode:     reg.SignatureMode,
+			SignatureTrust:    reg.SignatureTrust,
+			SignatureIdentity: reg.SignatureIdentity,
+			SignatureIssuer:   reg.SignatureIssuer,
 		})
 	}
 
@@ -875,6 +1103,9 @@ func (s *EnvironmentService) ProxyRequest(ctx context.Context, envID string, met
 	if envID == "0" {
 		return nil, 0, fmt.Errorf("cannot proxy request to local environment")
 	}
+	if IsSSHEnvironmentURL(environment.ApiUrl) {
+		return nil, 0, fmt.Errorf("environment is reached over SSH and has no agent API")
+	}
 
 	targetURL := strings.TrimRight(environment.ApiUrl, "/") + path
 
@@ -895,10 +1126,170 @@ func (s *EnvironmentService) ProxyRequest(ctx context.Context, envID string, met
 	}
 
 	// Use edge-aware client that routes through tunnel for edge environments
-	resp, err := edge.DoEdgeAwareRequest(proxyCtx, envID, environment.IsEdge, method, targetURL, path, headers, body)
+	resp, err := s.doWithRetriesInternal(proxyCtx, envID, method, func() (*edge.EdgeResponse, error) {
+		return edge.DoEdgeAwareRequest(proxyCtx, envID, environment.IsEdge, method, targetURL, path, headers, body)
+	})
 	if err != nil {
+		if errors.Is(err, ErrEnvironmentUnavailable) {
+			return nil, 0, err
+		}
 		return nil, 0, fmt.Errorf("failed to send request: %w", err)
 	}
 
 	return resp.Body, resp.StatusCode, nil
 }
+
+// doWithRetriesInternal sends a request to a remote environment through its
+// circuit breaker. Read requests that fail to connect or hit a gateway error
+// are retried up to the configured number of times.
+func (s *EnvironmentService) doWithRetriesInternal(ctx context.Context, envID, method string, send func() (*edge.EdgeResponse, error)) (*edge.EdgeResponse, error) {
+	if err := s.AllowRequest(envID); err != nil {
+		return nil, err
+	}
+
+	tries := uint(1)
+	if method == http.MethodGet || method == http.MethodHead {
+		tries += uint(s.ProxyRetries()) //nolint:gosec // ProxyRetries is never negative
+	}
+
+	resp, err := backoff.Retry(ctx, func() (*edge.EdgeResponse, error) {
+		resp, err := send()
+		if err != nil {
+			return nil, err
+		}
+		if remenv.IsUnavailableStatus(resp.StatusCode) {
+			return resp, errRetryableStatus
+		}
+		return resp, nil
+	}, backoff.WithBackOff(backoff.NewExponentialBackOff()), backoff.WithMaxTries(tries))
+	if errors.Is(err, errRetryableStatus) {
+		err = nil
+	}
+
+	statusCode := 0
+	if resp != nil {
+		statusCode = resp.StatusCode
+	}
+	s.RecordRequestResult(envID, statusCode, err)
+	return resp, err
+}
+
+func (s *EnvironmentService) breakerConfigInternal() remenv.BreakerConfig {
+	if s.settingsService == nil {
+		return remenv.BreakerConfig{}
+	}
+	cfg := s.settingsService.GetSettingsConfig()
+	return remenv.BreakerConfig{
+		Threshold: cfg.EnvironmentBreakerThreshold.AsInt(),
+		Cooldown:  cfg.EnvironmentBreakerCooldown.AsDurationSeconds(),
+	}
+}
+
+// AllowRequest returns ErrEnvironmentUnavailable while the circuit breaker of
+// a remote environment is open.
+func (s *EnvironmentService) AllowRequest(envID string) error {
+	if s.breakers.Allow(envID) {
+		return nil
+	}
+	if lastError := s.breakers.State(envID).LastError; lastError != "" {
+		return fmt.Errorf("%w (last error: %s)", ErrEnvironmentUnavailable, lastError)
+	}
+	return ErrEnvironmentUnavailable
+}
+
+// RecordRequestResult feeds the outcome of a request to a remote environment
+// into its circuit breaker. Connection errors and gateway errors count as
+// failures; requests cancelled by the caller say nothing about the agent.
+func (s *EnvironmentService) RecordRequestResult(envID string, statusCode int, err error) {
+	switch {
+	case errors.Is(err, context.Canceled):
+	case err != nil:
+		s.breakers.Failure(envID, err)
+	case remenv.IsUnavailableStatus(statusCode):
+		s.breakers.Failure(envID, fmt.Errorf("agent returned status %d", statusCode))
+	default:
+		s.breakers.Success(envID)
+	}
+}
+
+// BreakerState returns the circuit breaker state of a remote environment, or
+// nil for the local environment.
+func (s *EnvironmentService) BreakerState(envID string) *environment.CircuitBreaker {
+	if envID == "0" {
+		return nil
+	}
+	state := s.breakers.State(envID)
+	return &state
+}
+
+// AggregateRequestTimeout returns how long each remote environment may take
+// to answer an all-environments list.
+func (s *EnvironmentService) AggregateRequestTimeout() time.Duration {
+	if s.settingsService == nil {
+		return timeouts.DefaultAggregateRequest
+	}
+	return timeouts.GetDuration(s.settingsService.GetSettingsConfig().AggregateRequestTimeout.AsInt(), timeouts.DefaultAggregateRequest)
+}
+
+// ProxyRetries returns how often read requests to remote environments are retried.
+func (s *EnvironmentService) ProxyRetries() int {
+	if s.settingsService == nil {
+		return 0
+	}
+	return max(s.settingsService.GetSettingsConfig().ProxyRequestRetries.AsInt(), 0)
+}
+
+// UploadToEnvironment sends a request with a streamed body, such as an image
+// archive, to a remote environment's API. Edge environments are reached
+// through the tunnel, which carries whole messages, so the body is read into
+// memory first.
+func (s *EnvironmentService) UploadToEnvironment(ctx context.Context, envID, method, path, contentType string, body io.Reader) ([]byte, int, error) {
+	environment, err := s.GetEnvironmentByID(ctx, envID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get environment: %w", err)
+	}
+	if envID == "0" {
+		return nil, 0, fmt.Errorf("cannot proxy request to local environment")
+	}
+	if IsSSHEnvironmentURL(environment.ApiUrl) {
+		return nil, 0, fmt.Errorf("environment is reached over SSH and has no agent API")
+	}
+
+	targetURL := strings.TrimRight(environment.ApiUrl, "/") + path
+	headers := map[string]string{"Content-Type": contentType}
+	if environment.AccessToken != nil && *environment.AccessToken != "" {
+		headers["X-Arcane-Agent-Token"] = *environment.AccessToken
+		headers["X-API-Key"] = *environment.AccessToken
+	}
+
+	if environment.IsEdge {
+		data, err := io.ReadAll(body)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to read request body: %w", err)
+		}
+		resp, err := edge.DoEdgeAwareRequest(ctx, envID, true, method, targetURL, path, headers, data)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to send request: %w", err)
+		}
+		return resp.Body, resp.StatusCode, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, targetURL, body)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to create request: %w", err)
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := s.httpClient.Do(req) //nolint:gosec // intentional request to configured environment URL
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to read response body: %w", err)
+	}
+	return respBody, resp.StatusCode, nil
+}
@@ -260,3 +260,50 @@ func TestEnvironmentService_UpdateEnvironmentConnectionState(t *testing.T) {
 	require.NotNil(t, env.LastSeen)
 	require.Equal(t, *lastSeen, *env.LastSeen)
 }
+
+func TestEnvironmentService_PairingRequestsWaitForApproval(t *testing.T) {
+	ctx := context.Background()
+	db := setupEnvironmentServiceTestDB(t)
+	svc := NewEnvironmentService(db, nil, nil, nil, nil)
+
+	createTestEnvironmentWithState(t, db, "agent-1", "http://agent-1:3553", string(models.EnvironmentStatusPending), false, nil)
+
+	require.NoError(t, svc.RequestPairing(ctx, "agent-1", "SHA256:first", "10.0.0.5"))
+	require.NoError(t, svc.RequestPairing(ctx, "agent-1", "SHA256:first", "10.0.0.6"), "the same agent may repeat its request")
+	require.ErrorIs(t, svc.RequestPairing(ctx, "agent-1", "SHA256:rogue", "203.0.113.9"), ErrPairingFingerprintMismatch)
+
+	env, err := svc.GetEnvironmentByID(ctx, "agent-1")
+	require.NoError(t, err)
+	require.Equal(t, string(models.EnvironmentStatusPending), env.Status)
+	require.Equal(t, "SHA256:first", *env.PairingFingerprint)
+	require.Equal(t, "10.0.0.6", *env.PairingOriginIP)
+	require.NotNil(t, env.PairingRequestedAt)
+
+	env, err = svc.ApprovePairing(ctx, "agent-1", nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, string(models.EnvironmentStatusOnline), env.Status)
+	require.Nil(t, env.PairingRequestedAt)
+
+	_, err = svc.ApprovePairing(ctx, "agent-1", nil, nil)
+	require.ErrorIs(t, err, ErrPairingRequestNotFound)
+}
+
+func TestEnvironmentService_RejectPairingKeepsEnvironmentPending(t *testing.T) {
+	ctx := context.Background()
+	db := setupEnvironmentServiceTestDB(t)
+	svc := NewEnvironmentService(db, nil, nil, nil, nil)
+
+	createTestEnvironmentWithState(t, db, "agent-2", "http://agent-2:3553", string(models.EnvironmentStatusPending), false, nil)
+
+	require.ErrorIs(t, svc.RejectPairing(ctx, "agent-2", nil, nil), ErrPairingRequestNotFound)
+	require.NoError(t, svc.RequestPairing(ctx, "agent-2", "SHA256:rogue", "203.0.113.9"))
+	require.NoError(t, svc.RejectPairing(ctx, "agent-2", nil, nil))
+
+	env, err := svc.GetEnvironmentByID(ctx, "agent-2")
+	require.NoError(t, err)
+	require.Equal(t, string(models.EnvironmentStatusPending), env.Status)
+	require.Nil(t, env.PairingFingerprint)
+	require.Nil(t, env.PairingRequestedAt)
+
+	require.NoError(t, svc.RequestPairing(ctx, "agent-2", "SHA256:real", "10.0.0.7"), "a rejected request frees the queue")
+}
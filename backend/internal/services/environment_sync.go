@@ -0,0 +1,116 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/getarcaneapp/arcane/types/environment"
+)
+
+// DefaultEnvironmentSyncTries is how often each target of a tracked sync is
+// attempted before the sync is marked as failed.
+const DefaultEnvironmentSyncTries = 3
+
+// ErrEnvironmentSyncUnsupported is returned for environments without an agent
+// to sync registries and repositories to.
+var ErrEnvironmentSyncUnsupported = errors.New("environment has no agent to sync registries and repositories to")
+
+var environmentSyncTargets = []string{
+	environment.SyncTargetRegistries,
+	environment.SyncTargetRepositories,
+}
+
+// StartEnvironmentSync syncs container registries and git repositories to a
+// remote environment in the background, retrying failed targets. When a sync
+// of the environment is already running, that sync is returned instead.
+func (s *EnvironmentService) StartEnvironmentSync(ctx context.Context, envID, trigger string) (*environment.ConfigSyncStatus, error) { //nolint:contextcheck // the sync intentionally outlives the request
+	if err := s.checkSyncableInternal(ctx, envID); err != nil {
+		return nil, err
+	}
+
+	status, started := s.syncs.Begin(envID, trigger, environmentSyncTargets)
+	if started {
+		go s.runSyncTargetsInternal(context.WithoutCancel(ctx), envID, DefaultEnvironmentSyncTries)
+	}
+	return &status, nil
+}
+
+// RunEnvironmentSync syncs container registries and git repositories to a
+// remote environment and waits for the result. When a sync of the environment
+// is already running, it returns that sync without waiting.
+func (s *EnvironmentService) RunEnvironmentSync(ctx context.Context, envID, trigger string, tries uint) (*environment.ConfigSyncStatus, error) {
+	if err := s.checkSyncableInternal(ctx, envID); err != nil {
+		return nil, err
+	}
+
+	status, started := s.syncs.Begin(envID, trigger, environmentSyncTargets)
+	if !started {
+		return &status, nil
+	}
+	s.runSyncTargetsInternal(ctx, envID, tries)
+
+	status, _ = s.syncs.Status(envID)
+	return &status, nil
+}
+
+// EnvironmentSyncStatus returns the latest sync of a remote environment, or
+// nil if none ran since the manager started.
+func (s *EnvironmentService) EnvironmentSyncStatus(envID string) *environment.ConfigSyncStatus {
+	status, ok := s.syncs.Status(envID)
+	if !ok {
+		return nil
+	}
+	return &status
+}
+
+// ListEnvironmentSyncStatuses returns the latest sync of every remote
+// environment that was synced since the manager started.
+func (s *EnvironmentService) ListEnvironmentSyncStatuses() []environment.ConfigSyncStatus {
+	return s.syncs.List()
+}
+
+func (s *EnvironmentService) checkSyncableInternal(ctx context.Context, envID string) error {
+	if envID == "0" {
+		return ErrEnvironmentSyncUnsupported
+	}
+	env, err := s.GetEnvironmentByID(ctx, envID)
+	if err != nil {
+		return fmt.Errorf("failed to get environment: %w", err)
+	}
+	if IsSSHEnvironmentURL(env.ApiUrl) {
+		return ErrEnvironmentSyncUnsupported
+	}
+	return nil
+}
+
+func (s *EnvironmentService) runSyncTargetsInternal(ctx context.Context, envID string, tries uint) {
+	var wg sync.WaitGroup
+	for _, target := range environmentSyncTargets {
+		wg.Go(func() {
+			_, err := backoff.Retry(ctx, func() (struct{}, error) {
+				s.syncs.Attempt(envID, target)
+				return struct{}{}, s.syncTargetInternal(ctx, envID, target)
+			}, backoff.WithBackOff(backoff.NewExponentialBackOff()), backoff.WithMaxTries(tries))
+			if err != nil {
+				slog.WarnContext(ctx, "Failed to sync to environment", "environmentID", envID, "target", target, "error", err.Error())
+			}
+			s.syncs.Finish(envID, target, err)
+		})
+	}
+	wg.Wait()
+}
+
+func (s *EnvironmentService) syncTargetInternal(ctx context.Context, envID, target string) error {
+	switch target {
+	case environment.SyncTargetRegistries:
+		return s.SyncRegistriesToEnvironment(ctx, envID)
+	case environment.SyncTargetRepositories:
+		return s.SyncRepositoriesToEnvironment(ctx, envID)
+	default:
+		return backoff.Permanent(fmt.Errorf("unknown sync target %q", target))
+	}
+}
@@ -0,0 +1,216 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/getarcaneapp/arcane/backend/internal/database"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+)
+
+const (
+	defaultEnvironmentHeartbeatTimeoutSeconds = 300
+	defaultEnvironmentFlapWindowMinutes       = 10
+
+	// environmentFlapThreshold is how many state changes inside the flap
+	// window mark an environment as flapping.
+	environmentFlapThreshold = 3
+)
+
+// environmentWatchState tracks the observed and last notified connectivity of
+// one environment.
+type environmentWatchState struct {
+	offline         bool
+	notifiedOffline bool
+	changes         []time.Time
+}
+
+// EnvironmentWatchService marks remote environments offline when their
+// heartbeats stop and notifies on offline/online transitions. Environments
+// that change state repeatedly within the flap window are only notified once
+// they settle.
+type EnvironmentWatchService struct {
+	db                  *database.DB
+	settingsService     *SettingsService
+	eventService        *EventService
+	notificationService *NotificationService
+
+	mu     sync.Mutex
+	states map[string]*environmentWatchState
+	now    func() time.Time
+}
+
+func NewEnvironmentWatchService(db *database.DB, settingsService *SettingsService, eventService *EventService, notificationService *NotificationService) *EnvironmentWatchService {
+	return &EnvironmentWatchService{
+		db:                  db,
+		settingsService:     settingsService,
+		eventService:        eventService,
+		notificationService: notificationService,
+		states:              make(map[string]*environmentWatchState),
+		now:                 time.Now,
+	}
+}
+
+// CheckHeartbeats evaluates every enabled remote environment, marks the ones
+// whose heartbeat is older than the configured timeout as offline, and sends
+// offline/online notifications for settled transitions.
+func (s *EnvironmentWatchService) CheckHeartbeats(ctx context.Context) error {
+	timeoutSeconds := defaultEnvironmentHeartbeatTimeoutSeconds
+	flapWindowMinutes := defaultEnvironmentFlapWindowMinutes
+	if s.settingsService != nil {
+		timeoutSeconds = s.settingsService.GetIntSetting(ctx, "environmentHeartbeatTimeout", defaultEnvironmentHeartbeatTimeoutSeconds)
+		flapWindowMinutes = s.settingsService.GetIntSetting(ctx, "environmentFlapWindow", defaultEnvironmentFlapWindowMinutes)
+	}
+	if timeoutSeconds <= 0 {
+		return nil
+	}
+	timeout := time.Duration(timeoutSeconds) * time.Second
+	flapWindow := time.Duration(max(flapWindowMinutes, 0)) * time.Minute
+
+	var envs []models.Environment
+	err := s.db.WithContext(ctx).
+		Where("id != ?", "0").
+		Where("enabled = ?", true).
+		Where("status <> ?", string(models.EnvironmentStatusPending)).
+		Find(&envs).Error
+	if err != nil {
+		return fmt.Errorf("failed to list environments: %w", err)
+	}
+
+	now := s.now()
+	seen := make(map[string]struct{}, len(envs))
+	for i := range envs {
+		env := &envs[i]
+		seen[env.ID] = struct{}{}
+
+		stale := env.LastSeen == nil || now.Sub(*env.LastSeen) > timeout
+		offline := stale || env.Status == string(models.EnvironmentStatusOffline)
+
+		if stale && env.Status == string(models.EnvironmentStatusOnline) {
+			if err := s.markOfflineInternal(ctx, env.ID, now); err != nil {
+				slog.WarnContext(ctx, "Failed to mark environment offline", "environmentID", env.ID, "error", err)
+			}
+		}
+
+		if notify, becameOffline := s.observeInternal(env, offline, now, flapWindow); notify {
+			s.notifyTransitionInternal(ctx, env, becameOffline)
+		}
+	}
+
+	s.mu.Lock()
+	for id := range s.states {
+		if _, ok := seen[id]; !ok {
+			delete(s.states, id)
+		}
+	}
+	s.mu.Unlock()
+
+	return nil
+}
+
+// observeInternal records the current state of an environment and reports
+// whether a notification is due and, if so, whether it went offline.
+func (s *EnvironmentWatchService) observeInternal(env *models.Environment, offline bool, now time.Time, flapWindow time.Duration) (bool, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, ok := s.states[env.ID]
+	if !ok {
+		// Seed from the persisted status so a restart does not re-announce
+		// environments that were already known to be offline.
+		known := env.Status == string(models.EnvironmentStatusOffline)
+		state = &environmentWatchState{offline: known, notifiedOffline: known}
+		s.states[env.ID] = state
+	}
+
+	if offline != state.offline {
+		state.offline = offline
+		state.changes = append(state.changes, now)
+	}
+
+	cutoff := now.Add(-flapWindow)
+	kept := state.changes[:0]
+	for _, changedAt := range state.changes {
+		if changedAt.After(cutoff) {
+			kept = append(kept, changedAt)
+		}
+	}
+	state.changes = kept
+
+	if state.offline == state.notifiedOffline {
+		return false, false
+	}
+	if len(state.changes) >= environmentFlapThreshold {
+		slog.Debug("Suppressing notification for flapping environment", "environmentID", env.ID, "changes", len(state.changes))
+		return false, false
+	}
+
+	state.notifiedOffline = state.offline
+	return true, state.offline
+}
+
+func (s *EnvironmentWatchService) markOfflineInternal(ctx context.Context, envID string, now time.Time) error {
+	// last_seen is left untouched so it keeps pointing at the final heartbeat.
+	return s.db.WithContext(ctx).Model(&models.Environment{}).
+		Where("id = ?", envID).
+		Where("status = ?", string(models.EnvironmentStatusOnline)).
+		Updates(map[string]any{
+			"status":     string(models.EnvironmentStatusOffline),
+			"updated_at": &now,
+		}).Error
+}
+
+func (s *EnvironmentWatchService) notifyTransitionInternal(ctx context.Context, env *models.Environment, offline bool) {
+	eventType := models.EventTypeEnvironmentOnline
+	notificationType := models.NotificationEventEnvironmentOnline
+	title := fmt.Sprintf("Environment online: %s", env.Name)
+	message := fmt.Sprintf("Environment '%s' is sending heartbeats again", env.Name)
+	if offline {
+		eventType = models.EventTypeEnvironmentOffline
+		notificationType = models.NotificationEventEnvironmentOffline
+		title = fmt.Sprintf("Environment offline: %s", env.Name)
+		message = fmt.Sprintf("Environment '%s' stopped sending heartbeats", env.Name)
+	}
+
+	metadata := models.JSON{
+		"environmentId": env.ID,
+		"apiUrl":        env.ApiUrl,
+	}
+	if env.LastSeen != nil {
+		metadata["lastSeen"] = env.LastSeen.UTC().Format(time.RFC3339)
+	}
+
+	if s.eventService != nil {
+		resourceType := "environment"
+		_, err := s.eventService.CreateEvent(ctx, CreateEventRequest{
+			Type:          eventType,
+			Severity:      s.eventService.getEventSeverity(eventType),
+			Title:         title,
+			Description:   message,
+			ResourceType:  &resourceType,
+			ResourceID:    &env.ID,
+			ResourceName:  &env.Name,
+			EnvironmentID: &env.ID,
+			Metadata:      metadata,
+		})
+		if err != nil {
+			slog.WarnContext(ctx, "Failed to record environment connectivity event", "environmentID", env.ID, "error", err)
+		}
+	}
+
+	if s.notificationService != nil {
+		err := s.notificationService.SendAlertNotification(ctx, AlertNotification{
+			EventType: notificationType,
+			Subject:   env.Name,
+			Title:     title,
+			Message:   message,
+			Metadata:  metadata,
+		})
+		if err != nil {
+			slog.WarnContext(ctx, "Failed to send environment connectivity notification", "environmentID", env.ID, "error", err)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	glsqlite "github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/getarcaneapp/arcane/backend/internal/database"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+)
+
+func setupEnvironmentWatchServiceTest(t *testing.T) (*EnvironmentWatchService, *gorm.DB) {
+	t.Helper()
+	ctx := context.Background()
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared", strings.NewReplacer("/", "_", " ", "_").Replace(t.Name()))
+	db, err := gorm.Open(glsqlite.Open(dsn), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.SettingVariable{}, &models.Environment{}))
+
+	settingsSvc, err := NewSettingsService(ctx, &database.DB{DB: db})
+	require.NoError(t, err)
+	require.NoError(t, settingsSvc.EnsureDefaultSettings(ctx))
+
+	return NewEnvironmentWatchService(&database.DB{DB: db}, settingsSvc, nil, nil), db
+}
+
+func TestEnvironmentWatchService_MarksStaleEnvironmentOffline(t *testing.T) {
+	svc, db := setupEnvironmentWatchServiceTest(t)
+	ctx := context.Background()
+
+	now := time.Now()
+	svc.now = func() time.Time { return now }
+	stale := now.Add(-10 * time.Minute)
+	fresh := now.Add(-10 * time.Second)
+	require.NoError(t, db.Create(&models.Environment{BaseModel: models.BaseModel{ID: "env-stale"}, Name: "stale", ApiUrl: "http://stale", Status: "online", Enabled: true, LastSeen: &stale}).Error)
+	require.NoError(t, db.Create(&models.Environment{BaseModel: models.BaseModel{ID: "env-fresh"}, Name: "fresh", ApiUrl: "http://fresh", Status: "online", Enabled: true, LastSeen: &fresh}).Error)
+
+	require.NoError(t, svc.CheckHeartbeats(ctx))
+
+	var staleEnv models.Environment
+	require.NoError(t, db.First(&staleEnv, "id = ?", "env-stale").Error)
+	require.Equal(t, string(models.EnvironmentStatusOffline), staleEnv.Status)
+	require.WithinDuration(t, stale, *staleEnv.LastSeen, time.Second)
+	require.True(t, svc.states["env-stale"].notifiedOffline)
+
+	var freshEnv models.Environment
+	require.NoError(t, db.First(&freshEnv, "id = ?", "env-fresh").Error)
+	require.Equal(t, string(models.EnvironmentStatusOnline), freshEnv.Status)
+	require.False(t, svc.states["env-fresh"].notifiedOffline)
+}
+
+func TestEnvironmentWatchService_SuppressesFlapping(t *testing.T) {
+	svc, _ := setupEnvironmentWatchServiceTest(t)
+
+	now := time.Now()
+	env := &models.Environment{BaseModel: models.BaseModel{ID: "env-1"}, Status: "online"}
+	window := 10 * time.Minute
+
+	notify, offline := svc.observeInternal(env, true, now, window)
+	require.True(t, notify)
+	require.True(t, offline)
+
+	notify, _ = svc.observeInternal(env, false, now.Add(time.Minute), window)
+	require.True(t, notify)
+
+	// Third change inside the window: flapping, stay quiet.
+	notify, _ = svc.observeInternal(env, true, now.Add(2*time.Minute), window)
+	require.False(t, notify)
+
+	// Once the earlier changes age out of the window the settled state is announced.
+	notify, offline = svc.observeInternal(env, true, now.Add(12*time.Minute), window)
+	require.True(t, notify)
+	require.True(t, offline)
+}
@@ -3,6 +3,9 @@ package services
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,7 +13,9 @@ import (
 	"log/slog"
 	"net/http"
 	"net/url"
+	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/getarcaneapp/arcane/backend/internal/config"
@@ -18,6 +23,7 @@ import (
 	"github.com/getarcaneapp/arcane/backend/internal/models"
 	"github.com/getarcaneapp/arcane/backend/internal/utils/mapper"
 	"github.com/getarcaneapp/arcane/backend/internal/utils/pagination"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/siem"
 	"github.com/getarcaneapp/arcane/backend/pkg/libarcane/edge"
 	"github.com/getarcaneapp/arcane/types/event"
 	"golang.org/x/text/cases"
@@ -26,9 +32,55 @@ import (
 )
 
 type EventService struct {
-	db         *database.DB
-	cfg        *config.Config
-	httpClient *http.Client
+	db              *database.DB
+	cfg             *config.Config
+	httpClient      *http.Client
+	settingsService *SettingsService
+
+	subscribersMu sync.RWMutex
+	subscribers   map[*eventSubscription]struct{}
+}
+
+// eventSubscriptionBuffer is how many events a subscriber may fall behind
+// before further events are dropped for it.
+const eventSubscriptionBuffer = 64
+
+type eventSubscription struct {
+	filter EventSubscriptionFilter
+	ch     chan event.Event
+}
+
+// EventSubscriptionFilter selects the newly created events delivered to a
+// subscriber. Empty fields match every event. Types may end in ".*" to match
+// a whole family, e.g. "container.*".
+type EventSubscriptionFilter struct {
+	Types         []string
+	Severities    []string
+	EnvironmentID string
+}
+
+// Matches reports whether e passes the filter.
+func (f EventSubscriptionFilter) Matches(e *models.Event) bool {
+	if f.EnvironmentID != "" && (e.EnvironmentID == nil || *e.EnvironmentID != f.EnvironmentID) {
+		return false
+	}
+	if len(f.Severities) > 0 && !slices.Contains(f.Severities, string(e.Severity)) {
+		return false
+	}
+	if len(f.Types) == 0 {
+		return true
+	}
+	eventType := string(e.Type)
+	for _, t := range f.Types {
+		if prefix, ok := strings.CutSuffix(t, "*"); ok {
+			if strings.HasPrefix(eventType, prefix) {
+				return true
+			}
+		} else if t == eventType {
+			return true
+		}
+	}
+	return false
 }
 
 func NewEventService(db *database.DB, cfg *config.Config, httpClient *http.Client) *EventService {
@@ -44,6 +96,13 @@ func NewEventService(db *database.DB, cfg *config.Config, httpClient *http.Clien
 	}
 }
 
+// SetSettingsService wires the settings used to decide whether events are
+// forwarded to an external SIEM. The event service is constructed before
+// settings, so this is injected afterwards during bootstrap.
+func (s *EventService) SetSettingsService(settingsService *SettingsService) {
+	s.settingsService = settingsService
+}
+
 type CreateEventRequest struct {
 	Type          models.EventType     `json:"type"`
 	Severity      models.EventSeverity `json:"severity,omitempty"`
@@ -94,10 +153,62 @@ func (s *EventService) CreateEvent(ctx context.Context, req CreateEventRequest)
 	}
 
 	s.forwardEventToManager(ctx, event)
+	s.forwardEventToSIEM(ctx, event)
+	s.publishEventInternal(event)
 
 	return event, nil
 }
 
+// Subscribe registers a listener for events created from now on that match
+// filter. Delivery never blocks event creation: a subscriber that falls more
+// than a small buffer behind misses events until it catches up. The returned
+// function removes the subscription and closes the channel.
+func (s *EventService) Subscribe(filter EventSubscriptionFilter) (<-chan event.Event, func()) {
+	sub := &eventSubscription{
+		filter: filter,
+		ch:     make(chan event.Event, eventSubscriptionBuffer),
+	}
+
+	s.subscribersMu.Lock()
+	if s.subscribers == nil {
+		s.subscribers = make(map[*eventSubscription]struct{})
+	}
+	s.subscribers[sub] = struct{}{}
+	s.subscribersMu.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			s.subscribersMu.Lock()
+			delete(s.subscribers, sub)
+			s.subscribersMu.Unlock()
+			close(sub.ch)
+		})
+	}
+	return sub.ch, unsubscribe
+}
+
+func (s *EventService) publishEventInternal(eventModel *models.Event) {
+	s.subscribersMu.RLock()
+	defer s.subscribersMu.RUnlock()
+
+	if len(s.subscribers) == 0 {
+		return
+	}
+
+	dto := *s.toEventDto(eventModel)
+	for sub := range s.subscribers {
+		if !sub.filter.Matches(eventModel) {
+			continue
+		}
+		select {
+		case sub.ch <- dto:
+		default:
+			slog.Debug("Dropping event for slow subscriber", "event_id", eventModel.ID, "type", eventModel.Type)
+		}
+	}
+}
+
 func (s *EventService) forwardEventToManager(ctx context.Context, eventModel *models.Event) {
 	if eventModel == nil || s.cfg == nil || !s.cfg.AgentMode {
 		return
@@ -153,6 +264,72 @@ func (s *EventService) forwardEventToManager(ctx context.Context, eventModel *mo
 	}(ctx, evt)
 }
 
+// siemForwarder returns the configured SIEM forwarder, or nil when forwarding is disabled.
+func (s *EventService) siemForwarder() (siem.Forwarder, error) {
+	if s.settingsService == nil {
+		return nil, nil
+	}
+	cfg := s.settingsService.GetSettingsConfig()
+	if !cfg.AuditForwardEnabled.IsTrue() {
+		return nil, nil
+	}
+	return siem.NewForwarder(cfg.AuditForwardProtocol.Value, cfg.AuditForwardTarget.Value, cfg.AuditForwardToken.Value, s.httpClient)
+}
+
+func (s *EventService) forwardEventToSIEM(ctx context.Context, eventModel *models.Event) {
+	if eventModel == nil {
+		return
+	}
+
+	forwarder, err := s.siemForwarder()
+	if err != nil {
+		slog.WarnContext(ctx, "Audit forwarding is misconfigured", "error", err)
+		return
+	}
+	if forwarder == nil {
+		return
+	}
+
+	record, err := json.Marshal(s.toEventDto(eventModel))
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to marshal event for audit forwarding", "type", eventModel.Type, "error", err)
+		return
+	}
+
+	go func(parentCtx context.Context) {
+		fwdCtx, cancel := context.WithTimeout(context.WithoutCancel(parentCtx), 10*time.Second)
+		defer cancel()
+		if err := forwarder.Forward(fwdCtx, record, string(eventModel.Severity)); err != nil {
+			slog.WarnContext(fwdCtx, "Failed to forward event to SIEM", "type", eventModel.Type, "error", err)
+		}
+	}(ctx)
+}
+
+// TestSIEMForwarding sends a synthetic event through the configured forwarder.
+func (s *EventService) TestSIEMForwarding(ctx context.Context) error {
+	forwarder, err := s.siemForwarder()
+	if err != nil {
+		return err
+	}
+	if forwarder == nil {
+		return fmt.Errorf("audit forwarding is disabled")
+	}
+
+	now := time.Now()
+	record, err := json.Marshal(event.Event{
+		ID:        "test",
+		Type:      "system.audit_forward_test",
+		Severity:  string(models.EventSeverityInfo),
+		Title:     "Arcane audit forwarding test",
+		Timestamp: now,
+		CreatedAt: now,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal test event: %w", err)
+	}
+	return forwarder.Forward(ctx, record, string(models.EventSeverityInfo))
+}
+
 func (s *EventService) canForwardEventToManagerHTTP() bool {
 	if s.cfg == nil {
 		return false
@@ -378,6 +555,121 @@ func (s *EventService) GetEventsByEnvironmentPaginated(ctx context.Context, envi
 	return eventDtos, paginationResp, nil
 }
 
+// EventExport is a serialized batch of events together with its manifest.
+type EventExport struct {
+	Data     []byte
+	Manifest event.ExportManifest
+}
+
+var eventExportCSVHeader = []string{
+	"id", "timestamp", "type", "severity", "title", "description",
+	"resource_type", "resource_id", "resource_name", "user_id", "username", "environment_id", "metadata",
+}
+
+// ExportEvents serializes all events in [from, to) as JSONL or CSV, ordered by
+// timestamp, and computes a SHA-256 digest of the batch for integrity checks.
+func (s *EventService) ExportEvents(ctx context.Context, format string, from, to *time.Time, environmentID string) (*EventExport, error) {
+	format = strings.ToLower(strings.TrimSpace(format))
+	if format == "" {
+		format = event.ExportFormatJSONL
+	}
+	if format != event.ExportFormatJSONL && format != event.ExportFormatCSV {
+		return nil, fmt.Errorf("unsupported export format: %s", format)
+	}
+	if from != nil && to != nil && !from.Before(*to) {
+		return nil, fmt.Errorf("export range start must be before end")
+	}
+
+	q := s.db.WithContext(ctx).Model(&models.Event{}).Order("timestamp ASC")
+	if from != nil {
+		q = q.Where("timestamp >= ?", *from)
+	}
+	if to != nil {
+		q = q.Where("timestamp < ?", *to)
+	}
+	if environmentID != "" {
+		q = q.Where("environment_id = ?", environmentID)
+	}
+
+	var events []models.Event
+	if err := q.Find(&events).Error; err != nil {
+		return nil, fmt.Errorf("failed to load events for export: %w", err)
+	}
+
+	var buf bytes.Buffer
+	var err error
+	if format == event.ExportFormatCSV {
+		err = s.writeEventsCSV(&buf, events)
+	} else {
+		err = s.writeEventsJSONL(&buf, events)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	digest := sha256.Sum256(buf.Bytes())
+	return &EventExport{
+		Data: buf.Bytes(),
+		Manifest: event.ExportManifest{
+			Format:      format,
+			From:        from,
+			To:          to,
+			Count:       len(events),
+			SHA256:      hex.EncodeToString(digest[:]),
+			GeneratedAt: time.Now().UTC(),
+		},
+	}, nil
+}
+
+func (s *EventService) writeEventsJSONL(w io.Writer, events []models.Event) error {
+	enc := json.NewEncoder(w)
+	for i := range events {
+		if err := enc.Encode(s.toEventDto(&events[i])); err != nil {
+			return fmt.Errorf("failed to encode event %s: %w", events[i].ID, err)
+		}
+	}
+	return nil
+}
+
+func (s *EventService) writeEventsCSV(w io.Writer, events []models.Event) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(eventExportCSVHeader); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	deref := func(v *string) string {
+		if v == nil {
+			return ""
+		}
+		return *v
+	}
+
+	for _, e := range events {
+		metadata := ""
+		if len(e.Metadata) > 0 {
+			raw, err := json.Marshal(map[string]any(e.Metadata))
+			if err != nil {
+				return fmt.Errorf("failed to encode metadata for event %s: %w", e.ID, err)
+			}
+			metadata = string(raw)
+		}
+
+		row := []string{
+			e.ID, e.Timestamp.UTC().Format(time.RFC3339Nano), string(e.Type), string(e.Severity), e.Title, e.Description,
+			deref(e.ResourceType), deref(e.ResourceID), deref(e.ResourceName), deref(e.UserID), deref(e.Username), deref(e.EnvironmentID), metadata,
+		}
+		if err := cw.Write(row); err != nil {
+			return fmt.Errorf("failed to write CSV row: %w", err)
+		}
+	}
+
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return fmt.Errorf("failed to flush CSV export: %w", err)
+	}
+	return nil
+}
+
 func (s *EventService) DeleteEvent(ctx context.Context, eventID string) error {
 	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		result := tx.Delete(&models.Event{}, "id = ?", eventID)
@@ -402,6 +694,58 @@ func (s *EventService) DeleteOldEvents(ctx context.Context, olderThan time.Durat
 	})
 }
 
+// Arcane events whose actions create, change or remove containers, grouped by
+// what they apply to.
+var (
+	containerChangeEventTypes = []models.EventType{
+		models.EventTypeContainerCreate,
+		models.EventTypeContainerUpdate,
+		models.EventTypeContainerDelete,
+		models.EventTypeContainerRestart,
+		models.EventTypeContainerSnapshotRestore,
+		models.EventTypeContainerRollback,
+	}
+	projectChangeEventTypes = []models.EventType{
+		models.EventTypeProjectDeploy,
+		models.EventTypeProjectStart,
+		models.EventTypeProjectStop,
+		models.EventTypeProjectUpdate,
+		models.EventTypeProjectDelete,
+		models.EventTypeProjectMaintenanceStart,
+		models.EventTypeProjectMaintenanceEnd,
+	}
+	hostChangeEventTypes = []models.EventType{
+		models.EventTypeSystemPrune,
+		models.EventTypeSystemAutoUpdate,
+		models.EventTypeSystemUpgrade,
+		models.EventTypeRolloutCompleted,
+		models.EventTypeRolloutHalted,
+	}
+)
+
+// HasContainerChangeEventSince reports whether Arcane logged an action since the
+// given time that explains a change to the container: an action on the
+// container itself, on its compose project, or on every container on the host
+// such as a prune or an auto-update run.
+func (s *EventService) HasContainerChangeEventSince(ctx context.Context, since time.Time, containerID, containerName, projectName string) (bool, error) {
+	matches := s.db.Where("type IN ?", hostChangeEventTypes).
+		Or("resource_type = ? AND type IN ? AND (resource_id = ? OR resource_name = ?)", "container", containerChangeEventTypes, containerID, containerName)
+	if projectName != "" {
+		matches = matches.Or("resource_type = ? AND type IN ? AND LOWER(resource_name) = ?", "project", projectChangeEventTypes, strings.ToLower(projectName))
+	}
+
+	var count int64
+	err := s.db.WithContext(ctx).
+		Model(&models.Event{}).
+		Where("timestamp >= ?", since).
+		Where(matches).
+		Count(&count).Error
+	if err != nil {
+		return false, fmt.Errorf("failed to look up container change events: %w", err)
+	}
+	return count > 0, nil
+}
+
 func (s *EventService) LogContainerEvent(ctx context.Context, eventType models.EventType, containerID, containerName, userID, username, environmentID string, metadata models.JSON) error {
 	title := s.generateEventTitle(eventType, containerName)
 	description := s.generateEventDescription(eventType, "container", containerName)
@@ -605,11 +949,12 @@ var eventDefinitions = map[models.EventType]struct {
 	models.EventTypeContainerUpdate:  {"Container updated: %s", "Container '%s' has been updated", models.EventSeverityInfo},
 	models.EventTypeContainerError:   {"Container error: %s", "An error occurred with container '%s'", models.EventSeverityError},
 
-	models.EventTypeImagePull:   {"Image pulled: %s", "Image '%s' has been pulled", models.EventSeveritySuccess},
-	models.EventTypeImageLoad:   {"Image loaded: %s", "Image '%s' has been loaded from archive", models.EventSeveritySuccess},
-	models.EventTypeImageDelete: {"Image deleted: %s", "Image '%s' has been deleted", models.EventSeverityWarning},
-	models.EventTypeImageScan:   {"Image scanned: %s", "Security scan completed for image '%s'", models.EventSeverityInfo},
-	models.EventTypeImageError:  {"Image error: %s", "An error occurred with image '%s'", models.EventSeverityError},
+	models.EventTypeImagePull:       {"Image pulled: %s", "Image '%s' has been pulled", models.EventSeveritySuccess},
+	models.EventTypeImageLoad:       {"Image loaded: %s", "Image '%s' has been loaded from archive", models.EventSeveritySuccess},
+	models.EventTypeImageDelete:     {"Image deleted: %s", "Image '%s' has been deleted", models.EventSeverityWarning},
+	models.EventTypeImageScan:       {"Image scanned: %s", "Security scan completed for image '%s'", models.EventSeverityInfo},
+	models.EventTypeImageError:      {"Image error: %s", "An error occurred with image '%s'", models.EventSeverityError},
+	models.EventTypeImageDistribute: {"Image distributed: %s", "Image '%s' has been copied to other environments", models.EventSeveritySuccess},
 
 	models.EventTypeProjectDeploy: {"Project deployed: %s", "Project '%s' has been deployed", models.EventSeveritySuccess},
 	models.EventTypeProjectDelete: {"Project deleted: %s", "Project '%s' has been deleted", models.EventSeverityWarning},
@@ -618,6 +963,13 @@ var eventDefinitions = map[models.EventType]struct {
 	models.EventTypeProjectCreate: {"Project created: %s", "Project '%s' has been created", models.EventSeveritySuccess},
 	models.EventTypeProjectUpdate: {"Project updated: %s", "Project '%s' has been updated", models.EventSeverityInfo},
 	models.EventTypeProjectError:  {"Project error: %s", "An error occurred with project '%s'", models.EventSeverityError},
+	models.EventTypeProjectLock:   {"Project locked: %s", "Project '%s' has been locked", models.EventSeverityInfo},
+	models.EventTypeProjectUnlock: {"Project unlocked: %s", "Project '%s' has been unlocked", models.EventSeverityWarning},
+
+	models.EventTypeProjectMaintenanceStart: {"Project maintenance started: %s", "Project '%s' has been put into maintenance mode", models.EventSeverityWarning},
+	models.EventTypeProjectMaintenanceEnd:   {"Project maintenance ended: %s", "Project '%s' has been restored from maintenance mode", models.EventSeveritySuccess},
+	models.EventTypeProjectArchive:          {"Project archived: %s", "Project '%s' has been archived", models.EventSeverityInfo},
+	models.EventTypeProjectUnarchive:        {"Project unarchived: %s", "Project '%s' has been unarchived", models.EventSeverityInfo},
 
 	models.EventTypeVolumeCreate:             {"Volume created: %s", "Volume '%s' has been created", models.EventSeveritySuccess},
 	models.EventTypeVolumeDelete:             {"Volume deleted: %s", "Volume '%s' has been deleted", models.EventSeverityWarning},
@@ -638,9 +990,42 @@ var eventDefinitions = map[models.EventType]struct {
 	models.EventTypeSystemPrune:      {"System prune completed", "System resources have been pruned", models.EventSeverityInfo},
 	models.EventTypeSystemAutoUpdate: {"System auto-update completed", "System auto-update process has completed", models.EventSeverityInfo},
 	models.EventTypeSystemUpgrade:    {"System upgrade completed", "System upgrade process has completed", models.EventSeverityInfo},
+	models.EventTypeSystemDaemonJSON: {"Docker daemon configuration updated", "daemon.json was updated", models.EventSeverityInfo},
 
 	models.EventTypeUserLogin:  {"User logged in: %s", "User '%s' has logged in", models.EventSeverityInfo},
 	models.EventTypeUserLogout: {"User logged out: %s", "User '%s' has logged out", models.EventSeverityInfo},
+
+	models.EventTypeMonitorDown: {"Monitor down: %s", "Uptime monitor '%s' is failing", models.EventSeverityError},
+	models.EventTypeMonitorUp:   {"Monitor recovered: %s", "Uptime monitor '%s' is responding again", models.EventSeveritySuccess},
+
+	models.EventTypeLogAlertTriggered: {"Log alert triggered: %s", "Log alert rule '%s' matched a container log line", models.EventSeverityWarning},
+
+	models.EventTypeHostThresholdExceeded:  {"Host threshold exceeded: %s", "Host resource usage on '%s' is above the configured threshold", models.EventSeverityWarning},
+	models.EventTypeHostThresholdRecovered: {"Host threshold recovered: %s", "Host resource usage on '%s' is back below the configured threshold", models.EventSeveritySuccess},
+
+	models.EventTypeEnvironmentOffline: {"Environment offline: %s", "Environment '%s' stopped sending heartbeats", models.EventSeverityError},
+	models.EventTypeEnvironmentOnline:  {"Environment online: %s", "Environment '%s' is sending heartbeats again", models.EventSeveritySuccess},
+
+	models.EventTypeNotificationCredentialInvalid: {"Notification credentials failing: %s", "Stored credentials for notification provider '%s' no longer work", models.EventSeverityError},
+
+	models.EventTypeContainerCrashLoop: {"Container crash loop: %s", "Container '%s' keeps exiting with a non-zero code", models.EventSeverityError},
+
+	models.EventTypeContainerUnmanagedChange: {"Unmanaged change detected: %s", "Container '%s' was changed outside Arcane", models.EventSeverityWarning},
+
+	models.EventTypeContainerTaskFailed: {"Container task failed: %s", "Scheduled task '%s' did not complete successfully", models.EventSeverityError},
+
+	models.EventTypeContainerSnapshot:        {"Container snapshot created: %s", "Container '%s' has been committed to a snapshot image", models.EventSeveritySuccess},
+	models.EventTypeContainerSnapshotRestore: {"Container restored from snapshot: %s", "Container '%s' has been recreated from a snapshot", models.EventSeverityWarning},
+	models.EventTypeContainerRollback:        {"Container rolled back: %s", "Container '%s' has been recreated from the image it used before its last update", models.EventSeverityWarning},
+
+	models.EventTypeRolloutCompleted: {"Rollout completed: %s", "Staged rollout '%s' updated every stage", models.EventSeveritySuccess},
+	models.EventTypeRolloutHalted:    {"Rollout halted: %s", "Staged rollout '%s' stopped before the last stage", models.EventSeverityError},
+
+	models.EventTypeCommandWebhookTriggered: {"Webhook command completed: %s", "Command sent to webhook '%s' completed", models.EventSeveritySuccess},
+	models.EventTypeCommandWebhookFailed:    {"Webhook command failed: %s", "Command sent to webhook '%s' failed", models.EventSeverityError},
+
+	models.EventTypeNotificationActionCompleted: {"Notification action completed: %s", "Action link for image '%s' completed", models.EventSeveritySuccess},
+	models.EventTypeNotificationActionFailed:    {"Notification action failed: %s", "Action link for image '%s' failed", models.EventSeverityError},
 }
 
 func (s *EventService) toEventDto(e *models.Event) *event.Event {
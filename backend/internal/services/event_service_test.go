@@ -2,10 +2,13 @@ package services
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -280,3 +283,168 @@ func TestEventService_CreateEvent_NormalizesActor(t *testing.T) {
 		require.Equal(t, "kmendell", *evt.Username)
 	})
 }
+
+func TestEventService_ExportEvents(t *testing.T) {
+	db := setupEventServiceTestDB(t)
+	svc := NewEventService(db, &config.Config{}, nil)
+	ctx := context.Background()
+
+	base := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	for i, title := range []string{"first", "second", "third"} {
+		require.NoError(t, db.Create(&models.Event{
+			Type:      models.EventTypeUserLogin,
+			Severity:  models.EventSeverityInfo,
+			Title:     title,
+			Timestamp: base.Add(time.Duration(i) * time.Hour),
+		}).Error)
+	}
+
+	from := base.Add(30 * time.Minute)
+	export, err := svc.ExportEvents(ctx, event.ExportFormatJSONL, &from, nil, "")
+	require.NoError(t, err)
+	require.Equal(t, 2, export.Manifest.Count)
+	lines := strings.Split(strings.TrimSpace(string(export.Data)), "\n")
+	require.Len(t, lines, 2)
+	require.Contains(t, lines[0], `"title":"second"`)
+
+	digest := sha256.Sum256(export.Data)
+	require.Equal(t, hex.EncodeToString(digest[:]), export.Manifest.SHA256)
+
+	csvExport, err := svc.ExportEvents(ctx, event.ExportFormatCSV, nil, nil, "")
+	require.NoError(t, err)
+	require.Equal(t, 3, csvExport.Manifest.Count)
+	require.True(t, strings.HasPrefix(string(csvExport.Data), "id,timestamp,type,severity,title"))
+
+	_, err = svc.ExportEvents(ctx, "xml", nil, nil, "")
+	require.Error(t, err)
+}
+
+func TestEventService_HasContainerChangeEventSince(t *testing.T) {
+	db := setupEventServiceTestDB(t)
+	svc := NewEventService(db, &config.Config{}, nil)
+	ctx := context.Background()
+
+	base := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	logEvent := func(eventType models.EventType, resourceType, resourceID, resourceName string, at time.Time) {
+		require.NoError(t, db.Create(&models.Event{
+			Type:         eventType,
+			Severity:     models.EventSeverityInfo,
+			Title:        string(eventType),
+			ResourceType: new(resourceType),
+			ResourceID:   new(resourceID),
+			ResourceName: new(resourceName),
+			Timestamp:    at,
+		}).Error)
+	}
+
+	logEvent(models.EventTypeContainerCreate, "container", "abc123", "web", base)
+	logEvent(models.EventTypeContainerStart, "container", "def456", "db", base)
+	logEvent(models.EventTypeProjectDeploy, "project", "p1", "Media", base)
+
+	found, err := svc.HasContainerChangeEventSince(ctx, base.Add(-time.Minute), "abc123", "other", "")
+	require.NoError(t, err)
+	require.True(t, found, "matches on container ID")
+
+	found, err = svc.HasContainerChangeEventSince(ctx, base.Add(-time.Minute), "", "web", "")
+	require.NoError(t, err)
+	require.True(t, found, "matches on container name")
+
+	found, err = svc.HasContainerChangeEventSince(ctx, base.Add(time.Minute), "abc123", "web", "")
+	require.NoError(t, err)
+	require.False(t, found, "events before the window are ignored")
+
+	found, err = svc.HasContainerChangeEventSince(ctx, base.Add(-time.Minute), "def456", "db", "")
+	require.NoError(t, err)
+	require.False(t, found, "a start does not change the container")
+
+	found, err = svc.HasContainerChangeEventSince(ctx, base.Add(-time.Minute), "def456", "media-app-1", "media")
+	require.NoError(t, err)
+	require.True(t, found, "matches on compose project")
+
+	logEvent(models.EventTypeSystemPrune, "system", "", "", base.Add(time.Hour))
+	found, err = svc.HasContainerChangeEventSince(ctx, base.Add(30*time.Minute), "def456", "db", "")
+	require.NoError(t, err)
+	require.True(t, found, "host-wide actions match every container")
+}
+
+func TestEventService_Subscribe(t *testing.T) {
+	ctx := context.Background()
+	db := setupEventServiceTestDB(t)
+	svc := NewEventService(db, nil, nil)
+
+	envID := "env-1"
+	events, unsubscribe := svc.Subscribe(EventSubscriptionFilter{
+		Types:         []string{"container.*"},
+		Severities:    []string{string(models.EventSeverityError)},
+		EnvironmentID: envID,
+	})
+
+	_, err := svc.CreateEvent(ctx, CreateEventRequest{
+		Type:          models.EventTypeContainerStart,
+		Severity:      models.EventSeverityInfo,
+		Title:         "wrong severity",
+		EnvironmentID: &envID,
+	})
+	require.NoError(t, err)
+	_, err = svc.CreateEvent(ctx, CreateEventRequest{
+		Type:     models.EventTypeContainerCrashLoop,
+		Severity: models.EventSeverityError,
+		Title:    "wrong environment",
+	})
+	require.NoError(t, err)
+	_, err = svc.CreateEvent(ctx, CreateEventRequest{
+		Type:          models.EventTypeProjectDeploy,
+		Severity:      models.EventSeverityError,
+		Title:         "wrong type",
+		EnvironmentID: &envID,
+	})
+	require.NoError(t, err)
+	created, err := svc.CreateEvent(ctx, CreateEventRequest{
+		Type:          models.EventTypeContainerCrashLoop,
+		Severity:      models.EventSeverityError,
+		Title:         "match",
+		EnvironmentID: &envID,
+	})
+	require.NoError(t, err)
+
+	select {
+	case evt := <-events:
+		require.Equal(t, created.ID, evt.ID)
+		require.Equal(t, "match", evt.Title)
+	default:
+		t.Fatal("expected matching event to be delivered")
+	}
+	select {
+	case evt := <-events:
+		t.Fatalf("unexpected event delivered: %s", evt.Title)
+	default:
+	}
+
+	unsubscribe()
+	unsubscribe()
+	_, ok := <-events
+	require.False(t, ok)
+
+	_, err = svc.CreateEvent(ctx, CreateEventRequest{
+		Type:          models.EventTypeContainerCrashLoop,
+		Severity:      models.EventSeverityError,
+		Title:         "after unsubscribe",
+		EnvironmentID: &envID,
+	})
+	require.NoError(t, err)
+}
+
+func TestEventService_SubscribeDropsWhenSubscriberIsFull(t *testing.T) {
+	ctx := context.Background()
+	db := setupEventServiceTestDB(t)
+	svc := NewEventService(db, nil, nil)
+
+	events, unsubscribe := svc.Subscribe(EventSubscriptionFilter{})
+	defer unsubscribe()
+
+	for range eventSubscriptionBuffer + 5 {
+		_, err := svc.CreateEvent(ctx, CreateEventRequest{Type: models.EventTypeUserLogin, Title: "login"})
+		require.NoError(t, err)
+	}
+	require.Len(t, events, eventSubscriptionBuffer)
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"net/http"
 	"path/filepath"
 	"strings"
 	"time"
@@ -14,25 +15,30 @@ import (
 	bootstraputils "github.com/getarcaneapp/arcane/backend/internal/utils"
 	"github.com/getarcaneapp/arcane/backend/internal/utils/mapper"
 	"github.com/getarcaneapp/arcane/backend/internal/utils/pagination"
+	"github.com/getarcaneapp/arcane/backend/pkg/projects"
 	"github.com/getarcaneapp/arcane/types/gitops"
 	"gorm.io/gorm"
 )
 
 type GitOpsSyncService struct {
-	db             *database.DB
-	repoService    *GitRepositoryService
-	projectService *ProjectService
-	eventService   *EventService
+	db                 *database.DB
+	repoService        *GitRepositoryService
+	projectService     *ProjectService
+	imageUpdateService *ImageUpdateService
+	eventService       *EventService
+	httpClient         *http.Client
 }
 
 const defaultGitSyncTimeout = 5 * time.Minute
 
-func NewGitOpsSyncService(db *database.DB, repoService *GitRepositoryService, projectService *ProjectService, eventService *EventService) *GitOpsSyncService {
+func NewGitOpsSyncService(db *database.DB, repoService *GitRepositoryService, projectService *ProjectService, imageUpdateService *ImageUpdateService, eventService *EventService) *GitOpsSyncService {
 	return &GitOpsSyncService{
-		db:             db,
-		repoService:    repoService,
-		projectService: projectService,
-		eventService:   eventService,
+		db:                 db,
+		repoService:        repoService,
+		projectService:     projectService,
+		imageUpdateService: imageUpdateService,
+		eventService:       eventService,
+		httpClient:         &http.Client{Timeout: 30 * time.Second},
 	}
 }
 
@@ -183,6 +189,12 @@ func (s *GitOpsSyncService) CreateSync(ctx context.Context, environmentID string
 	if req.SyncInterval != nil {
 		sync.SyncInterval = *req.SyncInterval
 	}
+	if req.TagBumpMode != nil {
+		if err := validateTagBumpModeInternal(*req.TagBumpMode); err != nil {
+			return nil, err
+		}
+		sync.TagBumpMode = *req.TagBumpMode
+	}
 
 	if err := s.db.WithContext(ctx).Create(&sync).Error; err != nil {
 		slog.ErrorContext(ctx, "Failed to create GitOps sync in database", "name", req.Name, "repositoryID", req.RepositoryID, "environmentID", environmentID, "error", err)
@@ -246,6 +258,12 @@ func (s *GitOpsSyncService) UpdateSync(ctx context.Context, environmentID, id st
 	if req.SyncInterval != nil {
 		updates["sync_interval"] = *req.SyncInterval
 	}
+	if req.TagBumpMode != nil {
+		if err := validateTagBumpModeInternal(*req.TagBumpMode); err != nil {
+			return nil, err
+		}
+		updates["tag_bump_mode"] = *req.TagBumpMode
+	}
 
 	if len(updates) > 0 {
 		if err := s.db.WithContext(ctx).Model(sync).Updates(updates).Error; err != nil {
@@ -385,6 +403,14 @@ func (s *GitOpsSyncService) PerformSync(ctx context.Context, environmentID, id s
 		return result, err
 	}
 
+	if findings, lintErr := s.projectService.LintProject(syncCtx, project.ID); lintErr != nil {
+		slog.WarnContext(syncCtx, "Failed to lint synced project", "projectId", project.ID, "error", lintErr)
+	} else {
+		for _, f := range findings {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("%s: %s (%s)", f.Service, f.Message, f.Rule))
+		}
+	}
+
 	// Update sync status
 	s.updateSyncStatus(syncCtx, id, "success", "", commitHash)
 
@@ -593,10 +619,20 @@ func (s *GitOpsSyncService) failSync(ctx context.Context, id string, result *git
 	return fmt.Errorf("%s", errMsg)
 }
 
+// syncProjectSaveFailureMessageInternal names lint failures in the sync
+// result so they are not mistaken for file system errors.
+func syncProjectSaveFailureMessageInternal(err error, fallback string) string {
+	var lintErr *projects.LintError
+	if errors.As(err, &lintErr) {
+		return "Compose file breaks lint rules"
+	}
+	return fallback
+}
+
 func (s *GitOpsSyncService) createProjectForSyncInternal(ctx context.Context, sync *models.GitOpsSync, id string, composeContent string, envContent *string, result *gitops.SyncResult, actor models.User) (*models.Project, error) {
 	project, err := s.projectService.CreateProject(ctx, sync.ProjectName, composeContent, envContent, actor)
 	if err != nil {
-		return nil, s.failSync(ctx, id, result, sync, actor, "Failed to create project", err.Error())
+		return nil, s.failSync(ctx, id, result, sync, actor, syncProjectSaveFailureMessageInternal(err, "Failed to create project"), err.Error())
 	}
 
 	// Update sync with project ID
@@ -657,9 +693,9 @@ func (s *GitOpsSyncService) updateProjectForSyncInternal(ctx context.Context, sy
 	}
 
 	// Update existing project's compose and env files
-	_, err := s.projectService.UpdateProject(ctx, project.ID, nil, &composeContent, envContent, actor)
+	_, err := s.projectService.UpdateProject(withGitOpsSyncInternal(ctx), project.ID, nil, &composeContent, envContent, actor)
 	if err != nil {
-		return s.failSync(ctx, id, result, sync, actor, "Failed to update project files", err.Error())
+		return s.failSync(ctx, id, result, sync, actor, syncProjectSaveFailureMessageInternal(err, "Failed to update project files"), err.Error())
 	}
 	slog.InfoContext(ctx, "Updated project files", "projectName", project.Name, "projectId", project.ID)
 
@@ -0,0 +1,319 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/getarcaneapp/arcane/backend/internal/common"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/git"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/registry"
+	"github.com/getarcaneapp/arcane/backend/pkg/projects"
+	"github.com/getarcaneapp/arcane/types/gitops"
+	"github.com/goccy/go-yaml/ast"
+)
+
+const (
+	tagBumpAuthorName   = "Arcane"
+	tagBumpAuthorEmail  = "arcane@localhost"
+	tagBumpBranchPrefix = "arcane/tag-bump-"
+)
+
+// ProposeTagBumps looks for newer tags of the images in the sync's compose
+// file and, depending on the sync's tag bump mode, commits them to the sync
+// branch or pushes them to a new branch and opens a pull request. The compose
+// file is edited in place, so comments and anchors are kept.
+func (s *GitOpsSyncService) ProposeTagBumps(ctx context.Context, environmentID, id string, actor models.User) (*gitops.TagBumpResult, error) {
+	bumpCtx, cancel := context.WithTimeout(ctx, defaultGitSyncTimeout)
+	defer cancel()
+
+	sync, err := s.GetSyncByID(bumpCtx, environmentID, id)
+	if err != nil {
+		return nil, err
+	}
+	if sync.TagBumpMode == models.GitOpsTagBumpOff {
+		return nil, &models.ValidationError{Message: "tag bumps are disabled for this sync", Field: "tagBumpMode"}
+	}
+
+	result, err := s.proposeTagBumpsInternal(bumpCtx, sync)
+	if err != nil {
+		result = &gitops.TagBumpResult{Status: gitops.TagBumpStatusFailed, Message: err.Error(), Bumps: result.Bumps}
+	}
+	s.recordTagBumpInternal(bumpCtx, sync, result, actor)
+	return result, nil
+}
+
+// ProposeAllTagBumps runs ProposeTagBumps for every sync with tag bumps
+// enabled. It is called after the update checker polls for new images.
+func (s *GitOpsSyncService) ProposeAllTagBumps(ctx context.Context) error {
+	var syncs []models.GitOpsSync
+	if err := s.db.WithContext(ctx).Where("tag_bump_mode <> ?", models.GitOpsTagBumpOff).Find(&syncs).Error; err != nil {
+		return fmt.Errorf("failed to get syncs with tag bumps enabled: %w", err)
+	}
+
+	for _, sync := range syncs {
+		result, err := s.ProposeTagBumps(ctx, sync.EnvironmentID, sync.ID, systemUser)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to check GitOps sync for tag bumps", "syncId", sync.ID, "error", err)
+			continue
+		}
+		slog.InfoContext(ctx, "GitOps tag bump check completed", "syncId", sync.ID, "status", result.Status, "bumps", len(result.Bumps))
+	}
+	return nil
+}
+
+// proposeTagBumpsInternal does the work of ProposeTagBumps. The returned
+// result is never nil so the bumps that were found can be reported even when
+// pushing them fails.
+func (s *GitOpsSyncService) proposeTagBumpsInternal(ctx context.Context, sync *models.GitOpsSync) (*gitops.TagBumpResult, error) {
+	result := &gitops.TagBumpResult{Bumps: []gitops.ImageTagBump{}}
+
+	repository := sync.Repository
+	if repository == nil {
+		return result, errors.New("repository not found")
+	}
+	authConfig, err := s.repoService.GetAuthConfig(ctx, repository)
+	if err != nil {
+		return result, fmt.Errorf("failed to get authentication config: %w", err)
+	}
+
+	gitClient := s.repoService.gitClient
+	repoPath, err := gitClient.Clone(ctx, repository.URL, sync.Branch, authConfig)
+	if err != nil {
+		return result, err
+	}
+	defer func() {
+		if cleanupErr := gitClient.Cleanup(repoPath); cleanupErr != nil {
+			slog.WarnContext(ctx, "Failed to cleanup repository", "path", repoPath, "error", cleanupErr)
+		}
+	}()
+
+	content, err := gitClient.ReadFile(ctx, repoPath, sync.ComposePath)
+	if err != nil {
+		return result, err
+	}
+	editor, err := projects.NewComposeEditor([]byte(content))
+	if err != nil {
+		return result, err
+	}
+
+	result.Bumps = s.applyTagBumpsInternal(ctx, editor)
+	if len(result.Bumps) == 0 {
+		result.Status = gitops.TagBumpStatusUpToDate
+		result.Message = "All image tags are up to date"
+		return result, nil
+	}
+
+	title := tagBumpTitleInternal(sync, result.Bumps)
+	commit := git.CommitOptions{
+		Files:       []string{sync.ComposePath},
+		Message:     title + "\n\n" + tagBumpChangelogInternal(sync, result.Bumps),
+		AuthorName:  tagBumpAuthorName,
+		AuthorEmail: tagBumpAuthorEmail,
+	}
+	if sync.TagBumpMode == models.GitOpsTagBumpPullRequest {
+		commit.Branch = tagBumpBranchInternal(sync, result.Bumps)
+		result.Branch = commit.Branch
+
+		branches, err := gitClient.ListBranches(ctx, repository.URL, authConfig)
+		if err != nil {
+			return result, err
+		}
+		if slices.ContainsFunc(branches, func(b git.BranchInfo) bool { return b.Name == commit.Branch }) {
+			result.Status = gitops.TagBumpStatusAlreadyProposed
+			result.Message = fmt.Sprintf("These tag bumps are already proposed on branch %s", commit.Branch)
+			return result, nil
+		}
+	}
+
+	if err := os.WriteFile(filepath.Join(repoPath, sync.ComposePath), editor.Bytes(), common.FilePerm); err != nil {
+		return result, fmt.Errorf("failed to write compose file: %w", err)
+	}
+	result.Commit, err = gitClient.CommitAndPush(ctx, repoPath, commit, authConfig)
+	if err != nil {
+		return result, err
+	}
+
+	if sync.TagBumpMode != models.GitOpsTagBumpPullRequest {
+		result.Status = gitops.TagBumpStatusCommitted
+		result.Branch = sync.Branch
+		result.Message = fmt.Sprintf("Committed %d tag bump(s) to %s", len(result.Bumps), sync.Branch)
+		return result, nil
+	}
+
+	result.Status = gitops.TagBumpStatusProposed
+	result.PullRequestURL, err = git.OpenPullRequest(ctx, s.httpClient, repository.URL, authConfig, git.PullRequest{
+		Head:  commit.Branch,
+		Base:  sync.Branch,
+		Title: title,
+		Body:  tagBumpChangelogInternal(sync, result.Bumps),
+	})
+	switch {
+	case errors.Is(err, git.ErrPullRequestUnsupported):
+		result.Message = fmt.Sprintf("Pushed %d tag bump(s) to branch %s; open a pull request for it manually", len(result.Bumps), commit.Branch)
+	case err != nil:
+		return result, err
+	default:
+		result.Message = fmt.Sprintf("Opened a pull request with %d tag bump(s)", len(result.Bumps))
+	}
+	return result, nil
+}
+
+// applyTagBumpsInternal edits the image of every service that has a newer tag
+// with the same shape in its registry and returns the changes. Images given
+// by variable or digest are left alone, as are images whose tags cannot be
+// listed.
+func (s *GitOpsSyncService) applyTagBumpsInternal(ctx context.Context, editor *projects.ComposeEditor) []gitops.ImageTagBump {
+	bumps := []gitops.ImageTagBump{}
+	services, ok := editor.Lookup("services").(*ast.MappingNode)
+	if !ok || s.imageUpdateService == nil {
+		return bumps
+	}
+
+	tagsByRepo := map[string][]string{}
+	for _, entry := range services.Values {
+		config, ok := entry.Value.(*ast.MappingNode)
+		if !ok {
+			continue
+		}
+		node, ok := projects.ComposeMappingValue(config, "image").(*ast.StringNode)
+		if !ok {
+			continue
+		}
+		repo, tag, ok := splitImageTagInternal(node.Value)
+		if !ok {
+			continue
+		}
+
+		tags, seen := tagsByRepo[repo]
+		if !seen {
+			var err error
+			tags, err = s.imageUpdateService.remoteTagsInternal(ctx, node.Value)
+			if err != nil {
+				slog.WarnContext(ctx, "Failed to list tags for tag bump", "image", node.Value, "error", err)
+			}
+			tagsByRepo[repo] = tags
+		}
+
+		newer := registry.NewerTags(tag, tags)
+		if len(newer) == 0 {
+			continue
+		}
+		newTag := newer[len(newer)-1]
+		if err := editor.SetScalar(node, repo+":"+newTag); err != nil {
+			slog.WarnContext(ctx, "Failed to edit image tag", "image", node.Value, "error", err)
+			continue
+		}
+		bumps = append(bumps, gitops.ImageTagBump{
+			Service:     entry.Key.GetToken().Value,
+			Image:       repo,
+			CurrentTag:  tag,
+			NewTag:      newTag,
+			SkippedTags: newer[:len(newer)-1],
+		})
+	}
+	return bumps
+}
+
+func validateTagBumpModeInternal(mode string) error {
+	switch mode {
+	case models.GitOpsTagBumpOff, models.GitOpsTagBumpCommit, models.GitOpsTagBumpPullRequest:
+		return nil
+	}
+	return &models.ValidationError{Message: fmt.Sprintf("unknown tag bump mode %q", mode), Field: "tagBumpMode"}
+}
+
+// splitImageTagInternal splits an image reference like "ghcr.io/acme/app:1.2"
+// into its repository and tag. It reports false for references without a tag,
+// with a digest or with variables.
+func splitImageTagInternal(image string) (repo, tag string, ok bool) {
+	if image == "" || strings.ContainsAny(image, "$@") {
+		return "", "", false
+	}
+	sep := strings.LastIndex(image, ":")
+	if sep <= strings.LastIndex(image, "/") {
+		return "", "", false
+	}
+	return image[:sep], image[sep+1:], true
+}
+
+func tagBumpTitleInternal(sync *models.GitOpsSync, bumps []gitops.ImageTagBump) string {
+	if len(bumps) == 1 {
+		return fmt.Sprintf("Bump %s to %s", bumps[0].Image, bumps[0].NewTag)
+	}
+	return fmt.Sprintf("Bump %d image tags in %s", len(bumps), sync.ComposePath)
+}
+
+// tagBumpChangelogInternal describes the bumps as Markdown, for the commit
+// message and the pull request body.
+func tagBumpChangelogInternal(sync *models.GitOpsSync, bumps []gitops.ImageTagBump) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Arcane found newer image tags for `%s` (GitOps sync %q).\n\n", sync.ComposePath, sync.Name)
+	b.WriteString("| Service | Image | From | To |\n|---|---|---|---|\n")
+	for _, bump := range bumps {
+		fmt.Fprintf(&b, "| %s | `%s` | `%s` | `%s` |\n", bump.Service, bump.Image, bump.CurrentTag, bump.NewTag)
+	}
+	b.WriteString("\n### Changelog\n\n")
+	for _, bump := range bumps {
+		versions := append(slices.Clone(bump.SkippedTags), bump.NewTag)
+		fmt.Fprintf(&b, "- `%s` %s → %s: new versions %s\n", bump.Image, bump.CurrentTag, bump.NewTag, strings.Join(versions, ", "))
+	}
+	return b.String()
+}
+
+// tagBumpBranchInternal names the pull request branch after the bumps it
+// carries, so the same bumps are never proposed twice while a newer tag gets
+// a new branch.
+func tagBumpBranchInternal(sync *models.GitOpsSync, bumps []gitops.ImageTagBump) string {
+	h := sha256.New()
+	h.Write([]byte(sync.ComposePath))
+	for _, bump := range bumps {
+		fmt.Fprintf(h, "\n%s=%s:%s", bump.Service, bump.Image, bump.NewTag)
+	}
+	return tagBumpBranchPrefix + hex.EncodeToString(h.Sum(nil))[:12]
+}
+
+func (s *GitOpsSyncService) recordTagBumpInternal(ctx context.Context, sync *models.GitOpsSync, result *gitops.TagBumpResult, actor models.User) {
+	updates := map[string]any{
+		"last_tag_bump_at":      time.Now(),
+		"last_tag_bump_status":  result.Status,
+		"last_tag_bump_message": result.Message,
+	}
+	if result.PullRequestURL != "" {
+		updates["last_tag_bump_url"] = result.PullRequestURL
+	}
+	if err := s.db.WithContext(ctx).Model(&models.GitOpsSync{}).Where("id = ?", sync.ID).Updates(updates).Error; err != nil {
+		slog.ErrorContext(ctx, "Failed to update tag bump status", "syncId", sync.ID, "error", err)
+	}
+
+	severity := models.EventSeveritySuccess
+	switch result.Status {
+	case gitops.TagBumpStatusUpToDate, gitops.TagBumpStatusAlreadyProposed:
+		// Nothing changed in the repository; the status on the sync is enough.
+		return
+	case gitops.TagBumpStatusFailed:
+		severity = models.EventSeverityError
+	}
+	_, _ = s.eventService.CreateEvent(ctx, CreateEventRequest{
+		Type:          models.EventTypeGitSyncTagBump,
+		Severity:      severity,
+		Title:         "Git sync tag bump",
+		Description:   fmt.Sprintf("%s: %s", sync.Name, result.Message),
+		ResourceType:  new("git_sync"),
+		ResourceID:    new(sync.ID),
+		ResourceName:  new(sync.Name),
+		UserID:        new(actor.ID),
+		Username:      new(actor.Username),
+		EnvironmentID: new(sync.EnvironmentID),
+		Metadata:      models.JSON{"bumps": result.Bumps, "pullRequestUrl": result.PullRequestURL, "commit": result.Commit},
+	})
+}
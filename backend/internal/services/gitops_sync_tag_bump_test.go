@@ -0,0 +1,54 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/pkg/projects"
+	"github.com/getarcaneapp/arcane/types/gitops"
+)
+
+func TestSplitImageTag(t *testing.T) {
+	tests := []struct {
+		image string
+		repo  string
+		tag   string
+		ok    bool
+	}{
+		{"nginx:1.25", "nginx", "1.25", true},
+		{"registry.lan:5000/acme/app:v2.1.0-alpine", "registry.lan:5000/acme/app", "v2.1.0-alpine", true},
+		{"registry.lan:5000/acme/app", "", "", false},
+		{"nginx", "", "", false},
+		{"nginx@sha256:abc", "", "", false},
+		{"nginx:${NGINX_TAG}", "", "", false},
+	}
+
+	for _, tt := range tests {
+		repo, tag, ok := splitImageTagInternal(tt.image)
+		assert.Equal(t, tt.ok, ok, tt.image)
+		assert.Equal(t, tt.repo, repo, tt.image)
+		assert.Equal(t, tt.tag, tag, tt.image)
+	}
+}
+
+func TestTagBumpBranch(t *testing.T) {
+	sync := &models.GitOpsSync{ComposePath: "stack/compose.yaml"}
+	bumps := []gitops.ImageTagBump{{Service: "web", Image: "nginx", CurrentTag: "1.25", NewTag: "1.27"}}
+
+	branch := tagBumpBranchInternal(sync, bumps)
+	assert.Equal(t, branch, tagBumpBranchInternal(sync, bumps), "the same bumps reuse the branch")
+	assert.NotEqual(t, branch, tagBumpBranchInternal(sync, []gitops.ImageTagBump{{Service: "web", Image: "nginx", CurrentTag: "1.25", NewTag: "1.28"}}))
+	assert.Len(t, branch, len(tagBumpBranchPrefix)+12)
+}
+
+func TestApplyTagBumpsWithoutRegistry(t *testing.T) {
+	editor, err := projects.NewComposeEditor([]byte("services:\n  web:\n    image: nginx:1.25 # pinned\n"))
+	require.NoError(t, err)
+
+	svc := &GitOpsSyncService{}
+	assert.Empty(t, svc.applyTagBumpsInternal(t.Context(), editor))
+	assert.Equal(t, "services:\n  web:\n    image: nginx:1.25 # pinned\n", string(editor.Bytes()))
+}
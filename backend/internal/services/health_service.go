@@ -0,0 +1,177 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/getarcaneapp/arcane/backend/internal/database"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/fs"
+	"github.com/getarcaneapp/arcane/types/system"
+	"github.com/moby/moby/client"
+)
+
+const (
+	healthStatusUp   = "UP"
+	healthStatusDown = "DOWN"
+
+	healthCheckTimeout = 5 * time.Second
+)
+
+// HealthService runs the dependency checks behind the liveness and readiness probes.
+type HealthService struct {
+	db                 *database.DB
+	dockerService      *DockerClientService
+	settingsService    *SettingsService
+	environmentService *EnvironmentService
+}
+
+func NewHealthService(db *database.DB, dockerService *DockerClientService, settingsService *SettingsService, environmentService *EnvironmentService) *HealthService {
+	return &HealthService{
+		db:                 db,
+		dockerService:      dockerService,
+		settingsService:    settingsService,
+		environmentService: environmentService,
+	}
+}
+
+// Readiness checks the database, the Docker socket and write access to the
+// projects directory. Remote environments are left to CheckEnvironments, so an
+// offline agent never takes the manager out of a load balancer.
+func (s *HealthService) Readiness(ctx context.Context) system.ReadinessResponse {
+	checks := []struct {
+		name string
+		fn   func(context.Context) error
+	}{
+		{"database", s.checkDatabase},
+		{"docker", s.checkDocker},
+		{"projectsDirectory", s.checkProjectsDirectory},
+	}
+
+	components := make([]system.ComponentHealth, len(checks))
+	var wg sync.WaitGroup
+	for i, check := range checks {
+		wg.Go(func() {
+			components[i] = runHealthCheck(ctx, check.name, false, check.fn)
+		})
+	}
+	wg.Wait()
+
+	status := healthStatusUp
+	for _, c := range components {
+		if c.Status != healthStatusUp && !c.Optional {
+			status = healthStatusDown
+			break
+		}
+	}
+
+	return system.ReadinessResponse{
+		Status:     status,
+		Components: components,
+	}
+}
+
+func runHealthCheck(ctx context.Context, name string, optional bool, fn func(context.Context) error) system.ComponentHealth {
+	checkCtx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	start := time.Now()
+	err := fn(checkCtx)
+	result := system.ComponentHealth{
+		Name:      name,
+		Status:    healthStatusUp,
+		LatencyMs: time.Since(start).Milliseconds(),
+		Optional:  optional,
+	}
+	if err != nil {
+		result.Status = healthStatusDown
+		result.Error = err.Error()
+	}
+	return result
+}
+
+func (s *HealthService) checkDatabase(ctx context.Context) error {
+	if s.db == nil {
+		return fmt.Errorf("database not configured")
+	}
+	sqlDB, err := s.db.DB.DB()
+	if err != nil {
+		return fmt.Errorf("failed to get database handle: %w", err)
+	}
+	if err := sqlDB.PingContext(ctx); err != nil {
+		return fmt.Errorf("database ping failed: %w", err)
+	}
+	return nil
+}
+
+func (s *HealthService) checkDocker(ctx context.Context) error {
+	if s.dockerService == nil {
+		return fmt.Errorf("docker service not configured")
+	}
+	dockerClient, err := s.dockerService.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := dockerClient.Ping(ctx, client.PingOptions{}); err != nil {
+		return fmt.Errorf("docker ping failed: %w", err)
+	}
+	return nil
+}
+
+func (s *HealthService) checkProjectsDirectory(ctx context.Context) error {
+	if s.settingsService == nil {
+		return fmt.Errorf("settings service not configured")
+	}
+	projectsDirSetting := s.settingsService.GetStringSetting(ctx, "projectsDirectory", "/app/data/projects")
+	projectsDirectory, err := fs.GetProjectsDirectory(ctx, strings.TrimSpace(projectsDirSetting))
+	if err != nil {
+		return fmt.Errorf("failed to resolve projects directory: %w", err)
+	}
+
+	probe, err := os.CreateTemp(projectsDirectory, ".arcane-health-*")
+	if err != nil {
+		return fmt.Errorf("projects directory is not writable: %w", err)
+	}
+	name := probe.Name()
+	_ = probe.Close()
+	if err := os.Remove(name); err != nil {
+		return fmt.Errorf("failed to remove health probe file: %w", err)
+	}
+	return nil
+}
+
+// CheckEnvironments tests the connection to every enabled remote environment.
+// The results are optional components, one per environment.
+func (s *HealthService) CheckEnvironments(ctx context.Context) []system.ComponentHealth {
+	if s.environmentService == nil {
+		return nil
+	}
+
+	envs, err := s.environmentService.ListRemoteEnvironments(ctx)
+	if err != nil {
+		return []system.ComponentHealth{{
+			Name:     "environments",
+			Status:   healthStatusDown,
+			Error:    err.Error(),
+			Optional: true,
+		}}
+	}
+
+	components := make([]system.ComponentHealth, len(envs))
+	var wg sync.WaitGroup
+	for i := range envs {
+		envID := envs[i].ID
+		wg.Go(func() {
+			components[i] = runHealthCheck(ctx, "environment:"+envID, true, func(checkCtx context.Context) error {
+				_, err := s.environmentService.TestConnection(checkCtx, envID, nil)
+				return err
+			})
+		})
+	}
+	wg.Wait()
+
+	return components
+}
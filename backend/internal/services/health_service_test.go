@@ -0,0 +1,34 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	glsqlite "github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/getarcaneapp/arcane/backend/internal/database"
+)
+
+func TestHealthService_Readiness_ReportsComponents(t *testing.T) {
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared", strings.NewReplacer("/", "_", " ", "_").Replace(t.Name()))
+	db, err := gorm.Open(glsqlite.Open(dsn), &gorm.Config{})
+	require.NoError(t, err)
+
+	svc := NewHealthService(&database.DB{DB: db}, nil, nil, nil)
+	readiness := svc.Readiness(context.Background())
+
+	require.Equal(t, healthStatusDown, readiness.Status)
+	require.Len(t, readiness.Components, 3)
+
+	byName := map[string]string{}
+	for _, c := range readiness.Components {
+		byName[c.Name] = c.Status
+	}
+	require.Equal(t, healthStatusUp, byName["database"])
+	require.Equal(t, healthStatusDown, byName["docker"])
+	require.Equal(t, healthStatusDown, byName["projectsDirectory"])
+}
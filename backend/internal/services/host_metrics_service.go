@@ -0,0 +1,409 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/getarcaneapp/arcane/backend/internal/database"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/types/base"
+	"github.com/getarcaneapp/arcane/types/system"
+	"github.com/shirou/gopsutil/v4/cpu"
+	"github.com/shirou/gopsutil/v4/disk"
+	"github.com/shirou/gopsutil/v4/load"
+	"github.com/shirou/gopsutil/v4/mem"
+)
+
+const (
+	hostMetricCPU    = "cpu"
+	hostMetricMemory = "memory"
+	hostMetricDisk   = "disk"
+
+	defaultHostMetricsRetentionHours = 168
+	maxHostMetricsHistoryHours       = 24 * 31
+
+	// hostMetricsCPUSampleInterval is how long CPU usage is measured for. A
+	// zero interval would report the usage since the previous call instead.
+	hostMetricsCPUSampleInterval = time.Second
+
+	// hostMetricsRemotePath is the agent endpoint that returns a live sample.
+	hostMetricsRemotePath = "/api/environments/0/system/host-metrics"
+)
+
+// HostMetricsService samples CPU, memory, disk and load on the local host,
+// collects the same samples from remote environments, keeps a history per
+// environment and raises events when usage crosses the configured thresholds.
+type HostMetricsService struct {
+	db                  *database.DB
+	settingsService     *SettingsService
+	environmentService  *EnvironmentService
+	systemService       *SystemService
+	eventService        *EventService
+	notificationService *NotificationService
+
+	alertMu sync.Mutex
+	// alerting tracks "<envID>:<metric>" keys that are currently above threshold
+	// so each crossing only notifies once.
+	alerting map[string]bool
+}
+
+func NewHostMetricsService(db *database.DB, settingsService *SettingsService, environmentService *EnvironmentService, systemService *SystemService, eventService *EventService, notificationService *NotificationService) *HostMetricsService {
+	return &HostMetricsService{
+		db:                  db,
+		settingsService:     settingsService,
+		environmentService:  environmentService,
+		systemService:       systemService,
+		eventService:        eventService,
+		notificationService: notificationService,
+		alerting:            make(map[string]bool),
+	}
+}
+
+// CollectLocal takes a live sample of this host's resource usage. It blocks
+// for hostMetricsCPUSampleInterval while measuring CPU usage.
+func (s *HostMetricsService) CollectLocal(ctx context.Context) (*system.HostMetrics, error) {
+	sample := &system.HostMetrics{
+		EnvironmentID: "0",
+		CPUCount:      runtime.NumCPU(),
+		CollectedAt:   time.Now(),
+	}
+
+	if vals, err := cpu.PercentWithContext(ctx, hostMetricsCPUSampleInterval, false); err == nil && len(vals) > 0 {
+		sample.CPUPercent = vals[0]
+	}
+	if count, err := cpu.CountsWithContext(ctx, true); err == nil && count > 0 {
+		sample.CPUCount = count
+	}
+
+	memInfo, err := mem.VirtualMemoryWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read memory usage: %w", err)
+	}
+	sample.MemoryUsed = memInfo.Used
+	sample.MemoryTotal = memInfo.Total
+
+	diskPath := "/"
+	if s.systemService != nil {
+		diskPath = s.systemService.GetDiskUsagePath(ctx)
+	}
+	diskInfo, err := disk.UsageWithContext(ctx, diskPath)
+	if (err != nil || diskInfo == nil || diskInfo.Total == 0) && diskPath != "/" {
+		diskInfo, err = disk.UsageWithContext(ctx, "/")
+	}
+	if err == nil && diskInfo != nil {
+		sample.DiskUsed = diskInfo.Used
+		sample.DiskTotal = diskInfo.Total
+	}
+
+	// Load averages are not available on every platform.
+	if avg, err := load.AvgWithContext(ctx); err == nil && avg != nil {
+		sample.Load1 = avg.Load1
+		sample.Load5 = avg.Load5
+		sample.Load15 = avg.Load15
+	}
+
+	fillHostMetricPercentages(sample)
+	return sample, nil
+}
+
+// CollectAll samples the local host and every enabled remote environment,
+// stores the samples, evaluates thresholds and prunes expired history.
+func (s *HostMetricsService) CollectAll(ctx context.Context) error {
+	samples := make([]*system.HostMetrics, 0, 1)
+
+	local, err := s.CollectLocal(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to collect local host metrics", "error", err)
+	} else {
+		samples = append(samples, local)
+	}
+
+	if s.environmentService != nil {
+		envs, err := s.environmentService.ListRemoteEnvironments(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list environments: %w", err)
+		}
+
+		remote := make([]*system.HostMetrics, len(envs))
+		var wg sync.WaitGroup
+		for i := range envs {
+			envID := envs[i].ID
+			wg.Go(func() {
+				sample, err := s.collectRemoteInternal(ctx, envID)
+				if err != nil {
+					slog.DebugContext(ctx, "Failed to collect host metrics from environment", "environmentID", envID, "error", err)
+					return
+				}
+				remote[i] = sample
+			})
+		}
+		wg.Wait()
+
+		for _, sample := range remote {
+			if sample != nil {
+				samples = append(samples, sample)
+			}
+		}
+	}
+
+	if len(samples) == 0 {
+		return nil
+	}
+
+	rows := make([]models.HostMetricSample, 0, len(samples))
+	for _, sample := range samples {
+		rows = append(rows, toHostMetricSampleModel(sample))
+	}
+	if err := s.db.WithContext(ctx).Create(&rows).Error; err != nil {
+		return fmt.Errorf("failed to store host metrics: %w", err)
+	}
+
+	for _, sample := range samples {
+		s.evaluateThresholdsInternal(ctx, sample)
+	}
+
+	if err := s.pruneHistoryInternal(ctx); err != nil {
+		slog.WarnContext(ctx, "Failed to prune host metrics history", "error", err)
+	}
+
+	return nil
+}
+
+// GetHistory returns the stored samples of an environment collected within
+// the last hours, oldest first.
+func (s *HostMetricsService) GetHistory(ctx context.Context, environmentID string, hours int) ([]system.HostMetrics, error) {
+	if hours <= 0 {
+		hours = 24
+	}
+	hours = min(hours, maxHostMetricsHistoryHours)
+	since := time.Now().Add(-time.Duration(hours) * time.Hour)
+
+	var rows []models.HostMetricSample
+	if err := s.db.WithContext(ctx).
+		Where("environment_id = ? AND collected_at >= ?", environmentID, since).
+		Order("collected_at ASC").
+		Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to load host metrics history: %w", err)
+	}
+
+	out := make([]system.HostMetrics, 0, len(rows))
+	for i := range rows {
+		out = append(out, toHostMetricsDto(&rows[i]))
+	}
+	return out, nil
+}
+
+// ListLatest returns the most recent sample for every enabled environment.
+func (s *HostMetricsService) ListLatest(ctx context.Context) ([]system.EnvironmentHostMetrics, error) {
+	var envs []models.Environment
+	if err := s.db.WithContext(ctx).
+		Model(&models.Environment{}).
+		Where("enabled = ?", true).
+		Order("name ASC").
+		Find(&envs).Error; err != nil {
+		return nil, fmt.Errorf("failed to list environments: %w", err)
+	}
+
+	out := make([]system.EnvironmentHostMetrics, 0, len(envs))
+	for _, env := range envs {
+		entry := system.EnvironmentHostMetrics{
+			EnvironmentID:   env.ID,
+			EnvironmentName: env.Name,
+		}
+
+		var row models.HostMetricSample
+		err := s.db.WithContext(ctx).
+			Where("environment_id = ?", env.ID).
+			Order("collected_at DESC").
+			Limit(1).
+			Find(&row).Error
+		if err != nil {
+			return nil, fmt.Errorf("failed to load latest host metrics: %w", err)
+		}
+		if row.ID != "" {
+			latest := toHostMetricsDto(&row)
+			entry.Latest = &latest
+		}
+		out = append(out, entry)
+	}
+	return out, nil
+}
+
+func (s *HostMetricsService) collectRemoteInternal(ctx context.Context, envID string) (*system.HostMetrics, error) {
+	body, statusCode, err := s.environmentService.ProxyRequest(ctx, envID, http.MethodGet, hostMetricsRemotePath, nil)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != http.StatusOK {
+		return nil, fmt.Errorf("environment returned status %d", statusCode)
+	}
+
+	var resp base.ApiResponse[system.HostMetrics]
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to decode host metrics: %w", err)
+	}
+
+	sample := resp.Data
+	sample.EnvironmentID = envID
+	if sample.CollectedAt.IsZero() {
+		sample.CollectedAt = time.Now()
+	}
+	fillHostMetricPercentages(&sample)
+	return &sample, nil
+}
+
+func (s *HostMetricsService) evaluateThresholdsInternal(ctx context.Context, sample *system.HostMetrics) {
+	if s.settingsService == nil {
+		return
+	}
+
+	checks := []struct {
+		metric  string
+		label   string
+		key     string
+		percent float64
+	}{
+		{hostMetricCPU, "CPU", "hostMetricsCpuThreshold", sample.CPUPercent},
+		{hostMetricMemory, "Memory", "hostMetricsMemoryThreshold", sample.MemoryPercent},
+		{hostMetricDisk, "Disk", "hostMetricsDiskThreshold", sample.DiskPercent},
+	}
+
+	for _, check := range checks {
+		threshold := s.settingsService.GetIntSetting(ctx, check.key, 0)
+		key := sample.EnvironmentID + ":" + check.metric
+
+		s.alertMu.Lock()
+		wasAlerting := s.alerting[key]
+		isAlerting := threshold > 0 && check.percent >= float64(threshold)
+		if isAlerting {
+			s.alerting[key] = true
+		} else {
+			delete(s.alerting, key)
+		}
+		s.alertMu.Unlock()
+
+		switch {
+		case isAlerting && !wasAlerting:
+			s.notifyThresholdInternal(ctx, sample, models.EventTypeHostThresholdExceeded, check.metric,
+				fmt.Sprintf("%s usage above %d%%", check.label, threshold),
+				fmt.Sprintf("%s usage is %.1f%% (threshold %d%%)", check.label, check.percent, threshold))
+		case !isAlerting && wasAlerting:
+			s.notifyThresholdInternal(ctx, sample, models.EventTypeHostThresholdRecovered, check.metric,
+				fmt.Sprintf("%s usage back to normal", check.label),
+				fmt.Sprintf("%s usage is %.1f%%", check.label, check.percent))
+		}
+	}
+}
+
+func (s *HostMetricsService) notifyThresholdInternal(ctx context.Context, sample *system.HostMetrics, eventType models.EventType, metric, title, message string) {
+	envName := sample.EnvironmentID
+	if s.environmentService != nil {
+		if env, err := s.environmentService.GetEnvironmentByID(ctx, sample.EnvironmentID); err == nil && env != nil {
+			envName = env.Name
+		}
+	}
+	title = fmt.Sprintf("%s: %s", envName, title)
+
+	metadata := models.JSON{
+		"environmentId": sample.EnvironmentID,
+		"metric":        metric,
+		"cpuPercent":    sample.CPUPercent,
+		"memoryPercent": sample.MemoryPercent,
+		"diskPercent":   sample.DiskPercent,
+	}
+
+	if s.eventService != nil {
+		resourceType := "environment"
+		_, err := s.eventService.CreateEvent(ctx, CreateEventRequest{
+			Type:          eventType,
+			Severity:      s.eventService.getEventSeverity(eventType),
+			Title:         title,
+			Description:   message,
+			ResourceType:  &resourceType,
+			ResourceID:    &sample.EnvironmentID,
+			ResourceName:  &envName,
+			EnvironmentID: &sample.EnvironmentID,
+			Metadata:      metadata,
+		})
+		if err != nil {
+			slog.WarnContext(ctx, "Failed to record host threshold event", "environmentID", sample.EnvironmentID, "error", err)
+		}
+	}
+
+	// Only crossings above the threshold are worth a notification.
+	if eventType != models.EventTypeHostThresholdExceeded || s.notificationService == nil {
+		return
+	}
+	err := s.notificationService.SendAlertNotification(ctx, AlertNotification{
+		EventType: models.NotificationEventHostThreshold,
+		Subject:   envName,
+		Title:     title,
+		Message:   message,
+		Metadata:  metadata,
+	})
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to send host threshold notification", "environmentID", sample.EnvironmentID, "error", err)
+	}
+}
+
+func (s *HostMetricsService) pruneHistoryInternal(ctx context.Context) error {
+	hours := defaultHostMetricsRetentionHours
+	if s.settingsService != nil {
+		hours = s.settingsService.GetIntSetting(ctx, "hostMetricsRetentionHours", defaultHostMetricsRetentionHours)
+	}
+	if hours <= 0 {
+		hours = defaultHostMetricsRetentionHours
+	}
+
+	cutoff := time.Now().Add(-time.Duration(hours) * time.Hour)
+	return s.db.WithContext(ctx).Where("collected_at < ?", cutoff).Delete(&models.HostMetricSample{}).Error
+}
+
+func fillHostMetricPercentages(sample *system.HostMetrics) {
+	if sample.MemoryTotal > 0 {
+		sample.MemoryPercent = float64(sample.MemoryUsed) / float64(sample.MemoryTotal) * 100
+	}
+	if sample.DiskTotal > 0 {
+		sample.DiskPercent = float64(sample.DiskUsed) / float64(sample.DiskTotal) * 100
+	}
+}
+
+func toHostMetricSampleModel(sample *system.HostMetrics) models.HostMetricSample {
+	return models.HostMetricSample{
+		EnvironmentID: sample.EnvironmentID,
+		CPUPercent:    sample.CPUPercent,
+		CPUCount:      sample.CPUCount,
+		MemoryUsed:    sample.MemoryUsed,
+		MemoryTotal:   sample.MemoryTotal,
+		DiskUsed:      sample.DiskUsed,
+		DiskTotal:     sample.DiskTotal,
+		Load1:         sample.Load1,
+		Load5:         sample.Load5,
+		Load15:        sample.Load15,
+		CollectedAt:   sample.CollectedAt,
+	}
+}
+
+func toHostMetricsDto(row *models.HostMetricSample) system.HostMetrics {
+	out := system.HostMetrics{
+		EnvironmentID: row.EnvironmentID,
+		CPUPercent:    row.CPUPercent,
+		CPUCount:      row.CPUCount,
+		MemoryUsed:    row.MemoryUsed,
+		MemoryTotal:   row.MemoryTotal,
+		DiskUsed:      row.DiskUsed,
+		DiskTotal:     row.DiskTotal,
+		Load1:         row.Load1,
+		Load5:         row.Load5,
+		Load15:        row.Load15,
+		CollectedAt:   row.CollectedAt,
+	}
+	fillHostMetricPercentages(&out)
+	return out
+}
@@ -0,0 +1,69 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	glsqlite "github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/getarcaneapp/arcane/backend/internal/database"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/types/system"
+)
+
+func setupHostMetricsServiceTest(t *testing.T) *HostMetricsService {
+	t.Helper()
+	ctx := context.Background()
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared", strings.NewReplacer("/", "_", " ", "_").Replace(t.Name()))
+	db, err := gorm.Open(glsqlite.Open(dsn), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.SettingVariable{}, &models.HostMetricSample{}))
+
+	settingsSvc, err := NewSettingsService(ctx, &database.DB{DB: db})
+	require.NoError(t, err)
+	require.NoError(t, settingsSvc.EnsureDefaultSettings(ctx))
+
+	return NewHostMetricsService(&database.DB{DB: db}, settingsSvc, nil, nil, nil, nil)
+}
+
+func TestHostMetricsService_ThresholdAlertsOncePerCrossing(t *testing.T) {
+	svc := setupHostMetricsServiceTest(t)
+	ctx := context.Background()
+
+	sample := &system.HostMetrics{EnvironmentID: "0", DiskUsed: 95, DiskTotal: 100}
+	fillHostMetricPercentages(sample)
+
+	svc.evaluateThresholdsInternal(ctx, sample)
+	require.True(t, svc.alerting["0:"+hostMetricDisk])
+	require.False(t, svc.alerting["0:"+hostMetricMemory], "memory threshold is disabled by default")
+
+	sample.DiskUsed = 50
+	fillHostMetricPercentages(sample)
+	svc.evaluateThresholdsInternal(ctx, sample)
+	require.False(t, svc.alerting["0:"+hostMetricDisk])
+}
+
+func TestHostMetricsService_GetHistoryReturnsSamplesInWindow(t *testing.T) {
+	svc := setupHostMetricsServiceTest(t)
+	ctx := context.Background()
+
+	now := time.Now()
+	rows := []models.HostMetricSample{
+		{EnvironmentID: "env-1", MemoryUsed: 1, MemoryTotal: 4, CollectedAt: now.Add(-48 * time.Hour)},
+		{EnvironmentID: "env-1", MemoryUsed: 2, MemoryTotal: 4, CollectedAt: now.Add(-2 * time.Hour)},
+		{EnvironmentID: "env-1", MemoryUsed: 3, MemoryTotal: 4, CollectedAt: now.Add(-time.Hour)},
+		{EnvironmentID: "env-2", MemoryUsed: 4, MemoryTotal: 4, CollectedAt: now},
+	}
+	require.NoError(t, svc.db.Create(&rows).Error)
+
+	history, err := svc.GetHistory(ctx, "env-1", 24)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	require.InDelta(t, 50.0, history[0].MemoryPercent, 0.001)
+	require.InDelta(t, 75.0, history[1].MemoryPercent, 0.001)
+}
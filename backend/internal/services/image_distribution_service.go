@@ -0,0 +1,522 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime/multipart"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	dockerutils "github.com/getarcaneapp/arcane/backend/internal/utils/docker"
+	utilsregistry "github.com/getarcaneapp/arcane/backend/internal/utils/registry"
+	"github.com/getarcaneapp/arcane/types/image"
+	"github.com/moby/moby/client"
+	ref "go.podman.io/image/v5/docker/reference"
+)
+
+// ImageDistributionService copies images from one environment to others,
+// either by streaming `docker save` into `docker load` or through a registry,
+// and promotes images from one registry to another.
+type ImageDistributionService struct {
+	dockerService      *DockerClientService
+	environmentService *EnvironmentService
+	imageService       *ImageService
+	registryService    *ContainerRegistryService
+	eventService       *EventService
+}
+
+func NewImageDistributionService(dockerService *DockerClientService, environmentService *EnvironmentService, imageService *ImageService, registryService *ContainerRegistryService, eventService *EventService) *ImageDistributionService {
+	return &ImageDistributionService{
+		dockerService:      dockerService,
+		environmentService: environmentService,
+		imageService:       imageService,
+		registryService:    registryService,
+		eventService:       eventService,
+	}
+}
+
+// DistributeImage copies req.Image from the source environment to every
+// target. The source must be the local environment or an SSH environment,
+// since agents cannot stream images back to the manager. A failure on one
+// target does not stop the others; each target's outcome is in the result.
+func (s *ImageDistributionService) DistributeImage(ctx context.Context, req image.DistributeRequest, user models.User) (*image.DistributeResult, error) {
+	req, err := normalizeDistributeRequestInternal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceClient, err := s.sourceClientInternal(ctx, req.SourceEnvironmentID, "distributed")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := sourceClient.ImageInspect(ctx, req.Image); err != nil {
+		return nil, &models.NotFoundError{Message: fmt.Sprintf("image %s not found in the source environment", req.Image)}
+	}
+
+	if req.Method == image.DistributeMethodRegistry {
+		if err := s.pushImageInternal(ctx, sourceClient, req.Image); err != nil {
+			return nil, err
+		}
+	}
+
+	result := &image.DistributeResult{
+		Image:   req.Image,
+		Method:  req.Method,
+		Targets: make([]image.DistributeTargetResult, 0, len(req.TargetEnvironmentIDs)),
+	}
+	succeeded := 0
+	for _, envID := range req.TargetEnvironmentIDs {
+		target := image.DistributeTargetResult{EnvironmentID: envID, EnvironmentName: envID}
+		startedAt := time.Now()
+
+		err := s.distributeToTargetInternal(ctx, sourceClient, req, envID, &target, user)
+
+		target.DurationMs = time.Since(startedAt).Milliseconds()
+		if err != nil {
+			slog.WarnContext(ctx, "Failed to distribute image", "image", req.Image, "environment", envID, "error", err)
+			target.Error = new(err.Error())
+		} else {
+			target.Success = true
+			succeeded++
+		}
+		result.Targets = append(result.Targets, target)
+	}
+
+	_, _ = s.eventService.CreateEvent(ctx, CreateEventRequest{
+		Type:          models.EventTypeImageDistribute,
+		Title:         "Image distributed: " + req.Image,
+		Description:   fmt.Sprintf("Copied to %d of %d environments using %s", succeeded, len(result.Targets), req.Method),
+		ResourceType:  new("image"),
+		ResourceName:  new(req.Image),
+		UserID:        new(user.ID),
+		Username:      new(user.Username),
+		EnvironmentID: new(req.SourceEnvironmentID),
+		Metadata:      models.JSON{"method": string(req.Method), "targets": result.Targets},
+	})
+
+	return result, nil
+}
+
+// PromoteImage pulls req.SourceImage on the source environment, tags it for
+// the target registry and pushes it there. With req.SourceDigest the source
+// tag must still point to that digest, so a tag that moved since it was
+// tested is not promoted. After the push the target registry is asked for the
+// digest of the new tag, which must match the digest Docker pushed.
+func (s *ImageDistributionService) PromoteImage(ctx context.Context, req image.PromoteRequest, user models.User) (*image.PromoteResult, error) {
+	req, err := normalizePromoteRequestInternal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	registry, err := s.registryService.GetRegistryByID(ctx, req.TargetRegistryID)
+	if err != nil {
+		return nil, &models.NotFoundError{Message: "target registry not found"}
+	}
+	if !registry.Enabled {
+		return nil, &models.ValidationError{Message: fmt.Sprintf("registry %s is disabled", registry.URL), Field: "targetRegistryId"}
+	}
+	targetRef, err := promotionTargetRefInternal(req, registry.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceClient, err := s.sourceClientInternal(ctx, req.SourceEnvironmentID, "promoted")
+	if err != nil {
+		return nil, err
+	}
+
+	startedAt := time.Now()
+	result, err := s.promoteInternal(ctx, sourceClient, req, registry, targetRef, user)
+	if err != nil {
+		s.eventService.LogErrorEvent(ctx, models.EventTypeImageError, "image", "", req.SourceImage, user.ID, user.Username, req.SourceEnvironmentID, err, models.JSON{"action": "promote", "targetImage": targetRef, "registryId": registry.ID})
+		return nil, err
+	}
+	result.DurationMs = time.Since(startedAt).Milliseconds()
+
+	_, _ = s.eventService.CreateEvent(ctx, CreateEventRequest{
+		Type:          models.EventTypeImagePromote,
+		Title:         "Image promoted: " + req.SourceImage,
+		Description:   fmt.Sprintf("Pushed %s as %s", req.SourceImage, targetRef),
+		ResourceType:  new("image"),
+		ResourceName:  new(req.SourceImage),
+		UserID:        new(user.ID),
+		Username:      new(user.Username),
+		EnvironmentID: new(req.SourceEnvironmentID),
+		Metadata: models.JSON{
+			"targetImage":  targetRef,
+			"registryId":   registry.ID,
+			"sourceDigest": result.SourceDigest,
+			"digest":       result.Digest,
+			"verified":     result.Verified,
+		},
+	})
+
+	return result, nil
+}
+
+func (s *ImageDistributionService) promoteInternal(ctx context.Context, sourceClient *client.Client, req image.PromoteRequest, registry *models.ContainerRegistry, targetRef string, user models.User) (*image.PromoteResult, error) {
+	if err := s.imageService.PullImage(WithDockerEnvironment(ctx, req.SourceEnvironmentID), req.SourceImage, io.Discard, user, nil); err != nil {
+		return nil, fmt.Errorf("failed to pull image %s: %w", req.SourceImage, err)
+	}
+	inspect, err := sourceClient.ImageInspect(ctx, req.SourceImage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to inspect image %s: %w", req.SourceImage, err)
+	}
+
+	result := &image.PromoteResult{
+		SourceImage:  req.SourceImage,
+		SourceDigest: repoDigestInternal(inspect.RepoDigests, req.SourceImage),
+		TargetImage:  targetRef,
+	}
+	if req.SourceDigest != "" && result.SourceDigest != req.SourceDigest {
+		return nil, &models.ConflictError{Message: fmt.Sprintf("image %s has digest %s, not the expected %s", req.SourceImage, result.SourceDigest, req.SourceDigest)}
+	}
+
+	if _, err := sourceClient.ImageTag(ctx, client.ImageTagOptions{Source: inspect.ID, Target: targetRef}); err != nil {
+		return nil, fmt.Errorf("failed to tag image as %s: %w", targetRef, err)
+	}
+	// The target tag only exists to push from; the source tag keeps the image.
+	defer func() {
+		if _, err := sourceClient.ImageRemove(ctx, targetRef, client.ImageRemoveOptions{}); err != nil {
+			slog.WarnContext(ctx, "Failed to remove promotion tag", "image", targetRef, "error", err)
+		}
+	}()
+
+	authHeader, err := s.registryService.GetRegistryAuth(registry)
+	if err != nil {
+		return nil, err
+	}
+	result.Digest, err = pushImageDigestInternal(ctx, sourceClient, targetRef, authHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	repository, tag := parseImageReference(targetRef)
+	registryDigest, err := s.registryService.fetchDigestFromRegistry(ctx, repository, tag)
+	switch {
+	case err != nil:
+		slog.WarnContext(ctx, "Failed to verify promoted image digest", "image", targetRef, "error", err)
+	case result.Digest == "":
+		result.Digest = registryDigest
+	case registryDigest != result.Digest:
+		return nil, fmt.Errorf("registry reports digest %s for %s, but %s was pushed", registryDigest, targetRef, result.Digest)
+	default:
+		result.Verified = true
+	}
+
+	return result, nil
+}
+
+func (s *ImageDistributionService) sourceClientInternal(ctx context.Context, envID, action string) (*client.Client, error) {
+	if envID != "0" {
+		source, err := s.environmentService.GetEnvironmentByID(ctx, envID)
+		if err != nil {
+			return nil, &models.NotFoundError{Message: "source environment not found"}
+		}
+		if !IsSSHEnvironmentURL(source.ApiUrl) {
+			return nil, &models.ValidationError{Message: fmt.Sprintf("images can only be %s from the local environment or an SSH environment", action), Field: "sourceEnvironmentId"}
+		}
+	}
+
+	sourceClient, err := s.dockerService.GetClient(WithDockerEnvironment(ctx, envID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to Docker: %w", err)
+	}
+	return sourceClient, nil
+}
+
+func (s *ImageDistributionService) distributeToTargetInternal(ctx context.Context, sourceClient *client.Client, req image.DistributeRequest, envID string, target *image.DistributeTargetResult, user models.User) error {
+	remote := false
+	if envID != "0" {
+		env, err := s.environmentService.GetEnvironmentByID(ctx, envID)
+		if err != nil {
+			return errors.New("environment not found")
+		}
+		target.EnvironmentName = env.Name
+		remote = !IsSSHEnvironmentURL(env.ApiUrl)
+	} else {
+		target.EnvironmentName = "Local"
+	}
+
+	switch {
+	case req.Method == image.DistributeMethodRegistry && remote:
+		return s.pullOnAgentInternal(ctx, envID, req.Image)
+	case req.Method == image.DistributeMethodRegistry:
+		return s.imageService.PullImage(WithDockerEnvironment(ctx, envID), req.Image, io.Discard, user, nil)
+	case remote:
+		return s.streamToAgentInternal(ctx, sourceClient, envID, req.Image)
+	default:
+		return s.streamToDockerInternal(ctx, sourceClient, envID, req.Image)
+	}
+}
+
+func (s *ImageDistributionService) pushImageInternal(ctx context.Context, sourceClient *client.Client, ref string) error {
+	pushOptions := client.ImagePushOptions{}
+	if authHeader, err := s.registryService.GetRegistryAuthForImage(ctx, ref); err != nil {
+		slog.WarnContext(ctx, "Failed to get registry authentication for image; pushing without auth", "image", ref, "error", err)
+	} else {
+		pushOptions.RegistryAuth = authHeader
+	}
+
+	pushResp, err := sourceClient.ImagePush(ctx, ref, pushOptions)
+	if err != nil {
+		return fmt.Errorf("failed to push image %s: %w", ref, err)
+	}
+	defer func() { _ = pushResp.Close() }()
+
+	if err := dockerutils.ConsumeJSONMessageStream(pushResp, nil); err != nil {
+		return fmt.Errorf("failed to push image %s: %w", ref, err)
+	}
+	return nil
+}
+
+// pushImageDigestInternal pushes imageRef and returns the digest the daemon
+// reports for it, which is empty for daemons that do not report one.
+func pushImageDigestInternal(ctx context.Context, dockerClient *client.Client, imageRef, authHeader string) (string, error) {
+	pushResp, err := dockerClient.ImagePush(ctx, imageRef, client.ImagePushOptions{RegistryAuth: authHeader})
+	if err != nil {
+		return "", fmt.Errorf("failed to push image %s: %w", imageRef, err)
+	}
+	defer func() { _ = pushResp.Close() }()
+
+	var digest string
+	err = dockerutils.ConsumeJSONMessageStream(pushResp, func(line []byte) error {
+		var msg struct {
+			Aux *struct {
+				Digest string `json:"Digest"`
+			} `json:"aux"`
+		}
+		if json.Unmarshal(line, &msg) == nil && msg.Aux != nil && msg.Aux.Digest != "" {
+			digest = msg.Aux.Digest
+		}
+		return nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to push image %s: %w", imageRef, err)
+	}
+	return digest, nil
+}
+
+// streamToDockerInternal pipes the saved image straight into the target
+// daemon, for the local environment and SSH environments.
+func (s *ImageDistributionService) streamToDockerInternal(ctx context.Context, sourceClient *client.Client, envID, ref string) error {
+	targetClient, err := s.dockerService.GetClient(WithDockerEnvironment(ctx, envID))
+	if err != nil {
+		return fmt.Errorf("failed to connect to Docker: %w", err)
+	}
+
+	archive, err := sourceClient.ImageSave(ctx, []string{ref})
+	if err != nil {
+		return fmt.Errorf("failed to save image: %w", err)
+	}
+	defer func() { _ = archive.Close() }()
+
+	loadResp, err := targetClient.ImageLoad(ctx, archive)
+	if err != nil {
+		return fmt.Errorf("failed to load image: %w", err)
+	}
+	defer func() { _ = loadResp.Close() }()
+
+	if err := dockerutils.ConsumeJSONMessageStream(loadResp, nil); err != nil {
+		return fmt.Errorf("failed to load image: %w", err)
+	}
+	return nil
+}
+
+// streamToAgentInternal uploads the saved image to an agent's image upload
+// endpoint without buffering it on the manager.
+func (s *ImageDistributionService) streamToAgentInternal(ctx context.Context, sourceClient *client.Client, envID, ref string) error {
+	archive, err := sourceClient.ImageSave(ctx, []string{ref})
+	if err != nil {
+		return fmt.Errorf("failed to save image: %w", err)
+	}
+	defer func() { _ = archive.Close() }()
+
+	pr, pw := io.Pipe()
+	defer func() { _ = pr.Close() }()
+	form := multipart.NewWriter(pw)
+
+	go func() {
+		part, err := form.CreateFormFile("file", distributeArchiveNameInternal(ref))
+		if err == nil {
+			_, err = io.Copy(part, archive)
+		}
+		if err == nil {
+			err = form.Close()
+		}
+		_ = pw.CloseWithError(err)
+	}()
+
+	body, statusCode, err := s.environmentService.UploadToEnvironment(ctx, envID, http.MethodPost, "/api/environments/0/images/upload", form.FormDataContentType(), pr)
+	if err != nil {
+		return err
+	}
+	return remoteResponseErrorInternal(statusCode, body)
+}
+
+// pullOnAgentInternal has an agent pull the image through its own pull
+// endpoint, which streams progress and reports failures in the stream.
+func (s *ImageDistributionService) pullOnAgentInternal(ctx context.Context, envID, ref string) error {
+	payload, err := json.Marshal(image.PullOptions{ImageName: ref})
+	if err != nil {
+		return fmt.Errorf("failed to encode pull request: %w", err)
+	}
+
+	body, statusCode, err := s.environmentService.ProxyRequest(ctx, envID, http.MethodPost, "/api/environments/0/images/pull", payload)
+	if err != nil {
+		return err
+	}
+	if err := remoteResponseErrorInternal(statusCode, body); err != nil {
+		return err
+	}
+	if err := dockerutils.ConsumeJSONMessageStream(bytes.NewReader(body), nil); err != nil {
+		return fmt.Errorf("failed to pull image: %w", err)
+	}
+	return nil
+}
+
+// normalizeDistributeRequestInternal fills in defaults and drops duplicate
+// targets and the source from the target list.
+func normalizeDistributeRequestInternal(req image.DistributeRequest) (image.DistributeRequest, error) {
+	req.Image = strings.TrimSpace(req.Image)
+	if req.Image == "" {
+		return req, &models.ValidationError{Message: "image is required", Field: "image"}
+	}
+	req.SourceEnvironmentID = strings.TrimSpace(req.SourceEnvironmentID)
+	if req.SourceEnvironmentID == "" {
+		req.SourceEnvironmentID = "0"
+	}
+
+	switch req.Method {
+	case "":
+		req.Method = image.DistributeMethodStream
+	case image.DistributeMethodStream:
+	case image.DistributeMethodRegistry:
+		// Pushing needs a repository to push to; a bare image ID has none.
+		if strings.HasPrefix(req.Image, "sha256:") {
+			return req, &models.ValidationError{Message: "registry distribution needs an image reference, not an image ID", Field: "image"}
+		}
+	default:
+		return req, &models.ValidationError{Message: fmt.Sprintf("unsupported distribution method %q", req.Method), Field: "method"}
+	}
+
+	targets := make([]string, 0, len(req.TargetEnvironmentIDs))
+	for _, id := range req.TargetEnvironmentIDs {
+		id = strings.TrimSpace(id)
+		if id == "" || id == req.SourceEnvironmentID || slices.Contains(targets, id) {
+			continue
+		}
+		targets = append(targets, id)
+	}
+	if len(targets) == 0 {
+		return req, &models.ValidationError{Message: "at least one target environment other than the source is required", Field: "targetEnvironmentIds"}
+	}
+	req.TargetEnvironmentIDs = targets
+
+	return req, nil
+}
+
+// normalizePromoteRequestInternal fills in defaults and rejects sources that
+// cannot be pulled by reference.
+func normalizePromoteRequestInternal(req image.PromoteRequest) (image.PromoteRequest, error) {
+	req.SourceImage = strings.TrimSpace(req.SourceImage)
+	if req.SourceImage == "" || strings.HasPrefix(req.SourceImage, "sha256:") {
+		return req, &models.ValidationError{Message: "an image reference to pull is required", Field: "sourceImage"}
+	}
+	req.SourceEnvironmentID = strings.TrimSpace(req.SourceEnvironmentID)
+	if req.SourceEnvironmentID == "" {
+		req.SourceEnvironmentID = "0"
+	}
+	req.SourceDigest = strings.TrimSpace(req.SourceDigest)
+	if req.SourceDigest != "" && !strings.HasPrefix(req.SourceDigest, "sha256:") {
+		return req, &models.ValidationError{Message: "source digest must be a sha256 digest", Field: "sourceDigest"}
+	}
+	req.TargetRegistryID = strings.TrimSpace(req.TargetRegistryID)
+	if req.TargetRegistryID == "" {
+		return req, &models.ValidationError{Message: "target registry is required", Field: "targetRegistryId"}
+	}
+	req.TargetRepository = strings.Trim(strings.TrimSpace(req.TargetRepository), "/")
+	req.TargetTag = strings.TrimSpace(req.TargetTag)
+	return req, nil
+}
+
+// promotionTargetRefInternal names the promoted image in the target
+// registry. The repository defaults to the source's path and the tag to the
+// source's tag.
+func promotionTargetRefInternal(req image.PromoteRequest, registryURL string) (string, error) {
+	source, err := ref.ParseNormalizedNamed(req.SourceImage)
+	if err != nil {
+		return "", &models.ValidationError{Message: fmt.Sprintf("invalid image reference %q", req.SourceImage), Field: "sourceImage"}
+	}
+
+	host := utilsregistry.NormalizeRegistryForComparison(registryURL)
+	repository := req.TargetRepository
+	if repository == "" {
+		repository = ref.Path(source)
+	}
+	tag := req.TargetTag
+	if tag == "" {
+		tag = "latest"
+		if tagged, ok := source.(ref.Tagged); ok {
+			tag = tagged.Tag()
+		}
+	}
+
+	target, err := ref.ParseNormalizedNamed(host + "/" + repository + ":" + tag)
+	if err != nil {
+		return "", &models.ValidationError{Message: fmt.Sprintf("invalid target image %s/%s:%s", host, repository, tag), Field: "targetRepository"}
+	}
+	if target.String() == ref.TagNameOnly(source).String() {
+		return "", &models.ValidationError{Message: "the target image is the same as the source", Field: "targetRegistryId"}
+	}
+	return target.String(), nil
+}
+
+// repoDigestInternal returns the digest of imageRef's repository among an
+// image's repo digests.
+func repoDigestInternal(repoDigests []string, imageRef string) string {
+	source, err := ref.ParseNormalizedNamed(imageRef)
+	if err != nil {
+		return ""
+	}
+	for _, repoDigest := range repoDigests {
+		named, err := ref.ParseNormalizedNamed(repoDigest)
+		if err != nil || named.Name() != source.Name() {
+			continue
+		}
+		if canonical, ok := named.(ref.Canonical); ok {
+			return canonical.Digest().String()
+		}
+	}
+	return ""
+}
+
+// distributeArchiveNameInternal turns an image reference into a file name the
+// upload endpoint accepts.
+func distributeArchiveNameInternal(ref string) string {
+	name := strings.NewReplacer("/", "_", ":", "_", "@", "_").Replace(ref)
+	return name + ".tar"
+}
+
+// remoteResponseErrorInternal turns a failed agent response into an error,
+// preferring the detail of a problem response over the raw body.
+func remoteResponseErrorInternal(statusCode int, body []byte) error {
+	if statusCode >= http.StatusOK && statusCode < http.StatusMultipleChoices {
+		return nil
+	}
+	var problem struct {
+		Detail string `json:"detail"`
+	}
+	if err := json.Unmarshal(body, &problem); err == nil && problem.Detail != "" {
+		return fmt.Errorf("environment returned status %d: %s", statusCode, problem.Detail)
+	}
+	return fmt.Errorf("environment returned status %d", statusCode)
+}
@@ -0,0 +1,76 @@
+package services
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/types/image"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeDistributeRequestInternal(t *testing.T) {
+	req, err := normalizeDistributeRequestInternal(image.DistributeRequest{
+		Image:                " app:1.2 ",
+		TargetEnvironmentIDs: []string{"2", "0", "2", " ", "3"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "app:1.2", req.Image)
+	assert.Equal(t, "0", req.SourceEnvironmentID)
+	assert.Equal(t, image.DistributeMethodStream, req.Method)
+	assert.Equal(t, []string{"2", "3"}, req.TargetEnvironmentIDs)
+
+	_, err = normalizeDistributeRequestInternal(image.DistributeRequest{Image: "app", SourceEnvironmentID: "2", TargetEnvironmentIDs: []string{"2"}})
+	var validationErr *models.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "targetEnvironmentIds", validationErr.Field)
+
+	_, err = normalizeDistributeRequestInternal(image.DistributeRequest{Image: "sha256:abc", Method: image.DistributeMethodRegistry, TargetEnvironmentIDs: []string{"2"}})
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "image", validationErr.Field)
+
+	_, err = normalizeDistributeRequestInternal(image.DistributeRequest{Image: "app", Method: "rsync", TargetEnvironmentIDs: []string{"2"}})
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "method", validationErr.Field)
+}
+
+func TestDistributeArchiveNameInternal(t *testing.T) {
+	assert.Equal(t, "ghcr.io_acme_app_1.2.tar", distributeArchiveNameInternal("ghcr.io/acme/app:1.2"))
+}
+
+func TestRemoteResponseErrorInternal(t *testing.T) {
+	require.NoError(t, remoteResponseErrorInternal(http.StatusOK, nil))
+	assert.EqualError(t, remoteResponseErrorInternal(http.StatusRequestEntityTooLarge, []byte(`{"title":"Request Entity Too Large","detail":"file size exceeds maximum allowed size of 500 MB"}`)),
+		"environment returned status 413: file size exceeds maximum allowed size of 500 MB")
+	assert.EqualError(t, remoteResponseErrorInternal(http.StatusBadGateway, []byte("bad gateway")), "environment returned status 502")
+}
+
+func TestPromotionTargetRefInternal(t *testing.T) {
+	target, err := promotionTargetRefInternal(image.PromoteRequest{SourceImage: "registry.dev.example.com/team/app:1.4.0"}, "https://registry.example.com/")
+	require.NoError(t, err)
+	assert.Equal(t, "registry.example.com/team/app:1.4.0", target)
+
+	target, err = promotionTargetRefInternal(image.PromoteRequest{SourceImage: "nginx", TargetRepository: "acme/nginx", TargetTag: "stable"}, "docker.io")
+	require.NoError(t, err)
+	assert.Equal(t, "docker.io/acme/nginx:stable", target)
+
+	var validationErr *models.ValidationError
+	_, err = promotionTargetRefInternal(image.PromoteRequest{SourceImage: "ghcr.io/acme/app:2"}, "https://ghcr.io")
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "targetRegistryId", validationErr.Field)
+
+	_, err = promotionTargetRefInternal(image.PromoteRequest{SourceImage: "ghcr.io/acme/app:2", TargetTag: "not a tag"}, "ghcr.io")
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "targetRepository", validationErr.Field)
+}
+
+func TestRepoDigestInternal(t *testing.T) {
+	digests := []string{
+		"nginx@sha256:1111111111111111111111111111111111111111111111111111111111111111",
+		"registry.dev.example.com/team/app@sha256:2222222222222222222222222222222222222222222222222222222222222222",
+	}
+	assert.Equal(t, "sha256:2222222222222222222222222222222222222222222222222222222222222222", repoDigestInternal(digests, "registry.dev.example.com/team/app:1.4.0"))
+	assert.Equal(t, "sha256:1111111111111111111111111111111111111111111111111111111111111111", repoDigestInternal(digests, "docker.io/library/nginx:latest"))
+	assert.Empty(t, repoDigestInternal(digests, "ghcr.io/acme/app:1"))
+}
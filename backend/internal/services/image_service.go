@@ -8,6 +8,7 @@ import (
 	"log/slog"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/getarcaneapp/arcane/backend/internal/database"
 	"github.com/getarcaneapp/arcane/backend/internal/models"
@@ -20,6 +21,7 @@ import (
 	"github.com/moby/moby/api/types/container"
 	"github.com/moby/moby/api/types/image"
 	"github.com/moby/moby/client"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	ref "go.podman.io/image/v5/docker/reference"
 	"golang.org/x/sync/errgroup"
 	"gorm.io/gorm"
@@ -157,33 +159,54 @@ func (s *ImageService) RemoveImage(ctx context.Context, id string, force bool, u
 }
 
 func (s *ImageService) PullImage(ctx context.Context, imageName string, progressWriter io.Writer, user models.User, externalCreds []containerregistry.Credential) error {
+	return s.PullImageForPlatform(ctx, imageName, "", progressWriter, user, externalCreds)
+}
+
+// PullImageForPlatform pulls imageName for platform, given as os/arch[/variant]
+// like a compose service's platform field. An empty platform pulls the
+// daemon's default.
+func (s *ImageService) PullImageForPlatform(ctx context.Context, imageName, platform string, progressWriter io.Writer, user models.User, externalCreds []containerregistry.Credential) error {
+	var platforms []ocispec.Platform
+	if platform = strings.TrimSpace(platform); platform != "" {
+		p, err := utilsregistry.ParsePlatform(platform)
+		if err != nil {
+			return fmt.Errorf("failed to pull image %s: %w", imageName, err)
+		}
+		platforms = []ocispec.Platform{p}
+	}
+
 	dockerClient, err := s.dockerService.GetClient(ctx)
 	if err != nil {
 		s.eventService.LogErrorEvent(ctx, models.EventTypeImageError, "image", "", imageName, user.ID, user.Username, "0", err, models.JSON{"action": "pull"})
 		return fmt.Errorf("failed to connect to Docker: %w", err)
 	}
 
-	slog.DebugContext(ctx, "Attempting to pull image", "image", imageName, "externalCredCount", len(externalCreds))
+	slog.DebugContext(ctx, "Attempting to pull image", "image", imageName, "platform", platform, "externalCredCount", len(externalCreds))
 
 	pullOptions, err := s.getPullOptionsWithAuth(ctx, imageName, externalCreds)
 	if err != nil {
 		slog.WarnContext(ctx, "Failed to get registry authentication for image; proceeding without auth", "image", imageName, "error", err.Error())
 		pullOptions = client.ImagePullOptions{}
 	}
+	pullOptions.Platforms = platforms
 
 	initialHasAuth := pullOptions.RegistryAuth != ""
 	retriedWithoutAuth := false
 
+	startedAt := time.Now()
+	stats := dockerutils.NewPullStats()
+
 	reader, err := dockerClient.ImagePull(ctx, imageName, pullOptions)
 	if err != nil && shouldRetryAnonymousPullInternal(pullOptions, err) {
 		retriedWithoutAuth = true
 		slog.WarnContext(ctx, "Docker ImagePull failed with registry auth; retrying anonymously", "image", imageName, "error", err.Error())
-		pullOptions = client.ImagePullOptions{}
+		pullOptions = client.ImagePullOptions{Platforms: platforms}
 		reader, err = dockerClient.ImagePull(ctx, imageName, pullOptions)
 	}
 	if err != nil {
 		slog.ErrorContext(ctx, "Docker ImagePull failed", "image", imageName, "hasAuth", pullOptions.RegistryAuth != "", "initialHasAuth", initialHasAuth, "retriedWithoutAuth", retriedWithoutAuth, "error", err.Error())
 		s.eventService.LogErrorEvent(ctx, models.EventTypeImageError, "image", "", imageName, user.ID, user.Username, "0", err, models.JSON{"action": "pull"})
+		s.recordImagePullInternal(ctx, imageName, user, startedAt, stats, err)
 		return fmt.Errorf("failed to initiate image pull for %s: %w", imageName, err)
 	}
 	defer func() { _ = reader.Close() }()
@@ -195,6 +218,7 @@ func (s *ImageService) PullImage(ctx context.Context, imageName string, progress
 
 	flusher, implementsFlusher := streamWriter.(http.Flusher)
 	streamErr := dockerutils.ConsumeJSONMessageStream(reader, func(line []byte) error {
+		stats.Observe(line)
 		if _, writeErr := streamWriter.Write(line); writeErr != nil {
 			return writeErr
 		}
@@ -206,6 +230,7 @@ func (s *ImageService) PullImage(ctx context.Context, imageName string, progress
 		}
 		return nil
 	})
+	s.recordImagePullInternal(ctx, imageName, user, startedAt, stats, streamErr)
 	if streamErr != nil {
 		if errors.Is(streamErr, context.Canceled) || strings.Contains(streamErr.Error(), "context canceled") {
 			slog.Debug("image pull stream canceled", "image", imageName, "err", streamErr)
@@ -229,6 +254,118 @@ func (s *ImageService) PullImage(ctx context.Context, imageName string, progress
 	return nil
 }
 
+// imagePullTriggerKey can be set on a context to record what started the
+// image pulls made with it.
+type imagePullTriggerKey struct{}
+
+func withImagePullTriggerInternal(ctx context.Context, trigger models.ImagePullTrigger) context.Context {
+	return context.WithValue(ctx, imagePullTriggerKey{}, trigger)
+}
+
+func imagePullTriggerInternal(ctx context.Context, user models.User) models.ImagePullTrigger {
+	if trigger, ok := ctx.Value(imagePullTriggerKey{}).(models.ImagePullTrigger); ok {
+		return trigger
+	}
+	if user.ID == "" {
+		return models.ImagePullTriggerSystem
+	}
+	return models.ImagePullTriggerUser
+}
+
+// recordImagePullInternal stores a finished pull in the pull history.
+// Failures to record are logged and otherwise ignored.
+func (s *ImageService) recordImagePullInternal(ctx context.Context, imageName string, user models.User, startedAt time.Time, stats *dockerutils.PullStats, pullErr error) {
+	if s.db == nil {
+		return
+	}
+
+	completedAt := time.Now()
+	record := &models.ImagePull{
+		ImageName:        imageName,
+		Trigger:          imagePullTriggerInternal(ctx, user),
+		Status:           models.ImagePullStatusSuccess,
+		Layers:           stats.Layers(),
+		LayersDownloaded: stats.LayersDownloaded(),
+		Bytes:            stats.Bytes(),
+		CompletedAt:      &completedAt,
+		DurationMs:       completedAt.Sub(startedAt).Milliseconds(),
+		BaseModel: models.BaseModel{
+			CreatedAt: startedAt,
+		},
+	}
+	if user.ID != "" {
+		record.UserID = &user.ID
+	}
+	if user.Username != "" {
+		record.Username = &user.Username
+	}
+	if pullErr != nil {
+		record.Status = models.ImagePullStatusFailed
+		if errors.Is(pullErr, context.Canceled) || strings.Contains(pullErr.Error(), "context canceled") {
+			record.Status = models.ImagePullStatusCanceled
+		}
+		msg := pullErr.Error()
+		record.ErrorMessage = &msg
+	}
+
+	// The pull's context may already be canceled; the record is still wanted.
+	if err := s.db.WithContext(context.WithoutCancel(ctx)).Create(record).Error; err != nil {
+		slog.WarnContext(ctx, "failed to record image pull history", "image", imageName, "error", err)
+	}
+}
+
+// ListImagePullsPaginated returns the image pull history, newest first by
+// default.
+func (s *ImageService) ListImagePullsPaginated(ctx context.Context, params pagination.QueryParams) ([]imagetypes.PullRecord, pagination.Response, error) {
+	if s.db == nil {
+		return nil, pagination.Response{}, fmt.Errorf("pull history not available")
+	}
+
+	var pulls []models.ImagePull
+	q := s.db.WithContext(ctx).Model(&models.ImagePull{})
+
+	if term := strings.TrimSpace(params.Search); term != "" {
+		searchPattern := "%" + term + "%"
+		q = q.Where(
+			"image_name LIKE ? OR COALESCE(username, '') LIKE ? OR COALESCE(error_message, '') LIKE ?",
+			searchPattern, searchPattern, searchPattern,
+		)
+	}
+
+	q = pagination.ApplyFilter(q, "status", params.Filters["status"])
+	q = pagination.ApplyFilter(q, "triggered_by", params.Filters["trigger"])
+
+	if params.Sort == "" {
+		params.Sort = "createdAt"
+	}
+
+	paginationResp, err := pagination.PaginateAndSortDB(params, q, &pulls)
+	if err != nil {
+		return nil, pagination.Response{}, fmt.Errorf("failed to paginate pulls: %w", err)
+	}
+
+	records := make([]imagetypes.PullRecord, 0, len(pulls))
+	for _, pull := range pulls {
+		records = append(records, imagetypes.PullRecord{
+			ID:               pull.ID,
+			ImageName:        pull.ImageName,
+			UserID:           pull.UserID,
+			Username:         pull.Username,
+			Trigger:          string(pull.Trigger),
+			Status:           string(pull.Status),
+			Layers:           pull.Layers,
+			LayersDownloaded: pull.LayersDownloaded,
+			Bytes:            pull.Bytes,
+			ErrorMessage:     pull.ErrorMessage,
+			CompletedAt:      pull.CompletedAt,
+			DurationMs:       pull.DurationMs,
+			CreatedAt:        pull.CreatedAt,
+		})
+	}
+
+	return records, paginationResp, nil
+}
+
 func (s *ImageService) LoadImageFromReader(ctx context.Context, reader io.Reader, fileName string, user models.User, maxSizeBytes int64) (*imagetypes.LoadResult, error) {
 	// Wrap reader with size limit enforcement
 	limitedReader := io.LimitReader(reader, maxSizeBytes+1)
@@ -298,6 +435,36 @@ func (s *ImageService) ImageExistsLocally(ctx context.Context, imageName string)
 	return false, fmt.Errorf("failed to inspect image %s: %w", imageName, err)
 }
 
+// ImageExistsLocallyForPlatform reports whether imageName is stored locally
+// for platform. An empty platform matches any local image.
+func (s *ImageService) ImageExistsLocallyForPlatform(ctx context.Context, imageName, platform string) (bool, error) {
+	if strings.TrimSpace(platform) == "" {
+		return s.ImageExistsLocally(ctx, imageName)
+	}
+
+	want, err := utilsregistry.ParsePlatform(platform)
+	if err != nil {
+		return false, err
+	}
+
+	dockerClient, err := s.dockerService.GetClient(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to connect to Docker: %w", err)
+	}
+
+	inspect, err := dockerClient.ImageInspect(ctx, imageName)
+	if err != nil {
+		errLower := strings.ToLower(err.Error())
+		if strings.Contains(errLower, "no such image") || strings.Contains(errLower, "not found") {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to inspect image %s: %w", imageName, err)
+	}
+
+	have := ocispec.Platform{OS: inspect.Os, Architecture: inspect.Architecture, Variant: inspect.Variant}
+	return utilsregistry.PlatformMatches(utilsregistry.NormalizePlatform(want), utilsregistry.NormalizePlatform(have)), nil
+}
+
 func (s *ImageService) getPullOptionsWithAuth(ctx context.Context, imageRef string, externalCreds []containerregistry.Credential) (client.ImagePullOptions, error) {
 	pullOptions := client.ImagePullOptions{}
 
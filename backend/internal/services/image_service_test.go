@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	glsqlite "github.com/glebarez/sqlite"
 	"github.com/stretchr/testify/require"
@@ -13,6 +14,8 @@ import (
 	"github.com/getarcaneapp/arcane/backend/internal/database"
 	"github.com/getarcaneapp/arcane/backend/internal/models"
 	"github.com/getarcaneapp/arcane/backend/internal/utils/crypto"
+	dockerutils "github.com/getarcaneapp/arcane/backend/internal/utils/docker"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/pagination"
 	imagetypes "github.com/getarcaneapp/arcane/types/image"
 	"github.com/getarcaneapp/arcane/types/vulnerability"
 	dockerauthconfig "github.com/moby/moby/api/pkg/authconfig"
@@ -58,7 +61,7 @@ func setupImageServiceAuthTest(t *testing.T) (*ImageService, *database.DB) {
 
 	db, err := gorm.Open(glsqlite.Open(":memory:"), &gorm.Config{})
 	require.NoError(t, err)
-	require.NoError(t, db.AutoMigrate(&models.ContainerRegistry{}))
+	require.NoError(t, db.AutoMigrate(&models.ContainerRegistry{}, &models.ProjectRegistry{}))
 
 	crypto.InitEncryption(&config.Config{
 		Environment:   config.AppEnvironmentTest,
@@ -141,3 +144,51 @@ func TestShouldRetryAnonymousPullInternal_SkipsRetryWithoutUnauthorizedOrAuth(t
 	assert.False(t, shouldRetryAnonymousPullInternal(client.ImagePullOptions{RegistryAuth: "encoded-auth"}, nonAuthErr))
 	assert.False(t, shouldRetryAnonymousPullInternal(client.ImagePullOptions{}, unauthorizedErr))
 }
+
+func TestImageService_RecordImagePullInternal(t *testing.T) {
+	db, err := gorm.Open(glsqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.ImagePull{}))
+	svc := &ImageService{db: &database.DB{DB: db}}
+	ctx := context.Background()
+
+	stats := dockerutils.NewPullStats()
+	stats.Observe([]byte(`{"status":"Downloading","progressDetail":{"current":10,"total":1024},"id":"layer1"}`))
+	stats.Observe([]byte(`{"status":"Pull complete","progressDetail":{},"id":"layer1"}`))
+	stats.Observe([]byte(`{"status":"Already exists","progressDetail":{},"id":"layer2"}`))
+
+	user := models.User{BaseModel: models.BaseModel{ID: "u1"}, Username: "alice"}
+	svc.recordImagePullInternal(ctx, "nginx:latest", user, time.Now().Add(-time.Second), stats, nil)
+	svc.recordImagePullInternal(withImagePullTriggerInternal(ctx, models.ImagePullTriggerUpdater), "redis:7", systemUser, time.Now(), dockerutils.NewPullStats(), errors.New("manifest unknown"))
+
+	pulls, _, err := svc.ListImagePullsPaginated(ctx, pagination.QueryParams{Filters: map[string]string{}})
+	require.NoError(t, err)
+	require.Len(t, pulls, 2)
+
+	byImage := map[string]imagetypes.PullRecord{}
+	for _, p := range pulls {
+		byImage[p.ImageName] = p
+	}
+
+	nginx := byImage["nginx:latest"]
+	assert.Equal(t, string(models.ImagePullTriggerUser), nginx.Trigger)
+	assert.Equal(t, string(models.ImagePullStatusSuccess), nginx.Status)
+	assert.Equal(t, 2, nginx.Layers)
+	assert.Equal(t, 1, nginx.LayersDownloaded)
+	assert.Equal(t, int64(1024), nginx.Bytes)
+	assert.GreaterOrEqual(t, nginx.DurationMs, int64(1000))
+	require.NotNil(t, nginx.UserID)
+	assert.Equal(t, "u1", *nginx.UserID)
+
+	redis := byImage["redis:7"]
+	assert.Equal(t, string(models.ImagePullTriggerUpdater), redis.Trigger)
+	assert.Equal(t, string(models.ImagePullStatusFailed), redis.Status)
+	assert.Nil(t, redis.UserID)
+	require.NotNil(t, redis.ErrorMessage)
+	assert.Equal(t, "manifest unknown", *redis.ErrorMessage)
+
+	filtered, _, err := svc.ListImagePullsPaginated(ctx, pagination.QueryParams{Filters: map[string]string{"trigger": "updater"}})
+	require.NoError(t, err)
+	require.Len(t, filtered, 1)
+	assert.Equal(t, "redis:7", filtered[0].ImageName)
+}
@@ -5,18 +5,22 @@ import (
 	"fmt"
 	"log/slog"
 	"maps"
+	"slices"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/getarcaneapp/arcane/backend/internal/database"
 	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/arcaneupdater"
 	"github.com/getarcaneapp/arcane/backend/internal/utils/crypto"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/pagination"
 	registry "github.com/getarcaneapp/arcane/backend/internal/utils/registry"
 	"github.com/getarcaneapp/arcane/types/containerregistry"
 	"github.com/getarcaneapp/arcane/types/imageupdate"
 	"github.com/moby/moby/api/types/image"
 	"github.com/moby/moby/client"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	ref "go.podman.io/image/v5/docker/reference"
 	"golang.org/x/sync/errgroup"
 	"gorm.io/gorm"
@@ -43,6 +47,7 @@ type localImageSnapshot struct {
 	Tag           string
 	PrimaryDigest string
 	AllDigests    []string
+	Platform      ocispec.Platform
 }
 
 func NewImageUpdateService(db *database.DB, settingsService *SettingsService, registryService *ContainerRegistryService, dockerService *DockerClientService, eventService *EventService, notificationService *NotificationService) *ImageUpdateService {
@@ -195,10 +200,10 @@ func (s *ImageUpdateService) checkDigestUpdateWithSnapshotInternal(ctx context.C
 		enabledRegs, _ := s.registryService.GetEnabledRegistries(ctx)
 		authHeader, _, _, resolveErr := registry.ResolveAuthHeaderForRepository(ctx, parts.Registry, normalizedRepo, parts.Tag, enabledRegs)
 		if resolveErr == nil && authHeader != "" {
-			remoteDigest, _, err = rc.GetLatestDigestTimed(ctx, parts.Registry, normalizedRepo, parts.Tag, authHeader)
+			token = authHeader
+			remoteDigest, _, err = rc.GetLatestDigestTimed(ctx, parts.Registry, normalizedRepo, parts.Tag, token)
 		}
 	}
-	elapsed := time.Since(start)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to get remote digest: %w", err)
 	}
@@ -218,25 +223,68 @@ func (s *ImageUpdateService) checkDigestUpdateWithSnapshotInternal(ctx context.C
 		}
 	}
 
-	slog.DebugContext(ctx, "digest comparison",
-		"imageRef", fmt.Sprintf("%s/%s:%s", parts.Registry, parts.Repository, parts.Tag),
-		"primaryLocalDigest", localDigest,
-		"allLocalDigests", snapshot.AllDigests,
-		"remoteDigest", remoteDigest,
-		"hasUpdate", hasUpdate)
-
-	return &imageupdate.Response{
+	result := &imageupdate.Response{
 		HasUpdate:      hasUpdate,
 		UpdateType:     "digest",
 		CurrentDigest:  localDigest,
 		LatestDigest:   remoteDigest,
-		CheckTime:      time.Now(),
-		ResponseTimeMs: int(elapsed.Milliseconds()),
 		AuthMethod:     auth.Method,
 		AuthUsername:   auth.Username,
 		AuthRegistry:   auth.Registry,
 		UsedCredential: auth.Method == "credential",
-	}, snapshot, nil
+	}
+	if hasUpdate {
+		s.checkPlatformManifestInternal(ctx, rc, parts, normalizedRepo, token, snapshot, result)
+	}
+	result.CheckTime = time.Now()
+	result.ResponseTimeMs = int(time.Since(start).Milliseconds())
+
+	slog.DebugContext(ctx, "digest comparison",
+		"imageRef", fmt.Sprintf("%s/%s:%s", parts.Registry, parts.Repository, parts.Tag),
+		"primaryLocalDigest", result.CurrentDigest,
+		"allLocalDigests", snapshot.AllDigests,
+		"remoteDigest", result.LatestDigest,
+		"platform", result.Platform,
+		"hasUpdate", result.HasUpdate)
+
+	return result, snapshot, nil
+}
+
+// checkPlatformManifestInternal refines a digest mismatch for multi-arch tags.
+// An image pulled for a specific platform, e.g. through a compose platform
+// field, may record the platform manifest digest rather than the manifest
+// list's, so a match on that digest means the image is current. When the list
+// no longer provides the image's platform the update is flagged instead of
+// offered, since pulling it would fail or fetch another architecture.
+func (s *ImageUpdateService) checkPlatformManifestInternal(ctx context.Context, rc *registry.Client, parts *ImageParts, normalizedRepo, token string, snapshot *localImageSnapshot, result *imageupdate.Response) {
+	if snapshot.Platform.Architecture == "" {
+		return
+	}
+
+	index, err := rc.GetManifestIndex(ctx, parts.Registry, normalizedRepo, parts.Tag, token)
+	if err != nil {
+		slog.DebugContext(ctx, "failed to fetch manifest list for platform check", "repository", normalizedRepo, "tag", parts.Tag, "error", err)
+		return
+	}
+	if !index.IsIndex() {
+		return
+	}
+
+	platform := registry.NormalizePlatform(snapshot.Platform)
+	result.Platform = registry.FormatPlatform(platform)
+
+	platformDigest, ok := index.Resolve(platform)
+	if !ok {
+		result.HasUpdate = false
+		result.PlatformUnsupported = true
+		result.Error = fmt.Sprintf("%s:%s no longer provides platform %s (available: %s)", parts.Repository, parts.Tag, result.Platform, strings.Join(index.Platforms(), ", "))
+		return
+	}
+	if slices.Contains(snapshot.AllDigests, platformDigest) {
+		result.HasUpdate = false
+		result.CurrentDigest = platformDigest
+		result.LatestDigest = platformDigest
+	}
 }
 
 func (s *ImageUpdateService) parseImageReference(imageRef string) *ImageParts {
@@ -439,6 +487,11 @@ func (s *ImageUpdateService) inspectLocalImageSnapshotInternal(ctx context.Conte
 		Tag:           tag,
 		PrimaryDigest: primaryDigest,
 		AllDigests:    allDigests,
+		Platform: ocispec.Platform{
+			OS:           inspectResponse.Os,
+			Architecture: inspectResponse.Architecture,
+			Variant:      inspectResponse.Variant,
+		},
 	}, nil
 }
 
@@ -479,6 +532,64 @@ func (s *ImageUpdateService) normalizeRepository(regHost, repo string) string {
 	return repo
 }
 
+// ListRemoteTags lists the tags available in the registry for the repository
+// of imageRef, using the same credential resolution as update checks. Any tag
+// or digest in imageRef is ignored.
+func (s *ImageUpdateService) ListRemoteTags(ctx context.Context, imageRef string, params pagination.QueryParams) ([]imageupdate.RemoteTag, pagination.Response, error) {
+	tags, err := s.remoteTagsInternal(ctx, imageRef)
+	if err != nil {
+		return nil, pagination.Response{}, err
+	}
+
+	items := make([]imageupdate.RemoteTag, 0, len(tags))
+	for _, tag := range tags {
+		items = append(items, imageupdate.RemoteTag{Tag: tag, Semver: registry.TagSemver(tag)})
+	}
+
+	result := pagination.SearchOrderAndPaginate(items, params, pagination.Config[imageupdate.RemoteTag]{
+		SearchAccessors: []pagination.SearchAccessor[imageupdate.RemoteTag]{
+			func(t imageupdate.RemoteTag) (string, error) { return t.Tag, nil },
+		},
+		SortBindings: []pagination.SortBinding[imageupdate.RemoteTag]{
+			{Key: "version", Fn: func(a, b imageupdate.RemoteTag) int { return registry.CompareTags(a.Tag, b.Tag) }},
+			{Key: "tag", Fn: func(a, b imageupdate.RemoteTag) int { return strings.Compare(a.Tag, b.Tag) }},
+		},
+	})
+
+	return result.Items, pagination.BuildResponseFromFilterResult(result, params), nil
+}
+
+// remoteTagsInternal returns every tag in the registry for the repository of
+// imageRef.
+func (s *ImageUpdateService) remoteTagsInternal(ctx context.Context, imageRef string) ([]string, error) {
+	parts := s.parseImageReference(imageRef)
+	if parts == nil {
+		return nil, &models.ValidationError{Message: "invalid image reference format", Field: "imageRef"}
+	}
+
+	normalizedRepo := s.normalizeRepository(parts.Registry, parts.Repository)
+	registries := s.getRegistriesForImage(ctx, parts.Registry)
+
+	token, _, err := s.getRegistryToken(ctx, parts.Registry, normalizedRepo, registries)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get registry token: %w", err)
+	}
+
+	rc := registry.NewClient()
+	tags, err := rc.ListTags(ctx, parts.Registry, normalizedRepo, token)
+	if err != nil && strings.Contains(strings.ToLower(err.Error()), "unauthorized") {
+		enabledRegs, _ := s.registryService.GetEnabledRegistries(ctx)
+		authHeader, _, _, resolveErr := registry.ResolveAuthHeaderForRepository(ctx, parts.Registry, normalizedRepo, "", enabledRegs)
+		if resolveErr == nil && authHeader != "" {
+			tags, err = rc.ListTags(ctx, parts.Registry, normalizedRepo, authHeader)
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+	return tags, nil
+}
+
 func (s *ImageUpdateService) CheckImageUpdateByID(ctx context.Context, imageID string) (*imageupdate.Response, error) {
 	imageRef, err := s.getImageRefByID(ctx, imageID)
 	if err != nil {
@@ -631,9 +742,17 @@ func (s *ImageUpdateService) savePreparedUpdateResultInternal(ctx context.Contex
 				// Keep the existing notification_sent value if nothing changed
 				updateRecord.NotificationSent = existingRecord.NotificationSent
 			}
+			updateRecord.SnoozedUntil = existingRecord.SnoozedUntil
 		} else {
 			// New record - start with notification_sent = false
 			updateRecord.NotificationSent = false
+
+			// Snoozes belong to the repository and tag, so a newly pulled image
+			// keeps the snooze of the one it replaces.
+			var snoozed []models.ImageUpdateRecord
+			if err := tx.Where("repository = ? AND tag = ? AND snoozed_until > ?", repo, tag, time.Now()).Limit(1).Find(&snoozed).Error; err == nil && len(snoozed) > 0 {
+				updateRecord.SnoozedUntil = snoozed[0].SnoozedUntil
+			}
 		}
 
 		return tx.Save(updateRecord).Error
@@ -681,6 +800,123 @@ func (s *ImageUpdateService) MarkUpdatesAsNotified(ctx context.Context, imageIDs
 		Update("notification_sent", true).Error
 }
 
+// SnoozeUpdates holds back update notifications and automatic updates for the
+// repository and tag of imageRef until the given time.
+func (s *ImageUpdateService) SnoozeUpdates(ctx context.Context, imageRef string, until time.Time) (*imageupdate.Snooze, error) {
+	repo, tag, ok := snoozeRepoTagInternal(imageRef)
+	if !ok {
+		return nil, &models.ValidationError{Message: "imageRef must name a repository and tag", Field: "imageRef"}
+	}
+	if !until.After(time.Now()) {
+		return nil, &models.ValidationError{Message: "until must be in the future", Field: "until"}
+	}
+	until = until.UTC()
+
+	result := s.db.WithContext(ctx).
+		Model(&models.ImageUpdateRecord{}).
+		Where("repository = ? AND tag = ?", repo, tag).
+		Update("snoozed_until", until)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to snooze image updates: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, &models.NotFoundError{Message: fmt.Sprintf("no image updates found for %s:%s", repo, tag)}
+	}
+
+	slog.InfoContext(ctx, "Snoozed image updates", "repository", repo, "tag", tag, "until", until)
+	return &imageupdate.Snooze{Repository: repo, Tag: tag, SnoozedUntil: until}, nil
+}
+
+// UnsnoozeUpdates resumes update notifications and automatic updates for the
+// repository and tag of imageRef.
+func (s *ImageUpdateService) UnsnoozeUpdates(ctx context.Context, imageRef string) error {
+	repo, tag, ok := snoozeRepoTagInternal(imageRef)
+	if !ok {
+		return &models.ValidationError{Message: "imageRef must name a repository and tag", Field: "imageRef"}
+	}
+
+	result := s.db.WithContext(ctx).
+		Model(&models.ImageUpdateRecord{}).
+		Where("repository = ? AND tag = ? AND snoozed_until IS NOT NULL", repo, tag).
+		Update("snoozed_until", nil)
+	if result.Error != nil {
+		return fmt.Errorf("failed to unsnooze image updates: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return &models.NotFoundError{Message: fmt.Sprintf("updates of %s:%s are not snoozed", repo, tag)}
+	}
+	return nil
+}
+
+// ListSnoozedUpdates returns the repositories and tags whose updates are
+// currently snoozed.
+func (s *ImageUpdateService) ListSnoozedUpdates(ctx context.Context) ([]imageupdate.Snooze, error) {
+	var records []models.ImageUpdateRecord
+	if err := s.db.WithContext(ctx).
+		Where("snoozed_until > ?", time.Now()).
+		Order("repository ASC, tag ASC").
+		Find(&records).Error; err != nil {
+		return nil, fmt.Errorf("failed to list snoozed image updates: %w", err)
+	}
+
+	snoozes := make([]imageupdate.Snooze, 0, len(records))
+	seen := make(map[string]struct{}, len(records))
+	for _, record := range records {
+		key := record.Repository + ":" + record.Tag
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		snoozes = append(snoozes, imageupdate.Snooze{
+			Repository:   record.Repository,
+			Tag:          record.Tag,
+			SnoozedUntil: *record.SnoozedUntil,
+		})
+	}
+	return snoozes, nil
+}
+
+// snoozeRepoTagInternal returns the repository and tag that image update
+// records store for imageRef. Refs without a tag are treated as "latest".
+func snoozeRepoTagInternal(imageRef string) (repo, tag string, ok bool) {
+	named, err := ref.ParseNormalizedNamed(strings.TrimSpace(imageRef))
+	if err != nil {
+		return "", "", false
+	}
+	tagged, isTagged := ref.TagNameOnly(named).(ref.NamedTagged)
+	if !isTagged {
+		return "", "", false
+	}
+	return ref.FamiliarName(tagged), tagged.Tag(), true
+}
+
+// imageSnoozeKeyInternal returns the key of imageRef in the set returned by
+// snoozedImageRefsInternal.
+func imageSnoozeKeyInternal(imageRef string) string {
+	if repo, tag, ok := snoozeRepoTagInternal(imageRef); ok {
+		return repo + ":" + tag
+	}
+	return imageRef
+}
+
+// snoozedImageRefsInternal returns the "repository:tag" refs whose updates are
+// snoozed.
+func snoozedImageRefsInternal(ctx context.Context, db *database.DB) map[string]struct{} {
+	var records []models.ImageUpdateRecord
+	if err := db.WithContext(ctx).
+		Select("repository", "tag").
+		Where("snoozed_until > ?", time.Now()).
+		Find(&records).Error; err != nil {
+		slog.WarnContext(ctx, "Failed to load image update snoozes", "error", err)
+		return nil
+	}
+	refs := make(map[string]struct{}, len(records))
+	for _, record := range records {
+		refs[record.Repository+":"+record.Tag] = struct{}{}
+	}
+	return refs
+}
+
 type batchCred struct {
 	username string
 	token    string
@@ -895,6 +1131,7 @@ func (s *ImageUpdateService) checkSingleImageInBatchInternal(
 		if resolveErr == nil && authHeader != "" {
 			remoteDigest, _, digestErr = rc.GetLatestDigestTimed(ctx, parts.Registry, normalizedRepo, parts.Tag, authHeader)
 			if digestErr == nil {
+				token = authHeader
 				auth = &authDetails{Method: method, Username: username, Registry: parts.Registry}
 			}
 		}
@@ -934,18 +1171,23 @@ func (s *ImageUpdateService) checkSingleImageInBatchInternal(
 		}
 	}
 
-	return &imageupdate.Response{
+	result := &imageupdate.Response{
 		HasUpdate:      hasDigestUpdate,
 		UpdateType:     "digest",
 		CurrentDigest:  localDigest,
 		LatestDigest:   remoteDigest,
-		CheckTime:      time.Now(),
-		ResponseTimeMs: int(time.Since(start).Milliseconds()),
 		AuthMethod:     auth.Method,
 		AuthUsername:   auth.Username,
 		AuthRegistry:   auth.Registry,
 		UsedCredential: auth.Method == "credential",
-	}, snapshot
+	}
+	if hasDigestUpdate {
+		s.checkPlatformManifestInternal(ctx, rc, parts, normalizedRepo, token, snapshot, result)
+	}
+	result.CheckTime = time.Now()
+	result.ResponseTimeMs = int(time.Since(start).Milliseconds())
+
+	return result, snapshot
 }
 
 func (s *ImageUpdateService) CheckMultipleImages(ctx context.Context, imageRefs []string, externalCreds []containerregistry.Credential) (map[string]*imageupdate.Response, error) {
@@ -1012,10 +1254,21 @@ func (s *ImageUpdateService) CheckMultipleImages(ctx context.Context, imageRefs
 			// Convert unnotified records to the format expected by notification service
 			updatesToNotify := make(map[string]*imageupdate.Response)
 			imageIDsToMark := make([]string, 0, len(unnotifiedUpdates))
+			optedOut := s.optedOutImageIDsInternal(notifCtx)
+			snoozed := snoozedImageRefsInternal(notifCtx, s.db)
 
 			for imageID, record := range unnotifiedUpdates {
+				if _, skip := optedOut[imageID]; skip {
+					// Mark as notified so the update does not resurface on every run.
+					imageIDsToMark = append(imageIDsToMark, imageID)
+					continue
+				}
 				// Construct image ref from repository and tag
 				imageRef := fmt.Sprintf("%s:%s", record.Repository, record.Tag)
+				if _, skip := snoozed[imageRef]; skip {
+					// Left unnotified so the update is sent once the snooze ends.
+					continue
+				}
 				updatesToNotify[imageRef] = &imageupdate.Response{
 					HasUpdate:      record.HasUpdate,
 					UpdateType:     record.UpdateType,
@@ -1036,7 +1289,11 @@ func (s *ImageUpdateService) CheckMultipleImages(ctx context.Context, imageRefs
 
 			slog.InfoContext(ctx, "Sending notifications for unnotified updates", "count", len(updatesToNotify))
 
-			if notifErr := s.notificationService.SendBatchImageUpdateNotification(notifCtx, updatesToNotify); notifErr != nil {
+			if len(updatesToNotify) == 0 {
+				if markErr := s.MarkUpdatesAsNotified(notifCtx, imageIDsToMark); markErr != nil {
+					slog.WarnContext(ctx, "Failed to mark updates as notified", "error", markErr.Error())
+				}
+			} else if notifErr := s.notificationService.SendBatchImageUpdateNotification(notifCtx, updatesToNotify); notifErr != nil {
 				slog.WarnContext(ctx, "Failed to send batch update notification", "error", notifErr.Error())
 			} else {
 				// Mark the images as notified only if notification was successful
@@ -1074,6 +1331,42 @@ func (s *ImageUpdateService) CheckAllImages(ctx context.Context, limit int, exte
 	return results, nil
 }
 
+// optedOutImageIDsInternal returns the IDs of images whose containers have all
+// opted out of updates by label (Arcane or Watchtower). Images without
+// containers are not included.
+func (s *ImageUpdateService) optedOutImageIDsInternal(ctx context.Context) map[string]struct{} {
+	if s.dockerService == nil {
+		return nil
+	}
+	dockerClient, err := s.dockerService.GetClient(ctx)
+	if err != nil {
+		slog.DebugContext(ctx, "Skipping label-based notification filtering", "error", err)
+		return nil
+	}
+	listResult, err := dockerClient.ContainerList(ctx, client.ContainerListOptions{All: true})
+	if err != nil {
+		slog.DebugContext(ctx, "Skipping label-based notification filtering", "error", err)
+		return nil
+	}
+
+	optedOut := map[string]struct{}{}
+	wanted := map[string]struct{}{}
+	for _, c := range listResult.Items {
+		if c.ImageID == "" {
+			continue
+		}
+		if arcaneupdater.IsUpdateDisabled(c.Labels) {
+			optedOut[c.ImageID] = struct{}{}
+		} else {
+			wanted[c.ImageID] = struct{}{}
+		}
+	}
+	for id := range wanted {
+		delete(optedOut, id)
+	}
+	return optedOut
+}
+
 func (s *ImageUpdateService) CleanupOrphanedRecords(ctx context.Context) error {
 	if s.db == nil {
 		return nil
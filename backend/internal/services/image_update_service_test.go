@@ -664,6 +664,48 @@ func TestImageUpdateService_MarkUpdatesAsNotified_EmptyList(t *testing.T) {
 	require.NoError(t, err)
 }
 
+// TestSnoozeUpdates tests snoozing and unsnoozing the updates of a repository and tag
+func TestImageUpdateService_SnoozeUpdates(t *testing.T) {
+	ctx := context.Background()
+	db := setupImageUpdateTestDB(t)
+	svc := &ImageUpdateService{db: db}
+
+	for _, rec := range []models.ImageUpdateRecord{
+		{ID: "sha256:img1", Repository: "nginx", Tag: "1.27", HasUpdate: true},
+		{ID: "sha256:img2", Repository: "nginx", Tag: "1.27", HasUpdate: true},
+		{ID: "sha256:img3", Repository: "nginx", Tag: "latest", HasUpdate: true},
+	} {
+		require.NoError(t, db.Create(&rec).Error)
+	}
+
+	until := time.Now().Add(48 * time.Hour)
+	snooze, err := svc.SnoozeUpdates(ctx, "docker.io/library/nginx:1.27", until)
+	require.NoError(t, err)
+	assert.Equal(t, "nginx", snooze.Repository)
+	assert.Equal(t, "1.27", snooze.Tag)
+
+	snoozed := snoozedImageRefsInternal(ctx, db)
+	assert.Contains(t, snoozed, "nginx:1.27")
+	assert.NotContains(t, snoozed, "nginx:latest")
+	assert.Contains(t, snoozed, imageSnoozeKeyInternal("nginx:1.27"))
+
+	list, err := svc.ListSnoozedUpdates(ctx)
+	require.NoError(t, err)
+	require.Len(t, list, 1, "records of the same repository and tag are listed once")
+
+	_, err = svc.SnoozeUpdates(ctx, "nginx:1.27", time.Now().Add(-time.Hour))
+	var validationErr *models.ValidationError
+	assert.ErrorAs(t, err, &validationErr)
+
+	_, err = svc.SnoozeUpdates(ctx, "redis:7", until)
+	var notFoundErr *models.NotFoundError
+	assert.ErrorAs(t, err, &notFoundErr)
+
+	require.NoError(t, svc.UnsnoozeUpdates(ctx, "nginx:1.27"))
+	assert.Empty(t, snoozedImageRefsInternal(ctx, db))
+	assert.ErrorAs(t, svc.UnsnoozeUpdates(ctx, "nginx:1.27"), &notFoundErr)
+}
+
 func TestImageUpdateService_GetUpdateSummaryForImageIDs_FiltersToLiveImages(t *testing.T) {
 	ctx := context.Background()
 	db := setupImageUpdateTestDB(t)
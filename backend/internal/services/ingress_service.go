@@ -0,0 +1,124 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/proxylabels"
+	"github.com/getarcaneapp/arcane/types/ingress"
+	"github.com/moby/moby/client"
+)
+
+// IngressService inspects the Traefik and Caddy labels of running containers
+// and generates labels for new routes.
+type IngressService struct {
+	dockerService *DockerClientService
+}
+
+func NewIngressService(dockerService *DockerClientService) *IngressService {
+	return &IngressService{dockerService: dockerService}
+}
+
+// AnalyzeRoutes returns every reverse proxy route declared by running
+// containers together with the conflicts between them.
+func (s *IngressService) AnalyzeRoutes(ctx context.Context) (*ingress.Analysis, error) {
+	containers, err := s.listContainersInternal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	routes := proxylabels.ParseRoutes(containers)
+	entryPoints := traefikEntryPointsInternal(containers)
+	return &ingress.Analysis{
+		Routes:      routes,
+		Conflicts:   proxylabels.DetectConflicts(routes, entryPoints),
+		EntryPoints: entryPoints,
+	}, nil
+}
+
+// GenerateLabels returns labels for the requested route, along with any
+// conflicts the route would have with running containers.
+func (s *IngressService) GenerateLabels(ctx context.Context, req ingress.GenerateLabelsRequest) (*ingress.GeneratedLabels, error) {
+	labels, err := proxylabels.GenerateLabels(req)
+	if err != nil {
+		return nil, &models.ValidationError{Message: err.Error()}
+	}
+
+	containers, err := s.listContainersInternal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the new route against the others as if it were already running,
+	// keeping only the conflicts it takes part in. Running containers of the
+	// same service are excluded, since the new labels would replace theirs.
+	candidate := proxylabels.Container{ID: "new", Name: req.Service + " (new)", Labels: labels}
+	others := slices.DeleteFunc(containers, func(c proxylabels.Container) bool {
+		return c.Labels["com.docker.compose.service"] == req.Service
+	})
+	routes := proxylabels.ParseRoutes(append(others, candidate))
+
+	conflicts := []ingress.Conflict{}
+	for _, conflict := range proxylabels.DetectConflicts(routes, traefikEntryPointsInternal(others)) {
+		if slices.Contains(conflict.ContainerNames, candidate.Name) {
+			conflicts = append(conflicts, conflict)
+		}
+	}
+
+	return &ingress.GeneratedLabels{
+		Labels:    labels,
+		Compose:   proxylabels.ComposeLabels(labels),
+		Conflicts: conflicts,
+	}, nil
+}
+
+func (s *IngressService) listContainersInternal(ctx context.Context) ([]proxylabels.Container, error) {
+	dockerClient, err := s.dockerService.GetClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to Docker: %w", err)
+	}
+
+	// Stopped containers do not receive traffic, so only running ones can
+	// conflict.
+	containerList, err := dockerClient.ContainerList(ctx, client.ContainerListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Docker containers: %w", err)
+	}
+
+	containers := make([]proxylabels.Container, 0, len(containerList.Items))
+	for _, c := range containerList.Items {
+		name := c.ID
+		if len(c.Names) > 0 {
+			name = strings.TrimPrefix(c.Names[0], "/")
+		}
+		containers = append(containers, proxylabels.Container{
+			ID:      c.ID,
+			Name:    name,
+			Image:   c.Image,
+			Command: strings.Fields(c.Command),
+			Labels:  c.Labels,
+		})
+	}
+	return containers, nil
+}
+
+// traefikEntryPointsInternal returns the entrypoints defined by the running
+// Traefik containers, or nil when none define them on the command line.
+func traefikEntryPointsInternal(containers []proxylabels.Container) []string {
+	var entryPoints []string
+	for _, c := range containers {
+		eps, ok := proxylabels.ParseTraefikEntryPoints(c)
+		if !ok {
+			continue
+		}
+		for _, ep := range eps {
+			if !slices.Contains(entryPoints, ep) {
+				entryPoints = append(entryPoints, ep)
+			}
+		}
+	}
+	return entryPoints
+}
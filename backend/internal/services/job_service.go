@@ -63,6 +63,9 @@ func (s *JobService) GetJobSchedules(ctx context.Context) jobschedule.Config {
 		GitopsSyncInterval:         s.settings.GetStringSetting(ctx, "gitopsSyncInterval", "0 */1 * * * *"),
 		VulnerabilityScanInterval:  s.settings.GetStringSetting(ctx, "vulnerabilityScanInterval", "0 0 0 * * *"),
 		AutoHealInterval:           s.settings.GetStringSetting(ctx, "autoHealInterval", "*/30 * * * * *"),
+		ConfigBackupInterval:       s.settings.GetStringSetting(ctx, "configBackupInterval", "0 0 3 * * *"),
+		ImageRetentionInterval:     s.settings.GetStringSetting(ctx, "imageRetentionInterval", "0 0 4 * * *"),
+		StaleResourcesInterval:     s.settings.GetStringSetting(ctx, "staleResourcesInterval", "0 0 5 * * *"),
 	}
 }
 
@@ -90,6 +93,9 @@ func (s *JobService) UpdateJobSchedules(ctx context.Context, updates jobschedule
 		{key: "gitopsSyncInterval", current: current.GitopsSyncInterval, update: updates.GitopsSyncInterval},
 		{key: "vulnerabilityScanInterval", current: current.VulnerabilityScanInterval, update: updates.VulnerabilityScanInterval},
 		{key: "autoHealInterval", current: current.AutoHealInterval, update: updates.AutoHealInterval},
+		{key: "configBackupInterval", current: current.ConfigBackupInterval, update: updates.ConfigBackupInterval},
+		{key: "imageRetentionInterval", current: current.ImageRetentionInterval, update: updates.ImageRetentionInterval},
+		{key: "staleResourcesInterval", current: current.StaleResourcesInterval, update: updates.StaleResourcesInterval},
 	}
 
 	// Validate inputs (cron expressions)
@@ -231,6 +237,9 @@ func (s *JobService) getJobScheduleInternal(ctx context.Context, meta meta.JobMe
 		"gitopsSyncInterval":         "0 */1 * * * *",
 		"vulnerabilityScanInterval":  "0 0 0 * * *",
 		"autoHealInterval":           "*/30 * * * * *",
+		"configBackupInterval":       "0 0 3 * * *",
+		"imageRetentionInterval":     "0 0 4 * * *",
+		"staleResourcesInterval":     "0 0 5 * * *",
 	}
 
 	defaultSchedule := defaultSchedules[meta.SettingsKey]
@@ -0,0 +1,539 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/getarcaneapp/arcane/backend/internal/database"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/logmatch"
+	containertypes "github.com/getarcaneapp/arcane/types/container"
+	"github.com/getarcaneapp/arcane/types/logalert"
+	"github.com/moby/moby/client"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrLogAlertRuleNotFound = errors.New("log alert rule not found")
+	ErrLogAlertRuleInvalid  = errors.New("invalid log alert rule")
+)
+
+const (
+	defaultLogAlertCooldownSeconds = 300
+	minLogAlertCooldownSeconds     = 10
+)
+
+// LogAlertService manages log alert rules and keeps the containers each
+// enabled rule watches attached to its matcher.
+type LogAlertService struct {
+	db                  *database.DB
+	dockerService       *DockerClientService
+	projectService      *ProjectService
+	eventService        *EventService
+	notificationService *NotificationService
+
+	mu       sync.Mutex
+	watchers map[string]*logAlertWatcher // rule ID -> running watcher
+}
+
+// logAlertWatcher follows the logs of a rule's containers and matches every
+// line against the rule's pattern.
+type logAlertWatcher struct {
+	version string
+	rule    models.LogAlertRule
+	matcher *logmatch.Matcher
+	ctx     context.Context
+	cancel  context.CancelFunc
+
+	mu       sync.Mutex
+	readers  map[string]*logReader // container ID -> log stream
+	lastSeen map[string]time.Time  // container ID -> timestamp of the last line read
+}
+
+// logAlertTarget is a container watched by a rule.
+type logAlertTarget struct {
+	id      string
+	name    string
+	service string
+}
+
+func NewLogAlertService(db *database.DB, dockerService *DockerClientService, projectService *ProjectService, eventService *EventService, notificationService *NotificationService) *LogAlertService {
+	return &LogAlertService{
+		db:                  db,
+		dockerService:       dockerService,
+		projectService:      projectService,
+		eventService:        eventService,
+		notificationService: notificationService,
+		watchers:            make(map[string]*logAlertWatcher),
+	}
+}
+
+func (s *LogAlertService) ListRules(ctx context.Context) ([]logalert.Rule, error) {
+	var rules []models.LogAlertRule
+	if err := s.db.WithContext(ctx).Order("name ASC").Find(&rules).Error; err != nil {
+		return nil, fmt.Errorf("failed to list log alert rules: %w", err)
+	}
+
+	result := make([]logalert.Rule, len(rules))
+	for i := range rules {
+		result[i] = s.toLogAlertRuleDtoInternal(&rules[i])
+	}
+	return result, nil
+}
+
+func (s *LogAlertService) GetRule(ctx context.Context, id string) (*logalert.Rule, error) {
+	rule, err := s.getRuleModelInternal(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	dto := s.toLogAlertRuleDtoInternal(rule)
+	return &dto, nil
+}
+
+func (s *LogAlertService) CreateRule(ctx context.Context, req logalert.CreateRule) (*logalert.Rule, error) {
+	rule := &models.LogAlertRule{
+		Name:            strings.TrimSpace(req.Name),
+		Pattern:         req.Pattern,
+		ProjectID:       normalizeScopeID(req.ProjectID),
+		ContainerName:   normalizeLogAlertContainerName(req.ContainerName),
+		Services:        models.StringSlice(normalizeServiceNames(req.Services)),
+		CooldownSeconds: req.CooldownSeconds,
+		Enabled:         req.Enabled == nil || *req.Enabled,
+	}
+	if rule.CooldownSeconds <= 0 {
+		rule.CooldownSeconds = defaultLogAlertCooldownSeconds
+	}
+	if err := s.validateRuleInternal(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	if err := s.db.WithContext(ctx).Create(rule).Error; err != nil {
+		return nil, fmt.Errorf("failed to create log alert rule: %w", err)
+	}
+
+	dto := s.toLogAlertRuleDtoInternal(rule)
+	return &dto, nil
+}
+
+// UpdateRule updates a rule. Running watchers pick up the change on the next
+// reconcile.
+func (s *LogAlertService) UpdateRule(ctx context.Context, id string, req logalert.UpdateRule) (*logalert.Rule, error) {
+	rule, err := s.getRuleModelInternal(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		rule.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Pattern != nil {
+		rule.Pattern = *req.Pattern
+	}
+	if req.ProjectID != nil {
+		rule.ProjectID = normalizeScopeID(req.ProjectID)
+	}
+	if req.ContainerName != nil {
+		rule.ContainerName = normalizeLogAlertContainerName(req.ContainerName)
+	}
+	if req.Services != nil {
+		rule.Services = models.StringSlice(normalizeServiceNames(*req.Services))
+	}
+	if req.CooldownSeconds != nil && *req.CooldownSeconds > 0 {
+		rule.CooldownSeconds = *req.CooldownSeconds
+	}
+	if req.Enabled != nil {
+		rule.Enabled = *req.Enabled
+	}
+	if !rule.Enabled {
+		rule.LastError = nil
+	}
+	if err := s.validateRuleInternal(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	if err := s.db.WithContext(ctx).Save(rule).Error; err != nil {
+		return nil, fmt.Errorf("failed to update log alert rule: %w", err)
+	}
+
+	dto := s.toLogAlertRuleDtoInternal(rule)
+	return &dto, nil
+}
+
+func (s *LogAlertService) DeleteRule(ctx context.Context, id string) error {
+	result := s.db.WithContext(ctx).Delete(&models.LogAlertRule{}, "id = ?", id)
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete log alert rule: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrLogAlertRuleNotFound
+	}
+	return nil
+}
+
+// Reconcile starts watching the running containers of every enabled rule and
+// stops watching containers and rules that are gone, disabled or changed.
+// Log streams started here live until ctx is done.
+func (s *LogAlertService) Reconcile(ctx context.Context) error {
+	var rules []models.LogAlertRule
+	if err := s.db.WithContext(ctx).Where("enabled = ?", true).Find(&rules).Error; err != nil {
+		return fmt.Errorf("failed to load log alert rules: %w", err)
+	}
+
+	wanted := make(map[string]string, len(rules))
+	for i := range rules {
+		wanted[rules[i].ID] = logAlertRuleVersion(&rules[i])
+	}
+	s.mu.Lock()
+	for id, w := range s.watchers {
+		if version, ok := wanted[id]; !ok || version != w.version {
+			w.cancel()
+			delete(s.watchers, id)
+		}
+	}
+	s.mu.Unlock()
+
+	if len(rules) == 0 {
+		return nil
+	}
+
+	dockerClient, err := s.dockerService.GetClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to connect to Docker: %w", err)
+	}
+
+	for i := range rules {
+		rule := &rules[i]
+		reconcileErr := s.reconcileRuleInternal(ctx, dockerClient, rule)
+		if reconcileErr != nil {
+			slog.WarnContext(ctx, "Failed to reconcile log alert rule", "rule", rule.Name, "error", reconcileErr)
+		}
+		s.persistErrorInternal(ctx, rule, reconcileErr)
+	}
+	return nil
+}
+
+func (s *LogAlertService) reconcileRuleInternal(ctx context.Context, dockerClient *client.Client, rule *models.LogAlertRule) error {
+	w, err := s.ensureWatcherInternal(ctx, rule)
+	if err != nil {
+		return err
+	}
+
+	targets, err := s.resolveTargetsInternal(ctx, dockerClient, rule)
+	if err != nil {
+		return err
+	}
+
+	running := make(map[string]struct{}, len(targets))
+	for _, target := range targets {
+		running[target.id] = struct{}{}
+		s.attachContainerInternal(dockerClient, w, target)
+	}
+
+	w.mu.Lock()
+	for id, r := range w.readers {
+		if _, ok := running[id]; !ok {
+			r.cancel()
+			delete(w.readers, id)
+		}
+	}
+	for id := range w.lastSeen {
+		if _, ok := running[id]; !ok {
+			delete(w.lastSeen, id)
+		}
+	}
+	w.mu.Unlock()
+	return nil
+}
+
+// resolveTargetsInternal lists the running containers a rule watches: the
+// containers of its project, optionally limited to some services, or the
+// single container with its name.
+func (s *LogAlertService) resolveTargetsInternal(ctx context.Context, dockerClient *client.Client, rule *models.LogAlertRule) ([]logAlertTarget, error) {
+	filters := make(client.Filters)
+	if rule.ProjectID != nil {
+		proj, err := s.projectService.GetProjectFromDatabaseByID(ctx, *rule.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		filters = filters.Add("label", "com.docker.compose.project="+normalizeComposeProjectName(proj.Name))
+	} else {
+		filters = filters.Add("name", "^/"+regexp.QuoteMeta(stringPtrValue(rule.ContainerName))+"$")
+	}
+
+	list, err := dockerClient.ContainerList(ctx, client.ContainerListOptions{Filters: filters})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list containers: %w", err)
+	}
+
+	targets := make([]logAlertTarget, 0, len(list.Items))
+	for _, c := range list.Items {
+		name := c.ID
+		if len(c.Names) > 0 {
+			name = strings.TrimPrefix(c.Names[0], "/")
+		}
+		service := c.Labels["com.docker.compose.service"]
+		if rule.ProjectID != nil && len(rule.Services) > 0 && !slices.Contains(rule.Services, service) {
+			continue
+		}
+		targets = append(targets, logAlertTarget{id: c.ID, name: name, service: service})
+	}
+	return targets, nil
+}
+
+func (s *LogAlertService) ensureWatcherInternal(ctx context.Context, rule *models.LogAlertRule) (*logAlertWatcher, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if w, ok := s.watchers[rule.ID]; ok {
+		return w, nil
+	}
+
+	var lastFired time.Time
+	if rule.LastFiredAt != nil {
+		lastFired = *rule.LastFiredAt
+	}
+	matcher, err := logmatch.NewMatcher(rule.Pattern, time.Duration(rule.CooldownSeconds)*time.Second, lastFired)
+	if err != nil {
+		return nil, err
+	}
+
+	watcherCtx, cancel := context.WithCancel(ctx)
+	w := &logAlertWatcher{
+		version:  logAlertRuleVersion(rule),
+		rule:     *rule,
+		matcher:  matcher,
+		ctx:      watcherCtx,
+		cancel:   cancel,
+		readers:  make(map[string]*logReader),
+		lastSeen: make(map[string]time.Time),
+	}
+	s.watchers[rule.ID] = w
+	return w, nil
+}
+
+// attachContainerInternal starts following a container's logs unless it is
+// already followed. A container that was followed before resumes after the
+// last line read; a new one only matches lines written from now on.
+func (s *LogAlertService) attachContainerInternal(dockerClient *client.Client, w *logAlertWatcher, target logAlertTarget) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if _, ok := w.readers[target.id]; ok {
+		return
+	}
+
+	since := time.Now()
+	if last, ok := w.lastSeen[target.id]; ok {
+		// Docker includes lines at exactly the since time.
+		since = last.Add(time.Nanosecond)
+	}
+
+	readerCtx, cancel := context.WithCancel(w.ctx)
+	r := &logReader{cancel: cancel}
+	w.readers[target.id] = r
+
+	go func() {
+		defer cancel()
+		err := followContainerLogLinesInternal(readerCtx, dockerClient, target.id, since, func(line containertypes.LogLine) error {
+			at := time.Now()
+			if ts, err := time.Parse(time.RFC3339Nano, line.Timestamp); err == nil {
+				at = ts
+			}
+
+			w.mu.Lock()
+			w.lastSeen[target.id] = at
+			w.mu.Unlock()
+
+			if excerpt, fired := w.matcher.Match(line.Message, at); fired {
+				s.fireInternal(readerCtx, &w.rule, target, excerpt, at)
+			}
+			return nil
+		})
+		if err != nil && readerCtx.Err() == nil {
+			slog.DebugContext(w.ctx, "Log alert stream ended", "container", target.name, "error", err)
+		}
+
+		w.mu.Lock()
+		if w.readers[target.id] == r {
+			delete(w.readers, target.id)
+		}
+		w.mu.Unlock()
+	}()
+}
+
+// fireInternal records that a rule matched and sends the alert. Columns are
+// updated without hooks so that UpdatedAt, which versions the rule, is left
+// alone.
+func (s *LogAlertService) fireInternal(ctx context.Context, rule *models.LogAlertRule, target logAlertTarget, excerpt string, at time.Time) {
+	updates := map[string]any{
+		"last_fired_at": at,
+		"last_excerpt":  excerpt,
+	}
+	if err := s.db.WithContext(ctx).Model(&models.LogAlertRule{}).Where("id = ?", rule.ID).UpdateColumns(updates).Error; err != nil {
+		slog.WarnContext(ctx, "Failed to record log alert", "rule", rule.Name, "error", err)
+	}
+
+	title := fmt.Sprintf("Log alert '%s' triggered", rule.Name)
+	message := fmt.Sprintf("%s logged a line matching %s:\n%s", target.name, rule.Pattern, excerpt)
+	metadata := models.JSON{
+		"ruleId":        rule.ID,
+		"pattern":       rule.Pattern,
+		"containerId":   target.id,
+		"containerName": target.name,
+		"excerpt":       excerpt,
+	}
+	if rule.ProjectID != nil {
+		metadata["projectId"] = *rule.ProjectID
+	}
+	if target.service != "" {
+		metadata["serviceName"] = target.service
+	}
+
+	if s.eventService != nil {
+		resourceType := "log_alert_rule"
+		_, err := s.eventService.CreateEvent(ctx, CreateEventRequest{
+			Type:         models.EventTypeLogAlertTriggered,
+			Severity:     s.eventService.getEventSeverity(models.EventTypeLogAlertTriggered),
+			Title:        title,
+			Description:  message,
+			ResourceType: &resourceType,
+			ResourceID:   &rule.ID,
+			ResourceName: &rule.Name,
+			Metadata:     metadata,
+		})
+		if err != nil {
+			slog.WarnContext(ctx, "Failed to record log alert event", "rule", rule.Name, "error", err)
+		}
+	}
+
+	if s.notificationService != nil {
+		err := s.notificationService.SendAlertNotification(ctx, AlertNotification{
+			EventType: models.NotificationEventLogAlert,
+			Subject:   target.name,
+			Title:     title,
+			Message:   message,
+			Metadata:  metadata,
+		})
+		if err != nil {
+			slog.WarnContext(ctx, "Failed to send log alert notification", "rule", rule.Name, "error", err)
+		}
+	}
+}
+
+// persistErrorInternal stores why a rule's containers could not be resolved
+// when that changed.
+func (s *LogAlertService) persistErrorInternal(ctx context.Context, rule *models.LogAlertRule, reconcileErr error) {
+	var lastErr string
+	if reconcileErr != nil {
+		lastErr = reconcileErr.Error()
+	}
+	if stringPtrValue(rule.LastError) == lastErr {
+		return
+	}
+
+	var value any
+	if lastErr != "" {
+		value = lastErr
+	}
+	if err := s.db.WithContext(ctx).Model(&models.LogAlertRule{}).Where("id = ?", rule.ID).UpdateColumn("last_error", value).Error; err != nil {
+		slog.WarnContext(ctx, "Failed to record log alert rule status", "rule", rule.Name, "error", err)
+	}
+}
+
+func (s *LogAlertService) getRuleModelInternal(ctx context.Context, id string) (*models.LogAlertRule, error) {
+	var rule models.LogAlertRule
+	if err := s.db.WithContext(ctx).Where("id = ?", id).First(&rule).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrLogAlertRuleNotFound
+		}
+		return nil, fmt.Errorf("failed to get log alert rule: %w", err)
+	}
+	return &rule, nil
+}
+
+func (s *LogAlertService) validateRuleInternal(ctx context.Context, rule *models.LogAlertRule) error {
+	if rule.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrLogAlertRuleInvalid)
+	}
+	if _, err := logmatch.Compile(rule.Pattern); err != nil {
+		return fmt.Errorf("%w: %w", ErrLogAlertRuleInvalid, err)
+	}
+	if rule.CooldownSeconds < minLogAlertCooldownSeconds {
+		return fmt.Errorf("%w: cooldown must be at least %d seconds", ErrLogAlertRuleInvalid, minLogAlertCooldownSeconds)
+	}
+
+	switch {
+	case rule.ProjectID == nil && rule.ContainerName == nil:
+		return fmt.Errorf("%w: a project or a container name is required", ErrLogAlertRuleInvalid)
+	case rule.ProjectID != nil && rule.ContainerName != nil:
+		return fmt.Errorf("%w: set either a project or a container name, not both", ErrLogAlertRuleInvalid)
+	case rule.ContainerName != nil:
+		if strings.ContainsAny(*rule.ContainerName, " \t\n") {
+			return fmt.Errorf("%w: container name must not contain whitespace", ErrLogAlertRuleInvalid)
+		}
+		if len(rule.Services) > 0 {
+			return fmt.Errorf("%w: services can only be set for project rules", ErrLogAlertRuleInvalid)
+		}
+	default:
+		if _, err := s.projectService.GetProjectFromDatabaseByID(ctx, *rule.ProjectID); err != nil {
+			return fmt.Errorf("%w: %w", ErrLogAlertRuleInvalid, err)
+		}
+	}
+	return nil
+}
+
+func (s *LogAlertService) toLogAlertRuleDtoInternal(rule *models.LogAlertRule) logalert.Rule {
+	attached := 0
+	s.mu.Lock()
+	if w, ok := s.watchers[rule.ID]; ok && w.version == logAlertRuleVersion(rule) {
+		w.mu.Lock()
+		attached = len(w.readers)
+		w.mu.Unlock()
+	}
+	s.mu.Unlock()
+
+	services := []string(rule.Services)
+	if services == nil {
+		services = []string{}
+	}
+	return logalert.Rule{
+		ID:                 rule.ID,
+		Name:               rule.Name,
+		Pattern:            rule.Pattern,
+		ProjectID:          rule.ProjectID,
+		Services:           services,
+		ContainerName:      rule.ContainerName,
+		CooldownSeconds:    rule.CooldownSeconds,
+		Enabled:            rule.Enabled,
+		AttachedContainers: attached,
+		LastFiredAt:        rule.LastFiredAt,
+		LastExcerpt:        rule.LastExcerpt,
+		LastError:          rule.LastError,
+		CreatedAt:          rule.CreatedAt,
+		UpdatedAt:          rule.UpdatedAt,
+	}
+}
+
+// logAlertRuleVersion identifies a saved rule so that running watchers are
+// restarted after it changes.
+func logAlertRuleVersion(rule *models.LogAlertRule) string {
+	if rule.UpdatedAt != nil {
+		return rule.UpdatedAt.String()
+	}
+	return rule.CreatedAt.String()
+}
+
+func normalizeLogAlertContainerName(name *string) *string {
+	if name == nil {
+		return nil
+	}
+	trimmed := strings.TrimPrefix(strings.TrimSpace(*name), "/")
+	return normalizeScopeID(&trimmed)
+}
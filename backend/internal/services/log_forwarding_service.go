@@ -0,0 +1,544 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/getarcaneapp/arcane/backend/internal/database"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/crypto"
+	dockerutils "github.com/getarcaneapp/arcane/backend/internal/utils/docker"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/logship"
+	"github.com/getarcaneapp/arcane/backend/pkg/utils/stdcopy"
+	containertypes "github.com/getarcaneapp/arcane/types/container"
+	"github.com/getarcaneapp/arcane/types/logforward"
+	"github.com/moby/moby/client"
+	"gorm.io/gorm"
+)
+
+const (
+	logForwardBatchSize     = 500
+	logForwardBufferSize    = 2048
+	logForwardFlushInterval = 2 * time.Second
+	logForwardSendTimeout   = 10 * time.Second
+)
+
+// LogForwardingService manages per-project log forwarding and keeps the
+// containers of each configured project attached to its sink.
+type LogForwardingService struct {
+	db             *database.DB
+	dockerService  *DockerClientService
+	projectService *ProjectService
+	httpClient     *http.Client
+
+	mu       sync.Mutex
+	shippers map[string]*logShipper // forwarder ID -> running shipper
+}
+
+// logShipper batches the lines read from a forwarder's containers and
+// delivers them to its sink.
+type logShipper struct {
+	version string
+	sink    logship.Sink
+	entries chan logship.Entry
+	ctx     context.Context
+	cancel  context.CancelFunc
+	done    chan struct{}
+
+	mu          sync.Mutex
+	readers     map[string]*logReader // container ID -> log stream
+	lastSeen    map[string]time.Time  // container ID -> timestamp of the last line read
+	lastErr     string
+	lastShipped time.Time
+}
+
+type logReader struct {
+	cancel context.CancelFunc
+}
+
+func NewLogForwardingService(db *database.DB, dockerService *DockerClientService, projectService *ProjectService) *LogForwardingService {
+	return &LogForwardingService{
+		db:             db,
+		dockerService:  dockerService,
+		projectService: projectService,
+		httpClient:     &http.Client{Timeout: logForwardSendTimeout},
+		shippers:       make(map[string]*logShipper),
+	}
+}
+
+// GetProjectForwarder returns the log forwarding configuration of a project.
+func (s *LogForwardingService) GetProjectForwarder(ctx context.Context, projectID string) (*logforward.LogForwarder, error) {
+	fw, err := s.getForwarderModelInternal(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	dto := s.toLogForwarderDtoInternal(fw)
+	return &dto, nil
+}
+
+// UpsertProjectForwarder creates or replaces the log forwarding configuration
+// of a project. Running forwarders pick up the change on the next reconcile.
+func (s *LogForwardingService) UpsertProjectForwarder(ctx context.Context, projectID string, req logforward.UpsertLogForwarder) (*logforward.LogForwarder, error) {
+	if _, err := s.projectService.GetProjectFromDatabaseByID(ctx, projectID); err != nil {
+		return nil, &models.NotFoundError{Message: err.Error()}
+	}
+
+	fw, err := s.getForwarderModelInternal(ctx, projectID)
+	var notFound *models.NotFoundError
+	switch {
+	case errors.As(err, &notFound):
+		fw = &models.LogForwarder{ProjectID: projectID}
+	case err != nil:
+		return nil, err
+	}
+
+	fw.SinkType = req.SinkType
+	fw.Target = strings.TrimSpace(req.Target)
+	fw.FileMaxSizeMB = req.FileMaxSizeMB
+	fw.FileMaxFiles = req.FileMaxFiles
+	fw.Enabled = req.Enabled == nil || *req.Enabled
+	fw.Services = models.StringSlice(normalizeServiceNames(req.Services))
+	if req.Token != nil {
+		fw.Token = nil
+		if token := strings.TrimSpace(*req.Token); token != "" {
+			encrypted, err := crypto.Encrypt(token)
+			if err != nil {
+				return nil, fmt.Errorf("failed to encrypt log forwarding token: %w", err)
+			}
+			fw.Token = &encrypted
+		}
+	}
+	if !fw.Enabled {
+		fw.LastError = nil
+	}
+
+	cfg := logship.Config{Type: fw.SinkType, Target: fw.Target, MaxSizeMB: fw.FileMaxSizeMB, MaxFiles: fw.FileMaxFiles}
+	if err := cfg.Validate(); err != nil {
+		return nil, &models.ValidationError{Message: err.Error(), Field: "target"}
+	}
+
+	if err := s.db.WithContext(ctx).Save(fw).Error; err != nil {
+		return nil, fmt.Errorf("failed to save log forwarder: %w", err)
+	}
+
+	dto := s.toLogForwarderDtoInternal(fw)
+	return &dto, nil
+}
+
+// DeleteProjectForwarder removes the log forwarding configuration of a project.
+func (s *LogForwardingService) DeleteProjectForwarder(ctx context.Context, projectID string) error {
+	result := s.db.WithContext(ctx).Where("project_id = ?", projectID).Delete(&models.LogForwarder{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete log forwarder: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return &models.NotFoundError{Message: "log forwarding is not configured for this project"}
+	}
+	return nil
+}
+
+// Reconcile starts forwarding for the running containers of every enabled
+// forwarder and stops it for containers and forwarders that are gone,
+// disabled or reconfigured. Log streams started here live until ctx is done,
+// at which point queued lines are flushed to the sinks.
+func (s *LogForwardingService) Reconcile(ctx context.Context) error {
+	var forwarders []models.LogForwarder
+	if err := s.db.WithContext(ctx).Where("enabled = ?", true).Find(&forwarders).Error; err != nil {
+		return fmt.Errorf("failed to load log forwarders: %w", err)
+	}
+
+	wanted := make(map[string]string, len(forwarders))
+	for i := range forwarders {
+		wanted[forwarders[i].ID] = logForwarderVersion(&forwarders[i])
+	}
+	var stale []*logShipper
+	s.mu.Lock()
+	for id, sh := range s.shippers {
+		if version, ok := wanted[id]; !ok || version != sh.version {
+			stale = append(stale, sh)
+			delete(s.shippers, id)
+		}
+	}
+	s.mu.Unlock()
+	for _, sh := range stale {
+		sh.stop()
+	}
+
+	if len(forwarders) == 0 {
+		return nil
+	}
+
+	dockerClient, err := s.dockerService.GetClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to connect to Docker: %w", err)
+	}
+
+	for i := range forwarders {
+		fw := &forwarders[i]
+		reconcileErr := s.reconcileForwarderInternal(ctx, dockerClient, fw)
+		if reconcileErr != nil {
+			slog.WarnContext(ctx, "Failed to reconcile log forwarder", "projectId", fw.ProjectID, "error", reconcileErr)
+		}
+		s.persistStatusInternal(ctx, fw, reconcileErr)
+	}
+	return nil
+}
+
+func (s *LogForwardingService) reconcileForwarderInternal(ctx context.Context, dockerClient *client.Client, fw *models.LogForwarder) error {
+	sh, err := s.ensureShipperInternal(ctx, fw)
+	if err != nil {
+		return err
+	}
+
+	proj, err := s.projectService.GetProjectFromDatabaseByID(ctx, fw.ProjectID)
+	if err != nil {
+		return err
+	}
+	projectName := normalizeComposeProjectName(proj.Name)
+
+	filters := make(client.Filters).Add("label", "com.docker.compose.project="+projectName)
+	list, err := dockerClient.ContainerList(ctx, client.ContainerListOptions{Filters: filters})
+	if err != nil {
+		return fmt.Errorf("failed to list project containers: %w", err)
+	}
+
+	running := make(map[string]struct{}, len(list.Items))
+	for _, c := range list.Items {
+		service := c.Labels["com.docker.compose.service"]
+		if len(fw.Services) > 0 && !slices.Contains(fw.Services, service) {
+			continue
+		}
+		running[c.ID] = struct{}{}
+
+		name := c.ID
+		if len(c.Names) > 0 {
+			name = strings.TrimPrefix(c.Names[0], "/")
+		}
+		s.attachContainerInternal(dockerClient, sh, c.ID, logship.Entry{Project: projectName, Service: service, Container: name})
+	}
+
+	sh.mu.Lock()
+	for id, r := range sh.readers {
+		if _, ok := running[id]; !ok {
+			r.cancel()
+			delete(sh.readers, id)
+		}
+	}
+	for id := range sh.lastSeen {
+		if _, ok := running[id]; !ok {
+			delete(sh.lastSeen, id)
+		}
+	}
+	sh.mu.Unlock()
+	return nil
+}
+
+func (s *LogForwardingService) ensureShipperInternal(ctx context.Context, fw *models.LogForwarder) (*logShipper, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if sh, ok := s.shippers[fw.ID]; ok {
+		return sh, nil
+	}
+
+	token := ""
+	if fw.Token != nil && *fw.Token != "" {
+		decrypted, err := crypto.Decrypt(*fw.Token)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt log forwarding token: %w", err)
+		}
+		token = decrypted
+	}
+	sink, err := logship.NewSink(logship.Config{
+		Type:      fw.SinkType,
+		Target:    fw.Target,
+		Token:     token,
+		MaxSizeMB: fw.FileMaxSizeMB,
+		MaxFiles:  fw.FileMaxFiles,
+	}, s.httpClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open log sink: %w", err)
+	}
+
+	shipperCtx, cancel := context.WithCancel(ctx)
+	sh := &logShipper{
+		version:  logForwarderVersion(fw),
+		sink:     sink,
+		entries:  make(chan logship.Entry, logForwardBufferSize),
+		ctx:      shipperCtx,
+		cancel:   cancel,
+		done:     make(chan struct{}),
+		readers:  make(map[string]*logReader),
+		lastSeen: make(map[string]time.Time),
+	}
+	go sh.run()
+
+	s.shippers[fw.ID] = sh
+	return sh, nil
+}
+
+// attachContainerInternal starts following a container's logs unless it is
+// already followed. A container that was followed before resumes after the
+// last line read; a new one only forwards lines written from now on.
+func (s *LogForwardingService) attachContainerInternal(dockerClient *client.Client, sh *logShipper, containerID string, template logship.Entry) {
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+
+	if _, ok := sh.readers[containerID]; ok {
+		return
+	}
+
+	since := time.Now()
+	if last, ok := sh.lastSeen[containerID]; ok {
+		// Docker includes lines at exactly the since time.
+		since = last.Add(time.Nanosecond)
+	}
+
+	readerCtx, cancel := context.WithCancel(sh.ctx)
+	r := &logReader{cancel: cancel}
+	sh.readers[containerID] = r
+
+	go func() {
+		defer cancel()
+		err := s.followContainerLogsInternal(readerCtx, dockerClient, sh, containerID, since, template)
+		if err != nil && readerCtx.Err() == nil {
+			slog.DebugContext(sh.ctx, "Log forwarding stream ended", "container", template.Container, "error", err)
+		}
+
+		sh.mu.Lock()
+		if sh.readers[containerID] == r {
+			delete(sh.readers, containerID)
+		}
+		sh.mu.Unlock()
+	}()
+}
+
+// followContainerLogsInternal streams a container's logs into the shipper
+// until the container stops or ctx is cancelled.
+func (s *LogForwardingService) followContainerLogsInternal(ctx context.Context, dockerClient *client.Client, sh *logShipper, containerID string, since time.Time, template logship.Entry) error {
+	return followContainerLogLinesInternal(ctx, dockerClient, containerID, since, func(line containertypes.LogLine) error {
+		entry := template
+		entry.Stream = line.Stream
+		entry.Message = line.Message
+		entry.Time = time.Now()
+		if ts, err := time.Parse(time.RFC3339Nano, line.Timestamp); err == nil {
+			entry.Time = ts
+		}
+
+		select {
+		case sh.entries <- entry:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		sh.mu.Lock()
+		sh.lastSeen[containerID] = entry.Time
+		sh.mu.Unlock()
+		return nil
+	})
+}
+
+// followContainerLogLinesInternal calls onLine for every line a container
+// logs from since on, until the container stops or ctx is cancelled. Lines
+// carry their Docker timestamp.
+func followContainerLogLinesInternal(ctx context.Context, dockerClient *client.Client, containerID string, since time.Time, onLine func(containertypes.LogLine) error) error {
+	inspect, err := dockerClient.ContainerInspect(ctx, containerID, client.ContainerInspectOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to inspect container: %w", err)
+	}
+
+	logs, err := dockerClient.ContainerLogs(ctx, containerID, client.ContainerLogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Follow:     true,
+		Since:      fmt.Sprintf("%d.%09d", since.Unix(), since.Nanosecond()),
+		Timestamps: true,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get container logs: %w", err)
+	}
+	defer func() { _ = logs.Close() }()
+
+	stdout := dockerutils.NewLogLineWriter(dockerutils.LogStreamStdout, onLine)
+	stderr := dockerutils.NewLogLineWriter(dockerutils.LogStreamStderr, onLine)
+
+	if inspect.Container.Config != nil && inspect.Container.Config.Tty {
+		_, err = io.Copy(stdout, logs)
+	} else {
+		_, err = stdcopy.StdCopy(stdout, stderr, logs)
+	}
+	if err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	if err := stdout.Flush(); err != nil {
+		return err
+	}
+	return stderr.Flush()
+}
+
+// persistStatusInternal stores the delivery status of a forwarder when it
+// changed. Columns are updated without hooks so that UpdatedAt, which
+// versions the configuration, is left alone.
+func (s *LogForwardingService) persistStatusInternal(ctx context.Context, fw *models.LogForwarder, reconcileErr error) {
+	var lastErr string
+	var lastShipped time.Time
+	s.mu.Lock()
+	sh := s.shippers[fw.ID]
+	s.mu.Unlock()
+	if sh != nil {
+		sh.mu.Lock()
+		lastErr, lastShipped = sh.lastErr, sh.lastShipped
+		sh.mu.Unlock()
+	}
+	if reconcileErr != nil {
+		lastErr = reconcileErr.Error()
+	}
+
+	updates := map[string]any{}
+	if current := stringPtrValue(fw.LastError); current != lastErr {
+		if lastErr == "" {
+			updates["last_error"] = nil
+		} else {
+			updates["last_error"] = lastErr
+		}
+	}
+	if !lastShipped.IsZero() && (fw.LastShippedAt == nil || lastShipped.Sub(*fw.LastShippedAt) >= time.Second) {
+		updates["last_shipped_at"] = lastShipped
+	}
+	if len(updates) == 0 {
+		return
+	}
+	if err := s.db.WithContext(ctx).Model(&models.LogForwarder{}).Where("id = ?", fw.ID).UpdateColumns(updates).Error; err != nil {
+		slog.WarnContext(ctx, "Failed to record log forwarder status", "projectId", fw.ProjectID, "error", err)
+	}
+}
+
+func (s *LogForwardingService) getForwarderModelInternal(ctx context.Context, projectID string) (*models.LogForwarder, error) {
+	var fw models.LogForwarder
+	if err := s.db.WithContext(ctx).Where("project_id = ?", projectID).First(&fw).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, &models.NotFoundError{Message: "log forwarding is not configured for this project"}
+		}
+		return nil, fmt.Errorf("failed to get log forwarder: %w", err)
+	}
+	return &fw, nil
+}
+
+func (s *LogForwardingService) toLogForwarderDtoInternal(fw *models.LogForwarder) logforward.LogForwarder {
+	attached := 0
+	s.mu.Lock()
+	if sh, ok := s.shippers[fw.ID]; ok && sh.version == logForwarderVersion(fw) {
+		sh.mu.Lock()
+		attached = len(sh.readers)
+		sh.mu.Unlock()
+	}
+	s.mu.Unlock()
+
+	services := []string(fw.Services)
+	if services == nil {
+		services = []string{}
+	}
+	return logforward.LogForwarder{
+		ID:                 fw.ID,
+		ProjectID:          fw.ProjectID,
+		SinkType:           fw.SinkType,
+		Target:             fw.Target,
+		HasToken:           fw.Token != nil && *fw.Token != "",
+		Services:           services,
+		FileMaxSizeMB:      fw.FileMaxSizeMB,
+		FileMaxFiles:       fw.FileMaxFiles,
+		Enabled:            fw.Enabled,
+		AttachedContainers: attached,
+		LastError:          fw.LastError,
+		LastShippedAt:      fw.LastShippedAt,
+		CreatedAt:          fw.CreatedAt,
+		UpdatedAt:          fw.UpdatedAt,
+	}
+}
+
+// logForwarderVersion identifies a saved configuration so that running
+// shippers are restarted after it changes.
+func logForwarderVersion(fw *models.LogForwarder) string {
+	if fw.UpdatedAt != nil {
+		return fw.UpdatedAt.String()
+	}
+	return fw.CreatedAt.String()
+}
+
+func normalizeServiceNames(names []string) []string {
+	out := make([]string, 0, len(names))
+	for _, name := range names {
+		if name = strings.TrimSpace(name); name != "" && !slices.Contains(out, name) {
+			out = append(out, name)
+		}
+	}
+	return out
+}
+
+// run delivers queued entries in batches until the shipper is stopped, then
+// flushes what is left and closes the sink.
+func (sh *logShipper) run() {
+	defer close(sh.done)
+
+	ticker := time.NewTicker(logForwardFlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]logship.Entry, 0, logForwardBatchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		sendCtx, cancel := context.WithTimeout(context.Background(), logForwardSendTimeout)
+		err := sh.sink.Send(sendCtx, batch)
+		cancel()
+
+		sh.mu.Lock()
+		if err != nil {
+			sh.lastErr = err.Error()
+		} else {
+			sh.lastErr = ""
+			sh.lastShipped = time.Now()
+		}
+		sh.mu.Unlock()
+		if err != nil {
+			slog.WarnContext(sh.ctx, "Failed to forward container logs", "lines", len(batch), "error", err)
+		}
+		batch = batch[:0]
+	}
+
+	for {
+		select {
+		case <-sh.ctx.Done():
+			// Readers are stopped by now; deliver what they queued.
+			for len(sh.entries) > 0 {
+				batch = append(batch, <-sh.entries)
+				if len(batch) >= logForwardBatchSize {
+					flush()
+				}
+			}
+			flush()
+			_ = sh.sink.Close()
+			return
+		case e := <-sh.entries:
+			batch = append(batch, e)
+			if len(batch) >= logForwardBatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		}
+	}
+}
+
+func (sh *logShipper) stop() {
+	sh.cancel()
+	<-sh.done
+}
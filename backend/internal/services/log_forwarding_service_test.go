@@ -0,0 +1,120 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	glsqlite "github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/getarcaneapp/arcane/backend/internal/config"
+	"github.com/getarcaneapp/arcane/backend/internal/database"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/crypto"
+	"github.com/getarcaneapp/arcane/types/logforward"
+)
+
+func setupLogForwardingServiceTest(t *testing.T) (*LogForwardingService, *database.DB) {
+	t.Helper()
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared", strings.NewReplacer("/", "_", " ", "_").Replace(t.Name()))
+	gdb, err := gorm.Open(glsqlite.Open(dsn), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, gdb.AutoMigrate(&models.Project{}, &models.LogForwarder{}))
+
+	crypto.InitEncryption(&config.Config{
+		EncryptionKey: "test-encryption-key-for-testing-32bytes-min",
+		Environment:   "test",
+	})
+
+	db := &database.DB{DB: gdb}
+	return NewLogForwardingService(db, nil, NewProjectService(db, nil, nil, nil, nil, nil, nil)), db
+}
+
+func TestLogForwardingService_UpsertProjectForwarder(t *testing.T) {
+	svc, db := setupLogForwardingServiceTest(t)
+	ctx := context.Background()
+
+	project := &models.Project{Name: "shop", Path: "/app/data/projects/shop"}
+	require.NoError(t, db.Create(project).Error)
+
+	token := "loki-user:secret"
+	fw, err := svc.UpsertProjectForwarder(ctx, project.ID, logforward.UpsertLogForwarder{
+		SinkType: logforward.SinkLoki,
+		Target:   "https://loki.example.com/loki/api/v1/push",
+		Token:    &token,
+		Services: []string{"web", " web ", "", "worker"},
+	})
+	require.NoError(t, err)
+	require.True(t, fw.Enabled)
+	require.True(t, fw.HasToken)
+	require.Equal(t, []string{"web", "worker"}, fw.Services)
+
+	var stored models.LogForwarder
+	require.NoError(t, db.Where("project_id = ?", project.ID).First(&stored).Error)
+	require.NotNil(t, stored.Token)
+	require.NotEqual(t, token, *stored.Token, "token must be stored encrypted")
+
+	// Omitting the token keeps it; the forwarder is updated in place.
+	disabled := false
+	updated, err := svc.UpsertProjectForwarder(ctx, project.ID, logforward.UpsertLogForwarder{
+		SinkType: logforward.SinkLoki,
+		Target:   "https://loki.example.com/loki/api/v1/push",
+		Enabled:  &disabled,
+	})
+	require.NoError(t, err)
+	require.Equal(t, fw.ID, updated.ID)
+	require.True(t, updated.HasToken)
+	require.False(t, updated.Enabled)
+	require.Empty(t, updated.Services)
+
+	_, err = svc.UpsertProjectForwarder(ctx, project.ID, logforward.UpsertLogForwarder{
+		SinkType: logforward.SinkFile,
+		Target:   "logs/shop.log",
+	})
+	var validationErr *models.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+
+	_, err = svc.UpsertProjectForwarder(ctx, "missing", logforward.UpsertLogForwarder{
+		SinkType: logforward.SinkSyslogUDP,
+		Target:   "logs.example.com:514",
+	})
+	var notFoundErr *models.NotFoundError
+	require.ErrorAs(t, err, &notFoundErr)
+
+	require.NoError(t, svc.DeleteProjectForwarder(ctx, project.ID))
+	_, err = svc.GetProjectForwarder(ctx, project.ID)
+	require.ErrorAs(t, err, &notFoundErr)
+	require.ErrorAs(t, svc.DeleteProjectForwarder(ctx, project.ID), &notFoundErr)
+}
+
+func TestLogForwardingService_ReconcileStopsRemovedForwarders(t *testing.T) {
+	svc, db := setupLogForwardingServiceTest(t)
+	ctx := context.Background()
+
+	project := &models.Project{Name: "shop", Path: "/app/data/projects/shop"}
+	require.NoError(t, db.Create(project).Error)
+	fw, err := svc.UpsertProjectForwarder(ctx, project.ID, logforward.UpsertLogForwarder{
+		SinkType: logforward.SinkFile,
+		Target:   t.TempDir() + "/shop.log",
+	})
+	require.NoError(t, err)
+
+	stored, err := svc.getForwarderModelInternal(ctx, project.ID)
+	require.NoError(t, err)
+	sh, err := svc.ensureShipperInternal(ctx, stored)
+	require.NoError(t, err)
+	require.Len(t, svc.shippers, 1)
+
+	require.NoError(t, svc.DeleteProjectForwarder(ctx, project.ID))
+	require.NoError(t, svc.Reconcile(ctx))
+	require.Empty(t, svc.shippers)
+
+	select {
+	case <-sh.done:
+	default:
+		t.Fatalf("shipper for deleted forwarder %s is still running", fw.ID)
+	}
+}
@@ -0,0 +1,469 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/getarcaneapp/arcane/backend/internal/database"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/types/monitor"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrMonitorNotFound = errors.New("monitor not found")
+	ErrMonitorInvalid  = errors.New("invalid monitor")
+)
+
+const (
+	defaultMonitorIntervalSeconds = 60
+	minMonitorIntervalSeconds     = 15
+	defaultMonitorTimeoutSeconds  = 10
+	maxMonitorConcurrentChecks    = 8
+)
+
+// MonitorService manages uptime monitors and runs their checks.
+type MonitorService struct {
+	db                  *database.DB
+	eventService        *EventService
+	notificationService *NotificationService
+	httpClient          *http.Client
+	running             sync.Map // monitor ID -> struct{}; prevents overlapping checks
+}
+
+func NewMonitorService(db *database.DB, eventService *EventService, notificationService *NotificationService) *MonitorService {
+	return &MonitorService{
+		db:                  db,
+		eventService:        eventService,
+		notificationService: notificationService,
+		httpClient: &http.Client{
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				// Report redirects as-is so 3xx can be matched against ExpectedStatus.
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+func (s *MonitorService) ListMonitors(ctx context.Context, environmentID string) ([]monitor.Monitor, error) {
+	var monitors []models.Monitor
+	query := s.db.WithContext(ctx).Model(&models.Monitor{}).Order("name ASC")
+	if environmentID != "" {
+		query = query.Where("environment_id = ?", environmentID)
+	}
+	if err := query.Find(&monitors).Error; err != nil {
+		return nil, fmt.Errorf("failed to list monitors: %w", err)
+	}
+
+	result := make([]monitor.Monitor, len(monitors))
+	for i := range monitors {
+		result[i] = toMonitorDto(&monitors[i])
+	}
+	return result, nil
+}
+
+func (s *MonitorService) GetMonitor(ctx context.Context, id string) (*monitor.Monitor, error) {
+	m, err := s.getMonitorModel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	dto := toMonitorDto(m)
+	return &dto, nil
+}
+
+func (s *MonitorService) CreateMonitor(ctx context.Context, req monitor.CreateMonitor) (*monitor.Monitor, error) {
+	m := &models.Monitor{
+		Name:             strings.TrimSpace(req.Name),
+		Type:             req.Type,
+		Target:           strings.TrimSpace(req.Target),
+		ExpectedStatus:   req.ExpectedStatus,
+		IntervalSeconds:  req.IntervalSeconds,
+		TimeoutSeconds:   req.TimeoutSeconds,
+		FailureThreshold: req.FailureThreshold,
+		EnvironmentID:    req.EnvironmentID,
+		ContainerID:      normalizeScopeID(req.ContainerID),
+		ProjectID:        normalizeScopeID(req.ProjectID),
+		ServiceName:      normalizeScopeID(req.ServiceName),
+		Enabled:          req.Enabled == nil || *req.Enabled,
+		Status:           monitor.StatusUnknown,
+	}
+	applyMonitorDefaults(m)
+	if err := validateMonitor(m); err != nil {
+		return nil, err
+	}
+
+	if err := s.db.WithContext(ctx).Create(m).Error; err != nil {
+		return nil, fmt.Errorf("failed to create monitor: %w", err)
+	}
+
+	dto := toMonitorDto(m)
+	return &dto, nil
+}
+
+func (s *MonitorService) UpdateMonitor(ctx context.Context, id string, req monitor.UpdateMonitor) (*monitor.Monitor, error) {
+	m, err := s.getMonitorModel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		m.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Target != nil {
+		m.Target = strings.TrimSpace(*req.Target)
+	}
+	if req.ExpectedStatus != nil {
+		m.ExpectedStatus = *req.ExpectedStatus
+	}
+	if req.IntervalSeconds != nil {
+		m.IntervalSeconds = *req.IntervalSeconds
+	}
+	if req.TimeoutSeconds != nil {
+		m.TimeoutSeconds = *req.TimeoutSeconds
+	}
+	if req.FailureThreshold != nil {
+		m.FailureThreshold = *req.FailureThreshold
+	}
+	if req.ContainerID != nil {
+		m.ContainerID = normalizeScopeID(req.ContainerID)
+	}
+	if req.ProjectID != nil {
+		m.ProjectID = normalizeScopeID(req.ProjectID)
+	}
+	if req.ServiceName != nil {
+		m.ServiceName = normalizeScopeID(req.ServiceName)
+	}
+	if req.Enabled != nil {
+		m.Enabled = *req.Enabled
+		if !m.Enabled {
+			m.Status = monitor.StatusUnknown
+			m.ConsecutiveFailures = 0
+		}
+	}
+	applyMonitorDefaults(m)
+	if err := validateMonitor(m); err != nil {
+		return nil, err
+	}
+
+	if err := s.db.WithContext(ctx).Save(m).Error; err != nil {
+		return nil, fmt.Errorf("failed to update monitor: %w", err)
+	}
+
+	dto := toMonitorDto(m)
+	return &dto, nil
+}
+
+func (s *MonitorService) DeleteMonitor(ctx context.Context, id string) error {
+	result := s.db.WithContext(ctx).Delete(&models.Monitor{}, "id = ?", id)
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete monitor: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrMonitorNotFound
+	}
+	return nil
+}
+
+// CheckMonitorNow runs a single check immediately and records its result.
+func (s *MonitorService) CheckMonitorNow(ctx context.Context, id string) (*monitor.CheckResult, error) {
+	m, err := s.getMonitorModel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	result := s.runCheck(ctx, m)
+	if err := s.recordResult(ctx, m, result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// RunDueChecks checks every enabled monitor whose interval has elapsed.
+func (s *MonitorService) RunDueChecks(ctx context.Context) error {
+	var monitors []models.Monitor
+	if err := s.db.WithContext(ctx).Where("enabled = ?", true).Find(&monitors).Error; err != nil {
+		return fmt.Errorf("failed to load monitors: %w", err)
+	}
+
+	now := time.Now()
+	sem := make(chan struct{}, maxMonitorConcurrentChecks)
+	var wg sync.WaitGroup
+	for i := range monitors {
+		m := &monitors[i]
+		if !monitorIsDue(m, now) {
+			continue
+		}
+		if _, busy := s.running.LoadOrStore(m.ID, struct{}{}); busy {
+			continue
+		}
+
+		wg.Go(func() {
+			defer s.running.Delete(m.ID)
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			result := s.runCheck(ctx, m)
+			if err := s.recordResult(ctx, m, result); err != nil {
+				slog.WarnContext(ctx, "Failed to record monitor result", "monitor", m.Name, "error", err)
+			}
+		})
+	}
+	wg.Wait()
+
+	return nil
+}
+
+func monitorIsDue(m *models.Monitor, now time.Time) bool {
+	if m.LastCheckedAt == nil {
+		return true
+	}
+	return now.Sub(*m.LastCheckedAt) >= time.Duration(m.IntervalSeconds)*time.Second
+}
+
+func (s *MonitorService) runCheck(ctx context.Context, m *models.Monitor) monitor.CheckResult {
+	timeout := time.Duration(m.TimeoutSeconds) * time.Second
+	checkCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	result := monitor.CheckResult{MonitorID: m.ID, CheckedAt: time.Now()}
+	start := time.Now()
+
+	var err error
+	switch m.Type {
+	case monitor.TypeHTTP:
+		result.StatusCode, err = s.checkHTTP(checkCtx, m)
+	case monitor.TypeTCP:
+		err = checkTCP(checkCtx, m.Target)
+	default:
+		err = fmt.Errorf("unsupported monitor type %q", m.Type)
+	}
+
+	result.LatencyMs = time.Since(start).Milliseconds()
+	result.Up = err == nil
+	if err != nil {
+		result.Error = err.Error()
+	}
+	return result
+}
+
+func (s *MonitorService) checkHTTP(ctx context.Context, m *models.Monitor) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, m.Target, nil)
+	if err != nil {
+		return 0, fmt.Errorf("invalid request: %w", err)
+	}
+	req.Header.Set("User-Agent", "Arcane-Monitor")
+
+	resp, err := s.httpClient.Do(req) //nolint:gosec // intentional request to user-configured monitor target
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if m.ExpectedStatus > 0 {
+		if resp.StatusCode != m.ExpectedStatus {
+			return resp.StatusCode, fmt.Errorf("unexpected status code %d (expected %d)", resp.StatusCode, m.ExpectedStatus)
+		}
+		return resp.StatusCode, nil
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return resp.StatusCode, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+func checkTCP(ctx context.Context, target string) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", target)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+// recordResult persists a check result and emits events and notifications
+// when the monitor transitions between up and down.
+func (s *MonitorService) recordResult(ctx context.Context, m *models.Monitor, result monitor.CheckResult) error {
+	previous := m.Status
+	checkedAt := result.CheckedAt
+	latency := result.LatencyMs
+
+	m.LastCheckedAt = &checkedAt
+	m.LastLatencyMs = &latency
+	if result.Up {
+		m.ConsecutiveFailures = 0
+		m.LastError = nil
+		m.Status = monitor.StatusUp
+	} else {
+		m.ConsecutiveFailures++
+		m.LastError = &result.Error
+		if m.ConsecutiveFailures >= m.FailureThreshold {
+			m.Status = monitor.StatusDown
+		} else if previous == monitor.StatusUnknown {
+			m.Status = monitor.StatusUnknown
+		}
+	}
+	if m.Status != previous {
+		m.LastStatusChangeAt = &checkedAt
+	}
+
+	updates := map[string]any{
+		"status":                m.Status,
+		"consecutive_failures":  m.ConsecutiveFailures,
+		"last_checked_at":       m.LastCheckedAt,
+		"last_latency_ms":       m.LastLatencyMs,
+		"last_error":            m.LastError,
+		"last_status_change_at": m.LastStatusChangeAt,
+	}
+	if err := s.db.WithContext(ctx).Model(&models.Monitor{}).Where("id = ?", m.ID).Updates(updates).Error; err != nil {
+		return fmt.Errorf("failed to update monitor status: %w", err)
+	}
+
+	switch {
+	case m.Status == monitor.StatusDown && previous != monitor.StatusDown:
+		s.notifyTransition(ctx, m, models.EventTypeMonitorDown, fmt.Sprintf("Monitor '%s' is down", m.Name),
+			fmt.Sprintf("%s check for %s failed %d time(s): %s", strings.ToUpper(m.Type), m.Target, m.ConsecutiveFailures, result.Error))
+	case m.Status == monitor.StatusUp && previous == monitor.StatusDown:
+		s.notifyTransition(ctx, m, models.EventTypeMonitorUp, fmt.Sprintf("Monitor '%s' recovered", m.Name),
+			fmt.Sprintf("%s is responding again (%d ms)", m.Target, result.LatencyMs))
+	}
+
+	return nil
+}
+
+func (s *MonitorService) notifyTransition(ctx context.Context, m *models.Monitor, eventType models.EventType, title, message string) {
+	metadata := models.JSON{
+		"monitorId": m.ID,
+		"type":      m.Type,
+		"target":    m.Target,
+	}
+	if m.ContainerID != nil {
+		metadata["containerId"] = *m.ContainerID
+	}
+	if m.ProjectID != nil {
+		metadata["projectId"] = *m.ProjectID
+	}
+	if m.ServiceName != nil {
+		metadata["serviceName"] = *m.ServiceName
+	}
+
+	if s.eventService != nil {
+		resourceType := "monitor"
+		_, err := s.eventService.CreateEvent(ctx, CreateEventRequest{
+			Type:          eventType,
+			Severity:      s.eventService.getEventSeverity(eventType),
+			Title:         title,
+			Description:   message,
+			ResourceType:  &resourceType,
+			ResourceID:    &m.ID,
+			ResourceName:  &m.Name,
+			EnvironmentID: &m.EnvironmentID,
+			Metadata:      metadata,
+		})
+		if err != nil {
+			slog.WarnContext(ctx, "Failed to record monitor event", "monitor", m.Name, "error", err)
+		}
+	}
+
+	if s.notificationService != nil {
+		notificationType := models.NotificationEventMonitorDown
+		if eventType == models.EventTypeMonitorUp {
+			notificationType = models.NotificationEventMonitorUp
+		}
+		err := s.notificationService.SendAlertNotification(ctx, AlertNotification{
+			EventType: notificationType,
+			Subject:   m.Name,
+			Title:     title,
+			Message:   message,
+			Metadata:  metadata,
+		})
+		if err != nil {
+			slog.WarnContext(ctx, "Failed to send monitor notification", "monitor", m.Name, "error", err)
+		}
+	}
+}
+
+func (s *MonitorService) getMonitorModel(ctx context.Context, id string) (*models.Monitor, error) {
+	var m models.Monitor
+	if err := s.db.WithContext(ctx).Where("id = ?", id).First(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrMonitorNotFound
+		}
+		return nil, fmt.Errorf("failed to get monitor: %w", err)
+	}
+	return &m, nil
+}
+
+func applyMonitorDefaults(m *models.Monitor) {
+	if m.IntervalSeconds <= 0 {
+		m.IntervalSeconds = defaultMonitorIntervalSeconds
+	}
+	if m.TimeoutSeconds <= 0 {
+		m.TimeoutSeconds = defaultMonitorTimeoutSeconds
+	}
+	if m.FailureThreshold <= 0 {
+		m.FailureThreshold = 1
+	}
+	if m.EnvironmentID == "" {
+		m.EnvironmentID = "0"
+	}
+}
+
+func validateMonitor(m *models.Monitor) error {
+	if m.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrMonitorInvalid)
+	}
+	if m.IntervalSeconds < minMonitorIntervalSeconds {
+		return fmt.Errorf("%w: interval must be at least %d seconds", ErrMonitorInvalid, minMonitorIntervalSeconds)
+	}
+	if m.TimeoutSeconds >= m.IntervalSeconds {
+		return fmt.Errorf("%w: timeout must be shorter than the interval", ErrMonitorInvalid)
+	}
+
+	switch m.Type {
+	case monitor.TypeHTTP:
+		u, err := url.Parse(m.Target)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("%w: target must be an http(s) URL", ErrMonitorInvalid)
+		}
+	case monitor.TypeTCP:
+		if _, _, err := net.SplitHostPort(m.Target); err != nil {
+			return fmt.Errorf("%w: target must be host:port", ErrMonitorInvalid)
+		}
+	default:
+		return fmt.Errorf("%w: type must be http or tcp", ErrMonitorInvalid)
+	}
+	return nil
+}
+
+func toMonitorDto(m *models.Monitor) monitor.Monitor {
+	return monitor.Monitor{
+		ID:                  m.ID,
+		Name:                m.Name,
+		Type:                m.Type,
+		Target:              m.Target,
+		ExpectedStatus:      m.ExpectedStatus,
+		IntervalSeconds:     m.IntervalSeconds,
+		TimeoutSeconds:      m.TimeoutSeconds,
+		FailureThreshold:    m.FailureThreshold,
+		EnvironmentID:       m.EnvironmentID,
+		ContainerID:         m.ContainerID,
+		ProjectID:           m.ProjectID,
+		ServiceName:         m.ServiceName,
+		Enabled:             m.Enabled,
+		Status:              m.Status,
+		ConsecutiveFailures: m.ConsecutiveFailures,
+		LastCheckedAt:       m.LastCheckedAt,
+		LastLatencyMs:       m.LastLatencyMs,
+		LastError:           m.LastError,
+		LastStatusChangeAt:  m.LastStatusChangeAt,
+		CreatedAt:           m.CreatedAt,
+		UpdatedAt:           m.UpdatedAt,
+	}
+}
@@ -0,0 +1,114 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	glsqlite "github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/getarcaneapp/arcane/backend/internal/config"
+	"github.com/getarcaneapp/arcane/backend/internal/database"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/types/monitor"
+)
+
+func setupMonitorServiceTest(t *testing.T) *MonitorService {
+	t.Helper()
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared", strings.NewReplacer("/", "_", " ", "_").Replace(t.Name()))
+	db, err := gorm.Open(glsqlite.Open(dsn), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.Monitor{}))
+
+	return NewMonitorService(&database.DB{DB: db}, nil, nil)
+}
+
+func TestMonitorService_CheckTransitionsAfterFailureThreshold(t *testing.T) {
+	var healthy atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if healthy.Load() {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	svc := setupMonitorServiceTest(t)
+	ctx := context.Background()
+
+	created, err := svc.CreateMonitor(ctx, monitor.CreateMonitor{
+		Name:             "web",
+		Type:             monitor.TypeHTTP,
+		Target:           server.URL,
+		FailureThreshold: 2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, monitor.StatusUnknown, created.Status)
+	require.True(t, created.Enabled)
+
+	result, err := svc.CheckMonitorNow(ctx, created.ID)
+	require.NoError(t, err)
+	require.False(t, result.Up)
+	require.Equal(t, http.StatusServiceUnavailable, result.StatusCode)
+
+	got, err := svc.GetMonitor(ctx, created.ID)
+	require.NoError(t, err)
+	require.Equal(t, monitor.StatusUnknown, got.Status)
+	require.Equal(t, 1, got.ConsecutiveFailures)
+
+	_, err = svc.CheckMonitorNow(ctx, created.ID)
+	require.NoError(t, err)
+	got, err = svc.GetMonitor(ctx, created.ID)
+	require.NoError(t, err)
+	require.Equal(t, monitor.StatusDown, got.Status)
+	require.NotNil(t, got.LastStatusChangeAt)
+
+	healthy.Store(true)
+	result, err = svc.CheckMonitorNow(ctx, created.ID)
+	require.NoError(t, err)
+	require.True(t, result.Up)
+	got, err = svc.GetMonitor(ctx, created.ID)
+	require.NoError(t, err)
+	require.Equal(t, monitor.StatusUp, got.Status)
+	require.Zero(t, got.ConsecutiveFailures)
+	require.Nil(t, got.LastError)
+}
+
+func TestMonitorService_CreateMonitorValidatesTarget(t *testing.T) {
+	svc := setupMonitorServiceTest(t)
+
+	_, err := svc.CreateMonitor(context.Background(), monitor.CreateMonitor{
+		Name:   "db",
+		Type:   monitor.TypeTCP,
+		Target: "missing-port",
+	})
+	require.ErrorIs(t, err, ErrMonitorInvalid)
+}
+
+func TestMonitorService_NotifiesOutageAndRecoveryAsSeparateEvents(t *testing.T) {
+	db := setupNotificationBundleTestDB(t, "monitor_notifications")
+	require.NoError(t, db.AutoMigrate(&models.Monitor{}, &models.NotificationLog{}, &models.UserNotificationSubscription{}))
+	notificationSvc := NewNotificationService(db, &config.Config{})
+	notificationSvc.retryDelay = 0
+	ctx := context.Background()
+	_, err := notificationSvc.CreateOrUpdateSettings(ctx, models.NotificationProviderSlack, true, models.JSON{})
+	require.NoError(t, err)
+
+	svc := NewMonitorService(db, nil, notificationSvc)
+	m := &models.Monitor{Name: "web", Type: monitor.TypeHTTP, Target: "http://web"}
+	svc.notifyTransition(ctx, m, models.EventTypeMonitorDown, "down", "down")
+	svc.notifyTransition(ctx, m, models.EventTypeMonitorUp, "up", "up")
+
+	var logs []models.NotificationLog
+	require.NoError(t, db.Order("id").Find(&logs).Error)
+	require.Len(t, logs, 2)
+	require.Equal(t, string(models.NotificationEventMonitorDown), logs[0].Metadata["eventType"])
+	require.Equal(t, string(models.NotificationEventMonitorUp), logs[1].Metadata["eventType"])
+}
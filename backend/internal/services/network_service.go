@@ -111,14 +111,16 @@ func (s *NetworkService) RemoveNetwork(ctx context.Context, id string, user mode
 }
 
 func (s *NetworkService) PruneNetworks(ctx context.Context) (*network.PruneReport, error) {
+	return s.PruneNetworksWithFilters(ctx, make(client.Filters))
+}
+
+func (s *NetworkService) PruneNetworksWithFilters(ctx context.Context, filters client.Filters) (*network.PruneReport, error) {
 	dockerClient, err := s.dockerService.GetClient(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to Docker: %w", err)
 	}
 
-	filterArgs := make(client.Filters)
-
-	report, err := dockerClient.NetworkPrune(ctx, client.NetworkPruneOptions{Filters: filterArgs})
+	report, err := dockerClient.NetworkPrune(ctx, client.NetworkPruneOptions{Filters: filters})
 	if err != nil {
 		return nil, fmt.Errorf("failed to prune networks: %w", err)
 	}
@@ -0,0 +1,231 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/getarcaneapp/arcane/backend/internal/config"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/crypto"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/notifications"
+	"github.com/getarcaneapp/arcane/types/notificationaction"
+)
+
+var (
+	ErrNotificationActionInvalid  = errors.New("invalid or expired notification action link")
+	ErrNotificationActionDisabled = errors.New("notification action links are disabled")
+	ErrNotificationActionBusy     = errors.New("the update is already being applied")
+)
+
+const (
+	// notificationActionPurpose separates action link signatures from other
+	// values signed with the encryption key.
+	notificationActionPurpose    = "notification-action"
+	notificationActionSnoozeDays = 7
+)
+
+// notificationActionClaimsInternal is the signed payload of an action link.
+// Keys are short to keep the links short.
+type notificationActionClaimsInternal struct {
+	Action     string `json:"a"`
+	ImageRef   string `json:"i"`
+	SnoozeDays int    `json:"d,omitempty"`
+	ExpiresAt  int64  `json:"e"`
+}
+
+// NotificationActionService creates the signed, expiring links added to image
+// update notifications and performs their actions, so that users can apply or
+// snooze an update from Slack or email without logging in first. The
+// signature is the only authorization, so links only carry actions that are
+// safe to repeat.
+type NotificationActionService struct {
+	config             *config.Config
+	settingsService    *SettingsService
+	imageUpdateService *ImageUpdateService
+	updaterService     *UpdaterService
+	eventService       *EventService
+	running            sync.Map // image ref -> struct{}; prevents overlapping updates
+}
+
+func NewNotificationActionService(cfg *config.Config, settingsService *SettingsService, imageUpdateService *ImageUpdateService, updaterService *UpdaterService, eventService *EventService) *NotificationActionService {
+	return &NotificationActionService{
+		config:             cfg,
+		settingsService:    settingsService,
+		imageUpdateService: imageUpdateService,
+		updaterService:     updaterService,
+		eventService:       eventService,
+	}
+}
+
+// ImageUpdateActions returns the action links for an image update
+// notification, or nil when action links are disabled or the app URL is not
+// known.
+func (s *NotificationActionService) ImageUpdateActions(ctx context.Context, imageRef string) []notifications.RichLink {
+	if !s.settingsService.GetBoolSetting(ctx, "notificationActionLinks", false) {
+		return nil
+	}
+	appURL := strings.TrimRight(s.config.GetAppURL(), "/")
+	if appURL == "" || imageRef == "" {
+		return nil
+	}
+
+	hours := s.settingsService.GetIntSetting(ctx, "notificationActionLinkHours", 72)
+	if hours <= 0 {
+		hours = 72
+	}
+	expiresAt := time.Now().Add(time.Duration(hours) * time.Hour).Unix()
+
+	links := make([]notifications.RichLink, 0, 2)
+	for _, claims := range []notificationActionClaimsInternal{
+		{Action: notificationaction.ActionApplyUpdate, ImageRef: imageRef, ExpiresAt: expiresAt},
+		{Action: notificationaction.ActionSnooze, ImageRef: imageRef, SnoozeDays: notificationActionSnoozeDays, ExpiresAt: expiresAt},
+	} {
+		token, err := s.signInternal(claims)
+		if err != nil {
+			slog.WarnContext(ctx, "Failed to sign notification action link", "imageRef", imageRef, "action", claims.Action, "error", err)
+			return nil
+		}
+		links = append(links, notifications.RichLink{
+			Label: notificationActionLabelInternal(claims),
+			URL:   appURL + "/notification-actions/" + token,
+		})
+	}
+	return links
+}
+
+// Describe returns the action of a link without performing it.
+func (s *NotificationActionService) Describe(ctx context.Context, token string) (*notificationaction.Action, error) {
+	claims, err := s.verifyInternal(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	return &notificationaction.Action{
+		Action:     claims.Action,
+		ImageRef:   claims.ImageRef,
+		SnoozeDays: claims.SnoozeDays,
+		ExpiresAt:  time.Unix(claims.ExpiresAt, 0).UTC(),
+	}, nil
+}
+
+// Perform runs the action of a link. Updates are applied in the background,
+// since pulling images and recreating containers outlives the request.
+func (s *NotificationActionService) Perform(ctx context.Context, token string) (*notificationaction.Outcome, error) {
+	claims, err := s.verifyInternal(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &notificationaction.Outcome{Action: claims.Action, ImageRef: claims.ImageRef}
+	switch claims.Action {
+	case notificationaction.ActionApplyUpdate:
+		if _, busy := s.running.LoadOrStore(claims.ImageRef, struct{}{}); busy {
+			return nil, ErrNotificationActionBusy
+		}
+
+		slog.InfoContext(ctx, "Applying image update from notification link", "imageRef", claims.ImageRef)
+		runCtx := context.WithoutCancel(ctx)
+		go func() {
+			defer s.running.Delete(claims.ImageRef)
+			out, err := s.updaterService.ApplyImageUpdate(WithAutoUpdateTrigger(runCtx, models.AutoUpdateTriggerNotification), claims.ImageRef)
+			summary := ""
+			if err == nil {
+				summary = fmt.Sprintf("%d updated, %d skipped, %d failed", out.Updated, out.Skipped, out.Failed)
+			}
+			s.recordResultInternal(runCtx, claims, summary, err)
+		}()
+		result.Text = fmt.Sprintf("Started applying the update of %s", claims.ImageRef)
+
+	case notificationaction.ActionSnooze:
+		snooze, err := s.imageUpdateService.SnoozeUpdates(ctx, claims.ImageRef, time.Now().AddDate(0, 0, claims.SnoozeDays))
+		if err != nil {
+			s.recordResultInternal(ctx, claims, "", err)
+			return nil, err
+		}
+		result.Text = fmt.Sprintf("Updates of %s are snoozed until %s", claims.ImageRef, snooze.SnoozedUntil.Format(time.RFC1123))
+		s.recordResultInternal(ctx, claims, result.Text, nil)
+
+	default:
+		return nil, ErrNotificationActionInvalid
+	}
+
+	return result, nil
+}
+
+func (s *NotificationActionService) signInternal(claims notificationActionClaimsInternal) (string, error) {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	return crypto.Sign(notificationActionPurpose, payload)
+}
+
+// verifyInternal checks the signature and expiry of a link. Links stop
+// working as soon as action links are disabled.
+func (s *NotificationActionService) verifyInternal(ctx context.Context, token string) (*notificationActionClaimsInternal, error) {
+	if !s.settingsService.GetBoolSetting(ctx, "notificationActionLinks", false) {
+		return nil, ErrNotificationActionDisabled
+	}
+
+	payload, err := crypto.Verify(notificationActionPurpose, token)
+	if errors.Is(err, crypto.ErrInvalidSignature) {
+		return nil, ErrNotificationActionInvalid
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var claims notificationActionClaimsInternal
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.ImageRef == "" {
+		return nil, ErrNotificationActionInvalid
+	}
+	if time.Now().Unix() > claims.ExpiresAt {
+		return nil, ErrNotificationActionInvalid
+	}
+	if claims.Action == notificationaction.ActionSnooze && claims.SnoozeDays <= 0 {
+		return nil, ErrNotificationActionInvalid
+	}
+	return &claims, nil
+}
+
+func (s *NotificationActionService) recordResultInternal(ctx context.Context, claims *notificationActionClaimsInternal, summary string, runErr error) {
+	eventType := models.EventTypeNotificationActionCompleted
+	title := fmt.Sprintf("Notification action completed: %s", claims.ImageRef)
+	description := summary
+	if runErr != nil {
+		eventType = models.EventTypeNotificationActionFailed
+		title = fmt.Sprintf("Notification action failed: %s", claims.ImageRef)
+		description = runErr.Error()
+		slog.WarnContext(ctx, "Notification action failed", "action", claims.Action, "imageRef", claims.ImageRef, "error", runErr)
+	}
+
+	if s.eventService == nil {
+		return
+	}
+	resourceType := "image"
+	_, err := s.eventService.CreateEvent(ctx, CreateEventRequest{
+		Type:         eventType,
+		Severity:     s.eventService.getEventSeverity(eventType),
+		Title:        title,
+		Description:  description,
+		ResourceType: &resourceType,
+		ResourceName: &claims.ImageRef,
+		Username:     &systemUser.Username,
+		Metadata:     models.JSON{"action": claims.Action, "imageRef": claims.ImageRef},
+	})
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to record notification action event", "imageRef", claims.ImageRef, "error", err)
+	}
+}
+
+func notificationActionLabelInternal(claims notificationActionClaimsInternal) string {
+	if claims.Action == notificationaction.ActionSnooze {
+		return fmt.Sprintf("Snooze %d days", claims.SnoozeDays)
+	}
+	return "Apply update"
+}
@@ -0,0 +1,209 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/getarcaneapp/arcane/backend/internal/database"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/crypto"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/notifications"
+)
+
+// credentialCheckFunc verifies the stored credentials of one provider. It
+// returns notifications.ErrCredentialCheckUnsupported when the provider cannot
+// be checked without sending a message.
+type credentialCheckFunc func(ctx context.Context, config models.JSON) error
+
+// NotificationCredentialService periodically verifies that stored notification
+// provider credentials still work, so a revoked bot token or changed SMTP
+// password is flagged before a real notification silently fails.
+type NotificationCredentialService struct {
+	db                  *database.DB
+	eventService        *EventService
+	notificationService *NotificationService
+	httpClient          *http.Client
+	checks              map[models.NotificationProvider]credentialCheckFunc
+	now                 func() time.Time
+}
+
+func NewNotificationCredentialService(db *database.DB, eventService *EventService, notificationService *NotificationService) *NotificationCredentialService {
+	s := &NotificationCredentialService{
+		db:                  db,
+		eventService:        eventService,
+		notificationService: notificationService,
+		httpClient:          &http.Client{Timeout: 15 * time.Second},
+		now:                 time.Now,
+	}
+	s.checks = map[models.NotificationProvider]credentialCheckFunc{
+		models.NotificationProviderTelegram: s.checkTelegramInternal,
+		models.NotificationProviderDiscord:  s.checkDiscordInternal,
+		models.NotificationProviderSlack:    s.checkSlackInternal,
+		models.NotificationProviderPushover: s.checkPushoverInternal,
+		models.NotificationProviderEmail:    s.checkEmailInternal,
+	}
+	return s
+}
+
+// CheckAll verifies the credentials of every enabled provider, stores the
+// result and alerts when a provider's credentials start being rejected.
+func (s *NotificationCredentialService) CheckAll(ctx context.Context) error {
+	var settings []models.NotificationSettings
+	if err := s.db.WithContext(ctx).Where("enabled = ?", true).Find(&settings).Error; err != nil {
+		return fmt.Errorf("failed to list notification settings: %w", err)
+	}
+
+	for i := range settings {
+		setting := &settings[i]
+		status, checkErr := s.checkProviderInternal(ctx, setting)
+
+		previous := ""
+		if setting.CredentialStatus != nil {
+			previous = *setting.CredentialStatus
+		}
+
+		if err := s.saveResultInternal(ctx, setting.ID, status, checkErr); err != nil {
+			slog.WarnContext(ctx, "Failed to store notification credential check result", "provider", setting.Provider, "error", err)
+		}
+
+		switch {
+		case status == models.CredentialStatusInvalid && previous != models.CredentialStatusInvalid:
+			s.notifyInvalidInternal(ctx, setting.Provider, checkErr)
+		case status == models.CredentialStatusError:
+			slog.WarnContext(ctx, "Could not verify notification provider credentials", "provider", setting.Provider, "error", checkErr)
+		}
+	}
+
+	return nil
+}
+
+func (s *NotificationCredentialService) checkProviderInternal(ctx context.Context, setting *models.NotificationSettings) (string, error) {
+	check, ok := s.checks[setting.Provider]
+	if !ok {
+		return models.CredentialStatusUnsupported, nil
+	}
+
+	err := check(ctx, setting.Config)
+	switch {
+	case err == nil:
+		return models.CredentialStatusValid, nil
+	case errors.Is(err, notifications.ErrCredentialCheckUnsupported):
+		return models.CredentialStatusUnsupported, nil
+	case errors.Is(err, notifications.ErrCredentialsRejected):
+		return models.CredentialStatusInvalid, err
+	default:
+		return models.CredentialStatusError, err
+	}
+}
+
+func (s *NotificationCredentialService) saveResultInternal(ctx context.Context, id uint, status string, checkErr error) error {
+	var errMsg *string
+	if checkErr != nil {
+		msg := checkErr.Error()
+		errMsg = &msg
+	}
+	checkedAt := s.now()
+
+	// UpdateColumns keeps updated_at pointing at the last user edit.
+	return s.db.WithContext(ctx).Model(&models.NotificationSettings{}).
+		Where("id = ?", id).
+		UpdateColumns(map[string]any{
+			"credential_status":     status,
+			"credential_error":      errMsg,
+			"credential_checked_at": &checkedAt,
+		}).Error
+}
+
+func (s *NotificationCredentialService) notifyInvalidInternal(ctx context.Context, provider models.NotificationProvider, checkErr error) {
+	name := string(provider)
+	title := fmt.Sprintf("Notification credentials failing: %s", name)
+	message := fmt.Sprintf("Stored credentials for notification provider '%s' no longer work: %v", name, checkErr)
+	metadata := models.JSON{"provider": name}
+
+	if s.eventService != nil {
+		resourceType := "notification"
+		_, err := s.eventService.CreateEvent(ctx, CreateEventRequest{
+			Type:         models.EventTypeNotificationCredentialInvalid,
+			Severity:     s.eventService.getEventSeverity(models.EventTypeNotificationCredentialInvalid),
+			Title:        title,
+			Description:  message,
+			ResourceType: &resourceType,
+			ResourceName: &name,
+			Metadata:     metadata,
+		})
+		if err != nil {
+			slog.WarnContext(ctx, "Failed to record notification credential event", "provider", name, "error", err)
+		}
+	}
+
+	// The failing provider will reject this too; the other providers carry it.
+	if s.notificationService != nil {
+		err := s.notificationService.SendAlertNotification(ctx, AlertNotification{
+			EventType: models.NotificationEventCredentialInvalid,
+			Subject:   name,
+			Title:     title,
+			Message:   message,
+			Metadata:  metadata,
+		})
+		if err != nil {
+			slog.DebugContext(ctx, "Some providers failed to deliver the credential alert", "provider", name, "error", err)
+		}
+	}
+}
+
+func (s *NotificationCredentialService) checkTelegramInternal(ctx context.Context, config models.JSON) error {
+	var cfg models.TelegramConfig
+	if err := s.notificationService.unmarshalConfigInternal(config, &cfg); err != nil {
+		return err
+	}
+	return notifications.CheckTelegramCredentials(ctx, s.httpClient, decryptIfEncryptedInternal(cfg.BotToken))
+}
+
+func (s *NotificationCredentialService) checkDiscordInternal(ctx context.Context, config models.JSON) error {
+	var cfg models.DiscordConfig
+	if err := s.notificationService.unmarshalConfigInternal(config, &cfg); err != nil {
+		return err
+	}
+	return notifications.CheckDiscordCredentials(ctx, s.httpClient, cfg.WebhookID, decryptIfEncryptedInternal(cfg.Token))
+}
+
+func (s *NotificationCredentialService) checkSlackInternal(ctx context.Context, config models.JSON) error {
+	var cfg models.SlackConfig
+	if err := s.notificationService.unmarshalConfigInternal(config, &cfg); err != nil {
+		return err
+	}
+	return notifications.CheckSlackCredentials(ctx, s.httpClient, decryptIfEncryptedInternal(cfg.Token))
+}
+
+func (s *NotificationCredentialService) checkPushoverInternal(ctx context.Context, config models.JSON) error {
+	var cfg models.PushoverConfig
+	if err := s.notificationService.unmarshalConfigInternal(config, &cfg); err != nil {
+		return err
+	}
+	return notifications.CheckPushoverCredentials(ctx, s.httpClient, decryptIfEncryptedInternal(cfg.Token), cfg.User)
+}
+
+func (s *NotificationCredentialService) checkEmailInternal(ctx context.Context, config models.JSON) error {
+	var cfg models.EmailConfig
+	if err := s.notificationService.unmarshalConfigInternal(config, &cfg); err != nil {
+		return err
+	}
+	cfg.SMTPPassword = decryptIfEncryptedInternal(cfg.SMTPPassword)
+	return notifications.CheckEmailCredentials(ctx, cfg)
+}
+
+// decryptIfEncryptedInternal mirrors the senders: values that fail to decrypt
+// are treated as plaintext from before encryption was introduced.
+func decryptIfEncryptedInternal(value string) string {
+	if value == "" {
+		return value
+	}
+	if decrypted, err := crypto.Decrypt(value); err == nil {
+		return decrypted
+	}
+	return value
+}
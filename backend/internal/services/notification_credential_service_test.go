@@ -0,0 +1,68 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	glsqlite "github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/getarcaneapp/arcane/backend/internal/database"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/notifications"
+)
+
+func setupNotificationCredentialServiceTest(t *testing.T) (*NotificationCredentialService, *gorm.DB) {
+	t.Helper()
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared", strings.NewReplacer("/", "_", " ", "_").Replace(t.Name()))
+	db, err := gorm.Open(glsqlite.Open(dsn), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.NotificationSettings{}))
+
+	return NewNotificationCredentialService(&database.DB{DB: db}, nil, nil), db
+}
+
+func TestNotificationCredentialService_CheckAllStoresStatus(t *testing.T) {
+	svc, db := setupNotificationCredentialServiceTest(t)
+	ctx := context.Background()
+
+	require.NoError(t, db.Create(&models.NotificationSettings{Provider: models.NotificationProviderTelegram, Enabled: true, Config: models.JSON{}}).Error)
+	require.NoError(t, db.Create(&models.NotificationSettings{Provider: models.NotificationProviderDiscord, Enabled: true, Config: models.JSON{}}).Error)
+	require.NoError(t, db.Create(&models.NotificationSettings{Provider: models.NotificationProviderNtfy, Enabled: true, Config: models.JSON{}}).Error)
+	require.NoError(t, db.Create(&models.NotificationSettings{Provider: models.NotificationProviderSlack, Enabled: true, Config: models.JSON{}}).Error)
+
+	svc.checks = map[models.NotificationProvider]credentialCheckFunc{
+		models.NotificationProviderTelegram: func(context.Context, models.JSON) error {
+			return fmt.Errorf("%w: telegram: Unauthorized", notifications.ErrCredentialsRejected)
+		},
+		models.NotificationProviderDiscord: func(context.Context, models.JSON) error { return nil },
+		models.NotificationProviderSlack: func(context.Context, models.JSON) error {
+			return errors.New("connection refused")
+		},
+	}
+
+	require.NoError(t, svc.CheckAll(ctx))
+
+	expected := map[models.NotificationProvider]string{
+		models.NotificationProviderTelegram: models.CredentialStatusInvalid,
+		models.NotificationProviderDiscord:  models.CredentialStatusValid,
+		models.NotificationProviderNtfy:     models.CredentialStatusUnsupported,
+		models.NotificationProviderSlack:    models.CredentialStatusError,
+	}
+	for provider, status := range expected {
+		var setting models.NotificationSettings
+		require.NoError(t, db.First(&setting, "provider = ?", provider).Error)
+		require.NotNil(t, setting.CredentialStatus, provider)
+		require.Equal(t, status, *setting.CredentialStatus, provider)
+		require.NotNil(t, setting.CredentialCheckedAt, provider)
+	}
+
+	var telegram models.NotificationSettings
+	require.NoError(t, db.First(&telegram, "provider = ?", models.NotificationProviderTelegram).Error)
+	require.NotNil(t, telegram.CredentialError)
+	require.Contains(t, *telegram.CredentialError, "Unauthorized")
+}
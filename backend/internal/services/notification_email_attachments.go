@@ -0,0 +1,99 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/getarcaneapp/arcane/backend/internal/utils/notifications"
+	"github.com/getarcaneapp/arcane/types/imageupdate"
+	"github.com/getarcaneapp/arcane/types/system"
+)
+
+// Report emails keep their HTML body a summary and attach the full details,
+// since long tables get truncated or become unreadable in most mail clients.
+
+// batchUpdateEmailAttachmentsInternal lists every available image update.
+func batchUpdateEmailAttachmentsInternal(ctx context.Context, updates map[string]*imageupdate.Response) []notifications.EmailAttachment {
+	rows := make([][]string, 0, len(updates))
+	for _, imageRef := range slices.Sorted(maps.Keys(updates)) {
+		update := updates[imageRef]
+		if update == nil {
+			continue
+		}
+		rows = append(rows, []string{
+			imageRef,
+			update.UpdateType,
+			update.CurrentVersion,
+			update.LatestVersion,
+			update.CurrentDigest,
+			update.LatestDigest,
+			update.CheckTime.UTC().Format(time.RFC3339),
+		})
+	}
+
+	return reportEmailAttachmentsInternal(ctx, "image-updates",
+		[]string{"image", "update_type", "current_version", "latest_version", "current_digest", "latest_digest", "checked_at"},
+		rows, updates)
+}
+
+// vulnerabilityEmailAttachmentsInternal lists every finding behind a
+// vulnerability summary. Payloads without findings get no attachments.
+func vulnerabilityEmailAttachmentsInternal(ctx context.Context, payload VulnerabilityNotificationPayload) []notifications.EmailAttachment {
+	if len(payload.Findings) == 0 {
+		return nil
+	}
+
+	rows := make([][]string, 0, len(payload.Findings))
+	for _, f := range payload.Findings {
+		rows = append(rows, []string{f.ImageName, f.VulnerabilityID, f.Severity, f.PkgName, f.InstalledVersion, f.FixedVersion, f.Title})
+	}
+
+	return reportEmailAttachmentsInternal(ctx, "vulnerabilities",
+		[]string{"image", "vulnerability_id", "severity", "package", "installed_version", "fixed_version", "title"},
+		rows, payload.Findings)
+}
+
+// pruneEmailAttachmentsInternal lists every resource a prune removed. The
+// space reclaimed per resource type is in the JSON attachment.
+func pruneEmailAttachmentsInternal(ctx context.Context, result *system.PruneAllResult) []notifications.EmailAttachment {
+	var rows [][]string
+	for _, group := range []struct {
+		resourceType string
+		ids          []string
+	}{
+		{"container", result.ContainersPruned},
+		{"image", result.ImagesDeleted},
+		{"volume", result.VolumesDeleted},
+		{"network", result.NetworksDeleted},
+	} {
+		for _, id := range group.ids {
+			rows = append(rows, []string{group.resourceType, id})
+		}
+	}
+
+	return reportEmailAttachmentsInternal(ctx, "prune-report", []string{"type", "id"}, rows, result)
+}
+
+// reportEmailAttachmentsInternal renders a report as <name>.csv and
+// <name>.json. A report that fails to render is left out so the summary
+// email is still sent.
+func reportEmailAttachmentsInternal(ctx context.Context, name string, header []string, rows [][]string, details any) []notifications.EmailAttachment {
+	var attachments []notifications.EmailAttachment
+	csvAttachment, err := notifications.CSVAttachment(name+".csv", header, rows)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to build email attachment", "attachment", name+".csv", "error", err)
+	} else {
+		attachments = append(attachments, csvAttachment)
+	}
+
+	jsonAttachment, err := notifications.JSONAttachment(name+".json", details)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to build email attachment", "attachment", name+".json", "error", err)
+	} else {
+		attachments = append(attachments, jsonAttachment)
+	}
+	return attachments
+}
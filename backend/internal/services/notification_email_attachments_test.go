@@ -0,0 +1,32 @@
+package services
+
+import (
+	"encoding/csv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/getarcaneapp/arcane/types/system"
+)
+
+func TestPruneEmailAttachments(t *testing.T) {
+	attachments := pruneEmailAttachmentsInternal(t.Context(), &system.PruneAllResult{
+		ContainersPruned: []string{"c1"},
+		ImagesDeleted:    []string{"sha256:i1", "sha256:i2"},
+		SpaceReclaimed:   1024,
+	})
+	require.Len(t, attachments, 2)
+	assert.Equal(t, "prune-report.csv", attachments[0].Filename)
+	assert.Equal(t, "prune-report.json", attachments[1].Filename)
+
+	records, err := csv.NewReader(strings.NewReader(string(attachments[0].Data))).ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"type", "id"}, {"container", "c1"}, {"image", "sha256:i1"}, {"image", "sha256:i2"}}, records)
+	assert.Contains(t, string(attachments[1].Data), `"spaceReclaimed": 1024`)
+}
+
+func TestVulnerabilityEmailAttachments_NoFindings(t *testing.T) {
+	assert.Empty(t, vulnerabilityEmailAttachmentsInternal(t.Context(), VulnerabilityNotificationPayload{CVEID: "Daily Summary"}))
+}
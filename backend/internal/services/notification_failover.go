@@ -0,0 +1,159 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+)
+
+// notificationDeliveryAttempts is how often a provider is tried before its
+// delivery counts as failed.
+const notificationDeliveryAttempts = 3
+
+// notificationDeliveryInternal is one notification to send through every
+// provider that has its event enabled.
+type notificationDeliveryInternal struct {
+	eventType models.NotificationEventType
+	target    string // what the notification is about, logged as the notification target
+	metadata  models.JSON
+	// send formats and sends the notification through a single provider.
+	// handled is false when the provider is unknown.
+	send func(ctx context.Context, provider models.NotificationProvider, config models.JSON) (handled bool, err error)
+}
+
+// deliverInternal sends a notification through the primary providers that have
+// its event enabled and to the users subscribed to it. When a primary provider
+// still fails after its retries, the same notification is sent through the
+// fallback providers, and their log entries record which providers they stood
+// in for. Fallback providers are used as primary ones when no primary provider
+// has the event enabled, so that marking every provider as a fallback does not
+// silence the event. Personal targets reach a single user, so their failures
+// never trigger a failover.
+func (s *NotificationService) deliverInternal(ctx context.Context, d notificationDeliveryInternal) error {
+	settings, personal, err := s.getDeliveryTargetsInternal(ctx, d.eventType)
+	if err != nil {
+		return fmt.Errorf("failed to get notification settings: %w", err)
+	}
+
+	primaries, fallbacks := s.splitDeliveryTargetsInternal(settings, d.eventType)
+
+	targets := append(slices.Clone(primaries), personal...)
+	var errs []string
+	var failed []string
+	for i, sendErr := range s.deliverToProvidersInternal(ctx, d, targets, nil) {
+		if sendErr == nil {
+			continue
+		}
+		errs = append(errs, fmt.Sprintf("%s: %s", targets[i].Provider, sendErr.Error()))
+		if i < len(primaries) {
+			failed = append(failed, string(targets[i].Provider))
+		}
+	}
+
+	if len(failed) > 0 && len(fallbacks) > 0 {
+		slog.WarnContext(ctx, "Primary notification providers failed, failing over", "eventType", d.eventType, "failed", failed, "fallbacks", len(fallbacks))
+		for i, sendErr := range s.deliverToProvidersInternal(ctx, d, fallbacks, failed) {
+			if sendErr != nil {
+				errs = append(errs, fmt.Sprintf("%s: %s", fallbacks[i].Provider, sendErr.Error()))
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("notification errors: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// deliverToProvidersInternal sends a notification through every target at
+// once, so that one provider's retries do not hold up the others, and returns
+// the error of each target in order.
+func (s *NotificationService) deliverToProvidersInternal(ctx context.Context, d notificationDeliveryInternal, targets []models.NotificationSettings, failoverFrom []string) []error {
+	errs := make([]error, len(targets))
+	var wg sync.WaitGroup
+	for i, target := range targets {
+		wg.Go(func() {
+			errs[i] = s.deliverToProviderInternal(ctx, d, target, failoverFrom)
+		})
+	}
+	wg.Wait()
+	return errs
+}
+
+// splitDeliveryTargetsInternal returns the enabled providers with eventType
+// enabled, split by their role.
+func (s *NotificationService) splitDeliveryTargetsInternal(settings []models.NotificationSettings, eventType models.NotificationEventType) (primaries, fallbacks []models.NotificationSettings) {
+	for _, setting := range settings {
+		if !setting.Enabled || !s.isEventEnabled(setting.Config, eventType) {
+			continue
+		}
+		if role, _ := setting.Config["role"].(string); role == models.NotificationRoleFallback {
+			fallbacks = append(fallbacks, setting)
+		} else {
+			primaries = append(primaries, setting)
+		}
+	}
+	if len(primaries) == 0 {
+		return fallbacks, nil
+	}
+	return primaries, fallbacks
+}
+
+// deliverToProviderInternal sends a notification through one provider, trying
+// it up to notificationDeliveryAttempts times, and logs the outcome.
+// failoverFrom lists the providers a fallback provider stands in for. Unknown
+// providers are skipped without an error.
+func (s *NotificationService) deliverToProviderInternal(ctx context.Context, d notificationDeliveryInternal, setting models.NotificationSettings, failoverFrom []string) error {
+	var sendErr error
+	attempts := 0
+	for attempts < notificationDeliveryAttempts {
+		if attempts > 0 {
+			select {
+			case <-ctx.Done():
+			case <-time.After(time.Duration(attempts) * s.retryDelay):
+			}
+			if ctx.Err() != nil {
+				break
+			}
+		}
+		attempts++
+
+		var handled bool
+		handled, sendErr = d.send(ctx, setting.Provider, setting.Config)
+		if !handled {
+			slog.WarnContext(ctx, "Unknown notification provider", "provider", setting.Provider)
+			return nil
+		}
+		if sendErr == nil {
+			break
+		}
+		slog.DebugContext(ctx, "Notification delivery attempt failed", "provider", setting.Provider, "attempt", attempts, "error", sendErr)
+	}
+
+	status := "success"
+	var errMsg *string
+	if sendErr != nil {
+		status = "failed"
+		errMsg = new(sendErr.Error())
+	}
+
+	metadata := models.JSON{}
+	maps.Copy(metadata, d.metadata)
+	metadata["eventType"] = string(d.eventType)
+	if attempts > 1 {
+		metadata["attempts"] = attempts
+	}
+	if len(failoverFrom) > 0 {
+		metadata["failoverFrom"] = failoverFrom
+	}
+	s.logNotification(ctx, setting.Provider, d.target, status, errMsg, metadata)
+
+	return sendErr
+}
@@ -0,0 +1,50 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/crypto"
+)
+
+// The notification service stores Matrix sessions for the Matrix sender. They
+// hold the device's private keys, so they are encrypted at rest.
+
+// LoadMatrixSession implements notifications.MatrixStore.
+func (s *NotificationService) LoadMatrixSession(ctx context.Context, key string) ([]byte, error) {
+	var session models.MatrixSession
+	if err := s.db.WithContext(ctx).Where("id = ?", key).First(&session).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get Matrix session: %w", err)
+	}
+
+	state, err := crypto.Decrypt(session.State)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt Matrix session: %w", err)
+	}
+	return []byte(state), nil
+}
+
+// SaveMatrixSession implements notifications.MatrixStore.
+func (s *NotificationService) SaveMatrixSession(ctx context.Context, key string, state []byte) error {
+	encrypted, err := crypto.Encrypt(string(state))
+	if err != nil {
+		return fmt.Errorf("failed to encrypt Matrix session: %w", err)
+	}
+
+	session := models.MatrixSession{State: encrypted, BaseModel: models.BaseModel{ID: key}}
+	if err := s.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"state", "updated_at"}),
+	}).Create(&session).Error; err != nil {
+		return fmt.Errorf("failed to save Matrix session: %w", err)
+	}
+	return nil
+}
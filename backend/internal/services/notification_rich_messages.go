@@ -0,0 +1,241 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/notifications"
+	"github.com/getarcaneapp/arcane/types/imageupdate"
+	"github.com/getarcaneapp/arcane/types/system"
+)
+
+// The builders below describe each notification once for the providers that
+// render rich messages (Discord embeds and Slack Block Kit). text is the
+// provider's existing markdown message, kept as the plain-text version.
+
+// appLinkInternal returns the URL of a page in Arcane, or "" when the app
+// URL is unknown.
+func (s *NotificationService) appLinkInternal(path string) string {
+	appURL := strings.TrimRight(s.config.GetAppURL(), "/")
+	if appURL == "" {
+		return ""
+	}
+	return appURL + path
+}
+
+// containerLinkInternal returns the URL of a container's page. Docker
+// resolves container names as well as IDs.
+func (s *NotificationService) containerLinkInternal(container string) string {
+	if container == "" {
+		return s.appLinkInternal("/containers")
+	}
+	return s.appLinkInternal("/containers/" + url.PathEscape(container))
+}
+
+// alertLinkInternal returns the URL of the page an alert is about, falling
+// back to the events list.
+func (s *NotificationService) alertLinkInternal(alert AlertNotification) string {
+	metadataString := func(key string) string {
+		value, _ := alert.Metadata[key].(string)
+		return value
+	}
+	for _, key := range []string{"containerID", "containerId", "containerName"} {
+		if container := metadataString(key); container != "" {
+			return s.containerLinkInternal(container)
+		}
+	}
+	if projectID := metadataString("projectId"); projectID != "" {
+		return s.appLinkInternal("/projects/" + url.PathEscape(projectID))
+	}
+	if metadataString("imageRef") != "" {
+		return s.appLinkInternal("/images")
+	}
+	if releaseURL := metadataString("releaseUrl"); releaseURL != "" {
+		return releaseURL
+	}
+	return s.appLinkInternal("/events")
+}
+
+// imageUpdateActionsInternal returns the action links for an update of
+// imageRef, if action links are set up.
+func (s *NotificationService) imageUpdateActionsInternal(ctx context.Context, imageRef string) []notifications.RichLink {
+	if s.ImageUpdateActions == nil {
+		return nil
+	}
+	return s.ImageUpdateActions(ctx, imageRef)
+}
+
+func codeFieldInternal(name, value string) notifications.RichField {
+	if value == "" {
+		return notifications.RichField{Name: name, Value: "-"}
+	}
+	return notifications.RichField{Name: name, Value: "`" + value + "`"}
+}
+
+func (s *NotificationService) imageUpdateRichMessageInternal(ctx context.Context, imageRef string, updateInfo *imageupdate.Response, text string) notifications.RichMessage {
+	msg := notifications.RichMessage{
+		Title:       "Container Image Update",
+		Description: "No update available.",
+		Severity:    notifications.RichSeverityInfo,
+		Fields: []notifications.RichField{
+			{Name: "Image", Value: imageRef, Inline: true},
+			{Name: "Update Type", Value: updateInfo.UpdateType, Inline: true},
+			codeFieldInternal("Current Digest", updateInfo.CurrentDigest),
+			codeFieldInternal("Latest Digest", updateInfo.LatestDigest),
+		},
+		URL:       s.appLinkInternal("/images"),
+		LinkLabel: "View images",
+		Text:      text,
+	}
+	if updateInfo.HasUpdate {
+		msg.Description = "⚠️ An update is available."
+		msg.Severity = notifications.RichSeverityWarning
+		msg.Actions = s.imageUpdateActionsInternal(ctx, imageRef)
+	}
+	return msg
+}
+
+func (s *NotificationService) containerUpdateRichMessageInternal(containerName, imageRef, oldDigest, newDigest, text string) notifications.RichMessage {
+	return notifications.RichMessage{
+		Title:       "✅ Container Successfully Updated",
+		Description: "Your container has been updated with the latest image version.",
+		Severity:    notifications.RichSeveritySuccess,
+		Fields: []notifications.RichField{
+			{Name: "Container", Value: containerName, Inline: true},
+			{Name: "Image", Value: imageRef, Inline: true},
+			codeFieldInternal("Previous Version", oldDigest),
+			codeFieldInternal("Current Version", newDigest),
+		},
+		URL:       s.containerLinkInternal(containerName),
+		LinkLabel: "View container",
+		Text:      text,
+	}
+}
+
+// batchUpdateMaxActionImages caps how many images of a batch notification get
+// action links, keeping the message within the button limits of Discord and
+// Slack.
+const batchUpdateMaxActionImages = 10
+
+func (s *NotificationService) batchUpdateRichMessageInternal(ctx context.Context, updates map[string]*imageupdate.Response, text string) notifications.RichMessage {
+	description := fmt.Sprintf("%d container image(s) have updates available.", len(updates))
+	if len(updates) == 1 {
+		description = "1 container image has an update available."
+	}
+
+	fields := make([]notifications.RichField, 0, len(updates))
+	var actions []notifications.RichLink
+	for _, imageRef := range slices.Sorted(maps.Keys(updates)) {
+		update := updates[imageRef]
+		if update == nil {
+			continue
+		}
+		fields = append(fields, notifications.RichField{
+			Name:  imageRef,
+			Value: fmt.Sprintf("Type: %s\nCurrent: `%s`\nLatest: `%s`", update.UpdateType, update.CurrentDigest, update.LatestDigest),
+		})
+		if len(fields) <= batchUpdateMaxActionImages {
+			for _, action := range s.imageUpdateActionsInternal(ctx, imageRef) {
+				action.Label += ": " + imageRef
+				actions = append(actions, action)
+			}
+		}
+	}
+
+	return notifications.RichMessage{
+		Title:       "Container Image Updates Available",
+		Description: description,
+		Severity:    notifications.RichSeverityWarning,
+		Fields:      fields,
+		URL:         s.appLinkInternal("/images"),
+		LinkLabel:   "View images",
+		Actions:     actions,
+		Text:        text,
+	}
+}
+
+func (s *NotificationService) vulnerabilityRichMessageInternal(payload VulnerabilityNotificationPayload, text string) notifications.RichMessage {
+	fields := []notifications.RichField{
+		{Name: "Overview", Value: payload.ImageName},
+		{Name: "Fixable", Value: payload.FixedVersion, Inline: true},
+		{Name: "Severity", Value: payload.Severity, Inline: true},
+	}
+	if payload.PkgName != "" {
+		fields = append(fields, notifications.RichField{Name: "Sample CVEs", Value: payload.PkgName})
+	}
+
+	severity := notifications.RichSeverityWarning
+	if strings.Contains(payload.Severity, "CRITICAL") || (strings.Contains(payload.Severity, "Critical:") && !strings.Contains(payload.Severity, "Critical:0")) {
+		severity = notifications.RichSeverityError
+	}
+
+	return notifications.RichMessage{
+		Title:       "🛡️ " + payload.CVEID,
+		Description: "Fixable vulnerabilities were found in your images.",
+		Severity:    severity,
+		Fields:      fields,
+		URL:         s.appLinkInternal("/security"),
+		LinkLabel:   "View vulnerabilities",
+		Text:        text,
+	}
+}
+
+func (s *NotificationService) pruneRichMessageInternal(result *system.PruneAllResult, text string) notifications.RichMessage {
+	return notifications.RichMessage{
+		Title:       "🧹 System Prune Report",
+		Description: fmt.Sprintf("%s reclaimed.", s.formatBytesInternal(result.SpaceReclaimed)),
+		Severity:    notifications.RichSeveritySuccess,
+		Fields: []notifications.RichField{
+			{Name: "Containers", Value: s.formatBytesInternal(result.ContainerSpaceReclaimed), Inline: true},
+			{Name: "Images", Value: s.formatBytesInternal(result.ImageSpaceReclaimed), Inline: true},
+			{Name: "Volumes", Value: s.formatBytesInternal(result.VolumeSpaceReclaimed), Inline: true},
+			{Name: "Build Cache", Value: s.formatBytesInternal(result.BuildCacheSpaceReclaimed), Inline: true},
+		},
+		URL:       s.appLinkInternal("/dashboard"),
+		LinkLabel: "Open dashboard",
+		Text:      text,
+	}
+}
+
+func (s *NotificationService) autoHealRichMessageInternal(containerName, text string) notifications.RichMessage {
+	return notifications.RichMessage{
+		Title:       "Auto Heal",
+		Description: fmt.Sprintf("Container '%s' was automatically restarted because it was unhealthy.", containerName),
+		Severity:    notifications.RichSeverityWarning,
+		Fields:      []notifications.RichField{{Name: "Container", Value: containerName, Inline: true}},
+		URL:         s.containerLinkInternal(containerName),
+		LinkLabel:   "View container",
+		Text:        text,
+	}
+}
+
+func (s *NotificationService) alertRichMessageInternal(alert AlertNotification, text string) notifications.RichMessage {
+	severity := notifications.RichSeverityWarning
+	switch alert.EventType {
+	case models.NotificationEventEnvironmentOnline, models.NotificationEventMonitorUp:
+		severity = notifications.RichSeveritySuccess
+	case models.NotificationEventArcaneUpdate:
+		severity = notifications.RichSeverityInfo
+	case models.NotificationEventContainerCrash, models.NotificationEventMonitorDown, models.NotificationEventEnvironmentOffline,
+		models.NotificationEventContainerTaskFailed, models.NotificationEventRolloutHalted:
+		severity = notifications.RichSeverityError
+	}
+
+	msg := notifications.RichMessage{
+		Title:       alert.Title,
+		Description: alert.Message,
+		Severity:    severity,
+		URL:         s.alertLinkInternal(alert),
+		LinkLabel:   "View details",
+		Text:        text,
+	}
+	if alert.Subject != "" {
+		msg.Fields = []notifications.RichField{{Name: "Subject", Value: alert.Subject, Inline: true}}
+	}
+	return msg
+}
@@ -3,11 +3,18 @@ package services
 import (
 	"bytes"
 	"context"
+	"crypto/cipher"
+	crand "crypto/rand"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"html"
+	"io"
 	"log/slog"
+	"maps"
 	"net/mail"
+	"slices"
 	"strings"
 	"text/template"
 	"time"
@@ -20,7 +27,9 @@ import (
 	"github.com/getarcaneapp/arcane/backend/internal/utils/crypto"
 	"github.com/getarcaneapp/arcane/backend/internal/utils/notifications"
 	"github.com/getarcaneapp/arcane/backend/resources"
+	"github.com/getarcaneapp/arcane/types/base"
 	"github.com/getarcaneapp/arcane/types/imageupdate"
+	"github.com/getarcaneapp/arcane/types/notification"
 	"github.com/getarcaneapp/arcane/types/system"
 )
 
@@ -33,6 +42,15 @@ const (
 	notificationTestTypeVulnerability    = "vulnerability-found"
 	notificationTestTypePruneReport      = "prune-report"
 	notificationTestTypeAutoHeal         = "auto-heal"
+
+	notificationBundleVersion = 1
+)
+
+var (
+	ErrNotificationBundleInvalid            = errors.New("invalid notification settings bundle")
+	ErrNotificationBundlePassphraseRequired = errors.New("passphrase is required to import the secrets in this bundle")
+	ErrNotificationBundleDecryptFailed      = errors.New("failed to decrypt notification settings bundle: wrong passphrase or corrupted bundle")
+	ErrNotificationTLSInvalid               = errors.New("invalid TLS settings")
 )
 
 var supportedNotificationTestTypes = map[string]struct{}{
@@ -54,12 +72,33 @@ type VulnerabilityNotificationPayload struct {
 	FixedVersion     string
 	PkgName          string // optional
 	InstalledVersion string // optional
+	// Findings lists every vulnerability behind a summary. Email attaches
+	// them as CSV and JSON; other providers only send the summary.
+	Findings []VulnerabilityFinding
+}
+
+// VulnerabilityFinding is one vulnerability of one image in a report.
+type VulnerabilityFinding struct {
+	ImageName        string `json:"imageName"`
+	VulnerabilityID  string `json:"vulnerabilityId"`
+	Severity         string `json:"severity"`
+	PkgName          string `json:"pkgName"`
+	InstalledVersion string `json:"installedVersion"`
+	FixedVersion     string `json:"fixedVersion,omitempty"`
+	Title            string `json:"title,omitempty"`
 }
 
 type NotificationService struct {
 	db             *database.DB
 	config         *config.Config
 	appriseService *AppriseService
+	// retryDelay is the wait before the first retry of a failed delivery;
+	// later retries wait longer.
+	retryDelay time.Duration
+
+	// ImageUpdateActions returns the signed action links added to image update
+	// notifications. It is set once the updater exists.
+	ImageUpdateActions func(ctx context.Context, imageRef string) []notifications.RichLink
 }
 
 func NewNotificationService(db *database.DB, cfg *config.Config) *NotificationService {
@@ -67,6 +106,7 @@ func NewNotificationService(db *database.DB, cfg *config.Config) *NotificationSe
 		db:             db,
 		config:         cfg,
 		appriseService: NewAppriseService(db, cfg),
+		retryDelay:     2 * time.Second,
 	}
 }
 
@@ -93,6 +133,9 @@ func (s *NotificationService) CreateOrUpdateSettings(ctx context.Context, provid
 	if !enabled {
 		config = models.JSON{}
 	}
+	if err := validateNotificationTLSInternal(config); err != nil {
+		return nil, err
+	}
 
 	err := s.db.WithContext(ctx).Where("provider = ?", provider).First(&setting).Error
 	if err != nil {
@@ -107,6 +150,10 @@ func (s *NotificationService) CreateOrUpdateSettings(ctx context.Context, provid
 	} else {
 		setting.Enabled = enabled
 		setting.Config = config
+		// New credentials have not been checked yet.
+		setting.CredentialStatus = nil
+		setting.CredentialError = nil
+		setting.CredentialCheckedAt = nil
 		if err := s.db.WithContext(ctx).Save(&setting).Error; err != nil {
 			return nil, fmt.Errorf("failed to update notification settings: %w", err)
 		}
@@ -115,6 +162,28 @@ func (s *NotificationService) CreateOrUpdateSettings(ctx context.Context, provid
 	return &setting, nil
 }
 
+// validateNotificationTLSInternal rejects per-provider TLS settings that could
+// never be used, so the mistake shows up on save rather than on the next send.
+func validateNotificationTLSInternal(config models.JSON) error {
+	raw, ok := config["tls"]
+	if !ok || raw == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrNotificationTLSInvalid, err)
+	}
+	var opts models.NotificationTLSConfig
+	if err := json.Unmarshal(data, &opts); err != nil {
+		return fmt.Errorf("%w: %w", ErrNotificationTLSInvalid, err)
+	}
+	if err := notifications.ValidateTLSConfig(&opts); err != nil {
+		return fmt.Errorf("%w: %w", ErrNotificationTLSInvalid, err)
+	}
+	return nil
+}
+
 func (s *NotificationService) DeleteSettings(ctx context.Context, provider models.NotificationProvider) error {
 	if err := s.db.WithContext(ctx).Where("provider = ?", provider).Delete(&models.NotificationSettings{}).Error; err != nil {
 		return fmt.Errorf("failed to delete notification settings: %w", err)
@@ -122,78 +191,288 @@ func (s *NotificationService) DeleteSettings(ctx context.Context, provider model
 	return nil
 }
 
+// ExportSettings returns every provider configuration as a portable bundle.
+// Secrets are decrypted with the instance key and, when a passphrase is given,
+// re-encrypted with a key derived from it; otherwise they are left out.
+func (s *NotificationService) ExportSettings(ctx context.Context, passphrase string) (*notification.Bundle, error) {
+	var settings []models.NotificationSettings
+	if err := s.db.WithContext(ctx).Order("provider").Find(&settings).Error; err != nil {
+		return nil, fmt.Errorf("failed to get notification settings: %w", err)
+	}
+
+	bundle := &notification.Bundle{
+		Version:    notificationBundleVersion,
+		ExportedAt: time.Now().UTC(),
+		Providers:  make([]notification.BundleProvider, 0, len(settings)),
+	}
+
+	var gcm cipher.AEAD
+	if passphrase != "" {
+		salt := make([]byte, backupSaltSize)
+		if _, err := io.ReadFull(crand.Reader, salt); err != nil {
+			return nil, fmt.Errorf("failed to generate salt: %w", err)
+		}
+		var err error
+		if gcm, err = newBackupCipher(passphrase, salt); err != nil {
+			return nil, err
+		}
+		bundle.Salt = base64.StdEncoding.EncodeToString(salt)
+	}
+
+	for _, setting := range settings {
+		cfg, secretKeys := decryptNotificationSecrets(setting.Config)
+		for _, key := range secretKeys {
+			if gcm == nil {
+				delete(cfg, key)
+				continue
+			}
+			sealed, err := sealBundleSecretInternal(gcm, setting.Provider, key, cfg[key].(string))
+			if err != nil {
+				return nil, err
+			}
+			cfg[key] = sealed
+		}
+
+		bundle.Providers = append(bundle.Providers, notification.BundleProvider{
+			Provider:   notification.Provider(setting.Provider),
+			Enabled:    setting.Enabled,
+			Config:     base.JsonObject(cfg),
+			SecretKeys: secretKeys,
+		})
+	}
+
+	return bundle, nil
+}
+
+// ImportSettings creates or updates providers from a bundle. Secrets missing
+// from the bundle keep their current value when the provider already exists.
+func (s *NotificationService) ImportSettings(ctx context.Context, bundle notification.Bundle, passphrase string) (*notification.SettingsImportResult, error) {
+	if bundle.Version != notificationBundleVersion {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrNotificationBundleInvalid, bundle.Version)
+	}
+
+	var gcm cipher.AEAD
+	if bundle.Salt != "" {
+		if passphrase == "" {
+			return nil, ErrNotificationBundlePassphraseRequired
+		}
+		salt, err := base64.StdEncoding.DecodeString(bundle.Salt)
+		if err != nil || len(salt) != backupSaltSize {
+			return nil, fmt.Errorf("%w: malformed salt", ErrNotificationBundleInvalid)
+		}
+		if gcm, err = newBackupCipher(passphrase, salt); err != nil {
+			return nil, err
+		}
+	}
+
+	result := &notification.SettingsImportResult{Imported: []notification.Provider{}}
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, item := range bundle.Providers {
+			provider := models.NotificationProvider(item.Provider)
+			if !models.IsValidNotificationProvider(provider) {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("skipped unknown notification provider %q", item.Provider))
+				continue
+			}
+
+			var existing models.NotificationSettings
+			err := tx.Where("provider = ?", provider).First(&existing).Error
+			switch {
+			case errors.Is(err, gorm.ErrRecordNotFound):
+				existing = models.NotificationSettings{Provider: provider}
+			case err != nil:
+				return fmt.Errorf("failed to load notification provider %s: %w", provider, err)
+			}
+
+			cfg := make(models.JSON, len(item.Config))
+			maps.Copy(cfg, models.JSON(item.Config))
+
+			var plainSecretKeys []string
+			for _, key := range item.SecretKeys {
+				sealed, ok := cfg[key].(string)
+				if ok && sealed != "" && gcm != nil {
+					plain, err := openBundleSecretInternal(gcm, provider, key, sealed)
+					if err != nil {
+						return err
+					}
+					cfg[key] = plain
+					plainSecretKeys = append(plainSecretKeys, key)
+					continue
+				}
+				// Keep the stored value, which is already encrypted at rest.
+				if current, found := existing.Config[key]; found {
+					cfg[key] = current
+					continue
+				}
+				delete(cfg, key)
+				result.Warnings = append(result.Warnings, fmt.Sprintf("%s: secret %q was not included and must be re-entered", provider, key))
+			}
+
+			cfg, err = encryptNotificationSecrets(cfg, plainSecretKeys)
+			if err != nil {
+				return fmt.Errorf("failed to encrypt secrets of notification provider %s: %w", provider, err)
+			}
+
+			existing.Enabled = item.Enabled
+			existing.Config = cfg
+			existing.CredentialStatus = nil
+			existing.CredentialError = nil
+			existing.CredentialCheckedAt = nil
+			if err := tx.Save(&existing).Error; err != nil {
+				return fmt.Errorf("failed to save notification provider %s: %w", provider, err)
+			}
+			result.Imported = append(result.Imported, item.Provider)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// sealBundleSecretInternal encrypts one secret value, binding it to its
+// provider and key so values cannot be swapped between entries.
+func sealBundleSecretInternal(gcm cipher.AEAD, provider models.NotificationProvider, key, plain string) (string, error) {
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(crand.Reader, nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	sealed := gcm.Seal(nonce, nonce, []byte(plain), []byte(string(provider)+"/"+key))
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func openBundleSecretInternal(gcm cipher.AEAD, provider models.NotificationProvider, key, sealed string) (string, error) {
+	raw, err := base64.StdEncoding.DecodeString(sealed)
+	if err != nil || len(raw) < gcm.NonceSize() {
+		return "", fmt.Errorf("%w: malformed secret %s/%s", ErrNotificationBundleInvalid, provider, key)
+	}
+	plain, err := gcm.Open(nil, raw[:gcm.NonceSize()], raw[gcm.NonceSize():], []byte(string(provider)+"/"+key))
+	if err != nil {
+		return "", ErrNotificationBundleDecryptFailed
+	}
+	return string(plain), nil
+}
+
 func (s *NotificationService) SendImageUpdateNotification(ctx context.Context, imageRef string, updateInfo *imageupdate.Response, eventType models.NotificationEventType) error {
+	if _, snoozed := snoozedImageRefsInternal(ctx, s.db)[imageSnoozeKeyInternal(imageRef)]; snoozed {
+		slog.DebugContext(ctx, "Skipping snoozed image update notification", "imageRef", imageRef)
+		return nil
+	}
+
 	// Send to Apprise if enabled (don't block on error)
 	if appriseErr := s.appriseService.SendImageUpdateNotification(ctx, imageRef, updateInfo); appriseErr != nil {
 		slog.WarnContext(ctx, "Failed to send Apprise notification", "error", appriseErr)
 	}
 
-	settings, err := s.GetAllSettings(ctx)
+	return s.deliverInternal(ctx, notificationDeliveryInternal{
+		eventType: eventType,
+		target:    imageRef,
+		metadata: models.JSON{
+			"hasUpdate":     updateInfo.HasUpdate,
+			"currentDigest": updateInfo.CurrentDigest,
+			"latestDigest":  updateInfo.LatestDigest,
+			"updateType":    updateInfo.UpdateType,
+		},
+		send: func(ctx context.Context, provider models.NotificationProvider, config models.JSON) (bool, error) {
+			switch provider {
+			case models.NotificationProviderDiscord:
+				return true, s.sendDiscordNotification(ctx, imageRef, updateInfo, config)
+			case models.NotificationProviderEmail:
+				return true, s.sendEmailNotification(ctx, imageRef, updateInfo, config)
+			case models.NotificationProviderTelegram:
+				return true, s.sendTelegramNotification(ctx, imageRef, updateInfo, config)
+			case models.NotificationProviderSignal:
+				return true, s.sendSignalNotification(ctx, imageRef, updateInfo, config)
+			case models.NotificationProviderSlack:
+				return true, s.sendSlackNotification(ctx, imageRef, updateInfo, config)
+			case models.NotificationProviderNtfy:
+				return true, s.sendNtfyNotification(ctx, imageRef, updateInfo, config)
+			case models.NotificationProviderPushover:
+				return true, s.sendPushoverNotification(ctx, imageRef, updateInfo, config)
+			case models.NotificationProviderGotify:
+				return true, s.sendGotifyNotification(ctx, imageRef, updateInfo, config)
+			case models.NotificationProviderMatrix:
+				return true, s.sendMatrixNotification(ctx, imageRef, updateInfo, config)
+			case models.NotificationProviderGeneric:
+				return true, s.sendGenericNotification(ctx, imageRef, updateInfo, config)
+			default:
+				return false, nil
+			}
+		},
+	})
+}
+
+// getDeliveryTargetsInternal returns the global provider settings and one
+// personal target per user subscribed to eventType. A subscription reuses the
+// global provider's credentials with the user's own address or chat ID, so it
+// only applies while that provider is enabled.
+func (s *NotificationService) getDeliveryTargetsInternal(ctx context.Context, eventType models.NotificationEventType) (settings, personal []models.NotificationSettings, err error) {
+	settings, err = s.GetAllSettings(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to get notification settings: %w", err)
+		return nil, nil, err
 	}
 
-	var errors []string
+	var subs []models.UserNotificationSubscription
+	if err := s.db.WithContext(ctx).Where("enabled = ?", true).Find(&subs).Error; err != nil {
+		// Personal subscriptions must never block the global notifications.
+		slog.WarnContext(ctx, "Failed to load user notification subscriptions", "error", err)
+		return settings, nil, nil
+	}
+
+	global := make(map[models.NotificationProvider]models.JSON, len(settings))
 	for _, setting := range settings {
-		if !setting.Enabled {
-			continue
+		if setting.Enabled {
+			global[setting.Provider] = setting.Config
 		}
+	}
 
-		// Check if this event type is enabled for this provider
-		if !s.isEventEnabled(setting.Config, eventType) {
+	for _, sub := range subs {
+		if !slices.Contains(sub.Events, string(eventType)) {
 			continue
 		}
-
-		var sendErr error
-		switch setting.Provider {
-		case models.NotificationProviderDiscord:
-			sendErr = s.sendDiscordNotification(ctx, imageRef, updateInfo, setting.Config)
-		case models.NotificationProviderEmail:
-			sendErr = s.sendEmailNotification(ctx, imageRef, updateInfo, setting.Config)
-		case models.NotificationProviderTelegram:
-			sendErr = s.sendTelegramNotification(ctx, imageRef, updateInfo, setting.Config)
-		case models.NotificationProviderSignal:
-			sendErr = s.sendSignalNotification(ctx, imageRef, updateInfo, setting.Config)
-		case models.NotificationProviderSlack:
-			sendErr = s.sendSlackNotification(ctx, imageRef, updateInfo, setting.Config)
-		case models.NotificationProviderNtfy:
-			sendErr = s.sendNtfyNotification(ctx, imageRef, updateInfo, setting.Config)
-		case models.NotificationProviderPushover:
-			sendErr = s.sendPushoverNotification(ctx, imageRef, updateInfo, setting.Config)
-		case models.NotificationProviderGotify:
-			sendErr = s.sendGotifyNotification(ctx, imageRef, updateInfo, setting.Config)
-		case models.NotificationProviderMatrix:
-			sendErr = s.sendMatrixNotification(ctx, imageRef, updateInfo, setting.Config)
-		case models.NotificationProviderGeneric:
-			sendErr = s.sendGenericNotification(ctx, imageRef, updateInfo, setting.Config)
-		default:
-			slog.WarnContext(ctx, "Unknown notification provider", "provider", setting.Provider)
+		globalConfig, ok := global[sub.Provider]
+		if !ok {
 			continue
 		}
-
-		status := "success"
-		var errMsg *string
-		if sendErr != nil {
-			status = "failed"
-			msg := sendErr.Error()
-			errMsg = new(msg)
-			errors = append(errors, fmt.Sprintf("%s: %s", setting.Provider, msg))
+		config, ok := personalProviderConfigInternal(sub.Provider, globalConfig, sub.Target)
+		if !ok {
+			continue
 		}
+		personal = append(personal, models.NotificationSettings{Provider: sub.Provider, Enabled: true, Config: config})
+	}
+	return settings, personal, nil
+}
 
-		s.logNotification(ctx, setting.Provider, imageRef, status, errMsg, models.JSON{
-			"hasUpdate":     updateInfo.HasUpdate,
-			"currentDigest": updateInfo.CurrentDigest,
-			"latestDigest":  updateInfo.LatestDigest,
-			"updateType":    updateInfo.UpdateType,
-			"eventType":     string(eventType),
-		})
+// personalProviderConfigInternal copies a global provider config with its
+// recipients replaced by target. ok is false when the provider does not support
+// personal targets or target already receives the global notification.
+func personalProviderConfigInternal(provider models.NotificationProvider, globalConfig models.JSON, target string) (models.JSON, bool) {
+	var key string
+	switch provider {
+	case models.NotificationProviderEmail:
+		key = "toAddresses"
+	case models.NotificationProviderTelegram:
+		key = "chatIds"
+	default:
+		return nil, false
 	}
 
-	if len(errors) > 0 {
-		return fmt.Errorf("notification errors: %s", strings.Join(errors, "; "))
+	if existing, ok := globalConfig[key].([]any); ok {
+		for _, v := range existing {
+			if str, ok := v.(string); ok && strings.EqualFold(strings.TrimSpace(str), target) {
+				return nil, false
+			}
+		}
 	}
 
-	return nil
+	config := maps.Clone(globalConfig)
+	config[key] = []string{target}
+	// The user's subscription already selected the event, and a personal
+	// target is never a failover target of the global providers.
+	delete(config, "events")
+	delete(config, "role")
+	return config, true
 }
 
 // isEventEnabled checks if a specific event type is enabled in the config
@@ -227,71 +506,41 @@ func (s *NotificationService) SendContainerUpdateNotification(ctx context.Contex
 		slog.WarnContext(ctx, "Failed to send Apprise notification", "error", appriseErr)
 	}
 
-	settings, err := s.GetAllSettings(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to get notification settings: %w", err)
-	}
-
-	var errors []string
-	for _, setting := range settings {
-		if !setting.Enabled {
-			continue
-		}
-
-		// Check if container update event is enabled for this provider
-		if !s.isEventEnabled(setting.Config, models.NotificationEventContainerUpdate) {
-			continue
-		}
-
-		var sendErr error
-		switch setting.Provider {
-		case models.NotificationProviderDiscord:
-			sendErr = s.sendDiscordContainerUpdateNotification(ctx, containerName, imageRef, oldDigest, newDigest, setting.Config)
-		case models.NotificationProviderEmail:
-			sendErr = s.sendEmailContainerUpdateNotification(ctx, containerName, imageRef, oldDigest, newDigest, setting.Config)
-		case models.NotificationProviderTelegram:
-			sendErr = s.sendTelegramContainerUpdateNotification(ctx, containerName, imageRef, oldDigest, newDigest, setting.Config)
-		case models.NotificationProviderSignal:
-			sendErr = s.sendSignalContainerUpdateNotification(ctx, containerName, imageRef, oldDigest, newDigest, setting.Config)
-		case models.NotificationProviderSlack:
-			sendErr = s.sendSlackContainerUpdateNotification(ctx, containerName, imageRef, oldDigest, newDigest, setting.Config)
-		case models.NotificationProviderNtfy:
-			sendErr = s.sendNtfyContainerUpdateNotification(ctx, containerName, imageRef, oldDigest, newDigest, setting.Config)
-		case models.NotificationProviderPushover:
-			sendErr = s.sendPushoverContainerUpdateNotification(ctx, containerName, imageRef, oldDigest, newDigest, setting.Config)
-		case models.NotificationProviderGotify:
-			sendErr = s.sendGotifyContainerUpdateNotification(ctx, containerName, imageRef, oldDigest, newDigest, setting.Config)
-		case models.NotificationProviderMatrix:
-			sendErr = s.sendMatrixContainerUpdateNotification(ctx, containerName, imageRef, oldDigest, newDigest, setting.Config)
-		case models.NotificationProviderGeneric:
-			sendErr = s.sendGenericContainerUpdateNotification(ctx, containerName, imageRef, oldDigest, newDigest, setting.Config)
-		default:
-			slog.WarnContext(ctx, "Unknown notification provider", "provider", setting.Provider)
-			continue
-		}
-
-		status := "success"
-		var errMsg *string
-		if sendErr != nil {
-			status = "failed"
-			msg := sendErr.Error()
-			errMsg = new(msg)
-			errors = append(errors, fmt.Sprintf("%s: %s", setting.Provider, msg))
-		}
-
-		s.logNotification(ctx, setting.Provider, imageRef, status, errMsg, models.JSON{
+	return s.deliverInternal(ctx, notificationDeliveryInternal{
+		eventType: models.NotificationEventContainerUpdate,
+		target:    imageRef,
+		metadata: models.JSON{
 			"containerName": containerName,
 			"oldDigest":     oldDigest,
 			"newDigest":     newDigest,
-			"eventType":     string(models.NotificationEventContainerUpdate),
-		})
-	}
-
-	if len(errors) > 0 {
-		return fmt.Errorf("notification errors: %s", strings.Join(errors, "; "))
-	}
-
-	return nil
+		},
+		send: func(ctx context.Context, provider models.NotificationProvider, config models.JSON) (bool, error) {
+			switch provider {
+			case models.NotificationProviderDiscord:
+				return true, s.sendDiscordContainerUpdateNotification(ctx, containerName, imageRef, oldDigest, newDigest, config)
+			case models.NotificationProviderEmail:
+				return true, s.sendEmailContainerUpdateNotification(ctx, containerName, imageRef, oldDigest, newDigest, config)
+			case models.NotificationProviderTelegram:
+				return true, s.sendTelegramContainerUpdateNotification(ctx, containerName, imageRef, oldDigest, newDigest, config)
+			case models.NotificationProviderSignal:
+				return true, s.sendSignalContainerUpdateNotification(ctx, containerName, imageRef, oldDigest, newDigest, config)
+			case models.NotificationProviderSlack:
+				return true, s.sendSlackContainerUpdateNotification(ctx, containerName, imageRef, oldDigest, newDigest, config)
+			case models.NotificationProviderNtfy:
+				return true, s.sendNtfyContainerUpdateNotification(ctx, containerName, imageRef, oldDigest, newDigest, config)
+			case models.NotificationProviderPushover:
+				return true, s.sendPushoverContainerUpdateNotification(ctx, containerName, imageRef, oldDigest, newDigest, config)
+			case models.NotificationProviderGotify:
+				return true, s.sendGotifyContainerUpdateNotification(ctx, containerName, imageRef, oldDigest, newDigest, config)
+			case models.NotificationProviderMatrix:
+				return true, s.sendMatrixContainerUpdateNotification(ctx, containerName, imageRef, oldDigest, newDigest, config)
+			case models.NotificationProviderGeneric:
+				return true, s.sendGenericContainerUpdateNotification(ctx, containerName, imageRef, oldDigest, newDigest, config)
+			default:
+				return false, nil
+			}
+		},
+	})
 }
 
 func isVulnerabilitySummaryPayload(payload VulnerabilityNotificationPayload) bool {
@@ -398,68 +647,41 @@ func (s *NotificationService) SendVulnerabilityNotification(ctx context.Context,
 		return nil
 	}
 
-	settings, err := s.GetAllSettings(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to get notification settings: %w", err)
-	}
-
-	var errors []string
-	for _, setting := range settings {
-		if !setting.Enabled {
-			continue
-		}
-		if !s.isEventEnabled(setting.Config, models.NotificationEventVulnerabilityFound) {
-			continue
-		}
-
-		var sendErr error
-		switch setting.Provider {
-		case models.NotificationProviderDiscord:
-			sendErr = s.sendDiscordVulnerabilityNotification(ctx, payload, setting.Config)
-		case models.NotificationProviderEmail:
-			sendErr = s.sendEmailVulnerabilityNotification(ctx, payload, setting.Config)
-		case models.NotificationProviderTelegram:
-			sendErr = s.sendTelegramVulnerabilityNotification(ctx, payload, setting.Config)
-		case models.NotificationProviderSignal:
-			sendErr = s.sendSignalVulnerabilityNotification(ctx, payload, setting.Config)
-		case models.NotificationProviderSlack:
-			sendErr = s.sendSlackVulnerabilityNotification(ctx, payload, setting.Config)
-		case models.NotificationProviderNtfy:
-			sendErr = s.sendNtfyVulnerabilityNotification(ctx, payload, setting.Config)
-		case models.NotificationProviderPushover:
-			sendErr = s.sendPushoverVulnerabilityNotification(ctx, payload, setting.Config)
-		case models.NotificationProviderGotify:
-			sendErr = s.sendGotifyVulnerabilityNotification(ctx, payload, setting.Config)
-		case models.NotificationProviderMatrix:
-			sendErr = s.sendMatrixVulnerabilityNotification(ctx, payload, setting.Config)
-		case models.NotificationProviderGeneric:
-			sendErr = s.sendGenericVulnerabilityNotification(ctx, payload, setting.Config)
-		default:
-			slog.WarnContext(ctx, "Unknown notification provider", "provider", setting.Provider)
-			continue
-		}
-
-		status := "success"
-		var errMsg *string
-		if sendErr != nil {
-			status = "failed"
-			msg := sendErr.Error()
-			errMsg = new(msg)
-			errors = append(errors, fmt.Sprintf("%s: %s", setting.Provider, msg))
-		}
-
-		s.logNotification(ctx, setting.Provider, payload.ImageName, status, errMsg, models.JSON{
+	return s.deliverInternal(ctx, notificationDeliveryInternal{
+		eventType: models.NotificationEventVulnerabilityFound,
+		target:    payload.ImageName,
+		metadata: models.JSON{
 			"cveId":        payload.CVEID,
 			"severity":     payload.Severity,
 			"fixedVersion": payload.FixedVersion,
-			"eventType":    string(models.NotificationEventVulnerabilityFound),
-		})
-	}
-
-	if len(errors) > 0 {
-		return fmt.Errorf("notification errors: %s", strings.Join(errors, "; "))
-	}
-	return nil
+		},
+		send: func(ctx context.Context, provider models.NotificationProvider, config models.JSON) (bool, error) {
+			switch provider {
+			case models.NotificationProviderDiscord:
+				return true, s.sendDiscordVulnerabilityNotification(ctx, payload, config)
+			case models.NotificationProviderEmail:
+				return true, s.sendEmailVulnerabilityNotification(ctx, payload, config)
+			case models.NotificationProviderTelegram:
+				return true, s.sendTelegramVulnerabilityNotification(ctx, payload, config)
+			case models.NotificationProviderSignal:
+				return true, s.sendSignalVulnerabilityNotification(ctx, payload, config)
+			case models.NotificationProviderSlack:
+				return true, s.sendSlackVulnerabilityNotification(ctx, payload, config)
+			case models.NotificationProviderNtfy:
+				return true, s.sendNtfyVulnerabilityNotification(ctx, payload, config)
+			case models.NotificationProviderPushover:
+				return true, s.sendPushoverVulnerabilityNotification(ctx, payload, config)
+			case models.NotificationProviderGotify:
+				return true, s.sendGotifyVulnerabilityNotification(ctx, payload, config)
+			case models.NotificationProviderMatrix:
+				return true, s.sendMatrixVulnerabilityNotification(ctx, payload, config)
+			case models.NotificationProviderGeneric:
+				return true, s.sendGenericVulnerabilityNotification(ctx, payload, config)
+			default:
+				return false, nil
+			}
+		},
+	})
 }
 
 func (s *NotificationService) sendDiscordNotification(ctx context.Context, imageRef string, updateInfo *imageupdate.Response, config models.JSON) error {
@@ -504,7 +726,7 @@ func (s *NotificationService) sendDiscordNotification(ctx context.Context, image
 		message += fmt.Sprintf("**Latest Digest:** `%s`\n", updateInfo.LatestDigest)
 	}
 
-	if err := notifications.SendDiscord(ctx, discordConfig, message); err != nil {
+	if err := notifications.SendDiscordRich(ctx, discordConfig, s.imageUpdateRichMessageInternal(ctx, imageRef, updateInfo, message)); err != nil {
 		return fmt.Errorf("failed to send Discord notification: %w", err)
 	}
 
@@ -563,7 +785,7 @@ func (s *NotificationService) sendTelegramNotification(ctx context.Context, imag
 		telegramConfig.ParseMode = "HTML"
 	}
 
-	if err := notifications.SendTelegram(ctx, telegramConfig, message); err != nil {
+	if err := notifications.SendTelegram(ctx, telegramConfig, models.NotificationEventImageUpdate, message); err != nil {
 		return fmt.Errorf("failed to send Telegram notification: %w", err)
 	}
 
@@ -604,7 +826,7 @@ func (s *NotificationService) sendEmailNotification(ctx context.Context, imageRe
 		}
 	}
 
-	htmlBody, _, err := s.renderEmailTemplate(imageRef, updateInfo)
+	htmlBody, _, err := s.renderEmailTemplate(ctx, imageRef, updateInfo)
 	if err != nil {
 		return fmt.Errorf("failed to render email template: %w", err)
 	}
@@ -617,7 +839,7 @@ func (s *NotificationService) sendEmailNotification(ctx context.Context, imageRe
 	return nil
 }
 
-func (s *NotificationService) renderEmailTemplate(imageRef string, updateInfo *imageupdate.Response) (string, string, error) {
+func (s *NotificationService) renderEmailTemplate(ctx context.Context, imageRef string, updateInfo *imageupdate.Response) (string, string, error) {
 	appURL := s.config.GetAppURL()
 	logoURL := appURL + logoURLPath
 	data := map[string]any{
@@ -630,6 +852,7 @@ func (s *NotificationService) renderEmailTemplate(imageRef string, updateInfo *i
 		"CurrentDigest": updateInfo.CurrentDigest,
 		"LatestDigest":  updateInfo.LatestDigest,
 		"CheckTime":     updateInfo.CheckTime.Format(time.RFC1123),
+		"Actions":       s.imageUpdateActionsInternal(ctx, imageRef),
 	}
 
 	htmlContent, err := resources.FS.ReadFile("email-templates/image-update_html.tmpl")
@@ -703,7 +926,7 @@ func (s *NotificationService) sendDiscordContainerUpdateNotification(ctx context
 		message += fmt.Sprintf("**Current Version:** `%s`\n", newDigest)
 	}
 
-	if err := notifications.SendDiscord(ctx, discordConfig, message); err != nil {
+	if err := notifications.SendDiscordRich(ctx, discordConfig, s.containerUpdateRichMessageInternal(containerName, imageRef, oldDigest, newDigest, message)); err != nil {
 		return fmt.Errorf("failed to send Discord notification: %w", err)
 	}
 
@@ -756,7 +979,7 @@ func (s *NotificationService) sendTelegramContainerUpdateNotification(ctx contex
 		telegramConfig.ParseMode = "HTML"
 	}
 
-	if err := notifications.SendTelegram(ctx, telegramConfig, message); err != nil {
+	if err := notifications.SendTelegram(ctx, telegramConfig, models.NotificationEventContainerUpdate, message); err != nil {
 		return fmt.Errorf("failed to send Telegram notification: %w", err)
 	}
 
@@ -878,6 +1101,10 @@ func (s *NotificationService) TestNotification(ctx context.Context, provider mod
 			ImageName:    "5 image(s) scanned, 2 with fixable vulnerabilities",
 			FixedVersion: "7 fixable vulnerability record(s)",
 			PkgName:      "CVE-2025-1234, CVE-2025-5678, CVE-2026-0001",
+			Findings: []VulnerabilityFinding{
+				{ImageName: "nginx:1.25", VulnerabilityID: "CVE-2025-1234", Severity: "CRITICAL", PkgName: "openssl", InstalledVersion: "3.0.11", FixedVersion: "3.0.13"},
+				{ImageName: "postgres:16", VulnerabilityID: "CVE-2026-0001", Severity: "HIGH", PkgName: "libxml2", InstalledVersion: "2.9.14", FixedVersion: "2.9.14+dfsg-1.3"},
+			},
 		}
 		switch provider {
 		case models.NotificationProviderDiscord:
@@ -1193,74 +1420,45 @@ func (s *NotificationService) SendBatchImageUpdateNotification(ctx context.Conte
 		slog.WarnContext(ctx, "Failed to send Apprise notification", "error", appriseErr)
 	}
 
-	settings, err := s.GetAllSettings(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to get notification settings: %w", err)
+	imageRefs := make([]string, 0, len(updatesWithChanges))
+	for ref := range updatesWithChanges {
+		imageRefs = append(imageRefs, ref)
 	}
 
-	var errors []string
-	for _, setting := range settings {
-		if !setting.Enabled {
-			continue
-		}
-
-		if !s.isEventEnabled(setting.Config, models.NotificationEventImageUpdate) {
-			continue
-		}
-
-		var sendErr error
-		switch setting.Provider {
-		case models.NotificationProviderDiscord:
-			sendErr = s.sendBatchDiscordNotification(ctx, updatesWithChanges, setting.Config)
-		case models.NotificationProviderEmail:
-			sendErr = s.sendBatchEmailNotification(ctx, updatesWithChanges, setting.Config)
-		case models.NotificationProviderTelegram:
-			sendErr = s.sendBatchTelegramNotification(ctx, updatesWithChanges, setting.Config)
-		case models.NotificationProviderSignal:
-			sendErr = s.sendBatchSignalNotification(ctx, updatesWithChanges, setting.Config)
-		case models.NotificationProviderSlack:
-			sendErr = s.sendBatchSlackNotification(ctx, updatesWithChanges, setting.Config)
-		case models.NotificationProviderNtfy:
-			sendErr = s.sendBatchNtfyNotification(ctx, updatesWithChanges, setting.Config)
-		case models.NotificationProviderPushover:
-			sendErr = s.sendBatchPushoverNotification(ctx, updatesWithChanges, setting.Config)
-		case models.NotificationProviderGotify:
-			sendErr = s.sendBatchGotifyNotification(ctx, updatesWithChanges, setting.Config)
-		case models.NotificationProviderMatrix:
-			sendErr = s.sendBatchMatrixNotification(ctx, updatesWithChanges, setting.Config)
-		case models.NotificationProviderGeneric:
-			sendErr = s.sendBatchGenericNotification(ctx, updatesWithChanges, setting.Config)
-		default:
-			slog.WarnContext(ctx, "Unknown notification provider", "provider", setting.Provider)
-			continue
-		}
-
-		status := "success"
-		var errMsg *string
-		if sendErr != nil {
-			status = "failed"
-			msg := sendErr.Error()
-			errMsg = new(msg)
-			errors = append(errors, fmt.Sprintf("%s: %s", setting.Provider, msg))
-		}
-
-		imageRefs := make([]string, 0, len(updatesWithChanges))
-		for ref := range updatesWithChanges {
-			imageRefs = append(imageRefs, ref)
-		}
-
-		s.logNotification(ctx, setting.Provider, strings.Join(imageRefs, ", "), status, errMsg, models.JSON{
+	return s.deliverInternal(ctx, notificationDeliveryInternal{
+		eventType: models.NotificationEventImageUpdate,
+		target:    strings.Join(imageRefs, ", "),
+		metadata: models.JSON{
 			"updateCount": len(updatesWithChanges),
-			"eventType":   string(models.NotificationEventImageUpdate),
 			"batch":       true,
-		})
-	}
-
-	if len(errors) > 0 {
-		return fmt.Errorf("notification errors: %s", strings.Join(errors, "; "))
-	}
-
-	return nil
+		},
+		send: func(ctx context.Context, provider models.NotificationProvider, config models.JSON) (bool, error) {
+			switch provider {
+			case models.NotificationProviderDiscord:
+				return true, s.sendBatchDiscordNotification(ctx, updatesWithChanges, config)
+			case models.NotificationProviderEmail:
+				return true, s.sendBatchEmailNotification(ctx, updatesWithChanges, config)
+			case models.NotificationProviderTelegram:
+				return true, s.sendBatchTelegramNotification(ctx, updatesWithChanges, config)
+			case models.NotificationProviderSignal:
+				return true, s.sendBatchSignalNotification(ctx, updatesWithChanges, config)
+			case models.NotificationProviderSlack:
+				return true, s.sendBatchSlackNotification(ctx, updatesWithChanges, config)
+			case models.NotificationProviderNtfy:
+				return true, s.sendBatchNtfyNotification(ctx, updatesWithChanges, config)
+			case models.NotificationProviderPushover:
+				return true, s.sendBatchPushoverNotification(ctx, updatesWithChanges, config)
+			case models.NotificationProviderGotify:
+				return true, s.sendBatchGotifyNotification(ctx, updatesWithChanges, config)
+			case models.NotificationProviderMatrix:
+				return true, s.sendBatchMatrixNotification(ctx, updatesWithChanges, config)
+			case models.NotificationProviderGeneric:
+				return true, s.sendBatchGenericNotification(ctx, updatesWithChanges, config)
+			default:
+				return false, nil
+			}
+		},
+	})
 }
 
 func (s *NotificationService) sendBatchDiscordNotification(ctx context.Context, updates map[string]*imageupdate.Response, config models.JSON) error {
@@ -1300,7 +1498,7 @@ func (s *NotificationService) sendBatchDiscordNotification(ctx context.Context,
 		)
 	}
 
-	if err := notifications.SendDiscord(ctx, discordConfig, message.String()); err != nil {
+	if err := notifications.SendDiscordRich(ctx, discordConfig, s.batchUpdateRichMessageInternal(ctx, updates, message.String())); err != nil {
 		return fmt.Errorf("failed to send batch Discord notification: %w", err)
 	}
 
@@ -1349,7 +1547,7 @@ func (s *NotificationService) sendBatchTelegramNotification(ctx context.Context,
 		telegramConfig.ParseMode = "HTML"
 	}
 
-	if err := notifications.SendTelegram(ctx, telegramConfig, message.String()); err != nil {
+	if err := notifications.SendTelegram(ctx, telegramConfig, models.NotificationEventImageUpdate, message.String()); err != nil {
 		return fmt.Errorf("failed to send batch Telegram notification: %w", err)
 	}
 
@@ -1402,7 +1600,8 @@ func (s *NotificationService) sendBatchEmailNotification(ctx context.Context, up
 		}
 		return ""
 	}())
-	if err := notifications.SendEmail(ctx, emailConfig, subject, htmlBody); err != nil {
+	attachments := batchUpdateEmailAttachmentsInternal(ctx, updates)
+	if err := notifications.SendEmail(ctx, emailConfig, subject, htmlBody, attachments...); err != nil {
 		return fmt.Errorf("failed to send email: %w", err)
 	}
 
@@ -1708,7 +1907,7 @@ func (s *NotificationService) sendSlackNotification(ctx context.Context, imageRe
 		message += fmt.Sprintf("*Latest Digest:* `%s`\n", updateInfo.LatestDigest)
 	}
 
-	if err := notifications.SendSlack(ctx, slackConfig, message); err != nil {
+	if err := notifications.SendSlackRich(ctx, slackConfig, s.imageUpdateRichMessageInternal(ctx, imageRef, updateInfo, message)); err != nil {
 		return fmt.Errorf("failed to send Slack notification: %w", err)
 	}
 
@@ -1753,7 +1952,7 @@ func (s *NotificationService) sendSlackContainerUpdateNotification(ctx context.C
 		message += fmt.Sprintf("*Current Version:* `%s`\n", newDigest)
 	}
 
-	if err := notifications.SendSlack(ctx, slackConfig, message); err != nil {
+	if err := notifications.SendSlackRich(ctx, slackConfig, s.containerUpdateRichMessageInternal(containerName, imageRef, oldDigest, newDigest, message)); err != nil {
 		return fmt.Errorf("failed to send Slack notification: %w", err)
 	}
 
@@ -1797,7 +1996,7 @@ func (s *NotificationService) sendBatchSlackNotification(ctx context.Context, up
 		)
 	}
 
-	if err := notifications.SendSlack(ctx, slackConfig, message.String()); err != nil {
+	if err := notifications.SendSlackRich(ctx, slackConfig, s.batchUpdateRichMessageInternal(ctx, updates, message.String())); err != nil {
 		return fmt.Errorf("failed to send batch Slack notification: %w", err)
 	}
 
@@ -1846,7 +2045,7 @@ func (s *NotificationService) sendNtfyNotification(ctx context.Context, imageRef
 		message += fmt.Sprintf("Latest Digest: %s\n", updateInfo.LatestDigest)
 	}
 
-	if err := notifications.SendNtfy(ctx, ntfyConfig, message); err != nil {
+	if err := notifications.SendNtfy(ctx, ntfyConfig, models.NotificationEventImageUpdate, message); err != nil {
 		return fmt.Errorf("failed to send Ntfy notification: %w", err)
 	}
 
@@ -1891,7 +2090,7 @@ func (s *NotificationService) sendNtfyContainerUpdateNotification(ctx context.Co
 		message += fmt.Sprintf("Current Version: %s\n", newDigest)
 	}
 
-	if err := notifications.SendNtfy(ctx, ntfyConfig, message); err != nil {
+	if err := notifications.SendNtfy(ctx, ntfyConfig, models.NotificationEventContainerUpdate, message); err != nil {
 		return fmt.Errorf("failed to send Ntfy notification: %w", err)
 	}
 
@@ -1937,7 +2136,7 @@ func (s *NotificationService) sendBatchNtfyNotification(ctx context.Context, upd
 		)
 	}
 
-	if err := notifications.SendNtfy(ctx, ntfyConfig, message.String()); err != nil {
+	if err := notifications.SendNtfy(ctx, ntfyConfig, models.NotificationEventImageUpdate, message.String()); err != nil {
 		return fmt.Errorf("failed to send batch Ntfy notification: %w", err)
 	}
 
@@ -1987,7 +2186,7 @@ func (s *NotificationService) sendPushoverNotification(ctx context.Context, imag
 		message += fmt.Sprintf("Latest Digest: %s\n", updateInfo.LatestDigest)
 	}
 
-	if err := notifications.SendPushover(ctx, pushoverConfig, message); err != nil {
+	if err := notifications.SendPushover(ctx, pushoverConfig, models.NotificationEventImageUpdate, message); err != nil {
 		return fmt.Errorf("failed to send Pushover notification: %w", err)
 	}
 
@@ -2033,7 +2232,7 @@ func (s *NotificationService) sendPushoverContainerUpdateNotification(ctx contex
 		message += fmt.Sprintf("Current Version: %s\n", newDigest)
 	}
 
-	if err := notifications.SendPushover(ctx, pushoverConfig, message); err != nil {
+	if err := notifications.SendPushover(ctx, pushoverConfig, models.NotificationEventContainerUpdate, message); err != nil {
 		return fmt.Errorf("failed to send Pushover notification: %w", err)
 	}
 
@@ -2078,7 +2277,7 @@ func (s *NotificationService) sendBatchPushoverNotification(ctx context.Context,
 		)
 	}
 
-	if err := notifications.SendPushover(ctx, pushoverConfig, message.String()); err != nil {
+	if err := notifications.SendPushover(ctx, pushoverConfig, models.NotificationEventImageUpdate, message.String()); err != nil {
 		return fmt.Errorf("failed to send batch Pushover notification: %w", err)
 	}
 
@@ -2241,7 +2440,8 @@ func (s *NotificationService) sendEmailVulnerabilityNotification(ctx context.Con
 		return fmt.Errorf("failed to render summary email template: %w", err)
 	}
 	subject := fmt.Sprintf("Daily Vulnerability Summary: %s", notifications.SanitizeForEmail(payload.CVEID))
-	if err := notifications.SendEmail(ctx, emailConfig, subject, htmlBody); err != nil {
+	attachments := vulnerabilityEmailAttachmentsInternal(ctx, payload)
+	if err := notifications.SendEmail(ctx, emailConfig, subject, htmlBody, attachments...); err != nil {
 		return fmt.Errorf("failed to send email: %w", err)
 	}
 	return nil
@@ -2266,7 +2466,7 @@ func (s *NotificationService) sendDiscordVulnerabilityNotification(ctx context.C
 			slog.Warn("Failed to decrypt Discord token, using raw value (may be unencrypted legacy value)", "error", err)
 		}
 	}
-	if err := notifications.SendDiscord(ctx, discordConfig, vulnerabilitySummaryBodyMarkdownInternal(payload)); err != nil {
+	if err := notifications.SendDiscordRich(ctx, discordConfig, s.vulnerabilityRichMessageInternal(payload, vulnerabilitySummaryBodyMarkdownInternal(payload))); err != nil {
 		return fmt.Errorf("failed to send Discord notification: %w", err)
 	}
 	return nil
@@ -2297,7 +2497,7 @@ func (s *NotificationService) sendTelegramVulnerabilityNotification(ctx context.
 	if telegramConfig.ParseMode == "" {
 		telegramConfig.ParseMode = "HTML"
 	}
-	if err := notifications.SendTelegram(ctx, telegramConfig, vulnerabilitySummaryBodyHTMLInternal(payload)); err != nil {
+	if err := notifications.SendTelegram(ctx, telegramConfig, models.NotificationEventVulnerabilityFound, vulnerabilitySummaryBodyHTMLInternal(payload)); err != nil {
 		return fmt.Errorf("failed to send Telegram notification: %w", err)
 	}
 	return nil
@@ -2350,7 +2550,7 @@ func (s *NotificationService) sendSlackVulnerabilityNotification(ctx context.Con
 			slog.Warn("Failed to decrypt Slack token, using raw value (may be unencrypted legacy value)", "error", err)
 		}
 	}
-	if err := notifications.SendSlack(ctx, slackConfig, vulnerabilitySummaryBodySlackInternal(payload)); err != nil {
+	if err := notifications.SendSlackRich(ctx, slackConfig, s.vulnerabilityRichMessageInternal(payload, vulnerabilitySummaryBodySlackInternal(payload))); err != nil {
 		return fmt.Errorf("failed to send Slack notification: %w", err)
 	}
 	return nil
@@ -2373,7 +2573,7 @@ func (s *NotificationService) sendNtfyVulnerabilityNotification(ctx context.Cont
 			ntfyConfig.Password = decrypted
 		}
 	}
-	if err := notifications.SendNtfy(ctx, ntfyConfig, vulnerabilitySummaryBodyPlainInternal(payload)); err != nil {
+	if err := notifications.SendNtfy(ctx, ntfyConfig, models.NotificationEventVulnerabilityFound, vulnerabilitySummaryBodyPlainInternal(payload)); err != nil {
 		return fmt.Errorf("failed to send Ntfy notification: %w", err)
 	}
 	return nil
@@ -2396,7 +2596,7 @@ func (s *NotificationService) sendPushoverVulnerabilityNotification(ctx context.
 			pushoverConfig.Token = decrypted
 		}
 	}
-	if err := notifications.SendPushover(ctx, pushoverConfig, vulnerabilitySummaryBodyPlainInternal(payload)); err != nil {
+	if err := notifications.SendPushover(ctx, pushoverConfig, models.NotificationEventVulnerabilityFound, vulnerabilitySummaryBodyPlainInternal(payload)); err != nil {
 		return fmt.Errorf("failed to send Pushover notification: %w", err)
 	}
 	return nil
@@ -2416,7 +2616,7 @@ func (s *NotificationService) sendGotifyVulnerabilityNotification(ctx context.Co
 			gotifyConfig.Token = decrypted
 		}
 	}
-	if err := notifications.SendGotify(ctx, gotifyConfig, vulnerabilitySummaryBodyPlainInternal(payload)); err != nil {
+	if err := notifications.SendGotify(ctx, gotifyConfig, models.NotificationEventVulnerabilityFound, vulnerabilitySummaryBodyPlainInternal(payload), s.appLinkInternal("/security")); err != nil {
 		return fmt.Errorf("failed to send Gotify notification: %w", err)
 	}
 	return nil
@@ -2431,12 +2631,8 @@ func (s *NotificationService) sendMatrixVulnerabilityNotification(ctx context.Co
 	if err := json.Unmarshal(configBytes, &matrixConfig); err != nil {
 		return fmt.Errorf("failed to unmarshal Matrix config: %w", err)
 	}
-	if matrixConfig.Password != "" {
-		if decrypted, err := crypto.Decrypt(matrixConfig.Password); err == nil {
-			matrixConfig.Password = decrypted
-		}
-	}
-	if err := notifications.SendMatrix(ctx, matrixConfig, vulnerabilitySummaryBodyPlainInternal(payload)); err != nil {
+	s.decryptMatrixCredentialsInternal(&matrixConfig)
+	if err := notifications.SendMatrix(ctx, matrixConfig, s, vulnerabilitySummaryBodyPlainInternal(payload)); err != nil {
 		return fmt.Errorf("failed to send Matrix notification: %w", err)
 	}
 	return nil
@@ -2539,7 +2735,7 @@ func (s *NotificationService) sendGotifyNotification(ctx context.Context, imageR
 		message += fmt.Sprintf("Latest Digest: %s\n", updateInfo.LatestDigest)
 	}
 
-	if err := notifications.SendGotify(ctx, gotifyConfig, message); err != nil {
+	if err := notifications.SendGotify(ctx, gotifyConfig, models.NotificationEventImageUpdate, message, s.appLinkInternal("/images")); err != nil {
 		return fmt.Errorf("failed to send Gotify notification: %w", err)
 	}
 
@@ -2578,7 +2774,7 @@ func (s *NotificationService) sendGotifyContainerUpdateNotification(ctx context.
 		message += fmt.Sprintf("Current Version: %s\n", newDigest)
 	}
 
-	if err := notifications.SendGotify(ctx, gotifyConfig, message); err != nil {
+	if err := notifications.SendGotify(ctx, gotifyConfig, models.NotificationEventContainerUpdate, message, s.containerLinkInternal(containerName)); err != nil {
 		return fmt.Errorf("failed to send Gotify notification: %w", err)
 	}
 
@@ -2623,7 +2819,7 @@ func (s *NotificationService) sendBatchGotifyNotification(ctx context.Context, u
 		)
 	}
 
-	if err := notifications.SendGotify(ctx, gotifyConfig, message.String()); err != nil {
+	if err := notifications.SendGotify(ctx, gotifyConfig, models.NotificationEventImageUpdate, message.String(), s.appLinkInternal("/images")); err != nil {
 		return fmt.Errorf("failed to send batch Gotify notification: %w", err)
 	}
 
@@ -2640,11 +2836,7 @@ func (s *NotificationService) sendMatrixNotification(ctx context.Context, imageR
 		return fmt.Errorf("failed to unmarshal Matrix config: %w", err)
 	}
 
-	if matrixConfig.Password != "" {
-		if decrypted, err := crypto.Decrypt(matrixConfig.Password); err == nil {
-			matrixConfig.Password = decrypted
-		}
-	}
+	s.decryptMatrixCredentialsInternal(&matrixConfig)
 
 	updateStatus := "No Update"
 	if updateInfo.HasUpdate {
@@ -2664,7 +2856,7 @@ func (s *NotificationService) sendMatrixNotification(ctx context.Context, imageR
 		message += fmt.Sprintf("Latest Digest: %s\n", updateInfo.LatestDigest)
 	}
 
-	if err := notifications.SendMatrix(ctx, matrixConfig, message); err != nil {
+	if err := notifications.SendMatrix(ctx, matrixConfig, s, message); err != nil {
 		return fmt.Errorf("failed to send Matrix notification: %w", err)
 	}
 
@@ -2681,11 +2873,7 @@ func (s *NotificationService) sendMatrixContainerUpdateNotification(ctx context.
 		return fmt.Errorf("failed to unmarshal Matrix config: %w", err)
 	}
 
-	if matrixConfig.Password != "" {
-		if decrypted, err := crypto.Decrypt(matrixConfig.Password); err == nil {
-			matrixConfig.Password = decrypted
-		}
-	}
+	s.decryptMatrixCredentialsInternal(&matrixConfig)
 
 	message := fmt.Sprintf("✅ Container Successfully Updated\n\n"+
 		"Your container has been updated with the latest image version.\n\n"+
@@ -2701,7 +2889,7 @@ func (s *NotificationService) sendMatrixContainerUpdateNotification(ctx context.
 		message += fmt.Sprintf("Current Version: %s\n", newDigest)
 	}
 
-	if err := notifications.SendMatrix(ctx, matrixConfig, message); err != nil {
+	if err := notifications.SendMatrix(ctx, matrixConfig, s, message); err != nil {
 		return fmt.Errorf("failed to send Matrix notification: %w", err)
 	}
 
@@ -2718,11 +2906,7 @@ func (s *NotificationService) sendBatchMatrixNotification(ctx context.Context, u
 		return fmt.Errorf("failed to unmarshal Matrix config: %w", err)
 	}
 
-	if matrixConfig.Password != "" {
-		if decrypted, err := crypto.Decrypt(matrixConfig.Password); err == nil {
-			matrixConfig.Password = decrypted
-		}
-	}
+	s.decryptMatrixCredentialsInternal(&matrixConfig)
 
 	// Build batch message content
 	title := "Container Image Updates Available"
@@ -2746,7 +2930,7 @@ func (s *NotificationService) sendBatchMatrixNotification(ctx context.Context, u
 		)
 	}
 
-	if err := notifications.SendMatrix(ctx, matrixConfig, message.String()); err != nil {
+	if err := notifications.SendMatrix(ctx, matrixConfig, s, message.String()); err != nil {
 		return fmt.Errorf("failed to send batch Matrix notification: %w", err)
 	}
 
@@ -2858,71 +3042,43 @@ func (s *NotificationService) SendPruneReportNotification(ctx context.Context, r
 		return nil
 	}
 
-	settings, err := s.GetAllSettings(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to get notification settings: %w", err)
-	}
-
-	var errors []string
-	for _, setting := range settings {
-		if !setting.Enabled {
-			continue
-		}
-
-		if !s.isEventEnabled(setting.Config, models.NotificationEventPruneReport) {
-			continue
-		}
-
-		var sendErr error
-		switch setting.Provider {
-		case models.NotificationProviderDiscord:
-			sendErr = s.sendDiscordPruneNotification(ctx, result, setting.Config)
-		case models.NotificationProviderEmail:
-			sendErr = s.sendEmailPruneNotification(ctx, result, setting.Config)
-		case models.NotificationProviderTelegram:
-			sendErr = s.sendTelegramPruneNotification(ctx, result, setting.Config)
-		case models.NotificationProviderSignal:
-			sendErr = s.sendSignalPruneNotification(ctx, result, setting.Config)
-		case models.NotificationProviderSlack:
-			sendErr = s.sendSlackPruneNotification(ctx, result, setting.Config)
-		case models.NotificationProviderNtfy:
-			sendErr = s.sendNtfyPruneNotification(ctx, result, setting.Config)
-		case models.NotificationProviderPushover:
-			sendErr = s.sendPushoverPruneNotification(ctx, result, setting.Config)
-		case models.NotificationProviderGotify:
-			sendErr = s.sendGotifyPruneNotification(ctx, result, setting.Config)
-		case models.NotificationProviderMatrix:
-			sendErr = s.sendMatrixPruneNotification(ctx, result, setting.Config)
-		case models.NotificationProviderGeneric:
-			sendErr = s.sendGenericPruneNotification(ctx, result, setting.Config)
-		default:
-			slog.WarnContext(ctx, "Unknown notification provider", "provider", setting.Provider)
-			continue
-		}
-
-		status := "success"
-		var errMsg *string
-		if sendErr != nil {
-			status = "failed"
-			msg := sendErr.Error()
-			errMsg = new(msg)
-			errors = append(errors, fmt.Sprintf("%s: %s", setting.Provider, msg))
-		}
-
-		s.logNotification(ctx, setting.Provider, "System Prune Report", status, errMsg, models.JSON{
-			"spaceReclaimed": result.SpaceReclaimed,
-			"eventType":      string(models.NotificationEventPruneReport),
-		})
-	}
-
-	if len(errors) > 0 {
-		return fmt.Errorf("notification errors: %s", strings.Join(errors, "; "))
-	}
 	if hasErrors && !hasChanges {
 		slog.WarnContext(ctx, "sending prune report notification with errors but no resources were pruned", "errorCount", len(result.Errors))
 	}
 
-	return nil
+	return s.deliverInternal(ctx, notificationDeliveryInternal{
+		eventType: models.NotificationEventPruneReport,
+		target:    "System Prune Report",
+		metadata: models.JSON{
+			"spaceReclaimed": result.SpaceReclaimed,
+		},
+		send: func(ctx context.Context, provider models.NotificationProvider, config models.JSON) (bool, error) {
+			switch provider {
+			case models.NotificationProviderDiscord:
+				return true, s.sendDiscordPruneNotification(ctx, result, config)
+			case models.NotificationProviderEmail:
+				return true, s.sendEmailPruneNotification(ctx, result, config)
+			case models.NotificationProviderTelegram:
+				return true, s.sendTelegramPruneNotification(ctx, result, config)
+			case models.NotificationProviderSignal:
+				return true, s.sendSignalPruneNotification(ctx, result, config)
+			case models.NotificationProviderSlack:
+				return true, s.sendSlackPruneNotification(ctx, result, config)
+			case models.NotificationProviderNtfy:
+				return true, s.sendNtfyPruneNotification(ctx, result, config)
+			case models.NotificationProviderPushover:
+				return true, s.sendPushoverPruneNotification(ctx, result, config)
+			case models.NotificationProviderGotify:
+				return true, s.sendGotifyPruneNotification(ctx, result, config)
+			case models.NotificationProviderMatrix:
+				return true, s.sendMatrixPruneNotification(ctx, result, config)
+			case models.NotificationProviderGeneric:
+				return true, s.sendGenericPruneNotification(ctx, result, config)
+			default:
+				return false, nil
+			}
+		},
+	})
 }
 
 func pruneResultHasChangesInternal(result *system.PruneAllResult) bool {
@@ -2978,7 +3134,7 @@ func (s *NotificationService) sendDiscordPruneNotification(ctx context.Context,
 		s.formatBytesInternal(result.VolumeSpaceReclaimed),
 		s.formatBytesInternal(result.BuildCacheSpaceReclaimed))
 
-	if err := notifications.SendDiscord(ctx, discordConfig, message); err != nil {
+	if err := notifications.SendDiscordRich(ctx, discordConfig, s.pruneRichMessageInternal(result, message)); err != nil {
 		return fmt.Errorf("failed to send Discord notification: %w", err)
 	}
 
@@ -3014,7 +3170,7 @@ func (s *NotificationService) sendTelegramPruneNotification(ctx context.Context,
 		telegramConfig.ParseMode = "HTML"
 	}
 
-	if err := notifications.SendTelegram(ctx, telegramConfig, message); err != nil {
+	if err := notifications.SendTelegram(ctx, telegramConfig, models.NotificationEventPruneReport, message); err != nil {
 		return fmt.Errorf("failed to send Telegram notification: %w", err)
 	}
 
@@ -3039,7 +3195,8 @@ func (s *NotificationService) sendEmailPruneNotification(ctx context.Context, re
 	}
 
 	subject := fmt.Sprintf("System Prune Report: %s Reclaimed", s.formatBytesInternal(result.SpaceReclaimed))
-	if err := notifications.SendEmail(ctx, emailConfig, subject, htmlBody); err != nil {
+	attachments := pruneEmailAttachmentsInternal(ctx, result)
+	if err := notifications.SendEmail(ctx, emailConfig, subject, htmlBody, attachments...); err != nil {
 		return fmt.Errorf("failed to send email: %w", err)
 	}
 
@@ -3104,7 +3261,7 @@ func (s *NotificationService) sendSlackPruneNotification(ctx context.Context, re
 		s.formatBytesInternal(result.VolumeSpaceReclaimed),
 		s.formatBytesInternal(result.BuildCacheSpaceReclaimed))
 
-	return notifications.SendSlack(ctx, slackConfig, message)
+	return notifications.SendSlackRich(ctx, slackConfig, s.pruneRichMessageInternal(result, message))
 }
 
 func (s *NotificationService) sendNtfyPruneNotification(ctx context.Context, result *system.PruneAllResult, config models.JSON) error {
@@ -3125,7 +3282,7 @@ func (s *NotificationService) sendNtfyPruneNotification(ctx context.Context, res
 		s.formatBytesInternal(result.VolumeSpaceReclaimed),
 		s.formatBytesInternal(result.BuildCacheSpaceReclaimed))
 
-	return notifications.SendNtfy(ctx, ntfyConfig, message)
+	return notifications.SendNtfy(ctx, ntfyConfig, models.NotificationEventPruneReport, message)
 }
 
 func (s *NotificationService) sendPushoverPruneNotification(ctx context.Context, result *system.PruneAllResult, config models.JSON) error {
@@ -3150,7 +3307,7 @@ func (s *NotificationService) sendPushoverPruneNotification(ctx context.Context,
 		pushoverConfig.Title = "System Prune Report"
 	}
 
-	return notifications.SendPushover(ctx, pushoverConfig, message)
+	return notifications.SendPushover(ctx, pushoverConfig, models.NotificationEventPruneReport, message)
 }
 
 func (s *NotificationService) sendGotifyPruneNotification(ctx context.Context, result *system.PruneAllResult, config models.JSON) error {
@@ -3175,7 +3332,7 @@ func (s *NotificationService) sendGotifyPruneNotification(ctx context.Context, r
 		gotifyConfig.Title = "System Prune Report"
 	}
 
-	return notifications.SendGotify(ctx, gotifyConfig, message)
+	return notifications.SendGotify(ctx, gotifyConfig, models.NotificationEventPruneReport, message, s.appLinkInternal("/dashboard"))
 }
 
 func (s *NotificationService) sendMatrixPruneNotification(ctx context.Context, result *system.PruneAllResult, config models.JSON) error {
@@ -3183,6 +3340,7 @@ func (s *NotificationService) sendMatrixPruneNotification(ctx context.Context, r
 	if err := s.unmarshalConfigInternal(config, &matrixConfig); err != nil {
 		return err
 	}
+	s.decryptMatrixCredentialsInternal(&matrixConfig)
 
 	message := fmt.Sprintf("Total Space Reclaimed: %s\n"+
 		"Breakdown:\n"+
@@ -3196,7 +3354,7 @@ func (s *NotificationService) sendMatrixPruneNotification(ctx context.Context, r
 		s.formatBytesInternal(result.VolumeSpaceReclaimed),
 		s.formatBytesInternal(result.BuildCacheSpaceReclaimed))
 
-	return notifications.SendMatrix(ctx, matrixConfig, message)
+	return notifications.SendMatrix(ctx, matrixConfig, s, message)
 }
 
 func (s *NotificationService) sendGenericPruneNotification(ctx context.Context, result *system.PruneAllResult, config models.JSON) error {
@@ -3222,68 +3380,39 @@ func (s *NotificationService) sendGenericPruneNotification(ctx context.Context,
 
 // SendAutoHealNotification sends a notification when a container is auto-healed.
 func (s *NotificationService) SendAutoHealNotification(ctx context.Context, containerName, containerID string) error {
-	settings, err := s.GetAllSettings(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to get notification settings: %w", err)
-	}
-
-	var errs []string
-	for _, setting := range settings {
-		if !setting.Enabled {
-			continue
-		}
-
-		if !s.isEventEnabled(setting.Config, models.NotificationEventAutoHeal) {
-			continue
-		}
-
-		var sendErr error
-		switch setting.Provider {
-		case models.NotificationProviderDiscord:
-			sendErr = s.sendDiscordAutoHealNotification(ctx, containerName, setting.Config)
-		case models.NotificationProviderEmail:
-			sendErr = s.sendEmailAutoHealNotification(ctx, containerName, setting.Config)
-		case models.NotificationProviderTelegram:
-			sendErr = s.sendTelegramAutoHealNotification(ctx, containerName, setting.Config)
-		case models.NotificationProviderSignal:
-			sendErr = s.sendSignalAutoHealNotification(ctx, containerName, setting.Config)
-		case models.NotificationProviderSlack:
-			sendErr = s.sendSlackAutoHealNotification(ctx, containerName, setting.Config)
-		case models.NotificationProviderNtfy:
-			sendErr = s.sendNtfyAutoHealNotification(ctx, containerName, setting.Config)
-		case models.NotificationProviderPushover:
-			sendErr = s.sendPushoverAutoHealNotification(ctx, containerName, setting.Config)
-		case models.NotificationProviderGotify:
-			sendErr = s.sendGotifyAutoHealNotification(ctx, containerName, setting.Config)
-		case models.NotificationProviderMatrix:
-			sendErr = s.sendMatrixAutoHealNotification(ctx, containerName, setting.Config)
-		case models.NotificationProviderGeneric:
-			sendErr = s.sendGenericAutoHealNotification(ctx, containerName, setting.Config)
-		default:
-			slog.WarnContext(ctx, "Unknown notification provider", "provider", setting.Provider)
-			continue
-		}
-
-		status := "success"
-		var errMsg *string
-		if sendErr != nil {
-			status = "failed"
-			msg := sendErr.Error()
-			errMsg = new(msg)
-			errs = append(errs, fmt.Sprintf("%s: %s", setting.Provider, msg))
-		}
-
-		s.logNotification(ctx, setting.Provider, containerName, status, errMsg, models.JSON{
+	return s.deliverInternal(ctx, notificationDeliveryInternal{
+		eventType: models.NotificationEventAutoHeal,
+		target:    containerName,
+		metadata: models.JSON{
 			"containerID": containerID,
-			"eventType":   string(models.NotificationEventAutoHeal),
-		})
-	}
-
-	if len(errs) > 0 {
-		return fmt.Errorf("notification errors: %s", strings.Join(errs, "; "))
-	}
-
-	return nil
+		},
+		send: func(ctx context.Context, provider models.NotificationProvider, config models.JSON) (bool, error) {
+			switch provider {
+			case models.NotificationProviderDiscord:
+				return true, s.sendDiscordAutoHealNotification(ctx, containerName, config)
+			case models.NotificationProviderEmail:
+				return true, s.sendEmailAutoHealNotification(ctx, containerName, config)
+			case models.NotificationProviderTelegram:
+				return true, s.sendTelegramAutoHealNotification(ctx, containerName, config)
+			case models.NotificationProviderSignal:
+				return true, s.sendSignalAutoHealNotification(ctx, containerName, config)
+			case models.NotificationProviderSlack:
+				return true, s.sendSlackAutoHealNotification(ctx, containerName, config)
+			case models.NotificationProviderNtfy:
+				return true, s.sendNtfyAutoHealNotification(ctx, containerName, config)
+			case models.NotificationProviderPushover:
+				return true, s.sendPushoverAutoHealNotification(ctx, containerName, config)
+			case models.NotificationProviderGotify:
+				return true, s.sendGotifyAutoHealNotification(ctx, containerName, config)
+			case models.NotificationProviderMatrix:
+				return true, s.sendMatrixAutoHealNotification(ctx, containerName, config)
+			case models.NotificationProviderGeneric:
+				return true, s.sendGenericAutoHealNotification(ctx, containerName, config)
+			default:
+				return false, nil
+			}
+		},
+	})
 }
 
 func (s *NotificationService) sendDiscordAutoHealNotification(ctx context.Context, containerName string, config models.JSON) error {
@@ -3296,7 +3425,7 @@ func (s *NotificationService) sendDiscordAutoHealNotification(ctx context.Contex
 	}
 	s.decryptDiscordTokenInternal(&discordConfig)
 	message := fmt.Sprintf("**Container '%s' was automatically restarted because it was unhealthy**", containerName)
-	return notifications.SendDiscord(ctx, discordConfig, message)
+	return notifications.SendDiscordRich(ctx, discordConfig, s.autoHealRichMessageInternal(containerName, message))
 }
 
 func (s *NotificationService) sendEmailAutoHealNotification(ctx context.Context, containerName string, config models.JSON) error {
@@ -3326,7 +3455,7 @@ func (s *NotificationService) sendTelegramAutoHealNotification(ctx context.Conte
 		telegramConfig.ParseMode = "HTML"
 	}
 	message := fmt.Sprintf("<b>Auto Heal:</b> Container '%s' was automatically restarted because it was unhealthy", containerName)
-	return notifications.SendTelegram(ctx, telegramConfig, message)
+	return notifications.SendTelegram(ctx, telegramConfig, models.NotificationEventAutoHeal, message)
 }
 
 func (s *NotificationService) sendSignalAutoHealNotification(ctx context.Context, containerName string, config models.JSON) error {
@@ -3344,7 +3473,7 @@ func (s *NotificationService) sendSlackAutoHealNotification(ctx context.Context,
 		return err
 	}
 	message := fmt.Sprintf("*Auto Heal:* Container '%s' was automatically restarted because it was unhealthy", containerName)
-	return notifications.SendSlack(ctx, slackConfig, message)
+	return notifications.SendSlackRich(ctx, slackConfig, s.autoHealRichMessageInternal(containerName, message))
 }
 
 func (s *NotificationService) sendNtfyAutoHealNotification(ctx context.Context, containerName string, config models.JSON) error {
@@ -3353,7 +3482,7 @@ func (s *NotificationService) sendNtfyAutoHealNotification(ctx context.Context,
 		return err
 	}
 	message := fmt.Sprintf("Container '%s' was automatically restarted because it was unhealthy", containerName)
-	return notifications.SendNtfy(ctx, ntfyConfig, message)
+	return notifications.SendNtfy(ctx, ntfyConfig, models.NotificationEventAutoHeal, message)
 }
 
 func (s *NotificationService) sendPushoverAutoHealNotification(ctx context.Context, containerName string, config models.JSON) error {
@@ -3365,7 +3494,7 @@ func (s *NotificationService) sendPushoverAutoHealNotification(ctx context.Conte
 		pushoverConfig.Title = "Auto Heal"
 	}
 	message := fmt.Sprintf("Container '%s' was automatically restarted because it was unhealthy", containerName)
-	return notifications.SendPushover(ctx, pushoverConfig, message)
+	return notifications.SendPushover(ctx, pushoverConfig, models.NotificationEventAutoHeal, message)
 }
 
 func (s *NotificationService) sendGotifyAutoHealNotification(ctx context.Context, containerName string, config models.JSON) error {
@@ -3377,7 +3506,7 @@ func (s *NotificationService) sendGotifyAutoHealNotification(ctx context.Context
 		gotifyConfig.Title = "Auto Heal"
 	}
 	message := fmt.Sprintf("Container '%s' was automatically restarted because it was unhealthy", containerName)
-	return notifications.SendGotify(ctx, gotifyConfig, message)
+	return notifications.SendGotify(ctx, gotifyConfig, models.NotificationEventAutoHeal, message, s.containerLinkInternal(containerName))
 }
 
 func (s *NotificationService) sendMatrixAutoHealNotification(ctx context.Context, containerName string, config models.JSON) error {
@@ -3385,8 +3514,9 @@ func (s *NotificationService) sendMatrixAutoHealNotification(ctx context.Context
 	if err := s.unmarshalConfigInternal(config, &matrixConfig); err != nil {
 		return err
 	}
+	s.decryptMatrixCredentialsInternal(&matrixConfig)
 	message := fmt.Sprintf("Container '%s' was automatically restarted because it was unhealthy", containerName)
-	return notifications.SendMatrix(ctx, matrixConfig, message)
+	return notifications.SendMatrix(ctx, matrixConfig, s, message)
 }
 
 func (s *NotificationService) sendGenericAutoHealNotification(ctx context.Context, containerName string, config models.JSON) error {
@@ -3398,6 +3528,209 @@ func (s *NotificationService) sendGenericAutoHealNotification(ctx context.Contex
 	return notifications.SendGenericWithTitle(ctx, genericConfig, "Auto Heal", message)
 }
 
+// SendContainerCrashNotification alerts every enabled provider, and Apprise,
+// that a container keeps exiting with a non-zero code.
+func (s *NotificationService) SendContainerCrashNotification(ctx context.Context, containerName, containerID, exitCode string, crashes int, window time.Duration) error {
+	// Send to Apprise if enabled (don't block on error)
+	if appriseErr := s.appriseService.SendContainerCrashNotification(ctx, containerName, exitCode, crashes, window); appriseErr != nil {
+		slog.WarnContext(ctx, "Failed to send Apprise notification", "error", appriseErr)
+	}
+
+	return s.SendAlertNotification(ctx, AlertNotification{
+		EventType: models.NotificationEventContainerCrash,
+		Subject:   containerName,
+		Title:     fmt.Sprintf("Container crash loop: %s", containerName),
+		Message:   fmt.Sprintf("Container '%s' exited with code %s and has crashed %d times in the last %s.", containerName, exitCode, crashes, window),
+		Metadata: models.JSON{
+			"containerID": containerID,
+			"exitCode":    exitCode,
+			"crashes":     crashes,
+		},
+	})
+}
+
+// SendUnmanagedChangeNotification alerts every enabled provider, and Apprise,
+// that a container was created, changed or removed without a matching Arcane
+// action.
+func (s *NotificationService) SendUnmanagedChangeNotification(ctx context.Context, containerName, containerID, image string, actions []string) error {
+	changes := strings.Join(actions, ", ")
+
+	// Send to Apprise if enabled (don't block on error)
+	if appriseErr := s.appriseService.SendUnmanagedChangeNotification(ctx, containerName, changes, image); appriseErr != nil {
+		slog.WarnContext(ctx, "Failed to send Apprise notification", "error", appriseErr)
+	}
+
+	return s.SendAlertNotification(ctx, AlertNotification{
+		EventType: models.NotificationEventUnmanagedChange,
+		Subject:   containerName,
+		Title:     fmt.Sprintf("Unmanaged change detected: %s", containerName),
+		Message:   fmt.Sprintf("Container '%s' (image %s) had changes made outside Arcane: %s.", containerName, image, changes),
+		Metadata: models.JSON{
+			"containerID": containerID,
+			"image":       image,
+			"actions":     actions,
+		},
+	})
+}
+
+// SendArcaneUpdateNotification alerts every enabled provider, and Apprise,
+// that a newer Arcane release has been published.
+func (s *NotificationService) SendArcaneUpdateNotification(ctx context.Context, currentVersion, newestVersion, releaseURL string) error {
+	// Send to Apprise if enabled (don't block on error)
+	if appriseErr := s.appriseService.SendArcaneUpdateNotification(ctx, currentVersion, newestVersion, releaseURL); appriseErr != nil {
+		slog.WarnContext(ctx, "Failed to send Apprise notification", "error", appriseErr)
+	}
+
+	return s.SendAlertNotification(ctx, AlertNotification{
+		EventType: models.NotificationEventArcaneUpdate,
+		Subject:   "Arcane " + newestVersion,
+		Title:     fmt.Sprintf("Arcane %s is available", newestVersion),
+		Message:   fmt.Sprintf("Arcane %s has been released; this instance is running %s. Review the changelog and upgrade from the Arcane UI.", newestVersion, currentVersion),
+		Metadata: models.JSON{
+			"currentVersion": currentVersion,
+			"newestVersion":  newestVersion,
+			"releaseUrl":     releaseURL,
+		},
+	})
+}
+
+// SendUpdateBlockedNotification alerts every enabled provider, and Apprise,
+// that an image update was not applied because its signature could not be
+// verified.
+func (s *NotificationService) SendUpdateBlockedNotification(ctx context.Context, imageRef, reason string) error {
+	// Send to Apprise if enabled (don't block on error)
+	if appriseErr := s.appriseService.SendUpdateBlockedNotification(ctx, imageRef, reason); appriseErr != nil {
+		slog.WarnContext(ctx, "Failed to send Apprise notification", "error", appriseErr)
+	}
+
+	return s.SendAlertNotification(ctx, AlertNotification{
+		EventType: models.NotificationEventUpdateBlocked,
+		Subject:   imageRef,
+		Title:     fmt.Sprintf("Image update blocked: %s", imageRef),
+		Message:   fmt.Sprintf("The update to '%s' was not applied because its signature could not be verified: %s", imageRef, reason),
+		Metadata: models.JSON{
+			"imageRef": imageRef,
+			"reason":   reason,
+		},
+	})
+}
+
+// AlertNotification is a short title/message notification used by monitoring
+// subsystems that do not need a provider-specific layout.
+type AlertNotification struct {
+	EventType models.NotificationEventType
+	Subject   string // what the alert is about, logged as the notification target
+	Title     string
+	Message   string
+	Metadata  models.JSON
+}
+
+// SendAlertNotification delivers an alert to every enabled provider that has
+// the alert's event type enabled.
+func (s *NotificationService) SendAlertNotification(ctx context.Context, alert AlertNotification) error {
+	return s.deliverInternal(ctx, notificationDeliveryInternal{
+		eventType: alert.EventType,
+		target:    alert.Subject,
+		metadata:  alert.Metadata,
+		send: func(ctx context.Context, provider models.NotificationProvider, config models.JSON) (bool, error) {
+			return s.sendAlertToProviderInternal(ctx, provider, alert, config)
+		},
+	})
+}
+
+// sendAlertToProviderInternal formats and sends an alert for a single provider.
+// handled is false when the provider is unknown.
+func (s *NotificationService) sendAlertToProviderInternal(ctx context.Context, provider models.NotificationProvider, alert AlertNotification, config models.JSON) (handled bool, err error) {
+	switch provider {
+	case models.NotificationProviderDiscord:
+		var discordConfig models.DiscordConfig
+		if err := s.unmarshalConfigInternal(config, &discordConfig); err != nil {
+			return true, err
+		}
+		if discordConfig.WebhookID == "" || discordConfig.Token == "" {
+			return true, fmt.Errorf("discord webhook ID or token not configured")
+		}
+		s.decryptDiscordTokenInternal(&discordConfig)
+		return true, notifications.SendDiscordRich(ctx, discordConfig, s.alertRichMessageInternal(alert, fmt.Sprintf("**%s**\n%s", alert.Title, alert.Message)))
+	case models.NotificationProviderEmail:
+		var emailConfig models.EmailConfig
+		if err := s.unmarshalConfigInternal(config, &emailConfig); err != nil {
+			return true, err
+		}
+		if err := s.validateEmailConfigInternal(&emailConfig); err != nil {
+			return true, err
+		}
+		s.decryptEmailPasswordInternal(&emailConfig)
+		body := fmt.Sprintf("<p>%s</p>", html.EscapeString(alert.Message))
+		return true, notifications.SendEmail(ctx, emailConfig, alert.Title, body)
+	case models.NotificationProviderTelegram:
+		var telegramConfig models.TelegramConfig
+		if err := s.unmarshalConfigInternal(config, &telegramConfig); err != nil {
+			return true, err
+		}
+		if telegramConfig.BotToken == "" || len(telegramConfig.ChatIDs) == 0 {
+			return true, fmt.Errorf("telegram bot token or chat IDs not configured")
+		}
+		s.decryptTelegramTokenInternal(&telegramConfig)
+		if telegramConfig.ParseMode == "" {
+			telegramConfig.ParseMode = "HTML"
+		}
+		message := fmt.Sprintf("<b>%s:</b> %s", html.EscapeString(alert.Title), html.EscapeString(alert.Message))
+		return true, notifications.SendTelegram(ctx, telegramConfig, alert.EventType, message)
+	case models.NotificationProviderSignal:
+		var signalConfig models.SignalConfig
+		if err := s.unmarshalConfigInternal(config, &signalConfig); err != nil {
+			return true, err
+		}
+		return true, notifications.SendSignal(ctx, signalConfig, alert.Title+": "+alert.Message)
+	case models.NotificationProviderSlack:
+		var slackConfig models.SlackConfig
+		if err := s.unmarshalConfigInternal(config, &slackConfig); err != nil {
+			return true, err
+		}
+		return true, notifications.SendSlackRich(ctx, slackConfig, s.alertRichMessageInternal(alert, fmt.Sprintf("*%s:* %s", alert.Title, alert.Message)))
+	case models.NotificationProviderNtfy:
+		var ntfyConfig models.NtfyConfig
+		if err := s.unmarshalConfigInternal(config, &ntfyConfig); err != nil {
+			return true, err
+		}
+		return true, notifications.SendNtfy(ctx, ntfyConfig, alert.EventType, alert.Title+": "+alert.Message)
+	case models.NotificationProviderPushover:
+		var pushoverConfig models.PushoverConfig
+		if err := s.unmarshalConfigInternal(config, &pushoverConfig); err != nil {
+			return true, err
+		}
+		if pushoverConfig.Title == "" {
+			pushoverConfig.Title = alert.Title
+		}
+		return true, notifications.SendPushover(ctx, pushoverConfig, alert.EventType, alert.Message)
+	case models.NotificationProviderGotify:
+		var gotifyConfig models.GotifyConfig
+		if err := s.unmarshalConfigInternal(config, &gotifyConfig); err != nil {
+			return true, err
+		}
+		if gotifyConfig.Title == "" {
+			gotifyConfig.Title = alert.Title
+		}
+		return true, notifications.SendGotify(ctx, gotifyConfig, alert.EventType, alert.Message, s.alertLinkInternal(alert))
+	case models.NotificationProviderMatrix:
+		var matrixConfig models.MatrixConfig
+		if err := s.unmarshalConfigInternal(config, &matrixConfig); err != nil {
+			return true, err
+		}
+		s.decryptMatrixCredentialsInternal(&matrixConfig)
+		return true, notifications.SendMatrix(ctx, matrixConfig, s, alert.Title+": "+alert.Message)
+	case models.NotificationProviderGeneric:
+		var genericConfig models.GenericConfig
+		if err := s.unmarshalConfigInternal(config, &genericConfig); err != nil {
+			return true, err
+		}
+		return true, notifications.SendGenericWithTitle(ctx, genericConfig, alert.Title, alert.Message)
+	default:
+		return false, nil
+	}
+}
+
 // Helper methods to reduce code duplication
 func (s *NotificationService) unmarshalConfigInternal(config models.JSON, dest any) error {
 	configBytes, err := json.Marshal(config)
@@ -3428,6 +3761,19 @@ func (s *NotificationService) decryptDiscordTokenInternal(config *models.Discord
 	}
 }
 
+func (s *NotificationService) decryptMatrixCredentialsInternal(config *models.MatrixConfig) {
+	if config.Password != "" {
+		if decrypted, err := crypto.Decrypt(config.Password); err == nil {
+			config.Password = decrypted
+		}
+	}
+	if config.AccessToken != "" {
+		if decrypted, err := crypto.Decrypt(config.AccessToken); err == nil {
+			config.AccessToken = decrypted
+		}
+	}
+}
+
 func (s *NotificationService) decryptTelegramTokenInternal(config *models.TelegramConfig) {
 	if config.BotToken != "" {
 		if decrypted, err := crypto.Decrypt(config.BotToken); err == nil {
@@ -3,6 +3,8 @@ package services
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"sync"
 	"testing"
 
 	glsqlite "github.com/glebarez/sqlite"
@@ -13,6 +15,7 @@ import (
 	"github.com/getarcaneapp/arcane/backend/internal/database"
 	"github.com/getarcaneapp/arcane/backend/internal/models"
 	"github.com/getarcaneapp/arcane/backend/internal/utils/crypto"
+	"github.com/getarcaneapp/arcane/types/notification"
 )
 
 func setupNotificationTestDB(t *testing.T) *database.DB {
@@ -318,3 +321,172 @@ func TestSupportedNotificationTestTypes_IncludesAutoHeal(t *testing.T) {
 	require.Equal(t, len(expected), len(supportedNotificationTestTypes),
 		"supportedNotificationTestTypes has unexpected entries")
 }
+
+func setupNotificationBundleTestDB(t *testing.T, name string) *database.DB {
+	t.Helper()
+	db, err := gorm.Open(glsqlite.Open("file:"+name+"?mode=memory&cache=shared"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.NotificationSettings{}))
+	crypto.InitEncryption(&config.Config{
+		EncryptionKey: "test-encryption-key-for-testing-32bytes-min",
+		Environment:   "test",
+	})
+	return &database.DB{DB: db}
+}
+
+func TestNotificationService_ExportImportSettings(t *testing.T) {
+	ctx := context.Background()
+	source := NewNotificationService(setupNotificationBundleTestDB(t, "bundle_source"), &config.Config{})
+
+	token, err := crypto.Encrypt("bot-token")
+	require.NoError(t, err)
+	_, err = source.CreateOrUpdateSettings(ctx, models.NotificationProviderTelegram, true, models.JSON{
+		"botToken": token,
+		"chatIds":  []any{"123"},
+	})
+	require.NoError(t, err)
+
+	bundle, err := source.ExportSettings(ctx, "correct horse")
+	require.NoError(t, err)
+	require.Len(t, bundle.Providers, 1)
+	require.Equal(t, []string{"botToken"}, bundle.Providers[0].SecretKeys)
+	require.NotEqual(t, "bot-token", bundle.Providers[0].Config["botToken"])
+	require.NotEqual(t, token, bundle.Providers[0].Config["botToken"])
+
+	target := NewNotificationService(setupNotificationBundleTestDB(t, "bundle_target"), &config.Config{})
+
+	_, err = target.ImportSettings(ctx, *bundle, "")
+	require.ErrorIs(t, err, ErrNotificationBundlePassphraseRequired)
+	_, err = target.ImportSettings(ctx, *bundle, "wrong passphrase")
+	require.ErrorIs(t, err, ErrNotificationBundleDecryptFailed)
+
+	result, err := target.ImportSettings(ctx, *bundle, "correct horse")
+	require.NoError(t, err)
+	require.Len(t, result.Imported, 1)
+	require.Empty(t, result.Warnings)
+
+	imported, err := target.GetSettingsByProvider(ctx, models.NotificationProviderTelegram)
+	require.NoError(t, err)
+	require.True(t, imported.Enabled)
+	plain, err := crypto.Decrypt(imported.Config["botToken"].(string))
+	require.NoError(t, err)
+	require.Equal(t, "bot-token", plain)
+}
+
+func TestNotificationService_ImportSettingsWithoutSecretsKeepsExisting(t *testing.T) {
+	ctx := context.Background()
+	svc := NewNotificationService(setupNotificationBundleTestDB(t, "bundle_no_secrets"), &config.Config{})
+
+	token, err := crypto.Encrypt("bot-token")
+	require.NoError(t, err)
+	_, err = svc.CreateOrUpdateSettings(ctx, models.NotificationProviderTelegram, true, models.JSON{"botToken": token})
+	require.NoError(t, err)
+	pushoverToken, err := crypto.Encrypt("app-token")
+	require.NoError(t, err)
+	_, err = svc.CreateOrUpdateSettings(ctx, models.NotificationProviderPushover, true, models.JSON{"token": pushoverToken, "user": "u"})
+	require.NoError(t, err)
+
+	bundle, err := svc.ExportSettings(ctx, "")
+	require.NoError(t, err)
+	require.Empty(t, bundle.Salt)
+	for _, p := range bundle.Providers {
+		require.NotContains(t, p.Config, p.SecretKeys[0])
+	}
+
+	require.NoError(t, svc.DeleteSettings(ctx, models.NotificationProviderPushover))
+
+	result, err := svc.ImportSettings(ctx, *bundle, "")
+	require.NoError(t, err)
+	require.Len(t, result.Imported, 2)
+	require.Len(t, result.Warnings, 1)
+	require.Contains(t, result.Warnings[0], "pushover")
+
+	telegram, err := svc.GetSettingsByProvider(ctx, models.NotificationProviderTelegram)
+	require.NoError(t, err)
+	require.Equal(t, token, telegram.Config["botToken"])
+}
+
+func TestNotificationService_DeliverFailsOverToFallbackProviders(t *testing.T) {
+	ctx := context.Background()
+	db := setupNotificationBundleTestDB(t, "deliver_failover")
+	require.NoError(t, db.AutoMigrate(&models.NotificationLog{}, &models.UserNotificationSubscription{}))
+	sqlDB, err := db.DB.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+	svc := NewNotificationService(db, &config.Config{})
+	svc.retryDelay = 0
+
+	_, err = svc.CreateOrUpdateSettings(ctx, models.NotificationProviderSlack, true, models.JSON{"token": "x"})
+	require.NoError(t, err)
+	_, err = svc.CreateOrUpdateSettings(ctx, models.NotificationProviderEmail, true, models.JSON{"role": models.NotificationRoleFallback})
+	require.NoError(t, err)
+
+	// Providers are delivered to concurrently.
+	var mu sync.Mutex
+	calls := map[models.NotificationProvider]int{}
+	delivery := notificationDeliveryInternal{
+		eventType: models.NotificationEventContainerCrash,
+		target:    "web",
+		metadata:  models.JSON{"exitCode": "1"},
+		send: func(ctx context.Context, provider models.NotificationProvider, config models.JSON) (bool, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			calls[provider]++
+			if provider == models.NotificationProviderSlack {
+				return true, errors.New("slack is down")
+			}
+			return true, nil
+		},
+	}
+
+	err = svc.deliverInternal(ctx, delivery)
+	require.ErrorContains(t, err, "slack: slack is down")
+	require.Equal(t, notificationDeliveryAttempts, calls[models.NotificationProviderSlack])
+	require.Equal(t, 1, calls[models.NotificationProviderEmail])
+
+	var logs []models.NotificationLog
+	require.NoError(t, db.Order("id").Find(&logs).Error)
+	require.Len(t, logs, 2)
+	require.Equal(t, "failed", logs[0].Status)
+	require.EqualValues(t, notificationDeliveryAttempts, logs[0].Metadata["attempts"])
+	require.Equal(t, models.NotificationProviderEmail, logs[1].Provider)
+	require.Equal(t, "success", logs[1].Status)
+	require.Equal(t, []any{"slack"}, logs[1].Metadata["failoverFrom"])
+
+	// Fallback providers are left alone while the primary providers deliver.
+	calls = map[models.NotificationProvider]int{}
+	delivery.send = func(ctx context.Context, provider models.NotificationProvider, config models.JSON) (bool, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls[provider]++
+		return true, nil
+	}
+	require.NoError(t, svc.deliverInternal(ctx, delivery))
+	require.Equal(t, map[models.NotificationProvider]int{models.NotificationProviderSlack: 1}, calls)
+
+	// A subscriber of the fallback provider gets every notification, and a
+	// failing personal target does not fail over to the fallback providers.
+	_, err = NewUserNotificationService(db).SetSubscription(ctx, "user-1", models.NotificationProviderEmail, notification.SubscriptionUpdate{
+		Target:  "me@example.com",
+		Events:  []string{string(models.NotificationEventContainerCrash)},
+		Enabled: true,
+	})
+	require.NoError(t, err)
+
+	calls = map[models.NotificationProvider]int{}
+	delivery.send = func(ctx context.Context, provider models.NotificationProvider, config models.JSON) (bool, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls[provider]++
+		if provider == models.NotificationProviderEmail {
+			return true, errors.New("mailbox unavailable")
+		}
+		return true, nil
+	}
+	err = svc.deliverInternal(ctx, delivery)
+	require.ErrorContains(t, err, "email: mailbox unavailable")
+	require.Equal(t, map[models.NotificationProvider]int{
+		models.NotificationProviderSlack: 1,
+		models.NotificationProviderEmail: notificationDeliveryAttempts,
+	}, calls, "only the personal email target may be retried, the global fallback must stay idle")
+}
@@ -121,6 +121,8 @@ func (s *OidcService) getInsecureHttpClient() *http.Client {
 	} else {
 		insecureTransport.TLSClientConfig.InsecureSkipVerify = true
 	}
+	// The trust store dialer always verifies, so fall back to the default TLS dial.
+	insecureTransport.DialTLSContext = nil
 	// Force HTTP/2 even with custom TLS config to avoid "malformed HTTP response" errors
 	// when the server speaks HTTP/2 but the client disabled it due to custom TLS config.
 	if err := http2.ConfigureTransport(insecureTransport); err != nil {
@@ -0,0 +1,241 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/arcaneupdater"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/converter"
+	"github.com/getarcaneapp/arcane/types/project"
+	"github.com/goccy/go-yaml"
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/api/types/mount"
+	"github.com/moby/moby/client"
+)
+
+// adoptBackupSuffix is appended to the name of an original container while its
+// compose-managed replacement is started, so it can be restored on failure.
+const adoptBackupSuffix = "-pre-adopt"
+
+// ProjectAdoptionService moves standalone containers into a new compose
+// project generated from their configuration.
+type ProjectAdoptionService struct {
+	dockerService  *DockerClientService
+	projectService *ProjectService
+}
+
+func NewProjectAdoptionService(dockerService *DockerClientService, projectService *ProjectService) *ProjectAdoptionService {
+	return &ProjectAdoptionService{
+		dockerService:  dockerService,
+		projectService: projectService,
+	}
+}
+
+type adoptedContainerInternal struct {
+	inspect container.InspectResponse
+	parsed  *models.DockerRunCommand
+}
+
+// AdoptContainers creates a project whose compose file reproduces the given
+// containers. With req.Recreate the originals are replaced by containers
+// managed by the project; if the deploy fails they are restored.
+func (s *ProjectAdoptionService) AdoptContainers(ctx context.Context, req project.AdoptContainers, user models.User) (*models.Project, error) {
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, &models.ValidationError{Message: "project name is required", Field: "name"}
+	}
+	if len(req.ContainerIDs) == 0 {
+		return nil, &models.ValidationError{Message: "at least one container is required", Field: "containerIds"}
+	}
+
+	dockerClient, err := s.dockerService.GetClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to Docker: %w", err)
+	}
+
+	adopted := make([]adoptedContainerInternal, 0, len(req.ContainerIDs))
+	seen := make(map[string]struct{}, len(req.ContainerIDs))
+	for _, id := range req.ContainerIDs {
+		result, err := dockerClient.ContainerInspect(ctx, id, client.ContainerInspectOptions{})
+		if err != nil {
+			return nil, &models.NotFoundError{Message: fmt.Sprintf("container %s not found", id)}
+		}
+		inspect := result.Container
+		if _, dup := seen[inspect.ID]; dup {
+			continue
+		}
+		seen[inspect.ID] = struct{}{}
+
+		if err := validateAdoptableContainerInternal(inspect, req.Recreate); err != nil {
+			return nil, err
+		}
+
+		adopted = append(adopted, adoptedContainerInternal{
+			inspect: inspect,
+			parsed:  converter.FromContainerInspect(inspect, imageDefaultsInternal(ctx, dockerClient, inspect.Image)),
+		})
+	}
+
+	compose := buildAdoptionComposeInternal(adopted)
+	composeContent, err := yaml.Marshal(&compose)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate compose file: %w", err)
+	}
+
+	proj, err := s.projectService.CreateProject(ctx, req.Name, string(composeContent), nil, user)
+	if err != nil {
+		return nil, err
+	}
+
+	if !req.Recreate {
+		return proj, nil
+	}
+
+	if err := s.recreateUnderProjectInternal(ctx, dockerClient, proj, adopted, user); err != nil {
+		return nil, fmt.Errorf("project %q was created but the containers could not be recreated: %w", proj.Name, err)
+	}
+	return proj, nil
+}
+
+// recreateUnderProjectInternal stops and renames the original containers,
+// deploys the project, and removes the originals once the deploy succeeded.
+func (s *ProjectAdoptionService) recreateUnderProjectInternal(ctx context.Context, dockerClient *client.Client, proj *models.Project, adopted []adoptedContainerInternal, user models.User) error {
+	var moved []adoptedContainerInternal
+	restore := func() {
+		for _, c := range moved {
+			name := strings.TrimPrefix(c.inspect.Name, "/")
+			if _, err := dockerClient.ContainerRename(ctx, c.inspect.ID, client.ContainerRenameOptions{NewName: name}); err != nil {
+				slog.ErrorContext(ctx, "Failed to restore container name after adoption failure", "container", name, "error", err)
+				continue
+			}
+			if c.inspect.State != nil && c.inspect.State.Running {
+				if _, err := dockerClient.ContainerStart(ctx, c.inspect.ID, client.ContainerStartOptions{}); err != nil {
+					slog.ErrorContext(ctx, "Failed to restart container after adoption failure", "container", name, "error", err)
+				}
+			}
+		}
+	}
+
+	for _, c := range adopted {
+		name := strings.TrimPrefix(c.inspect.Name, "/")
+		if _, err := dockerClient.ContainerStop(ctx, c.inspect.ID, client.ContainerStopOptions{}); err != nil {
+			restore()
+			return fmt.Errorf("failed to stop container %s: %w", name, err)
+		}
+		if _, err := dockerClient.ContainerRename(ctx, c.inspect.ID, client.ContainerRenameOptions{NewName: name + adoptBackupSuffix}); err != nil {
+			moved = append(moved, c)
+			restore()
+			return fmt.Errorf("failed to rename container %s: %w", name, err)
+		}
+		moved = append(moved, c)
+	}
+
+	if err := s.projectService.DeployProject(ctx, proj.ID, user, nil); err != nil {
+		// Compose may have created some of the new containers already; they
+		// hold the original names and have to go before the rename back.
+		if downErr := s.projectService.DownProject(ctx, proj.ID, user); downErr != nil {
+			slog.WarnContext(ctx, "Failed to bring down adopted project after deploy failure", "project", proj.Name, "error", downErr)
+		}
+		restore()
+		return fmt.Errorf("failed to deploy project: %w", err)
+	}
+
+	var errs []error
+	for _, c := range moved {
+		if _, err := dockerClient.ContainerRemove(ctx, c.inspect.ID, client.ContainerRemoveOptions{}); err != nil {
+			errs = append(errs, fmt.Errorf("failed to remove original container %s: %w", strings.TrimPrefix(c.inspect.Name, "/"), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func validateAdoptableContainerInternal(inspect container.InspectResponse, recreate bool) error {
+	name := strings.TrimPrefix(inspect.Name, "/")
+	if inspect.Config == nil {
+		return &models.ValidationError{Message: fmt.Sprintf("container %s has no configuration", name), Field: "containerIds"}
+	}
+	if p := inspect.Config.Labels["com.docker.compose.project"]; p != "" {
+		return &models.ValidationError{Message: fmt.Sprintf("container %s already belongs to compose project %q", name, p), Field: "containerIds"}
+	}
+	if arcaneupdater.IsArcaneContainer(inspect.Config.Labels) {
+		return &models.ValidationError{Message: "the Arcane container cannot be adopted", Field: "containerIds"}
+	}
+	// Stopping an auto-remove container deletes it, so it could not be
+	// restored if the deploy fails.
+	if recreate && inspect.HostConfig != nil && inspect.HostConfig.AutoRemove {
+		return &models.ValidationError{Message: fmt.Sprintf("container %s is started with --rm and cannot be recreated", name), Field: "containerIds"}
+	}
+	return nil
+}
+
+// buildAdoptionComposeInternal generates the compose file for the adopted
+// containers. Volumes and networks already exist, so they are declared
+// external and the project reuses them instead of creating its own.
+func buildAdoptionComposeInternal(adopted []adoptedContainerInternal) models.DockerComposeConfig {
+	compose := models.DockerComposeConfig{
+		Services: make(map[string]models.DockerComposeService, len(adopted)),
+	}
+	networks := make(map[string]models.DockerComposeExternalResource)
+	volumes := make(map[string]models.DockerComposeExternalResource)
+
+	for _, c := range adopted {
+		// Anonymous volumes are referenced by their generated name so the
+		// new container keeps the data.
+		for i, spec := range c.parsed.Volumes {
+			for _, m := range c.inspect.Mounts {
+				if m.Type == mount.TypeVolume && spec == m.Destination {
+					c.parsed.Volumes[i] = m.Name + ":" + m.Destination
+				}
+			}
+		}
+
+		service := converter.ToComposeService(c.parsed)
+		for _, n := range service.Networks {
+			networks[n] = models.DockerComposeExternalResource{External: true}
+		}
+		for _, v := range service.Volumes {
+			if source, _, ok := strings.Cut(v, ":"); ok && source != "" && !strings.ContainsAny(source[:1], "/.~$") {
+				volumes[source] = models.DockerComposeExternalResource{External: true}
+			}
+		}
+
+		compose.Services[uniqueServiceNameInternal(c.parsed.Name, compose.Services)] = service
+	}
+
+	if len(networks) > 0 {
+		compose.Networks = networks
+	}
+	if len(volumes) > 0 {
+		compose.Volumes = volumes
+	}
+	return compose
+}
+
+// uniqueServiceNameInternal derives a compose service name from a container
+// name that does not collide with existing services.
+func uniqueServiceNameInternal(containerName string, existing map[string]models.DockerComposeService) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(containerName) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '_', r == '-', r == '.':
+			b.WriteRune(r)
+		default:
+			b.WriteRune('-')
+		}
+	}
+	baseName := strings.Trim(b.String(), "-_.")
+	if baseName == "" {
+		baseName = "app"
+	}
+
+	name := baseName
+	for i := 2; ; i++ {
+		if _, taken := existing[name]; !taken {
+			return name
+		}
+		name = fmt.Sprintf("%s-%d", baseName, i)
+	}
+}
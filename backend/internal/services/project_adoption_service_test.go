@@ -0,0 +1,107 @@
+package services
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/api/types/mount"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildAdoptionCompose(t *testing.T) {
+	anonymous := "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	adopted := []adoptedContainerInternal{
+		{
+			inspect: container.InspectResponse{
+				Mounts: []container.MountPoint{
+					{Type: mount.TypeVolume, Name: "db_data", Destination: "/var/lib/postgresql/data"},
+					{Type: mount.TypeVolume, Name: anonymous, Destination: "/tmp/cache"},
+				},
+			},
+			parsed: &models.DockerRunCommand{
+				Name:     "Web",
+				Image:    "postgres:17",
+				Volumes:  []string{"db_data:/var/lib/postgresql/data", "/tmp/cache", "./init:/docker-entrypoint-initdb.d:ro"},
+				Networks: []string{"backend"},
+			},
+		},
+		{
+			parsed: &models.DockerRunCommand{
+				Name:     "web",
+				Image:    "postgres:17",
+				Networks: []string{"host"},
+			},
+		},
+	}
+
+	compose := buildAdoptionComposeInternal(adopted)
+
+	require.Len(t, compose.Services, 2)
+	db := compose.Services["web"]
+	assert.Equal(t, "Web", db.ContainerName)
+	assert.Equal(t, []string{
+		"db_data:/var/lib/postgresql/data",
+		anonymous + ":/tmp/cache",
+		"./init:/docker-entrypoint-initdb.d:ro",
+	}, db.Volumes)
+	assert.Equal(t, []string{"backend"}, db.Networks)
+
+	hostNet := compose.Services["web-2"]
+	assert.Equal(t, "host", hostNet.NetworkMode)
+	assert.Empty(t, hostNet.Networks)
+
+	assert.Equal(t, map[string]models.DockerComposeExternalResource{"backend": {External: true}}, compose.Networks)
+	assert.Equal(t, map[string]models.DockerComposeExternalResource{
+		"db_data": {External: true},
+		anonymous: {External: true},
+	}, compose.Volumes)
+}
+
+func TestValidateAdoptableContainer(t *testing.T) {
+	tests := []struct {
+		name     string
+		inspect  container.InspectResponse
+		recreate bool
+		wantErr  bool
+	}{
+		{
+			name:    "standalone container",
+			inspect: container.InspectResponse{Name: "/web", Config: &container.Config{}},
+		},
+		{
+			name:    "compose container",
+			inspect: container.InspectResponse{Name: "/web", Config: &container.Config{Labels: map[string]string{"com.docker.compose.project": "site"}}},
+			wantErr: true,
+		},
+		{
+			name:    "arcane container",
+			inspect: container.InspectResponse{Name: "/arcane", Config: &container.Config{Labels: map[string]string{"com.getarcaneapp.arcane": "true"}}},
+			wantErr: true,
+		},
+		{
+			name:    "auto-remove container without recreate",
+			inspect: container.InspectResponse{Name: "/job", Config: &container.Config{}, HostConfig: &container.HostConfig{AutoRemove: true}},
+		},
+		{
+			name:     "auto-remove container with recreate",
+			inspect:  container.InspectResponse{Name: "/job", Config: &container.Config{}, HostConfig: &container.HostConfig{AutoRemove: true}},
+			recreate: true,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateAdoptableContainerInternal(tt.inspect, tt.recreate)
+			if !tt.wantErr {
+				require.NoError(t, err)
+				return
+			}
+			var validationErr *models.ValidationError
+			require.True(t, errors.As(err, &validationErr), "expected validation error, got %v", err)
+		})
+	}
+}
@@ -0,0 +1,321 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"log/slog"
+	"net/netip"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	composetypes "github.com/compose-spec/compose-go/v2/types"
+	"github.com/getarcaneapp/arcane/backend/internal/database"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/pkg/projects"
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/api/types/network"
+	"github.com/moby/moby/client"
+)
+
+const (
+	// maintenanceImage serves the placeholder page with busybox httpd.
+	maintenanceImage = "busybox:stable"
+
+	// maintenancePort is the port the placeholder listens on inside its
+	// container. Every published port of the project is mapped to it.
+	maintenancePort = "8080"
+
+	// maintenanceLabel marks the placeholder container with the ID of the
+	// project it stands in for.
+	maintenanceLabel = "com.getarcaneapp.arcane.maintenance"
+
+	defaultMaintenanceMessage = "This service is down for maintenance. Please check back soon."
+)
+
+var (
+	traefikServerPortLabelRe = regexp.MustCompile(`^traefik\.(http|tcp)\.services\.[^.]+\.loadbalancer\.server\.port$`)
+	caddyUpstreamsRe         = regexp.MustCompile(`\{\{\s*upstreams[^}]*\}\}`)
+)
+
+// ProjectMaintenanceService swaps a project's services for a placeholder
+// container that serves a "down for maintenance" page on the same published
+// ports and reverse proxy labels.
+type ProjectMaintenanceService struct {
+	db               *database.DB
+	projectService   *ProjectService
+	containerService *ContainerService
+	dockerService    *DockerClientService
+	eventService     *EventService
+}
+
+func NewProjectMaintenanceService(db *database.DB, projectService *ProjectService, containerService *ContainerService, dockerService *DockerClientService, eventService *EventService) *ProjectMaintenanceService {
+	return &ProjectMaintenanceService{
+		db:               db,
+		projectService:   projectService,
+		containerService: containerService,
+		dockerService:    dockerService,
+		eventService:     eventService,
+	}
+}
+
+// maintenancePlaceholderInternal is the configuration of a placeholder
+// container derived from a compose project.
+type maintenancePlaceholderInternal struct {
+	config     *container.Config
+	hostConfig *container.HostConfig
+	networking *network.NetworkingConfig
+}
+
+// StartMaintenance stops the project's services and starts the placeholder.
+// If the placeholder cannot be started the services are brought back up.
+func (s *ProjectMaintenanceService) StartMaintenance(ctx context.Context, projectID, message string, user models.User) (*models.Project, error) {
+	proj, err := s.projectService.GetProjectFromDatabaseByID(ctx, projectID)
+	if err != nil {
+		return nil, &models.NotFoundError{Message: err.Error()}
+	}
+	if err := ensureProjectUnlockedInternal(proj, user, "put into maintenance"); err != nil {
+		return nil, err
+	}
+	if err := ensureProjectNotArchivedInternal(proj, "put into maintenance"); err != nil {
+		return nil, err
+	}
+	if proj.Maintenance {
+		return nil, &models.ConflictError{Message: "project is already in maintenance mode"}
+	}
+
+	composeProject, err := s.projectService.loadComposeProjectInternal(ctx, proj)
+	if err != nil {
+		return nil, err
+	}
+
+	message = strings.TrimSpace(message)
+	placeholder, err := buildMaintenancePlaceholderInternal(composeProject, proj.ID, message)
+	if err != nil {
+		return nil, &models.ValidationError{Message: err.Error()}
+	}
+
+	if err := projects.ComposeStop(ctx, composeProject, nil); err != nil {
+		return nil, fmt.Errorf("failed to stop project services: %w", err)
+	}
+
+	if _, err := s.containerService.CreateContainer(ctx, placeholder.config, placeholder.hostConfig, placeholder.networking, maintenanceContainerNameInternal(composeProject.Name), user, nil); err != nil {
+		slog.ErrorContext(ctx, "Failed to start maintenance placeholder; restarting project services", "projectID", projectID, "error", err)
+		if upErr := projects.ComposeUp(ctx, composeProject, nil, false, false); upErr != nil {
+			return nil, errors.Join(fmt.Errorf("failed to start maintenance placeholder: %w", err), fmt.Errorf("failed to restart project services: %w", upErr))
+		}
+		return nil, fmt.Errorf("failed to start maintenance placeholder: %w", err)
+	}
+
+	now := time.Now()
+	var storedMessage *string
+	if message != "" {
+		storedMessage = &message
+	}
+	if err := s.db.WithContext(ctx).Model(&models.Project{}).Where("id = ?", projectID).Updates(map[string]any{
+		"maintenance":            true,
+		"maintenance_message":    storedMessage,
+		"maintenance_started_at": now,
+	}).Error; err != nil {
+		return nil, fmt.Errorf("failed to update project maintenance state: %w", err)
+	}
+	proj.Maintenance = true
+	proj.MaintenanceMessage = storedMessage
+	proj.MaintenanceStartedAt = &now
+
+	if err := s.projectService.updateProjectStatusandCountsInternal(ctx, projectID, models.ProjectStatusStopped); err != nil {
+		slog.WarnContext(ctx, "Failed to update project status after starting maintenance", "projectID", projectID, "error", err)
+	}
+
+	metadata := models.JSON{"action": "maintenance_start", "projectID": projectID, "projectName": proj.Name, "message": message}
+	if logErr := s.eventService.LogProjectEvent(ctx, models.EventTypeProjectMaintenanceStart, projectID, proj.Name, user.ID, user.Username, "0", metadata); logErr != nil {
+		slog.ErrorContext(ctx, "could not log project maintenance start", "error", logErr)
+	}
+
+	return proj, nil
+}
+
+// EndMaintenance removes the placeholder and deploys the project's services
+// again.
+func (s *ProjectMaintenanceService) EndMaintenance(ctx context.Context, projectID string, user models.User) (*models.Project, error) {
+	proj, err := s.projectService.GetProjectFromDatabaseByID(ctx, projectID)
+	if err != nil {
+		return nil, &models.NotFoundError{Message: err.Error()}
+	}
+	if err := ensureProjectUnlockedInternal(proj, user, "taken out of maintenance"); err != nil {
+		return nil, err
+	}
+	if !proj.Maintenance {
+		return nil, &models.ConflictError{Message: "project is not in maintenance mode"}
+	}
+
+	if err := s.removePlaceholdersInternal(ctx, projectID); err != nil {
+		return nil, err
+	}
+
+	if err := s.db.WithContext(ctx).Model(&models.Project{}).Where("id = ?", projectID).Updates(map[string]any{
+		"maintenance":            false,
+		"maintenance_message":    nil,
+		"maintenance_started_at": nil,
+	}).Error; err != nil {
+		return nil, fmt.Errorf("failed to update project maintenance state: %w", err)
+	}
+	proj.Maintenance = false
+	proj.MaintenanceMessage = nil
+	proj.MaintenanceStartedAt = nil
+
+	if err := s.projectService.DeployProject(ctx, projectID, user, nil); err != nil {
+		return nil, fmt.Errorf("maintenance ended but the project failed to deploy: %w", err)
+	}
+
+	metadata := models.JSON{"action": "maintenance_end", "projectID": projectID, "projectName": proj.Name}
+	if logErr := s.eventService.LogProjectEvent(ctx, models.EventTypeProjectMaintenanceEnd, projectID, proj.Name, user.ID, user.Username, "0", metadata); logErr != nil {
+		slog.ErrorContext(ctx, "could not log project maintenance end", "error", logErr)
+	}
+
+	return proj, nil
+}
+
+func (s *ProjectMaintenanceService) removePlaceholdersInternal(ctx context.Context, projectID string) error {
+	dockerClient, err := s.dockerService.GetClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to connect to Docker: %w", err)
+	}
+
+	filters := make(client.Filters).Add("label", maintenanceLabel+"="+projectID)
+	list, err := dockerClient.ContainerList(ctx, client.ContainerListOptions{All: true, Filters: filters})
+	if err != nil {
+		return fmt.Errorf("failed to list maintenance placeholders: %w", err)
+	}
+
+	for _, c := range list.Items {
+		if _, err := dockerClient.ContainerRemove(ctx, c.ID, client.ContainerRemoveOptions{Force: true}); err != nil {
+			return fmt.Errorf("failed to remove maintenance placeholder %s: %w", c.ID, err)
+		}
+	}
+	return nil
+}
+
+func maintenanceContainerNameInternal(composeName string) string {
+	return "arcane-maintenance-" + composeName
+}
+
+// buildMaintenancePlaceholderInternal derives the placeholder from the
+// published TCP ports, reverse proxy labels and networks of the project's
+// services.
+func buildMaintenancePlaceholderInternal(proj *composetypes.Project, projectID, message string) (*maintenancePlaceholderInternal, error) {
+	containerPort, err := network.ParsePort(maintenancePort + "/tcp")
+	if err != nil {
+		return nil, err
+	}
+
+	if message == "" {
+		message = defaultMaintenanceMessage
+	}
+
+	labels := map[string]string{}
+	bindings := []network.PortBinding{}
+	seenBindings := map[string]struct{}{}
+	networks := map[string]struct{}{}
+
+	for _, name := range proj.ServiceNames() {
+		svc := proj.Services[name]
+		if svc.NetworkMode == "host" {
+			continue
+		}
+
+		for key, value := range maintenanceLabelsInternal(svc.Labels) {
+			labels[key] = value
+		}
+
+		for _, port := range svc.Ports {
+			if port.Protocol != "" && port.Protocol != "tcp" {
+				continue
+			}
+			if port.Published == "" || strings.Contains(port.Published, "-") {
+				continue
+			}
+			key := port.HostIP + ":" + port.Published
+			if _, ok := seenBindings[key]; ok {
+				continue
+			}
+			seenBindings[key] = struct{}{}
+
+			binding := network.PortBinding{HostPort: port.Published}
+			if port.HostIP != "" {
+				hostIP, err := netip.ParseAddr(port.HostIP)
+				if err != nil {
+					return nil, fmt.Errorf("invalid host IP %q for service %s: %w", port.HostIP, name, err)
+				}
+				binding.HostIP = hostIP
+			}
+			bindings = append(bindings, binding)
+		}
+
+		for key := range svc.Networks {
+			if netCfg, ok := proj.Networks[key]; ok && netCfg.Name != "" {
+				networks[netCfg.Name] = struct{}{}
+			}
+		}
+	}
+
+	if len(bindings) == 0 && len(labels) == 0 {
+		return nil, errors.New("project has no published ports or labels for a maintenance page to take over")
+	}
+
+	labels[maintenanceLabel] = projectID
+
+	networkNames := make([]string, 0, len(networks))
+	for name := range networks {
+		networkNames = append(networkNames, name)
+	}
+	sort.Strings(networkNames)
+	endpoints := make(map[string]*network.EndpointSettings, len(networkNames))
+	for _, name := range networkNames {
+		endpoints[name] = &network.EndpointSettings{}
+	}
+
+	return &maintenancePlaceholderInternal{
+		config: &container.Config{
+			Image:        maintenanceImage,
+			Cmd:          []string{"sh", "-c", `mkdir -p /www && printf '%s' "$MAINTENANCE_PAGE" > /www/index.html && exec httpd -f -p ` + maintenancePort + ` -h /www`},
+			Env:          []string{"MAINTENANCE_PAGE=" + maintenancePageInternal(proj.Name, message)},
+			ExposedPorts: network.PortSet{containerPort: struct{}{}},
+			Labels:       labels,
+		},
+		hostConfig: &container.HostConfig{
+			PortBindings:  network.PortMap{containerPort: bindings},
+			RestartPolicy: container.RestartPolicy{Name: container.RestartPolicyUnlessStopped},
+		},
+		networking: &network.NetworkingConfig{EndpointsConfig: endpoints},
+	}, nil
+}
+
+// maintenanceLabelsInternal returns the reverse proxy labels of a service,
+// pointed at the placeholder's port. Compose labels are left out so the
+// placeholder is not mistaken for part of the project.
+func maintenanceLabelsInternal(serviceLabels composetypes.Labels) map[string]string {
+	labels := map[string]string{}
+	for key, value := range serviceLabels {
+		switch {
+		case traefikServerPortLabelRe.MatchString(key):
+			labels[key] = maintenancePort
+		case strings.HasPrefix(key, "traefik."):
+			labels[key] = value
+		case key == "caddy" || strings.HasPrefix(key, "caddy.") || strings.HasPrefix(key, "caddy_"):
+			labels[key] = caddyUpstreamsRe.ReplaceAllString(value, "{{upstreams "+maintenancePort+"}}")
+		}
+	}
+	return labels
+}
+
+func maintenancePageInternal(projectName, message string) string {
+	title := html.EscapeString(projectName)
+	return `<!doctype html><html><head><meta charset="utf-8"><meta name="viewport" content="width=device-width,initial-scale=1">` +
+		`<title>` + title + ` - Maintenance</title>` +
+		`<style>body{font-family:system-ui,sans-serif;display:flex;min-height:100vh;margin:0;align-items:center;justify-content:center;background:#f4f4f5;color:#18181b}main{max-width:32rem;padding:2rem;text-align:center}</style>` +
+		`</head><body><main><h1>Down for maintenance</h1><p>` + html.EscapeString(message) + `</p></main></body></html>`
+}
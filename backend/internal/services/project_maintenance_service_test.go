@@ -0,0 +1,84 @@
+package services
+
+import (
+	"testing"
+
+	composetypes "github.com/compose-spec/compose-go/v2/types"
+	"github.com/moby/moby/api/types/network"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaintenanceLabelsInternal_PointsProxyLabelsAtPlaceholder(t *testing.T) {
+	labels := maintenanceLabelsInternal(composetypes.Labels{
+		"traefik.enable":                                     "true",
+		"traefik.http.routers.app.rule":                      "Host(`app.example.com`)",
+		"traefik.http.services.app.loadbalancer.server.port": "3000",
+		"caddy":                        "app.example.com",
+		"caddy.reverse_proxy":          "{{upstreams 3000}}",
+		"com.docker.compose.project":   "app",
+		"com.getarcaneapp.arcane.icon": "https://example.com/icon.png",
+		"traefik.http.services.app.loadbalancer.server.scheme": "http",
+		"caddy_1.reverse_proxy":                                "{{ upstreams https 8443 }}",
+	})
+
+	assert.Equal(t, map[string]string{
+		"traefik.enable":                                       "true",
+		"traefik.http.routers.app.rule":                        "Host(`app.example.com`)",
+		"traefik.http.services.app.loadbalancer.server.port":   maintenancePort,
+		"traefik.http.services.app.loadbalancer.server.scheme": "http",
+		"caddy":                 "app.example.com",
+		"caddy.reverse_proxy":   "{{upstreams 8080}}",
+		"caddy_1.reverse_proxy": "{{upstreams 8080}}",
+	}, labels)
+}
+
+func TestBuildMaintenancePlaceholderInternal_TakesOverPublishedPorts(t *testing.T) {
+	proj := &composetypes.Project{
+		Name: "shop",
+		Services: composetypes.Services{
+			"web": {
+				Name: "web",
+				Ports: []composetypes.ServicePortConfig{
+					{Target: 80, Published: "8000", Protocol: "tcp"},
+					{Target: 443, Published: "8443", HostIP: "127.0.0.1"},
+					{Target: 53, Published: "5353", Protocol: "udp"},
+				},
+				Networks: map[string]*composetypes.ServiceNetworkConfig{"default": nil},
+			},
+			"api": {
+				Name:   "api",
+				Ports:  []composetypes.ServicePortConfig{{Target: 80, Published: "8000"}},
+				Labels: composetypes.Labels{"traefik.enable": "true"},
+			},
+		},
+		Networks: composetypes.Networks{"default": {Name: "shop_default"}},
+	}
+
+	placeholder, err := buildMaintenancePlaceholderInternal(proj, "proj-1", "Back at noon <3")
+	require.NoError(t, err)
+
+	port, err := network.ParsePort(maintenancePort + "/tcp")
+	require.NoError(t, err)
+	bindings := placeholder.hostConfig.PortBindings[port]
+	require.Len(t, bindings, 2)
+	assert.Equal(t, "8000", bindings[0].HostPort)
+	assert.Equal(t, "8443", bindings[1].HostPort)
+	assert.Equal(t, "127.0.0.1", bindings[1].HostIP.String())
+
+	assert.Equal(t, "proj-1", placeholder.config.Labels[maintenanceLabel])
+	assert.Equal(t, "true", placeholder.config.Labels["traefik.enable"])
+	assert.Contains(t, placeholder.networking.EndpointsConfig, "shop_default")
+	require.Len(t, placeholder.config.Env, 1)
+	assert.Contains(t, placeholder.config.Env[0], "Back at noon &lt;3")
+}
+
+func TestBuildMaintenancePlaceholderInternal_RequiresPortsOrLabels(t *testing.T) {
+	proj := &composetypes.Project{
+		Name:     "worker",
+		Services: composetypes.Services{"worker": {Name: "worker"}},
+	}
+
+	_, err := buildMaintenancePlaceholderInternal(proj, "proj-1", "")
+	require.Error(t, err)
+}
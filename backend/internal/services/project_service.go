@@ -3,13 +3,16 @@ package services
 import (
 	"bufio"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"log/slog"
 	"maps"
+	"math"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"time"
 
@@ -20,6 +23,7 @@ import (
 	"github.com/getarcaneapp/arcane/backend/internal/database"
 	"github.com/getarcaneapp/arcane/backend/internal/models"
 	"github.com/getarcaneapp/arcane/backend/internal/utils"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/cache"
 	"github.com/getarcaneapp/arcane/backend/internal/utils/docker"
 	"github.com/getarcaneapp/arcane/backend/internal/utils/fs"
 	"github.com/getarcaneapp/arcane/backend/internal/utils/mapper"
@@ -28,14 +32,34 @@ import (
 	"github.com/getarcaneapp/arcane/backend/internal/utils/timeouts"
 	libbuild "github.com/getarcaneapp/arcane/backend/pkg/libarcane/libbuild"
 	"github.com/getarcaneapp/arcane/backend/pkg/projects"
+	pkgutils "github.com/getarcaneapp/arcane/backend/pkg/utils"
 	"github.com/getarcaneapp/arcane/types"
 	"github.com/getarcaneapp/arcane/types/containerregistry"
 	imagetypes "github.com/getarcaneapp/arcane/types/image"
 	"github.com/getarcaneapp/arcane/types/project"
+	"github.com/getarcaneapp/arcane/types/rbac"
 	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/api/types/mount"
+	"github.com/moby/moby/client"
+	"golang.org/x/sync/errgroup"
 	"gorm.io/gorm"
 )
 
+const (
+	// composeContainersSnapshotTTL bounds how long the project list and the
+	// dashboard counts share one Docker container listing.
+	composeContainersSnapshotTTL = 3 * time.Second
+
+	// projectEnrichmentConcurrency limits parallel per-project metadata reads.
+	projectEnrichmentConcurrency = 8
+
+	// projectImageCheckConcurrency limits parallel local image lookups.
+	projectImageCheckConcurrency = 8
+
+	// projectImagePullConcurrency limits parallel image pulls for a project.
+	projectImagePullConcurrency = 4
+)
+
 type ProjectService struct {
 	db              *database.DB
 	settingsService *SettingsService
@@ -43,9 +67,13 @@ type ProjectService struct {
 	imageService    *ImageService
 	dockerService   *DockerClientService
 	buildService    *BuildService
+	registryService *ContainerRegistryService
+
+	composeContainers     *cache.Cache[[]container.Summary]
+	listComposeContainers func(ctx context.Context) ([]container.Summary, error)
 }
 
-func NewProjectService(db *database.DB, settingsService *SettingsService, eventService *EventService, imageService *ImageService, dockerService *DockerClientService, buildService *BuildService) *ProjectService {
+func NewProjectService(db *database.DB, settingsService *SettingsService, eventService *EventService, imageService *ImageService, dockerService *DockerClientService, buildService *BuildService, registryService *ContainerRegistryService) *ProjectService {
 	return &ProjectService{
 		db:              db,
 		settingsService: settingsService,
@@ -53,26 +81,59 @@ func NewProjectService(db *database.DB, settingsService *SettingsService, eventS
 		imageService:    imageService,
 		dockerService:   dockerService,
 		buildService:    buildService,
+		registryService: registryService,
+
+		composeContainers:     cache.New[[]container.Summary](composeContainersSnapshotTTL),
+		listComposeContainers: projects.ListGlobalComposeContainers,
 	}
 }
 
-func (s *ProjectService) getPathMapper(ctx context.Context) (*pathmapper.PathMapper, error) {
-	configuredPath := s.settingsService.GetStringSetting(ctx, "projectsDirectory", "/app/data/projects")
-
-	var containerDir, hostDir string
+// composeContainersSnapshotInternal returns the compose containers of the
+// local Docker host. Calls within composeContainersSnapshotTTL share one
+// listing, and concurrent callers share one in-flight request.
+func (s *ProjectService) composeContainersSnapshotInternal(ctx context.Context) ([]container.Summary, error) {
+	list := s.listComposeContainers
+	if list == nil {
+		list = projects.ListGlobalComposeContainers
+	}
+	if s.composeContainers == nil {
+		return list(ctx)
+	}
 
-	// Handle mapping format: "container_path:host_path"
-	if parts := strings.SplitN(configuredPath, ":", 2); len(parts) == 2 {
-		// Only treat as mapping if first part is absolute Linux path (not Windows drive)
-		if !pathmapper.IsWindowsDrivePath(configuredPath) && strings.HasPrefix(parts[0], "/") {
-			containerDir = parts[0]
-			hostDir = parts[1]
+	containers, err := s.composeContainers.GetOrFetch(ctx, list)
+	if err != nil {
+		var staleErr *cache.ErrStale
+		if errors.As(err, &staleErr) {
+			slog.WarnContext(ctx, "Using stale compose container snapshot", "error", staleErr.Err)
+			return containers, nil
 		}
+		return nil, err
 	}
+	return containers, nil
+}
 
-	if containerDir == "" {
-		containerDir = configuredPath
+// invalidateComposeContainersInternal forces the next snapshot to hit Docker,
+// so project actions are reflected immediately.
+func (s *ProjectService) invalidateComposeContainersInternal() {
+	if s.composeContainers != nil {
+		s.composeContainers.Invalidate()
 	}
+}
+
+func groupContainersByProjectInternal(containers []container.Summary) map[string][]container.Summary {
+	containersByProject := make(map[string][]container.Summary)
+	for _, c := range containers {
+		projName := c.Labels["com.docker.compose.project"]
+		if projName != "" {
+			containersByProject[projName] = append(containersByProject[projName], c)
+		}
+	}
+	return containersByProject
+}
+
+func (s *ProjectService) getPathMapper(ctx context.Context) (*pathmapper.PathMapper, error) {
+	configuredPath := s.settingsService.GetStringSetting(ctx, "projectsDirectory", "/app/data/projects")
+	containerDir, hostDir := splitProjectsDirectoryInternal(configuredPath)
 
 	// Resolve container directory to absolute path
 	containerDirResolved, err := fs.GetProjectsDirectory(ctx, strings.TrimSpace(containerDir))
@@ -81,10 +142,123 @@ func (s *ProjectService) getPathMapper(ctx context.Context) (*pathmapper.PathMap
 		containerDirResolved = "/app/data/projects"
 	}
 
+	hostDirResolved := s.resolveHostProjectsDirInternal(ctx, containerDirResolved, hostDir)
+
+	pm := pathmapper.NewPathMapper(containerDirResolved, hostDirResolved)
+	if !pm.IsNonMatchingMount() {
+		return nil, nil
+	}
+
+	return pm, nil
+}
+
+// ResolvePathMapping returns the container and host directories a
+// projectsDirectory value maps to, like getPathMapper but without creating the
+// directory. hostDir is empty when no host path is configured or discovered.
+func (s *ProjectService) ResolvePathMapping(ctx context.Context, projectsDirectory string) (containerDir, hostDir string) {
+	containerDir, hostDir = splitProjectsDirectoryInternal(projectsDirectory)
+	containerDir = cleanContainerProjectsDirInternal(containerDir)
+
+	return containerDir, s.resolveHostProjectsDirInternal(ctx, containerDir, hostDir)
+}
+
+// DiagnosePathMapping explains how getPathMapper resolves the projects
+// directory: the container path, where the host path comes from, what the
+// Arcane container mount looks like, and whether paths are translated.
+// Discovery is always attempted so a configured host path can be checked
+// against the actual mount.
+func (s *ProjectService) DiagnosePathMapping(ctx context.Context) *project.PathMappingDiagnostics {
+	configured := s.settingsService.GetStringSetting(ctx, "projectsDirectory", "/app/data/projects")
+	containerDir, hostDir := splitProjectsDirectoryInternal(configured)
+	containerDir = cleanContainerProjectsDirInternal(containerDir)
+
+	diag := &project.PathMappingDiagnostics{
+		ConfiguredValue: configured,
+		ContainerPath:   containerDir,
+		HostPathSource:  project.PathMappingHostSourceNone,
+	}
+	if hostDir = strings.TrimSpace(hostDir); hostDir != "" {
+		diag.HostPath = filepath.Clean(hostDir)
+		diag.HostPathSource = project.PathMappingHostSourceSetting
+	}
+
+	var discovery docker.HostPathDiscovery
+	dockerCli, err := s.dockerService.GetClient(ctx)
+	if err == nil {
+		discovery, err = docker.DiscoverHostPathForContainerPath(ctx, dockerCli, containerDir)
+	}
+	if err != nil {
+		diag.DiscoveryError = err.Error()
+	}
+	if discovery.Mount != nil {
+		diag.MountType = string(discovery.Mount.Type)
+		diag.MountSource = discovery.Mount.Source
+		diag.MountDestination = discovery.Mount.Destination
+	}
+	if diag.HostPathSource == project.PathMappingHostSourceNone && discovery.HostPath != "" {
+		diag.HostPath = filepath.Clean(discovery.HostPath)
+		diag.HostPathSource = project.PathMappingHostSourceDiscovered
+	}
+
+	pm := pathmapper.NewPathMapper(containerDir, diag.HostPath)
+	diag.TranslationActive = pm.IsNonMatchingMount()
+	diag.SampleContainerPath = filepath.Join(containerDir, "example", "compose.yaml")
+	diag.SampleHostPath, _ = pm.ContainerToHost(diag.SampleContainerPath)
+
+	diag.Decision, diag.Warnings = pathMappingDecisionInternal(diag, discovery)
+	return diag
+}
+
+// pathMappingDecisionInternal explains a path mapping and flags setups that
+// make bind mounts in compose files resolve to the wrong host directory.
+func pathMappingDecisionInternal(diag *project.PathMappingDiagnostics, discovery docker.HostPathDiscovery) (string, []string) {
+	var warnings []string
+
+	if discovery.Mount != nil && discovery.Mount.Type == mount.TypeVolume {
+		warnings = append(warnings, fmt.Sprintf("The projects directory is on the named volume %q, so relative bind mounts in compose files point to a path Docker cannot see. Bind mount the projects directory from the host instead.", discovery.Mount.Name))
+	}
+	if diag.HostPathSource == project.PathMappingHostSourceSetting && discovery.HostPath != "" && filepath.Clean(discovery.HostPath) != diag.HostPath {
+		warnings = append(warnings, fmt.Sprintf("The host path in projectsDirectory (%s) differs from the host path of the Arcane container mount (%s).", diag.HostPath, filepath.Clean(discovery.HostPath)))
+	}
+
+	var decision string
+	switch {
+	case diag.TranslationActive:
+		decision = fmt.Sprintf("Paths under %s are translated to %s before compose files are sent to Docker.", diag.ContainerPath, diag.HostPath)
+	case diag.HostPathSource != project.PathMappingHostSourceNone:
+		decision = "The container and host paths match, so paths are sent to Docker unchanged."
+	case diag.DiscoveryError != "":
+		decision = "The Arcane container mounts could not be inspected, so paths are sent to Docker unchanged."
+		warnings = append(warnings, "If Arcane runs in a container whose projects directory is mounted from a different host path, set projectsDirectory to \"container_path:host_path\".")
+	case discovery.Mount == nil:
+		decision = "The projects directory is not on a mount of the Arcane container, so paths are sent to Docker unchanged."
+	default:
+		decision = "No host path was found for the projects directory, so paths are sent to Docker unchanged."
+	}
+
+	return decision, warnings
+}
+
+// cleanContainerProjectsDirInternal makes the container side of the projects
+// directory absolute, like fs.GetProjectsDirectory but without creating it.
+func cleanContainerProjectsDirInternal(containerDir string) string {
+	containerDir = strings.TrimSpace(containerDir)
+	if containerDir == "" {
+		containerDir = "/app/data/projects"
+	}
+	if abs, err := filepath.Abs(containerDir); err == nil {
+		containerDir = abs
+	}
+	return filepath.Clean(containerDir)
+}
+
+// resolveHostProjectsDirInternal cleans the configured host directory, or
+// discovers it from the Docker mounts of the Arcane container when none is set.
+func (s *ProjectService) resolveHostProjectsDirInternal(ctx context.Context, containerDir, hostDir string) string {
 	// If hostDir not obtained from mapping, attempt auto-discovery from Docker mounts
-	if hostDir == "" {
+	if hostDir == "" && s.dockerService != nil {
 		if dockerCli, derr := s.dockerService.GetClient(ctx); derr == nil {
-			absContainerDir, _ := filepath.Abs(containerDirResolved)
+			absContainerDir, _ := filepath.Abs(containerDir)
 			if discovery, aerr := docker.GetHostPathForContainerPath(ctx, dockerCli, absContainerDir); aerr == nil && discovery != "" {
 				hostDir = discovery
 				slog.DebugContext(ctx, "Auto-discovered host path for projects", "container", absContainerDir, "host", hostDir)
@@ -97,13 +271,7 @@ func (s *ProjectService) getPathMapper(ctx context.Context) (*pathmapper.PathMap
 	if hostDirResolved != "" {
 		hostDirResolved = filepath.Clean(hostDirResolved)
 	}
-
-	pm := pathmapper.NewPathMapper(containerDirResolved, hostDirResolved)
-	if !pm.IsNonMatchingMount() {
-		return nil, nil
-	}
-
-	return pm, nil
+	return hostDirResolved
 }
 
 // Helpers
@@ -180,16 +348,38 @@ func resolveServiceImagePullMode(svc composetypes.ServiceConfig) imagePullMode {
 	}
 }
 
-func buildProjectImagePullPlan(services composetypes.Services) map[string]imagePullMode {
-	plan := map[string]imagePullMode{}
+// imagePullTarget is an image as a service uses it, for the service's
+// platform if it sets one.
+type imagePullTarget struct {
+	Image    string
+	Platform string
+}
+
+func (t imagePullTarget) String() string {
+	if t.Platform == "" {
+		return t.Image
+	}
+	return t.Image + " (" + t.Platform + ")"
+}
+
+// buildProjectImagePullPlan decides how each image of services is pulled
+// from the services' pull_policy. Services without a pull_policy use
+// defaultMode. When services share an image and platform, the most eager
+// policy wins.
+func buildProjectImagePullPlan(services composetypes.Services, defaultMode imagePullMode) map[imagePullTarget]imagePullMode {
+	plan := map[imagePullTarget]imagePullMode{}
 	for _, svc := range services {
 		img := strings.TrimSpace(svc.Image)
 		if img == "" {
 			continue
 		}
-		mode := resolveServiceImagePullMode(svc)
-		if existing, exists := plan[img]; !exists || mode > existing {
-			plan[img] = mode
+		mode := defaultMode
+		if strings.TrimSpace(svc.PullPolicy) != "" {
+			mode = resolveServiceImagePullMode(svc)
+		}
+		target := imagePullTarget{Image: img, Platform: strings.TrimSpace(svc.Platform)}
+		if existing, exists := plan[target]; !exists || mode > existing {
+			plan[target] = mode
 		}
 	}
 	return plan
@@ -385,6 +575,8 @@ func (s *ProjectService) GetProjectDetails(ctx context.Context, projectID string
 	resp.HasBuildDirective = false
 	resp.DirName = utils.DerefString(proj.DirName)
 	resp.GitOpsManagedBy = proj.GitOpsManagedBy
+	resp.LockedAt = formatOptionalTimeInternal(proj.LockedAt)
+	resp.MaintenanceStartedAt = formatOptionalTimeInternal(proj.MaintenanceStartedAt)
 	meta := s.getProjectMetadataFromPath(ctx, proj.Path)
 	resp.IconURL = meta.ProjectIconURL
 	resp.URLs = meta.ProjectURLS
@@ -402,6 +594,17 @@ func (s *ProjectService) GetProjectDetails(ctx context.Context, projectID string
 	composeFile, _ := projects.DetectComposeFile(proj.Path)
 	if composeFile != "" {
 		s.enrichWithComposeServiceConfigs(ctx, proj, composeFile, &resp)
+		if check, err := s.checkProjectEnvInternal(ctx, composeFile); err == nil {
+			resp.EnvWarnings = projects.EnvCheckWarnings(check)
+		} else {
+			slog.DebugContext(ctx, "failed to check project environment", "path", composeFile, "error", err)
+		}
+	}
+
+	// Archived projects are down and not polled.
+	if proj.Status == models.ProjectStatusArchived {
+		resp.RunningCount = 0
+		return resp, nil
 	}
 
 	// Get runtime services and update status/counts
@@ -425,6 +628,10 @@ func (s *ProjectService) GetProjectDetails(ctx context.Context, projectID string
 				IconURL:       svc.IconURL,
 				ServiceConfig: svc.ServiceConfig,
 			}
+			if svc.ServiceConfig != nil {
+				runtimeServices[i].Runtime = svc.ServiceConfig.Runtime
+				runtimeServices[i].Devices, runtimeServices[i].DeviceRequests = projects.ServiceDevices(*svc.ServiceConfig)
+			}
 		}
 		resp.RuntimeServices = runtimeServices
 	}
@@ -432,6 +639,490 @@ func (s *ProjectService) GetProjectDetails(ctx context.Context, projectID string
 	return resp, nil
 }
 
+// CheckProjectEnv compares the variables referenced by the project's compose
+// files with the variables defined for it.
+func (s *ProjectService) CheckProjectEnv(ctx context.Context, projectID string) (*project.EnvCheck, error) {
+	proj, err := s.GetProjectFromDatabaseByID(ctx, projectID)
+	if err != nil {
+		return nil, &models.NotFoundError{Message: err.Error()}
+	}
+
+	composeFile, err := projects.DetectComposeFile(proj.Path)
+	if err != nil {
+		return nil, &models.NotFoundError{Message: err.Error()}
+	}
+
+	check, err := s.checkProjectEnvInternal(ctx, composeFile)
+	if err != nil {
+		return nil, err
+	}
+	return &check, nil
+}
+
+func (s *ProjectService) checkProjectEnvInternal(ctx context.Context, composeFile string) (project.EnvCheck, error) {
+	projectsDirSetting := s.settingsService.GetStringSetting(ctx, "projectsDirectory", "/app/data/projects")
+	projectsDirectory, _ := fs.GetProjectsDirectory(ctx, strings.TrimSpace(projectsDirSetting))
+	autoInjectEnv := s.settingsService.GetBoolSetting(ctx, "autoInjectEnv", false)
+	return projects.CheckProjectEnv(composeFile, projectsDirectory, autoInjectEnv)
+}
+
+// GetResourceQuotaForComposeName returns the quota of the project whose
+// compose name is name, or nil when it has none. It is registered with the
+// compose loader so quotas are enforced wherever a project is loaded.
+func (s *ProjectService) GetResourceQuotaForComposeName(ctx context.Context, name string) (*project.ResourceQuota, error) {
+	var candidates []models.Project
+	if err := s.db.WithContext(ctx).
+		Where("quota_cpus IS NOT NULL OR quota_memory_bytes IS NOT NULL").
+		Find(&candidates).Error; err != nil {
+		return nil, fmt.Errorf("failed to list project quotas: %w", err)
+	}
+
+	for _, p := range candidates {
+		if normalizeComposeProjectName(p.Name) == name {
+			return projectQuotaInternal(&p), nil
+		}
+	}
+	return nil, nil
+}
+
+// GetProjectQuota returns the project's quota, how it is divided between the
+// services on deploy, and the current usage of its running containers.
+func (s *ProjectService) GetProjectQuota(ctx context.Context, projectID string) (*project.QuotaStatus, error) {
+	proj, err := s.GetProjectFromDatabaseByID(ctx, projectID)
+	if err != nil {
+		return nil, &models.NotFoundError{Message: err.Error()}
+	}
+
+	status := &project.QuotaStatus{
+		Quota:      projectQuotaInternal(proj),
+		Allocation: project.QuotaAllocation{Services: []project.ServiceAllocation{}, Warnings: []string{}},
+	}
+
+	// Load without the quota so the allocation can tell declared limits from
+	// injected ones.
+	composeProject, err := s.loadComposeProjectInternal(context.WithValue(ctx, projects.SkipResourceQuotaKey{}, true), proj)
+	if err != nil {
+		return nil, err
+	}
+	var quota project.ResourceQuota
+	if status.Quota != nil {
+		quota = *status.Quota
+	}
+	status.Allocation = projects.ApplyResourceQuota(composeProject, quota)
+
+	usage, err := s.projectResourceUsageInternal(ctx, normalizeComposeProjectName(proj.Name))
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to measure project resource usage", "projectID", projectID, "error", err)
+	}
+	status.Usage = usage
+
+	return status, nil
+}
+
+// UpdateProjectQuota sets the project's quota. A quota with neither CPUs nor
+// memory removes it.
+func (s *ProjectService) UpdateProjectQuota(ctx context.Context, projectID string, quota project.ResourceQuota) (*project.QuotaStatus, error) {
+	if quota.CPUs < 0 || quota.MemoryBytes < 0 {
+		return nil, &models.ValidationError{Message: "quota values must not be negative"}
+	}
+	if quota.MemoryBytes > 0 && quota.MemoryBytes < projects.MinQuotaMemoryBytes {
+		return nil, &models.ValidationError{Message: "memory quota must be at least 6MiB", Field: "memoryBytes"}
+	}
+
+	if _, err := s.GetProjectFromDatabaseByID(ctx, projectID); err != nil {
+		return nil, &models.NotFoundError{Message: err.Error()}
+	}
+
+	updates := map[string]any{
+		"quota_cpus":         nil,
+		"quota_memory_bytes": nil,
+		"updated_at":         time.Now(),
+	}
+	if quota.CPUs > 0 {
+		updates["quota_cpus"] = quota.CPUs
+	}
+	if quota.MemoryBytes > 0 {
+		updates["quota_memory_bytes"] = quota.MemoryBytes
+	}
+	if err := s.db.WithContext(ctx).Model(&models.Project{}).Where("id = ?", projectID).Updates(updates).Error; err != nil {
+		return nil, fmt.Errorf("failed to update project quota: %w", err)
+	}
+
+	return s.GetProjectQuota(ctx, projectID)
+}
+
+// DeleteProjectQuota removes the project's quota. Running containers keep
+// their limits until the project is redeployed.
+func (s *ProjectService) DeleteProjectQuota(ctx context.Context, projectID string) error {
+	_, err := s.UpdateProjectQuota(ctx, projectID, project.ResourceQuota{})
+	return err
+}
+
+// GetProjectRegistries returns the registries whose credentials are scoped to
+// the project.
+func (s *ProjectService) GetProjectRegistries(ctx context.Context, projectID string) (*project.Registries, error) {
+	if _, err := s.GetProjectFromDatabaseByID(ctx, projectID); err != nil {
+		return nil, &models.NotFoundError{Message: err.Error()}
+	}
+	if s.registryService == nil {
+		return &project.Registries{RegistryIDs: []string{}}, nil
+	}
+
+	ids, err := s.registryService.GetProjectRegistryIDs(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return &project.Registries{RegistryIDs: ids}, nil
+}
+
+// UpdateProjectRegistries scopes the given registries' credentials to the
+// project, replacing its previous set. Project pulls try them before the
+// global registries, and other pulls stop using them.
+func (s *ProjectService) UpdateProjectRegistries(ctx context.Context, projectID string, registries project.Registries, user models.User) (*project.Registries, error) {
+	proj, err := s.GetProjectFromDatabaseByID(ctx, projectID)
+	if err != nil {
+		return nil, &models.NotFoundError{Message: err.Error()}
+	}
+	if err := ensureProjectUnlockedInternal(proj, user, "updated"); err != nil {
+		return nil, err
+	}
+	if s.registryService == nil {
+		return nil, errors.New("registry service not available")
+	}
+
+	if err := s.registryService.SetProjectRegistries(ctx, projectID, registries.RegistryIDs); err != nil {
+		return nil, err
+	}
+
+	metadata := models.JSON{"action": "update_registries", "registryIds": registries.RegistryIDs}
+	if logErr := s.eventService.LogProjectEvent(ctx, models.EventTypeProjectUpdate, proj.ID, proj.Name, user.ID, user.Username, "0", metadata); logErr != nil {
+		slog.ErrorContext(ctx, "could not log project registries update", "error", logErr)
+	}
+
+	return s.GetProjectRegistries(ctx, projectID)
+}
+
+// LockProject protects the project from deploy, down, destroy and update
+// operations by anyone but admins.
+func (s *ProjectService) LockProject(ctx context.Context, projectID, reason string, user models.User) (*models.Project, error) {
+	proj, err := s.GetProjectFromDatabaseByID(ctx, projectID)
+	if err != nil {
+		return nil, &models.NotFoundError{Message: err.Error()}
+	}
+	if proj.Locked {
+		return nil, &models.ConflictError{Message: "project is already locked"}
+	}
+
+	now := time.Now()
+	proj.Locked = true
+	proj.LockReason = nil
+	if reason = strings.TrimSpace(reason); reason != "" {
+		proj.LockReason = &reason
+	}
+	proj.LockedBy = &user.Username
+	proj.LockedAt = &now
+	if err := s.db.WithContext(ctx).Model(&models.Project{}).Where("id = ?", projectID).Updates(map[string]any{
+		"locked":      true,
+		"lock_reason": proj.LockReason,
+		"locked_by":   proj.LockedBy,
+		"locked_at":   proj.LockedAt,
+	}).Error; err != nil {
+		return nil, fmt.Errorf("failed to lock project: %w", err)
+	}
+
+	metadata := models.JSON{"action": "lock", "projectID": projectID, "projectName": proj.Name, "reason": reason}
+	if logErr := s.eventService.LogProjectEvent(ctx, models.EventTypeProjectLock, projectID, proj.Name, user.ID, user.Username, "0", metadata); logErr != nil {
+		slog.ErrorContext(ctx, "could not log project lock action", "error", logErr)
+	}
+
+	return proj, nil
+}
+
+// UnlockProject removes the project's lock. The reason is recorded in the
+// project's events.
+func (s *ProjectService) UnlockProject(ctx context.Context, projectID, reason string, user models.User) (*models.Project, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, &models.ValidationError{Message: "a reason is required to unlock a project", Field: "reason"}
+	}
+
+	proj, err := s.GetProjectFromDatabaseByID(ctx, projectID)
+	if err != nil {
+		return nil, &models.NotFoundError{Message: err.Error()}
+	}
+	if !proj.Locked {
+		return nil, &models.ConflictError{Message: "project is not locked"}
+	}
+
+	if err := s.db.WithContext(ctx).Model(&models.Project{}).Where("id = ?", projectID).Updates(map[string]any{
+		"locked":      false,
+		"lock_reason": nil,
+		"locked_by":   nil,
+		"locked_at":   nil,
+	}).Error; err != nil {
+		return nil, fmt.Errorf("failed to unlock project: %w", err)
+	}
+
+	metadata := models.JSON{
+		"action":      "unlock",
+		"projectID":   projectID,
+		"projectName": proj.Name,
+		"reason":      reason,
+		"lockedBy":    utils.DerefString(proj.LockedBy),
+		"lockReason":  utils.DerefString(proj.LockReason),
+	}
+	if logErr := s.eventService.LogProjectEvent(ctx, models.EventTypeProjectUnlock, projectID, proj.Name, user.ID, user.Username, "0", metadata); logErr != nil {
+		slog.ErrorContext(ctx, "could not log project unlock action", "error", logErr)
+	}
+
+	proj.Locked = false
+	proj.LockReason = nil
+	proj.LockedBy = nil
+	proj.LockedAt = nil
+	return proj, nil
+}
+
+// ArchiveProject brings the project down and marks it archived. Archived
+// projects keep their files and database record but are left out of update
+// checks, status polling and dashboard counts until they are unarchived.
+func (s *ProjectService) ArchiveProject(ctx context.Context, projectID string, user models.User) (*models.Project, error) {
+	proj, err := s.GetProjectFromDatabaseByID(ctx, projectID)
+	if err != nil {
+		return nil, &models.NotFoundError{Message: err.Error()}
+	}
+	if proj.Status == models.ProjectStatusArchived {
+		return nil, &models.ConflictError{Message: "project is already archived"}
+	}
+	if err := ensureProjectUnlockedInternal(proj, user, "archived"); err != nil {
+		return nil, err
+	}
+	if proj.Maintenance {
+		return nil, &models.ConflictError{Message: fmt.Sprintf("project %s is in maintenance mode; end maintenance to archive it", proj.Name)}
+	}
+
+	if err := s.DownProject(ctx, projectID, user); err != nil {
+		return nil, err
+	}
+
+	if err := s.db.WithContext(ctx).Model(&models.Project{}).Where("id = ?", projectID).Updates(map[string]any{
+		"status":        models.ProjectStatusArchived,
+		"running_count": 0,
+		"updated_at":    time.Now(),
+	}).Error; err != nil {
+		return nil, fmt.Errorf("failed to archive project: %w", err)
+	}
+
+	metadata := models.JSON{"action": "archive", "projectID": projectID, "projectName": proj.Name}
+	if logErr := s.eventService.LogProjectEvent(ctx, models.EventTypeProjectArchive, projectID, proj.Name, user.ID, user.Username, "0", metadata); logErr != nil {
+		slog.ErrorContext(ctx, "could not log project archive action", "error", logErr)
+	}
+
+	proj.Status = models.ProjectStatusArchived
+	proj.RunningCount = 0
+	return proj, nil
+}
+
+// UnarchiveProject returns an archived project to the stopped state. It is
+// not deployed.
+func (s *ProjectService) UnarchiveProject(ctx context.Context, projectID string, user models.User) (*models.Project, error) {
+	proj, err := s.GetProjectFromDatabaseByID(ctx, projectID)
+	if err != nil {
+		return nil, &models.NotFoundError{Message: err.Error()}
+	}
+	if proj.Status != models.ProjectStatusArchived {
+		return nil, &models.ConflictError{Message: "project is not archived"}
+	}
+
+	if err := s.updateProjectStatusInternal(ctx, projectID, models.ProjectStatusStopped); err != nil {
+		return nil, err
+	}
+
+	metadata := models.JSON{"action": "unarchive", "projectID": projectID, "projectName": proj.Name}
+	if logErr := s.eventService.LogProjectEvent(ctx, models.EventTypeProjectUnarchive, projectID, proj.Name, user.ID, user.Username, "0", metadata); logErr != nil {
+		slog.ErrorContext(ctx, "could not log project unarchive action", "error", logErr)
+	}
+
+	proj.Status = models.ProjectStatusStopped
+	return proj, nil
+}
+
+// ensureProjectNotArchivedInternal rejects starting an archived project.
+func ensureProjectNotArchivedInternal(proj *models.Project, action string) error {
+	if proj.Status != models.ProjectStatusArchived {
+		return nil
+	}
+	return &models.ConflictError{Message: fmt.Sprintf("project %s is archived; unarchive it before it can be %s", proj.Name, action)}
+}
+
+// ensureProjectUnlockedInternal rejects changes to a locked project unless
+// the user is an admin.
+func ensureProjectUnlockedInternal(proj *models.Project, user models.User, action string) error {
+	if !proj.Locked || pkgutils.UserHasRole(user.Roles, rbac.RoleAdmin) {
+		return nil
+	}
+	msg := fmt.Sprintf("project %s is locked and cannot be %s", proj.Name, action)
+	if proj.LockReason != nil && *proj.LockReason != "" {
+		msg += ": " + *proj.LockReason
+	}
+	return &models.ConflictError{Message: msg}
+}
+
+// gitOpsEditKey marks a context whose project edits may touch GitOps-managed
+// projects, either because the GitOps sync itself is writing or because the
+// user explicitly overrode the read-only guard.
+type gitOpsEditKey struct{}
+
+type gitOpsEditMode int
+
+const (
+	gitOpsEditSync gitOpsEditMode = iota + 1
+	gitOpsEditOverride
+)
+
+// WithGitOpsOverride returns a context that lets edits through to projects
+// managed by a GitOps sync. Overridden edits are recorded in the event log,
+// since they drift from the repository until the next sync replaces them.
+func WithGitOpsOverride(ctx context.Context) context.Context {
+	return context.WithValue(ctx, gitOpsEditKey{}, gitOpsEditOverride)
+}
+
+func withGitOpsSyncInternal(ctx context.Context) context.Context {
+	return context.WithValue(ctx, gitOpsEditKey{}, gitOpsEditSync)
+}
+
+// ensureProjectEditableInternal rejects edits to GitOps-managed projects
+// unless ctx carries the sync or an override. It reports whether the edit
+// overrides the guard, so callers can record it.
+func ensureProjectEditableInternal(ctx context.Context, proj *models.Project) (bool, error) {
+	if proj.GitOpsManagedBy == nil || *proj.GitOpsManagedBy == "" {
+		return false, nil
+	}
+	switch mode, _ := ctx.Value(gitOpsEditKey{}).(gitOpsEditMode); mode {
+	case gitOpsEditSync:
+		return false, nil
+	case gitOpsEditOverride:
+		slog.WarnContext(ctx, "editing GitOps-managed project with override", "projectID", proj.ID, "project", proj.Name)
+		return true, nil
+	}
+	return false, &models.ConflictError{Message: fmt.Sprintf("project %s is managed by GitOps and is read-only; change it in the repository or override to edit it anyway", proj.Name)}
+}
+
+func formatOptionalTimeInternal(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	formatted := t.Format(time.RFC3339)
+	return &formatted
+}
+
+func projectQuotaInternal(p *models.Project) *project.ResourceQuota {
+	if p.QuotaCPUs == nil && p.QuotaMemoryBytes == nil {
+		return nil
+	}
+	quota := &project.ResourceQuota{}
+	if p.QuotaCPUs != nil {
+		quota.CPUs = *p.QuotaCPUs
+	}
+	if p.QuotaMemoryBytes != nil {
+		quota.MemoryBytes = *p.QuotaMemoryBytes
+	}
+	return quota
+}
+
+func (s *ProjectService) loadComposeProjectInternal(ctx context.Context, proj *models.Project) (*composetypes.Project, error) {
+	composeFile, err := projects.DetectComposeFile(proj.Path)
+	if err != nil {
+		return nil, &models.NotFoundError{Message: err.Error()}
+	}
+
+	projectsDirSetting := s.settingsService.GetStringSetting(ctx, "projectsDirectory", "/app/data/projects")
+	projectsDirectory, pdErr := fs.GetProjectsDirectory(ctx, strings.TrimSpace(projectsDirSetting))
+	if pdErr != nil {
+		slog.WarnContext(ctx, "unable to determine projects directory; using default", "error", pdErr)
+		projectsDirectory = "/app/data/projects"
+	}
+
+	pathMapper, pmErr := s.getPathMapper(ctx)
+	if pmErr != nil {
+		slog.WarnContext(ctx, "failed to create path mapper, continuing without translation", "error", pmErr)
+	}
+
+	autoInjectEnv := s.settingsService.GetBoolSetting(ctx, "autoInjectEnv", false)
+	composeProject, err := projects.LoadComposeProject(ctx, composeFile, normalizeComposeProjectName(proj.Name), projectsDirectory, autoInjectEnv, pathMapper)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load compose project from %s: %w", proj.Path, err)
+	}
+	return composeProject, nil
+}
+
+// projectResourceUsageInternal sums a single stats sample of each running
+// container of the compose project.
+func (s *ProjectService) projectResourceUsageInternal(ctx context.Context, composeName string) (project.ResourceUsage, error) {
+	usage := project.ResourceUsage{}
+
+	containers, err := s.composeContainersSnapshotInternal(ctx)
+	if err != nil {
+		return usage, fmt.Errorf("failed to list project containers: %w", err)
+	}
+
+	dockerClient, err := s.dockerService.GetClient(ctx)
+	if err != nil {
+		return usage, fmt.Errorf("failed to connect to Docker: %w", err)
+	}
+
+	for _, c := range groupContainersByProjectInternal(containers)[composeName] {
+		if c.State != container.StateRunning {
+			continue
+		}
+
+		stats, err := dockerClient.ContainerStats(ctx, c.ID, client.ContainerStatsOptions{IncludePreviousSample: true})
+		if err != nil {
+			slog.DebugContext(ctx, "Failed to get container stats", "containerID", c.ID, "error", err)
+			continue
+		}
+		var sample container.StatsResponse
+		decodeErr := json.NewDecoder(stats.Body).Decode(&sample)
+		_ = stats.Body.Close()
+		if decodeErr != nil {
+			slog.DebugContext(ctx, "Failed to decode container stats", "containerID", c.ID, "error", decodeErr)
+			continue
+		}
+
+		usage.CPUs += statsCPUsInternal(sample)
+		usage.MemoryBytes += statsMemoryBytesInternal(sample)
+		usage.Containers++
+	}
+
+	usage.CPUs = math.Round(usage.CPUs*100) / 100
+	return usage, nil
+}
+
+// statsCPUsInternal converts a stats sample to the number of cores in use.
+func statsCPUsInternal(sample container.StatsResponse) float64 {
+	cpuDelta := float64(sample.CPUStats.CPUUsage.TotalUsage) - float64(sample.PreCPUStats.CPUUsage.TotalUsage)
+	systemDelta := float64(sample.CPUStats.SystemUsage) - float64(sample.PreCPUStats.SystemUsage)
+	if cpuDelta <= 0 || systemDelta <= 0 {
+		return 0
+	}
+	onlineCPUs := float64(sample.CPUStats.OnlineCPUs)
+	if onlineCPUs == 0 {
+		onlineCPUs = float64(len(sample.CPUStats.CPUUsage.PercpuUsage))
+	}
+	return cpuDelta / systemDelta * onlineCPUs
+}
+
+// statsMemoryBytesInternal returns the memory in use without page cache, as
+// docker stats does.
+func statsMemoryBytesInternal(sample container.StatsResponse) int64 {
+	used := sample.MemoryStats.Usage
+	if inactive, ok := sample.MemoryStats.Stats["inactive_file"]; ok && inactive < used {
+		used -= inactive
+	} else if cache, ok := sample.MemoryStats.Stats["total_inactive_file"]; ok && cache < used {
+		used -= cache
+	}
+	return int64(used)
+}
+
 func (s *ProjectService) enrichWithIncludeFiles(ctx context.Context, projectPath string, resp *project.Details) {
 	composeFile, detectErr := projects.DetectComposeFile(projectPath)
 	if detectErr == nil {
@@ -495,6 +1186,7 @@ func (s *ProjectService) enrichWithComposeServiceConfigs(ctx context.Context, pr
 	}
 	resp.Services = svcList
 	resp.HasBuildDirective = resp.HasBuildDirective || hasBuildDirective
+	resp.LintFindings = projects.LintComposeProject(composeProj, s.lintConfigInternal(ctx))
 }
 
 func (s *ProjectService) SyncProjectsFromFileSystem(ctx context.Context) error {
@@ -539,6 +1231,73 @@ func (s *ProjectService) SyncProjectsFromFileSystem(ctx context.Context) error {
 	return nil
 }
 
+// AutoDeployChangedProjects redeploys the projects touched by changedPaths whose
+// compose file opts in with `x-arcane: {auto-deploy: true}`. Stopped, locked,
+// archived and maintenance projects are left alone. It is called by the projects
+// watcher after a sync, so new folders already have project records.
+func (s *ProjectService) AutoDeployChangedProjects(ctx context.Context, changedPaths []string) {
+	projectsDirSetting := s.settingsService.GetStringSetting(ctx, "projectsDirectory", "/app/data/projects")
+	projectsDir, err := fs.GetProjectsDirectory(ctx, strings.TrimSpace(projectsDirSetting))
+	if err != nil {
+		slog.WarnContext(ctx, "unable to prepare projects directory", "error", err)
+		return
+	}
+
+	for _, dirPath := range projectDirsFromChangedPathsInternal(filepath.Clean(projectsDir), changedPaths) {
+		composeFile, derr := projects.DetectComposeFile(dirPath)
+		if derr != nil {
+			// Removed folders are handled by the sync; nothing to deploy.
+			continue
+		}
+
+		meta, merr := projects.ParseArcaneComposeMetadata(ctx, composeFile)
+		if merr != nil {
+			slog.WarnContext(ctx, "failed to parse Arcane compose metadata", "path", composeFile, "error", merr)
+			continue
+		}
+		if !meta.AutoDeploy {
+			continue
+		}
+
+		var proj models.Project
+		if err := s.db.WithContext(ctx).Where("path = ?", dirPath).First(&proj).Error; err != nil {
+			slog.WarnContext(ctx, "no project record for changed folder", "path", dirPath, "error", err)
+			continue
+		}
+		// A stopped project was stopped on purpose; editing its files shouldn't start it.
+		if proj.Locked || proj.Maintenance || proj.Status == models.ProjectStatusArchived || proj.Status == models.ProjectStatusStopped {
+			slog.InfoContext(ctx, "Skipping auto-deploy of project", "project", proj.Name, "status", proj.Status, "locked", proj.Locked, "maintenance", proj.Maintenance)
+			continue
+		}
+
+		slog.InfoContext(ctx, "Project files changed on disk, auto-deploying", "project", proj.Name, "path", dirPath)
+		if err := s.DeployProject(ctx, proj.ID, systemUser, nil); err != nil {
+			slog.ErrorContext(ctx, "Auto-deploy after filesystem change failed", "project", proj.Name, "error", err)
+		}
+	}
+}
+
+// projectDirsFromChangedPathsInternal maps changed paths to the top-level project
+// folders under projectsDir that contain them, in first-seen order.
+func projectDirsFromChangedPathsInternal(projectsDir string, changedPaths []string) []string {
+	seen := map[string]struct{}{}
+	dirs := make([]string, 0, len(changedPaths))
+	for _, p := range changedPaths {
+		rel, err := filepath.Rel(projectsDir, filepath.Clean(p))
+		if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			continue
+		}
+		dirName, _, _ := strings.Cut(rel, string(filepath.Separator))
+		dirPath := filepath.Join(projectsDir, dirName)
+		if _, ok := seen[dirPath]; ok {
+			continue
+		}
+		seen[dirPath] = struct{}{}
+		dirs = append(dirs, dirPath)
+	}
+	return dirs
+}
+
 func (s *ProjectService) upsertProjectForDir(ctx context.Context, dirName, dirPath string) error {
 	var existing models.Project
 	err := s.db.WithContext(ctx).
@@ -701,27 +1460,47 @@ func (s *ProjectService) incrementStatusCounts(status models.ProjectStatus, runn
 		*running++
 	case models.ProjectStatusStopped, models.ProjectStatusStopping:
 		*stopped++
-	case models.ProjectStatusUnknown:
-		// Don't count unknown
+	case models.ProjectStatusUnknown, models.ProjectStatusArchived:
+		// Don't count unknown or archived
 	}
 }
 
 func (s *ProjectService) GetProjectStatusCounts(ctx context.Context) (folderCount, runningProjects, stoppedProjects, totalProjects int, err error) {
-	folderCount, _ = s.countProjectFolders(ctx)
-
-	var projectsList []models.Project
-	if err := s.db.WithContext(ctx).Find(&projectsList).Error; err != nil {
-		return folderCount, 0, 0, 0, fmt.Errorf("failed to list projects: %w", err)
+	// The folder scan, the project query and the container listing are
+	// independent, so run them side by side.
+	var (
+		projectsList []models.Project
+		containers   []container.Summary
+		containerErr error
+	)
+	g, groupCtx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		folderCount, _ = s.countProjectFolders(groupCtx)
+		return nil
+	})
+	g.Go(func() error {
+		if err := s.db.WithContext(groupCtx).Find(&projectsList).Error; err != nil {
+			return fmt.Errorf("failed to list projects: %w", err)
+		}
+		return nil
+	})
+	g.Go(func() error {
+		containers, containerErr = s.composeContainersSnapshotInternal(groupCtx)
+		return nil
+	})
+	if err := g.Wait(); err != nil {
+		return folderCount, 0, 0, 0, err
 	}
 
+	projectsList = slices.DeleteFunc(projectsList, func(p models.Project) bool {
+		return p.Status == models.ProjectStatusArchived
+	})
 	totalProjects = len(projectsList)
 	runningProjects = 0
 	stoppedProjects = 0
 
-	// 1. Fetch all compose containers
-	containers, err := projects.ListGlobalComposeContainers(ctx)
-	if err != nil {
-		slog.ErrorContext(ctx, "Failed to list global compose containers for counts", "error", err)
+	if containerErr != nil {
+		slog.ErrorContext(ctx, "Failed to list global compose containers for counts", "error", containerErr)
 		// Fallback to DB status
 		for _, p := range projectsList {
 			s.incrementStatusCounts(p.Status, &runningProjects, &stoppedProjects)
@@ -729,16 +1508,8 @@ func (s *ProjectService) GetProjectStatusCounts(ctx context.Context) (folderCoun
 		return folderCount, runningProjects, stoppedProjects, totalProjects, nil
 	}
 
-	// 2. Group by project
-	containersByProject := make(map[string][]container.Summary)
-	for _, c := range containers {
-		projName := c.Labels["com.docker.compose.project"]
-		if projName != "" {
-			containersByProject[projName] = append(containersByProject[projName], c)
-		}
-	}
+	containersByProject := groupContainersByProjectInternal(containers)
 
-	// 3. Calculate status for each project
 	for _, p := range projectsList {
 		normName := normalizeComposeProjectName(p.Name)
 		projectContainers := containersByProject[normName]
@@ -792,10 +1563,27 @@ func (s *ProjectService) GetProjectStatusCounts(ctx context.Context) (folderCoun
 // Project Actions
 
 func (s *ProjectService) DeployProject(ctx context.Context, projectID string, user models.User, options *project.DeployOptions) error {
+	return s.deployProjectInternal(ctx, projectID, user, options, nil)
+}
+
+// deployProjectInternal deploys the project. When services is set, only
+// those services are created or recreated.
+func (s *ProjectService) deployProjectInternal(ctx context.Context, projectID string, user models.User, options *project.DeployOptions, services []string) error {
+	defer s.invalidateComposeContainersInternal()
+
 	projectFromDb, err := s.GetProjectFromDatabaseByID(ctx, projectID)
 	if err != nil {
 		return fmt.Errorf("failed to get project: %w", err)
 	}
+	if err := ensureProjectUnlockedInternal(projectFromDb, user, "deployed"); err != nil {
+		return err
+	}
+	if err := ensureProjectNotArchivedInternal(projectFromDb, "deployed"); err != nil {
+		return err
+	}
+	if projectFromDb.Maintenance {
+		return &models.ConflictError{Message: fmt.Sprintf("project %s is in maintenance mode; end maintenance to deploy it", projectFromDb.Name)}
+	}
 
 	resolvedPullPolicy := ""
 	forceRecreate := false
@@ -834,21 +1622,36 @@ func (s *ProjectService) DeployProject(ctx context.Context, projectID string, us
 		return fmt.Errorf("failed to load compose project from %s: %w", projectFromDb.Path, loadErr)
 	}
 
+	progressWriter, _ := ctx.Value(projects.ProgressWriterKey{}).(io.Writer)
+
+	// The loader already applied the quota; applying it again changes nothing
+	// but yields the warnings to show the user.
+	if quota := projectQuotaInternal(projectFromDb); quota != nil {
+		if warnings := projects.ApplyResourceQuota(project, *quota).Warnings; len(warnings) > 0 {
+			slog.WarnContext(ctx, "Deploy exceeds project resource quota", "projectID", projectID, "warnings", warnings)
+			projects.WriteQuotaWarnings(progressWriter, warnings)
+		}
+	}
+
+	if err := s.checkProjectDevicesInternal(ctx, project, services); err != nil {
+		return err
+	}
+
 	if err := s.updateProjectStatusInternal(ctx, projectID, models.ProjectStatusDeploying); err != nil {
 		return fmt.Errorf("failed to update project status to deploying: %w", err)
 	}
 
-	progressWriter, _ := ctx.Value(projects.ProgressWriterKey{}).(io.Writer)
 	if perr := s.prepareProjectImagesForDeploy(ctx, projectID, project, progressWriter, nil, &user, resolvedPullPolicy); perr != nil {
 		s.restoreProjectStatusAfterFailedDeployInternal(ctx, projectID)
 		return fmt.Errorf("failed to prepare project images for deploy: %w", perr)
 	}
 
-	removeOrphans := projectFromDb.GitOpsManagedBy != nil && *projectFromDb.GitOpsManagedBy != ""
+	// Orphans are only removed when the whole project is deployed.
+	removeOrphans := len(services) == 0 && projectFromDb.GitOpsManagedBy != nil && *projectFromDb.GitOpsManagedBy != ""
 
 	slog.Info("starting compose up with health check support", "projectID", projectID, "projectName", project.Name, "services", len(project.Services), "removeOrphans", removeOrphans)
 	// Health/progress streaming (if any) is handled inside projects.ComposeUp via ctx.
-	if err := projects.ComposeUp(ctx, project, nil, removeOrphans, forceRecreate); err != nil {
+	if err := projects.ComposeUp(ctx, project, services, removeOrphans, forceRecreate); err != nil {
 		slog.Error("compose up failed", "projectName", project.Name, "projectID", projectID, "error", err)
 		if containers, psErr := s.GetProjectServices(ctx, projectID); psErr == nil {
 			slog.Info("containers after failed deploy", "projectID", projectID, "containers", containers)
@@ -856,6 +1659,10 @@ func (s *ProjectService) DeployProject(ctx context.Context, projectID string, us
 		s.restoreProjectStatusAfterFailedDeployInternal(ctx, projectID)
 
 		// Provide more helpful error messages
+		var deviceErr *projects.DeviceError
+		if errors.As(projects.ExplainDeviceError(err), &deviceErr) {
+			return deviceErr
+		}
 		errMsg := err.Error()
 		if strings.Contains(errMsg, "timeout") || strings.Contains(errMsg, "context deadline exceeded") {
 			return fmt.Errorf("deployment timed out - check if services with 'condition: service_healthy' have healthchecks defined: %w", err)
@@ -865,6 +1672,9 @@ func (s *ProjectService) DeployProject(ctx context.Context, projectID string, us
 	slog.Info("compose up completed successfully", "projectID", projectID, "projectName", project.Name)
 
 	metadata := models.JSON{"action": "deploy", "projectID": projectID, "projectName": project.Name}
+	if len(services) > 0 {
+		metadata["services"] = services
+	}
 	if logErr := s.eventService.LogProjectEvent(ctx, models.EventTypeProjectDeploy, projectID, project.Name, user.ID, user.Username, "0", metadata); logErr != nil {
 		slog.ErrorContext(ctx, "could not log project deployment action", "error", logErr)
 	}
@@ -876,11 +1686,37 @@ func (s *ProjectService) DeployProject(ctx context.Context, projectID string, us
 	return err
 }
 
+// checkProjectDevicesInternal returns a *projects.DeviceError when the Docker
+// host lacks a runtime or device the services to deploy request. Deploys go
+// ahead when the host cannot be inspected.
+func (s *ProjectService) checkProjectDevicesInternal(ctx context.Context, composeProj *composetypes.Project, services []string) error {
+	if s.dockerService == nil || !projects.RequestsDevices(composeProj, services) {
+		return nil
+	}
+
+	dockerClient, err := s.dockerService.GetClient(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "Skipping device check before deploy", "project", composeProj.Name, "error", err)
+		return nil
+	}
+	infoResult, err := dockerClient.Info(ctx, client.InfoOptions{})
+	if err != nil {
+		slog.WarnContext(ctx, "Skipping device check before deploy", "project", composeProj.Name, "error", err)
+		return nil
+	}
+	return projects.CheckDevices(composeProj, services, infoResult.Info)
+}
+
 func (s *ProjectService) DownProject(ctx context.Context, projectID string, user models.User) error {
+	defer s.invalidateComposeContainersInternal()
+
 	projectFromDb, err := s.GetProjectFromDatabaseByID(ctx, projectID)
 	if err != nil {
 		return err
 	}
+	if err := ensureProjectUnlockedInternal(projectFromDb, user, "brought down"); err != nil {
+		return err
+	}
 
 	// Get configured projects directory from settings
 	projectsDirSetting := s.settingsService.GetStringSetting(ctx, "projectsDirectory", "/app/data/projects")
@@ -937,6 +1773,21 @@ func (s *ProjectService) CreateProject(ctx context.Context, name, composeContent
 		return nil, fmt.Errorf("failed to create project directory: %w", err)
 	}
 
+	// Linting needs the loaded project, so the compose file is only
+	// validated up front when a rule is on.
+	if s.lintConfigInternal(ctx).Enabled() {
+		composeProj, err := s.validateComposeContentForUpdate(ctx, projectPath, name, composeContent, envContent)
+		if err == nil {
+			err = s.enforceComposeLintInternal(ctx, name, composeProj)
+		} else {
+			err = fmt.Errorf("invalid compose file: %w", err)
+		}
+		if err != nil {
+			_ = os.RemoveAll(projectPath)
+			return nil, err
+		}
+	}
+
 	proj := &models.Project{
 		Name:         name,
 		DirName:      &folderName,
@@ -964,7 +1815,122 @@ func (s *ProjectService) CreateProject(ctx context.Context, name, composeContent
 	return proj, nil
 }
 
+// CloneProject copies the project's directory into a new project named
+// name. The compose file is rewritten so the copy does not collide with the
+// original: see projects.RewriteComposeForClone. It returns the new project
+// and the rewritten values.
+func (s *ProjectService) CloneProject(ctx context.Context, projectID, name string, copyData bool, user models.User) (*models.Project, []string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, nil, &models.ValidationError{Message: "project name is required", Field: "name"}
+	}
+
+	source, err := s.GetProjectFromDatabaseByID(ctx, projectID)
+	if err != nil {
+		return nil, nil, &models.NotFoundError{Message: err.Error()}
+	}
+
+	var existing int64
+	if err := s.db.WithContext(ctx).Model(&models.Project{}).Where("name = ?", name).Count(&existing).Error; err != nil {
+		return nil, nil, fmt.Errorf("failed to check project name: %w", err)
+	}
+	if existing > 0 {
+		return nil, nil, &models.ConflictError{Message: fmt.Sprintf("a project named %s already exists", name)}
+	}
+
+	composeFile, err := projects.DetectComposeFile(source.Path)
+	if err != nil {
+		return nil, nil, &models.NotFoundError{Message: err.Error()}
+	}
+	content, err := os.ReadFile(composeFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read compose file: %w", err)
+	}
+
+	usedPorts, err := s.publishedHostPortsInternal(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to list published ports; only the original project's ports are avoided", "error", err)
+	}
+
+	rewrite, err := projects.RewriteComposeForClone(content, normalizeComposeProjectName(name), usedPorts)
+	if err != nil {
+		return nil, nil, &models.ValidationError{Message: err.Error()}
+	}
+
+	projectsDirectory, err := fs.GetProjectsDirectory(ctx, s.settingsService.GetStringSetting(ctx, "projectsDirectory", "/app/data/projects"))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get projects directory: %w", err)
+	}
+	projectPath, folderName, err := fs.CreateUniqueDir(projectsDirectory, filepath.Join(projectsDirectory, fs.SanitizeProjectName(name)), name, common.DirPerm)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create project directory: %w", err)
+	}
+
+	emptyDirs := map[string]struct{}{}
+	if !copyData {
+		for _, dir := range rewrite.BindSources {
+			emptyDirs[dir] = struct{}{}
+		}
+	}
+	if err := fs.CopyProjectDir(source.Path, projectPath, emptyDirs); err != nil {
+		_ = os.RemoveAll(projectPath)
+		return nil, nil, fmt.Errorf("failed to copy project files: %w", err)
+	}
+	clonedComposePath := filepath.Join(projectPath, filepath.Base(composeFile))
+	fs.MarkSelfWrite(clonedComposePath)
+	if err := os.WriteFile(clonedComposePath, rewrite.Content, common.FilePerm); err != nil {
+		_ = os.RemoveAll(projectPath)
+		return nil, nil, fmt.Errorf("failed to write compose file: %w", err)
+	}
+
+	proj := &models.Project{
+		Name:    name,
+		DirName: &folderName,
+		Path:    projectPath,
+		Status:  models.ProjectStatusStopped,
+	}
+	if err := s.db.WithContext(ctx).Create(proj).Error; err != nil {
+		_ = os.RemoveAll(projectPath)
+		return nil, nil, fmt.Errorf("failed to create project: %w", err)
+	}
+
+	metadata := models.JSON{"action": "clone", "projectID": proj.ID, "projectName": name, "path": projectPath, "sourceProjectID": source.ID, "sourceProjectName": source.Name}
+	if logErr := s.eventService.LogProjectEvent(ctx, models.EventTypeProjectCreate, proj.ID, name, user.ID, user.Username, "0", metadata); logErr != nil {
+		slog.ErrorContext(ctx, "could not log project clone", "error", logErr)
+	}
+
+	return proj, rewrite.Changes, nil
+}
+
+// publishedHostPortsInternal returns the host ports published by any
+// container on the Docker host.
+func (s *ProjectService) publishedHostPortsInternal(ctx context.Context) (map[int]struct{}, error) {
+	ports := map[int]struct{}{}
+	if s.dockerService == nil {
+		return ports, nil
+	}
+
+	dockerClient, err := s.dockerService.GetClient(ctx)
+	if err != nil {
+		return ports, fmt.Errorf("failed to connect to Docker: %w", err)
+	}
+	list, err := dockerClient.ContainerList(ctx, client.ContainerListOptions{All: true})
+	if err != nil {
+		return ports, fmt.Errorf("failed to list containers: %w", err)
+	}
+	for _, c := range list.Items {
+		for _, p := range c.Ports {
+			if p.PublicPort != 0 {
+				ports[int(p.PublicPort)] = struct{}{}
+			}
+		}
+	}
+	return ports, nil
+}
+
 func (s *ProjectService) DestroyProject(ctx context.Context, projectID string, removeFiles, removeVolumes bool, user models.User) error {
+	defer s.invalidateComposeContainersInternal()
+
 	slog.DebugContext(ctx, "DestroyProject service called",
 		"projectID", projectID,
 		"removeFiles", removeFiles,
@@ -976,12 +1942,18 @@ func (s *ProjectService) DestroyProject(ctx context.Context, projectID string, r
 	if err != nil {
 		return err
 	}
+	if err := ensureProjectUnlockedInternal(proj, user, "destroyed"); err != nil {
+		return err
+	}
+	if proj.Maintenance {
+		return &models.ConflictError{Message: fmt.Sprintf("project %s is in maintenance mode; end maintenance before destroying it", proj.Name)}
+	}
 
 	slog.DebugContext(ctx, "Found project to destroy",
 		"projectName", proj.Name,
 		"projectPath", proj.Path)
 
-	if err := s.DownProject(ctx, projectID, systemUser); err != nil {
+	if err := s.DownProject(ctx, projectID, user); err != nil {
 		slog.WarnContext(ctx, "failed to bring down project", "error", err)
 	}
 
@@ -1023,6 +1995,7 @@ func (s *ProjectService) DestroyProject(ctx context.Context, projectID string, r
 	if err := s.db.WithContext(ctx).Delete(proj).Error; err != nil {
 		return fmt.Errorf("failed to delete project from database: %w", err)
 	}
+	deleteResourceTagsInternal(ctx, s.db, models.TagResourceProject, projectID)
 
 	metadata := models.JSON{"action": "destroy", "projectID": projectID, "projectName": proj.Name, "removeFiles": removeFiles, "removeVolumes": removeVolumes}
 	if logErr := s.eventService.LogProjectEvent(ctx, models.EventTypeProjectDelete, projectID, proj.Name, user.ID, user.Username, "0", metadata); logErr != nil {
@@ -1037,6 +2010,9 @@ func (s *ProjectService) RedeployProject(ctx context.Context, projectID string,
 	if err != nil {
 		return err
 	}
+	if err := ensureProjectUnlockedInternal(proj, user, "redeployed"); err != nil {
+		return err
+	}
 
 	if err := s.PullProjectImages(ctx, projectID, io.Discard, user, nil); err != nil {
 		slog.WarnContext(ctx, "failed to pull project images", "error", err)
@@ -1069,40 +2045,17 @@ func (s *ProjectService) PullProjectImages(ctx context.Context, projectID string
 		slog.WarnContext(ctx, "failed to create path mapper, continuing without translation", "error", pmErr)
 	}
 
-	autoInjectEnv := s.settingsService.GetBoolSetting(ctx, "autoInjectEnv", false)
-	compProj, _, lerr := projects.LoadComposeProjectFromDir(ctx, proj.Path, normalizeComposeProjectName(proj.Name), projectsDirectory, autoInjectEnv, pathMapper)
-	if lerr != nil {
-		return fmt.Errorf("failed to load compose project: %w", lerr)
-	}
-
-	images := map[string]struct{}{}
-	for _, svc := range compProj.Services {
-		img := strings.TrimSpace(svc.Image)
-		if img == "" {
-			continue
-		}
-		images[img] = struct{}{}
-	}
-
-	settings := s.settingsService.GetSettingsConfig()
-
-	for img := range images {
-		err := func() error {
-			pullCtx, pullCancel := timeouts.WithTimeout(ctx, settings.DockerImagePullTimeout.AsInt(), timeouts.DefaultDockerImagePull)
-			defer pullCancel()
-			if err := s.imageService.PullImage(pullCtx, img, progressWriter, user, credentials); err != nil {
-				if errors.Is(pullCtx.Err(), context.DeadlineExceeded) {
-					return fmt.Errorf("image pull timed out for %s (increase DOCKER_IMAGE_PULL_TIMEOUT or setting)", img)
-				}
-				return fmt.Errorf("failed to pull image %s: %w", img, err)
-			}
-			return nil
-		}()
-		if err != nil {
-			return err
-		}
+	autoInjectEnv := s.settingsService.GetBoolSetting(ctx, "autoInjectEnv", false)
+	compProj, _, lerr := projects.LoadComposeProjectFromDir(ctx, proj.Path, normalizeComposeProjectName(proj.Name), projectsDirectory, autoInjectEnv, pathMapper)
+	if lerr != nil {
+		return fmt.Errorf("failed to load compose project: %w", lerr)
 	}
-	return nil
+
+	// An explicit pull refreshes every image unless its service's
+	// pull_policy says otherwise.
+	pullPlan := buildProjectImagePullPlan(compProj.Services, imagePullModeAlways)
+
+	return s.ensureImagesPresent(ctx, pullPlan, progressWriter, s.projectCredentialsInternal(ctx, proj.ID, credentials), user)
 }
 
 func (s *ProjectService) BuildProjectServices(ctx context.Context, projectID string, options ProjectBuildOptions, progressWriter io.Writer, user *models.User) error {
@@ -1167,16 +2120,52 @@ func (s *ProjectService) EnsureProjectImagesPresent(ctx context.Context, project
 		return fmt.Errorf("failed to load compose project: %w", lerr)
 	}
 
-	pullPlan := buildProjectImagePullPlan(compProj.Services)
+	pullPlan := buildProjectImagePullPlan(compProj.Services, imagePullModeIfMissing)
+
+	return s.ensureImagesPresent(ctx, pullPlan, progressWriter, s.projectCredentialsInternal(ctx, proj.ID, credentials), user)
+}
 
-	return s.ensureImagesPresent(ctx, pullPlan, progressWriter, credentials, user)
+// projectCredentialsInternal returns credentials followed by the registry
+// credentials scoped to projectID. Credentials passed with the request win,
+// and scoped ones are tried before the global registries.
+func (s *ProjectService) projectCredentialsInternal(ctx context.Context, projectID string, credentials []containerregistry.Credential) []containerregistry.Credential {
+	if s.registryService == nil {
+		return credentials
+	}
+	scoped, err := s.registryService.GetProjectCredentials(ctx, projectID)
+	if err != nil {
+		slog.WarnContext(ctx, "failed to load project registry credentials; using global registries only", "projectID", projectID, "error", err)
+		return credentials
+	}
+	return append(slices.Clone(credentials), scoped...)
 }
 
-func (s *ProjectService) ensureImagesPresent(ctx context.Context, pullPlan map[string]imagePullMode, progressWriter io.Writer, credentials []containerregistry.Credential, user models.User) error {
+// ensureImagesPresent checks and pulls the images of pullPlan with bounded
+// concurrency. Pull progress is merged onto progressWriter with each line
+// tagged with its image, and every failed pull is reported, not just the
+// first.
+func (s *ProjectService) ensureImagesPresent(ctx context.Context, pullPlan map[imagePullTarget]imagePullMode, progressWriter io.Writer, credentials []containerregistry.Credential, user models.User) error {
 	settings := s.settingsService.GetSettingsConfig()
 
-	for img, mode := range pullPlan {
-		exists, ierr := s.imageService.ImageExistsLocally(ctx, img)
+	images := slices.SortedFunc(maps.Keys(pullPlan), func(a, b imagePullTarget) int {
+		return strings.Compare(a.String(), b.String())
+	})
+	exists := make([]bool, len(images))
+	checkErrs := make([]error, len(images))
+	var checks errgroup.Group
+	checks.SetLimit(projectImageCheckConcurrency)
+	for i, img := range images {
+		checks.Go(func() error {
+			exists[i], checkErrs[i] = s.imageService.ImageExistsLocallyForPlatform(ctx, img.Image, img.Platform)
+			return nil
+		})
+	}
+	_ = checks.Wait()
+
+	var toPull []imagePullTarget
+	for i, img := range images {
+		mode := pullPlan[img]
+		ierr := checkErrs[i]
 		if ierr != nil && mode != imagePullModeAlways {
 			slog.WarnContext(ctx, "failed to check local image existence", "image", img, "error", ierr)
 			// Non-fatal: attempt to pull to be safe
@@ -1187,42 +2176,53 @@ func (s *ProjectService) ensureImagesPresent(ctx context.Context, pullPlan map[s
 				slog.WarnContext(ctx, "pull_policy is 'never' but image presence check failed; continuing without pull", "image", img, "error", ierr)
 				continue
 			}
-			if !exists {
+			if !exists[i] {
 				return fmt.Errorf("image %s is not available locally and pull_policy is 'never'", img)
 			}
 			slog.DebugContext(ctx, "pull_policy is 'never'; using local image without pull", "image", img)
 			continue
 		}
 
-		if mode == imagePullModeIfMissing && exists {
+		if mode == imagePullModeIfMissing && exists[i] {
 			slog.DebugContext(ctx, "image already present locally; skipping pull", "image", img)
 			continue
 		}
 
-		err := func() error {
+		toPull = append(toPull, img)
+	}
+
+	mux := docker.NewProgressMux(progressWriter)
+	pullErrs := make([]error, len(toPull))
+	var pulls errgroup.Group
+	pulls.SetLimit(projectImagePullConcurrency)
+	for i, img := range toPull {
+		pulls.Go(func() error {
+			stream := mux.Stream("image", img.String())
+			defer func() { _ = stream.Close() }()
+
 			pullCtx, pullCancel := timeouts.WithTimeout(ctx, settings.DockerImagePullTimeout.AsInt(), timeouts.DefaultDockerImagePull)
 			defer pullCancel()
-			if err := s.imageService.PullImage(pullCtx, img, progressWriter, user, credentials); err != nil {
+			if err := s.imageService.PullImageForPlatform(pullCtx, img.Image, img.Platform, stream, user, credentials); err != nil {
 				if errors.Is(pullCtx.Err(), context.DeadlineExceeded) {
-					return fmt.Errorf("image pull timed out for %s (increase DOCKER_IMAGE_PULL_TIMEOUT or setting)", img)
+					pullErrs[i] = fmt.Errorf("image pull timed out for %s (increase DOCKER_IMAGE_PULL_TIMEOUT or setting)", img)
+				} else {
+					pullErrs[i] = fmt.Errorf("failed to pull image %s: %w", img, err)
 				}
-				return fmt.Errorf("failed to pull image %s: %w", img, err)
 			}
 			return nil
-		}()
-		if err != nil {
-			return err
-		}
+		})
 	}
-	return nil
+	_ = pulls.Wait()
+
+	return errors.Join(pullErrs...)
 }
 
-func (s *ProjectService) pullImageForService(ctx context.Context, imageRef string, progressWriter io.Writer, credentials []containerregistry.Credential) error {
+func (s *ProjectService) pullImageForService(ctx context.Context, imageRef, platform string, progressWriter io.Writer, credentials []containerregistry.Credential) error {
 	settings := s.settingsService.GetSettingsConfig()
 	pullCtx, pullCancel := timeouts.WithTimeout(ctx, settings.DockerImagePullTimeout.AsInt(), timeouts.DefaultDockerImagePull)
 	defer pullCancel()
 
-	if err := s.imageService.PullImage(pullCtx, imageRef, progressWriter, systemUser, credentials); err != nil {
+	if err := s.imageService.PullImageForPlatform(pullCtx, imageRef, platform, progressWriter, systemUser, credentials); err != nil {
 		if errors.Is(pullCtx.Err(), context.DeadlineExceeded) {
 			return fmt.Errorf("image pull timed out for %s (increase DOCKER_IMAGE_PULL_TIMEOUT or setting)", imageRef)
 		}
@@ -1249,6 +2249,7 @@ func (s *ProjectService) prepareProjectImagesForDeploy(
 	if pmErr != nil {
 		slog.WarnContext(ctx, "failed to create path mapper, continuing without translation", "error", pmErr)
 	}
+	credentials = s.projectCredentialsInternal(ctx, projectID, credentials)
 
 	for name, svc := range project.Services {
 		svc, imageName, updated := prepareDeployServiceConfig(projectID, project.Name, name, svc)
@@ -1302,7 +2303,8 @@ func (s *ProjectService) ensureDeployServiceImageReady(
 		return s.buildServiceImageForDeploy(ctx, projectID, project, serviceName, svc, progressWriter, user, pathMapper)
 	}
 
-	exists, err := s.imageService.ImageExistsLocally(ctx, imageName)
+	platform := strings.TrimSpace(svc.Platform)
+	exists, err := s.imageService.ImageExistsLocallyForPlatform(ctx, imageName, platform)
 	if err != nil {
 		slog.WarnContext(ctx, "failed to check local image existence", "image", imageName, "error", err)
 	}
@@ -1318,7 +2320,7 @@ func (s *ProjectService) ensureDeployServiceImageReady(
 		return nil
 	}
 
-	if err := s.pullImageForService(ctx, imageName, progressWriter, credentials); err == nil {
+	if err := s.pullImageForService(ctx, imageName, platform, progressWriter, credentials); err == nil {
 		return nil
 	} else if svc.Build != nil && decision.FallbackBuildOnPullFail {
 		slog.WarnContext(ctx, "image pull failed, falling back to build", "service", serviceName, "image", imageName, "error", err)
@@ -1763,10 +2765,15 @@ func sanitizeImageComponent(value string) string {
 }
 
 func (s *ProjectService) RestartProject(ctx context.Context, projectID string, user models.User) error {
+	defer s.invalidateComposeContainersInternal()
+
 	proj, err := s.GetProjectFromDatabaseByID(ctx, projectID)
 	if err != nil {
 		return err
 	}
+	if err := ensureProjectNotArchivedInternal(proj, "restarted"); err != nil {
+		return err
+	}
 
 	if err := s.updateProjectStatusInternal(ctx, projectID, models.ProjectStatusRestarting); err != nil {
 		return fmt.Errorf("failed to update project status to restarting: %w", err)
@@ -1814,6 +2821,13 @@ func (s *ProjectService) UpdateProject(ctx context.Context, projectID string, na
 	if err != nil {
 		return nil, err
 	}
+	if err := ensureProjectUnlockedInternal(&proj, user, "updated"); err != nil {
+		return nil, err
+	}
+	gitOpsOverride, err := ensureProjectEditableInternal(ctx, &proj)
+	if err != nil {
+		return nil, err
+	}
 
 	if err := s.withProjectRenameRollback(ctx, &proj, func() error {
 		if err := s.applyProjectRenameIfNeeded(&proj, name, projectsDirectory); err != nil {
@@ -1841,6 +2855,9 @@ func (s *ProjectService) UpdateProject(ctx context.Context, projectID string, na
 	if envContent != nil {
 		metadata["envUpdated"] = true
 	}
+	if gitOpsOverride {
+		metadata["gitopsOverride"] = true
+	}
 	if logErr := s.eventService.LogProjectEvent(ctx, models.EventTypeProjectUpdate, proj.ID, proj.Name, user.ID, user.Username, "0", metadata); logErr != nil {
 		slog.ErrorContext(ctx, "could not log project update action", "error", logErr)
 	}
@@ -1849,6 +2866,73 @@ func (s *ProjectService) UpdateProject(ctx context.Context, projectID string, na
 	return &proj, nil
 }
 
+// PatchProjectService changes single fields of one service in the project's
+// compose file, keeping the rest of the file as written, see
+// projects.PatchComposeService. The patched file is validated like a full
+// update. With patch.Redeploy, only the patched service is recreated.
+func (s *ProjectService) PatchProjectService(ctx context.Context, projectID, serviceName string, patch project.ServicePatch, user models.User) (*project.ServicePatchResult, error) {
+	proj, projectsDirectory, err := s.getProjectForUpdate(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureProjectUnlockedInternal(&proj, user, "updated"); err != nil {
+		return nil, err
+	}
+	gitOpsOverride, err := ensureProjectEditableInternal(ctx, &proj)
+	if err != nil {
+		return nil, err
+	}
+
+	composeFile, err := projects.DetectComposeFile(proj.Path)
+	if err != nil {
+		return nil, &models.NotFoundError{Message: err.Error()}
+	}
+	content, err := os.ReadFile(composeFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read compose file: %w", err)
+	}
+
+	rewrite, err := projects.PatchComposeService(content, serviceName, patch)
+	if errors.Is(err, projects.ErrComposeServiceNotFound) {
+		return nil, &models.NotFoundError{Message: err.Error()}
+	}
+	if err != nil {
+		return nil, &models.ValidationError{Message: err.Error()}
+	}
+
+	result := &project.ServicePatchResult{Changes: rewrite.Changes}
+	if len(rewrite.Changes) > 0 {
+		composeContent := string(rewrite.Content)
+		if err := s.persistUpdatedProjectFiles(ctx, &proj, projectsDirectory, &composeContent, nil); err != nil {
+			return nil, err
+		}
+
+		metadata := models.JSON{
+			"action":         "update",
+			"projectID":      proj.ID,
+			"projectName":    proj.Name,
+			"service":        serviceName,
+			"changes":        rewrite.Changes,
+			"composeUpdated": true,
+		}
+		if gitOpsOverride {
+			metadata["gitopsOverride"] = true
+		}
+		if logErr := s.eventService.LogProjectEvent(ctx, models.EventTypeProjectUpdate, proj.ID, proj.Name, user.ID, user.Username, "0", metadata); logErr != nil {
+			slog.ErrorContext(ctx, "could not log project update action", "error", logErr)
+		}
+		slog.InfoContext(ctx, "project service patched", "projectID", proj.ID, "service", serviceName, "changes", len(rewrite.Changes))
+	}
+
+	if patch.Redeploy {
+		if err := s.deployProjectInternal(ctx, proj.ID, user, nil, []string{serviceName}); err != nil {
+			return nil, fmt.Errorf("compose file saved, but redeploying %s failed: %w", serviceName, err)
+		}
+		result.Redeployed = true
+	}
+	return result, nil
+}
+
 func (s *ProjectService) getProjectForUpdate(ctx context.Context, projectID string) (models.Project, string, error) {
 	var proj models.Project
 	if err := s.db.WithContext(ctx).First(&proj, "id = ?", projectID).Error; err != nil {
@@ -1892,9 +2976,13 @@ func (s *ProjectService) withProjectRenameRollback(ctx context.Context, proj *mo
 func (s *ProjectService) persistUpdatedProjectFiles(ctx context.Context, proj *models.Project, projectsDirectory string, composeContent, envContent *string) error {
 	switch {
 	case composeContent != nil:
-		if err := s.validateComposeContentForUpdate(ctx, proj.Path, proj.Name, *composeContent, envContent); err != nil {
+		composeProj, err := s.validateComposeContentForUpdate(ctx, proj.Path, proj.Name, *composeContent, envContent)
+		if err != nil {
 			return fmt.Errorf("invalid compose file: %w", err)
 		}
+		if err := s.enforceComposeLintInternal(ctx, proj.Name, composeProj); err != nil {
+			return err
+		}
 		if err := fs.SaveOrUpdateProjectFiles(projectsDirectory, proj.Path, *composeContent, envContent); err != nil {
 			return fmt.Errorf("failed to save project files: %w", err)
 		}
@@ -1907,7 +2995,7 @@ func (s *ProjectService) persistUpdatedProjectFiles(ctx context.Context, proj *m
 	return nil
 }
 
-func (s *ProjectService) validateComposeContentForUpdate(ctx context.Context, projectPath, projectName, composeContent string, envContent *string) (err error) {
+func (s *ProjectService) validateComposeContentForUpdate(ctx context.Context, projectPath, projectName, composeContent string, envContent *string) (composeProj *composetypes.Project, err error) {
 	defer func() {
 		if recovered := recover(); recovered != nil {
 			err = fmt.Errorf("compose file contains invalid syntax: %v", recovered)
@@ -1925,7 +3013,7 @@ func (s *ProjectService) validateComposeContentForUpdate(ctx context.Context, pr
 	// Prefer the provided new environment content if available, otherwise read from disk.
 	if envContent != nil {
 		if fileEnv, envErr := projects.ParseProjectEnvContent(*envContent, fullEnvMap); envErr != nil {
-			return fmt.Errorf("parse provided env content: %w", envErr)
+			return nil, fmt.Errorf("parse provided env content: %w", envErr)
 		} else {
 			for k, v := range fileEnv {
 				fullEnvMap[k] = v
@@ -1934,7 +3022,7 @@ func (s *ProjectService) validateComposeContentForUpdate(ctx context.Context, pr
 	} else {
 		projectEnvPath := filepath.Join(projectPath, ".env")
 		if fileEnv, envErr := projects.ParseProjectEnvFile(projectEnvPath, fullEnvMap); envErr != nil {
-			return fmt.Errorf("parse project env file: %w", envErr)
+			return nil, fmt.Errorf("parse project env file: %w", envErr)
 		} else {
 			for k, v := range fileEnv {
 				fullEnvMap[k] = v
@@ -1943,6 +3031,11 @@ func (s *ProjectService) validateComposeContentForUpdate(ctx context.Context, pr
 	}
 
 	validationProjectName := normalizeComposeProjectName(projectName)
+	composeContent, err = projects.ApplyTemplateVariables(ctx, composeContent, validationProjectName, projectPath)
+	if err != nil {
+		return nil, err
+	}
+
 	cfg := composetypes.ConfigDetails{
 		Version:    api.ComposeVersion,
 		WorkingDir: projectPath,
@@ -1953,15 +3046,75 @@ func (s *ProjectService) validateComposeContentForUpdate(ctx context.Context, pr
 	}
 
 	err = withTransientValidationEnvFile(projectPath, envContent, func() error {
-		_, loadErr := loader.LoadWithContext(ctx, cfg, func(opts *loader.Options) {
+		loaded, loadErr := loader.LoadWithContext(ctx, cfg, func(opts *loader.Options) {
 			if validationProjectName != "" {
 				opts.SetProjectName(validationProjectName, true)
 			}
 		})
+		composeProj = loaded
 		return loadErr
 	})
+	if err != nil {
+		return nil, err
+	}
 
-	return err
+	return composeProj, nil
+}
+
+// lintConfigInternal returns the compose lint rules configured by the admin.
+// A broken configuration turns linting off rather than blocking every save.
+func (s *ProjectService) lintConfigInternal(ctx context.Context) projects.LintConfig {
+	cfg, err := projects.ParseLintConfig(s.settingsService.GetStringSetting(ctx, "composeLintRules", ""))
+	if err != nil {
+		slog.WarnContext(ctx, "ignoring invalid compose lint rules", "error", err)
+		return projects.LintConfig{}
+	}
+	return cfg
+}
+
+// enforceComposeLintInternal returns a *projects.LintError when composeProj
+// breaks lint rules set to error. Warnings are only logged here; they are
+// reported with the project details.
+func (s *ProjectService) enforceComposeLintInternal(ctx context.Context, projectName string, composeProj *composetypes.Project) error {
+	findings := projects.LintComposeProject(composeProj, s.lintConfigInternal(ctx))
+	if blocking := projects.LintBlockingFindings(findings); len(blocking) > 0 {
+		return &projects.LintError{Findings: blocking}
+	}
+	if len(findings) > 0 {
+		slog.InfoContext(ctx, "compose file has lint warnings", "project", projectName, "warnings", len(findings))
+	}
+	return nil
+}
+
+// LintProject checks the project's compose file against the configured lint
+// rules.
+func (s *ProjectService) LintProject(ctx context.Context, projectID string) ([]project.LintFinding, error) {
+	cfg := s.lintConfigInternal(ctx)
+	if !cfg.Enabled() {
+		return nil, nil
+	}
+
+	proj, err := s.GetProjectFromDatabaseByID(ctx, projectID)
+	if err != nil {
+		return nil, &models.NotFoundError{Message: err.Error()}
+	}
+	composeFile, err := projects.DetectComposeFile(proj.Path)
+	if err != nil {
+		return nil, &models.NotFoundError{Message: err.Error()}
+	}
+
+	projectsDirectory, _ := fs.GetProjectsDirectory(ctx, strings.TrimSpace(s.settingsService.GetStringSetting(ctx, "projectsDirectory", "/app/data/projects")))
+	pathMapper, pmErr := s.getPathMapper(ctx)
+	if pmErr != nil {
+		slog.WarnContext(ctx, "failed to create path mapper, continuing without translation", "error", pmErr)
+	}
+	autoInjectEnv := s.settingsService.GetBoolSetting(ctx, "autoInjectEnv", false)
+	composeProj, err := projects.LoadComposeProject(ctx, composeFile, normalizeComposeProjectName(proj.Name), projectsDirectory, autoInjectEnv, pathMapper)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load compose project: %w", err)
+	}
+
+	return projects.LintComposeProject(composeProj, cfg), nil
 }
 
 func withTransientValidationEnvFile(projectPath string, envContent *string, run func() error) (err error) {
@@ -2053,12 +3206,23 @@ func (s *ProjectService) UpdateProjectIncludeFile(ctx context.Context, projectID
 	if err != nil {
 		return err
 	}
+	if err := ensureProjectUnlockedInternal(proj, user, "updated"); err != nil {
+		return err
+	}
+	gitOpsOverride, err := ensureProjectEditableInternal(ctx, proj)
+	if err != nil {
+		return err
+	}
 
 	// Normalize and persist project path to ensure include writes occur under projects root
 	if err := s.ensureProjectPathUnderRoot(ctx, proj, true); err != nil {
 		return err
 	}
 
+	if err := projects.ValidateCustomFile(projects.DetectCustomFileType(relativePath), content); err != nil {
+		return err
+	}
+
 	if err := projects.WriteIncludeFile(proj.Path, relativePath, content); err != nil {
 		return fmt.Errorf("failed to update include file: %w", err)
 	}
@@ -2069,6 +3233,9 @@ func (s *ProjectService) UpdateProjectIncludeFile(ctx context.Context, projectID
 		"projectName":  proj.Name,
 		"relativePath": relativePath,
 	}
+	if gitOpsOverride {
+		metadata["gitopsOverride"] = true
+	}
 	if logErr := s.eventService.LogProjectEvent(ctx, models.EventTypeProjectUpdate, proj.ID, proj.Name, user.ID, user.Username, "0", metadata); logErr != nil {
 		slog.ErrorContext(ctx, "could not log project include update action", "error", logErr)
 	}
@@ -2077,6 +3244,169 @@ func (s *ProjectService) UpdateProjectIncludeFile(ctx context.Context, projectID
 	return nil
 }
 
+// CreateProjectCustomFile creates a custom file in a project, such as a proxy
+// or monitoring config mounted by a service. When no content is given the
+// type's template is written, and the content is validated against the type's
+// syntax before anything touches the disk.
+func (s *ProjectService) CreateProjectCustomFile(ctx context.Context, projectID string, req project.CreateCustomFile, user models.User) error {
+	proj, err := s.GetProjectFromDatabaseByID(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	if err := ensureProjectUnlockedInternal(proj, user, "updated"); err != nil {
+		return err
+	}
+	gitOpsOverride, err := ensureProjectEditableInternal(ctx, proj)
+	if err != nil {
+		return err
+	}
+	if err := s.ensureProjectPathUnderRoot(ctx, proj, true); err != nil {
+		return err
+	}
+
+	targetPath, err := projects.ValidateIncludePathForWrite(proj.Path, req.RelativePath)
+	if err != nil {
+		return &models.ValidationError{Message: err.Error(), Field: "relativePath"}
+	}
+	if _, err := os.Stat(targetPath); err == nil {
+		return &models.ConflictError{Message: fmt.Sprintf("%s already exists in project %s", req.RelativePath, proj.Name)}
+	}
+
+	fileType := req.Type
+	if fileType == "" {
+		fileType = projects.DetectCustomFileType(req.RelativePath)
+	}
+	tmpl, ok := projects.CustomFileTemplate(fileType)
+	if !ok {
+		return &models.ValidationError{Message: fmt.Sprintf("unknown custom file type %q", fileType), Field: "type"}
+	}
+	content := tmpl.Content
+	if req.Content != nil {
+		content = *req.Content
+	}
+	if err := projects.ValidateCustomFile(fileType, content); err != nil {
+		return err
+	}
+
+	fs.MarkSelfWrite(targetPath)
+	if err := projects.WriteIncludeFile(proj.Path, req.RelativePath, content); err != nil {
+		return fmt.Errorf("failed to create custom file: %w", err)
+	}
+
+	metadata := models.JSON{
+		"action":       "create_custom_file",
+		"projectID":    proj.ID,
+		"projectName":  proj.Name,
+		"relativePath": req.RelativePath,
+		"type":         string(fileType),
+	}
+	if gitOpsOverride {
+		metadata["gitopsOverride"] = true
+	}
+	if logErr := s.eventService.LogProjectEvent(ctx, models.EventTypeProjectUpdate, proj.ID, proj.Name, user.ID, user.Username, "0", metadata); logErr != nil {
+		slog.ErrorContext(ctx, "could not log project custom file create action", "error", logErr)
+	}
+
+	slog.InfoContext(ctx, "project custom file created", "projectID", proj.ID, "file", req.RelativePath, "type", fileType)
+	return nil
+}
+
+// DeleteProjectFile removes a file from a project after checking that the
+// compose configuration doesn't reference it. A referenced file is only
+// deleted with force, and the references are returned so the caller can warn
+// that the next deploy may fail.
+func (s *ProjectService) DeleteProjectFile(ctx context.Context, projectID, relativePath string, force bool, user models.User) (*project.DeleteFileResult, error) {
+	proj, err := s.GetProjectFromDatabaseByID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureProjectUnlockedInternal(proj, user, "updated"); err != nil {
+		return nil, err
+	}
+	gitOpsOverride, err := ensureProjectEditableInternal(ctx, proj)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ensureProjectPathUnderRoot(ctx, proj, true); err != nil {
+		return nil, err
+	}
+
+	targetPath, err := projects.ValidateIncludePathForWrite(proj.Path, relativePath)
+	if err != nil {
+		return nil, &models.ValidationError{Message: err.Error(), Field: "path"}
+	}
+	info, err := os.Stat(targetPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, &models.NotFoundError{Message: fmt.Sprintf("file %s not found in project %s", relativePath, proj.Name)}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat %s: %w", relativePath, err)
+	}
+	if info.IsDir() {
+		return nil, &models.ValidationError{Message: "only files can be deleted", Field: "path"}
+	}
+
+	// Resolve the project directory the same way the path validation does, so
+	// compose references and the target compare equal through symlinks.
+	projectDir, _ := filepath.Abs(proj.Path)
+	if evalDir, evalErr := filepath.EvalSymlinks(projectDir); evalErr == nil {
+		projectDir = evalDir
+	}
+
+	composeFile, err := projects.DetectComposeFile(projectDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find compose file: %w", err)
+	}
+	if filepath.Clean(composeFile) == targetPath {
+		return nil, &models.ConflictError{Message: fmt.Sprintf("%s is the project's compose file and cannot be deleted", relativePath)}
+	}
+
+	refs, err := projects.FindFileReferences(projectDir, composeFile, targetPath)
+	if err != nil {
+		if !force {
+			return nil, fmt.Errorf("failed to check references to %s: %w", relativePath, err)
+		}
+		slog.WarnContext(ctx, "could not check file references, deleting anyway", "projectID", proj.ID, "file", relativePath, "error", err)
+	}
+	if len(refs) > 0 && !force {
+		return nil, &models.ConflictError{Message: fmt.Sprintf("%s is referenced by %s; remove the references or delete with force", relativePath, describeFileReferencesInternal(refs))}
+	}
+
+	fs.MarkSelfWrite(targetPath)
+	if err := os.Remove(targetPath); err != nil {
+		return nil, fmt.Errorf("failed to delete %s: %w", relativePath, err)
+	}
+
+	metadata := models.JSON{
+		"action":       "delete_file",
+		"projectID":    proj.ID,
+		"projectName":  proj.Name,
+		"relativePath": relativePath,
+		"references":   len(refs),
+	}
+	if gitOpsOverride {
+		metadata["gitopsOverride"] = true
+	}
+	if logErr := s.eventService.LogProjectEvent(ctx, models.EventTypeProjectUpdate, proj.ID, proj.Name, user.ID, user.Username, "0", metadata); logErr != nil {
+		slog.ErrorContext(ctx, "could not log project file delete action", "error", logErr)
+	}
+
+	slog.InfoContext(ctx, "project file deleted", "projectID", proj.ID, "file", relativePath, "references", len(refs))
+	return &project.DeleteFileResult{RelativePath: relativePath, References: refs}, nil
+}
+
+func describeFileReferencesInternal(refs []project.FileReference) string {
+	parts := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		part := ref.Kind
+		if ref.Service != "" {
+			part += " of service " + ref.Service
+		}
+		parts = append(parts, part+" in "+ref.ComposeFile)
+	}
+	return strings.Join(parts, ", ")
+}
+
 // ensureProjectPathUnderRoot validates that the project's path is a safe subdirectory of the configured projects root.
 // If not, it normalizes the path to `<projectsRoot>/<dirName or sanitized project name>`. When persist=true, it saves
 // the updated project path to the database.
@@ -2168,12 +3498,27 @@ func (s *ProjectService) StreamProjectLogs(ctx context.Context, projectID string
 
 func (s *ProjectService) ListProjects(ctx context.Context, params pagination.QueryParams) ([]project.Details, pagination.Response, error) {
 	query := s.db.WithContext(ctx).Model(&models.Project{})
+
+	var tagFilter string
+	params.Filters, tagFilter = popTagFilterInternal(params.Filters)
+	if tagFilter != "" {
+		var err error
+		if query, err = applyTagFilterInternal(query, models.TagResourceProject, "id", tagFilter); err != nil {
+			return nil, pagination.Response{}, err
+		}
+	}
+
 	statusFilter := ""
 	if params.Filters != nil {
 		statusFilter = strings.TrimSpace(params.Filters["status"])
 	}
 	if statusFilter != "" {
-		return s.listProjectsByStatus(ctx, params, query)
+		items, paginationResp, err := s.listProjectsByStatus(ctx, params, query)
+		if err != nil {
+			return nil, pagination.Response{}, err
+		}
+		s.attachProjectTagsInternal(ctx, items)
+		return items, paginationResp, nil
 	}
 
 	if term := strings.TrimSpace(params.Search); term != "" {
@@ -2197,6 +3542,7 @@ func (s *ProjectService) ListProjects(ctx context.Context, params pagination.Que
 
 	// Fetch live status concurrently for all projects
 	result := s.fetchProjectStatusConcurrently(ctx, projectsArray)
+	s.attachProjectTagsInternal(ctx, result)
 
 	slog.DebugContext(ctx, "Completed ListProjects request",
 		"result_count", len(result))
@@ -2221,7 +3567,36 @@ func (s *ProjectService) listProjectsByStatus(
 		return nil, pagination.Response{}, fmt.Errorf("failed to list projects: %w", err)
 	}
 
-	items := s.fetchProjectStatusConcurrently(ctx, projectsArray)
+	// Filter, sort and paginate on summaries built from the cached container
+	// snapshot, then enrich only the requested page.
+	containers, snapshotErr := s.composeContainersSnapshotInternal(ctx)
+	if snapshotErr != nil {
+		slog.ErrorContext(ctx, "Failed to list global compose containers", "error", snapshotErr)
+	}
+	containersByProject := groupContainersByProjectInternal(containers)
+
+	items := make([]project.Details, len(projectsArray))
+	projectsByID := make(map[string]models.Project, len(projectsArray))
+	for i, p := range projectsArray {
+		status := models.ProjectStatusUnknown
+		switch {
+		case p.Status == models.ProjectStatusArchived:
+			status = models.ProjectStatusArchived
+		case snapshotErr == nil:
+			status = runtimeProjectStatusInternal(p.ServiceCount, containersByProject[normalizeComposeProjectName(p.Name)])
+		}
+		items[i] = project.Details{
+			ID:           p.ID,
+			Name:         p.Name,
+			DirName:      utils.DerefString(p.DirName),
+			Path:         p.Path,
+			Status:       string(status),
+			ServiceCount: p.ServiceCount,
+			CreatedAt:    p.CreatedAt.Format(time.RFC3339),
+			UpdatedAt:    p.UpdatedAt.Format(time.RFC3339),
+		}
+		projectsByID[p.ID] = p
+	}
 
 	limit := params.Limit
 	if limit <= 0 {
@@ -2294,39 +3669,74 @@ func (s *ProjectService) listProjectsByStatus(
 	result := pagination.SearchOrderAndPaginate(items, params, config)
 	paginationResp := pagination.BuildResponseFromFilterResult(result, params)
 
-	return result.Items, paginationResp, nil
+	if snapshotErr != nil {
+		// Without container state the summaries are all that can be returned.
+		return result.Items, paginationResp, nil
+	}
+
+	page := make([]models.Project, len(result.Items))
+	for i, item := range result.Items {
+		page[i] = projectsByID[item.ID]
+	}
+	enriched := s.mapProjectsToDtoInternal(ctx, page, containersByProject)
+	for i := range enriched {
+		// Keep the status the page was filtered and sorted by.
+		enriched[i].Status = result.Items[i].Status
+	}
+
+	return enriched, paginationResp, nil
+}
+
+// attachProjectTagsInternal fills in the tags of a page of projects.
+func (s *ProjectService) attachProjectTagsInternal(ctx context.Context, items []project.Details) {
+	ids := make([]string, len(items))
+	for i, p := range items {
+		ids[i] = p.ID
+	}
+	tags, err := resourceTagsInternal(ctx, s.db, models.TagResourceProject, ids)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to load project tags", "error", err)
+		return
+	}
+	for i := range items {
+		items[i].Tags = tags[items[i].ID]
+	}
 }
 
-// fetchProjectStatusConcurrently fetches live Docker status for multiple projects in parallel
-// Optimized to use a single Docker API call instead of N calls + N file reads
+// fetchProjectStatusConcurrently fetches live Docker status for multiple projects in parallel.
+// Container state comes from the shared compose container snapshot; the
+// per-project metadata reads run with bounded concurrency.
 func (s *ProjectService) fetchProjectStatusConcurrently(ctx context.Context, projectsList []models.Project) []project.Details {
-	// 1. Fetch all compose containers in one go
-	containers, err := projects.ListGlobalComposeContainers(ctx)
+	containers, err := s.composeContainersSnapshotInternal(ctx)
 	if err != nil {
 		slog.ErrorContext(ctx, "Failed to list global compose containers", "error", err)
 		// Fallback: return basic info with unknown status
 		results := make([]project.Details, len(projectsList))
 		for i, p := range projectsList {
 			_ = mapper.MapStruct(p, &results[i])
-			results[i].Status = string(models.ProjectStatusUnknown)
+			if p.Status != models.ProjectStatusArchived {
+				results[i].Status = string(models.ProjectStatusUnknown)
+			}
 		}
 		return results
 	}
 
-	// 2. Group containers by project name
-	containersByProject := make(map[string][]container.Summary)
-	for _, c := range containers {
-		projName := c.Labels["com.docker.compose.project"]
-		if projName != "" {
-			containersByProject[projName] = append(containersByProject[projName], c)
-		}
-	}
+	return s.mapProjectsToDtoInternal(ctx, projectsList, groupContainersByProjectInternal(containers))
+}
 
-	// 3. Map to DTOs
+// mapProjectsToDtoInternal maps projects to DTOs, reading per-project compose
+// metadata with bounded concurrency.
+func (s *ProjectService) mapProjectsToDtoInternal(ctx context.Context, projectsList []models.Project, containersByProject map[string][]container.Summary) []project.Details {
 	results := make([]project.Details, len(projectsList))
-	for i, p := range projectsList {
-		results[i] = s.mapProjectToDto(ctx, p, containersByProject)
+	var g errgroup.Group
+	g.SetLimit(projectEnrichmentConcurrency)
+	for i := range projectsList {
+		g.Go(func() error {
+			results[i] = s.mapProjectToDto(ctx, projectsList[i], containersByProject)
+			return nil
+		})
 	}
+	_ = g.Wait()
 
 	return results
 }
@@ -2339,10 +3749,19 @@ func (s *ProjectService) mapProjectToDto(ctx context.Context, p models.Project,
 	resp.UpdatedAt = p.UpdatedAt.Format(time.RFC3339)
 	resp.DirName = utils.DerefString(p.DirName)
 	resp.GitOpsManagedBy = p.GitOpsManagedBy
+	resp.LockedAt = formatOptionalTimeInternal(p.LockedAt)
+	resp.MaintenanceStartedAt = formatOptionalTimeInternal(p.MaintenanceStartedAt)
 	meta := s.getProjectMetadataFromPath(ctx, p.Path)
 	resp.IconURL = meta.ProjectIconURL
 	resp.URLs = meta.ProjectURLS
 
+	if p.Status == models.ProjectStatusArchived {
+		resp.Status = string(models.ProjectStatusArchived)
+		resp.RunningCount = 0
+		resp.RuntimeServices = []project.RuntimeService{}
+		return resp
+	}
+
 	// Find containers for this project
 	normName := normalizeComposeProjectName(p.Name)
 	projectContainers := containersByProject[normName]
@@ -2418,21 +3837,40 @@ func (s *ProjectService) mapProjectToDto(ctx context.Context, p models.Project,
 		}
 	}
 
-	// Calculate Status
-	if len(services) == 0 {
-		resp.Status = string(models.ProjectStatusStopped)
-	} else {
-		switch {
-		case runningCount >= resp.ServiceCount && resp.ServiceCount > 0:
-			resp.Status = string(models.ProjectStatusRunning)
-		case runningCount > 0:
-			resp.Status = string(models.ProjectStatusPartiallyRunning)
-		default:
-			resp.Status = string(models.ProjectStatusStopped)
+	resp.Status = string(projectStatusFromCountsInternal(resp.ServiceCount, len(services), runningCount))
+
+	return resp
+}
+
+// runtimeProjectStatusInternal derives a project's status from its containers
+// without reading the compose file. When the stored service count is unknown,
+// the distinct services seen on the containers stand in for it.
+func runtimeProjectStatusInternal(serviceCount int, projectContainers []container.Summary) models.ProjectStatus {
+	runningCount := 0
+	seenServices := make(map[string]struct{}, len(projectContainers))
+	for _, c := range projectContainers {
+		seenServices[c.Labels["com.docker.compose.service"]] = struct{}{}
+		if c.State == "running" {
+			runningCount++
 		}
 	}
+	if serviceCount == 0 {
+		serviceCount = len(seenServices)
+	}
+	return projectStatusFromCountsInternal(serviceCount, len(projectContainers), runningCount)
+}
 
-	return resp
+func projectStatusFromCountsInternal(serviceCount, containerCount, runningCount int) models.ProjectStatus {
+	switch {
+	case containerCount == 0:
+		return models.ProjectStatusStopped
+	case runningCount >= serviceCount && serviceCount > 0:
+		return models.ProjectStatusRunning
+	case runningCount > 0:
+		return models.ProjectStatusPartiallyRunning
+	default:
+		return models.ProjectStatusStopped
+	}
 }
 
 func (s *ProjectService) getProjectMetadataFromPath(ctx context.Context, projectPath string) projects.ArcaneComposeMetadata {
@@ -0,0 +1,183 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	glsqlite "github.com/glebarez/sqlite"
+	"github.com/moby/moby/api/types/container"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/getarcaneapp/arcane/backend/internal/database"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/pagination"
+)
+
+// fakeComposeLister stands in for the Docker API and counts list calls.
+type fakeComposeLister struct {
+	calls      atomic.Int64
+	latency    time.Duration
+	containers []container.Summary
+}
+
+func (f *fakeComposeLister) list(ctx context.Context) ([]container.Summary, error) {
+	f.calls.Add(1)
+	if f.latency > 0 {
+		select {
+		case <-time.After(f.latency):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	return f.containers, nil
+}
+
+func setupProjectSnapshotServiceInternal(tb testing.TB, projectCount int, latency time.Duration) (*ProjectService, *fakeComposeLister) {
+	tb.Helper()
+	ctx := context.Background()
+
+	// Shared cache so the concurrent queries in GetProjectStatusCounts see one database.
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared", strings.NewReplacer("/", "_", " ", "_").Replace(tb.Name()))
+	gormDB, err := gorm.Open(glsqlite.Open(dsn), &gorm.Config{})
+	require.NoError(tb, err)
+	sqlDB, err := gormDB.DB()
+	require.NoError(tb, err)
+	// Benchmarks run this setup once per b.N under the same name; closing
+	// drops the in-memory database so every run starts empty.
+	tb.Cleanup(func() { _ = sqlDB.Close() })
+	require.NoError(tb, gormDB.AutoMigrate(&models.Project{}, &models.SettingVariable{}))
+	db := &database.DB{DB: gormDB}
+
+	settingsService, err := NewSettingsService(ctx, db)
+	require.NoError(tb, err)
+	projectsRoot := tb.TempDir()
+	require.NoError(tb, settingsService.SetStringSetting(ctx, "projectsDirectory", projectsRoot+":"+projectsRoot))
+
+	lister := &fakeComposeLister{latency: latency}
+	for i := range projectCount {
+		name := fmt.Sprintf("app%03d", i)
+		require.NoError(tb, db.Create(&models.Project{
+			BaseModel:    models.BaseModel{ID: name},
+			Name:         name,
+			Path:         filepath.Join(projectsRoot, name),
+			ServiceCount: 1,
+			Status:       models.ProjectStatusStopped,
+		}).Error)
+		if i%2 == 0 {
+			lister.containers = append(lister.containers, container.Summary{
+				ID:    "c-" + name,
+				Names: []string{"/" + name + "-web-1"},
+				State: "running",
+				Labels: map[string]string{
+					"com.docker.compose.project": name,
+					"com.docker.compose.service": "web",
+				},
+			})
+		}
+	}
+
+	svc := NewProjectService(db, settingsService, nil, nil, nil, nil, nil)
+	svc.listComposeContainers = lister.list
+	return svc, lister
+}
+
+func loadProjectsPageInternal(tb testing.TB, svc *ProjectService) {
+	tb.Helper()
+	ctx := context.Background()
+
+	_, running, _, total, err := svc.GetProjectStatusCounts(ctx)
+	require.NoError(tb, err)
+	require.Equal(tb, 25, running)
+	require.Equal(tb, 50, total)
+
+	items, _, err := svc.ListProjects(ctx, pagination.QueryParams{PaginationParams: pagination.PaginationParams{Limit: 20}})
+	require.NoError(tb, err)
+	require.Len(tb, items, 20)
+}
+
+func TestProjectService_ComposeContainerSnapshotSharedAcrossCountsAndList(t *testing.T) {
+	svc, lister := setupProjectSnapshotServiceInternal(t, 50, 0)
+
+	loadProjectsPageInternal(t, svc)
+	require.Equal(t, int64(1), lister.calls.Load())
+
+	svc.invalidateComposeContainersInternal()
+	loadProjectsPageInternal(t, svc)
+	require.Equal(t, int64(2), lister.calls.Load())
+}
+
+func TestProjectService_ComposeContainerSnapshotWithoutCache(t *testing.T) {
+	svc, lister := setupProjectSnapshotServiceInternal(t, 50, 0)
+	svc.composeContainers = nil
+
+	loadProjectsPageInternal(t, svc)
+	require.Equal(t, int64(2), lister.calls.Load())
+}
+
+// BenchmarkProjectsPage simulates the projects page, which loads the status
+// counts and the project list together, against a Docker API with 5ms latency.
+func BenchmarkProjectsPage(b *testing.B) {
+	b.Run("direct", func(b *testing.B) {
+		svc, lister := setupProjectSnapshotServiceInternal(b, 50, 5*time.Millisecond)
+		svc.composeContainers = nil
+
+		for b.Loop() {
+			loadProjectsPageInternal(b, svc)
+		}
+		b.ReportMetric(float64(lister.calls.Load())/float64(b.N), "docker-calls/op")
+	})
+
+	b.Run("snapshot", func(b *testing.B) {
+		svc, lister := setupProjectSnapshotServiceInternal(b, 50, 5*time.Millisecond)
+
+		for b.Loop() {
+			loadProjectsPageInternal(b, svc)
+		}
+		b.ReportMetric(float64(lister.calls.Load())/float64(b.N), "docker-calls/op")
+	})
+}
+
+func TestProjectService_ListProjectsByStatusPaginatesBeforeEnrichment(t *testing.T) {
+	svc, lister := setupProjectSnapshotServiceInternal(t, 50, 0)
+
+	items, resp, err := svc.ListProjects(context.Background(), pagination.QueryParams{
+		SortParams:       pagination.SortParams{Sort: "name", Order: "asc"},
+		PaginationParams: pagination.PaginationParams{Start: 10, Limit: 10},
+		Filters:          map[string]string{"status": "running"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(25), resp.TotalItems)
+	require.Len(t, items, 10)
+	require.Equal(t, "app020", items[0].Name)
+	for _, item := range items {
+		require.Equal(t, string(models.ProjectStatusRunning), item.Status)
+		require.Equal(t, 1, item.RunningCount)
+	}
+	require.Equal(t, int64(1), lister.calls.Load())
+}
+
+// BenchmarkListProjectsByStatus filters a large project list by status; only
+// the requested page is enriched, so cost should not grow with project count.
+func BenchmarkListProjectsByStatus(b *testing.B) {
+	for _, count := range []int{50, 500} {
+		b.Run(fmt.Sprintf("projects=%d", count), func(b *testing.B) {
+			svc, _ := setupProjectSnapshotServiceInternal(b, count, 0)
+			params := pagination.QueryParams{
+				PaginationParams: pagination.PaginationParams{Limit: 20},
+				Filters:          map[string]string{"status": "running"},
+			}
+
+			for b.Loop() {
+				items, _, err := svc.ListProjects(context.Background(), params)
+				require.NoError(b, err)
+				require.Len(b, items, 20)
+			}
+		})
+	}
+}
@@ -10,11 +10,14 @@ import (
 	"time"
 
 	composetypes "github.com/compose-spec/compose-go/v2/types"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/docker"
 	"github.com/getarcaneapp/arcane/backend/internal/utils/pathmapper"
 	buildtypes "github.com/getarcaneapp/arcane/types/builds"
 	imagetypes "github.com/getarcaneapp/arcane/types/image"
+	"github.com/getarcaneapp/arcane/types/project"
 	glsqlite "github.com/glebarez/sqlite"
 	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/api/types/mount"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gorm.io/gorm"
@@ -50,7 +53,7 @@ func TestProjectService_GetProjectFromDatabaseByID(t *testing.T) {
 
 	// Setup dependencies
 	settingsService, _ := NewSettingsService(ctx, db)
-	svc := NewProjectService(db, settingsService, nil, nil, nil, nil)
+	svc := NewProjectService(db, settingsService, nil, nil, nil, nil, nil)
 
 	// Create test project
 	proj := &models.Project{
@@ -167,7 +170,7 @@ func TestProjectService_CalculateProjectStatus(t *testing.T) {
 func TestProjectService_UpdateProjectStatusInternal(t *testing.T) {
 	db := setupProjectTestDB(t)
 	ctx := context.Background()
-	svc := NewProjectService(db, nil, nil, nil, nil, nil)
+	svc := NewProjectService(db, nil, nil, nil, nil, nil, nil)
 
 	proj := &models.Project{
 		BaseModel: models.BaseModel{
@@ -206,6 +209,10 @@ func TestProjectService_IncrementStatusCounts(t *testing.T) {
 	svc.incrementStatusCounts(models.ProjectStatusUnknown, &running, &stopped)
 	assert.Equal(t, 1, running)
 	assert.Equal(t, 1, stopped)
+
+	svc.incrementStatusCounts(models.ProjectStatusArchived, &running, &stopped)
+	assert.Equal(t, 1, running)
+	assert.Equal(t, 1, stopped)
 }
 
 func TestProjectService_FormatDockerPorts(t *testing.T) {
@@ -348,12 +355,39 @@ func TestBuildProjectImagePullPlan(t *testing.T) {
 		},
 	}
 
-	plan := buildProjectImagePullPlan(services)
+	plan := buildProjectImagePullPlan(services, imagePullModeIfMissing)
 
 	assert.Len(t, plan, 2)
-	assert.Equal(t, imagePullModeAlways, plan["redis:latest"])
-	assert.Equal(t, imagePullModeNever, plan["nginx:latest"])
+	assert.Equal(t, imagePullModeAlways, plan[imagePullTarget{Image: "redis:latest"}])
+	assert.Equal(t, imagePullModeNever, plan[imagePullTarget{Image: "nginx:latest"}])
 }
+
+func TestBuildProjectImagePullPlan_DefaultModeAndPlatform(t *testing.T) {
+	services := composetypes.Services{
+		"web": {
+			Name:  "web",
+			Image: "nginx:latest",
+		},
+		"web-arm": {
+			Name:     "web-arm",
+			Image:    "nginx:latest",
+			Platform: "linux/arm64",
+		},
+		"db": {
+			Name:       "db",
+			Image:      "postgres:16",
+			PullPolicy: composetypes.PullPolicyMissing,
+		},
+	}
+
+	plan := buildProjectImagePullPlan(services, imagePullModeAlways)
+
+	assert.Len(t, plan, 3)
+	assert.Equal(t, imagePullModeAlways, plan[imagePullTarget{Image: "nginx:latest"}])
+	assert.Equal(t, imagePullModeAlways, plan[imagePullTarget{Image: "nginx:latest", Platform: "linux/arm64"}])
+	assert.Equal(t, imagePullModeIfMissing, plan[imagePullTarget{Image: "postgres:16"}])
+}
+
 func TestProjectService_UpdateProject_RenamesDirectoryWhenNameChanges(t *testing.T) {
 	db := setupProjectTestDB(t)
 	ctx := context.Background()
@@ -365,7 +399,7 @@ func TestProjectService_UpdateProject_RenamesDirectoryWhenNameChanges(t *testing
 	require.NoError(t, err)
 
 	eventService := NewEventService(db, nil, nil)
-	svc := NewProjectService(db, settingsService, eventService, nil, nil, nil)
+	svc := NewProjectService(db, settingsService, eventService, nil, nil, nil, nil)
 
 	originalDirName := "Foo"
 	originalPath := filepath.Join(projectsDir, originalDirName)
@@ -414,7 +448,7 @@ func TestProjectService_UpdateProject_RenameFailsWhenTargetDirectoryExists(t *te
 	require.NoError(t, err)
 
 	eventService := NewEventService(db, nil, nil)
-	svc := NewProjectService(db, settingsService, eventService, nil, nil, nil)
+	svc := NewProjectService(db, settingsService, eventService, nil, nil, nil, nil)
 
 	originalDirName := "Foo"
 	originalPath := filepath.Join(projectsDir, originalDirName)
@@ -461,7 +495,7 @@ func TestProjectService_UpdateProject_RenameFailsWhenProjectRunning(t *testing.T
 	require.NoError(t, err)
 
 	eventService := NewEventService(db, nil, nil)
-	svc := NewProjectService(db, settingsService, eventService, nil, nil, nil)
+	svc := NewProjectService(db, settingsService, eventService, nil, nil, nil, nil)
 
 	originalDirName := "Foo"
 	originalPath := filepath.Join(projectsDir, originalDirName)
@@ -505,7 +539,7 @@ func TestProjectService_UpdateProject_ValidatesComposeUsingExistingProjectName(t
 	require.NoError(t, err)
 
 	eventService := NewEventService(db, nil, nil)
-	svc := NewProjectService(db, settingsService, eventService, nil, nil, nil)
+	svc := NewProjectService(db, settingsService, eventService, nil, nil, nil, nil)
 
 	dirName := "demo"
 	projectPath := filepath.Join(projectsDir, dirName)
@@ -547,7 +581,7 @@ func TestProjectService_UpdateProject_AllowsMissingEnvFileDuringComposeValidatio
 	require.NoError(t, err)
 
 	eventService := NewEventService(db, nil, nil)
-	svc := NewProjectService(db, settingsService, eventService, nil, nil, nil)
+	svc := NewProjectService(db, settingsService, eventService, nil, nil, nil, nil)
 
 	dirName := "env-required"
 	projectPath := filepath.Join(projectsDir, dirName)
@@ -591,7 +625,7 @@ func TestProjectService_UpdateProject_UsesExistingEnvFileDuringComposeValidation
 	require.NoError(t, err)
 
 	eventService := NewEventService(db, nil, nil)
-	svc := NewProjectService(db, settingsService, eventService, nil, nil, nil)
+	svc := NewProjectService(db, settingsService, eventService, nil, nil, nil, nil)
 
 	dirName := "env-existing"
 	projectPath := filepath.Join(projectsDir, dirName)
@@ -637,7 +671,7 @@ func TestProjectService_UpdateProject_UsesProvidedEnvContentDuringComposeValidat
 	require.NoError(t, err)
 
 	eventService := NewEventService(db, nil, nil)
-	svc := NewProjectService(db, settingsService, eventService, nil, nil, nil)
+	svc := NewProjectService(db, settingsService, eventService, nil, nil, nil, nil)
 
 	dirName := "env-updated"
 	projectPath := filepath.Join(projectsDir, dirName)
@@ -683,7 +717,7 @@ func TestProjectService_UpdateProject_ReturnsEnvParseErrorDuringComposeValidatio
 	require.NoError(t, err)
 
 	eventService := NewEventService(db, nil, nil)
-	svc := NewProjectService(db, settingsService, eventService, nil, nil, nil)
+	svc := NewProjectService(db, settingsService, eventService, nil, nil, nil, nil)
 
 	dirName := "env-invalid"
 	projectPath := filepath.Join(projectsDir, dirName)
@@ -716,6 +750,162 @@ func TestProjectService_UpdateProject_ReturnsEnvParseErrorDuringComposeValidatio
 	assert.Contains(t, err.Error(), "parse env")
 }
 
+func TestProjectService_LockProject_BlocksChangesUntilUnlocked(t *testing.T) {
+	db := setupProjectTestDB(t)
+	ctx := context.Background()
+
+	projectsDir := t.TempDir()
+	t.Setenv("PROJECTS_DIRECTORY", projectsDir)
+
+	settingsService, err := NewSettingsService(ctx, db)
+	require.NoError(t, err)
+
+	eventService := NewEventService(db, nil, nil)
+	svc := NewProjectService(db, settingsService, eventService, nil, nil, nil, nil)
+
+	projectPath := filepath.Join(projectsDir, "proxy")
+	require.NoError(t, os.MkdirAll(projectPath, 0o755))
+	require.NoError(t, db.Create(&models.Project{
+		BaseModel: models.BaseModel{ID: "proj-1"},
+		Name:      "proxy",
+		Path:      projectPath,
+		Status:    models.ProjectStatusStopped,
+	}).Error)
+
+	operator := models.User{BaseModel: models.BaseModel{ID: "u1"}, Username: "operator"}
+	admin := models.User{BaseModel: models.BaseModel{ID: "u2"}, Username: "admin", Roles: models.StringSlice{"admin"}}
+
+	locked, err := svc.LockProject(ctx, "proj-1", "reverse proxy", operator)
+	require.NoError(t, err)
+	assert.True(t, locked.Locked)
+	require.NotNil(t, locked.LockedBy)
+	assert.Equal(t, "operator", *locked.LockedBy)
+
+	_, err = svc.LockProject(ctx, "proj-1", "", operator)
+	var conflictErr *models.ConflictError
+	require.ErrorAs(t, err, &conflictErr)
+
+	newName := "proxy2"
+	_, err = svc.UpdateProject(ctx, "proj-1", &newName, nil, nil, operator)
+	require.ErrorAs(t, err, &conflictErr)
+	assert.Contains(t, err.Error(), "reverse proxy")
+
+	require.ErrorAs(t, svc.DestroyProject(ctx, "proj-1", true, false, operator), &conflictErr)
+	assert.DirExists(t, projectPath)
+
+	// Admins are not blocked by the lock.
+	require.NoError(t, ensureProjectUnlockedInternal(locked, admin, "updated"))
+
+	_, err = svc.UnlockProject(ctx, "proj-1", " ", operator)
+	var validationErr *models.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+
+	unlocked, err := svc.UnlockProject(ctx, "proj-1", "maintenance window", operator)
+	require.NoError(t, err)
+	assert.False(t, unlocked.Locked)
+
+	var fromDB models.Project
+	require.NoError(t, db.First(&fromDB, "id = ?", "proj-1").Error)
+	assert.False(t, fromDB.Locked)
+	assert.Nil(t, fromDB.LockReason)
+	assert.Nil(t, fromDB.LockedAt)
+
+	_, err = svc.UpdateProject(ctx, "proj-1", &newName, nil, nil, operator)
+	require.NoError(t, err)
+}
+
+func TestProjectService_ArchivedProjectIsKeptOutOfActions(t *testing.T) {
+	db := setupProjectTestDB(t)
+	ctx := context.Background()
+
+	projectsDir := t.TempDir()
+	t.Setenv("PROJECTS_DIRECTORY", projectsDir)
+
+	settingsService, err := NewSettingsService(ctx, db)
+	require.NoError(t, err)
+
+	eventService := NewEventService(db, nil, nil)
+	svc := NewProjectService(db, settingsService, eventService, nil, nil, nil, nil)
+
+	projectPath := filepath.Join(projectsDir, "wiki")
+	require.NoError(t, os.MkdirAll(projectPath, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(projectPath, "compose.yaml"), []byte("services:\n  web:\n    image: nginx\n"), 0o644))
+	require.NoError(t, db.Create(&models.Project{
+		BaseModel:    models.BaseModel{ID: "proj-1"},
+		Name:         "wiki",
+		Path:         projectPath,
+		Status:       models.ProjectStatusArchived,
+		ServiceCount: 1,
+	}).Error)
+
+	user := models.User{BaseModel: models.BaseModel{ID: "u1"}, Username: "operator"}
+
+	var conflictErr *models.ConflictError
+	_, err = svc.ArchiveProject(ctx, "proj-1", user)
+	require.ErrorAs(t, err, &conflictErr)
+	require.ErrorAs(t, svc.DeployProject(ctx, "proj-1", user, nil), &conflictErr)
+	require.ErrorAs(t, svc.RestartProject(ctx, "proj-1", user), &conflictErr)
+
+	details, err := svc.GetProjectDetails(ctx, "proj-1")
+	require.NoError(t, err)
+	assert.Equal(t, string(models.ProjectStatusArchived), details.Status)
+
+	unarchived, err := svc.UnarchiveProject(ctx, "proj-1", user)
+	require.NoError(t, err)
+	assert.Equal(t, models.ProjectStatusStopped, unarchived.Status)
+	assert.FileExists(t, filepath.Join(projectPath, "compose.yaml"))
+
+	_, err = svc.UnarchiveProject(ctx, "proj-1", user)
+	require.ErrorAs(t, err, &conflictErr)
+}
+
+func TestProjectService_CloneProject(t *testing.T) {
+	db := setupProjectTestDB(t)
+	ctx := context.Background()
+
+	projectsDir := t.TempDir()
+	t.Setenv("PROJECTS_DIRECTORY", projectsDir)
+
+	settingsService, err := NewSettingsService(ctx, db)
+	require.NoError(t, err)
+
+	eventService := NewEventService(db, nil, nil)
+	svc := NewProjectService(db, settingsService, eventService, nil, nil, nil, nil)
+
+	sourcePath := filepath.Join(projectsDir, "blog")
+	require.NoError(t, os.MkdirAll(filepath.Join(sourcePath, "data"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(sourcePath, "compose.yaml"), []byte("services:\n  web:\n    image: nginx\n    container_name: blog\n    ports:\n      - \"8080:80\"\n    volumes:\n      - ./data:/data\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(sourcePath, ".env"), []byte("TITLE=blog\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(sourcePath, "data", "posts.db"), []byte("posts"), 0o644))
+	require.NoError(t, db.Create(&models.Project{
+		BaseModel: models.BaseModel{ID: "proj-1"},
+		Name:      "blog",
+		Path:      sourcePath,
+		Status:    models.ProjectStatusRunning,
+	}).Error)
+
+	user := models.User{BaseModel: models.BaseModel{ID: "u1"}, Username: "tester"}
+
+	clone, changes, err := svc.CloneProject(ctx, "proj-1", "blog-staging", false, user)
+	require.NoError(t, err)
+	assert.Equal(t, "blog-staging", clone.Name)
+	assert.Equal(t, filepath.Join(projectsDir, "blog-staging"), clone.Path)
+	assert.Equal(t, models.ProjectStatusStopped, clone.Status)
+	assert.Contains(t, changes, "web: published port 8080 -> 8081")
+
+	compose, err := os.ReadFile(filepath.Join(clone.Path, "compose.yaml"))
+	require.NoError(t, err)
+	assert.Contains(t, string(compose), "container_name: blog-staging-web")
+	assert.Contains(t, string(compose), "8081:80")
+	assert.FileExists(t, filepath.Join(clone.Path, ".env"))
+	assert.DirExists(t, filepath.Join(clone.Path, "data"))
+	assert.NoFileExists(t, filepath.Join(clone.Path, "data", "posts.db"))
+
+	_, _, err = svc.CloneProject(ctx, "proj-1", "blog-staging", false, user)
+	var conflictErr *models.ConflictError
+	require.ErrorAs(t, err, &conflictErr)
+}
+
 func TestProjectService_MergeBuildTags(t *testing.T) {
 	tags := mergeBuildTags("example/app:latest", []string{"example/app:sha", "example/app:latest", " "})
 	assert.Equal(t, []string{"example/app:latest", "example/app:sha"}, tags)
@@ -980,7 +1170,7 @@ func TestProjectService_DeployProject_StopsOnBuildPreparationError(t *testing.T)
 	require.NoError(t, db.Create(proj).Error)
 
 	buildSvc := &BuildService{builder: testBuildBuilder{err: errors.New("boom build")}}
-	svc := NewProjectService(db, settingsService, nil, nil, nil, buildSvc)
+	svc := NewProjectService(db, settingsService, nil, nil, nil, buildSvc, nil)
 
 	err = svc.DeployProject(ctx, "p1", models.User{BaseModel: models.BaseModel{ID: "u1"}, Username: "tester"}, nil)
 	require.Error(t, err)
@@ -1022,7 +1212,7 @@ func TestProjectService_DeployProject_BuildsGeneratedImageWithoutPull(t *testing
 	require.NoError(t, db.Create(proj).Error)
 
 	buildSvc := &BuildService{builder: testBuildBuilder{err: errors.New("boom build")}}
-	svc := NewProjectService(db, settingsService, nil, nil, nil, buildSvc)
+	svc := NewProjectService(db, settingsService, nil, nil, nil, buildSvc, nil)
 
 	err = svc.DeployProject(ctx, proj.ID, models.User{BaseModel: models.BaseModel{ID: "u1"}, Username: "tester"}, nil)
 	require.Error(t, err)
@@ -1060,3 +1250,114 @@ func TestResolveBuildContextInternal_RejectsUnsupportedRemoteContext(t *testing.
 func ptr(v string) *string {
 	return new(v)
 }
+
+func TestProjectDirsFromChangedPathsInternal(t *testing.T) {
+	root := filepath.Join(string(filepath.Separator), "data", "projects")
+
+	dirs := projectDirsFromChangedPathsInternal(root, []string{
+		filepath.Join(root, "web", "compose.yaml"),
+		filepath.Join(root, "web", ".env"),
+		filepath.Join(root, "db"),
+		root,
+		filepath.Join(string(filepath.Separator), "elsewhere", "compose.yaml"),
+	})
+	assert.Equal(t, []string{filepath.Join(root, "web"), filepath.Join(root, "db")}, dirs)
+}
+
+func TestProjectService_GitOpsManagedProjectIsReadOnly(t *testing.T) {
+	db := setupProjectTestDB(t)
+	require.NoError(t, db.AutoMigrate(&models.Event{}))
+	ctx := context.Background()
+
+	projectsDir := t.TempDir()
+	t.Setenv("PROJECTS_DIRECTORY", projectsDir)
+
+	settingsService, err := NewSettingsService(ctx, db)
+	require.NoError(t, err)
+
+	eventService := NewEventService(db, nil, nil)
+	svc := NewProjectService(db, settingsService, eventService, nil, nil, nil, nil)
+
+	projectPath := filepath.Join(projectsDir, "site")
+	require.NoError(t, os.MkdirAll(projectPath, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(projectPath, "compose.yaml"), []byte("services:\n  web:\n    image: nginx\n"), 0o644))
+	require.NoError(t, db.Create(&models.Project{
+		BaseModel:       models.BaseModel{ID: "proj-1"},
+		Name:            "site",
+		Path:            projectPath,
+		Status:          models.ProjectStatusStopped,
+		GitOpsManagedBy: new("sync-1"),
+	}).Error)
+
+	user := models.User{BaseModel: models.BaseModel{ID: "u1"}, Username: "operator"}
+	compose := "services:\n  web:\n    image: nginx:alpine\n"
+
+	var conflictErr *models.ConflictError
+	_, err = svc.UpdateProject(ctx, "proj-1", nil, new(compose), nil, user)
+	require.ErrorAs(t, err, &conflictErr)
+	assert.Contains(t, err.Error(), "managed by GitOps")
+	require.ErrorAs(t, svc.UpdateProjectIncludeFile(ctx, "proj-1", "extra.yaml", "services: {}\n", user), &conflictErr)
+	assert.NoFileExists(t, filepath.Join(projectPath, "extra.yaml"))
+
+	// The sync itself writes without an override and isn't recorded as one.
+	_, err = svc.UpdateProject(withGitOpsSyncInternal(ctx), "proj-1", nil, new(compose), nil, user)
+	require.NoError(t, err)
+
+	_, err = svc.UpdateProject(WithGitOpsOverride(ctx), "proj-1", nil, new(compose), nil, user)
+	require.NoError(t, err)
+
+	var events []models.Event
+	require.NoError(t, db.Where("resource_id = ?", "proj-1").Find(&events).Error)
+	require.Len(t, events, 2)
+	overrides := 0
+	for _, event := range events {
+		if event.Metadata["gitopsOverride"] == true {
+			overrides++
+		}
+	}
+	assert.Equal(t, 1, overrides)
+}
+
+func TestPathMappingDecision(t *testing.T) {
+	t.Run("translation from setting", func(t *testing.T) {
+		diag := &project.PathMappingDiagnostics{
+			ContainerPath:     "/app/data/projects",
+			HostPath:          "/srv/projects",
+			HostPathSource:    project.PathMappingHostSourceSetting,
+			TranslationActive: true,
+		}
+		discovery := docker.HostPathDiscovery{
+			HostPath: "/srv/other",
+			Mount:    &container.MountPoint{Type: mount.TypeBind, Source: "/srv/other", Destination: "/app/data/projects"},
+		}
+
+		decision, warnings := pathMappingDecisionInternal(diag, discovery)
+		assert.Contains(t, decision, "translated to /srv/projects")
+		require.Len(t, warnings, 1)
+		assert.Contains(t, warnings[0], "differs from the host path of the Arcane container mount (/srv/other)")
+	})
+
+	t.Run("named volume", func(t *testing.T) {
+		diag := &project.PathMappingDiagnostics{ContainerPath: "/app/data/projects", HostPathSource: project.PathMappingHostSourceNone}
+		discovery := docker.HostPathDiscovery{
+			Mount: &container.MountPoint{Type: mount.TypeVolume, Name: "arcane-data", Destination: "/app/data"},
+		}
+
+		decision, warnings := pathMappingDecisionInternal(diag, discovery)
+		assert.Contains(t, decision, "No host path was found")
+		require.Len(t, warnings, 1)
+		assert.Contains(t, warnings[0], `named volume "arcane-data"`)
+	})
+
+	t.Run("not in a container", func(t *testing.T) {
+		diag := &project.PathMappingDiagnostics{
+			ContainerPath:  "/opt/arcane/projects",
+			HostPathSource: project.PathMappingHostSourceNone,
+			DiscoveryError: "No such container: arcane-host",
+		}
+
+		decision, warnings := pathMappingDecisionInternal(diag, docker.HostPathDiscovery{})
+		assert.Contains(t, decision, "could not be inspected")
+		require.Len(t, warnings, 1)
+	})
+}
@@ -0,0 +1,211 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/getarcaneapp/arcane/backend/internal/database"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	pkgutils "github.com/getarcaneapp/arcane/backend/pkg/utils"
+	"github.com/getarcaneapp/arcane/types/rbac"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrRoleBindingNotFound = errors.New("role binding not found")
+	ErrRoleBindingInvalid  = errors.New("invalid role binding")
+	ErrAccessDenied        = errors.New("insufficient permissions")
+)
+
+const (
+	roleSourceProject     = "project"
+	roleSourceEnvironment = "environment"
+	roleSourceGlobal      = "global"
+	roleSourceUser        = "user"
+)
+
+// RbacService manages role bindings and resolves the effective role of a user
+// for a given environment and project scope.
+type RbacService struct {
+	db *database.DB
+}
+
+func NewRbacService(db *database.DB) *RbacService {
+	return &RbacService{db: db}
+}
+
+func (s *RbacService) ListRoleBindings(ctx context.Context, userID string) ([]rbac.RoleBinding, error) {
+	var bindings []models.RoleBinding
+	query := s.db.WithContext(ctx).Model(&models.RoleBinding{}).Order("created_at ASC")
+	if userID != "" {
+		query = query.Where("user_id = ?", userID)
+	}
+	if err := query.Find(&bindings).Error; err != nil {
+		return nil, fmt.Errorf("failed to list role bindings: %w", err)
+	}
+
+	result := make([]rbac.RoleBinding, len(bindings))
+	for i := range bindings {
+		result[i] = toRoleBindingDto(&bindings[i])
+	}
+	return result, nil
+}
+
+// CreateRoleBinding assigns a role to a user. An existing binding for the same
+// user and scope is replaced so that each scope resolves to exactly one role.
+func (s *RbacService) CreateRoleBinding(ctx context.Context, req rbac.CreateRoleBinding) (*rbac.RoleBinding, error) {
+	envID := normalizeScopeID(req.EnvironmentID)
+	projectID := normalizeScopeID(req.ProjectID)
+
+	if strings.TrimSpace(req.UserID) == "" || !rbac.IsValidRole(req.Role) {
+		return nil, ErrRoleBindingInvalid
+	}
+	if projectID != nil && envID == nil {
+		return nil, fmt.Errorf("%w: project bindings require an environment", ErrRoleBindingInvalid)
+	}
+
+	binding := &models.RoleBinding{
+		UserID:        req.UserID,
+		Role:          req.Role,
+		EnvironmentID: envID,
+		ProjectID:     projectID,
+	}
+
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := scopeQuery(tx.Where("user_id = ?", req.UserID), envID, projectID).Delete(&models.RoleBinding{}).Error; err != nil {
+			return fmt.Errorf("failed to replace existing role binding: %w", err)
+		}
+		if err := tx.Create(binding).Error; err != nil {
+			return fmt.Errorf("failed to create role binding: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	dto := toRoleBindingDto(binding)
+	return &dto, nil
+}
+
+func (s *RbacService) DeleteRoleBinding(ctx context.Context, id string) error {
+	result := s.db.WithContext(ctx).Delete(&models.RoleBinding{}, "id = ?", id)
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete role binding: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrRoleBindingNotFound
+	}
+	return nil
+}
+
+// DeleteRoleBindingsForUser removes every binding owned by the given user.
+func (s *RbacService) DeleteRoleBindingsForUser(ctx context.Context, userID string) error {
+	if err := s.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&models.RoleBinding{}).Error; err != nil {
+		return fmt.Errorf("failed to delete role bindings for user: %w", err)
+	}
+	return nil
+}
+
+// ResolveRole returns the effective role of a user for the given scope.
+// Resolution order is project binding, environment binding, global binding and
+// finally the user's own roles: users with the "admin" role are admins
+// everywhere, and other users keep operator access until a binding narrows it.
+func (s *RbacService) ResolveRole(ctx context.Context, user *models.User, environmentID, projectID string) (rbac.EffectiveRole, error) {
+	result := rbac.EffectiveRole{
+		EnvironmentID: environmentID,
+		ProjectID:     projectID,
+	}
+	if user == nil {
+		return result, ErrAccessDenied
+	}
+	result.UserID = user.ID
+
+	if pkgutils.UserHasRole(user.Roles, rbac.RoleAdmin) {
+		result.Role = rbac.RoleAdmin
+		result.Source = roleSourceUser
+		return result, nil
+	}
+
+	var bindings []models.RoleBinding
+	if err := s.db.WithContext(ctx).Where("user_id = ?", user.ID).Find(&bindings).Error; err != nil {
+		return result, fmt.Errorf("failed to load role bindings: %w", err)
+	}
+
+	var projectRole, envRole, globalRole string
+	for _, b := range bindings {
+		switch {
+		case b.EnvironmentID == nil:
+			globalRole = b.Role
+		case *b.EnvironmentID != environmentID:
+			continue
+		case b.ProjectID == nil:
+			envRole = b.Role
+		case projectID != "" && *b.ProjectID == projectID:
+			projectRole = b.Role
+		}
+	}
+
+	switch {
+	case projectRole != "":
+		result.Role, result.Source = projectRole, roleSourceProject
+	case envRole != "":
+		result.Role, result.Source = envRole, roleSourceEnvironment
+	case globalRole != "":
+		result.Role, result.Source = globalRole, roleSourceGlobal
+	default:
+		result.Role, result.Source = rbac.RoleOperator, roleSourceUser
+	}
+
+	return result, nil
+}
+
+// Authorize returns ErrAccessDenied unless the user's effective role for the
+// scope satisfies the required role.
+func (s *RbacService) Authorize(ctx context.Context, user *models.User, environmentID, projectID, requiredRole string) (string, error) {
+	effective, err := s.ResolveRole(ctx, user, environmentID, projectID)
+	if err != nil {
+		return "", err
+	}
+	if !rbac.RoleSatisfies(effective.Role, requiredRole) {
+		return effective.Role, ErrAccessDenied
+	}
+	return effective.Role, nil
+}
+
+func scopeQuery(query *gorm.DB, envID, projectID *string) *gorm.DB {
+	if envID == nil {
+		query = query.Where("environment_id IS NULL")
+	} else {
+		query = query.Where("environment_id = ?", *envID)
+	}
+	if projectID == nil {
+		return query.Where("project_id IS NULL")
+	}
+	return query.Where("project_id = ?", *projectID)
+}
+
+func normalizeScopeID(id *string) *string {
+	if id == nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*id)
+	if trimmed == "" {
+		return nil
+	}
+	return &trimmed
+}
+
+func toRoleBindingDto(b *models.RoleBinding) rbac.RoleBinding {
+	return rbac.RoleBinding{
+		ID:            b.ID,
+		UserID:        b.UserID,
+		Role:          b.Role,
+		EnvironmentID: b.EnvironmentID,
+		ProjectID:     b.ProjectID,
+		CreatedAt:     b.CreatedAt,
+		UpdatedAt:     b.UpdatedAt,
+	}
+}
@@ -0,0 +1,93 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	glsqlite "github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/getarcaneapp/arcane/backend/internal/database"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/types/rbac"
+)
+
+func setupRbacServiceTestDB(t *testing.T) *database.DB {
+	t.Helper()
+
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared", strings.NewReplacer("/", "_", " ", "_").Replace(t.Name()))
+	db, err := gorm.Open(glsqlite.Open(dsn), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.RoleBinding{}))
+
+	return &database.DB{DB: db}
+}
+
+func TestRbacService_ResolveRole_Precedence(t *testing.T) {
+	ctx := context.Background()
+	svc := NewRbacService(setupRbacServiceTestDB(t))
+	user := &models.User{BaseModel: models.BaseModel{ID: "u1"}, Roles: models.StringSlice{"user"}}
+
+	effective, err := svc.ResolveRole(ctx, user, "env-1", "")
+	require.NoError(t, err)
+	require.Equal(t, rbac.RoleOperator, effective.Role)
+	require.Equal(t, "user", effective.Source)
+
+	_, err = svc.CreateRoleBinding(ctx, rbac.CreateRoleBinding{UserID: "u1", Role: rbac.RoleViewer})
+	require.NoError(t, err)
+	_, err = svc.CreateRoleBinding(ctx, rbac.CreateRoleBinding{UserID: "u1", Role: rbac.RoleOperator, EnvironmentID: new("env-1")})
+	require.NoError(t, err)
+	_, err = svc.CreateRoleBinding(ctx, rbac.CreateRoleBinding{UserID: "u1", Role: rbac.RoleAdmin, EnvironmentID: new("env-1"), ProjectID: new("p1")})
+	require.NoError(t, err)
+
+	effective, err = svc.ResolveRole(ctx, user, "env-2", "")
+	require.NoError(t, err)
+	require.Equal(t, rbac.RoleViewer, effective.Role)
+
+	effective, err = svc.ResolveRole(ctx, user, "env-1", "p2")
+	require.NoError(t, err)
+	require.Equal(t, rbac.RoleOperator, effective.Role)
+
+	effective, err = svc.ResolveRole(ctx, user, "env-1", "p1")
+	require.NoError(t, err)
+	require.Equal(t, rbac.RoleAdmin, effective.Role)
+	require.Equal(t, "project", effective.Source)
+}
+
+func TestRbacService_Authorize(t *testing.T) {
+	ctx := context.Background()
+	svc := NewRbacService(setupRbacServiceTestDB(t))
+	viewer := &models.User{BaseModel: models.BaseModel{ID: "u1"}}
+	admin := &models.User{BaseModel: models.BaseModel{ID: "u2"}, Roles: models.StringSlice{"admin"}}
+
+	_, err := svc.CreateRoleBinding(ctx, rbac.CreateRoleBinding{UserID: "u1", Role: rbac.RoleViewer, EnvironmentID: new("0")})
+	require.NoError(t, err)
+
+	_, err = svc.Authorize(ctx, viewer, "0", "", rbac.RoleViewer)
+	require.NoError(t, err)
+	_, err = svc.Authorize(ctx, viewer, "0", "", rbac.RoleOperator)
+	require.ErrorIs(t, err, ErrAccessDenied)
+	_, err = svc.Authorize(ctx, admin, "0", "", rbac.RoleAdmin)
+	require.NoError(t, err)
+}
+
+func TestRbacService_CreateRoleBinding_ReplacesSameScope(t *testing.T) {
+	ctx := context.Background()
+	svc := NewRbacService(setupRbacServiceTestDB(t))
+
+	_, err := svc.CreateRoleBinding(ctx, rbac.CreateRoleBinding{UserID: "u1", Role: rbac.RoleViewer, EnvironmentID: new("0")})
+	require.NoError(t, err)
+	_, err = svc.CreateRoleBinding(ctx, rbac.CreateRoleBinding{UserID: "u1", Role: rbac.RoleAdmin, EnvironmentID: new("0")})
+	require.NoError(t, err)
+
+	bindings, err := svc.ListRoleBindings(ctx, "u1")
+	require.NoError(t, err)
+	require.Len(t, bindings, 1)
+	require.Equal(t, rbac.RoleAdmin, bindings[0].Role)
+
+	_, err = svc.CreateRoleBinding(ctx, rbac.CreateRoleBinding{UserID: "u1", Role: rbac.RoleAdmin, ProjectID: new("p1")})
+	require.ErrorIs(t, err, ErrRoleBindingInvalid)
+}
@@ -0,0 +1,412 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/types/base"
+	containertypes "github.com/getarcaneapp/arcane/types/container"
+	"github.com/getarcaneapp/arcane/types/updater"
+	"github.com/google/uuid"
+)
+
+// maxRolloutSoakMinutes caps a stage's soak period at one week.
+const maxRolloutSoakMinutes = 7 * 24 * 60
+
+// RolloutService applies pending updates to groups of environments one stage
+// at a time: a canary stage is updated first, must stay healthy for its soak
+// period, and only then does the next stage start. Any failure halts the
+// rollout and sends a notification.
+type RolloutService struct {
+	updaterService      *UpdaterService
+	environmentService  *EnvironmentService
+	aggregationService  *AggregationService
+	settingsService     *SettingsService
+	eventService        *EventService
+	notificationService *NotificationService
+
+	mu      sync.Mutex
+	current *updater.Rollout
+}
+
+func NewRolloutService(updaterService *UpdaterService, environmentService *EnvironmentService, aggregationService *AggregationService, settingsService *SettingsService, eventService *EventService, notificationService *NotificationService) *RolloutService {
+	return &RolloutService{
+		updaterService:      updaterService,
+		environmentService:  environmentService,
+		aggregationService:  aggregationService,
+		settingsService:     settingsService,
+		eventService:        eventService,
+		notificationService: notificationService,
+	}
+}
+
+// Stages returns the configured rollout stages. No stages means staged
+// rollouts are off.
+func (s *RolloutService) Stages(ctx context.Context) ([]updater.RolloutStage, error) {
+	return parseRolloutStagesInternal(s.settingsService.GetStringSetting(ctx, "autoUpdateRolloutStages", ""))
+}
+
+// Current returns the running or most recent rollout, or nil when none has
+// run since startup.
+func (s *RolloutService) Current() *updater.Rollout {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return cloneRolloutInternal(s.current)
+}
+
+// Start begins a rollout of the configured stages in the background and
+// returns its initial state.
+func (s *RolloutService) Start(ctx context.Context, trigger string) (*updater.Rollout, error) {
+	rollout, err := s.beginInternal(ctx, trigger)
+	if err != nil {
+		return nil, err
+	}
+	go s.runInternal(context.WithoutCancel(ctx), rollout.ID)
+	return rollout, nil
+}
+
+// Run performs a rollout of the configured stages and returns when it has
+// finished or halted.
+func (s *RolloutService) Run(ctx context.Context, trigger string) (*updater.Rollout, error) {
+	rollout, err := s.beginInternal(ctx, trigger)
+	if err != nil {
+		return nil, err
+	}
+	s.runInternal(ctx, rollout.ID)
+	return s.Current(), nil
+}
+
+func (s *RolloutService) beginInternal(ctx context.Context, trigger string) (*updater.Rollout, error) {
+	stages, err := s.Stages(ctx)
+	if err != nil {
+		return nil, &models.ValidationError{Message: err.Error(), Field: "autoUpdateRolloutStages"}
+	}
+	if len(stages) == 0 {
+		return nil, &models.ValidationError{Message: "no rollout stages are configured", Field: "autoUpdateRolloutStages"}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.current != nil && (s.current.State == updater.RolloutStateRunning || s.current.State == updater.RolloutStateSoaking) {
+		return nil, &models.ConflictError{Message: "a rollout is already in progress"}
+	}
+
+	rollout := &updater.Rollout{
+		ID:        uuid.NewString(),
+		Trigger:   trigger,
+		State:     updater.RolloutStateRunning,
+		StartedAt: time.Now(),
+		Stages:    make([]updater.RolloutStageStatus, len(stages)),
+	}
+	for i, stage := range stages {
+		rollout.Stages[i] = updater.RolloutStageStatus{
+			RolloutStage: stage,
+			State:        updater.RolloutStatePending,
+			Environments: []updater.RolloutEnvironmentResult{},
+		}
+	}
+	s.current = rollout
+	return cloneRolloutInternal(rollout), nil
+}
+
+// updateInternal applies fn to the rollout with the given ID under the lock.
+func (s *RolloutService) updateInternal(id string, fn func(r *updater.Rollout)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.current != nil && s.current.ID == id {
+		fn(s.current)
+	}
+}
+
+func (s *RolloutService) runInternal(ctx context.Context, id string) {
+	rollout := s.Current()
+	slog.InfoContext(ctx, "Staged rollout started", "rollout", id, "stages", len(rollout.Stages))
+
+	haltReason := ""
+	for i, stage := range rollout.Stages {
+		startedAt := time.Now()
+		s.updateInternal(id, func(r *updater.Rollout) {
+			r.Stages[i].State = updater.RolloutStateRunning
+			r.Stages[i].StartedAt = &startedAt
+		})
+
+		results, updated := s.updateStageInternal(ctx, stage.RolloutStage)
+		failed := rolloutStageFailureInternal(stage.Name, results)
+
+		if failed == "" && stage.SoakMinutes > 0 && len(updated) > 0 {
+			s.updateInternal(id, func(r *updater.Rollout) {
+				r.Stages[i].State = updater.RolloutStateSoaking
+				r.Stages[i].Environments = results
+			})
+			slog.InfoContext(ctx, "Staged rollout soaking", "rollout", id, "stage", stage.Name, "minutes", stage.SoakMinutes)
+			select {
+			case <-ctx.Done():
+				failed = "rollout was cancelled"
+			case <-time.After(time.Duration(stage.SoakMinutes) * time.Minute):
+				s.checkStageHealthInternal(ctx, results, updated)
+				failed = rolloutStageFailureInternal(stage.Name, results)
+			}
+		}
+
+		finishedAt := time.Now()
+		s.updateInternal(id, func(r *updater.Rollout) {
+			r.Stages[i].Environments = results
+			r.Stages[i].FinishedAt = &finishedAt
+			r.Stages[i].State = updater.RolloutStateSucceeded
+			if failed != "" {
+				r.Stages[i].State = updater.RolloutStateFailed
+			}
+		})
+
+		if failed != "" {
+			haltReason = failed
+			break
+		}
+	}
+
+	finishedAt := time.Now()
+	s.updateInternal(id, func(r *updater.Rollout) {
+		r.FinishedAt = &finishedAt
+		r.State = updater.RolloutStateSucceeded
+		if haltReason != "" {
+			r.State = updater.RolloutStateHalted
+			r.HaltReason = haltReason
+			for i := range r.Stages {
+				if r.Stages[i].State == updater.RolloutStatePending {
+					r.Stages[i].State = updater.RolloutStateSkipped
+				}
+			}
+		}
+	})
+
+	s.reportInternal(ctx, s.Current())
+}
+
+// updateStageInternal runs the updater on every environment of a stage. It
+// returns the per-environment results and, per environment, the names of
+// the containers and projects that were updated.
+func (s *RolloutService) updateStageInternal(ctx context.Context, stage updater.RolloutStage) ([]updater.RolloutEnvironmentResult, map[string][]string) {
+	results := make([]updater.RolloutEnvironmentResult, len(stage.EnvironmentIDs))
+	updated := map[string][]string{}
+	for i, envID := range stage.EnvironmentIDs {
+		results[i] = updater.RolloutEnvironmentResult{EnvironmentID: envID, EnvironmentName: envID}
+
+		result, err := s.runUpdaterInternal(ctx, envID, &results[i])
+		if err != nil {
+			slog.WarnContext(ctx, "Staged rollout failed to update environment", "environment", envID, "error", err)
+			results[i].Error = err.Error()
+			continue
+		}
+
+		results[i].Updated = result.Updated
+		results[i].Failed = result.Failed
+		for _, item := range result.Items {
+			if item.Status == "updated" && item.ResourceName != "" {
+				updated[envID] = append(updated[envID], item.ResourceName)
+			}
+		}
+	}
+	return results, updated
+}
+
+func (s *RolloutService) runUpdaterInternal(ctx context.Context, envID string, out *updater.RolloutEnvironmentResult) (*updater.Result, error) {
+	if envID == "0" {
+		out.EnvironmentName = "Local"
+		return s.updaterService.ApplyPending(ctx, false)
+	}
+
+	env, err := s.environmentService.GetEnvironmentByID(ctx, envID)
+	if err != nil {
+		return nil, fmt.Errorf("environment not found")
+	}
+	out.EnvironmentName = env.Name
+	if !env.Enabled {
+		return nil, fmt.Errorf("environment is disabled")
+	}
+	if IsSSHEnvironmentURL(env.ApiUrl) {
+		// Pending updates are tracked by the updater running next to the
+		// Docker host, which SSH environments do not have.
+		return nil, fmt.Errorf("updates cannot be rolled out to SSH environments")
+	}
+
+	body, statusCode, err := s.environmentService.ProxyRequest(ctx, envID, http.MethodPost, "/api/environments/0/updater/run", []byte("{}"))
+	if err != nil {
+		return nil, err
+	}
+	if err := remoteResponseErrorInternal(statusCode, body); err != nil {
+		return nil, err
+	}
+
+	var resp base.ApiResponse[updater.Result]
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to decode updater result: %w", err)
+	}
+	return &resp.Data, nil
+}
+
+// checkStageHealthInternal records, for each environment, the updated
+// containers that are unhealthy or restarting.
+func (s *RolloutService) checkStageHealthInternal(ctx context.Context, results []updater.RolloutEnvironmentResult, updated map[string][]string) {
+	for i := range results {
+		names := updated[results[i].EnvironmentID]
+		if results[i].Error != "" || len(names) == 0 {
+			continue
+		}
+
+		list, err := s.aggregationService.ListContainers(ctx, AggregateQuery{EnvironmentIDs: []string{results[i].EnvironmentID}}, false)
+		if err == nil && len(list.Sources) > 0 && list.Sources[0].Error != "" {
+			err = fmt.Errorf("%s", list.Sources[0].Error)
+		}
+		if err != nil {
+			results[i].Error = "failed to check container health: " + err.Error()
+			continue
+		}
+
+		for _, item := range list.Items {
+			if name, unhealthy := rolloutContainerUnhealthyInternal(item.Item, names); unhealthy {
+				results[i].Unhealthy = append(results[i].Unhealthy, name)
+			}
+		}
+	}
+}
+
+func (s *RolloutService) reportInternal(ctx context.Context, rollout *updater.Rollout) {
+	stageNames := make([]string, len(rollout.Stages))
+	for i, stage := range rollout.Stages {
+		stageNames[i] = stage.Name
+	}
+	subject := strings.Join(stageNames, ", ")
+	metadata := models.JSON{"rolloutId": rollout.ID, "trigger": rollout.Trigger, "stages": rollout.Stages}
+
+	eventType := models.EventTypeRolloutCompleted
+	title := "Rollout completed: " + subject
+	description := fmt.Sprintf("Updated %d stages", len(rollout.Stages))
+	if rollout.State == updater.RolloutStateHalted {
+		eventType = models.EventTypeRolloutHalted
+		title = "Rollout halted: " + subject
+		description = rollout.HaltReason
+		metadata["haltReason"] = rollout.HaltReason
+	}
+	slog.InfoContext(ctx, "Staged rollout finished", "rollout", rollout.ID, "state", rollout.State, "reason", rollout.HaltReason)
+
+	if s.eventService != nil {
+		if _, err := s.eventService.CreateEvent(ctx, CreateEventRequest{
+			Type:        eventType,
+			Severity:    s.eventService.getEventSeverity(eventType),
+			Title:       title,
+			Description: description,
+			Metadata:    metadata,
+		}); err != nil {
+			slog.WarnContext(ctx, "Failed to record rollout event", "rollout", rollout.ID, "error", err)
+		}
+	}
+
+	if s.notificationService != nil && rollout.State == updater.RolloutStateHalted {
+		if err := s.notificationService.SendAlertNotification(ctx, AlertNotification{
+			EventType: models.NotificationEventRolloutHalted,
+			Subject:   subject,
+			Title:     title,
+			Message:   "The staged rollout stopped and later stages were not updated: " + rollout.HaltReason,
+			Metadata:  metadata,
+		}); err != nil {
+			slog.WarnContext(ctx, "Failed to send rollout notification", "rollout", rollout.ID, "error", err)
+		}
+	}
+}
+
+// rolloutStageFailureInternal describes why a stage failed, or returns ""
+// when every environment was updated and is healthy.
+func rolloutStageFailureInternal(stageName string, results []updater.RolloutEnvironmentResult) string {
+	var problems []string
+	for _, r := range results {
+		switch {
+		case r.Error != "":
+			problems = append(problems, fmt.Sprintf("%s: %s", r.EnvironmentName, r.Error))
+		case r.Failed > 0:
+			problems = append(problems, fmt.Sprintf("%s: %d updates failed", r.EnvironmentName, r.Failed))
+		case len(r.Unhealthy) > 0:
+			problems = append(problems, fmt.Sprintf("%s: unhealthy after soak: %s", r.EnvironmentName, strings.Join(r.Unhealthy, ", ")))
+		}
+	}
+	if len(problems) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("stage %s failed (%s)", stageName, strings.Join(problems, "; "))
+}
+
+// rolloutContainerUnhealthyInternal reports whether c belongs to one of the
+// updated containers or projects and is restarting or failing its health
+// check. It returns the container name.
+func rolloutContainerUnhealthyInternal(c containertypes.Summary, updated []string) (string, bool) {
+	name := c.ID
+	if len(c.Names) > 0 {
+		name = strings.TrimPrefix(c.Names[0], "/")
+	}
+	project := c.Labels["com.docker.compose.project"]
+	if !slices.Contains(updated, name) && (project == "" || !slices.Contains(updated, project)) {
+		return name, false
+	}
+	return name, c.State == "restarting" || strings.Contains(c.Status, "(unhealthy)")
+}
+
+// parseRolloutStagesInternal parses the autoUpdateRolloutStages setting, a
+// JSON array of stages. Blank means staged rollouts are off.
+func parseRolloutStagesInternal(raw string) ([]updater.RolloutStage, error) {
+	if strings.TrimSpace(raw) == "" {
+		return nil, nil
+	}
+
+	var stages []updater.RolloutStage
+	if err := json.Unmarshal([]byte(raw), &stages); err != nil {
+		return nil, fmt.Errorf("rollout stages must be a JSON array of stages: %w", err)
+	}
+
+	seen := map[string]string{}
+	for i := range stages {
+		stage := &stages[i]
+		stage.Name = strings.TrimSpace(stage.Name)
+		if stage.Name == "" {
+			stage.Name = fmt.Sprintf("stage %d", i+1)
+		}
+		if len(stage.EnvironmentIDs) == 0 {
+			return nil, fmt.Errorf("stage %s has no environments", stage.Name)
+		}
+		if stage.SoakMinutes < 0 || stage.SoakMinutes > maxRolloutSoakMinutes {
+			return nil, fmt.Errorf("stage %s soakMinutes must be between 0 and %d", stage.Name, maxRolloutSoakMinutes)
+		}
+		for j, envID := range stage.EnvironmentIDs {
+			envID = strings.TrimSpace(envID)
+			if envID == "" {
+				return nil, fmt.Errorf("stage %s has an empty environment ID", stage.Name)
+			}
+			if other, ok := seen[envID]; ok {
+				return nil, fmt.Errorf("environment %s is in both stage %s and stage %s", envID, other, stage.Name)
+			}
+			seen[envID] = stage.Name
+			stage.EnvironmentIDs[j] = envID
+		}
+	}
+	return stages, nil
+}
+
+func cloneRolloutInternal(r *updater.Rollout) *updater.Rollout {
+	if r == nil {
+		return nil
+	}
+	out := *r
+	out.Stages = make([]updater.RolloutStageStatus, len(r.Stages))
+	for i, stage := range r.Stages {
+		stage.EnvironmentIDs = slices.Clone(stage.EnvironmentIDs)
+		stage.Environments = slices.Clone(stage.Environments)
+		out.Stages[i] = stage
+	}
+	return &out
+}
@@ -0,0 +1,61 @@
+package services
+
+import (
+	"testing"
+
+	containertypes "github.com/getarcaneapp/arcane/types/container"
+	"github.com/getarcaneapp/arcane/types/updater"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRolloutStagesInternal(t *testing.T) {
+	stages, err := parseRolloutStagesInternal("")
+	require.NoError(t, err)
+	assert.Empty(t, stages)
+
+	stages, err = parseRolloutStagesInternal(`[{"name":"canary","environmentIds":[" 2 "],"soakMinutes":30},{"environmentIds":["3","4"]}]`)
+	require.NoError(t, err)
+	require.Len(t, stages, 2)
+	assert.Equal(t, "canary", stages[0].Name)
+	assert.Equal(t, []string{"2"}, stages[0].EnvironmentIDs)
+	assert.Equal(t, 30, stages[0].SoakMinutes)
+	assert.Equal(t, "stage 2", stages[1].Name)
+
+	for _, raw := range []string{
+		`{"name":"canary"}`,
+		`[{"name":"canary","environmentIds":[]}]`,
+		`[{"name":"canary","environmentIds":["2"],"soakMinutes":-1}]`,
+		`[{"name":"canary","environmentIds":["2"]},{"name":"prod","environmentIds":["2"]}]`,
+	} {
+		_, err := parseRolloutStagesInternal(raw)
+		assert.Error(t, err, raw)
+	}
+}
+
+func TestRolloutStageFailureInternal(t *testing.T) {
+	assert.Empty(t, rolloutStageFailureInternal("canary", []updater.RolloutEnvironmentResult{{EnvironmentName: "staging", Updated: 2}}))
+
+	reason := rolloutStageFailureInternal("canary", []updater.RolloutEnvironmentResult{
+		{EnvironmentName: "staging", Failed: 1},
+		{EnvironmentName: "edge", Unhealthy: []string{"web"}},
+	})
+	assert.Equal(t, "stage canary failed (staging: 1 updates failed; edge: unhealthy after soak: web)", reason)
+}
+
+func TestRolloutContainerUnhealthyInternal(t *testing.T) {
+	updated := []string{"web", "shop"}
+
+	name, unhealthy := rolloutContainerUnhealthyInternal(containertypes.Summary{Names: []string{"/web"}, State: "running", Status: "Up 5 minutes (unhealthy)"}, updated)
+	assert.Equal(t, "web", name)
+	assert.True(t, unhealthy)
+
+	_, unhealthy = rolloutContainerUnhealthyInternal(containertypes.Summary{Names: []string{"/shop-db-1"}, State: "restarting", Labels: map[string]string{"com.docker.compose.project": "shop"}}, updated)
+	assert.True(t, unhealthy)
+
+	_, unhealthy = rolloutContainerUnhealthyInternal(containertypes.Summary{Names: []string{"/other"}, State: "restarting"}, updated)
+	assert.False(t, unhealthy)
+
+	_, unhealthy = rolloutContainerUnhealthyInternal(containertypes.Summary{Names: []string{"/web"}, State: "running", Status: "Up 5 minutes (healthy)"}, updated)
+	assert.False(t, unhealthy)
+}
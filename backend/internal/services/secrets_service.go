@@ -0,0 +1,151 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/getarcaneapp/arcane/backend/internal/database"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/crypto"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/mapper"
+	"github.com/getarcaneapp/arcane/types/secret"
+)
+
+var secretNameRegex = regexp.MustCompile(`^[A-Za-z0-9_.-]{1,128}$`)
+
+// SecretsService manages named secrets that project env files reference as
+// ${arcane:name}. Values are encrypted with the instance key and are only
+// decrypted when a compose project is loaded.
+type SecretsService struct {
+	db *database.DB
+}
+
+func NewSecretsService(db *database.DB) *SecretsService {
+	return &SecretsService{db: db}
+}
+
+func (s *SecretsService) ListSecrets(ctx context.Context) ([]secret.Secret, error) {
+	var rows []models.Secret
+	if err := s.db.WithContext(ctx).Order("name ASC").Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to list secrets: %w", err)
+	}
+
+	out, err := mapper.MapSlice[models.Secret, secret.Secret](rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to map secrets: %w", err)
+	}
+	return out, nil
+}
+
+func (s *SecretsService) CreateSecret(ctx context.Context, req secret.CreateRequest) (*secret.Secret, error) {
+	name := strings.TrimSpace(req.Name)
+	if !secretNameRegex.MatchString(name) {
+		return nil, &models.ValidationError{Message: "secret name may only contain letters, digits, '.', '-' and '_'", Field: "name"}
+	}
+	if req.Value == "" {
+		return nil, &models.ValidationError{Message: "secret value is required", Field: "value"}
+	}
+
+	var count int64
+	if err := s.db.WithContext(ctx).Model(&models.Secret{}).Where("name = ?", name).Count(&count).Error; err != nil {
+		return nil, fmt.Errorf("failed to check secret name: %w", err)
+	}
+	if count > 0 {
+		return nil, &models.ConflictError{Message: fmt.Sprintf("secret %q already exists", name)}
+	}
+
+	encrypted, err := crypto.Encrypt(req.Value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt secret: %w", err)
+	}
+
+	row := &models.Secret{
+		Name:        name,
+		Value:       encrypted,
+		Description: req.Description,
+	}
+	if err := s.db.WithContext(ctx).Create(row).Error; err != nil {
+		return nil, fmt.Errorf("failed to create secret: %w", err)
+	}
+
+	return mapSecretInternal(row)
+}
+
+func (s *SecretsService) UpdateSecret(ctx context.Context, id string, req secret.UpdateRequest) (*secret.Secret, error) {
+	row, err := s.getSecretByIDInternal(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Value != nil {
+		if *req.Value == "" {
+			return nil, &models.ValidationError{Message: "secret value cannot be empty", Field: "value"}
+		}
+		encrypted, err := crypto.Encrypt(*req.Value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encrypt secret: %w", err)
+		}
+		row.Value = encrypted
+	}
+	if req.Description != nil {
+		row.Description = req.Description
+	}
+
+	if err := s.db.WithContext(ctx).Save(row).Error; err != nil {
+		return nil, fmt.Errorf("failed to update secret: %w", err)
+	}
+
+	return mapSecretInternal(row)
+}
+
+func (s *SecretsService) DeleteSecret(ctx context.Context, id string) error {
+	result := s.db.WithContext(ctx).Where("id = ?", id).Delete(&models.Secret{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete secret: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return &models.NotFoundError{Message: "secret not found"}
+	}
+	return nil
+}
+
+// ResolveSecret returns the decrypted value of the secret with the given name.
+func (s *SecretsService) ResolveSecret(ctx context.Context, name string) (string, error) {
+	var row models.Secret
+	if err := s.db.WithContext(ctx).Where("name = ?", name).First(&row).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", &models.NotFoundError{Message: fmt.Sprintf("secret %q not found", name)}
+		}
+		return "", fmt.Errorf("failed to load secret %q: %w", name, err)
+	}
+
+	value, err := crypto.Decrypt(row.Value)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt secret %q: %w", name, err)
+	}
+	return value, nil
+}
+
+func (s *SecretsService) getSecretByIDInternal(ctx context.Context, id string) (*models.Secret, error) {
+	var row models.Secret
+	if err := s.db.WithContext(ctx).Where("id = ?", id).First(&row).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, &models.NotFoundError{Message: "secret not found"}
+		}
+		return nil, fmt.Errorf("failed to get secret: %w", err)
+	}
+	return &row, nil
+}
+
+func mapSecretInternal(row *models.Secret) (*secret.Secret, error) {
+	out, err := mapper.MapOne[*models.Secret, secret.Secret](row)
+	if err != nil {
+		return nil, fmt.Errorf("failed to map secret: %w", err)
+	}
+	return &out, nil
+}
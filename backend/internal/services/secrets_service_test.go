@@ -0,0 +1,70 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	glsqlite "github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/getarcaneapp/arcane/backend/internal/config"
+	"github.com/getarcaneapp/arcane/backend/internal/database"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/crypto"
+	"github.com/getarcaneapp/arcane/types/secret"
+)
+
+func setupSecretsServiceTest(t *testing.T) (*SecretsService, *gorm.DB) {
+	t.Helper()
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared", strings.NewReplacer("/", "_", " ", "_").Replace(t.Name()))
+	db, err := gorm.Open(glsqlite.Open(dsn), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.Secret{}))
+
+	crypto.InitEncryption(&config.Config{
+		EncryptionKey: "test-encryption-key-for-testing-32bytes-min",
+		Environment:   "test",
+	})
+
+	return NewSecretsService(&database.DB{DB: db}), db
+}
+
+func TestSecretsService_CreateResolveUpdate(t *testing.T) {
+	svc, db := setupSecretsServiceTest(t)
+	ctx := context.Background()
+
+	created, err := svc.CreateSecret(ctx, secret.CreateRequest{Name: "db_password", Value: "hunter2"})
+	require.NoError(t, err)
+
+	var row models.Secret
+	require.NoError(t, db.First(&row, "id = ?", created.ID).Error)
+	require.NotEqual(t, "hunter2", row.Value)
+
+	value, err := svc.ResolveSecret(ctx, "db_password")
+	require.NoError(t, err)
+	require.Equal(t, "hunter2", value)
+
+	rotated := "correct-horse"
+	_, err = svc.UpdateSecret(ctx, created.ID, secret.UpdateRequest{Value: &rotated})
+	require.NoError(t, err)
+
+	value, err = svc.ResolveSecret(ctx, "db_password")
+	require.NoError(t, err)
+	require.Equal(t, rotated, value)
+
+	_, err = svc.CreateSecret(ctx, secret.CreateRequest{Name: "db_password", Value: "other"})
+	var conflict *models.ConflictError
+	require.ErrorAs(t, err, &conflict)
+
+	_, err = svc.CreateSecret(ctx, secret.CreateRequest{Name: "bad name", Value: "x"})
+	var validation *models.ValidationError
+	require.ErrorAs(t, err, &validation)
+
+	require.NoError(t, svc.DeleteSecret(ctx, created.ID))
+	_, err = svc.ResolveSecret(ctx, "db_password")
+	var notFound *models.NotFoundError
+	require.ErrorAs(t, err, &notFound)
+}
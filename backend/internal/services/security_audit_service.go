@@ -0,0 +1,267 @@
+package services
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/pkg/projects"
+	"github.com/getarcaneapp/arcane/types/securityaudit"
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/client"
+)
+
+// securityAuditWeights is how many points a finding of each severity takes
+// off a container's score.
+var securityAuditWeights = map[securityaudit.Severity]int{
+	securityaudit.SeverityLow:      5,
+	securityaudit.SeverityMedium:   15,
+	securityaudit.SeverityHigh:     25,
+	securityaudit.SeverityCritical: 40,
+}
+
+var securityAuditSeverityOrder = []securityaudit.Severity{
+	securityaudit.SeverityCritical,
+	securityaudit.SeverityHigh,
+	securityaudit.SeverityMedium,
+	securityaudit.SeverityLow,
+}
+
+// dangerousCapabilities are added capabilities that amount to root on the
+// host.
+var dangerousCapabilities = []string{"ALL", "SYS_ADMIN", "SYS_PTRACE", "SYS_MODULE", "NET_ADMIN", "DAC_READ_SEARCH"}
+
+// SecurityAuditService checks containers against common hardening practices
+// and scores them.
+type SecurityAuditService struct {
+	dockerService  *DockerClientService
+	projectService *ProjectService
+}
+
+func NewSecurityAuditService(dockerService *DockerClientService, projectService *ProjectService) *SecurityAuditService {
+	return &SecurityAuditService{
+		dockerService:  dockerService,
+		projectService: projectService,
+	}
+}
+
+// AuditHost audits every container on the host.
+func (s *SecurityAuditService) AuditHost(ctx context.Context) (*securityaudit.Report, error) {
+	return s.auditInternal(ctx, nil)
+}
+
+// AuditProject audits the containers of a single project.
+func (s *SecurityAuditService) AuditProject(ctx context.Context, projectID string) (*securityaudit.Report, error) {
+	proj, err := s.projectService.GetProjectFromDatabaseByID(ctx, projectID)
+	if err != nil {
+		return nil, &models.NotFoundError{Message: err.Error()}
+	}
+
+	filters := make(client.Filters).Add("label", "com.docker.compose.project="+normalizeComposeProjectName(proj.Name))
+	return s.auditInternal(ctx, filters)
+}
+
+// ExportHostAudit audits every container on the host and renders the report
+// as JSON or as CSV with one row per finding.
+func (s *SecurityAuditService) ExportHostAudit(ctx context.Context, format securityaudit.ExportFormat) ([]byte, *securityaudit.Report, error) {
+	report, err := s.AuditHost(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch format {
+	case securityaudit.ExportFormatJSON, "":
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to encode audit report: %w", err)
+		}
+		return data, report, nil
+	case securityaudit.ExportFormatCSV:
+		data, err := securityAuditCSVInternal(report)
+		if err != nil {
+			return nil, nil, err
+		}
+		return data, report, nil
+	default:
+		return nil, nil, &models.ValidationError{Message: fmt.Sprintf("unsupported export format %q", format), Field: "format"}
+	}
+}
+
+func (s *SecurityAuditService) auditInternal(ctx context.Context, filters client.Filters) (*securityaudit.Report, error) {
+	dockerClient, err := s.dockerService.GetClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to Docker: %w", err)
+	}
+
+	containerList, err := dockerClient.ContainerList(ctx, client.ContainerListOptions{All: true, Filters: filters})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Docker containers: %w", err)
+	}
+
+	reports := make([]securityaudit.ContainerReport, 0, len(containerList.Items))
+	for _, c := range containerList.Items {
+		inspect, err := dockerClient.ContainerInspect(ctx, c.ID, client.ContainerInspectOptions{})
+		if err != nil {
+			// The container may have been removed since it was listed.
+			slog.DebugContext(ctx, "Skipping container in security audit", "container", c.ID, "error", err)
+			continue
+		}
+		reports = append(reports, auditContainerInternal(inspect.Container))
+	}
+
+	report := buildSecurityAuditReportInternal(reports)
+	report.GeneratedAt = time.Now().UTC()
+	return &report, nil
+}
+
+// auditContainerInternal runs every check against one container.
+func auditContainerInternal(inspect container.InspectResponse) securityaudit.ContainerReport {
+	report := securityaudit.ContainerReport{
+		ID:       inspect.ID,
+		Name:     strings.TrimPrefix(inspect.Name, "/"),
+		Running:  inspect.State != nil && inspect.State.Running,
+		Findings: []securityaudit.Finding{},
+	}
+	if inspect.Config != nil {
+		report.Image = inspect.Config.Image
+		report.Project = inspect.Config.Labels["com.docker.compose.project"]
+	}
+
+	add := func(check string, severity securityaudit.Severity, message, remediation string) {
+		report.Findings = append(report.Findings, securityaudit.Finding{Check: check, Severity: severity, Message: message, Remediation: remediation})
+	}
+
+	if hc := inspect.HostConfig; hc != nil {
+		if hc.Privileged {
+			add(securityaudit.CheckPrivileged, securityaudit.SeverityCritical,
+				"Container runs in privileged mode with full access to host devices",
+				"Remove privileged: true and grant only the capabilities or devices the service needs")
+		}
+		if string(hc.NetworkMode) == "host" {
+			add(securityaudit.CheckHostNetwork, securityaudit.SeverityHigh,
+				"Container shares the host network namespace",
+				"Remove network_mode: host and publish the required ports instead")
+		}
+		if !hc.ReadonlyRootfs {
+			add(securityaudit.CheckWritableRootfs, securityaudit.SeverityLow,
+				"Container root filesystem is writable",
+				"Set read_only: true and mount a tmpfs or volume for paths the service writes to")
+		}
+		if len(hc.CapAdd) > 0 {
+			severity := securityaudit.SeverityMedium
+			for _, capability := range hc.CapAdd {
+				if slices.Contains(dangerousCapabilities, strings.TrimPrefix(strings.ToUpper(capability), "CAP_")) {
+					severity = securityaudit.SeverityHigh
+				}
+			}
+			add(securityaudit.CheckAddedCaps, severity,
+				"Container adds capabilities: "+strings.Join(hc.CapAdd, ", "),
+				"Drop cap_add entries the service does not need, and prefer cap_drop: [ALL] with a minimal cap_add")
+		}
+	}
+
+	for _, m := range inspect.Mounts {
+		if strings.HasSuffix(m.Source, "/docker.sock") {
+			add(securityaudit.CheckDockerSocket, securityaudit.SeverityCritical,
+				"Docker socket is mounted at "+m.Destination+", which gives root access to the host",
+				"Remove the docker.sock mount, or put a socket proxy that only allows the needed API calls in front of it")
+			break
+		}
+	}
+
+	if report.Image != "" && !strings.HasPrefix(report.Image, "sha256:") && projects.ImageUsesLatestTag(report.Image) {
+		add(securityaudit.CheckLatestTag, securityaudit.SeverityMedium,
+			"Image "+report.Image+" uses the latest tag, so redeploys can pull unreviewed versions",
+			"Pin the image to a version tag or digest")
+	}
+
+	slices.SortStableFunc(report.Findings, func(a, b securityaudit.Finding) int {
+		return cmp.Compare(slices.Index(securityAuditSeverityOrder, a.Severity), slices.Index(securityAuditSeverityOrder, b.Severity))
+	})
+
+	report.Score = 100
+	for _, f := range report.Findings {
+		report.Score -= securityAuditWeights[f.Severity]
+	}
+	report.Score = max(report.Score, 0)
+	return report
+}
+
+// buildSecurityAuditReportInternal aggregates container reports into the
+// host and per-project scores.
+func buildSecurityAuditReportInternal(containers []securityaudit.ContainerReport) securityaudit.Report {
+	report := securityaudit.Report{
+		Score:          100,
+		SeverityCounts: map[securityaudit.Severity]int{},
+		Containers:     containers,
+		Projects:       []securityaudit.ProjectReport{},
+	}
+	for _, severity := range securityAuditSeverityOrder {
+		report.SeverityCounts[severity] = 0
+	}
+
+	byProject := map[string]*securityaudit.ProjectReport{}
+	total := 0
+	for _, c := range containers {
+		total += c.Score
+		for _, f := range c.Findings {
+			report.SeverityCounts[f.Severity]++
+		}
+		if c.Project == "" {
+			continue
+		}
+		p, ok := byProject[c.Project]
+		if !ok {
+			p = &securityaudit.ProjectReport{Name: c.Project, Score: 100}
+			byProject[c.Project] = p
+		}
+		p.Containers++
+		p.Findings += len(c.Findings)
+		p.Score = min(p.Score, c.Score)
+	}
+	if len(containers) > 0 {
+		report.Score = total / len(containers)
+	}
+
+	for _, p := range byProject {
+		report.Projects = append(report.Projects, *p)
+	}
+	slices.SortFunc(report.Projects, func(a, b securityaudit.ProjectReport) int {
+		return cmp.Or(cmp.Compare(a.Score, b.Score), strings.Compare(a.Name, b.Name))
+	})
+	slices.SortFunc(report.Containers, func(a, b securityaudit.ContainerReport) int {
+		return cmp.Or(cmp.Compare(a.Score, b.Score), strings.Compare(a.Name, b.Name))
+	})
+
+	return report
+}
+
+func securityAuditCSVInternal(report *securityaudit.Report) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	_ = w.Write([]string{"container", "project", "image", "score", "check", "severity", "message", "remediation"})
+	for _, c := range report.Containers {
+		score := strconv.Itoa(c.Score)
+		if len(c.Findings) == 0 {
+			_ = w.Write([]string{c.Name, c.Project, c.Image, score, "", "", "", ""})
+			continue
+		}
+		for _, f := range c.Findings {
+			_ = w.Write([]string{c.Name, c.Project, c.Image, score, f.Check, string(f.Severity), f.Message, f.Remediation})
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, fmt.Errorf("failed to encode audit report: %w", err)
+	}
+	return buf.Bytes(), nil
+}
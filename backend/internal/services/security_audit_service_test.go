@@ -0,0 +1,77 @@
+package services
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/getarcaneapp/arcane/types/securityaudit"
+	"github.com/moby/moby/api/types/container"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditContainerInternal(t *testing.T) {
+	report := auditContainerInternal(container.InspectResponse{
+		ID:   "abc",
+		Name: "/web",
+		Config: &container.Config{
+			Image:  "nginx",
+			Labels: map[string]string{"com.docker.compose.project": "site"},
+		},
+		HostConfig: &container.HostConfig{
+			Privileged:  true,
+			NetworkMode: "host",
+			CapAdd:      []string{"NET_BIND_SERVICE", "SYS_ADMIN"},
+		},
+		Mounts: []container.MountPoint{{Source: "/var/run/docker.sock", Destination: "/var/run/docker.sock"}},
+	})
+
+	checks := make([]string, len(report.Findings))
+	for i, f := range report.Findings {
+		checks[i] = f.Check
+	}
+	assert.Equal(t, []string{
+		securityaudit.CheckPrivileged,
+		securityaudit.CheckDockerSocket,
+		securityaudit.CheckHostNetwork,
+		securityaudit.CheckAddedCaps,
+		securityaudit.CheckLatestTag,
+		securityaudit.CheckWritableRootfs,
+	}, checks)
+	assert.Equal(t, securityaudit.SeverityHigh, report.Findings[3].Severity)
+	assert.Equal(t, "web", report.Name)
+	assert.Equal(t, "site", report.Project)
+	assert.Equal(t, 0, report.Score)
+}
+
+func TestAuditContainerInternal_Hardened(t *testing.T) {
+	report := auditContainerInternal(container.InspectResponse{
+		Name:       "/db",
+		Config:     &container.Config{Image: "postgres:17"},
+		HostConfig: &container.HostConfig{ReadonlyRootfs: true, NetworkMode: "bridge"},
+	})
+
+	assert.Empty(t, report.Findings)
+	assert.Equal(t, 100, report.Score)
+}
+
+func TestBuildSecurityAuditReportInternal(t *testing.T) {
+	report := buildSecurityAuditReportInternal([]securityaudit.ContainerReport{
+		{Name: "web", Project: "site", Score: 60, Findings: []securityaudit.Finding{{Check: securityaudit.CheckHostNetwork, Severity: securityaudit.SeverityHigh}, {Check: securityaudit.CheckLatestTag, Severity: securityaudit.SeverityMedium}}},
+		{Name: "db", Project: "site", Score: 95, Findings: []securityaudit.Finding{{Check: securityaudit.CheckWritableRootfs, Severity: securityaudit.SeverityLow}}},
+		{Name: "standalone", Score: 100, Findings: []securityaudit.Finding{}},
+	})
+
+	assert.Equal(t, 85, report.Score)
+	assert.Equal(t, 1, report.SeverityCounts[securityaudit.SeverityHigh])
+	assert.Equal(t, 0, report.SeverityCounts[securityaudit.SeverityCritical])
+	require.Len(t, report.Projects, 1)
+	assert.Equal(t, securityaudit.ProjectReport{Name: "site", Score: 60, Containers: 2, Findings: 3}, report.Projects[0])
+	assert.Equal(t, "web", report.Containers[0].Name)
+
+	data, err := securityAuditCSVInternal(&report)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	assert.Len(t, lines, 5)
+	assert.Equal(t, "container,project,image,score,check,severity,message,remediation", lines[0])
+}
@@ -39,7 +39,13 @@ type SettingsService struct {
 	OnScheduledPruneSettingsChanged    func(ctx context.Context)
 	OnVulnerabilityScanSettingsChanged func(ctx context.Context)
 	OnAutoHealSettingsChanged          func(ctx context.Context)
+	OnOutboundProxyChanged             func(ctx context.Context)
 	OnTimeoutSettingsChanged           func(ctx context.Context, timeoutSettings []libarcane.SettingUpdate)
+
+	// ResolveProjectsPathMapping returns the container and host directories a
+	// projectsDirectory value maps to, without creating anything. Used to
+	// preview path mapping changes.
+	ResolveProjectsPathMapping func(ctx context.Context, projectsDirectory string) (containerDir, hostDir string)
 }
 
 func NewSettingsService(ctx context.Context, db *database.DB) (*SettingsService, error) {
@@ -91,12 +97,24 @@ func (s *SettingsService) getDefaultSettings() *models.Settings {
 		AutoUpdate:                    models.SettingVariable{Value: "false"},
 		AutoUpdateInterval:            models.SettingVariable{Value: "0 0 0 * * *"},
 		AutoUpdateExcludedContainers:  models.SettingVariable{Value: ""},
+		AutoUpdateMonitorOnly:         models.SettingVariable{Value: ""},
+		AutoUpdateMinFreeDisk:         models.SettingVariable{Value: "1024"},
+		AutoUpdateRegistryCheck:       models.SettingVariable{Value: "true"},
+		AutoUpdateMaxLoad:             models.SettingVariable{Value: "0"},
+		AutoUpdateRollbackWindow:      models.SettingVariable{Value: "72"},
+		AutoUpdateRolloutStages:       models.SettingVariable{Value: ""},
+		ImageRetentionEnabled:         models.SettingVariable{Value: "false"},
+		ImageRetentionKeepCount:       models.SettingVariable{Value: "2"},
+		ImageRetentionInterval:        models.SettingVariable{Value: "0 0 4 * * *"},
 		PollingEnabled:                models.SettingVariable{Value: "true"},
 		PollingInterval:               models.SettingVariable{Value: "0 0 * * * *"},
 		EventCleanupInterval:          models.SettingVariable{Value: "0 0 */6 * * *"},
 		AnalyticsHeartbeatInterval:    models.SettingVariable{Value: "0 0 0 * * *"},
 		AutoInjectEnv:                 models.SettingVariable{Value: "false"},
+		ComposeTemplateVariables:      models.SettingVariable{Value: ""},
+		ComposeLintRules:              models.SettingVariable{Value: ""},
 		PruneMode:                     models.SettingVariable{Value: "dangling"},
+		PruneProtectionLabel:          models.SettingVariable{Value: libarcane.DefaultPruneProtectionLabel},
 		DefaultDeployPullPolicy:       models.SettingVariable{Value: "missing"},
 		ScheduledPruneEnabled:         models.SettingVariable{Value: "false"},
 		ScheduledPruneInterval:        models.SettingVariable{Value: "0 0 0 * * *"},
@@ -105,20 +123,54 @@ func (s *SettingsService) getDefaultSettings() *models.Settings {
 		ScheduledPruneVolumes:         models.SettingVariable{Value: "false"},
 		ScheduledPruneNetworks:        models.SettingVariable{Value: "true"},
 		ScheduledPruneBuildCache:      models.SettingVariable{Value: "false"},
+		StaleResourcesEnabled:         models.SettingVariable{Value: "false"},
+		StaleResourcesInterval:        models.SettingVariable{Value: "0 0 5 * * *"},
+		StaleContainerDays:            models.SettingVariable{Value: "30"},
+		StaleImageDays:                models.SettingVariable{Value: "90"},
 		AutoHealEnabled:               models.SettingVariable{Value: "false"},
 		AutoHealInterval:              models.SettingVariable{Value: "*/30 * * * * *"},
 		AutoHealExcludedContainers:    models.SettingVariable{Value: ""},
 		AutoHealMaxRestarts:           models.SettingVariable{Value: "5"},
+		ContainerSnapshotRetention:    models.SettingVariable{Value: "5"},
 		AutoHealRestartWindow:         models.SettingVariable{Value: "30"},
+		ContainerCrashThreshold:       models.SettingVariable{Value: "3"},
+		ContainerCrashWindow:          models.SettingVariable{Value: "10"},
+		ContainerCrashCooldown:        models.SettingVariable{Value: "60"},
+		UnmanagedChangeDetection:      models.SettingVariable{Value: "false"},
+		NotificationActionLinks:       models.SettingVariable{Value: "false"},
+		NotificationActionLinkHours:   models.SettingVariable{Value: "72"},
+		HostMetricsCpuThreshold:       models.SettingVariable{Value: "0"},
+		HostMetricsMemoryThreshold:    models.SettingVariable{Value: "0"},
+		HostMetricsDiskThreshold:      models.SettingVariable{Value: "90"},
+		HostMetricsRetentionHours:     models.SettingVariable{Value: "168"},
+		EnvironmentHeartbeatTimeout:   models.SettingVariable{Value: "300"},
+		EnvironmentFlapWindow:         models.SettingVariable{Value: "10"},
+		ConfigBackupEnabled:           models.SettingVariable{Value: "false"},
+		ConfigBackupInterval:          models.SettingVariable{Value: "0 0 3 * * *"},
+		ConfigBackupDestination:       models.SettingVariable{Value: "local"},
+		ConfigBackupPath:              models.SettingVariable{Value: "/app/data/backups"},
+		ConfigBackupRetention:         models.SettingVariable{Value: "7"},
+		ConfigBackupPassphrase:        models.SettingVariable{Value: ""},
+		ConfigBackupS3Endpoint:        models.SettingVariable{Value: ""},
+		ConfigBackupS3Region:          models.SettingVariable{Value: ""},
+		ConfigBackupS3Bucket:          models.SettingVariable{Value: ""},
+		ConfigBackupS3Prefix:          models.SettingVariable{Value: ""},
+		ConfigBackupS3AccessKey:       models.SettingVariable{Value: ""},
+		ConfigBackupS3SecretKey:       models.SettingVariable{Value: ""},
 		GitopsSyncInterval:            models.SettingVariable{Value: "0 */1 * * * *"},
 		BaseServerURL:                 models.SettingVariable{Value: "http://localhost"},
 		EnableGravatar:                models.SettingVariable{Value: "true"},
 		DefaultShell:                  models.SettingVariable{Value: "/bin/sh"},
+		OutboundProxyUrl:              models.SettingVariable{Value: ""},
+		OutboundNoProxy:               models.SettingVariable{Value: ""},
+		MaintenanceModeEnabled:        models.SettingVariable{Value: "false"},
+		MaintenanceModeMessage:        models.SettingVariable{Value: ""},
 		DockerHost:                    models.SettingVariable{Value: "unix:///var/run/docker.sock"},
 		BuildsDirectory:               models.SettingVariable{Value: "/builds"},
 		AuthLocalEnabled:              models.SettingVariable{Value: "true"},
 		AuthSessionTimeout:            models.SettingVariable{Value: "1440"},
 		AuthPasswordPolicy:            models.SettingVariable{Value: "strong"},
+		CorsAllowedOrigins:            models.SettingVariable{Value: ""},
 		VulnerabilityScanEnabled:      models.SettingVariable{Value: "false"},
 		VulnerabilityScanInterval:     models.SettingVariable{Value: "0 0 0 * * *"},
 		TrivyImage:                    models.SettingVariable{Value: "ghcr.io/aquasecurity/trivy:latest"},
@@ -145,6 +197,10 @@ func (s *SettingsService) getDefaultSettings() *models.Settings {
 		OidcMergeAccounts:          models.SettingVariable{Value: "false"},
 		OidcProviderName:           models.SettingVariable{Value: ""},
 		OidcProviderLogoUrl:        models.SettingVariable{Value: ""},
+		AuditForwardEnabled:        models.SettingVariable{Value: "false"},
+		AuditForwardProtocol:       models.SettingVariable{Value: "syslog-udp"},
+		AuditForwardTarget:         models.SettingVariable{Value: ""},
+		AuditForwardToken:          models.SettingVariable{Value: ""},
 		MobileNavigationMode:       models.SettingVariable{Value: "floating"},
 		MobileNavigationShowLabels: models.SettingVariable{Value: "true"},
 		SidebarHoverExpansion:      models.SettingVariable{Value: "true"},
@@ -166,7 +222,15 @@ func (s *SettingsService) getDefaultSettings() *models.Settings {
 		DepotProjectId:         models.SettingVariable{Value: ""},
 		DepotToken:             models.SettingVariable{Value: ""},
 
+		AggregateRequestTimeout:     models.SettingVariable{Value: "10"},
+		ProxyRequestRetries:         models.SettingVariable{Value: "2"},
+		EnvironmentBreakerThreshold: models.SettingVariable{Value: "5"},
+		EnvironmentBreakerCooldown:  models.SettingVariable{Value: "30"},
+
 		InstanceID: models.SettingVariable{Value: ""},
+		SetupState: models.SettingVariable{Value: ""},
+
+		NotifiedRelease: models.SettingVariable{Value: ""},
 	}
 }
 
@@ -511,6 +575,11 @@ func (s *SettingsService) UpdateSettings(ctx context.Context, updates settings.U
 	if slices.ContainsFunc(valuesToUpdate, func(sv models.SettingVariable) bool { return sv.Key == "projectsDirectory" }) && s.OnProjectsDirectoryChanged != nil {
 		s.OnProjectsDirectoryChanged(ctx)
 	}
+	if slices.ContainsFunc(valuesToUpdate, func(sv models.SettingVariable) bool {
+		return sv.Key == "outboundProxyUrl" || sv.Key == "outboundNoProxy"
+	}) && s.OnOutboundProxyChanged != nil {
+		s.OnOutboundProxyChanged(ctx)
+	}
 	if len(changedTimeouts) > 0 && s.OnTimeoutSettingsChanged != nil {
 		s.OnTimeoutSettingsChanged(ctx, changedTimeouts)
 	}
@@ -558,8 +627,12 @@ func (s *SettingsService) prepareUpdateValues(updates settings.Update, cfg, defa
 			continue
 		}
 
-		if err := libarcane.ValidateCronSetting(key, value); err != nil {
-			return nil, false, false, false, false, false, nil, fmt.Errorf("invalid cron expression for %s: %w", key, err)
+		// The projects directory is validated by ValidateSettings only when it
+		// changes, since the current value may come from the environment.
+		if value != "" && key != "projectsDirectory" {
+			if err := validateSettingValueInternal(key, value); err != nil {
+				return nil, false, false, false, false, false, nil, err
+			}
 		}
 
 		var valueToSave string
@@ -0,0 +1,388 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	httputils "github.com/getarcaneapp/arcane/backend/internal/utils/http"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/pathmapper"
+	"github.com/getarcaneapp/arcane/backend/pkg/libarcane"
+	"github.com/getarcaneapp/arcane/backend/pkg/projects"
+	"github.com/getarcaneapp/arcane/types/settings"
+)
+
+type settingBounds struct {
+	min, max int
+	unit     string
+}
+
+// Bounds for numeric settings where a value far outside the range is almost
+// certainly a typo, e.g. milliseconds entered for a seconds field.
+var settingValueBounds = map[string]settingBounds{
+	"dockerApiTimeout":            {5, 3600, "seconds"},
+	"dockerImagePullTimeout":      {30, 86400, "seconds"},
+	"trivyScanTimeout":            {60, 86400, "seconds"},
+	"gitOperationTimeout":         {10, 3600, "seconds"},
+	"httpClientTimeout":           {5, 3600, "seconds"},
+	"registryTimeout":             {5, 3600, "seconds"},
+	"proxyRequestTimeout":         {5, 3600, "seconds"},
+	"aggregateRequestTimeout":     {1, 600, "seconds"},
+	"proxyRequestRetries":         {0, 10, "retries"},
+	"environmentBreakerThreshold": {0, 100, "failures"},
+	"environmentBreakerCooldown":  {5, 3600, "seconds"},
+	"buildTimeout":                {60, 86400, "seconds"},
+	"environmentHeartbeatTimeout": {0, 86400, "seconds"},
+	"authSessionTimeout":          {5, 43200, "minutes"},
+}
+
+// What Arcane does when a setting changes, matching the callbacks run by
+// UpdateSettings.
+var settingChangeEffects = map[string][]string{
+	"pollingEnabled":                {"Image update polling job is rescheduled"},
+	"pollingInterval":               {"Image update polling job is rescheduled"},
+	"autoUpdate":                    {"Auto-update job is rescheduled"},
+	"autoUpdateInterval":            {"Auto-update job is rescheduled"},
+	"scheduledPruneEnabled":         {"Scheduled prune job is rescheduled"},
+	"scheduledPruneInterval":        {"Scheduled prune job is rescheduled"},
+	"scheduledPruneContainers":      {"Scheduled prune job is rescheduled"},
+	"scheduledPruneImages":          {"Scheduled prune job is rescheduled"},
+	"scheduledPruneVolumes":         {"Scheduled prune job is rescheduled"},
+	"scheduledPruneNetworks":        {"Scheduled prune job is rescheduled"},
+	"scheduledPruneBuildCache":      {"Scheduled prune job is rescheduled"},
+	"vulnerabilityScanEnabled":      {"Vulnerability scan job is rescheduled"},
+	"vulnerabilityScanInterval":     {"Vulnerability scan job is rescheduled"},
+	"trivyNetwork":                  {"Vulnerability scan job is rescheduled"},
+	"trivyResourceLimitsEnabled":    {"Vulnerability scan job is rescheduled"},
+	"trivyCpuLimit":                 {"Vulnerability scan job is rescheduled"},
+	"trivyMemoryLimitMb":            {"Vulnerability scan job is rescheduled"},
+	"trivyConcurrentScanContainers": {"Vulnerability scan job is rescheduled"},
+	"autoHealEnabled":               {"Auto-heal job is rescheduled"},
+	"autoHealInterval":              {"Auto-heal job is rescheduled"},
+	"autoHealExcludedContainers":    {"Auto-heal job is rescheduled"},
+	"autoHealMaxRestarts":           {"Auto-heal job is rescheduled"},
+	"autoHealRestartWindow":         {"Auto-heal job is rescheduled"},
+	"projectsDirectory":             {"Projects filesystem watcher is restarted", "Project path mapping is recalculated"},
+	"outboundProxyUrl":              {"Outbound proxy is reapplied to HTTP clients"},
+	"outboundNoProxy":               {"Outbound proxy is reapplied to HTTP clients"},
+	"corsAllowedOrigins":            {"Cross-origin requests are checked against the new allowlist"},
+	"pruneProtectionLabel":          {"Prunes skip resources with the new label instead of the old one"},
+	"authSessionTimeout":            {"New sessions use the new lifetime; existing sessions keep theirs"},
+}
+
+// ValidateSettings checks an update without saving it. Format problems are
+// errors; values that can be saved but look wrong are warnings. The projects
+// directory is checked on disk only when it changes, so an unchanged value
+// provided by the environment never blocks saving other settings.
+func (s *SettingsService) ValidateSettings(updates settings.Update) []settings.ValidationIssue {
+	issues := make([]settings.ValidationIssue, 0)
+	current := s.GetSettingsConfig()
+
+	rt := reflect.TypeFor[settings.Update]()
+	rv := reflect.ValueOf(updates)
+	for i := 0; i < rt.NumField(); i++ {
+		key, value, ok := extractUpdateValue(rt.Field(i), rv.Field(i))
+		if !ok || value == "" {
+			continue
+		}
+
+		if key == "projectsDirectory" {
+			if current != nil && value == current.ProjectsDirectory.Value {
+				continue
+			}
+			issues = append(issues, checkProjectsDirectoryInternal(value)...)
+			continue
+		}
+
+		if err := validateSettingValueInternal(key, value); err != nil {
+			issues = append(issues, settings.ValidationIssue{Key: key, Severity: settings.IssueSeverityError, Message: err.Error()})
+		}
+	}
+
+	if updates.BaseServerURL != nil && *updates.BaseServerURL != "" {
+		issues = append(issues, checkBaseServerURLInternal(*updates.BaseServerURL)...)
+	}
+
+	return issues
+}
+
+// DryApplySettings validates an update and reports which settings would change
+// and what Arcane would do as a result, without saving anything.
+func (s *SettingsService) DryApplySettings(ctx context.Context, updates settings.Update) (*settings.DryApplyResult, error) {
+	cfg, err := s.GetSettings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load current settings: %w", err)
+	}
+	defaultCfg := s.getDefaultSettings()
+
+	result := &settings.DryApplyResult{
+		Issues:  s.ValidateSettings(updates),
+		Changes: make([]settings.Change, 0),
+	}
+
+	rt := reflect.TypeFor[settings.Update]()
+	rv := reflect.ValueOf(updates)
+	for i := 0; i < rt.NumField(); i++ {
+		key, value, ok := extractUpdateValue(rt.Field(i), rv.Field(i))
+		if !ok {
+			continue
+		}
+
+		oldValue, _, sensitive, err := cfg.FieldByKey(key)
+		if err != nil {
+			continue
+		}
+		// UpdateSettings only writes the depot token among sensitive settings,
+		// and keeps it when the value is empty.
+		if sensitive && (key != libarcane.DepotTokenSettingKey || strings.TrimSpace(value) == "") {
+			continue
+		}
+
+		newValue := value
+		if value == "" {
+			newValue, _, _, _ = defaultCfg.FieldByKey(key)
+		}
+		if newValue == oldValue {
+			continue
+		}
+
+		change := settings.Change{Key: key, Effects: slices.Clone(settingChangeEffects[key])}
+		if !sensitive {
+			change.OldValue = oldValue
+			change.NewValue = newValue
+		}
+		if libarcane.IsTimeoutSettingKey(key) && s.OnTimeoutSettingsChanged != nil {
+			change.Effects = append(change.Effects, "Setting is synced to connected agents")
+		}
+		if key == "projectsDirectory" {
+			change.Effects = append(change.Effects, s.describePathMappingInternal(ctx, oldValue, newValue)...)
+		}
+		if s.isEnvOverrideActiveInternal(key) {
+			result.Issues = append(result.Issues, settings.ValidationIssue{
+				Key:      key,
+				Severity: settings.IssueSeverityWarning,
+				Message:  key + " is set by an environment variable; the saved value is ignored until it is removed",
+			})
+		}
+
+		result.Changes = append(result.Changes, change)
+	}
+
+	result.Valid = !HasSettingsValidationErrors(result.Issues)
+	return result, nil
+}
+
+// HasSettingsValidationErrors reports whether any issue prevents saving.
+func HasSettingsValidationErrors(issues []settings.ValidationIssue) bool {
+	for _, issue := range issues {
+		if issue.Severity == settings.IssueSeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// validateSettingValueInternal checks the format of a single non-empty value.
+func validateSettingValueInternal(key, value string) error {
+	if err := libarcane.ValidateCronSetting(key, value); err != nil {
+		return fmt.Errorf("invalid cron expression for %s: %w", key, err)
+	}
+
+	if bounds, ok := settingValueBounds[key]; ok {
+		n, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil {
+			return fmt.Errorf("%s must be a whole number of %s", key, bounds.unit)
+		}
+		if n < bounds.min || n > bounds.max {
+			return fmt.Errorf("%s must be between %d and %d %s", key, bounds.min, bounds.max, bounds.unit)
+		}
+	}
+
+	switch key {
+	case "outboundProxyUrl":
+		return httputils.ValidateProxyURL(value)
+	case "corsAllowedOrigins":
+		_, err := httputils.ParseAllowedOrigins(value)
+		return err
+	case "pruneProtectionLabel":
+		return libarcane.ValidatePruneProtectionLabel(value)
+	case "baseServerUrl":
+		return validateBaseServerURLInternal(value)
+	case "projectsDirectory":
+		return validateProjectsDirectoryFormatInternal(value)
+	case "composeTemplateVariables":
+		if _, err := projects.ParseTemplateVariables(value); err != nil {
+			return fmt.Errorf("invalid compose template variables: %w", err)
+		}
+	case "autoUpdateRolloutStages":
+		if _, err := parseRolloutStagesInternal(value); err != nil {
+			return fmt.Errorf("invalid rollout stages: %w", err)
+		}
+	case "composeLintRules":
+		if _, err := projects.ParseLintConfig(value); err != nil {
+			return fmt.Errorf("invalid compose lint rules: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func validateBaseServerURLInternal(value string) error {
+	u, err := url.Parse(strings.TrimSpace(value))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.New("baseServerUrl must be an absolute http or https URL, e.g. https://arcane.example.com")
+	}
+	return nil
+}
+
+// checkBaseServerURLInternal warns about URLs that parse but break links and
+// OIDC callbacks once Arcane is reached from another machine.
+func checkBaseServerURLInternal(value string) []settings.ValidationIssue {
+	u, err := url.Parse(strings.TrimSpace(value))
+	if err != nil || u.Host == "" {
+		return nil
+	}
+
+	var issues []settings.ValidationIssue
+	if u.RawQuery != "" || u.Fragment != "" {
+		issues = append(issues, settings.ValidationIssue{Key: "baseServerUrl", Severity: settings.IssueSeverityWarning, Message: "baseServerUrl should not contain a query string or fragment"})
+	}
+	if host := u.Hostname(); host == "localhost" || host == "127.0.0.1" || host == "::1" {
+		issues = append(issues, settings.ValidationIssue{Key: "baseServerUrl", Severity: settings.IssueSeverityWarning, Message: "baseServerUrl points to localhost; links in notifications and OIDC redirects will not work from other machines"})
+	}
+	return issues
+}
+
+// validateProjectsDirectoryFormatInternal validates a projects directory value allowing:
+// - Unix absolute paths (/...)
+// - Windows drive paths (C:/..., C:\...)
+// - Mapping format "container:host" where container is absolute Unix or Windows path
+func validateProjectsDirectoryFormatInternal(path string) error {
+	switch {
+	case pathmapper.IsWindowsDrivePath(path):
+		return nil
+	case strings.Contains(path, ":"):
+		parts := strings.SplitN(path, ":", 2)
+		if len(parts) != 2 {
+			return errors.New("projectsDirectory must be an absolute path or valid mapping format")
+		}
+		container := parts[0]
+		if !strings.HasPrefix(container, "/") && !pathmapper.IsWindowsDrivePath(container) {
+			return errors.New("projectsDirectory mapping format: container path must be absolute")
+		}
+		return nil
+	default:
+		if !strings.HasPrefix(path, "/") {
+			return errors.New("projectsDirectory must be an absolute path starting with '/'")
+		}
+		return nil
+	}
+}
+
+// checkProjectsDirectoryInternal validates the format of a projects directory
+// and checks that Arcane can write to the container side of it. A missing
+// directory is only a warning when it can be created, since Arcane creates it
+// on first use.
+func checkProjectsDirectoryInternal(value string) []settings.ValidationIssue {
+	issue := func(severity, format string, args ...any) []settings.ValidationIssue {
+		return []settings.ValidationIssue{{Key: "projectsDirectory", Severity: severity, Message: fmt.Sprintf(format, args...)}}
+	}
+
+	if err := validateProjectsDirectoryFormatInternal(value); err != nil {
+		return issue(settings.IssueSeverityError, "%s", err.Error())
+	}
+
+	containerDir, _ := splitProjectsDirectoryInternal(value)
+	if !strings.HasPrefix(containerDir, "/") {
+		// Windows paths cannot be checked from inside the container.
+		return nil
+	}
+	containerDir = filepath.Clean(containerDir)
+
+	info, err := os.Stat(containerDir)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		parent := nearestExistingDirInternal(containerDir)
+		if parent == "" || !isDirWritableInternal(parent) {
+			return issue(settings.IssueSeverityError, "projectsDirectory %s does not exist and cannot be created", containerDir)
+		}
+		return issue(settings.IssueSeverityWarning, "projectsDirectory %s does not exist and will be created", containerDir)
+	case err != nil:
+		return issue(settings.IssueSeverityError, "projectsDirectory %s cannot be accessed: %v", containerDir, err)
+	case !info.IsDir():
+		return issue(settings.IssueSeverityError, "projectsDirectory %s is not a directory", containerDir)
+	case !isDirWritableInternal(containerDir):
+		return issue(settings.IssueSeverityError, "projectsDirectory %s is not writable", containerDir)
+	}
+	return nil
+}
+
+// splitProjectsDirectoryInternal splits the "container_path:host_path" mapping
+// format. hostDir is empty when the value is a plain path.
+func splitProjectsDirectoryInternal(value string) (containerDir, hostDir string) {
+	if parts := strings.SplitN(value, ":", 2); len(parts) == 2 {
+		// Only treat as mapping if first part is absolute Linux path (not Windows drive)
+		if !pathmapper.IsWindowsDrivePath(value) && strings.HasPrefix(parts[0], "/") {
+			return parts[0], parts[1]
+		}
+	}
+	return value, ""
+}
+
+func nearestExistingDirInternal(path string) string {
+	for dir := filepath.Dir(path); ; dir = filepath.Dir(dir) {
+		if info, err := os.Stat(dir); err == nil {
+			if info.IsDir() {
+				return dir
+			}
+			return ""
+		}
+		if dir == filepath.Dir(dir) {
+			return ""
+		}
+	}
+}
+
+func isDirWritableInternal(dir string) bool {
+	f, err := os.CreateTemp(dir, ".arcane-write-check-*")
+	if err != nil {
+		return false
+	}
+	name := f.Name()
+	_ = f.Close()
+	_ = os.Remove(name)
+	return true
+}
+
+// describePathMappingInternal explains how compose paths are translated before
+// and after a projects directory change, so a change that silently breaks bind
+// mounts of existing projects is visible before saving.
+func (s *SettingsService) describePathMappingInternal(ctx context.Context, oldValue, newValue string) []string {
+	if s.ResolveProjectsPathMapping == nil {
+		return nil
+	}
+
+	describe := func(value string) string {
+		containerDir, hostDir := s.ResolveProjectsPathMapping(ctx, value)
+		if hostDir == "" || hostDir == containerDir {
+			return containerDir + " (paths are used as-is)"
+		}
+		return containerDir + " -> " + hostDir
+	}
+
+	before, after := describe(oldValue), describe(newValue)
+	if before == after {
+		return []string{"Path mapping is unchanged: " + after}
+	}
+	return []string{
+		"Path mapping changes from " + before + " to " + after,
+		"Relative bind mounts of existing projects resolve to new host paths on their next deploy",
+	}
+}
@@ -0,0 +1,63 @@
+package services
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/getarcaneapp/arcane/types/settings"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSettingValue_BaseServerURL(t *testing.T) {
+	require.NoError(t, validateSettingValueInternal("baseServerUrl", "https://arcane.example.com"))
+	require.NoError(t, validateSettingValueInternal("baseServerUrl", "http://10.0.0.5:3552/arcane"))
+	require.Error(t, validateSettingValueInternal("baseServerUrl", "arcane.example.com"))
+	require.Error(t, validateSettingValueInternal("baseServerUrl", "ftp://arcane.example.com"))
+	require.Error(t, validateSettingValueInternal("baseServerUrl", "https://"))
+
+	issues := checkBaseServerURLInternal("http://localhost:3552")
+	require.Len(t, issues, 1)
+	require.Equal(t, settings.IssueSeverityWarning, issues[0].Severity)
+}
+
+func TestValidateSettingValue_TimeoutBounds(t *testing.T) {
+	require.NoError(t, validateSettingValueInternal("dockerApiTimeout", "30"))
+	require.NoError(t, validateSettingValueInternal("environmentHeartbeatTimeout", "0"))
+	require.ErrorContains(t, validateSettingValueInternal("dockerApiTimeout", "30000"), "between 5 and 3600 seconds")
+	require.ErrorContains(t, validateSettingValueInternal("authSessionTimeout", "1"), "minutes")
+	require.ErrorContains(t, validateSettingValueInternal("buildTimeout", "30m"), "whole number")
+}
+
+func TestCheckProjectsDirectory(t *testing.T) {
+	dir := t.TempDir()
+
+	require.Empty(t, checkProjectsDirectoryInternal(dir))
+	require.Empty(t, checkProjectsDirectoryInternal(dir+":/srv/projects"))
+
+	issues := checkProjectsDirectoryInternal(filepath.Join(dir, "new", "projects"))
+	require.Len(t, issues, 1)
+	require.Equal(t, settings.IssueSeverityWarning, issues[0].Severity)
+	require.NoDirExists(t, filepath.Join(dir, "new"), "checking must not create the directory")
+
+	file := filepath.Join(dir, "file")
+	require.NoError(t, os.WriteFile(file, nil, 0o600))
+	issues = checkProjectsDirectoryInternal(file)
+	require.Len(t, issues, 1)
+	require.Equal(t, settings.IssueSeverityError, issues[0].Severity)
+	require.Contains(t, issues[0].Message, "not a directory")
+
+	issues = checkProjectsDirectoryInternal("data/projects")
+	require.Len(t, issues, 1)
+	require.Equal(t, settings.IssueSeverityError, issues[0].Severity)
+}
+
+func TestSplitProjectsDirectory(t *testing.T) {
+	containerDir, hostDir := splitProjectsDirectoryInternal("/app/data/projects:/srv/projects")
+	require.Equal(t, "/app/data/projects", containerDir)
+	require.Equal(t, "/srv/projects", hostDir)
+
+	containerDir, hostDir = splitProjectsDirectoryInternal("C:/projects")
+	require.Equal(t, "C:/projects", containerDir)
+	require.Empty(t, hostDir)
+}
@@ -0,0 +1,420 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+
+	"github.com/moby/moby/client"
+	"gorm.io/gorm"
+
+	"github.com/getarcaneapp/arcane/backend/internal/database"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/types/settings"
+	"github.com/getarcaneapp/arcane/types/setup"
+)
+
+const (
+	setupStateSettingKey = "setupState"
+	defaultAdminUsername = "arcane"
+)
+
+var (
+	ErrSetupCompleted       = errors.New("setup has already been completed")
+	ErrSetupAdminCreated    = errors.New("the administrator account has already been created")
+	ErrSetupStepNotOptional = errors.New("setup step cannot be skipped")
+	ErrSetupStepUnknown     = errors.New("unknown setup step")
+	ErrSetupIncomplete      = errors.New("required setup steps are not completed")
+	ErrSetupInvalidValue    = errors.New("invalid setup value")
+)
+
+// setupSteps lists the setup steps in order and whether they can be skipped.
+var setupSteps = []setup.Step{
+	{ID: setup.StepAdmin},
+	{ID: setup.StepProjectsDirectory},
+	{ID: setup.StepDocker},
+	{ID: setup.StepNotification, Optional: true},
+	{ID: setup.StepEnvironment, Optional: true},
+}
+
+// setupStateInternal is the setup progress stored in the setupState setting.
+type setupStateInternal struct {
+	Completed bool              `json:"completed"`
+	Steps     map[string]string `json:"steps,omitempty"`
+}
+
+// SetupService guides a new installation through first-run setup: replacing
+// the default administrator, choosing the projects directory, checking the
+// Docker connection and optionally adding a notification provider and a first
+// environment. Once setup is completed its endpoints are closed.
+type SetupService struct {
+	db              *database.DB
+	userService     *UserService
+	settingsService *SettingsService
+	dockerService   *DockerClientService
+
+	mu sync.Mutex
+}
+
+func NewSetupService(db *database.DB, userService *UserService, settingsService *SettingsService, dockerService *DockerClientService) *SetupService {
+	return &SetupService{
+		db:              db,
+		userService:     userService,
+		settingsService: settingsService,
+		dockerService:   dockerService,
+	}
+}
+
+// MarkExistingInstallComplete records setup as completed for installations
+// that were set up before the setup flow existed, recognised by having users
+// other than the untouched default administrator.
+func (s *SetupService) MarkExistingInstallComplete(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state := s.loadStateInternal(ctx)
+	if state.Completed {
+		return nil
+	}
+
+	pending, err := s.adminPendingInternal(ctx, s.db.DB)
+	if err != nil {
+		return err
+	}
+	if pending {
+		return nil
+	}
+
+	state.Completed = true
+	slog.InfoContext(ctx, "Existing installation detected, skipping first-run setup")
+	return s.saveStateInternal(ctx, state)
+}
+
+// GetStatus returns the progress of the setup.
+func (s *SetupService) GetStatus(ctx context.Context) (*setup.Progress, error) {
+	state := s.loadStateInternal(ctx)
+	if state.Completed {
+		status := &setup.Progress{Steps: make([]setup.Step, 0, len(setupSteps))}
+		for _, step := range setupSteps {
+			step.Status = setup.StepStatusCompleted
+			if recorded := state.Steps[step.ID]; recorded != "" {
+				step.Status = recorded
+			}
+			status.Steps = append(status.Steps, step)
+		}
+		return status, nil
+	}
+
+	adminPending, err := s.adminPendingInternal(ctx, s.db.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &setup.Progress{Required: true, Steps: make([]setup.Step, 0, len(setupSteps))}
+	for _, step := range setupSteps {
+		step.Status = setup.StepStatusPending
+		if recorded := state.Steps[step.ID]; recorded != "" {
+			step.Status = recorded
+		}
+		if step.ID == setup.StepAdmin && !adminPending {
+			step.Status = setup.StepStatusCompleted
+		}
+		if step.Status == setup.StepStatusPending && status.CurrentStep == "" {
+			status.CurrentStep = step.ID
+		}
+		status.Steps = append(status.Steps, step)
+	}
+	return status, nil
+}
+
+// IsRequired reports whether setup still has to be completed.
+func (s *SetupService) IsRequired(ctx context.Context) bool {
+	return !s.loadStateInternal(ctx).Completed
+}
+
+// CreateAdmin replaces the default administrator with the given account, or
+// creates it when no user exists. It fails once any other account exists, so
+// it cannot be used to take over an installation that is already in use.
+func (s *SetupService) CreateAdmin(ctx context.Context, req setup.Admin) (*models.User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.loadStateInternal(ctx).Completed {
+		return nil, ErrSetupCompleted
+	}
+
+	username := strings.TrimSpace(req.Username)
+	if username == "" {
+		return nil, fmt.Errorf("%w: username is required", ErrSetupInvalidValue)
+	}
+
+	// Hash password outside transaction to minimize lock time
+	hashedPassword, err := s.userService.HashPassword(req.Password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	var admin models.User
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		pending, err := s.adminPendingInternal(ctx, tx)
+		if err != nil {
+			return err
+		}
+		if !pending {
+			return ErrSetupAdminCreated
+		}
+
+		err = tx.Where("username = ?", defaultAdminUsername).First(&admin).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("failed to load default admin: %w", err)
+		}
+
+		admin.Username = username
+		admin.PasswordHash = hashedPassword
+		admin.DisplayName = req.DisplayName
+		admin.Email = req.Email
+		admin.Roles = models.StringSlice{"admin"}
+		admin.RequiresPasswordChange = false
+
+		if admin.ID == "" {
+			if err := tx.Create(&admin).Error; err != nil {
+				return fmt.Errorf("failed to create admin user: %w", err)
+			}
+			return nil
+		}
+		if err := tx.Save(&admin).Error; err != nil {
+			return fmt.Errorf("failed to update default admin: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.setStepStatusInternal(ctx, setup.StepAdmin, setup.StepStatusCompleted); err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "Administrator account created during setup", "username", admin.Username)
+	return &admin, nil
+}
+
+// SetProjectsDirectory validates and saves the projects directory. Unlike
+// settings updates, the directory is always checked on disk, since the
+// default has never been confirmed on a new installation.
+func (s *SetupService) SetProjectsDirectory(ctx context.Context, value string) (*setup.ProjectsDirectoryResult, error) {
+	if !s.IsRequired(ctx) {
+		return nil, ErrSetupCompleted
+	}
+
+	value = strings.TrimSpace(value)
+	warnings := make([]settings.ValidationIssue, 0)
+	for _, issue := range checkProjectsDirectoryInternal(value) {
+		if issue.Severity == settings.IssueSeverityError {
+			return nil, fmt.Errorf("%w: %s", ErrSetupInvalidValue, issue.Message)
+		}
+		warnings = append(warnings, issue)
+	}
+	if s.settingsService.isEnvOverrideActiveInternal("projectsDirectory") {
+		warnings = append(warnings, settings.ValidationIssue{
+			Key:      "projectsDirectory",
+			Severity: settings.IssueSeverityWarning,
+			Message:  "projectsDirectory is set by an environment variable; the saved value is ignored until it is removed",
+		})
+	}
+
+	if _, err := s.settingsService.UpdateSettings(ctx, settings.Update{ProjectsDirectory: &value}); err != nil {
+		return nil, fmt.Errorf("failed to save projects directory: %w", err)
+	}
+	if err := s.setStepStatus(ctx, setup.StepProjectsDirectory, setup.StepStatusCompleted); err != nil {
+		return nil, err
+	}
+
+	return &setup.ProjectsDirectoryResult{ProjectsDirectory: value, Warnings: warnings}, nil
+}
+
+// CheckDocker checks that Arcane can reach the Docker daemon. The step is
+// completed once a check succeeds.
+func (s *SetupService) CheckDocker(ctx context.Context) (*setup.DockerCheck, error) {
+	if !s.IsRequired(ctx) {
+		return nil, ErrSetupCompleted
+	}
+
+	result := &setup.DockerCheck{}
+	if s.dockerService.config != nil {
+		result.Host = s.dockerService.config.DockerHost
+	}
+
+	dockerClient, err := s.dockerService.GetClient(ctx)
+	if err != nil {
+		result.Error = dockerConnectionHintInternal(result.Host, err)
+		return result, nil
+	}
+	result.Host = dockerClient.DaemonHost()
+
+	info, err := dockerClient.Info(ctx, client.InfoOptions{})
+	if err != nil {
+		result.Error = dockerConnectionHintInternal(result.Host, err)
+		return result, nil
+	}
+
+	result.Connected = true
+	result.ServerVersion = info.Info.ServerVersion
+	result.APIVersion = dockerClient.ClientVersion()
+	result.OperatingSystem = info.Info.OperatingSystem
+
+	if err := s.setStepStatus(ctx, setup.StepDocker, setup.StepStatusCompleted); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// dockerConnectionHintInternal adds the usual fix for a Docker socket that is
+// missing or not accessible to the connection error.
+func dockerConnectionHintInternal(host string, err error) string {
+	msg := err.Error()
+	if strings.HasPrefix(host, "unix://") {
+		switch {
+		case strings.Contains(msg, "no such file or directory"):
+			return msg + ". Mount the Docker socket into the Arcane container, e.g. -v /var/run/docker.sock:/var/run/docker.sock"
+		case strings.Contains(msg, "permission denied"):
+			return msg + ". Run Arcane as a user that can access the Docker socket, e.g. by adding the docker group"
+		}
+	}
+	return msg
+}
+
+// CompleteStep records a step as completed, for steps whose action is
+// performed by another service such as adding a notification provider.
+func (s *SetupService) CompleteStep(ctx context.Context, step string) error {
+	if !s.IsRequired(ctx) {
+		return ErrSetupCompleted
+	}
+	return s.setStepStatus(ctx, step, setup.StepStatusCompleted)
+}
+
+// SkipStep records an optional step as skipped.
+func (s *SetupService) SkipStep(ctx context.Context, step string) error {
+	if !s.IsRequired(ctx) {
+		return ErrSetupCompleted
+	}
+
+	idx := setupStepIndexInternal(step)
+	if idx < 0 {
+		return ErrSetupStepUnknown
+	}
+	if !setupSteps[idx].Optional {
+		return ErrSetupStepNotOptional
+	}
+	return s.setStepStatus(ctx, step, setup.StepStatusSkipped)
+}
+
+// Complete finishes setup once every required step is done, which closes the
+// setup endpoints.
+func (s *SetupService) Complete(ctx context.Context) error {
+	status, err := s.GetStatus(ctx)
+	if err != nil {
+		return err
+	}
+	if !status.Required {
+		return ErrSetupCompleted
+	}
+
+	var missing []string
+	for _, step := range status.Steps {
+		if !step.Optional && step.Status != setup.StepStatusCompleted {
+			missing = append(missing, step.ID)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: %s", ErrSetupIncomplete, strings.Join(missing, ", "))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state := s.loadStateInternal(ctx)
+	state.Completed = true
+	if err := s.saveStateInternal(ctx, state); err != nil {
+		return err
+	}
+
+	slog.InfoContext(ctx, "First-run setup completed")
+	return nil
+}
+
+func (s *SetupService) setStepStatus(ctx context.Context, step, status string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.setStepStatusInternal(ctx, step, status)
+}
+
+// setStepStatusInternal must be called with s.mu held.
+func (s *SetupService) setStepStatusInternal(ctx context.Context, step, status string) error {
+	if setupStepIndexInternal(step) < 0 {
+		return ErrSetupStepUnknown
+	}
+
+	state := s.loadStateInternal(ctx)
+	if state.Steps == nil {
+		state.Steps = make(map[string]string)
+	}
+	state.Steps[step] = status
+	return s.saveStateInternal(ctx, state)
+}
+
+func (s *SetupService) loadStateInternal(ctx context.Context) setupStateInternal {
+	var state setupStateInternal
+	raw := s.settingsService.GetStringSetting(ctx, setupStateSettingKey, "")
+	if raw == "" {
+		return state
+	}
+	if err := json.Unmarshal([]byte(raw), &state); err != nil {
+		slog.WarnContext(ctx, "Ignoring invalid setup state", "error", err)
+		return setupStateInternal{}
+	}
+	return state
+}
+
+func (s *SetupService) saveStateInternal(ctx context.Context, state setupStateInternal) error {
+	raw, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("failed to encode setup state: %w", err)
+	}
+	if err := s.settingsService.SetStringSetting(ctx, setupStateSettingKey, string(raw)); err != nil {
+		return fmt.Errorf("failed to save setup state: %w", err)
+	}
+	return nil
+}
+
+// adminPendingInternal reports whether the administrator still has to be set
+// up: there are no users, or the only user is the default administrator that
+// never changed its password.
+func (s *SetupService) adminPendingInternal(ctx context.Context, tx *gorm.DB) (bool, error) {
+	var users []models.User
+	if err := tx.WithContext(ctx).Limit(2).Find(&users).Error; err != nil {
+		return false, fmt.Errorf("failed to list users: %w", err)
+	}
+
+	switch len(users) {
+	case 0:
+		return true, nil
+	case 1:
+		return users[0].Username == defaultAdminUsername && users[0].RequiresPasswordChange, nil
+	default:
+		return false, nil
+	}
+}
+
+func setupStepIndexInternal(step string) int {
+	for i, candidate := range setupSteps {
+		if candidate.ID == step {
+			return i
+		}
+	}
+	return -1
+}
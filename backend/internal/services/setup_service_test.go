@@ -0,0 +1,75 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	glsqlite "github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/getarcaneapp/arcane/backend/internal/database"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/types/setup"
+)
+
+func setupSetupTestDB(t *testing.T) *database.DB {
+	t.Helper()
+	db, err := gorm.Open(glsqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.User{}))
+	return &database.DB{DB: db}
+}
+
+func TestSetupService_AdminPending(t *testing.T) {
+	ctx := context.Background()
+	db := setupSetupTestDB(t)
+	svc := &SetupService{db: db}
+
+	pending, err := svc.adminPendingInternal(ctx, db.DB)
+	require.NoError(t, err)
+	require.True(t, pending, "no users means the admin must be created")
+
+	defaultAdmin := &models.User{Username: defaultAdminUsername, Roles: models.StringSlice{"admin"}, RequiresPasswordChange: true}
+	require.NoError(t, db.Create(defaultAdmin).Error)
+	pending, err = svc.adminPendingInternal(ctx, db.DB)
+	require.NoError(t, err)
+	require.True(t, pending, "the untouched default admin must be replaced")
+
+	require.NoError(t, db.Model(defaultAdmin).Update("requires_password_change", false).Error)
+	pending, err = svc.adminPendingInternal(ctx, db.DB)
+	require.NoError(t, err)
+	require.False(t, pending, "a default admin with a changed password is in use")
+
+	require.NoError(t, db.Model(defaultAdmin).Update("requires_password_change", true).Error)
+	require.NoError(t, db.Create(&models.User{Username: "alice", Roles: models.StringSlice{"viewer"}}).Error)
+	pending, err = svc.adminPendingInternal(ctx, db.DB)
+	require.NoError(t, err)
+	require.False(t, pending, "other accounts mean the installation is in use")
+}
+
+func TestSetupSteps(t *testing.T) {
+	require.Equal(t, 0, setupStepIndexInternal(setup.StepAdmin))
+	require.Equal(t, -1, setupStepIndexInternal("unknown"))
+
+	for _, step := range setupSteps {
+		switch step.ID {
+		case setup.StepNotification, setup.StepEnvironment:
+			require.True(t, step.Optional, step.ID)
+		default:
+			require.False(t, step.Optional, step.ID)
+		}
+	}
+}
+
+func TestDockerConnectionHint(t *testing.T) {
+	err := errors.New("dial unix /var/run/docker.sock: connect: no such file or directory")
+	require.Contains(t, dockerConnectionHintInternal("unix:///var/run/docker.sock", err), "Mount the Docker socket")
+
+	err = errors.New("dial unix /var/run/docker.sock: connect: permission denied")
+	require.Contains(t, dockerConnectionHintInternal("unix:///var/run/docker.sock", err), "docker group")
+
+	err = errors.New("connection refused")
+	require.Equal(t, "connection refused", dockerConnectionHintInternal("tcp://10.0.0.5:2375", err))
+}
@@ -0,0 +1,367 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	dockerutils "github.com/getarcaneapp/arcane/backend/internal/utils/docker"
+	"github.com/getarcaneapp/arcane/types/staleresource"
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/api/types/image"
+	"github.com/moby/moby/api/types/mount"
+	"github.com/moby/moby/api/types/network"
+	"github.com/moby/moby/api/types/volume"
+	"github.com/moby/moby/client"
+)
+
+var ErrStaleResourceNotReported = errors.New("resource is not part of the current stale resource report")
+
+const (
+	defaultStaleContainerDays = 30
+	defaultStaleImageDays     = 90
+)
+
+// staleResourceKindOrder is the order kinds are listed in a report.
+var staleResourceKindOrder = []string{
+	staleresource.KindContainer,
+	staleresource.KindVolume,
+	staleresource.KindNetwork,
+	staleresource.KindImage,
+}
+
+// StaleResourceService finds containers, volumes, networks and images that
+// look abandoned and removes the ones a user picks from the report.
+type StaleResourceService struct {
+	dockerService    *DockerClientService
+	settingsService  *SettingsService
+	containerService *ContainerService
+	volumeService    *VolumeService
+	networkService   *NetworkService
+	imageService     *ImageService
+
+	mu     sync.Mutex
+	report *staleresource.Inventory
+}
+
+// staleResourceInventory is what the analyzer looks at.
+type staleResourceInventory struct {
+	containers []container.Summary
+	stoppedAt  map[string]time.Time // container ID -> when it stopped
+	volumes    []volume.Volume
+	networks   []network.Summary
+	images     []image.Summary
+}
+
+func NewStaleResourceService(dockerService *DockerClientService, settingsService *SettingsService, containerService *ContainerService, volumeService *VolumeService, networkService *NetworkService, imageService *ImageService) *StaleResourceService {
+	return &StaleResourceService{
+		dockerService:    dockerService,
+		settingsService:  settingsService,
+		containerService: containerService,
+		volumeService:    volumeService,
+		networkService:   networkService,
+		imageService:     imageService,
+	}
+}
+
+// GetReport returns the report of the last analysis, running one first if
+// there is none yet.
+func (s *StaleResourceService) GetReport(ctx context.Context) (*staleresource.Inventory, error) {
+	s.mu.Lock()
+	report := s.report
+	s.mu.Unlock()
+	if report != nil {
+		return cloneStaleResourceReport(report), nil
+	}
+	return s.Analyze(ctx)
+}
+
+// Analyze inspects the Docker host and replaces the current report.
+func (s *StaleResourceService) Analyze(ctx context.Context) (*staleresource.Inventory, error) {
+	inv, err := s.collectInventoryInternal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	containerDays := s.settingsService.GetIntSetting(ctx, "staleContainerDays", defaultStaleContainerDays)
+	if containerDays <= 0 {
+		containerDays = defaultStaleContainerDays
+	}
+	imageDays := s.settingsService.GetIntSetting(ctx, "staleImageDays", defaultStaleImageDays)
+	if imageDays <= 0 {
+		imageDays = defaultStaleImageDays
+	}
+
+	now := time.Now()
+	report := &staleresource.Inventory{
+		GeneratedAt:   now,
+		ContainerDays: containerDays,
+		ImageDays:     imageDays,
+		Items:         findStaleResourcesInternal(inv, containerDays, imageDays, now),
+	}
+
+	s.mu.Lock()
+	s.report = report
+	s.mu.Unlock()
+	return cloneStaleResourceReport(report), nil
+}
+
+// Cleanup removes the given resources, which must be part of the current
+// report. Resources are removed without force, so Docker still refuses to
+// remove anything that started being used since the analysis.
+func (s *StaleResourceService) Cleanup(ctx context.Context, refs []staleresource.ItemRef, user models.User) (*staleresource.CleanupResult, error) {
+	s.mu.Lock()
+	report := s.report
+	s.mu.Unlock()
+
+	result := &staleresource.CleanupResult{
+		Removed: []staleresource.ItemRef{},
+		Failed:  []staleresource.CleanupFailure{},
+	}
+	removed := make(map[staleresource.ItemRef]struct{}, len(refs))
+	for _, ref := range refs {
+		if _, done := removed[ref]; done {
+			continue
+		}
+
+		err := ErrStaleResourceNotReported
+		if report != nil && slices.ContainsFunc(report.Items, func(item staleresource.Item) bool {
+			return item.Kind == ref.Kind && item.ID == ref.ID
+		}) {
+			err = s.removeInternal(ctx, ref, user)
+		}
+		if err != nil {
+			result.Failed = append(result.Failed, staleresource.CleanupFailure{Kind: ref.Kind, ID: ref.ID, Error: err.Error()})
+			continue
+		}
+		removed[ref] = struct{}{}
+		result.Removed = append(result.Removed, ref)
+	}
+
+	if len(removed) > 0 {
+		s.mu.Lock()
+		if s.report == report && report != nil {
+			next := cloneStaleResourceReport(report)
+			next.Items = slices.DeleteFunc(next.Items, func(item staleresource.Item) bool {
+				_, ok := removed[staleresource.ItemRef{Kind: item.Kind, ID: item.ID}]
+				return ok
+			})
+			s.report = next
+		}
+		s.mu.Unlock()
+	}
+
+	slog.InfoContext(ctx, "Stale resource cleanup completed", "removed", len(result.Removed), "failed", len(result.Failed))
+	return result, nil
+}
+
+func (s *StaleResourceService) removeInternal(ctx context.Context, ref staleresource.ItemRef, user models.User) error {
+	switch ref.Kind {
+	case staleresource.KindContainer:
+		return s.containerService.DeleteContainer(ctx, ref.ID, false, false, user)
+	case staleresource.KindVolume:
+		return s.volumeService.DeleteVolume(ctx, ref.ID, false, user)
+	case staleresource.KindNetwork:
+		return s.networkService.RemoveNetwork(ctx, ref.ID, user)
+	case staleresource.KindImage:
+		return s.imageService.RemoveImage(ctx, ref.ID, false, user)
+	default:
+		return fmt.Errorf("unsupported resource kind %q", ref.Kind)
+	}
+}
+
+func (s *StaleResourceService) collectInventoryInternal(ctx context.Context) (staleResourceInventory, error) {
+	var inv staleResourceInventory
+
+	dockerClient, err := s.dockerService.GetClient(ctx)
+	if err != nil {
+		return inv, fmt.Errorf("failed to connect to Docker: %w", err)
+	}
+
+	containerList, err := dockerClient.ContainerList(ctx, client.ContainerListOptions{All: true})
+	if err != nil {
+		return inv, fmt.Errorf("failed to list Docker containers: %w", err)
+	}
+	inv.containers = containerList.Items
+
+	inv.stoppedAt = make(map[string]time.Time)
+	for _, c := range inv.containers {
+		if c.State == "running" || c.State == "paused" || c.State == "restarting" {
+			continue
+		}
+		inspect, err := dockerClient.ContainerInspect(ctx, c.ID, client.ContainerInspectOptions{})
+		if err != nil {
+			// The container may have been removed since it was listed.
+			slog.DebugContext(ctx, "Skipping container in stale resource analysis", "container", c.ID, "error", err)
+			continue
+		}
+		if inspect.Container.State == nil {
+			continue
+		}
+		if finishedAt, err := time.Parse(time.RFC3339Nano, inspect.Container.State.FinishedAt); err == nil && !finishedAt.IsZero() {
+			inv.stoppedAt[c.ID] = finishedAt
+		}
+	}
+
+	volumeList, err := dockerClient.VolumeList(ctx, client.VolumeListOptions{})
+	if err != nil {
+		return inv, fmt.Errorf("failed to list Docker volumes: %w", err)
+	}
+	inv.volumes = volumeList.Items
+
+	networkList, err := dockerClient.NetworkList(ctx, client.NetworkListOptions{})
+	if err != nil {
+		return inv, fmt.Errorf("failed to list Docker networks: %w", err)
+	}
+	inv.networks = networkList.Items
+
+	imageList, err := dockerClient.ImageList(ctx, client.ImageListOptions{})
+	if err != nil {
+		return inv, fmt.Errorf("failed to list Docker images: %w", err)
+	}
+	inv.images = imageList.Items
+
+	return inv, nil
+}
+
+// findStaleResourcesInternal reports containers stopped for at least
+// containerDays, volumes no container mounts, user-defined networks no
+// container is attached to, and images no container uses that are at least
+// imageDays old. Containers that never ran count as stopped since creation.
+func findStaleResourcesInternal(inv staleResourceInventory, containerDays, imageDays int, now time.Time) []staleresource.Item {
+	items := []staleresource.Item{}
+	containerCutoff := now.AddDate(0, 0, -containerDays)
+	imageCutoff := now.AddDate(0, 0, -imageDays)
+
+	mountedVolumes := make(map[string]struct{})
+	attachedNetworks := make(map[string]struct{})
+	usedImages := make(map[string]struct{})
+	for _, c := range inv.containers {
+		for _, m := range c.Mounts {
+			if m.Type == mount.TypeVolume && m.Name != "" {
+				mountedVolumes[m.Name] = struct{}{}
+			}
+		}
+		if c.NetworkSettings != nil {
+			for name, es := range c.NetworkSettings.Networks {
+				attachedNetworks[name] = struct{}{}
+				if es != nil && es.NetworkID != "" {
+					attachedNetworks[es.NetworkID] = struct{}{}
+				}
+			}
+		}
+		if c.ImageID != "" {
+			usedImages[c.ImageID] = struct{}{}
+		}
+
+		if c.State == "running" || c.State == "paused" || c.State == "restarting" {
+			continue
+		}
+		stoppedAt, ok := inv.stoppedAt[c.ID]
+		if !ok {
+			stoppedAt = time.Unix(c.Created, 0)
+		}
+		if stoppedAt.After(containerCutoff) {
+			continue
+		}
+		name := c.ID
+		if len(c.Names) > 0 {
+			name = strings.TrimPrefix(c.Names[0], "/")
+		}
+		items = append(items, staleresource.Item{
+			Kind:   staleresource.KindContainer,
+			ID:     c.ID,
+			Name:   name,
+			Reason: fmt.Sprintf("Stopped for %d days", daysBetweenInternal(stoppedAt, now)),
+			Since:  new(stoppedAt),
+		})
+	}
+
+	for _, v := range inv.volumes {
+		if _, ok := mountedVolumes[v.Name]; ok {
+			continue
+		}
+		item := staleresource.Item{
+			Kind:   staleresource.KindVolume,
+			ID:     v.Name,
+			Name:   v.Name,
+			Reason: "Not mounted by any container",
+		}
+		if createdAt, err := time.Parse(time.RFC3339, v.CreatedAt); err == nil {
+			item.Since = &createdAt
+		}
+		if v.UsageData != nil && v.UsageData.Size > 0 {
+			item.SizeBytes = v.UsageData.Size
+		}
+		items = append(items, item)
+	}
+
+	for _, n := range inv.networks {
+		if dockerutils.IsDefaultNetwork(n.Name) || n.Name == "docker_gwbridge" {
+			continue
+		}
+		if _, ok := attachedNetworks[n.ID]; ok {
+			continue
+		}
+		if _, ok := attachedNetworks[n.Name]; ok {
+			continue
+		}
+		item := staleresource.Item{
+			Kind:   staleresource.KindNetwork,
+			ID:     n.ID,
+			Name:   n.Name,
+			Reason: "No containers attached",
+		}
+		if !n.Created.IsZero() {
+			item.Since = new(n.Created)
+		}
+		items = append(items, item)
+	}
+
+	for _, img := range inv.images {
+		if _, ok := usedImages[img.ID]; ok {
+			continue
+		}
+		createdAt := time.Unix(img.Created, 0)
+		if createdAt.After(imageCutoff) {
+			continue
+		}
+		name := "<none>"
+		if len(img.RepoTags) > 0 && img.RepoTags[0] != "<none>:<none>" {
+			name = img.RepoTags[0]
+		}
+		items = append(items, staleresource.Item{
+			Kind:      staleresource.KindImage,
+			ID:        img.ID,
+			Name:      name,
+			Reason:    fmt.Sprintf("Unused, created %d days ago", daysBetweenInternal(createdAt, now)),
+			Since:     &createdAt,
+			SizeBytes: img.Size,
+		})
+	}
+
+	slices.SortStableFunc(items, func(a, b staleresource.Item) int {
+		return cmp.Or(
+			cmp.Compare(slices.Index(staleResourceKindOrder, a.Kind), slices.Index(staleResourceKindOrder, b.Kind)),
+			cmp.Compare(a.Name, b.Name),
+		)
+	})
+	return items
+}
+
+func daysBetweenInternal(from, to time.Time) int {
+	return int(to.Sub(from).Hours() / 24)
+}
+
+func cloneStaleResourceReport(report *staleresource.Inventory) *staleresource.Inventory {
+	clone := *report
+	clone.Items = slices.Clone(report.Items)
+	return &clone
+}
@@ -0,0 +1,78 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/getarcaneapp/arcane/types/staleresource"
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/api/types/image"
+	"github.com/moby/moby/api/types/mount"
+	"github.com/moby/moby/api/types/network"
+	"github.com/moby/moby/api/types/volume"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindStaleResourcesInternal(t *testing.T) {
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	daysAgo := func(days int) time.Time { return now.AddDate(0, 0, -days) }
+
+	inv := staleResourceInventory{
+		containers: []container.Summary{
+			{
+				ID:      "running",
+				Names:   []string{"/web"},
+				State:   "running",
+				ImageID: "sha256:nginx",
+				Mounts:  []container.MountPoint{{Type: mount.TypeVolume, Name: "web-data"}},
+				NetworkSettings: &container.NetworkSettingsSummary{Networks: map[string]*network.EndpointSettings{
+					"site_default": {NetworkID: "net-site"},
+				}},
+			},
+			{ID: "old", Names: []string{"/old-worker"}, State: "exited", ImageID: "sha256:worker", Created: daysAgo(100).Unix()},
+			{ID: "recent", Names: []string{"/recent-job"}, State: "exited", ImageID: "sha256:job", Created: daysAgo(100).Unix()},
+			{ID: "never-started", Names: []string{"/draft"}, State: "created", Created: daysAgo(40).Unix()},
+		},
+		stoppedAt: map[string]time.Time{
+			"old":    daysAgo(45),
+			"recent": daysAgo(2),
+		},
+		volumes: []volume.Volume{
+			{Name: "web-data"},
+			{Name: "orphaned", CreatedAt: daysAgo(10).Format(time.RFC3339), UsageData: &volume.UsageData{Size: 2048}},
+		},
+		networks: []network.Summary{
+			{Network: network.Network{ID: "net-bridge", Name: "bridge"}},
+			{Network: network.Network{ID: "net-site", Name: "site_default"}},
+			{Network: network.Network{ID: "net-empty", Name: "legacy_default", Created: daysAgo(200)}},
+		},
+		images: []image.Summary{
+			{ID: "sha256:nginx", RepoTags: []string{"nginx:latest"}, Created: daysAgo(300).Unix()},
+			{ID: "sha256:worker", RepoTags: []string{"worker:1"}, Created: daysAgo(300).Unix()},
+			{ID: "sha256:old-unused", RepoTags: []string{"app:0.9"}, Created: daysAgo(120).Unix(), Size: 1000},
+			{ID: "sha256:new-unused", RepoTags: []string{"app:1.0"}, Created: daysAgo(5).Unix()},
+			{ID: "sha256:dangling", RepoTags: []string{"<none>:<none>"}, Created: daysAgo(95).Unix()},
+		},
+	}
+
+	items := findStaleResourcesInternal(inv, 30, 90, now)
+
+	refs := make([]staleresource.ItemRef, len(items))
+	for i, item := range items {
+		refs[i] = staleresource.ItemRef{Kind: item.Kind, ID: item.ID}
+	}
+	require.Equal(t, []staleresource.ItemRef{
+		{Kind: staleresource.KindContainer, ID: "never-started"},
+		{Kind: staleresource.KindContainer, ID: "old"},
+		{Kind: staleresource.KindVolume, ID: "orphaned"},
+		{Kind: staleresource.KindNetwork, ID: "net-empty"},
+		{Kind: staleresource.KindImage, ID: "sha256:dangling"},
+		{Kind: staleresource.KindImage, ID: "sha256:old-unused"},
+	}, refs)
+
+	require.Equal(t, "Stopped for 45 days", items[1].Reason)
+	require.Equal(t, daysAgo(45), *items[1].Since)
+	require.Equal(t, int64(2048), items[2].SizeBytes)
+	require.Equal(t, "<none>", items[4].Name)
+	require.Equal(t, int64(1000), items[5].SizeBytes)
+}
@@ -0,0 +1,207 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/getarcaneapp/arcane/backend/pkg/libarcane"
+	"github.com/getarcaneapp/arcane/types/system"
+	"github.com/moby/moby/api/types/build"
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/api/types/image"
+	"github.com/moby/moby/api/types/mount"
+	"github.com/moby/moby/api/types/network"
+	"github.com/moby/moby/api/types/volume"
+	"github.com/moby/moby/client"
+)
+
+// Networks Docker creates itself and never prunes.
+var predefinedNetworkNames = []string{"bridge", "host", "none"}
+
+// PreviewPruneAll reports what PruneAll would remove for the same request
+// without removing anything. Docker has no dry run for prune, so the candidates
+// are worked out from its disk usage data with the rules the daemon applies,
+// in the same order: stopped containers go first and no longer keep their
+// images, volumes and networks in use.
+//
+// Image space is an estimate: layers shared only between pruned images are not
+// counted.
+func (s *SystemService) PreviewPruneAll(ctx context.Context, req system.PruneAllRequest) (*system.PruneAllResult, error) {
+	dockerClient, err := s.dockerService.GetClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to Docker: %w", err)
+	}
+
+	usage, err := dockerClient.DiskUsage(ctx, client.DiskUsageOptions{
+		Containers: true,
+		Images:     req.Images,
+		Volumes:    req.Volumes,
+		BuildCache: req.BuildCache,
+		Verbose:    true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get disk usage: %w", err)
+	}
+
+	protectionLabel := s.settingsService.GetStringSetting(ctx, "pruneProtectionLabel", "")
+	result := &system.PruneAllResult{Success: true, DryRun: true}
+
+	remaining := usage.Containers.Items
+	if req.Containers {
+		remaining = previewContainerPruneInternal(usage.Containers.Items, protectionLabel, result)
+	}
+	if req.Images {
+		previewImagePruneInternal(usage.Images.Items, remaining, req.Dangling, protectionLabel, result)
+	}
+	if req.Volumes {
+		previewVolumePruneInternal(usage.Volumes.Items, remaining, protectionLabel, result)
+	}
+	if req.Networks {
+		networks, err := dockerClient.NetworkList(ctx, client.NetworkListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list networks: %w", err)
+		}
+		previewNetworkPruneInternal(networks.Items, remaining, protectionLabel, result)
+	}
+	if req.BuildCache {
+		previewBuildCachePruneInternal(usage.BuildCache.Items, !req.Dangling, result)
+	}
+
+	return result, nil
+}
+
+// previewContainerPruneInternal records the containers that are not running
+// and returns the ones a prune keeps.
+func previewContainerPruneInternal(containers []container.Summary, protectionLabel string, result *system.PruneAllResult) []container.Summary {
+	remaining := make([]container.Summary, 0, len(containers))
+	for _, c := range containers {
+		switch c.State {
+		case container.StateCreated, container.StateExited, container.StateDead:
+			if !libarcane.HasPruneProtection(c.Labels, protectionLabel) {
+				result.ContainersPruned = append(result.ContainersPruned, c.ID)
+				addPreviewSpaceInternal(result, &result.ContainerSpaceReclaimed, c.SizeRw)
+				continue
+			}
+		}
+		remaining = append(remaining, c)
+	}
+	return remaining
+}
+
+// previewImagePruneInternal records the images no remaining container uses,
+// limited to untagged images when danglingOnly is set.
+func previewImagePruneInternal(images []image.Summary, containers []container.Summary, danglingOnly bool, protectionLabel string, result *system.PruneAllResult) {
+	inUse := make(map[string]struct{}, len(containers))
+	for _, c := range containers {
+		inUse[c.ImageID] = struct{}{}
+	}
+
+	for _, img := range images {
+		if _, used := inUse[img.ID]; used {
+			continue
+		}
+		if danglingOnly && !isDanglingImageInternal(img) {
+			continue
+		}
+		if libarcane.HasPruneProtection(img.Labels, protectionLabel) {
+			continue
+		}
+
+		result.ImagesDeleted = append(result.ImagesDeleted, img.ID)
+		size := img.Size
+		if img.SharedSize > 0 {
+			size -= img.SharedSize
+		}
+		addPreviewSpaceInternal(result, &result.ImageSpaceReclaimed, size)
+	}
+}
+
+func isDanglingImageInternal(img image.Summary) bool {
+	for _, tag := range img.RepoTags {
+		if tag != "<none>:<none>" {
+			return false
+		}
+	}
+	return true
+}
+
+// previewVolumePruneInternal records the volumes no remaining container mounts.
+func previewVolumePruneInternal(volumes []volume.Volume, containers []container.Summary, protectionLabel string, result *system.PruneAllResult) {
+	mounted := make(map[string]struct{})
+	for _, c := range containers {
+		for _, m := range c.Mounts {
+			if m.Type == mount.TypeVolume {
+				mounted[m.Name] = struct{}{}
+			}
+		}
+	}
+
+	for _, v := range volumes {
+		if _, used := mounted[v.Name]; used {
+			continue
+		}
+		if libarcane.HasPruneProtection(v.Labels, protectionLabel) {
+			continue
+		}
+
+		result.VolumesDeleted = append(result.VolumesDeleted, v.Name)
+		if v.UsageData != nil {
+			addPreviewSpaceInternal(result, &result.VolumeSpaceReclaimed, v.UsageData.Size)
+		}
+	}
+}
+
+// previewNetworkPruneInternal records the user-defined networks no running
+// container is attached to. Stopped containers hold no endpoints, so they do
+// not keep a network.
+func previewNetworkPruneInternal(networks []network.Summary, containers []container.Summary, protectionLabel string, result *system.PruneAllResult) {
+	attached := make(map[string]struct{})
+	for _, c := range containers {
+		if c.State != container.StateRunning && c.State != container.StatePaused && c.State != container.StateRestarting {
+			continue
+		}
+		if c.NetworkSettings == nil {
+			continue
+		}
+		for _, endpoint := range c.NetworkSettings.Networks {
+			if endpoint != nil {
+				attached[endpoint.NetworkID] = struct{}{}
+			}
+		}
+	}
+
+	for _, n := range networks {
+		if n.Ingress || slices.Contains(predefinedNetworkNames, n.Name) {
+			continue
+		}
+		if _, used := attached[n.ID]; used {
+			continue
+		}
+		if libarcane.HasPruneProtection(n.Labels, protectionLabel) {
+			continue
+		}
+		result.NetworksDeleted = append(result.NetworksDeleted, n.Name)
+	}
+}
+
+// previewBuildCachePruneInternal adds the build cache a prune would free. Without
+// all, cache records shared with other records are kept.
+func previewBuildCachePruneInternal(records []build.CacheRecord, all bool, result *system.PruneAllResult) {
+	for _, record := range records {
+		if record.InUse || (!all && record.Shared) {
+			continue
+		}
+		addPreviewSpaceInternal(result, &result.BuildCacheSpaceReclaimed, record.Size)
+	}
+}
+
+// addPreviewSpaceInternal adds size to the per-type and total space. Docker
+// reports -1 when a size is not known.
+func addPreviewSpaceInternal(result *system.PruneAllResult, typeTotal *uint64, size int64) {
+	if size <= 0 {
+		return
+	}
+	*typeTotal += uint64(size)
+	result.SpaceReclaimed += uint64(size)
+}
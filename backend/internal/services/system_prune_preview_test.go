@@ -0,0 +1,98 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/getarcaneapp/arcane/types/system"
+	"github.com/moby/moby/api/types/build"
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/api/types/image"
+	"github.com/moby/moby/api/types/mount"
+	"github.com/moby/moby/api/types/network"
+	"github.com/moby/moby/api/types/volume"
+	"github.com/stretchr/testify/require"
+)
+
+const testProtectionLabel = "com.getarcaneapp.arcane.prune-protect"
+
+func TestPreviewPrune_StoppedContainersReleaseResources(t *testing.T) {
+	containers := []container.Summary{
+		{
+			ID: "running", State: container.StateRunning, ImageID: "sha256:web",
+			Mounts:          []container.MountPoint{{Type: mount.TypeVolume, Name: "web-data"}},
+			NetworkSettings: &container.NetworkSettingsSummary{Networks: map[string]*network.EndpointSettings{"front": {NetworkID: "net-front"}}},
+		},
+		{
+			ID: "stopped", State: container.StateExited, ImageID: "sha256:old", SizeRw: 100,
+			Mounts:          []container.MountPoint{{Type: mount.TypeVolume, Name: "old-data"}},
+			NetworkSettings: &container.NetworkSettingsSummary{Networks: map[string]*network.EndpointSettings{"back": {NetworkID: "net-back"}}},
+		},
+		{
+			ID: "kept", State: container.StateExited, ImageID: "sha256:kept", SizeRw: 50,
+			Labels: map[string]string{testProtectionLabel: "true"},
+		},
+	}
+	result := &system.PruneAllResult{}
+
+	remaining := previewContainerPruneInternal(containers, testProtectionLabel, result)
+	require.Equal(t, []string{"stopped"}, result.ContainersPruned)
+	require.EqualValues(t, 100, result.ContainerSpaceReclaimed)
+	require.Len(t, remaining, 2)
+
+	previewImagePruneInternal([]image.Summary{
+		{ID: "sha256:web", RepoTags: []string{"web:1"}, Size: 1000},
+		{ID: "sha256:old", RepoTags: []string{"old:1"}, Size: 500, SharedSize: 200},
+		{ID: "sha256:kept", Size: 300},
+		{ID: "sha256:base", RepoTags: []string{"base:1"}, Size: 400, Labels: map[string]string{testProtectionLabel: ""}},
+	}, remaining, false, testProtectionLabel, result)
+	require.Equal(t, []string{"sha256:old"}, result.ImagesDeleted)
+	require.EqualValues(t, 300, result.ImageSpaceReclaimed, "shared layers are not counted")
+
+	previewVolumePruneInternal([]volume.Volume{
+		{Name: "web-data", UsageData: &volume.UsageData{Size: 10}},
+		{Name: "old-data", UsageData: &volume.UsageData{Size: 20}},
+		{Name: "unknown-size", UsageData: &volume.UsageData{Size: -1}},
+		{Name: "backups", Labels: map[string]string{testProtectionLabel: "true"}},
+	}, remaining, testProtectionLabel, result)
+	require.Equal(t, []string{"old-data", "unknown-size"}, result.VolumesDeleted)
+	require.EqualValues(t, 20, result.VolumeSpaceReclaimed)
+
+	previewNetworkPruneInternal([]network.Summary{
+		{Network: network.Network{ID: "net-front", Name: "front"}},
+		{Network: network.Network{ID: "net-back", Name: "back"}},
+		{Network: network.Network{ID: "net-bridge", Name: "bridge"}},
+		{Network: network.Network{ID: "net-shared", Name: "shared", Labels: map[string]string{testProtectionLabel: "true"}}},
+	}, remaining, testProtectionLabel, result)
+	require.Equal(t, []string{"back"}, result.NetworksDeleted)
+
+	require.EqualValues(t, 420, result.SpaceReclaimed)
+}
+
+func TestPreviewPrune_DanglingImagesOnly(t *testing.T) {
+	result := &system.PruneAllResult{}
+
+	previewImagePruneInternal([]image.Summary{
+		{ID: "sha256:tagged", RepoTags: []string{"app:1"}, Size: 100},
+		{ID: "sha256:untagged", Size: 200},
+		{ID: "sha256:none", RepoTags: []string{"<none>:<none>"}, Size: 300},
+	}, nil, true, "", result)
+
+	require.Equal(t, []string{"sha256:untagged", "sha256:none"}, result.ImagesDeleted)
+	require.EqualValues(t, 500, result.SpaceReclaimed)
+}
+
+func TestPreviewPrune_BuildCache(t *testing.T) {
+	records := []build.CacheRecord{
+		{ID: "a", Size: 10},
+		{ID: "b", Size: 20, Shared: true},
+		{ID: "c", Size: 40, InUse: true},
+	}
+
+	result := &system.PruneAllResult{}
+	previewBuildCachePruneInternal(records, false, result)
+	require.EqualValues(t, 10, result.BuildCacheSpaceReclaimed)
+
+	result = &system.PruneAllResult{}
+	previewBuildCachePruneInternal(records, true, result)
+	require.EqualValues(t, 30, result.BuildCacheSpaceReclaimed)
+}
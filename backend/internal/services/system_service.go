@@ -59,11 +59,12 @@ func (s *SystemService) PruneAll(ctx context.Context, req system.PruneAllRequest
 
 	result := &system.PruneAllResult{Success: true}
 	var mu sync.Mutex
+	protectionLabel := s.settingsService.GetStringSetting(ctx, "pruneProtectionLabel", "")
 
 	// 1. Prune Containers first (sequential) as it may free up other resources
 	if req.Containers {
 		slog.InfoContext(ctx, "Pruning stopped containers...")
-		if err := s.pruneContainers(ctx, result); err != nil {
+		if err := s.pruneContainers(ctx, protectionLabel, result); err != nil {
 			result.Errors = append(result.Errors, fmt.Sprintf("Container pruning failed: %v", err))
 			result.Success = false
 		}
@@ -81,7 +82,7 @@ func (s *SystemService) PruneAll(ctx context.Context, req system.PruneAllRequest
 
 			slog.InfoContext(groupCtx, "Pruning images...", "dangling_only", danglingOnly)
 			localResult := &system.PruneAllResult{}
-			if err := s.pruneImages(groupCtx, danglingOnly, localResult); err != nil {
+			if err := s.pruneImages(groupCtx, danglingOnly, protectionLabel, localResult); err != nil {
 				mu.Lock()
 				result.Errors = append(result.Errors, fmt.Sprintf("Image pruning failed: %v", err))
 				result.Success = false
@@ -118,7 +119,7 @@ func (s *SystemService) PruneAll(ctx context.Context, req system.PruneAllRequest
 		g.Go(func() error {
 			slog.InfoContext(groupCtx, "Pruning unused volumes...")
 			localResult := &system.PruneAllResult{}
-			if err := s.pruneVolumes(groupCtx, localResult); err != nil {
+			if err := s.pruneVolumes(groupCtx, protectionLabel, localResult); err != nil {
 				mu.Lock()
 				result.Errors = append(result.Errors, fmt.Sprintf("Volume pruning failed: %v", err))
 				result.Success = false
@@ -138,7 +139,7 @@ func (s *SystemService) PruneAll(ctx context.Context, req system.PruneAllRequest
 		g.Go(func() error {
 			slog.InfoContext(groupCtx, "Pruning unused networks...")
 			localResult := &system.PruneAllResult{}
-			if err := s.pruneNetworks(groupCtx, localResult); err != nil {
+			if err := s.pruneNetworks(groupCtx, protectionLabel, localResult); err != nil {
 				mu.Lock()
 				result.Errors = append(result.Errors, fmt.Sprintf("Network pruning failed: %v", err))
 				result.Success = false
@@ -264,13 +265,24 @@ func (s *SystemService) StopAllContainers(ctx context.Context) (*containertypes.
 		}), nil
 }
 
-func (s *SystemService) pruneContainers(ctx context.Context, result *system.PruneAllResult) error {
+// pruneFiltersInternal returns new prune filters that skip resources carrying
+// the protection label. Docker adds to filters in place, so every prune call
+// needs its own.
+func pruneFiltersInternal(protectionLabel string) client.Filters {
+	filterArgs := make(client.Filters)
+	if protectionLabel != "" {
+		filterArgs = filterArgs.Add("label!", protectionLabel)
+	}
+	return filterArgs
+}
+
+func (s *SystemService) pruneContainers(ctx context.Context, protectionLabel string, result *system.PruneAllResult) error {
 	dockerClient, err := s.dockerService.GetClient(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to connect to Docker: %w", err)
 	}
 
-	filterArgs := make(client.Filters)
+	filterArgs := pruneFiltersInternal(protectionLabel)
 
 	report, err := dockerClient.ContainerPrune(ctx, client.ContainerPruneOptions{Filters: filterArgs})
 	if err != nil {
@@ -283,7 +295,7 @@ func (s *SystemService) pruneContainers(ctx context.Context, result *system.Prun
 	return nil
 }
 
-func (s *SystemService) pruneImages(ctx context.Context, danglingOnly bool, result *system.PruneAllResult) error {
+func (s *SystemService) pruneImages(ctx context.Context, danglingOnly bool, protectionLabel string, result *system.PruneAllResult) error {
 	slog.DebugContext(ctx, "Starting image pruning", "dangling_only", danglingOnly)
 
 	dockerClient, err := s.dockerService.GetClient(ctx)
@@ -291,15 +303,13 @@ func (s *SystemService) pruneImages(ctx context.Context, danglingOnly bool, resu
 		return fmt.Errorf("failed to connect to Docker: %w", err)
 	}
 
-	var filterArgs client.Filters
+	filterArgs := pruneFiltersInternal(protectionLabel)
 
 	if danglingOnly {
 		slog.DebugContext(ctx, "Configured to prune only dangling images")
-		filterArgs = make(client.Filters)
 		filterArgs = filterArgs.Add("dangling", "true")
 	} else {
 		slog.DebugContext(ctx, "Configured to prune all unused images (including non-dangling)")
-		filterArgs = make(client.Filters)
 		filterArgs = filterArgs.Add("dangling", "false")
 	}
 
@@ -360,13 +370,13 @@ func (s *SystemService) pruneBuildCache(ctx context.Context, result *system.Prun
 	return nil
 }
 
-func (s *SystemService) pruneVolumes(ctx context.Context, result *system.PruneAllResult) error {
+func (s *SystemService) pruneVolumes(ctx context.Context, protectionLabel string, result *system.PruneAllResult) error {
 	// Prune ALL unused volumes (both named and anonymous)
 	// Note: Docker API only prunes volumes that are NOT in use by any containers (running or stopped)
 	// With all=true, it will remove both named and anonymous unused volumes
 	// With all=false, it only removes anonymous (unnamed) unused volumes
 	allVolumes := true
-	report, err := s.volumeService.PruneVolumesWithOptions(ctx, allVolumes)
+	report, err := s.volumeService.PruneVolumesWithOptions(ctx, allVolumes, pruneFiltersInternal(protectionLabel))
 	if err != nil {
 		return err
 	}
@@ -379,9 +389,9 @@ func (s *SystemService) pruneVolumes(ctx context.Context, result *system.PruneAl
 	return nil
 }
 
-func (s *SystemService) pruneNetworks(ctx context.Context, result *system.PruneAllResult) error {
+func (s *SystemService) pruneNetworks(ctx context.Context, protectionLabel string, result *system.PruneAllResult) error {
 	// Note: Docker API only prunes networks that are NOT in use by any containers
-	report, err := s.networkService.PruneNetworks(ctx)
+	report, err := s.networkService.PruneNetworksWithFilters(ctx, pruneFiltersInternal(protectionLabel))
 	if err != nil {
 		return err
 	}
@@ -435,82 +445,7 @@ func (s *SystemService) ConvertToDockerCompose(parsed *models.DockerRunCommand)
 		serviceName = "app"
 	}
 
-	service := models.DockerComposeService{
-		Image: parsed.Image,
-	}
-
-	if parsed.Name != "" {
-		service.ContainerName = parsed.Name
-	}
-
-	if len(parsed.Ports) > 0 {
-		service.Ports = parsed.Ports
-	}
-
-	if len(parsed.Volumes) > 0 {
-		service.Volumes = parsed.Volumes
-	}
-
-	if len(parsed.Environment) > 0 {
-		service.Environment = parsed.Environment
-	}
-
-	if len(parsed.Networks) > 0 {
-		service.Networks = parsed.Networks
-	}
-
-	if parsed.Restart != "" {
-		service.Restart = parsed.Restart
-	}
-
-	if parsed.Workdir != "" {
-		service.WorkingDir = parsed.Workdir
-	}
-
-	if parsed.User != "" {
-		service.User = parsed.User
-	}
-
-	if parsed.Entrypoint != "" {
-		service.Entrypoint = parsed.Entrypoint
-	}
-
-	if parsed.Command != "" {
-		service.Command = parsed.Command
-	}
-
-	if parsed.Interactive && parsed.TTY {
-		service.StdinOpen = true
-		service.TTY = true
-	}
-
-	if parsed.Privileged {
-		service.Privileged = true
-	}
-
-	if len(parsed.Labels) > 0 {
-		service.Labels = parsed.Labels
-	}
-
-	if parsed.HealthCheck != "" {
-		service.Healthcheck = &models.DockerComposeHealthcheck{
-			Test: parsed.HealthCheck,
-		}
-	}
-
-	if parsed.MemoryLimit != "" || parsed.CPULimit != "" {
-		service.Deploy = &models.DockerComposeDeploy{
-			Resources: &models.DockerComposeResources{
-				Limits: &models.DockerComposeResourceLimits{},
-			},
-		}
-		if parsed.MemoryLimit != "" {
-			service.Deploy.Resources.Limits.Memory = parsed.MemoryLimit
-		}
-		if parsed.CPULimit != "" {
-			service.Deploy.Resources.Limits.CPUs = parsed.CPULimit
-		}
-	}
+	service := converter.ToComposeService(parsed)
 
 	compose := models.DockerComposeConfig{
 		Services: map[string]models.DockerComposeService{
@@ -530,6 +465,49 @@ func (s *SystemService) ConvertToDockerCompose(parsed *models.DockerRunCommand)
 	return string(yamlData), envVars, serviceName, nil
 }
 
+// GenerateDockerRunCommand reconstructs the docker run command for an existing
+// container. Settings the container inherits from its image are left out.
+func (s *SystemService) GenerateDockerRunCommand(ctx context.Context, containerID string) (*models.DockerRunCommand, string, error) {
+	dockerClient, err := s.dockerService.GetClient(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to connect to Docker: %w", err)
+	}
+
+	inspect, err := dockerClient.ContainerInspect(ctx, containerID, client.ContainerInspectOptions{})
+	if err != nil {
+		return nil, "", &models.NotFoundError{Message: fmt.Sprintf("container not found: %v", err)}
+	}
+
+	parsed := converter.FromContainerInspect(inspect.Container, imageDefaultsInternal(ctx, dockerClient, inspect.Container.Image))
+	return parsed, converter.BuildRunCommand(parsed), nil
+}
+
+// imageDefaultsInternal returns the settings containers inherit from imageRef.
+// When the image cannot be inspected, e.g. because it was removed, no defaults
+// are returned and every setting is treated as overridden.
+func imageDefaultsInternal(ctx context.Context, dockerClient *client.Client, imageRef string) converter.ImageDefaults {
+	var defaults converter.ImageDefaults
+	imageInspect, err := dockerClient.ImageInspect(ctx, imageRef)
+	if err != nil {
+		slog.DebugContext(ctx, "Failed to inspect container image", "image", imageRef, "error", err)
+		return defaults
+	}
+	if cfg := imageInspect.Config; cfg != nil {
+		defaults = converter.ImageDefaults{
+			Env:        cfg.Env,
+			Entrypoint: cfg.Entrypoint,
+			Cmd:        cfg.Cmd,
+			Labels:     cfg.Labels,
+			WorkingDir: cfg.WorkingDir,
+			User:       cfg.User,
+		}
+		if cfg.Healthcheck != nil {
+			defaults.Healthcheck = cfg.Healthcheck.Test
+		}
+	}
+	return defaults
+}
+
 func (s *SystemService) GetDiskUsagePath(ctx context.Context) string {
 	cfg := s.settingsService.GetSettingsConfig()
 	if cfg == nil {
@@ -0,0 +1,419 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/getarcaneapp/arcane/backend/internal/database"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/types/tag"
+	"github.com/moby/moby/client"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrTagInvalid             = errors.New("invalid tag")
+	ErrSavedFilterNotFound    = errors.New("saved filter not found")
+	ErrSavedFilterInvalid     = errors.New("invalid saved filter")
+	ErrTaggedResourceNotFound = errors.New("resource not found")
+)
+
+const (
+	maxTagKeyLength     = 64
+	maxTagValueLength   = 255
+	maxTagsPerResource  = 50
+	tagFilterQueryParam = "tags"
+)
+
+// tagKeyPattern keeps keys usable in the tags list filter, which separates
+// conditions with commas and keys from values with '='.
+var tagKeyPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._/-]*$`)
+
+// savedFilterParams lists the query parameters each list endpoint accepts
+// besides search, so saved filters can only hold filters the list applies.
+var savedFilterParams = map[models.TagResourceType][]string{
+	models.TagResourceProject:     {"status", tagFilterQueryParam},
+	models.TagResourceContainer:   {"updates", tagFilterQueryParam},
+	models.TagResourceEnvironment: {tagFilterQueryParam},
+}
+
+// TagService manages user-defined tags on projects, containers and
+// environments, and the saved filters used to list them.
+type TagService struct {
+	db            *database.DB
+	dockerService *DockerClientService
+}
+
+func NewTagService(db *database.DB, dockerService *DockerClientService) *TagService {
+	return &TagService{
+		db:            db,
+		dockerService: dockerService,
+	}
+}
+
+// GetTags returns the tags on a resource.
+func (s *TagService) GetTags(ctx context.Context, resourceType models.TagResourceType, resourceID string) (map[string]string, error) {
+	tags, err := resourceTagsInternal(ctx, s.db, resourceType, []string{resourceID})
+	if err != nil {
+		return nil, err
+	}
+	if t, ok := tags[resourceID]; ok {
+		return t, nil
+	}
+	return map[string]string{}, nil
+}
+
+// SetTags replaces the tags on a resource.
+func (s *TagService) SetTags(ctx context.Context, resourceType models.TagResourceType, resourceID string, tags map[string]string) (map[string]string, error) {
+	normalized, err := normalizeTagsInternal(tags)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("resource_type = ? AND resource_id = ?", resourceType, resourceID).Delete(&models.ResourceTag{}).Error; err != nil {
+			return fmt.Errorf("failed to clear tags: %w", err)
+		}
+		for _, key := range slices.Sorted(maps.Keys(normalized)) {
+			row := &models.ResourceTag{ResourceType: resourceType, ResourceID: resourceID, Key: key, Value: normalized[key]}
+			if err := tx.Create(row).Error; err != nil {
+				return fmt.Errorf("failed to save tag %q: %w", key, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return normalized, nil
+}
+
+// ListTagKeys returns the tag keys used on a resource type with their values.
+func (s *TagService) ListTagKeys(ctx context.Context, resourceType models.TagResourceType) ([]tag.KeySummary, error) {
+	var rows []models.ResourceTag
+	if err := s.db.WithContext(ctx).Where("resource_type = ?", resourceType).Order("tag_key ASC, tag_value ASC").Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	out := []tag.KeySummary{}
+	for _, row := range rows {
+		if len(out) == 0 || out[len(out)-1].Key != row.Key {
+			out = append(out, tag.KeySummary{Key: row.Key, Values: []string{}})
+		}
+		summary := &out[len(out)-1]
+		summary.Resources++
+		if row.Value != "" && !slices.Contains(summary.Values, row.Value) {
+			summary.Values = append(summary.Values, row.Value)
+		}
+	}
+	return out, nil
+}
+
+// ContainerTagID resolves a container ID or name to the name its tags are
+// stored under.
+func (s *TagService) ContainerTagID(ctx context.Context, containerID string) (string, error) {
+	dcli, err := s.dockerService.GetClient(ctx)
+	if err != nil {
+		return "", fmt.Errorf("docker connect: %w", err)
+	}
+	inspect, err := dcli.ContainerInspect(ctx, containerID, client.ContainerInspectOptions{})
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrTaggedResourceNotFound, err)
+	}
+	return strings.TrimPrefix(inspect.Container.Name, "/"), nil
+}
+
+// ProjectTagID checks that the project exists and returns the ID its tags are
+// stored under.
+func (s *TagService) ProjectTagID(ctx context.Context, projectID string) (string, error) {
+	return s.existingTagIDInternal(ctx, &models.Project{}, projectID)
+}
+
+// EnvironmentTagID checks that the environment exists and returns the ID its
+// tags are stored under.
+func (s *TagService) EnvironmentTagID(ctx context.Context, environmentID string) (string, error) {
+	return s.existingTagIDInternal(ctx, &models.Environment{}, environmentID)
+}
+
+func (s *TagService) existingTagIDInternal(ctx context.Context, model any, id string) (string, error) {
+	var count int64
+	if err := s.db.WithContext(ctx).Model(model).Where("id = ?", id).Count(&count).Error; err != nil {
+		return "", fmt.Errorf("failed to look up resource: %w", err)
+	}
+	if count == 0 {
+		return "", ErrTaggedResourceNotFound
+	}
+	return id, nil
+}
+
+// ListSavedFilters returns the saved filters, optionally for one resource type.
+func (s *TagService) ListSavedFilters(ctx context.Context, resourceType string) ([]tag.SavedFilter, error) {
+	var filters []models.SavedFilter
+	query := s.db.WithContext(ctx).Model(&models.SavedFilter{}).Order("name ASC")
+	if resourceType != "" {
+		query = query.Where("resource_type = ?", resourceType)
+	}
+	if err := query.Find(&filters).Error; err != nil {
+		return nil, fmt.Errorf("failed to list saved filters: %w", err)
+	}
+
+	out := make([]tag.SavedFilter, len(filters))
+	for i := range filters {
+		out[i] = toSavedFilterDto(&filters[i])
+	}
+	return out, nil
+}
+
+// CreateSavedFilter saves a search and list filters under a name.
+func (s *TagService) CreateSavedFilter(ctx context.Context, req tag.CreateSavedFilter) (*tag.SavedFilter, error) {
+	f := &models.SavedFilter{
+		Name:         strings.TrimSpace(req.Name),
+		ResourceType: models.TagResourceType(req.ResourceType),
+		Search:       strings.TrimSpace(req.Search),
+		Filters:      req.Filters,
+	}
+	if err := validateSavedFilter(f); err != nil {
+		return nil, err
+	}
+
+	if err := s.db.WithContext(ctx).Create(f).Error; err != nil {
+		return nil, fmt.Errorf("failed to create saved filter: %w", err)
+	}
+
+	dto := toSavedFilterDto(f)
+	return &dto, nil
+}
+
+// UpdateSavedFilter renames a saved filter or replaces its search and filters.
+func (s *TagService) UpdateSavedFilter(ctx context.Context, id string, req tag.UpdateSavedFilter) (*tag.SavedFilter, error) {
+	f, err := s.getSavedFilterModel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		f.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Search != nil {
+		f.Search = strings.TrimSpace(*req.Search)
+	}
+	if req.Filters != nil {
+		f.Filters = req.Filters
+	}
+	if err := validateSavedFilter(f); err != nil {
+		return nil, err
+	}
+
+	if err := s.db.WithContext(ctx).Save(f).Error; err != nil {
+		return nil, fmt.Errorf("failed to update saved filter: %w", err)
+	}
+
+	dto := toSavedFilterDto(f)
+	return &dto, nil
+}
+
+// DeleteSavedFilter removes a saved filter.
+func (s *TagService) DeleteSavedFilter(ctx context.Context, id string) error {
+	result := s.db.WithContext(ctx).Where("id = ?", id).Delete(&models.SavedFilter{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete saved filter: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrSavedFilterNotFound
+	}
+	return nil
+}
+
+func (s *TagService) getSavedFilterModel(ctx context.Context, id string) (*models.SavedFilter, error) {
+	var f models.SavedFilter
+	if err := s.db.WithContext(ctx).Where("id = ?", id).First(&f).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrSavedFilterNotFound
+		}
+		return nil, fmt.Errorf("failed to get saved filter: %w", err)
+	}
+	return &f, nil
+}
+
+func validateSavedFilter(f *models.SavedFilter) error {
+	if f.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrSavedFilterInvalid)
+	}
+	allowed, ok := savedFilterParams[f.ResourceType]
+	if !ok {
+		return fmt.Errorf("%w: unknown resource type %q", ErrSavedFilterInvalid, f.ResourceType)
+	}
+	for name, value := range f.Filters {
+		if !slices.Contains(allowed, name) {
+			return fmt.Errorf("%w: %s lists do not accept the %q filter", ErrSavedFilterInvalid, f.ResourceType, name)
+		}
+		if name == tagFilterQueryParam {
+			if _, err := parseTagFilterInternal(value); err != nil {
+				return fmt.Errorf("%w: %w", ErrSavedFilterInvalid, err)
+			}
+		}
+	}
+	if f.Filters == nil {
+		f.Filters = map[string]string{}
+	}
+	return nil
+}
+
+func toSavedFilterDto(f *models.SavedFilter) tag.SavedFilter {
+	filters := f.Filters
+	if filters == nil {
+		filters = map[string]string{}
+	}
+	return tag.SavedFilter{
+		ID:           f.ID,
+		Name:         f.Name,
+		ResourceType: string(f.ResourceType),
+		Search:       f.Search,
+		Filters:      filters,
+		CreatedAt:    f.CreatedAt,
+		UpdatedAt:    f.UpdatedAt,
+	}
+}
+
+func normalizeTagsInternal(tags map[string]string) (map[string]string, error) {
+	if len(tags) > maxTagsPerResource {
+		return nil, fmt.Errorf("%w: a resource can have at most %d tags", ErrTagInvalid, maxTagsPerResource)
+	}
+	out := make(map[string]string, len(tags))
+	for key, value := range tags {
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+		if err := validateTagInternal(key, value); err != nil {
+			return nil, err
+		}
+		out[key] = value
+	}
+	return out, nil
+}
+
+func validateTagInternal(key, value string) error {
+	switch {
+	case key == "":
+		return fmt.Errorf("%w: key is required", ErrTagInvalid)
+	case len(key) > maxTagKeyLength:
+		return fmt.Errorf("%w: key %q is longer than %d characters", ErrTagInvalid, key, maxTagKeyLength)
+	case !tagKeyPattern.MatchString(key):
+		return fmt.Errorf("%w: key %q may only contain letters, digits, '.', '_', '/' and '-'", ErrTagInvalid, key)
+	case len(value) > maxTagValueLength:
+		return fmt.Errorf("%w: value of %q is longer than %d characters", ErrTagInvalid, key, maxTagValueLength)
+	case strings.Contains(value, ","):
+		return fmt.Errorf("%w: value of %q may not contain ','", ErrTagInvalid, key)
+	}
+	return nil
+}
+
+// tagConditionInternal is one condition of a tags list filter: the key alone
+// matches any value.
+type tagConditionInternal struct {
+	key      string
+	value    string
+	hasValue bool
+}
+
+// parseTagFilterInternal parses a tags list filter such as
+// "team=web,critical". A resource matches when it has every listed tag.
+func parseTagFilterInternal(filter string) ([]tagConditionInternal, error) {
+	var conditions []tagConditionInternal
+	for part := range strings.SplitSeq(filter, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		key, value, hasValue := strings.Cut(part, "=")
+		cond := tagConditionInternal{key: strings.TrimSpace(key), value: strings.TrimSpace(value), hasValue: hasValue}
+		if err := validateTagInternal(cond.key, cond.value); err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, cond)
+	}
+	return conditions, nil
+}
+
+// applyTagFilterInternal limits a list query to the resources whose idColumn
+// matches every condition of the tags filter.
+func applyTagFilterInternal(q *gorm.DB, resourceType models.TagResourceType, idColumn, filter string) (*gorm.DB, error) {
+	conditions, err := parseTagFilterInternal(filter)
+	if err != nil {
+		return nil, err
+	}
+	for _, cond := range conditions {
+		sub := q.Session(&gorm.Session{NewDB: true}).
+			Model(&models.ResourceTag{}).
+			Select("resource_id").
+			Where("resource_type = ? AND tag_key = ?", resourceType, cond.key)
+		if cond.hasValue {
+			sub = sub.Where("tag_value = ?", cond.value)
+		}
+		q = q.Where(idColumn+" IN (?)", sub)
+	}
+	return q, nil
+}
+
+// taggedResourceIDsInternal returns the resource IDs that match every
+// condition of the tags filter, for lists that are filtered in memory.
+func taggedResourceIDsInternal(ctx context.Context, db *database.DB, resourceType models.TagResourceType, filter string) (map[string]struct{}, error) {
+	q, err := applyTagFilterInternal(db.WithContext(ctx).Model(&models.ResourceTag{}), resourceType, "resource_id", filter)
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	if err := q.Where("resource_type = ?", resourceType).Distinct("resource_id").Pluck("resource_id", &ids).Error; err != nil {
+		return nil, fmt.Errorf("failed to filter by tags: %w", err)
+	}
+	out := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		out[id] = struct{}{}
+	}
+	return out, nil
+}
+
+// resourceTagsInternal loads the tags of the given resources, keyed by
+// resource ID.
+func resourceTagsInternal(ctx context.Context, db *database.DB, resourceType models.TagResourceType, resourceIDs []string) (map[string]map[string]string, error) {
+	out := map[string]map[string]string{}
+	if db == nil || len(resourceIDs) == 0 {
+		return out, nil
+	}
+	var rows []models.ResourceTag
+	if err := db.WithContext(ctx).Where("resource_type = ? AND resource_id IN ?", resourceType, resourceIDs).Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to load tags: %w", err)
+	}
+	for _, row := range rows {
+		if out[row.ResourceID] == nil {
+			out[row.ResourceID] = map[string]string{}
+		}
+		out[row.ResourceID][row.Key] = row.Value
+	}
+	return out, nil
+}
+
+// deleteResourceTagsInternal removes the tags of a deleted resource. Failures
+// are only logged since stale tags do not affect the deletion.
+func deleteResourceTagsInternal(ctx context.Context, db *database.DB, resourceType models.TagResourceType, resourceID string) {
+	if err := db.WithContext(ctx).Where("resource_type = ? AND resource_id = ?", resourceType, resourceID).Delete(&models.ResourceTag{}).Error; err != nil {
+		slog.WarnContext(ctx, "failed to delete resource tags", "resourceType", resourceType, "resourceId", resourceID, "error", err)
+	}
+}
+
+// popTagFilterInternal removes the tags filter from params so in-memory
+// filtering does not treat it as an unknown filter, and returns its value.
+func popTagFilterInternal(filters map[string]string) (map[string]string, string) {
+	value := strings.TrimSpace(filters[tagFilterQueryParam])
+	if _, ok := filters[tagFilterQueryParam]; !ok {
+		return filters, value
+	}
+	rest := maps.Clone(filters)
+	delete(rest, tagFilterQueryParam)
+	return rest, value
+}
@@ -0,0 +1,95 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	glsqlite "github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/getarcaneapp/arcane/backend/internal/database"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/types/tag"
+)
+
+func setupTagServiceTest(t *testing.T) *TagService {
+	t.Helper()
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared", strings.NewReplacer("/", "_", " ", "_").Replace(t.Name()))
+	db, err := gorm.Open(glsqlite.Open(dsn), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.ResourceTag{}, &models.SavedFilter{}))
+
+	return NewTagService(&database.DB{DB: db}, nil)
+}
+
+func TestTagService_SetTagsAndFilter(t *testing.T) {
+	svc := setupTagServiceTest(t)
+	ctx := context.Background()
+
+	_, err := svc.SetTags(ctx, models.TagResourceProject, "p1", map[string]string{" team ": "web", "critical": ""})
+	require.NoError(t, err)
+	_, err = svc.SetTags(ctx, models.TagResourceProject, "p2", map[string]string{"team": "web"})
+	require.NoError(t, err)
+	_, err = svc.SetTags(ctx, models.TagResourceContainer, "p1", map[string]string{"team": "db"})
+	require.NoError(t, err)
+
+	tags, err := svc.GetTags(ctx, models.TagResourceProject, "p1")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"team": "web", "critical": ""}, tags)
+
+	ids, err := taggedResourceIDsInternal(ctx, svc.db, models.TagResourceProject, "team=web")
+	require.NoError(t, err)
+	require.Len(t, ids, 2)
+
+	ids, err = taggedResourceIDsInternal(ctx, svc.db, models.TagResourceProject, "team=web,critical")
+	require.NoError(t, err)
+	require.Equal(t, map[string]struct{}{"p1": {}}, ids, "every condition has to match")
+
+	_, err = svc.SetTags(ctx, models.TagResourceProject, "p1", map[string]string{"team": "api"})
+	require.NoError(t, err)
+	tags, err = svc.GetTags(ctx, models.TagResourceProject, "p1")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"team": "api"}, tags, "setting tags replaces the current ones")
+
+	keys, err := svc.ListTagKeys(ctx, models.TagResourceProject)
+	require.NoError(t, err)
+	require.Equal(t, []tag.KeySummary{{Key: "team", Values: []string{"api", "web"}, Resources: 2}}, keys)
+
+	_, err = svc.SetTags(ctx, models.TagResourceProject, "p1", map[string]string{"bad key": "x"})
+	require.ErrorIs(t, err, ErrTagInvalid)
+	_, err = taggedResourceIDsInternal(ctx, svc.db, models.TagResourceProject, "team=a,=b")
+	require.ErrorIs(t, err, ErrTagInvalid)
+}
+
+func TestTagService_SavedFilterValidation(t *testing.T) {
+	svc := setupTagServiceTest(t)
+	ctx := context.Background()
+
+	created, err := svc.CreateSavedFilter(ctx, tag.CreateSavedFilter{
+		Name:         "Web team",
+		ResourceType: tag.ResourceContainer,
+		Filters:      map[string]string{"tags": "team=web", "updates": "has_update"},
+	})
+	require.NoError(t, err)
+
+	_, err = svc.CreateSavedFilter(ctx, tag.CreateSavedFilter{
+		Name:         "Updates",
+		ResourceType: tag.ResourceEnvironment,
+		Filters:      map[string]string{"updates": "has_update"},
+	})
+	require.ErrorIs(t, err, ErrSavedFilterInvalid, "environment lists have no updates filter")
+
+	_, err = svc.UpdateSavedFilter(ctx, created.ID, tag.UpdateSavedFilter{Filters: map[string]string{"tags": "team=a,b"}})
+	require.NoError(t, err)
+
+	filters, err := svc.ListSavedFilters(ctx, tag.ResourceContainer)
+	require.NoError(t, err)
+	require.Len(t, filters, 1)
+	require.Equal(t, map[string]string{"tags": "team=a,b"}, filters[0].Filters)
+
+	require.NoError(t, svc.DeleteSavedFilter(ctx, created.ID))
+	require.ErrorIs(t, svc.DeleteSavedFilter(ctx, created.ID), ErrSavedFilterNotFound)
+}
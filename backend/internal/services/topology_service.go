@@ -0,0 +1,197 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/arcaneupdater"
+	"github.com/getarcaneapp/arcane/types/topology"
+	"github.com/moby/moby/api/types/mount"
+	"github.com/moby/moby/client"
+)
+
+// TopologyService builds graphs of containers, networks and volumes with the
+// dependency relationships between them, for the topology view.
+type TopologyService struct {
+	dockerService  *DockerClientService
+	projectService *ProjectService
+}
+
+func NewTopologyService(dockerService *DockerClientService, projectService *ProjectService) *TopologyService {
+	return &TopologyService{
+		dockerService:  dockerService,
+		projectService: projectService,
+	}
+}
+
+// GetProjectTopology returns the graph for the containers of a single project.
+func (s *TopologyService) GetProjectTopology(ctx context.Context, projectID string) (*topology.Graph, error) {
+	proj, err := s.projectService.GetProjectFromDatabaseByID(ctx, projectID)
+	if err != nil {
+		return nil, &models.NotFoundError{Message: err.Error()}
+	}
+
+	filters := make(client.Filters).Add("label", "com.docker.compose.project="+normalizeComposeProjectName(proj.Name))
+	return s.buildTopologyInternal(ctx, filters)
+}
+
+// GetHostTopology returns the graph for every container on the host.
+func (s *TopologyService) GetHostTopology(ctx context.Context) (*topology.Graph, error) {
+	return s.buildTopologyInternal(ctx, nil)
+}
+
+func (s *TopologyService) buildTopologyInternal(ctx context.Context, filters client.Filters) (*topology.Graph, error) {
+	dockerClient, err := s.dockerService.GetClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to Docker: %w", err)
+	}
+
+	containerList, err := dockerClient.ContainerList(ctx, client.ContainerListOptions{All: true, Filters: filters})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Docker containers: %w", err)
+	}
+
+	containers := make([]arcaneupdater.ContainerWithDeps, 0, len(containerList.Items))
+	for _, c := range containerList.Items {
+		inspect, err := dockerClient.ContainerInspect(ctx, c.ID, client.ContainerInspectOptions{})
+		if err != nil {
+			// The container may have been removed since it was listed.
+			slog.DebugContext(ctx, "Skipping container in topology", "container", c.ID, "error", err)
+			continue
+		}
+		containers = append(containers, arcaneupdater.ExtractContainerDeps(ctx, dockerClient, c, inspect.Container))
+	}
+
+	graph := buildTopologyGraphInternal(containers)
+	return &graph, nil
+}
+
+// buildTopologyGraphInternal turns containers and their extracted
+// dependencies into nodes and edges. Dependencies on containers outside the
+// set are dropped, since there is no node to point to.
+func buildTopologyGraphInternal(containers []arcaneupdater.ContainerWithDeps) topology.Graph {
+	graph := topology.Graph{
+		Nodes: []topology.Node{},
+		Edges: []topology.Edge{},
+	}
+
+	byName := make(map[string]string, len(containers))
+	byService := make(map[string]string, len(containers))
+	for _, c := range containers {
+		nodeID := containerNodeIDInternal(c.Container.ID)
+		byName[c.Name] = nodeID
+		if project, service := c.Container.Labels["com.docker.compose.project"], c.Container.Labels["com.docker.compose.service"]; service != "" {
+			byService[project+"/"+service] = nodeID
+		}
+	}
+
+	resolve := func(ref string) (string, bool) {
+		if id, ok := byName[strings.TrimPrefix(ref, "/")]; ok {
+			return id, true
+		}
+		// network_mode may reference the container by ID or ID prefix.
+		for _, c := range containers {
+			if len(ref) >= 12 && strings.HasPrefix(c.Container.ID, ref) {
+				return containerNodeIDInternal(c.Container.ID), true
+			}
+		}
+		return "", false
+	}
+
+	networks := make(map[string]struct{})
+	volumes := make(map[string]struct{})
+	edges := make(map[topology.Edge]struct{})
+	addEdge := func(source, target string, edgeType topology.EdgeType) {
+		if source == target {
+			return
+		}
+		e := topology.Edge{Source: source, Target: target, Type: edgeType}
+		if _, ok := edges[e]; ok {
+			return
+		}
+		edges[e] = struct{}{}
+		graph.Edges = append(graph.Edges, e)
+	}
+
+	for _, c := range containers {
+		nodeID := containerNodeIDInternal(c.Container.ID)
+		project := c.Container.Labels["com.docker.compose.project"]
+		graph.Nodes = append(graph.Nodes, topology.Node{
+			ID:         nodeID,
+			Type:       topology.NodeTypeContainer,
+			Label:      c.Name,
+			ResourceID: c.Container.ID,
+			State:      string(c.Container.State),
+			Image:      c.Container.Image,
+			Project:    project,
+			Service:    c.Container.Labels["com.docker.compose.service"],
+		})
+
+		for _, service := range arcaneupdater.GetComposeDependsOn(c.Container.Labels) {
+			if target, ok := byService[project+"/"+service]; ok {
+				addEdge(nodeID, target, topology.EdgeTypeDependsOn)
+			}
+		}
+		for _, ref := range c.DependsOn {
+			if target, ok := resolve(ref); ok {
+				addEdge(nodeID, target, topology.EdgeTypeDependsOn)
+			}
+		}
+		for _, ref := range c.Links {
+			if target, ok := resolve(ref); ok {
+				addEdge(nodeID, target, topology.EdgeTypeLink)
+			}
+		}
+		for _, ref := range c.NetworkDeps {
+			if target, ok := resolve(ref); ok {
+				addEdge(nodeID, target, topology.EdgeTypeNetworkMode)
+			}
+		}
+
+		if c.Container.NetworkSettings != nil {
+			for name := range c.Container.NetworkSettings.Networks {
+				if name == "none" {
+					continue
+				}
+				networks[name] = struct{}{}
+				addEdge(nodeID, networkNodeIDInternal(name), topology.EdgeTypeNetwork)
+			}
+		}
+		for _, m := range c.Container.Mounts {
+			if m.Type == mount.TypeVolume && m.Name != "" {
+				volumes[m.Name] = struct{}{}
+				addEdge(nodeID, volumeNodeIDInternal(m.Name), topology.EdgeTypeVolume)
+			}
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(networks)) {
+		graph.Nodes = append(graph.Nodes, topology.Node{
+			ID:         networkNodeIDInternal(name),
+			Type:       topology.NodeTypeNetwork,
+			Label:      name,
+			ResourceID: name,
+		})
+	}
+	for _, name := range slices.Sorted(maps.Keys(volumes)) {
+		graph.Nodes = append(graph.Nodes, topology.Node{
+			ID:         volumeNodeIDInternal(name),
+			Type:       topology.NodeTypeVolume,
+			Label:      name,
+			ResourceID: name,
+		})
+	}
+
+	return graph
+}
+
+func containerNodeIDInternal(id string) string { return "container:" + id }
+
+func networkNodeIDInternal(name string) string { return "network:" + name }
+
+func volumeNodeIDInternal(name string) string { return "volume:" + name }
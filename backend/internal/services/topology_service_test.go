@@ -0,0 +1,92 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/getarcaneapp/arcane/backend/internal/utils/arcaneupdater"
+	"github.com/getarcaneapp/arcane/types/topology"
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/api/types/mount"
+	"github.com/moby/moby/api/types/network"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildTopologyGraph(t *testing.T) {
+	dbID := "aaaaaaaaaaaa0000000000000000000000000000000000000000000000000000"
+	webID := "bbbbbbbbbbbb0000000000000000000000000000000000000000000000000000"
+	vpnID := "cccccccccccc0000000000000000000000000000000000000000000000000000"
+
+	containers := []arcaneupdater.ContainerWithDeps{
+		{
+			Name: "app-db-1",
+			Container: container.Summary{
+				ID:     dbID,
+				Image:  "postgres:17",
+				State:  "running",
+				Labels: map[string]string{"com.docker.compose.project": "app", "com.docker.compose.service": "db"},
+				NetworkSettings: &container.NetworkSettingsSummary{
+					Networks: map[string]*network.EndpointSettings{"app_default": {}},
+				},
+				Mounts: []container.MountPoint{
+					{Type: mount.TypeVolume, Name: "app_pgdata"},
+					{Type: mount.TypeBind, Source: "/etc/localtime"},
+				},
+			},
+		},
+		{
+			Name: "app-web-1",
+			Container: container.Summary{
+				ID:    webID,
+				Image: "nginx:latest",
+				State: "exited",
+				Labels: map[string]string{
+					"com.docker.compose.project":    "app",
+					"com.docker.compose.service":    "web",
+					"com.docker.compose.depends_on": "db:service_healthy:false,cache:service_started:false",
+				},
+				NetworkSettings: &container.NetworkSettingsSummary{
+					Networks: map[string]*network.EndpointSettings{"app_default": {}, "none": {}},
+				},
+			},
+			Links: []string{"app-db-1"},
+		},
+		{
+			Name:        "vpn-client",
+			Container:   container.Summary{ID: vpnID, Image: "wireguard"},
+			NetworkDeps: []string{webID[:12]},
+			DependsOn:   []string{"missing"},
+		},
+	}
+
+	graph := buildTopologyGraphInternal(containers)
+
+	require.Len(t, graph.Nodes, 5)
+	assert.Equal(t, topology.Node{
+		ID:         "container:" + webID,
+		Type:       topology.NodeTypeContainer,
+		Label:      "app-web-1",
+		ResourceID: webID,
+		State:      "exited",
+		Image:      "nginx:latest",
+		Project:    "app",
+		Service:    "web",
+	}, graph.Nodes[1])
+	assert.Equal(t, "network:app_default", graph.Nodes[3].ID)
+	assert.Equal(t, "volume:app_pgdata", graph.Nodes[4].ID)
+
+	assert.ElementsMatch(t, []topology.Edge{
+		{Source: "container:" + dbID, Target: "network:app_default", Type: topology.EdgeTypeNetwork},
+		{Source: "container:" + dbID, Target: "volume:app_pgdata", Type: topology.EdgeTypeVolume},
+		{Source: "container:" + webID, Target: "container:" + dbID, Type: topology.EdgeTypeDependsOn},
+		{Source: "container:" + webID, Target: "container:" + dbID, Type: topology.EdgeTypeLink},
+		{Source: "container:" + webID, Target: "network:app_default", Type: topology.EdgeTypeNetwork},
+		{Source: "container:" + vpnID, Target: "container:" + webID, Type: topology.EdgeTypeNetworkMode},
+	}, graph.Edges)
+}
+
+func TestBuildTopologyGraph_Empty(t *testing.T) {
+	graph := buildTopologyGraphInternal(nil)
+	assert.NotNil(t, graph.Nodes)
+	assert.NotNil(t, graph.Edges)
+}
@@ -0,0 +1,194 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/moby/moby/client"
+	"github.com/shirou/gopsutil/v4/cpu"
+	"github.com/shirou/gopsutil/v4/disk"
+	"github.com/shirou/gopsutil/v4/load"
+
+	arcRegistry "github.com/getarcaneapp/arcane/backend/internal/utils/registry"
+	"github.com/getarcaneapp/arcane/types/updater"
+)
+
+// Names of the checks made before an update run pulls images.
+const (
+	preconditionConcurrentRun = "concurrent_run"
+	preconditionDiskSpace     = "disk_space"
+	preconditionRegistries    = "registries"
+	preconditionHostLoad      = "host_load"
+)
+
+// UpdaterPreconditionError is returned when an update run stops before pulling
+// images because one of its precondition checks failed.
+type UpdaterPreconditionError struct {
+	Checks []updater.PreconditionCheck
+}
+
+func (e *UpdaterPreconditionError) Error() string {
+	var failed []string
+	for _, c := range e.Checks {
+		if c.Status == updater.PreconditionFailed {
+			failed = append(failed, c.Message)
+		}
+	}
+	return "update run preconditions failed: " + strings.Join(failed, "; ")
+}
+
+// acquireRunInternal reserves the updater for a run and returns the function
+// that releases it, or nil when another run is in progress. Dry runs only
+// check that no run is in progress, so they never hold up a real one.
+func (s *UpdaterService) acquireRunInternal(dryRun bool) func() {
+	if !s.runMu.TryLock() {
+		return nil
+	}
+	if dryRun {
+		s.runMu.Unlock()
+		return func() {}
+	}
+	return s.runMu.Unlock
+}
+
+// checkRunPreconditionsInternal makes the checks that would otherwise only
+// fail midway through pulling newRefs: free disk space on the Docker data
+// directory, reachability of their registries and the host load.
+func (s *UpdaterService) checkRunPreconditionsInternal(ctx context.Context, dcli *client.Client, registryClient *arcRegistry.Client, newRefs []string) []updater.PreconditionCheck {
+	return []updater.PreconditionCheck{
+		s.checkDiskSpaceInternal(ctx, dcli),
+		s.checkRegistriesInternal(ctx, registryClient, newRefs),
+		s.checkHostLoadInternal(ctx),
+	}
+}
+
+func (s *UpdaterService) checkDiskSpaceInternal(ctx context.Context, dcli *client.Client) updater.PreconditionCheck {
+	minMB := 1024
+	if s.settingsService != nil {
+		minMB = s.settingsService.GetIntSetting(ctx, "autoUpdateMinFreeDisk", minMB)
+	}
+	if minMB <= 0 {
+		return updater.PreconditionCheck{Name: preconditionDiskSpace, Status: updater.PreconditionSkipped, Message: "disabled"}
+	}
+
+	// Images land in the Docker data directory. Arcane only sees it when it
+	// runs on the Docker host itself; in a container, its own root usually
+	// shares the filesystem, and the disk usage path is the next best guess.
+	paths := make([]string, 0, 3)
+	if info, err := dcli.Info(ctx, client.InfoOptions{}); err == nil && info.Info.DockerRootDir != "" {
+		paths = append(paths, info.Info.DockerRootDir)
+	}
+	if s.settingsService != nil {
+		paths = append(paths, s.settingsService.GetStringSetting(ctx, "diskUsagePath", "/app/data/projects"))
+	}
+	paths = append(paths, "/")
+
+	for _, path := range paths {
+		usage, err := disk.UsageWithContext(ctx, path)
+		if err != nil || usage == nil || usage.Total == 0 {
+			continue
+		}
+		return diskSpaceCheckInternal(path, usage.Free, minMB)
+	}
+	return updater.PreconditionCheck{Name: preconditionDiskSpace, Status: updater.PreconditionSkipped, Message: "disk usage could not be read"}
+}
+
+func diskSpaceCheckInternal(path string, freeBytes uint64, minMB int) updater.PreconditionCheck {
+	freeMB := freeBytes / (1024 * 1024)
+	check := updater.PreconditionCheck{
+		Name:    preconditionDiskSpace,
+		Status:  updater.PreconditionPassed,
+		Message: fmt.Sprintf("%d MB free on %s, %d MB required", freeMB, path, minMB),
+	}
+	if freeMB < uint64(minMB) { //nolint:gosec // minMB is positive
+		check.Status = updater.PreconditionFailed
+	}
+	return check
+}
+
+func (s *UpdaterService) checkRegistriesInternal(ctx context.Context, registryClient *arcRegistry.Client, newRefs []string) updater.PreconditionCheck {
+	if s.settingsService != nil && !s.settingsService.GetBoolSetting(ctx, "autoUpdateRegistryCheck", true) {
+		return updater.PreconditionCheck{Name: preconditionRegistries, Status: updater.PreconditionSkipped, Message: "disabled"}
+	}
+
+	var hosts []string
+	for _, ref := range newRefs {
+		if host := arcRegistry.ExtractRegistryHost(ref); !slices.Contains(hosts, host) {
+			hosts = append(hosts, host)
+		}
+	}
+	slices.Sort(hosts)
+
+	unreachable := map[string]error{}
+	for _, host := range hosts {
+		if _, err := registryClient.CheckAuth(ctx, host); err != nil {
+			slog.DebugContext(ctx, "ApplyPending: registry unreachable", "registry", host, "error", err)
+			unreachable[host] = err
+		}
+	}
+	return registriesCheckInternal(hosts, unreachable)
+}
+
+func registriesCheckInternal(hosts []string, unreachable map[string]error) updater.PreconditionCheck {
+	if len(unreachable) == 0 {
+		return updater.PreconditionCheck{
+			Name:    preconditionRegistries,
+			Status:  updater.PreconditionPassed,
+			Message: "reachable: " + strings.Join(hosts, ", "),
+		}
+	}
+	problems := make([]string, 0, len(unreachable))
+	for _, host := range hosts {
+		if err, ok := unreachable[host]; ok {
+			problems = append(problems, fmt.Sprintf("%s (%v)", host, err))
+		}
+	}
+	return updater.PreconditionCheck{
+		Name:    preconditionRegistries,
+		Status:  updater.PreconditionFailed,
+		Message: "unreachable registries: " + strings.Join(problems, ", "),
+	}
+}
+
+func (s *UpdaterService) checkHostLoadInternal(ctx context.Context) updater.PreconditionCheck {
+	maxPercent := 0
+	if s.settingsService != nil {
+		maxPercent = s.settingsService.GetIntSetting(ctx, "autoUpdateMaxLoad", maxPercent)
+	}
+	if maxPercent <= 0 {
+		return updater.PreconditionCheck{Name: preconditionHostLoad, Status: updater.PreconditionSkipped, Message: "disabled"}
+	}
+
+	// Load averages are not available on every platform.
+	avg, err := load.AvgWithContext(ctx)
+	if err != nil || avg == nil {
+		return updater.PreconditionCheck{Name: preconditionHostLoad, Status: updater.PreconditionSkipped, Message: "load average not available"}
+	}
+	cpus, err := cpu.CountsWithContext(ctx, true)
+	if err != nil || cpus <= 0 {
+		return updater.PreconditionCheck{Name: preconditionHostLoad, Status: updater.PreconditionSkipped, Message: "CPU count not available"}
+	}
+	return hostLoadCheckInternal(avg.Load1, cpus, maxPercent)
+}
+
+func hostLoadCheckInternal(load1 float64, cpus, maxPercent int) updater.PreconditionCheck {
+	percent := load1 / float64(cpus) * 100
+	check := updater.PreconditionCheck{
+		Name:    preconditionHostLoad,
+		Status:  updater.PreconditionPassed,
+		Message: fmt.Sprintf("load average %.2f on %d CPUs is %.0f%% of capacity, limit %d%%", load1, cpus, percent, maxPercent),
+	}
+	if percent > float64(maxPercent) {
+		check.Status = updater.PreconditionFailed
+	}
+	return check
+}
+
+func preconditionsFailedInternal(checks []updater.PreconditionCheck) bool {
+	return slices.ContainsFunc(checks, func(c updater.PreconditionCheck) bool {
+		return c.Status == updater.PreconditionFailed
+	})
+}
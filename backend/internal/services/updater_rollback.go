@@ -0,0 +1,228 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/distribution/reference"
+	"github.com/moby/moby/client"
+	"gorm.io/gorm"
+
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/arcaneupdater"
+	"github.com/getarcaneapp/arcane/backend/pkg/libarcane"
+	"github.com/getarcaneapp/arcane/types/updater"
+)
+
+// previousImageDigestKey is the key of the old image versions of a container
+// update record that holds the repo digest of the replaced image, so that a
+// rollback can pull it again after it was pruned.
+const previousImageDigestKey = "digest"
+
+// previousImageVersionsInternal returns the old image versions to record for
+// a container whose image imageID is replaced by an update to newRef: the
+// matched image and its repo digest.
+func (s *UpdaterService) previousImageVersionsInternal(ctx context.Context, dcli *client.Client, imageID, match, newRef string) map[string]string {
+	versions := map[string]string{"main": match}
+	if imageID == "" {
+		return versions
+	}
+	inspectResult, err := dcli.ImageInspect(ctx, imageID)
+	if err != nil {
+		slog.DebugContext(ctx, "failed to inspect replaced image for its digest", "imageId", imageID, "error", err)
+		return versions
+	}
+	if digest := repoDigestForRefInternal(inspectResult.RepoDigests, newRef); digest != "" {
+		versions[previousImageDigestKey] = digest
+	}
+	return versions
+}
+
+// repoDigestForRefInternal picks the repo digest of the repository of ref, or
+// the first one when none matches.
+func repoDigestForRefInternal(repoDigests []string, ref string) string {
+	if len(repoDigests) == 0 {
+		return ""
+	}
+	if named, err := reference.ParseNormalizedNamed(ref); err == nil {
+		for _, rd := range repoDigests {
+			if digested, err := reference.ParseNormalizedNamed(rd); err == nil && digested.Name() == named.Name() {
+				return rd
+			}
+		}
+	}
+	return repoDigests[0]
+}
+
+// RollbackContainer recreates a container from the image it used before its
+// most recent update by Arcane, taken from the update record. The image is
+// pulled again by digest when it is no longer present locally. The container
+// is recreated from the digest, which keeps the updater from updating it again
+// until it is recreated from its tag. The old container is kept until the new
+// one has started, and is put back if it fails to.
+func (s *UpdaterService) RollbackContainer(ctx context.Context, containerID string, user models.User) (*updater.ContainerRollback, error) {
+	dcli, err := s.dockerService.GetClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("docker connect: %w", err)
+	}
+
+	inspectResult, err := dcli.ContainerInspect(ctx, containerID, client.ContainerInspectOptions{})
+	if err != nil {
+		return nil, &models.NotFoundError{Message: fmt.Sprintf("container %s not found", containerID)}
+	}
+	inspect := inspectResult.Container
+	if inspect.Config == nil || inspect.HostConfig == nil {
+		return nil, fmt.Errorf("container %s has no configuration", containerID)
+	}
+	name := strings.TrimPrefix(inspect.Name, "/")
+	if arcaneupdater.IsArcaneContainer(inspect.Config.Labels) {
+		return nil, &models.ValidationError{Message: "Arcane's own container cannot be rolled back; run the previous Arcane version instead"}
+	}
+
+	rec, err := s.lastContainerUpdateRecordInternal(ctx, inspect.ID, name)
+	if err != nil {
+		return nil, err
+	}
+
+	imageRef, pulled, err := s.resolveRollbackImageInternal(ctx, dcli, rec, inspect.Image, user)
+	if err != nil {
+		return nil, err
+	}
+
+	endStatus := s.beginContainerUpdateInternal(inspect.ID)
+	defer endStatus()
+
+	wasRunning := inspect.State != nil && inspect.State.Running
+	if _, err := dcli.ContainerStop(ctx, inspect.ID, client.ContainerStopOptions{Signal: arcaneupdater.GetStopSignal(inspect.Config.Labels)}); err != nil {
+		return nil, fmt.Errorf("failed to stop container: %w", err)
+	}
+	backupName := fmt.Sprintf("%s-pre-rollback-%d", name, time.Now().Unix())
+	if _, err := dcli.ContainerRename(ctx, inspect.ID, client.ContainerRenameOptions{NewName: backupName}); err != nil {
+		rollbackRecreateInternal(ctx, dcli, inspect.ID, "", name, wasRunning)
+		return nil, fmt.Errorf("failed to rename container: %w", err)
+	}
+
+	replacedImageID := inspect.Image
+	inspect.Config.Image = imageRef
+	apiVersion := libarcane.DetectDockerAPIVersion(ctx, dcli)
+
+	created, err := dcli.ContainerCreate(ctx, buildRecreateCreateOptionsInternal(inspect, name, apiVersion))
+	if err != nil {
+		rollbackRecreateInternal(ctx, dcli, inspect.ID, "", name, wasRunning)
+		return nil, fmt.Errorf("failed to create container from previous image: %w", err)
+	}
+	if wasRunning {
+		if _, err := dcli.ContainerStart(ctx, created.ID, client.ContainerStartOptions{}); err != nil {
+			rollbackRecreateInternal(ctx, dcli, inspect.ID, created.ID, name, wasRunning)
+			return nil, fmt.Errorf("failed to start container from previous image: %w", err)
+		}
+	}
+
+	if _, err := dcli.ContainerRemove(ctx, inspect.ID, client.ContainerRemoveOptions{}); err != nil {
+		slog.WarnContext(ctx, "Failed to remove container replaced by rollback", "container", backupName, "error", err)
+	}
+
+	// The rollback is recorded in the run of the update it rolled back.
+	rollbackRec := &models.AutoUpdateRecord{
+		RunID:            rec.RunID,
+		ResourceID:       created.ID,
+		ResourceType:     "container",
+		ResourceName:     name,
+		Status:           models.AutoUpdateStatusRolledBack,
+		StartTime:        time.Now(),
+		EndTime:          new(time.Now()),
+		OldImageVersions: models.JSON{"main": replacedImageID},
+		NewImageVersions: models.JSON{"main": imageRef},
+		Details:          models.JSON{"rollbackOf": rec.ID, "username": user.Username},
+	}
+	if err := s.db.WithContext(ctx).Create(rollbackRec).Error; err != nil {
+		slog.WarnContext(ctx, "Failed to record container rollback", "container", name, "error", err)
+	}
+
+	metadata := models.JSON{"action": "rollback", "oldContainerId": inspect.ID, "image": imageRef, "replacedImageId": replacedImageID, "recordId": rec.ID, "pulled": pulled}
+	if rec.RunID != nil {
+		metadata["runId"] = *rec.RunID
+	}
+	_, _ = s.eventService.CreateEvent(ctx, CreateEventRequest{
+		Type:         models.EventTypeContainerRollback,
+		Title:        "Container rolled back: " + name,
+		Description:  fmt.Sprintf("Recreated %s from %s, the image it used before its last update", name, imageRef),
+		ResourceType: new("container"),
+		ResourceID:   new(created.ID),
+		ResourceName: new(name),
+		UserID:       new(user.ID),
+		Username:     new(user.Username),
+		Metadata:     metadata,
+	})
+
+	return &updater.ContainerRollback{
+		ContainerID:     created.ID,
+		ContainerName:   name,
+		Image:           imageRef,
+		ReplacedImageID: replacedImageID,
+		Pulled:          pulled,
+		RunID:           rec.RunID,
+		RecordID:        rec.ID,
+	}, nil
+}
+
+// lastContainerUpdateRecordInternal returns the record of the most recent
+// update Arcane applied to a container. Updates recreate containers, so the
+// record is found by name as well as by ID.
+func (s *UpdaterService) lastContainerUpdateRecordInternal(ctx context.Context, containerID, name string) (*models.AutoUpdateRecord, error) {
+	var rec models.AutoUpdateRecord
+	err := s.db.WithContext(ctx).
+		Where("resource_type = ? AND status = ? AND update_applied = ?", "container", "updated", true).
+		Where("resource_id = ? OR resource_name = ?", containerID, name).
+		Order("start_time DESC").
+		First(&rec).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, &models.NotFoundError{Message: fmt.Sprintf("no update by Arcane is recorded for container %s", name)}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("query container update records: %w", err)
+	}
+	return &rec, nil
+}
+
+// resolveRollbackImageInternal returns the reference to recreate a container
+// from to roll back the update of rec, and whether it had to be pulled.
+// currentImageID is the ID of the image the container uses now.
+func (s *UpdaterService) resolveRollbackImageInternal(ctx context.Context, dcli *client.Client, rec *models.AutoUpdateRecord, currentImageID string, user models.User) (string, bool, error) {
+	previous, _ := rec.OldImageVersions["main"].(string)
+	digest, _ := rec.OldImageVersions[previousImageDigestKey].(string)
+	newRef, _ := rec.NewImageVersions["main"].(string)
+
+	// Old records only know the image by ID when it was matched by ID; a tag
+	// has moved on to the update since.
+	if isImageIDLikeReferenceInternal(previous) {
+		if previous == currentImageID {
+			return "", false, &models.ConflictError{Message: "the container already uses the image it used before its last update"}
+		}
+		if inspectResult, err := dcli.ImageInspect(ctx, previous); err == nil {
+			if rd := repoDigestForRefInternal(inspectResult.RepoDigests, newRef); rd != "" {
+				return rd, false, nil
+			}
+			return previous, false, nil
+		}
+	}
+
+	if digest == "" {
+		return "", false, &models.ValidationError{Message: "the image the container used before its last update is no longer present and was not recorded by digest"}
+	}
+	if inspectResult, err := dcli.ImageInspect(ctx, digest); err == nil {
+		if inspectResult.ID == currentImageID {
+			return "", false, &models.ConflictError{Message: "the container already uses the image it used before its last update"}
+		}
+		return digest, false, nil
+	}
+	if err := s.imageService.PullImage(withImagePullTriggerInternal(ctx, models.ImagePullTriggerUpdater), digest, io.Discard, user, nil); err != nil {
+		return "", false, fmt.Errorf("pull previous image %s: %w", digest, err)
+	}
+	return digest, true, nil
+}
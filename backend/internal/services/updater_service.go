@@ -1,12 +1,14 @@
 package services
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
 	"io"
 	"log/slog"
 	"maps"
+	"regexp"
 	"slices"
 	"strings"
 	"sync"
@@ -14,15 +16,20 @@ import (
 
 	"github.com/compose-spec/compose-go/v2/loader"
 	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/api/types/image"
 	"github.com/moby/moby/api/types/network"
 	"github.com/moby/moby/client"
+	"gorm.io/gorm"
 
 	"github.com/getarcaneapp/arcane/backend/internal/database"
 	"github.com/getarcaneapp/arcane/backend/internal/models"
 	"github.com/getarcaneapp/arcane/backend/internal/utils/arcaneupdater"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/pagination"
 	arcRegistry "github.com/getarcaneapp/arcane/backend/internal/utils/registry"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/signature"
 	"github.com/getarcaneapp/arcane/backend/pkg/libarcane"
 	projectspkg "github.com/getarcaneapp/arcane/backend/pkg/projects"
+	"github.com/getarcaneapp/arcane/types/containerregistry"
 	"github.com/getarcaneapp/arcane/types/updater"
 )
 
@@ -38,6 +45,10 @@ type UpdaterService struct {
 	notificationService *NotificationService
 	upgradeService      *SystemUpgradeService
 
+	// runMu is held for the duration of an update run, so that runs do not
+	// pull and recreate the same resources at once.
+	runMu sync.Mutex
+
 	statusMu           sync.RWMutex
 	updatingContainers map[string]bool
 	updatingProjects   map[string]bool
@@ -71,8 +82,56 @@ func NewUpdaterService(
 	}
 }
 
-//nolint:gocognit
+// autoUpdateTriggerKey can be set on a context to record what started the
+// updater runs made with it.
+type autoUpdateTriggerKey struct{}
+
+// autoUpdateRunKey carries the ID of the run that records are written for.
+type autoUpdateRunKey struct{}
+
+// autoUpdateImageKey limits a run to the pending update of one normalized
+// image reference.
+type autoUpdateImageKey struct{}
+
+// WithAutoUpdateTrigger returns a context whose updater runs are recorded as
+// started by trigger. Runs default to manual.
+func WithAutoUpdateTrigger(ctx context.Context, trigger models.AutoUpdateTrigger) context.Context {
+	return context.WithValue(ctx, autoUpdateTriggerKey{}, trigger)
+}
+
+func autoUpdateTriggerInternal(ctx context.Context) models.AutoUpdateTrigger {
+	if trigger, ok := ctx.Value(autoUpdateTriggerKey{}).(models.AutoUpdateTrigger); ok {
+		return trigger
+	}
+	return models.AutoUpdateTriggerManual
+}
+
+// ApplyPending applies the pending image updates to the resources that use
+// them and records the outcome as one run.
 func (s *UpdaterService) ApplyPending(ctx context.Context, dryRun bool) (*updater.Result, error) {
+	run := s.beginRunInternal(ctx, dryRun)
+	out, err := s.applyPendingInternal(withAutoUpdateRunInternal(ctx, run), dryRun)
+	s.finishRunInternal(ctx, run, out, err)
+
+	// Each run also releases the previous images of earlier runs whose
+	// rollback window has passed.
+	if !dryRun && run != nil {
+		if releaseErr := s.releaseRollbackImagesInternal(ctx); releaseErr != nil {
+			slog.WarnContext(ctx, "failed to release images kept for rollback", "error", releaseErr)
+		}
+	}
+	return out, err
+}
+
+// ApplyImageUpdate applies the pending update of imageRef to the resources
+// that use it and records the outcome as one run.
+func (s *UpdaterService) ApplyImageUpdate(ctx context.Context, imageRef string) (*updater.Result, error) {
+	ctx = context.WithValue(ctx, autoUpdateImageKey{}, s.normalizeRef(imageRef))
+	return s.ApplyPending(ctx, false)
+}
+
+//nolint:gocognit
+func (s *UpdaterService) applyPendingInternal(ctx context.Context, dryRun bool) (*updater.Result, error) {
 	start := time.Now()
 	out := &updater.Result{Items: []updater.ResourceResult{}}
 
@@ -117,6 +176,15 @@ func (s *UpdaterService) ApplyPending(ctx context.Context, dryRun bool) (*update
 		oldRef := fmt.Sprintf("%s:%s", r.Repository, r.Tag)
 		oldNorm := s.normalizeRef(oldRef)
 
+		only, onlyOne := ctx.Value(autoUpdateImageKey{}).(string)
+		if onlyOne && oldNorm != only {
+			continue
+		}
+		// Applying the update of one image on request overrides its snooze.
+		if !onlyOne && r.SnoozedUntil != nil && r.SnoozedUntil.After(start) {
+			slog.DebugContext(ctx, "ApplyPending: skipping snoozed image update", "image", oldRef, "snoozedUntil", r.SnoozedUntil)
+			continue
+		}
 		if _, ok := usedImages[oldNorm]; !ok {
 			continue
 		}
@@ -135,6 +203,39 @@ func (s *UpdaterService) ApplyPending(ctx context.Context, dryRun bool) (*update
 		return out, nil
 	}
 
+	dcli, err := s.dockerService.GetClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("docker connect: %w", err)
+	}
+	registryClient := arcRegistry.NewClient()
+
+	// Check what would otherwise fail midway through the pulls before
+	// pulling anything.
+	concurrentRun := updater.PreconditionCheck{Name: preconditionConcurrentRun, Status: updater.PreconditionPassed, Message: "no other update run in progress"}
+	if release := s.acquireRunInternal(dryRun); release != nil {
+		defer release()
+	} else {
+		concurrentRun.Status = updater.PreconditionFailed
+		concurrentRun.Message = "another update run is in progress"
+	}
+	newRefs := make([]string, 0, len(plans))
+	for _, p := range plans {
+		newRefs = append(newRefs, p.newRef)
+	}
+	out.Preconditions = append([]updater.PreconditionCheck{concurrentRun}, s.checkRunPreconditionsInternal(ctx, dcli, registryClient, newRefs)...)
+	if !dryRun && preconditionsFailedInternal(out.Preconditions) {
+		preconditionErr := &UpdaterPreconditionError{Checks: out.Preconditions}
+		slog.WarnContext(ctx, "ApplyPending: aborting update run", "error", preconditionErr)
+		s.logAutoUpdate(ctx, models.EventSeverityWarning, models.JSON{
+			"phase":         "preconditions",
+			"planned":       len(plans),
+			"preconditions": out.Preconditions,
+			"error":         preconditionErr.Error(),
+		})
+		out.Duration = time.Since(start).String()
+		return out, preconditionErr
+	}
+
 	// Log run start
 	s.logAutoUpdate(ctx, models.EventSeverityInfo, models.JSON{
 		"phase":   "start",
@@ -146,11 +247,6 @@ func (s *UpdaterService) ApplyPending(ctx context.Context, dryRun bool) (*update
 	// Pull images with ImageService (waits for completion)
 	// Only containers using the OLD image IDs will be restarted after pulls succeed.
 	// This prevents restarts when pulls fail or when the image digest didn't change.
-	dcli, err := s.dockerService.GetClient(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("docker connect: %w", err)
-	}
-	registryClient := arcRegistry.NewClient()
 	digestChecker := arcaneupdater.NewDigestChecker(dcli, registryClient)
 
 	enabledRegs := []models.ContainerRegistry{}
@@ -160,8 +256,9 @@ func (s *UpdaterService) ApplyPending(ctx context.Context, dryRun bool) (*update
 		}
 	}
 
-	// track all old image IDs we saw for pulled updates so we can prune them after restart
-	oldIDSet := map[string]struct{}{}
+	// track all old image IDs we saw for pulled updates, and the refs they backed,
+	// so we can prune or keep them for rollback after restart
+	oldImages := map[string]string{}
 
 	for i := range plans {
 		p := plans[i]
@@ -196,10 +293,28 @@ func (s *UpdaterService) ApplyPending(ctx context.Context, dryRun bool) (*update
 		normNew := s.normalizeRef(p.newRef)
 		host, repo, tag := s.parseNormalizedRef(normNew)
 		authHeader, _, _, _ := arcRegistry.ResolveAuthHeaderForRepository(ctx, host, repo, tag, enabledRegs)
-		check := digestChecker.CheckImageNeedsUpdate(ctx, normNew, authHeader)
+		// The image currently in use carries the platform it was pulled for,
+		// including one requested through a compose platform field, so the
+		// new tag is resolved for that platform rather than the daemon's.
+		platform, _ := digestChecker.ImagePlatform(ctx, p.oldRef)
+		check := digestChecker.CheckImageNeedsUpdateForPlatform(ctx, normNew, authHeader, platform)
 		skipPull := false
 
-		if check.CheckedViaAPI && check.Error == nil && !check.NeedsUpdate {
+		if check.PlatformUnsupported {
+			item.Status = "skipped"
+			item.Error = fmt.Sprintf("%s no longer provides platform %s", p.newRef, check.Platform)
+			out.Skipped++
+			skipPull = true
+
+			s.logAutoUpdate(ctx, models.EventSeverityWarning, models.JSON{
+				"phase":    "image_pull",
+				"imageOld": p.oldRef,
+				"imageNew": p.newRef,
+				"status":   item.Status,
+				"platform": check.Platform,
+				"error":    item.Error,
+			})
+		} else if check.CheckedViaAPI && check.Error == nil && !check.NeedsUpdate {
 			item.Status = "skipped"
 			item.Error = "image already up to date"
 			out.Skipped++
@@ -220,10 +335,24 @@ func (s *UpdaterService) ApplyPending(ctx context.Context, dryRun bool) (*update
 				"checkedViaApi": true,
 				"error":         item.Error,
 			})
+		} else if reason := s.verifyImageSignatureInternal(ctx, registryClient, normNew, check.RemoteDigest, authHeader, enabledRegs); reason != "" {
+			item.Status = "blocked"
+			item.Error = reason
+			out.Blocked++
+			skipPull = true
+
+			s.logAutoUpdate(ctx, s.severityFromStatus(item.Status), models.JSON{
+				"phase":    "image_pull",
+				"imageOld": p.oldRef,
+				"imageNew": p.newRef,
+				"status":   item.Status,
+				"error":    item.Error,
+			})
+			s.notifyUpdateBlockedInternal(ctx, p.newRef, reason)
 		}
 
 		if !skipPull {
-			if err := s.imageService.PullImage(ctx, p.newRef, io.Discard, systemUser, nil); err != nil {
+			if err := s.imageService.PullImage(withImagePullTriggerInternal(ctx, models.ImagePullTriggerUpdater), p.newRef, io.Discard, systemUser, nil); err != nil {
 				item.Status = "failed"
 				item.Error = err.Error()
 				out.Failed++
@@ -234,7 +363,7 @@ func (s *UpdaterService) ApplyPending(ctx context.Context, dryRun bool) (*update
 				plans[i].pulled = true
 				for _, id := range p.oldIDs {
 					if id != "" {
-						oldIDSet[id] = struct{}{}
+						oldImages[id] = p.oldRef
 					}
 				}
 			}
@@ -273,14 +402,15 @@ func (s *UpdaterService) ApplyPending(ctx context.Context, dryRun bool) (*update
 		}
 		for _, r := range results {
 			item := updater.ResourceResult{
-				ResourceID:    r.ResourceID,
-				ResourceType:  "container",
-				ResourceName:  r.ResourceName,
-				Status:        r.Status,
-				Error:         r.Error,
-				OldImages:     r.OldImages,
-				NewImages:     r.NewImages,
-				UpdateApplied: r.UpdateApplied,
+				ResourceID:      r.ResourceID,
+				ResourceType:    "container",
+				ResourceName:    r.ResourceName,
+				Status:          r.Status,
+				Error:           r.Error,
+				UpdateAvailable: r.UpdateAvailable,
+				OldImages:       r.OldImages,
+				NewImages:       r.NewImages,
+				UpdateApplied:   r.UpdateApplied,
 			}
 			out.Items = append(out.Items, item)
 			out.Checked++
@@ -316,13 +446,26 @@ func (s *UpdaterService) ApplyPending(ctx context.Context, dryRun bool) (*update
 		}
 	}
 
-	if !dryRun && len(oldIDSet) > 0 {
-		ids := make([]string, 0, len(oldIDSet))
-		for id := range oldIDSet {
-			ids = append(ids, id)
+	if !dryRun && len(oldImages) > 0 {
+		// Old images within the rollback window are recorded on the run, which
+		// keeps them from being pruned until the window has passed.
+		window := s.rollbackWindowInternal(ctx)
+		if window > 0 {
+			if err := s.retainRollbackImagesInternal(ctx, oldImages, window); err != nil {
+				slog.WarnContext(ctx, "ApplyPending: failed to record images kept for rollback; keeping them", "count", len(oldImages), "error", err)
+			}
 		}
-		if err := s.pruneImageIDsWithInUseSetInternal(ctx, ids, runningImageIDs); err != nil {
-			slog.Warn("image prune failed", "err", err)
+
+		switch {
+		case s.imageRetentionEnabledInternal(ctx):
+			// Old images are kept for rollback and trimmed by the retention job.
+			slog.DebugContext(ctx, "ApplyPending: image retention enabled; keeping old images", "count", len(oldImages))
+		case window > 0:
+			slog.DebugContext(ctx, "ApplyPending: keeping old images for rollback", "count", len(oldImages), "window", window)
+		default:
+			if err := s.pruneImageIDsWithInUseSetInternal(ctx, slices.Collect(maps.Keys(oldImages)), runningImageIDs); err != nil {
+				slog.Warn("image prune failed", "err", err)
+			}
 		}
 	}
 
@@ -385,9 +528,163 @@ func (s *UpdaterService) ApplyPending(ctx context.Context, dryRun bool) (*update
 
 // UpdateSingleContainer updates a single container by ID to the latest available image.
 // It pulls the new image, stops the container, removes it, and recreates it with the new image.
-//
-//nolint:gocognit // single-container update flow is intentionally linear with explicit early exits for failure reporting
+// The update is recorded as a run of its own.
 func (s *UpdaterService) UpdateSingleContainer(ctx context.Context, containerID string) (*updater.Result, error) {
+	run := s.beginRunInternal(ctx, false)
+	runCtx := withAutoUpdateRunInternal(ctx, run)
+	out, err := s.updateSingleContainerInternal(runCtx, containerID)
+	if out != nil {
+		for _, item := range out.Items {
+			_ = s.recordRun(runCtx, item)
+		}
+	}
+	s.finishRunInternal(ctx, run, out, err)
+	return out, err
+}
+
+// PreviewContainerUpdate reports whether UpdateSingleContainer would recreate
+// the container, the digest it would move to and which running containers
+// would restart with it. It only asks the registry for digests and never
+// pulls or changes anything.
+func (s *UpdaterService) PreviewContainerUpdate(ctx context.Context, containerID string) (*updater.ContainerUpdatePreview, error) {
+	dcli, err := s.dockerService.GetClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("docker connect: %w", err)
+	}
+
+	containerList, err := dcli.ContainerList(ctx, client.ContainerListOptions{All: true, Filters: make(client.Filters).Add("id", containerID)})
+	if err != nil {
+		return nil, fmt.Errorf("list containers: %w", err)
+	}
+	if len(containerList.Items) == 0 {
+		return nil, &models.NotFoundError{Message: "container not found"}
+	}
+	cnt := containerList.Items[0]
+
+	inspectResult, err := dcli.ContainerInspect(ctx, cnt.ID, client.ContainerInspectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("inspect container: %w", err)
+	}
+	inspect := inspectResult.Container
+	labels := map[string]string{}
+	if inspect.Config != nil && inspect.Config.Labels != nil {
+		labels = inspect.Config.Labels
+	}
+
+	preview := &updater.ContainerUpdatePreview{
+		ContainerID:   cnt.ID,
+		ContainerName: s.getContainerName(cnt),
+		Dependents:    []string{},
+	}
+
+	if arcaneupdater.IsUpdateDisabled(labels) {
+		preview.Reason = "updates disabled by label"
+		return preview, nil
+	}
+
+	imageRef, _ := resolveContainerPullableRefInternal(ctx, dcli, cnt, inspect)
+	if imageRef == "" || isImageIDLikeReferenceInternal(imageRef) {
+		preview.Reason = "unable to resolve a pullable image reference for container"
+		return preview, nil
+	}
+	normalizedRef := s.normalizeRef(imageRef)
+	preview.ImageRef = normalizedRef
+
+	enabledRegs, _ := s.containerRegistriesInternal(ctx, labels)
+	host, repository, remoteTag := s.parseNormalizedRef(normalizedRef)
+	authHeader, _, _, _ := arcRegistry.ResolveAuthHeaderForRepository(ctx, host, repository, remoteTag, enabledRegs)
+
+	checker := arcaneupdater.NewDigestChecker(dcli, arcRegistry.NewClient())
+	platform, _ := checker.ImagePlatform(ctx, inspect.Image)
+	check := checker.CheckImageNeedsUpdateForPlatform(ctx, normalizedRef, authHeader, platform)
+	preview.CurrentDigest = check.LocalDigest
+	preview.TargetDigest = check.RemoteDigest
+	preview.Platform = check.Platform
+	preview.CheckedViaAPI = check.CheckedViaAPI
+
+	switch {
+	case check.PlatformUnsupported:
+		preview.Reason = fmt.Sprintf("%s no longer provides platform %s", normalizedRef, check.Platform)
+		return preview, nil
+	case !check.CheckedViaAPI:
+		preview.WouldUpdate = check.NeedsUpdate
+		if check.Error != nil {
+			preview.Reason = fmt.Sprintf("registry digest unavailable, the update would pull to check: %v", check.Error)
+		}
+	case check.NeedsUpdate:
+		preview.WouldUpdate = true
+	default:
+		// The tag may already have been pulled without the container being
+		// recreated, in which case an update only recreates it.
+		ids, idsErr := checker.GetImageIDsForRef(ctx, normalizedRef)
+		if idsErr == nil && inspect.Image != "" && !slices.Contains(ids, inspect.Image) {
+			preview.WouldUpdate = true
+			preview.Reason = "the latest image is already pulled but the container still runs an older one"
+		} else {
+			preview.Reason = "image already up to date"
+		}
+	}
+
+	if !preview.WouldUpdate {
+		return preview, nil
+	}
+	if s.isMonitorOnlyInternal(labels, preview.ContainerName, s.monitorOnlyTargetsInternal(ctx)) {
+		preview.MonitorOnly = true
+		preview.WouldUpdate = false
+		preview.Reason = "container is monitor-only, the update would be reported but not applied"
+		return preview, nil
+	}
+
+	dependents, err := s.dependentContainersInternal(ctx, dcli, preview.ContainerName)
+	if err != nil {
+		return nil, err
+	}
+	preview.Dependents = dependents
+	return preview, nil
+}
+
+// dependentContainersInternal returns the running containers that restarting
+// name would also restart, following the same links, depends-on labels and
+// network modes as restartContainersUsingOldIDs.
+func (s *UpdaterService) dependentContainersInternal(ctx context.Context, dcli *client.Client, name string) ([]string, error) {
+	listResult, err := dcli.ContainerList(ctx, client.ContainerListOptions{All: false})
+	if err != nil {
+		return nil, fmt.Errorf("list containers: %w", err)
+	}
+
+	excluded := s.excludedContainersInternal(ctx)
+	monitorOnlyTargets := s.monitorOnlyTargetsInternal(ctx)
+	containersWithDeps := make([]arcaneupdater.ContainerWithDeps, 0, len(listResult.Items))
+	for _, c := range listResult.Items {
+		cname := s.getContainerName(c)
+		if excluded[cname] || arcaneupdater.IsUpdateDisabled(c.Labels) || s.isMonitorOnlyInternal(c.Labels, cname, monitorOnlyTargets) {
+			continue
+		}
+		if c.Labels == nil {
+			c.Labels = map[string]string{}
+		}
+		inspectResult, ierr := dcli.ContainerInspect(ctx, c.ID, client.ContainerInspectOptions{})
+		if ierr != nil {
+			continue
+		}
+		containersWithDeps = append(containersWithDeps, arcaneupdater.ExtractContainerDeps(ctx, dcli, c, inspectResult.Container))
+	}
+
+	markedForRestart := map[string]bool{name: true}
+	dependents := []string{}
+	for {
+		added := arcaneupdater.UpdateImplicitRestart(containersWithDeps, markedForRestart)
+		if len(added) == 0 {
+			break
+		}
+		dependents = append(dependents, added...)
+	}
+	slices.Sort(dependents)
+	return dependents, nil
+}
+
+//nolint:gocognit // single-container update flow is intentionally linear with explicit early exits for failure reporting
+func (s *UpdaterService) updateSingleContainerInternal(ctx context.Context, containerID string) (*updater.Result, error) {
 	start := time.Now()
 	out := &updater.Result{Items: []updater.ResourceResult{}}
 
@@ -468,18 +765,7 @@ func (s *UpdaterService) UpdateSingleContainer(ctx context.Context, containerID
 	}
 
 	// Resolve the best pullable image reference for this container.
-	configImageRef := ""
-	if inspectBefore.Config != nil {
-		configImageRef = strings.TrimSpace(inspectBefore.Config.Image)
-	}
-	imageRef, imageRefSource := resolvePullableImageRefInternal(targetContainer.Image, configImageRef, nil)
-	if imageRef == "" && inspectBefore.Image != "" {
-		if imageInspect, inspectErr := dcli.ImageInspect(ctx, inspectBefore.Image); inspectErr == nil {
-			imageRef, imageRefSource = resolvePullableImageRefInternal(targetContainer.Image, configImageRef, imageInspect.RepoTags)
-		} else {
-			slog.DebugContext(ctx, "UpdateSingleContainer: failed to inspect container image for fallback refs", "containerID", containerID, "imageID", inspectBefore.Image, "error", inspectErr)
-		}
-	}
+	imageRef, imageRefSource := resolveContainerPullableRefInternal(ctx, dcli, *targetContainer, inspectBefore)
 	if imageRef == "" || isImageIDLikeReferenceInternal(imageRef) {
 		out.Items = append(out.Items, updater.ResourceResult{
 			ResourceID:   targetContainer.ID,
@@ -509,10 +795,50 @@ func (s *UpdaterService) UpdateSingleContainer(ctx context.Context, containerID
 		return out, nil
 	}
 
+	enabledRegs, projectCreds := s.containerRegistriesInternal(ctx, labels)
+	host, repository, remoteTag := s.parseNormalizedRef(normalizedRef)
+	authHeader, _, _, _ := arcRegistry.ResolveAuthHeaderForRepository(ctx, host, repository, remoteTag, enabledRegs)
+	registryClient := arcRegistry.NewClient()
+
+	// Don't pull a tag that stopped shipping the platform the container runs
+	// on: the pull would fail or switch the container to another architecture.
+	checker := arcaneupdater.NewDigestChecker(dcli, registryClient)
+	remoteDigest := ""
+	if platform, platformErr := checker.ImagePlatform(ctx, inspectBefore.Image); platformErr == nil {
+		check := checker.CheckImageNeedsUpdateForPlatform(ctx, normalizedRef, authHeader, platform)
+		if check.PlatformUnsupported {
+			out.Items = append(out.Items, updater.ResourceResult{
+				ResourceID:   targetContainer.ID,
+				ResourceType: "container",
+				ResourceName: containerName,
+				Status:       "skipped",
+				Error:        fmt.Sprintf("%s no longer provides platform %s", normalizedRef, check.Platform),
+			})
+			out.Skipped++
+			out.Duration = time.Since(start).String()
+			return out, nil
+		}
+		remoteDigest = check.RemoteDigest
+	}
+
+	if reason := s.verifyImageSignatureInternal(ctx, registryClient, normalizedRef, remoteDigest, authHeader, enabledRegs); reason != "" {
+		out.Items = append(out.Items, updater.ResourceResult{
+			ResourceID:   targetContainer.ID,
+			ResourceType: "container",
+			ResourceName: containerName,
+			Status:       "blocked",
+			Error:        reason,
+		})
+		out.Blocked++
+		out.Duration = time.Since(start).String()
+		s.notifyUpdateBlockedInternal(ctx, normalizedRef, reason)
+		return out, nil
+	}
+
 	slog.InfoContext(ctx, "UpdateSingleContainer: pulling new image", "containerID", containerID, "image", normalizedRef, "imageRefSource", imageRefSource)
 
 	// Pull the latest image using the image service
-	if err := s.imageService.PullImage(ctx, normalizedRef, io.Discard, systemUser, nil); err != nil {
+	if err := s.imageService.PullImage(withImagePullTriggerInternal(ctx, models.ImagePullTriggerUpdater), normalizedRef, io.Discard, systemUser, projectCreds); err != nil {
 		out.Items = append(out.Items, updater.ResourceResult{
 			ResourceID:   targetContainer.ID,
 			ResourceType: "container",
@@ -526,7 +852,6 @@ func (s *UpdaterService) UpdateSingleContainer(ctx context.Context, containerID
 	}
 
 	// Compare with pulled image to avoid unnecessary restart
-	checker := arcaneupdater.NewDigestChecker(dcli, arcRegistry.NewClient())
 	changed, cmpErr := checker.CompareWithPulled(ctx, inspectBefore.Image, normalizedRef)
 	slog.InfoContext(ctx, "UpdateSingleContainer: digest comparison",
 		"containerID", containerID,
@@ -552,6 +877,22 @@ func (s *UpdaterService) UpdateSingleContainer(ctx context.Context, containerID
 		return out, nil
 	}
 
+	if s.isMonitorOnlyInternal(labels, containerName, s.monitorOnlyTargetsInternal(ctx)) {
+		slog.InfoContext(ctx, "UpdateSingleContainer: update available but container is monitor-only", "containerID", containerID, "image", normalizedRef)
+		out.Items = append(out.Items, updater.ResourceResult{
+			ResourceID:      targetContainer.ID,
+			ResourceType:    "container",
+			ResourceName:    containerName,
+			Status:          string(models.AutoUpdateStatusMonitorOnly),
+			UpdateAvailable: true,
+			NewImages:       map[string]string{"main": normalizedRef},
+		})
+		out.Skipped++
+		out.Checked = 1
+		out.Duration = time.Since(start).String()
+		return out, nil
+	}
+
 	inspect := inspectBefore
 
 	// Check if this is Arcane self-update - use CLI upgrade instead
@@ -591,6 +932,7 @@ func (s *UpdaterService) UpdateSingleContainer(ctx context.Context, containerID
 	}
 
 	// Update the container
+	oldImages := s.previousImageVersionsInternal(ctx, dcli, inspectBefore.Image, inspectBefore.Image, normalizedRef)
 	if err := s.updateContainer(ctx, *targetContainer, inspect, normalizedRef); err != nil {
 		out.Items = append(out.Items, updater.ResourceResult{
 			ResourceID:   targetContainer.ID,
@@ -602,96 +944,752 @@ func (s *UpdaterService) UpdateSingleContainer(ctx context.Context, containerID
 		out.Failed++
 	} else {
 		out.Items = append(out.Items, updater.ResourceResult{
-			ResourceID:   targetContainer.ID,
-			ResourceType: "container",
-			ResourceName: containerName,
-			Status:       "updated",
+			ResourceID:    targetContainer.ID,
+			ResourceType:  "container",
+			ResourceName:  containerName,
+			Status:        "updated",
+			UpdateApplied: true,
+			OldImages:     oldImages,
+			NewImages:     map[string]string{"main": normalizedRef},
 		})
 		out.Updated++
 
-		// Clear the update record for this exact image ID when no running container still uses it.
-		// This avoids repo-name canonicalization mismatches (e.g. "nginx" vs "docker.io/library/nginx").
-		if inspectBefore.Image != "" {
-			stillUsed, usageErr := s.anyImageIDsStillInUse(ctx, []string{inspectBefore.Image})
-			if usageErr != nil {
-				slog.WarnContext(ctx, "failed to check image usage before clearing update record", "imageID", inspectBefore.Image, "err", usageErr)
-			} else if !stillUsed {
-				if err := s.clearImageUpdateRecordByID(ctx, inspectBefore.Image); err != nil {
-					slog.WarnContext(ctx, "failed to clear update record by image id", "imageID", inspectBefore.Image, "err", err)
+		// Clear the update record for this exact image ID when no running container still uses it.
+		// This avoids repo-name canonicalization mismatches (e.g. "nginx" vs "docker.io/library/nginx").
+		if inspectBefore.Image != "" {
+			stillUsed, usageErr := s.anyImageIDsStillInUse(ctx, []string{inspectBefore.Image})
+			if usageErr != nil {
+				slog.WarnContext(ctx, "failed to check image usage before clearing update record", "imageID", inspectBefore.Image, "err", usageErr)
+			} else if !stillUsed {
+				if err := s.clearImageUpdateRecordByID(ctx, inspectBefore.Image); err != nil {
+					slog.WarnContext(ctx, "failed to clear update record by image id", "imageID", inspectBefore.Image, "err", err)
+				}
+			}
+		} else if err := s.clearImageUpdateRecord(ctx, repo, tag); err != nil {
+			// Fallback for unexpected cases where the old image ID is unavailable.
+			slog.WarnContext(ctx, "failed to clear update record", "repo", repo, "tag", tag, "err", err)
+		}
+	}
+
+	out.Checked = 1
+	out.Duration = time.Since(start).String()
+
+	slog.InfoContext(ctx, "UpdateSingleContainer: complete", "containerID", containerID, "updated", out.Updated, "failed", out.Failed)
+
+	return out, nil
+}
+
+func (s *UpdaterService) pruneImageIDsWithInUseSetInternal(ctx context.Context, ids []string, inUseSet map[string]struct{}) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	slog.DebugContext(ctx, "pruneImageIDs: attempting to prune image ids", "count", len(ids))
+
+	retained, err := s.rollbackImageIDsInternal(ctx)
+	if err != nil {
+		return fmt.Errorf("list images kept for rollback: %w", err)
+	}
+
+	dcli, err := s.dockerService.GetClient(ctx)
+	if err != nil {
+		return fmt.Errorf("docker connect: %w", err)
+	}
+
+	for _, id := range ids {
+		if id == "" {
+			continue
+		}
+
+		slog.DebugContext(ctx, "pruneImageIDs: checking image id", "imageId", id)
+
+		if _, ok := retained[id]; ok {
+			slog.DebugContext(ctx, "pruneImageIDs: image kept for rollback, skipping", "imageId", id)
+			continue
+		}
+
+		inUse, err := s.anyImageIDsStillInUseWithSetInternal(ctx, []string{id}, inUseSet)
+		if err != nil {
+			slog.Warn("check image usage failed", "imageId", id, "err", err)
+			continue
+		}
+		if inUse {
+			slog.DebugContext(ctx, "pruneImageIDs: image still in use, skipping", "imageId", id)
+			// still referenced by a container; skip
+			continue
+		}
+
+		if _, err := dcli.ImageRemove(ctx, id, client.ImageRemoveOptions{PruneChildren: true}); err != nil {
+			slog.Warn("image remove failed", "imageId", id, "err", err)
+			continue
+		}
+
+		s.logAutoUpdate(ctx, models.EventSeverityInfo, models.JSON{
+			"phase":   "image_prune",
+			"imageId": id,
+			"status":  "removed",
+		})
+		slog.DebugContext(ctx, "pruneImageIDs: image removed", "imageId", id)
+	}
+
+	return nil
+}
+
+func (s *UpdaterService) imageRetentionEnabledInternal(ctx context.Context) bool {
+	return s.settingsService != nil && s.settingsService.GetBoolSetting(ctx, "imageRetentionEnabled", false)
+}
+
+// rollbackWindowInternal returns how long the previous images of updated
+// containers are kept before they may be pruned.
+func (s *UpdaterService) rollbackWindowInternal(ctx context.Context) time.Duration {
+	if s.settingsService == nil {
+		return 0
+	}
+	return time.Duration(max(s.settingsService.GetIntSetting(ctx, "autoUpdateRollbackWindow", 72), 0)) * time.Hour
+}
+
+// retainRollbackImagesInternal records images, old image IDs mapped to the
+// references they backed, on the run of ctx as kept for rollback until window
+// has passed.
+func (s *UpdaterService) retainRollbackImagesInternal(ctx context.Context, images map[string]string, window time.Duration) error {
+	runID, ok := ctx.Value(autoUpdateRunKey{}).(string)
+	if !ok || runID == "" {
+		return errors.New("no run to record the images on")
+	}
+	retained := make(models.JSON, len(images))
+	for id, ref := range images {
+		retained[id] = ref
+	}
+	return s.db.WithContext(ctx).Model(&models.AutoUpdateRun{}).Where("id = ?", runID).Updates(map[string]any{
+		"retained_images": retained,
+		"retained_until":  time.Now().Add(window),
+	}).Error
+}
+
+// rollbackImageIDsInternal returns the IDs of the previous images that are
+// still within the rollback window of the run that replaced them.
+func (s *UpdaterService) rollbackImageIDsInternal(ctx context.Context) (map[string]struct{}, error) {
+	ids := map[string]struct{}{}
+	if s.db == nil {
+		return ids, nil
+	}
+	var runs []models.AutoUpdateRun
+	if err := s.db.WithContext(ctx).Where("retained_images IS NOT NULL AND retained_until > ?", time.Now()).Find(&runs).Error; err != nil {
+		return nil, err
+	}
+	for _, run := range runs {
+		for id := range run.RetainedImages {
+			ids[id] = struct{}{}
+		}
+	}
+	return ids, nil
+}
+
+// releaseRollbackImagesInternal prunes the previous images whose rollback
+// window has passed and clears them from their runs. With image retention
+// enabled they are left to the retention job instead.
+func (s *UpdaterService) releaseRollbackImagesInternal(ctx context.Context) error {
+	var runs []models.AutoUpdateRun
+	if err := s.db.WithContext(ctx).Where("retained_images IS NOT NULL AND retained_until <= ?", time.Now()).Find(&runs).Error; err != nil {
+		return fmt.Errorf("query images kept for rollback: %w", err)
+	}
+	if len(runs) == 0 {
+		return nil
+	}
+
+	runIDs := make([]string, 0, len(runs))
+	var ids []string
+	for _, run := range runs {
+		runIDs = append(runIDs, run.ID)
+		for id := range run.RetainedImages {
+			ids = append(ids, id)
+		}
+	}
+
+	if !s.imageRetentionEnabledInternal(ctx) && len(ids) > 0 {
+		inUse, err := s.buildRunningImageIDSetInternal(ctx)
+		if err != nil {
+			slog.WarnContext(ctx, "failed to build running image id set; falling back to compatibility checks", "error", err)
+		}
+		if err := s.pruneImageIDsWithInUseSetInternal(ctx, ids, inUse); err != nil {
+			return fmt.Errorf("prune images kept for rollback: %w", err)
+		}
+	}
+
+	return s.db.WithContext(ctx).Model(&models.AutoUpdateRun{}).Where("id IN ?", runIDs).Update("retained_images", gorm.Expr("NULL")).Error
+}
+
+// ApplyImageRetention trims previous image versions of the repositories the
+// updater tracks, keeping the imageRetentionKeepCount most recent ones per
+// repository. Images used by any container, running or not, and images
+// tagged with a reference that is currently in use are never removed.
+func (s *UpdaterService) ApplyImageRetention(ctx context.Context) (*updater.RetentionResult, error) {
+	keep := max(s.settingsService.GetIntSetting(ctx, "imageRetentionKeepCount", 2), 0)
+	out := &updater.RetentionResult{KeepCount: keep, Removed: []string{}}
+
+	trackedRefs, err := s.collectUsedImages(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("collect tracked images: %w", err)
+	}
+
+	dcli, err := s.dockerService.GetClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("docker connect: %w", err)
+	}
+
+	containersResult, err := dcli.ContainerList(ctx, client.ContainerListOptions{All: true})
+	if err != nil {
+		return nil, fmt.Errorf("list containers: %w", err)
+	}
+	inUse := make(map[string]struct{}, len(containersResult.Items))
+	for _, c := range containersResult.Items {
+		if c.ImageID != "" {
+			inUse[c.ImageID] = struct{}{}
+		}
+	}
+	// Previous images within their rollback window are kept like used ones.
+	retained, err := s.rollbackImageIDsInternal(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list images kept for rollback: %w", err)
+	}
+	maps.Copy(inUse, retained)
+
+	imagesResult, err := dcli.ImageList(ctx, client.ImageListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list images: %w", err)
+	}
+
+	plan := s.planImageRetentionInternal(imagesResult.Items, trackedRefs, inUse, keep)
+	out.Repositories = plan.repositories
+	out.Kept = plan.kept
+
+	for _, img := range plan.remove {
+		if _, err := dcli.ImageRemove(ctx, img.ID, client.ImageRemoveOptions{PruneChildren: true}); err != nil {
+			slog.WarnContext(ctx, "image retention: image remove failed", "imageId", img.ID, "error", err)
+			out.Errors = append(out.Errors, fmt.Sprintf("%s: %v", img.ID, err))
+			continue
+		}
+		out.Removed = append(out.Removed, img.ID)
+		out.SpaceReclaimed += img.Size
+
+		s.logAutoUpdate(ctx, models.EventSeverityInfo, models.JSON{
+			"phase":   "image_prune",
+			"imageId": img.ID,
+			"status":  "removed",
+			"reason":  "retention",
+		})
+	}
+
+	return out, nil
+}
+
+type imageRetentionPlanInternal struct {
+	repositories int
+	kept         int
+	remove       []image.Summary
+}
+
+// planImageRetentionInternal groups the local images by tracked repository
+// and picks the previous versions beyond keep, newest first. An image that
+// belongs to several repositories is removed only if none of them keeps it.
+func (s *UpdaterService) planImageRetentionInternal(images []image.Summary, trackedRefs, inUse map[string]struct{}, keep int) imageRetentionPlanInternal {
+	trackedRepos := make(map[string]struct{}, len(trackedRefs))
+	for ref := range trackedRefs {
+		repo, _ := s.parseRepoAndTag(ref)
+		trackedRepos[repo] = struct{}{}
+	}
+
+	byRepo := map[string][]image.Summary{}
+	for _, img := range images {
+		if _, used := inUse[img.ID]; used {
+			continue
+		}
+		current := false
+		for _, tag := range img.RepoTags {
+			if _, ok := trackedRefs[s.normalizeRef(tag)]; ok {
+				current = true
+				break
+			}
+		}
+		if current {
+			continue
+		}
+		for _, repo := range s.imageRepositoriesInternal(img) {
+			if _, ok := trackedRepos[repo]; ok {
+				byRepo[repo] = append(byRepo[repo], img)
+			}
+		}
+	}
+
+	kept := map[string]struct{}{}
+	candidates := map[string]image.Summary{}
+	for _, versions := range byRepo {
+		slices.SortFunc(versions, func(a, b image.Summary) int {
+			if a.Created != b.Created {
+				return cmp.Compare(b.Created, a.Created)
+			}
+			return strings.Compare(a.ID, b.ID)
+		})
+		for i, img := range versions {
+			if i < keep {
+				kept[img.ID] = struct{}{}
+			} else {
+				candidates[img.ID] = img
+			}
+		}
+	}
+
+	plan := imageRetentionPlanInternal{repositories: len(byRepo), kept: len(kept)}
+	for _, id := range slices.Sorted(maps.Keys(candidates)) {
+		if _, ok := kept[id]; !ok {
+			plan.remove = append(plan.remove, candidates[id])
+		}
+	}
+	return plan
+}
+
+// imageRepositoriesInternal returns the normalized repositories an image is
+// known by. Old versions usually lost their tag to the update, so the repo
+// digests are included as well.
+func (s *UpdaterService) imageRepositoriesInternal(img image.Summary) []string {
+	var repos []string
+	for _, ref := range slices.Concat(img.RepoTags, img.RepoDigests) {
+		if ref == "" || strings.HasPrefix(ref, "<none>") {
+			continue
+		}
+		repo, _ := s.parseRepoAndTag(s.normalizeRef(ref))
+		if !slices.Contains(repos, repo) {
+			repos = append(repos, repo)
+		}
+	}
+	return repos
+}
+
+func (s *UpdaterService) GetStatus() updater.Status { return s.statusSnapshotInternal() }
+
+func (s *UpdaterService) GetHistory(ctx context.Context, limit int) ([]models.AutoUpdateRecord, error) {
+	var rec []models.AutoUpdateRecord
+	q := s.db.WithContext(ctx).Order("start_time DESC")
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+	if err := q.Find(&rec).Error; err != nil {
+		return nil, fmt.Errorf("get history: %w", err)
+	}
+	return rec, nil
+}
+
+// ListRunsPaginated returns updater runs with their aggregate counts, newest
+// first unless another sort is requested.
+func (s *UpdaterService) ListRunsPaginated(ctx context.Context, params pagination.QueryParams) ([]updater.Run, pagination.Response, error) {
+	var runs []models.AutoUpdateRun
+	q := s.db.WithContext(ctx).Model(&models.AutoUpdateRun{})
+	q = pagination.ApplyFilter(q, "triggered_by", params.Filters["trigger"])
+	q = pagination.ApplyFilter(q, "status", params.Filters["status"])
+
+	if params.Sort == "" {
+		params.Sort = "startTime"
+	}
+
+	paginationResp, err := pagination.PaginateAndSortDB(params, q, &runs)
+	if err != nil {
+		return nil, pagination.Response{}, fmt.Errorf("failed to paginate runs: %w", err)
+	}
+
+	out := make([]updater.Run, 0, len(runs))
+	for _, run := range runs {
+		out = append(out, toUpdaterRunInternal(run))
+	}
+	return out, paginationResp, nil
+}
+
+// GetRun returns an updater run with the per-resource results it recorded.
+func (s *UpdaterService) GetRun(ctx context.Context, runID string) (*updater.RunDetail, error) {
+	var run models.AutoUpdateRun
+	if err := s.db.WithContext(ctx).Where("id = ?", runID).First(&run).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, &models.NotFoundError{Message: "updater run not found"}
+		}
+		return nil, fmt.Errorf("get run: %w", err)
+	}
+
+	var records []models.AutoUpdateRecord
+	if err := s.db.WithContext(ctx).Where("run_id = ?", runID).Order("start_time ASC").Find(&records).Error; err != nil {
+		return nil, fmt.Errorf("get run records: %w", err)
+	}
+
+	detail := &updater.RunDetail{Run: toUpdaterRunInternal(run), Items: make([]updater.RunItem, 0, len(records))}
+	for _, rec := range records {
+		detail.Items = append(detail.Items, updater.RunItem{
+			ID:              rec.ID,
+			ResourceID:      rec.ResourceID,
+			ResourceType:    rec.ResourceType,
+			ResourceName:    rec.ResourceName,
+			Status:          string(rec.Status),
+			UpdateAvailable: rec.UpdateAvailable,
+			UpdateApplied:   rec.UpdateApplied,
+			OldImages:       imageVersionsInternal(rec.OldImageVersions),
+			NewImages:       imageVersionsInternal(rec.NewImageVersions),
+			Error:           rec.Error,
+			Time:            rec.StartTime,
+		})
+	}
+	return detail, nil
+}
+
+// RetryRun re-attempts the failed items of a finished run in a new run linked
+// to it. Image pulls are retried with the reference the original run planned,
+// and containers are recreated on the image they were being moved to.
+func (s *UpdaterService) RetryRun(ctx context.Context, runID string) (*updater.RunDetail, error) {
+	var original models.AutoUpdateRun
+	if err := s.db.WithContext(ctx).Where("id = ?", runID).First(&original).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, &models.NotFoundError{Message: "updater run not found"}
+		}
+		return nil, fmt.Errorf("get run: %w", err)
+	}
+	if original.Status == models.AutoUpdateStatusUpdating {
+		return nil, &models.ConflictError{Message: "updater run is still in progress"}
+	}
+
+	var failed []models.AutoUpdateRecord
+	if err := s.db.WithContext(ctx).
+		Where("run_id = ? AND status = ?", runID, models.AutoUpdateStatusFailed).
+		Order("start_time ASC").
+		Find(&failed).Error; err != nil {
+		return nil, fmt.Errorf("get failed run records: %w", err)
+	}
+	if len(failed) == 0 {
+		return nil, &models.ValidationError{Message: "updater run has no failed items to retry", Field: "runId"}
+	}
+
+	run := newAutoUpdateRunInternal(ctx, false)
+	run.RetryOfRunID = &original.ID
+	if err := s.db.WithContext(ctx).Create(run).Error; err != nil {
+		return nil, fmt.Errorf("create retry run: %w", err)
+	}
+
+	runCtx := withAutoUpdateRunInternal(ctx, run)
+	out, err := s.retryRecordsInternal(runCtx, failed)
+	if out != nil {
+		for _, item := range out.Items {
+			_ = s.recordRun(runCtx, item)
+		}
+	}
+	s.finishRunInternal(ctx, run, out, err)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetRun(ctx, run.ID)
+}
+
+// retryRecordsInternal pulls the images of failed image items first, so the
+// containers that were waiting on them are recreated in the same pass, then
+// retries the failed containers that pass did not already cover.
+func (s *UpdaterService) retryRecordsInternal(ctx context.Context, records []models.AutoUpdateRecord) (*updater.Result, error) {
+	start := time.Now()
+	out := &updater.Result{Items: []updater.ResourceResult{}}
+
+	oldRefToNewRef := map[string]string{}
+	oldIDToNewRef := map[string]string{}
+	var containers []models.AutoUpdateRecord
+	seen := map[string]struct{}{}
+	for _, rec := range records {
+		key := rec.ResourceType + "/" + rec.ResourceID
+		if _, dup := seen[key]; dup {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		switch rec.ResourceType {
+		case "image":
+			item, oldIDs := s.retryImagePullInternal(ctx, rec)
+			tallyRetryItemInternal(out, item)
+			if item.UpdateApplied {
+				oldRefToNewRef[item.OldImages["main"]] = item.NewImages["main"]
+				for _, id := range oldIDs {
+					oldIDToNewRef[id] = item.NewImages["main"]
 				}
 			}
-		} else if err := s.clearImageUpdateRecord(ctx, repo, tag); err != nil {
-			// Fallback for unexpected cases where the old image ID is unavailable.
-			slog.WarnContext(ctx, "failed to clear update record", "repo", repo, "tag", tag, "err", err)
+		case "container":
+			containers = append(containers, rec)
 		}
 	}
 
-	out.Checked = 1
-	out.Duration = time.Since(start).String()
+	retried := map[string]struct{}{}
+	if len(oldRefToNewRef) > 0 {
+		results, err := s.restartContainersUsingOldIDs(ctx, oldIDToNewRef, oldRefToNewRef)
+		if err != nil {
+			slog.WarnContext(ctx, "container restarts had errors during retry", "error", err)
+		}
+		for _, r := range results {
+			r.ResourceType = "container"
+			tallyRetryItemInternal(out, r)
+			retried[r.ResourceID] = struct{}{}
+			if r.ResourceName != "" {
+				retried[r.ResourceName] = struct{}{}
+			}
+		}
+	}
 
-	slog.InfoContext(ctx, "UpdateSingleContainer: complete", "containerID", containerID, "updated", out.Updated, "failed", out.Failed)
+	for _, rec := range containers {
+		if _, ok := retried[rec.ResourceID]; ok {
+			continue
+		}
+		if _, ok := retried[rec.ResourceName]; ok && rec.ResourceName != "" {
+			continue
+		}
+		tallyRetryItemInternal(out, s.retryContainerInternal(ctx, rec))
+	}
 
+	out.Duration = time.Since(start).String()
 	return out, nil
 }
 
-func (s *UpdaterService) pruneImageIDsWithInUseSetInternal(ctx context.Context, ids []string, inUseSet map[string]struct{}) error {
-	if len(ids) == 0 {
-		return nil
+// retryImagePullInternal pulls the new reference planned by the failed item
+// and returns the IDs the old reference resolved to before the pull.
+func (s *UpdaterService) retryImagePullInternal(ctx context.Context, rec models.AutoUpdateRecord) (updater.ResourceResult, []string) {
+	oldRef := cmp.Or(imageVersionsInternal(rec.OldImageVersions)["main"], rec.ResourceName)
+	newRef := cmp.Or(imageVersionsInternal(rec.NewImageVersions)["main"], oldRef)
+	item := updater.ResourceResult{
+		ResourceID:   rec.ResourceID,
+		ResourceType: "image",
+		ResourceName: rec.ResourceName,
+		Status:       "failed",
+		OldImages:    map[string]string{"main": oldRef},
+		NewImages:    map[string]string{"main": newRef},
+	}
+
+	oldIDs, err := s.resolveLocalImageIDsForRef(ctx, oldRef)
+	if err != nil {
+		slog.DebugContext(ctx, "retryImagePullInternal: failed to resolve old image ids", "ref", oldRef, "error", err)
 	}
 
-	slog.DebugContext(ctx, "pruneImageIDs: attempting to prune image ids", "count", len(ids))
+	if err := s.imageService.PullImage(withImagePullTriggerInternal(ctx, models.ImagePullTriggerUpdater), newRef, io.Discard, systemUser, nil); err != nil {
+		item.Error = err.Error()
+	} else {
+		item.Status = "updated"
+		item.UpdateApplied = true
+	}
+	s.logAutoUpdate(ctx, s.severityFromStatus(item.Status), models.JSON{
+		"phase":    "image_pull",
+		"imageOld": oldRef,
+		"imageNew": newRef,
+		"status":   item.Status,
+		"error":    item.Error,
+		"retry":    true,
+	})
+	return item, oldIDs
+}
+
+// retryContainerInternal recreates the container of a failed container item
+// on the image the original run planned for it. The container is looked up by
+// name when its ID is gone, since a failed recreate can leave a new container
+// behind. Items without a planned image go through the single-container
+// update instead.
+func (s *UpdaterService) retryContainerInternal(ctx context.Context, rec models.AutoUpdateRecord) updater.ResourceResult {
+	newRef := imageVersionsInternal(rec.NewImageVersions)["main"]
+	item := updater.ResourceResult{
+		ResourceID:   rec.ResourceID,
+		ResourceType: "container",
+		ResourceName: rec.ResourceName,
+		Status:       "failed",
+		OldImages:    imageVersionsInternal(rec.OldImageVersions),
+	}
+	if newRef != "" {
+		item.NewImages = map[string]string{"main": newRef}
+	}
 
 	dcli, err := s.dockerService.GetClient(ctx)
 	if err != nil {
-		return fmt.Errorf("docker connect: %w", err)
+		item.Error = fmt.Sprintf("docker connect: %v", err)
+		return item
+	}
+	cnt, err := findRetryContainerInternal(ctx, dcli, rec)
+	if err != nil {
+		item.Error = err.Error()
+		return item
+	}
+	if cnt == nil {
+		item.Error = "container no longer exists"
+		return item
 	}
+	item.ResourceID = cnt.ID
+	item.ResourceName = s.getContainerName(*cnt)
 
-	for _, id := range ids {
-		if id == "" {
-			continue
+	inspectResult, err := dcli.ContainerInspect(ctx, cnt.ID, client.ContainerInspectOptions{})
+	if err != nil {
+		item.Error = fmt.Sprintf("inspect failed: %v", err)
+		return item
+	}
+	inspect := inspectResult.Container
+	labels := map[string]string{}
+	if inspect.Config != nil && inspect.Config.Labels != nil {
+		labels = inspect.Config.Labels
+	}
+
+	if newRef == "" || arcaneupdater.IsArcaneContainer(labels) {
+		res, err := s.updateSingleContainerInternal(ctx, cnt.ID)
+		if err != nil {
+			item.Error = err.Error()
+			return item
+		}
+		if len(res.Items) == 0 {
+			item.Status = "skipped"
+			return item
 		}
+		return res.Items[0]
+	}
 
-		slog.DebugContext(ctx, "pruneImageIDs: checking image id", "imageId", id)
+	if arcaneupdater.IsUpdateDisabled(labels) {
+		item.Status = "skipped"
+		item.Error = "updates disabled by label"
+		return item
+	}
 
-		inUse, err := s.anyImageIDsStillInUseWithSetInternal(ctx, []string{id}, inUseSet)
+	endContainerStatus := s.beginContainerUpdateInternal(cnt.ID)
+	defer endContainerStatus()
+	endProjectStatus := s.beginProjectUpdateInternal(composeProjectNameFromLabelsInternal(labels))
+	defer endProjectStatus()
+
+	if _, err := dcli.ImageInspect(ctx, newRef); err != nil {
+		if err := s.imageService.PullImage(withImagePullTriggerInternal(ctx, models.ImagePullTriggerUpdater), newRef, io.Discard, systemUser, nil); err != nil {
+			item.Error = fmt.Sprintf("pull failed: %v", err)
+			return item
+		}
+	}
+
+	if err := s.updateContainer(ctx, *cnt, inspect, newRef); err != nil {
+		item.Error = err.Error()
+	} else {
+		item.Status = "updated"
+		item.UpdateApplied = true
+	}
+	s.logAutoUpdate(ctx, s.severityFromStatus(item.Status), models.JSON{
+		"phase":        "container",
+		"containerId":  item.ResourceID,
+		"container":    item.ResourceName,
+		"status":       item.Status,
+		"newImageMain": newRef,
+		"error":        item.Error,
+		"retry":        true,
+	})
+	return item
+}
+
+func findRetryContainerInternal(ctx context.Context, dcli *client.Client, rec models.AutoUpdateRecord) (*container.Summary, error) {
+	lookups := []client.Filters{make(client.Filters).Add("id", rec.ResourceID)}
+	if rec.ResourceName != "" {
+		lookups = append(lookups, make(client.Filters).Add("name", "^/"+regexp.QuoteMeta(rec.ResourceName)+"$"))
+	}
+	for _, filters := range lookups {
+		list, err := dcli.ContainerList(ctx, client.ContainerListOptions{All: true, Filters: filters})
 		if err != nil {
-			slog.Warn("check image usage failed", "imageId", id, "err", err)
-			continue
+			return nil, fmt.Errorf("list containers: %w", err)
 		}
-		if inUse {
-			slog.DebugContext(ctx, "pruneImageIDs: image still in use, skipping", "imageId", id)
-			// still referenced by a container; skip
-			continue
+		if len(list.Items) > 0 {
+			return &list.Items[0], nil
 		}
+	}
+	return nil, nil
+}
 
-		if _, err := dcli.ImageRemove(ctx, id, client.ImageRemoveOptions{PruneChildren: true}); err != nil {
-			slog.Warn("image remove failed", "imageId", id, "err", err)
-			continue
-		}
+func tallyRetryItemInternal(out *updater.Result, item updater.ResourceResult) {
+	out.Items = append(out.Items, item)
+	out.Checked++
+	switch {
+	case item.UpdateApplied:
+		out.Updated++
+	case item.Status == "blocked":
+		out.Blocked++
+	case item.Status == "skipped" || item.Status == string(models.AutoUpdateStatusMonitorOnly):
+		out.Skipped++
+	case item.Status == "failed" || item.Error != "":
+		out.Failed++
+	default:
+		out.Skipped++
+	}
+}
 
-		s.logAutoUpdate(ctx, models.EventSeverityInfo, models.JSON{
-			"phase":   "image_prune",
-			"imageId": id,
-			"status":  "removed",
-		})
-		slog.DebugContext(ctx, "pruneImageIDs: image removed", "imageId", id)
+func toUpdaterRunInternal(run models.AutoUpdateRun) updater.Run {
+	return updater.Run{
+		ID:             run.ID,
+		Trigger:        string(run.Trigger),
+		Status:         string(run.Status),
+		DryRun:         run.DryRun,
+		RetryOfRunID:   run.RetryOfRunID,
+		StartTime:      run.StartTime,
+		EndTime:        run.EndTime,
+		Checked:        run.Checked,
+		Updated:        run.Updated,
+		Skipped:        run.Skipped,
+		Failed:         run.Failed,
+		Blocked:        run.Blocked,
+		Error:          run.Error,
+		RetainedImages: imageVersionsInternal(run.RetainedImages),
+		RetainedUntil:  run.RetainedUntil,
 	}
+}
 
-	return nil
+func imageVersionsInternal(versions models.JSON) map[string]string {
+	if len(versions) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(versions))
+	for k, v := range versions {
+		out[k] = fmt.Sprint(v)
+	}
+	return out
 }
 
-func (s *UpdaterService) GetStatus() updater.Status { return s.statusSnapshotInternal() }
+// beginRunInternal stores a new run. Records are still written when the run
+// cannot be stored, just without a run to group them.
+func (s *UpdaterService) beginRunInternal(ctx context.Context, dryRun bool) *models.AutoUpdateRun {
+	if s.db == nil {
+		return nil
+	}
+	run := newAutoUpdateRunInternal(ctx, dryRun)
+	if err := s.db.WithContext(ctx).Create(run).Error; err != nil {
+		slog.WarnContext(ctx, "failed to record updater run", "error", err)
+		return nil
+	}
+	return run
+}
 
-func (s *UpdaterService) GetHistory(ctx context.Context, limit int) ([]models.AutoUpdateRecord, error) {
-	var rec []models.AutoUpdateRecord
-	q := s.db.WithContext(ctx).Order("start_time DESC")
-	if limit > 0 {
-		q = q.Limit(limit)
+func (s *UpdaterService) finishRunInternal(ctx context.Context, run *models.AutoUpdateRun, out *updater.Result, runErr error) {
+	if run == nil {
+		return
 	}
-	if err := q.Find(&rec).Error; err != nil {
-		return nil, fmt.Errorf("get history: %w", err)
+	end := time.Now()
+	run.EndTime = &end
+	run.Status = models.AutoUpdateStatusCompleted
+	if out != nil {
+		run.Checked, run.Updated, run.Skipped, run.Failed, run.Blocked = out.Checked, out.Updated, out.Skipped, out.Failed, out.Blocked
 	}
-	return rec, nil
+	if runErr != nil {
+		run.Status = models.AutoUpdateStatusFailed
+		run.Error = new(runErr.Error())
+	}
+	// The images kept for rollback are written by the run itself.
+	if err := s.db.WithContext(context.WithoutCancel(ctx)).Omit("retained_images", "retained_until").Save(run).Error; err != nil {
+		slog.WarnContext(ctx, "failed to finish updater run", "runId", run.ID, "error", err)
+	}
+}
+
+func newAutoUpdateRunInternal(ctx context.Context, dryRun bool) *models.AutoUpdateRun {
+	return &models.AutoUpdateRun{
+		Trigger:   autoUpdateTriggerInternal(ctx),
+		Status:    models.AutoUpdateStatusUpdating,
+		DryRun:    dryRun,
+		StartTime: time.Now(),
+	}
+}
+
+func withAutoUpdateRunInternal(ctx context.Context, run *models.AutoUpdateRun) context.Context {
+	if run == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, autoUpdateRunKey{}, run.ID)
 }
 
 // --- internals ---
@@ -741,28 +1739,14 @@ func (s *UpdaterService) updateContainer(ctx context.Context, cnt container.Summ
 	_ = s.eventService.LogContainerEvent(ctx, models.EventTypeContainerDelete, cnt.ID, name, systemUser.ID, systemUser.Username, "0", models.JSON{"action": "updater_delete"})
 
 	// recreate with new image ref
-	cfg := inspect.Config
-	cfg.Image = newRef
-
-	// Fix for "conflicting options: hostname and the network mode"
-	// When network mode is "host" or "container:...", Hostname must be empty
-	nm := inspect.HostConfig.NetworkMode
-	if nm.IsHost() || nm.IsContainer() {
-		cfg.Hostname = ""
-		cfg.Domainname = ""
-	}
+	inspect.Config.Image = newRef
 
-	// Fix for "conflicting options: port exposing and the container type network mode"
-	// When network mode is "container:...", port mappings are not allowed
-	if nm.IsContainer() {
-		cfg.ExposedPorts = nil
-		inspect.HostConfig.PortBindings = nil
-		inspect.HostConfig.PublishAllPorts = false
-	}
+	// Use original name for new container
+	containerName := strings.TrimPrefix(originalName, "/")
 
 	apiVersion := libarcane.DetectDockerAPIVersion(ctx, dcli)
-	networkingConfig := buildUpdaterRecreateNetworkingConfigInternal(nm, inspect.NetworkSettings, apiVersion)
-	if networkingConfig != nil && apiVersion != "" && !libarcane.SupportsDockerCreatePerNetworkMACAddress(apiVersion) {
+	createOpts := buildRecreateCreateOptionsInternal(inspect, containerName, apiVersion)
+	if createOpts.NetworkingConfig != nil && apiVersion != "" && !libarcane.SupportsDockerCreatePerNetworkMACAddress(apiVersion) {
 		slog.InfoContext(ctx,
 			"updateContainer: daemon API does not support per-network mac-address on create; stripping endpoint mac addresses",
 			"containerId", cnt.ID,
@@ -772,15 +1756,7 @@ func (s *UpdaterService) updateContainer(ctx context.Context, cnt container.Summ
 		)
 	}
 
-	// Use original name for new container
-	containerName := strings.TrimPrefix(originalName, "/")
-
-	resp, err := dcli.ContainerCreate(ctx, client.ContainerCreateOptions{
-		Config:           cfg,
-		HostConfig:       inspect.HostConfig,
-		NetworkingConfig: networkingConfig,
-		Name:             containerName,
-	})
+	resp, err := dcli.ContainerCreate(ctx, createOpts)
 	if err != nil {
 		slog.DebugContext(ctx, "updateContainer: create failed", "containerName", containerName, "err", err)
 		return fmt.Errorf("create: %w", err)
@@ -803,6 +1779,37 @@ func (s *UpdaterService) updateContainer(ctx context.Context, cnt container.Summ
 	return nil
 }
 
+// buildRecreateCreateOptionsInternal returns the create options that reproduce
+// inspect as a new container called name, keeping its networks, aliases and
+// static addresses. Settings the daemon rejects in combination with the
+// container's network mode are dropped from inspect's config.
+func buildRecreateCreateOptionsInternal(inspect container.InspectResponse, name, apiVersion string) client.ContainerCreateOptions {
+	cfg := inspect.Config
+	nm := inspect.HostConfig.NetworkMode
+
+	// Fix for "conflicting options: hostname and the network mode"
+	// When network mode is "host" or "container:...", Hostname must be empty
+	if nm.IsHost() || nm.IsContainer() {
+		cfg.Hostname = ""
+		cfg.Domainname = ""
+	}
+
+	// Fix for "conflicting options: port exposing and the container type network mode"
+	// When network mode is "container:...", port mappings are not allowed
+	if nm.IsContainer() {
+		cfg.ExposedPorts = nil
+		inspect.HostConfig.PortBindings = nil
+		inspect.HostConfig.PublishAllPorts = false
+	}
+
+	return client.ContainerCreateOptions{
+		Config:           cfg,
+		HostConfig:       inspect.HostConfig,
+		NetworkingConfig: buildUpdaterRecreateNetworkingConfigInternal(nm, inspect.NetworkSettings, apiVersion),
+		Name:             name,
+	}
+}
+
 func buildUpdaterRecreateNetworkingConfigInternal(networkMode container.NetworkMode, settings *container.NetworkSettings, apiVersion string) *network.NetworkingConfig {
 	if networkMode.IsContainer() || settings == nil || len(settings.Networks) == 0 {
 		return nil
@@ -1071,7 +2078,7 @@ func (s *UpdaterService) recordRun(ctx context.Context, item updater.ResourceRes
 		ResourceName:    item.ResourceName,
 		Status:          models.AutoUpdateStatus(item.Status),
 		StartTime:       time.Now(),
-		UpdateAvailable: item.Status == "updated" || item.Status == "update_available",
+		UpdateAvailable: item.Status == "updated" || item.Status == "update_available" || item.Status == string(models.AutoUpdateStatusMonitorOnly),
 		UpdateApplied:   item.UpdateApplied,
 	}
 
@@ -1094,6 +2101,10 @@ func (s *UpdaterService) recordRun(ctx context.Context, item updater.ResourceRes
 		rec.NewImageVersions = newv
 	}
 
+	if runID, ok := ctx.Value(autoUpdateRunKey{}).(string); ok {
+		rec.RunID = &runID
+	}
+
 	end := time.Now()
 	rec.EndTime = &end
 
@@ -1133,15 +2144,9 @@ func (s *UpdaterService) restartContainersUsingOldIDs(ctx context.Context, oldID
 	list := listResult.Items
 	slog.DebugContext(ctx, "restartContainersUsingOldIDs: scanning containers for matching images", "containers", len(list), "oldIDMatches", len(oldIDToNewRef), "oldRefMatches", len(oldRefToNewRef))
 
-	// Parse excluded containers settings
-	excludedSetting := s.settingsService.GetStringSetting(ctx, "autoUpdateExcludedContainers", "")
-	excludedContainers := make(map[string]bool)
-	if excludedSetting != "" {
-		parts := strings.SplitSeq(excludedSetting, ",")
-		for p := range parts {
-			excludedContainers[strings.TrimSpace(p)] = true
-		}
-	}
+	excludedContainers := s.excludedContainersInternal(ctx)
+
+	monitorOnlyTargets := s.monitorOnlyTargetsInternal(ctx)
 
 	updatedNorm := map[string]string{}
 	for oldRef, nr := range oldRefToNewRef {
@@ -1159,6 +2164,7 @@ func (s *UpdaterService) restartContainersUsingOldIDs(ctx context.Context, oldID
 
 	plansByName := map[string]*restartPlan{}
 	markedForRestart := map[string]bool{}
+	var monitorOnlyResults []updater.ResourceResult
 	containersWithDeps := make([]arcaneupdater.ContainerWithDeps, 0, len(list))
 
 	// Cache resolved IDs for newRefs to avoid repeated API calls
@@ -1190,11 +2196,6 @@ func (s *UpdaterService) restartContainersUsingOldIDs(ctx context.Context, oldID
 		}
 
 		name := s.getContainerName(c)
-		containersWithDeps = append(containersWithDeps, arcaneupdater.ContainerWithDeps{
-			Container: c,
-			Name:      name,
-		})
-
 		newRef, match := s.resolveContainerImageMatchInternal(c, oldIDToNewRef, updatedNorm)
 
 		if newRef != "" {
@@ -1213,6 +2214,28 @@ func (s *UpdaterService) restartContainersUsingOldIDs(ctx context.Context, oldID
 			}
 		}
 
+		// Monitor-only containers report the update but are never restarted,
+		// neither directly nor as a dependent of another container.
+		if s.isMonitorOnlyInternal(c.Labels, name, monitorOnlyTargets) {
+			if newRef != "" {
+				monitorOnlyResults = append(monitorOnlyResults, updater.ResourceResult{
+					ResourceID:      c.ID,
+					ResourceName:    name,
+					ResourceType:    "container",
+					Status:          string(models.AutoUpdateStatusMonitorOnly),
+					UpdateAvailable: true,
+					OldImages:       map[string]string{"main": match},
+					NewImages:       map[string]string{"main": s.normalizeRef(newRef)},
+				})
+			}
+			continue
+		}
+
+		containersWithDeps = append(containersWithDeps, arcaneupdater.ContainerWithDeps{
+			Container: c,
+			Name:      name,
+		})
+
 		p := &restartPlan{cnt: c, newRef: newRef, match: match, explicit: newRef != ""}
 		plansByName[name] = p
 		if p.explicit {
@@ -1277,7 +2300,7 @@ func (s *UpdaterService) restartContainersUsingOldIDs(ctx context.Context, oldID
 		sorted = candidates
 	}
 
-	var results []updater.ResourceResult
+	results := monitorOnlyResults
 	for _, cd := range sorted {
 		p := plansByName[cd.Name]
 		if p == nil {
@@ -1322,6 +2345,7 @@ func (s *UpdaterService) restartContainersUsingOldIDs(ctx context.Context, oldID
 			results = append(results, res)
 			continue
 		}
+		res.OldImages = s.previousImageVersionsInternal(ctx, dcli, p.cnt.ImageID, p.match, p.newRef)
 
 		slog.DebugContext(ctx, "restartContainersUsingOldIDs: restarting container", "containerId", p.cnt.ID, "container", name, "match", p.match, "newRef", p.newRef, "implicit", p.implicit)
 
@@ -1403,6 +2427,50 @@ func (s *UpdaterService) beginProjectUpdateInternal(projectID string) func() {
 	}
 }
 
+// excludedContainersInternal returns the container names listed in the
+// autoUpdateExcludedContainers setting.
+func (s *UpdaterService) excludedContainersInternal(ctx context.Context) map[string]bool {
+	excluded := make(map[string]bool)
+	for name := range strings.SplitSeq(s.settingsService.GetStringSetting(ctx, "autoUpdateExcludedContainers", ""), ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			excluded[name] = true
+		}
+	}
+	return excluded
+}
+
+// monitorOnlyTargetsInternal returns the container and project names listed in
+// the autoUpdateMonitorOnly setting.
+func (s *UpdaterService) monitorOnlyTargetsInternal(ctx context.Context) map[string]struct{} {
+	targets := map[string]struct{}{}
+	if s.settingsService == nil {
+		return targets
+	}
+	for name := range strings.SplitSeq(s.settingsService.GetStringSetting(ctx, "autoUpdateMonitorOnly", ""), ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			targets[name] = struct{}{}
+		}
+	}
+	return targets
+}
+
+// isMonitorOnlyInternal reports whether updates for a container should only be
+// detected, based on its labels or on its name or compose project being listed
+// in the monitor-only setting.
+func (s *UpdaterService) isMonitorOnlyInternal(labels map[string]string, containerName string, targets map[string]struct{}) bool {
+	if arcaneupdater.IsMonitorOnly(labels) {
+		return true
+	}
+	if _, ok := targets[strings.TrimPrefix(containerName, "/")]; ok {
+		return true
+	}
+	if project := composeProjectNameFromLabelsInternal(labels); project != "" {
+		_, ok := targets[project]
+		return ok
+	}
+	return false
+}
+
 func composeProjectNameFromLabelsInternal(labels map[string]string) string {
 	if len(labels) == 0 {
 		return ""
@@ -1451,6 +2519,25 @@ func (s *UpdaterService) resolveContainerImageMatchInternal(c container.Summary,
 	return "", ""
 }
 
+// resolveContainerPullableRefInternal resolves the image reference a
+// container would be updated from, falling back to the tags of its image
+// when the container only records an image ID.
+func resolveContainerPullableRefInternal(ctx context.Context, dcli *client.Client, cnt container.Summary, inspect container.InspectResponse) (ref, source string) {
+	configImageRef := ""
+	if inspect.Config != nil {
+		configImageRef = strings.TrimSpace(inspect.Config.Image)
+	}
+	ref, source = resolvePullableImageRefInternal(cnt.Image, configImageRef, nil)
+	if ref == "" && inspect.Image != "" {
+		if imageInspect, inspectErr := dcli.ImageInspect(ctx, inspect.Image); inspectErr == nil {
+			ref, source = resolvePullableImageRefInternal(cnt.Image, configImageRef, imageInspect.RepoTags)
+		} else {
+			slog.DebugContext(ctx, "failed to inspect container image for fallback refs", "containerID", cnt.ID, "imageID", inspect.Image, "error", inspectErr)
+		}
+	}
+	return ref, source
+}
+
 func resolvePullableImageRefInternal(summaryImage, inspectConfigImage string, repoTags []string) (ref, source string) {
 	if image := strings.TrimSpace(inspectConfigImage); image != "" && !isImageIDLikeReferenceInternal(image) {
 		return image, "container_inspect_config"
@@ -1471,7 +2558,81 @@ func resolvePullableImageRefInternal(summaryImage, inspectConfigImage string, re
 	return "", ""
 }
 
+// containerRegistriesInternal returns the registries whose credentials may be
+// used for a container's image: the ones scoped to the project the container
+// belongs to, which are tried first, and the global ones. The scoped ones are
+// also returned as pull credentials, since pulls without credentials only use
+// the global registries.
+func (s *UpdaterService) containerRegistriesInternal(ctx context.Context, labels map[string]string) ([]models.ContainerRegistry, []containerregistry.Credential) {
+	if s.registryService == nil {
+		return nil, nil
+	}
+
+	global, _ := s.registryService.GetEnabledRegistries(ctx)
+	scoped, err := s.registryService.GetComposeProjectRegistries(ctx, composeProjectNameFromLabelsInternal(labels))
+	if err != nil {
+		slog.WarnContext(ctx, "failed to load project registries; using global registries only", "error", err)
+		return global, nil
+	}
+	creds, err := registryCredentialsInternal(scoped)
+	if err != nil {
+		slog.WarnContext(ctx, "failed to decrypt project registry credentials; using global registries only", "error", err)
+		return global, nil
+	}
+	return append(scoped, global...), creds
+}
+
 // parseNormalizedRef expects a normalized ref in the form "host/repository:tag".
+// verifyImageSignatureInternal applies the signature policy of the registry
+// ref belongs to. It returns why the update has to be blocked, or "" when the
+// image may be pulled. Images from registries without a policy pass; with a
+// policy, failing to resolve or verify the signature blocks the update.
+func (s *UpdaterService) verifyImageSignatureInternal(ctx context.Context, registryClient *arcRegistry.Client, ref, digest, authHeader string, enabledRegs []models.ContainerRegistry) string {
+	host, repository, tag := s.parseNormalizedRef(ref)
+	if host == "" {
+		return ""
+	}
+
+	var reg *models.ContainerRegistry
+	for i := range enabledRegs {
+		if enabledRegs[i].SignatureMode != "" && arcRegistry.IsRegistryMatch(enabledRegs[i].URL, host) {
+			reg = &enabledRegs[i]
+			break
+		}
+	}
+	if reg == nil {
+		return ""
+	}
+
+	policy, err := signature.ParsePolicy(reg.SignatureMode, stringPtrValue(reg.SignatureTrust), stringPtrValue(reg.SignatureIdentity), stringPtrValue(reg.SignatureIssuer))
+	if err != nil {
+		return fmt.Sprintf("invalid signature policy for %s: %v", reg.URL, err)
+	}
+
+	if digest == "" {
+		digest, err = registryClient.GetLatestDigest(ctx, host, repository, tag, authHeader)
+		if err != nil {
+			return fmt.Sprintf("could not resolve digest to verify signature: %v", err)
+		}
+	}
+
+	if err := signature.NewVerifier(registryClient).Verify(ctx, host, repository, digest, authHeader, policy); err != nil {
+		slog.WarnContext(ctx, "Image signature verification failed", "image", ref, "digest", digest, "mode", policy.Mode, "error", err)
+		return err.Error()
+	}
+	slog.DebugContext(ctx, "Image signature verified", "image", ref, "digest", digest, "mode", policy.Mode)
+	return ""
+}
+
+func (s *UpdaterService) notifyUpdateBlockedInternal(ctx context.Context, imageRef, reason string) {
+	if s.notificationService == nil {
+		return
+	}
+	if err := s.notificationService.SendUpdateBlockedNotification(ctx, imageRef, reason); err != nil {
+		slog.WarnContext(ctx, "Failed to send update blocked notification", "image", imageRef, "error", err)
+	}
+}
+
 func (s *UpdaterService) parseNormalizedRef(ref string) (host, repository, tag string) {
 	// host/repo:tag
 	parts := strings.SplitN(ref, "/", 2)
@@ -1534,6 +2695,8 @@ func (s *UpdaterService) logAutoUpdate(ctx context.Context, sev models.EventSeve
 		} else {
 			title = "Auto-update: project"
 		}
+	case "preconditions":
+		title = "Auto-update run aborted: preconditions failed"
 	case "complete":
 		title = "Auto-update run completed"
 	}
@@ -1555,6 +2718,8 @@ func (s *UpdaterService) severityFromStatus(status string) models.EventSeverity
 		return models.EventSeverityError
 	case "updated":
 		return models.EventSeveritySuccess
+	case "blocked":
+		return models.EventSeverityWarning
 	default:
 		return models.EventSeverityInfo
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/getarcaneapp/arcane/backend/internal/database"
 	glsqlite "github.com/glebarez/sqlite"
 	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/api/types/image"
 	"github.com/moby/moby/api/types/network"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -19,6 +20,8 @@ import (
 
 	"github.com/getarcaneapp/arcane/backend/internal/models"
 	"github.com/getarcaneapp/arcane/backend/internal/utils/arcaneupdater"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/pagination"
+	"github.com/getarcaneapp/arcane/types/updater"
 )
 
 // mockSystemUpgradeService is a simple mock implementation for testing
@@ -705,3 +708,259 @@ func TestComposeProjectNameFromLabelsInternal(t *testing.T) {
 		"com.docker.compose.project": " my-project ",
 	}))
 }
+
+func TestUpdaterService_IsMonitorOnlyInternal(t *testing.T) {
+	svc := &UpdaterService{}
+	targets := map[string]struct{}{"web": {}, "media": {}}
+
+	assert.False(t, svc.isMonitorOnlyInternal(nil, "db", targets))
+	assert.True(t, svc.isMonitorOnlyInternal(map[string]string{arcaneupdater.LabelMonitorOnly: "true"}, "db", nil))
+	assert.True(t, svc.isMonitorOnlyInternal(map[string]string{arcaneupdater.LabelWatchtowerMonitorOnly: "true"}, "db", nil))
+	assert.True(t, svc.isMonitorOnlyInternal(nil, "/web", targets))
+	assert.True(t, svc.isMonitorOnlyInternal(map[string]string{"com.docker.compose.project": "media"}, "media-jellyfin-1", targets))
+	assert.False(t, svc.isMonitorOnlyInternal(map[string]string{"com.docker.compose.project": "other"}, "other-app-1", targets))
+}
+
+func TestPlanImageRetentionInternal(t *testing.T) {
+	svc := &UpdaterService{}
+	trackedRefs := map[string]struct{}{
+		"docker.io/library/nginx:1.27": {},
+		"ghcr.io/acme/api:latest":      {},
+	}
+	images := []image.Summary{
+		{ID: "sha256:current", Created: 500, RepoTags: []string{"nginx:1.27"}},
+		{ID: "sha256:stopped", Created: 450, RepoDigests: []string{"nginx@sha256:aaa"}},
+		{ID: "sha256:old1", Created: 400, RepoTags: []string{"nginx:1.26"}},
+		{ID: "sha256:old2", Created: 300, RepoDigests: []string{"nginx@sha256:bbb"}},
+		{ID: "sha256:old3", Created: 200, RepoDigests: []string{"nginx@sha256:ccc"}},
+		{ID: "sha256:old4", Created: 100, RepoTags: []string{"<none>:<none>"}, RepoDigests: []string{"docker.io/library/nginx@sha256:ddd"}},
+		{ID: "sha256:api-old", Created: 100, RepoDigests: []string{"ghcr.io/acme/api@sha256:eee"}},
+		{ID: "sha256:untracked", Created: 50, RepoTags: []string{"redis:6"}},
+	}
+	inUse := map[string]struct{}{"sha256:stopped": {}}
+
+	plan := svc.planImageRetentionInternal(images, trackedRefs, inUse, 2)
+	assert.Equal(t, 2, plan.repositories)
+	assert.Equal(t, 3, plan.kept)
+
+	removed := make([]string, len(plan.remove))
+	for i, img := range plan.remove {
+		removed[i] = img.ID
+	}
+	assert.Equal(t, []string{"sha256:old3", "sha256:old4"}, removed)
+
+	plan = svc.planImageRetentionInternal(images, trackedRefs, inUse, 0)
+	assert.Len(t, plan.remove, 5)
+}
+
+func TestUpdaterService_RunsGroupRecords(t *testing.T) {
+	ctx := context.Background()
+	db := setupUpdaterServiceTestDB(t)
+	require.NoError(t, db.AutoMigrate(&models.AutoUpdateRun{}, &models.AutoUpdateRecord{}))
+	svc := &UpdaterService{db: db}
+
+	run := svc.beginRunInternal(WithAutoUpdateTrigger(ctx, models.AutoUpdateTriggerWebhook), false)
+	require.NotNil(t, run)
+	runCtx := withAutoUpdateRunInternal(ctx, run)
+	require.NoError(t, svc.recordRun(runCtx, updater.ResourceResult{ResourceID: "abc", ResourceType: "container", ResourceName: "web", Status: "updated", UpdateApplied: true, NewImages: map[string]string{"main": "nginx:1.27"}}))
+	require.NoError(t, svc.recordRun(runCtx, updater.ResourceResult{ResourceID: "def", ResourceType: "container", ResourceName: "db", Status: "failed", Error: "pull failed"}))
+	svc.finishRunInternal(ctx, run, &updater.Result{Checked: 2, Updated: 1, Failed: 1}, nil)
+
+	// A record written outside a run is not grouped.
+	require.NoError(t, svc.recordRun(ctx, updater.ResourceResult{ResourceID: "ghi", ResourceType: "container", Status: "checked"}))
+	svc.finishRunInternal(ctx, svc.beginRunInternal(ctx, true), nil, errors.New("docker unavailable"))
+
+	runs, _, err := svc.ListRunsPaginated(ctx, pagination.QueryParams{Filters: map[string]string{"trigger": "webhook"}})
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	assert.Equal(t, "completed", runs[0].Status)
+	assert.Equal(t, 1, runs[0].Updated)
+	assert.Equal(t, 1, runs[0].Failed)
+
+	detail, err := svc.GetRun(ctx, run.ID)
+	require.NoError(t, err)
+	require.Len(t, detail.Items, 2)
+	assert.Equal(t, "web", detail.Items[0].ResourceName)
+	assert.Equal(t, map[string]string{"main": "nginx:1.27"}, detail.Items[0].NewImages)
+	assert.Equal(t, "pull failed", *detail.Items[1].Error)
+
+	runs, _, err = svc.ListRunsPaginated(ctx, pagination.QueryParams{Filters: map[string]string{"trigger": "manual"}})
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	assert.Equal(t, "failed", runs[0].Status)
+	assert.True(t, runs[0].DryRun)
+
+	_, err = svc.GetRun(ctx, "missing")
+	var notFound *models.NotFoundError
+	require.ErrorAs(t, err, &notFound)
+}
+
+func TestUpdaterService_RetryRunRequiresFailedItems(t *testing.T) {
+	ctx := context.Background()
+	db := setupUpdaterServiceTestDB(t)
+	require.NoError(t, db.AutoMigrate(&models.AutoUpdateRun{}, &models.AutoUpdateRecord{}))
+	svc := &UpdaterService{db: db}
+
+	_, err := svc.RetryRun(ctx, "missing")
+	var notFound *models.NotFoundError
+	require.ErrorAs(t, err, &notFound)
+
+	inProgress := svc.beginRunInternal(ctx, false)
+	require.NotNil(t, inProgress)
+	_, err = svc.RetryRun(ctx, inProgress.ID)
+	var conflict *models.ConflictError
+	require.ErrorAs(t, err, &conflict)
+
+	run := svc.beginRunInternal(ctx, false)
+	require.NotNil(t, run)
+	require.NoError(t, svc.recordRun(withAutoUpdateRunInternal(ctx, run), updater.ResourceResult{ResourceID: "abc", ResourceType: "container", ResourceName: "web", Status: "updated", UpdateApplied: true}))
+	svc.finishRunInternal(ctx, run, &updater.Result{Checked: 1, Updated: 1}, nil)
+
+	_, err = svc.RetryRun(ctx, run.ID)
+	var validation *models.ValidationError
+	require.ErrorAs(t, err, &validation)
+
+	var count int64
+	require.NoError(t, db.Model(&models.AutoUpdateRun{}).Where("retry_of_run_id IS NOT NULL").Count(&count).Error)
+	assert.Zero(t, count, "no retry run is started when there is nothing to retry")
+}
+
+func TestUpdaterService_RunPreconditionsInternal(t *testing.T) {
+	disk := diskSpaceCheckInternal("/var/lib/docker", 512*1024*1024, 1024)
+	assert.Equal(t, updater.PreconditionFailed, disk.Status)
+	assert.Equal(t, "512 MB free on /var/lib/docker, 1024 MB required", disk.Message)
+	assert.Equal(t, updater.PreconditionPassed, diskSpaceCheckInternal("/", 2048*1024*1024, 1024).Status)
+
+	load := hostLoadCheckInternal(6.2, 4, 90)
+	assert.Equal(t, updater.PreconditionFailed, load.Status)
+	assert.Equal(t, "load average 6.20 on 4 CPUs is 155% of capacity, limit 90%", load.Message)
+	assert.Equal(t, updater.PreconditionPassed, hostLoadCheckInternal(1, 4, 90).Status)
+
+	hosts := []string{"docker.io", "ghcr.io"}
+	assert.Equal(t, updater.PreconditionPassed, registriesCheckInternal(hosts, nil).Status)
+	registries := registriesCheckInternal(hosts, map[string]error{"ghcr.io": errors.New("i/o timeout")})
+	assert.Equal(t, updater.PreconditionFailed, registries.Status)
+	assert.Equal(t, "unreachable registries: ghcr.io (i/o timeout)", registries.Message)
+
+	err := &UpdaterPreconditionError{Checks: []updater.PreconditionCheck{disk, registries, {Name: preconditionHostLoad, Status: updater.PreconditionSkipped}}}
+	assert.True(t, preconditionsFailedInternal(err.Checks))
+	assert.Equal(t, "update run preconditions failed: 512 MB free on /var/lib/docker, 1024 MB required; unreachable registries: ghcr.io (i/o timeout)", err.Error())
+}
+
+func TestUpdaterService_AcquireRunInternal(t *testing.T) {
+	svc := &UpdaterService{}
+
+	release := svc.acquireRunInternal(false)
+	require.NotNil(t, release)
+	assert.Nil(t, svc.acquireRunInternal(false), "a second run is refused while one is in progress")
+	assert.Nil(t, svc.acquireRunInternal(true), "dry runs report a run in progress too")
+	release()
+
+	dryRelease := svc.acquireRunInternal(true)
+	require.NotNil(t, dryRelease)
+	assert.NotNil(t, svc.acquireRunInternal(false), "dry runs do not hold up real runs")
+}
+
+func TestUpdaterService_RollbackImagesKeptForWindow(t *testing.T) {
+	ctx := context.Background()
+	db := setupUpdaterServiceTestDB(t)
+	require.NoError(t, db.AutoMigrate(&models.AutoUpdateRun{}, &models.AutoUpdateRecord{}))
+	svc := &UpdaterService{db: db}
+
+	require.Error(t, svc.retainRollbackImagesInternal(ctx, map[string]string{"sha256:old": "nginx:1.27"}, time.Hour), "images are recorded on a run")
+
+	run := svc.beginRunInternal(ctx, false)
+	require.NotNil(t, run)
+	require.NoError(t, svc.retainRollbackImagesInternal(withAutoUpdateRunInternal(ctx, run), map[string]string{"sha256:old": "nginx:1.27"}, time.Hour))
+	svc.finishRunInternal(ctx, run, &updater.Result{Checked: 1, Updated: 1}, nil)
+
+	retained, err := svc.rollbackImageIDsInternal(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]struct{}{"sha256:old": {}}, retained, "finishing the run keeps the recorded images")
+
+	detail, err := svc.GetRun(ctx, run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"sha256:old": "nginx:1.27"}, detail.RetainedImages)
+	require.NotNil(t, detail.RetainedUntil)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), *detail.RetainedUntil, time.Minute)
+
+	require.NoError(t, db.Model(&models.AutoUpdateRun{}).Where("id = ?", run.ID).Update("retained_until", time.Now().Add(-time.Minute)).Error)
+	retained, err = svc.rollbackImageIDsInternal(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, retained, "images are released once the window has passed")
+}
+
+func TestRepoDigestForRefInternal(t *testing.T) {
+	digests := []string{
+		"ghcr.io/acme/web@sha256:1111111111111111111111111111111111111111111111111111111111111111",
+		"nginx@sha256:2222222222222222222222222222222222222222222222222222222222222222",
+	}
+	assert.Equal(t, digests[1], repoDigestForRefInternal(digests, "docker.io/library/nginx:1.27"))
+	assert.Equal(t, digests[0], repoDigestForRefInternal(digests, "redis:7"), "falls back to the first digest")
+	assert.Empty(t, repoDigestForRefInternal(nil, "nginx:1.27"))
+}
+
+func TestUpdaterService_LastContainerUpdateRecordInternal(t *testing.T) {
+	ctx := context.Background()
+	db := setupUpdaterServiceTestDB(t)
+	require.NoError(t, db.AutoMigrate(&models.AutoUpdateRun{}, &models.AutoUpdateRecord{}))
+	svc := &UpdaterService{db: db}
+
+	_, err := svc.lastContainerUpdateRecordInternal(ctx, "abc", "web")
+	var notFound *models.NotFoundError
+	require.ErrorAs(t, err, &notFound)
+
+	now := time.Now()
+	require.NoError(t, db.Create(&models.AutoUpdateRecord{ResourceID: "old-1", ResourceType: "container", ResourceName: "web", Status: "updated", UpdateApplied: true, StartTime: now.Add(-2 * time.Hour)}).Error)
+	latest := &models.AutoUpdateRecord{ResourceID: "old-2", ResourceType: "container", ResourceName: "web", Status: "updated", UpdateApplied: true, StartTime: now.Add(-time.Hour)}
+	require.NoError(t, db.Create(latest).Error)
+	require.NoError(t, db.Create(&models.AutoUpdateRecord{ResourceID: "abc", ResourceType: "container", ResourceName: "web", Status: models.AutoUpdateStatusRolledBack, StartTime: now}).Error)
+	require.NoError(t, db.Create(&models.AutoUpdateRecord{ResourceID: "old-3", ResourceType: "container", ResourceName: "web", Status: "failed", StartTime: now}).Error)
+
+	rec, err := svc.lastContainerUpdateRecordInternal(ctx, "abc", "web")
+	require.NoError(t, err)
+	assert.Equal(t, latest.ID, rec.ID, "updates recreate containers, so records are found by name")
+}
+
+func TestUpdaterService_ContainerRegistriesInternal_ScopedToProject(t *testing.T) {
+	ctx := context.Background()
+	_, db := setupImageServiceAuthTest(t)
+	require.NoError(t, db.AutoMigrate(&models.Project{}))
+	createTestPullRegistry(t, db, "https://ghcr.io", "gh-user", "gh-token")
+	createTestPullRegistry(t, db, "https://registry.example.com", "team-user", "team-token")
+
+	var scoped models.ContainerRegistry
+	require.NoError(t, db.Where("url = ?", "https://registry.example.com").First(&scoped).Error)
+	proj := &models.Project{Name: "team-a", Path: "/app/data/projects/team-a"}
+	require.NoError(t, db.Create(proj).Error)
+
+	registryService := NewContainerRegistryService(db)
+	require.NoError(t, registryService.SetProjectRegistries(ctx, proj.ID, []string{scoped.ID}))
+	svc := &UpdaterService{registryService: registryService}
+
+	urls := func(regs []models.ContainerRegistry) []string {
+		out := make([]string, 0, len(regs))
+		for _, r := range regs {
+			out = append(out, r.URL)
+		}
+		return out
+	}
+
+	for name, labels := range map[string]map[string]string{
+		"standalone container":      {},
+		"other project's container": {"com.docker.compose.project": "team-b"},
+	} {
+		regs, creds := svc.containerRegistriesInternal(ctx, labels)
+		assert.Equal(t, []string{"https://ghcr.io"}, urls(regs), name)
+		assert.Empty(t, creds, name)
+	}
+
+	regs, creds := svc.containerRegistriesInternal(ctx, map[string]string{"com.docker.compose.project": "team-a"})
+	assert.Equal(t, []string{"https://registry.example.com", "https://ghcr.io"}, urls(regs), "scoped registries are tried first")
+	require.Len(t, creds, 1)
+	assert.Equal(t, "team-token", creds[0].Token)
+
+	global, err := registryService.GetEnabledRegistries(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"https://ghcr.io"}, urls(global))
+}
@@ -0,0 +1,144 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"slices"
+	"strings"
+
+	"github.com/getarcaneapp/arcane/backend/internal/database"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/types/notification"
+	"gorm.io/gorm"
+)
+
+// UserNotificationService manages per-user notification subscriptions. A
+// subscription delivers selected events to the user's own email address or
+// Telegram chat using the credentials of the matching global provider.
+type UserNotificationService struct {
+	db *database.DB
+}
+
+func NewUserNotificationService(db *database.DB) *UserNotificationService {
+	return &UserNotificationService{db: db}
+}
+
+func (s *UserNotificationService) ListSubscriptions(ctx context.Context, userID string) ([]notification.Subscription, error) {
+	var rows []models.UserNotificationSubscription
+	if err := s.db.WithContext(ctx).Where("user_id = ?", userID).Order("provider ASC").Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to list notification subscriptions: %w", err)
+	}
+
+	available, err := s.enabledProvidersInternal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]notification.Subscription, 0, len(rows))
+	for i := range rows {
+		out = append(out, toSubscriptionDTOInternal(&rows[i], available))
+	}
+	return out, nil
+}
+
+// SetSubscription creates or replaces the user's subscription for provider.
+func (s *UserNotificationService) SetSubscription(ctx context.Context, userID string, provider models.NotificationProvider, req notification.SubscriptionUpdate) (*notification.Subscription, error) {
+	if !models.SupportsPersonalTarget(provider) {
+		return nil, &models.ValidationError{Message: fmt.Sprintf("provider %q does not support personal subscriptions", provider), Field: "provider"}
+	}
+
+	target, err := normalizeSubscriptionTargetInternal(provider, req.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(models.StringSlice, 0, len(req.Events))
+	for _, event := range req.Events {
+		event = strings.TrimSpace(event)
+		if !models.IsValidNotificationEvent(models.NotificationEventType(event)) {
+			return nil, &models.ValidationError{Message: fmt.Sprintf("unknown notification event %q", event), Field: "events"}
+		}
+		if !slices.Contains(events, event) {
+			events = append(events, event)
+		}
+	}
+
+	var row models.UserNotificationSubscription
+	err = s.db.WithContext(ctx).Where("user_id = ? AND provider = ?", userID, provider).First(&row).Error
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		row = models.UserNotificationSubscription{UserID: userID, Provider: provider}
+	case err != nil:
+		return nil, fmt.Errorf("failed to load notification subscription: %w", err)
+	}
+
+	row.Target = target
+	row.Events = events
+	row.Enabled = req.Enabled
+	if err := s.db.WithContext(ctx).Save(&row).Error; err != nil {
+		return nil, fmt.Errorf("failed to save notification subscription: %w", err)
+	}
+
+	available, err := s.enabledProvidersInternal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := toSubscriptionDTOInternal(&row, available)
+	return &out, nil
+}
+
+func (s *UserNotificationService) DeleteSubscription(ctx context.Context, userID string, provider models.NotificationProvider) error {
+	result := s.db.WithContext(ctx).Where("user_id = ? AND provider = ?", userID, provider).Delete(&models.UserNotificationSubscription{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete notification subscription: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return &models.NotFoundError{Message: "notification subscription not found"}
+	}
+	return nil
+}
+
+func (s *UserNotificationService) enabledProvidersInternal(ctx context.Context) (map[models.NotificationProvider]bool, error) {
+	var providers []models.NotificationProvider
+	if err := s.db.WithContext(ctx).Model(&models.NotificationSettings{}).Where("enabled = ?", true).Pluck("provider", &providers).Error; err != nil {
+		return nil, fmt.Errorf("failed to list notification providers: %w", err)
+	}
+	available := make(map[models.NotificationProvider]bool, len(providers))
+	for _, p := range providers {
+		available[p] = true
+	}
+	return available, nil
+}
+
+func normalizeSubscriptionTargetInternal(provider models.NotificationProvider, target string) (string, error) {
+	target = strings.TrimSpace(target)
+	if target == "" {
+		return "", &models.ValidationError{Message: "target is required", Field: "target"}
+	}
+	if provider == models.NotificationProviderEmail {
+		addr, err := mail.ParseAddress(target)
+		if err != nil {
+			return "", &models.ValidationError{Message: "target must be a valid email address", Field: "target"}
+		}
+		return addr.Address, nil
+	}
+	return target, nil
+}
+
+func toSubscriptionDTOInternal(row *models.UserNotificationSubscription, available map[models.NotificationProvider]bool) notification.Subscription {
+	events := []string(row.Events)
+	if events == nil {
+		events = []string{}
+	}
+	return notification.Subscription{
+		ID:                row.ID,
+		Provider:          notification.Provider(row.Provider),
+		Target:            row.Target,
+		Events:            events,
+		Enabled:           row.Enabled,
+		ProviderAvailable: available[row.Provider],
+		UpdatedAt:         row.UpdatedAt,
+	}
+}
@@ -0,0 +1,149 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	glsqlite "github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/getarcaneapp/arcane/backend/internal/config"
+	"github.com/getarcaneapp/arcane/backend/internal/database"
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/types/notification"
+)
+
+func setupUserNotificationTestDB(t *testing.T) *database.DB {
+	t.Helper()
+
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared", strings.NewReplacer("/", "_", " ", "_").Replace(t.Name()))
+	db, err := gorm.Open(glsqlite.Open(dsn), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&models.NotificationSettings{}, &models.UserNotificationSubscription{}))
+
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+	sqlDB.SetMaxIdleConns(1)
+
+	return &database.DB{DB: db}
+}
+
+func TestUserNotificationService_SetListDelete(t *testing.T) {
+	ctx := context.Background()
+	db := setupUserNotificationTestDB(t)
+	svc := NewUserNotificationService(db)
+
+	sub, err := svc.SetSubscription(ctx, "user-1", models.NotificationProviderEmail, notification.SubscriptionUpdate{
+		Target:  "Me <me@example.com>",
+		Events:  []string{"image_update", "image_update", "auto_heal"},
+		Enabled: false,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "me@example.com", sub.Target)
+	require.Equal(t, []string{"image_update", "auto_heal"}, sub.Events)
+	require.False(t, sub.Enabled, "a disabled subscription must stay disabled")
+	require.False(t, sub.ProviderAvailable)
+
+	notificationSvc := NewNotificationService(db, &config.Config{})
+	_, err = notificationSvc.CreateOrUpdateSettings(ctx, models.NotificationProviderEmail, true, models.JSON{"smtpHost": "smtp.example.com"})
+	require.NoError(t, err)
+
+	_, err = svc.SetSubscription(ctx, "user-1", models.NotificationProviderEmail, notification.SubscriptionUpdate{
+		Target:  "me@example.com",
+		Events:  []string{"prune_report"},
+		Enabled: true,
+	})
+	require.NoError(t, err)
+
+	subs, err := svc.ListSubscriptions(ctx, "user-1")
+	require.NoError(t, err)
+	require.Len(t, subs, 1)
+	require.Equal(t, []string{"prune_report"}, subs[0].Events)
+	require.True(t, subs[0].Enabled)
+	require.True(t, subs[0].ProviderAvailable)
+
+	others, err := svc.ListSubscriptions(ctx, "user-2")
+	require.NoError(t, err)
+	require.Empty(t, others)
+
+	require.NoError(t, svc.DeleteSubscription(ctx, "user-1", models.NotificationProviderEmail))
+	var notFound *models.NotFoundError
+	require.True(t, errors.As(svc.DeleteSubscription(ctx, "user-1", models.NotificationProviderEmail), &notFound))
+}
+
+func TestUserNotificationService_Validation(t *testing.T) {
+	ctx := context.Background()
+	svc := NewUserNotificationService(setupUserNotificationTestDB(t))
+
+	tests := []struct {
+		name     string
+		provider models.NotificationProvider
+		req      notification.SubscriptionUpdate
+	}{
+		{"unsupported provider", models.NotificationProviderDiscord, notification.SubscriptionUpdate{Target: "x", Events: []string{"image_update"}}},
+		{"invalid email", models.NotificationProviderEmail, notification.SubscriptionUpdate{Target: "not-an-email", Events: []string{"image_update"}}},
+		{"empty telegram chat", models.NotificationProviderTelegram, notification.SubscriptionUpdate{Target: " ", Events: []string{"image_update"}}},
+		{"unknown event", models.NotificationProviderTelegram, notification.SubscriptionUpdate{Target: "12345", Events: []string{"bogus"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.SetSubscription(ctx, "user-1", tt.provider, tt.req)
+			var validationErr *models.ValidationError
+			require.True(t, errors.As(err, &validationErr), "expected validation error, got %v", err)
+		})
+	}
+}
+
+func TestNotificationService_DeliveryTargetsIncludeSubscriptions(t *testing.T) {
+	ctx := context.Background()
+	db := setupUserNotificationTestDB(t)
+	svc := NewNotificationService(db, &config.Config{})
+	userSvc := NewUserNotificationService(db)
+
+	_, err := svc.CreateOrUpdateSettings(ctx, models.NotificationProviderEmail, true, models.JSON{
+		"smtpHost":    "smtp.example.com",
+		"toAddresses": []string{"ops@example.com"},
+		"events":      map[string]bool{"image_update": false},
+		"role":        models.NotificationRoleFallback,
+	})
+	require.NoError(t, err)
+
+	for user, target := range map[string]string{"user-1": "me@example.com", "user-2": "ops@example.com"} {
+		_, err := userSvc.SetSubscription(ctx, user, models.NotificationProviderEmail, notification.SubscriptionUpdate{
+			Target:  target,
+			Events:  []string{"image_update"},
+			Enabled: true,
+		})
+		require.NoError(t, err)
+	}
+	// Telegram is not configured globally, so this subscription is skipped.
+	_, err = userSvc.SetSubscription(ctx, "user-1", models.NotificationProviderTelegram, notification.SubscriptionUpdate{
+		Target:  "12345",
+		Events:  []string{"image_update"},
+		Enabled: true,
+	})
+	require.NoError(t, err)
+
+	settings, targets, err := svc.getDeliveryTargetsInternal(ctx, models.NotificationEventImageUpdate)
+	require.NoError(t, err)
+	require.Len(t, settings, 1)
+	require.Len(t, targets, 1, "only the subscriber not already on the global list")
+
+	personal := targets[0]
+	require.Equal(t, models.NotificationProviderEmail, personal.Provider)
+	require.Equal(t, []string{"me@example.com"}, personal.Config["toAddresses"])
+	require.Equal(t, "smtp.example.com", personal.Config["smtpHost"])
+	require.True(t, svc.isEventEnabled(personal.Config, models.NotificationEventImageUpdate),
+		"the global event filter must not apply to a personal subscription")
+	require.NotContains(t, personal.Config, "role", "a personal target must not inherit the provider's fallback role")
+
+	settings, targets, err = svc.getDeliveryTargetsInternal(ctx, models.NotificationEventPruneReport)
+	require.NoError(t, err)
+	require.Len(t, settings, 1)
+	require.Empty(t, targets)
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"slices"
 	"strings"
 	"time"
 
@@ -25,12 +26,14 @@ import (
 const (
 	versionTTL            = 3 * time.Hour
 	versionCheckURL       = "https://api.github.com/repos/getarcaneapp/arcane/releases/latest"
+	releasesURL           = "https://api.github.com/repos/getarcaneapp/arcane/releases?per_page=30"
 	defaultRequestTimeout = 15 * time.Second
 )
 
 type VersionService struct {
 	httpClient               *http.Client
 	cache                    *cache.Cache[string]
+	releasesCache            *cache.Cache[[]version.Release]
 	disabled                 bool
 	version                  string
 	revision                 string
@@ -38,15 +41,16 @@ type VersionService struct {
 	dockerService            *DockerClientService
 }
 
-func NewVersionService(httpClient *http.Client, disabled bool, version string, revision string, containerRegistryService *ContainerRegistryService, dockerService *DockerClientService) *VersionService {
+func NewVersionService(httpClient *http.Client, disabled bool, appVersion string, revision string, containerRegistryService *ContainerRegistryService, dockerService *DockerClientService) *VersionService {
 	if httpClient == nil {
 		httpClient = http.DefaultClient
 	}
 	return &VersionService{
 		httpClient:               httpClient,
 		cache:                    cache.New[string](versionTTL),
+		releasesCache:            cache.New[[]version.Release](versionTTL),
 		disabled:                 disabled,
-		version:                  version,
+		version:                  appVersion,
 		revision:                 revision,
 		containerRegistryService: containerRegistryService,
 		dockerService:            dockerService,
@@ -176,6 +180,118 @@ func (s *VersionService) GetVersionInformation(ctx context.Context, currentVersi
 	return check, nil
 }
 
+// githubReleaseInternal is the part of a GitHub release payload Arcane uses.
+type githubReleaseInternal struct {
+	TagName     string     `json:"tag_name"`
+	Name        string     `json:"name"`
+	Body        string     `json:"body"`
+	HTMLURL     string     `json:"html_url"`
+	PublishedAt *time.Time `json:"published_at"`
+	Draft       bool       `json:"draft"`
+	Prerelease  bool       `json:"prerelease"`
+}
+
+// GetReleases returns the recent stable releases, newest first.
+func (s *VersionService) GetReleases(ctx context.Context) ([]version.Release, error) {
+	releases, err := s.releasesCache.GetOrFetch(ctx, func(ctx context.Context) ([]version.Release, error) {
+		reqCtx, cancel := context.WithTimeout(ctx, defaultRequestTimeout)
+		defer cancel()
+
+		req, err := http.NewRequestWithContext(reqCtx, http.MethodGet, releasesURL, nil)
+		if err != nil {
+			return nil, fmt.Errorf("create GitHub request: %w", err)
+		}
+
+		resp, err := s.httpClient.Do(req) //nolint:gosec // intentional request to fixed GitHub releases API endpoint
+		if err != nil {
+			return nil, fmt.Errorf("get releases: %w", err)
+		}
+		defer func() { _ = resp.Body.Close() }()
+
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("GitHub API returned status %d", resp.StatusCode)
+		}
+
+		var payload []githubReleaseInternal
+		if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+			return nil, fmt.Errorf("decode payload: %w", err)
+		}
+
+		return s.stableReleasesInternal(payload), nil
+	})
+
+	var staleErr *cache.ErrStale
+	if errors.As(err, &staleErr) {
+		slog.Warn("Failed to fetch releases, returning stale cache", "error", staleErr.Err)
+		return releases, nil
+	}
+
+	return releases, err
+}
+
+// stableReleasesInternal drops drafts and pre-releases and sorts the rest
+// newest first.
+func (s *VersionService) stableReleasesInternal(payload []githubReleaseInternal) []version.Release {
+	releases := make([]version.Release, 0, len(payload))
+	for _, r := range payload {
+		if r.Draft || r.Prerelease || r.TagName == "" {
+			continue
+		}
+		url := r.HTMLURL
+		if url == "" {
+			url = s.ReleaseURL(r.TagName)
+		}
+		releases = append(releases, version.Release{
+			Version:     r.TagName,
+			Name:        r.Name,
+			Notes:       r.Body,
+			URL:         url,
+			PublishedAt: r.PublishedAt,
+		})
+	}
+
+	slices.SortStableFunc(releases, func(a, b version.Release) int {
+		return semver.Compare(s.normalizeVersion(b.Version), s.normalizeVersion(a.Version))
+	})
+	return releases
+}
+
+// GetChangelog returns the releases published since the running version.
+// Development builds are not semver, so they only get the newest release
+// notes and never report an update; digest-based updates are reported by
+// GetAppVersionInfo instead.
+func (s *VersionService) GetChangelog(ctx context.Context) (*version.Changelog, error) {
+	changelog := &version.Changelog{
+		CurrentVersion: s.normalizeVersion(s.version),
+	}
+	if s.disabled {
+		return changelog, nil
+	}
+
+	releases, err := s.GetReleases(ctx)
+	if err != nil {
+		return changelog, err
+	}
+	if len(releases) == 0 {
+		return changelog, nil
+	}
+
+	changelog.NewestVersion = releases[0].Version
+	if !s.isSemverVersion() {
+		changelog.Releases = releases[:1]
+		return changelog, nil
+	}
+
+	for _, release := range releases {
+		if !s.IsNewer(release.Version, changelog.CurrentVersion) {
+			break
+		}
+		changelog.Releases = append(changelog.Releases, release)
+	}
+	changelog.UpdateAvailable = len(changelog.Releases) > 0
+	return changelog, nil
+}
+
 // isSemverVersion checks if a version string is semver-based (e.g., v1.0.0)
 func (s *VersionService) isSemverVersion() bool {
 	version := strings.TrimSpace(s.version)
@@ -0,0 +1,75 @@
+package services
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type releasesTransportInternal struct {
+	body string
+}
+
+func (t releasesTransportInternal) RoundTrip(req *http.Request) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(t.body)),
+		Request:    req,
+	}, nil
+}
+
+const testReleasesPayload = `[
+	{"tag_name": "v1.5.0-rc.1", "prerelease": true, "body": "rc"},
+	{"tag_name": "v1.3.0", "name": "Arcane 1.3", "body": "third", "html_url": "https://github.com/getarcaneapp/arcane/releases/tag/v1.3.0"},
+	{"tag_name": "v1.4.0", "body": "fourth", "published_at": "2026-09-01T10:00:00Z"},
+	{"tag_name": "v1.2.0", "body": "second"},
+	{"tag_name": "v1.6.0", "draft": true}
+]`
+
+func newTestVersionService(current string) *VersionService {
+	httpClient := &http.Client{Transport: releasesTransportInternal{body: testReleasesPayload}}
+	return NewVersionService(httpClient, false, current, "abcdef0123", nil, nil)
+}
+
+func TestVersionService_GetChangelog(t *testing.T) {
+	changelog, err := newTestVersionService("1.2.0").GetChangelog(context.Background())
+	require.NoError(t, err)
+
+	require.Equal(t, "v1.2.0", changelog.CurrentVersion)
+	require.Equal(t, "v1.4.0", changelog.NewestVersion)
+	require.True(t, changelog.UpdateAvailable)
+	require.Len(t, changelog.Releases, 2)
+	require.Equal(t, "v1.4.0", changelog.Releases[0].Version)
+	require.Equal(t, "https://github.com/getarcaneapp/arcane/releases/tag/v1.4.0", changelog.Releases[0].URL)
+	require.NotNil(t, changelog.Releases[0].PublishedAt)
+	require.Equal(t, "Arcane 1.3", changelog.Releases[1].Name)
+	require.Equal(t, "third", changelog.Releases[1].Notes)
+}
+
+func TestVersionService_GetChangelog_UpToDate(t *testing.T) {
+	changelog, err := newTestVersionService("v1.4.0").GetChangelog(context.Background())
+	require.NoError(t, err)
+	require.False(t, changelog.UpdateAvailable)
+	require.Empty(t, changelog.Releases)
+	require.Equal(t, "v1.4.0", changelog.NewestVersion)
+}
+
+func TestVersionService_GetChangelog_DevelopmentBuild(t *testing.T) {
+	changelog, err := newTestVersionService("next").GetChangelog(context.Background())
+	require.NoError(t, err)
+	require.False(t, changelog.UpdateAvailable)
+	require.Len(t, changelog.Releases, 1)
+	require.Equal(t, "v1.4.0", changelog.Releases[0].Version)
+}
+
+func TestVersionService_GetChangelog_Disabled(t *testing.T) {
+	svc := NewVersionService(&http.Client{Transport: releasesTransportInternal{body: "invalid"}}, true, "1.2.0", "", nil, nil)
+	changelog, err := svc.GetChangelog(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, changelog.Releases)
+}
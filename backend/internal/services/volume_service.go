@@ -187,10 +187,10 @@ func (s *VolumeService) DeleteVolume(ctx context.Context, name string, force boo
 
 func (s *VolumeService) PruneVolumes(ctx context.Context) (*volumetypes.PruneReport, error) {
 	slog.DebugContext(ctx, "volume service: prune volumes")
-	return s.PruneVolumesWithOptions(ctx, false)
+	return s.PruneVolumesWithOptions(ctx, false, nil)
 }
 
-func (s *VolumeService) PruneVolumesWithOptions(ctx context.Context, all bool) (*volumetypes.PruneReport, error) {
+func (s *VolumeService) PruneVolumesWithOptions(ctx context.Context, all bool, filters client.Filters) (*volumetypes.PruneReport, error) {
 	slog.DebugContext(ctx, "volume service: prune volumes with options", "all", all)
 	dockerClient, err := s.dockerService.GetClient(ctx)
 	if err != nil {
@@ -202,7 +202,8 @@ func (s *VolumeService) PruneVolumesWithOptions(ctx context.Context, all bool) (
 	// - With 'all=true' flag: Removes ALL unused volumes (both named and anonymous)
 	// Note: Volumes are considered "in use" if referenced by any container (running or stopped)
 	volumePruneResult, err := dockerClient.VolumePrune(ctx, client.VolumePruneOptions{
-		All: all,
+		All:     all,
+		Filters: filters,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to prune volumes: %w", err)
@@ -998,6 +998,7 @@ type scheduledScanNotificationSummary struct {
 	severityCounts    map[string]int
 	sampleCVEs        []string
 	seenCVEs          map[string]struct{}
+	findings          []VulnerabilityFinding
 }
 
 func newScheduledScanNotificationSummary() *scheduledScanNotificationSummary {
@@ -1107,6 +1108,15 @@ func (s *VulnerabilityService) collectScheduledFixableSummaryInternal(
 			sev = "UNKNOWN"
 		}
 		summary.severityCounts[sev]++
+		summary.findings = append(summary.findings, VulnerabilityFinding{
+			ImageName:        result.ImageName,
+			VulnerabilityID:  strings.TrimSpace(v.VulnerabilityID),
+			Severity:         sev,
+			PkgName:          v.PkgName,
+			InstalledVersion: v.InstalledVersion,
+			FixedVersion:     v.FixedVersion,
+			Title:            v.Title,
+		})
 
 		cveID := strings.TrimSpace(v.VulnerabilityID)
 		if cveID == "" {
@@ -1137,6 +1147,7 @@ func (s *VulnerabilityService) sendScheduledVulnerabilitySummaryInternal(
 		Severity:     fmt.Sprintf("Critical:%d High:%d Medium:%d Low:%d Unknown:%d", summary.severityCounts["CRITICAL"], summary.severityCounts["HIGH"], summary.severityCounts["MEDIUM"], summary.severityCounts["LOW"], summary.severityCounts["UNKNOWN"]),
 		ImageName:    fmt.Sprintf("%d image(s) scanned, %d with fixable vulnerabilities", scanned, summary.imagesWithFixable),
 		FixedVersion: fmt.Sprintf("%d fixable vulnerability record(s)", summary.totalFixable),
+		Findings:     summary.findings,
 	}
 	if len(summary.sampleCVEs) > 0 {
 		payload.PkgName = strings.Join(summary.sampleCVEs, ", ")
@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strings"
 
 	"github.com/getarcaneapp/arcane/backend/internal/utils/registry"
 	"github.com/moby/moby/client"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	ref "go.podman.io/image/v5/docker/reference"
 )
 
@@ -32,11 +34,27 @@ type CheckResult struct {
 	RemoteDigest  string
 	Error         error
 	CheckedViaAPI bool // True if we checked via registry API, false if we had to pull
+	// Platform is the os/arch[/variant] the remote tag was resolved for.
+	Platform string
+	// PlatformUnsupported is set when the remote tag is a manifest list that
+	// no longer provides Platform, so pulling it would fail or fetch the
+	// wrong architecture.
+	PlatformUnsupported bool
 }
 
 // CheckImageNeedsUpdate checks if an image has a newer version available without pulling
 // Returns true if the remote digest differs from the local digest
 func (c *DigestChecker) CheckImageNeedsUpdate(ctx context.Context, imageRef string, authToken string) CheckResult {
+	return c.CheckImageNeedsUpdateForPlatform(ctx, imageRef, authToken, ocispec.Platform{})
+}
+
+// CheckImageNeedsUpdateForPlatform is CheckImageNeedsUpdate for a specific
+// platform, such as a compose service's platform field or the platform of the
+// image a container currently runs. An empty platform means the platform of
+// the local image. For multi-arch tags the platform-specific digest is
+// compared as well, since images pulled by it record that digest instead of
+// the manifest list's.
+func (c *DigestChecker) CheckImageNeedsUpdateForPlatform(ctx context.Context, imageRef string, authToken string, platform ocispec.Platform) CheckResult {
 	result := CheckResult{}
 
 	// Parse image reference
@@ -48,18 +66,24 @@ func (c *DigestChecker) CheckImageNeedsUpdate(ctx context.Context, imageRef stri
 		"repository", repository,
 		"tag", tag)
 
-	// Get local digest
-	localDigest, err := c.getLocalDigest(ctx, imageRef)
-	if err != nil {
+	// Get local digests
+	local, localErr := c.getLocalImage(ctx, imageRef)
+	if localErr == nil {
+		result.LocalDigest = local.digests[0]
+		if platform.Architecture == "" {
+			platform = local.platform
+		}
+	} else {
 		slog.DebugContext(ctx, "CheckImageNeedsUpdate: failed to get local digest",
 			"imageRef", imageRef,
-			"error", err)
-		// Image not present locally - definitely needs update
-		result.NeedsUpdate = true
-		result.Error = err
-		return result
+			"error", localErr)
+		if platform.Architecture == "" {
+			// Image not present locally - definitely needs update
+			result.NeedsUpdate = true
+			result.Error = localErr
+			return result
+		}
 	}
-	result.LocalDigest = localDigest
 
 	// Get remote digest via HEAD request
 	remoteDigest, err := c.registryClient.GetLatestDigest(ctx, registryHost, repository, tag, authToken)
@@ -68,46 +92,120 @@ func (c *DigestChecker) CheckImageNeedsUpdate(ctx context.Context, imageRef stri
 			"imageRef", imageRef,
 			"error", err)
 		// Can't determine remotely - caller should fall back to pull
+		result.NeedsUpdate = localErr != nil
 		result.Error = err
 		return result
 	}
-
 	result.RemoteDigest = remoteDigest
+
+	remoteDigests := []string{remoteDigest}
+	if localErr != nil || !slices.Contains(local.digests, remoteDigest) {
+		platformDigest, supported := c.resolvePlatformDigest(ctx, registryHost, repository, tag, authToken, platform)
+		if platform.Architecture != "" {
+			result.Platform = registry.FormatPlatform(registry.NormalizePlatform(platform))
+		}
+		if !supported {
+			slog.WarnContext(ctx, "CheckImageNeedsUpdate: remote tag does not provide the image platform",
+				"imageRef", imageRef,
+				"platform", result.Platform)
+			result.PlatformUnsupported = true
+			result.CheckedViaAPI = true
+			result.Error = fmt.Errorf("%s does not provide platform %s", imageRef, result.Platform)
+			return result
+		}
+		if platformDigest != "" {
+			remoteDigests = append(remoteDigests, platformDigest)
+		}
+	}
+
+	if localErr != nil {
+		// The platform is available but the image has to be pulled.
+		result.NeedsUpdate = true
+		result.Error = localErr
+		return result
+	}
+
 	result.CheckedViaAPI = true
-	result.NeedsUpdate = localDigest != remoteDigest
+	result.NeedsUpdate = true
+	for _, d := range remoteDigests {
+		if slices.Contains(local.digests, d) {
+			result.NeedsUpdate = false
+			break
+		}
+	}
 
 	slog.DebugContext(ctx, "CheckImageNeedsUpdate: digest comparison complete",
 		"imageRef", imageRef,
-		"localDigest", localDigest,
+		"localDigest", result.LocalDigest,
 		"remoteDigest", remoteDigest,
+		"platform", result.Platform,
 		"needsUpdate", result.NeedsUpdate)
 
 	return result
 }
 
-// getLocalDigest retrieves the digest of a locally stored image
-func (c *DigestChecker) getLocalDigest(ctx context.Context, imageRef string) (string, error) {
+// ImagePlatform returns the platform of a locally stored image.
+func (c *DigestChecker) ImagePlatform(ctx context.Context, imageRef string) (ocispec.Platform, error) {
+	local, err := c.getLocalImage(ctx, imageRef)
+	if err != nil {
+		return ocispec.Platform{}, err
+	}
+	return local.platform, nil
+}
+
+// resolvePlatformDigest looks up the manifest for platform when the tag is a
+// manifest list. It returns an empty digest when that can't be determined
+// and reports false only when the list definitely lacks the platform.
+func (c *DigestChecker) resolvePlatformDigest(ctx context.Context, registryHost, repository, tag, authToken string, platform ocispec.Platform) (string, bool) {
+	if platform.Architecture == "" {
+		return "", true
+	}
+	index, err := c.registryClient.GetManifestIndex(ctx, registryHost, repository, tag, authToken)
+	if err != nil {
+		slog.DebugContext(ctx, "CheckImageNeedsUpdate: failed to fetch manifest list",
+			"repository", repository,
+			"tag", tag,
+			"error", err)
+		return "", true
+	}
+	if !index.IsIndex() {
+		return "", true
+	}
+	return index.Resolve(platform)
+}
+
+type localImage struct {
+	digests  []string
+	platform ocispec.Platform
+}
+
+// getLocalImage retrieves the digests and platform of a locally stored image
+func (c *DigestChecker) getLocalImage(ctx context.Context, imageRef string) (localImage, error) {
 	inspect, err := c.dcli.ImageInspect(ctx, imageRef)
 	if err != nil {
-		return "", fmt.Errorf("image not found locally: %w", err)
+		return localImage{}, fmt.Errorf("image not found locally: %w", err)
+	}
+
+	local := localImage{
+		platform: ocispec.Platform{OS: inspect.Os, Architecture: inspect.Architecture, Variant: inspect.Variant},
 	}
 
 	// Try to get digest from RepoDigests
 	for _, rd := range inspect.RepoDigests {
-		if strings.Contains(rd, "@sha256:") {
-			parts := strings.Split(rd, "@")
-			if len(parts) == 2 {
-				return parts[1], nil
-			}
+		if _, digest, ok := strings.Cut(rd, "@"); ok && strings.HasPrefix(digest, "sha256:") {
+			local.digests = append(local.digests, digest)
 		}
 	}
 
 	// Fall back to image ID (which is a content-addressed hash)
-	if inspect.ID != "" {
-		return inspect.ID, nil
+	if len(local.digests) == 0 && inspect.ID != "" {
+		local.digests = append(local.digests, inspect.ID)
 	}
 
-	return "", fmt.Errorf("no digest available for image")
+	if len(local.digests) == 0 {
+		return localImage{}, fmt.Errorf("no digest available for image")
+	}
+	return local, nil
 }
 
 // CompareWithPulled compares the current container's image with a freshly pulled image
@@ -4,58 +4,112 @@ import "strings"
 
 const (
 	// Core labels
-	LabelArcane  = "com.getarcaneapp.arcane"         // Identifies the Arcane container itself
-	LabelUpdater = "com.getarcaneapp.arcane.updater" // Enable/disable updates (true/false)
+	LabelArcane      = "com.getarcaneapp.arcane"              // Identifies the Arcane container itself
+	LabelUpdater     = "com.getarcaneapp.arcane.updater"      // Enable/disable updates (true/false)
+	LabelMonitorOnly = "com.getarcaneapp.arcane.monitor-only" // Detect and notify, never apply (true/false)
 
 	// Dependency labels
 	LabelDependsOn  = "com.getarcaneapp.arcane.depends-on"  // Comma-separated list of container names this depends on
 	LabelStopSignal = "com.getarcaneapp.arcane.stop-signal" // Custom stop signal (e.g., SIGINT)
 )
 
-// IsArcaneContainer checks if the container is the Arcane application itself
-func IsArcaneContainer(labels map[string]string) bool {
-	if labels == nil {
-		return false
-	}
-	for k, v := range labels {
-		if strings.EqualFold(k, LabelArcane) {
-			switch strings.TrimSpace(strings.ToLower(v)) {
-			case "true", "1", "yes", "on":
-				return true
+// Watchtower labels are honored as fallbacks so containers migrated from
+// Watchtower keep their behavior without relabeling. Arcane labels win when
+// both are present.
+const (
+	LabelWatchtowerEnable      = "com.centurylinklabs.watchtower.enable"       // Enable/disable updates (true/false)
+	LabelWatchtowerMonitorOnly = "com.centurylinklabs.watchtower.monitor-only" // Detect and notify, never apply (true/false)
+	LabelWatchtowerDependsOn   = "com.centurylinklabs.watchtower.depends-on"   // Comma-separated list of container names this depends on
+	LabelWatchtowerStopSignal  = "com.centurylinklabs.watchtower.stop-signal"  // Custom stop signal (e.g., SIGINT)
+)
+
+// LabelComposeDependsOn is set by Docker Compose from a service's depends_on,
+// as "service:condition:restart" entries separated by commas.
+const LabelComposeDependsOn = "com.docker.compose.depends_on"
+
+// lookupLabel returns the value of the first of keys present in labels,
+// matching keys case-insensitively.
+func lookupLabel(labels map[string]string, keys ...string) (string, bool) {
+	for _, key := range keys {
+		for k, v := range labels {
+			if strings.EqualFold(k, key) {
+				return v, true
 			}
 		}
 	}
+	return "", false
+}
+
+func isTruthy(v string) bool {
+	switch strings.TrimSpace(strings.ToLower(v)) {
+	case "true", "1", "yes", "on":
+		return true
+	}
+	return false
+}
+
+func isFalsy(v string) bool {
+	switch strings.TrimSpace(strings.ToLower(v)) {
+	case "false", "0", "no", "off":
+		return true
+	}
 	return false
 }
 
-// IsUpdateDisabled returns true if the special label is present and evaluates to false.
+// IsArcaneContainer checks if the container is the Arcane application itself
+func IsArcaneContainer(labels map[string]string) bool {
+	v, ok := lookupLabel(labels, LabelArcane)
+	return ok && isTruthy(v)
+}
+
+// IsUpdateDisabled returns true if the updater label (or Watchtower's enable
+// label) is present and evaluates to false.
 // Accepts false/0/no/off (case-insensitive) as "disabled". Default is enabled.
 func IsUpdateDisabled(labels map[string]string) bool {
-	if labels == nil {
-		return false
+	v, ok := lookupLabel(labels, LabelUpdater, LabelWatchtowerEnable)
+	return ok && isFalsy(v)
+}
+
+// IsMonitorOnly returns true if the container should only be checked for
+// updates, never updated.
+func IsMonitorOnly(labels map[string]string) bool {
+	v, ok := lookupLabel(labels, LabelMonitorOnly, LabelWatchtowerMonitorOnly)
+	return ok && isTruthy(v)
+}
+
+// GetDependsOn returns the container names listed in the depends-on label.
+func GetDependsOn(labels map[string]string) []string {
+	v, ok := lookupLabel(labels, LabelDependsOn, LabelWatchtowerDependsOn)
+	if !ok {
+		return nil
 	}
-	for k, v := range labels {
-		if strings.EqualFold(k, LabelUpdater) {
-			switch strings.TrimSpace(strings.ToLower(v)) {
-			case "false", "0", "no", "off":
-				return true
-			default:
-				return false
-			}
+	var deps []string
+	for dep := range strings.SplitSeq(v, ",") {
+		// Watchtower names may carry the leading slash Docker uses.
+		dep = strings.TrimPrefix(strings.TrimSpace(dep), "/")
+		if dep != "" {
+			deps = append(deps, dep)
 		}
 	}
-	return false
+	return deps
 }
 
-// GetStopSignal returns the custom stop signal if set, otherwise empty string
-func GetStopSignal(labels map[string]string) string {
-	if labels == nil {
-		return ""
-	}
-	for k, v := range labels {
-		if strings.EqualFold(k, LabelStopSignal) {
-			return strings.TrimSpace(strings.ToUpper(v))
+// GetComposeDependsOn returns the compose service names listed in the
+// depends_on label Compose writes on its containers.
+func GetComposeDependsOn(labels map[string]string) []string {
+	v := labels[LabelComposeDependsOn]
+	var deps []string
+	for entry := range strings.SplitSeq(v, ",") {
+		service, _, _ := strings.Cut(strings.TrimSpace(entry), ":")
+		if service != "" {
+			deps = append(deps, service)
 		}
 	}
-	return ""
+	return deps
+}
+
+// GetStopSignal returns the custom stop signal if set, otherwise empty string
+func GetStopSignal(labels map[string]string) string {
+	v, _ := lookupLabel(labels, LabelStopSignal, LabelWatchtowerStopSignal)
+	return strings.TrimSpace(strings.ToUpper(v))
 }
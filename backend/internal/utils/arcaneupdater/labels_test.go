@@ -1,6 +1,7 @@
 package arcaneupdater
 
 import (
+	"slices"
 	"testing"
 )
 
@@ -132,6 +133,21 @@ func TestIsUpdateDisabled(t *testing.T) {
 			labels: map[string]string{"COM.GETARCANEAPP.ARCANE.UPDATER": "false"},
 			want:   true,
 		},
+		{
+			name:   "watchtower enable false",
+			labels: map[string]string{LabelWatchtowerEnable: "false"},
+			want:   true,
+		},
+		{
+			name:   "watchtower enable true - enabled",
+			labels: map[string]string{LabelWatchtowerEnable: "true"},
+			want:   false,
+		},
+		{
+			name:   "arcane label wins over watchtower",
+			labels: map[string]string{LabelUpdater: "true", LabelWatchtowerEnable: "false"},
+			want:   false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -194,6 +210,16 @@ func TestGetStopSignal(t *testing.T) {
 			labels: map[string]string{"COM.GETARCANEAPP.ARCANE.STOP-SIGNAL": "SIGINT"},
 			want:   "SIGINT",
 		},
+		{
+			name:   "watchtower stop signal",
+			labels: map[string]string{LabelWatchtowerStopSignal: "SIGHUP"},
+			want:   "SIGHUP",
+		},
+		{
+			name:   "arcane stop signal wins over watchtower",
+			labels: map[string]string{LabelStopSignal: "SIGINT", LabelWatchtowerStopSignal: "SIGHUP"},
+			want:   "SIGINT",
+		},
 	}
 
 	for _, tt := range tests {
@@ -204,3 +230,97 @@ func TestGetStopSignal(t *testing.T) {
 		})
 	}
 }
+
+func TestIsMonitorOnly(t *testing.T) {
+	tests := []struct {
+		name   string
+		labels map[string]string
+		want   bool
+	}{
+		{
+			name:   "nil labels",
+			labels: nil,
+			want:   false,
+		},
+		{
+			name:   "watchtower monitor-only true",
+			labels: map[string]string{LabelWatchtowerMonitorOnly: "true"},
+			want:   true,
+		},
+		{
+			name:   "watchtower monitor-only false",
+			labels: map[string]string{LabelWatchtowerMonitorOnly: "false"},
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsMonitorOnly(tt.labels); got != tt.want {
+				t.Errorf("IsMonitorOnly() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetDependsOn(t *testing.T) {
+	tests := []struct {
+		name   string
+		labels map[string]string
+		want   []string
+	}{
+		{
+			name:   "nil labels",
+			labels: nil,
+			want:   nil,
+		},
+		{
+			name:   "arcane depends-on",
+			labels: map[string]string{LabelDependsOn: "db, cache"},
+			want:   []string{"db", "cache"},
+		},
+		{
+			name:   "watchtower depends-on with leading slash",
+			labels: map[string]string{LabelWatchtowerDependsOn: "/db,/cache"},
+			want:   []string{"db", "cache"},
+		},
+		{
+			name:   "arcane depends-on wins over watchtower",
+			labels: map[string]string{LabelDependsOn: "db", LabelWatchtowerDependsOn: "cache"},
+			want:   []string{"db"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetDependsOn(tt.labels); !slices.Equal(got, tt.want) {
+				t.Errorf("GetDependsOn() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetComposeDependsOn(t *testing.T) {
+	tests := []struct {
+		name   string
+		labels map[string]string
+		want   []string
+	}{
+		{name: "no label", labels: map[string]string{}, want: nil},
+		{name: "empty label", labels: map[string]string{LabelComposeDependsOn: ""}, want: nil},
+		{name: "legacy format", labels: map[string]string{LabelComposeDependsOn: "db:service_started"}, want: []string{"db"}},
+		{
+			name:   "condition and restart",
+			labels: map[string]string{LabelComposeDependsOn: "db:service_healthy:true, cache:service_started:false"},
+			want:   []string{"db", "cache"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetComposeDependsOn(tt.labels); !slices.Equal(got, tt.want) {
+				t.Errorf("GetComposeDependsOn() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
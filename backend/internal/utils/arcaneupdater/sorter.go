@@ -143,15 +143,8 @@ func ExtractContainerDeps(ctx context.Context, dcli *client.Client, cnt containe
 	}
 
 	// Extract explicit depends-on from label
-	if inspect.Config != nil && inspect.Config.Labels != nil {
-		if deps, ok := inspect.Config.Labels[LabelDependsOn]; ok {
-			for dep := range strings.SplitSeq(deps, ",") {
-				dep = strings.TrimSpace(dep)
-				if dep != "" {
-					c.DependsOn = append(c.DependsOn, dep)
-				}
-			}
-		}
+	if inspect.Config != nil {
+		c.DependsOn = append(c.DependsOn, GetDependsOn(inspect.Config.Labels)...)
 	}
 
 	// Extract implicit dependencies from network mode (container:xxx)
@@ -66,3 +66,14 @@ func (c *Cache[T]) GetOrFetch(ctx context.Context, fetch func(ctx context.Contex
 	v, _ := res.(T)
 	return v, nil
 }
+
+// Invalidate drops the cached value so the next GetOrFetch fetches again.
+func (c *Cache[T]) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var zero T
+	c.val = zero
+	c.set = false
+	c.exp = time.Time{}
+}
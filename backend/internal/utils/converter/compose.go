@@ -0,0 +1,67 @@
+package converter
+
+import (
+	"strings"
+
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+)
+
+// ToComposeService maps parsed docker run options onto a compose service.
+func ToComposeService(parsed *models.DockerRunCommand) models.DockerComposeService {
+	service := models.DockerComposeService{
+		Image:         parsed.Image,
+		ContainerName: parsed.Name,
+		Ports:         parsed.Ports,
+		Volumes:       parsed.Volumes,
+		Environment:   parsed.Environment,
+		Restart:       parsed.Restart,
+		WorkingDir:    parsed.Workdir,
+		User:          parsed.User,
+		Entrypoint:    parsed.Entrypoint,
+		Command:       parsed.Command,
+		Privileged:    parsed.Privileged,
+		Labels:        parsed.Labels,
+	}
+
+	for _, n := range parsed.Networks {
+		if IsNetworkMode(n) {
+			service.NetworkMode = n
+			continue
+		}
+		service.Networks = append(service.Networks, n)
+	}
+
+	if parsed.Interactive && parsed.TTY {
+		service.StdinOpen = true
+		service.TTY = true
+	}
+
+	if parsed.HealthCheck != "" {
+		service.Healthcheck = &models.DockerComposeHealthcheck{
+			Test: parsed.HealthCheck,
+		}
+	}
+
+	if parsed.MemoryLimit != "" || parsed.CPULimit != "" {
+		service.Deploy = &models.DockerComposeDeploy{
+			Resources: &models.DockerComposeResources{
+				Limits: &models.DockerComposeResourceLimits{
+					Memory: parsed.MemoryLimit,
+					CPUs:   parsed.CPULimit,
+				},
+			},
+		}
+	}
+
+	return service
+}
+
+// IsNetworkMode reports whether a --network value selects a network mode
+// rather than a network to attach to.
+func IsNetworkMode(network string) bool {
+	switch network {
+	case "host", "none", "bridge", "default":
+		return true
+	}
+	return strings.HasPrefix(network, "container:") || strings.HasPrefix(network, "service:")
+}
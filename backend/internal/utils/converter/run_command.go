@@ -0,0 +1,278 @@
+package converter
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/api/types/mount"
+	"github.com/moby/moby/api/types/network"
+)
+
+// ImageDefaults holds the settings a container inherits from its image. They
+// are left out of a generated command so it only carries what was overridden.
+type ImageDefaults struct {
+	Env         []string
+	Entrypoint  []string
+	Cmd         []string
+	Labels      map[string]string
+	WorkingDir  string
+	User        string
+	Healthcheck []string
+}
+
+// FromContainerInspect reconstructs the docker run options that produce a
+// container equivalent to inspect.
+func FromContainerInspect(inspect container.InspectResponse, image ImageDefaults) *models.DockerRunCommand {
+	result := &models.DockerRunCommand{
+		Name:     strings.TrimPrefix(inspect.Name, "/"),
+		Detached: true,
+	}
+
+	if cfg := inspect.Config; cfg != nil {
+		result.Image = cfg.Image
+		result.Interactive = cfg.OpenStdin
+		result.TTY = cfg.Tty
+
+		for _, env := range cfg.Env {
+			if !slices.Contains(image.Env, env) {
+				result.Environment = append(result.Environment, env)
+			}
+		}
+
+		for _, key := range slices.Sorted(maps.Keys(cfg.Labels)) {
+			value := cfg.Labels[key]
+			if strings.HasPrefix(key, "com.docker.compose.") {
+				continue
+			}
+			if imageValue, ok := image.Labels[key]; ok && imageValue == value {
+				continue
+			}
+			result.Labels = append(result.Labels, key+"="+value)
+		}
+
+		if cfg.WorkingDir != image.WorkingDir {
+			result.Workdir = cfg.WorkingDir
+		}
+		if cfg.User != image.User {
+			result.User = cfg.User
+		}
+
+		// Overriding the entrypoint resets the image command, so the full
+		// command has to be repeated in that case.
+		entrypoint := []string(cfg.Entrypoint)
+		cmd := []string(cfg.Cmd)
+		switch {
+		case len(entrypoint) > 0 && !slices.Equal(entrypoint, image.Entrypoint):
+			result.Entrypoint = entrypoint[0]
+			result.Command = joinShellWords(append(slices.Clone(entrypoint[1:]), cmd...))
+		case !slices.Equal(cmd, image.Cmd):
+			result.Command = joinShellWords(cmd)
+		}
+
+		if hc := cfg.Healthcheck; hc != nil && len(hc.Test) > 0 && !slices.Equal(hc.Test, image.Healthcheck) {
+			switch hc.Test[0] {
+			case "CMD-SHELL":
+				result.HealthCheck = strings.Join(hc.Test[1:], " ")
+			case "CMD":
+				result.HealthCheck = joinShellWords(hc.Test[1:])
+			}
+		}
+	}
+
+	if hc := inspect.HostConfig; hc != nil {
+		result.Privileged = hc.Privileged
+		result.Remove = hc.AutoRemove
+
+		switch hc.RestartPolicy.Name {
+		case "", container.RestartPolicyDisabled:
+		case container.RestartPolicyOnFailure:
+			result.Restart = string(hc.RestartPolicy.Name)
+			if hc.RestartPolicy.MaximumRetryCount > 0 {
+				result.Restart += ":" + strconv.Itoa(hc.RestartPolicy.MaximumRetryCount)
+			}
+		default:
+			result.Restart = string(hc.RestartPolicy.Name)
+		}
+
+		for port, bindings := range hc.PortBindings {
+			for _, b := range bindings {
+				spec := strings.TrimSuffix(port.String(), "/tcp")
+				if b.HostPort != "" {
+					spec = b.HostPort + ":" + spec
+				}
+				if b.HostIP.IsValid() && !b.HostIP.IsUnspecified() {
+					spec = b.HostIP.String() + ":" + spec
+				}
+				result.Ports = append(result.Ports, spec)
+			}
+		}
+		slices.Sort(result.Ports)
+
+		if hc.Memory > 0 {
+			result.MemoryLimit = formatByteSize(hc.Memory)
+		}
+		if hc.NanoCPUs > 0 {
+			result.CPULimit = strconv.FormatFloat(float64(hc.NanoCPUs)/1e9, 'f', -1, 64)
+		}
+
+		nm := hc.NetworkMode
+		if nm.IsHost() || nm.IsNone() || nm.IsContainer() {
+			result.Networks = append(result.Networks, string(nm))
+		}
+	}
+
+	for _, m := range inspect.Mounts {
+		var spec string
+		switch m.Type {
+		case mount.TypeVolume:
+			if isAnonymousVolume(m.Name) {
+				spec = m.Destination
+			} else {
+				spec = m.Name + ":" + m.Destination
+			}
+		case mount.TypeBind:
+			spec = m.Source + ":" + m.Destination
+		default:
+			continue
+		}
+		if !m.RW && spec != m.Destination {
+			spec += ":ro"
+		}
+		result.Volumes = append(result.Volumes, spec)
+	}
+
+	if len(result.Networks) == 0 && inspect.NetworkSettings != nil {
+		for _, name := range slices.Sorted(maps.Keys(inspect.NetworkSettings.Networks)) {
+			if name != "bridge" {
+				result.Networks = append(result.Networks, name)
+			}
+		}
+	}
+
+	return result
+}
+
+// FromCreateConfig reconstructs the docker run options of a container that
+// hasn't been created yet. Nothing is known about the image, so every setting
+// in cfg is kept, and binds are rendered as given.
+func FromCreateConfig(name string, cfg *container.Config, hostConfig *container.HostConfig, networking *network.NetworkingConfig) *models.DockerRunCommand {
+	inspect := container.InspectResponse{Name: name, Config: cfg, HostConfig: hostConfig}
+	if networking != nil {
+		inspect.NetworkSettings = &container.NetworkSettings{Networks: networking.EndpointsConfig}
+	}
+
+	result := FromContainerInspect(inspect, ImageDefaults{})
+	if hostConfig != nil {
+		result.Volumes = slices.Clone(hostConfig.Binds)
+	}
+	return result
+}
+
+// BuildRunCommand renders cmd as a docker run command line, quoting values
+// for a POSIX shell.
+func BuildRunCommand(cmd *models.DockerRunCommand) string {
+	args := []string{"docker", "run"}
+	if cmd.Detached {
+		args = append(args, "-d")
+	}
+	if cmd.Interactive {
+		args = append(args, "-i")
+	}
+	if cmd.TTY {
+		args = append(args, "-t")
+	}
+	if cmd.Remove {
+		args = append(args, "--rm")
+	}
+	if cmd.Privileged {
+		args = append(args, "--privileged")
+	}
+
+	addFlag := func(flag, value string) {
+		if value != "" {
+			args = append(args, flag, quoteShellWord(value))
+		}
+	}
+	addFlag("--name", cmd.Name)
+	addFlag("--restart", cmd.Restart)
+	for _, p := range cmd.Ports {
+		addFlag("-p", p)
+	}
+	for _, v := range cmd.Volumes {
+		addFlag("-v", v)
+	}
+	for _, e := range cmd.Environment {
+		addFlag("-e", e)
+	}
+	for _, n := range cmd.Networks {
+		addFlag("--network", n)
+	}
+	for _, l := range cmd.Labels {
+		addFlag("--label", l)
+	}
+	addFlag("-w", cmd.Workdir)
+	addFlag("-u", cmd.User)
+	addFlag("-m", cmd.MemoryLimit)
+	addFlag("--cpus", cmd.CPULimit)
+	addFlag("--health-cmd", cmd.HealthCheck)
+	addFlag("--entrypoint", cmd.Entrypoint)
+
+	args = append(args, quoteShellWord(cmd.Image))
+	if cmd.Command != "" {
+		// Command is already a shell-formatted string.
+		args = append(args, cmd.Command)
+	}
+
+	return strings.Join(args, " ")
+}
+
+func joinShellWords(words []string) string {
+	quoted := make([]string, len(words))
+	for i, w := range words {
+		quoted[i] = quoteShellWord(w)
+	}
+	return strings.Join(quoted, " ")
+}
+
+func quoteShellWord(s string) string {
+	if s == "" {
+		return "''"
+	}
+	if !strings.ContainsFunc(s, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("_@%+=:,./-", r))
+	}) {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'"'"'`) + "'"
+}
+
+func formatByteSize(n int64) string {
+	for _, unit := range []struct {
+		suffix string
+		size   int64
+	}{{"g", 1 << 30}, {"m", 1 << 20}, {"k", 1 << 10}} {
+		if n%unit.size == 0 {
+			return fmt.Sprintf("%d%s", n/unit.size, unit.suffix)
+		}
+	}
+	return fmt.Sprintf("%db", n)
+}
+
+// isAnonymousVolume reports whether name looks like the random ID Docker
+// gives a volume created without a name.
+func isAnonymousVolume(name string) bool {
+	if len(name) != 64 {
+		return false
+	}
+	for _, r := range name {
+		if !(r >= '0' && r <= '9' || r >= 'a' && r <= 'f') {
+			return false
+		}
+	}
+	return true
+}
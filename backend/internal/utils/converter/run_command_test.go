@@ -0,0 +1,132 @@
+package converter
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/api/types/mount"
+	"github.com/moby/moby/api/types/network"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromContainerInspect(t *testing.T) {
+	port, err := network.ParsePort("80/tcp")
+	require.NoError(t, err)
+	udpPort, err := network.ParsePort("53/udp")
+	require.NoError(t, err)
+
+	inspect := container.InspectResponse{
+		Name: "/web",
+		Config: &container.Config{
+			Image:      "nginx:alpine",
+			Env:        []string{"PATH=/usr/bin", "MODE=prod"},
+			Cmd:        []string{"nginx", "-g", "daemon off;"},
+			Entrypoint: []string{"/docker-entrypoint.sh"},
+			WorkingDir: "/srv",
+			Labels: map[string]string{
+				"maintainer":                 "nginx",
+				"com.docker.compose.project": "other",
+				"tier":                       "frontend",
+			},
+			Healthcheck: &container.HealthConfig{Test: []string{"CMD-SHELL", "curl -f http://localhost/"}},
+		},
+		HostConfig: &container.HostConfig{
+			RestartPolicy: container.RestartPolicy{Name: container.RestartPolicyOnFailure, MaximumRetryCount: 3},
+			PortBindings: network.PortMap{
+				port:    {{HostPort: "8080"}, {HostIP: netip.MustParseAddr("127.0.0.1"), HostPort: "8081"}},
+				udpPort: {{HostPort: "5353"}},
+			},
+			Resources: container.Resources{Memory: 512 << 20, NanoCPUs: 1_500_000_000},
+		},
+		Mounts: []container.MountPoint{
+			{Type: mount.TypeVolume, Name: "web_data", Destination: "/data", RW: true},
+			{Type: mount.TypeBind, Source: "/etc/nginx", Destination: "/etc/nginx", RW: false},
+			{Type: mount.TypeVolume, Name: "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef", Destination: "/cache", RW: true},
+		},
+		NetworkSettings: &container.NetworkSettings{
+			Networks: map[string]*network.EndpointSettings{"bridge": {}, "frontend": {}},
+		},
+	}
+	image := ImageDefaults{
+		Env:        []string{"PATH=/usr/bin"},
+		Entrypoint: []string{"/docker-entrypoint.sh"},
+		Cmd:        []string{"nginx", "-g", "daemon off;"},
+		Labels:     map[string]string{"maintainer": "nginx"},
+	}
+
+	got := FromContainerInspect(inspect, image)
+
+	assert.Equal(t, &models.DockerRunCommand{
+		Image:       "nginx:alpine",
+		Name:        "web",
+		Ports:       []string{"127.0.0.1:8081:80", "5353:53/udp", "8080:80"},
+		Volumes:     []string{"web_data:/data", "/etc/nginx:/etc/nginx:ro", "/cache"},
+		Environment: []string{"MODE=prod"},
+		Networks:    []string{"frontend"},
+		Restart:     "on-failure:3",
+		Workdir:     "/srv",
+		Detached:    true,
+		Labels:      []string{"tier=frontend"},
+		HealthCheck: "curl -f http://localhost/",
+		MemoryLimit: "512m",
+		CPULimit:    "1.5",
+	}, got)
+}
+
+func TestFromContainerInspect_EntrypointOverride(t *testing.T) {
+	inspect := container.InspectResponse{
+		Name: "/tool",
+		Config: &container.Config{
+			Image:      "alpine",
+			Entrypoint: []string{"sh", "-c"},
+			Cmd:        []string{"echo 'hi there'"},
+		},
+		HostConfig: &container.HostConfig{NetworkMode: "host"},
+	}
+
+	got := FromContainerInspect(inspect, ImageDefaults{Cmd: []string{"/bin/sh"}})
+	assert.Equal(t, "sh", got.Entrypoint)
+	assert.Equal(t, `-c 'echo '"'"'hi there'"'"''`, got.Command)
+	assert.Equal(t, []string{"host"}, got.Networks)
+}
+
+func TestBuildRunCommand(t *testing.T) {
+	cmd := BuildRunCommand(&models.DockerRunCommand{
+		Image:       "nginx:alpine",
+		Name:        "web",
+		Ports:       []string{"8080:80"},
+		Environment: []string{"GREETING=hello world"},
+		Restart:     "unless-stopped",
+		Detached:    true,
+		Command:     "nginx -g 'daemon off;'",
+	})
+	assert.Equal(t, "docker run -d --name web --restart unless-stopped -p 8080:80 -e 'GREETING=hello world' nginx:alpine nginx -g 'daemon off;'", cmd)
+
+	tokens, err := ParseCommandTokens(cmd[len("docker run "):])
+	require.NoError(t, err)
+	var parsed models.DockerRunCommand
+	require.NoError(t, ParseTokens(tokens, &parsed))
+	assert.Equal(t, "web", parsed.Name)
+	assert.Equal(t, []string{"GREETING=hello world"}, parsed.Environment)
+	assert.Equal(t, "nginx:alpine", parsed.Image)
+}
+
+func TestFromCreateConfig(t *testing.T) {
+	port, err := network.ParsePort("80/tcp")
+	require.NoError(t, err)
+
+	got := FromCreateConfig("web",
+		&container.Config{Image: "nginx:alpine", Env: []string{"MODE=prod"}, Cmd: []string{"nginx", "-g", "daemon off;"}},
+		&container.HostConfig{
+			Binds:         []string{"web_data:/data", "/etc/nginx:/etc/nginx:ro"},
+			PortBindings:  network.PortMap{port: {{HostPort: "8080"}}},
+			RestartPolicy: container.RestartPolicy{Name: container.RestartPolicyUnlessStopped},
+		},
+		&network.NetworkingConfig{EndpointsConfig: map[string]*network.EndpointSettings{"frontend": {}}},
+	)
+
+	assert.Equal(t, "docker run -d --name web --restart unless-stopped -p 8080:80 -v web_data:/data -v /etc/nginx:/etc/nginx:ro -e MODE=prod --network frontend nginx:alpine nginx -g 'daemon off;'", BuildRunCommand(got))
+}
@@ -10,6 +10,7 @@ var (
 	TokenCookieName         = "__Host-token" // #nosec G101: cookie name label, not a credential
 	InsecureTokenCookieName = "token"        // #nosec G101: cookie name label, not a credential
 	OidcStateCookieName     = "oidc_state"
+	CsrfCookieName          = "csrf_token"
 )
 
 func isSecure(c *gin.Context) bool {
@@ -28,6 +29,22 @@ func ClearTokenCookie(c *gin.Context) {
 	c.SetCookie(name, "", -1, "/", "", isSecure(c), true)
 }
 
+// SetCsrfCookie issues the CSRF token cookie. It is readable by scripts so the
+// frontend can echo the value in the X-CSRF-Token header.
+func SetCsrfCookie(c *gin.Context, token string) {
+	http.SetCookie(c.Writer, &http.Cookie{
+		Name:     CsrfCookieName,
+		Value:    token,
+		Path:     "/",
+		Secure:   isSecure(c),
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+func GetCsrfCookie(c *gin.Context) (string, error) {
+	return c.Cookie(CsrfCookieName)
+}
+
 func GetTokenCookie(c *gin.Context) (string, error) {
 	// Try secure name first, then fallback to insecure
 	if v, err := c.Cookie(TokenCookieName); err == nil {
@@ -0,0 +1,63 @@
+package crypto
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidSignature is returned by Verify for tokens that were altered or
+// signed with another key or for another purpose.
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// Sign returns payload and its HMAC-SHA256 signature as a URL-safe token. The
+// signing key is derived from the encryption key and purpose, so a token
+// signed for one purpose is never accepted for another.
+func Sign(purpose string, payload []byte) (string, error) {
+	mac, err := signatureInternal(purpose, payload)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(mac), nil
+}
+
+// Verify returns the payload of a token created by Sign for purpose.
+func Verify(purpose, token string) ([]byte, error) {
+	encodedPayload, encodedMAC, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, ErrInvalidSignature
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return nil, ErrInvalidSignature
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(encodedMAC)
+	if err != nil {
+		return nil, ErrInvalidSignature
+	}
+
+	expected, err := signatureInternal(purpose, payload)
+	if err != nil {
+		return nil, err
+	}
+	if !hmac.Equal(mac, expected) {
+		return nil, ErrInvalidSignature
+	}
+	return payload, nil
+}
+
+func signatureInternal(purpose string, payload []byte) ([]byte, error) {
+	if encryptionKey == nil {
+		return nil, fmt.Errorf("encryption not initialized - call InitEncryption first")
+	}
+
+	keyMAC := hmac.New(sha256.New, encryptionKey)
+	keyMAC.Write([]byte("arcane-signing:" + purpose))
+
+	mac := hmac.New(sha256.New, keyMAC.Sum(nil))
+	mac.Write(payload)
+	return mac.Sum(nil), nil
+}
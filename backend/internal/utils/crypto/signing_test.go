@@ -0,0 +1,55 @@
+package crypto
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/getarcaneapp/arcane/backend/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignVerify(t *testing.T) {
+	InitEncryption(&config.Config{
+		EncryptionKey: "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+		Environment:   "test",
+	})
+
+	token, err := Sign("notification-action", []byte(`{"a":"apply"}`))
+	require.NoError(t, err)
+	assert.NotContains(t, token, "/", "tokens are used in URL paths")
+
+	payload, err := Verify("notification-action", token)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"a":"apply"}`, string(payload))
+
+	t.Run("other purpose", func(t *testing.T) {
+		_, err := Verify("other", token)
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+	})
+
+	t.Run("altered payload", func(t *testing.T) {
+		other, err := Sign("notification-action", []byte(`{"a":"snooze"}`))
+		require.NoError(t, err)
+		otherPayload, _, _ := strings.Cut(other, ".")
+		_, mac, _ := strings.Cut(token, ".")
+		_, err = Verify("notification-action", otherPayload+"."+mac)
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		for _, bad := range []string{"", "abc", "abc.!!!", "!!!.abc"} {
+			_, err := Verify("notification-action", bad)
+			assert.ErrorIs(t, err, ErrInvalidSignature, bad)
+		}
+	})
+
+	t.Run("other key", func(t *testing.T) {
+		InitEncryption(&config.Config{
+			EncryptionKey: "fedcba9876543210fedcba9876543210fedcba9876543210fedcba9876543210",
+			Environment:   "test",
+		})
+		_, err := Verify("notification-action", token)
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+	})
+}
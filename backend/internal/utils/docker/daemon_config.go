@@ -0,0 +1,181 @@
+package docker
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/url"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// daemonReloadableKeys are the daemon.json options dockerd applies on SIGHUP
+// (systemctl reload docker). Changing any other option needs a restart.
+var daemonReloadableKeys = []string{
+	"authorization-plugins",
+	"builder",
+	"debug",
+	"default-runtime",
+	"features",
+	"insecure-registries",
+	"labels",
+	"live-restore",
+	"max-concurrent-downloads",
+	"max-concurrent-uploads",
+	"max-download-attempts",
+	"registry-mirrors",
+	"runtimes",
+	"shutdown-timeout",
+}
+
+type daemonKeyKindInternal int
+
+const (
+	daemonKeyStringInternal daemonKeyKindInternal = iota
+	daemonKeyBoolInternal
+	daemonKeyNumberInternal
+	daemonKeyStringListInternal
+	daemonKeyStringMapInternal
+)
+
+// daemonKeyKinds are the value types of common daemon.json options. Options
+// that are not listed are accepted as is, since dockerd validates them on
+// start.
+var daemonKeyKinds = map[string]daemonKeyKindInternal{
+	"data-root":                daemonKeyStringInternal,
+	"debug":                    daemonKeyBoolInternal,
+	"default-runtime":          daemonKeyStringInternal,
+	"dns":                      daemonKeyStringListInternal,
+	"experimental":             daemonKeyBoolInternal,
+	"insecure-registries":      daemonKeyStringListInternal,
+	"ipv6":                     daemonKeyBoolInternal,
+	"labels":                   daemonKeyStringListInternal,
+	"live-restore":             daemonKeyBoolInternal,
+	"log-driver":               daemonKeyStringInternal,
+	"log-level":                daemonKeyStringInternal,
+	"log-opts":                 daemonKeyStringMapInternal,
+	"max-concurrent-downloads": daemonKeyNumberInternal,
+	"max-concurrent-uploads":   daemonKeyNumberInternal,
+	"max-download-attempts":    daemonKeyNumberInternal,
+	"registry-mirrors":         daemonKeyStringListInternal,
+	"shutdown-timeout":         daemonKeyNumberInternal,
+	"storage-driver":           daemonKeyStringInternal,
+	"storage-opts":             daemonKeyStringListInternal,
+	"userland-proxy":           daemonKeyBoolInternal,
+}
+
+// ParseDaemonJSON parses daemon.json content and checks the types of the
+// common options, so mistakes are caught before dockerd refuses to start.
+// Empty content is an empty configuration.
+func ParseDaemonJSON(content []byte) (map[string]any, error) {
+	cfg := map[string]any{}
+	if len(bytes.TrimSpace(content)) == 0 {
+		return cfg, nil
+	}
+	if err := json.Unmarshal(content, &cfg); err != nil {
+		return nil, fmt.Errorf("daemon.json must be a JSON object: %w", err)
+	}
+	if cfg == nil {
+		return nil, fmt.Errorf("daemon.json must be a JSON object")
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(cfg)) {
+		kind, known := daemonKeyKinds[key]
+		if !known {
+			continue
+		}
+		if err := checkDaemonValueInternal(key, kind, cfg[key]); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, mirror := range daemonStringListInternal(cfg["registry-mirrors"]) {
+		u, err := url.Parse(mirror)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("registry-mirrors: %q must be an http or https URL", mirror)
+		}
+	}
+
+	return cfg, nil
+}
+
+func checkDaemonValueInternal(key string, kind daemonKeyKindInternal, value any) error {
+	switch kind {
+	case daemonKeyStringInternal:
+		if _, ok := value.(string); !ok {
+			return fmt.Errorf("%s must be a string", key)
+		}
+	case daemonKeyBoolInternal:
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("%s must be true or false", key)
+		}
+	case daemonKeyNumberInternal:
+		if n, ok := value.(float64); !ok || n < 0 || n != float64(int64(n)) {
+			return fmt.Errorf("%s must be a non-negative integer", key)
+		}
+	case daemonKeyStringListInternal:
+		list, ok := value.([]any)
+		if !ok {
+			return fmt.Errorf("%s must be a list of strings", key)
+		}
+		for _, item := range list {
+			if _, ok := item.(string); !ok {
+				return fmt.Errorf("%s must be a list of strings", key)
+			}
+		}
+	case daemonKeyStringMapInternal:
+		m, ok := value.(map[string]any)
+		if !ok {
+			return fmt.Errorf("%s must be an object of strings", key)
+		}
+		for name, item := range m {
+			if _, ok := item.(string); !ok {
+				return fmt.Errorf("%s.%s must be a string", key, name)
+			}
+		}
+	}
+	return nil
+}
+
+func daemonStringListInternal(value any) []string {
+	list, _ := value.([]any)
+	out := make([]string, 0, len(list))
+	for _, item := range list {
+		if s, ok := item.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// DaemonLogOption returns a log-opts entry of a parsed daemon.json.
+func DaemonLogOption(cfg map[string]any, name string) string {
+	opts, _ := cfg["log-opts"].(map[string]any)
+	value, _ := opts[name].(string)
+	return strings.TrimSpace(value)
+}
+
+// DaemonChangedKeys returns the options that differ between two parsed
+// daemon.json files, sorted.
+func DaemonChangedKeys(before, after map[string]any) []string {
+	var changed []string
+	for key, value := range after {
+		if old, ok := before[key]; !ok || !reflect.DeepEqual(old, value) {
+			changed = append(changed, key)
+		}
+	}
+	for key := range before {
+		if _, ok := after[key]; !ok {
+			changed = append(changed, key)
+		}
+	}
+	slices.Sort(changed)
+	return changed
+}
+
+// DaemonKeyReloadable reports whether dockerd applies key on reload.
+func DaemonKeyReloadable(key string) bool {
+	return slices.Contains(daemonReloadableKeys, key)
+}
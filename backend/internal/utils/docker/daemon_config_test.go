@@ -0,0 +1,56 @@
+package docker
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseDaemonJSON(t *testing.T) {
+	cfg, err := ParseDaemonJSON([]byte(`{
+		"log-driver": "json-file",
+		"log-opts": {"max-size": "10m", "max-file": "3"},
+		"live-restore": true,
+		"registry-mirrors": ["https://mirror.example.com"],
+		"some-future-option": 42
+	}`))
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if got := DaemonLogOption(cfg, "max-size"); got != "10m" {
+		t.Fatalf("expected max-size 10m, got %q", got)
+	}
+
+	if cfg, err := ParseDaemonJSON([]byte("  ")); err != nil || len(cfg) != 0 {
+		t.Fatalf("expected empty config, got %v, %v", cfg, err)
+	}
+
+	invalid := map[string]string{
+		`[]`:                                  "JSON object",
+		`{"live-restore": "yes"}`:             "live-restore",
+		`{"log-opts": {"max-file": 3}}`:       "log-opts.max-file",
+		`{"registry-mirrors": ["mirror"]}`:    "registry-mirrors",
+		`{"max-concurrent-downloads": 1.5}`:   "max-concurrent-downloads",
+		`{"insecure-registries": "reg:5000"}`: "insecure-registries",
+	}
+	for content, want := range invalid {
+		_, err := ParseDaemonJSON([]byte(content))
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Fatalf("%s: expected error mentioning %q, got %v", content, want, err)
+		}
+	}
+}
+
+func TestDaemonChangedKeys(t *testing.T) {
+	before := map[string]any{"debug": false, "log-driver": "json-file", "labels": []any{"a"}}
+	after := map[string]any{"debug": true, "labels": []any{"a"}, "live-restore": true}
+
+	got := DaemonChangedKeys(before, after)
+	want := []string{"debug", "live-restore", "log-driver"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+
+	if !DaemonKeyReloadable("live-restore") || DaemonKeyReloadable("log-driver") {
+		t.Fatal("expected live-restore to be reloadable and log-driver not")
+	}
+}
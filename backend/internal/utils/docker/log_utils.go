@@ -0,0 +1,154 @@
+package docker
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	containertypes "github.com/getarcaneapp/arcane/types/container"
+)
+
+const (
+	LogStreamStdout = "stdout"
+	LogStreamStderr = "stderr"
+)
+
+// LogFilter selects container log lines by stream and content.
+type LogFilter struct {
+	stream  string
+	substr  string
+	fold    bool
+	pattern *regexp.Regexp
+}
+
+// NewLogFilter builds a filter for query. With regex the query is a regular
+// expression, otherwise a plain substring. An empty query matches every line.
+// stream limits matches to stdout or stderr; empty or "all" keeps both.
+func NewLogFilter(query string, regex, caseSensitive bool, stream string) (*LogFilter, error) {
+	f := &LogFilter{}
+
+	switch stream {
+	case "", "all":
+	case LogStreamStdout, LogStreamStderr:
+		f.stream = stream
+	default:
+		return nil, fmt.Errorf("unknown log stream %q", stream)
+	}
+
+	if query == "" {
+		return f, nil
+	}
+	if regex {
+		if !caseSensitive {
+			query = "(?i)" + query
+		}
+		re, err := regexp.Compile(query)
+		if err != nil {
+			return nil, fmt.Errorf("invalid log search pattern: %w", err)
+		}
+		f.pattern = re
+		return f, nil
+	}
+
+	f.substr = query
+	f.fold = !caseSensitive
+	if f.fold {
+		f.substr = strings.ToLower(query)
+	}
+	return f, nil
+}
+
+// Match reports whether line passes the filter.
+func (f *LogFilter) Match(line containertypes.LogLine) bool {
+	if f.stream != "" && line.Stream != f.stream {
+		return false
+	}
+	switch {
+	case f.pattern != nil:
+		return f.pattern.MatchString(line.Message)
+	case f.substr == "":
+		return true
+	case f.fold:
+		return strings.Contains(strings.ToLower(line.Message), f.substr)
+	default:
+		return strings.Contains(line.Message, f.substr)
+	}
+}
+
+// ParseLogLine splits the timestamp Docker prepends to log lines when
+// timestamps are requested. Lines without a valid timestamp are returned
+// unchanged with an empty Timestamp.
+func ParseLogLine(stream, raw string) containertypes.LogLine {
+	raw = strings.TrimSuffix(raw, "\r")
+	line := containertypes.LogLine{Stream: stream, Message: raw}
+
+	ts, msg, ok := strings.Cut(raw, " ")
+	if !ok {
+		// A line that was empty apart from the timestamp.
+		ts, msg = raw, ""
+	}
+	if _, err := time.Parse(time.RFC3339Nano, ts); err != nil {
+		return line
+	}
+	line.Timestamp = ts
+	line.Message = msg
+	return line
+}
+
+// FormatLogLine renders a line the way it is written to log downloads.
+func FormatLogLine(line containertypes.LogLine) string {
+	var b strings.Builder
+	if line.Timestamp != "" {
+		b.WriteString(line.Timestamp)
+		b.WriteByte(' ')
+	}
+	if line.Stream == LogStreamStderr {
+		b.WriteString("[STDERR] ")
+	}
+	b.WriteString(line.Message)
+	b.WriteByte('\n')
+	return b.String()
+}
+
+// LogLineWriter is an io.Writer that splits the bytes written to it into
+// lines of one stream and hands each parsed line to a callback. It is meant
+// as a destination for stdcopy, whose frames do not always end on a line
+// boundary.
+type LogLineWriter struct {
+	stream string
+	buf    []byte
+	onLine func(containertypes.LogLine) error
+}
+
+// NewLogLineWriter creates a LogLineWriter for stream.
+func NewLogLineWriter(stream string, onLine func(containertypes.LogLine) error) *LogLineWriter {
+	return &LogLineWriter{stream: stream, onLine: onLine}
+}
+
+func (w *LogLineWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i < 0 {
+			break
+		}
+		raw := string(w.buf[:i])
+		w.buf = w.buf[i+1:]
+		if err := w.onLine(ParseLogLine(w.stream, raw)); err != nil {
+			return len(p), err
+		}
+	}
+	return len(p), nil
+}
+
+// Flush emits a trailing line that was not terminated by a newline.
+func (w *LogLineWriter) Flush() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+	raw := string(w.buf)
+	w.buf = nil
+	return w.onLine(ParseLogLine(w.stream, raw))
+}
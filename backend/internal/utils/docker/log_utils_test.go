@@ -0,0 +1,102 @@
+package docker
+
+import (
+	"testing"
+
+	containertypes "github.com/getarcaneapp/arcane/types/container"
+)
+
+func TestParseLogLine(t *testing.T) {
+	line := ParseLogLine(LogStreamStdout, "2024-05-01T10:00:00.123456789Z listening on :8080\r")
+	if line.Timestamp != "2024-05-01T10:00:00.123456789Z" || line.Message != "listening on :8080" {
+		t.Fatalf("unexpected parse result: %+v", line)
+	}
+
+	line = ParseLogLine(LogStreamStderr, "no timestamp here")
+	if line.Timestamp != "" || line.Message != "no timestamp here" || line.Stream != LogStreamStderr {
+		t.Fatalf("expected line without timestamp to be kept, got %+v", line)
+	}
+
+	line = ParseLogLine(LogStreamStdout, "2024-05-01T10:00:00Z")
+	if line.Timestamp != "2024-05-01T10:00:00Z" || line.Message != "" {
+		t.Fatalf("expected empty message, got %+v", line)
+	}
+}
+
+func TestLogFilter(t *testing.T) {
+	stdout := containertypes.LogLine{Stream: LogStreamStdout, Message: "GET /health 200"}
+	stderr := containertypes.LogLine{Stream: LogStreamStderr, Message: "ERROR connection refused"}
+
+	tests := []struct {
+		name          string
+		query         string
+		regex         bool
+		caseSensitive bool
+		stream        string
+		wantStdout    bool
+		wantStderr    bool
+	}{
+		{name: "empty query matches all", wantStdout: true, wantStderr: true},
+		{name: "substring ignores case", query: "error", wantStderr: true},
+		{name: "case sensitive substring", query: "error", caseSensitive: true},
+		{name: "regex", query: `GET /\w+ 2\d\d`, regex: true, wantStdout: true},
+		{name: "regex ignores case", query: "^error", regex: true, wantStderr: true},
+		{name: "stream filter", stream: LogStreamStdout, wantStdout: true},
+		{name: "all streams", stream: "all", query: "e", wantStdout: true, wantStderr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := NewLogFilter(tt.query, tt.regex, tt.caseSensitive, tt.stream)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := f.Match(stdout); got != tt.wantStdout {
+				t.Fatalf("stdout match = %v, want %v", got, tt.wantStdout)
+			}
+			if got := f.Match(stderr); got != tt.wantStderr {
+				t.Fatalf("stderr match = %v, want %v", got, tt.wantStderr)
+			}
+		})
+	}
+
+	if _, err := NewLogFilter("([", true, false, ""); err == nil {
+		t.Fatalf("expected invalid pattern to be rejected")
+	}
+	if _, err := NewLogFilter("", false, false, "stdin"); err == nil {
+		t.Fatalf("expected unknown stream to be rejected")
+	}
+}
+
+func TestLogLineWriterSplitsFrames(t *testing.T) {
+	var lines []containertypes.LogLine
+	w := NewLogLineWriter(LogStreamStdout, func(line containertypes.LogLine) error {
+		lines = append(lines, line)
+		return nil
+	})
+
+	for _, chunk := range []string{"2024-05-01T10:00:00Z first", " line\n2024-05-01T10:00:01Z sec", "ond\n2024-05-01T10:00:02Z tail"} {
+		if _, err := w.Write([]byte(chunk)); err != nil {
+			t.Fatalf("write failed: %v", err)
+		}
+	}
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 complete lines before flush, got %d", len(lines))
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatalf("flush failed: %v", err)
+	}
+
+	want := []string{"first line", "second", "tail"}
+	if len(lines) != len(want) {
+		t.Fatalf("expected %d lines, got %d", len(want), len(lines))
+	}
+	for i, msg := range want {
+		if lines[i].Message != msg {
+			t.Fatalf("line %d = %q, want %q", i, lines[i].Message, msg)
+		}
+	}
+	if got := FormatLogLine(containertypes.LogLine{Timestamp: "2024-05-01T10:00:00Z", Stream: LogStreamStderr, Message: "boom"}); got != "2024-05-01T10:00:00Z [STDERR] boom\n" {
+		t.Fatalf("unexpected formatted line %q", got)
+	}
+}
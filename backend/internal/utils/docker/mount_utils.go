@@ -15,28 +15,50 @@ import (
 // by inspecting the container itself. This is useful for Docker-in-Docker scenarios
 // where the application needs to know host paths for volume mapping.
 func GetHostPathForContainerPath(ctx context.Context, dockerCli *client.Client, containerPath string) (string, error) {
+	discovery, err := DiscoverHostPathForContainerPath(ctx, dockerCli, containerPath)
+	return discovery.HostPath, err
+}
+
+// HostPathDiscovery is the result of matching a container path to a mount of
+// the Arcane container.
+type HostPathDiscovery struct {
+	// HostPath is the host path for the container path. It is only set when
+	// the matching mount is a bind mount.
+	HostPath string
+	// Mount is the most specific mount containing the container path, or nil
+	// when the path is not on a mount.
+	Mount *containertypes.MountPoint
+}
+
+// DiscoverHostPathForContainerPath is GetHostPathForContainerPath with the
+// mount that was matched, so callers can explain why no host path was found.
+func DiscoverHostPathForContainerPath(ctx context.Context, dockerCli *client.Client, containerPath string) (HostPathDiscovery, error) {
 	if dockerCli == nil {
-		return "", nil // No docker client, can't discover
+		return HostPathDiscovery{}, nil // No docker client, can't discover
 	}
 
 	// 1. Get current container ID (usually the short ID is the hostname)
 	hostname, err := os.Hostname()
 	if err != nil {
-		return "", err
+		return HostPathDiscovery{}, err
 	}
 
 	// 2. Inspect self
 	inspect, err := dockerCli.ContainerInspect(ctx, hostname, client.ContainerInspectOptions{})
 	if err != nil {
 		// Not running in a container or can't reach docker daemon
-		return "", err
+		return HostPathDiscovery{}, err
 	}
 
-	// 3. Find mount point for the target path
-	// We want to find the mount that most specifically matches our path
+	return hostPathFromMountsInternal(inspect.Container.Mounts, containerPath), nil
+}
+
+// hostPathFromMountsInternal finds the mount that most specifically matches
+// containerPath and, for a bind mount, the host path it maps to.
+func hostPathFromMountsInternal(mounts []containertypes.MountPoint, containerPath string) HostPathDiscovery {
 	var bestMatch *containertypes.MountPoint
-	for i := range inspect.Container.Mounts {
-		m := &inspect.Container.Mounts[i]
+	for i := range mounts {
+		m := &mounts[i]
 		if strings.HasPrefix(containerPath, m.Destination) {
 			if bestMatch == nil || len(m.Destination) > len(bestMatch.Destination) {
 				bestMatch = m
@@ -44,6 +66,7 @@ func GetHostPathForContainerPath(ctx context.Context, dockerCli *client.Client,
 		}
 	}
 
+	discovery := HostPathDiscovery{Mount: bestMatch}
 	if bestMatch != nil && bestMatch.Type == mounttypes.TypeBind {
 		// Calculate the relative path from mount destination to target path
 		rel := strings.TrimPrefix(containerPath, bestMatch.Destination)
@@ -63,10 +86,10 @@ func GetHostPathForContainerPath(ctx context.Context, dockerCli *client.Client,
 			}
 			hostPath += rel
 		}
-		return hostPath, nil
+		discovery.HostPath = hostPath
 	}
 
-	return "", nil
+	return discovery
 }
 
 // MountForDestination returns a Mount suitable for container creation that mirrors an
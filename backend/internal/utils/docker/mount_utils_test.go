@@ -81,3 +81,28 @@ func TestMountForDestination(t *testing.T) {
 		})
 	}
 }
+
+func TestHostPathFromMounts(t *testing.T) {
+	mounts := []containertypes.MountPoint{
+		{Type: mounttypes.TypeBind, Source: "/srv/arcane", Destination: "/app/data"},
+		{Type: mounttypes.TypeBind, Source: "/srv/projects", Destination: "/app/data/projects"},
+		{Type: mounttypes.TypeVolume, Name: "arcane-builds", Source: "/var/lib/docker/volumes/arcane-builds/_data", Destination: "/builds"},
+		{Type: mounttypes.TypeBind, Source: `D:\arcane\stacks`, Destination: "/stacks"},
+	}
+
+	discovery := hostPathFromMountsInternal(mounts, "/app/data/projects/web")
+	require.Equal(t, "/srv/projects/web", discovery.HostPath, "the most specific mount wins")
+	require.Equal(t, "/app/data/projects", discovery.Mount.Destination)
+
+	discovery = hostPathFromMountsInternal(mounts, "/builds/cache")
+	require.Empty(t, discovery.HostPath, "named volumes have no usable host path")
+	require.NotNil(t, discovery.Mount)
+	require.Equal(t, mounttypes.TypeVolume, discovery.Mount.Type)
+
+	discovery = hostPathFromMountsInternal(mounts, "/stacks/web")
+	require.Equal(t, `D:\arcane\stacks\web`, discovery.HostPath)
+
+	discovery = hostPathFromMountsInternal(mounts, "/opt/projects")
+	require.Empty(t, discovery.HostPath)
+	require.Nil(t, discovery.Mount)
+}
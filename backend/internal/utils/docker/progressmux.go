@@ -0,0 +1,100 @@
+package docker
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"sync"
+)
+
+// ProgressMux merges the JSON progress streams of concurrent operations onto
+// one writer. Lines are written whole, and JSON object lines are tagged with
+// the name of the stream they came from so readers can tell them apart.
+type ProgressMux struct {
+	mu  sync.Mutex
+	dst io.Writer
+}
+
+// NewProgressMux creates a ProgressMux writing to dst. A nil dst discards
+// everything.
+func NewProgressMux(dst io.Writer) *ProgressMux {
+	if dst == nil {
+		dst = io.Discard
+	}
+	return &ProgressMux{dst: dst}
+}
+
+// Stream returns a writer for one operation whose lines are tagged with
+// key set to name.
+func (m *ProgressMux) Stream(key, name string) *ProgressStream {
+	return &ProgressStream{mux: m, key: key, name: name}
+}
+
+func (m *ProgressMux) writeLineInternal(line []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, err := m.dst.Write(line); err != nil {
+		return err
+	}
+	if f, ok := m.dst.(interface{ Flush() }); ok {
+		f.Flush()
+	}
+	return nil
+}
+
+// ProgressStream is the writer for one stream of a ProgressMux. It is not
+// safe for concurrent use; each operation gets its own.
+type ProgressStream struct {
+	mux  *ProgressMux
+	key  string
+	name string
+	buf  []byte
+}
+
+func (w *ProgressStream) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i < 0 {
+			break
+		}
+		line := w.buf[:i]
+		w.buf = w.buf[i+1:]
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		if err := w.mux.writeLineInternal(w.tagLineInternal(line)); err != nil {
+			return len(p), err
+		}
+	}
+	return len(p), nil
+}
+
+// Flush is a no-op; lines are flushed to the destination as they complete.
+// It lets writers that flush after every line, such as image pulls, keep
+// doing so.
+func (w *ProgressStream) Flush() {}
+
+// Close writes a trailing line that was not terminated by a newline.
+func (w *ProgressStream) Close() error {
+	if len(bytes.TrimSpace(w.buf)) == 0 {
+		w.buf = nil
+		return nil
+	}
+	line := w.buf
+	w.buf = nil
+	return w.mux.writeLineInternal(w.tagLineInternal(line))
+}
+
+func (w *ProgressStream) tagLineInternal(line []byte) []byte {
+	var msg map[string]any
+	if err := json.Unmarshal(line, &msg); err != nil || msg == nil {
+		return append(bytes.Clone(line), '\n')
+	}
+	msg[w.key] = w.name
+	tagged, err := json.Marshal(msg)
+	if err != nil {
+		return append(bytes.Clone(line), '\n')
+	}
+	return append(tagged, '\n')
+}
@@ -0,0 +1,69 @@
+package docker
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestProgressMux_TagsAndKeepsLinesWhole(t *testing.T) {
+	var out bytes.Buffer
+	mux := NewProgressMux(&out)
+
+	var wg sync.WaitGroup
+	for _, name := range []string{"nginx:latest", "redis:7", "postgres:16"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			stream := mux.Stream("image", name)
+			for i := range 50 {
+				// Split each line across writes the way image pulls do.
+				_, _ = fmt.Fprintf(stream, `{"status":"Downloading","id":"layer%d"}`, i)
+				_, _ = stream.Write([]byte("\n"))
+			}
+			_ = stream.Close()
+		}()
+	}
+	wg.Wait()
+
+	counts := map[string]int{}
+	for line := range strings.SplitSeq(strings.TrimSpace(out.String()), "\n") {
+		var msg map[string]any
+		if err := json.Unmarshal([]byte(line), &msg); err != nil {
+			t.Fatalf("line is not whole JSON: %q: %v", line, err)
+		}
+		image, _ := msg["image"].(string)
+		counts[image]++
+	}
+	for _, name := range []string{"nginx:latest", "redis:7", "postgres:16"} {
+		if counts[name] != 50 {
+			t.Fatalf("expected 50 lines for %s, got %d", name, counts[name])
+		}
+	}
+}
+
+func TestProgressStream_CloseWritesTrailingLine(t *testing.T) {
+	var out bytes.Buffer
+	stream := NewProgressMux(&out).Stream("image", "nginx")
+
+	_, _ = stream.Write([]byte("plain text"))
+	if out.Len() != 0 {
+		t.Fatalf("expected partial line to be held back, got %q", out.String())
+	}
+	if err := stream.Close(); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if out.String() != "plain text\n" {
+		t.Fatalf("expected non-JSON line to pass through untagged, got %q", out.String())
+	}
+}
+
+func TestProgressMux_NilDestination(t *testing.T) {
+	stream := NewProgressMux(nil).Stream("image", "nginx")
+	if _, err := stream.Write([]byte(`{"status":"Pulling"}` + "\n")); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+}
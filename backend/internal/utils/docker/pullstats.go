@@ -0,0 +1,89 @@
+package docker
+
+import "encoding/json"
+
+// PullStats summarises the layers of an image pull from the daemon's
+// progress messages.
+type PullStats struct {
+	layers map[string]*pullLayerInternal
+	order  []string
+}
+
+type pullLayerInternal struct {
+	size       int64
+	downloaded bool
+	existed    bool
+}
+
+type pullMessageInternal struct {
+	ID             string `json:"id"`
+	Status         string `json:"status"`
+	ProgressDetail struct {
+		Current int64 `json:"current"`
+		Total   int64 `json:"total"`
+	} `json:"progressDetail"`
+}
+
+// NewPullStats returns an empty PullStats.
+func NewPullStats() *PullStats {
+	return &PullStats{layers: map[string]*pullLayerInternal{}}
+}
+
+// Observe records a raw progress line. Lines that are not layer progress,
+// such as "Pulling from library/nginx" or the final digest, are ignored.
+func (p *PullStats) Observe(line []byte) {
+	var msg pullMessageInternal
+	if err := json.Unmarshal(line, &msg); err != nil || msg.ID == "" {
+		return
+	}
+
+	switch msg.Status {
+	case "Pulling fs layer", "Waiting", "Downloading", "Verifying Checksum", "Download complete", "Extracting", "Pull complete", "Already exists":
+	default:
+		return
+	}
+
+	layer, ok := p.layers[msg.ID]
+	if !ok {
+		layer = &pullLayerInternal{}
+		p.layers[msg.ID] = layer
+		p.order = append(p.order, msg.ID)
+	}
+
+	switch msg.Status {
+	case "Downloading":
+		layer.size = max(layer.size, msg.ProgressDetail.Total, msg.ProgressDetail.Current)
+	case "Download complete", "Pull complete":
+		layer.downloaded = true
+	case "Already exists":
+		layer.existed = true
+	}
+}
+
+// Layers returns the number of layers the image has.
+func (p *PullStats) Layers() int {
+	return len(p.order)
+}
+
+// LayersDownloaded returns the number of layers that were not already
+// present and had to be downloaded.
+func (p *PullStats) LayersDownloaded() int {
+	count := 0
+	for _, id := range p.order {
+		if layer := p.layers[id]; layer.downloaded && !layer.existed {
+			count++
+		}
+	}
+	return count
+}
+
+// Bytes returns the compressed size of the downloaded layers.
+func (p *PullStats) Bytes() int64 {
+	var total int64
+	for _, id := range p.order {
+		if layer := p.layers[id]; !layer.existed {
+			total += layer.size
+		}
+	}
+	return total
+}
@@ -0,0 +1,53 @@
+package docker
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPullStats(t *testing.T) {
+	stream := strings.Join([]string{
+		`{"status":"Pulling from library/nginx","id":"latest"}`,
+		`{"status":"Already exists","progressDetail":{},"id":"aaa"}`,
+		`{"status":"Pulling fs layer","progressDetail":{},"id":"bbb"}`,
+		`{"status":"Pulling fs layer","progressDetail":{},"id":"ccc"}`,
+		`{"status":"Downloading","progressDetail":{"current":512,"total":2048},"id":"bbb"}`,
+		`{"status":"Downloading","progressDetail":{"current":2048,"total":2048},"id":"bbb"}`,
+		`{"status":"Download complete","progressDetail":{},"id":"bbb"}`,
+		`{"status":"Downloading","progressDetail":{"current":100,"total":300},"id":"ccc"}`,
+		`{"status":"Download complete","progressDetail":{},"id":"ccc"}`,
+		`{"status":"Pull complete","progressDetail":{},"id":"bbb"}`,
+		`{"status":"Pull complete","progressDetail":{},"id":"ccc"}`,
+		`{"status":"Digest: sha256:abc"}`,
+		`{"status":"Status: Downloaded newer image for nginx:latest"}`,
+		`not json`,
+	}, "\n")
+
+	stats := NewPullStats()
+	if err := ConsumeJSONMessageStream(strings.NewReader(stream), func(line []byte) error {
+		stats.Observe(line)
+		return nil
+	}); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+
+	if got := stats.Layers(); got != 3 {
+		t.Fatalf("expected 3 layers, got %d", got)
+	}
+	if got := stats.LayersDownloaded(); got != 2 {
+		t.Fatalf("expected 2 downloaded layers, got %d", got)
+	}
+	if got := stats.Bytes(); got != 2348 {
+		t.Fatalf("expected 2348 bytes, got %d", got)
+	}
+}
+
+func TestPullStats_UpToDateImage(t *testing.T) {
+	stats := NewPullStats()
+	stats.Observe([]byte(`{"status":"Pulling from library/nginx","id":"latest"}`))
+	stats.Observe([]byte(`{"status":"Status: Image is up to date for nginx:latest"}`))
+
+	if stats.Layers() != 0 || stats.LayersDownloaded() != 0 || stats.Bytes() != 0 {
+		t.Fatalf("expected no layers, got %d layers, %d downloaded, %d bytes", stats.Layers(), stats.LayersDownloaded(), stats.Bytes())
+	}
+}
@@ -3,6 +3,8 @@ package fs
 import (
 	"context"
 	"fmt"
+	"io"
+	iofs "io/fs"
 	"log/slog"
 	"os"
 	"path/filepath"
@@ -160,6 +162,70 @@ func IsSafeSubdirectory(baseDir, subdir string) bool {
 	return !strings.HasPrefix(rel, "..") && !filepath.IsAbs(rel)
 }
 
+// CopyProjectDir copies the files of src into dst, keeping file modes and
+// symlinks. Directories whose slash-separated path relative to src is in
+// emptyDirs are created without their contents.
+func CopyProjectDir(src, dst string, emptyDirs map[string]struct{}) error {
+	return filepath.WalkDir(src, func(path string, d iofs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+		target := filepath.Join(dst, rel)
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case d.IsDir():
+			if err := os.MkdirAll(target, info.Mode().Perm()); err != nil {
+				return err
+			}
+			if _, ok := emptyDirs[filepath.ToSlash(rel)]; ok {
+				return filepath.SkipDir
+			}
+			return nil
+		case info.Mode()&os.ModeSymlink != 0:
+			link, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			return os.Symlink(link, target)
+		case info.Mode().IsRegular():
+			return copyFileInternal(path, target, info.Mode().Perm())
+		default:
+			// Sockets, devices and pipes are not project files.
+			return nil
+		}
+	})
+}
+
+func copyFileInternal(src, dst string, perm os.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = in.Close() }()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
+
 func SaveOrUpdateProjectFiles(projectsRoot, projectPath, composeContent string, envContent *string) error {
 	return WriteProjectFiles(projectsRoot, projectPath, composeContent, envContent)
 }
@@ -0,0 +1,41 @@
+package fs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCopyProjectDir(t *testing.T) {
+	src := t.TempDir()
+	dst := filepath.Join(t.TempDir(), "copy")
+	require.NoError(t, os.MkdirAll(dst, 0o755))
+
+	require.NoError(t, os.WriteFile(filepath.Join(src, "compose.yaml"), []byte("services: {}\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(src, ".env"), []byte("TOKEN=x\n"), 0o600))
+	require.NoError(t, os.MkdirAll(filepath.Join(src, "config", "nested"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "config", "nested", "app.conf"), []byte("a=1\n"), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Join(src, "data"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "data", "db.sqlite"), []byte("data"), 0o644))
+	require.NoError(t, os.Symlink("config/nested/app.conf", filepath.Join(src, "app.conf")))
+
+	require.NoError(t, CopyProjectDir(src, dst, map[string]struct{}{"data": {}}))
+
+	content, err := os.ReadFile(filepath.Join(dst, "config", "nested", "app.conf"))
+	require.NoError(t, err)
+	assert.Equal(t, "a=1\n", string(content))
+
+	info, err := os.Stat(filepath.Join(dst, ".env"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	link, err := os.Readlink(filepath.Join(dst, "app.conf"))
+	require.NoError(t, err)
+	assert.Equal(t, "config/nested/app.conf", link)
+
+	assert.DirExists(t, filepath.Join(dst, "data"))
+	assert.NoFileExists(t, filepath.Join(dst, "data", "db.sqlite"))
+}
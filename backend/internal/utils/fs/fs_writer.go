@@ -51,6 +51,7 @@ func WriteComposeFile(projectsRoot, dirPath, content string) error {
 		return fmt.Errorf("refusing to write compose file: path outside projects root")
 	}
 
+	MarkSelfWrite(dirPath)
 	if err := os.MkdirAll(dirPath, common.DirPerm); err != nil {
 		return fmt.Errorf("failed to create directory: %w", err)
 	}
@@ -62,6 +63,7 @@ func WriteComposeFile(projectsRoot, dirPath, content string) error {
 		composePath = filepath.Join(dirPath, "compose.yaml")
 	}
 
+	MarkSelfWrite(composePath)
 	if err := os.WriteFile(composePath, []byte(content), common.FilePerm); err != nil {
 		return fmt.Errorf("failed to write compose file: %w", err)
 	}
@@ -90,11 +92,13 @@ func WriteEnvFile(projectsRoot, dirPath, content string) error {
 		return fmt.Errorf("refusing to write env file: path outside projects root")
 	}
 
+	MarkSelfWrite(dirPath)
 	if err := os.MkdirAll(dirPath, common.DirPerm); err != nil {
 		return fmt.Errorf("failed to create directory: %w", err)
 	}
 
 	envPath := filepath.Join(dirPath, ".env")
+	MarkSelfWrite(envPath)
 	if err := os.WriteFile(envPath, []byte(content), common.FilePerm); err != nil {
 		return fmt.Errorf("failed to write env file: %w", err)
 	}
@@ -133,10 +137,12 @@ func WriteProjectFiles(projectsRoot, dirPath, composeContent string, envContent
 // WriteTemplateFile writes a template file (like .compose.template or .env.template)
 func WriteTemplateFile(filePath, content string) error {
 	dir := filepath.Dir(filePath)
+	MarkSelfWrite(dir)
 	if err := os.MkdirAll(dir, common.DirPerm); err != nil {
 		return fmt.Errorf("failed to create template directory: %w", err)
 	}
 
+	MarkSelfWrite(filePath)
 	if err := os.WriteFile(filePath, []byte(content), common.FilePerm); err != nil {
 		return fmt.Errorf("failed to write template file: %w", err)
 	}
@@ -147,10 +153,12 @@ func WriteTemplateFile(filePath, content string) error {
 // WriteFileWithPerm is a generic file writer with custom permissions
 func WriteFileWithPerm(filePath, content string, perm os.FileMode) error {
 	dir := filepath.Dir(filePath)
+	MarkSelfWrite(dir)
 	if err := os.MkdirAll(dir, common.DirPerm); err != nil {
 		return fmt.Errorf("failed to create directory: %w", err)
 	}
 
+	MarkSelfWrite(filePath)
 	if err := os.WriteFile(filePath, []byte(content), perm); err != nil {
 		return fmt.Errorf("failed to write file: %w", err)
 	}
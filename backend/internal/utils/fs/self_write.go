@@ -0,0 +1,46 @@
+package fs
+
+import (
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// selfWriteTTL is how long a path written by Arcane is ignored by watchers.
+// fsnotify delivers events within milliseconds of the write, so this only has
+// to outlast the event burst a single write produces.
+const selfWriteTTL = 5 * time.Second
+
+var (
+	selfWritesMu sync.Mutex
+	selfWrites   = map[string]time.Time{}
+)
+
+// MarkSelfWrite records that Arcane itself is about to write path, so watchers
+// don't treat the resulting events as external changes and loop back into a
+// sync or deploy.
+func MarkSelfWrite(path string) {
+	markSelfWriteAtInternal(path, time.Now())
+}
+
+func markSelfWriteAtInternal(path string, now time.Time) {
+	selfWritesMu.Lock()
+	defer selfWritesMu.Unlock()
+
+	for p, expires := range selfWrites {
+		if now.After(expires) {
+			delete(selfWrites, p)
+		}
+	}
+	selfWrites[filepath.Clean(path)] = now.Add(selfWriteTTL)
+}
+
+// isSelfWriteInternal reports whether path was marked by MarkSelfWrite within
+// the last selfWriteTTL.
+func isSelfWriteInternal(path string, now time.Time) bool {
+	selfWritesMu.Lock()
+	defer selfWritesMu.Unlock()
+
+	expires, ok := selfWrites[filepath.Clean(path)]
+	return ok && !now.After(expires)
+}
@@ -0,0 +1,21 @@
+package fs
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelfWriteRegistry(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "compose.yaml")
+	now := time.Now()
+
+	assert.False(t, isSelfWriteInternal(path, now))
+
+	markSelfWriteAtInternal(path, now)
+	assert.True(t, isSelfWriteInternal(path, now.Add(time.Second)))
+	assert.True(t, isSelfWriteInternal(filepath.Join(filepath.Dir(path), ".", "compose.yaml"), now))
+	assert.False(t, isSelfWriteInternal(path, now.Add(selfWriteTTL+time.Second)))
+}
@@ -3,9 +3,11 @@ package fs
 import (
 	"context"
 	"log/slog"
+	"maps"
 	"os"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"strings"
 	"time"
 
@@ -18,7 +20,9 @@ type Watcher struct {
 	watchedPath string
 	maxDepth    int
 	onChange    func(ctx context.Context)
+	onPaths     func(ctx context.Context, paths []string)
 	debounce    time.Duration
+	pending     map[string]struct{}
 	stopCh      chan struct{}
 	stoppedCh   chan struct{}
 }
@@ -26,7 +30,10 @@ type Watcher struct {
 type WatcherOptions struct {
 	Debounce time.Duration
 	OnChange func(ctx context.Context)
-	MaxDepth int
+	// OnPathsChange is called after OnChange with the sorted, de-duplicated
+	// paths that changed during the debounce window.
+	OnPathsChange func(ctx context.Context, paths []string)
+	MaxDepth      int
 }
 
 func NewWatcher(watchPath string, opts WatcherOptions) (*Watcher, error) {
@@ -48,7 +55,9 @@ func NewWatcher(watchPath string, opts WatcherOptions) (*Watcher, error) {
 		watchedPath: filepath.Clean(watchPath),
 		maxDepth:    opts.MaxDepth,
 		onChange:    opts.OnChange,
+		onPaths:     opts.OnPathsChange,
 		debounce:    opts.Debounce,
+		pending:     map[string]struct{}{},
 		stopCh:      make(chan struct{}),
 		stoppedCh:   make(chan struct{}),
 	}, nil
@@ -119,6 +128,7 @@ func (fw *Watcher) processEventInternal(ctx context.Context, event fsnotify.Even
 		return false
 	}
 	fw.handleEvent(ctx, event)
+	fw.pending[filepath.Clean(event.Name)] = struct{}{}
 	if !debounceTimer.Stop() {
 		select {
 		case <-debounceTimer.C:
@@ -142,8 +152,17 @@ func (fw *Watcher) fireDebounceInternal(ctx context.Context, debouncePending *bo
 			"goroutines", runtime.NumGoroutine())
 		*lastGoroutineLog = time.Now()
 	}
-	if fw.onChange != nil {
-		go fw.onChange(ctx)
+	paths := slices.Sorted(maps.Keys(fw.pending))
+	clear(fw.pending)
+	if fw.onChange != nil || fw.onPaths != nil {
+		go func() {
+			if fw.onChange != nil {
+				fw.onChange(ctx)
+			}
+			if fw.onPaths != nil {
+				fw.onPaths(ctx, paths)
+			}
+		}()
 	}
 	return false
 }
@@ -169,6 +188,12 @@ func (fw *Watcher) handleEvent(ctx context.Context, event fsnotify.Event) {
 func (fw *Watcher) shouldHandleEvent(event fsnotify.Event) bool {
 	name := filepath.Base(event.Name)
 
+	// Ignore Arcane's own writes so saving a project doesn't trigger a sync
+	// (or an auto-deploy) of the change it just made.
+	if isSelfWriteInternal(event.Name, time.Now()) {
+		return false
+	}
+
 	// Watch for new directories, compose files, .env being manipulated.
 	if event.Has(fsnotify.Write) || event.Has(fsnotify.Create) || event.Has(fsnotify.Rename) || event.Has(fsnotify.Remove) {
 		if info, err := os.Stat(event.Name); err == nil && info.IsDir() || projects.IsProjectFile(name) {
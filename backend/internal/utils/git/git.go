@@ -15,6 +15,7 @@ import (
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/config"
 	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/go-git/go-git/v5/plumbing/transport"
 	"github.com/go-git/go-git/v5/plumbing/transport/http"
 	"github.com/go-git/go-git/v5/plumbing/transport/ssh"
@@ -453,6 +454,77 @@ type CommitInfo struct {
 	Date    time.Time
 }
 
+// CommitOptions describes a commit made by CommitAndPush.
+type CommitOptions struct {
+	// Branch is created from the checked out commit and receives the commit.
+	// When empty the commit goes onto the checked out branch.
+	Branch      string
+	Files       []string
+	Message     string
+	AuthorName  string
+	AuthorEmail string
+}
+
+// CommitAndPush commits files changed in a cloned repository and pushes the
+// branch to origin. It returns the new commit hash.
+func (c *Client) CommitAndPush(ctx context.Context, repoPath string, opts CommitOptions, auth AuthConfig) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	repo, err := git.PlainOpen(repoPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to open repository: %w", err)
+	}
+	wt, err := repo.Worktree()
+	if err != nil {
+		return "", fmt.Errorf("failed to open worktree: %w", err)
+	}
+
+	branch := plumbing.NewBranchReferenceName(opts.Branch)
+	if opts.Branch == "" {
+		head, err := repo.Head()
+		if err != nil {
+			return "", fmt.Errorf("failed to get HEAD: %w", err)
+		}
+		branch = head.Name()
+	} else if err := wt.Checkout(&git.CheckoutOptions{Branch: branch, Create: true, Keep: true}); err != nil {
+		return "", fmt.Errorf("failed to create branch %s: %w", opts.Branch, err)
+	}
+
+	for _, file := range opts.Files {
+		if err := ValidatePath(repoPath, file); err != nil {
+			return "", err
+		}
+		if _, err := wt.Add(filepath.ToSlash(file)); err != nil {
+			return "", fmt.Errorf("failed to stage %s: %w", file, err)
+		}
+	}
+
+	hash, err := wt.Commit(opts.Message, &git.CommitOptions{
+		Author: &object.Signature{Name: opts.AuthorName, Email: opts.AuthorEmail, When: time.Now()},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to commit: %w", err)
+	}
+
+	authMethod, err := c.getAuth(auth)
+	if err != nil {
+		return "", err
+	}
+	pushOptions := &git.PushOptions{
+		RemoteName: "origin",
+		RefSpecs:   []config.RefSpec{config.RefSpec(branch.String() + ":" + branch.String())},
+	}
+	if authMethod != nil {
+		pushOptions.Auth = authMethod
+	}
+	if err := repo.PushContext(ctx, pushOptions); err != nil {
+		return "", fmt.Errorf("failed to push %s: %w", branch.Short(), err)
+	}
+
+	return hash.String(), nil
+}
+
 // TestConnection tests if the repository can be accessed with the given credentials
 func (c *Client) TestConnection(ctx context.Context, url, branch string, auth AuthConfig) error {
 	if err := ctx.Err(); err != nil {
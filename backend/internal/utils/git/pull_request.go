@@ -0,0 +1,150 @@
+package git
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"net/url"
+	"strings"
+)
+
+// ErrPullRequestUnsupported is returned when a pull request cannot be opened
+// through the hosting provider's API, either because the provider is unknown
+// or because the repository does not use token authentication.
+var ErrPullRequestUnsupported = errors.New("opening pull requests is not supported for this repository")
+
+// PullRequest describes a pull request opened by OpenPullRequest.
+type PullRequest struct {
+	// Head is the branch with the changes.
+	Head string
+	// Base is the branch the changes are proposed for.
+	Base  string
+	Title string
+	Body  string
+}
+
+// repoLocationInternal is a repository on a hosting provider.
+type repoLocationInternal struct {
+	scheme string
+	host   string
+	// path is the owner and name of the repository, such as "acme/stack".
+	path string
+}
+
+// OpenPullRequest opens a pull request, or a merge request on GitLab, for a
+// branch that is already pushed. GitHub, GitLab and Gitea or Forgejo are
+// supported; the provider is picked from the repository host. It returns the
+// web URL of the pull request.
+func OpenPullRequest(ctx context.Context, client *nethttp.Client, repoURL string, auth AuthConfig, pr PullRequest) (string, error) {
+	if auth.AuthType != "http" || auth.Token == "" {
+		return "", ErrPullRequestUnsupported
+	}
+	loc, err := parseRepoLocationInternal(repoURL)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case loc.host == "github.com":
+		return openGitHubPullRequestInternal(ctx, client, "https://api.github.com/repos/"+loc.path+"/pulls", "token "+auth.Token, pr)
+	case strings.Contains(loc.host, "gitlab"):
+		return openGitLabMergeRequestInternal(ctx, client, loc, auth.Token, pr)
+	default:
+		// Gitea and Forgejo mirror the GitHub pull request API.
+		return openGitHubPullRequestInternal(ctx, client, fmt.Sprintf("%s://%s/api/v1/repos/%s/pulls", loc.scheme, loc.host, loc.path), "token "+auth.Token, pr)
+	}
+}
+
+func openGitHubPullRequestInternal(ctx context.Context, client *nethttp.Client, endpoint, authorization string, pr PullRequest) (string, error) {
+	var created struct {
+		HTMLURL string `json:"html_url"`
+	}
+	payload := map[string]string{"title": pr.Title, "body": pr.Body, "head": pr.Head, "base": pr.Base}
+	if err := postJSONInternal(ctx, client, endpoint, map[string]string{"Authorization": authorization}, payload, &created); err != nil {
+		return "", err
+	}
+	return created.HTMLURL, nil
+}
+
+func openGitLabMergeRequestInternal(ctx context.Context, client *nethttp.Client, loc repoLocationInternal, token string, pr PullRequest) (string, error) {
+	endpoint := fmt.Sprintf("%s://%s/api/v4/projects/%s/merge_requests", loc.scheme, loc.host, url.PathEscape(loc.path))
+	var created struct {
+		WebURL string `json:"web_url"`
+	}
+	payload := map[string]string{"title": pr.Title, "description": pr.Body, "source_branch": pr.Head, "target_branch": pr.Base}
+	if err := postJSONInternal(ctx, client, endpoint, map[string]string{"PRIVATE-TOKEN": token}, payload, &created); err != nil {
+		return "", err
+	}
+	return created.WebURL, nil
+}
+
+func postJSONInternal(ctx context.Context, client *nethttp.Client, endpoint string, headers map[string]string, payload, out any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	req, err := nethttp.NewRequestWithContext(ctx, nethttp.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", "Arcane")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := client.Do(req) //nolint:gosec // intentional request to the user-configured repository host
+	if err != nil {
+		return fmt.Errorf("failed to open pull request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("failed to open pull request: status %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode pull request response: %w", err)
+	}
+	return nil
+}
+
+// parseRepoLocationInternal splits an HTTP(S) or SSH clone URL into its host
+// and repository path.
+func parseRepoLocationInternal(repoURL string) (repoLocationInternal, error) {
+	raw := strings.TrimSpace(repoURL)
+	loc := repoLocationInternal{scheme: "https"}
+
+	if !strings.Contains(raw, "://") {
+		// scp-like SSH syntax: git@host:owner/repo.git
+		_, rest, _ := strings.Cut(raw, "@")
+		host, path, ok := strings.Cut(rest, ":")
+		if !ok {
+			return loc, fmt.Errorf("unrecognized repository URL %q", repoURL)
+		}
+		loc.host, loc.path = host, path
+	} else {
+		u, err := url.Parse(raw)
+		if err != nil {
+			return loc, fmt.Errorf("invalid repository URL: %w", err)
+		}
+		if u.Scheme == "http" {
+			loc.scheme = "http"
+		}
+		loc.host, loc.path = u.Host, u.Path
+		if u.Scheme == "ssh" {
+			loc.host = u.Hostname()
+		}
+	}
+
+	loc.path = strings.TrimSuffix(strings.Trim(loc.path, "/"), ".git")
+	if loc.host == "" || !strings.Contains(loc.path, "/") {
+		return loc, fmt.Errorf("unrecognized repository URL %q", repoURL)
+	}
+	return loc, nil
+}
@@ -0,0 +1,71 @@
+package git
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestParseRepoLocation(t *testing.T) {
+	tests := []struct {
+		url    string
+		scheme string
+		host   string
+		path   string
+	}{
+		{"https://github.com/acme/stack.git", "https", "github.com", "acme/stack"},
+		{"http://gitea.lan:3000/acme/stack", "http", "gitea.lan:3000", "acme/stack"},
+		{"git@gitlab.com:group/sub/stack.git", "https", "gitlab.com", "group/sub/stack"},
+		{"ssh://git@git.example.com:2222/acme/stack.git", "https", "git.example.com", "acme/stack"},
+	}
+
+	for _, tt := range tests {
+		loc, err := parseRepoLocationInternal(tt.url)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.url, err)
+		}
+		if loc.scheme != tt.scheme || loc.host != tt.host || loc.path != tt.path {
+			t.Errorf("%s: got %+v", tt.url, loc)
+		}
+	}
+
+	if _, err := parseRepoLocationInternal("https://github.com/stack"); err == nil {
+		t.Error("expected an error for a URL without an owner")
+	}
+}
+
+func TestOpenPullRequest(t *testing.T) {
+	var got map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/repos/acme/stack/pulls" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if auth := r.Header.Get("Authorization"); auth != "token secret" {
+			t.Errorf("unexpected Authorization header %q", auth)
+		}
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"html_url":"http://gitea.lan/acme/stack/pulls/7"}`))
+	}))
+	defer server.Close()
+
+	pr := PullRequest{Head: "arcane/tag-bump-1", Base: "main", Title: "Bump nginx", Body: "changelog"}
+	url, err := OpenPullRequest(context.Background(), server.Client(), server.URL+"/acme/stack.git", AuthConfig{AuthType: "http", Token: "secret"}, pr)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if url != "http://gitea.lan/acme/stack/pulls/7" {
+		t.Errorf("unexpected URL %s", url)
+	}
+	if got["head"] != "arcane/tag-bump-1" || got["base"] != "main" || got["title"] != "Bump nginx" {
+		t.Errorf("unexpected payload %v", got)
+	}
+
+	_, err = OpenPullRequest(context.Background(), server.Client(), server.URL+"/acme/stack.git", AuthConfig{AuthType: "ssh"}, pr)
+	if !errors.Is(err, ErrPullRequestUnsupported) {
+		t.Errorf("expected ErrPullRequestUnsupported, got %v", err)
+	}
+}
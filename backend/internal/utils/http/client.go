@@ -3,16 +3,19 @@ package http
 import (
 	"net/http"
 	"time"
+
+	"github.com/getarcaneapp/arcane/backend/internal/utils/truststore"
 )
 
 func NewHTTPClient() *http.Client {
 	transport := &http.Transport{
-		Proxy:                 http.ProxyFromEnvironment,
+		Proxy:                 ProxyFromSettings,
 		MaxIdleConns:          100,
 		IdleConnTimeout:       90 * time.Second,
 		TLSHandshakeTimeout:   5 * time.Second,
 		ExpectContinueTimeout: 1 * time.Second,
 	}
+	truststore.ConfigureTransport(transport)
 	return &http.Client{
 		Transport: transport,
 		Timeout:   10 * time.Second,
@@ -21,12 +24,13 @@ func NewHTTPClient() *http.Client {
 
 func NewHTTPClientWithTimeout(timeout time.Duration) *http.Client {
 	transport := &http.Transport{
-		Proxy:                 http.ProxyFromEnvironment,
+		Proxy:                 ProxyFromSettings,
 		MaxIdleConns:          100,
 		IdleConnTimeout:       90 * time.Second,
 		TLSHandshakeTimeout:   10 * time.Second,
 		ExpectContinueTimeout: 1 * time.Second,
 	}
+	truststore.ConfigureTransport(transport)
 	return &http.Client{
 		Transport: transport,
 		Timeout:   timeout,
@@ -0,0 +1,58 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ErrInvalidOrigin is returned when an allowed origin is not a bare http or
+// https origin.
+var ErrInvalidOrigin = errors.New("invalid origin")
+
+// ParseAllowedOrigins splits a comma or newline separated list of origins and
+// normalizes each to the scheme://host[:port] form browsers send in the Origin
+// header. Wildcards are rejected because CORS responses allow credentials.
+func ParseAllowedOrigins(value string) ([]string, error) {
+	entries := strings.FieldsFunc(value, func(r rune) bool {
+		return r == ',' || r == '\n' || r == '\r' || r == ' ' || r == '\t'
+	})
+
+	origins := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		origin, err := normalizeOriginInternal(entry)
+		if err != nil {
+			return nil, err
+		}
+		origins = append(origins, origin)
+	}
+	return origins, nil
+}
+
+func normalizeOriginInternal(entry string) (string, error) {
+	u, err := url.Parse(strings.TrimSuffix(entry, "/"))
+	if err != nil {
+		return "", fmt.Errorf("%w %q: %w", ErrInvalidOrigin, entry, err)
+	}
+
+	scheme := strings.ToLower(u.Scheme)
+	if scheme != "http" && scheme != "https" {
+		return "", fmt.Errorf("%w %q: scheme must be http or https", ErrInvalidOrigin, entry)
+	}
+	if u.Host == "" || strings.Contains(u.Host, "*") {
+		return "", fmt.Errorf("%w %q: a single host is required", ErrInvalidOrigin, entry)
+	}
+	if u.User != nil || u.Path != "" || u.RawQuery != "" || u.Fragment != "" {
+		return "", fmt.Errorf("%w %q: only scheme, host and port are allowed", ErrInvalidOrigin, entry)
+	}
+
+	host := strings.ToLower(u.Host)
+	if (scheme == "https" && u.Port() == "443") || (scheme == "http" && u.Port() == "80") {
+		host = strings.ToLower(u.Hostname())
+		if strings.Contains(host, ":") {
+			host = "[" + host + "]"
+		}
+	}
+	return scheme + "://" + host, nil
+}
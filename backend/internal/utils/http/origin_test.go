@@ -0,0 +1,23 @@
+package http
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAllowedOrigins(t *testing.T) {
+	origins, err := ParseAllowedOrigins("https://Arcane.Example.com/, http://10.0.0.5:3552\nhttps://proxy.example.com:443\n\n")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"https://arcane.example.com", "http://10.0.0.5:3552", "https://proxy.example.com"}, origins)
+
+	origins, err = ParseAllowedOrigins("")
+	require.NoError(t, err)
+	assert.Empty(t, origins)
+
+	for _, invalid := range []string{"*", "https://*.example.com", "arcane.example.com", "ftp://example.com", "https://example.com/app", "https://user@example.com"} {
+		_, err := ParseAllowedOrigins(invalid)
+		require.ErrorIs(t, err, ErrInvalidOrigin, invalid)
+	}
+}
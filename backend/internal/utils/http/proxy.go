@@ -0,0 +1,101 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+// ErrInvalidProxyURL is returned when the configured outbound proxy cannot be used.
+var ErrInvalidProxyURL = errors.New("invalid proxy URL")
+
+type proxyFunc func(*url.URL) (*url.URL, error)
+
+// outboundProxy holds the proxy configured in settings; nil means the standard
+// HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables are used.
+var outboundProxy atomic.Pointer[proxyFunc]
+
+// ValidateProxyURL checks that proxyURL is empty or an absolute http, https,
+// socks5 or socks5h URL with a host.
+func ValidateProxyURL(proxyURL string) error {
+	proxyURL = strings.TrimSpace(proxyURL)
+	if proxyURL == "" {
+		return nil
+	}
+
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidProxyURL, err)
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "http", "https", "socks5", "socks5h":
+	default:
+		return fmt.Errorf("%w: unsupported scheme %q", ErrInvalidProxyURL, u.Scheme)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("%w: missing host", ErrInvalidProxyURL)
+	}
+	return nil
+}
+
+// SetOutboundProxy routes outbound requests through proxyURL, except for hosts
+// matching noProxy (comma or newline separated, NO_PROXY syntax). An empty
+// proxyURL restores the environment variable behaviour.
+func SetOutboundProxy(proxyURL, noProxy string) error {
+	proxyURL = strings.TrimSpace(proxyURL)
+	if proxyURL == "" {
+		outboundProxy.Store(nil)
+		return nil
+	}
+	if err := ValidateProxyURL(proxyURL); err != nil {
+		return err
+	}
+
+	cfg := &httpproxy.Config{
+		HTTPProxy:  proxyURL,
+		HTTPSProxy: proxyURL,
+		NoProxy:    normalizeNoProxyInternal(noProxy),
+	}
+	fn := proxyFunc(cfg.ProxyFunc())
+	outboundProxy.Store(&fn)
+	return nil
+}
+
+// ProxyFromSettings is an http.Transport Proxy function that honours
+// SetOutboundProxy and falls back to http.ProxyFromEnvironment.
+func ProxyFromSettings(req *http.Request) (*url.URL, error) {
+	if fn := outboundProxy.Load(); fn != nil {
+		return (*fn)(req.URL)
+	}
+	return http.ProxyFromEnvironment(req)
+}
+
+// InstallDefaultTransportProxy makes http.DefaultTransport use
+// ProxyFromSettings. Clients without their own transport, and libraries such as
+// Shoutrrr and go-git, go through the default transport.
+func InstallDefaultTransportProxy() {
+	if transport, ok := http.DefaultTransport.(*http.Transport); ok {
+		transport.Proxy = ProxyFromSettings
+	}
+}
+
+// RedactProxyURL removes credentials from proxyURL for logging.
+func RedactProxyURL(proxyURL string) string {
+	u, err := url.Parse(strings.TrimSpace(proxyURL))
+	if err != nil || u.User == nil {
+		return proxyURL
+	}
+	return u.Redacted()
+}
+
+func normalizeNoProxyInternal(noProxy string) string {
+	entries := strings.FieldsFunc(noProxy, func(r rune) bool {
+		return r == ',' || r == '\n' || r == '\r' || r == ' ' || r == '\t'
+	})
+	return strings.Join(entries, ",")
+}
@@ -0,0 +1,121 @@
+package logmatch
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// MaxPatternLength bounds the size of a rule's regular expression.
+	MaxPatternLength = 1024
+	// MaxExcerptLength is the maximum size in bytes of an excerpt, not
+	// counting the ellipses added where the line was cut.
+	MaxExcerptLength = 300
+)
+
+// ansiPattern matches terminal color and cursor sequences, which many
+// containers write to their logs and which would otherwise break patterns.
+var ansiPattern = regexp.MustCompile(`\x1b\[[0-9;?]*[ -/]*[@-~]`)
+
+// Compile validates and compiles a rule pattern. Patterns use RE2 syntax, so
+// matching time is linear in the length of the line.
+func Compile(pattern string) (*regexp.Regexp, error) {
+	if strings.TrimSpace(pattern) == "" {
+		return nil, errors.New("pattern is required")
+	}
+	if len(pattern) > MaxPatternLength {
+		return nil, fmt.Errorf("pattern must be at most %d characters", MaxPatternLength)
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern: %w", err)
+	}
+	return re, nil
+}
+
+// Matcher reports log lines that match a pattern, at most once per cooldown.
+// It is safe for concurrent use, so one matcher can serve all the containers
+// of a rule.
+type Matcher struct {
+	re       *regexp.Regexp
+	cooldown time.Duration
+
+	mu        sync.Mutex
+	lastFired time.Time
+}
+
+// NewMatcher creates a matcher for pattern. lastFired is when the rule last
+// fired, so that a restart does not cut a running cooldown short; pass the
+// zero time if it never fired.
+func NewMatcher(pattern string, cooldown time.Duration, lastFired time.Time) (*Matcher, error) {
+	re, err := Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	return &Matcher{re: re, cooldown: cooldown, lastFired: lastFired}, nil
+}
+
+// Match reports whether line matches and the cooldown has passed at time at.
+// When it fires it returns an excerpt of the line around the match and starts
+// a new cooldown.
+func (m *Matcher) Match(line string, at time.Time) (string, bool) {
+	line = ansiPattern.ReplaceAllString(line, "")
+	loc := m.re.FindStringIndex(line)
+	if loc == nil {
+		return "", false
+	}
+
+	m.mu.Lock()
+	if !m.lastFired.IsZero() && at.Before(m.lastFired.Add(m.cooldown)) {
+		m.mu.Unlock()
+		return "", false
+	}
+	m.lastFired = at
+	m.mu.Unlock()
+
+	return Excerpt(line, loc[0], loc[1]), true
+}
+
+// Excerpt returns the part of line around the match [start, end), cut to
+// MaxExcerptLength bytes on rune boundaries. Some context before the match is
+// kept so the excerpt reads naturally.
+func Excerpt(line string, start, end int) string {
+	line = strings.TrimRight(line, "\r\n")
+	if end > len(line) {
+		end = len(line)
+	}
+	if start > end {
+		start = end
+	}
+	if len(line) <= MaxExcerptLength {
+		return strings.TrimSpace(line)
+	}
+
+	from := max(start-MaxExcerptLength/4, 0)
+	if end-from > MaxExcerptLength {
+		from = start
+	}
+	to := min(from+MaxExcerptLength, len(line))
+	from = max(to-MaxExcerptLength, 0)
+
+	for from > 0 && !utf8.RuneStart(line[from]) {
+		from++
+	}
+	for to < len(line) && !utf8.RuneStart(line[to]) {
+		to--
+	}
+
+	excerpt := strings.TrimSpace(line[from:to])
+	if from > 0 {
+		excerpt = "…" + excerpt
+	}
+	if to < len(line) {
+		excerpt += "…"
+	}
+	return excerpt
+}
@@ -0,0 +1,73 @@
+package logmatch
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatcher_Cooldown(t *testing.T) {
+	m, err := NewMatcher(`(?i)out of memory`, time.Minute, time.Time{})
+	require.NoError(t, err)
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	_, fired := m.Match("worker started", now)
+	require.False(t, fired)
+
+	excerpt, fired := m.Match("fatal: Out Of Memory while allocating\n", now)
+	require.True(t, fired)
+	require.Equal(t, "fatal: Out Of Memory while allocating", excerpt)
+
+	_, fired = m.Match("out of memory again", now.Add(30*time.Second))
+	require.False(t, fired, "matches inside the cooldown are suppressed")
+
+	_, fired = m.Match("out of memory again", now.Add(time.Minute))
+	require.True(t, fired)
+}
+
+func TestMatcher_ResumesCooldown(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	m, err := NewMatcher(`panic`, 5*time.Minute, now.Add(-time.Minute))
+	require.NoError(t, err)
+
+	_, fired := m.Match("panic: nil map", now)
+	require.False(t, fired)
+
+	_, fired = m.Match("panic: nil map", now.Add(4*time.Minute))
+	require.True(t, fired)
+}
+
+func TestMatcher_StripsANSISequences(t *testing.T) {
+	m, err := NewMatcher(`ERROR db`, time.Minute, time.Time{})
+	require.NoError(t, err)
+
+	excerpt, fired := m.Match("\x1b[31mERROR\x1b[0m db unreachable", time.Now())
+	require.True(t, fired)
+	require.Equal(t, "ERROR db unreachable", excerpt)
+}
+
+func TestCompile_RejectsInvalidPatterns(t *testing.T) {
+	_, err := Compile("  ")
+	require.Error(t, err)
+
+	_, err = Compile("(unclosed")
+	require.Error(t, err)
+
+	_, err = Compile(strings.Repeat("a", MaxPatternLength+1))
+	require.Error(t, err)
+}
+
+func TestExcerpt_CutsLongLinesAroundMatch(t *testing.T) {
+	line := strings.Repeat("é", 400) + "connection refused" + strings.Repeat("x", 400)
+	start := strings.Index(line, "connection refused")
+
+	excerpt := Excerpt(line, start, start+len("connection refused"))
+	require.True(t, utf8.ValidString(excerpt))
+	require.Contains(t, excerpt, "connection refused")
+	require.True(t, strings.HasPrefix(excerpt, "…"))
+	require.True(t, strings.HasSuffix(excerpt, "…"))
+	require.LessOrEqual(t, len(excerpt), MaxExcerptLength+2*len("…"))
+}
@@ -0,0 +1,131 @@
+package logship
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// FileSink appends entries as JSON lines to a file and rotates it once it
+// reaches maxSize bytes, keeping up to maxFiles rotated copies named
+// path.1 (newest) to path.N (oldest).
+type FileSink struct {
+	path     string
+	maxSize  int64
+	maxFiles int
+
+	mu   sync.Mutex
+	file *os.File
+	size int64
+}
+
+type fileRecord struct {
+	Time      string `json:"time"`
+	Project   string `json:"project,omitempty"`
+	Service   string `json:"service,omitempty"`
+	Container string `json:"container"`
+	Stream    string `json:"stream"`
+	Message   string `json:"message"`
+}
+
+// NewFileSink opens path for appending, creating its directory if needed.
+func NewFileSink(path string, maxSize int64, maxFiles int) (*FileSink, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create log directory: %w", err)
+	}
+	s := &FileSink{path: path, maxSize: maxSize, maxFiles: maxFiles}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *FileSink) open() error {
+	f, err := os.OpenFile(s.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o640)
+	if err != nil {
+		return fmt.Errorf("failed to open log file: %w", err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to stat log file: %w", err)
+	}
+	s.file = f
+	s.size = info.Size()
+	return nil
+}
+
+func (s *FileSink) Send(_ context.Context, entries []Entry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.file == nil {
+		return fmt.Errorf("log file %s is closed", s.path)
+	}
+
+	for _, e := range entries {
+		line, err := json.Marshal(fileRecord{
+			Time:      e.Time.UTC().Format(time.RFC3339Nano),
+			Project:   e.Project,
+			Service:   e.Service,
+			Container: e.Container,
+			Stream:    e.Stream,
+			Message:   e.Message,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to encode log line: %w", err)
+		}
+		line = append(line, '\n')
+
+		if s.size > 0 && s.size+int64(len(line)) > s.maxSize {
+			if err := s.rotate(); err != nil {
+				return err
+			}
+		}
+		n, err := s.file.Write(line)
+		s.size += int64(n)
+		if err != nil {
+			return fmt.Errorf("failed to write log file: %w", err)
+		}
+	}
+	return nil
+}
+
+// rotate renames path.i to path.i+1, path to path.1 and starts a new file.
+// The oldest copy is dropped.
+func (s *FileSink) rotate() error {
+	if err := s.file.Close(); err != nil {
+		return fmt.Errorf("failed to close log file: %w", err)
+	}
+	s.file = nil
+
+	if s.maxFiles > 0 {
+		_ = os.Remove(fmt.Sprintf("%s.%d", s.path, s.maxFiles))
+		for i := s.maxFiles - 1; i >= 1; i-- {
+			_ = os.Rename(fmt.Sprintf("%s.%d", s.path, i), fmt.Sprintf("%s.%d", s.path, i+1))
+		}
+		if err := os.Rename(s.path, s.path+".1"); err != nil {
+			return fmt.Errorf("failed to rotate log file: %w", err)
+		}
+	} else if err := os.Remove(s.path); err != nil {
+		return fmt.Errorf("failed to rotate log file: %w", err)
+	}
+
+	return s.open()
+}
+
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	s.file = nil
+	return err
+}
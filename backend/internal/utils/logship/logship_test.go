@@ -0,0 +1,101 @@
+package logship
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigValidate(t *testing.T) {
+	require.NoError(t, Config{Type: SinkLoki, Target: "https://loki.example.com/loki/api/v1/push"}.Validate())
+	require.NoError(t, Config{Type: SinkSyslogTCP, Target: "logs.example.com:514"}.Validate())
+	require.NoError(t, Config{Type: SinkFile, Target: "/var/log/arcane/app.log"}.Validate())
+
+	require.Error(t, Config{Type: SinkLoki, Target: "loki:3100"}.Validate())
+	require.Error(t, Config{Type: SinkSyslogUDP, Target: "logs.example.com"}.Validate())
+	require.Error(t, Config{Type: SinkFile, Target: "relative.log"}.Validate())
+	require.Error(t, Config{Type: "kafka", Target: "broker:9092"}.Validate())
+	require.Error(t, Config{Type: SinkLoki}.Validate())
+}
+
+func TestLokiSinkGroupsStreams(t *testing.T) {
+	var got lokiPushRequest
+	var auth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = r.Header.Get("Authorization")
+		body, _ := io.ReadAll(r.Body)
+		require.NoError(t, json.Unmarshal(body, &got))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	sink, err := NewSink(Config{Type: SinkLoki, Target: srv.URL, Token: "secret"}, srv.Client())
+	require.NoError(t, err)
+
+	ts := time.Unix(1700000000, 5)
+	err = sink.Send(context.Background(), []Entry{
+		{Time: ts, Stream: "stdout", Message: "one", Project: "shop", Service: "web", Container: "shop-web-1"},
+		{Time: ts, Stream: "stderr", Message: "oops", Project: "shop", Service: "web", Container: "shop-web-1"},
+		{Time: ts.Add(time.Second), Stream: "stdout", Message: "two", Project: "shop", Service: "web", Container: "shop-web-1"},
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, "Bearer secret", auth)
+	require.Len(t, got.Streams, 2)
+	require.Equal(t, map[string]string{"job": "arcane", "project": "shop", "service": "web", "container": "shop-web-1", "stream": "stdout"}, got.Streams[0].Stream)
+	require.Equal(t, [][2]string{{"1700000000000000005", "one"}, {"1700000001000000005", "two"}}, got.Streams[0].Values)
+	require.Equal(t, "oops", got.Streams[1].Values[0][1])
+}
+
+func TestLokiSinkReportsErrors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "entry too far behind", http.StatusBadRequest)
+	}))
+	defer srv.Close()
+
+	sink, err := NewSink(Config{Type: SinkLoki, Target: srv.URL}, srv.Client())
+	require.NoError(t, err)
+	err = sink.Send(context.Background(), []Entry{{Time: time.Now(), Stream: "stdout", Message: "x", Container: "c"}})
+	require.ErrorContains(t, err, "entry too far behind")
+}
+
+func TestFormatSyslogEntry(t *testing.T) {
+	ts := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	msg := string(FormatSyslogEntry("host", Entry{Time: ts, Stream: "stderr", Message: "boom", Service: "web", Container: "shop web"}))
+	require.Equal(t, "<139>1 2025-01-02T03:04:05Z host shop_web - web - boom", msg)
+}
+
+func TestFileSinkRotates(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs", "shop.log")
+	sink, err := NewFileSink(path, 200, 2)
+	require.NoError(t, err)
+	defer func() { _ = sink.Close() }()
+
+	entry := Entry{Time: time.Now(), Stream: "stdout", Message: strings.Repeat("x", 80), Container: "c"}
+	for range 6 {
+		require.NoError(t, sink.Send(context.Background(), []Entry{entry}))
+	}
+
+	for _, name := range []string{path, path + ".1", path + ".2"} {
+		info, err := os.Stat(name)
+		require.NoError(t, err, name)
+		require.LessOrEqual(t, info.Size(), int64(200), name)
+	}
+	_, err = os.Stat(path + ".3")
+	require.True(t, os.IsNotExist(err), "only maxFiles rotated copies are kept")
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var rec fileRecord
+	require.NoError(t, json.Unmarshal([]byte(strings.SplitN(string(data), "\n", 2)[0]), &rec))
+	require.Equal(t, "c", rec.Container)
+}
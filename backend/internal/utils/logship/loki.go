@@ -0,0 +1,92 @@
+package logship
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// LokiSink pushes entries to the Loki push API (/loki/api/v1/push). Each
+// container stream becomes a Loki stream labelled with the project, service,
+// container and output stream.
+type LokiSink struct {
+	client *http.Client
+	url    string
+	token  string
+}
+
+type lokiStream struct {
+	Stream map[string]string `json:"stream"`
+	Values [][2]string       `json:"values"`
+}
+
+type lokiPushRequest struct {
+	Streams []lokiStream `json:"streams"`
+}
+
+// BuildLokiPush groups entries into Loki streams, keeping their order within
+// each stream.
+func BuildLokiPush(entries []Entry) ([]byte, error) {
+	var streams []lokiStream
+	index := make(map[[4]string]int)
+	for _, e := range entries {
+		key := [4]string{e.Project, e.Service, e.Container, e.Stream}
+		i, ok := index[key]
+		if !ok {
+			labels := map[string]string{"job": "arcane", "container": e.Container, "stream": e.Stream}
+			if e.Project != "" {
+				labels["project"] = e.Project
+			}
+			if e.Service != "" {
+				labels["service"] = e.Service
+			}
+			i = len(streams)
+			index[key] = i
+			streams = append(streams, lokiStream{Stream: labels})
+		}
+		streams[i].Values = append(streams[i].Values, [2]string{strconv.FormatInt(e.Time.UnixNano(), 10), e.Message})
+	}
+	return json.Marshal(lokiPushRequest{Streams: streams})
+}
+
+func (s *LokiSink) Send(ctx context.Context, entries []Entry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	body, err := BuildLokiPush(entries)
+	if err != nil {
+		return fmt.Errorf("failed to encode Loki push: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create Loki push request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if user, pass, ok := strings.Cut(s.token, ":"); ok {
+		req.SetBasicAuth(user, pass)
+	} else if s.token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.token)
+	}
+
+	resp, err := s.client.Do(req) //nolint:gosec // URL is validated in NewSink
+	if err != nil {
+		return fmt.Errorf("failed to push logs to Loki: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("loki returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+func (s *LokiSink) Close() error {
+	return nil
+}
@@ -0,0 +1,114 @@
+// Package logship ships container log lines to external sinks: a Loki push
+// API, a syslog collector or a local file with size based rotation.
+package logship
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	SinkLoki      = "loki"
+	SinkSyslogUDP = "syslog-udp"
+	SinkSyslogTCP = "syslog-tcp"
+	SinkFile      = "file"
+
+	defaultFileMaxSizeMB = 10
+	defaultFileMaxFiles  = 5
+	dialTimeout          = 5 * time.Second
+)
+
+// Entry is a single log line together with the container it came from.
+type Entry struct {
+	Time      time.Time
+	Stream    string
+	Message   string
+	Project   string
+	Service   string
+	Container string
+}
+
+// Sink delivers batches of entries to a destination.
+type Sink interface {
+	Send(ctx context.Context, entries []Entry) error
+	Close() error
+}
+
+// Config describes a sink. Target is a push URL for Loki, host:port for
+// syslog and an absolute file path for file sinks.
+type Config struct {
+	Type   string
+	Target string
+	// Token is sent as a bearer token to Loki. A token of the form
+	// user:password is sent as basic auth instead.
+	Token string
+	// MaxSizeMB and MaxFiles control rotation of file sinks.
+	MaxSizeMB int
+	MaxFiles  int
+}
+
+// Validate checks the config without opening the sink.
+func (c Config) Validate() error {
+	target := strings.TrimSpace(c.Target)
+	if target == "" {
+		return fmt.Errorf("log forwarding target is required")
+	}
+
+	switch c.Type {
+	case SinkLoki:
+		u, err := url.Parse(target)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid Loki push URL: %q", target)
+		}
+	case SinkSyslogUDP, SinkSyslogTCP:
+		if _, _, err := net.SplitHostPort(target); err != nil {
+			return fmt.Errorf("syslog target must be host:port: %q", target)
+		}
+	case SinkFile:
+		if !filepath.IsAbs(target) {
+			return fmt.Errorf("log file path must be absolute: %q", target)
+		}
+		if c.MaxSizeMB < 0 || c.MaxFiles < 0 {
+			return fmt.Errorf("log file rotation limits must not be negative")
+		}
+	default:
+		return fmt.Errorf("unsupported log sink: %q", c.Type)
+	}
+	return nil
+}
+
+// NewSink opens the sink described by cfg.
+func NewSink(cfg Config, httpClient *http.Client) (Sink, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	target := strings.TrimSpace(cfg.Target)
+
+	switch cfg.Type {
+	case SinkLoki:
+		if httpClient == nil {
+			httpClient = &http.Client{Timeout: 10 * time.Second}
+		}
+		return &LokiSink{client: httpClient, url: target, token: cfg.Token}, nil
+	case SinkSyslogUDP:
+		return &SyslogSink{network: "udp", address: target}, nil
+	case SinkSyslogTCP:
+		return &SyslogSink{network: "tcp", address: target}, nil
+	default:
+		maxSize := cfg.MaxSizeMB
+		if maxSize == 0 {
+			maxSize = defaultFileMaxSizeMB
+		}
+		maxFiles := cfg.MaxFiles
+		if maxFiles == 0 {
+			maxFiles = defaultFileMaxFiles
+		}
+		return NewFileSink(target, int64(maxSize)*1024*1024, maxFiles)
+	}
+}
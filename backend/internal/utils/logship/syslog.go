@@ -0,0 +1,85 @@
+package logship
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+)
+
+// SyslogSink writes entries as RFC 5424 messages to a remote collector,
+// using the container name as the app name. Each batch uses one connection.
+type SyslogSink struct {
+	network string
+	address string
+}
+
+func (s *SyslogSink) Send(ctx context.Context, entries []Entry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	dialer := net.Dialer{Timeout: dialTimeout}
+	conn, err := dialer.DialContext(ctx, s.network, s.address)
+	if err != nil {
+		return fmt.Errorf("failed to connect to syslog collector: %w", err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetWriteDeadline(deadline)
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "-"
+	}
+	for _, e := range entries {
+		msg := FormatSyslogEntry(hostname, e)
+		if s.network == "tcp" {
+			// RFC 6587 octet counting framing
+			msg = fmt.Appendf(nil, "%d %s", len(msg), msg)
+		}
+		if _, err := conn.Write(msg); err != nil {
+			return fmt.Errorf("failed to write syslog message: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *SyslogSink) Close() error {
+	return nil
+}
+
+// FormatSyslogEntry renders an entry with the local1 facility. stderr lines
+// are sent with error severity, stdout lines as informational.
+func FormatSyslogEntry(hostname string, e Entry) []byte {
+	const facilityLocal1 = 17
+	severity := 6
+	if e.Stream == "stderr" {
+		severity = 3
+	}
+	appName := syslogName(e.Container)
+	msgID := syslogName(e.Service)
+	return fmt.Appendf(nil, "<%d>1 %s %s %s - %s - %s", facilityLocal1*8+severity, e.Time.UTC().Format(time.RFC3339Nano), hostname, appName, msgID, e.Message)
+}
+
+// syslogName makes value usable as an RFC 5424 header field, which must be
+// printable ASCII without spaces and at most 32 characters here.
+func syslogName(value string) string {
+	value = strings.Map(func(r rune) rune {
+		if r <= ' ' || r > '~' {
+			return '_'
+		}
+		return r
+	}, value)
+	if value == "" {
+		return "-"
+	}
+	if len(value) > 32 {
+		value = value[:32]
+	}
+	return value
+}
@@ -0,0 +1,220 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/smtp"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+)
+
+// ErrCredentialsRejected means the provider answered and refused the stored
+// credentials, as opposed to a network or server error during the check.
+var ErrCredentialsRejected = errors.New("credentials rejected by provider")
+
+// ErrCredentialCheckUnsupported is returned for providers whose credentials
+// cannot be verified without sending a real message.
+var ErrCredentialCheckUnsupported = errors.New("credential check not supported for this provider")
+
+const credentialCheckTimeout = 15 * time.Second
+
+// Base URLs are variables so tests can point them at a local server.
+var (
+	telegramAPIBaseURL = "https://api.telegram.org"
+	discordAPIBaseURL  = "https://discord.com/api"
+	slackAPIBaseURL    = "https://slack.com/api"
+	pushoverAPIBaseURL = "https://api.pushover.net"
+)
+
+// CheckTelegramCredentials calls getMe to verify the bot token.
+func CheckTelegramCredentials(ctx context.Context, client *http.Client, botToken string) error {
+	if strings.TrimSpace(botToken) == "" {
+		return fmt.Errorf("%w: telegram bot token is empty", ErrCredentialsRejected)
+	}
+
+	var body struct {
+		OK          bool   `json:"ok"`
+		Description string `json:"description"`
+	}
+	status, err := doCredentialRequestInternal(ctx, client, http.MethodGet, telegramAPIBaseURL+"/bot"+url.PathEscape(botToken)+"/getMe", nil, nil, &body)
+	if err != nil {
+		return err
+	}
+	if status == http.StatusUnauthorized || status == http.StatusNotFound || (status == http.StatusOK && !body.OK) {
+		return fmt.Errorf("%w: telegram: %s", ErrCredentialsRejected, describeStatusInternal(status, body.Description))
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("telegram returned status %d", status)
+	}
+	return nil
+}
+
+// CheckDiscordCredentials fetches the webhook, which Discord allows with the
+// webhook token and without posting a message.
+func CheckDiscordCredentials(ctx context.Context, client *http.Client, webhookID, token string) error {
+	if webhookID == "" || token == "" {
+		return fmt.Errorf("%w: discord webhook ID or token is empty", ErrCredentialsRejected)
+	}
+
+	endpoint := discordAPIBaseURL + "/webhooks/" + url.PathEscape(webhookID) + "/" + url.PathEscape(token)
+	status, err := doCredentialRequestInternal(ctx, client, http.MethodGet, endpoint, nil, nil, nil)
+	if err != nil {
+		return err
+	}
+	switch {
+	case status == http.StatusUnauthorized || status == http.StatusForbidden || status == http.StatusNotFound:
+		return fmt.Errorf("%w: discord webhook %s", ErrCredentialsRejected, describeStatusInternal(status, ""))
+	case status != http.StatusOK:
+		return fmt.Errorf("discord returned status %d", status)
+	}
+	return nil
+}
+
+// CheckSlackCredentials verifies bot or user OAuth tokens with auth.test.
+// Incoming webhook tokens cannot be checked without posting.
+func CheckSlackCredentials(ctx context.Context, client *http.Client, token string) error {
+	token = strings.TrimSpace(token)
+	if !strings.HasPrefix(token, "xoxb") && !strings.HasPrefix(token, "xoxp") {
+		return ErrCredentialCheckUnsupported
+	}
+	// Shoutrrr accepts "xoxb:rest" as well as the native "xoxb-rest" form.
+	if len(token) > 4 && token[4] == ':' {
+		token = token[:4] + "-" + token[5:]
+	}
+
+	var body struct {
+		OK    bool   `json:"ok"`
+		Error string `json:"error"`
+	}
+	headers := map[string]string{"Authorization": "Bearer " + token}
+	status, err := doCredentialRequestInternal(ctx, client, http.MethodPost, slackAPIBaseURL+"/auth.test", headers, nil, &body)
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("slack returned status %d", status)
+	}
+	if !body.OK {
+		return fmt.Errorf("%w: slack: %s", ErrCredentialsRejected, body.Error)
+	}
+	return nil
+}
+
+// CheckPushoverCredentials validates the application token and user key.
+func CheckPushoverCredentials(ctx context.Context, client *http.Client, token, user string) error {
+	if strings.TrimSpace(token) == "" || strings.TrimSpace(user) == "" {
+		return fmt.Errorf("%w: pushover token or user key is empty", ErrCredentialsRejected)
+	}
+
+	form := url.Values{}
+	form.Set("token", strings.TrimSpace(token))
+	form.Set("user", strings.TrimSpace(user))
+	headers := map[string]string{"Content-Type": "application/x-www-form-urlencoded"}
+
+	var body struct {
+		Status int      `json:"status"`
+		Errors []string `json:"errors"`
+	}
+	status, err := doCredentialRequestInternal(ctx, client, http.MethodPost, pushoverAPIBaseURL+"/1/users/validate.json", headers, strings.NewReader(form.Encode()), &body)
+	if err != nil {
+		return err
+	}
+	if body.Status == 1 {
+		return nil
+	}
+	if status >= 400 && status < 500 {
+		return fmt.Errorf("%w: pushover: %s", ErrCredentialsRejected, strings.Join(body.Errors, "; "))
+	}
+	return fmt.Errorf("pushover returned status %d", status)
+}
+
+// CheckEmailCredentials connects to the SMTP server and authenticates without
+// sending a message.
+func CheckEmailCredentials(ctx context.Context, config models.EmailConfig) error {
+	if config.SMTPHost == "" || config.SMTPPort == 0 {
+		return fmt.Errorf("%w: SMTP host or port not configured", ErrCredentialsRejected)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, credentialCheckTimeout)
+	defer cancel()
+
+	client, err := dialSMTPInternal(ctx, config)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = client.Close() }()
+
+	if config.SMTPUsername != "" || config.SMTPPassword != "" {
+		if ok, _ := client.Extension("AUTH"); !ok {
+			return fmt.Errorf("SMTP server does not support authentication")
+		}
+		if err := client.Auth(&plainAuthInternal{username: config.SMTPUsername, password: config.SMTPPassword}); err != nil {
+			return fmt.Errorf("%w: SMTP login failed: %w", ErrCredentialsRejected, err)
+		}
+	}
+
+	return client.Quit()
+}
+
+// plainAuthInternal is PLAIN auth without net/smtp's refusal to authenticate
+// over unencrypted connections; the TLS mode is the user's choice and matches
+// how notifications are actually sent.
+type plainAuthInternal struct {
+	username string
+	password string
+}
+
+func (a *plainAuthInternal) Start(_ *smtp.ServerInfo) (string, []byte, error) {
+	return "PLAIN", []byte("\x00" + a.username + "\x00" + a.password), nil
+}
+
+func (a *plainAuthInternal) Next(_ []byte, more bool) ([]byte, error) {
+	if more {
+		return nil, errors.New("unexpected server challenge")
+	}
+	return nil, nil
+}
+
+func doCredentialRequestInternal(ctx context.Context, client *http.Client, method, endpoint string, headers map[string]string, body io.Reader, out any) (int, error) {
+	if client == nil {
+		client = &http.Client{Timeout: credentialCheckTimeout}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, body)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		// The URL may contain the token; report the error without it.
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return 0, fmt.Errorf("request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if out != nil {
+		_ = json.NewDecoder(io.LimitReader(resp.Body, 64*1024)).Decode(out)
+	}
+	return resp.StatusCode, nil
+}
+
+func describeStatusInternal(status int, description string) string {
+	if description != "" {
+		return description
+	}
+	return fmt.Sprintf("status %d", status)
+}
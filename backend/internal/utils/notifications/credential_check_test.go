@@ -0,0 +1,52 @@
+package notifications
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckTelegramCredentials(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/botgood-token/getMe" {
+			_, _ = w.Write([]byte(`{"ok":true,"result":{"id":1}}`))
+			return
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"ok":false,"description":"Unauthorized"}`))
+	}))
+	defer server.Close()
+
+	original := telegramAPIBaseURL
+	telegramAPIBaseURL = server.URL
+	t.Cleanup(func() { telegramAPIBaseURL = original })
+
+	require.NoError(t, CheckTelegramCredentials(context.Background(), server.Client(), "good-token"))
+
+	err := CheckTelegramCredentials(context.Background(), server.Client(), "revoked-token")
+	require.ErrorIs(t, err, ErrCredentialsRejected)
+	require.NotContains(t, err.Error(), "revoked-token")
+}
+
+func TestCheckDiscordCredentials_ServerErrorIsNotRejection(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	original := discordAPIBaseURL
+	discordAPIBaseURL = server.URL
+	t.Cleanup(func() { discordAPIBaseURL = original })
+
+	err := CheckDiscordCredentials(context.Background(), server.Client(), "123", "token")
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrCredentialsRejected)
+}
+
+func TestCheckSlackCredentials_WebhookUnsupported(t *testing.T) {
+	err := CheckSlackCredentials(context.Background(), nil, "hook:T000/B000/XXXX")
+	require.ErrorIs(t, err, ErrCredentialCheckUnsupported)
+}
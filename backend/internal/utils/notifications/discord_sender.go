@@ -3,6 +3,9 @@ package notifications
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/url"
+	"time"
 
 	"github.com/getarcaneapp/arcane/backend/internal/models"
 	"github.com/nicholas-fedor/shoutrrr"
@@ -49,3 +52,105 @@ func SendDiscord(ctx context.Context, config models.DiscordConfig, message strin
 	}
 	return nil
 }
+
+// discordWebhookBaseURL is where rich messages are posted; Shoutrrr only
+// sends plain content.
+var discordWebhookBaseURL = "https://discord.com/api/webhooks/"
+
+// SendDiscordRich sends msg as an embed with its fields, a color for its
+// severity and a link button back to Arcane. msg.Text is sent through
+// SendDiscord instead when the config asks for plain text.
+func SendDiscordRich(ctx context.Context, config models.DiscordConfig, msg RichMessage) error {
+	if config.PlainText {
+		return SendDiscord(ctx, config, msg.Text)
+	}
+	if config.WebhookID == "" {
+		return fmt.Errorf("discord webhook ID is empty")
+	}
+	if config.Token == "" {
+		return fmt.Errorf("discord token is empty")
+	}
+
+	payload := discordRichPayloadInternal(msg, time.Now())
+	if config.Username != "" {
+		payload["username"] = config.Username
+	}
+	if config.AvatarURL != "" {
+		payload["avatar_url"] = config.AvatarURL
+	}
+
+	endpoint := discordWebhookBaseURL + url.PathEscape(config.WebhookID) + "/" + url.PathEscape(config.Token)
+	if _, ok := payload["components"]; ok {
+		// Webhooks not owned by an application may only send link buttons, and
+		// only when they ask for components explicitly.
+		endpoint += "?with_components=true"
+	}
+
+	tlsConfig, err := BuildTLSConfig(nil, "")
+	if err != nil {
+		return fmt.Errorf("invalid Discord TLS settings: %w", err)
+	}
+	headers := map[string]string{"Content-Type": "application/json"}
+	if err := doDirectRequestInternal(ctx, newTLSHTTPClientInternal(tlsConfig), http.MethodPost, endpoint, headers, payload, nil); err != nil {
+		return fmt.Errorf("failed to send Discord message: %w", err)
+	}
+	return nil
+}
+
+// discordRichPayloadInternal renders msg as a webhook payload, keeping to
+// Discord's embed limits.
+func discordRichPayloadInternal(msg RichMessage, now time.Time) map[string]any {
+	embed := map[string]any{
+		"title":     truncateRunesInternal(msg.Title, 256),
+		"color":     msg.Severity.color(),
+		"timestamp": now.UTC().Format(time.RFC3339),
+	}
+	if msg.Description != "" {
+		embed["description"] = truncateRunesInternal(msg.Description, 4096)
+	}
+	if msg.URL != "" {
+		embed["url"] = msg.URL
+	}
+
+	fields := make([]map[string]any, 0, len(msg.Fields))
+	for _, f := range msg.Fields {
+		if len(fields) == 25 {
+			break
+		}
+		value := f.Value
+		if value == "" {
+			value = "-"
+		}
+		fields = append(fields, map[string]any{
+			"name":   truncateRunesInternal(f.Name, 256),
+			"value":  truncateRunesInternal(value, 1024),
+			"inline": f.Inline,
+		})
+	}
+	if len(fields) > 0 {
+		embed["fields"] = fields
+	}
+
+	payload := map[string]any{"embeds": []any{embed}}
+
+	// Link buttons go in action rows of up to five, and a message has at most
+	// five rows.
+	var rows []any
+	buttons := msg.buttonsInternal()
+	for start := 0; start < len(buttons) && len(rows) < 5; start += 5 {
+		row := make([]any, 0, 5)
+		for _, b := range buttons[start:min(start+5, len(buttons))] {
+			row = append(row, map[string]any{
+				"type":  2,
+				"style": 5,
+				"label": truncateRunesInternal(b.Label, 80),
+				"url":   b.URL,
+			})
+		}
+		rows = append(rows, map[string]any{"type": 1, "components": row})
+	}
+	if len(rows) > 0 {
+		payload["components"] = rows
+	}
+	return payload
+}
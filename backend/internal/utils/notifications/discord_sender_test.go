@@ -2,7 +2,9 @@ package notifications
 
 import (
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/getarcaneapp/arcane/backend/internal/models"
 	"github.com/stretchr/testify/assert"
@@ -281,3 +283,42 @@ func validateDiscordConfig(config models.DiscordConfig) error {
 	}
 	return nil
 }
+
+func TestDiscordRichPayload(t *testing.T) {
+	msg := RichMessage{
+		Title:       strings.Repeat("t", 300),
+		Description: "Fixable vulnerabilities were found.",
+		Severity:    RichSeverityError,
+		Fields:      []RichField{{Name: "Image", Value: "nginx:1.25", Inline: true}, {Name: "Empty"}},
+		URL:         "https://arcane.example.com/security",
+		Actions:     []RichLink{{Label: "Apply update", URL: "https://arcane.example.com/notification-actions/abc"}, {Label: "No URL"}},
+	}
+	payload := discordRichPayloadInternal(msg, time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+
+	embed := payload["embeds"].([]any)[0].(map[string]any)
+	assert.Len(t, []rune(embed["title"].(string)), 256)
+	assert.Equal(t, 0xEF4444, embed["color"])
+	assert.Equal(t, "2026-01-02T03:04:05Z", embed["timestamp"])
+	fields := embed["fields"].([]map[string]any)
+	require.Len(t, fields, 2)
+	assert.Equal(t, "-", fields[1]["value"], "Discord rejects empty field values")
+
+	row := payload["components"].([]any)[0].(map[string]any)
+	button := row["components"].([]any)[0].(map[string]any)
+	assert.Equal(t, "Open in Arcane", button["label"])
+	assert.Equal(t, "https://arcane.example.com/security", button["url"])
+	buttons := row["components"].([]any)
+	require.Len(t, buttons, 2, "actions without a URL are skipped")
+	assert.Equal(t, "Apply update", buttons[1].(map[string]any)["label"])
+
+	many := RichMessage{Title: "Updates", URL: "https://arcane.example.com/images"}
+	for range 30 {
+		many.Actions = append(many.Actions, RichLink{Label: "Apply", URL: "https://arcane.example.com/notification-actions/abc"})
+	}
+	rows := discordRichPayloadInternal(many, time.Now())["components"].([]any)
+	assert.Len(t, rows, 5, "Discord allows five action rows")
+	assert.Len(t, rows[4].(map[string]any)["components"].([]any), 5)
+
+	noLink := discordRichPayloadInternal(RichMessage{Title: "Auto Heal"}, time.Now())
+	assert.NotContains(t, noLink, "components")
+}
@@ -0,0 +1,77 @@
+package notifications
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"strings"
+)
+
+// base64LineLength keeps encoded attachment lines within the 76 characters
+// allowed by RFC 2045.
+const base64LineLength = 76
+
+// EmailAttachment is a file sent alongside the HTML body of an email.
+type EmailAttachment struct {
+	Filename    string
+	ContentType string
+	Data        []byte
+}
+
+// CSVAttachment renders rows under header as a CSV attachment.
+func CSVAttachment(filename string, header []string, rows [][]string) (EmailAttachment, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(header); err != nil {
+		return EmailAttachment{}, fmt.Errorf("failed to write %s: %w", filename, err)
+	}
+	if err := w.WriteAll(rows); err != nil {
+		return EmailAttachment{}, fmt.Errorf("failed to write %s: %w", filename, err)
+	}
+	return EmailAttachment{Filename: filename, ContentType: "text/csv", Data: buf.Bytes()}, nil
+}
+
+// JSONAttachment renders v as an indented JSON attachment.
+func JSONAttachment(filename string, v any) (EmailAttachment, error) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return EmailAttachment{}, fmt.Errorf("failed to write %s: %w", filename, err)
+	}
+	return EmailAttachment{Filename: filename, ContentType: "application/json", Data: data}, nil
+}
+
+// writeAttachmentPartInternal writes the headers and base64 body of a
+// multipart/mixed part for attachment.
+func writeAttachmentPartInternal(buf *bytes.Buffer, attachment EmailAttachment) {
+	contentType := attachment.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	filename := SanitizeForEmail(attachment.Filename)
+
+	buf.WriteString("Content-Type: " + mime.FormatMediaType(contentType, map[string]string{"name": filename}) + "\r\n")
+	buf.WriteString("Content-Disposition: " + mime.FormatMediaType("attachment", map[string]string{"filename": filename}) + "\r\n")
+	buf.WriteString("Content-Transfer-Encoding: base64\r\n\r\n")
+
+	encoded := base64.StdEncoding.EncodeToString(attachment.Data)
+	for len(encoded) > base64LineLength {
+		buf.WriteString(encoded[:base64LineLength] + "\r\n")
+		encoded = encoded[base64LineLength:]
+	}
+	if encoded != "" {
+		buf.WriteString(encoded + "\r\n")
+	}
+}
+
+// attachmentBoundaryInternal picks a multipart boundary that does not occur
+// in the body. Attachments are base64 encoded, so only the body is checked.
+func attachmentBoundaryInternal(body string) string {
+	boundary := "arcane-mixed"
+	for strings.Contains(body, boundary) {
+		boundary += "-x"
+	}
+	return boundary
+}
@@ -3,6 +3,7 @@ package notifications
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"net/url"
 	"strings"
 
@@ -106,6 +107,12 @@ func SendGenericWithTitle(ctx context.Context, config models.GenericConfig, titl
 		return fmt.Errorf("webhook URL is empty")
 	}
 
+	if hasCustomTLSInternal(config.TLS) {
+		if endpoint, ok := genericHTTPSEndpointInternal(config); ok {
+			return sendGenericDirectInternal(ctx, config, endpoint, title, message)
+		}
+	}
+
 	shoutrrrURL, err := BuildGenericURL(config)
 	if err != nil {
 		return fmt.Errorf("failed to build shoutrrr Generic URL: %w", err)
@@ -134,3 +141,60 @@ func SendGenericWithTitle(ctx context.Context, config models.GenericConfig, titl
 	}
 	return nil
 }
+
+// genericHTTPSEndpointInternal returns the webhook URL when it is served over
+// HTTPS, applying the same scheme defaulting as BuildGenericURL.
+func genericHTTPSEndpointInternal(config models.GenericConfig) (string, bool) {
+	raw := config.WebhookURL
+	if !strings.Contains(raw, "://") {
+		if config.DisableTLS {
+			return "", false
+		}
+		raw = "https://" + strings.TrimPrefix(raw, "//")
+	}
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" || !strings.EqualFold(u.Scheme, "https") {
+		return "", false
+	}
+	return u.String(), true
+}
+
+// sendGenericDirectInternal posts the same JSON body Shoutrrr's generic
+// service would, using the provider's own TLS settings.
+func sendGenericDirectInternal(ctx context.Context, config models.GenericConfig, endpoint, title, message string) error {
+	tlsConfig, err := BuildTLSConfig(config.TLS, "")
+	if err != nil {
+		return fmt.Errorf("invalid webhook TLS settings: %w", err)
+	}
+
+	method := strings.ToUpper(config.Method)
+	if method == "" {
+		method = http.MethodPost
+	}
+	contentType := config.ContentType
+	if contentType == "" {
+		contentType = "application/json"
+	}
+	titleKey := config.TitleKey
+	if titleKey == "" {
+		titleKey = "title"
+	}
+	messageKey := config.MessageKey
+	if messageKey == "" {
+		messageKey = "message"
+	}
+
+	headers := map[string]string{"Content-Type": contentType}
+	for k, v := range config.CustomHeaders {
+		headers[k] = v
+	}
+	body := map[string]string{messageKey: message}
+	if title != "" {
+		body[titleKey] = title
+	}
+
+	if err := doDirectRequestInternal(ctx, newTLSHTTPClientInternal(tlsConfig), method, endpoint, headers, body, nil); err != nil {
+		return fmt.Errorf("failed to send Generic webhook message: %w", err)
+	}
+	return nil
+}
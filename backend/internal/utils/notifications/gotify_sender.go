@@ -3,6 +3,7 @@ package notifications
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"net/url"
 	"strings"
 
@@ -55,8 +56,15 @@ func BuildGotifyURL(config models.GotifyConfig) (string, error) {
 	return u.String(), nil
 }
 
-// SendGotify sends a message via Shoutrrr Gotify using proper service configuration
-func SendGotify(ctx context.Context, config models.GotifyConfig, message string) error {
+// SendGotify sends a message via Shoutrrr Gotify using proper service configuration.
+// A non-empty clickURL is attached as a client notification extra, so tapping
+// the notification opens it.
+func SendGotify(ctx context.Context, config models.GotifyConfig, event models.NotificationEventType, message, clickURL string) error {
+	config = config.ForEvent(event)
+	if clickURL != "" || (hasCustomTLSInternal(config.TLS) && !config.DisableTLS) {
+		return sendGotifyDirectInternal(ctx, config, message, clickURL)
+	}
+
 	shoutrrrURL, err := BuildGotifyURL(config)
 	if err != nil {
 		return fmt.Errorf("failed to build shoutrrr Gotify URL: %w", err)
@@ -77,3 +85,55 @@ func SendGotify(ctx context.Context, config models.GotifyConfig, message string)
 	}
 	return nil
 }
+
+// sendGotifyDirectInternal posts to Gotify's message API with the provider's
+// own TLS settings. Shoutrrr cannot send message extras, so click URLs take
+// this path as well.
+func sendGotifyDirectInternal(ctx context.Context, config models.GotifyConfig, message, clickURL string) error {
+	if config.Host == "" {
+		return fmt.Errorf("gotify host is required")
+	}
+	if config.Token == "" {
+		return fmt.Errorf("gotify token is required")
+	}
+
+	tlsConfig, err := BuildTLSConfig(config.TLS, "")
+	if err != nil {
+		return fmt.Errorf("invalid Gotify TLS settings: %w", err)
+	}
+
+	host := config.Host
+	if config.Port > 0 {
+		host = fmt.Sprintf("%s:%d", host, config.Port)
+	}
+	path := strings.TrimSuffix(config.Path, "/")
+	if path != "" && !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	scheme := "https"
+	if config.DisableTLS {
+		scheme = "http"
+	}
+	endpoint := (&url.URL{Scheme: scheme, Host: host, Path: path + "/message"}).String()
+	headers := map[string]string{
+		"Content-Type": "application/json",
+		"X-Gotify-Key": config.Token,
+	}
+	body := map[string]any{
+		"title":    config.Title,
+		"message":  message,
+		"priority": config.Priority,
+	}
+	if clickURL != "" {
+		body["extras"] = map[string]any{
+			"client::notification": map[string]any{
+				"click": map[string]string{"url": clickURL},
+			},
+		}
+	}
+	if err := doDirectRequestInternal(ctx, newTLSHTTPClientInternal(tlsConfig), http.MethodPost, endpoint, headers, body, nil); err != nil {
+		return fmt.Errorf("failed to send Gotify message: %w", err)
+	}
+	return nil
+}
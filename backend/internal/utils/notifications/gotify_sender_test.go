@@ -1,6 +1,11 @@
 package notifications
 
 import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
 	"testing"
 
 	"github.com/getarcaneapp/arcane/backend/internal/models"
@@ -105,3 +110,51 @@ func TestBuildGotifyURL(t *testing.T) {
 		})
 	}
 }
+
+func TestGotifyConfigForEvent(t *testing.T) {
+	config := models.GotifyConfig{
+		Priority: 5,
+		EventSettings: map[models.NotificationEventType]models.GotifyEventSettings{
+			models.NotificationEventContainerCrash: {Priority: new(9)},
+			models.NotificationEventPruneReport:    {Priority: new(0)},
+		},
+	}
+
+	assert.Equal(t, 9, config.ForEvent(models.NotificationEventContainerCrash).Priority)
+	assert.Equal(t, 0, config.ForEvent(models.NotificationEventPruneReport).Priority)
+	assert.Equal(t, 5, config.ForEvent(models.NotificationEventImageUpdate).Priority)
+}
+
+func TestSendGotifyClickURL(t *testing.T) {
+	var got map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/message", r.URL.Path)
+		assert.Equal(t, "token123", r.Header.Get("X-Gotify-Key"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+	}))
+	defer server.Close()
+
+	host, port, err := net.SplitHostPort(server.Listener.Addr().String())
+	require.NoError(t, err)
+	portNumber, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	config := models.GotifyConfig{
+		Host:       host,
+		Port:       portNumber,
+		Token:      "token123",
+		Priority:   5,
+		DisableTLS: true,
+		EventSettings: map[models.NotificationEventType]models.GotifyEventSettings{
+			models.NotificationEventContainerCrash: {Priority: new(9)},
+		},
+	}
+	clickURL := "https://arcane.example.com/containers/web"
+	require.NoError(t, SendGotify(t.Context(), config, models.NotificationEventContainerCrash, "crashed", clickURL))
+
+	assert.Equal(t, "crashed", got["message"])
+	assert.InDelta(t, 9, got["priority"], 0)
+	assert.Equal(t, map[string]any{
+		"client::notification": map[string]any{"click": map[string]any{"url": clickURL}},
+	}, got["extras"])
+}
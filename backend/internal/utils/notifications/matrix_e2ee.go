@@ -0,0 +1,673 @@
+package notifications
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"maunium.net/go/mautrix/crypto/canonicaljson"
+	"maunium.net/go/mautrix/crypto/goolm/account"
+	"maunium.net/go/mautrix/crypto/goolm/session"
+	"maunium.net/go/mautrix/crypto/olm"
+	"maunium.net/go/mautrix/crypto/signatures"
+	"maunium.net/go/mautrix/id"
+
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+)
+
+// Rotation defaults from the m.room.encryption spec, used when the room does
+// not set its own.
+const (
+	matrixDefaultRotationPeriod   = 7 * 24 * time.Hour
+	matrixDefaultRotationMessages = 100
+)
+
+// matrixPickleKey encrypts the Olm and Megolm pickles inside the session
+// state. The store already encrypts the whole state at rest, so a fixed key is
+// enough.
+var matrixPickleKey = []byte("arcane-matrix-session")
+
+// MatrixStore persists the device and encryption sessions of Matrix accounts
+// between sends. LoadMatrixSession returns nil when nothing is stored for key.
+type MatrixStore interface {
+	LoadMatrixSession(ctx context.Context, key string) ([]byte, error)
+	SaveMatrixSession(ctx context.Context, key string, state []byte) error
+}
+
+// matrixSessionLocks serialises sends per account, since every send advances
+// the stored ratchets.
+var matrixSessionLocks sync.Map
+
+// matrixSessionState is what Arcane keeps per account to send to encrypted
+// rooms from one stable device.
+type matrixSessionState struct {
+	UserID       string         `json:"userId"`
+	DeviceID     string         `json:"deviceId"`
+	AccessToken  string         `json:"accessToken,omitempty"`
+	Account      *matrixAccount `json:"account,omitempty"`
+	KeysUploaded bool           `json:"keysUploaded"`
+	// OlmSessions are keyed by the Curve25519 key of the other device.
+	OlmSessions map[string]*matrixOlmSession  `json:"olmSessions,omitempty"`
+	Rooms       map[string]*matrixRoomSession `json:"rooms,omitempty"`
+}
+
+// matrixRoomSession is the outbound Megolm session of a room. SharedWith maps
+// "<user> <device>" to the Curve25519 key the session key was sent to.
+type matrixRoomSession struct {
+	Session    *matrixGroupSession `json:"session"`
+	CreatedAt  time.Time           `json:"createdAt"`
+	SharedWith map[string]string   `json:"sharedWith"`
+}
+
+// matrixAccount, matrixOlmSession and matrixGroupSession store the goolm
+// account and sessions in the session state as pickles.
+type matrixAccount struct{ *account.Account }
+
+func (a *matrixAccount) MarshalJSON() ([]byte, error) {
+	pickled, err := a.Pickle(matrixPickleKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pickle Matrix account: %w", err)
+	}
+	return json.Marshal(string(pickled))
+}
+
+func (a *matrixAccount) UnmarshalJSON(data []byte) error {
+	var pickled string
+	if err := json.Unmarshal(data, &pickled); err != nil {
+		return err
+	}
+	acc, err := account.AccountFromPickled([]byte(pickled), matrixPickleKey)
+	if err != nil {
+		return fmt.Errorf("failed to unpickle Matrix account: %w", err)
+	}
+	a.Account = acc
+	return nil
+}
+
+// curve25519Internal returns the account's identity key for key exchange.
+func (a *matrixAccount) curve25519Internal() string {
+	_, curve25519, _ := a.IdentityKeys()
+	return string(curve25519)
+}
+
+// ed25519Internal returns the account's signing key.
+func (a *matrixAccount) ed25519Internal() string {
+	ed25519, _, _ := a.IdentityKeys()
+	return string(ed25519)
+}
+
+// signJSONInternal adds the account's signature to obj, following the
+// Matrix rules for signing JSON.
+func (a *matrixAccount) signJSONInternal(obj map[string]any, userID, deviceID string) error {
+	unsigned := maps.Clone(obj)
+	delete(unsigned, "signatures")
+	delete(unsigned, "unsigned")
+	data, err := json.Marshal(unsigned)
+	if err != nil {
+		return fmt.Errorf("failed to encode signed JSON: %w", err)
+	}
+	canonical, err := canonicaljson.CanonicalJSON(data)
+	if err != nil {
+		return fmt.Errorf("failed to encode signed JSON: %w", err)
+	}
+	signature, err := a.Sign(canonical)
+	if err != nil {
+		return fmt.Errorf("failed to sign JSON: %w", err)
+	}
+
+	sigs, _ := obj["signatures"].(map[string]any)
+	if sigs == nil {
+		sigs = map[string]any{}
+	}
+	userSigs, _ := sigs[userID].(map[string]any)
+	if userSigs == nil {
+		userSigs = map[string]any{}
+	}
+	userSigs["ed25519:"+deviceID] = string(signature)
+	sigs[userID] = userSigs
+	obj["signatures"] = sigs
+	return nil
+}
+
+// deviceKeysInternal returns the signed device keys to upload for the device.
+func (a *matrixAccount) deviceKeysInternal(userID, deviceID string) (map[string]any, error) {
+	deviceKeys := map[string]any{
+		"user_id":    userID,
+		"device_id":  deviceID,
+		"algorithms": []string{string(id.AlgorithmOlmV1), string(id.AlgorithmMegolmV1)},
+		"keys": map[string]string{
+			"curve25519:" + deviceID: a.curve25519Internal(),
+			"ed25519:" + deviceID:    a.ed25519Internal(),
+		},
+	}
+	if err := a.signJSONInternal(deviceKeys, userID, deviceID); err != nil {
+		return nil, err
+	}
+	return deviceKeys, nil
+}
+
+type matrixOlmSession struct{ olm.Session }
+
+func (s *matrixOlmSession) MarshalJSON() ([]byte, error) {
+	pickled, err := s.Pickle(matrixPickleKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pickle Olm session: %w", err)
+	}
+	return json.Marshal(string(pickled))
+}
+
+func (s *matrixOlmSession) UnmarshalJSON(data []byte) error {
+	var pickled string
+	if err := json.Unmarshal(data, &pickled); err != nil {
+		return err
+	}
+	olmSession, err := session.OlmSessionFromPickled([]byte(pickled), matrixPickleKey)
+	if err != nil {
+		return fmt.Errorf("failed to unpickle Olm session: %w", err)
+	}
+	s.Session = olmSession
+	return nil
+}
+
+type matrixGroupSession struct {
+	*session.MegolmOutboundSession
+}
+
+func (s *matrixGroupSession) MarshalJSON() ([]byte, error) {
+	pickled, err := s.Pickle(matrixPickleKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pickle Megolm session: %w", err)
+	}
+	return json.Marshal(string(pickled))
+}
+
+func (s *matrixGroupSession) UnmarshalJSON(data []byte) error {
+	var pickled string
+	if err := json.Unmarshal(data, &pickled); err != nil {
+		return err
+	}
+	groupSession, err := session.MegolmOutboundSessionFromPickled([]byte(pickled), matrixPickleKey)
+	if err != nil {
+		return fmt.Errorf("failed to unpickle Megolm session: %w", err)
+	}
+	s.MegolmOutboundSession = groupSession
+	return nil
+}
+
+// matrixEncryption is the content of a room's m.room.encryption state.
+type matrixEncryption struct {
+	Algorithm          string `json:"algorithm"`
+	RotationPeriodMs   int64  `json:"rotation_period_ms"`
+	RotationPeriodMsgs uint32 `json:"rotation_period_msgs"`
+}
+
+// matrixDevice is a device whose keys carry a valid self-signature.
+type matrixDevice struct {
+	UserID     string
+	DeviceID   string
+	Curve25519 string
+	Ed25519    string
+}
+
+func (d matrixDevice) keyInternal() string {
+	return d.UserID + " " + d.DeviceID
+}
+
+// sendMatrixEncryptedInternal sends message to each room, encrypting it for
+// rooms with encryption enabled. Room keys go to every device of the joined
+// members; devices are not verified beyond their self-signature.
+func sendMatrixEncryptedInternal(ctx context.Context, client *matrixClient, config models.MatrixConfig, store MatrixStore, message string) error {
+	if store == nil {
+		return fmt.Errorf("matrix encryption needs a session store")
+	}
+
+	key := matrixSessionKeyInternal(config)
+	lock, _ := matrixSessionLocks.LoadOrStore(key, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	defer lock.(*sync.Mutex).Unlock()
+
+	state := &matrixSessionState{}
+	data, err := store.LoadMatrixSession(ctx, key)
+	if err != nil {
+		return fmt.Errorf("failed to load Matrix session: %w", err)
+	}
+	if data != nil {
+		if err := json.Unmarshal(data, state); err != nil {
+			slog.WarnContext(ctx, "Discarding unreadable Matrix session", "error", err)
+			state = &matrixSessionState{}
+		}
+	}
+	save := func() error {
+		data, err := json.Marshal(state)
+		if err != nil {
+			return fmt.Errorf("failed to encode Matrix session: %w", err)
+		}
+		if err := store.SaveMatrixSession(ctx, key, data); err != nil {
+			return fmt.Errorf("failed to save Matrix session: %w", err)
+		}
+		return nil
+	}
+
+	if err := client.resumeSessionInternal(ctx, config, state); err != nil {
+		return err
+	}
+	if err := client.ensureDeviceKeysInternal(ctx, state); err != nil {
+		return err
+	}
+	if err := save(); err != nil {
+		return err
+	}
+
+	rooms, err := client.roomsInternal(ctx, config.Rooms)
+	if err != nil {
+		return err
+	}
+	for _, room := range rooms {
+		content := map[string]string{"msgtype": "m.text", "body": message}
+
+		encryption, err := client.roomEncryptionInternal(ctx, room)
+		if err != nil {
+			return err
+		}
+		if encryption == nil {
+			if err := client.sendEventInternal(ctx, room, "m.room.message", content); err != nil {
+				return fmt.Errorf("failed to send Matrix message to %s: %w", room, err)
+			}
+			continue
+		}
+
+		if err := client.sendRoomEncryptedInternal(ctx, state, save, room, encryption, content); err != nil {
+			return fmt.Errorf("failed to send encrypted Matrix message to %s: %w", room, err)
+		}
+	}
+	return nil
+}
+
+// matrixSessionKeyInternal identifies the account a config logs in as,
+// without storing its credentials.
+func matrixSessionKeyInternal(config models.MatrixConfig) string {
+	account := config.Username
+	if token := matrixAccessTokenInternal(config); token != "" {
+		account = "token:" + token
+	}
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s:%d\x00%s", strings.ToLower(config.Host), config.Port, account)))
+	return "matrix-" + hex.EncodeToString(sum[:16])
+}
+
+// resumeSessionInternal authenticates as the stored device, logging in again
+// when its access token has expired. A different device starts a fresh state,
+// since its keys cannot be reused.
+func (c *matrixClient) resumeSessionInternal(ctx context.Context, config models.MatrixConfig, state *matrixSessionState) error {
+	var userID, deviceID string
+	if token := matrixAccessTokenInternal(config); token != "" {
+		c.token = token
+		var whoami struct {
+			UserID   string `json:"user_id"`
+			DeviceID string `json:"device_id"`
+		}
+		if err := c.doInternal(ctx, http.MethodGet, "/account/whoami", nil, &whoami); err != nil {
+			return fmt.Errorf("matrix access token check failed: %w", err)
+		}
+		if whoami.DeviceID == "" {
+			return fmt.Errorf("matrix access token is not tied to a device, which encryption requires")
+		}
+		userID, deviceID = whoami.UserID, whoami.DeviceID
+	} else {
+		if config.Username == "" || config.Password == "" {
+			return fmt.Errorf("matrix credentials are required")
+		}
+		if state.AccessToken != "" {
+			c.token = state.AccessToken
+			var whoami struct {
+				DeviceID string `json:"device_id"`
+			}
+			err := c.doInternal(ctx, http.MethodGet, "/account/whoami", nil, &whoami)
+			if err == nil && whoami.DeviceID == state.DeviceID {
+				return nil
+			}
+			if err != nil && directStatusCodeInternal(err) != http.StatusUnauthorized {
+				return fmt.Errorf("matrix session check failed: %w", err)
+			}
+		}
+		login, err := c.loginInternal(ctx, config, state.DeviceID)
+		if err != nil {
+			return err
+		}
+		state.AccessToken = login.AccessToken
+		// The device may have been deleted and recreated without its keys.
+		state.KeysUploaded = false
+		userID, deviceID = login.UserID, login.DeviceID
+	}
+
+	if userID != state.UserID || deviceID != state.DeviceID {
+		*state = matrixSessionState{UserID: userID, DeviceID: deviceID, AccessToken: state.AccessToken}
+	}
+	return nil
+}
+
+// ensureDeviceKeysInternal creates and uploads the device's keys once.
+func (c *matrixClient) ensureDeviceKeysInternal(ctx context.Context, state *matrixSessionState) error {
+	if state.Account == nil {
+		acc, err := account.NewAccount()
+		if err != nil {
+			return fmt.Errorf("failed to create Matrix account: %w", err)
+		}
+		state.Account = &matrixAccount{acc}
+		state.KeysUploaded = false
+	}
+	if state.KeysUploaded {
+		return nil
+	}
+
+	devices, err := c.queryDevicesInternal(ctx, []string{state.UserID})
+	if err != nil {
+		return err
+	}
+	for _, device := range devices {
+		if device.DeviceID == state.DeviceID && device.Curve25519 != state.Account.curve25519Internal() {
+			return fmt.Errorf("matrix device %s already has encryption keys from another client; give Arcane its own login or access token", state.DeviceID)
+		}
+	}
+
+	deviceKeys, err := state.Account.deviceKeysInternal(state.UserID, state.DeviceID)
+	if err != nil {
+		return err
+	}
+	if err := c.doInternal(ctx, http.MethodPost, "/keys/upload", map[string]any{"device_keys": deviceKeys}, nil); err != nil {
+		return fmt.Errorf("failed to upload Matrix device keys: %w", err)
+	}
+	state.KeysUploaded = true
+	return nil
+}
+
+// roomEncryptionInternal returns the room's encryption settings, or nil when
+// the room is not encrypted.
+func (c *matrixClient) roomEncryptionInternal(ctx context.Context, room string) (*matrixEncryption, error) {
+	var encryption matrixEncryption
+	err := c.doInternal(ctx, http.MethodGet, "/rooms/"+url.PathEscape(room)+"/state/m.room.encryption", nil, &encryption)
+	if directStatusCodeInternal(err) == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read encryption settings of %s: %w", room, err)
+	}
+	if encryption.Algorithm != string(id.AlgorithmMegolmV1) {
+		return nil, fmt.Errorf("matrix room %s uses unsupported encryption %q", room, encryption.Algorithm)
+	}
+	return &encryption, nil
+}
+
+// queryDevicesInternal returns the devices of users that can receive room
+// keys. Devices whose keys are not signed by themselves are skipped.
+func (c *matrixClient) queryDevicesInternal(ctx context.Context, users []string) ([]matrixDevice, error) {
+	query := map[string][]string{}
+	for _, user := range users {
+		query[user] = []string{}
+	}
+	var resp struct {
+		DeviceKeys map[string]map[string]map[string]any `json:"device_keys"`
+	}
+	if err := c.doInternal(ctx, http.MethodPost, "/keys/query", map[string]any{"device_keys": query}, &resp); err != nil {
+		return nil, fmt.Errorf("failed to query Matrix device keys: %w", err)
+	}
+
+	var devices []matrixDevice
+	for userID, userDevices := range resp.DeviceKeys {
+		for deviceID, keys := range userDevices {
+			algorithms, _ := keys["algorithms"].([]any)
+			keyMap, _ := keys["keys"].(map[string]any)
+			device := matrixDevice{UserID: userID, DeviceID: deviceID}
+			device.Curve25519, _ = keyMap["curve25519:"+deviceID].(string)
+			device.Ed25519, _ = keyMap["ed25519:"+deviceID].(string)
+
+			switch {
+			case keys["user_id"] != userID || keys["device_id"] != deviceID,
+				device.Curve25519 == "" || device.Ed25519 == "",
+				!slices.Contains(algorithms, any(string(id.AlgorithmOlmV1))):
+				continue
+			}
+			if err := matrixVerifyJSONInternal(keys, device); err != nil {
+				slog.WarnContext(ctx, "Skipping Matrix device with invalid keys", "user", userID, "device", deviceID, "error", err)
+				continue
+			}
+			devices = append(devices, device)
+		}
+	}
+	slices.SortFunc(devices, func(a, b matrixDevice) int { return strings.Compare(a.keyInternal(), b.keyInternal()) })
+	return devices, nil
+}
+
+// matrixVerifyJSONInternal checks that obj carries a valid signature by the
+// device's Ed25519 key.
+func matrixVerifyJSONInternal(obj map[string]any, device matrixDevice) error {
+	ok, err := signatures.VerifySignatureJSON(obj, id.UserID(device.UserID), device.DeviceID, id.Ed25519(device.Ed25519))
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("signature does not match")
+	}
+	return nil
+}
+
+// sendRoomEncryptedInternal encrypts content with the room's Megolm session,
+// first sharing the session key with devices that do not have it yet. State
+// is saved after every ratchet step and before the result leaves, so a failed
+// send never causes keys to be reused.
+func (c *matrixClient) sendRoomEncryptedInternal(ctx context.Context, state *matrixSessionState, save func() error, room string, encryption *matrixEncryption, content any) error {
+	var members struct {
+		Joined map[string]json.RawMessage `json:"joined"`
+	}
+	if err := c.doInternal(ctx, http.MethodGet, "/rooms/"+url.PathEscape(room)+"/joined_members", nil, &members); err != nil {
+		return fmt.Errorf("failed to list room members: %w", err)
+	}
+	devices, err := c.queryDevicesInternal(ctx, slices.Sorted(maps.Keys(members.Joined)))
+	if err != nil {
+		return err
+	}
+	devices = slices.DeleteFunc(devices, func(d matrixDevice) bool {
+		return d.UserID == state.UserID && d.DeviceID == state.DeviceID
+	})
+
+	if state.Rooms == nil {
+		state.Rooms = map[string]*matrixRoomSession{}
+	}
+	roomSession := state.Rooms[room]
+	if roomSession == nil || matrixNeedsRotationInternal(roomSession, encryption, devices, time.Now()) {
+		groupSession, err := session.NewMegolmOutboundSession()
+		if err != nil {
+			return fmt.Errorf("failed to create Megolm session: %w", err)
+		}
+		roomSession = &matrixRoomSession{Session: &matrixGroupSession{groupSession}, CreatedAt: time.Now(), SharedWith: map[string]string{}}
+		state.Rooms[room] = roomSession
+	}
+
+	var pending []matrixDevice
+	for _, device := range devices {
+		if roomSession.SharedWith[device.keyInternal()] != device.Curve25519 {
+			pending = append(pending, device)
+		}
+	}
+	if len(pending) > 0 {
+		if err := c.shareRoomKeyInternal(ctx, state, save, room, roomSession, pending); err != nil {
+			return err
+		}
+	}
+
+	plaintext, err := json.Marshal(map[string]any{"type": "m.room.message", "content": content, "room_id": room})
+	if err != nil {
+		return fmt.Errorf("failed to encode message: %w", err)
+	}
+	ciphertext, err := roomSession.Session.Encrypt(plaintext)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt message: %w", err)
+	}
+	if err := save(); err != nil {
+		return err
+	}
+
+	return c.sendEventInternal(ctx, room, "m.room.encrypted", map[string]any{
+		"algorithm":  id.AlgorithmMegolmV1,
+		"sender_key": state.Account.curve25519Internal(),
+		"ciphertext": string(ciphertext),
+		"session_id": roomSession.Session.ID(),
+		"device_id":  state.DeviceID,
+	})
+}
+
+// matrixNeedsRotationInternal reports whether a room session has reached the
+// room's rotation limits or was shared with a device that is no longer in the
+// room, which must not read later messages.
+func matrixNeedsRotationInternal(roomSession *matrixRoomSession, encryption *matrixEncryption, devices []matrixDevice, now time.Time) bool {
+	period := matrixDefaultRotationPeriod
+	if encryption.RotationPeriodMs > 0 {
+		period = time.Duration(encryption.RotationPeriodMs) * time.Millisecond
+	}
+	messages := uint(matrixDefaultRotationMessages)
+	if encryption.RotationPeriodMsgs > 0 {
+		messages = uint(encryption.RotationPeriodMsgs)
+	}
+	if roomSession.Session.MessageIndex() >= messages || now.Sub(roomSession.CreatedAt) >= period {
+		return true
+	}
+
+	current := make(map[string]string, len(devices))
+	for _, device := range devices {
+		current[device.keyInternal()] = device.Curve25519
+	}
+	for key, curve25519 := range roomSession.SharedWith {
+		if current[key] != curve25519 {
+			return true
+		}
+	}
+	return false
+}
+
+// shareRoomKeyInternal sends the room session's key to devices over Olm,
+// claiming one-time keys for devices without an Olm session. Devices that have
+// no one-time keys left are skipped and retried on the next send.
+func (c *matrixClient) shareRoomKeyInternal(ctx context.Context, state *matrixSessionState, save func() error, room string, roomSession *matrixRoomSession, devices []matrixDevice) error {
+	if state.OlmSessions == nil {
+		state.OlmSessions = map[string]*matrixOlmSession{}
+	}
+	if err := c.claimOlmSessionsInternal(ctx, state, devices); err != nil {
+		return err
+	}
+
+	roomKey := map[string]any{
+		"algorithm":   id.AlgorithmMegolmV1,
+		"room_id":     room,
+		"session_id":  roomSession.Session.ID(),
+		"session_key": roomSession.Session.Key(),
+	}
+	messages := map[string]map[string]any{}
+	var sent []matrixDevice
+	for _, device := range devices {
+		olmSession := state.OlmSessions[device.Curve25519]
+		if olmSession == nil {
+			continue
+		}
+		plaintext, err := json.Marshal(map[string]any{
+			"type":           "m.room_key",
+			"content":        roomKey,
+			"sender":         state.UserID,
+			"sender_device":  state.DeviceID,
+			"keys":           map[string]string{"ed25519": state.Account.ed25519Internal()},
+			"recipient":      device.UserID,
+			"recipient_keys": map[string]string{"ed25519": device.Ed25519},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to encode room key: %w", err)
+		}
+		messageType, body, err := olmSession.Encrypt(plaintext)
+		if err != nil {
+			return fmt.Errorf("failed to encrypt room key: %w", err)
+		}
+
+		if messages[device.UserID] == nil {
+			messages[device.UserID] = map[string]any{}
+		}
+		messages[device.UserID][device.DeviceID] = map[string]any{
+			"algorithm":  id.AlgorithmOlmV1,
+			"sender_key": state.Account.curve25519Internal(),
+			"ciphertext": map[string]any{
+				device.Curve25519: map[string]any{"type": messageType, "body": string(body)},
+			},
+		}
+		sent = append(sent, device)
+	}
+	if len(sent) == 0 {
+		return nil
+	}
+	if err := save(); err != nil {
+		return err
+	}
+
+	path := "/sendToDevice/m.room.encrypted/" + c.nextTxnIDInternal()
+	if err := c.doInternal(ctx, http.MethodPut, path, map[string]any{"messages": messages}, nil); err != nil {
+		return fmt.Errorf("failed to send room keys: %w", err)
+	}
+	for _, device := range sent {
+		roomSession.SharedWith[device.keyInternal()] = device.Curve25519
+	}
+	return nil
+}
+
+// claimOlmSessionsInternal starts Olm sessions with the devices that have
+// none, using a signed one-time key claimed from each.
+func (c *matrixClient) claimOlmSessionsInternal(ctx context.Context, state *matrixSessionState, devices []matrixDevice) error {
+	claim := map[string]map[string]string{}
+	for _, device := range devices {
+		if state.OlmSessions[device.Curve25519] != nil {
+			continue
+		}
+		if claim[device.UserID] == nil {
+			claim[device.UserID] = map[string]string{}
+		}
+		claim[device.UserID][device.DeviceID] = "signed_curve25519"
+	}
+	if len(claim) == 0 {
+		return nil
+	}
+
+	var resp struct {
+		OneTimeKeys map[string]map[string]map[string]map[string]any `json:"one_time_keys"`
+	}
+	if err := c.doInternal(ctx, http.MethodPost, "/keys/claim", map[string]any{"one_time_keys": claim}, &resp); err != nil {
+		return fmt.Errorf("failed to claim Matrix one-time keys: %w", err)
+	}
+
+	for _, device := range devices {
+		if state.OlmSessions[device.Curve25519] != nil {
+			continue
+		}
+		for keyID, oneTimeKey := range resp.OneTimeKeys[device.UserID][device.DeviceID] {
+			key, _ := oneTimeKey["key"].(string)
+			if !strings.HasPrefix(keyID, "signed_curve25519:") || key == "" {
+				continue
+			}
+			if err := matrixVerifyJSONInternal(oneTimeKey, device); err != nil {
+				slog.WarnContext(ctx, "Skipping Matrix one-time key with invalid signature", "user", device.UserID, "device", device.DeviceID, "error", err)
+				continue
+			}
+			olmSession, err := state.Account.NewOutboundSession(id.Curve25519(device.Curve25519), id.Curve25519(key))
+			if err != nil {
+				slog.WarnContext(ctx, "Skipping Matrix device", "user", device.UserID, "device", device.DeviceID, "error", err)
+				continue
+			}
+			state.OlmSessions[device.Curve25519] = &matrixOlmSession{olmSession}
+			break
+		}
+	}
+	return nil
+}
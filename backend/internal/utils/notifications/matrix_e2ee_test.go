@@ -0,0 +1,197 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"maunium.net/go/mautrix/crypto/goolm/account"
+	"maunium.net/go/mautrix/crypto/goolm/session"
+	"maunium.net/go/mautrix/id"
+
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+)
+
+type memoryMatrixStore struct {
+	data map[string][]byte
+}
+
+func (m *memoryMatrixStore) LoadMatrixSession(_ context.Context, key string) ([]byte, error) {
+	return m.data[key], nil
+}
+
+func (m *memoryMatrixStore) SaveMatrixSession(_ context.Context, key string, state []byte) error {
+	m.data[key] = state
+	return nil
+}
+
+func TestSendMatrixEncrypted(t *testing.T) {
+	bobAccount, err := account.NewAccount()
+	require.NoError(t, err)
+	bob := &matrixAccount{bobAccount}
+	bobKeys, err := bob.deviceKeysInternal("@bob:example.com", "BOB")
+	require.NoError(t, err)
+	require.NoError(t, bob.GenOneTimeKeys(1))
+	oneTimeKeys, err := bob.OneTimeKeys()
+	require.NoError(t, err)
+	require.Len(t, oneTimeKeys, 1)
+	signedOneTimeKey := map[string]any{}
+	for _, key := range oneTimeKeys {
+		signedOneTimeKey["key"] = string(key)
+	}
+	require.NoError(t, bob.signJSONInternal(signedOneTimeKey, "@bob:example.com", "BOB"))
+
+	var (
+		mu       sync.Mutex
+		uploaded map[string]any
+		claims   int
+		toDevice []map[string]any
+		events   []map[string]any
+	)
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		assert.Equal(t, "Bearer syt_token", r.Header.Get("Authorization"))
+
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		reply := func(v any) { _ = json.NewEncoder(w).Encode(v) }
+
+		switch path := strings.TrimPrefix(r.URL.Path, "/_matrix/client/v3"); {
+		case path == "/account/whoami":
+			reply(map[string]string{"user_id": "@arcane:example.com", "device_id": "ARCANE"})
+		case path == "/keys/query":
+			devices := map[string]any{"@bob:example.com": map[string]any{"BOB": bobKeys}}
+			if uploaded != nil {
+				devices["@arcane:example.com"] = map[string]any{"ARCANE": uploaded}
+			}
+			reply(map[string]any{"device_keys": devices})
+		case path == "/keys/upload":
+			uploaded, _ = body["device_keys"].(map[string]any)
+			reply(map[string]any{})
+		case path == "/rooms/!room:example.com/state/m.room.encryption":
+			reply(map[string]any{"algorithm": id.AlgorithmMegolmV1})
+		case path == "/rooms/!room:example.com/joined_members":
+			reply(map[string]any{"joined": map[string]any{"@arcane:example.com": map[string]any{}, "@bob:example.com": map[string]any{}}})
+		case path == "/keys/claim":
+			claims++
+			reply(map[string]any{"one_time_keys": map[string]any{
+				"@bob:example.com": map[string]any{"BOB": map[string]any{"signed_curve25519:AAAA": signedOneTimeKey}},
+			}})
+		case strings.HasPrefix(path, "/sendToDevice/m.room.encrypted/"):
+			toDevice = append(toDevice, body)
+			reply(map[string]any{})
+		case strings.HasPrefix(path, "/rooms/!room:example.com/send/m.room.encrypted/"):
+			events = append(events, body)
+			reply(map[string]any{"event_id": "$event"})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(serverURL.Port())
+	require.NoError(t, err)
+	config := models.MatrixConfig{
+		Host:                   serverURL.Hostname(),
+		Port:                   port,
+		Rooms:                  "!room:example.com",
+		AccessToken:            "syt_token",
+		Encryption:             true,
+		DisableTLSVerification: true,
+	}
+	store := &memoryMatrixStore{data: map[string][]byte{}}
+
+	require.NoError(t, SendMatrix(t.Context(), config, store, "first"))
+	require.NoError(t, SendMatrix(t.Context(), config, store, "second"))
+
+	require.NotNil(t, uploaded, "device keys are uploaded")
+	senderKey := uploaded["keys"].(map[string]any)["curve25519:ARCANE"]
+
+	// The room key goes to Bob once; the second message reuses the session.
+	assert.Equal(t, 1, claims)
+	require.Len(t, toDevice, 1)
+	roomKey := toDevice[0]["messages"].(map[string]any)["@bob:example.com"].(map[string]any)["BOB"].(map[string]any)
+	assert.Equal(t, string(id.AlgorithmOlmV1), roomKey["algorithm"])
+	assert.Equal(t, senderKey, roomKey["sender_key"])
+
+	// Bob can open the room key and read both messages with it.
+	olmMessage, ok := roomKey["ciphertext"].(map[string]any)[bob.curve25519Internal()].(map[string]any)
+	require.True(t, ok, "room key is encrypted for Bob's device")
+	assert.EqualValues(t, id.OlmMsgTypePreKey, olmMessage["type"])
+	body, _ := olmMessage["body"].(string)
+	bobSession, err := bob.NewInboundSession(body)
+	require.NoError(t, err)
+	decrypted, err := bobSession.Decrypt(body, id.OlmMsgTypePreKey)
+	require.NoError(t, err)
+	var keyEvent struct {
+		Type    string `json:"type"`
+		Content struct {
+			RoomID     string `json:"room_id"`
+			SessionID  string `json:"session_id"`
+			SessionKey string `json:"session_key"`
+		} `json:"content"`
+	}
+	require.NoError(t, json.Unmarshal(decrypted, &keyEvent))
+	assert.Equal(t, "m.room_key", keyEvent.Type)
+	assert.Equal(t, "!room:example.com", keyEvent.Content.RoomID)
+	inbound, err := session.NewMegolmInboundSession([]byte(keyEvent.Content.SessionKey))
+	require.NoError(t, err)
+
+	require.Len(t, events, 2)
+	for i, event := range events {
+		assert.Equal(t, string(id.AlgorithmMegolmV1), event["algorithm"])
+		assert.Equal(t, senderKey, event["sender_key"])
+		assert.Equal(t, "ARCANE", event["device_id"])
+		assert.Equal(t, keyEvent.Content.SessionID, event["session_id"])
+
+		ciphertext, _ := event["ciphertext"].(string)
+		plaintext, index, err := inbound.Decrypt([]byte(ciphertext))
+		require.NoError(t, err)
+		assert.Equal(t, uint(i), index)
+		var message struct {
+			Content map[string]string `json:"content"`
+		}
+		require.NoError(t, json.Unmarshal(plaintext, &message))
+		assert.Equal(t, []string{"first", "second"}[i], message.Content["body"])
+	}
+	assert.Len(t, store.data, 1)
+}
+
+func TestSendMatrixEncryptedRequiresStore(t *testing.T) {
+	err := SendMatrix(t.Context(), models.MatrixConfig{Host: "matrix.example.com", AccessToken: "syt_token", Encryption: true}, nil, "hello")
+	assert.ErrorContains(t, err, "session store")
+}
+
+func TestMatrixNeedsRotation(t *testing.T) {
+	groupSession, err := session.NewMegolmOutboundSession()
+	require.NoError(t, err)
+	now := time.Now()
+	bob := matrixDevice{UserID: "@bob:example.com", DeviceID: "BOB", Curve25519: "bob-key"}
+	roomSession := &matrixRoomSession{
+		Session:    &matrixGroupSession{groupSession},
+		CreatedAt:  now,
+		SharedWith: map[string]string{bob.keyInternal(): bob.Curve25519},
+	}
+
+	assert.False(t, matrixNeedsRotationInternal(roomSession, &matrixEncryption{}, []matrixDevice{bob}, now))
+	assert.True(t, matrixNeedsRotationInternal(roomSession, &matrixEncryption{}, []matrixDevice{bob}, now.Add(matrixDefaultRotationPeriod)))
+	assert.True(t, matrixNeedsRotationInternal(roomSession, &matrixEncryption{RotationPeriodMs: 1000}, []matrixDevice{bob}, now.Add(time.Second)))
+	assert.True(t, matrixNeedsRotationInternal(roomSession, &matrixEncryption{}, nil, now), "a device left the room")
+
+	_, err = groupSession.Encrypt([]byte("hello"))
+	require.NoError(t, err)
+	assert.True(t, matrixNeedsRotationInternal(roomSession, &matrixEncryption{RotationPeriodMsgs: 1}, []matrixDevice{bob}, now))
+}
@@ -3,8 +3,10 @@ package notifications
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/getarcaneapp/arcane/backend/internal/models"
 	"github.com/nicholas-fedor/shoutrrr"
@@ -24,7 +26,9 @@ func BuildMatrixURL(config models.MatrixConfig) (string, error) {
 	}
 
 	// Add authentication if provided (username can be empty)
-	if config.Password != "" {
+	if config.AccessToken != "" {
+		u.User = url.UserPassword("", config.AccessToken)
+	} else if config.Password != "" {
 		u.User = url.UserPassword(config.Username, config.Password)
 	}
 
@@ -55,8 +59,13 @@ func BuildMatrixURL(config models.MatrixConfig) (string, error) {
 	return u.String(), nil
 }
 
-// SendMatrix sends a message via Shoutrrr Matrix using proper service configuration
-func SendMatrix(ctx context.Context, config models.MatrixConfig, message string) error {
+// SendMatrix sends a message via Shoutrrr Matrix using proper service configuration.
+// Encrypted sends keep their device and sessions in store.
+func SendMatrix(ctx context.Context, config models.MatrixConfig, store MatrixStore, message string) error {
+	if config.Encryption || hasCustomTLSInternal(config.TLS) {
+		return sendMatrixDirectInternal(ctx, config, store, message)
+	}
+
 	shoutrrrURL, err := BuildMatrixURL(config)
 	if err != nil {
 		return fmt.Errorf("failed to build shoutrrr Matrix URL: %w", err)
@@ -77,3 +86,168 @@ func SendMatrix(ctx context.Context, config models.MatrixConfig, message string)
 	}
 	return nil
 }
+
+// sendMatrixDirectInternal talks to the client-server API with the provider's
+// own TLS settings. Like Shoutrrr, an empty username means the password is an
+// access token, and no rooms means every joined room.
+func sendMatrixDirectInternal(ctx context.Context, config models.MatrixConfig, store MatrixStore, message string) error {
+	client, err := newMatrixClientInternal(config)
+	if err != nil {
+		return err
+	}
+	if config.Encryption {
+		return sendMatrixEncryptedInternal(ctx, client, config, store, message)
+	}
+
+	if token := matrixAccessTokenInternal(config); token != "" {
+		client.token = token
+	} else if config.Username != "" {
+		if _, err := client.loginInternal(ctx, config, ""); err != nil {
+			return err
+		}
+	}
+	if client.token == "" {
+		return fmt.Errorf("matrix credentials are required")
+	}
+
+	rooms, err := client.roomsInternal(ctx, config.Rooms)
+	if err != nil {
+		return err
+	}
+	for _, room := range rooms {
+		body := map[string]string{"msgtype": "m.text", "body": message}
+		if err := client.sendEventInternal(ctx, room, "m.room.message", body); err != nil {
+			return fmt.Errorf("failed to send Matrix message to %s: %w", room, err)
+		}
+	}
+	return nil
+}
+
+// matrixAccessTokenInternal returns the configured access token. A password
+// without a username is an access token too, as in Shoutrrr URLs.
+func matrixAccessTokenInternal(config models.MatrixConfig) string {
+	if config.AccessToken != "" {
+		return config.AccessToken
+	}
+	if config.Username == "" {
+		return config.Password
+	}
+	return ""
+}
+
+// matrixClient calls the client-server API as one user.
+type matrixClient struct {
+	http    *http.Client
+	baseURL string
+	token   string
+	txnBase int64
+	txnSeq  int
+}
+
+func newMatrixClientInternal(config models.MatrixConfig) (*matrixClient, error) {
+	if config.Host == "" {
+		return nil, fmt.Errorf("matrix host is required")
+	}
+
+	tlsConfig, err := BuildTLSConfig(config.TLS, "")
+	if err != nil {
+		return nil, fmt.Errorf("invalid Matrix TLS settings: %w", err)
+	}
+	if config.DisableTLSVerification {
+		tlsConfig.InsecureSkipVerify = true //nolint:gosec // explicit user opt-in
+	}
+
+	host := config.Host
+	if config.Port > 0 {
+		host = fmt.Sprintf("%s:%d", host, config.Port)
+	}
+	return &matrixClient{
+		http:    newTLSHTTPClientInternal(tlsConfig),
+		baseURL: "https://" + host + "/_matrix/client/v3",
+		txnBase: time.Now().UnixNano(),
+	}, nil
+}
+
+func (c *matrixClient) doInternal(ctx context.Context, method, path string, body, out any) error {
+	headers := map[string]string{"Content-Type": "application/json"}
+	if c.token != "" {
+		headers["Authorization"] = "Bearer " + c.token
+	}
+	return doDirectRequestInternal(ctx, c.http, method, c.baseURL+path, headers, body, out)
+}
+
+// matrixLogin is the response to a password login.
+type matrixLogin struct {
+	AccessToken string `json:"access_token"`
+	UserID      string `json:"user_id"`
+	DeviceID    string `json:"device_id"`
+}
+
+// loginInternal logs in with the configured password. A non-empty deviceID
+// logs in to that existing device instead of creating a new one.
+func (c *matrixClient) loginInternal(ctx context.Context, config models.MatrixConfig, deviceID string) (*matrixLogin, error) {
+	body := map[string]any{
+		"type":                        "m.login.password",
+		"identifier":                  map[string]string{"type": "m.id.user", "user": config.Username},
+		"password":                    config.Password,
+		"initial_device_display_name": "Arcane",
+	}
+	if deviceID != "" {
+		body["device_id"] = deviceID
+	}
+	var login matrixLogin
+	if err := c.doInternal(ctx, http.MethodPost, "/login", body, &login); err != nil {
+		return nil, fmt.Errorf("matrix login failed: %w", err)
+	}
+	c.token = login.AccessToken
+	return &login, nil
+}
+
+// roomsInternal resolves a comma separated list of room IDs and aliases to
+// room IDs, joining aliased rooms. An empty list means every joined room.
+func (c *matrixClient) roomsInternal(ctx context.Context, list string) ([]string, error) {
+	var rooms []string
+	for _, room := range strings.Split(list, ",") {
+		if room = strings.TrimSpace(room); room != "" {
+			rooms = append(rooms, room)
+		}
+	}
+	if len(rooms) == 0 {
+		var joined struct {
+			JoinedRooms []string `json:"joined_rooms"`
+		}
+		if err := c.doInternal(ctx, http.MethodGet, "/joined_rooms", nil, &joined); err != nil {
+			return nil, fmt.Errorf("failed to list Matrix rooms: %w", err)
+		}
+		return joined.JoinedRooms, nil
+	}
+
+	for i, room := range rooms {
+		if !strings.HasPrefix(room, "!") && !strings.HasPrefix(room, "#") {
+			room = "#" + room
+		}
+		if strings.HasPrefix(room, "#") {
+			var joined struct {
+				RoomID string `json:"room_id"`
+			}
+			if err := c.doInternal(ctx, http.MethodPost, "/join/"+url.PathEscape(room), map[string]any{}, &joined); err != nil {
+				return nil, fmt.Errorf("failed to join Matrix room %s: %w", room, err)
+			}
+			room = joined.RoomID
+		}
+		rooms[i] = room
+	}
+	return rooms, nil
+}
+
+// sendEventInternal sends a room event with a transaction ID unique to this
+// client.
+func (c *matrixClient) sendEventInternal(ctx context.Context, room, eventType string, content any) error {
+	path := fmt.Sprintf("/rooms/%s/send/%s/%s", url.PathEscape(room), eventType, c.nextTxnIDInternal())
+	return c.doInternal(ctx, http.MethodPut, path, content, nil)
+}
+
+func (c *matrixClient) nextTxnIDInternal() string {
+	c.txnSeq++
+	return fmt.Sprintf("arcane-%d-%d", c.txnBase, c.txnSeq)
+}
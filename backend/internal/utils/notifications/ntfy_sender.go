@@ -2,7 +2,9 @@ package notifications
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
+	"net/http"
 	"net/url"
 	"strings"
 
@@ -84,12 +86,18 @@ func BuildNtfyURL(config models.NtfyConfig) (string, error) {
 	return u.String(), nil
 }
 
-// SendNtfy sends a message via Shoutrrr Ntfy using proper service configuration
-func SendNtfy(ctx context.Context, config models.NtfyConfig, message string) error {
+// SendNtfy sends a message via Shoutrrr Ntfy using proper service
+// configuration, with the priority and tags configured for event.
+func SendNtfy(ctx context.Context, config models.NtfyConfig, event models.NotificationEventType, message string) error {
+	config = config.ForEvent(event)
 	if config.Topic == "" {
 		return fmt.Errorf("ntfy topic is required")
 	}
 
+	if hasCustomTLSInternal(config.TLS) {
+		return sendNtfyDirectInternal(ctx, config, message)
+	}
+
 	shoutrrrURL, err := BuildNtfyURL(config)
 	if err != nil {
 		return fmt.Errorf("failed to build shoutrrr Ntfy URL: %w", err)
@@ -110,3 +118,52 @@ func SendNtfy(ctx context.Context, config models.NtfyConfig, message string) err
 	}
 	return nil
 }
+
+// sendNtfyDirectInternal publishes through ntfy's HTTP API with the provider's
+// own TLS settings.
+func sendNtfyDirectInternal(ctx context.Context, config models.NtfyConfig, message string) error {
+	host := config.Host
+	if host == "" {
+		host = "ntfy.sh"
+	}
+	if config.Port > 0 {
+		host = fmt.Sprintf("%s:%d", host, config.Port)
+	}
+
+	tlsConfig, err := BuildTLSConfig(config.TLS, "")
+	if err != nil {
+		return fmt.Errorf("invalid ntfy TLS settings: %w", err)
+	}
+	if config.DisableTLSVerification {
+		tlsConfig.InsecureSkipVerify = true //nolint:gosec // explicit user opt-in
+	}
+
+	headers := map[string]string{"Content-Type": "text/plain; charset=utf-8"}
+	if config.Title != "" {
+		headers["X-Title"] = config.Title
+	}
+	if config.Priority != "" {
+		headers["X-Priority"] = config.Priority
+	}
+	if len(config.Tags) > 0 {
+		headers["X-Tags"] = strings.Join(config.Tags, ",")
+	}
+	if config.Icon != "" {
+		headers["X-Icon"] = config.Icon
+	}
+	if !config.Cache {
+		headers["X-Cache"] = "no"
+	}
+	if !config.Firebase {
+		headers["X-Firebase"] = "no"
+	}
+	if config.Username != "" || config.Password != "" {
+		headers["Authorization"] = "Basic " + base64.StdEncoding.EncodeToString([]byte(config.Username+":"+config.Password))
+	}
+
+	endpoint := (&url.URL{Scheme: "https", Host: host, Path: "/" + config.Topic}).String()
+	if err := doDirectRequestInternal(ctx, newTLSHTTPClientInternal(tlsConfig), http.MethodPost, endpoint, headers, message, nil); err != nil {
+		return fmt.Errorf("failed to send Ntfy message: %w", err)
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package notifications
 
 import (
+	"net/url"
 	"testing"
 
 	"github.com/getarcaneapp/arcane/backend/internal/models"
@@ -128,3 +129,28 @@ func TestBuildNtfyURL(t *testing.T) {
 		})
 	}
 }
+
+func TestNtfyConfigForEvent(t *testing.T) {
+	config := models.NtfyConfig{
+		Topic:    "arcane",
+		Priority: "default",
+		Tags:     []string{"arcane"},
+		EventSettings: map[models.NotificationEventType]models.NtfyEventSettings{
+			models.NotificationEventPruneReport: {Priority: "min", Tags: []string{"broom", "arcane"}},
+		},
+	}
+
+	prune := config.ForEvent(models.NotificationEventPruneReport)
+	assert.Equal(t, "min", prune.Priority)
+	assert.Equal(t, []string{"arcane", "broom"}, prune.Tags)
+	assert.Equal(t, []string{"arcane"}, config.Tags, "the provider tags are not modified")
+
+	update := config.ForEvent(models.NotificationEventImageUpdate)
+	assert.Equal(t, "default", update.Priority)
+
+	gotURL, err := BuildNtfyURL(prune)
+	require.NoError(t, err)
+	parsed, err := url.Parse(gotURL)
+	require.NoError(t, err)
+	assert.Equal(t, "arcane,broom", parsed.Query().Get("tags"))
+}
@@ -3,6 +3,7 @@ package notifications
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
@@ -55,8 +56,16 @@ func BuildPushoverURL(config models.PushoverConfig) (string, error) {
 	return u.String(), nil
 }
 
-// SendPushover sends a message via Shoutrrr Pushover using proper service configuration.
-func SendPushover(ctx context.Context, config models.PushoverConfig, message string) error {
+// pushoverMessagesURL is the Pushover message API, used directly when a
+// message needs a sound.
+var pushoverMessagesURL = "https://api.pushover.net/1/messages.json"
+
+// SendPushover sends a message via Shoutrrr Pushover using proper service
+// configuration, with the priority and sound configured for event. Messages
+// with a sound are sent through the Pushover API directly, since Shoutrrr
+// cannot set one.
+func SendPushover(ctx context.Context, config models.PushoverConfig, event models.NotificationEventType, message string) error {
+	config = config.ForEvent(event)
 	if strings.TrimSpace(config.Token) == "" {
 		return fmt.Errorf("pushover token is empty")
 	}
@@ -67,6 +76,10 @@ func SendPushover(ctx context.Context, config models.PushoverConfig, message str
 		return fmt.Errorf("pushover priority must be between -2 and 2")
 	}
 
+	if strings.TrimSpace(config.Sound) != "" {
+		return sendPushoverDirectInternal(ctx, config, message)
+	}
+
 	shoutrrrURL, err := BuildPushoverURL(config)
 	if err != nil {
 		return fmt.Errorf("failed to build shoutrrr Pushover URL: %w", err)
@@ -85,3 +98,41 @@ func SendPushover(ctx context.Context, config models.PushoverConfig, message str
 	}
 	return nil
 }
+
+// sendPushoverDirectInternal posts a message to the Pushover API.
+func sendPushoverDirectInternal(ctx context.Context, config models.PushoverConfig, message string) error {
+	payload := map[string]any{
+		"token":    strings.TrimSpace(config.Token),
+		"user":     strings.TrimSpace(config.User),
+		"message":  message,
+		"priority": config.Priority,
+		"sound":    strings.TrimSpace(config.Sound),
+	}
+	devices := make([]string, 0, len(config.Devices))
+	for _, device := range config.Devices {
+		if trimmed := strings.TrimSpace(device); trimmed != "" {
+			devices = append(devices, trimmed)
+		}
+	}
+	if len(devices) > 0 {
+		payload["device"] = strings.Join(devices, ",")
+	}
+	if title := strings.TrimSpace(config.Title); title != "" {
+		payload["title"] = title
+	}
+	if config.Priority == 2 {
+		// Emergency priority repeats until acknowledged and requires both.
+		payload["retry"] = 60
+		payload["expire"] = 3600
+	}
+
+	tlsConfig, err := BuildTLSConfig(nil, "")
+	if err != nil {
+		return fmt.Errorf("invalid Pushover TLS settings: %w", err)
+	}
+	headers := map[string]string{"Content-Type": "application/json"}
+	if err := doDirectRequestInternal(ctx, newTLSHTTPClientInternal(tlsConfig), http.MethodPost, pushoverMessagesURL, headers, payload, nil); err != nil {
+		return fmt.Errorf("failed to send Pushover message: %w", err)
+	}
+	return nil
+}
@@ -1,6 +1,9 @@
 package notifications
 
 import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"testing"
 
@@ -92,3 +95,32 @@ func TestBuildPushoverURL(t *testing.T) {
 		})
 	}
 }
+
+func TestSendPushover_EventSettings(t *testing.T) {
+	var got map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		_, _ = w.Write([]byte(`{"status":1}`))
+	}))
+	defer server.Close()
+
+	original := pushoverMessagesURL
+	pushoverMessagesURL = server.URL
+	t.Cleanup(func() { pushoverMessagesURL = original })
+
+	config := models.PushoverConfig{
+		Token:    "token123",
+		User:     "userKey",
+		Priority: -1,
+		EventSettings: map[models.NotificationEventType]models.PushoverEventSettings{
+			models.NotificationEventVulnerabilityFound: {Priority: new(int8(2)), Sound: "siren"},
+		},
+	}
+	require.NoError(t, SendPushover(t.Context(), config, models.NotificationEventVulnerabilityFound, "CVE found"))
+
+	assert.Equal(t, "CVE found", got["message"])
+	assert.Equal(t, "siren", got["sound"])
+	assert.EqualValues(t, 2, got["priority"])
+	assert.EqualValues(t, 60, got["retry"], "emergency priority needs retry and expire")
+	assert.EqualValues(t, 3600, got["expire"])
+}
@@ -0,0 +1,91 @@
+package notifications
+
+import "unicode/utf8"
+
+// RichSeverity picks the accent color of a rich message.
+type RichSeverity string
+
+const (
+	RichSeverityInfo    RichSeverity = "info"
+	RichSeveritySuccess RichSeverity = "success"
+	RichSeverityWarning RichSeverity = "warning"
+	RichSeverityError   RichSeverity = "error"
+)
+
+// color returns the accent color of the severity as 0xRRGGBB.
+func (s RichSeverity) color() int {
+	switch s {
+	case RichSeveritySuccess:
+		return 0x22C55E
+	case RichSeverityWarning:
+		return 0xF59E0B
+	case RichSeverityError:
+		return 0xEF4444
+	default:
+		return 0x3B82F6
+	}
+}
+
+// RichField is a labelled value shown in a grid, such as "Image: nginx:1.27".
+type RichField struct {
+	Name   string
+	Value  string
+	Inline bool
+}
+
+// RichLink is an extra button of a rich message, such as a signed link that
+// applies an update.
+type RichLink struct {
+	Label string
+	URL   string
+}
+
+// RichMessage is a notification rendered as a Discord embed or Slack Block
+// Kit message. Text is the plain markdown version, sent instead when the
+// provider asks for plain text and shown by clients that cannot render the
+// rich version.
+type RichMessage struct {
+	Title       string
+	Description string
+	Severity    RichSeverity
+	Fields      []RichField
+	// URL links back to the relevant page in Arcane; LinkLabel names the
+	// button.
+	URL       string
+	LinkLabel string
+	// Actions are shown as buttons after the link.
+	Actions []RichLink
+	Text    string
+}
+
+func (m RichMessage) linkLabelInternal() string {
+	if m.LinkLabel != "" {
+		return m.LinkLabel
+	}
+	return "Open in Arcane"
+}
+
+// buttonsInternal returns the link followed by the actions, skipping those
+// without a URL.
+func (m RichMessage) buttonsInternal() []RichLink {
+	buttons := make([]RichLink, 0, len(m.Actions)+1)
+	if m.URL != "" {
+		buttons = append(buttons, RichLink{Label: m.linkLabelInternal(), URL: m.URL})
+	}
+	for _, action := range m.Actions {
+		if action.URL != "" {
+			buttons = append(buttons, action)
+		}
+	}
+	return buttons
+}
+
+// truncateRunesInternal shortens s to at most n runes, marking the cut with an
+// ellipsis, so messages stay within provider limits.
+func truncateRunesInternal(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	runes := []rune(s)
+	return string(runes[:n-1]) + "…"
+}
@@ -3,6 +3,8 @@ package notifications
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"strings"
 
 	"github.com/getarcaneapp/arcane/backend/internal/models"
 	"github.com/nicholas-fedor/shoutrrr"
@@ -59,3 +61,149 @@ func SendSlack(ctx context.Context, config models.SlackConfig, message string) e
 	}
 	return nil
 }
+
+// Rich Slack messages are posted directly, since Shoutrrr only sends text.
+var (
+	slackPostMessageURL = "https://slack.com/api/chat.postMessage"
+	slackWebhookBaseURL = "https://hooks.slack.com/services/"
+)
+
+// slackMaxFields caps the fields of a message; a section holds at most 10.
+const slackMaxFields = 40
+
+// SendSlackRich sends msg as Block Kit blocks inside an attachment colored by
+// its severity, with a link button back to Arcane. Bot tokens post through
+// chat.postMessage and webhook tokens to the incoming webhook. msg.Text is
+// sent through SendSlack instead when the config asks for plain text.
+func SendSlackRich(ctx context.Context, config models.SlackConfig, msg RichMessage) error {
+	if config.PlainText {
+		return SendSlack(ctx, config, msg.Text)
+	}
+	token := strings.TrimSpace(config.Token)
+	if token == "" {
+		return fmt.Errorf("slack token is empty")
+	}
+
+	fallback := msg.Text
+	if fallback == "" {
+		fallback = msg.Title
+	}
+	payload := map[string]any{
+		"text": fallback,
+		"attachments": []any{map[string]any{
+			"color":  fmt.Sprintf("#%06X", msg.Severity.color()),
+			"blocks": slackBlocksInternal(msg),
+		}},
+	}
+
+	tlsConfig, err := BuildTLSConfig(nil, "")
+	if err != nil {
+		return fmt.Errorf("invalid Slack TLS settings: %w", err)
+	}
+	client := newTLSHTTPClientInternal(tlsConfig)
+	headers := map[string]string{"Content-Type": "application/json; charset=utf-8"}
+
+	if !strings.HasPrefix(token, "xoxb-") && !strings.HasPrefix(token, "xoxp-") {
+		endpoint, ok := slackWebhookURLInternal(token)
+		if !ok {
+			return fmt.Errorf("invalid Slack token format (expected format: xoxb-..., xoxp-... or a webhook token)")
+		}
+		if err := doDirectRequestInternal(ctx, client, http.MethodPost, endpoint, headers, payload, nil); err != nil {
+			return fmt.Errorf("failed to send Slack message: %w", err)
+		}
+		return nil
+	}
+
+	if config.Channel == "" {
+		return fmt.Errorf("slack channel is required when using a bot token")
+	}
+	payload["channel"] = config.Channel
+	if config.BotName != "" {
+		payload["username"] = config.BotName
+	}
+	if icon := strings.TrimSpace(config.Icon); icon != "" {
+		if strings.HasPrefix(icon, "http://") || strings.HasPrefix(icon, "https://") {
+			payload["icon_url"] = icon
+		} else {
+			payload["icon_emoji"] = icon
+		}
+	}
+	if config.ThreadTS != "" {
+		payload["thread_ts"] = config.ThreadTS
+	}
+	headers["Authorization"] = "Bearer " + token
+
+	// chat.postMessage reports failures in the body with a 200 status.
+	var resp struct {
+		OK    bool   `json:"ok"`
+		Error string `json:"error"`
+	}
+	if err := doDirectRequestInternal(ctx, client, http.MethodPost, slackPostMessageURL, headers, payload, &resp); err != nil {
+		return fmt.Errorf("failed to send Slack message: %w", err)
+	}
+	if !resp.OK {
+		return fmt.Errorf("failed to send Slack message: %s", resp.Error)
+	}
+	return nil
+}
+
+// slackBlocksInternal renders msg as Block Kit blocks within Slack's limits.
+func slackBlocksInternal(msg RichMessage) []any {
+	blocks := []any{map[string]any{
+		"type": "header",
+		"text": map[string]any{"type": "plain_text", "text": truncateRunesInternal(msg.Title, 150), "emoji": true},
+	}}
+	if msg.Description != "" {
+		blocks = append(blocks, map[string]any{
+			"type": "section",
+			"text": map[string]any{"type": "mrkdwn", "text": truncateRunesInternal(msg.Description, 3000)},
+		})
+	}
+
+	var fields []any
+	for i, f := range msg.Fields {
+		if i == slackMaxFields {
+			break
+		}
+		value := f.Value
+		if value == "" {
+			value = "-"
+		}
+		fields = append(fields, map[string]any{"type": "mrkdwn", "text": truncateRunesInternal("*"+f.Name+"*\n"+value, 2000)})
+		if len(fields) == 10 {
+			blocks = append(blocks, map[string]any{"type": "section", "fields": fields})
+			fields = nil
+		}
+	}
+	if len(fields) > 0 {
+		blocks = append(blocks, map[string]any{"type": "section", "fields": fields})
+	}
+
+	if buttons := msg.buttonsInternal(); len(buttons) > 0 {
+		// An actions block holds at most 25 elements.
+		elements := make([]any, 0, min(len(buttons), 25))
+		for _, b := range buttons[:min(len(buttons), 25)] {
+			elements = append(elements, map[string]any{
+				"type": "button",
+				"text": map[string]any{"type": "plain_text", "text": truncateRunesInternal(b.Label, 75)},
+				"url":  b.URL,
+			})
+		}
+		blocks = append(blocks, map[string]any{"type": "actions", "elements": elements})
+	}
+	return blocks
+}
+
+// slackWebhookURLInternal returns the incoming webhook URL of a webhook token
+// in Shoutrrr's "hook:T000-B000-XXXX" form, or of a full webhook URL.
+func slackWebhookURLInternal(token string) (string, bool) {
+	if strings.HasPrefix(token, "https://hooks.slack.com/") {
+		return token, true
+	}
+	token = strings.TrimPrefix(token, "hook:")
+	parts := strings.FieldsFunc(token, func(r rune) bool { return r == '-' || r == '/' })
+	if len(parts) != 3 || !strings.HasPrefix(parts[0], "T") || !strings.HasPrefix(parts[1], "B") {
+		return "", false
+	}
+	return slackWebhookBaseURL + strings.Join(parts, "/"), true
+}
@@ -1,6 +1,10 @@
 package notifications
 
 import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/getarcaneapp/arcane/backend/internal/models"
@@ -148,3 +152,59 @@ func TestBuildSlackURL_TokenParsing(t *testing.T) {
 		})
 	}
 }
+
+func TestSlackWebhookURL(t *testing.T) {
+	got, ok := slackWebhookURLInternal("hook:T000-B000-XXXX")
+	require.True(t, ok)
+	assert.Equal(t, "https://hooks.slack.com/services/T000/B000/XXXX", got)
+
+	got, ok = slackWebhookURLInternal("https://hooks.slack.com/services/T000/B000/XXXX")
+	require.True(t, ok)
+	assert.Equal(t, "https://hooks.slack.com/services/T000/B000/XXXX", got)
+
+	_, ok = slackWebhookURLInternal("not-a-token")
+	assert.False(t, ok)
+}
+
+func TestSendSlackRich(t *testing.T) {
+	var got map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/T000/B000/XXXX", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	original := slackWebhookBaseURL
+	slackWebhookBaseURL = server.URL + "/"
+	t.Cleanup(func() { slackWebhookBaseURL = original })
+
+	fields := make([]RichField, 12)
+	for i := range fields {
+		fields[i] = RichField{Name: "Field", Value: strings.Repeat("v", 10)}
+	}
+	msg := RichMessage{
+		Title:    "Prune Report",
+		Severity: RichSeveritySuccess,
+		Fields:   fields,
+		URL:      "https://arcane.example.com/dashboard",
+		Actions:  []RichLink{{Label: "Snooze 7 days", URL: "https://arcane.example.com/notification-actions/abc"}},
+		Text:     "*Prune Report*",
+	}
+	require.NoError(t, SendSlackRich(t.Context(), models.SlackConfig{Token: "hook:T000-B000-XXXX"}, msg))
+
+	assert.Equal(t, "*Prune Report*", got["text"])
+	attachment := got["attachments"].([]any)[0].(map[string]any)
+	assert.Equal(t, "#22C55E", attachment["color"])
+
+	blocks := attachment["blocks"].([]any)
+	types := make([]string, 0, len(blocks))
+	for _, b := range blocks {
+		types = append(types, b.(map[string]any)["type"].(string))
+	}
+	assert.Equal(t, []string{"header", "section", "section", "actions"}, types, "12 fields need two sections")
+	elements := blocks[3].(map[string]any)["elements"].([]any)
+	require.Len(t, elements, 2)
+	assert.Equal(t, "https://arcane.example.com/dashboard", elements[0].(map[string]any)["url"])
+	assert.Equal(t, "https://arcane.example.com/notification-actions/abc", elements[1].(map[string]any)["url"])
+}
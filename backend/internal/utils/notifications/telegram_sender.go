@@ -3,6 +3,9 @@ package notifications
 import (
 	"context"
 	"fmt"
+	"html"
+	"net/http"
+	"strings"
 
 	"github.com/getarcaneapp/arcane/backend/internal/models"
 	"github.com/nicholas-fedor/shoutrrr"
@@ -23,22 +26,29 @@ func BuildTelegramURL(config models.TelegramConfig) (string, error) {
 
 	// Add parse mode as query parameter if provided
 	if config.ParseMode != "" {
-		// Validate parse mode
-		switch config.ParseMode {
-		case "Markdown", "HTML", "MarkdownV2", "None":
-			q := url.Query()
-			q.Set("parsemode", config.ParseMode)
-			url.RawQuery = q.Encode()
-		default:
-			return "", fmt.Errorf("invalid parse mode: %s (must be Markdown, HTML, MarkdownV2, or None)", config.ParseMode)
+		if err := validateTelegramParseModeInternal(config.ParseMode); err != nil {
+			return "", err
 		}
+		q := url.Query()
+		q.Set("parsemode", config.ParseMode)
+		url.RawQuery = q.Encode()
 	}
 
 	return url.String(), nil
 }
 
+func validateTelegramParseModeInternal(parseMode string) error {
+	switch parseMode {
+	case "", "Markdown", "HTML", "MarkdownV2", "None":
+		return nil
+	default:
+		return fmt.Errorf("invalid parse mode: %s (must be Markdown, HTML, MarkdownV2, or None)", parseMode)
+	}
+}
+
 // SendTelegram sends a message via Shoutrrr Telegram using proper service configuration
-func SendTelegram(ctx context.Context, config models.TelegramConfig, message string) error {
+func SendTelegram(ctx context.Context, config models.TelegramConfig, event models.NotificationEventType, message string) error {
+	config = config.ForEvent(event)
 	if config.BotToken == "" {
 		return fmt.Errorf("telegram bot token is empty")
 	}
@@ -46,6 +56,10 @@ func SendTelegram(ctx context.Context, config models.TelegramConfig, message str
 		return fmt.Errorf("no telegram chat IDs configured")
 	}
 
+	if len(config.Topics) > 0 {
+		return sendTelegramDirectInternal(ctx, config, message)
+	}
+
 	shoutrrrURL, err := BuildTelegramURL(config)
 	if err != nil {
 		return fmt.Errorf("failed to build shoutrrr Telegram URL: %w", err)
@@ -64,3 +78,49 @@ func SendTelegram(ctx context.Context, config models.TelegramConfig, message str
 	}
 	return nil
 }
+
+// sendTelegramDirectInternal posts to the Bot API directly, since Shoutrrr
+// cannot address a forum topic.
+func sendTelegramDirectInternal(ctx context.Context, config models.TelegramConfig, message string) error {
+	if err := validateTelegramParseModeInternal(config.ParseMode); err != nil {
+		return err
+	}
+
+	text := message
+	if config.Title != "" {
+		if config.ParseMode == "HTML" {
+			text = "<b>" + html.EscapeString(config.Title) + "</b>\n" + message
+		} else {
+			text = config.Title + "\n" + message
+		}
+	}
+
+	tlsConfig, err := BuildTLSConfig(nil, "")
+	if err != nil {
+		return fmt.Errorf("invalid Telegram TLS settings: %w", err)
+	}
+	client := newTLSHTTPClientInternal(tlsConfig)
+	endpoint := telegramAPIBaseURL + "/bot" + config.BotToken + "/sendMessage"
+	headers := map[string]string{"Content-Type": "application/json"}
+
+	for _, chat := range config.ChatIDs {
+		payload := map[string]any{
+			"chat_id":              chat,
+			"text":                 text,
+			"disable_notification": !config.Notification,
+			"link_preview_options": map[string]bool{"is_disabled": !config.Preview},
+		}
+		if config.ParseMode != "" && config.ParseMode != "None" {
+			payload["parse_mode"] = config.ParseMode
+		}
+		if topic := config.Topics[chat]; topic > 0 {
+			payload["message_thread_id"] = topic
+		}
+
+		if err := doDirectRequestInternal(ctx, client, http.MethodPost, endpoint, headers, payload, nil); err != nil {
+			// The bot token is part of the URL, so keep it out of the error.
+			return fmt.Errorf("failed to send Telegram message to %s: %s", chat, strings.ReplaceAll(err.Error(), config.BotToken, "***"))
+		}
+	}
+	return nil
+}
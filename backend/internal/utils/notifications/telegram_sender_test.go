@@ -1,6 +1,9 @@
 package notifications
 
 import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/getarcaneapp/arcane/backend/internal/models"
@@ -56,3 +59,68 @@ func TestBuildTelegramURL(t *testing.T) {
 		})
 	}
 }
+
+func TestTelegramConfigForEvent(t *testing.T) {
+	config := models.TelegramConfig{
+		Notification: true,
+		EventSettings: map[models.NotificationEventType]models.TelegramEventSettings{
+			models.NotificationEventImageUpdate: {Silent: new(true)},
+		},
+	}
+
+	assert.False(t, config.ForEvent(models.NotificationEventImageUpdate).Notification)
+	assert.True(t, config.ForEvent(models.NotificationEventPruneReport).Notification)
+	assert.True(t, config.Notification, "the provider setting is not modified")
+}
+
+func TestSendTelegramTopic(t *testing.T) {
+	var got []map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/bot123:abc/sendMessage", r.URL.Path)
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		got = append(got, body)
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	defer server.Close()
+
+	original := telegramAPIBaseURL
+	telegramAPIBaseURL = server.URL
+	t.Cleanup(func() { telegramAPIBaseURL = original })
+
+	config := models.TelegramConfig{
+		BotToken:     "123:abc",
+		ChatIDs:      []string{"-1001", "@channel"},
+		Notification: true,
+		ParseMode:    "HTML",
+		Title:        "Arcane & Co",
+		Topics:       map[string]int64{"-1001": 42},
+		EventSettings: map[models.NotificationEventType]models.TelegramEventSettings{
+			models.NotificationEventPruneReport: {Silent: new(true)},
+		},
+	}
+	require.NoError(t, SendTelegram(t.Context(), config, models.NotificationEventPruneReport, "<b>Pruned</b>"))
+
+	require.Len(t, got, 2)
+	assert.Equal(t, "-1001", got[0]["chat_id"])
+	assert.InDelta(t, 42, got[0]["message_thread_id"], 0)
+	assert.Equal(t, "<b>Arcane &amp; Co</b>\n<b>Pruned</b>", got[0]["text"])
+	assert.Equal(t, "HTML", got[0]["parse_mode"])
+	assert.Equal(t, true, got[0]["disable_notification"])
+	assert.NotContains(t, got[1], "message_thread_id")
+}
+
+func TestSendTelegramTopicHidesToken(t *testing.T) {
+	// Transport errors include the request URL, which contains the token.
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	original := telegramAPIBaseURL
+	telegramAPIBaseURL = server.URL
+	t.Cleanup(func() { telegramAPIBaseURL = original })
+
+	config := models.TelegramConfig{BotToken: "123:secret", ChatIDs: []string{"-1001"}, Topics: map[string]int64{"-1001": 7}}
+	err := SendTelegram(t.Context(), config, models.NotificationEventImageUpdate, "hello")
+	require.Error(t, err)
+	assert.NotContains(t, err.Error(), "secret")
+}
@@ -0,0 +1,142 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	httputils "github.com/getarcaneapp/arcane/backend/internal/utils/http"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/truststore"
+)
+
+const directSendTimeout = 30 * time.Second
+
+// ValidateTLSConfig checks that the CA bundle parses and the minimum version is
+// supported.
+func ValidateTLSConfig(opts *models.NotificationTLSConfig) error {
+	_, err := BuildTLSConfig(opts, "")
+	return err
+}
+
+// BuildTLSConfig returns the client TLS configuration for a provider: Arcane's
+// trust store, extended or relaxed by the provider's own settings.
+func BuildTLSConfig(opts *models.NotificationTLSConfig, serverName string) (*tls.Config, error) {
+	cfg := truststore.ClientTLSConfig(serverName)
+	if opts == nil {
+		return cfg, nil
+	}
+
+	switch strings.TrimSpace(opts.MinVersion) {
+	case "", "1.2":
+	case "1.3":
+		cfg.MinVersion = tls.VersionTLS13
+	default:
+		return nil, fmt.Errorf("unsupported minimum TLS version %q, use 1.2 or 1.3", opts.MinVersion)
+	}
+
+	if strings.TrimSpace(opts.CACertificate) != "" {
+		pool := truststore.RootCAs()
+		if pool != nil {
+			pool = pool.Clone()
+		} else if pool, _ = x509.SystemCertPool(); pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM([]byte(opts.CACertificate)) {
+			return nil, fmt.Errorf("CA certificate must contain at least one PEM encoded certificate")
+		}
+		cfg.RootCAs = pool
+	}
+
+	cfg.InsecureSkipVerify = opts.InsecureSkipVerify //nolint:gosec // explicit per-provider opt-in
+	return cfg, nil
+}
+
+// hasCustomTLSInternal reports whether opts change anything compared to the
+// defaults, in which case the message is sent directly rather than through
+// Shoutrrr, whose transports cannot be configured.
+func hasCustomTLSInternal(opts *models.NotificationTLSConfig) bool {
+	return opts != nil && (strings.TrimSpace(opts.CACertificate) != "" || opts.InsecureSkipVerify || strings.TrimSpace(opts.MinVersion) != "")
+}
+
+// newTLSHTTPClientInternal builds a client that uses tlsConfig for every
+// connection while keeping the outbound proxy settings.
+func newTLSHTTPClientInternal(tlsConfig *tls.Config) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// The default transport dials TLS itself against the shared trust store.
+	transport.DialTLSContext = nil
+	transport.Proxy = httputils.ProxyFromSettings
+	transport.TLSClientConfig = tlsConfig
+	return &http.Client{Transport: transport, Timeout: directSendTimeout}
+}
+
+// directStatusError is a non-2xx response to a direct request.
+type directStatusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *directStatusError) Error() string {
+	return fmt.Sprintf("server returned status %d: %s", e.StatusCode, e.Body)
+}
+
+// directStatusCodeInternal returns the status of a non-2xx response behind
+// err, or 0.
+func directStatusCodeInternal(err error) int {
+	var statusErr *directStatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode
+	}
+	return 0
+}
+
+// doDirectRequestInternal sends a request and decodes a JSON response into out
+// when given. Non-2xx responses are returned as errors.
+func doDirectRequestInternal(ctx context.Context, client *http.Client, method, endpoint string, headers map[string]string, body any, out any) error {
+	var reader io.Reader
+	if body != nil {
+		switch b := body.(type) {
+		case string:
+			reader = strings.NewReader(b)
+		default:
+			payload, err := json.Marshal(b)
+			if err != nil {
+				return fmt.Errorf("failed to encode request: %w", err)
+			}
+			reader = bytes.NewReader(payload)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reader)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return &directStatusError{StatusCode: resp.StatusCode, Body: strings.TrimSpace(string(respBody))}
+	}
+	if out != nil && len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, out); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+	}
+	return nil
+}
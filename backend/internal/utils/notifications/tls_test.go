@@ -0,0 +1,104 @@
+package notifications
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTLSTestServer(t *testing.T, handler http.HandlerFunc) (host string, port int, caPEM string) {
+	t.Helper()
+
+	srv := httptest.NewTLSServer(handler)
+	t.Cleanup(srv.Close)
+
+	h, p, err := net.SplitHostPort(srv.Listener.Addr().String())
+	require.NoError(t, err)
+	port, err = strconv.Atoi(p)
+	require.NoError(t, err)
+	caPEM = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}))
+	return h, port, caPEM
+}
+
+func TestBuildTLSConfig(t *testing.T) {
+	cfg, err := BuildTLSConfig(nil, "example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "example.com", cfg.ServerName)
+	assert.Equal(t, uint16(tls.VersionTLS12), cfg.MinVersion)
+	assert.False(t, cfg.InsecureSkipVerify)
+
+	cfg, err = BuildTLSConfig(&models.NotificationTLSConfig{MinVersion: "1.3", InsecureSkipVerify: true}, "")
+	require.NoError(t, err)
+	assert.Equal(t, uint16(tls.VersionTLS13), cfg.MinVersion)
+	assert.True(t, cfg.InsecureSkipVerify)
+
+	_, err = BuildTLSConfig(&models.NotificationTLSConfig{MinVersion: "1.0"}, "")
+	require.Error(t, err)
+
+	_, err = BuildTLSConfig(&models.NotificationTLSConfig{CACertificate: "not a certificate"}, "")
+	require.Error(t, err)
+}
+
+func TestSendNtfy_CustomCA(t *testing.T) {
+	var gotTitle, gotBody string
+	host, port, caPEM := newTLSTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/alerts", r.URL.Path)
+		gotTitle = r.Header.Get("X-Title")
+		body, _ := io.ReadAll(r.Body)
+		gotBody = string(body)
+	})
+
+	config := models.NtfyConfig{Host: host, Port: port, Topic: "alerts", Title: "Arcane"}
+
+	config.TLS = &models.NotificationTLSConfig{MinVersion: "1.2"}
+	require.Error(t, SendNtfy(context.Background(), config, "", "hello"), "server certificate should not be trusted without the CA")
+
+	config.TLS = &models.NotificationTLSConfig{CACertificate: caPEM}
+	require.NoError(t, SendNtfy(context.Background(), config, "", "hello"))
+	assert.Equal(t, "Arcane", gotTitle)
+	assert.Equal(t, "hello", gotBody)
+}
+
+func TestSendGotify_InsecureSkipVerify(t *testing.T) {
+	var got map[string]any
+	host, port, _ := newTLSTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/gotify/message", r.URL.Path)
+		assert.Equal(t, "token123", r.Header.Get("X-Gotify-Key"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+	})
+
+	config := models.GotifyConfig{
+		Host:     host,
+		Port:     port,
+		Path:     "gotify",
+		Token:    "token123",
+		Priority: 5,
+		TLS:      &models.NotificationTLSConfig{InsecureSkipVerify: true},
+	}
+	require.NoError(t, SendGotify(context.Background(), config, "", "hello", ""))
+	assert.Equal(t, "hello", got["message"])
+	assert.EqualValues(t, 5, got["priority"])
+}
+
+func TestGenericHTTPSEndpoint(t *testing.T) {
+	endpoint, ok := genericHTTPSEndpointInternal(models.GenericConfig{WebhookURL: "hooks.internal/notify"})
+	require.True(t, ok)
+	assert.Equal(t, "https://hooks.internal/notify", endpoint)
+
+	_, ok = genericHTTPSEndpointInternal(models.GenericConfig{WebhookURL: "http://hooks.internal/notify"})
+	assert.False(t, ok)
+
+	_, ok = genericHTTPSEndpointInternal(models.GenericConfig{WebhookURL: "hooks.internal/notify", DisableTLS: true})
+	assert.False(t, ok)
+}
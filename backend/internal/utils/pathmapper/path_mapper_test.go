@@ -1,8 +1,11 @@
 package pathmapper
 
 import (
+	"context"
+	"path/filepath"
 	"testing"
 
+	"github.com/compose-spec/compose-go/v2/loader"
 	composetypes "github.com/compose-spec/compose-go/v2/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -77,3 +80,41 @@ func TestPathMapper_TranslateVolumeSources(t *testing.T) {
 	assert.Equal(t, "C:/User/arcane/projects/myproj/secret.txt", project.Secrets["my-secret"].File)
 	assert.Equal(t, "C:/User/arcane/projects/myproj/config.yaml", project.Configs["my-config"].File)
 }
+
+func TestPathMapper_BindMountedComposeFileResolvesOnHost(t *testing.T) {
+	// Arcane runs with -v /srv/arcane/projects:/app/data/projects, so compose
+	// loads the project from the container path and resolves relative bind
+	// mounts against it. Docker only sees host paths.
+	containerDir := "/app/data/projects"
+	workingDir := filepath.Join(containerDir, "web")
+	pm := NewPathMapper(containerDir, "/srv/arcane/projects")
+
+	composeFile := filepath.Join(workingDir, "compose.yaml")
+	composeHost, err := pm.ContainerToHost(composeFile)
+	require.NoError(t, err)
+	assert.Equal(t, "/srv/arcane/projects/web/compose.yaml", composeHost)
+
+	project, err := loader.LoadWithContext(context.Background(), composetypes.ConfigDetails{
+		WorkingDir: workingDir,
+		ConfigFiles: []composetypes.ConfigFile{{
+			Filename: composeFile,
+			Content: []byte(`
+services:
+  app:
+    image: nginx
+    volumes:
+      - ./html:/usr/share/nginx/html:ro
+      - /var/run/docker.sock:/var/run/docker.sock
+`),
+		}},
+	}, func(o *loader.Options) {
+		o.SetProjectName("web", true)
+		o.SkipConsistencyCheck = true
+	})
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(workingDir, "html"), project.Services["app"].Volumes[0].Source)
+
+	require.NoError(t, pm.TranslateVolumeSources(project))
+	assert.Equal(t, "/srv/arcane/projects/web/html", project.Services["app"].Volumes[0].Source)
+	assert.Equal(t, "/var/run/docker.sock", project.Services["app"].Volumes[1].Source, "paths outside the projects directory are unchanged")
+}
@@ -0,0 +1,145 @@
+package proxylabels
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/getarcaneapp/arcane/types/ingress"
+)
+
+// DetectConflicts finds hosts claimed by more than one container, Traefik
+// router names defined more than once, routes without a host and Traefik
+// routers with missing or undefined entrypoints. entryPoints are the
+// entrypoints Traefik defines; when nil, entrypoint names are not checked.
+//
+// Containers of the same compose service are replicas sharing one set of
+// labels, so they never conflict with each other.
+func DetectConflicts(routes []ingress.Route, entryPoints []string) []ingress.Conflict {
+	conflicts := []ingress.Conflict{}
+
+	type hostKey struct {
+		provider ingress.Provider
+		host     string
+		path     string
+	}
+	hostOwners := map[hostKey][]ingress.Route{}
+	var hostOrder []hostKey
+
+	type routerKey struct {
+		provider ingress.Provider
+		name     string
+	}
+	routerOwners := map[routerKey][]ingress.Route{}
+	var routerOrder []routerKey
+
+	for _, route := range routes {
+		paths := route.PathPrefixes
+		if len(paths) == 0 {
+			paths = []string{""}
+		}
+		for _, host := range route.Hosts {
+			for _, path := range paths {
+				key := hostKey{provider: route.Provider, host: host, path: path}
+				if _, ok := hostOwners[key]; !ok {
+					hostOrder = append(hostOrder, key)
+				}
+				hostOwners[key] = append(hostOwners[key], route)
+			}
+		}
+
+		if route.Provider == ingress.ProviderTraefik {
+			key := routerKey{provider: route.Provider, name: route.Name}
+			if _, ok := routerOwners[key]; !ok {
+				routerOrder = append(routerOrder, key)
+			}
+			routerOwners[key] = append(routerOwners[key], route)
+		}
+
+		if len(route.Hosts) == 0 {
+			conflicts = append(conflicts, newConflictInternal(ingress.ConflictMissingRule,
+				fmt.Sprintf("%s route %q on %s does not match any host", route.Provider, route.Name, route.ContainerName),
+				"", []ingress.Route{route}))
+		}
+
+		if route.Provider != ingress.ProviderTraefik {
+			continue
+		}
+		if len(route.EntryPoints) == 0 {
+			conflicts = append(conflicts, newConflictInternal(ingress.ConflictMissingEntryPoint,
+				fmt.Sprintf("router %q on %s has no entrypoints and will listen on every entrypoint", route.Name, route.ContainerName),
+				"", []ingress.Route{route}))
+			continue
+		}
+		if entryPoints == nil {
+			continue
+		}
+		for _, ep := range route.EntryPoints {
+			if !slices.Contains(entryPoints, ep) {
+				conflicts = append(conflicts, newConflictInternal(ingress.ConflictMissingEntryPoint,
+					fmt.Sprintf("router %q on %s uses entrypoint %q, which Traefik does not define", route.Name, route.ContainerName, ep),
+					"", []ingress.Route{route}))
+			}
+		}
+	}
+
+	for _, key := range hostOrder {
+		owners := hostOwners[key]
+		if countOwnersInternal(owners) < 2 {
+			continue
+		}
+		target := key.host + key.path
+		conflicts = append(conflicts, newConflictInternal(ingress.ConflictDuplicateHost,
+			fmt.Sprintf("%s is routed to more than one container by %s", target, key.provider),
+			key.host, owners))
+	}
+
+	for _, key := range routerOrder {
+		owners := routerOwners[key]
+		if countOwnersInternal(owners) < 2 {
+			continue
+		}
+		conflicts = append(conflicts, newConflictInternal(ingress.ConflictDuplicateRouter,
+			fmt.Sprintf("router %q is defined by more than one container", key.name),
+			"", owners))
+	}
+
+	return conflicts
+}
+
+// countOwnersInternal counts the distinct owners of routes, treating the
+// containers of one compose service as a single owner.
+func countOwnersInternal(routes []ingress.Route) int {
+	owners := map[string]struct{}{}
+	for _, route := range routes {
+		owners[ownerKeyInternal(route)] = struct{}{}
+	}
+	return len(owners)
+}
+
+func ownerKeyInternal(route ingress.Route) string {
+	if route.Project != "" && route.Service != "" {
+		return route.Project + "/" + route.Service
+	}
+	return route.ContainerID
+}
+
+func newConflictInternal(kind ingress.ConflictKind, message, host string, routes []ingress.Route) ingress.Conflict {
+	conflict := ingress.Conflict{
+		Kind:           kind,
+		Message:        message,
+		Provider:       routes[0].Provider,
+		Host:           host,
+		Routes:         []string{},
+		ContainerNames: []string{},
+	}
+	for _, route := range routes {
+		if !slices.Contains(conflict.Routes, route.Name) {
+			conflict.Routes = append(conflict.Routes, route.Name)
+		}
+		if !slices.Contains(conflict.ContainerNames, route.ContainerName) {
+			conflict.ContainerNames = append(conflict.ContainerNames, route.ContainerName)
+		}
+	}
+	slices.Sort(conflict.ContainerNames)
+	return conflict
+}
@@ -0,0 +1,119 @@
+package proxylabels
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/getarcaneapp/arcane/types/ingress"
+)
+
+var (
+	routerNameRe = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]*$`)
+	domainRe     = regexp.MustCompile(`^([A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?\.)*[A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?$`)
+	labelValueRe = regexp.MustCompile(`^[A-Za-z0-9._/*-]*$`)
+)
+
+// GenerateLabels returns the labels that route req.Domain to req.Port of the
+// service with the requested proxy.
+func GenerateLabels(req ingress.GenerateLabelsRequest) (map[string]string, error) {
+	if err := validateRequestInternal(req); err != nil {
+		return nil, err
+	}
+	domain := strings.ToLower(req.Domain)
+	port := strconv.Itoa(req.Port)
+
+	switch req.Provider {
+	case ingress.ProviderTraefik:
+		router := traefikRouterPrefix + req.Service
+		rule := fmt.Sprintf("Host(`%s`)", domain)
+		if req.PathPrefix != "" {
+			rule += fmt.Sprintf(" && PathPrefix(`%s`)", req.PathPrefix)
+		}
+		entryPoint := req.EntryPoint
+		if entryPoint == "" {
+			entryPoint = "web"
+			if req.TLS {
+				entryPoint = "websecure"
+			}
+		}
+
+		labels := map[string]string{
+			"traefik.enable":        "true",
+			router + ".rule":        rule,
+			router + ".entrypoints": entryPoint,
+			router + ".service":     req.Service,
+			traefikServicePrefix + req.Service + traefikPortSuffix: port,
+		}
+		if req.TLS {
+			labels[router+".tls"] = "true"
+			if req.CertResolver != "" {
+				labels[router+".tls.certresolver"] = req.CertResolver
+			}
+		}
+		if req.Network != "" {
+			labels["traefik.docker.network"] = req.Network
+		}
+		return labels, nil
+
+	case ingress.ProviderCaddy:
+		site := domain
+		if !req.TLS {
+			site = "http://" + domain
+		}
+		upstream := fmt.Sprintf("{{upstreams %s}}", port)
+		if req.PathPrefix != "" {
+			upstream = strings.TrimSuffix(req.PathPrefix, "/") + "/* " + upstream
+		}
+
+		labels := map[string]string{
+			"caddy":               site,
+			"caddy.reverse_proxy": upstream,
+		}
+		if req.Network != "" {
+			labels["caddy_ingress_network"] = req.Network
+		}
+		return labels, nil
+	}
+
+	return nil, fmt.Errorf("unsupported provider: %q", req.Provider)
+}
+
+// ComposeLabels renders labels as a compose labels block, sorted by key.
+func ComposeLabels(labels map[string]string) string {
+	var sb strings.Builder
+	sb.WriteString("labels:\n")
+	for _, key := range slices.Sorted(maps.Keys(labels)) {
+		sb.WriteString("  - ")
+		sb.WriteString(strconv.Quote(key + "=" + labels[key]))
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+func validateRequestInternal(req ingress.GenerateLabelsRequest) error {
+	if req.Provider != ingress.ProviderTraefik && req.Provider != ingress.ProviderCaddy {
+		return fmt.Errorf("unsupported provider: %q", req.Provider)
+	}
+	if !routerNameRe.MatchString(req.Service) {
+		return fmt.Errorf("service name may only contain letters, digits, '-' and '_': %q", req.Service)
+	}
+	if len(req.Domain) > 253 || !domainRe.MatchString(req.Domain) {
+		return fmt.Errorf("invalid domain: %q", req.Domain)
+	}
+	if req.Port < 1 || req.Port > 65535 {
+		return fmt.Errorf("port must be between 1 and 65535")
+	}
+	if req.PathPrefix != "" && (!strings.HasPrefix(req.PathPrefix, "/") || !labelValueRe.MatchString(req.PathPrefix)) {
+		return fmt.Errorf("path prefix must start with '/' and contain no spaces or quotes: %q", req.PathPrefix)
+	}
+	for field, value := range map[string]string{"entrypoint": req.EntryPoint, "cert resolver": req.CertResolver, "network": req.Network} {
+		if !labelValueRe.MatchString(value) {
+			return fmt.Errorf("invalid %s: %q", field, value)
+		}
+	}
+	return nil
+}
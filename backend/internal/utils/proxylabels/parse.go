@@ -0,0 +1,243 @@
+// Package proxylabels reads and writes the container labels used by Traefik
+// and caddy-docker-proxy to route traffic to containers, and finds routes
+// that conflict with each other.
+package proxylabels
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/getarcaneapp/arcane/types/ingress"
+)
+
+const (
+	traefikRouterPrefix  = "traefik.http.routers."
+	traefikServicePrefix = "traefik.http.services."
+	traefikPortSuffix    = ".loadbalancer.server.port"
+
+	composeProjectLabel = "com.docker.compose.project"
+	composeServiceLabel = "com.docker.compose.service"
+)
+
+var (
+	traefikHostRe       = regexp.MustCompile(`\bHost\(([^)]*)\)`)
+	traefikPathPrefixRe = regexp.MustCompile(`\bPathPrefix\(([^)]*)\)`)
+	traefikArgRe        = regexp.MustCompile("[`\"']([^`\"']*)[`\"']")
+	traefikEntryPointRe = regexp.MustCompile(`(?i)^--entrypoints\.([^.=]+)\.address(=|$)`)
+	caddyLabelRe        = regexp.MustCompile(`^caddy(_\d+)?$`)
+	caddyUpstreamsRe    = regexp.MustCompile(`\{\{\s*upstreams\s+(?:https?\s+)?(\d+)\s*\}\}`)
+)
+
+// Container is the part of a container the parser needs.
+type Container struct {
+	ID      string
+	Name    string
+	Image   string
+	Command []string
+	Labels  map[string]string
+}
+
+func (c Container) project() string {
+	return c.Labels[composeProjectLabel]
+}
+
+func (c Container) service() string {
+	return c.Labels[composeServiceLabel]
+}
+
+// ParseRoutes returns the Traefik and Caddy routes declared by the labels of
+// every container.
+func ParseRoutes(containers []Container) []ingress.Route {
+	routes := []ingress.Route{}
+	for _, c := range containers {
+		routes = append(routes, ParseTraefikLabels(c)...)
+		routes = append(routes, ParseCaddyLabels(c)...)
+	}
+	return routes
+}
+
+// ParseTraefikLabels returns a route for each router declared in the
+// container's traefik.http.routers labels. Containers with
+// traefik.enable=false are ignored, as Traefik ignores them too.
+func ParseTraefikLabels(c Container) []ingress.Route {
+	if strings.EqualFold(c.Labels["traefik.enable"], "false") {
+		return nil
+	}
+
+	routers := map[string]map[string]string{}
+	ports := map[string]string{}
+	for key, value := range c.Labels {
+		if rest, ok := strings.CutPrefix(key, traefikRouterPrefix); ok {
+			name, option, _ := strings.Cut(rest, ".")
+			if name == "" {
+				continue
+			}
+			if routers[name] == nil {
+				routers[name] = map[string]string{}
+			}
+			routers[name][option] = strings.TrimSpace(value)
+			continue
+		}
+		if rest, ok := strings.CutPrefix(key, traefikServicePrefix); ok {
+			if name, ok := strings.CutSuffix(rest, traefikPortSuffix); ok && name != "" {
+				ports[name] = strings.TrimSpace(value)
+			}
+		}
+	}
+
+	names := make([]string, 0, len(routers))
+	for name := range routers {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	routes := make([]ingress.Route, 0, len(names))
+	for _, name := range names {
+		options := routers[name]
+		route := newRouteInternal(ingress.ProviderTraefik, name, c)
+		route.Rule = options["rule"]
+		route.Hosts, route.PathPrefixes = ParseTraefikRule(route.Rule)
+		route.EntryPoints = splitListInternal(options["entrypoints"])
+		route.TLS = strings.EqualFold(options["tls"], "true") || options["tls.certresolver"] != ""
+
+		// A router without an explicit service uses the container's only
+		// service, if it has exactly one.
+		if svc := options["service"]; svc != "" {
+			route.Port = ports[svc]
+		} else if len(ports) == 1 {
+			for _, port := range ports {
+				route.Port = port
+			}
+		}
+		routes = append(routes, route)
+	}
+	return routes
+}
+
+// ParseTraefikRule returns the hosts and path prefixes matched by a Traefik
+// router rule. Both the v2 Host(`a`, `b`) form and v3 Host(`a`) || Host(`b`)
+// form are understood. Other matchers are ignored.
+func ParseTraefikRule(rule string) (hosts, pathPrefixes []string) {
+	hosts = []string{}
+	for _, m := range traefikHostRe.FindAllStringSubmatch(rule, -1) {
+		for _, arg := range traefikArgRe.FindAllStringSubmatch(m[1], -1) {
+			if host := normalizeHostInternal(arg[1]); host != "" && !slices.Contains(hosts, host) {
+				hosts = append(hosts, host)
+			}
+		}
+	}
+	for _, m := range traefikPathPrefixRe.FindAllStringSubmatch(rule, -1) {
+		for _, arg := range traefikArgRe.FindAllStringSubmatch(m[1], -1) {
+			if arg[1] != "" && !slices.Contains(pathPrefixes, arg[1]) {
+				pathPrefixes = append(pathPrefixes, arg[1])
+			}
+		}
+	}
+	return hosts, pathPrefixes
+}
+
+// ParseCaddyLabels returns a route for each site declared by the container's
+// caddy or caddy_N labels, in the format read by caddy-docker-proxy.
+func ParseCaddyLabels(c Container) []ingress.Route {
+	var names []string
+	for key := range c.Labels {
+		if caddyLabelRe.MatchString(key) {
+			names = append(names, key)
+		}
+	}
+	slices.Sort(names)
+
+	routes := make([]ingress.Route, 0, len(names))
+	for _, name := range names {
+		route := newRouteInternal(ingress.ProviderCaddy, name, c)
+		route.Rule = strings.TrimSpace(c.Labels[name])
+
+		// Caddy serves HTTPS automatically unless every address of the site
+		// is explicitly plain HTTP.
+		route.TLS = false
+		for _, address := range splitListInternal(route.Rule) {
+			if !strings.HasPrefix(strings.ToLower(address), "http://") {
+				route.TLS = true
+			}
+			if host := normalizeHostInternal(address); host != "" && !slices.Contains(route.Hosts, host) {
+				route.Hosts = append(route.Hosts, host)
+			}
+		}
+
+		if m := caddyUpstreamsRe.FindStringSubmatch(c.Labels[name+".reverse_proxy"]); m != nil {
+			route.Port = m[1]
+		}
+		routes = append(routes, route)
+	}
+	return routes
+}
+
+// ParseTraefikEntryPoints returns the entrypoints a Traefik container defines
+// through --entrypoints.<name>.address arguments. ok is false when the
+// container is not Traefik or configures entrypoints some other way, such as
+// a static configuration file, in which case they cannot be checked.
+func ParseTraefikEntryPoints(c Container) (entryPoints []string, ok bool) {
+	if !IsTraefikContainer(c) {
+		return nil, false
+	}
+	for _, arg := range c.Command {
+		if m := traefikEntryPointRe.FindStringSubmatch(arg); m != nil && !slices.Contains(entryPoints, m[1]) {
+			entryPoints = append(entryPoints, m[1])
+		}
+	}
+	return entryPoints, len(entryPoints) > 0
+}
+
+// IsTraefikContainer reports whether the container runs the Traefik image.
+func IsTraefikContainer(c Container) bool {
+	image := strings.ToLower(c.Image)
+	if i := strings.LastIndex(image, "@"); i >= 0 {
+		image = image[:i]
+	}
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		image = image[:i]
+	}
+	return image == "traefik" || strings.HasSuffix(image, "/traefik")
+}
+
+func newRouteInternal(provider ingress.Provider, name string, c Container) ingress.Route {
+	return ingress.Route{
+		Provider:      provider,
+		Name:          name,
+		ContainerID:   c.ID,
+		ContainerName: c.Name,
+		Project:       c.project(),
+		Service:       c.service(),
+		Hosts:         []string{},
+	}
+}
+
+// normalizeHostInternal strips the scheme, port and path from an address and
+// lowercases it. Addresses without a host, such as :8080, return "".
+func normalizeHostInternal(address string) string {
+	host := strings.TrimSpace(address)
+	if _, rest, ok := strings.Cut(host, "://"); ok {
+		host = rest
+	}
+	if i := strings.IndexByte(host, '/'); i >= 0 {
+		host = host[:i]
+	}
+	if h, port, ok := strings.Cut(host, ":"); ok {
+		if _, err := strconv.Atoi(port); err == nil {
+			host = h
+		}
+	}
+	return strings.ToLower(strings.TrimSuffix(host, "."))
+}
+
+func splitListInternal(value string) []string {
+	fields := strings.FieldsFunc(value, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t'
+	})
+	if len(fields) == 0 {
+		return nil
+	}
+	return fields
+}
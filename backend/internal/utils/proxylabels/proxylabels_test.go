@@ -0,0 +1,195 @@
+package proxylabels
+
+import (
+	"testing"
+
+	"github.com/getarcaneapp/arcane/types/ingress"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTraefikRule(t *testing.T) {
+	hosts, paths := ParseTraefikRule("Host(`App.example.com`, `www.example.com`) && PathPrefix(`/api`)")
+	require.Equal(t, []string{"app.example.com", "www.example.com"}, hosts)
+	require.Equal(t, []string{"/api"}, paths)
+
+	hosts, paths = ParseTraefikRule("Host(`a.example.com`) || Host(`b.example.com`) || HostRegexp(`^.+\\.example\\.com$`)")
+	require.Equal(t, []string{"a.example.com", "b.example.com"}, hosts)
+	require.Empty(t, paths)
+}
+
+func TestParseTraefikLabels(t *testing.T) {
+	c := Container{
+		ID:   "c1",
+		Name: "web",
+		Labels: map[string]string{
+			"traefik.enable":                                     "true",
+			"traefik.http.routers.web.rule":                      "Host(`web.example.com`)",
+			"traefik.http.routers.web.entrypoints":               "websecure",
+			"traefik.http.routers.web.tls.certresolver":          "le",
+			"traefik.http.services.web.loadbalancer.server.port": "8080",
+			"traefik.http.routers.web-http.rule":                 "Host(`web.example.com`)",
+			"traefik.http.routers.web-http.entrypoints":          "web",
+			"com.docker.compose.project":                         "site",
+			"com.docker.compose.service":                         "web",
+		},
+	}
+
+	routes := ParseTraefikLabels(c)
+	require.Len(t, routes, 2)
+	require.Equal(t, "web", routes[0].Name)
+	require.Equal(t, []string{"web.example.com"}, routes[0].Hosts)
+	require.Equal(t, []string{"websecure"}, routes[0].EntryPoints)
+	require.Equal(t, "8080", routes[0].Port)
+	require.True(t, routes[0].TLS)
+	require.Equal(t, "site", routes[0].Project)
+	require.Equal(t, "web-http", routes[1].Name)
+	require.False(t, routes[1].TLS)
+	require.Equal(t, "8080", routes[1].Port)
+
+	c.Labels["traefik.enable"] = "false"
+	require.Empty(t, ParseTraefikLabels(c))
+}
+
+func TestParseCaddyLabels(t *testing.T) {
+	routes := ParseCaddyLabels(Container{
+		ID:   "c1",
+		Name: "app",
+		Labels: map[string]string{
+			"caddy":                 "app.example.com, www.example.com:443",
+			"caddy.reverse_proxy":   "{{upstreams 3000}}",
+			"caddy_1":               "http://internal.lan",
+			"caddy_1.reverse_proxy": "{{upstreams http 8080}}",
+		},
+	})
+
+	require.Len(t, routes, 2)
+	require.Equal(t, []string{"app.example.com", "www.example.com"}, routes[0].Hosts)
+	require.Equal(t, "3000", routes[0].Port)
+	require.True(t, routes[0].TLS)
+	require.Equal(t, []string{"internal.lan"}, routes[1].Hosts)
+	require.Equal(t, "8080", routes[1].Port)
+	require.False(t, routes[1].TLS)
+}
+
+func TestParseTraefikEntryPoints(t *testing.T) {
+	eps, ok := ParseTraefikEntryPoints(Container{
+		Image:   "traefik:v3.1",
+		Command: []string{"--providers.docker", "--entrypoints.web.address=:80", "--entryPoints.websecure.address=:443"},
+	})
+	require.True(t, ok)
+	require.Equal(t, []string{"web", "websecure"}, eps)
+
+	_, ok = ParseTraefikEntryPoints(Container{Image: "traefik:v3.1", Command: []string{"--configFile=/etc/traefik.yml"}})
+	require.False(t, ok)
+
+	_, ok = ParseTraefikEntryPoints(Container{Image: "nginx", Command: []string{"--entrypoints.web.address=:80"}})
+	require.False(t, ok)
+}
+
+func TestDetectConflicts(t *testing.T) {
+	routes := ParseRoutes([]Container{
+		{ID: "a", Name: "a", Labels: map[string]string{
+			"traefik.http.routers.app.rule":        "Host(`app.example.com`)",
+			"traefik.http.routers.app.entrypoints": "websecure",
+		}},
+		{ID: "b", Name: "b", Labels: map[string]string{
+			"traefik.http.routers.app.rule":        "Host(`app.example.com`)",
+			"traefik.http.routers.app.entrypoints": "https",
+		}},
+		{ID: "c", Name: "c", Labels: map[string]string{
+			"traefik.http.routers.api.rule": "Host(`app.example.com`) && PathPrefix(`/api`)",
+		}},
+	})
+
+	conflicts := DetectConflicts(routes, []string{"web", "websecure"})
+	kinds := map[ingress.ConflictKind]int{}
+	for _, c := range conflicts {
+		kinds[c.Kind]++
+	}
+	require.Equal(t, 1, kinds[ingress.ConflictDuplicateHost])
+	require.Equal(t, 1, kinds[ingress.ConflictDuplicateRouter])
+	require.Equal(t, 2, kinds[ingress.ConflictMissingEntryPoint])
+
+	for _, c := range conflicts {
+		if c.Kind == ingress.ConflictDuplicateHost {
+			require.Equal(t, "app.example.com", c.Host)
+			require.Equal(t, []string{"a", "b"}, c.ContainerNames)
+		}
+	}
+}
+
+func TestDetectConflictsIgnoresReplicas(t *testing.T) {
+	labels := map[string]string{
+		"traefik.http.routers.app.rule":        "Host(`app.example.com`)",
+		"traefik.http.routers.app.entrypoints": "web",
+		"com.docker.compose.project":           "site",
+		"com.docker.compose.service":           "app",
+	}
+	routes := ParseRoutes([]Container{
+		{ID: "a", Name: "site-app-1", Labels: labels},
+		{ID: "b", Name: "site-app-2", Labels: labels},
+	})
+
+	require.Empty(t, DetectConflicts(routes, nil))
+}
+
+func TestGenerateLabels(t *testing.T) {
+	labels, err := GenerateLabels(ingress.GenerateLabelsRequest{
+		Provider:     ingress.ProviderTraefik,
+		Service:      "app",
+		Domain:       "App.example.com",
+		Port:         8080,
+		TLS:          true,
+		CertResolver: "le",
+		Network:      "proxy",
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"traefik.enable":                                     "true",
+		"traefik.http.routers.app.rule":                      "Host(`app.example.com`)",
+		"traefik.http.routers.app.entrypoints":               "websecure",
+		"traefik.http.routers.app.service":                   "app",
+		"traefik.http.routers.app.tls":                       "true",
+		"traefik.http.routers.app.tls.certresolver":          "le",
+		"traefik.http.services.app.loadbalancer.server.port": "8080",
+		"traefik.docker.network":                             "proxy",
+	}, labels)
+
+	// Generated labels must parse back to the same route.
+	routes := ParseTraefikLabels(Container{Labels: labels})
+	require.Len(t, routes, 1)
+	require.Equal(t, []string{"app.example.com"}, routes[0].Hosts)
+	require.Equal(t, "8080", routes[0].Port)
+	require.True(t, routes[0].TLS)
+
+	labels, err = GenerateLabels(ingress.GenerateLabelsRequest{
+		Provider:   ingress.ProviderCaddy,
+		Service:    "app",
+		Domain:     "app.example.com",
+		Port:       3000,
+		PathPrefix: "/api",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "http://app.example.com", labels["caddy"])
+	require.Equal(t, "/api/* {{upstreams 3000}}", labels["caddy.reverse_proxy"])
+
+	require.Equal(t, "labels:\n  - \"caddy=http://app.example.com\"\n  - \"caddy.reverse_proxy=/api/* {{upstreams 3000}}\"\n", ComposeLabels(labels))
+}
+
+func TestGenerateLabelsValidation(t *testing.T) {
+	base := ingress.GenerateLabelsRequest{Provider: ingress.ProviderTraefik, Service: "app", Domain: "app.example.com", Port: 80}
+
+	for name, mutate := range map[string]func(*ingress.GenerateLabelsRequest){
+		"provider":    func(r *ingress.GenerateLabelsRequest) { r.Provider = "nginx" },
+		"service":     func(r *ingress.GenerateLabelsRequest) { r.Service = "my app" },
+		"domain":      func(r *ingress.GenerateLabelsRequest) { r.Domain = "bad`domain" },
+		"port":        func(r *ingress.GenerateLabelsRequest) { r.Port = 70000 },
+		"path prefix": func(r *ingress.GenerateLabelsRequest) { r.PathPrefix = "api" },
+		"entrypoint":  func(r *ingress.GenerateLabelsRequest) { r.EntryPoint = "web`" },
+	} {
+		req := base
+		mutate(&req)
+		_, err := GenerateLabels(req)
+		require.Error(t, err, name)
+	}
+}
@@ -0,0 +1,129 @@
+package registry
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// maxBlobSize bounds how much of a blob is read. Only small artifacts such as
+// signature payloads are fetched through the client.
+const maxBlobSize = 4 << 20
+
+// ErrNotFound is returned when the registry has no manifest or blob for the
+// requested reference.
+var ErrNotFound = errors.New("not found in registry")
+
+// GetManifest fetches the manifest for reference, a tag or digest, and
+// returns its raw content and digest.
+func (c *Client) GetManifest(ctx context.Context, registry, repository, reference, token string) ([]byte, string, error) {
+	u := fmt.Sprintf("%s/v2/%s/manifests/%s", c.GetRegistryURL(registry), repository, reference)
+	body, header, err := c.getArtifactInternal(ctx, u, token, maxManifestSize,
+		ocispec.MediaTypeImageManifest,
+		ocispec.MediaTypeImageIndex,
+		"application/vnd.docker.distribution.manifest.v2+json",
+		mediaTypeDockerManifestList,
+	)
+	if err != nil {
+		return nil, "", err
+	}
+
+	digest := extractDigestFromHeaders(header)
+	if digest == "" {
+		digest = fmt.Sprintf("sha256:%x", sha256.Sum256(body))
+	}
+	return body, digest, nil
+}
+
+// GetBlob fetches a blob and checks its content against digest.
+func (c *Client) GetBlob(ctx context.Context, registry, repository, digest, token string) ([]byte, error) {
+	u := fmt.Sprintf("%s/v2/%s/blobs/%s", c.GetRegistryURL(registry), repository, digest)
+	body, _, err := c.getArtifactInternal(ctx, u, token, maxBlobSize)
+	if err != nil {
+		return nil, err
+	}
+	if got := fmt.Sprintf("sha256:%x", sha256.Sum256(body)); got != digest {
+		return nil, fmt.Errorf("blob digest mismatch: expected %s, got %s", digest, got)
+	}
+	return body, nil
+}
+
+// GetReferrers lists the artifacts of artifactType that refer to digest, using
+// the OCI referrers API. Registries without that API yield no referrers.
+func (c *Client) GetReferrers(ctx context.Context, registry, repository, digest, artifactType, token string) ([]ocispec.Descriptor, error) {
+	u := fmt.Sprintf("%s/v2/%s/referrers/%s", c.GetRegistryURL(registry), repository, digest)
+	if artifactType != "" {
+		u += "?artifactType=" + url.QueryEscape(artifactType)
+	}
+	body, _, err := c.getArtifactInternal(ctx, u, token, maxManifestSize, ocispec.MediaTypeImageIndex)
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var index ocispec.Index
+	if err := json.Unmarshal(body, &index); err != nil {
+		return nil, fmt.Errorf("failed to decode referrers: %w", err)
+	}
+
+	// Registries may ignore the filter, so apply it here as well.
+	referrers := make([]ocispec.Descriptor, 0, len(index.Manifests))
+	for _, desc := range index.Manifests {
+		if artifactType == "" || desc.ArtifactType == artifactType {
+			referrers = append(referrers, desc)
+		}
+	}
+	return referrers, nil
+}
+
+func (c *Client) getArtifactInternal(ctx context.Context, u, token string, limit int64, accept ...string) ([]byte, http.Header, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, a := range accept {
+		req.Header.Add("Accept", a)
+	}
+	req.Header.Set("User-Agent", "Arcane")
+	if ah := buildAuthHeader(token); ah != "" {
+		req.Header.Set("Authorization", ah)
+	}
+
+	resp, err := c.http.Do(req) //nolint:gosec // intentional request to user-configured registry endpoint
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, nil, ErrNotFound
+	case http.StatusUnauthorized:
+		return nil, nil, fmt.Errorf("unauthorized: %s", getHeaderCI(resp.Header, ChallengeHeader))
+	default:
+		return nil, nil, fmt.Errorf("registry request failed with status: %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, limit+1))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	if int64(len(body)) > limit {
+		return nil, nil, fmt.Errorf("response exceeds %d bytes", limit)
+	}
+	return body, resp.Header, nil
+}
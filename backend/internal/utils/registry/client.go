@@ -2,6 +2,8 @@ package registry
 
 import (
 	"net/http"
+
+	httputils "github.com/getarcaneapp/arcane/backend/internal/utils/http"
 )
 
 // Client provides helper methods for Docker/OCI registries.
@@ -11,7 +13,7 @@ type Client struct {
 
 func NewClient() *Client {
 	transport := http.DefaultTransport.(*http.Transport).Clone()
-	transport.Proxy = http.ProxyFromEnvironment
+	transport.Proxy = httputils.ProxyFromSettings
 
 	return &Client{http: &http.Client{Transport: transport}}
 }
@@ -0,0 +1,204 @@
+package registry
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+const (
+	mediaTypeDockerManifestList = "application/vnd.docker.distribution.manifest.list.v2+json"
+	// maxManifestSize bounds how much of a manifest response is read.
+	maxManifestSize = 4 << 20
+)
+
+// ManifestIndex describes what a tag resolves to in a registry. Manifests is
+// empty when the tag points at a single-platform manifest.
+type ManifestIndex struct {
+	Digest    string
+	Manifests []PlatformManifest
+}
+
+// PlatformManifest is one platform-specific entry of a manifest list.
+type PlatformManifest struct {
+	Digest   string
+	Platform ocispec.Platform
+}
+
+// IsIndex reports whether the tag points at a manifest list or OCI index.
+func (m *ManifestIndex) IsIndex() bool {
+	return len(m.Manifests) > 0
+}
+
+// Resolve returns the digest of the manifest matching platform. For a
+// single-platform manifest it returns the tag digest, since the platform can
+// not be told from the manifest alone.
+func (m *ManifestIndex) Resolve(platform ocispec.Platform) (string, bool) {
+	if !m.IsIndex() {
+		return m.Digest, true
+	}
+	want := NormalizePlatform(platform)
+	for _, entry := range m.Manifests {
+		if PlatformMatches(want, NormalizePlatform(entry.Platform)) {
+			return entry.Digest, true
+		}
+	}
+	return "", false
+}
+
+// Platforms lists the platforms provided by the index, skipping attestation
+// entries that carry no real platform.
+func (m *ManifestIndex) Platforms() []string {
+	platforms := make([]string, 0, len(m.Manifests))
+	for _, entry := range m.Manifests {
+		if entry.Platform.OS == "" || entry.Platform.OS == "unknown" {
+			continue
+		}
+		platforms = append(platforms, FormatPlatform(entry.Platform))
+	}
+	return platforms
+}
+
+// GetManifestIndex fetches the manifest repository:tag points at and, for a
+// manifest list or OCI index, the platform-specific entries it contains.
+func (c *Client) GetManifestIndex(ctx context.Context, registry, repository, tag, token string) (*ManifestIndex, error) {
+	url := fmt.Sprintf("%s/v2/%s/manifests/%s", c.GetRegistryURL(registry), repository, tag)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Accept", ocispec.MediaTypeImageIndex)
+	req.Header.Add("Accept", mediaTypeDockerManifestList)
+	req.Header.Add("Accept", "application/vnd.docker.distribution.manifest.v2+json")
+	req.Header.Add("Accept", ocispec.MediaTypeImageManifest)
+	req.Header.Set("User-Agent", "Arcane")
+	if ah := buildAuthHeader(token); ah != "" {
+		req.Header.Set("Authorization", ah)
+	}
+
+	resp, err := c.http.Do(req) //nolint:gosec // intentional request to user-configured registry endpoint
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return nil, fmt.Errorf("unauthorized: %s", getHeaderCI(resp.Header, ChallengeHeader))
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("manifest request failed with status: %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxManifestSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+
+	index := &ManifestIndex{Digest: extractDigestFromHeaders(resp.Header)}
+	if index.Digest == "" {
+		index.Digest = fmt.Sprintf("sha256:%x", sha256.Sum256(body))
+	}
+
+	var manifest struct {
+		Manifests []struct {
+			Digest   string            `json:"digest"`
+			Platform *ocispec.Platform `json:"platform"`
+		} `json:"manifests"`
+	}
+	if err := json.Unmarshal(body, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to decode manifest: %w", err)
+	}
+	for _, entry := range manifest.Manifests {
+		if entry.Platform == nil {
+			continue
+		}
+		index.Manifests = append(index.Manifests, PlatformManifest{Digest: entry.Digest, Platform: *entry.Platform})
+	}
+
+	return index, nil
+}
+
+// ParsePlatform parses an os/arch[/variant] string such as a compose
+// service's platform field.
+func ParsePlatform(value string) (ocispec.Platform, error) {
+	parts := strings.Split(strings.TrimSpace(value), "/")
+	if len(parts) < 2 || len(parts) > 3 {
+		return ocispec.Platform{}, fmt.Errorf("invalid platform: %q", value)
+	}
+
+	platform := ocispec.Platform{
+		OS:           strings.TrimSpace(parts[0]),
+		Architecture: strings.TrimSpace(parts[1]),
+	}
+	if len(parts) == 3 {
+		platform.Variant = strings.TrimSpace(parts[2])
+	}
+	if platform.OS == "" || platform.Architecture == "" {
+		return ocispec.Platform{}, fmt.Errorf("invalid platform: %q", value)
+	}
+	return platform, nil
+}
+
+// FormatPlatform renders platform as os/arch[/variant].
+func FormatPlatform(platform ocispec.Platform) string {
+	s := platform.OS + "/" + platform.Architecture
+	if platform.Variant != "" {
+		s += "/" + platform.Variant
+	}
+	return s
+}
+
+// NormalizePlatform maps the architecture aliases reported by kernels and
+// older images to their OCI names and fills in implied variants.
+func NormalizePlatform(platform ocispec.Platform) ocispec.Platform {
+	platform.OS = strings.ToLower(platform.OS)
+	platform.Architecture = strings.ToLower(platform.Architecture)
+	platform.Variant = strings.ToLower(platform.Variant)
+
+	switch platform.Architecture {
+	case "x86_64", "x86-64":
+		platform.Architecture = "amd64"
+	case "i386", "i686":
+		platform.Architecture = "386"
+	case "aarch64":
+		platform.Architecture = "arm64"
+	case "armhf":
+		platform.Architecture, platform.Variant = "arm", "v7"
+	case "armel":
+		platform.Architecture, platform.Variant = "arm", "v6"
+	}
+
+	switch platform.Architecture {
+	case "arm64":
+		if platform.Variant == "" || platform.Variant == "8" {
+			platform.Variant = "v8"
+		}
+	case "arm":
+		switch platform.Variant {
+		case "":
+			platform.Variant = "v7"
+		case "5", "6", "7", "8":
+			platform.Variant = "v" + platform.Variant
+		}
+	}
+	return platform
+}
+
+// PlatformMatches reports whether an image built for have runs on want. Both
+// platforms must be normalized; a missing variant matches any variant.
+func PlatformMatches(want, have ocispec.Platform) bool {
+	if want.OS != have.OS || want.Architecture != have.Architecture {
+		return false
+	}
+	return want.Variant == "" || have.Variant == "" || want.Variant == have.Variant
+}
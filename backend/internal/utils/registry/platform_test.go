@@ -0,0 +1,104 @@
+package registry
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+func TestGetManifestIndexResolvesPlatform(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Docker-Content-Digest", "sha256:index")
+		w.Header().Set("Content-Type", ocispec.MediaTypeImageIndex)
+		_, _ = w.Write([]byte(`{
+			"mediaType": "application/vnd.oci.image.index.v1+json",
+			"manifests": [
+				{"digest": "sha256:amd64", "platform": {"os": "linux", "architecture": "amd64"}},
+				{"digest": "sha256:arm64", "platform": {"os": "linux", "architecture": "arm64", "variant": "v8"}},
+				{"digest": "sha256:armv7", "platform": {"os": "linux", "architecture": "arm", "variant": "v7"}},
+				{"digest": "sha256:attest", "platform": {"os": "unknown", "architecture": "unknown"}}
+			]
+		}`))
+	}))
+	defer srv.Close()
+
+	index, err := NewClient().GetManifestIndex(context.Background(), srv.URL, "org/repo", "latest", "")
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if index.Digest != "sha256:index" || !index.IsIndex() {
+		t.Fatalf("index %+v", index)
+	}
+
+	tests := []struct {
+		platform ocispec.Platform
+		want     string
+		ok       bool
+	}{
+		{ocispec.Platform{OS: "linux", Architecture: "x86_64"}, "sha256:amd64", true},
+		{ocispec.Platform{OS: "linux", Architecture: "arm64"}, "sha256:arm64", true},
+		{ocispec.Platform{OS: "linux", Architecture: "armhf"}, "sha256:armv7", true},
+		{ocispec.Platform{OS: "linux", Architecture: "arm", Variant: "v6"}, "", false},
+		{ocispec.Platform{OS: "linux", Architecture: "s390x"}, "", false},
+	}
+	for _, tt := range tests {
+		got, ok := index.Resolve(tt.platform)
+		if got != tt.want || ok != tt.ok {
+			t.Fatalf("Resolve(%v) = %q, %v; want %q, %v", tt.platform, got, ok, tt.want, tt.ok)
+		}
+	}
+
+	if got := index.Platforms(); len(got) != 3 {
+		t.Fatalf("platforms %v", got)
+	}
+}
+
+func TestGetManifestIndexSinglePlatform(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"mediaType": "application/vnd.oci.image.manifest.v1+json", "layers": []}`))
+	}))
+	defer srv.Close()
+
+	index, err := NewClient().GetManifestIndex(context.Background(), srv.URL, "org/repo", "latest", "")
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if index.IsIndex() {
+		t.Fatalf("expected a single manifest")
+	}
+	if d, ok := index.Resolve(ocispec.Platform{OS: "linux", Architecture: "riscv64"}); !ok || d != index.Digest || d == "" {
+		t.Fatalf("Resolve = %q, %v", d, ok)
+	}
+}
+
+func TestParsePlatform(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		in      string
+		want    ocispec.Platform
+		wantErr bool
+	}{
+		{in: "linux/amd64", want: ocispec.Platform{OS: "linux", Architecture: "amd64"}},
+		{in: " linux/arm/v7 ", want: ocispec.Platform{OS: "linux", Architecture: "arm", Variant: "v7"}},
+		{in: "linux", wantErr: true},
+		{in: "linux//", wantErr: true},
+		{in: "linux/arm/v7/extra", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParsePlatform(tt.in)
+		if tt.wantErr {
+			if err == nil {
+				t.Fatalf("ParsePlatform(%q): expected error", tt.in)
+			}
+			continue
+		}
+		if err != nil || got.OS != tt.want.OS || got.Architecture != tt.want.Architecture || got.Variant != tt.want.Variant {
+			t.Fatalf("ParsePlatform(%q) = %+v, %v", tt.in, got, err)
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"golang.org/x/mod/semver"
+)
+
+const (
+	// tagsPageSize is the page size requested from the registry.
+	tagsPageSize = 1000
+	// maxTagPages bounds how many pages are followed for a single repository.
+	maxTagPages = 20
+)
+
+// ListTags returns every tag of repository, following the registry's Link
+// pagination. token may be a bare bearer token or a full Authorization value.
+func (c *Client) ListTags(ctx context.Context, registry, repository, token string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, 60*time.Second)
+	defer cancel()
+
+	baseURL := c.GetRegistryURL(registry)
+	next := fmt.Sprintf("%s/v2/%s/tags/list?n=%d", baseURL, repository, tagsPageSize)
+
+	var tags []string
+	for page := 0; next != "" && page < maxTagPages; page++ {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, next, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Accept", "application/json")
+		req.Header.Set("User-Agent", "Arcane")
+		if ah := buildAuthHeader(token); ah != "" {
+			req.Header.Set("Authorization", ah)
+		}
+
+		resp, err := c.http.Do(req) //nolint:gosec // intentional request to user-configured registry endpoint
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.StatusCode == http.StatusUnauthorized {
+			_ = resp.Body.Close()
+			return nil, fmt.Errorf("unauthorized: %s", getHeaderCI(resp.Header, ChallengeHeader))
+		}
+		if resp.StatusCode != http.StatusOK {
+			_ = resp.Body.Close()
+			return nil, fmt.Errorf("tag list request failed with status: %d", resp.StatusCode)
+		}
+
+		var body struct {
+			Tags []string `json:"tags"`
+		}
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		_ = resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode tag list: %w", err)
+		}
+		tags = append(tags, body.Tags...)
+
+		next = nextTagsPageURL(baseURL, getHeaderCI(resp.Header, "Link"))
+	}
+
+	return tags, nil
+}
+
+// nextTagsPageURL extracts the rel="next" target of a Link header, resolved
+// against the registry URL.
+func nextTagsPageURL(baseURL, link string) string {
+	for part := range strings.SplitSeq(link, ",") {
+		target, params, ok := strings.Cut(strings.TrimSpace(part), ";")
+		if !ok || !strings.Contains(strings.ReplaceAll(params, " ", ""), `rel="next"`) {
+			continue
+		}
+		target = strings.Trim(strings.TrimSpace(target), "<>")
+		base, err := url.Parse(baseURL)
+		if err != nil {
+			return ""
+		}
+		resolved, err := base.Parse(target)
+		if err != nil {
+			return ""
+		}
+		return resolved.String()
+	}
+	return ""
+}
+
+// TagSemver returns the canonical semantic version of tag, accepting an
+// optional "v" prefix and shortened versions like "1.2". It returns "" when
+// tag is not a version.
+func TagSemver(tag string) string {
+	v := tag
+	if !strings.HasPrefix(v, "v") {
+		v = "v" + v
+	}
+	if !semver.IsValid(v) {
+		return ""
+	}
+	return semver.Canonical(v)
+}
+
+// CompareTags orders tags by semantic version, with tags that are not
+// versions (e.g. "latest") sorting before all versions and alphabetically
+// among themselves.
+func CompareTags(a, b string) int {
+	va, vb := TagSemver(a), TagSemver(b)
+	switch {
+	case va == "" && vb == "":
+		return strings.Compare(a, b)
+	case va == "":
+		return -1
+	case vb == "":
+		return 1
+	}
+	if c := semver.Compare(va, vb); c != 0 {
+		return c
+	}
+	return strings.Compare(a, b)
+}
+
+// NewerTags returns the tags that are newer versions of current with the same
+// shape, oldest first. A tag has the same shape when it keeps the "v" prefix,
+// the number of version components and any suffix such as "-alpine", so
+// "1.25" only moves to "1.26" and never to "1.26.0-rc1". It returns nil when
+// current is not a version.
+func NewerTags(current string, tags []string) []string {
+	shape, ok := parseTagShapeInternal(current)
+	if !ok {
+		return nil
+	}
+	currentVersion := TagSemver(shape.core)
+
+	var out []string
+	for _, tag := range tags {
+		candidate, ok := parseTagShapeInternal(tag)
+		if !ok || candidate.prefix != shape.prefix || candidate.suffix != shape.suffix || candidate.parts != shape.parts {
+			continue
+		}
+		if semver.Compare(TagSemver(candidate.core), currentVersion) > 0 {
+			out = append(out, tag)
+		}
+	}
+	slices.SortFunc(out, CompareTags)
+	return slices.Compact(out)
+}
+
+type tagShape struct {
+	prefix string
+	core   string
+	suffix string
+	parts  int
+}
+
+// parseTagShapeInternal splits a tag like "v1.2.3-alpine" into its prefix, numeric
+// version core and suffix.
+func parseTagShapeInternal(tag string) (tagShape, bool) {
+	var shape tagShape
+	rest := tag
+	if strings.HasPrefix(rest, "v") {
+		shape.prefix, rest = "v", rest[1:]
+	}
+	shape.core, shape.suffix, _ = strings.Cut(rest, "-")
+	if shape.core == "" {
+		return shape, false
+	}
+	for part := range strings.SplitSeq(shape.core, ".") {
+		if part == "" || strings.Trim(part, "0123456789") != "" {
+			return shape, false
+		}
+		shape.parts++
+	}
+	if shape.parts > 3 || TagSemver(shape.core) == "" {
+		return shape, false
+	}
+	return shape, true
+}
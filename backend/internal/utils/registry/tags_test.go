@@ -0,0 +1,77 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+func TestListTagsFollowsLinkPagination(t *testing.T) {
+	t.Parallel()
+	var auth []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/team/app/tags/list" {
+			http.NotFound(w, r)
+			return
+		}
+		auth = append(auth, r.Header.Get("Authorization"))
+		if r.URL.Query().Get("last") == "" {
+			w.Header().Set("Link", `</v2/team/app/tags/list?last=1.1&n=2>; rel="next"`)
+			_ = json.NewEncoder(w).Encode(map[string]any{"name": "team/app", "tags": []string{"1.0", "1.1"}})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"name": "team/app", "tags": []string{"latest"}})
+	}))
+	defer srv.Close()
+
+	tags, err := NewClient().ListTags(context.Background(), srv.URL, "team/app", "tok")
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+	if want := []string{"1.0", "1.1", "latest"}; !slices.Equal(tags, want) {
+		t.Fatalf("got %v want %v", tags, want)
+	}
+	if len(auth) != 2 || auth[0] != "Bearer tok" || auth[1] != "Bearer tok" {
+		t.Fatalf("unexpected auth headers %v", auth)
+	}
+}
+
+func TestListTagsUnauthorized(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(ChallengeHeader, `Bearer realm="https://auth.example/token"`)
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+	}))
+	defer srv.Close()
+
+	if _, err := NewClient().ListTags(context.Background(), srv.URL, "team/app", ""); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestCompareTags(t *testing.T) {
+	t.Parallel()
+	tags := []string{"1.10.0", "latest", "v1.2", "1.9.1", "1.10.0-rc1", "alpine", "2"}
+	slices.SortFunc(tags, CompareTags)
+	want := []string{"alpine", "latest", "v1.2", "1.9.1", "1.10.0-rc1", "1.10.0", "2"}
+	if !slices.Equal(tags, want) {
+		t.Fatalf("got %v want %v", tags, want)
+	}
+}
+
+func TestNewerTags(t *testing.T) {
+	t.Parallel()
+	tags := []string{"1.24", "1.25", "1.26", "1.27", "1.26.0", "1.26-alpine", "1.27-rc1", "v1.30", "latest", "2.0"}
+	if got, want := NewerTags("1.25", tags), []string{"1.26", "1.27", "2.0"}; !slices.Equal(got, want) {
+		t.Fatalf("got %v want %v", got, want)
+	}
+	if got, want := NewerTags("1.25-alpine", tags), []string{"1.26-alpine"}; !slices.Equal(got, want) {
+		t.Fatalf("got %v want %v", got, want)
+	}
+	if got := NewerTags("latest", tags); got != nil {
+		t.Fatalf("got %v for a tag that is not a version", got)
+	}
+}
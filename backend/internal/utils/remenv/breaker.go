@@ -0,0 +1,140 @@
+package remenv
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/getarcaneapp/arcane/types/environment"
+)
+
+// BreakerConfig controls when a breaker opens and for how long.
+type BreakerConfig struct {
+	// Threshold is the number of consecutive failures that opens the
+	// breaker. Zero or less disables the breaker.
+	Threshold int
+	// Cooldown is how long an open breaker rejects requests before it lets
+	// a single trial request through.
+	Cooldown time.Duration
+}
+
+type breakerEntry struct {
+	failures  int
+	lastError string
+	openedAt  time.Time
+	trial     bool
+}
+
+// IsUnavailableStatus reports whether an agent response means the agent could
+// not be reached at all, as opposed to an error of the request itself. 503 is
+// left out because agents answer it for read-only maintenance mode.
+func IsUnavailableStatus(statusCode int) bool {
+	switch statusCode {
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// Breakers tracks a circuit breaker per remote environment so requests to an
+// unreachable agent fail fast instead of waiting for a timeout each time.
+type Breakers struct {
+	mu      sync.Mutex
+	entries map[string]*breakerEntry
+	config  func() BreakerConfig
+	now     func() time.Time
+}
+
+// NewBreakers creates breakers that read their configuration on every call,
+// so changed settings apply immediately.
+func NewBreakers(config func() BreakerConfig) *Breakers {
+	return &Breakers{
+		entries: make(map[string]*breakerEntry),
+		config:  config,
+		now:     time.Now,
+	}
+}
+
+// Allow reports whether a request to the environment may be sent. An open
+// breaker admits one trial request once its cooldown has passed.
+func (b *Breakers) Allow(envID string) bool {
+	cfg := b.config()
+	if cfg.Threshold <= 0 {
+		return true
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	e, ok := b.entries[envID]
+	if !ok || e.failures < cfg.Threshold {
+		return true
+	}
+	if b.now().Before(e.openedAt.Add(cfg.Cooldown)) || e.trial {
+		return false
+	}
+	e.trial = true
+	return true
+}
+
+// Success closes the breaker of the environment.
+func (b *Breakers) Success(envID string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.entries, envID)
+}
+
+// Failure records a failed request. Reaching the threshold, or failing the
+// trial request of an open breaker, (re)opens the breaker.
+func (b *Breakers) Failure(envID string, err error) {
+	cfg := b.config()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	e, ok := b.entries[envID]
+	if !ok {
+		e = &breakerEntry{}
+		b.entries[envID] = e
+	}
+	e.failures++
+	e.trial = false
+	if err != nil {
+		e.lastError = err.Error()
+	}
+	if cfg.Threshold > 0 && e.failures >= cfg.Threshold {
+		e.openedAt = b.now()
+	}
+}
+
+// State returns the breaker state of the environment for API responses.
+func (b *Breakers) State(envID string) environment.CircuitBreaker {
+	cfg := b.config()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	e, ok := b.entries[envID]
+	if !ok {
+		return environment.CircuitBreaker{State: environment.BreakerClosed}
+	}
+
+	state := environment.CircuitBreaker{
+		State:               environment.BreakerClosed,
+		ConsecutiveFailures: e.failures,
+		LastError:           e.lastError,
+	}
+	if cfg.Threshold <= 0 || e.failures < cfg.Threshold {
+		return state
+	}
+
+	openedAt := e.openedAt
+	retryAt := openedAt.Add(cfg.Cooldown)
+	state.OpenedAt = &openedAt
+	state.RetryAt = &retryAt
+	state.State = environment.BreakerOpen
+	if !b.now().Before(retryAt) {
+		state.State = environment.BreakerHalfOpen
+	}
+	return state
+}
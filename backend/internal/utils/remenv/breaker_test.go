@@ -0,0 +1,79 @@
+package remenv
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/getarcaneapp/arcane/types/environment"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestBreakers(threshold int, cooldown time.Duration) (*Breakers, *time.Time) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	b := NewBreakers(func() BreakerConfig {
+		return BreakerConfig{Threshold: threshold, Cooldown: cooldown}
+	})
+	b.now = func() time.Time { return now }
+	return b, &now
+}
+
+func TestBreakers_OpensAfterThreshold(t *testing.T) {
+	b, _ := newTestBreakers(3, time.Minute)
+	failure := errors.New("connection refused")
+
+	for range 2 {
+		b.Failure("env-1", failure)
+		require.True(t, b.Allow("env-1"))
+	}
+	require.Equal(t, environment.BreakerClosed, b.State("env-1").State)
+
+	b.Failure("env-1", failure)
+	require.False(t, b.Allow("env-1"))
+	require.True(t, b.Allow("env-2"), "breakers are per environment")
+
+	state := b.State("env-1")
+	require.Equal(t, environment.BreakerOpen, state.State)
+	require.Equal(t, 3, state.ConsecutiveFailures)
+	require.Equal(t, "connection refused", state.LastError)
+	require.NotNil(t, state.RetryAt)
+}
+
+func TestBreakers_HalfOpenAdmitsSingleTrial(t *testing.T) {
+	b, now := newTestBreakers(1, time.Minute)
+	b.Failure("env-1", errors.New("timeout"))
+	require.False(t, b.Allow("env-1"))
+
+	*now = now.Add(time.Minute)
+	require.Equal(t, environment.BreakerHalfOpen, b.State("env-1").State)
+	require.True(t, b.Allow("env-1"))
+	require.False(t, b.Allow("env-1"), "only one trial request while half-open")
+
+	b.Failure("env-1", errors.New("timeout"))
+	require.False(t, b.Allow("env-1"), "a failed trial reopens the breaker")
+	require.Equal(t, environment.BreakerOpen, b.State("env-1").State)
+
+	*now = now.Add(time.Minute)
+	require.True(t, b.Allow("env-1"))
+	b.Success("env-1")
+	require.True(t, b.Allow("env-1"))
+	require.Equal(t, environment.CircuitBreaker{State: environment.BreakerClosed}, b.State("env-1"))
+}
+
+func TestBreakers_DisabledWithoutThreshold(t *testing.T) {
+	b, _ := newTestBreakers(0, time.Minute)
+	for range 10 {
+		b.Failure("env-1", errors.New("timeout"))
+	}
+	require.True(t, b.Allow("env-1"))
+	require.Equal(t, environment.BreakerClosed, b.State("env-1").State)
+}
+
+func TestIsUnavailableStatus(t *testing.T) {
+	require.True(t, IsUnavailableStatus(http.StatusBadGateway))
+	require.True(t, IsUnavailableStatus(http.StatusGatewayTimeout))
+	require.False(t, IsUnavailableStatus(http.StatusServiceUnavailable))
+	require.False(t, IsUnavailableStatus(http.StatusInternalServerError))
+	require.False(t, IsUnavailableStatus(http.StatusNotFound))
+}
@@ -0,0 +1,116 @@
+package remenv
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/getarcaneapp/arcane/types/environment"
+)
+
+// featurePrefixes maps each feature to the resource paths it covers, relative
+// to /api/environments/{id}.
+var featurePrefixes = map[string][]string{
+	environment.FeatureContainers:          {"/containers", "/ws/containers"},
+	environment.FeatureImages:              {"/images"},
+	environment.FeatureImageUpdates:        {"/image-updates"},
+	environment.FeatureVolumes:             {"/volumes"},
+	environment.FeatureNetworks:            {"/networks"},
+	environment.FeatureProjects:            {"/projects", "/ws/projects"},
+	environment.FeatureBuilds:              {"/builds"},
+	environment.FeatureGitOps:              {"/gitops-syncs"},
+	environment.FeatureVulnerabilities:     {"/vulnerabilities"},
+	environment.FeatureUpdater:             {"/updater"},
+	environment.FeatureSystem:              {"/system", "/ws/system"},
+	environment.FeatureDashboard:           {"/dashboard"},
+	environment.FeatureNotifications:       {"/notifications"},
+	environment.FeatureSettings:            {"/settings"},
+	environment.FeatureJobs:                {"/jobs", "/job-schedules"},
+	environment.FeatureContainerTasks:      {"/container-tasks"},
+	environment.FeatureContainerPreview:    {"/containers/preview"},
+	environment.FeatureDashboardWidgets:    {"/dashboard/widgets"},
+	environment.FeatureHostMetrics:         {"/host-metrics", "/system/host-metrics"},
+	environment.FeatureImageTags:           {"/image-updates/tags"},
+	environment.FeatureImagePulls:          {"/images/pulls"},
+	environment.FeatureIngress:             {"/ingress"},
+	environment.FeatureProjectAdoption:     {"/projects/adopt"},
+	environment.FeatureCustomFileTemplates: {"/projects/custom-file-templates"},
+	environment.FeaturePathMapping:         {"/projects/path-mapping"},
+	environment.FeatureSecurityAudit:       {"/security/audit"},
+	environment.FeatureSettingsDryApply:    {"/settings/dry-apply"},
+	environment.FeatureSnapshots:           {"/snapshots"},
+	environment.FeatureDaemonConfig:        {"/system/daemon-config"},
+	environment.FeatureTags:                {"/tags", "/tag-keys"},
+	environment.FeatureTopology:            {"/topology"},
+	environment.FeatureUpdateRuns:          {"/updater/runs"},
+	environment.FeatureLogAlerts:           {"/log-alerts"},
+	environment.FeatureStaleResources:      {"/stale-resources"},
+	environment.FeatureContainerImport:     {"/containers/import"},
+}
+
+// legacyFeatures are served by every agent, including those that predate
+// capability negotiation and do not advertise anything.
+var legacyFeatures = []string{
+	environment.FeatureContainers,
+	environment.FeatureImages,
+	environment.FeatureImageUpdates,
+	environment.FeatureVolumes,
+	environment.FeatureNetworks,
+	environment.FeatureProjects,
+	environment.FeatureBuilds,
+	environment.FeatureGitOps,
+	environment.FeatureVulnerabilities,
+	environment.FeatureUpdater,
+	environment.FeatureSystem,
+	environment.FeatureDashboard,
+	environment.FeatureNotifications,
+	environment.FeatureSettings,
+	environment.FeatureJobs,
+}
+
+// Features returns every feature this build serves, sorted by name.
+func Features() []string {
+	features := make([]string, 0, len(featurePrefixes))
+	for feature := range featurePrefixes {
+		features = append(features, feature)
+	}
+	slices.Sort(features)
+	return features
+}
+
+// LegacyFeatures returns the features assumed for agents that do not
+// advertise capabilities.
+func LegacyFeatures() []string {
+	return slices.Clone(legacyFeatures)
+}
+
+// FeatureForPath returns the feature serving a resource path such as
+// /projects/adopt. The most specific prefix wins. Paths that belong to no
+// feature return an empty string.
+func FeatureForPath(resourcePath string) string {
+	feature, longest := "", 0
+	for name, prefixes := range featurePrefixes {
+		for _, prefix := range prefixes {
+			if len(prefix) <= longest {
+				continue
+			}
+			if resourcePath == prefix || strings.HasPrefix(resourcePath, prefix+"/") {
+				feature, longest = name, len(prefix)
+			}
+		}
+	}
+	return feature
+}
+
+// SupportsPath reports whether an agent with the given capabilities serves a
+// resource path. Unknown capabilities and paths outside any feature are
+// allowed, so the agent decides.
+func SupportsPath(capabilities *environment.Capabilities, resourcePath string) (bool, string) {
+	if capabilities == nil {
+		return true, ""
+	}
+	feature := FeatureForPath(resourcePath)
+	if feature == "" {
+		return true, ""
+	}
+	return slices.Contains(capabilities.Features, feature), feature
+}
@@ -0,0 +1,56 @@
+package remenv
+
+import (
+	"testing"
+
+	"github.com/getarcaneapp/arcane/types/environment"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeatureForPath(t *testing.T) {
+	cases := map[string]string{
+		"/containers":                     environment.FeatureContainers,
+		"/containers/abc/start":           environment.FeatureContainers,
+		"/containers/preview":             environment.FeatureContainerPreview,
+		"/containers-extra":               "",
+		"/ws/containers/abc/logs":         environment.FeatureContainers,
+		"/projects/abc/up":                environment.FeatureProjects,
+		"/projects/adopt":                 environment.FeatureProjectAdoption,
+		"/projects/path-mapping":          environment.FeaturePathMapping,
+		"/system/host-metrics":            environment.FeatureHostMetrics,
+		"/system/docker/info":             environment.FeatureSystem,
+		"/updater/runs/1":                 environment.FeatureUpdateRuns,
+		"/tag-keys":                       environment.FeatureTags,
+		"/unknown":                        "",
+		"/projects/custom-file-templates": environment.FeatureCustomFileTemplates,
+	}
+	for resourcePath, want := range cases {
+		require.Equal(t, want, FeatureForPath(resourcePath), resourcePath)
+	}
+}
+
+func TestSupportsPath(t *testing.T) {
+	ok, _ := SupportsPath(nil, "/snapshots")
+	require.True(t, ok, "unknown capabilities are allowed")
+
+	legacy := &environment.Capabilities{Version: "1.0.0", Features: LegacyFeatures(), Legacy: true}
+	ok, _ = SupportsPath(legacy, "/containers/abc")
+	require.True(t, ok)
+
+	ok, feature := SupportsPath(legacy, "/snapshots/abc")
+	require.False(t, ok)
+	require.Equal(t, environment.FeatureSnapshots, feature)
+
+	ok, _ = SupportsPath(legacy, "/unknown")
+	require.True(t, ok, "paths outside any feature are left to the agent")
+
+	current := &environment.Capabilities{Features: Features()}
+	ok, _ = SupportsPath(current, "/snapshots/abc")
+	require.True(t, ok)
+}
+
+func TestLegacyFeaturesAreKnown(t *testing.T) {
+	for _, feature := range LegacyFeatures() {
+		require.Contains(t, Features(), feature)
+	}
+}
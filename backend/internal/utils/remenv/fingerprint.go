@@ -0,0 +1,14 @@
+package remenv
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+)
+
+// Fingerprint derives the fingerprint an agent presents when it requests
+// pairing from a stable identity of its host. It uses the "SHA256:<base64>"
+// form ssh-keygen prints, so admins can compare it with the agent log.
+func Fingerprint(identity string) string {
+	sum := sha256.Sum256([]byte(identity))
+	return "SHA256:" + base64.RawStdEncoding.EncodeToString(sum[:])
+}
@@ -0,0 +1,16 @@
+package remenv
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFingerprint(t *testing.T) {
+	fp := Fingerprint("4c3b2a19-docker-engine-id")
+	require.True(t, strings.HasPrefix(fp, "SHA256:"))
+	require.Len(t, fp, len("SHA256:")+43)
+	require.Equal(t, fp, Fingerprint("4c3b2a19-docker-engine-id"))
+	require.NotEqual(t, fp, Fingerprint("another-host"))
+}
@@ -0,0 +1,149 @@
+package remenv
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/getarcaneapp/arcane/types/environment"
+)
+
+// SyncTracker records the progress of syncing shared configuration to each
+// remote environment, so users can tell whether an environment received it.
+type SyncTracker struct {
+	mu       sync.Mutex
+	statuses map[string]*environment.ConfigSyncStatus
+	now      func() time.Time
+}
+
+// NewSyncTracker creates an empty tracker.
+func NewSyncTracker() *SyncTracker {
+	return &SyncTracker{
+		statuses: make(map[string]*environment.ConfigSyncStatus),
+		now:      time.Now,
+	}
+}
+
+// Begin starts a run of the given targets. When a run is already in
+// progress it returns that run and false instead.
+func (t *SyncTracker) Begin(envID, trigger string, targets []string) (environment.ConfigSyncStatus, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	prev, ok := t.statuses[envID]
+	if ok && prev.State == environment.SyncRunning {
+		return cloneSyncStatus(prev), false
+	}
+
+	status := &environment.ConfigSyncStatus{
+		EnvironmentID: envID,
+		State:         environment.SyncRunning,
+		Trigger:       trigger,
+		StartedAt:     t.now(),
+		Total:         len(targets),
+		Targets:       make([]environment.SyncTarget, 0, len(targets)),
+	}
+	for _, target := range targets {
+		entry := environment.SyncTarget{Target: target, State: environment.SyncPending}
+		if ok {
+			if i := slices.IndexFunc(prev.Targets, func(p environment.SyncTarget) bool { return p.Target == target }); i >= 0 {
+				entry.LastSuccessAt = prev.Targets[i].LastSuccessAt
+			}
+		}
+		status.Targets = append(status.Targets, entry)
+	}
+	t.statuses[envID] = status
+	return cloneSyncStatus(status), true
+}
+
+// Attempt records that a target is being tried (again).
+func (t *SyncTracker) Attempt(envID, target string) {
+	t.updateTargetInternal(envID, target, func(_ *environment.ConfigSyncStatus, entry *environment.SyncTarget) {
+		entry.State = environment.SyncRunning
+		entry.Attempts++
+	})
+}
+
+// Finish records the final outcome of a target. The run ends once every
+// target finished and fails if any of them did.
+func (t *SyncTracker) Finish(envID, target string, err error) {
+	t.updateTargetInternal(envID, target, func(status *environment.ConfigSyncStatus, entry *environment.SyncTarget) {
+		now := t.now()
+		entry.FinishedAt = &now
+		if err != nil {
+			entry.State = environment.SyncFailed
+			entry.Error = err.Error()
+		} else {
+			entry.State = environment.SyncSucceeded
+			entry.Error = ""
+			entry.LastSuccessAt = &now
+		}
+
+		status.Completed++
+		if status.Completed < status.Total {
+			return
+		}
+		status.FinishedAt = &now
+		status.State = environment.SyncSucceeded
+		if slices.ContainsFunc(status.Targets, func(e environment.SyncTarget) bool { return e.State == environment.SyncFailed }) {
+			status.State = environment.SyncFailed
+		}
+	})
+}
+
+// Status returns the latest run of the environment.
+func (t *SyncTracker) Status(envID string) (environment.ConfigSyncStatus, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	status, ok := t.statuses[envID]
+	if !ok {
+		return environment.ConfigSyncStatus{}, false
+	}
+	return cloneSyncStatus(status), true
+}
+
+// List returns the latest run of every environment, ordered by environment ID.
+func (t *SyncTracker) List() []environment.ConfigSyncStatus {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	out := make([]environment.ConfigSyncStatus, 0, len(t.statuses))
+	for _, status := range t.statuses {
+		out = append(out, cloneSyncStatus(status))
+	}
+	slices.SortFunc(out, func(a, b environment.ConfigSyncStatus) int {
+		return strings.Compare(a.EnvironmentID, b.EnvironmentID)
+	})
+	return out
+}
+
+// Forget drops the runs of a deleted environment.
+func (t *SyncTracker) Forget(envID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.statuses, envID)
+}
+
+func (t *SyncTracker) updateTargetInternal(envID, target string, update func(*environment.ConfigSyncStatus, *environment.SyncTarget)) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	status, ok := t.statuses[envID]
+	if !ok || status.State != environment.SyncRunning {
+		return
+	}
+	for i := range status.Targets {
+		if status.Targets[i].Target == target {
+			update(status, &status.Targets[i])
+			return
+		}
+	}
+}
+
+func cloneSyncStatus(status *environment.ConfigSyncStatus) environment.ConfigSyncStatus {
+	out := *status
+	out.Targets = slices.Clone(status.Targets)
+	return out
+}
@@ -0,0 +1,94 @@
+package remenv
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/getarcaneapp/arcane/types/environment"
+	"github.com/stretchr/testify/require"
+)
+
+var testSyncTargets = []string{environment.SyncTargetRegistries, environment.SyncTargetRepositories}
+
+func newTestSyncTracker() (*SyncTracker, *time.Time) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	t := NewSyncTracker()
+	t.now = func() time.Time { return now }
+	return t, &now
+}
+
+func TestSyncTracker_RunSucceeds(t *testing.T) {
+	tracker, _ := newTestSyncTracker()
+
+	status, started := tracker.Begin("env-1", "manual sync", testSyncTargets)
+	require.True(t, started)
+	require.Equal(t, environment.SyncRunning, status.State)
+	require.Equal(t, 2, status.Total)
+
+	_, started = tracker.Begin("env-1", "health check", testSyncTargets)
+	require.False(t, started, "a running sync is not started twice")
+
+	tracker.Attempt("env-1", environment.SyncTargetRegistries)
+	tracker.Finish("env-1", environment.SyncTargetRegistries, nil)
+
+	status, ok := tracker.Status("env-1")
+	require.True(t, ok)
+	require.Equal(t, environment.SyncRunning, status.State)
+	require.Equal(t, 1, status.Completed)
+	require.Equal(t, environment.SyncSucceeded, status.Targets[0].State)
+	require.Equal(t, environment.SyncPending, status.Targets[1].State)
+
+	tracker.Attempt("env-1", environment.SyncTargetRepositories)
+	tracker.Finish("env-1", environment.SyncTargetRepositories, nil)
+
+	status, _ = tracker.Status("env-1")
+	require.Equal(t, environment.SyncSucceeded, status.State)
+	require.Equal(t, "manual sync", status.Trigger)
+	require.NotNil(t, status.FinishedAt)
+}
+
+func TestSyncTracker_FailureKeepsLastSuccess(t *testing.T) {
+	tracker, now := newTestSyncTracker()
+	firstSuccess := *now
+
+	tracker.Begin("env-1", "environment creation", testSyncTargets)
+	for _, target := range testSyncTargets {
+		tracker.Attempt("env-1", target)
+		tracker.Finish("env-1", target, nil)
+	}
+
+	*now = now.Add(time.Hour)
+	tracker.Begin("env-1", "manual sync", testSyncTargets)
+	tracker.Attempt("env-1", environment.SyncTargetRegistries)
+	tracker.Finish("env-1", environment.SyncTargetRegistries, nil)
+	for range 3 {
+		tracker.Attempt("env-1", environment.SyncTargetRepositories)
+	}
+	tracker.Finish("env-1", environment.SyncTargetRepositories, errors.New("connection refused"))
+
+	status, _ := tracker.Status("env-1")
+	require.Equal(t, environment.SyncFailed, status.State)
+
+	repos := status.Targets[1]
+	require.Equal(t, environment.SyncFailed, repos.State)
+	require.Equal(t, 3, repos.Attempts)
+	require.Equal(t, "connection refused", repos.Error)
+	require.Equal(t, firstSuccess, *repos.LastSuccessAt)
+	require.Equal(t, *now, *status.Targets[0].LastSuccessAt)
+}
+
+func TestSyncTracker_ListAndForget(t *testing.T) {
+	tracker, _ := newTestSyncTracker()
+	tracker.Begin("env-2", "health check", testSyncTargets)
+	tracker.Begin("env-1", "health check", testSyncTargets)
+
+	list := tracker.List()
+	require.Len(t, list, 2)
+	require.Equal(t, "env-1", list[0].EnvironmentID)
+
+	tracker.Forget("env-1")
+	_, ok := tracker.Status("env-1")
+	require.False(t, ok)
+	require.Len(t, tracker.List(), 1)
+}
@@ -0,0 +1,141 @@
+package siem
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	ProtocolSyslogUDP = "syslog-udp"
+	ProtocolSyslogTCP = "syslog-tcp"
+	ProtocolHTTP      = "http"
+
+	defaultAppName = "arcane"
+	dialTimeout    = 5 * time.Second
+)
+
+// Forwarder ships a single serialized audit record to an external collector.
+type Forwarder interface {
+	Forward(ctx context.Context, record []byte, severity string) error
+}
+
+// NewForwarder builds a forwarder for the configured protocol and target.
+// Syslog targets are host:port; HTTP targets are absolute http(s) URLs.
+func NewForwarder(protocol, target, token string, httpClient *http.Client) (Forwarder, error) {
+	target = strings.TrimSpace(target)
+	if target == "" {
+		return nil, fmt.Errorf("forwarding target is required")
+	}
+
+	switch strings.ToLower(strings.TrimSpace(protocol)) {
+	case ProtocolSyslogUDP, "":
+		return &SyslogForwarder{network: "udp", address: target, appName: defaultAppName}, nil
+	case ProtocolSyslogTCP:
+		return &SyslogForwarder{network: "tcp", address: target, appName: defaultAppName}, nil
+	case ProtocolHTTP:
+		u, err := url.Parse(target)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("invalid HTTP forwarding URL: %q", target)
+		}
+		if httpClient == nil {
+			httpClient = &http.Client{Timeout: 10 * time.Second}
+		}
+		return &HTTPForwarder{client: httpClient, url: u.String(), token: token}, nil
+	default:
+		return nil, fmt.Errorf("unsupported forwarding protocol: %q", protocol)
+	}
+}
+
+// SyslogForwarder writes RFC 5424 messages to a remote syslog collector.
+type SyslogForwarder struct {
+	network string
+	address string
+	appName string
+}
+
+func (f *SyslogForwarder) Forward(ctx context.Context, record []byte, severity string) error {
+	dialer := net.Dialer{Timeout: dialTimeout}
+	conn, err := dialer.DialContext(ctx, f.network, f.address)
+	if err != nil {
+		return fmt.Errorf("failed to connect to syslog collector: %w", err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetWriteDeadline(deadline)
+	}
+
+	msg := FormatSyslogMessage(f.appName, severity, time.Now(), record)
+	if f.network == "tcp" {
+		// RFC 6587 octet counting framing
+		msg = fmt.Appendf(nil, "%d %s", len(msg), msg)
+	}
+
+	if _, err := conn.Write(msg); err != nil {
+		return fmt.Errorf("failed to write syslog message: %w", err)
+	}
+	return nil
+}
+
+// FormatSyslogMessage renders an RFC 5424 message using the local0 facility.
+func FormatSyslogMessage(appName, severity string, ts time.Time, record []byte) []byte {
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "-"
+	}
+	const facilityLocal0 = 16
+	pri := facilityLocal0*8 + syslogSeverity(severity)
+	return fmt.Appendf(nil, "<%d>1 %s %s %s - audit - %s", pri, ts.UTC().Format(time.RFC3339Nano), hostname, appName, record)
+}
+
+func syslogSeverity(severity string) int {
+	switch strings.ToLower(severity) {
+	case "error":
+		return 3
+	case "warning":
+		return 4
+	case "success":
+		return 5
+	default:
+		return 6
+	}
+}
+
+// HTTPForwarder POSTs each record as JSON to a collector endpoint (e.g. a
+// Splunk HEC, Logstash HTTP input or a generic webhook).
+type HTTPForwarder struct {
+	client *http.Client
+	url    string
+	token  string
+}
+
+func (f *HTTPForwarder) Forward(ctx context.Context, record []byte, _ string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, f.url, bytes.NewReader(record))
+	if err != nil {
+		return fmt.Errorf("failed to create forwarding request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if f.token != "" {
+		req.Header.Set("Authorization", "Bearer "+f.token)
+	}
+
+	resp, err := f.client.Do(req) //nolint:gosec // URL is validated in NewForwarder
+	if err != nil {
+		return fmt.Errorf("failed to forward record: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("collector returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
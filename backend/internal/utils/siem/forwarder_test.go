@@ -0,0 +1,66 @@
+package siem
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatSyslogMessage(t *testing.T) {
+	ts := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	msg := string(FormatSyslogMessage("arcane", "error", ts, []byte(`{"type":"user.login"}`)))
+
+	require.True(t, strings.HasPrefix(msg, "<131>1 2025-01-02T03:04:05Z "), msg)
+	require.True(t, strings.HasSuffix(msg, ` arcane - audit - {"type":"user.login"}`), msg)
+}
+
+func TestNewForwarder_Validation(t *testing.T) {
+	_, err := NewForwarder(ProtocolHTTP, "ftp://collector", "", nil)
+	require.Error(t, err)
+
+	_, err = NewForwarder("carrier-pigeon", "collector:514", "", nil)
+	require.Error(t, err)
+
+	_, err = NewForwarder(ProtocolSyslogUDP, " ", "", nil)
+	require.Error(t, err)
+}
+
+func TestHTTPForwarder_Forward(t *testing.T) {
+	var gotAuth, gotBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		body, _ := io.ReadAll(r.Body)
+		gotBody = string(body)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	f, err := NewForwarder(ProtocolHTTP, server.URL, "secret", server.Client())
+	require.NoError(t, err)
+	require.NoError(t, f.Forward(context.Background(), []byte(`{"a":1}`), "info"))
+	require.Equal(t, "Bearer secret", gotAuth)
+	require.Equal(t, `{"a":1}`, gotBody)
+}
+
+func TestSyslogForwarder_ForwardUDP(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer func() { _ = conn.Close() }()
+
+	f, err := NewForwarder(ProtocolSyslogUDP, conn.LocalAddr().String(), "", nil)
+	require.NoError(t, err)
+	require.NoError(t, f.Forward(context.Background(), []byte("hello"), "info"))
+
+	buf := make([]byte, 1024)
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(2*time.Second)))
+	n, _, err := conn.ReadFrom(buf)
+	require.NoError(t, err)
+	require.Contains(t, string(buf[:n]), "audit - hello")
+}
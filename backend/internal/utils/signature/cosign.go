@@ -0,0 +1,205 @@
+package signature
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/getarcaneapp/arcane/backend/internal/utils/registry"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+const (
+	cosignSignatureAnnotation   = "dev.cosignproject.cosign/signature"
+	cosignCertificateAnnotation = "dev.sigstore.cosign/certificate"
+	cosignChainAnnotation       = "dev.sigstore.cosign/chain"
+	cosignBundleAnnotation      = "dev.sigstore.cosign/bundle"
+)
+
+var (
+	// oidFulcioIssuerV1 holds the OIDC issuer as raw bytes.
+	oidFulcioIssuerV1 = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 1}
+	// oidFulcioIssuerV2 holds the OIDC issuer as a DER UTF8String.
+	oidFulcioIssuerV2 = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 8}
+)
+
+// cosignPayload is the simple signing payload cosign signs.
+type cosignPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+// verifyCosign checks the signatures cosign stores under the
+// sha256-<digest>.sig tag of the repository.
+func (v *Verifier) verifyCosign(ctx context.Context, registryHost, repository, digest, token string, policy *Policy) error {
+	tag := strings.Replace(digest, ":", "-", 1) + ".sig"
+	body, _, err := v.registryClient.GetManifest(ctx, registryHost, repository, tag, token)
+	if errors.Is(err, registry.ErrNotFound) {
+		return ErrUnsigned
+	}
+	if err != nil {
+		return fmt.Errorf("failed to fetch cosign signatures: %w", err)
+	}
+
+	var manifest ocispec.Manifest
+	if err := json.Unmarshal(body, &manifest); err != nil {
+		return fmt.Errorf("failed to decode cosign signature manifest: %w", err)
+	}
+	if len(manifest.Layers) == 0 {
+		return ErrUnsigned
+	}
+
+	var reasons []error
+	for _, layer := range manifest.Layers {
+		payload, err := v.registryClient.GetBlob(ctx, registryHost, repository, layer.Digest.String(), token)
+		if err != nil {
+			return fmt.Errorf("failed to fetch cosign signature payload: %w", err)
+		}
+		if err := verifyCosignLayer(layer.Annotations, payload, digest, policy); err != nil {
+			reasons = append(reasons, err)
+			continue
+		}
+		return nil
+	}
+	return invalidSignatures(reasons)
+}
+
+// verifyCosignLayer checks one signature layer against the policy.
+func verifyCosignLayer(annotations map[string]string, payload []byte, digest string, policy *Policy) error {
+	sig, err := base64.StdEncoding.DecodeString(annotations[cosignSignatureAnnotation])
+	if err != nil || len(sig) == 0 {
+		return errors.New("missing or malformed signature annotation")
+	}
+
+	var p cosignPayload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return fmt.Errorf("malformed signature payload: %w", err)
+	}
+	if p.Critical.Image.DockerManifestDigest != digest {
+		return fmt.Errorf("signature is for %s", p.Critical.Image.DockerManifestDigest)
+	}
+
+	if policy.Mode == ModeCosignKey {
+		for _, key := range policy.PublicKeys {
+			if verifyDigestSignature(key, payload, sig) == nil {
+				return nil
+			}
+		}
+		return errors.New("signature does not match any trusted key")
+	}
+
+	cert, err := verifyFulcioCertificate(annotations, policy)
+	if err != nil {
+		return err
+	}
+	return verifyDigestSignature(cert.PublicKey, payload, sig)
+}
+
+// verifyFulcioCertificate checks the short-lived signing certificate of a
+// keyless signature. The certificate only has to be valid when the signature
+// entered the transparency log, so that time is taken from the bundle.
+func verifyFulcioCertificate(annotations map[string]string, policy *Policy) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(annotations[cosignCertificateAnnotation]))
+	if block == nil {
+		return nil, errors.New("keyless signature has no certificate")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signing certificate: %w", err)
+	}
+
+	intermediates := x509.NewCertPool()
+	rest := []byte(annotations[cosignChainAnnotation])
+	for {
+		var b *pem.Block
+		b, rest = pem.Decode(rest)
+		if b == nil {
+			break
+		}
+		if c, err := x509.ParseCertificate(b.Bytes); err == nil {
+			intermediates.AddCert(c)
+		}
+	}
+
+	signedAt, err := bundleIntegratedTime(annotations[cosignBundleAnnotation])
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := cert.Verify(x509.VerifyOptions{
+		Roots:         policy.Roots,
+		Intermediates: intermediates,
+		CurrentTime:   signedAt,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	}); err != nil {
+		return nil, fmt.Errorf("untrusted signing certificate: %w", err)
+	}
+
+	if policy.Identity != nil && !certificateIdentityMatches(cert, policy) {
+		return nil, errors.New("signing certificate identity does not match policy")
+	}
+	if policy.Issuer != "" {
+		if issuer := fulcioIssuer(cert); issuer != policy.Issuer {
+			return nil, fmt.Errorf("signing certificate issuer %q does not match policy", issuer)
+		}
+	}
+	return cert, nil
+}
+
+func certificateIdentityMatches(cert *x509.Certificate, policy *Policy) bool {
+	for _, email := range cert.EmailAddresses {
+		if policy.Identity.MatchString(email) {
+			return true
+		}
+	}
+	for _, uri := range cert.URIs {
+		if policy.Identity.MatchString(uri.String()) {
+			return true
+		}
+	}
+	return false
+}
+
+func fulcioIssuer(cert *x509.Certificate) string {
+	var legacy string
+	for _, ext := range cert.Extensions {
+		switch {
+		case ext.Id.Equal(oidFulcioIssuerV2):
+			var issuer string
+			if _, err := asn1.UnmarshalWithParams(ext.Value, &issuer, "utf8"); err == nil {
+				return issuer
+			}
+		case ext.Id.Equal(oidFulcioIssuerV1):
+			legacy = string(ext.Value)
+		}
+	}
+	return legacy
+}
+
+// bundleIntegratedTime reads the transparency log time from a cosign bundle.
+// The log's signed entry timestamp is not checked; trust in the certificate
+// comes from the configured roots.
+func bundleIntegratedTime(bundle string) (time.Time, error) {
+	if bundle == "" {
+		return time.Time{}, errors.New("keyless signature has no transparency log bundle")
+	}
+	var b struct {
+		Payload struct {
+			IntegratedTime int64 `json:"integratedTime"`
+		} `json:"Payload"`
+	}
+	if err := json.Unmarshal([]byte(bundle), &b); err != nil || b.Payload.IntegratedTime == 0 {
+		return time.Time{}, errors.New("malformed transparency log bundle")
+	}
+	return time.Unix(b.Payload.IntegratedTime, 0), nil
+}
@@ -0,0 +1,211 @@
+package signature
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+const (
+	notationArtifactType = "application/vnd.cncf.notary.signature"
+	notationJWSMediaType = "application/jose+json"
+)
+
+// notationJWS is the flattened JSON serialization of a Notation JWS envelope.
+type notationJWS struct {
+	Payload   string `json:"payload"`
+	Protected string `json:"protected"`
+	Header    struct {
+		X5C []string `json:"x5c"`
+	} `json:"header"`
+	Signature string `json:"signature"`
+}
+
+type notationProtectedHeader struct {
+	Alg         string     `json:"alg"`
+	SigningTime *time.Time `json:"io.cncf.notary.signingTime"`
+	Expiry      *time.Time `json:"io.cncf.notary.expiry"`
+}
+
+type notationPayload struct {
+	TargetArtifact struct {
+		Digest string `json:"digest"`
+	} `json:"targetArtifact"`
+}
+
+// verifyNotation checks the Notation signatures attached to digest through the
+// OCI referrers API. Only JWS envelopes are supported.
+func (v *Verifier) verifyNotation(ctx context.Context, registryHost, repository, digest, token string, policy *Policy) error {
+	referrers, err := v.registryClient.GetReferrers(ctx, registryHost, repository, digest, notationArtifactType, token)
+	if err != nil {
+		return fmt.Errorf("failed to list notation signatures: %w", err)
+	}
+	if len(referrers) == 0 {
+		return ErrUnsigned
+	}
+
+	var reasons []error
+	for _, desc := range referrers {
+		body, _, err := v.registryClient.GetManifest(ctx, registryHost, repository, desc.Digest.String(), token)
+		if err != nil {
+			return fmt.Errorf("failed to fetch notation signature manifest: %w", err)
+		}
+		var manifest ocispec.Manifest
+		if err := json.Unmarshal(body, &manifest); err != nil || len(manifest.Layers) == 0 {
+			reasons = append(reasons, errors.New("malformed notation signature manifest"))
+			continue
+		}
+		layer := manifest.Layers[0]
+		if layer.MediaType != notationJWSMediaType {
+			reasons = append(reasons, fmt.Errorf("unsupported signature envelope %s", layer.MediaType))
+			continue
+		}
+
+		envelope, err := v.registryClient.GetBlob(ctx, registryHost, repository, layer.Digest.String(), token)
+		if err != nil {
+			return fmt.Errorf("failed to fetch notation signature: %w", err)
+		}
+		if err := verifyNotationEnvelope(envelope, digest, policy, time.Now()); err != nil {
+			reasons = append(reasons, err)
+			continue
+		}
+		return nil
+	}
+	return invalidSignatures(reasons)
+}
+
+// verifyNotationEnvelope checks a JWS envelope against the policy. The
+// certificate chain has to be valid at the signing time recorded in the
+// envelope, and the signature must not have expired by now.
+func verifyNotationEnvelope(envelope []byte, digest string, policy *Policy, now time.Time) error {
+	var jws notationJWS
+	if err := json.Unmarshal(envelope, &jws); err != nil {
+		return fmt.Errorf("malformed JWS envelope: %w", err)
+	}
+
+	protectedJSON, err := base64.RawURLEncoding.DecodeString(jws.Protected)
+	if err != nil {
+		return errors.New("malformed JWS protected header")
+	}
+	var header notationProtectedHeader
+	if err := json.Unmarshal(protectedJSON, &header); err != nil {
+		return errors.New("malformed JWS protected header")
+	}
+	if header.Expiry != nil && now.After(*header.Expiry) {
+		return errors.New("signature has expired")
+	}
+
+	if len(jws.Header.X5C) == 0 {
+		return errors.New("signature has no certificate chain")
+	}
+	certs := make([]*x509.Certificate, 0, len(jws.Header.X5C))
+	for _, encoded := range jws.Header.X5C {
+		der, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return errors.New("malformed certificate in chain")
+		}
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return fmt.Errorf("invalid certificate in chain: %w", err)
+		}
+		certs = append(certs, cert)
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	verifyAt := now
+	if header.SigningTime != nil {
+		verifyAt = *header.SigningTime
+	}
+	leaf := certs[0]
+	if _, err := leaf.Verify(x509.VerifyOptions{
+		Roots:         policy.Roots,
+		Intermediates: intermediates,
+		CurrentTime:   verifyAt,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	}); err != nil {
+		return fmt.Errorf("untrusted signing certificate: %w", err)
+	}
+	if policy.Identity != nil && !policy.Identity.MatchString(leaf.Subject.String()) {
+		return fmt.Errorf("signing certificate subject %q does not match policy", leaf.Subject.String())
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(jws.Signature)
+	if err != nil {
+		return errors.New("malformed JWS signature")
+	}
+	if err := verifyJWSSignature(header.Alg, leaf.PublicKey, []byte(jws.Protected+"."+jws.Payload), sig); err != nil {
+		return err
+	}
+
+	payloadJSON, err := base64.RawURLEncoding.DecodeString(jws.Payload)
+	if err != nil {
+		return errors.New("malformed JWS payload")
+	}
+	var payload notationPayload
+	if err := json.Unmarshal(payloadJSON, &payload); err != nil {
+		return errors.New("malformed JWS payload")
+	}
+	if payload.TargetArtifact.Digest != digest {
+		return fmt.Errorf("signature is for %s", payload.TargetArtifact.Digest)
+	}
+	return nil
+}
+
+// verifyJWSSignature checks a JWS signature for the algorithms Notation uses.
+func verifyJWSSignature(alg string, key crypto.PublicKey, signingInput, sig []byte) error {
+	var hash crypto.Hash
+	switch alg {
+	case "PS256", "ES256":
+		hash = crypto.SHA256
+	case "PS384", "ES384":
+		hash = crypto.SHA384
+	case "PS512", "ES512":
+		hash = crypto.SHA512
+	default:
+		return fmt.Errorf("unsupported JWS algorithm %q", alg)
+	}
+	h := hash.New()
+	h.Write(signingInput)
+	sum := h.Sum(nil)
+
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		if alg[0] != 'P' {
+			return fmt.Errorf("algorithm %s does not match an RSA key", alg)
+		}
+		if err := rsa.VerifyPSS(k, hash, sum, sig, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash}); err != nil {
+			return errors.New("rsa signature mismatch")
+		}
+		return nil
+	case *ecdsa.PublicKey:
+		if alg[0] != 'E' {
+			return fmt.Errorf("algorithm %s does not match an ECDSA key", alg)
+		}
+		// JWS encodes ECDSA signatures as the fixed-size concatenation r||s.
+		size := (k.Curve.Params().BitSize + 7) / 8
+		if len(sig) != 2*size {
+			return errors.New("ecdsa signature has the wrong length")
+		}
+		r := new(big.Int).SetBytes(sig[:size])
+		s := new(big.Int).SetBytes(sig[size:])
+		if !ecdsa.Verify(k, sum, r, s) {
+			return errors.New("ecdsa signature mismatch")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported public key type %T", key)
+	}
+}
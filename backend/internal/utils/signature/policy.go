@@ -0,0 +1,120 @@
+// Package signature verifies container image signatures made with cosign or
+// Notation before an image is pulled.
+package signature
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Mode selects how images from a registry are verified.
+type Mode string
+
+const (
+	// ModeNone disables verification.
+	ModeNone Mode = ""
+	// ModeCosignKey accepts cosign signatures made with one of the policy's
+	// public keys.
+	ModeCosignKey Mode = "cosign-key"
+	// ModeCosignKeyless accepts cosign keyless signatures whose Fulcio
+	// certificate chains to one of the policy's root certificates and matches
+	// its identity and issuer.
+	ModeCosignKeyless Mode = "cosign-keyless"
+	// ModeNotation accepts Notation JWS signatures whose certificate chains to
+	// one of the policy's root certificates.
+	ModeNotation Mode = "notation"
+)
+
+// IsValidMode reports whether mode is a known verification mode.
+func IsValidMode(mode string) bool {
+	switch Mode(mode) {
+	case ModeNone, ModeCosignKey, ModeCosignKeyless, ModeNotation:
+		return true
+	}
+	return false
+}
+
+var (
+	// ErrUnsigned is returned when an image carries no signature of the
+	// kind the policy expects.
+	ErrUnsigned = errors.New("image is not signed")
+	// ErrInvalidSignature is returned when no signature of the image could
+	// be verified against the policy.
+	ErrInvalidSignature = errors.New("image signature is invalid")
+)
+
+// Policy is the parsed verification policy of a registry.
+type Policy struct {
+	Mode Mode
+	// PublicKeys are the trusted keys for ModeCosignKey.
+	PublicKeys []crypto.PublicKey
+	// Roots are the trusted root certificates for ModeCosignKeyless and
+	// ModeNotation.
+	Roots *x509.CertPool
+	// Identity, when set, must match a certificate identity: the email or
+	// URI subject alternative name for keyless signatures, the subject DN
+	// for Notation.
+	Identity *regexp.Regexp
+	// Issuer, when set, must equal the OIDC issuer recorded in a keyless
+	// signing certificate.
+	Issuer string
+}
+
+// ParsePolicy builds a policy from a registry's settings. trustMaterial holds
+// PEM public keys for ModeCosignKey and PEM certificates otherwise.
+func ParsePolicy(mode, trustMaterial, identity, issuer string) (*Policy, error) {
+	if !IsValidMode(mode) {
+		return nil, fmt.Errorf("unknown signature verification mode %q", mode)
+	}
+	policy := &Policy{Mode: Mode(mode), Issuer: strings.TrimSpace(issuer)}
+	if policy.Mode == ModeNone {
+		return policy, nil
+	}
+
+	if identity = strings.TrimSpace(identity); identity != "" {
+		re, err := regexp.Compile(identity)
+		if err != nil {
+			return nil, fmt.Errorf("invalid identity pattern: %w", err)
+		}
+		policy.Identity = re
+	}
+
+	rest := []byte(trustMaterial)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		switch {
+		case policy.Mode == ModeCosignKey && block.Type == "PUBLIC KEY":
+			key, err := x509.ParsePKIXPublicKey(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("invalid public key: %w", err)
+			}
+			policy.PublicKeys = append(policy.PublicKeys, key)
+		case policy.Mode != ModeCosignKey && block.Type == "CERTIFICATE":
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("invalid certificate: %w", err)
+			}
+			if policy.Roots == nil {
+				policy.Roots = x509.NewCertPool()
+			}
+			policy.Roots.AddCert(cert)
+		}
+	}
+
+	if policy.Mode == ModeCosignKey && len(policy.PublicKeys) == 0 {
+		return nil, errors.New("cosign key verification requires at least one PEM public key")
+	}
+	if policy.Mode != ModeCosignKey && policy.Roots == nil {
+		return nil, errors.New("certificate based verification requires at least one PEM root certificate")
+	}
+	return policy, nil
+}
@@ -0,0 +1,198 @@
+package signature
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/getarcaneapp/arcane/backend/internal/utils/registry"
+)
+
+const testImageDigest = "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+func publicKeyPEM(t *testing.T, key crypto.PublicKey) string {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func TestParsePolicy(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	policy, err := ParsePolicy("cosign-key", publicKeyPEM(t, &key.PublicKey), "", "")
+	require.NoError(t, err)
+	require.Len(t, policy.PublicKeys, 1)
+
+	policy, err = ParsePolicy("", "", "", "")
+	require.NoError(t, err)
+	require.Equal(t, ModeNone, policy.Mode)
+
+	_, err = ParsePolicy("gpg", "", "", "")
+	require.Error(t, err)
+	_, err = ParsePolicy("cosign-key", "", "", "")
+	require.Error(t, err, "key mode needs a key")
+	_, err = ParsePolicy("notation", publicKeyPEM(t, &key.PublicKey), "", "")
+	require.Error(t, err, "notation needs certificates, not keys")
+	_, err = ParsePolicy("cosign-key", publicKeyPEM(t, &key.PublicKey), "([", "")
+	require.Error(t, err)
+}
+
+// newCosignRegistry serves a cosign signature manifest for testImageDigest
+// whose single layer is payload signed with key.
+func newCosignRegistry(t *testing.T, key *ecdsa.PrivateKey, payload []byte) *httptest.Server {
+	t.Helper()
+	sum := sha256.Sum256(payload)
+	sig, err := ecdsa.SignASN1(rand.Reader, key, sum[:])
+	require.NoError(t, err)
+	blobDigest := fmt.Sprintf("sha256:%x", sum)
+
+	manifest, err := json.Marshal(map[string]any{
+		"schemaVersion": 2,
+		"mediaType":     "application/vnd.oci.image.manifest.v1+json",
+		"config":        map[string]any{"mediaType": "application/vnd.oci.image.config.v1+json", "digest": blobDigest, "size": 2},
+		"layers": []map[string]any{{
+			"mediaType":   "application/vnd.dev.cosign.simplesigning.v1+json",
+			"digest":      blobDigest,
+			"size":        len(payload),
+			"annotations": map[string]string{cosignSignatureAnnotation: base64.StdEncoding.EncodeToString(sig)},
+		}},
+	})
+	require.NoError(t, err)
+
+	sigTag := strings.Replace(testImageDigest, ":", "-", 1) + ".sig"
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/org/app/manifests/" + sigTag:
+			_, _ = w.Write(manifest)
+		case "/v2/org/app/blobs/" + blobDigest:
+			_, _ = w.Write(payload)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+}
+
+func TestVerifyCosignKey(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	payload := []byte(`{"critical":{"identity":{"docker-reference":"example.com/org/app"},"image":{"docker-manifest-digest":"` + testImageDigest + `"},"type":"cosign container image signature"},"optional":null}`)
+	srv := newCosignRegistry(t, key, payload)
+	defer srv.Close()
+
+	verifier := NewVerifier(registry.NewClient())
+	ctx := context.Background()
+
+	trusted, err := ParsePolicy("cosign-key", publicKeyPEM(t, &otherKey.PublicKey)+publicKeyPEM(t, &key.PublicKey), "", "")
+	require.NoError(t, err)
+	require.NoError(t, verifier.Verify(ctx, srv.URL, "org/app", testImageDigest, "", trusted))
+
+	untrusted, err := ParsePolicy("cosign-key", publicKeyPEM(t, &otherKey.PublicKey), "", "")
+	require.NoError(t, err)
+	require.ErrorIs(t, verifier.Verify(ctx, srv.URL, "org/app", testImageDigest, "", untrusted), ErrInvalidSignature)
+
+	otherDigest := "sha256:" + strings.Repeat("f", 64)
+	require.ErrorIs(t, verifier.Verify(ctx, srv.URL, "org/app", otherDigest, "", trusted), ErrUnsigned)
+}
+
+func TestVerifyCosignKeyRejectsPayloadForOtherImage(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	payload := []byte(`{"critical":{"image":{"docker-manifest-digest":"sha256:` + strings.Repeat("e", 64) + `"}}}`)
+	srv := newCosignRegistry(t, key, payload)
+	defer srv.Close()
+
+	policy, err := ParsePolicy("cosign-key", publicKeyPEM(t, &key.PublicKey), "", "")
+	require.NoError(t, err)
+	err = NewVerifier(registry.NewClient()).Verify(context.Background(), srv.URL, "org/app", testImageDigest, "", policy)
+	require.ErrorIs(t, err, ErrInvalidSignature)
+}
+
+func TestVerifyNotationEnvelope(t *testing.T) {
+	now := time.Now()
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test Root"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	caCert, err := x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+
+	leafKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	leafDER, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "release-signer", Organization: []string{"Example"}},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	}, caCert, &leafKey.PublicKey, caKey)
+	require.NoError(t, err)
+
+	protected := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"ES256","cty":"application/vnd.cncf.notary.payload.v1+json","io.cncf.notary.signingScheme":"notary.x509","io.cncf.notary.signingTime":"` + now.Format(time.RFC3339) + `"}`))
+	payload := base64.RawURLEncoding.EncodeToString([]byte(`{"targetArtifact":{"mediaType":"application/vnd.oci.image.manifest.v1+json","digest":"` + testImageDigest + `","size":1234}}`))
+	sum := sha256.Sum256([]byte(protected + "." + payload))
+	r, s, err := ecdsa.Sign(rand.Reader, leafKey, sum[:])
+	require.NoError(t, err)
+	sig := make([]byte, 64)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:])
+
+	envelope, err := json.Marshal(map[string]any{
+		"payload":   payload,
+		"protected": protected,
+		"header":    map[string]any{"x5c": []string{base64.StdEncoding.EncodeToString(leafDER)}},
+		"signature": base64.RawURLEncoding.EncodeToString(sig),
+	})
+	require.NoError(t, err)
+
+	rootPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}))
+	policy, err := ParsePolicy("notation", rootPEM, "O=Example", "")
+	require.NoError(t, err)
+	require.NoError(t, verifyNotationEnvelope(envelope, testImageDigest, policy, now))
+
+	require.Error(t, verifyNotationEnvelope(envelope, "sha256:"+strings.Repeat("a", 64), policy, now), "digest must match")
+
+	wrongIdentity, err := ParsePolicy("notation", rootPEM, "O=Other", "")
+	require.NoError(t, err)
+	require.Error(t, verifyNotationEnvelope(envelope, testImageDigest, wrongIdentity, now))
+
+	otherCAKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherCADER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &otherCAKey.PublicKey, otherCAKey)
+	require.NoError(t, err)
+	untrusted, err := ParsePolicy("notation", string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: otherCADER})), "", "")
+	require.NoError(t, err)
+	require.Error(t, verifyNotationEnvelope(envelope, testImageDigest, untrusted, now))
+}
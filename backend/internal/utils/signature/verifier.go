@@ -0,0 +1,74 @@
+package signature
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/getarcaneapp/arcane/backend/internal/utils/registry"
+)
+
+// Verifier checks image signatures stored in a registry.
+type Verifier struct {
+	registryClient *registry.Client
+}
+
+// NewVerifier creates a Verifier that fetches signatures with registryClient.
+func NewVerifier(registryClient *registry.Client) *Verifier {
+	return &Verifier{registryClient: registryClient}
+}
+
+// Verify checks that the manifest digest of repository carries at least one
+// signature accepted by policy. It returns an error wrapping ErrUnsigned or
+// ErrInvalidSignature when the image has to be blocked, and other errors when
+// the signatures could not be retrieved.
+func (v *Verifier) Verify(ctx context.Context, registryHost, repository, digest, token string, policy *Policy) error {
+	if policy == nil || policy.Mode == ModeNone {
+		return nil
+	}
+	switch policy.Mode {
+	case ModeCosignKey, ModeCosignKeyless:
+		return v.verifyCosign(ctx, registryHost, repository, digest, token, policy)
+	case ModeNotation:
+		return v.verifyNotation(ctx, registryHost, repository, digest, token, policy)
+	default:
+		return fmt.Errorf("unknown signature verification mode %q", policy.Mode)
+	}
+}
+
+// invalidSignatures combines the reasons each signature was rejected.
+func invalidSignatures(reasons []error) error {
+	return fmt.Errorf("%w: %w", ErrInvalidSignature, errors.Join(reasons...))
+}
+
+// verifyDigestSignature checks sig over the SHA-256 digest of payload, the
+// scheme cosign uses for every key type except Ed25519.
+func verifyDigestSignature(key crypto.PublicKey, payload, sig []byte) error {
+	sum := sha256.Sum256(payload)
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(k, sum[:], sig) {
+			return errors.New("ecdsa signature mismatch")
+		}
+		return nil
+	case *rsa.PublicKey:
+		if err := rsa.VerifyPKCS1v15(k, crypto.SHA256, sum[:], sig); err != nil {
+			if pssErr := rsa.VerifyPSS(k, crypto.SHA256, sum[:], sig, nil); pssErr != nil {
+				return errors.New("rsa signature mismatch")
+			}
+		}
+		return nil
+	case ed25519.PublicKey:
+		if !ed25519.Verify(k, payload, sig) {
+			return errors.New("ed25519 signature mismatch")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported public key type %T", key)
+	}
+}
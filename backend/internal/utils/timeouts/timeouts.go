@@ -14,6 +14,10 @@ const (
 	DefaultRegistry        = 30 * time.Second
 	DefaultProxyRequest    = 60 * time.Second
 	DefaultBuildTimeout    = 30 * time.Minute
+
+	// DefaultAggregateRequest bounds each environment of an all-environments
+	// list so one slow agent does not hold up the whole page.
+	DefaultAggregateRequest = 10 * time.Second
 )
 
 func GetDuration(settingSeconds int, defaultDuration time.Duration) time.Duration {
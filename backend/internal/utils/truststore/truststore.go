@@ -0,0 +1,88 @@
+// Package truststore holds the CA certificates Arcane trusts for outbound TLS:
+// the system roots plus any custom CAs uploaded by an administrator.
+package truststore
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+// roots is the system pool extended with the custom CAs; nil means only the
+// system roots are trusted.
+var roots atomic.Pointer[x509.CertPool]
+
+// SetCustomCAs replaces the custom CAs trusted in addition to the system roots.
+func SetCustomCAs(certs []*x509.Certificate) {
+	if len(certs) == 0 {
+		roots.Store(nil)
+		return
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+	for _, cert := range certs {
+		pool.AddCert(cert)
+	}
+	roots.Store(pool)
+}
+
+// RootCAs returns the pool to verify servers against, or nil when no custom CAs
+// are configured so that the system roots are used.
+func RootCAs() *x509.CertPool {
+	return roots.Load()
+}
+
+// ClientTLSConfig returns a new client TLS configuration that trusts the
+// current roots. serverName may be empty when the caller fills it in, as
+// net/http, gorilla/websocket and gRPC do.
+func ClientTLSConfig(serverName string) *tls.Config {
+	return &tls.Config{
+		ServerName: serverName,
+		RootCAs:    RootCAs(),
+		MinVersion: tls.VersionTLS12,
+	}
+}
+
+// DialTLSContext dials addr and completes a TLS handshake against the roots
+// current at the time of the call, so CA changes apply to new connections
+// without rebuilding the transports that use it.
+func DialTLSContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := ClientTLSConfig(host)
+	cfg.NextProtos = []string{"h2", "http/1.1"}
+	dialer := &tls.Dialer{
+		NetDialer: &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second},
+		Config:    cfg,
+	}
+	return dialer.DialContext(ctx, network, addr)
+}
+
+// ConfigureTransport makes transport verify servers against the trust store.
+// Direct HTTPS connections pick up CA changes immediately; connections
+// tunnelled through a proxy use the roots loaded when this is called.
+func ConfigureTransport(transport *http.Transport) {
+	transport.DialTLSContext = DialTLSContext
+	transport.TLSClientConfig = ClientTLSConfig("")
+	// DialTLSContext offers h2, so the transport must be able to speak it.
+	transport.ForceAttemptHTTP2 = true
+}
+
+// InstallDefaultTransport applies ConfigureTransport to http.DefaultTransport,
+// which clients without their own transport and libraries such as Shoutrrr and
+// go-git use.
+func InstallDefaultTransport() {
+	if transport, ok := http.DefaultTransport.(*http.Transport); ok {
+		ConfigureTransport(transport)
+	}
+}
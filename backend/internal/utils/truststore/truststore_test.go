@@ -0,0 +1,49 @@
+package truststore
+
+import (
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetCustomCAs(t *testing.T) {
+	t.Cleanup(func() { SetCustomCAs(nil) })
+
+	require.Nil(t, RootCAs())
+
+	srv := httptest.NewTLSServer(nil)
+	defer srv.Close()
+
+	SetCustomCAs([]*x509.Certificate{srv.Certificate()})
+	require.NotNil(t, RootCAs())
+	require.Equal(t, RootCAs(), ClientTLSConfig("").RootCAs)
+
+	SetCustomCAs(nil)
+	require.Nil(t, RootCAs())
+}
+
+func TestConfigureTransport_TrustsCustomCA(t *testing.T) {
+	t.Cleanup(func() { SetCustomCAs(nil) })
+
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	transport := &http.Transport{}
+	ConfigureTransport(transport)
+	client := &http.Client{Transport: transport}
+
+	_, err := client.Get(srv.URL)
+	require.Error(t, err, "self-signed server should not be trusted by default")
+
+	// The dialer reads the roots on every connection, so no rebuild is needed.
+	SetCustomCAs([]*x509.Certificate{srv.Certificate()})
+	resp, err := client.Get(srv.URL)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/getarcaneapp/arcane/backend/internal/utils/truststore"
 	"github.com/gorilla/websocket"
 )
 
@@ -28,6 +29,7 @@ func ProxyHTTP(w http.ResponseWriter, r *http.Request, remoteWS string, header h
 	dialer := websocket.Dialer{
 		Proxy:            http.ProxyFromEnvironment,
 		HandshakeTimeout: 45 * time.Second,
+		TLSClientConfig:  truststore.ClientTLSConfig(""),
 	}
 
 	slog.Debug("attempting websocket dial", "remoteWS", remoteWS, "headers", header)
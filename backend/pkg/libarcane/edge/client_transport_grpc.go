@@ -2,7 +2,6 @@ package edge
 
 import (
 	"context"
-	"crypto/tls"
 	"fmt"
 	"log/slog"
 	"net/url"
@@ -10,6 +9,7 @@ import (
 	"time"
 
 	"github.com/getarcaneapp/arcane/backend/internal/utils/remenv"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/truststore"
 	tunnelpb "github.com/getarcaneapp/arcane/backend/pkg/libarcane/edge/proto/tunnel/v1"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/backoff"
@@ -43,9 +43,7 @@ func (c *TunnelClient) connectAndServeGRPC(ctx context.Context) error {
 	}
 
 	if c.useTLSForManagerGRPC() {
-		dialOpts = append(dialOpts, grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{ //nolint:gosec
-			MinVersion: tls.VersionTLS12,
-		})))
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(credentials.NewTLS(truststore.ClientTLSConfig(""))))
 	} else {
 		dialOpts = append(dialOpts, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/getarcaneapp/arcane/backend/internal/utils/remenv"
+	"github.com/getarcaneapp/arcane/backend/internal/utils/truststore"
 	"github.com/gorilla/websocket"
 )
 
@@ -22,6 +23,7 @@ func (c *TunnelClient) connectAndServeWebSocket(ctx context.Context) error {
 	dialer := websocket.Dialer{
 		Proxy:            http.ProxyFromEnvironment,
 		HandshakeTimeout: 30 * time.Second,
+		TLSClientConfig:  truststore.ClientTLSConfig(""),
 	}
 
 	headers := http.Header{}
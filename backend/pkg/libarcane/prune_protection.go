@@ -0,0 +1,30 @@
+package libarcane
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultPruneProtectionLabel keeps a container, image, volume or network out
+// of prune-all when no other label is configured. Only the label key matters,
+// so any value protects the resource.
+const DefaultPruneProtectionLabel = "com.getarcaneapp.arcane.prune-protect"
+
+// HasPruneProtection reports whether labels carry the protection label. An
+// empty label disables protection.
+func HasPruneProtection(labels map[string]string, label string) bool {
+	if label == "" {
+		return false
+	}
+	_, ok := labels[label]
+	return ok
+}
+
+// ValidatePruneProtectionLabel checks that value can be used as a Docker label
+// key in a prune filter.
+func ValidatePruneProtectionLabel(value string) error {
+	if strings.ContainsAny(value, "=, \t\r\n") {
+		return fmt.Errorf("invalid prune protection label %q: must be a single label key", value)
+	}
+	return nil
+}
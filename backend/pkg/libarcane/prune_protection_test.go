@@ -0,0 +1,26 @@
+package libarcane
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHasPruneProtection(t *testing.T) {
+	labels := map[string]string{DefaultPruneProtectionLabel: "", "app": "web"}
+
+	require.True(t, HasPruneProtection(labels, DefaultPruneProtectionLabel), "any value protects")
+	require.True(t, HasPruneProtection(labels, "app"))
+	require.False(t, HasPruneProtection(labels, "backup"))
+	require.False(t, HasPruneProtection(labels, ""), "an empty label disables protection")
+	require.False(t, HasPruneProtection(nil, DefaultPruneProtectionLabel))
+}
+
+func TestValidatePruneProtectionLabel(t *testing.T) {
+	require.NoError(t, ValidatePruneProtectionLabel(DefaultPruneProtectionLabel))
+	require.NoError(t, ValidatePruneProtectionLabel(""))
+
+	for _, invalid := range []string{"keep=true", "a,b", "my label"} {
+		require.Error(t, ValidatePruneProtectionLabel(invalid), invalid)
+	}
+}
@@ -0,0 +1,256 @@
+package projects
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+	"github.com/goccy/go-yaml/ast"
+)
+
+// CloneRewrite is a compose file rewritten for a copy of its project.
+type CloneRewrite struct {
+	// Content is the rewritten compose file.
+	Content []byte
+	// Changes describe each rewritten value.
+	Changes []string
+	// BindSources are the relative bind mount sources of the services, such
+	// as "data" for "./data:/var/lib/app".
+	BindSources []string
+}
+
+// RewriteComposeForClone renames the project in a compose file to
+// composeName and rewrites the values that would collide with the original
+// when both run: container_name is derived from the new project name, and
+// every fixed published port moves to the next port that is in neither
+// usedPorts nor the original file. Ports given as ranges or variables are
+// left alone. Only the rewritten values change; comments, anchors and
+// extension fields are kept, see ComposeEditor.
+func RewriteComposeForClone(content []byte, composeName string, usedPorts map[int]struct{}) (*CloneRewrite, error) {
+	editor, err := NewComposeEditor(content)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &CloneRewrite{Changes: []string{}, BindSources: []string{}}
+	services := composeServicesInternal(editor)
+	ports := publishedPortsInternal(services)
+
+	taken := make(map[int]struct{}, len(usedPorts)+len(ports))
+	for p := range usedPorts {
+		taken[p] = struct{}{}
+	}
+	// Reserve the original ports first so a moved port never lands on one
+	// that appears later in the file.
+	for _, p := range ports {
+		taken[p.port] = struct{}{}
+	}
+
+	if node := editor.Lookup("name"); node != nil {
+		if err := editor.SetScalar(node, composeName); err != nil {
+			return nil, fmt.Errorf("failed to rename project: %w", err)
+		}
+		result.Changes = append(result.Changes, fmt.Sprintf("name: %s -> %s", composeScalarStringInternal(node), composeName))
+	}
+
+	for _, p := range ports {
+		next, err := nextFreePortInternal(p.port, taken)
+		if err != nil {
+			return nil, err
+		}
+		if err := editor.SetScalar(p.node, p.format(next)); err != nil {
+			return nil, fmt.Errorf("failed to move %s port %d: %w", p.service, p.port, err)
+		}
+		result.Changes = append(result.Changes, fmt.Sprintf("%s: published port %d -> %d", p.service, p.port, next))
+	}
+
+	for _, svc := range services {
+		if node := ComposeMappingValue(svc.config, "container_name"); node != nil {
+			newName := composeName + "-" + svc.name
+			if err := editor.SetScalar(node, newName); err != nil {
+				return nil, fmt.Errorf("failed to rename %s container: %w", svc.name, err)
+			}
+			result.Changes = append(result.Changes, fmt.Sprintf("%s: container_name %s -> %s", svc.name, composeScalarStringInternal(node), newName))
+		}
+		if node := ComposeMappingValue(svc.config, "volumes"); node != nil {
+			var volumes any
+			if err := yaml.NodeToValue(node, &volumes, yaml.UseOrderedMap()); err == nil {
+				result.BindSources = append(result.BindSources, relativeBindSourcesInternal(volumes)...)
+			}
+		}
+	}
+
+	result.Content = editor.Bytes()
+	return result, nil
+}
+
+type composeServiceInternal struct {
+	name   string
+	config *ast.MappingNode
+}
+
+func composeServicesInternal(editor *ComposeEditor) []composeServiceInternal {
+	entries, ok := editor.Lookup("services").(*ast.MappingNode)
+	if !ok {
+		return nil
+	}
+	var services []composeServiceInternal
+	for _, entry := range entries.Values {
+		key, ok := unwrapComposeNodeInternal(entry.Key).(*ast.StringNode)
+		if !ok || entry.Key.IsMergeKey() {
+			continue
+		}
+		config, ok := unwrapComposeNodeInternal(entry.Value).(*ast.MappingNode)
+		if key.Value == "" || !ok {
+			continue
+		}
+		services = append(services, composeServiceInternal{name: key.Value, config: config})
+	}
+	return services
+}
+
+// publishedPortInternal is a fixed published port of a service.
+type publishedPortInternal struct {
+	service string
+	node    ast.Node
+	port    int
+	// format returns the value of node with the port replaced.
+	format func(port int) string
+}
+
+// publishedPortsInternal returns every fixed published port in the order
+// it appears in the file.
+func publishedPortsInternal(services []composeServiceInternal) []publishedPortInternal {
+	var out []publishedPortInternal
+	for _, svc := range services {
+		ports, ok := ComposeMappingValue(svc.config, "ports").(*ast.SequenceNode)
+		if !ok {
+			continue
+		}
+		for _, entry := range ports.Values {
+			switch v := unwrapComposeNodeInternal(entry).(type) {
+			case *ast.StringNode:
+				prefix, host, suffix, ok := splitPortSpecInternal(v.Value)
+				if !ok {
+					continue
+				}
+				out = append(out, publishedPortInternal{
+					service: svc.name,
+					node:    v,
+					port:    host,
+					format:  func(port int) string { return prefix + strconv.Itoa(port) + suffix },
+				})
+			case *ast.MappingNode:
+				published, ok := ComposeMappingValue(v, "published").(ast.ScalarNode)
+				if !ok {
+					continue
+				}
+				host, ok := portNumberInternal(published.GetValue())
+				if !ok {
+					continue
+				}
+				out = append(out, publishedPortInternal{service: svc.name, node: published, port: host, format: strconv.Itoa})
+			}
+		}
+	}
+	return out
+}
+
+// composeScalarStringInternal returns the value of a scalar node as text.
+func composeScalarStringInternal(node ast.Node) string {
+	if scalar, ok := node.(ast.ScalarNode); ok {
+		return fmt.Sprint(scalar.GetValue())
+	}
+	return node.String()
+}
+
+// splitPortSpecInternal splits a short port syntax such as
+// "127.0.0.1:8080:80/tcp" around its host port. It reports false when the
+// entry has no fixed host port.
+func splitPortSpecInternal(spec string) (prefix string, host int, suffix string, ok bool) {
+	containerSep := strings.LastIndex(spec, ":")
+	if containerSep < 0 {
+		return "", 0, "", false
+	}
+	rest := spec[:containerSep]
+	hostStart := strings.LastIndex(rest, ":") + 1
+	host, ok = parsePortInternal(rest[hostStart:])
+	if !ok {
+		return "", 0, "", false
+	}
+	return spec[:hostStart], host, spec[containerSep:], true
+}
+
+func portNumberInternal(v any) (int, bool) {
+	switch n := v.(type) {
+	case uint64:
+		return parsePortInternal(strconv.FormatUint(n, 10))
+	case int64:
+		return parsePortInternal(strconv.FormatInt(n, 10))
+	case int:
+		return parsePortInternal(strconv.Itoa(n))
+	case string:
+		return parsePortInternal(n)
+	}
+	return 0, false
+}
+
+func parsePortInternal(s string) (int, bool) {
+	port, err := strconv.Atoi(s)
+	if err != nil || port < 1 || port > 65535 {
+		return 0, false
+	}
+	return port, true
+}
+
+func nextFreePortInternal(port int, taken map[int]struct{}) (int, error) {
+	for candidate := port + 1; candidate <= 65535; candidate++ {
+		if _, ok := taken[candidate]; !ok {
+			taken[candidate] = struct{}{}
+			return candidate, nil
+		}
+	}
+	return 0, fmt.Errorf("no free port above %d", port)
+}
+
+// relativeBindSourcesInternal returns the sources of bind mounts given
+// relative to the project directory.
+func relativeBindSourcesInternal(volumes any) []string {
+	entries, ok := volumes.([]any)
+	if !ok {
+		return nil
+	}
+
+	var sources []string
+	for _, entry := range entries {
+		var source string
+		switch v := entry.(type) {
+		case string:
+			source, _, _ = strings.Cut(v, ":")
+		case yaml.MapSlice:
+			var isBind bool
+			for _, item := range v {
+				key, _ := item.Key.(string)
+				value, _ := item.Value.(string)
+				switch key {
+				case "type":
+					isBind = value == "bind"
+				case "source":
+					source = value
+				}
+			}
+			if !isBind {
+				continue
+			}
+		}
+		if !strings.HasPrefix(source, "./") && source != "." {
+			continue
+		}
+		if cleaned := path.Clean(source); cleaned != "." {
+			sources = append(sources, cleaned)
+		}
+	}
+	return sources
+}
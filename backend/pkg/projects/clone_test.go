@@ -0,0 +1,71 @@
+package projects
+
+import (
+	"testing"
+
+	"github.com/goccy/go-yaml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewriteComposeForClone(t *testing.T) {
+	content := []byte(`name: shop
+services:
+  web:
+    image: nginx
+    container_name: shop-web
+    ports:
+      - "8080:80"
+      - "127.0.0.1:8081:443/tcp"
+      - "9000-9001:9000-9001"
+      - "${WEB_PORT:-3000}:3000"
+      - "80"
+    volumes:
+      - ./html:/usr/share/nginx/html:ro
+      - data:/data
+      - /etc/localtime:/etc/localtime:ro
+  api:
+    image: api
+    ports:
+      - target: 8000
+        published: 8082
+    volumes:
+      - type: bind
+        source: ./config
+        target: /config
+volumes:
+  data:
+`)
+
+	result, err := RewriteComposeForClone(content, "shop-staging", map[int]struct{}{8083: {}})
+	require.NoError(t, err)
+
+	var doc struct {
+		Name     string `yaml:"name"`
+		Services map[string]struct {
+			ContainerName string `yaml:"container_name"`
+			Ports         []any  `yaml:"ports"`
+		} `yaml:"services"`
+	}
+	require.NoError(t, yaml.Unmarshal(result.Content, &doc))
+
+	assert.Equal(t, "shop-staging", doc.Name)
+	assert.Equal(t, "shop-staging-web", doc.Services["web"].ContainerName)
+	assert.Empty(t, doc.Services["api"].ContainerName)
+
+	// 8080-8082 are taken by the original and 8083 is in use on the host.
+	assert.Equal(t, []any{"8084:80", "127.0.0.1:8085:443/tcp", "9000-9001:9000-9001", "${WEB_PORT:-3000}:3000", "80"}, doc.Services["web"].Ports)
+	require.Len(t, doc.Services["api"].Ports, 1)
+	apiPort, ok := doc.Services["api"].Ports[0].(map[string]any)
+	require.True(t, ok)
+	assert.EqualValues(t, 8086, apiPort["published"], "the port stays an integer")
+
+	assert.ElementsMatch(t, []string{"html", "config"}, result.BindSources)
+	assert.Contains(t, result.Changes, "web: published port 8080 -> 8084")
+	assert.Contains(t, result.Changes, "web: container_name shop-web -> shop-staging-web")
+}
+
+func TestRewriteComposeForClone_InvalidYAML(t *testing.T) {
+	_, err := RewriteComposeForClone([]byte("services: ["), "copy", nil)
+	require.Error(t, err)
+}
@@ -188,6 +188,16 @@ func ComposePs(ctx context.Context, proj *types.Project, services []string, all
 	return c.svc.Ps(ctx, proj.Name, api.PsOptions{All: all})
 }
 
+func ComposeStop(ctx context.Context, proj *types.Project, services []string) error {
+	c, err := NewClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = c.Close() }()
+
+	return c.svc.Stop(ctx, proj.Name, api.StopOptions{Project: proj, Services: services})
+}
+
 func ComposeDown(ctx context.Context, proj *types.Project, removeVolumes bool) error {
 	c, err := NewClient(ctx)
 	if err != nil {
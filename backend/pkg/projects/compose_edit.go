@@ -0,0 +1,306 @@
+package projects
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/goccy/go-yaml"
+	"github.com/goccy/go-yaml/ast"
+	"github.com/goccy/go-yaml/parser"
+	"github.com/goccy/go-yaml/token"
+)
+
+// ComposeEditor changes values in a compose file without re-encoding it.
+// Each change is written over the original text of the value it replaces,
+// so comments, anchors, aliases, x- extension fields, key order and
+// formatting are kept as the author wrote them. Use it whenever Arcane
+// modifies a compose file that a user maintains.
+type ComposeEditor struct {
+	content []byte
+	file    *ast.File
+	// lineStarts are the byte offsets at which each line of content starts.
+	lineStarts []int
+	edits      []composeEditInternal
+}
+
+type composeEditInternal struct {
+	start, end  int
+	replacement string
+}
+
+// NewComposeEditor parses a compose file for editing.
+func NewComposeEditor(content []byte) (*ComposeEditor, error) {
+	file, err := parser.ParseBytes(content, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse compose file: %w", err)
+	}
+
+	lineStarts := []int{0}
+	for i, b := range content {
+		if b == '\n' {
+			lineStarts = append(lineStarts, i+1)
+		}
+	}
+
+	return &ComposeEditor{content: content, file: file, lineStarts: lineStarts}, nil
+}
+
+// Root returns the top-level mapping of the compose file, or nil when the
+// file is empty or not a mapping.
+func (e *ComposeEditor) Root() *ast.MappingNode {
+	if len(e.file.Docs) == 0 {
+		return nil
+	}
+	root, _ := unwrapComposeNodeInternal(e.file.Docs[0].Body).(*ast.MappingNode)
+	return root
+}
+
+// Lookup follows keys from the root mapping and returns the value found, or
+// nil when a key is missing. Keys inherited through a merge key (<<) are not
+// followed, since editing them would change every service sharing the anchor.
+func (e *ComposeEditor) Lookup(keys ...string) ast.Node {
+	var node ast.Node = e.Root()
+	for _, key := range keys {
+		mapping, ok := node.(*ast.MappingNode)
+		if !ok || mapping == nil {
+			return nil
+		}
+		node = ComposeMappingValue(mapping, key)
+	}
+	return node
+}
+
+// SetScalar replaces the value of a single-line scalar node. The original
+// quoting style is kept; plain values that would no longer read back as the
+// same string are double-quoted.
+func (e *ComposeEditor) SetScalar(node ast.Node, value string) error {
+	tk := node.GetToken()
+	switch node.(type) {
+	case *ast.StringNode, *ast.IntegerNode, *ast.FloatNode, *ast.BoolNode, *ast.NullNode:
+	default:
+		return fmt.Errorf("line %d: cannot edit a %s value in place", tk.Position.Line, node.Type())
+	}
+
+	raw := strings.TrimSpace(tk.Origin)
+	start, ok := e.offsetInternal(tk.Position)
+	if !ok || raw == "" {
+		return fmt.Errorf("line %d: cannot locate value", tk.Position.Line)
+	}
+	end := start + len(raw)
+	if end > len(e.content) || string(e.content[start:end]) != raw {
+		return fmt.Errorf("line %d: cannot edit a multi-line value in place", tk.Position.Line)
+	}
+
+	for _, edit := range e.edits {
+		if start < edit.end && edit.start < end {
+			return fmt.Errorf("line %d: value is already edited", tk.Position.Line)
+		}
+	}
+	e.edits = append(e.edits, composeEditInternal{start: start, end: end, replacement: composeScalarInternal(node, tk, value)})
+	return nil
+}
+
+// AddMappingEntry adds key with value after the last entry of a block
+// mapping. Scalars are written on the key's line; other values are written as
+// a block below it, indented like the rest of the file.
+func (e *ComposeEditor) AddMappingEntry(mapping *ast.MappingNode, key string, value any) error {
+	if mapping == nil || len(mapping.Values) == 0 {
+		return errors.New("cannot add to an empty mapping")
+	}
+	if mapping.IsFlowStyle {
+		return fmt.Errorf("line %d: cannot add to a flow mapping", mapping.GetToken().Position.Line)
+	}
+	first := mapping.Values[0].Key.GetToken().Position
+	last := mapping.Values[len(mapping.Values)-1].Key.GetToken().Position
+	encoded, err := yaml.MarshalWithOptions(yaml.MapSlice{{Key: key, Value: value}}, yaml.Indent(e.indentStepInternal()), yaml.IndentSequence(true))
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", key, err)
+	}
+	// A sequence value may start at the same indentation as its key.
+	return e.insertAfterInternal(last.Line, first.Column-1, true, encoded)
+}
+
+// AppendSequenceItem adds value after the last item of a block sequence.
+func (e *ComposeEditor) AppendSequenceItem(seq *ast.SequenceNode, value any) error {
+	if seq == nil || len(seq.Entries) == 0 {
+		return errors.New("cannot add to an empty sequence")
+	}
+	if seq.IsFlowStyle {
+		return fmt.Errorf("line %d: cannot add to a flow sequence", seq.GetToken().Position.Line)
+	}
+	first := seq.Entries[0].Start.Position
+	last := seq.Entries[len(seq.Entries)-1].Start.Position
+	encoded, err := yaml.MarshalWithOptions([]any{value}, yaml.Indent(e.indentStepInternal()))
+	if err != nil {
+		return fmt.Errorf("failed to encode item: %w", err)
+	}
+	return e.insertAfterInternal(last.Line, first.Column-1, false, encoded)
+}
+
+// insertAfterInternal writes encoded, indented by indent spaces, after the
+// block that starts on line. The block ends before the next line indented
+// by indent or less; comments and blank lines at its end stay where they are.
+func (e *ComposeEditor) insertAfterInternal(line, indent int, allowDash bool, encoded []byte) error {
+	end := line
+	for l := line + 1; l <= len(e.lineStarts); l++ {
+		text := e.lineInternal(l)
+		trimmed := strings.TrimLeft(text, " ")
+		if strings.TrimSpace(trimmed) == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		depth := len(text) - len(trimmed)
+		if depth > indent || (allowDash && depth == indent && (trimmed == "-" || strings.HasPrefix(trimmed, "- "))) {
+			end = l
+			continue
+		}
+		break
+	}
+
+	var block strings.Builder
+	offset := len(e.content)
+	if end < len(e.lineStarts) {
+		offset = e.lineStarts[end]
+	} else if offset > 0 && e.content[offset-1] != '\n' {
+		block.WriteByte('\n')
+	}
+	pad := strings.Repeat(" ", indent)
+	for l := range strings.Lines(string(encoded)) {
+		if strings.TrimSpace(l) != "" {
+			block.WriteString(pad)
+		}
+		block.WriteString(l)
+	}
+
+	for _, edit := range e.edits {
+		if offset < edit.end && edit.start < offset {
+			return fmt.Errorf("line %d: value is already edited", line)
+		}
+	}
+	e.edits = append(e.edits, composeEditInternal{start: offset, end: offset, replacement: block.String()})
+	return nil
+}
+
+// lineInternal returns the text of a line, without its line break.
+func (e *ComposeEditor) lineInternal(line int) string {
+	end := len(e.content)
+	if line < len(e.lineStarts) {
+		end = e.lineStarts[line]
+	}
+	return strings.TrimRight(string(e.content[e.lineStarts[line-1]:end]), "\r\n")
+}
+
+// indentStepInternal returns how far the file indents nested blocks, judged
+// by the first nested block mapping of the root. It defaults to two spaces.
+func (e *ComposeEditor) indentStepInternal() int {
+	root := e.Root()
+	if root == nil {
+		return 2
+	}
+	for _, item := range root.Values {
+		nested, ok := unwrapComposeNodeInternal(item.Value).(*ast.MappingNode)
+		if !ok || nested.IsFlowStyle || len(nested.Values) == 0 {
+			continue
+		}
+		if step := nested.Values[0].Key.GetToken().Position.Column - 1; step > 0 {
+			return step
+		}
+	}
+	return 2
+}
+
+// offsetInternal converts a parser position, whose column counts runes, to a
+// byte offset in content.
+func (e *ComposeEditor) offsetInternal(pos *token.Position) (int, bool) {
+	if pos == nil || pos.Line < 1 || pos.Line > len(e.lineStarts) || pos.Column < 1 {
+		return 0, false
+	}
+	offset := e.lineStarts[pos.Line-1]
+	for range pos.Column - 1 {
+		if offset >= len(e.content) || e.content[offset] == '\n' {
+			return 0, false
+		}
+		_, size := utf8.DecodeRune(e.content[offset:])
+		offset += size
+	}
+	return offset, true
+}
+
+// Bytes returns the compose file with every edit applied.
+func (e *ComposeEditor) Bytes() []byte {
+	edits := slices.Clone(e.edits)
+	// Entries added to the same mapping share an offset and keep their order.
+	slices.SortStableFunc(edits, func(a, b composeEditInternal) int { return a.start - b.start })
+
+	var out strings.Builder
+	out.Grow(len(e.content))
+	last := 0
+	for _, edit := range edits {
+		out.Write(e.content[last:edit.start])
+		out.WriteString(edit.replacement)
+		last = edit.end
+	}
+	out.Write(e.content[last:])
+	return []byte(out.String())
+}
+
+// ComposeMappingValue returns the value of key in mapping, looking through
+// anchors and tags, or nil when the key is missing.
+func ComposeMappingValue(mapping *ast.MappingNode, key string) ast.Node {
+	for _, item := range mapping.Values {
+		if item.Key == nil || item.Key.IsMergeKey() {
+			continue
+		}
+		if k, ok := unwrapComposeNodeInternal(item.Key).(*ast.StringNode); ok && k.Value == key {
+			return unwrapComposeNodeInternal(item.Value)
+		}
+	}
+	return nil
+}
+
+// unwrapComposeNodeInternal returns the value behind an anchor or tag.
+// Aliases are returned as they are: their value belongs to the anchor.
+func unwrapComposeNodeInternal(node ast.Node) ast.Node {
+	for {
+		switch n := node.(type) {
+		case *ast.AnchorNode:
+			node = n.Value
+		case *ast.TagNode:
+			node = n.Value
+		case *ast.MappingKeyNode:
+			node = n.Value
+		default:
+			return node
+		}
+	}
+}
+
+func composeScalarInternal(node ast.Node, tk *token.Token, value string) string {
+	switch tk.Type {
+	case token.SingleQuoteType:
+		return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+	case token.DoubleQuoteType:
+		return strconv.Quote(value)
+	}
+	if _, isString := node.(*ast.StringNode); !isString || plainComposeStringInternal(value) {
+		return value
+	}
+	return strconv.Quote(value)
+}
+
+// plainComposeStringInternal reports whether value reads back as the same
+// string when written unquoted.
+func plainComposeStringInternal(value string) bool {
+	if value == "" || strings.ContainsAny(value, "#\n") {
+		return false
+	}
+	var decoded map[string]any
+	if err := yaml.Unmarshal([]byte("v: "+value), &decoded); err != nil {
+		return false
+	}
+	s, ok := decoded["v"].(string)
+	return ok && s == value
+}
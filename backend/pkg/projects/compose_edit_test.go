@@ -0,0 +1,113 @@
+package projects
+
+import (
+	"testing"
+
+	"github.com/goccy/go-yaml/ast"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComposeEditorKeepsFormatting(t *testing.T) {
+	content := []byte(`# Shop stack, maintained by hand
+name: shop
+
+x-defaults: &defaults
+  restart: unless-stopped   # survive reboots
+  logging:
+    driver: local
+
+services:
+  web:
+    <<: *defaults
+    image: 'nginx:1.25'
+    container_name: shop-web
+
+    ports:
+      - "8080:80"  # public
+      - target: 443
+        published: 8443
+`)
+
+	editor, err := NewComposeEditor(content)
+	require.NoError(t, err)
+
+	require.NoError(t, editor.SetScalar(editor.Lookup("services", "web", "image"), "nginx:1.27"))
+	require.NoError(t, editor.SetScalar(editor.Lookup("services", "web", "container_name"), "shop: web"))
+	require.Nil(t, editor.Lookup("services", "web", "restart"), "merged keys are not followed")
+
+	assert.Equal(t, `# Shop stack, maintained by hand
+name: shop
+
+x-defaults: &defaults
+  restart: unless-stopped   # survive reboots
+  logging:
+    driver: local
+
+services:
+  web:
+    <<: *defaults
+    image: 'nginx:1.27'
+    container_name: "shop: web"
+
+    ports:
+      - "8080:80"  # public
+      - target: 443
+        published: 8443
+`, string(editor.Bytes()))
+}
+
+func TestComposeEditorRejectsBlockValues(t *testing.T) {
+	editor, err := NewComposeEditor([]byte("services:\n  web:\n    command: |\n      run\n      --fast\n"))
+	require.NoError(t, err)
+
+	assert.Error(t, editor.SetScalar(editor.Lookup("services", "web", "command"), "run"))
+	assert.Error(t, editor.SetScalar(editor.Lookup("services", "web"), "x"))
+}
+
+func TestPlainComposeString(t *testing.T) {
+	assert.True(t, plainComposeStringInternal("nginx:1.27"))
+	assert.True(t, plainComposeStringInternal("shop-staging-web"))
+	assert.False(t, plainComposeStringInternal("8080"))
+	assert.False(t, plainComposeStringInternal("true"))
+	assert.False(t, plainComposeStringInternal("a: b"))
+	assert.False(t, plainComposeStringInternal("web # prod"))
+}
+
+func TestComposeEditorAddsEntries(t *testing.T) {
+	content := []byte("services:\n  web:\n    image: nginx\n    volumes:\n    - ./html:/usr/share/nginx/html\n\n# trailing comment\nvolumes:\n  data: {}")
+
+	editor, err := NewComposeEditor(content)
+	require.NoError(t, err)
+
+	web, ok := editor.Lookup("services", "web").(*ast.MappingNode)
+	require.True(t, ok)
+	volumes, ok := editor.Lookup("services", "web", "volumes").(*ast.SequenceNode)
+	require.True(t, ok)
+	root := editor.Root()
+
+	require.NoError(t, editor.AppendSequenceItem(volumes, "data:/data"))
+	require.NoError(t, editor.AddMappingEntry(web, "restart", "always"))
+	require.NoError(t, editor.AddMappingEntry(web, "labels", map[string]string{"tier": "front"}))
+	require.NoError(t, editor.AddMappingEntry(root, "name", "shop"))
+
+	assert.Equal(t, `services:
+  web:
+    image: nginx
+    volumes:
+    - ./html:/usr/share/nginx/html
+    - data:/data
+    restart: always
+    labels:
+      tier: front
+
+# trailing comment
+volumes:
+  data: {}
+name: shop
+`, string(editor.Bytes()))
+
+	flow, ok := editor.Lookup("volumes", "data").(*ast.MappingNode)
+	require.True(t, ok)
+	require.Error(t, editor.AddMappingEntry(flow, "driver", "local"))
+}
@@ -0,0 +1,406 @@
+package projects
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/getarcaneapp/arcane/types/project"
+	"github.com/goccy/go-yaml"
+)
+
+// FileSyntaxError is returned when a custom file fails syntax validation.
+type FileSyntaxError struct {
+	Type   project.CustomFileType
+	Issues []project.FileSyntaxIssue
+}
+
+func (e *FileSyntaxError) Error() string {
+	parts := make([]string, len(e.Issues))
+	for i, issue := range e.Issues {
+		if issue.Line > 0 {
+			parts[i] = fmt.Sprintf("line %d: %s", issue.Line, issue.Message)
+		} else {
+			parts[i] = issue.Message
+		}
+	}
+	return fmt.Sprintf("invalid %s file: %s", e.Type, strings.Join(parts, "; "))
+}
+
+var customFileTemplates = []project.CustomFileTemplate{
+	{
+		Type:        project.CustomFileTypeNginx,
+		DefaultName: "nginx.conf",
+		Content: `events {}
+
+http {
+    server {
+        listen 80;
+        server_name _;
+
+        location / {
+            proxy_pass http://app:8080;
+            proxy_set_header Host $host;
+            proxy_set_header X-Forwarded-For $proxy_add_x_forwarded_for;
+            proxy_set_header X-Forwarded-Proto $scheme;
+        }
+    }
+}
+`,
+	},
+	{
+		Type:        project.CustomFileTypeCaddyfile,
+		DefaultName: "Caddyfile",
+		Content: `example.com {
+	encode gzip
+	reverse_proxy app:8080
+}
+`,
+	},
+	{
+		Type:        project.CustomFileTypePrometheus,
+		DefaultName: "prometheus.yml",
+		Content: `global:
+  scrape_interval: 15s
+  evaluation_interval: 15s
+
+scrape_configs:
+  - job_name: prometheus
+    static_configs:
+      - targets: ["localhost:9090"]
+`,
+	},
+	{
+		Type:        project.CustomFileTypeJSON,
+		DefaultName: "config.json",
+		Content:     "{}\n",
+	},
+	{
+		Type:        project.CustomFileTypeYAML,
+		DefaultName: "config.yaml",
+		Content:     "# Configuration\n",
+	},
+	{
+		Type:        project.CustomFileTypePlain,
+		DefaultName: "notes.txt",
+		Content:     "",
+	},
+}
+
+// CustomFileTemplates returns the starting point for each custom file type.
+func CustomFileTemplates() []project.CustomFileTemplate {
+	out := make([]project.CustomFileTemplate, len(customFileTemplates))
+	copy(out, customFileTemplates)
+	return out
+}
+
+// CustomFileTemplate returns the template for fileType.
+func CustomFileTemplate(fileType project.CustomFileType) (project.CustomFileTemplate, bool) {
+	for _, tmpl := range customFileTemplates {
+		if tmpl.Type == fileType {
+			return tmpl, true
+		}
+	}
+	return project.CustomFileTemplate{}, false
+}
+
+// DetectCustomFileType infers a custom file type from a file name, falling
+// back to plain.
+func DetectCustomFileType(name string) project.CustomFileType {
+	base := strings.ToLower(filepath.Base(name))
+	switch {
+	case base == "nginx.conf":
+		return project.CustomFileTypeNginx
+	case base == "caddyfile" || strings.HasSuffix(base, ".caddyfile"):
+		return project.CustomFileTypeCaddyfile
+	case base == "prometheus.yml" || base == "prometheus.yaml":
+		return project.CustomFileTypePrometheus
+	case strings.HasSuffix(base, ".json"):
+		return project.CustomFileTypeJSON
+	case strings.HasSuffix(base, ".yml") || strings.HasSuffix(base, ".yaml"):
+		return project.CustomFileTypeYAML
+	}
+	return project.CustomFileTypePlain
+}
+
+// ValidateCustomFile checks content against the syntax of fileType and
+// returns a *FileSyntaxError listing the problems found. Plain files are
+// never rejected.
+func ValidateCustomFile(fileType project.CustomFileType, content string) error {
+	var issues []project.FileSyntaxIssue
+	switch fileType {
+	case project.CustomFileTypeJSON:
+		issues = validateJSONInternal(content)
+	case project.CustomFileTypeYAML:
+		_, issues = parseYAMLInternal(content)
+	case project.CustomFileTypePrometheus:
+		issues = validatePrometheusInternal(content)
+	case project.CustomFileTypeNginx:
+		issues = validateNginxInternal(content)
+	case project.CustomFileTypeCaddyfile:
+		issues = validateCaddyfileInternal(content)
+	case project.CustomFileTypePlain, "":
+		return nil
+	default:
+		return fmt.Errorf("unknown custom file type %q", fileType)
+	}
+
+	if len(issues) > 0 {
+		return &FileSyntaxError{Type: fileType, Issues: issues}
+	}
+	return nil
+}
+
+func validateJSONInternal(content string) []project.FileSyntaxIssue {
+	var v any
+	err := json.Unmarshal([]byte(content), &v)
+	if err == nil {
+		return nil
+	}
+
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		line, col := lineColumnAtOffsetInternal(content, int(syntaxErr.Offset))
+		return []project.FileSyntaxIssue{{Line: line, Column: col, Message: syntaxErr.Error()}}
+	}
+	return []project.FileSyntaxIssue{{Message: err.Error()}}
+}
+
+func parseYAMLInternal(content string) (any, []project.FileSyntaxIssue) {
+	var v any
+	err := yaml.Unmarshal([]byte(content), &v)
+	if err == nil {
+		return v, nil
+	}
+
+	var yamlErr yaml.Error
+	if errors.As(err, &yamlErr) && yamlErr.GetToken() != nil {
+		pos := yamlErr.GetToken().Position
+		return nil, []project.FileSyntaxIssue{{Line: pos.Line, Column: pos.Column, Message: yamlErr.GetMessage()}}
+	}
+	return nil, []project.FileSyntaxIssue{{Message: err.Error()}}
+}
+
+// validatePrometheusInternal checks the YAML syntax and the parts of the
+// Prometheus schema that keep the server from starting.
+func validatePrometheusInternal(content string) []project.FileSyntaxIssue {
+	doc, issues := parseYAMLInternal(content)
+	if len(issues) > 0 {
+		return issues
+	}
+	if doc == nil {
+		return nil
+	}
+
+	root, ok := doc.(map[string]any)
+	if !ok {
+		return []project.FileSyntaxIssue{{Message: "configuration must be a mapping"}}
+	}
+	if global, ok := root["global"]; ok && global != nil {
+		if _, ok := global.(map[string]any); !ok {
+			issues = append(issues, project.FileSyntaxIssue{Message: "global must be a mapping"})
+		}
+	}
+
+	raw, ok := root["scrape_configs"]
+	if !ok || raw == nil {
+		return issues
+	}
+	scrapeConfigs, ok := raw.([]any)
+	if !ok {
+		return append(issues, project.FileSyntaxIssue{Message: "scrape_configs must be a list"})
+	}
+	seen := map[string]struct{}{}
+	for i, item := range scrapeConfigs {
+		cfg, ok := item.(map[string]any)
+		if !ok {
+			issues = append(issues, project.FileSyntaxIssue{Message: fmt.Sprintf("scrape_configs[%d] must be a mapping", i)})
+			continue
+		}
+		jobName, _ := cfg["job_name"].(string)
+		if strings.TrimSpace(jobName) == "" {
+			issues = append(issues, project.FileSyntaxIssue{Message: fmt.Sprintf("scrape_configs[%d] is missing job_name", i)})
+			continue
+		}
+		if _, dup := seen[jobName]; dup {
+			issues = append(issues, project.FileSyntaxIssue{Message: fmt.Sprintf("job_name %q is used by more than one scrape config", jobName)})
+		}
+		seen[jobName] = struct{}{}
+	}
+	return issues
+}
+
+// validateNginxInternal checks that nginx statements end with ';' and that
+// blocks are balanced. Like nginx, '#' only starts a comment at the start of
+// a token, and quoted strings may contain braces and semicolons.
+func validateNginxInternal(content string) []project.FileSyntaxIssue {
+	var (
+		issues     []project.FileSyntaxIssue
+		openBlocks []int
+		line       = 1
+		col        = 0
+		inComment  bool
+		quote      rune
+		quoteLine  int
+		escaped    bool
+		pending    bool // words since the last statement end
+		pendingAt  int
+		tokenStart = true
+	)
+	missingSemicolon := func() {
+		if pending {
+			issues = append(issues, project.FileSyntaxIssue{Line: pendingAt, Message: `statement is missing ";"`})
+			pending = false
+		}
+	}
+
+	for _, r := range content {
+		col++
+		if r == '\n' {
+			inComment = false
+			line++
+			col = 0
+			tokenStart = true
+			continue
+		}
+		if inComment {
+			continue
+		}
+		if quote != 0 {
+			switch {
+			case escaped:
+				escaped = false
+			case r == '\\':
+				escaped = true
+			case r == quote:
+				quote = 0
+			}
+			continue
+		}
+
+		switch {
+		case r == '#' && tokenStart:
+			inComment = true
+		case r == '"' || r == '\'':
+			if !pending {
+				pendingAt = line
+			}
+			quote, quoteLine, pending, tokenStart = r, line, true, false
+		case r == ';':
+			pending, tokenStart = false, true
+		case r == '{':
+			openBlocks = append(openBlocks, line)
+			pending, tokenStart = false, true
+		case r == '}':
+			missingSemicolon()
+			if len(openBlocks) == 0 {
+				issues = append(issues, project.FileSyntaxIssue{Line: line, Column: col, Message: `unexpected "}"`})
+			} else {
+				openBlocks = openBlocks[:len(openBlocks)-1]
+			}
+			tokenStart = true
+		case r == ' ' || r == '\t' || r == '\r':
+			tokenStart = true
+		default:
+			if !pending {
+				pendingAt = line
+			}
+			pending, tokenStart = true, false
+		}
+	}
+
+	if quote != 0 {
+		issues = append(issues, project.FileSyntaxIssue{Line: quoteLine, Message: "unterminated quoted string"})
+	}
+	missingSemicolon()
+	for _, openedAt := range openBlocks {
+		issues = append(issues, project.FileSyntaxIssue{Line: openedAt, Message: `block is missing its closing "}"`})
+	}
+	return issues
+}
+
+// validateCaddyfileInternal checks that Caddyfile blocks are balanced. Only a
+// standalone "{" or "}" token opens or closes a block, so placeholders such
+// as {http.request.host} are left alone.
+func validateCaddyfileInternal(content string) []project.FileSyntaxIssue {
+	var (
+		issues     []project.FileSyntaxIssue
+		openBlocks []int
+	)
+
+	for i, rawLine := range strings.Split(content, "\n") {
+		line := i + 1
+		tokens, unterminated := caddyfileTokensInternal(rawLine)
+		if unterminated {
+			issues = append(issues, project.FileSyntaxIssue{Line: line, Message: "unterminated quoted string"})
+			continue
+		}
+		for _, tok := range tokens {
+			switch tok {
+			case "{":
+				openBlocks = append(openBlocks, line)
+			case "}":
+				if len(openBlocks) == 0 {
+					issues = append(issues, project.FileSyntaxIssue{Line: line, Message: `unexpected "}"`})
+				} else {
+					openBlocks = openBlocks[:len(openBlocks)-1]
+				}
+			}
+		}
+	}
+
+	for _, openedAt := range openBlocks {
+		issues = append(issues, project.FileSyntaxIssue{Line: openedAt, Message: `block is missing its closing "}"`})
+	}
+	return issues
+}
+
+// caddyfileTokensInternal splits a Caddyfile line into whitespace-separated
+// tokens, keeping quoted strings whole and dropping comments.
+func caddyfileTokensInternal(line string) ([]string, bool) {
+	var (
+		tokens []string
+		cur    strings.Builder
+		quote  rune
+	)
+	flush := func() {
+		if cur.Len() > 0 {
+			tokens = append(tokens, cur.String())
+			cur.Reset()
+		}
+	}
+
+	for _, r := range line {
+		switch {
+		case quote != 0:
+			cur.WriteRune(r)
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '`':
+			quote = r
+			cur.WriteRune(r)
+		case r == '#' && cur.Len() == 0:
+			flush()
+			return tokens, false
+		case r == ' ' || r == '\t' || r == '\r':
+			flush()
+		default:
+			cur.WriteRune(r)
+		}
+	}
+	flush()
+	return tokens, quote != 0
+}
+
+func lineColumnAtOffsetInternal(content string, offset int) (int, int) {
+	if offset > len(content) {
+		offset = len(content)
+	}
+	before := content[:offset]
+	line := strings.Count(before, "\n") + 1
+	col := offset - strings.LastIndex(before, "\n")
+	return line, col
+}
@@ -0,0 +1,84 @@
+package projects
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/getarcaneapp/arcane/types/project"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomFileTemplatesValidate(t *testing.T) {
+	for _, tmpl := range CustomFileTemplates() {
+		assert.NoError(t, ValidateCustomFile(tmpl.Type, tmpl.Content), tmpl.Type)
+		assert.Equal(t, tmpl.Type, DetectCustomFileType(tmpl.DefaultName), tmpl.DefaultName)
+	}
+}
+
+func TestDetectCustomFileType(t *testing.T) {
+	assert.Equal(t, project.CustomFileTypeNginx, DetectCustomFileType("conf/nginx.conf"))
+	assert.Equal(t, project.CustomFileTypeCaddyfile, DetectCustomFileType("Caddyfile"))
+	assert.Equal(t, project.CustomFileTypePrometheus, DetectCustomFileType("prometheus/prometheus.yaml"))
+	assert.Equal(t, project.CustomFileTypeYAML, DetectCustomFileType("alertmanager.yml"))
+	assert.Equal(t, project.CustomFileTypeJSON, DetectCustomFileType("settings.json"))
+	assert.Equal(t, project.CustomFileTypePlain, DetectCustomFileType("README"))
+}
+
+func syntaxIssuesInternal(t *testing.T, fileType project.CustomFileType, content string) []project.FileSyntaxIssue {
+	t.Helper()
+	err := ValidateCustomFile(fileType, content)
+	var syntaxErr *FileSyntaxError
+	require.True(t, errors.As(err, &syntaxErr), "expected a syntax error, got %v", err)
+	return syntaxErr.Issues
+}
+
+func TestValidateCustomFile_JSON(t *testing.T) {
+	issues := syntaxIssuesInternal(t, project.CustomFileTypeJSON, "{\n  \"a\": 1,\n  \"b\" 2\n}")
+	require.Len(t, issues, 1)
+	assert.Equal(t, 3, issues[0].Line)
+}
+
+func TestValidateCustomFile_YAML(t *testing.T) {
+	issues := syntaxIssuesInternal(t, project.CustomFileTypeYAML, "a: 1\nb: [1, 2\n")
+	require.Len(t, issues, 1)
+	assert.Positive(t, issues[0].Line)
+}
+
+func TestValidateCustomFile_Prometheus(t *testing.T) {
+	issues := syntaxIssuesInternal(t, project.CustomFileTypePrometheus, "scrape_configs:\n  - static_configs: []\n  - job_name: a\n  - job_name: a\n")
+	require.Len(t, issues, 2)
+	assert.Contains(t, issues[0].Message, "missing job_name")
+	assert.Contains(t, issues[1].Message, "more than one")
+
+	syntaxIssuesInternal(t, project.CustomFileTypePrometheus, "- a\n- b\n")
+}
+
+func TestValidateCustomFile_Nginx(t *testing.T) {
+	require.NoError(t, ValidateCustomFile(project.CustomFileTypeNginx, "# comment {\nhttp {\n  add_header X \"a;b}\";\n}\n"))
+
+	issues := syntaxIssuesInternal(t, project.CustomFileTypeNginx, "http {\n  server {\n    listen 80\n  }\n")
+	require.Len(t, issues, 2)
+	assert.Equal(t, project.FileSyntaxIssue{Line: 3, Message: `statement is missing ";"`}, issues[0])
+	assert.Equal(t, project.FileSyntaxIssue{Line: 1, Message: `block is missing its closing "}"`}, issues[1])
+
+	issues = syntaxIssuesInternal(t, project.CustomFileTypeNginx, "events {}\n}\n")
+	require.Len(t, issues, 1)
+	assert.Equal(t, 2, issues[0].Line)
+}
+
+func TestValidateCustomFile_Caddyfile(t *testing.T) {
+	require.NoError(t, ValidateCustomFile(project.CustomFileTypeCaddyfile, "example.com {\n\trespond \"{http.request.host}\" 200\n\theader X-Host {host} # comment }\n}\n"))
+
+	issues := syntaxIssuesInternal(t, project.CustomFileTypeCaddyfile, "example.com {\n\treverse_proxy app:80 {\n\t}\n")
+	require.Len(t, issues, 1)
+	assert.Equal(t, 1, issues[0].Line)
+
+	issues = syntaxIssuesInternal(t, project.CustomFileTypeCaddyfile, "example.com {\n\trespond \"oops\n}\n")
+	assert.Equal(t, "unterminated quoted string", issues[0].Message)
+}
+
+func TestValidateCustomFile_Plain(t *testing.T) {
+	assert.NoError(t, ValidateCustomFile(project.CustomFileTypePlain, "{{{"))
+	assert.Error(t, ValidateCustomFile("toml", ""))
+}
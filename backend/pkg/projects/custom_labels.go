@@ -7,6 +7,7 @@ import (
 	"maps"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/compose-spec/compose-go/v2/loader"
@@ -19,10 +20,11 @@ const (
 	// ArcaneIconLabel is the full reverse-DNS label key for service-level icons.
 	ArcaneIconLabel = "com.getarcaneapp.arcane.icon"
 
-	arcaneBlockKey = "x-arcane"
-	arcaneIconKey  = "icon"
-	arcaneIconsKey = "icons"
-	arcaneURLsKey  = "urls"
+	arcaneBlockKey      = "x-arcane"
+	arcaneIconKey       = "icon"
+	arcaneIconsKey      = "icons"
+	arcaneURLsKey       = "urls"
+	arcaneAutoDeployKey = "auto-deploy"
 )
 
 // ArcaneComposeMetadata represents Arcane-specific configuration extracted from a Compose file.
@@ -33,6 +35,8 @@ type ArcaneComposeMetadata struct {
 	ProjectURLS []string
 	// ServiceIcons maps service names to their respective icon identifiers or URLs.
 	ServiceIcons map[string]string
+	// AutoDeploy redeploys the project when its files change on disk outside Arcane.
+	AutoDeploy bool
 }
 
 // ParseArcaneComposeMetadata reads a Docker Compose file and extracts Arcane-specific metadata.
@@ -104,6 +108,7 @@ func extractArcaneComposeMetadata(project *composetypes.Project) ArcaneComposeMe
 
 	if arcaneBlock, ok := project.Extensions[arcaneBlockKey]; ok {
 		meta.ProjectIconURL, meta.ProjectURLS = parseArcaneBlock(arcaneBlock)
+		meta.AutoDeploy = parseArcaneAutoDeploy(arcaneBlock)
 	}
 
 	for name, svc := range project.Services {
@@ -134,6 +139,16 @@ func parseArcaneBlock(block any) (string, []string) {
 	return icon, urls
 }
 
+// parseArcaneAutoDeploy reads the auto-deploy flag, accepting a YAML bool or a "true" string.
+func parseArcaneAutoDeploy(block any) bool {
+	arcaneBlock, ok := utils.AsStringMap(block)
+	if !ok {
+		return false
+	}
+	enabled, err := strconv.ParseBool(utils.ToString(arcaneBlock[arcaneAutoDeployKey]))
+	return err == nil && enabled
+}
+
 func mergeArcaneComposeMetadata(target *ArcaneComposeMetadata, source ArcaneComposeMetadata) {
 	if target == nil {
 		return
@@ -58,3 +58,20 @@ services:
 	require.Equal(t, "https://example.com/icon.png", meta.ProjectIconURL)
 	require.Equal(t, []string{"https://example.com/docs"}, meta.ProjectURLS)
 }
+
+func TestParseArcaneComposeMetadata_AutoDeploy(t *testing.T) {
+	tempDir := t.TempDir()
+
+	composePath := filepath.Join(tempDir, "compose.yaml")
+	require.NoError(t, os.WriteFile(composePath, []byte("services:\n  app:\n    image: nginx:alpine\nx-arcane:\n  auto-deploy: true\n"), 0o600))
+
+	meta, err := ParseArcaneComposeMetadata(context.Background(), composePath)
+	require.NoError(t, err)
+	require.True(t, meta.AutoDeploy)
+
+	require.NoError(t, os.WriteFile(composePath, []byte("services:\n  app:\n    image: nginx:alpine\nx-arcane:\n  auto-deploy: \"no\"\n"), 0o600))
+
+	meta, err = ParseArcaneComposeMetadata(context.Background(), composePath)
+	require.NoError(t, err)
+	require.False(t, meta.AutoDeploy)
+}
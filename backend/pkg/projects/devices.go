@@ -0,0 +1,275 @@
+package projects
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	composetypes "github.com/compose-spec/compose-go/v2/types"
+	"github.com/getarcaneapp/arcane/types/container"
+	"github.com/moby/moby/api/types/system"
+)
+
+const (
+	nvidiaRuntime = "nvidia"
+	cdiDriver     = "cdi"
+	// nvidiaGPUCDIPrefix prefixes the CDI names of the GPUs described by
+	// "nvidia-ctk cdi generate", e.g. "nvidia.com/gpu=0".
+	nvidiaGPUCDIPrefix = "nvidia.com/gpu="
+)
+
+// DeviceError is returned when the Docker host cannot provide the runtimes or
+// devices that services request.
+type DeviceError struct {
+	Problems []string
+	// Err is the daemon error the problems were derived from, if any.
+	Err error
+}
+
+func (e *DeviceError) Error() string {
+	msg := "the Docker host cannot provide the requested devices: " + strings.Join(e.Problems, "; ")
+	if e.Err != nil {
+		msg += " (" + e.Err.Error() + ")"
+	}
+	return msg
+}
+
+func (e *DeviceError) Unwrap() error {
+	return e.Err
+}
+
+// ServiceDeviceRequests returns the device requests of a service: its gpus and
+// the devices reserved under deploy.resources.reservations. Entries of gpus
+// without capabilities request the "gpu" capability, as they do in Compose.
+func ServiceDeviceRequests(svc composetypes.ServiceConfig) []composetypes.DeviceRequest {
+	requests := make([]composetypes.DeviceRequest, 0, len(svc.Gpus))
+	for _, req := range svc.Gpus {
+		if len(req.Capabilities) == 0 {
+			req.Capabilities = []string{"gpu"}
+		}
+		requests = append(requests, req)
+	}
+	if svc.Deploy != nil && svc.Deploy.Resources.Reservations != nil {
+		requests = append(requests, svc.Deploy.Resources.Reservations.Devices...)
+	}
+	return requests
+}
+
+// ServiceDevices returns the device mappings and device requests of a service
+// in the form the API reports them.
+func ServiceDevices(svc composetypes.ServiceConfig) ([]container.DeviceMapping, []container.DeviceRequest) {
+	var mappings []container.DeviceMapping
+	for _, d := range svc.Devices {
+		mappings = append(mappings, container.DeviceMapping{
+			PathOnHost:        d.Source,
+			PathInContainer:   d.Target,
+			CgroupPermissions: d.Permissions,
+		})
+	}
+
+	var requests []container.DeviceRequest
+	for _, req := range ServiceDeviceRequests(svc) {
+		out := container.DeviceRequest{
+			Driver:    req.Driver,
+			Count:     int(req.Count),
+			DeviceIDs: req.IDs,
+		}
+		if len(req.Capabilities) > 0 {
+			out.Capabilities = [][]string{req.Capabilities}
+		}
+		requests = append(requests, out)
+	}
+	return mappings, requests
+}
+
+// RequestsDevices reports whether any of the services sets a runtime or
+// requests devices from a device driver. Only the named services are
+// considered when services is set.
+func RequestsDevices(proj *composetypes.Project, services []string) bool {
+	for name, svc := range proj.Services {
+		if len(services) > 0 && !slices.Contains(services, name) {
+			continue
+		}
+		if svc.Runtime != "" || len(ServiceDeviceRequests(svc)) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckDevices checks that the Docker host described by info can provide the
+// runtimes and devices the services request, so that a deploy fails with an
+// error that says what to fix instead of an error from the daemon. Only the
+// named services are checked when services is set. Host device paths are not
+// checked, since Arcane usually does not see the host's /dev.
+func CheckDevices(proj *composetypes.Project, services []string, info system.Info) error {
+	gpus, gpuCount, cdiDevices := discoveredDevicesInternal(info)
+	_, hasNvidiaRuntime := info.Runtimes[nvidiaRuntime]
+
+	var problems []string
+	names := proj.ServiceNames()
+	slices.Sort(names)
+	for _, name := range names {
+		if len(services) > 0 && !slices.Contains(services, name) {
+			continue
+		}
+		svc := proj.Services[name]
+
+		if svc.Runtime != "" {
+			if _, ok := info.Runtimes[svc.Runtime]; !ok {
+				problems = append(problems, fmt.Sprintf("service %q uses the %q runtime, which is not registered with Docker (registered: %s)",
+					name, svc.Runtime, strings.Join(runtimeNamesInternal(info), ", ")))
+			}
+		}
+
+		for _, req := range ServiceDeviceRequests(svc) {
+			switch {
+			case req.Driver == cdiDriver:
+				if len(info.CDISpecDirs) == 0 {
+					problems = append(problems, fmt.Sprintf("service %q requests CDI devices, but CDI is disabled on the Docker host", name))
+					continue
+				}
+				// Daemons before 28.2 do not report the devices they found.
+				if len(cdiDevices) == 0 {
+					continue
+				}
+				for _, id := range req.IDs {
+					if _, ok := cdiDevices[id]; !ok {
+						problems = append(problems, fmt.Sprintf("service %q requests CDI device %q, which is not defined on the Docker host", name, id))
+					}
+				}
+
+			case isGPURequestInternal(req):
+				if !hasNvidiaRuntime && len(gpus) == 0 {
+					problems = append(problems, fmt.Sprintf("service %q requests GPUs, but the NVIDIA container runtime is not registered with Docker; "+
+						"install the NVIDIA Container Toolkit and run \"nvidia-ctk runtime configure --runtime=docker\"", name))
+					continue
+				}
+				// Counts and IDs can only be checked when the GPUs are
+				// described through CDI.
+				if len(gpus) == 0 {
+					continue
+				}
+				if req.Count > 0 && int(req.Count) > gpuCount {
+					problems = append(problems, fmt.Sprintf("service %q requests %d GPUs, but the Docker host has %d", name, req.Count, gpuCount))
+				}
+				for _, id := range req.IDs {
+					if _, ok := gpus[id]; !ok {
+						problems = append(problems, fmt.Sprintf("service %q requests GPU %q, which the Docker host does not have", name, id))
+					}
+				}
+			}
+		}
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	return &DeviceError{Problems: problems}
+}
+
+// deviceErrorHintsInternal map the daemon errors of failed device passthrough
+// to what needs fixing on the host.
+var deviceErrorHintsInternal = []struct {
+	pattern *regexp.Regexp
+	hint    func(match []string) string
+}{
+	{
+		pattern: regexp.MustCompile(`could not select device driver "[^"]*" with capabilities: (\[.*?\]\])`),
+		hint: func(m []string) string {
+			return fmt.Sprintf("no device driver on the Docker host provides the capabilities %s; for NVIDIA GPUs, install the NVIDIA Container Toolkit and restart Docker", m[1])
+		},
+	},
+	{
+		pattern: regexp.MustCompile(`unknown or invalid runtime name: (\S+)`),
+		hint: func(m []string) string {
+			return fmt.Sprintf("the %q runtime is not registered with Docker", m[1])
+		},
+	},
+	{
+		pattern: regexp.MustCompile(`error gathering device information while adding custom device "([^"]+)"`),
+		hint: func(m []string) string {
+			return fmt.Sprintf("device %s does not exist on the Docker host", m[1])
+		},
+	},
+	{
+		pattern: regexp.MustCompile(`(?m)nvidia-container-cli: initialization error: (.*?)(?:: unknown)?$`),
+		hint: func(m []string) string {
+			return fmt.Sprintf("the NVIDIA driver could not be initialized on the Docker host (%s); check that the driver is installed and loaded", m[1])
+		},
+	},
+	{
+		pattern: regexp.MustCompile(`unresolvable CDI devices (\S+(?:, \S+)*)`),
+		hint: func(m []string) string {
+			return fmt.Sprintf("the CDI devices %s are not defined on the Docker host; generate their specification, e.g. with \"nvidia-ctk cdi generate\"", m[1])
+		},
+	},
+}
+
+// ExplainDeviceError returns a *DeviceError that says how to fix err when err
+// is a daemon error about device passthrough, and err otherwise.
+func ExplainDeviceError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var deviceErr *DeviceError
+	if errors.As(err, &deviceErr) {
+		return err
+	}
+	for _, h := range deviceErrorHintsInternal {
+		if m := h.pattern.FindStringSubmatch(err.Error()); m != nil {
+			return &DeviceError{Problems: []string{h.hint(m)}, Err: err}
+		}
+	}
+	return err
+}
+
+func isGPURequestInternal(req composetypes.DeviceRequest) bool {
+	return req.Driver == nvidiaRuntime || slices.Contains(req.Capabilities, "gpu")
+}
+
+// discoveredDevicesInternal returns the names of the NVIDIA GPUs and all CDI
+// devices the daemon discovered, and the number of GPUs. GPUs are listed by
+// index and by UUID, so only indexes are counted.
+func discoveredDevicesInternal(info system.Info) (gpus map[string]struct{}, gpuCount int, cdiDevices map[string]struct{}) {
+	gpus = map[string]struct{}{}
+	cdiDevices = map[string]struct{}{}
+	for _, d := range info.DiscoveredDevices {
+		if d.Source != cdiDriver {
+			continue
+		}
+		cdiDevices[d.ID] = struct{}{}
+		name, ok := strings.CutPrefix(d.ID, nvidiaGPUCDIPrefix)
+		if !ok || name == "all" {
+			continue
+		}
+		gpus[name] = struct{}{}
+		if isIndexInternal(name) {
+			gpuCount++
+		}
+	}
+	return gpus, gpuCount, cdiDevices
+}
+
+func isIndexInternal(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func runtimeNamesInternal(info system.Info) []string {
+	names := make([]string, 0, len(info.Runtimes))
+	for name := range info.Runtimes {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
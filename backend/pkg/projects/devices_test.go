@@ -0,0 +1,158 @@
+package projects
+
+import (
+	"errors"
+	"testing"
+
+	composetypes "github.com/compose-spec/compose-go/v2/types"
+	"github.com/getarcaneapp/arcane/types/container"
+	"github.com/moby/moby/api/types/system"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func gpuProjectInternal() *composetypes.Project {
+	return &composetypes.Project{
+		Name: "ml",
+		Services: composetypes.Services{
+			"trainer": {
+				Name: "trainer",
+				Deploy: &composetypes.DeployConfig{Resources: composetypes.Resources{Reservations: &composetypes.Resource{
+					Devices: []composetypes.DeviceRequest{{Driver: "nvidia", Count: 2, Capabilities: []string{"gpu"}}},
+				}}},
+			},
+			"web": {Name: "web"},
+		},
+	}
+}
+
+func TestServiceDevices(t *testing.T) {
+	svc := composetypes.ServiceConfig{
+		Devices: []composetypes.DeviceMapping{{Source: "/dev/dri", Target: "/dev/dri", Permissions: "rwm"}},
+		Gpus:    []composetypes.DeviceRequest{{Count: -1}},
+	}
+
+	mappings, requests := ServiceDevices(svc)
+	assert.Equal(t, []container.DeviceMapping{{PathOnHost: "/dev/dri", PathInContainer: "/dev/dri", CgroupPermissions: "rwm"}}, mappings)
+	require.Len(t, requests, 1)
+	assert.Equal(t, -1, requests[0].Count)
+	assert.Equal(t, [][]string{{"gpu"}}, requests[0].Capabilities, "gpus entries request the gpu capability")
+}
+
+func TestCheckDevices(t *testing.T) {
+	proj := gpuProjectInternal()
+
+	t.Run("nvidia runtime registered", func(t *testing.T) {
+		info := system.Info{Runtimes: map[string]system.RuntimeWithStatus{"runc": {}, "nvidia": {}}}
+		assert.NoError(t, CheckDevices(proj, nil, info))
+	})
+
+	t.Run("no nvidia runtime", func(t *testing.T) {
+		info := system.Info{Runtimes: map[string]system.RuntimeWithStatus{"runc": {}}}
+		err := CheckDevices(proj, nil, info)
+		var deviceErr *DeviceError
+		require.ErrorAs(t, err, &deviceErr)
+		require.Len(t, deviceErr.Problems, 1)
+		assert.Contains(t, deviceErr.Problems[0], `service "trainer" requests GPUs`)
+		assert.Contains(t, deviceErr.Problems[0], "nvidia-ctk runtime configure")
+	})
+
+	t.Run("only named services are checked", func(t *testing.T) {
+		info := system.Info{Runtimes: map[string]system.RuntimeWithStatus{"runc": {}}}
+		assert.NoError(t, CheckDevices(proj, []string{"web"}, info))
+		assert.False(t, RequestsDevices(proj, []string{"web"}))
+		assert.True(t, RequestsDevices(proj, nil))
+	})
+
+	t.Run("more GPUs than discovered", func(t *testing.T) {
+		info := system.Info{
+			Runtimes:    map[string]system.RuntimeWithStatus{"runc": {}},
+			CDISpecDirs: []string{"/etc/cdi"},
+			DiscoveredDevices: []system.DeviceInfo{
+				{Source: "cdi", ID: "nvidia.com/gpu=0"},
+				{Source: "cdi", ID: "nvidia.com/gpu=GPU-8f6c1a2e"},
+				{Source: "cdi", ID: "nvidia.com/gpu=all"},
+			},
+		}
+		err := CheckDevices(proj, nil, info)
+		var deviceErr *DeviceError
+		require.ErrorAs(t, err, &deviceErr)
+		assert.Equal(t, []string{`service "trainer" requests 2 GPUs, but the Docker host has 1`}, deviceErr.Problems)
+	})
+
+	t.Run("unknown runtime and CDI device", func(t *testing.T) {
+		proj := &composetypes.Project{
+			Name: "app",
+			Services: composetypes.Services{
+				"app": {
+					Name:    "app",
+					Runtime: "kata",
+					Deploy: &composetypes.DeployConfig{Resources: composetypes.Resources{Reservations: &composetypes.Resource{
+						Devices: []composetypes.DeviceRequest{{Driver: "cdi", IDs: []string{"vendor.com/device=foo"}}},
+					}}},
+				},
+			},
+		}
+		info := system.Info{
+			Runtimes:          map[string]system.RuntimeWithStatus{"runc": {}},
+			CDISpecDirs:       []string{"/etc/cdi"},
+			DiscoveredDevices: []system.DeviceInfo{{Source: "cdi", ID: "vendor.com/device=bar"}},
+		}
+		err := CheckDevices(proj, nil, info)
+		var deviceErr *DeviceError
+		require.ErrorAs(t, err, &deviceErr)
+		assert.Equal(t, []string{
+			`service "app" uses the "kata" runtime, which is not registered with Docker (registered: runc)`,
+			`service "app" requests CDI device "vendor.com/device=foo", which is not defined on the Docker host`,
+		}, deviceErr.Problems)
+	})
+}
+
+func TestExplainDeviceError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  string
+		hint string
+	}{
+		{
+			name: "no gpu driver",
+			err:  `Error response from daemon: could not select device driver "" with capabilities: [[gpu]]`,
+			hint: "no device driver on the Docker host provides the capabilities [[gpu]]",
+		},
+		{
+			name: "unknown runtime",
+			err:  "Error response from daemon: unknown or invalid runtime name: nvidia",
+			hint: `the "nvidia" runtime is not registered with Docker`,
+		},
+		{
+			name: "missing device",
+			err:  `error gathering device information while adding custom device "/dev/dri": no such file or directory`,
+			hint: "device /dev/dri does not exist on the Docker host",
+		},
+		{
+			name: "driver not loaded",
+			err:  "nvidia-container-cli: initialization error: nvml error: driver not loaded: unknown",
+			hint: "the NVIDIA driver could not be initialized on the Docker host (nvml error: driver not loaded)",
+		},
+		{
+			name: "unresolvable cdi device",
+			err:  "Error response from daemon: CDI device injection failed: unresolvable CDI devices nvidia.com/gpu=1",
+			hint: "the CDI devices nvidia.com/gpu=1 are not defined on the Docker host",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			daemonErr := errors.New(tt.err)
+			err := ExplainDeviceError(daemonErr)
+			var deviceErr *DeviceError
+			require.ErrorAs(t, err, &deviceErr)
+			assert.Contains(t, deviceErr.Problems[0], tt.hint)
+			assert.ErrorIs(t, err, daemonErr)
+		})
+	}
+
+	other := errors.New("pull access denied")
+	assert.Equal(t, other, ExplainDeviceError(other))
+	assert.NoError(t, ExplainDeviceError(nil))
+}
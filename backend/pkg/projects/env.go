@@ -181,6 +181,7 @@ func ParseProjectEnvContent(content string, contextEnv EnvMap) (EnvMap, error) {
 
 // parseEnvWithContext parses environment variables from an io.Reader using compose-go's
 // dotenv parser with variable expansion using the provided context lookup map.
+// ${arcane:name} secret placeholders are kept verbatim; they are resolved at load time.
 func parseEnvWithContext(r io.Reader, contextEnv EnvMap) (EnvMap, error) {
 	// Create lookup function for variable expansion
 	// Checks contextEnv first (previously loaded vars), then process environment
@@ -191,11 +192,18 @@ func parseEnvWithContext(r io.Reader, contextEnv EnvMap) (EnvMap, error) {
 		return os.LookupEnv(key)
 	}
 
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read env: %w", err)
+	}
+	protected, tokens := protectSecretPlaceholdersInternal(string(content))
+
 	// Use compose-go's dotenv parser with lookup support for variable expansion
-	envMap, err := dotenv.ParseWithLookup(r, lookupFn)
+	envMap, err := dotenv.ParseWithLookup(strings.NewReader(protected), lookupFn)
 	if err != nil {
 		return nil, fmt.Errorf("parse env: %w", err)
 	}
 
+	restoreSecretPlaceholdersInternal(envMap, tokens)
 	return EnvMap(envMap), nil
 }
@@ -0,0 +1,243 @@
+package projects
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/getarcaneapp/arcane/types/project"
+)
+
+// ComposeVariableRef is a single ${VAR} or $VAR reference in a compose file.
+type ComposeVariableRef struct {
+	Name string
+	Line int
+	// HasDefault is set for ${VAR:-x}, ${VAR-x}, ${VAR:+x} and ${VAR+x}.
+	HasDefault bool
+	// Required is set for ${VAR:?msg} and ${VAR?msg}.
+	Required bool
+}
+
+// builtinComposeVariables are set by Arcane or compose when a project loads.
+var builtinComposeVariables = []string{"PWD", "COMPOSE_PROJECT_NAME"}
+
+// ExtractComposeVariables returns the variable references in compose content
+// in order of appearance. $$ escapes and comment lines are skipped, and
+// references nested in a default value are reported as well.
+func ExtractComposeVariables(content string) []ComposeVariableRef {
+	var refs []ComposeVariableRef
+	for i, line := range strings.Split(content, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "#") {
+			continue
+		}
+		refs = append(refs, extractLineVariablesInternal(line, i+1)...)
+	}
+	return refs
+}
+
+func extractLineVariablesInternal(line string, lineNo int) []ComposeVariableRef {
+	var refs []ComposeVariableRef
+	for i := 0; i < len(line); i++ {
+		if line[i] != '$' || i+1 >= len(line) {
+			continue
+		}
+		next := line[i+1]
+		switch {
+		case next == '$':
+			i++
+		case next == '{':
+			name := readVariableNameInternal(line[i+2:])
+			if name == "" {
+				continue
+			}
+			ref := ComposeVariableRef{Name: name, Line: lineNo}
+			rest := strings.TrimPrefix(line[i+2+len(name):], ":")
+			if rest != "" {
+				switch rest[0] {
+				case '-', '+':
+					ref.HasDefault = true
+				case '?':
+					ref.Required = true
+				}
+			}
+			refs = append(refs, ref)
+			// Continue right after the name so references inside the
+			// default value are found too.
+			i += 1 + len(name)
+		case isVariableStartInternal(next):
+			name := readVariableNameInternal(line[i+1:])
+			refs = append(refs, ComposeVariableRef{Name: name, Line: lineNo})
+			i += len(name)
+		}
+	}
+	return refs
+}
+
+func isVariableStartInternal(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func readVariableNameInternal(s string) string {
+	if s == "" || !isVariableStartInternal(s[0]) {
+		return ""
+	}
+	end := 1
+	for end < len(s) && (isVariableStartInternal(s[end]) || (s[end] >= '0' && s[end] <= '9')) {
+		end++
+	}
+	return s[:end]
+}
+
+// CheckProjectEnv compares the variables referenced by a compose file and
+// its includes with the process environment, the global .env.global in
+// projectsDir and the project .env next to the compose file.
+func CheckProjectEnv(composeFile, projectsDir string, autoInjectEnv bool) (project.EnvCheck, error) {
+	content, err := os.ReadFile(composeFile)
+	if err != nil {
+		return project.EnvCheck{}, fmt.Errorf("read compose file: %w", err)
+	}
+	files := map[string]string{filepath.Base(composeFile): string(content)}
+
+	includes, err := ParseIncludes(composeFile)
+	if err != nil {
+		return project.EnvCheck{}, fmt.Errorf("parse includes: %w", err)
+	}
+	for _, inc := range includes {
+		files[inc.RelativePath] = inc.Content
+	}
+
+	process := make(EnvMap)
+	for _, kv := range os.Environ() {
+		if k, v, ok := strings.Cut(kv, "="); ok {
+			process[k] = v
+		}
+	}
+	global, err := ParseProjectEnvFile(filepath.Join(projectsDir, globalEnvFileName), process)
+	if err != nil {
+		return project.EnvCheck{}, fmt.Errorf("parse global env file: %w", err)
+	}
+	projectEnvPath := filepath.Join(filepath.Dir(composeFile), projectEnvFileName)
+	projectEnv, err := ParseProjectEnvFile(projectEnvPath, process)
+	if err != nil {
+		return project.EnvCheck{}, fmt.Errorf("parse project env file: %w", err)
+	}
+	projectEnvContent, _ := os.ReadFile(projectEnvPath)
+
+	return CompareComposeEnv(EnvCheckInput{
+		Files:             files,
+		ProjectEnv:        projectEnv,
+		ProjectEnvContent: string(projectEnvContent),
+		GlobalEnv:         global,
+		ProcessEnv:        process,
+		AutoInjectEnv:     autoInjectEnv,
+	}), nil
+}
+
+// EnvCheckInput holds the compose files and environment sources of a project.
+type EnvCheckInput struct {
+	// Files maps compose file names to their content.
+	Files      map[string]string
+	ProjectEnv EnvMap
+	// ProjectEnvContent is the raw project .env, used so that variables only
+	// referenced by other .env entries are not reported as unused.
+	ProjectEnvContent string
+	GlobalEnv         EnvMap
+	ProcessEnv        EnvMap
+	AutoInjectEnv     bool
+}
+
+// CompareComposeEnv builds an env check for the given compose file contents.
+// Sources take precedence in the order the loader applies them: the project
+// .env, then the process environment, then the global env file.
+func CompareComposeEnv(in EnvCheckInput) project.EnvCheck {
+	vars := make(map[string]*project.EnvVariable)
+	for _, file := range slices.Sorted(maps.Keys(in.Files)) {
+		for _, ref := range ExtractComposeVariables(in.Files[file]) {
+			v, ok := vars[ref.Name]
+			if !ok {
+				v = &project.EnvVariable{Name: ref.Name, HasDefault: true, Files: map[string][]int{}}
+				vars[ref.Name] = v
+			}
+			v.HasDefault = v.HasDefault && ref.HasDefault
+			v.Required = v.Required || ref.Required
+			if lines := v.Files[file]; len(lines) == 0 || lines[len(lines)-1] != ref.Line {
+				v.Files[file] = append(lines, ref.Line)
+			}
+		}
+	}
+
+	check := project.EnvCheck{
+		Variables: make([]project.EnvVariable, 0, len(vars)),
+		Missing:   []string{},
+		Empty:     []string{},
+		Unused:    []string{},
+	}
+	for _, name := range slices.Sorted(maps.Keys(vars)) {
+		v := vars[name]
+		value, source := lookupEnvSourceInternal(name, in.ProjectEnv, in.GlobalEnv, in.ProcessEnv)
+		v.Defined = source != ""
+		v.Source = source
+		check.Variables = append(check.Variables, *v)
+
+		switch {
+		case v.HasDefault:
+		case !v.Defined:
+			check.Missing = append(check.Missing, name)
+		case value == "" && source != project.EnvVariableSourceBuiltin:
+			check.Empty = append(check.Empty, name)
+		}
+	}
+
+	if !in.AutoInjectEnv {
+		referencedInEnv := make(map[string]struct{})
+		for _, ref := range ExtractComposeVariables(in.ProjectEnvContent) {
+			referencedInEnv[ref.Name] = struct{}{}
+		}
+		for _, name := range slices.Sorted(maps.Keys(in.ProjectEnv)) {
+			_, used := vars[name]
+			_, usedInEnv := referencedInEnv[name]
+			if !used && !usedInEnv {
+				check.Unused = append(check.Unused, name)
+			}
+		}
+	}
+	return check
+}
+
+func lookupEnvSourceInternal(name string, projectEnv, global, process EnvMap) (string, project.EnvVariableSource) {
+	if value, ok := projectEnv[name]; ok {
+		return value, project.EnvVariableSourceProject
+	}
+	if slices.Contains(builtinComposeVariables, name) {
+		return "", project.EnvVariableSourceBuiltin
+	}
+	if value, ok := process[name]; ok {
+		return value, project.EnvVariableSourceProcess
+	}
+	if value, ok := global[name]; ok {
+		return value, project.EnvVariableSourceGlobal
+	}
+	return "", ""
+}
+
+// EnvCheckWarnings turns an env check into human readable warnings.
+func EnvCheckWarnings(check project.EnvCheck) []string {
+	var warnings []string
+	for _, v := range check.Variables {
+		if !slices.Contains(check.Missing, v.Name) {
+			continue
+		}
+		if v.Required {
+			warnings = append(warnings, fmt.Sprintf("Variable %s is required but not defined; deploying will fail", v.Name))
+		} else {
+			warnings = append(warnings, fmt.Sprintf("Variable %s is not defined and will be substituted with an empty string", v.Name))
+		}
+	}
+	for _, name := range check.Empty {
+		warnings = append(warnings, fmt.Sprintf("Variable %s is defined but empty", name))
+	}
+	return warnings
+}
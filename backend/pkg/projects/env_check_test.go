@@ -0,0 +1,71 @@
+package projects
+
+import (
+	"testing"
+
+	"github.com/getarcaneapp/arcane/types/project"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractComposeVariables(t *testing.T) {
+	content := `services:
+  app:
+    image: "nginx:${TAG:-latest}"
+    # comment with ${IGNORED}
+    environment:
+      DSN: postgres://$DB_USER:${DB_PASSWORD:?set a password}@db/app
+      PRICE: $$5
+      NESTED: ${OUTER:-${INNER}}
+      PLUS: ${FLAG+on}
+`
+	refs := ExtractComposeVariables(content)
+	assert.Equal(t, []ComposeVariableRef{
+		{Name: "TAG", Line: 3, HasDefault: true},
+		{Name: "DB_USER", Line: 6},
+		{Name: "DB_PASSWORD", Line: 6, Required: true},
+		{Name: "OUTER", Line: 8, HasDefault: true},
+		{Name: "INNER", Line: 8},
+		{Name: "FLAG", Line: 9, HasDefault: true},
+	}, refs)
+}
+
+func TestCompareComposeEnv(t *testing.T) {
+	files := map[string]string{
+		"compose.yaml": "services:\n  app:\n    image: app:${TAG:-latest}\n    environment:\n      A: ${FROM_PROJECT}\n      B: ${FROM_GLOBAL}\n      C: ${MISSING}\n      D: ${EMPTY}\n      E: ${SECRET:?required}\n      F: ${PWD}\n",
+		"extra.yaml":   "services:\n  worker:\n    environment:\n      A: ${FROM_PROJECT}\n",
+	}
+	in := EnvCheckInput{
+		Files:             files,
+		ProjectEnv:        EnvMap{"FROM_PROJECT": "x", "EMPTY": "", "UNUSED": "y", "BASE": "z", "DERIVED": "z/path"},
+		ProjectEnvContent: "FROM_PROJECT=x\nEMPTY=\nUNUSED=y\nBASE=z\nDERIVED=${BASE}/path\n",
+		GlobalEnv:         EnvMap{"FROM_GLOBAL": "g"},
+		ProcessEnv:        EnvMap{"HOME": "/root"},
+	}
+
+	check := CompareComposeEnv(in)
+	assert.Equal(t, []string{"MISSING", "SECRET"}, check.Missing)
+	assert.Equal(t, []string{"EMPTY"}, check.Empty)
+	assert.Equal(t, []string{"DERIVED", "UNUSED"}, check.Unused)
+
+	byName := make(map[string]project.EnvVariable)
+	for _, v := range check.Variables {
+		byName[v.Name] = v
+	}
+	require.Len(t, byName, 7)
+	assert.Equal(t, project.EnvVariableSourceProject, byName["FROM_PROJECT"].Source)
+	assert.Equal(t, map[string][]int{"compose.yaml": {5}, "extra.yaml": {4}}, byName["FROM_PROJECT"].Files)
+	assert.Equal(t, project.EnvVariableSourceGlobal, byName["FROM_GLOBAL"].Source)
+	assert.Equal(t, project.EnvVariableSourceBuiltin, byName["PWD"].Source)
+	assert.True(t, byName["TAG"].HasDefault)
+	assert.True(t, byName["SECRET"].Required)
+
+	warnings := EnvCheckWarnings(check)
+	require.Len(t, warnings, 3)
+	assert.Contains(t, warnings[0], "MISSING")
+	assert.Contains(t, warnings[1], "SECRET")
+	assert.Contains(t, warnings[1], "required")
+
+	in.AutoInjectEnv = true
+	assert.Empty(t, CompareComposeEnv(in).Unused)
+}
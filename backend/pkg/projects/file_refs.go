@@ -0,0 +1,199 @@
+package projects
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/getarcaneapp/arcane/types/project"
+	"github.com/goccy/go-yaml"
+)
+
+type resolvedFileReference struct {
+	ref  project.FileReference
+	path string
+}
+
+// FindFileReferences returns the places in the compose file at composeFilePath,
+// and in the files it includes, that point at targetPath: include entries,
+// env_file entries, bind-mount volume sources and config/secret files.
+// The raw YAML is read without interpolation, so references built from
+// variables are not matched.
+func FindFileReferences(projectDir, composeFilePath, targetPath string) ([]project.FileReference, error) {
+	resolved, err := collectFileReferencesInternal(projectDir, composeFilePath, map[string]struct{}{})
+	if err != nil {
+		return nil, err
+	}
+
+	target := filepath.Clean(targetPath)
+	refs := []project.FileReference{}
+	for _, r := range resolved {
+		if r.path == target {
+			refs = append(refs, r.ref)
+		}
+	}
+	return refs, nil
+}
+
+func collectFileReferencesInternal(projectDir, composeFilePath string, visited map[string]struct{}) ([]resolvedFileReference, error) {
+	composeFilePath = filepath.Clean(composeFilePath)
+	if _, seen := visited[composeFilePath]; seen {
+		return nil, nil
+	}
+	visited[composeFilePath] = struct{}{}
+
+	content, err := os.ReadFile(composeFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("read compose file: %w", err)
+	}
+
+	composeData := map[string]any{}
+	if err := yaml.Unmarshal(content, &composeData); err != nil {
+		return nil, fmt.Errorf("parse compose file %s: %w", filepath.Base(composeFilePath), err)
+	}
+
+	baseDir := filepath.Dir(composeFilePath)
+	composeFile := composeFilePath
+	if rel, err := filepath.Rel(projectDir, composeFilePath); err == nil {
+		composeFile = rel
+	}
+
+	var refs []resolvedFileReference
+	add := func(kind, service, value string) {
+		path, ok := resolveReferencePathInternal(baseDir, value)
+		if !ok {
+			return
+		}
+		refs = append(refs, resolvedFileReference{
+			ref:  project.FileReference{Kind: kind, ComposeFile: composeFile, Service: service, Value: value},
+			path: path,
+		})
+	}
+
+	var includePaths []string
+	for _, item := range referenceItemsInternal(composeData["include"]) {
+		switch v := item.(type) {
+		case string:
+			includePaths = append(includePaths, v)
+		case map[string]any:
+			includePaths = append(includePaths, stringsFromReferenceInternal(v["path"])...)
+			for _, p := range stringsFromReferenceInternal(v["env_file"]) {
+				add("env_file", "", p)
+			}
+		}
+	}
+	for _, p := range includePaths {
+		add("include", "", p)
+	}
+
+	if services, ok := composeData["services"].(map[string]any); ok {
+		for _, name := range slices.Sorted(maps.Keys(services)) {
+			svc, ok := services[name].(map[string]any)
+			if !ok {
+				continue
+			}
+			for _, item := range referenceItemsInternal(svc["env_file"]) {
+				switch v := item.(type) {
+				case string:
+					add("env_file", name, v)
+				case map[string]any:
+					if p, ok := v["path"].(string); ok {
+						add("env_file", name, p)
+					}
+				}
+			}
+			for _, item := range referenceItemsInternal(svc["volumes"]) {
+				if source := bindSourceInternal(item); source != "" {
+					add("volume", name, source)
+				}
+			}
+		}
+	}
+
+	for _, kind := range []string{"configs", "secrets"} {
+		defs, ok := composeData[kind].(map[string]any)
+		if !ok {
+			continue
+		}
+		for _, name := range slices.Sorted(maps.Keys(defs)) {
+			if def, ok := defs[name].(map[string]any); ok {
+				if file, ok := def["file"].(string); ok {
+					add(strings.TrimSuffix(kind, "s"), "", file)
+				}
+			}
+		}
+	}
+
+	// References in included files are relative to the included file.
+	for _, p := range includePaths {
+		path, ok := resolveReferencePathInternal(baseDir, p)
+		if !ok {
+			continue
+		}
+		if _, err := os.Stat(path); err != nil {
+			continue
+		}
+		included, err := collectFileReferencesInternal(projectDir, path, visited)
+		if err != nil {
+			return nil, err
+		}
+		refs = append(refs, included...)
+	}
+
+	return refs, nil
+}
+
+// bindSourceInternal returns the host path of a bind-mount volume entry, or ""
+// for named volumes and tmpfs mounts.
+func bindSourceInternal(item any) string {
+	switch v := item.(type) {
+	case string:
+		source, _, found := strings.Cut(v, ":")
+		if !found || !(strings.HasPrefix(source, ".") || strings.HasPrefix(source, "/")) {
+			return ""
+		}
+		return source
+	case map[string]any:
+		if t, _ := v["type"].(string); t != "bind" {
+			return ""
+		}
+		source, _ := v["source"].(string)
+		return source
+	}
+	return ""
+}
+
+func resolveReferencePathInternal(baseDir, value string) (string, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" || strings.HasPrefix(value, "~") || strings.Contains(value, "$") {
+		return "", false
+	}
+	if !filepath.IsAbs(value) {
+		value = filepath.Join(baseDir, value)
+	}
+	return filepath.Clean(value), true
+}
+
+func referenceItemsInternal(v any) []any {
+	switch val := v.(type) {
+	case []any:
+		return val
+	case nil:
+		return nil
+	default:
+		return []any{val}
+	}
+}
+
+func stringsFromReferenceInternal(v any) []string {
+	var out []string
+	for _, item := range referenceItemsInternal(v) {
+		if s, ok := item.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
@@ -0,0 +1,62 @@
+package projects
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/getarcaneapp/arcane/types/project"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindFileReferences(t *testing.T) {
+	dir := t.TempDir()
+
+	compose := `include:
+  - path: extra/db.yaml
+services:
+  web:
+    image: nginx
+    env_file:
+      - ./web.env
+      - path: shared.env
+        required: false
+    volumes:
+      - ./site:/usr/share/nginx/html:ro
+      - data:/data
+      - type: bind
+        source: ./nginx.conf
+        target: /etc/nginx/nginx.conf
+secrets:
+  token:
+    file: ./token.txt
+volumes:
+  data:
+`
+	composePath := filepath.Join(dir, "compose.yaml")
+	require.NoError(t, os.WriteFile(composePath, []byte(compose), 0o600))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "extra"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "extra", "db.yaml"), []byte("services:\n  db:\n    image: postgres\n    env_file: ../shared.env\n"), 0o600))
+
+	refs, err := FindFileReferences(dir, composePath, filepath.Join(dir, "shared.env"))
+	require.NoError(t, err)
+	assert.Equal(t, []project.FileReference{
+		{Kind: "env_file", ComposeFile: "compose.yaml", Service: "web", Value: "shared.env"},
+		{Kind: "env_file", ComposeFile: filepath.Join("extra", "db.yaml"), Service: "db", Value: "../shared.env"},
+	}, refs)
+
+	refs, err = FindFileReferences(dir, composePath, filepath.Join(dir, "extra", "db.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, []project.FileReference{{Kind: "include", ComposeFile: "compose.yaml", Value: "extra/db.yaml"}}, refs)
+
+	for _, name := range []string{"nginx.conf", "token.txt", "web.env"} {
+		refs, err = FindFileReferences(dir, composePath, filepath.Join(dir, name))
+		require.NoError(t, err)
+		assert.Len(t, refs, 1, name)
+	}
+
+	refs, err = FindFileReferences(dir, composePath, filepath.Join(dir, "notes.md"))
+	require.NoError(t, err)
+	assert.Empty(t, refs)
+}
@@ -0,0 +1,177 @@
+package projects
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	composetypes "github.com/compose-spec/compose-go/v2/types"
+	"github.com/getarcaneapp/arcane/types/project"
+)
+
+// Compose lint rule IDs.
+const (
+	LintRuleNoLatestTag           = "no-latest-tag"
+	LintRuleRequireHealthcheck    = "require-healthcheck"
+	LintRuleRequireResourceLimits = "require-resource-limits"
+	LintRuleNoPrivileged          = "no-privileged"
+	LintRuleRequireLabels         = "require-labels"
+)
+
+var lintRules = []string{
+	LintRuleNoLatestTag,
+	LintRuleRequireHealthcheck,
+	LintRuleRequireResourceLimits,
+	LintRuleNoPrivileged,
+	LintRuleRequireLabels,
+}
+
+// LintConfig selects the compose lint rules to enforce. Rules that are not
+// listed are off.
+//
+//	{"rules": {"no-latest-tag": "error", "require-labels": "warning"}, "requiredLabels": ["team"]}
+type LintConfig struct {
+	Rules map[string]project.LintSeverity `json:"rules"`
+	// RequiredLabels are the labels every service must set when
+	// require-labels is on.
+	RequiredLabels []string `json:"requiredLabels"`
+}
+
+// ParseLintConfig parses the JSON lint configuration. An empty string turns
+// every rule off.
+func ParseLintConfig(content string) (LintConfig, error) {
+	var cfg LintConfig
+	if strings.TrimSpace(content) == "" {
+		return cfg, nil
+	}
+
+	dec := json.NewDecoder(strings.NewReader(content))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&cfg); err != nil {
+		return LintConfig{}, fmt.Errorf("parse lint config: %w", err)
+	}
+
+	for rule, severity := range cfg.Rules {
+		if !slices.Contains(lintRules, rule) {
+			return LintConfig{}, fmt.Errorf("unknown lint rule %q", rule)
+		}
+		switch severity {
+		case project.LintSeverityOff, project.LintSeverityWarning, project.LintSeverityError:
+		default:
+			return LintConfig{}, fmt.Errorf("lint rule %s: severity must be off, warning or error", rule)
+		}
+	}
+
+	labels := make([]string, 0, len(cfg.RequiredLabels))
+	for _, label := range cfg.RequiredLabels {
+		if label = strings.TrimSpace(label); label != "" {
+			labels = append(labels, label)
+		}
+	}
+	cfg.RequiredLabels = labels
+	if cfg.severityInternal(LintRuleRequireLabels) != project.LintSeverityOff && len(cfg.RequiredLabels) == 0 {
+		return LintConfig{}, fmt.Errorf("lint rule %s needs at least one entry in requiredLabels", LintRuleRequireLabels)
+	}
+
+	return cfg, nil
+}
+
+// Enabled reports whether any rule is on.
+func (c LintConfig) Enabled() bool {
+	for _, rule := range lintRules {
+		if c.severityInternal(rule) != project.LintSeverityOff {
+			return true
+		}
+	}
+	return false
+}
+
+func (c LintConfig) severityInternal(rule string) project.LintSeverity {
+	severity, ok := c.Rules[rule]
+	if !ok || severity == "" {
+		return project.LintSeverityOff
+	}
+	return severity
+}
+
+// LintComposeProject checks the services of proj against the rules turned on
+// in cfg. Findings are sorted by service, then rule.
+func LintComposeProject(proj *composetypes.Project, cfg LintConfig) []project.LintFinding {
+	if proj == nil || !cfg.Enabled() {
+		return nil
+	}
+
+	var findings []project.LintFinding
+	report := func(rule, service, message string) {
+		severity := cfg.severityInternal(rule)
+		if severity == project.LintSeverityOff {
+			return
+		}
+		findings = append(findings, project.LintFinding{Rule: rule, Severity: severity, Service: service, Message: message})
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(proj.Services)) {
+		svc := proj.Services[name]
+
+		if img := strings.TrimSpace(svc.Image); img != "" && ImageUsesLatestTag(img) {
+			report(LintRuleNoLatestTag, name, fmt.Sprintf("image %s is not pinned to a tag or digest other than latest", img))
+		}
+		if svc.HealthCheck == nil || svc.HealthCheck.Disable || len(svc.HealthCheck.Test) == 0 {
+			report(LintRuleRequireHealthcheck, name, "service has no healthcheck")
+		}
+		if serviceCPULimitInternal(svc) <= 0 || serviceMemoryLimitInternal(svc) <= 0 {
+			report(LintRuleRequireResourceLimits, name, "service does not set both a CPU and a memory limit")
+		}
+		if svc.Privileged {
+			report(LintRuleNoPrivileged, name, "service runs in privileged mode")
+		}
+		var missing []string
+		for _, label := range cfg.RequiredLabels {
+			if _, ok := svc.Labels[label]; !ok {
+				missing = append(missing, label)
+			}
+		}
+		if len(missing) > 0 {
+			report(LintRuleRequireLabels, name, "service is missing labels: "+strings.Join(missing, ", "))
+		}
+	}
+
+	return findings
+}
+
+// ImageUsesLatestTag reports whether ref resolves to the latest tag,
+// either explicitly or because it has neither a tag nor a digest.
+func ImageUsesLatestTag(ref string) bool {
+	if strings.Contains(ref, "@") {
+		return false
+	}
+	name := ref[strings.LastIndex(ref, "/")+1:]
+	_, tag, ok := strings.Cut(name, ":")
+	return !ok || tag == "latest"
+}
+
+// LintBlockingFindings returns the findings of rules set to error.
+func LintBlockingFindings(findings []project.LintFinding) []project.LintFinding {
+	var blocking []project.LintFinding
+	for _, f := range findings {
+		if f.Severity == project.LintSeverityError {
+			blocking = append(blocking, f)
+		}
+	}
+	return blocking
+}
+
+// LintError is returned when a compose file breaks lint rules set to error.
+type LintError struct {
+	Findings []project.LintFinding
+}
+
+func (e *LintError) Error() string {
+	parts := make([]string, len(e.Findings))
+	for i, f := range e.Findings {
+		parts[i] = fmt.Sprintf("%s: %s (%s)", f.Service, f.Message, f.Rule)
+	}
+	return "compose file breaks lint rules: " + strings.Join(parts, "; ")
+}
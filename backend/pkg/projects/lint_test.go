@@ -0,0 +1,87 @@
+package projects
+
+import (
+	"testing"
+
+	composetypes "github.com/compose-spec/compose-go/v2/types"
+	"github.com/getarcaneapp/arcane/types/project"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLintConfig(t *testing.T) {
+	cfg, err := ParseLintConfig("")
+	require.NoError(t, err)
+	assert.False(t, cfg.Enabled())
+
+	cfg, err = ParseLintConfig(`{"rules": {"no-latest-tag": "error", "no-privileged": "off"}}`)
+	require.NoError(t, err)
+	assert.True(t, cfg.Enabled())
+
+	_, err = ParseLintConfig(`{"rules": {"no-such-rule": "error"}}`)
+	require.Error(t, err)
+
+	_, err = ParseLintConfig(`{"rules": {"no-latest-tag": "fatal"}}`)
+	require.Error(t, err)
+
+	_, err = ParseLintConfig(`{"rules": {"require-labels": "warning"}, "requiredLabels": [" "]}`)
+	require.Error(t, err)
+}
+
+func TestLintComposeProject(t *testing.T) {
+	proj := &composetypes.Project{
+		Name: "app",
+		Services: composetypes.Services{
+			"web": {
+				Name:       "web",
+				Image:      "nginx",
+				Privileged: true,
+			},
+			"db": {
+				Name:        "db",
+				Image:       "registry.example.com:5000/postgres:16",
+				HealthCheck: &composetypes.HealthCheckConfig{Test: composetypes.HealthCheckTest{"CMD", "pg_isready"}},
+				Labels:      composetypes.Labels{"team": "data"},
+				CPUS:        1,
+				MemLimit:    512 * mib,
+			},
+		},
+	}
+	cfg := LintConfig{
+		Rules: map[string]project.LintSeverity{
+			LintRuleNoLatestTag:           project.LintSeverityError,
+			LintRuleRequireHealthcheck:    project.LintSeverityWarning,
+			LintRuleRequireResourceLimits: project.LintSeverityWarning,
+			LintRuleNoPrivileged:          project.LintSeverityError,
+			LintRuleRequireLabels:         project.LintSeverityWarning,
+		},
+		RequiredLabels: []string{"team"},
+	}
+
+	findings := LintComposeProject(proj, cfg)
+
+	rules := make([]string, len(findings))
+	for i, f := range findings {
+		assert.Equal(t, "web", f.Service)
+		rules[i] = f.Rule
+	}
+	assert.Equal(t, []string{
+		LintRuleNoLatestTag,
+		LintRuleRequireHealthcheck,
+		LintRuleRequireResourceLimits,
+		LintRuleNoPrivileged,
+		LintRuleRequireLabels,
+	}, rules)
+
+	blocking := LintBlockingFindings(findings)
+	require.Len(t, blocking, 2)
+	assert.Contains(t, (&LintError{Findings: blocking}).Error(), "web: service runs in privileged mode (no-privileged)")
+}
+
+func TestImageUsesLatestTag(t *testing.T) {
+	assert.True(t, ImageUsesLatestTag("nginx"))
+	assert.True(t, ImageUsesLatestTag("nginx:latest"))
+	assert.True(t, ImageUsesLatestTag("localhost:5000/nginx"))
+	assert.False(t, ImageUsesLatestTag("localhost:5000/nginx:1.27"))
+	assert.False(t, ImageUsesLatestTag("nginx@sha256:abc"))
+}
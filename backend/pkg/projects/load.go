@@ -92,6 +92,10 @@ func loadComposeProjectInternal(
 		slog.WarnContext(ctx, "Failed to load environment", "error", err)
 	}
 
+	if err := ResolveSecretPlaceholders(ctx, fullEnvMap, injectionVars); err != nil {
+		return nil, fmt.Errorf("resolve secrets: %w", err)
+	}
+
 	maps.Copy(fullEnvMap, envOverride)
 
 	// Set PWD
@@ -101,13 +105,20 @@ func loadComposeProjectInternal(
 		slog.WarnContext(ctx, "Failed to set PWD environment variable", "workdir", workdir, "error", absErr)
 	}
 
+	configFile := composetypes.ConfigFile{Filename: composeFile}
+	if content, readErr := os.ReadFile(composeFile); readErr == nil && HasTemplateVariables(string(content)) {
+		rendered, err := ApplyTemplateVariables(ctx, string(content), projectName, workdir)
+		if err != nil {
+			return nil, fmt.Errorf("apply template variables: %w", err)
+		}
+		configFile.Content = []byte(rendered)
+	}
+
 	// Pass full environment to compose-go for interpolation, compose-go will use this for ${VAR} expansion in the compose file
 	cfg := composetypes.ConfigDetails{
-		Version:    api.ComposeVersion,
-		WorkingDir: workdir,
-		ConfigFiles: []composetypes.ConfigFile{
-			{Filename: composeFile},
-		},
+		Version:     api.ComposeVersion,
+		WorkingDir:  workdir,
+		ConfigFiles: []composetypes.ConfigFile{configFile},
 		Environment: composetypes.Mapping(fullEnvMap),
 	}
 
@@ -137,6 +148,10 @@ func loadComposeProjectInternal(
 
 	injectServiceConfiguration(project, injectionVars, workdir, composeFile)
 
+	if err := applyProjectQuotaInternal(ctx, project); err != nil {
+		return nil, err
+	}
+
 	project.ComposeFiles = []string{composeFile}
 	return project, nil
 }
@@ -0,0 +1,214 @@
+package projects
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"sync"
+
+	composetypes "github.com/compose-spec/compose-go/v2/types"
+	"github.com/getarcaneapp/arcane/types/project"
+)
+
+// ResourceQuotaProvider returns the resource quota of a compose project, or
+// nil when it has none.
+type ResourceQuotaProvider func(ctx context.Context, projectName string) (*project.ResourceQuota, error)
+
+// SkipResourceQuotaKey can be set on a context to load a compose project
+// without applying its resource quota.
+type SkipResourceQuotaKey struct{}
+
+// MinQuotaMemoryBytes is the smallest memory limit Docker accepts.
+const MinQuotaMemoryBytes = 6 * 1024 * 1024
+
+var (
+	resourceQuotaProviderMu sync.RWMutex
+	resourceQuotaProvider   ResourceQuotaProvider
+)
+
+// SetResourceQuotaProvider registers the function that looks up a project's
+// quota when its compose file is loaded.
+func SetResourceQuotaProvider(p ResourceQuotaProvider) {
+	resourceQuotaProviderMu.Lock()
+	defer resourceQuotaProviderMu.Unlock()
+	resourceQuotaProvider = p
+}
+
+func getResourceQuotaProviderInternal() ResourceQuotaProvider {
+	resourceQuotaProviderMu.RLock()
+	defer resourceQuotaProviderMu.RUnlock()
+	return resourceQuotaProvider
+}
+
+// applyProjectQuotaInternal applies the registered quota of proj, if any.
+func applyProjectQuotaInternal(ctx context.Context, proj *composetypes.Project) error {
+	provider := getResourceQuotaProviderInternal()
+	if provider == nil {
+		return nil
+	}
+	if skip, _ := ctx.Value(SkipResourceQuotaKey{}).(bool); skip {
+		return nil
+	}
+
+	quota, err := provider(ctx, proj.Name)
+	if err != nil {
+		return fmt.Errorf("get resource quota: %w", err)
+	}
+	if quota == nil {
+		return nil
+	}
+
+	allocation := ApplyResourceQuota(proj, *quota)
+	for _, warning := range allocation.Warnings {
+		slog.WarnContext(ctx, "Project resource quota", "project", proj.Name, "warning", warning)
+	}
+	return nil
+}
+
+// ApplyResourceQuota sets deploy.resources.limits on services that have no
+// CPU or memory limit, giving each container an equal share of what the
+// quota leaves after the limits declared in the compose file. Declared limits
+// are kept even when they exceed the quota; the returned warnings say so.
+func ApplyResourceQuota(proj *composetypes.Project, quota project.ResourceQuota) project.QuotaAllocation {
+	allocation := project.QuotaAllocation{Services: []project.ServiceAllocation{}, Warnings: []string{}}
+
+	names := proj.ServiceNames()
+	services := make([]project.ServiceAllocation, 0, len(names))
+	var declaredCPUs float64
+	var declaredMemory int64
+	var unlimitedCPUReplicas, unlimitedMemoryReplicas int
+	for _, name := range names {
+		svc := proj.Services[name]
+		alloc := project.ServiceAllocation{
+			Service:     name,
+			Replicas:    serviceReplicasInternal(svc),
+			CPUs:        serviceCPULimitInternal(svc),
+			MemoryBytes: serviceMemoryLimitInternal(svc),
+		}
+		if alloc.CPUs > 0 {
+			declaredCPUs += alloc.CPUs * float64(alloc.Replicas)
+		} else {
+			unlimitedCPUReplicas += alloc.Replicas
+		}
+		if alloc.MemoryBytes > 0 {
+			declaredMemory += alloc.MemoryBytes * int64(alloc.Replicas)
+		} else {
+			unlimitedMemoryReplicas += alloc.Replicas
+		}
+		services = append(services, alloc)
+	}
+
+	var cpuShare float64
+	if quota.CPUs > 0 {
+		if declaredCPUs > quota.CPUs {
+			allocation.Warnings = append(allocation.Warnings, fmt.Sprintf("declared CPU limits total %.2f, above the quota of %.2f", declaredCPUs, quota.CPUs))
+		}
+		if unlimitedCPUReplicas > 0 {
+			// Round down to hundredths of a CPU so the shares never add up to
+			// more than the quota.
+			cpuShare = math.Floor((quota.CPUs-declaredCPUs)/float64(unlimitedCPUReplicas)*100) / 100
+			if cpuShare <= 0 {
+				cpuShare = 0
+				allocation.Warnings = append(allocation.Warnings, "no CPU quota is left for services without a CPU limit")
+			}
+		}
+	}
+
+	var memoryShare int64
+	if quota.MemoryBytes > 0 {
+		if declaredMemory > quota.MemoryBytes {
+			allocation.Warnings = append(allocation.Warnings, fmt.Sprintf("declared memory limits total %s, above the quota of %s", formatQuotaBytesInternal(declaredMemory), formatQuotaBytesInternal(quota.MemoryBytes)))
+		}
+		if unlimitedMemoryReplicas > 0 {
+			// Round down to whole MiB.
+			memoryShare = (quota.MemoryBytes - declaredMemory) / int64(unlimitedMemoryReplicas) / (1024 * 1024) * (1024 * 1024)
+			if memoryShare < MinQuotaMemoryBytes {
+				memoryShare = 0
+				allocation.Warnings = append(allocation.Warnings, "no memory quota is left for services without a memory limit")
+			}
+		}
+	}
+
+	for i := range services {
+		alloc := &services[i]
+		inject := (alloc.CPUs == 0 && cpuShare > 0) || (alloc.MemoryBytes == 0 && memoryShare > 0)
+		if inject {
+			svc := proj.Services[alloc.Service]
+			limits := ensureServiceLimitsInternal(&svc)
+			if alloc.CPUs == 0 && cpuShare > 0 {
+				limits.NanoCPUs = composetypes.NanoCPUs(cpuShare)
+				alloc.CPUs = cpuShare
+				alloc.CPUsInjected = true
+			}
+			if alloc.MemoryBytes == 0 && memoryShare > 0 {
+				limits.MemoryBytes = composetypes.UnitBytes(memoryShare)
+				alloc.MemoryBytes = memoryShare
+				alloc.MemoryInjected = true
+			}
+			proj.Services[alloc.Service] = svc
+		}
+
+		allocation.CPUs += alloc.CPUs * float64(alloc.Replicas)
+		allocation.MemoryBytes += alloc.MemoryBytes * int64(alloc.Replicas)
+	}
+
+	allocation.CPUs = math.Round(allocation.CPUs*100) / 100
+	allocation.Services = services
+	return allocation
+}
+
+// WriteQuotaWarnings writes each warning as a JSON progress line.
+func WriteQuotaWarnings(w io.Writer, warnings []string) {
+	for _, warning := range warnings {
+		writeJSONLine(w, map[string]any{"type": "quota", "status": "Quota warning: " + warning})
+	}
+}
+
+func serviceReplicasInternal(svc composetypes.ServiceConfig) int {
+	if svc.Scale != nil {
+		return max(*svc.Scale, 0)
+	}
+	if svc.Deploy != nil && svc.Deploy.Replicas != nil {
+		return max(*svc.Deploy.Replicas, 0)
+	}
+	return 1
+}
+
+func serviceCPULimitInternal(svc composetypes.ServiceConfig) float64 {
+	if svc.Deploy != nil && svc.Deploy.Resources.Limits != nil && svc.Deploy.Resources.Limits.NanoCPUs > 0 {
+		return roundCPUsInternal(float64(svc.Deploy.Resources.Limits.NanoCPUs))
+	}
+	return roundCPUsInternal(float64(svc.CPUS))
+}
+
+func serviceMemoryLimitInternal(svc composetypes.ServiceConfig) int64 {
+	if svc.Deploy != nil && svc.Deploy.Resources.Limits != nil && svc.Deploy.Resources.Limits.MemoryBytes > 0 {
+		return int64(svc.Deploy.Resources.Limits.MemoryBytes)
+	}
+	return int64(svc.MemLimit)
+}
+
+func ensureServiceLimitsInternal(svc *composetypes.ServiceConfig) *composetypes.Resource {
+	if svc.Deploy == nil {
+		svc.Deploy = &composetypes.DeployConfig{}
+	}
+	if svc.Deploy.Resources.Limits == nil {
+		svc.Deploy.Resources.Limits = &composetypes.Resource{}
+	}
+	return svc.Deploy.Resources.Limits
+}
+
+// roundCPUsInternal drops the float32 noise compose-go stores CPU counts with.
+func roundCPUsInternal(cpus float64) float64 {
+	return math.Round(cpus*1000) / 1000
+}
+
+func formatQuotaBytesInternal(b int64) string {
+	const mib = 1024 * 1024
+	if b >= 1024*mib && b%(1024*mib) == 0 {
+		return fmt.Sprintf("%dGiB", b/(1024*mib))
+	}
+	return fmt.Sprintf("%dMiB", b/mib)
+}
@@ -0,0 +1,110 @@
+package projects
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	composetypes "github.com/compose-spec/compose-go/v2/types"
+	"github.com/getarcaneapp/arcane/types/project"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const mib = 1024 * 1024
+
+func TestApplyResourceQuota_SplitsRemainderBetweenUnlimitedServices(t *testing.T) {
+	two := 2
+	proj := &composetypes.Project{
+		Name: "app",
+		Services: composetypes.Services{
+			"db": {
+				Name: "db",
+				Deploy: &composetypes.DeployConfig{Resources: composetypes.Resources{Limits: &composetypes.Resource{
+					NanoCPUs:    1,
+					MemoryBytes: 1024 * mib,
+				}}},
+			},
+			"web":    {Name: "web", Scale: &two},
+			"worker": {Name: "worker", MemLimit: 512 * mib},
+		},
+	}
+
+	allocation := ApplyResourceQuota(proj, project.ResourceQuota{CPUs: 4, MemoryBytes: 2048 * mib})
+
+	// 3 CPUs are left for 3 unlimited containers (web x2, worker); 512MiB
+	// for the 2 web containers.
+	require.Empty(t, allocation.Warnings)
+	assert.InDelta(t, 4.0, allocation.CPUs, 0.001)
+	assert.Equal(t, int64(2048*mib), allocation.MemoryBytes)
+
+	byName := map[string]project.ServiceAllocation{}
+	for _, s := range allocation.Services {
+		byName[s.Service] = s
+	}
+	assert.False(t, byName["db"].CPUsInjected)
+	assert.True(t, byName["web"].CPUsInjected)
+	assert.True(t, byName["web"].MemoryInjected)
+	assert.Equal(t, 2, byName["web"].Replicas)
+	assert.InDelta(t, 1.0, byName["web"].CPUs, 0.001)
+	assert.Equal(t, int64(256*mib), byName["web"].MemoryBytes)
+	assert.False(t, byName["worker"].MemoryInjected)
+
+	web := proj.Services["web"]
+	require.NotNil(t, web.Deploy)
+	require.NotNil(t, web.Deploy.Resources.Limits)
+	assert.InDelta(t, 1.0, float64(web.Deploy.Resources.Limits.NanoCPUs), 0.001)
+	assert.Equal(t, composetypes.UnitBytes(256*mib), web.Deploy.Resources.Limits.MemoryBytes)
+
+	// Applying again finds nothing left to inject.
+	again := ApplyResourceQuota(proj, project.ResourceQuota{CPUs: 4, MemoryBytes: 2048 * mib})
+	assert.InDelta(t, allocation.CPUs, again.CPUs, 0.001)
+	assert.Equal(t, allocation.MemoryBytes, again.MemoryBytes)
+}
+
+func TestApplyResourceQuota_WarnsWhenDeclaredLimitsExceedQuota(t *testing.T) {
+	proj := &composetypes.Project{
+		Name: "app",
+		Services: composetypes.Services{
+			"big":   {Name: "big", CPUS: 3, MemLimit: 2048 * mib},
+			"small": {Name: "small"},
+		},
+	}
+
+	allocation := ApplyResourceQuota(proj, project.ResourceQuota{CPUs: 2, MemoryBytes: 1024 * mib})
+
+	require.Len(t, allocation.Warnings, 4)
+	assert.Contains(t, allocation.Warnings[0], "above the quota of 2.00")
+	assert.Contains(t, allocation.Warnings[2], "2GiB, above the quota of 1GiB")
+	assert.Nil(t, proj.Services["small"].Deploy)
+}
+
+func TestLoadComposeProject_AppliesResourceQuota(t *testing.T) {
+	t.Cleanup(func() { SetResourceQuotaProvider(nil) })
+	SetResourceQuotaProvider(func(_ context.Context, name string) (*project.ResourceQuota, error) {
+		if name != "app" {
+			return nil, nil
+		}
+		return &project.ResourceQuota{MemoryBytes: 512 * mib}, nil
+	})
+
+	projectsDir := t.TempDir()
+	workdir := filepath.Join(projectsDir, "app")
+	require.NoError(t, os.MkdirAll(workdir, 0o755))
+	composeFile := filepath.Join(workdir, "compose.yaml")
+	require.NoError(t, os.WriteFile(composeFile, []byte("services:\n  app:\n    image: nginx:alpine\n"), 0o600))
+
+	proj, err := LoadComposeProject(context.Background(), composeFile, "app", projectsDir, false, nil)
+	require.NoError(t, err)
+	app := proj.Services["app"]
+	require.NotNil(t, app.Deploy)
+	require.NotNil(t, app.Deploy.Resources.Limits)
+	assert.Equal(t, composetypes.UnitBytes(512*mib), app.Deploy.Resources.Limits.MemoryBytes)
+	assert.Zero(t, app.Deploy.Resources.Limits.NanoCPUs)
+
+	ctx := context.WithValue(context.Background(), SkipResourceQuotaKey{}, true)
+	proj, err = LoadComposeProject(ctx, composeFile, "app", projectsDir, false, nil)
+	require.NoError(t, err)
+	assert.Nil(t, proj.Services["app"].Deploy)
+}
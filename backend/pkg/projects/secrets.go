@@ -0,0 +1,108 @@
+package projects
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// SecretResolver returns the plaintext value of a named Arcane secret.
+type SecretResolver func(ctx context.Context, name string) (string, error)
+
+var (
+	secretResolverMu sync.RWMutex
+	secretResolver   SecretResolver
+
+	secretPlaceholderRegex = regexp.MustCompile(`\$\{arcane:([A-Za-z0-9_.-]+)\}`)
+)
+
+const secretTokenPrefix = "ARCANESECRETPLACEHOLDER"
+
+// SetSecretResolver registers the function used to resolve ${arcane:name}
+// placeholders in env files when a compose project is loaded.
+func SetSecretResolver(r SecretResolver) {
+	secretResolverMu.Lock()
+	defer secretResolverMu.Unlock()
+	secretResolver = r
+}
+
+func getSecretResolverInternal() SecretResolver {
+	secretResolverMu.RLock()
+	defer secretResolverMu.RUnlock()
+	return secretResolver
+}
+
+// protectSecretPlaceholdersInternal swaps ${arcane:name} placeholders for plain
+// tokens so the dotenv parser does not reject them as invalid interpolation.
+func protectSecretPlaceholdersInternal(content string) (string, map[string]string) {
+	if !strings.Contains(content, "${arcane:") {
+		return content, nil
+	}
+
+	tokens := make(map[string]string)
+	protected := secretPlaceholderRegex.ReplaceAllStringFunc(content, func(match string) string {
+		token := secretTokenPrefix + strconv.Itoa(len(tokens)) + "X"
+		tokens[token] = match
+		return token
+	})
+	return protected, tokens
+}
+
+// restoreSecretPlaceholdersInternal puts the original placeholders back into
+// parsed values, including values that picked them up through ${VAR} expansion.
+func restoreSecretPlaceholdersInternal(env EnvMap, tokens map[string]string) {
+	if len(tokens) == 0 {
+		return
+	}
+	for k, v := range env {
+		if !strings.Contains(v, secretTokenPrefix) {
+			continue
+		}
+		for token, placeholder := range tokens {
+			v = strings.ReplaceAll(v, token, placeholder)
+		}
+		env[k] = v
+	}
+}
+
+// ResolveSecretPlaceholders replaces ${arcane:name} placeholders in the values
+// of each map with the decrypted secret. Every referenced secret must exist.
+func ResolveSecretPlaceholders(ctx context.Context, envs ...EnvMap) error {
+	resolved := make(map[string]string)
+	resolver := getSecretResolverInternal()
+
+	for _, env := range envs {
+		for k, v := range env {
+			if !strings.Contains(v, "${arcane:") {
+				continue
+			}
+
+			var resolveErr error
+			env[k] = secretPlaceholderRegex.ReplaceAllStringFunc(v, func(match string) string {
+				name := secretPlaceholderRegex.FindStringSubmatch(match)[1]
+				if value, ok := resolved[name]; ok {
+					return value
+				}
+				if resolver == nil {
+					resolveErr = fmt.Errorf("variable %s references secret %q but secrets are not available", k, name)
+					return match
+				}
+				value, err := resolver(ctx, name)
+				if err != nil {
+					resolveErr = fmt.Errorf("variable %s references secret %q: %w", k, name, err)
+					return match
+				}
+				resolved[name] = value
+				return value
+			})
+			if resolveErr != nil {
+				return resolveErr
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package projects
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseProjectEnvContent_KeepsSecretPlaceholders(t *testing.T) {
+	env, err := ParseProjectEnvContent("DB_PASSWORD=${arcane:db_password}\nDSN=postgres://app:${DB_PASSWORD}@db/app\n", EnvMap{})
+	require.NoError(t, err)
+	assert.Equal(t, "${arcane:db_password}", env["DB_PASSWORD"])
+	assert.Equal(t, "postgres://app:${arcane:db_password}@db/app", env["DSN"])
+}
+
+func TestLoadComposeProject_ResolvesSecretPlaceholders(t *testing.T) {
+	t.Cleanup(func() { SetSecretResolver(nil) })
+
+	projectsDir := t.TempDir()
+	workdir := filepath.Join(projectsDir, "app")
+	require.NoError(t, os.MkdirAll(workdir, 0o755))
+	composeFile := filepath.Join(workdir, "compose.yaml")
+	require.NoError(t, os.WriteFile(composeFile, []byte("services:\n  app:\n    image: nginx:alpine\n    environment:\n      PASSWORD: ${DB_PASSWORD}\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(workdir, ".env"), []byte("DB_PASSWORD=${arcane:db_password}\n"), 0o600))
+
+	SetSecretResolver(func(_ context.Context, name string) (string, error) {
+		if name == "db_password" {
+			return "s3cr3t", nil
+		}
+		return "", errors.New("not found")
+	})
+
+	project, err := LoadComposeProject(context.Background(), composeFile, "app", projectsDir, false, nil)
+	require.NoError(t, err)
+	require.NotNil(t, project.Services["app"].Environment["PASSWORD"])
+	assert.Equal(t, "s3cr3t", *project.Services["app"].Environment["PASSWORD"])
+
+	require.NoError(t, os.WriteFile(filepath.Join(workdir, ".env"), []byte("DB_PASSWORD=${arcane:missing}\n"), 0o600))
+	_, err = LoadComposeProject(context.Background(), composeFile, "app", projectsDir, false, nil)
+	require.ErrorContains(t, err, "missing")
+}
@@ -0,0 +1,378 @@
+package projects
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/getarcaneapp/arcane/types/project"
+	"github.com/goccy/go-yaml/ast"
+)
+
+// ErrComposeServiceNotFound is returned when a patched service is not
+// defined in the compose file.
+var ErrComposeServiceNotFound = errors.New("service not found in compose file")
+
+// ServicePatchRewrite is a compose file with one service patched.
+type ServicePatchRewrite struct {
+	// Content is the patched compose file.
+	Content []byte
+	// Changes describe each changed value. Environment values are left out,
+	// since they often hold secrets.
+	Changes []string
+}
+
+// PatchComposeService applies patch to the service named service. Existing
+// values are replaced in place and missing ones are added to the service, so
+// the rest of the file is kept as written, see ComposeEditor. Replicas are
+// written to scale when the service uses it and to deploy.replicas otherwise.
+// Ports are matched by container port and protocol.
+func PatchComposeService(content []byte, service string, patch project.ServicePatch) (*ServicePatchRewrite, error) {
+	if patch.Image != nil && patch.Tag != nil {
+		return nil, errors.New("set either image or tag, not both")
+	}
+
+	editor, err := NewComposeEditor(content)
+	if err != nil {
+		return nil, err
+	}
+	services := composeServicesInternal(editor)
+	idx := slices.IndexFunc(services, func(svc composeServiceInternal) bool { return svc.name == service })
+	if idx < 0 {
+		return nil, fmt.Errorf("%w: %s", ErrComposeServiceNotFound, service)
+	}
+	p := &servicePatcherInternal{
+		editor:  editor,
+		service: service,
+		config:  services[idx].config,
+		changes: []string{},
+	}
+
+	if patch.Image != nil {
+		if err := p.setImageInternal(*patch.Image); err != nil {
+			return nil, err
+		}
+	}
+	if patch.Tag != nil {
+		if err := p.setTagInternal(*patch.Tag); err != nil {
+			return nil, err
+		}
+	}
+	if len(patch.Environment) > 0 {
+		if err := p.setEnvironmentInternal(patch.Environment); err != nil {
+			return nil, err
+		}
+	}
+	if patch.Replicas != nil {
+		if err := p.setReplicasInternal(*patch.Replicas); err != nil {
+			return nil, err
+		}
+	}
+	for _, port := range patch.Ports {
+		if err := p.setPortInternal(port); err != nil {
+			return nil, err
+		}
+	}
+
+	return &ServicePatchRewrite{Content: editor.Bytes(), Changes: p.changes}, nil
+}
+
+type servicePatcherInternal struct {
+	editor  *ComposeEditor
+	service string
+	config  *ast.MappingNode
+	changes []string
+}
+
+func (p *servicePatcherInternal) changedInternal(format string, args ...any) {
+	p.changes = append(p.changes, p.service+": "+fmt.Sprintf(format, args...))
+}
+
+// setScalarInternal sets key in mapping, adding it when missing. It returns
+// the previous value and whether the key existed.
+func (p *servicePatcherInternal) setScalarInternal(mapping *ast.MappingNode, key string, value any) (string, bool, error) {
+	node := ComposeMappingValue(mapping, key)
+	if node == nil {
+		return "", false, p.editor.AddMappingEntry(mapping, key, value)
+	}
+	old := composeScalarStringInternal(node)
+	if old == fmt.Sprint(value) {
+		return old, true, nil
+	}
+	if err := p.editor.SetScalar(node, fmt.Sprint(value)); err != nil {
+		return "", true, fmt.Errorf("failed to set %s of %s: %w", key, p.service, err)
+	}
+	return old, true, nil
+}
+
+func (p *servicePatcherInternal) setImageInternal(image string) error {
+	image = strings.TrimSpace(image)
+	if image == "" {
+		return errors.New("image must not be empty")
+	}
+	old, existed, err := p.setScalarInternal(p.config, "image", image)
+	if err != nil || (existed && old == image) {
+		return err
+	}
+	if !existed {
+		p.changedInternal("image %s", image)
+	} else {
+		p.changedInternal("image %s -> %s", old, image)
+	}
+	return nil
+}
+
+func (p *servicePatcherInternal) setTagInternal(tag string) error {
+	tag = strings.TrimSpace(tag)
+	if tag == "" || strings.ContainsAny(tag, ":@/ ") {
+		return fmt.Errorf("invalid image tag %q", tag)
+	}
+	node, ok := ComposeMappingValue(p.config, "image").(*ast.StringNode)
+	if !ok {
+		return fmt.Errorf("service %s has no image to retag", p.service)
+	}
+	image := node.Value
+	if strings.Contains(image, "${") || strings.Contains(image, "@") {
+		return fmt.Errorf("image %s of %s cannot be retagged; set the image instead", image, p.service)
+	}
+	repo := image
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		repo = image[:i]
+	}
+	return p.setImageInternal(repo + ":" + tag)
+}
+
+func (p *servicePatcherInternal) setEnvironmentInternal(env map[string]string) error {
+	keys := slices.Sorted(maps.Keys(env))
+	for _, key := range keys {
+		if key == "" || strings.ContainsAny(key, "= ") {
+			return fmt.Errorf("invalid environment variable name %q", key)
+		}
+	}
+
+	switch current := ComposeMappingValue(p.config, "environment").(type) {
+	case nil:
+		if err := p.editor.AddMappingEntry(p.config, "environment", env); err != nil {
+			return err
+		}
+		for _, key := range keys {
+			p.changedInternal("environment %s added", key)
+		}
+	case *ast.MappingNode:
+		for _, key := range keys {
+			old, existed, err := p.setScalarInternal(current, key, env[key])
+			switch {
+			case err != nil:
+				return err
+			case !existed:
+				p.changedInternal("environment %s added", key)
+			case old != env[key]:
+				p.changedInternal("environment %s updated", key)
+			}
+		}
+	case *ast.SequenceNode:
+		for _, key := range keys {
+			if err := p.setEnvironmentItemInternal(current, key, env[key]); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("environment of %s is neither a mapping nor a list", p.service)
+	}
+	return nil
+}
+
+// setEnvironmentItemInternal sets a variable in the list form of
+// environment, where entries read "KEY=value" or just "KEY".
+func (p *servicePatcherInternal) setEnvironmentItemInternal(list *ast.SequenceNode, key, value string) error {
+	item := key + "=" + value
+	for _, entry := range list.Values {
+		node, ok := unwrapComposeNodeInternal(entry).(*ast.StringNode)
+		if !ok {
+			continue
+		}
+		name, _, _ := strings.Cut(node.Value, "=")
+		if name != key {
+			continue
+		}
+		if node.Value == item {
+			return nil
+		}
+		if err := p.editor.SetScalar(node, item); err != nil {
+			return fmt.Errorf("failed to set environment %s of %s: %w", key, p.service, err)
+		}
+		p.changedInternal("environment %s updated", key)
+		return nil
+	}
+	if err := p.editor.AppendSequenceItem(list, item); err != nil {
+		return err
+	}
+	p.changedInternal("environment %s added", key)
+	return nil
+}
+
+func (p *servicePatcherInternal) setReplicasInternal(replicas int) error {
+	if replicas < 0 {
+		return fmt.Errorf("invalid replica count %d", replicas)
+	}
+	value := strconv.Itoa(replicas)
+
+	target, key := p.config, "scale"
+	if ComposeMappingValue(p.config, "scale") == nil {
+		key = "replicas"
+		switch deploy := ComposeMappingValue(p.config, "deploy").(type) {
+		case nil:
+			if err := p.editor.AddMappingEntry(p.config, "deploy", map[string]int{"replicas": replicas}); err != nil {
+				return err
+			}
+			p.changedInternal("replicas %d", replicas)
+			return nil
+		case *ast.MappingNode:
+			target = deploy
+		default:
+			return fmt.Errorf("deploy of %s is not a mapping", p.service)
+		}
+	}
+
+	old, existed, err := p.setScalarInternal(target, key, replicas)
+	if err != nil || (existed && old == value) {
+		return err
+	}
+	if !existed {
+		p.changedInternal("replicas %d", replicas)
+	} else {
+		p.changedInternal("replicas %s -> %d", old, replicas)
+	}
+	return nil
+}
+
+func (p *servicePatcherInternal) setPortInternal(port project.ServicePortPatch) error {
+	protocol := strings.ToLower(port.Protocol)
+	if protocol == "" {
+		protocol = "tcp"
+	}
+	if protocol != "tcp" && protocol != "udp" {
+		return fmt.Errorf("invalid port protocol %q", port.Protocol)
+	}
+	if _, ok := parsePortInternal(strconv.Itoa(port.Target)); !ok {
+		return fmt.Errorf("invalid container port %d", port.Target)
+	}
+	if _, ok := parsePortInternal(strconv.Itoa(port.Published)); !ok {
+		return fmt.Errorf("invalid published port %d", port.Published)
+	}
+	spec := fmt.Sprintf("%d/%s", port.Target, protocol)
+
+	var ports *ast.SequenceNode
+	switch current := ComposeMappingValue(p.config, "ports").(type) {
+	case nil:
+		if err := p.editor.AddMappingEntry(p.config, "ports", []string{shortPortSpecInternal(port.Published, port.Target, protocol)}); err != nil {
+			return err
+		}
+		p.changedInternal("port %s published on %d", spec, port.Published)
+		return nil
+	case *ast.SequenceNode:
+		ports = current
+	default:
+		return fmt.Errorf("ports of %s is not a list", p.service)
+	}
+
+	for _, entry := range ports.Values {
+		switch v := unwrapComposeNodeInternal(entry).(type) {
+		case *ast.StringNode:
+			target, proto, ok := shortPortTargetInternal(v.Value)
+			if !ok || target != port.Target || proto != protocol {
+				continue
+			}
+			old, updated, ok := republishShortPortInternal(v.Value, port.Published)
+			if !ok {
+				return fmt.Errorf("port %s of %s cannot be republished; edit it in the compose file", v.Value, p.service)
+			}
+			if updated == v.Value {
+				return nil
+			}
+			if err := p.editor.SetScalar(v, updated); err != nil {
+				return fmt.Errorf("failed to set port %s of %s: %w", spec, p.service, err)
+			}
+			p.portChangedInternal(spec, old, port.Published)
+			return nil
+		case *ast.MappingNode:
+			target, ok := ComposeMappingValue(v, "target").(ast.ScalarNode)
+			if !ok {
+				continue
+			}
+			if n, ok := portNumberInternal(target.GetValue()); !ok || n != port.Target {
+				continue
+			}
+			proto := "tcp"
+			if node := ComposeMappingValue(v, "protocol"); node != nil {
+				proto = strings.ToLower(composeScalarStringInternal(node))
+			}
+			if proto != protocol {
+				continue
+			}
+			published := strconv.Itoa(port.Published)
+			old, existed, err := p.setScalarInternal(v, "published", port.Published)
+			if err != nil || (existed && old == published) {
+				return err
+			}
+			p.portChangedInternal(spec, old, port.Published)
+			return nil
+		}
+	}
+
+	if err := p.editor.AppendSequenceItem(ports, shortPortSpecInternal(port.Published, port.Target, protocol)); err != nil {
+		return err
+	}
+	p.changedInternal("port %s published on %d", spec, port.Published)
+	return nil
+}
+
+func (p *servicePatcherInternal) portChangedInternal(spec, old string, published int) {
+	if old == "" {
+		p.changedInternal("port %s published on %d", spec, published)
+	} else {
+		p.changedInternal("port %s published on %d instead of %s", spec, published, old)
+	}
+}
+
+func shortPortSpecInternal(published, target int, protocol string) string {
+	spec := fmt.Sprintf("%d:%d", published, target)
+	if protocol != "tcp" {
+		spec += "/" + protocol
+	}
+	return spec
+}
+
+// shortPortTargetInternal returns the container port and protocol of a
+// short port syntax such as "127.0.0.1:8080:80/udp". Port ranges are not
+// matched.
+func shortPortTargetInternal(spec string) (int, string, bool) {
+	ports, protocol, found := strings.Cut(spec, "/")
+	if !found {
+		protocol = "tcp"
+	}
+	target, ok := parsePortInternal(ports[strings.LastIndex(ports, ":")+1:])
+	return target, strings.ToLower(protocol), ok
+}
+
+// republishShortPortInternal puts published in place of the host port of a
+// short port syntax, adding a host port when the entry has none. It returns
+// the previous host port, or "" when there was none, and reports false for
+// host port ranges and variables.
+func republishShortPortInternal(spec string, published int) (string, string, bool) {
+	if prefix, host, suffix, ok := splitPortSpecInternal(spec); ok {
+		return strconv.Itoa(host), prefix + strconv.Itoa(published) + suffix, true
+	}
+	containerSep := strings.LastIndex(spec, ":")
+	switch {
+	case containerSep < 0:
+		return "", strconv.Itoa(published) + ":" + spec, true
+	case strings.HasSuffix(spec[:containerSep], ":"):
+		// An address without a host port, such as "127.0.0.1::80".
+		return "", spec[:containerSep] + strconv.Itoa(published) + spec[containerSep:], true
+	}
+	return "", "", false
+}
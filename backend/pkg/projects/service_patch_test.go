@@ -0,0 +1,144 @@
+package projects
+
+import (
+	"testing"
+
+	"github.com/getarcaneapp/arcane/types/project"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPatchComposeService_EditsInPlace(t *testing.T) {
+	content := []byte(`services:
+  web:
+    image: nginx:1.25 # pinned
+    environment:
+      LOG_LEVEL: info
+    ports:
+      - "8080:80"
+      - target: 443
+        published: "8443"
+    deploy:
+      resources:
+        limits:
+          memory: 256M
+
+  # background jobs
+  worker:
+    image: "ghcr.io/acme/worker:2.0"
+    environment:
+      - QUEUE=default
+    scale: 1
+`)
+
+	tag := "1.27"
+	replicas := 2
+	rewrite, err := PatchComposeService(content, "web", project.ServicePatch{
+		Tag:         &tag,
+		Environment: map[string]string{"LOG_LEVEL": "debug", "DEBUG": "true"},
+		Replicas:    &replicas,
+		Ports:       []project.ServicePortPatch{{Target: 80, Published: 9090}, {Target: 443, Published: 9443}, {Target: 53, Published: 5353, Protocol: "udp"}},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, `services:
+  web:
+    image: nginx:1.27 # pinned
+    environment:
+      LOG_LEVEL: debug
+      DEBUG: "true"
+    ports:
+      - "9090:80"
+      - target: 443
+        published: "9443"
+      - 5353:53/udp
+    deploy:
+      resources:
+        limits:
+          memory: 256M
+      replicas: 2
+
+  # background jobs
+  worker:
+    image: "ghcr.io/acme/worker:2.0"
+    environment:
+      - QUEUE=default
+    scale: 1
+`, string(rewrite.Content))
+	assert.Equal(t, []string{
+		"web: image nginx:1.25 -> nginx:1.27",
+		"web: environment DEBUG added",
+		"web: environment LOG_LEVEL updated",
+		"web: replicas 2",
+		"web: port 80/tcp published on 9090 instead of 8080",
+		"web: port 443/tcp published on 9443 instead of 8443",
+		"web: port 53/udp published on 5353",
+	}, rewrite.Changes)
+
+	replicas = 3
+	rewrite, err = PatchComposeService(content, "worker", project.ServicePatch{
+		Tag:         &tag,
+		Environment: map[string]string{"QUEUE": "high", "WORKERS": "4"},
+		Replicas:    &replicas,
+	})
+	require.NoError(t, err)
+	assert.Contains(t, string(rewrite.Content), `    image: "ghcr.io/acme/worker:1.27"
+    environment:
+      - QUEUE=high
+      - WORKERS=4
+    scale: 3
+`)
+}
+
+func TestPatchComposeService_AddsMissingSections(t *testing.T) {
+	content := []byte("services:\n    app:\n        build: .\n")
+
+	image := "acme/app:1.0"
+	replicas := 0
+	rewrite, err := PatchComposeService(content, "app", project.ServicePatch{
+		Image:       &image,
+		Environment: map[string]string{"PORT": "3000"},
+		Replicas:    &replicas,
+		Ports:       []project.ServicePortPatch{{Target: 3000, Published: 3000}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `services:
+    app:
+        build: .
+        image: acme/app:1.0
+        environment:
+            PORT: "3000"
+        deploy:
+            replicas: 0
+        ports:
+            - 3000:3000
+`, string(rewrite.Content))
+}
+
+func TestPatchComposeService_Errors(t *testing.T) {
+	content := []byte(`services:
+  web:
+    image: ${WEB_IMAGE}
+    ports: ["8080-8081:80"]
+  api:
+    image: acme/api@sha256:abc
+    ports:
+      - "${API_PORT}:80"
+`)
+	tag := "2.0"
+
+	_, err := PatchComposeService(content, "db", project.ServicePatch{Tag: &tag})
+	require.ErrorIs(t, err, ErrComposeServiceNotFound)
+
+	_, err = PatchComposeService(content, "web", project.ServicePatch{Tag: &tag})
+	require.Error(t, err, "variables cannot be retagged")
+
+	_, err = PatchComposeService(content, "api", project.ServicePatch{Tag: &tag})
+	require.Error(t, err, "digests cannot be retagged")
+
+	_, err = PatchComposeService(content, "web", project.ServicePatch{Ports: []project.ServicePortPatch{{Target: 443, Published: 8443}}})
+	require.Error(t, err, "flow sequences cannot grow")
+
+	_, err = PatchComposeService(content, "api", project.ServicePatch{Ports: []project.ServicePortPatch{{Target: 80, Published: 8080}}})
+	require.Error(t, err, "variable host ports are not replaced")
+}
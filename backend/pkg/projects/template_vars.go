@@ -0,0 +1,121 @@
+package projects
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"maps"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// TemplateVariableProvider returns the user defined variables available to
+// compose files as {{arcane.name}}.
+type TemplateVariableProvider func(ctx context.Context) (map[string]string, error)
+
+const (
+	TemplateVariableProjectName = "project_name"
+	TemplateVariableProjectDir  = "project_dir"
+)
+
+var (
+	templateVariableProviderMu sync.RWMutex
+	templateVariableProvider   TemplateVariableProvider
+
+	templateVariableRegex     = regexp.MustCompile(`\{\{\s*arcane\.([A-Za-z0-9_]+)\s*\}\}`)
+	templateVariableNameRegex = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+)
+
+// SetTemplateVariableProvider registers the function that supplies user
+// defined {{arcane.name}} variables when a compose project is loaded.
+func SetTemplateVariableProvider(p TemplateVariableProvider) {
+	templateVariableProviderMu.Lock()
+	defer templateVariableProviderMu.Unlock()
+	templateVariableProvider = p
+}
+
+func getTemplateVariableProviderInternal() TemplateVariableProvider {
+	templateVariableProviderMu.RLock()
+	defer templateVariableProviderMu.RUnlock()
+	return templateVariableProvider
+}
+
+// ParseTemplateVariables parses NAME=value lines. Blank lines and lines
+// starting with # are ignored. The built-in project variables cannot be
+// redefined.
+func ParseTemplateVariables(content string) (map[string]string, error) {
+	vars := make(map[string]string)
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		name, value, ok := strings.Cut(line, "=")
+		name = strings.TrimSpace(name)
+		if !ok || !templateVariableNameRegex.MatchString(name) {
+			return nil, fmt.Errorf("line %d: expected NAME=value with a name of letters, digits and underscores", lineNo)
+		}
+		if name == TemplateVariableProjectName || name == TemplateVariableProjectDir {
+			return nil, fmt.Errorf("line %d: %s is a built-in variable", lineNo, name)
+		}
+		vars[name] = strings.TrimSpace(value)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return vars, nil
+}
+
+// HasTemplateVariables reports whether content references any {{arcane.name}}
+// variable.
+func HasTemplateVariables(content string) bool {
+	return strings.Contains(content, "arcane.") && templateVariableRegex.MatchString(content)
+}
+
+// ApplyTemplateVariables replaces {{arcane.name}} references in compose
+// content with the built-in project variables and the registered user
+// defined variables. Referencing an undefined variable is an error so typos
+// do not silently produce broken compose files.
+func ApplyTemplateVariables(ctx context.Context, content, projectName, workdir string) (string, error) {
+	if !HasTemplateVariables(content) {
+		return content, nil
+	}
+
+	vars := make(map[string]string)
+	if provider := getTemplateVariableProviderInternal(); provider != nil {
+		userVars, err := provider(ctx)
+		if err != nil {
+			return "", fmt.Errorf("load template variables: %w", err)
+		}
+		maps.Copy(vars, userVars)
+	}
+	if absWorkdir, err := filepath.Abs(workdir); err == nil {
+		workdir = absWorkdir
+	}
+	vars[TemplateVariableProjectDir] = workdir
+	if projectName == "" {
+		projectName = filepath.Base(workdir)
+	}
+	vars[TemplateVariableProjectName] = projectName
+
+	var missing []string
+	out := templateVariableRegex.ReplaceAllStringFunc(content, func(match string) string {
+		name := templateVariableRegex.FindStringSubmatch(match)[1]
+		value, ok := vars[name]
+		if !ok {
+			if !slices.Contains(missing, name) {
+				missing = append(missing, name)
+			}
+			return match
+		}
+		return value
+	})
+	if len(missing) > 0 {
+		return "", fmt.Errorf("undefined template variables: %s", strings.Join(missing, ", "))
+	}
+	return out, nil
+}
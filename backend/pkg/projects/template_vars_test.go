@@ -0,0 +1,65 @@
+package projects
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTemplateVariables(t *testing.T) {
+	vars, err := ParseTemplateVariables("# shared hosts\nhost_ip = 10.0.0.5\n\ndomain=example.com\nempty=\n")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"host_ip": "10.0.0.5", "domain": "example.com", "empty": ""}, vars)
+
+	_, err = ParseTemplateVariables("not a pair")
+	require.ErrorContains(t, err, "line 1")
+
+	_, err = ParseTemplateVariables("host-ip=1.2.3.4")
+	require.Error(t, err)
+
+	_, err = ParseTemplateVariables("project_dir=/tmp")
+	require.ErrorContains(t, err, "built-in")
+}
+
+func TestApplyTemplateVariables(t *testing.T) {
+	t.Cleanup(func() { SetTemplateVariableProvider(nil) })
+	SetTemplateVariableProvider(func(context.Context) (map[string]string, error) {
+		return map[string]string{"host_ip": "10.0.0.5", "domain": "example.com"}, nil
+	})
+
+	workdir := t.TempDir()
+	content := "ports:\n  - \"{{arcane.host_ip}}:80:80\"\nlabels:\n  host: app.{{ arcane.domain }}\n  dir: {{arcane.project_dir}}/data\n  name: {{arcane.project_name}}\n  other: {{ .Name }}\n"
+	out, err := ApplyTemplateVariables(context.Background(), content, "app", workdir)
+	require.NoError(t, err)
+	assert.Equal(t, "ports:\n  - \"10.0.0.5:80:80\"\nlabels:\n  host: app.example.com\n  dir: "+workdir+"/data\n  name: app\n  other: {{ .Name }}\n", out)
+
+	_, err = ApplyTemplateVariables(context.Background(), "image: {{arcane.registry}}/app\n", "app", workdir)
+	require.ErrorContains(t, err, "registry")
+
+	plain := "services:\n  app:\n    image: nginx\n"
+	out, err = ApplyTemplateVariables(context.Background(), plain, "app", workdir)
+	require.NoError(t, err)
+	assert.Equal(t, plain, out)
+}
+
+func TestLoadComposeProject_AppliesTemplateVariables(t *testing.T) {
+	t.Cleanup(func() { SetTemplateVariableProvider(nil) })
+	SetTemplateVariableProvider(func(context.Context) (map[string]string, error) {
+		return map[string]string{"domain": "example.com"}, nil
+	})
+
+	projectsDir := t.TempDir()
+	workdir := filepath.Join(projectsDir, "app")
+	require.NoError(t, os.MkdirAll(workdir, 0o755))
+	composeFile := filepath.Join(workdir, "compose.yaml")
+	require.NoError(t, os.WriteFile(composeFile, []byte("services:\n  app:\n    image: nginx:alpine\n    labels:\n      host: app.{{arcane.domain}}\n      project: \"{{arcane.project_name}}\"\n"), 0o600))
+
+	project, err := LoadComposeProject(context.Background(), composeFile, "app", projectsDir, false, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "app.example.com", project.Services["app"].Labels["host"])
+	assert.Equal(t, "app", project.Services["app"].Labels["project"])
+}
@@ -0,0 +1,84 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/getarcaneapp/arcane/backend/internal/services"
+	"github.com/getarcaneapp/arcane/types/version"
+)
+
+const (
+	ArcaneUpdateCheckJobName = "arcane-update-check"
+	notifiedReleaseKey       = "notifiedRelease"
+)
+
+// ArcaneUpdateCheckJob checks GitHub for a newer Arcane release every six hours
+// and sends an arcane_update_available notification once per release.
+type ArcaneUpdateCheckJob struct {
+	versionService      *services.VersionService
+	settingsService     *services.SettingsService
+	notificationService *services.NotificationService
+}
+
+func NewArcaneUpdateCheckJob(versionService *services.VersionService, settingsService *services.SettingsService, notificationService *services.NotificationService) *ArcaneUpdateCheckJob {
+	return &ArcaneUpdateCheckJob{
+		versionService:      versionService,
+		settingsService:     settingsService,
+		notificationService: notificationService,
+	}
+}
+
+func (j *ArcaneUpdateCheckJob) Name() string {
+	return ArcaneUpdateCheckJobName
+}
+
+func (j *ArcaneUpdateCheckJob) Schedule(ctx context.Context) string {
+	return "0 41 */6 * * *"
+}
+
+func (j *ArcaneUpdateCheckJob) Run(ctx context.Context) {
+	if j.versionService == nil || j.settingsService == nil || j.notificationService == nil {
+		return
+	}
+
+	changelog, err := j.versionService.GetChangelog(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to check for Arcane updates", "jobName", ArcaneUpdateCheckJobName, "error", err)
+		return
+	}
+
+	notified := j.settingsService.GetStringSetting(ctx, notifiedReleaseKey, "")
+	release, ok := releaseToNotifyInternal(changelog, notified)
+	if !ok {
+		return
+	}
+
+	slog.InfoContext(ctx, "Arcane update available", "currentVersion", changelog.CurrentVersion, "newestVersion", release.Version)
+	if err := j.notificationService.SendArcaneUpdateNotification(ctx, changelog.CurrentVersion, release.Version, release.URL); err != nil {
+		slog.WarnContext(ctx, "Failed to send Arcane update notification", "jobName", ArcaneUpdateCheckJobName, "error", err)
+		return
+	}
+
+	if err := j.settingsService.SetStringSetting(ctx, notifiedReleaseKey, release.Version); err != nil {
+		slog.WarnContext(ctx, "Failed to record notified Arcane release", "jobName", ArcaneUpdateCheckJobName, "error", err)
+	}
+}
+
+func (j *ArcaneUpdateCheckJob) Reschedule(ctx context.Context) error {
+	return nil
+}
+
+// releaseToNotifyInternal returns the newest release when it is newer than the
+// running version and no notification was sent for it yet.
+func releaseToNotifyInternal(changelog *version.Changelog, notified string) (version.Release, bool) {
+	if changelog == nil || !changelog.UpdateAvailable || len(changelog.Releases) == 0 {
+		return version.Release{}, false
+	}
+
+	newest := changelog.Releases[0]
+	if newest.Version == notified {
+		return version.Release{}, false
+	}
+	return newest, true
+}
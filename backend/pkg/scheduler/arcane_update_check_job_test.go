@@ -0,0 +1,37 @@
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/getarcaneapp/arcane/types/version"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReleaseToNotify(t *testing.T) {
+	changelog := &version.Changelog{
+		CurrentVersion:  "v1.2.0",
+		NewestVersion:   "v1.4.0",
+		UpdateAvailable: true,
+		Releases: []version.Release{
+			{Version: "v1.4.0", URL: "https://github.com/getarcaneapp/arcane/releases/tag/v1.4.0"},
+			{Version: "v1.3.0", URL: "https://github.com/getarcaneapp/arcane/releases/tag/v1.3.0"},
+		},
+	}
+
+	release, ok := releaseToNotifyInternal(changelog, "")
+	require.True(t, ok)
+	require.Equal(t, "v1.4.0", release.Version)
+
+	release, ok = releaseToNotifyInternal(changelog, "v1.3.0")
+	require.True(t, ok, "a newer release than the last notified one is announced")
+	require.Equal(t, "v1.4.0", release.Version)
+
+	_, ok = releaseToNotifyInternal(changelog, "v1.4.0")
+	require.False(t, ok, "each release is announced once")
+
+	_, ok = releaseToNotifyInternal(&version.Changelog{CurrentVersion: "v1.4.0", NewestVersion: "v1.4.0"}, "")
+	require.False(t, ok)
+
+	_, ok = releaseToNotifyInternal(nil, "")
+	require.False(t, ok)
+}
@@ -6,17 +6,20 @@ import (
 	"log/slog"
 	"strconv"
 
+	"github.com/getarcaneapp/arcane/backend/internal/models"
 	"github.com/getarcaneapp/arcane/backend/internal/services"
 )
 
 type AutoUpdateJob struct {
 	updaterService  *services.UpdaterService
+	rolloutService  *services.RolloutService
 	settingsService *services.SettingsService
 }
 
-func NewAutoUpdateJob(updaterService *services.UpdaterService, settingsService *services.SettingsService) *AutoUpdateJob {
+func NewAutoUpdateJob(updaterService *services.UpdaterService, rolloutService *services.RolloutService, settingsService *services.SettingsService) *AutoUpdateJob {
 	return &AutoUpdateJob{
 		updaterService:  updaterService,
+		rolloutService:  rolloutService,
 		settingsService: settingsService,
 	}
 }
@@ -57,9 +60,23 @@ func (j *AutoUpdateJob) Run(ctx context.Context) {
 		return
 	}
 
+	// With rollout stages configured, updates go out stage by stage to the
+	// listed environments instead of only to this one.
+	if j.rolloutService != nil {
+		stages, err := j.rolloutService.Stages(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "auto-update rollout stages are invalid; skipping run", "err", err)
+			return
+		}
+		if len(stages) > 0 {
+			j.runRolloutInternal(ctx)
+			return
+		}
+	}
+
 	slog.InfoContext(ctx, "auto-update run started")
 
-	result, err := j.updaterService.ApplyPending(ctx, false)
+	result, err := j.updaterService.ApplyPending(services.WithAutoUpdateTrigger(ctx, models.AutoUpdateTriggerSchedule), false)
 	if err != nil {
 		slog.ErrorContext(ctx, "auto-update run failed", "err", err)
 		return
@@ -73,6 +90,22 @@ func (j *AutoUpdateJob) Run(ctx context.Context) {
 	)
 }
 
+func (j *AutoUpdateJob) runRolloutInternal(ctx context.Context) {
+	slog.InfoContext(ctx, "auto-update staged rollout started")
+
+	rollout, err := j.rolloutService.Run(services.WithAutoUpdateTrigger(ctx, models.AutoUpdateTriggerSchedule), "scheduled")
+	if err != nil {
+		slog.ErrorContext(ctx, "auto-update staged rollout failed", "err", err)
+		return
+	}
+
+	slog.InfoContext(ctx, "auto-update staged rollout completed",
+		"state", rollout.State,
+		"stages", len(rollout.Stages),
+		"haltReason", rollout.HaltReason,
+	)
+}
+
 func (j *AutoUpdateJob) Reschedule(ctx context.Context) error {
 	slog.InfoContext(ctx, "rescheduling auto-update job in new scheduler; currently requires restart")
 	return nil
@@ -0,0 +1,60 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/getarcaneapp/arcane/backend/internal/services"
+	"github.com/robfig/cron/v3"
+)
+
+const (
+	ConfigBackupJobName         = "config-backup"
+	defaultConfigBackupSchedule = "0 0 3 * * *"
+)
+
+type ConfigBackupJob struct {
+	backupService   *services.BackupService
+	settingsService *services.SettingsService
+}
+
+func NewConfigBackupJob(backupService *services.BackupService, settingsService *services.SettingsService) *ConfigBackupJob {
+	return &ConfigBackupJob{
+		backupService:   backupService,
+		settingsService: settingsService,
+	}
+}
+
+func (j *ConfigBackupJob) Name() string {
+	return ConfigBackupJobName
+}
+
+func (j *ConfigBackupJob) Schedule(ctx context.Context) string {
+	schedule := j.settingsService.GetStringSetting(ctx, "configBackupInterval", defaultConfigBackupSchedule)
+	if schedule == "" {
+		return defaultConfigBackupSchedule
+	}
+
+	parser := cron.NewParser(cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+	if _, err := parser.Parse(schedule); err != nil {
+		slog.WarnContext(ctx, "Invalid cron expression for config-backup, using default", "invalid_schedule", schedule, "error", err)
+		return defaultConfigBackupSchedule
+	}
+
+	return schedule
+}
+
+func (j *ConfigBackupJob) Run(ctx context.Context) {
+	if !j.settingsService.GetBoolSetting(ctx, "configBackupEnabled", false) {
+		slog.DebugContext(ctx, "configuration backup disabled; skipping run")
+		return
+	}
+
+	if err := j.backupService.RunScheduledBackup(ctx); err != nil {
+		slog.ErrorContext(ctx, "Failed to write configuration backup", "jobName", ConfigBackupJobName, "error", err)
+	}
+}
+
+func (j *ConfigBackupJob) Reschedule(ctx context.Context) error {
+	return nil
+}
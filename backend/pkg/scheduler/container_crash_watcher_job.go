@@ -0,0 +1,252 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/services"
+	"github.com/getarcaneapp/arcane/backend/pkg/libarcane"
+	"github.com/moby/moby/api/types/events"
+	"github.com/moby/moby/client"
+)
+
+const (
+	// A die event this soon after a kill was requested by a user or by Docker
+	// itself (stop, restart, update) rather than a crash.
+	containerKillGracePeriod = 30 * time.Second
+
+	containerEventsRetryMin = 5 * time.Second
+	containerEventsRetryMax = time.Minute
+)
+
+// crashRecord tracks recent crash timestamps and the last alert for a container.
+type crashRecord struct {
+	timestamps []time.Time
+	lastKill   time.Time
+	lastAlert  time.Time
+}
+
+// ContainerCrashWatcherJob listens to Docker container events and alerts when
+// a container exits with a non-zero code repeatedly, which covers restart
+// loops driven by a restart policy.
+type ContainerCrashWatcherJob struct {
+	dockerClientService *services.DockerClientService
+	settingsService     *services.SettingsService
+	eventService        *services.EventService
+	notificationService *services.NotificationService
+
+	mu      sync.Mutex
+	records map[string]*crashRecord
+	now     func() time.Time
+}
+
+func NewContainerCrashWatcherJob(
+	dockerClientService *services.DockerClientService,
+	settingsService *services.SettingsService,
+	eventService *services.EventService,
+	notificationService *services.NotificationService,
+) *ContainerCrashWatcherJob {
+	return &ContainerCrashWatcherJob{
+		dockerClientService: dockerClientService,
+		settingsService:     settingsService,
+		eventService:        eventService,
+		notificationService: notificationService,
+		records:             make(map[string]*crashRecord),
+		now:                 time.Now,
+	}
+}
+
+// RegisterContainerCrashWatcherJob starts the watcher in the background. It runs
+// until ctx is cancelled and reconnects when the Docker event stream drops.
+func RegisterContainerCrashWatcherJob(
+	ctx context.Context,
+	dockerClientService *services.DockerClientService,
+	settingsService *services.SettingsService,
+	eventService *services.EventService,
+	notificationService *services.NotificationService,
+) *ContainerCrashWatcherJob {
+	job := NewContainerCrashWatcherJob(dockerClientService, settingsService, eventService, notificationService)
+
+	go job.Start(ctx)
+
+	slog.InfoContext(ctx, "Container crash watcher job registered")
+	return job
+}
+
+func (j *ContainerCrashWatcherJob) Start(ctx context.Context) {
+	retry := containerEventsRetryMin
+	for {
+		started := time.Now()
+		err := j.watchInternal(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		// Back off only while the stream keeps failing right away.
+		if time.Since(started) > containerEventsRetryMax {
+			retry = containerEventsRetryMin
+		}
+		if err != nil {
+			slog.WarnContext(ctx, "Container event stream failed; reconnecting", "error", err, "retry_in", retry)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retry):
+		}
+		retry = min(retry*2, containerEventsRetryMax)
+	}
+}
+
+func (j *ContainerCrashWatcherJob) watchInternal(ctx context.Context) error {
+	dockerClient, err := j.dockerClientService.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	filters := make(client.Filters)
+	filters = filters.Add("type", string(events.ContainerEventType))
+	filters = filters.Add("event", string(events.ActionDie), string(events.ActionKill), string(events.ActionDestroy))
+
+	result := dockerClient.Events(ctx, client.EventsListOptions{Filters: filters})
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-result.Err:
+			return err
+		case msg := <-result.Messages:
+			j.handleEvent(ctx, msg)
+		}
+	}
+}
+
+func (j *ContainerCrashWatcherJob) handleEvent(ctx context.Context, msg events.Message) {
+	containerID := msg.Actor.ID
+	attrs := msg.Actor.Attributes
+	if containerID == "" || libarcane.IsInternalContainer(attrs) {
+		return
+	}
+
+	switch msg.Action {
+	case events.ActionKill:
+		j.recordKill(containerID)
+	case events.ActionDestroy:
+		j.forget(containerID)
+	case events.ActionDie:
+		exitCode := attrs["exitCode"]
+		if exitCode == "" || exitCode == "0" {
+			return
+		}
+
+		threshold := j.settingsService.GetIntSetting(ctx, "containerCrashThreshold", 3)
+		if threshold <= 0 {
+			return
+		}
+		window := time.Duration(j.settingsService.GetIntSetting(ctx, "containerCrashWindow", 10)) * time.Minute
+		cooldown := time.Duration(j.settingsService.GetIntSetting(ctx, "containerCrashCooldown", 60)) * time.Minute
+
+		crashes, alert := j.recordCrash(containerID, threshold, window, cooldown)
+		if !alert {
+			return
+		}
+
+		j.notifyCrashLoop(ctx, containerID, strings.TrimPrefix(attrs["name"], "/"), exitCode, crashes, window)
+	}
+}
+
+// recordKill remembers that the container was asked to stop, so the die event
+// that follows is not counted as a crash.
+func (j *ContainerCrashWatcherJob) recordKill(containerID string) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.recordFor(containerID).lastKill = j.now()
+}
+
+// recordCrash records a non-zero exit and reports whether the container has
+// crashed threshold times within window and is out of its alert cooldown.
+func (j *ContainerCrashWatcherJob) recordCrash(containerID string, threshold int, window, cooldown time.Duration) (int, bool) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	now := j.now()
+	record := j.recordFor(containerID)
+	if !record.lastKill.IsZero() && now.Sub(record.lastKill) < containerKillGracePeriod {
+		record.lastKill = time.Time{}
+		return len(record.timestamps), false
+	}
+
+	record.timestamps = append(pruneCrashTimestamps(record.timestamps, now.Add(-window)), now)
+	crashes := len(record.timestamps)
+	if crashes < threshold {
+		return crashes, false
+	}
+	if !record.lastAlert.IsZero() && now.Sub(record.lastAlert) < cooldown {
+		return crashes, false
+	}
+
+	record.lastAlert = now
+	return crashes, true
+}
+
+func (j *ContainerCrashWatcherJob) forget(containerID string) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	delete(j.records, containerID)
+}
+
+// recordFor returns the record for a container, creating it if needed. The
+// caller must hold j.mu.
+func (j *ContainerCrashWatcherJob) recordFor(containerID string) *crashRecord {
+	record, exists := j.records[containerID]
+	if !exists {
+		record = &crashRecord{}
+		j.records[containerID] = record
+	}
+	return record
+}
+
+func (j *ContainerCrashWatcherJob) notifyCrashLoop(ctx context.Context, containerID, containerName, exitCode string, crashes int, window time.Duration) {
+	if containerName == "" {
+		containerName = containerID
+	}
+	slog.WarnContext(ctx, "Container is crash looping", "container", containerName, "exit_code", exitCode, "crashes", crashes, "window", window)
+
+	if j.eventService != nil {
+		if err := j.eventService.LogContainerEvent(
+			ctx,
+			models.EventTypeContainerCrashLoop,
+			containerID,
+			containerName,
+			"", // no user - system action
+			"system",
+			"",
+			models.JSON{"exitCode": exitCode, "crashes": crashes, "windowMinutes": int(window.Minutes())},
+		); err != nil {
+			slog.WarnContext(ctx, "Failed to log container crash event", "container", containerName, "error", err)
+		}
+	}
+
+	if j.notificationService != nil {
+		if err := j.notificationService.SendContainerCrashNotification(ctx, containerName, containerID, exitCode, crashes, window); err != nil {
+			slog.WarnContext(ctx, "Failed to send container crash notification", "container", containerName, "error", err)
+		}
+	}
+}
+
+// pruneCrashTimestamps removes timestamps older than the cutoff.
+func pruneCrashTimestamps(timestamps []time.Time, cutoff time.Time) []time.Time {
+	result := timestamps[:0]
+	for _, ts := range timestamps {
+		if ts.After(cutoff) {
+			result = append(result, ts)
+		}
+	}
+	return result
+}
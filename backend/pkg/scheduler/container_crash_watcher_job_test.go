@@ -0,0 +1,89 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestContainerCrashWatcherJob(now *time.Time) *ContainerCrashWatcherJob {
+	return &ContainerCrashWatcherJob{
+		records: make(map[string]*crashRecord),
+		now:     func() time.Time { return *now },
+	}
+}
+
+func TestContainerCrashWatcher_AlertsAtThreshold(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	job := newTestContainerCrashWatcherJob(&now)
+
+	for i := 1; i < 3; i++ {
+		crashes, alert := job.recordCrash("c1", 3, 10*time.Minute, time.Hour)
+		require.Equal(t, i, crashes)
+		require.False(t, alert)
+		now = now.Add(time.Minute)
+	}
+
+	crashes, alert := job.recordCrash("c1", 3, 10*time.Minute, time.Hour)
+	require.Equal(t, 3, crashes)
+	require.True(t, alert)
+}
+
+func TestContainerCrashWatcher_OldCrashesExpire(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	job := newTestContainerCrashWatcherJob(&now)
+
+	job.recordCrash("c1", 3, 10*time.Minute, time.Hour)
+	job.recordCrash("c1", 3, 10*time.Minute, time.Hour)
+	now = now.Add(15 * time.Minute)
+
+	crashes, alert := job.recordCrash("c1", 3, 10*time.Minute, time.Hour)
+	require.Equal(t, 1, crashes)
+	require.False(t, alert)
+}
+
+func TestContainerCrashWatcher_Cooldown(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	job := newTestContainerCrashWatcherJob(&now)
+
+	_, alert := job.recordCrash("c1", 1, 10*time.Minute, time.Hour)
+	require.True(t, alert)
+
+	now = now.Add(30 * time.Minute)
+	_, alert = job.recordCrash("c1", 1, 10*time.Minute, time.Hour)
+	require.False(t, alert, "alert should be suppressed during cooldown")
+
+	// Cooldown is per container.
+	_, alert = job.recordCrash("c2", 1, 10*time.Minute, time.Hour)
+	require.True(t, alert)
+
+	now = now.Add(31 * time.Minute)
+	_, alert = job.recordCrash("c1", 1, 10*time.Minute, time.Hour)
+	require.True(t, alert)
+}
+
+func TestContainerCrashWatcher_IgnoresExitAfterKill(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	job := newTestContainerCrashWatcherJob(&now)
+
+	job.recordKill("c1")
+	now = now.Add(2 * time.Second)
+	crashes, alert := job.recordCrash("c1", 1, 10*time.Minute, time.Hour)
+	require.Equal(t, 0, crashes)
+	require.False(t, alert)
+
+	// The kill only excuses the exit that follows it.
+	crashes, alert = job.recordCrash("c1", 1, 10*time.Minute, time.Hour)
+	require.Equal(t, 1, crashes)
+	require.True(t, alert)
+}
+
+func TestContainerCrashWatcher_ForgetClearsState(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	job := newTestContainerCrashWatcherJob(&now)
+
+	job.recordCrash("c1", 3, 10*time.Minute, time.Hour)
+	job.forget("c1")
+	require.Empty(t, job.records)
+}
@@ -0,0 +1,44 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/getarcaneapp/arcane/backend/internal/services"
+)
+
+const ContainerTaskJobName = "container-tasks"
+
+// ContainerTaskJob runs the container tasks that are due. It ticks every 15
+// seconds; each task's own cron schedule decides whether it runs.
+type ContainerTaskJob struct {
+	containerTaskService *services.ContainerTaskService
+}
+
+func NewContainerTaskJob(containerTaskService *services.ContainerTaskService) *ContainerTaskJob {
+	return &ContainerTaskJob{
+		containerTaskService: containerTaskService,
+	}
+}
+
+func (j *ContainerTaskJob) Name() string {
+	return ContainerTaskJobName
+}
+
+func (j *ContainerTaskJob) Schedule(ctx context.Context) string {
+	return "*/15 * * * * *"
+}
+
+func (j *ContainerTaskJob) Run(ctx context.Context) {
+	if j.containerTaskService == nil {
+		return
+	}
+
+	if err := j.containerTaskService.RunDueTasks(ctx); err != nil {
+		slog.ErrorContext(ctx, "Failed to run container tasks", "jobName", ContainerTaskJobName, "error", err)
+	}
+}
+
+func (j *ContainerTaskJob) Reschedule(ctx context.Context) error {
+	return nil
+}
@@ -2,6 +2,7 @@ package scheduler
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"strconv"
@@ -9,6 +10,7 @@ import (
 	"time"
 
 	"github.com/getarcaneapp/arcane/backend/internal/services"
+	"github.com/getarcaneapp/arcane/types/environment"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -97,6 +99,11 @@ func (j *EnvironmentHealthJob) Run(ctx context.Context) {
 
 		// Test connection without custom URL (will update DB status)
 		status, err := j.environmentService.TestConnection(ctx, env.ID, nil)
+		if env.ID != "0" {
+			// A passing health check closes the circuit breaker, a failing one
+			// counts towards opening it before users run into timeouts.
+			j.environmentService.RecordRequestResult(env.ID, 0, err)
+		}
 		switch {
 		case err != nil:
 			slog.WarnContext(ctx, "environment health check failed", "environment_id", env.ID, "environment_name", env.Name, "status", status, "error", err)
@@ -137,32 +144,33 @@ func (j *EnvironmentHealthJob) syncOnlineRemoteEnvironments(ctx context.Context,
 
 	for _, env := range environments {
 		g.Go(func() error {
-			if err := j.environmentService.SyncRegistriesToEnvironment(groupCtx, env.ID); err != nil {
-				slog.WarnContext(groupCtx, "failed to sync registries during health check",
-					"environment_id", env.ID,
-					"environment_name", env.Name,
-					"error", err)
+			// The health check runs again soon, so failed targets are not retried here.
+			status, err := j.environmentService.RunEnvironmentSync(groupCtx, env.ID, "health check", 1)
+			if errors.Is(err, services.ErrEnvironmentSyncUnsupported) {
 				return nil
 			}
-
-			slog.DebugContext(groupCtx, "successfully synced registries during health check",
-				"environment_id", env.ID,
-				"environment_name", env.Name)
-			return nil
-		})
-
-		g.Go(func() error {
-			if err := j.environmentService.SyncRepositoriesToEnvironment(groupCtx, env.ID); err != nil {
-				slog.WarnContext(groupCtx, "failed to sync git repositories during health check",
+			if err != nil {
+				slog.WarnContext(groupCtx, "failed to sync environment during health check",
 					"environment_id", env.ID,
 					"environment_name", env.Name,
 					"error", err)
 				return nil
 			}
 
-			slog.DebugContext(groupCtx, "successfully synced git repositories during health check",
-				"environment_id", env.ID,
-				"environment_name", env.Name)
+			for _, target := range status.Targets {
+				if target.State == environment.SyncFailed {
+					slog.WarnContext(groupCtx, "failed to sync environment during health check",
+						"environment_id", env.ID,
+						"environment_name", env.Name,
+						"target", target.Target,
+						"error", target.Error)
+				}
+			}
+			if status.State == environment.SyncSucceeded {
+				slog.DebugContext(groupCtx, "successfully synced environment during health check",
+					"environment_id", env.ID,
+					"environment_name", env.Name)
+			}
 			return nil
 		})
 	}
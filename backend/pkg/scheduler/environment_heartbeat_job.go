@@ -0,0 +1,43 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/getarcaneapp/arcane/backend/internal/services"
+)
+
+const EnvironmentHeartbeatJobName = "environment-heartbeat"
+
+// EnvironmentHeartbeatJob watches remote environment heartbeats every 30 seconds.
+type EnvironmentHeartbeatJob struct {
+	environmentWatchService *services.EnvironmentWatchService
+}
+
+func NewEnvironmentHeartbeatJob(environmentWatchService *services.EnvironmentWatchService) *EnvironmentHeartbeatJob {
+	return &EnvironmentHeartbeatJob{
+		environmentWatchService: environmentWatchService,
+	}
+}
+
+func (j *EnvironmentHeartbeatJob) Name() string {
+	return EnvironmentHeartbeatJobName
+}
+
+func (j *EnvironmentHeartbeatJob) Schedule(ctx context.Context) string {
+	return "*/30 * * * * *"
+}
+
+func (j *EnvironmentHeartbeatJob) Run(ctx context.Context) {
+	if j.environmentWatchService == nil {
+		return
+	}
+
+	if err := j.environmentWatchService.CheckHeartbeats(ctx); err != nil {
+		slog.ErrorContext(ctx, "Failed to check environment heartbeats", "jobName", EnvironmentHeartbeatJobName, "error", err)
+	}
+}
+
+func (j *EnvironmentHeartbeatJob) Reschedule(ctx context.Context) error {
+	return nil
+}
@@ -53,9 +53,10 @@ func (j *FilesystemWatcherJob) Start(ctx context.Context) error {
 	}
 
 	sw, err := fs.NewWatcher(projectsDirectory, fs.WatcherOptions{
-		Debounce: 3 * time.Second, // Wait 3 seconds after last change before syncing
-		OnChange: j.handleFilesystemChange,
-		MaxDepth: 1,
+		Debounce:      3 * time.Second, // Wait 3 seconds after last change before syncing
+		OnChange:      j.handleFilesystemChange,
+		OnPathsChange: j.projectService.AutoDeployChangedProjects,
+		MaxDepth:      1,
 	})
 	if err != nil {
 		return err
@@ -174,9 +175,10 @@ func (j *FilesystemWatcherJob) RestartProjectsWatcher(ctx context.Context) error
 
 	// Create a new watcher with the updated path
 	sw, err := fs.NewWatcher(projectsDirectory, fs.WatcherOptions{
-		Debounce: 3 * time.Second,
-		OnChange: j.handleFilesystemChange,
-		MaxDepth: 1,
+		Debounce:      3 * time.Second,
+		OnChange:      j.handleFilesystemChange,
+		OnPathsChange: j.projectService.AutoDeployChangedProjects,
+		MaxDepth:      1,
 	})
 	if err != nil {
 		return err
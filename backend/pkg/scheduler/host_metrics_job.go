@@ -0,0 +1,43 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/getarcaneapp/arcane/backend/internal/services"
+)
+
+const HostMetricsJobName = "host-metrics"
+
+// HostMetricsJob collects host metrics from every environment once a minute.
+type HostMetricsJob struct {
+	hostMetricsService *services.HostMetricsService
+}
+
+func NewHostMetricsJob(hostMetricsService *services.HostMetricsService) *HostMetricsJob {
+	return &HostMetricsJob{
+		hostMetricsService: hostMetricsService,
+	}
+}
+
+func (j *HostMetricsJob) Name() string {
+	return HostMetricsJobName
+}
+
+func (j *HostMetricsJob) Schedule(ctx context.Context) string {
+	return "0 * * * * *"
+}
+
+func (j *HostMetricsJob) Run(ctx context.Context) {
+	if j.hostMetricsService == nil {
+		return
+	}
+
+	if err := j.hostMetricsService.CollectAll(ctx); err != nil {
+		slog.ErrorContext(ctx, "Failed to collect host metrics", "jobName", HostMetricsJobName, "error", err)
+	}
+}
+
+func (j *HostMetricsJob) Reschedule(ctx context.Context) error {
+	return nil
+}
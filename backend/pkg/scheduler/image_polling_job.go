@@ -13,13 +13,15 @@ type ImagePollingJob struct {
 	imageUpdateService *services.ImageUpdateService
 	settingsService    *services.SettingsService
 	environmentService *services.EnvironmentService
+	gitOpsSyncService  *services.GitOpsSyncService
 }
 
-func NewImagePollingJob(imageUpdateService *services.ImageUpdateService, settingsService *services.SettingsService, environmentService *services.EnvironmentService) *ImagePollingJob {
+func NewImagePollingJob(imageUpdateService *services.ImageUpdateService, settingsService *services.SettingsService, environmentService *services.EnvironmentService, gitOpsSyncService *services.GitOpsSyncService) *ImagePollingJob {
 	return &ImagePollingJob{
 		imageUpdateService: imageUpdateService,
 		settingsService:    settingsService,
 		environmentService: environmentService,
+		gitOpsSyncService:  gitOpsSyncService,
 	}
 }
 
@@ -85,6 +87,12 @@ func (j *ImagePollingJob) Run(ctx context.Context) {
 	}
 
 	slog.InfoContext(ctx, "image scan run completed", "checked", total, "updates", updates, "errors", errors)
+
+	if j.gitOpsSyncService != nil {
+		if err := j.gitOpsSyncService.ProposeAllTagBumps(ctx); err != nil {
+			slog.ErrorContext(ctx, "gitops tag bump check failed", "err", err)
+		}
+	}
 }
 
 func (j *ImagePollingJob) Reschedule(ctx context.Context) error {
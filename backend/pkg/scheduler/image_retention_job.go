@@ -0,0 +1,73 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/getarcaneapp/arcane/backend/internal/services"
+	"github.com/robfig/cron/v3"
+)
+
+const (
+	ImageRetentionJobName         = "image-retention"
+	defaultImageRetentionSchedule = "0 0 4 * * *"
+)
+
+// ImageRetentionJob trims previous image versions of the repositories tracked
+// by the updater down to the configured keep count.
+type ImageRetentionJob struct {
+	updaterService  *services.UpdaterService
+	settingsService *services.SettingsService
+}
+
+func NewImageRetentionJob(updaterService *services.UpdaterService, settingsService *services.SettingsService) *ImageRetentionJob {
+	return &ImageRetentionJob{
+		updaterService:  updaterService,
+		settingsService: settingsService,
+	}
+}
+
+func (j *ImageRetentionJob) Name() string {
+	return ImageRetentionJobName
+}
+
+func (j *ImageRetentionJob) Schedule(ctx context.Context) string {
+	schedule := j.settingsService.GetStringSetting(ctx, "imageRetentionInterval", defaultImageRetentionSchedule)
+	if schedule == "" {
+		return defaultImageRetentionSchedule
+	}
+
+	parser := cron.NewParser(cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+	if _, err := parser.Parse(schedule); err != nil {
+		slog.WarnContext(ctx, "Invalid cron expression for image-retention, using default", "invalid_schedule", schedule, "error", err)
+		return defaultImageRetentionSchedule
+	}
+
+	return schedule
+}
+
+func (j *ImageRetentionJob) Run(ctx context.Context) {
+	if !j.settingsService.GetBoolSetting(ctx, "imageRetentionEnabled", false) {
+		slog.DebugContext(ctx, "image retention disabled; skipping run")
+		return
+	}
+
+	result, err := j.updaterService.ApplyImageRetention(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to apply image retention", "jobName", ImageRetentionJobName, "error", err)
+		return
+	}
+
+	slog.InfoContext(ctx, "image retention run completed",
+		"keep_count", result.KeepCount,
+		"repositories", result.Repositories,
+		"kept", result.Kept,
+		"removed", len(result.Removed),
+		"space_reclaimed_bytes", result.SpaceReclaimed,
+		"errors", len(result.Errors),
+	)
+}
+
+func (j *ImageRetentionJob) Reschedule(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,45 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/getarcaneapp/arcane/backend/internal/services"
+)
+
+const LogAlertJobName = "log-alerts"
+
+// LogAlertJob attaches log alert rules to containers that started since the
+// last run and applies rule changes. Lines are matched continuously between
+// runs.
+type LogAlertJob struct {
+	logAlertService *services.LogAlertService
+}
+
+func NewLogAlertJob(logAlertService *services.LogAlertService) *LogAlertJob {
+	return &LogAlertJob{
+		logAlertService: logAlertService,
+	}
+}
+
+func (j *LogAlertJob) Name() string {
+	return LogAlertJobName
+}
+
+func (j *LogAlertJob) Schedule(ctx context.Context) string {
+	return "*/30 * * * * *"
+}
+
+func (j *LogAlertJob) Run(ctx context.Context) {
+	if j.logAlertService == nil {
+		return
+	}
+
+	if err := j.logAlertService.Reconcile(ctx); err != nil {
+		slog.ErrorContext(ctx, "Failed to reconcile log alerts", "jobName", LogAlertJobName, "error", err)
+	}
+}
+
+func (j *LogAlertJob) Reschedule(ctx context.Context) error {
+	return nil
+}
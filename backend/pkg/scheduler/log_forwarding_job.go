@@ -0,0 +1,45 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/getarcaneapp/arcane/backend/internal/services"
+)
+
+const LogForwardingJobName = "log-forwarding"
+
+// LogForwardingJob attaches log forwarding to containers that started since
+// the last run and applies configuration changes. Lines are streamed
+// continuously between runs.
+type LogForwardingJob struct {
+	logForwardingService *services.LogForwardingService
+}
+
+func NewLogForwardingJob(logForwardingService *services.LogForwardingService) *LogForwardingJob {
+	return &LogForwardingJob{
+		logForwardingService: logForwardingService,
+	}
+}
+
+func (j *LogForwardingJob) Name() string {
+	return LogForwardingJobName
+}
+
+func (j *LogForwardingJob) Schedule(ctx context.Context) string {
+	return "*/30 * * * * *"
+}
+
+func (j *LogForwardingJob) Run(ctx context.Context) {
+	if j.logForwardingService == nil {
+		return
+	}
+
+	if err := j.logForwardingService.Reconcile(ctx); err != nil {
+		slog.ErrorContext(ctx, "Failed to reconcile log forwarding", "jobName", LogForwardingJobName, "error", err)
+	}
+}
+
+func (j *LogForwardingJob) Reschedule(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,43 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/getarcaneapp/arcane/backend/internal/services"
+)
+
+const NotificationCredentialJobName = "notification-credential-check"
+
+// NotificationCredentialJob verifies notification provider credentials every six hours.
+type NotificationCredentialJob struct {
+	credentialService *services.NotificationCredentialService
+}
+
+func NewNotificationCredentialJob(credentialService *services.NotificationCredentialService) *NotificationCredentialJob {
+	return &NotificationCredentialJob{
+		credentialService: credentialService,
+	}
+}
+
+func (j *NotificationCredentialJob) Name() string {
+	return NotificationCredentialJobName
+}
+
+func (j *NotificationCredentialJob) Schedule(ctx context.Context) string {
+	return "0 17 */6 * * *"
+}
+
+func (j *NotificationCredentialJob) Run(ctx context.Context) {
+	if j.credentialService == nil {
+		return
+	}
+
+	if err := j.credentialService.CheckAll(ctx); err != nil {
+		slog.ErrorContext(ctx, "Failed to check notification credentials", "jobName", NotificationCredentialJobName, "error", err)
+	}
+}
+
+func (j *NotificationCredentialJob) Reschedule(ctx context.Context) error {
+	return nil
+}
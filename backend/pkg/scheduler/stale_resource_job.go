@@ -0,0 +1,70 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/getarcaneapp/arcane/backend/internal/services"
+	"github.com/robfig/cron/v3"
+)
+
+const (
+	StaleResourceJobName         = "stale-resources"
+	defaultStaleResourceSchedule = "0 0 5 * * *"
+)
+
+// StaleResourceJob refreshes the stale resource report. It never removes
+// anything; cleanup is left to the user reviewing the report.
+type StaleResourceJob struct {
+	staleResourceService *services.StaleResourceService
+	settingsService      *services.SettingsService
+}
+
+func NewStaleResourceJob(staleResourceService *services.StaleResourceService, settingsService *services.SettingsService) *StaleResourceJob {
+	return &StaleResourceJob{
+		staleResourceService: staleResourceService,
+		settingsService:      settingsService,
+	}
+}
+
+func (j *StaleResourceJob) Name() string {
+	return StaleResourceJobName
+}
+
+func (j *StaleResourceJob) Schedule(ctx context.Context) string {
+	schedule := j.settingsService.GetStringSetting(ctx, "staleResourcesInterval", defaultStaleResourceSchedule)
+	if schedule == "" {
+		return defaultStaleResourceSchedule
+	}
+
+	parser := cron.NewParser(cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+	if _, err := parser.Parse(schedule); err != nil {
+		slog.WarnContext(ctx, "Invalid cron expression for stale-resources, using default", "invalid_schedule", schedule, "error", err)
+		return defaultStaleResourceSchedule
+	}
+
+	return schedule
+}
+
+func (j *StaleResourceJob) Run(ctx context.Context) {
+	if !j.settingsService.GetBoolSetting(ctx, "staleResourcesEnabled", false) {
+		slog.DebugContext(ctx, "stale resource report disabled; skipping run")
+		return
+	}
+
+	report, err := j.staleResourceService.Analyze(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to analyze stale resources", "jobName", StaleResourceJobName, "error", err)
+		return
+	}
+
+	slog.InfoContext(ctx, "stale resource analysis completed",
+		"items", len(report.Items),
+		"container_days", report.ContainerDays,
+		"image_days", report.ImageDays,
+	)
+}
+
+func (j *StaleResourceJob) Reschedule(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,253 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/getarcaneapp/arcane/backend/internal/models"
+	"github.com/getarcaneapp/arcane/backend/internal/services"
+	"github.com/getarcaneapp/arcane/backend/pkg/libarcane"
+	"github.com/moby/moby/api/types/events"
+	"github.com/moby/moby/client"
+)
+
+const (
+	// Arcane logs its event once an action has finished, which for a deploy
+	// can be well after Docker reported the first container change.
+	unmanagedChangeSettleDelay = 2 * time.Minute
+	// An Arcane event logged shortly before the change was first seen still
+	// explains it.
+	unmanagedChangeLookback = time.Minute
+
+	composeProjectLabel = "com.docker.compose.project"
+	composeServiceLabel = "com.docker.compose.service"
+)
+
+// pendingChange collects the Docker events seen for one container until they
+// are checked against the Arcane event log.
+type pendingChange struct {
+	containerID   string
+	containerName string
+	image         string
+	project       string
+	actions       []string
+	firstSeen     time.Time
+}
+
+// UnmanagedChangeWatcherJob listens to Docker container events and alerts when
+// a container is created, changed or removed without a matching Arcane action,
+// which points to someone bypassing Arcane or to a compromised host.
+type UnmanagedChangeWatcherJob struct {
+	dockerClientService *services.DockerClientService
+	settingsService     *services.SettingsService
+	eventService        *services.EventService
+	notificationService *services.NotificationService
+
+	mu      sync.Mutex
+	pending map[string]*pendingChange
+	now     func() time.Time
+}
+
+func NewUnmanagedChangeWatcherJob(
+	dockerClientService *services.DockerClientService,
+	settingsService *services.SettingsService,
+	eventService *services.EventService,
+	notificationService *services.NotificationService,
+) *UnmanagedChangeWatcherJob {
+	return &UnmanagedChangeWatcherJob{
+		dockerClientService: dockerClientService,
+		settingsService:     settingsService,
+		eventService:        eventService,
+		notificationService: notificationService,
+		pending:             make(map[string]*pendingChange),
+		now:                 time.Now,
+	}
+}
+
+// RegisterUnmanagedChangeWatcherJob starts the watcher in the background. It
+// runs until ctx is cancelled and reconnects when the Docker event stream drops.
+func RegisterUnmanagedChangeWatcherJob(
+	ctx context.Context,
+	dockerClientService *services.DockerClientService,
+	settingsService *services.SettingsService,
+	eventService *services.EventService,
+	notificationService *services.NotificationService,
+) *UnmanagedChangeWatcherJob {
+	job := NewUnmanagedChangeWatcherJob(dockerClientService, settingsService, eventService, notificationService)
+
+	go job.Start(ctx)
+
+	slog.InfoContext(ctx, "Unmanaged change watcher job registered")
+	return job
+}
+
+func (j *UnmanagedChangeWatcherJob) Start(ctx context.Context) {
+	retry := containerEventsRetryMin
+	for {
+		started := time.Now()
+		err := j.watchInternal(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		// Back off only while the stream keeps failing right away.
+		if time.Since(started) > containerEventsRetryMax {
+			retry = containerEventsRetryMin
+		}
+		if err != nil {
+			slog.WarnContext(ctx, "Container event stream failed; reconnecting", "error", err, "retry_in", retry)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retry):
+		}
+		retry = min(retry*2, containerEventsRetryMax)
+	}
+}
+
+func (j *UnmanagedChangeWatcherJob) watchInternal(ctx context.Context) error {
+	dockerClient, err := j.dockerClientService.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	filters := make(client.Filters)
+	filters = filters.Add("type", string(events.ContainerEventType))
+	filters = filters.Add("event", string(events.ActionCreate), string(events.ActionUpdate), string(events.ActionRename), string(events.ActionDestroy))
+
+	result := dockerClient.Events(ctx, client.EventsListOptions{Filters: filters})
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-result.Err:
+			return err
+		case msg := <-result.Messages:
+			j.handleEvent(ctx, msg)
+		}
+	}
+}
+
+func (j *UnmanagedChangeWatcherJob) handleEvent(ctx context.Context, msg events.Message) {
+	attrs := msg.Actor.Attributes
+	if msg.Actor.ID == "" || libarcane.IsInternalContainer(attrs) {
+		return
+	}
+	if !j.settingsService.GetBoolSetting(ctx, "unmanagedChangeDetection", false) {
+		return
+	}
+
+	key, isNew := j.recordChange(msg.Actor.ID, attrs, string(msg.Action))
+	if !isNew {
+		return
+	}
+
+	time.AfterFunc(unmanagedChangeSettleDelay, func() {
+		if ctx.Err() != nil {
+			return
+		}
+		if change := j.takePending(key); change != nil {
+			j.checkChange(ctx, change)
+		}
+	})
+}
+
+// recordChange adds a Docker event to the pending change for its container and
+// reports whether the change is new. Compose containers are grouped by service
+// so the create, rename and destroy of a recreate produce a single alert.
+func (j *UnmanagedChangeWatcherJob) recordChange(containerID string, attrs map[string]string, action string) (string, bool) {
+	name := strings.TrimPrefix(attrs["name"], "/")
+	project := attrs[composeProjectLabel]
+	key := name
+	if service := attrs[composeServiceLabel]; project != "" && service != "" {
+		key = project + "/" + service
+	} else if action == string(events.ActionRename) && attrs["oldName"] != "" {
+		key = strings.TrimPrefix(attrs["oldName"], "/")
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	change, exists := j.pending[key]
+	if !exists {
+		change = &pendingChange{
+			containerID:   containerID,
+			containerName: name,
+			project:       project,
+			firstSeen:     j.now(),
+		}
+		j.pending[key] = change
+	}
+	// Prefer the container that was created, since the others are gone.
+	if action == string(events.ActionCreate) {
+		change.containerID = containerID
+		change.containerName = name
+	}
+	if image := attrs["image"]; image != "" {
+		change.image = image
+	}
+	if !slices.Contains(change.actions, action) {
+		change.actions = append(change.actions, action)
+	}
+	return key, !exists
+}
+
+func (j *UnmanagedChangeWatcherJob) takePending(key string) *pendingChange {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	change := j.pending[key]
+	delete(j.pending, key)
+	return change
+}
+
+// checkChange alerts when Arcane has no event that explains the change.
+func (j *UnmanagedChangeWatcherJob) checkChange(ctx context.Context, change *pendingChange) {
+	if j.eventService == nil {
+		return
+	}
+
+	since := change.firstSeen.Add(-unmanagedChangeLookback)
+	managed, err := j.eventService.HasContainerChangeEventSince(ctx, since, change.containerID, change.containerName, change.project)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to correlate container change with Arcane events", "container", change.containerName, "error", err)
+		return
+	}
+	if managed {
+		return
+	}
+
+	j.notifyUnmanagedChange(ctx, change)
+}
+
+func (j *UnmanagedChangeWatcherJob) notifyUnmanagedChange(ctx context.Context, change *pendingChange) {
+	containerName := change.containerName
+	if containerName == "" {
+		containerName = change.containerID
+	}
+	slog.WarnContext(ctx, "Container changed outside Arcane", "container", containerName, "image", change.image, "actions", change.actions)
+
+	if err := j.eventService.LogContainerEvent(
+		ctx,
+		models.EventTypeContainerUnmanagedChange,
+		change.containerID,
+		containerName,
+		"", // no user - system action
+		"system",
+		"",
+		models.JSON{"actions": change.actions, "image": change.image, "project": change.project},
+	); err != nil {
+		slog.WarnContext(ctx, "Failed to log unmanaged change event", "container", containerName, "error", err)
+	}
+
+	if j.notificationService != nil {
+		if err := j.notificationService.SendUnmanagedChangeNotification(ctx, containerName, change.containerID, change.image, change.actions); err != nil {
+			slog.WarnContext(ctx, "Failed to send unmanaged change notification", "container", containerName, "error", err)
+		}
+	}
+}
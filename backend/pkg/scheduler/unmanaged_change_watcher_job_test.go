@@ -0,0 +1,64 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestUnmanagedChangeWatcherJob(now *time.Time) *UnmanagedChangeWatcherJob {
+	return &UnmanagedChangeWatcherJob{
+		pending: make(map[string]*pendingChange),
+		now:     func() time.Time { return *now },
+	}
+}
+
+func TestUnmanagedChangeWatcher_GroupsComposeRecreate(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	job := newTestUnmanagedChangeWatcherJob(&now)
+	labels := func(name string) map[string]string {
+		return map[string]string{
+			"name":                       name,
+			"image":                      "nginx:1.27",
+			"com.docker.compose.project": "web",
+			"com.docker.compose.service": "proxy",
+		}
+	}
+
+	key, isNew := job.recordChange("old", labels("abc_web-proxy-1"), "rename")
+	require.True(t, isNew)
+
+	now = now.Add(time.Second)
+	_, isNew = job.recordChange("new", labels("web-proxy-1"), "create")
+	require.False(t, isNew)
+	_, isNew = job.recordChange("old", labels("abc_web-proxy-1"), "destroy")
+	require.False(t, isNew)
+
+	change := job.takePending(key)
+	require.NotNil(t, change)
+	require.Equal(t, "new", change.containerID)
+	require.Equal(t, "web-proxy-1", change.containerName)
+	require.Equal(t, "web", change.project)
+	require.Equal(t, "nginx:1.27", change.image)
+	require.Equal(t, []string{"rename", "create", "destroy"}, change.actions)
+	require.Equal(t, time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC), change.firstSeen)
+
+	require.Nil(t, job.takePending(key), "a change is only checked once")
+}
+
+func TestUnmanagedChangeWatcher_RenameJoinsOldName(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	job := newTestUnmanagedChangeWatcherJob(&now)
+
+	key, isNew := job.recordChange("c1", map[string]string{"name": "/app", "image": "app:1"}, "update")
+	require.True(t, isNew)
+
+	renameKey, isNew := job.recordChange("c1", map[string]string{"name": "/app-old", "oldName": "/app"}, "rename")
+	require.False(t, isNew)
+	require.Equal(t, key, renameKey)
+
+	change := job.takePending(key)
+	require.Equal(t, "app:1", change.image)
+	require.Equal(t, []string{"update", "rename"}, change.actions)
+}
@@ -0,0 +1,44 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/getarcaneapp/arcane/backend/internal/services"
+)
+
+const UptimeMonitorJobName = "uptime-monitor"
+
+// UptimeMonitorJob runs the uptime checks that are due. It ticks every 15
+// seconds; each monitor's own interval decides whether it is checked.
+type UptimeMonitorJob struct {
+	monitorService *services.MonitorService
+}
+
+func NewUptimeMonitorJob(monitorService *services.MonitorService) *UptimeMonitorJob {
+	return &UptimeMonitorJob{
+		monitorService: monitorService,
+	}
+}
+
+func (j *UptimeMonitorJob) Name() string {
+	return UptimeMonitorJobName
+}
+
+func (j *UptimeMonitorJob) Schedule(ctx context.Context) string {
+	return "*/15 * * * * *"
+}
+
+func (j *UptimeMonitorJob) Run(ctx context.Context) {
+	if j.monitorService == nil {
+		return
+	}
+
+	if err := j.monitorService.RunDueChecks(ctx); err != nil {
+		slog.ErrorContext(ctx, "Failed to run uptime checks", "jobName", UptimeMonitorJobName, "error", err)
+	}
+}
+
+func (j *UptimeMonitorJob) Reschedule(ctx context.Context) error {
+	return nil
+}
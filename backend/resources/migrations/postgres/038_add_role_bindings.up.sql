@@ -0,0 +1,13 @@
+-- Add role_bindings table for environment and project scoped access control
+CREATE TABLE IF NOT EXISTS role_bindings (
+    id TEXT PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    role TEXT NOT NULL,
+    environment_id TEXT,
+    project_id TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_role_bindings_user ON role_bindings(user_id);
+CREATE INDEX IF NOT EXISTS idx_role_bindings_scope ON role_bindings(user_id, environment_id, project_id);
@@ -0,0 +1,2 @@
+-- Drop monitors table
+DROP TABLE IF EXISTS monitors;
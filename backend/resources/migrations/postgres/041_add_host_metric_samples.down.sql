@@ -0,0 +1,3 @@
+-- Drop host_metric_samples table
+DROP INDEX IF EXISTS idx_host_metric_samples_env_collected;
+DROP TABLE IF EXISTS host_metric_samples;
@@ -0,0 +1,3 @@
+ALTER TABLE environments DROP COLUMN IF EXISTS ssh_host_key_verification;
+ALTER TABLE environments DROP COLUMN IF EXISTS ssh_host_key;
+ALTER TABLE environments DROP COLUMN IF EXISTS ssh_key;
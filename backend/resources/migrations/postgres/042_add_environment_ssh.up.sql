@@ -0,0 +1,3 @@
+ALTER TABLE environments ADD COLUMN IF NOT EXISTS ssh_key TEXT;
+ALTER TABLE environments ADD COLUMN IF NOT EXISTS ssh_host_key TEXT;
+ALTER TABLE environments ADD COLUMN IF NOT EXISTS ssh_host_key_verification TEXT NOT NULL DEFAULT 'accept_new';
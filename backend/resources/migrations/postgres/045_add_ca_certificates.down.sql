@@ -0,0 +1,2 @@
+-- Drop ca_certificates table
+DROP TABLE IF EXISTS ca_certificates;
@@ -0,0 +1,3 @@
+-- Drop user_notification_subscriptions table
+DROP INDEX IF EXISTS idx_user_notification_subscriptions_user_provider;
+DROP TABLE IF EXISTS user_notification_subscriptions;
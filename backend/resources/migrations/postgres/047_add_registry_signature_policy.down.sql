@@ -0,0 +1,5 @@
+-- Remove registry signature verification policy columns
+ALTER TABLE container_registries DROP COLUMN IF EXISTS signature_issuer;
+ALTER TABLE container_registries DROP COLUMN IF EXISTS signature_identity;
+ALTER TABLE container_registries DROP COLUMN IF EXISTS signature_trust;
+ALTER TABLE container_registries DROP COLUMN IF EXISTS signature_mode;
@@ -0,0 +1,2 @@
+-- Drop log forwarders table
+DROP TABLE IF EXISTS log_forwarders;
@@ -0,0 +1,19 @@
+-- Add per-project log forwarding to Loki, syslog or rotated files
+CREATE TABLE IF NOT EXISTS log_forwarders (
+    id TEXT PRIMARY KEY,
+    project_id TEXT NOT NULL,
+    sink_type TEXT NOT NULL,
+    target TEXT NOT NULL,
+    token TEXT,
+    services TEXT NOT NULL DEFAULT '[]',
+    file_max_size_mb INTEGER NOT NULL DEFAULT 10,
+    file_max_files INTEGER NOT NULL DEFAULT 5,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    last_error TEXT,
+    last_shipped_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP,
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_log_forwarders_project ON log_forwarders(project_id);
@@ -0,0 +1,11 @@
+-- Add per-user dashboard layouts
+CREATE TABLE IF NOT EXISTS dashboard_layouts (
+    id TEXT PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    widgets TEXT NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_dashboard_layouts_user ON dashboard_layouts(user_id);
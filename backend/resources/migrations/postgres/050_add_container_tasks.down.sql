@@ -0,0 +1,3 @@
+-- Drop container task tables
+DROP TABLE IF EXISTS container_task_runs;
+DROP TABLE IF EXISTS container_tasks;
@@ -0,0 +1,35 @@
+-- Add scheduled commands run inside containers and their run history
+CREATE TABLE IF NOT EXISTS container_tasks (
+    id TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    container_name TEXT NOT NULL,
+    command TEXT NOT NULL,
+    "user" TEXT,
+    schedule TEXT NOT NULL,
+    timeout_seconds INTEGER NOT NULL DEFAULT 300,
+    notify_on_failure BOOLEAN NOT NULL DEFAULT TRUE,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    last_run_at TIMESTAMP,
+    last_status TEXT,
+    next_run_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS container_task_runs (
+    id TEXT PRIMARY KEY,
+    task_id TEXT NOT NULL,
+    "trigger" TEXT NOT NULL,
+    status TEXT NOT NULL,
+    exit_code INTEGER,
+    output TEXT NOT NULL DEFAULT '',
+    error TEXT,
+    started_at TIMESTAMP NOT NULL,
+    finished_at TIMESTAMP,
+    duration_ms BIGINT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP,
+    FOREIGN KEY (task_id) REFERENCES container_tasks(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_container_task_runs_task ON container_task_runs(task_id, started_at);
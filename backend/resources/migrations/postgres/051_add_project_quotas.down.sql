@@ -0,0 +1,2 @@
+ALTER TABLE projects DROP COLUMN IF EXISTS quota_memory_bytes;
+ALTER TABLE projects DROP COLUMN IF EXISTS quota_cpus;
@@ -0,0 +1,2 @@
+ALTER TABLE projects ADD COLUMN IF NOT EXISTS quota_cpus DOUBLE PRECISION;
+ALTER TABLE projects ADD COLUMN IF NOT EXISTS quota_memory_bytes BIGINT;
@@ -0,0 +1,4 @@
+ALTER TABLE projects DROP COLUMN IF EXISTS locked_at;
+ALTER TABLE projects DROP COLUMN IF EXISTS locked_by;
+ALTER TABLE projects DROP COLUMN IF EXISTS lock_reason;
+ALTER TABLE projects DROP COLUMN IF EXISTS locked;
@@ -0,0 +1,4 @@
+ALTER TABLE projects ADD COLUMN IF NOT EXISTS locked BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE projects ADD COLUMN IF NOT EXISTS lock_reason TEXT;
+ALTER TABLE projects ADD COLUMN IF NOT EXISTS locked_by TEXT;
+ALTER TABLE projects ADD COLUMN IF NOT EXISTS locked_at TIMESTAMPTZ;
@@ -0,0 +1,3 @@
+ALTER TABLE projects DROP COLUMN IF EXISTS maintenance_started_at;
+ALTER TABLE projects DROP COLUMN IF EXISTS maintenance_message;
+ALTER TABLE projects DROP COLUMN IF EXISTS maintenance;
@@ -0,0 +1,3 @@
+ALTER TABLE projects ADD COLUMN IF NOT EXISTS maintenance BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE projects ADD COLUMN IF NOT EXISTS maintenance_message TEXT;
+ALTER TABLE projects ADD COLUMN IF NOT EXISTS maintenance_started_at TIMESTAMPTZ;
@@ -0,0 +1,2 @@
+-- Drop image_pulls table
+DROP TABLE IF EXISTS image_pulls;
@@ -0,0 +1,22 @@
+-- Add image_pulls table for storing pull history
+CREATE TABLE IF NOT EXISTS image_pulls (
+    id TEXT PRIMARY KEY,
+    image_name TEXT NOT NULL,
+    user_id TEXT,
+    username TEXT,
+    triggered_by TEXT NOT NULL,
+    status TEXT NOT NULL,
+    layers INTEGER NOT NULL DEFAULT 0,
+    layers_downloaded INTEGER NOT NULL DEFAULT 0,
+    bytes BIGINT NOT NULL DEFAULT 0,
+    error_message TEXT,
+    completed_at TIMESTAMP,
+    duration_ms BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_image_pulls_image_name ON image_pulls(image_name);
+CREATE INDEX IF NOT EXISTS idx_image_pulls_triggered_by ON image_pulls(triggered_by);
+CREATE INDEX IF NOT EXISTS idx_image_pulls_status ON image_pulls(status);
+CREATE INDEX IF NOT EXISTS idx_image_pulls_created_at ON image_pulls(created_at);
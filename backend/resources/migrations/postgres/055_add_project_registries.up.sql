@@ -0,0 +1,11 @@
+-- Scope registry credentials to projects
+CREATE TABLE IF NOT EXISTS project_registries (
+    project_id TEXT NOT NULL,
+    registry_id TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (project_id, registry_id),
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE,
+    FOREIGN KEY (registry_id) REFERENCES container_registries(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_project_registries_registry ON project_registries(registry_id);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_auto_update_records_run_id;
+ALTER TABLE auto_update_records DROP COLUMN run_id;
+DROP TABLE IF EXISTS auto_update_runs;
@@ -0,0 +1,3 @@
+-- Link retry runs to the run they retry
+ALTER TABLE auto_update_runs ADD COLUMN retry_of_run_id TEXT REFERENCES auto_update_runs(id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS idx_auto_update_runs_retry_of_run_id ON auto_update_runs(retry_of_run_id);
@@ -0,0 +1,5 @@
+ALTER TABLE gitops_syncs DROP COLUMN last_tag_bump_url;
+ALTER TABLE gitops_syncs DROP COLUMN last_tag_bump_message;
+ALTER TABLE gitops_syncs DROP COLUMN last_tag_bump_status;
+ALTER TABLE gitops_syncs DROP COLUMN last_tag_bump_at;
+ALTER TABLE gitops_syncs DROP COLUMN tag_bump_mode;
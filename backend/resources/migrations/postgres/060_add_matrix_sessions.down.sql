@@ -0,0 +1,2 @@
+-- Drop matrix_sessions table
+DROP TABLE IF EXISTS matrix_sessions;
@@ -0,0 +1,7 @@
+-- Keep Matrix devices and encryption sessions across sends
+CREATE TABLE IF NOT EXISTS matrix_sessions (
+    id TEXT PRIMARY KEY,
+    state TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP
+);
@@ -0,0 +1,2 @@
+-- Drop command_webhooks table
+DROP TABLE IF EXISTS command_webhooks;
@@ -0,0 +1,2 @@
+-- Remove environment capabilities
+ALTER TABLE environments DROP COLUMN capabilities;
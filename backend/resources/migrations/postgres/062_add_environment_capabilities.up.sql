@@ -0,0 +1,2 @@
+-- Store the capabilities advertised by environment agents
+ALTER TABLE environments ADD COLUMN capabilities TEXT;
@@ -0,0 +1,4 @@
+-- Queue agent-initiated pairing requests for admin approval
+ALTER TABLE environments ADD COLUMN pairing_fingerprint TEXT;
+ALTER TABLE environments ADD COLUMN pairing_origin_ip TEXT;
+ALTER TABLE environments ADD COLUMN pairing_requested_at TIMESTAMPTZ;
@@ -0,0 +1,2 @@
+-- Drop log alert rules table
+DROP TABLE IF EXISTS log_alert_rules;
@@ -0,0 +1,2 @@
+-- Drop image update snoozes
+ALTER TABLE image_updates DROP COLUMN IF EXISTS snoozed_until;
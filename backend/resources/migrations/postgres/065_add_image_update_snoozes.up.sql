@@ -0,0 +1,2 @@
+-- Track update snoozes on the image update records of each repository and tag
+ALTER TABLE image_updates ADD COLUMN snoozed_until TIMESTAMP;
@@ -0,0 +1,4 @@
+-- Track the previous images an update run keeps for rollback
+ALTER TABLE auto_update_runs ADD COLUMN retained_images TEXT;
+ALTER TABLE auto_update_runs ADD COLUMN retained_until TIMESTAMP;
+CREATE INDEX IF NOT EXISTS idx_auto_update_runs_retained_until ON auto_update_runs(retained_until);
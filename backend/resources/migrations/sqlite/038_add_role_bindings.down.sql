@@ -0,0 +1,2 @@
+-- Drop role_bindings table
+DROP TABLE IF EXISTS role_bindings;
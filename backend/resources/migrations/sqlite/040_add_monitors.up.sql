@@ -0,0 +1,26 @@
+-- Add monitors table for built-in uptime checks
+CREATE TABLE IF NOT EXISTS monitors (
+    id TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    type TEXT NOT NULL,
+    target TEXT NOT NULL,
+    expected_status INTEGER NOT NULL DEFAULT 0,
+    interval_seconds INTEGER NOT NULL DEFAULT 60,
+    timeout_seconds INTEGER NOT NULL DEFAULT 10,
+    failure_threshold INTEGER NOT NULL DEFAULT 1,
+    environment_id TEXT NOT NULL DEFAULT '0',
+    container_id TEXT,
+    project_id TEXT,
+    service_name TEXT,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    status TEXT NOT NULL DEFAULT 'unknown',
+    consecutive_failures INTEGER NOT NULL DEFAULT 0,
+    last_checked_at DATETIME,
+    last_latency_ms INTEGER,
+    last_error TEXT,
+    last_status_change_at DATETIME,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME
+);
+
+CREATE INDEX IF NOT EXISTS idx_monitors_environment ON monitors(environment_id);
@@ -0,0 +1,19 @@
+-- Add host_metric_samples table for per-environment host metrics history
+CREATE TABLE IF NOT EXISTS host_metric_samples (
+    id TEXT PRIMARY KEY,
+    environment_id TEXT NOT NULL,
+    cpu_percent REAL NOT NULL DEFAULT 0,
+    cpu_count INTEGER NOT NULL DEFAULT 0,
+    memory_used INTEGER NOT NULL DEFAULT 0,
+    memory_total INTEGER NOT NULL DEFAULT 0,
+    disk_used INTEGER NOT NULL DEFAULT 0,
+    disk_total INTEGER NOT NULL DEFAULT 0,
+    load1 REAL NOT NULL DEFAULT 0,
+    load5 REAL NOT NULL DEFAULT 0,
+    load15 REAL NOT NULL DEFAULT 0,
+    collected_at DATETIME NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME
+);
+
+CREATE INDEX IF NOT EXISTS idx_host_metric_samples_env_collected ON host_metric_samples(environment_id, collected_at);
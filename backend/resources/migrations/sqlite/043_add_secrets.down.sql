@@ -0,0 +1,2 @@
+-- Drop secrets table
+DROP TABLE IF EXISTS secrets;
@@ -0,0 +1,4 @@
+-- Remove notification credential check columns
+ALTER TABLE notification_settings DROP COLUMN credential_checked_at;
+ALTER TABLE notification_settings DROP COLUMN credential_error;
+ALTER TABLE notification_settings DROP COLUMN credential_status;
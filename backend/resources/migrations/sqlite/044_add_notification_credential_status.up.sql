@@ -0,0 +1,4 @@
+-- Track the result of periodic notification provider credential checks
+ALTER TABLE notification_settings ADD COLUMN credential_status TEXT;
+ALTER TABLE notification_settings ADD COLUMN credential_error TEXT;
+ALTER TABLE notification_settings ADD COLUMN credential_checked_at DATETIME;
@@ -0,0 +1,11 @@
+-- Add custom CA certificates trusted for outbound TLS
+CREATE TABLE IF NOT EXISTS ca_certificates (
+    id TEXT PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE,
+    certificate TEXT NOT NULL,
+    subject TEXT NOT NULL,
+    fingerprint TEXT NOT NULL UNIQUE,
+    not_after DATETIME NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME
+);
@@ -0,0 +1,5 @@
+-- Per-registry signature verification policy for image updates
+ALTER TABLE container_registries ADD COLUMN signature_mode TEXT NOT NULL DEFAULT '';
+ALTER TABLE container_registries ADD COLUMN signature_trust TEXT;
+ALTER TABLE container_registries ADD COLUMN signature_identity TEXT;
+ALTER TABLE container_registries ADD COLUMN signature_issuer TEXT;
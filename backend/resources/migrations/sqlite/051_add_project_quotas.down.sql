@@ -0,0 +1,2 @@
+ALTER TABLE projects DROP COLUMN quota_memory_bytes;
+ALTER TABLE projects DROP COLUMN quota_cpus;
@@ -0,0 +1,2 @@
+ALTER TABLE projects ADD COLUMN quota_cpus REAL;
+ALTER TABLE projects ADD COLUMN quota_memory_bytes INTEGER;
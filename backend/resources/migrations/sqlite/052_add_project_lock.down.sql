@@ -0,0 +1,4 @@
+ALTER TABLE projects DROP COLUMN locked_at;
+ALTER TABLE projects DROP COLUMN locked_by;
+ALTER TABLE projects DROP COLUMN lock_reason;
+ALTER TABLE projects DROP COLUMN locked;
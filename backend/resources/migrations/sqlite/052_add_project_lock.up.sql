@@ -0,0 +1,4 @@
+ALTER TABLE projects ADD COLUMN locked INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE projects ADD COLUMN lock_reason TEXT;
+ALTER TABLE projects ADD COLUMN locked_by TEXT;
+ALTER TABLE projects ADD COLUMN locked_at DATETIME;
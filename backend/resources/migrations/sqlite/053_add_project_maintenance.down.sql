@@ -0,0 +1,3 @@
+ALTER TABLE projects DROP COLUMN maintenance_started_at;
+ALTER TABLE projects DROP COLUMN maintenance_message;
+ALTER TABLE projects DROP COLUMN maintenance;
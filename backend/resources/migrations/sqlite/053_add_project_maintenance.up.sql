@@ -0,0 +1,3 @@
+ALTER TABLE projects ADD COLUMN maintenance INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE projects ADD COLUMN maintenance_message TEXT;
+ALTER TABLE projects ADD COLUMN maintenance_started_at DATETIME;
@@ -0,0 +1,2 @@
+-- Drop project registry scoping
+DROP TABLE IF EXISTS project_registries;
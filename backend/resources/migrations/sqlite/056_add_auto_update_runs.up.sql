@@ -0,0 +1,23 @@
+-- Group auto-update records into runs
+CREATE TABLE IF NOT EXISTS auto_update_runs (
+    id TEXT PRIMARY KEY,
+    triggered_by TEXT NOT NULL,
+    status TEXT NOT NULL,
+    dry_run BOOLEAN NOT NULL DEFAULT false,
+    start_time DATETIME NOT NULL,
+    end_time DATETIME,
+    checked INTEGER NOT NULL DEFAULT 0,
+    updated INTEGER NOT NULL DEFAULT 0,
+    skipped INTEGER NOT NULL DEFAULT 0,
+    failed INTEGER NOT NULL DEFAULT 0,
+    blocked INTEGER NOT NULL DEFAULT 0,
+    error TEXT,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME
+);
+
+CREATE INDEX IF NOT EXISTS idx_auto_update_runs_start_time ON auto_update_runs(start_time);
+CREATE INDEX IF NOT EXISTS idx_auto_update_runs_triggered_by ON auto_update_runs(triggered_by);
+
+ALTER TABLE auto_update_records ADD COLUMN run_id TEXT REFERENCES auto_update_runs(id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS idx_auto_update_records_run_id ON auto_update_records(run_id);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_auto_update_runs_retry_of_run_id;
+ALTER TABLE auto_update_runs DROP COLUMN retry_of_run_id;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_saved_filters_type_name;
+DROP TABLE IF EXISTS saved_filters;
+DROP INDEX IF EXISTS idx_resource_tags_key_value;
+DROP INDEX IF EXISTS idx_resource_tags_resource_key;
+DROP TABLE IF EXISTS resource_tags;
@@ -0,0 +1,25 @@
+-- Add user-defined tags on projects, containers and environments, and saved list filters
+CREATE TABLE IF NOT EXISTS resource_tags (
+    id TEXT PRIMARY KEY,
+    resource_type TEXT NOT NULL,
+    resource_id TEXT NOT NULL,
+    tag_key TEXT NOT NULL,
+    tag_value TEXT NOT NULL DEFAULT '',
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_resource_tags_resource_key ON resource_tags(resource_type, resource_id, tag_key);
+CREATE INDEX IF NOT EXISTS idx_resource_tags_key_value ON resource_tags(resource_type, tag_key, tag_value);
+
+CREATE TABLE IF NOT EXISTS saved_filters (
+    id TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    resource_type TEXT NOT NULL,
+    search TEXT NOT NULL DEFAULT '',
+    filters TEXT NOT NULL DEFAULT '{}',
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_saved_filters_type_name ON saved_filters(resource_type, name);
@@ -0,0 +1,6 @@
+-- Propose newer image tags for GitOps projects as commits or pull requests
+ALTER TABLE gitops_syncs ADD COLUMN tag_bump_mode TEXT NOT NULL DEFAULT '';
+ALTER TABLE gitops_syncs ADD COLUMN last_tag_bump_at DATETIME;
+ALTER TABLE gitops_syncs ADD COLUMN last_tag_bump_status TEXT;
+ALTER TABLE gitops_syncs ADD COLUMN last_tag_bump_message TEXT;
+ALTER TABLE gitops_syncs ADD COLUMN last_tag_bump_url TEXT;
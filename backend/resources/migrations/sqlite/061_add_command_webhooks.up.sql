@@ -0,0 +1,15 @@
+-- Add inbound webhooks that run allowlisted actions
+CREATE TABLE IF NOT EXISTS command_webhooks (
+    id TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    token_hash TEXT NOT NULL,
+    token_prefix TEXT NOT NULL,
+    actions TEXT NOT NULL,
+    user_id TEXT NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    last_used_at DATETIME,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME
+);
+
+CREATE INDEX IF NOT EXISTS idx_command_webhooks_token_prefix ON command_webhooks(token_prefix);
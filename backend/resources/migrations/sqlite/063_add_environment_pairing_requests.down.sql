@@ -0,0 +1,4 @@
+-- Remove queued pairing requests
+ALTER TABLE environments DROP COLUMN pairing_requested_at;
+ALTER TABLE environments DROP COLUMN pairing_origin_ip;
+ALTER TABLE environments DROP COLUMN pairing_fingerprint;
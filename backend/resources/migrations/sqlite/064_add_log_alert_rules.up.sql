@@ -0,0 +1,17 @@
+-- Add alert rules that match container log lines against a pattern
+CREATE TABLE IF NOT EXISTS log_alert_rules (
+    id TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    pattern TEXT NOT NULL,
+    project_id TEXT,
+    container_name TEXT,
+    services TEXT NOT NULL DEFAULT '[]',
+    cooldown_seconds INTEGER NOT NULL DEFAULT 300,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    last_fired_at DATETIME,
+    last_excerpt TEXT,
+    last_error TEXT,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME,
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
+);
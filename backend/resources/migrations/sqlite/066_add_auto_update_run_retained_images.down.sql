@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_auto_update_runs_retained_until;
+ALTER TABLE auto_update_runs DROP COLUMN retained_until;
+ALTER TABLE auto_update_runs DROP COLUMN retained_images;
@@ -32,6 +32,7 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"os"
 	"strings"
 	"time"
 
@@ -40,10 +41,13 @@ import (
 	"github.com/getarcaneapp/arcane/cli/internal/types"
 	"github.com/getarcaneapp/arcane/types/auth"
 	"github.com/getarcaneapp/arcane/types/base"
+	"github.com/gorilla/websocket"
 )
 
 const (
 	headerAPIKey   = "X-API-KEY" //nolint:gosec
+	envServerURL   = "ARCANE_SERVER_URL"
+	envAPIKey      = "ARCANE_API_KEY" //nolint:gosec
 	defaultTimeout = 10 * time.Minute
 	defaultEnvID   = "0"
 	maxErrorBody   = 4096
@@ -78,6 +82,7 @@ type Client struct {
 // (ServerURL, APIKey) are missing. The client is initialized with a default
 // 30-second timeout and the configured environment ID.
 func New(cfg *types.Config) (*Client, error) {
+	cfg = applyEnvOverridesInternal(cfg)
 	if err := cfg.Validate(); err != nil {
 		return nil, err
 	}
@@ -108,6 +113,7 @@ func New(cfg *types.Config) (*Client, error) {
 // NewUnauthenticated creates a client that can call unauthenticated endpoints
 // (e.g. /api/auth/login). It only validates that server_url is configured.
 func NewUnauthenticated(cfg *types.Config) (*Client, error) {
+	cfg = applyEnvOverridesInternal(cfg)
 	if err := cfg.ValidateServerURL(); err != nil {
 		return nil, err
 	}
@@ -189,6 +195,28 @@ func NewFromConfigUnauthenticated() (*Client, error) {
 	return c, nil
 }
 
+// applyEnvOverridesInternal lets ARCANE_SERVER_URL and ARCANE_API_KEY take
+// precedence over the config file, so CI jobs can run without one. An API key
+// from the environment replaces any stored login session. cfg is not modified.
+func applyEnvOverridesInternal(cfg *types.Config) *types.Config {
+	serverURL := strings.TrimSpace(os.Getenv(envServerURL))
+	apiKey := strings.TrimSpace(os.Getenv(envAPIKey))
+	if serverURL == "" && apiKey == "" {
+		return cfg
+	}
+
+	cfg = cfg.Clone()
+	if serverURL != "" {
+		cfg.ServerURL = serverURL
+	}
+	if apiKey != "" {
+		cfg.APIKey = apiKey
+		cfg.JWTToken = ""
+		cfg.RefreshToken = ""
+	}
+	return cfg
+}
+
 // SetEnvironment changes the environment ID for subsequent requests.
 // This allows switching between different Arcane environments without
 // creating a new client instance.
@@ -440,13 +468,17 @@ func isIdempotentMethod(method string) bool {
 }
 
 func (c *Client) applyAuth(req *http.Request) {
+	c.setAuthHeaders(req.Header)
+}
+
+func (c *Client) setAuthHeaders(header http.Header) {
 	// Prefer JWT bearer token if present.
 	if c.jwtToken != "" {
-		req.Header.Set("Authorization", "Bearer "+c.jwtToken)
+		header.Set("Authorization", "Bearer "+c.jwtToken)
 		return
 	}
 	if c.apiKey != "" {
-		req.Header.Set(headerAPIKey, c.apiKey)
+		header.Set(headerAPIKey, c.apiKey)
 	}
 }
 
@@ -541,6 +573,56 @@ func (c *Client) Delete(ctx context.Context, path string) (*http.Response, error
 	return c.Request(ctx, http.MethodDelete, path, nil)
 }
 
+// DialWebSocket opens a WebSocket connection to the specified path, such as a
+// log stream. The http(s) scheme of the server URL is switched to ws(s) and
+// the client's credentials are sent with the handshake. The caller is
+// responsible for closing the connection.
+func (c *Client) DialWebSocket(ctx context.Context, path string) (*websocket.Conn, error) {
+	fullURL, err := c.resolveURL(path)
+	if err != nil {
+		return nil, err
+	}
+	wsURL, err := url.Parse(fullURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid path: %w", err)
+	}
+	switch wsURL.Scheme {
+	case "https":
+		wsURL.Scheme = "wss"
+	case "http":
+		wsURL.Scheme = "ws"
+	}
+
+	dialer := *websocket.DefaultDialer
+	allowRefresh := true
+	for {
+		header := http.Header{}
+		c.setAuthHeaders(header)
+
+		conn, resp, err := dialer.DialContext(ctx, wsURL.String(), header)
+		if err == nil {
+			if resp != nil && resp.Body != nil {
+				_ = resp.Body.Close()
+			}
+			return conn, nil
+		}
+		if resp == nil {
+			return nil, fmt.Errorf("websocket connection failed: %w", err)
+		}
+
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		_ = resp.Body.Close()
+		if resp.StatusCode == http.StatusUnauthorized && allowRefresh && c.jwtToken != "" && c.refreshToken != "" {
+			if err := c.refreshAccessToken(ctx); err != nil {
+				return nil, err
+			}
+			allowRefresh = false
+			continue
+		}
+		return nil, fmt.Errorf("websocket handshake failed with status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+}
+
 // EnvPath returns a path prefixed with the environment.
 // It constructs an environment-scoped API path in the format:
 // /api/environments/{envID}{path}
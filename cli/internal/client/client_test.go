@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/getarcaneapp/arcane/cli/internal/types"
+	"github.com/gorilla/websocket"
 )
 
 func TestClient_UsesAPIKeyHeader(t *testing.T) {
@@ -143,3 +144,71 @@ func TestClient_Request_DoesNotDoubleMarshalBytes(t *testing.T) {
 		t.Fatalf("unexpected status: %d", resp.StatusCode)
 	}
 }
+
+func TestClient_DialWebSocketSendsAPIKey(t *testing.T) {
+	t.Parallel()
+
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-API-KEY"); got != "arc_test_key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Query().Get("follow") != "false" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+		_ = conn.WriteMessage(websocket.TextMessage, []byte("hello"))
+	}))
+	defer srv.Close()
+
+	c, err := New(&types.Config{ServerURL: srv.URL, APIKey: "arc_test_key"})
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+
+	conn, err := c.DialWebSocket(context.Background(), "/api/environments/0/ws/projects/p1/logs?follow=false")
+	if err != nil {
+		t.Fatalf("DialWebSocket() error: %v", err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	_, msg, err := conn.ReadMessage()
+	if err != nil {
+		t.Fatalf("ReadMessage() error: %v", err)
+	}
+	if string(msg) != "hello" {
+		t.Fatalf("unexpected message: %q", msg)
+	}
+
+	bad, err := New(&types.Config{ServerURL: srv.URL, APIKey: "arc_wrong_key"})
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+	if _, err := bad.DialWebSocket(context.Background(), "/api/environments/0/ws/projects/p1/logs?follow=false"); err == nil {
+		t.Fatal("expected handshake error for invalid API key")
+	}
+}
+
+func TestApplyEnvOverrides(t *testing.T) {
+	t.Setenv("ARCANE_SERVER_URL", "https://arcane.example.com")
+	t.Setenv("ARCANE_API_KEY", "arc_env_key")
+
+	stored := &types.Config{ServerURL: "http://localhost:3552", JWTToken: "header.payload.sig", RefreshToken: "refresh"}
+	cfg := applyEnvOverridesInternal(stored)
+
+	if cfg.ServerURL != "https://arcane.example.com" {
+		t.Fatalf("unexpected server URL: %q", cfg.ServerURL)
+	}
+	if cfg.APIKey != "arc_env_key" || cfg.JWTToken != "" || cfg.RefreshToken != "" {
+		t.Fatalf("API key from environment should replace the stored session: %+v", cfg)
+	}
+	if stored.JWTToken == "" || stored.APIKey != "" {
+		t.Fatalf("stored config should not be modified: %+v", stored)
+	}
+}
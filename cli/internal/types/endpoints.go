@@ -81,6 +81,7 @@ type ArcaneApiEndpoints struct {
 	ProjectRedeployEndpoint string
 	ProjectPullEndpoint     string
 	ProjectIncludesEndpoint string
+	ProjectLogsEndpoint     string
 
 	// System
 	SystemPruneEndpoint              string
@@ -244,6 +245,7 @@ var Endpoints = ArcaneApiEndpoints{ //nolint:gosec // static endpoint paths; aut
 	ProjectRedeployEndpoint: "/api/environments/%s/projects/%s/redeploy",
 	ProjectPullEndpoint:     "/api/environments/%s/projects/%s/pull",
 	ProjectIncludesEndpoint: "/api/environments/%s/projects/%s/includes",
+	ProjectLogsEndpoint:     "/api/environments/%s/ws/projects/%s/logs",
 
 	// System
 	SystemPruneEndpoint:              "/api/environments/%s/system/prune",
@@ -515,6 +517,10 @@ func (e ArcaneApiEndpoints) ProjectIncludes(envID, projectID string) string {
 	return fmt.Sprintf(e.ProjectIncludesEndpoint, envID, projectID)
 }
 
+func (e ArcaneApiEndpoints) ProjectLogs(envID, projectID string) string {
+	return fmt.Sprintf(e.ProjectLogsEndpoint, envID, projectID)
+}
+
 // System endpoints
 func (e ArcaneApiEndpoints) SystemPrune(envID string) string {
 	return fmt.Sprintf(e.SystemPruneEndpoint, envID)
@@ -3,12 +3,16 @@ package projects
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/url"
 	"os"
+	"os/signal"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -19,6 +23,7 @@ import (
 	"github.com/getarcaneapp/arcane/cli/internal/types"
 	"github.com/getarcaneapp/arcane/types/base"
 	"github.com/getarcaneapp/arcane/types/project"
+	"github.com/gorilla/websocket"
 	"github.com/spf13/cobra"
 )
 
@@ -34,9 +39,20 @@ var (
 	updateFile    string
 	updateEnvFile string
 	includesFile  string
+
+	logsFollow     bool
+	logsTail       string
+	logsSince      string
+	logsTimestamps bool
 )
 
-const maxPromptOptions = 20
+const (
+	maxPromptOptions = 20
+
+	// logsIdleTimeout ends a log stream without --follow once no new lines
+	// have arrived for this long, since the server keeps the socket open.
+	logsIdleTimeout = 3 * time.Second
+)
 
 // ProjectsCmd is the parent command for project operations
 var ProjectsCmd = &cobra.Command{
@@ -538,6 +554,71 @@ var updateIncludesCmd = &cobra.Command{
 	},
 }
 
+var logsCmd = &cobra.Command{
+	Use:          "logs <project-id|name>",
+	Short:        "Show project logs",
+	Long:         "Print the most recent log lines of a project's services. With --follow, keep streaming new lines until interrupted.",
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := client.NewFromConfig()
+		if err != nil {
+			return err
+		}
+
+		resolved, _, err := resolveProject(cmd.Context(), c, args[0], false)
+		if err != nil {
+			return err
+		}
+
+		query := url.Values{}
+		query.Set("follow", strconv.FormatBool(logsFollow))
+		query.Set("tail", logsTail)
+		query.Set("timestamps", strconv.FormatBool(logsTimestamps))
+		if logsSince != "" {
+			query.Set("since", logsSince)
+		}
+		if jsonOutput {
+			query.Set("format", "json")
+		}
+
+		ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+		defer stop()
+
+		conn, err := c.DialWebSocket(ctx, types.Endpoints.ProjectLogs(c.EnvID(), resolved.ID)+"?"+query.Encode())
+		if err != nil {
+			return fmt.Errorf("failed to stream project logs: %w", err)
+		}
+		defer func() { _ = conn.Close() }()
+
+		// Closing the connection unblocks the read below on interrupt.
+		go func() {
+			<-ctx.Done()
+			_ = conn.Close()
+		}()
+
+		for {
+			if !logsFollow {
+				_ = conn.SetReadDeadline(time.Now().Add(logsIdleTimeout))
+			}
+			_, msg, err := conn.ReadMessage()
+			if err != nil {
+				var netErr net.Error
+				switch {
+				case ctx.Err() != nil:
+					return nil
+				case websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway):
+					return nil
+				case !logsFollow && errors.As(err, &netErr) && netErr.Timeout():
+					return nil
+				}
+				return fmt.Errorf("project log stream ended: %w", err)
+			}
+			fmt.Println(strings.TrimRight(string(msg), "\n"))
+		}
+	},
+}
+
 var countsCmd = &cobra.Command{
 	Use:          "counts",
 	Short:        "Get project counts",
@@ -585,6 +666,7 @@ func init() {
 	ProjectsCmd.AddCommand(redeployCmd)
 	ProjectsCmd.AddCommand(pullCmd)
 	ProjectsCmd.AddCommand(countsCmd)
+	ProjectsCmd.AddCommand(logsCmd)
 	ProjectsCmd.AddCommand(destroyCmd)
 	ProjectsCmd.AddCommand(createCmd)
 	ProjectsCmd.AddCommand(updateCmd)
@@ -597,6 +679,13 @@ func init() {
 	// Get command flags
 	getCmd.Flags().BoolVar(&jsonOutput, "json", false, "Output in JSON format")
 
+	// Logs command flags
+	logsCmd.Flags().BoolVarP(&logsFollow, "follow", "f", false, "Keep streaming new log lines")
+	logsCmd.Flags().StringVar(&logsTail, "tail", "100", "Number of lines to show from the end of the logs, or \"all\"")
+	logsCmd.Flags().StringVar(&logsSince, "since", "", "Show logs since a timestamp or relative duration (e.g. 2024-01-02T13:00:00Z, 10m)")
+	logsCmd.Flags().BoolVarP(&logsTimestamps, "timestamps", "t", false, "Show timestamps")
+	logsCmd.Flags().BoolVar(&jsonOutput, "json", false, "Output each log line as a JSON object")
+
 	// Counts command flags
 	countsCmd.Flags().BoolVar(&jsonOutput, "json", false, "Output in JSON format")
 
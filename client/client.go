@@ -0,0 +1,293 @@
+// Package client is a typed Go client for the Arcane API.
+//
+// The operation methods and the request and response types in
+// zz_generated.go are generated from the OpenAPI specification that the
+// backend serves at /api/openapi.json. Run `just generate-client` after
+// changing an endpoint instead of editing them by hand. Helpers for the
+// WebSocket log and event streams, which the specification does not
+// describe, live in stream.go.
+//
+// # Creating a Client
+//
+//	c, err := client.New("https://arcane.example.com", client.WithAPIKey("arc_xxxxxxxxxxxxx"))
+//	if err != nil {
+//	    return err
+//	}
+//
+// # Errors
+//
+// Responses outside the 2xx range are returned as *Error, which carries the
+// status code and the problem details reported by the server:
+//
+//	var apiErr *client.Error
+//	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+//	    // ...
+//	}
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	headerAPIKey   = "X-API-Key" //nolint:gosec // header name, not a credential
+	defaultTimeout = 10 * time.Minute
+	maxErrorBody   = 64 * 1024
+	maxStreamLine  = 1024 * 1024
+)
+
+// Client calls the Arcane API. It is safe for concurrent use.
+type Client struct {
+	baseURL    *url.URL
+	httpClient *http.Client
+	header     http.Header
+}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithAPIKey authenticates requests with an API key.
+func WithAPIKey(key string) Option {
+	return func(c *Client) {
+		c.header.Del("Authorization")
+		c.header.Set(headerAPIKey, key)
+	}
+}
+
+// WithBearerToken authenticates requests with a JWT access token.
+func WithBearerToken(token string) Option {
+	return func(c *Client) {
+		c.header.Del(headerAPIKey)
+		c.header.Set("Authorization", "Bearer "+token)
+	}
+}
+
+// WithHTTPClient replaces the HTTP client used for requests, for example to
+// trust a private certificate authority. It is not used for WebSocket streams.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithHeader sends an additional header with every request.
+func WithHeader(key, value string) Option {
+	return func(c *Client) {
+		c.header.Set(key, value)
+	}
+}
+
+// New creates a client for the Arcane server at serverURL, such as
+// https://arcane.example.com. Operation paths already include the /api prefix.
+func New(serverURL string, opts ...Option) (*Client, error) {
+	parsed, err := url.Parse(strings.TrimRight(strings.TrimSpace(serverURL), "/"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid server URL: %w", err)
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return nil, fmt.Errorf("invalid server URL %q: scheme must be http or https", serverURL)
+	}
+
+	c := &Client{
+		baseURL:    parsed,
+		httpClient: &http.Client{Timeout: defaultTimeout},
+		header:     http.Header{},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c, nil
+}
+
+// Error is returned for responses outside the 2xx range. The server reports
+// failures as RFC 9457 problem details.
+type Error struct {
+	StatusCode int           `json:"status"`
+	Title      string        `json:"title,omitempty"`
+	Detail     string        `json:"detail,omitempty"`
+	Errors     []ErrorDetail `json:"errors,omitempty"`
+}
+
+// ErrorDetail describes a single validation failure.
+type ErrorDetail struct {
+	Message  string `json:"message,omitempty"`
+	Location string `json:"location,omitempty"`
+	Value    any    `json:"value,omitempty"`
+}
+
+func (e *Error) Error() string {
+	msg := e.Detail
+	if msg == "" {
+		msg = e.Title
+	}
+	if msg == "" {
+		msg = http.StatusText(e.StatusCode)
+	}
+	for _, detail := range e.Errors {
+		if detail.Location != "" {
+			msg += fmt.Sprintf("; %s: %s", detail.Location, detail.Message)
+		} else if detail.Message != "" {
+			msg += "; " + detail.Message
+		}
+	}
+	return fmt.Sprintf("arcane API error (status %d): %s", e.StatusCode, msg)
+}
+
+func (c *Client) newRequest(ctx context.Context, method, path string, query url.Values, header http.Header, body any) (*http.Request, error) {
+	target := c.baseURL.JoinPath(path)
+	target.RawQuery = query.Encode()
+
+	var reader io.Reader
+	contentType := ""
+	switch v := body.(type) {
+	case nil:
+	case io.Reader:
+		reader = v
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal request body: %w", err)
+		}
+		reader = bytes.NewReader(data)
+		contentType = "application/json"
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, target.String(), reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	for key, values := range c.header {
+		req.Header[key] = values
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	if req.Header.Get("Accept") == "" {
+		req.Header.Set("Accept", "application/json")
+	}
+	return req, nil
+}
+
+// send performs a request and returns the response if its status is 2xx.
+func (c *Client) send(ctx context.Context, method, path string, query url.Values, header http.Header, body any) (*http.Response, error) {
+	req, err := c.newRequest(ctx, method, path, query, header, body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp, nil
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+	return nil, readError(resp)
+}
+
+func readError(resp *http.Response) error {
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+	apiErr := &Error{}
+	if err := json.Unmarshal(data, apiErr); err != nil || (apiErr.Title == "" && apiErr.Detail == "") {
+		apiErr = &Error{Detail: strings.TrimSpace(string(data))}
+	}
+	apiErr.StatusCode = resp.StatusCode
+	return apiErr
+}
+
+// doJSON performs a request and decodes the JSON response into out, which
+// may be nil to discard the body.
+func (c *Client) doJSON(ctx context.Context, method, path string, query url.Values, header http.Header, body, out any) error {
+	resp, err := c.send(ctx, method, path, query, header, body)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if out == nil || resp.StatusCode == http.StatusNoContent {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// doRaw performs a request and returns the response body unread. The caller
+// must close it.
+func (c *Client) doRaw(ctx context.Context, method, path string, query url.Values, header http.Header, body any) (io.ReadCloser, error) {
+	resp, err := c.send(ctx, method, path, query, header, body)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// doStream performs a request whose response is a stream of JSON objects,
+// one per line, such as deploy or image pull progress. Each object is passed
+// to onMessage. A line with an "error" field ends the stream with that error.
+func (c *Client) doStream(ctx context.Context, method, path string, query url.Values, header http.Header, body any, onMessage func(json.RawMessage) error) error {
+	resp, err := c.send(ctx, method, path, query, header, body)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxStreamLine)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		var failure struct {
+			Error string `json:"error"`
+		}
+		if json.Unmarshal(line, &failure) == nil && failure.Error != "" {
+			return fmt.Errorf("stream reported an error: %s", failure.Error)
+		}
+		if onMessage != nil {
+			if err := onMessage(json.RawMessage(bytes.Clone(line))); err != nil {
+				return err
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read stream: %w", err)
+	}
+	return nil
+}
+
+func setString(set func(key, value string), key, value string) {
+	if value != "" {
+		set(key, value)
+	}
+}
+
+func setValue[T any](set func(key, value string), key string, value *T) {
+	if value != nil {
+		set(key, fmt.Sprint(*value))
+	}
+}
+
+func addValues[T any](add func(key, value string), key string, values []T) {
+	for _, value := range values {
+		add(key, fmt.Sprint(value))
+	}
+}
@@ -0,0 +1,141 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestNew_RejectsInvalidServerURL(t *testing.T) {
+	t.Parallel()
+
+	for _, serverURL := range []string{"", "arcane.example.com", "ftp://arcane.example.com"} {
+		if _, err := New(serverURL); err == nil {
+			t.Fatalf("New(%q) expected error", serverURL)
+		}
+	}
+}
+
+func TestClient_DoJSONSendsAPIKey(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/base/api/version" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if got := r.Header.Get("X-API-Key"); got != "arc_test_key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if got := r.Header.Get("Authorization"); got != "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(`{"currentVersion":"v1.2.3"}`))
+	}))
+	defer srv.Close()
+
+	c, err := New(srv.URL+"/base/", WithBearerToken("header.payload.sig"), WithAPIKey("arc_test_key"))
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+
+	var out struct {
+		CurrentVersion string `json:"currentVersion"`
+	}
+	if err := c.doJSON(context.Background(), http.MethodGet, "/api/version", nil, nil, nil, &out); err != nil {
+		t.Fatalf("doJSON() error: %v", err)
+	}
+	if out.CurrentVersion != "v1.2.3" {
+		t.Fatalf("unexpected version: %q", out.CurrentVersion)
+	}
+}
+
+func TestClient_ReturnsProblemDetails(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{"status":422,"title":"Unprocessable Entity","detail":"validation failed","errors":[{"message":"expected string","location":"body.name"}]}`))
+	}))
+	defer srv.Close()
+
+	c, err := New(srv.URL)
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+
+	err = c.doJSON(context.Background(), http.MethodPost, "/api/projects", nil, nil, map[string]int{"name": 1}, nil)
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *Error, got %v", err)
+	}
+	if apiErr.StatusCode != http.StatusUnprocessableEntity || apiErr.Detail != "validation failed" {
+		t.Fatalf("unexpected error: %+v", apiErr)
+	}
+	if !strings.Contains(apiErr.Error(), "body.name: expected string") {
+		t.Fatalf("error message missing detail: %q", apiErr.Error())
+	}
+}
+
+func TestClient_ReturnsPlainTextErrors(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "upstream unavailable", http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	c, err := New(srv.URL)
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+
+	_, err = c.doRaw(context.Background(), http.MethodGet, "/api/version", nil, nil, nil)
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *Error, got %v", err)
+	}
+	if apiErr.StatusCode != http.StatusBadGateway || apiErr.Detail != "upstream unavailable" {
+		t.Fatalf("unexpected error: %+v", apiErr)
+	}
+}
+
+func TestClient_DoStreamStopsOnErrorLine(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-json-stream")
+		_, _ = w.Write([]byte("{\"status\":\"Pulling\"}\n\n{\"status\":\"Extracting\"}\n{\"error\":\"no space left on device\"}\n{\"status\":\"Done\"}\n"))
+	}))
+	defer srv.Close()
+
+	c, err := New(srv.URL)
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+
+	var statuses []string
+	err = c.doStream(context.Background(), http.MethodPost, "/api/images/pull", nil, nil, nil, func(msg json.RawMessage) error {
+		var progress struct {
+			Status string `json:"status"`
+		}
+		if err := json.Unmarshal(msg, &progress); err != nil {
+			return err
+		}
+		statuses = append(statuses, progress.Status)
+		return nil
+	})
+	if err == nil || !strings.Contains(err.Error(), "no space left on device") {
+		t.Fatalf("expected stream error, got %v", err)
+	}
+	if strings.Join(statuses, ",") != "Pulling,Extracting" {
+		t.Fatalf("unexpected messages: %v", statuses)
+	}
+}
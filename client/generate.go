@@ -0,0 +1,3 @@
+package client
+
+//go:generate go run ./internal/clientgen -spec openapi.json -out zz_generated.go -stream deploy-project,pull-project-images,build-project-images,pull-image,build-image -raw download-container-logs,download-volume-file,download-volume-backup
@@ -0,0 +1,5 @@
+module github.com/getarcaneapp/arcane/client
+
+go 1.26.0
+
+require github.com/gorilla/websocket v1.5.4-0.20250319132907-e064f32e3674
@@ -0,0 +1,776 @@
+// Command clientgen generates the operation methods and types of the client
+// package from the OpenAPI specification exported by `arcane openapi`.
+//
+//	go run ./internal/clientgen -spec openapi.json -out zz_generated.go
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+func main() {
+	specPath := flag.String("spec", "openapi.json", "OpenAPI specification in JSON format")
+	outPath := flag.String("out", "zz_generated.go", "Output file")
+	pkg := flag.String("package", "client", "Package name of the generated file")
+	streams := flag.String("stream", "", "Comma separated operation IDs that respond with JSON lines")
+	raw := flag.String("raw", "", "Comma separated operation IDs whose response body is returned unread")
+	flag.Parse()
+
+	data, err := os.ReadFile(*specPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to read spec: %v\n", err)
+		os.Exit(1)
+	}
+
+	src, err := generate(data, options{
+		Package: *pkg,
+		Streams: splitList(*streams),
+		Raw:     splitList(*raw),
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to generate client: %v\n", err)
+		os.Exit(1)
+	}
+
+	if err := os.WriteFile(*outPath, src, 0o644); err != nil { //nolint:gosec // generated source is not sensitive
+		fmt.Fprintf(os.Stderr, "failed to write %s: %v\n", *outPath, err)
+		os.Exit(1)
+	}
+}
+
+func splitList(value string) []string {
+	var out []string
+	for part := range strings.SplitSeq(value, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			out = append(out, part)
+		}
+	}
+	return out
+}
+
+type options struct {
+	Package string
+	Streams []string
+	Raw     []string
+}
+
+// --- OpenAPI model (the subset the generator needs) ---
+
+type spec struct {
+	Servers    []struct{ URL string } `json:"servers"`
+	Paths      map[string]pathItem    `json:"paths"`
+	Components struct {
+		Schemas map[string]*schema `json:"schemas"`
+	} `json:"components"`
+}
+
+type pathItem map[string]json.RawMessage
+
+type operation struct {
+	OperationID string       `json:"operationId"`
+	Summary     string       `json:"summary"`
+	Description string       `json:"description"`
+	Deprecated  bool         `json:"deprecated"`
+	Parameters  []*parameter `json:"parameters"`
+	RequestBody *struct {
+		Required bool                 `json:"required"`
+		Content  map[string]mediaType `json:"content"`
+	} `json:"requestBody"`
+	Responses map[string]struct {
+		Content map[string]mediaType `json:"content"`
+	} `json:"responses"`
+}
+
+type parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description"`
+	Required    bool    `json:"required"`
+	Schema      *schema `json:"schema"`
+}
+
+type mediaType struct {
+	Schema *schema `json:"schema"`
+}
+
+type schema struct {
+	Ref                  string             `json:"$ref"`
+	Type                 typeList           `json:"type"`
+	Format               string             `json:"format"`
+	Description          string             `json:"description"`
+	Nullable             bool               `json:"nullable"`
+	Properties           map[string]*schema `json:"properties"`
+	Required             []string           `json:"required"`
+	Items                *schema            `json:"items"`
+	AdditionalProperties json.RawMessage    `json:"additionalProperties"`
+	AllOf                []*schema          `json:"allOf"`
+	AnyOf                []*schema          `json:"anyOf"`
+	OneOf                []*schema          `json:"oneOf"`
+}
+
+// typeList accepts both the 3.0 form "type": "string" and the 3.1 form
+// "type": ["string", "null"].
+type typeList []string
+
+func (t *typeList) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*t = typeList{single}
+		return nil
+	}
+	var multi []string
+	if err := json.Unmarshal(data, &multi); err != nil {
+		return err
+	}
+	*t = multi
+	return nil
+}
+
+// primary returns the schema type ignoring "null", and whether null is allowed.
+func (s *schema) primary() (string, bool) {
+	nullable := s.Nullable
+	kind := ""
+	for _, t := range s.Type {
+		if t == "null" {
+			nullable = true
+			continue
+		}
+		if kind == "" {
+			kind = t
+		}
+	}
+	return kind, nullable
+}
+
+// --- Generation ---
+
+var methodOrder = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+// reservedMethods are hand-written Client methods that generated operations must not shadow.
+var reservedMethods = map[string]bool{
+	"StreamProjectLogs":   true,
+	"StreamContainerLogs": true,
+	"StreamEvents":        true,
+}
+
+// reservedTypes are hand-written types that generated code uses in place of
+// the schemas of the same name.
+var reservedTypes = map[string]bool{
+	"ErrorDetail": true,
+}
+
+type generator struct {
+	spec     *spec
+	opts     options
+	basePath string
+	types    map[string]string // Go type name -> declaration
+	names    map[string]bool   // every declared type name
+	imports  map[string]bool
+}
+
+func generate(data []byte, opts options) ([]byte, error) {
+	var s spec
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("invalid spec: %w", err)
+	}
+
+	g := &generator{
+		spec:    &s,
+		opts:    opts,
+		types:   map[string]string{},
+		names:   map[string]bool{},
+		imports: map[string]bool{},
+	}
+	if len(s.Servers) > 0 && strings.HasPrefix(s.Servers[0].URL, "/") {
+		g.basePath = strings.TrimRight(s.Servers[0].URL, "/")
+	}
+
+	schemaNames := make([]string, 0, len(s.Components.Schemas))
+	for name := range s.Components.Schemas {
+		schemaNames = append(schemaNames, name)
+		g.names[typeName(name)] = true
+	}
+	sort.Strings(schemaNames)
+	for _, name := range schemaNames {
+		if reservedTypes[typeName(name)] {
+			continue
+		}
+		g.declareSchema(typeName(name), s.Components.Schemas[name])
+	}
+
+	var methods strings.Builder
+	paths := make([]string, 0, len(s.Paths))
+	for p := range s.Paths {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	seen := map[string]bool{}
+	for _, p := range paths {
+		item := s.Paths[p]
+		for _, method := range methodOrder {
+			rawOp, ok := item[method]
+			if !ok {
+				continue
+			}
+			var op operation
+			if err := json.Unmarshal(rawOp, &op); err != nil {
+				return nil, fmt.Errorf("invalid operation %s %s: %w", strings.ToUpper(method), p, err)
+			}
+			if op.OperationID == "" {
+				continue
+			}
+			name := exportName(op.OperationID, false)
+			if reservedMethods[name] || seen[name] {
+				name += "Operation"
+			}
+			seen[name] = true
+			if err := g.writeOperation(&methods, name, strings.ToUpper(method), p, &op); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	var out strings.Builder
+	out.WriteString("// Code generated by clientgen from the Arcane OpenAPI specification. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&out, "package %s\n\n", g.opts.Package)
+	if len(g.imports) > 0 {
+		imports := make([]string, 0, len(g.imports))
+		for imp := range g.imports {
+			imports = append(imports, imp)
+		}
+		sort.Strings(imports)
+		out.WriteString("import (\n")
+		for _, imp := range imports {
+			fmt.Fprintf(&out, "\t%q\n", imp)
+		}
+		out.WriteString(")\n\n")
+	}
+
+	typeNames := make([]string, 0, len(g.types))
+	for name := range g.types {
+		typeNames = append(typeNames, name)
+	}
+	sort.Strings(typeNames)
+	for _, name := range typeNames {
+		out.WriteString(g.types[name])
+		out.WriteString("\n")
+	}
+	out.WriteString(methods.String())
+
+	src, err := format.Source([]byte(out.String()))
+	if err != nil {
+		return nil, fmt.Errorf("generated code does not compile: %w", err)
+	}
+	return src, nil
+}
+
+// declareSchema adds a named type for a component or inline object schema.
+func (g *generator) declareSchema(name string, s *schema) {
+	var b strings.Builder
+	writeComment(&b, "", name, s.Description)
+
+	if isStruct(s) {
+		g.types[name] = "" // reserve the name before recursing into properties
+		fmt.Fprintf(&b, "type %s struct {\n", name)
+		g.writeFields(&b, name, s)
+		b.WriteString("}\n")
+	} else {
+		fmt.Fprintf(&b, "type %s = %s\n", name, g.goType(name+"Item", s, true))
+	}
+	g.types[name] = b.String()
+}
+
+func (g *generator) writeFields(b *strings.Builder, parent string, s *schema) {
+	props := make([]string, 0, len(s.Properties))
+	for prop := range s.Properties {
+		props = append(props, prop)
+	}
+	sort.Strings(props)
+
+	used := map[string]bool{}
+	for _, prop := range props {
+		field := exportName(prop, true)
+		for used[field] {
+			field += "_"
+		}
+		used[field] = true
+
+		propSchema := s.Properties[prop]
+		required := slices.Contains(s.Required, prop)
+		goType := g.goType(parent+field, propSchema, required)
+		if goType == parent {
+			goType = "*" + parent // a struct cannot contain itself
+		}
+		tag := prop
+		if !required {
+			tag += ",omitempty"
+		}
+		writeComment(b, "\t", "", propSchema.Description)
+		fmt.Fprintf(b, "\t%s %s `json:%q`\n", field, goType, tag)
+	}
+}
+
+func isStruct(s *schema) bool {
+	kind, _ := s.primary()
+	return len(s.Properties) > 0 && (kind == "object" || kind == "")
+}
+
+// resolve follows a single $ref or single-element allOf to the schema it names.
+func (g *generator) resolve(s *schema) *schema {
+	if s == nil {
+		return &schema{}
+	}
+	if len(s.AllOf) == 1 && s.Ref == "" {
+		return g.resolve(s.AllOf[0])
+	}
+	if s.Ref != "" {
+		if target, ok := g.spec.Components.Schemas[refName(s.Ref)]; ok {
+			return target
+		}
+	}
+	return s
+}
+
+// goType returns the Go type of a schema. Optional and nullable values other
+// than slices, maps and interfaces are pointers so they can be omitted.
+func (g *generator) goType(hint string, s *schema, required bool) string {
+	if s == nil {
+		return "any"
+	}
+	if len(s.AllOf) == 1 && s.Ref == "" {
+		return g.goType(hint, s.AllOf[0], required)
+	}
+	if s.Ref != "" {
+		name := typeName(refName(s.Ref))
+		if !g.names[name] {
+			return "any"
+		}
+		target := g.resolve(s)
+		if kind, _ := target.primary(); required || kind == "array" || (kind == "object" && !isStruct(target)) {
+			return name
+		}
+		return "*" + name
+	}
+
+	kind, nullable := s.primary()
+	if isStruct(s) {
+		kind = "object"
+	}
+	optional := !required || nullable
+	pointer := func(t string) string {
+		if optional {
+			return "*" + t
+		}
+		return t
+	}
+
+	switch kind {
+	case "string":
+		if s.Format == "date-time" {
+			g.imports["time"] = true
+			return pointer("time.Time")
+		}
+		return pointer("string")
+	case "integer":
+		switch s.Format {
+		case "int64":
+			return pointer("int64")
+		case "int32":
+			return pointer("int32")
+		}
+		return pointer("int")
+	case "number":
+		return pointer("float64")
+	case "boolean":
+		return pointer("bool")
+	case "array":
+		return "[]" + g.goType(hint+"Item", s.Items, true)
+	case "object":
+		if len(s.Properties) > 0 {
+			name := hint
+			for g.names[name] {
+				name += "_"
+			}
+			g.names[name] = true
+			g.declareSchema(name, s)
+			return pointer(name)
+		}
+		if len(s.AdditionalProperties) > 0 && string(s.AdditionalProperties) != "true" && string(s.AdditionalProperties) != "false" {
+			var value schema
+			if err := json.Unmarshal(s.AdditionalProperties, &value); err == nil {
+				return "map[string]" + g.goType(hint+"Value", &value, true)
+			}
+		}
+		return "map[string]any"
+	}
+	return "any"
+}
+
+// paramType is the Go type of a query or header parameter field.
+func paramType(s *schema) string {
+	if s == nil {
+		return "string"
+	}
+	kind, _ := s.primary()
+	switch kind {
+	case "integer":
+		if s.Format == "int64" {
+			return "*int64"
+		}
+		return "*int"
+	case "number":
+		return "*float64"
+	case "boolean":
+		return "*bool"
+	case "array":
+		return "[]" + strings.TrimPrefix(paramType(s.Items), "*")
+	}
+	return "string"
+}
+
+func (g *generator) writeOperation(b *strings.Builder, name, method, path string, op *operation) error {
+	fullPath := g.basePath + path
+
+	var pathParams, optionalParams []*parameter
+	for _, param := range op.Parameters {
+		switch param.In {
+		case "path":
+			pathParams = append(pathParams, param)
+		case "query", "header":
+			optionalParams = append(optionalParams, param)
+		}
+	}
+	// Path parameters are passed in the order they appear in the path.
+	sort.SliceStable(pathParams, func(i, j int) bool {
+		return strings.Index(path, "{"+pathParams[i].Name+"}") < strings.Index(path, "{"+pathParams[j].Name+"}")
+	})
+
+	args := []string{"ctx context.Context"}
+	argNames := map[string]string{}
+	for _, param := range pathParams {
+		arg := argName(param.Name)
+		argNames[param.Name] = arg
+		args = append(args, arg+" string")
+	}
+
+	paramsType := ""
+	if len(optionalParams) > 0 {
+		paramsType = name + "Params"
+		for g.names[paramsType] {
+			paramsType += "_"
+		}
+		g.names[paramsType] = true
+		g.declareParams(paramsType, name, optionalParams)
+		args = append(args, "params *"+paramsType)
+	}
+
+	bodyExpr := "nil"
+	var bodySetup string
+	if op.RequestBody != nil {
+		if media, ok := op.RequestBody.Content["application/json"]; ok {
+			bodyType := g.goType(name+"Request", media.Schema, op.RequestBody.Required)
+			args = append(args, "body "+bodyType)
+			bodyExpr = "body"
+			if strings.HasPrefix(bodyType, "*") {
+				bodySetup = "\tvar reqBody any\n\tif body != nil {\n\t\treqBody = body\n\t}\n"
+				bodyExpr = "reqBody"
+			}
+		} else if len(op.RequestBody.Content) > 0 {
+			g.imports["io"] = true
+			args = append(args, "body io.Reader", "contentType string")
+			bodyExpr = "body"
+			bodySetup = "\theader.Set(\"Content-Type\", contentType)\n"
+		}
+	}
+
+	isStream := slices.Contains(g.opts.Streams, op.OperationID)
+	isRaw := slices.Contains(g.opts.Raw, op.OperationID)
+	responseType := ""
+	if !isStream && !isRaw {
+		responseType, isRaw = g.responseType(name, op)
+	}
+
+	results := "error"
+	switch {
+	case isStream:
+		g.imports["encoding/json"] = true
+		args = append(args, "onMessage func(json.RawMessage) error")
+	case isRaw:
+		g.imports["io"] = true
+		results = "(io.ReadCloser, error)"
+	case responseType != "":
+		results = "(*" + responseType + ", error)"
+	}
+
+	summary := strings.TrimSuffix(strings.TrimSpace(op.Summary), ".")
+	if summary == "" {
+		summary = strings.TrimSpace(op.Description)
+	}
+	if summary != "" {
+		fmt.Fprintf(b, "// %s calls %s %s: %s.\n", name, method, fullPath, lowerFirst(summary))
+	} else {
+		fmt.Fprintf(b, "// %s calls %s %s.\n", name, method, fullPath)
+	}
+	if isStream {
+		b.WriteString("// Progress messages are passed to onMessage as they arrive.\n")
+	}
+	if isRaw {
+		b.WriteString("// The caller must close the returned body.\n")
+	}
+	if op.Deprecated {
+		b.WriteString("//\n// Deprecated: the endpoint is deprecated.\n")
+	}
+	fmt.Fprintf(b, "func (c *Client) %s(%s) %s {\n", name, strings.Join(args, ", "), results)
+
+	// Build the request path.
+	g.imports["context"] = true
+	g.imports["net/url"] = true
+	g.imports["net/http"] = true
+	var pathExpr strings.Builder
+	rest := fullPath
+	pathExpr.WriteString("\"")
+	for {
+		start := strings.Index(rest, "{")
+		end := strings.Index(rest, "}")
+		if start < 0 || end < start {
+			pathExpr.WriteString(rest)
+			break
+		}
+		arg, ok := argNames[rest[start+1:end]]
+		if !ok {
+			return fmt.Errorf("operation %s: path parameter %q is not declared", op.OperationID, rest[start+1:end])
+		}
+		pathExpr.WriteString(rest[:start])
+		fmt.Fprintf(&pathExpr, "\" + url.PathEscape(%s) + \"", arg)
+		rest = rest[end+1:]
+	}
+	pathExpr.WriteString("\"")
+	fmt.Fprintf(b, "\tpath := %s\n", strings.TrimSuffix(pathExpr.String(), " + \"\""))
+
+	b.WriteString("\tquery := url.Values{}\n\theader := http.Header{}\n")
+	if paramsType != "" {
+		b.WriteString("\tif params != nil {\n\t\tparams.apply(query, header)\n\t}\n")
+	}
+	b.WriteString(bodySetup)
+
+	call := fmt.Sprintf("http.Method%s, path, query, header, %s", methodConst(method), bodyExpr)
+	switch {
+	case isStream:
+		fmt.Fprintf(b, "\treturn c.doStream(ctx, %s, onMessage)\n", call)
+	case isRaw:
+		fmt.Fprintf(b, "\treturn c.doRaw(ctx, %s)\n", call)
+	case responseType != "":
+		fmt.Fprintf(b, "\tvar out %s\n\tif err := c.doJSON(ctx, %s, &out); err != nil {\n\t\treturn nil, err\n\t}\n\treturn &out, nil\n", responseType, call)
+	default:
+		fmt.Fprintf(b, "\treturn c.doJSON(ctx, %s, nil)\n", call)
+	}
+	b.WriteString("}\n\n")
+	return nil
+}
+
+// responseType returns the type of the first 2xx JSON response, or reports
+// that the response is not JSON and should be returned unread.
+func (g *generator) responseType(name string, op *operation) (string, bool) {
+	codes := make([]string, 0, len(op.Responses))
+	for code := range op.Responses {
+		if strings.HasPrefix(code, "2") {
+			codes = append(codes, code)
+		}
+	}
+	sort.Strings(codes)
+	for _, code := range codes {
+		content := op.Responses[code].Content
+		if len(content) == 0 {
+			continue
+		}
+		for contentType, media := range content {
+			if strings.Contains(contentType, "json") {
+				return strings.TrimPrefix(g.goType(name+"Response", media.Schema, true), "*"), false
+			}
+		}
+		return "", true
+	}
+	return "", false
+}
+
+func (g *generator) declareParams(typeName, opName string, params []*parameter) {
+	sort.SliceStable(params, func(i, j int) bool { return params[i].Name < params[j].Name })
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "// %s holds the query and header parameters of %s.\n", typeName, opName)
+	fmt.Fprintf(&b, "type %s struct {\n", typeName)
+	var apply strings.Builder
+	fmt.Fprintf(&apply, "func (p *%s) apply(query url.Values, header http.Header) {\n", typeName)
+
+	used := map[string]bool{}
+	for _, param := range params {
+		field := exportName(param.Name, true)
+		for used[field] {
+			field += "_"
+		}
+		used[field] = true
+
+		fieldType := paramType(param.Schema)
+		desc := param.Description
+		if desc == "" && param.Schema != nil {
+			desc = param.Schema.Description
+		}
+		writeComment(&b, "\t", "", desc)
+		fmt.Fprintf(&b, "\t%s %s\n", field, fieldType)
+
+		target := "query"
+		if param.In == "header" {
+			target = "header"
+		}
+		switch {
+		case strings.HasPrefix(fieldType, "[]"):
+			fmt.Fprintf(&apply, "\taddValues(%s.Add, %q, p.%s)\n", target, param.Name, field)
+		case strings.HasPrefix(fieldType, "*"):
+			fmt.Fprintf(&apply, "\tsetValue(%s.Set, %q, p.%s)\n", target, param.Name, field)
+		default:
+			fmt.Fprintf(&apply, "\tsetString(%s.Set, %q, p.%s)\n", target, param.Name, field)
+		}
+	}
+	b.WriteString("}\n\n")
+	apply.WriteString("}\n")
+	b.WriteString(apply.String())
+	g.types[typeName] = b.String()
+}
+
+// --- Naming helpers ---
+
+var initialisms = map[string]string{
+	"api": "API", "cpu": "CPU", "dns": "DNS", "gpu": "GPU", "html": "HTML", "http": "HTTP",
+	"https": "HTTPS", "id": "ID", "ip": "IP", "json": "JSON", "jwt": "JWT", "oidc": "OIDC",
+	"sql": "SQL", "ssh": "SSH", "tls": "TLS", "ttl": "TTL", "ui": "UI", "uri": "URI",
+	"url": "URL", "uuid": "UUID",
+}
+
+var keywords = map[string]bool{
+	"break": true, "case": true, "chan": true, "const": true, "continue": true, "default": true,
+	"defer": true, "else": true, "fallthrough": true, "for": true, "func": true, "go": true,
+	"goto": true, "if": true, "import": true, "interface": true, "map": true, "package": true,
+	"range": true, "return": true, "select": true, "struct": true, "switch": true, "type": true,
+	"var": true,
+	// Names used by the generated method bodies.
+	"ctx": true, "params": true, "body": true, "contentType": true, "c": true, "path": true,
+	"query": true, "header": true, "out": true, "reqBody": true, "onMessage": true, "url": true,
+	"http": true, "json": true, "io": true, "context": true,
+}
+
+// words splits an identifier such as "projectId" or "list-projects" into words.
+func words(s string) []string {
+	var out []string
+	var cur []rune
+	flush := func() {
+		if len(cur) > 0 {
+			out = append(out, string(cur))
+			cur = nil
+		}
+	}
+	runes := []rune(s)
+	for i, r := range runes {
+		switch {
+		case !unicode.IsLetter(r) && !unicode.IsDigit(r):
+			flush()
+		case unicode.IsUpper(r) && len(cur) > 0 && (unicode.IsLower(cur[len(cur)-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))):
+			flush()
+			cur = append(cur, r)
+		default:
+			cur = append(cur, r)
+		}
+	}
+	flush()
+	return out
+}
+
+// exportName converts s to an exported Go identifier, optionally spelling
+// initialisms such as ID and URL in upper case.
+func exportName(s string, useInitialisms bool) string {
+	var b strings.Builder
+	for _, word := range words(s) {
+		if useInitialisms {
+			if upper, ok := initialisms[strings.ToLower(word)]; ok {
+				b.WriteString(upper)
+				continue
+			}
+		}
+		r := []rune(word)
+		r[0] = unicode.ToUpper(r[0])
+		b.WriteString(string(r))
+	}
+	name := b.String()
+	if name == "" {
+		return "Field"
+	}
+	if unicode.IsDigit([]rune(name)[0]) {
+		name = "X" + name
+	}
+	return name
+}
+
+// typeName converts a component schema name to a Go type name.
+func typeName(s string) string {
+	return exportName(s, false)
+}
+
+func argName(s string) string {
+	name := exportName(s, true)
+	ws := words(name)
+	if len(ws) > 0 && initialisms[strings.ToLower(ws[0])] == ws[0] {
+		name = strings.ToLower(ws[0]) + name[len(ws[0]):]
+	} else {
+		name = lowerFirst(name)
+	}
+	if keywords[name] {
+		name += "Param"
+	}
+	return name
+}
+
+func refName(ref string) string {
+	return ref[strings.LastIndex(ref, "/")+1:]
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	r := []rune(s)
+	if len(r) > 1 && unicode.IsUpper(r[1]) {
+		return s // keep acronyms such as "API"
+	}
+	r[0] = unicode.ToLower(r[0])
+	return string(r)
+}
+
+func methodConst(method string) string {
+	return string(method[0]) + strings.ToLower(method[1:])
+}
+
+// writeComment writes a doc comment. Type comments start with the type name
+// as Go doc comments do, followed by the schema description.
+func writeComment(b *strings.Builder, indent, name, text string) {
+	text = strings.TrimSpace(text)
+	if name != "" {
+		fmt.Fprintf(b, "%s// %s is generated from the OpenAPI specification.\n", indent, name)
+		if text == "" {
+			return
+		}
+		fmt.Fprintf(b, "%s//\n", indent)
+	}
+	if text == "" {
+		return
+	}
+	for line := range strings.SplitSeq(text, "\n") {
+		fmt.Fprintf(b, "%s// %s\n", indent, strings.TrimRight(line, " \t"))
+	}
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	data, err := os.ReadFile("testdata/openapi.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	src, err := generate(data, options{
+		Package: "client",
+		Streams: []string{"deploy-project"},
+		Raw:     []string{"download-container-logs"},
+	})
+	if err != nil {
+		t.Fatalf("generate() error: %v", err)
+	}
+	// Compare without gofmt's column alignment.
+	collapse := func(s string) string { return strings.Join(strings.Fields(s), " ") }
+	out := collapse(string(src))
+
+	for _, want := range []string{
+		"func (c *Client) ListProjects(ctx context.Context, id string, params *ListProjectsParams) (*PaginatedProjectDetails, error)",
+		`path := "/api/environments/" + url.PathEscape(id) + "/projects"`,
+		"func (c *Client) GetProject(ctx context.Context, id string, projectID string) (*ApiResponseProjectDetails, error)",
+		`path := "/api/environments/" + url.PathEscape(id) + "/projects/" + url.PathEscape(projectID)` + "\n",
+		"func (c *Client) DestroyProject(ctx context.Context, id string, projectID string, params *DestroyProjectParams) error",
+		"// Deprecated: the endpoint is deprecated.",
+		"func (c *Client) DeployProject(ctx context.Context, id string, projectID string, body *DeployOptions, onMessage func(json.RawMessage) error) error",
+		"func (c *Client) DownloadContainerLogs(ctx context.Context, id string, containerID string) (io.ReadCloser, error)",
+		"func (c *Client) ImportTemplate(ctx context.Context, body io.Reader, contentType string) (*ImportTemplateResponse, error)",
+		"func (c *Client) StreamEventsOperation(ctx context.Context) error",
+		"Limit *int64",
+		"Tags []string",
+		`setValue(query.Set, "limit", p.Limit)`,
+		`addValues(query.Add, "tags", p.Tags)`,
+		`setString(header.Set, "X-Request-Id", p.XRequestID)`,
+		"type ProjectStatus = string",
+		"type TagList = []string",
+		"Data []ProjectDetails `json:\"data\"`",
+		"Pagination *PaginatedProjectDetailsPagination `json:\"pagination,omitempty\"`",
+		"Labels map[string]string `json:\"labels,omitempty\"`",
+		"Parent *ProjectDetails `json:\"parent,omitempty\"`",
+		"Status *ProjectStatus `json:\"status,omitempty\"`",
+		"Tags TagList `json:\"tags,omitempty\"`",
+		"UpdatedAt *time.Time `json:\"updatedAt,omitempty\"`",
+		"// Unique identifier ID string `json:\"id\"`",
+		"Type *string",
+		"// ProjectDetails is generated from the OpenAPI specification.\n//\n// A Docker Compose project.",
+	} {
+		if !strings.Contains(out, collapse(want)) {
+			t.Errorf("generated code is missing %q", want)
+		}
+	}
+	if t.Failed() {
+		t.Log(string(src))
+	}
+}
+
+func TestExportName(t *testing.T) {
+	tests := map[string]string{
+		"list-projects": "ListProjects",
+		"projectId":     "ProjectID",
+		"$schema":       "Schema",
+		"tlsCACert":     "TLSCACert",
+		"HTTPServer":    "HTTPServer",
+		"2fa":           "X2fa",
+	}
+	for in, want := range tests {
+		if got := exportName(in, true); got != want {
+			t.Errorf("exportName(%q) = %q, want %q", in, got, want)
+		}
+	}
+	if got := argName("type"); got != "typeParam" {
+		t.Errorf("argName(\"type\") = %q", got)
+	}
+	if got := argName("id"); got != "id" {
+		t.Errorf("argName(\"id\") = %q", got)
+	}
+}
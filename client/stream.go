@@ -0,0 +1,159 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const defaultLogIdleTimeout = 3 * time.Second
+
+// LogOptions selects the log lines a log stream returns.
+type LogOptions struct {
+	// Follow keeps the stream open for new lines until ctx is done.
+	Follow bool
+	// Tail is the number of lines to return from the end of the logs, or
+	// "all". The server default is 100.
+	Tail string
+	// Since returns lines after a timestamp or relative duration, like "10m".
+	Since string
+	// Timestamps prefixes each line with its timestamp.
+	Timestamps bool
+	// IdleTimeout ends a stream that does not follow once no line has arrived
+	// for this long, because the server keeps the connection open. The
+	// default is 3 seconds.
+	IdleTimeout time.Duration
+}
+
+func (o LogOptions) query() url.Values {
+	query := url.Values{}
+	query.Set("follow", strconv.FormatBool(o.Follow))
+	query.Set("timestamps", strconv.FormatBool(o.Timestamps))
+	setString(query.Set, "tail", o.Tail)
+	setString(query.Set, "since", o.Since)
+	return query
+}
+
+func (o LogOptions) idleTimeout() time.Duration {
+	if o.Follow {
+		return 0
+	}
+	if o.IdleTimeout > 0 {
+		return o.IdleTimeout
+	}
+	return defaultLogIdleTimeout
+}
+
+// StreamProjectLogs passes the log lines of a project's services to onLine.
+// It returns when the stream ends, ctx is done or onLine returns an error.
+func (c *Client) StreamProjectLogs(ctx context.Context, envID, projectID string, opts LogOptions, onLine func(line string) error) error {
+	path := "/api/environments/" + url.PathEscape(envID) + "/ws/projects/" + url.PathEscape(projectID) + "/logs"
+	return c.streamWebSocket(ctx, path, opts.query(), opts.idleTimeout(), func(msg []byte) error {
+		return onLine(string(msg))
+	})
+}
+
+// StreamContainerLogs passes the log lines of a container to onLine. It
+// returns when the stream ends, ctx is done or onLine returns an error.
+func (c *Client) StreamContainerLogs(ctx context.Context, envID, containerID string, opts LogOptions, onLine func(line string) error) error {
+	path := "/api/environments/" + url.PathEscape(envID) + "/ws/containers/" + url.PathEscape(containerID) + "/logs"
+	return c.streamWebSocket(ctx, path, opts.query(), opts.idleTimeout(), func(msg []byte) error {
+		return onLine(string(msg))
+	})
+}
+
+// EventFilter limits the events StreamEvents receives. Empty fields match
+// every event.
+type EventFilter struct {
+	Types         []string
+	Severities    []string
+	EnvironmentID string
+}
+
+// StreamEvents passes each new event, as JSON, to onEvent until ctx is done
+// or onEvent returns an error.
+func (c *Client) StreamEvents(ctx context.Context, filter EventFilter, onEvent func(json.RawMessage) error) error {
+	query := url.Values{}
+	addValues(query.Add, "type", filter.Types)
+	addValues(query.Add, "severity", filter.Severities)
+	setString(query.Set, "environmentId", filter.EnvironmentID)
+	return c.streamWebSocket(ctx, "/api/events/ws", query, 0, func(msg []byte) error {
+		return onEvent(json.RawMessage(msg))
+	})
+}
+
+// streamWebSocket reads messages from a WebSocket endpoint until the server
+// closes the connection, ctx is done or onMessage fails. A positive
+// idleTimeout also ends the stream once no message arrives for that long.
+func (c *Client) streamWebSocket(ctx context.Context, path string, query url.Values, idleTimeout time.Duration, onMessage func([]byte) error) error {
+	conn, err := c.dialWebSocket(ctx, path, query)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	// Closing the connection unblocks the read below once ctx is done.
+	stop := context.AfterFunc(ctx, func() { _ = conn.Close() })
+	defer stop()
+
+	for {
+		if idleTimeout > 0 {
+			_ = conn.SetReadDeadline(time.Now().Add(idleTimeout))
+		}
+		_, msg, err := conn.ReadMessage()
+		if err != nil {
+			var netErr net.Error
+			switch {
+			case ctx.Err() != nil:
+				return ctx.Err()
+			case websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway):
+				return nil
+			case idleTimeout > 0 && errors.As(err, &netErr) && netErr.Timeout():
+				return nil
+			}
+			return fmt.Errorf("stream ended: %w", err)
+		}
+		if err := onMessage(msg); err != nil {
+			return err
+		}
+	}
+}
+
+func (c *Client) dialWebSocket(ctx context.Context, path string, query url.Values) (*websocket.Conn, error) {
+	target := c.baseURL.JoinPath(path)
+	target.RawQuery = query.Encode()
+	switch target.Scheme {
+	case "https":
+		target.Scheme = "wss"
+	case "http":
+		target.Scheme = "ws"
+	}
+
+	header := http.Header{}
+	for key, values := range c.header {
+		header[key] = values
+	}
+
+	conn, resp, err := websocket.DefaultDialer.DialContext(ctx, target.String(), header)
+	if err != nil {
+		if resp != nil {
+			defer func() { _ = resp.Body.Close() }()
+			return nil, readError(resp)
+		}
+		return nil, fmt.Errorf("websocket connection failed: %w", err)
+	}
+	if resp != nil && resp.Body != nil {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}
+	return conn, nil
+}
@@ -0,0 +1,88 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestClient_StreamProjectLogsEndsWhenIdle(t *testing.T) {
+	t.Parallel()
+
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/environments/0/ws/projects/web/logs" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if got := r.Header.Get("X-API-Key"); got != "arc_test_key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if got := r.URL.Query().Get("follow"); got != "false" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if got := r.URL.Query().Get("tail"); got != "2" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+		_ = conn.WriteMessage(websocket.TextMessage, []byte("first"))
+		_ = conn.WriteMessage(websocket.TextMessage, []byte("second"))
+
+		// Like the server, keep the connection open after the last line.
+		<-r.Context().Done()
+	}))
+	defer srv.Close()
+
+	c, err := New(srv.URL, WithAPIKey("arc_test_key"))
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+
+	var lines []string
+	opts := LogOptions{Tail: "2", IdleTimeout: 200 * time.Millisecond}
+	err = c.StreamProjectLogs(context.Background(), "0", "web", opts, func(line string) error {
+		lines = append(lines, line)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("StreamProjectLogs() error: %v", err)
+	}
+	if len(lines) != 2 || lines[0] != "first" || lines[1] != "second" {
+		t.Fatalf("unexpected lines: %v", lines)
+	}
+}
+
+func TestClient_StreamReturnsHandshakeError(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"status":401,"title":"Unauthorized","detail":"invalid API key"}`))
+	}))
+	defer srv.Close()
+
+	c, err := New(srv.URL, WithAPIKey("wrong"))
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+
+	err = c.StreamEvents(context.Background(), EventFilter{Types: []string{"container.start"}}, nil)
+	var apiErr *Error
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected 401 *Error, got %v", err)
+	}
+}
@@ -0,0 +1,12733 @@
+// Code generated by clientgen from the Arcane OpenAPI specification. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// AggregatedPaginatedResponseContainerSummary is generated from the OpenAPI specification.
+type AggregatedPaginatedResponseContainerSummary struct {
+	// A URL to the JSON Schema for this object.
+	Schema       *string                                     `json:"$schema,omitempty"`
+	Data         []EnvironmentAggregatedItemContainerSummary `json:"data"`
+	Environments []EnvironmentAggregateSource                `json:"environments"`
+	Pagination   BasePaginationResponse                      `json:"pagination"`
+	Success      bool                                        `json:"success"`
+}
+
+// AggregatedPaginatedResponseImageSummary is generated from the OpenAPI specification.
+type AggregatedPaginatedResponseImageSummary struct {
+	// A URL to the JSON Schema for this object.
+	Schema       *string                                 `json:"$schema,omitempty"`
+	Data         []EnvironmentAggregatedItemImageSummary `json:"data"`
+	Environments []EnvironmentAggregateSource            `json:"environments"`
+	Pagination   BasePaginationResponse                  `json:"pagination"`
+	Success      bool                                    `json:"success"`
+}
+
+// AggregatedPaginatedResponseProjectDetails is generated from the OpenAPI specification.
+type AggregatedPaginatedResponseProjectDetails struct {
+	// A URL to the JSON Schema for this object.
+	Schema       *string                                   `json:"$schema,omitempty"`
+	Data         []EnvironmentAggregatedItemProjectDetails `json:"data"`
+	Environments []EnvironmentAggregateSource              `json:"environments"`
+	Pagination   BasePaginationResponse                    `json:"pagination"`
+	Success      bool                                      `json:"success"`
+}
+
+// ApiKeyPaginatedResponse is generated from the OpenAPI specification.
+type ApiKeyPaginatedResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema     *string                `json:"$schema,omitempty"`
+	Data       []ApikeyApiKey         `json:"data"`
+	Pagination BasePaginationResponse `json:"pagination"`
+	Success    bool                   `json:"success"`
+}
+
+// ApiResponseStructDataStruct is generated from the OpenAPI specification.
+type ApiResponseStructDataStruct = map[string]any
+
+// ApikeyApiKey is generated from the OpenAPI specification.
+type ApikeyApiKey struct {
+	// Creation timestamp
+	CreatedAt time.Time `json:"createdAt"`
+	// Description of the API key
+	Description *string `json:"description,omitempty"`
+	// Expiration date of the API key
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	// Unique identifier of the API key
+	ID string `json:"id"`
+	// Prefix of the API key for identification
+	KeyPrefix string `json:"keyPrefix"`
+	// Last time the API key was used
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	// Name of the API key
+	Name string `json:"name"`
+	// Scopes granted to the API key. Empty means full access
+	Scopes []string `json:"scopes"`
+	// Last update timestamp
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+	// ID of the user who owns the API key
+	UserID string `json:"userId"`
+}
+
+// ApikeyApiKeyCreatedDto is generated from the OpenAPI specification.
+type ApikeyApiKeyCreatedDto struct {
+	// Creation timestamp
+	CreatedAt time.Time `json:"createdAt"`
+	// Description of the API key
+	Description *string `json:"description,omitempty"`
+	// Expiration date of the API key
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	// Unique identifier of the API key
+	ID string `json:"id"`
+	// The full API key secret (only shown once)
+	Key string `json:"key"`
+	// Prefix of the API key for identification
+	KeyPrefix string `json:"keyPrefix"`
+	// Last time the API key was used
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	// Name of the API key
+	Name string `json:"name"`
+	// Scopes granted to the API key. Empty means full access
+	Scopes []string `json:"scopes"`
+	// Last update timestamp
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+	// ID of the user who owns the API key
+	UserID string `json:"userId"`
+}
+
+// ApikeyCreateApiKey is generated from the OpenAPI specification.
+type ApikeyCreateApiKey struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Optional description of the API key
+	Description *string `json:"description,omitempty"`
+	// Optional expiration date for the API key
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	// Name of the API key
+	Name string `json:"name"`
+	// Scopes granted to the API key (read, projects:deploy, environments:manage or *). Empty means full access
+	Scopes []string `json:"scopes,omitempty"`
+}
+
+// ApikeyUpdateApiKey is generated from the OpenAPI specification.
+type ApikeyUpdateApiKey struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// New description for the API key
+	Description *string `json:"description,omitempty"`
+	// New expiration date for the API key
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	// New name for the API key
+	Name *string `json:"name,omitempty"`
+	// New scopes for the API key. An empty list restores full access
+	Scopes []string `json:"scopes,omitempty"`
+}
+
+// AuthLogin is generated from the OpenAPI specification.
+type AuthLogin struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Password of the user
+	Password string `json:"password"`
+	// Username of the user
+	Username string `json:"username"`
+}
+
+// AuthLoginResponse is generated from the OpenAPI specification.
+type AuthLoginResponse struct {
+	// Expiration time of the access token
+	ExpiresAt time.Time `json:"expiresAt"`
+	// Refresh token for obtaining new access tokens
+	RefreshToken string `json:"refreshToken"`
+	// JWT access token
+	Token string `json:"token"`
+	// Authenticated user information
+	User UserUser `json:"user"`
+}
+
+// AuthOidcAuthUrlRequest is generated from the OpenAPI specification.
+type AuthOidcAuthUrlRequest struct {
+	// A URL to the JSON Schema for this object.
+	Schema      *string `json:"$schema,omitempty"`
+	RedirectURI string  `json:"redirectUri"`
+}
+
+// AuthOidcAuthUrlResponse is generated from the OpenAPI specification.
+type AuthOidcAuthUrlResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema  *string `json:"$schema,omitempty"`
+	AuthURL string  `json:"authUrl"`
+}
+
+// AuthOidcCallbackRequest is generated from the OpenAPI specification.
+type AuthOidcCallbackRequest struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	Code   string  `json:"code"`
+	State  string  `json:"state"`
+}
+
+// AuthOidcCallbackResponse is generated from the OpenAPI specification.
+type AuthOidcCallbackResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema       *string   `json:"$schema,omitempty"`
+	ExpiresAt    time.Time `json:"expiresAt"`
+	RefreshToken string    `json:"refreshToken"`
+	Success      bool      `json:"success"`
+	Token        string    `json:"token"`
+	User         UserUser  `json:"user"`
+}
+
+// AuthOidcConfigResponse is generated from the OpenAPI specification.
+type AuthOidcConfigResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema                      *string `json:"$schema,omitempty"`
+	AuthorizationEndpoint       string  `json:"authorizationEndpoint"`
+	ClientID                    string  `json:"clientId"`
+	DeviceAuthorizationEndpoint *string `json:"deviceAuthorizationEndpoint,omitempty"`
+	IssuerURL                   string  `json:"issuerUrl"`
+	RedirectURI                 string  `json:"redirectUri"`
+	Scopes                      string  `json:"scopes"`
+	TokenEndpoint               string  `json:"tokenEndpoint"`
+	UserinfoEndpoint            string  `json:"userinfoEndpoint"`
+}
+
+// AuthOidcDeviceAuthResponse is generated from the OpenAPI specification.
+type AuthOidcDeviceAuthResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema                  *string `json:"$schema,omitempty"`
+	DeviceCode              string  `json:"deviceCode"`
+	ExpiresIn               int64   `json:"expiresIn"`
+	Interval                *int64  `json:"interval,omitempty"`
+	UserCode                string  `json:"userCode"`
+	VerificationURI         string  `json:"verificationUri"`
+	VerificationURIComplete *string `json:"verificationUriComplete,omitempty"`
+}
+
+// AuthOidcDeviceTokenRequest is generated from the OpenAPI specification.
+type AuthOidcDeviceTokenRequest struct {
+	// A URL to the JSON Schema for this object.
+	Schema     *string `json:"$schema,omitempty"`
+	DeviceCode string  `json:"deviceCode"`
+}
+
+// AuthOidcDeviceTokenResponse is generated from the OpenAPI specification.
+type AuthOidcDeviceTokenResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema       *string   `json:"$schema,omitempty"`
+	ExpiresAt    time.Time `json:"expiresAt"`
+	RefreshToken string    `json:"refreshToken"`
+	Success      bool      `json:"success"`
+	Token        string    `json:"token"`
+	User         UserUser  `json:"user"`
+}
+
+// AuthOidcStatusInfo is generated from the OpenAPI specification.
+type AuthOidcStatusInfo struct {
+	// A URL to the JSON Schema for this object.
+	Schema          *string `json:"$schema,omitempty"`
+	EnvConfigured   bool    `json:"envConfigured"`
+	EnvForced       bool    `json:"envForced"`
+	MergeAccounts   bool    `json:"mergeAccounts"`
+	ProviderLogoURL *string `json:"providerLogoUrl,omitempty"`
+	ProviderName    *string `json:"providerName,omitempty"`
+}
+
+// AuthPasswordChange is generated from the OpenAPI specification.
+type AuthPasswordChange struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Current password of the user (required for non-OIDC users)
+	CurrentPassword *string `json:"currentPassword,omitempty"`
+	// New password for the user
+	NewPassword string `json:"newPassword"`
+}
+
+// AuthRefresh is generated from the OpenAPI specification.
+type AuthRefresh struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Refresh token used to obtain a new access token
+	RefreshToken string `json:"refreshToken"`
+}
+
+// AuthTokenRefreshResponse is generated from the OpenAPI specification.
+type AuthTokenRefreshResponse struct {
+	// Expiration time of the new access token
+	ExpiresAt time.Time `json:"expiresAt"`
+	// New refresh token
+	RefreshToken string `json:"refreshToken"`
+	// New JWT access token
+	Token string `json:"token"`
+}
+
+// AutoUpdateRecord is generated from the OpenAPI specification.
+type AutoUpdateRecord struct {
+	CreatedAt        time.Time      `json:"createdAt"`
+	Details          map[string]any `json:"details,omitempty"`
+	EndTime          *time.Time     `json:"endTime,omitempty"`
+	Error            *string        `json:"error,omitempty"`
+	ID               string         `json:"id"`
+	NewImageVersions map[string]any `json:"newImageVersions,omitempty"`
+	OldImageVersions map[string]any `json:"oldImageVersions,omitempty"`
+	ResourceID       string         `json:"resourceId"`
+	ResourceName     string         `json:"resourceName"`
+	ResourceType     string         `json:"resourceType"`
+	RunID            *string        `json:"runId,omitempty"`
+	StartTime        time.Time      `json:"startTime"`
+	Status           string         `json:"status"`
+	UpdateApplied    bool           `json:"updateApplied"`
+	UpdateAvailable  bool           `json:"updateAvailable"`
+	UpdatedAt        *time.Time     `json:"updatedAt,omitempty"`
+}
+
+// BackupExportRequest is generated from the OpenAPI specification.
+type BackupExportRequest struct {
+	// A URL to the JSON Schema for this object.
+	Schema     *string `json:"$schema,omitempty"`
+	Passphrase string  `json:"passphrase"`
+}
+
+// BackupHasPathParams holds the query and header parameters of BackupHasPath.
+type BackupHasPathParams struct {
+	// Path to check
+	Path string
+}
+
+func (p *BackupHasPathParams) apply(query url.Values, header http.Header) {
+	setString(query.Set, "path", p.Path)
+}
+
+// BackupHasPathResponse is generated from the OpenAPI specification.
+type BackupHasPathResponse struct {
+	Exists bool `json:"exists"`
+}
+
+// BackupImportRequest is generated from the OpenAPI specification.
+type BackupImportRequest struct {
+	// A URL to the JSON Schema for this object.
+	Schema     *string `json:"$schema,omitempty"`
+	Archive    string  `json:"archive"`
+	Passphrase string  `json:"passphrase"`
+}
+
+// BackupImportResult is generated from the OpenAPI specification.
+type BackupImportResult struct {
+	Manifest BackupManifest `json:"manifest"`
+	Warnings []string       `json:"warnings,omitempty"`
+}
+
+// BackupManifest is generated from the OpenAPI specification.
+type BackupManifest struct {
+	ArcaneVersion         string    `json:"arcaneVersion"`
+	CreatedAt             time.Time `json:"createdAt"`
+	Environments          int64     `json:"environments"`
+	NotificationProviders int64     `json:"notificationProviders"`
+	Projects              int64     `json:"projects"`
+	Registries            int64     `json:"registries"`
+	Settings              int64     `json:"settings"`
+	Version               int64     `json:"version"`
+}
+
+// BaseApiResponseAction is generated from the OpenAPI specification.
+type BaseApiResponseAction struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data NotificationactionAction `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseActionItems is generated from the OpenAPI specification.
+type BaseApiResponseActionItems struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data DashboardActionItems `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseActionResult is generated from the OpenAPI specification.
+type BaseApiResponseActionResult struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data ContainerActionResult `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseAgentPairResponse is generated from the OpenAPI specification.
+type BaseApiResponseAgentPairResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data EnvironmentAgentPairResponse `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseAnalysis is generated from the OpenAPI specification.
+type BaseApiResponseAnalysis struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data IngressAnalysis `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseApiKey is generated from the OpenAPI specification.
+type BaseApiResponseApiKey struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data ApikeyApiKey `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseApiKeyCreatedDto is generated from the OpenAPI specification.
+type BaseApiResponseApiKeyCreatedDto struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data ApikeyApiKeyCreatedDto `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseBackupHasPathResponse is generated from the OpenAPI specification.
+type BaseApiResponseBackupHasPathResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data BackupHasPathResponse `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseBatchResponse is generated from the OpenAPI specification.
+type BaseApiResponseBatchResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data map[string]ImageupdateResponse `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseBranchesResponse is generated from the OpenAPI specification.
+type BaseApiResponseBranchesResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data GitopsBranchesResponse `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseBrowseResponse is generated from the OpenAPI specification.
+type BaseApiResponseBrowseResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data GitopsBrowseResponse `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseBuildFileContentResponse is generated from the OpenAPI specification.
+type BaseApiResponseBuildFileContentResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data BuildFileContentResponse `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseBuildRecord is generated from the OpenAPI specification.
+type BaseApiResponseBuildRecord struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data ImageBuildRecord `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseBundle is generated from the OpenAPI specification.
+type BaseApiResponseBundle struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data NotificationBundle `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseCACertificate is generated from the OpenAPI specification.
+type BaseApiResponseCACertificate struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data CacertificateCACertificate `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseChangelog is generated from the OpenAPI specification.
+type BaseApiResponseChangelog struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data VersionChangelog `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseCheckResult is generated from the OpenAPI specification.
+type BaseApiResponseCheckResult struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data MonitorCheckResult `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseCleanupResult is generated from the OpenAPI specification.
+type BaseApiResponseCleanupResult struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data StaleresourceCleanupResult `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseCloneResult is generated from the OpenAPI specification.
+type BaseApiResponseCloneResult struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data ProjectCloneResult `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseClonedContainer is generated from the OpenAPI specification.
+type BaseApiResponseClonedContainer struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data ContainerClonedContainer `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseConfig is generated from the OpenAPI specification.
+type BaseApiResponseConfig struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data JobscheduleConfig `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseConfigSyncStatus is generated from the OpenAPI specification.
+type BaseApiResponseConfigSyncStatus struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data EnvironmentConfigSyncStatus `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseContainerRegistry is generated from the OpenAPI specification.
+type BaseApiResponseContainerRegistry struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data ContainerregistryContainerRegistry `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseContainerRollback is generated from the OpenAPI specification.
+type BaseApiResponseContainerRollback struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data UpdaterContainerRollback `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseContainerUpdatePreview is generated from the OpenAPI specification.
+type BaseApiResponseContainerUpdatePreview struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data UpdaterContainerUpdatePreview `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseCreatePreview is generated from the OpenAPI specification.
+type BaseApiResponseCreatePreview struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data ContainerCreatePreview `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseCreateReponse is generated from the OpenAPI specification.
+type BaseApiResponseCreateReponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data ProjectCreateReponse `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseDaemonConfig is generated from the OpenAPI specification.
+type BaseApiResponseDaemonConfig struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data DockerinfoDaemonConfig `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseDefaultTemplatesResponse is generated from the OpenAPI specification.
+type BaseApiResponseDefaultTemplatesResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data TemplateDefaultTemplatesResponse `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseDeleteFileResult is generated from the OpenAPI specification.
+type BaseApiResponseDeleteFileResult struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data ProjectDeleteFileResult `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseDeploymentSnippet is generated from the OpenAPI specification.
+type BaseApiResponseDeploymentSnippet struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data DeploymentSnippet `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseDetailSummary is generated from the OpenAPI specification.
+type BaseApiResponseDetailSummary struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data ImageDetailSummary `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseDetails is generated from the OpenAPI specification.
+type BaseApiResponseDetails struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data ProjectDetails `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseDistributeResult is generated from the OpenAPI specification.
+type BaseApiResponseDistributeResult struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data ImageDistributeResult `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseDockerCheck is generated from the OpenAPI specification.
+type BaseApiResponseDockerCheck struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data SetupDockerCheck `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseDryApplyResult is generated from the OpenAPI specification.
+type BaseApiResponseDryApplyResult struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data SettingsDryApplyResult `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseEditResult is generated from the OpenAPI specification.
+type BaseApiResponseEditResult struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data ContainerEditResult `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseEffectiveRole is generated from the OpenAPI specification.
+type BaseApiResponseEffectiveRole struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data RbacEffectiveRole `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseEnvCheck is generated from the OpenAPI specification.
+type BaseApiResponseEnvCheck struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data ProjectEnvCheck `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseEnvironment is generated from the OpenAPI specification.
+type BaseApiResponseEnvironment struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data EnvironmentEnvironment `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseEnvironmentVulnerabilitySummary is generated from the OpenAPI specification.
+type BaseApiResponseEnvironmentVulnerabilitySummary struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data VulnerabilityEnvironmentVulnerabilitySummary `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseEnvironmentWithApiKey is generated from the OpenAPI specification.
+type BaseApiResponseEnvironmentWithApiKey struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data EnvironmentWithApiKey `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseEvent is generated from the OpenAPI specification.
+type BaseApiResponseEvent struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data EventEvent `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseFileContentResponse is generated from the OpenAPI specification.
+type BaseApiResponseFileContentResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data FileContentResponse `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseGeneratedLabels is generated from the OpenAPI specification.
+type BaseApiResponseGeneratedLabels struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data IngressGeneratedLabels `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseGitOpsSync is generated from the OpenAPI specification.
+type BaseApiResponseGitOpsSync struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data GitopsGitOpsSync `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseGitRepository is generated from the OpenAPI specification.
+type BaseApiResponseGitRepository struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data GitopsGitRepository `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseGraph is generated from the OpenAPI specification.
+type BaseApiResponseGraph struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data TopologyGraph `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseHostMetrics is generated from the OpenAPI specification.
+type BaseApiResponseHostMetrics struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data SystemHostMetrics `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseIgnoredVulnerability is generated from the OpenAPI specification.
+type BaseApiResponseIgnoredVulnerability struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data VulnerabilityIgnoredVulnerability `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseImportGitOpsSyncResponse is generated from the OpenAPI specification.
+type BaseApiResponseImportGitOpsSyncResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data GitopsImportGitOpsSyncResponse `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseImportResult is generated from the OpenAPI specification.
+type BaseApiResponseImportResult struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data BackupImportResult `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseImportedContainer is generated from the OpenAPI specification.
+type BaseApiResponseImportedContainer struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data ContainerImportedContainer `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseInfo is generated from the OpenAPI specification.
+type BaseApiResponseInfo struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data VersionInfo `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseInventory is generated from the OpenAPI specification.
+type BaseApiResponseInventory struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data StaleresourceInventory `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseLayout is generated from the OpenAPI specification.
+type BaseApiResponseLayout struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data DashboardLayout `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseListAutoUpdateRecord is generated from the OpenAPI specification.
+type BaseApiResponseListAutoUpdateRecord struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data []AutoUpdateRecord `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseListCACertificate is generated from the OpenAPI specification.
+type BaseApiResponseListCACertificate struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data []CacertificateCACertificate `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseListCustomFileTemplate is generated from the OpenAPI specification.
+type BaseApiResponseListCustomFileTemplate struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data []ProjectCustomFileTemplate `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseListEnvironmentHostMetrics is generated from the OpenAPI specification.
+type BaseApiResponseListEnvironmentHostMetrics struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data []SystemEnvironmentHostMetrics `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseListFileEntry is generated from the OpenAPI specification.
+type BaseApiResponseListFileEntry struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data []VolumeFileEntry `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseListHostMetrics is generated from the OpenAPI specification.
+type BaseApiResponseListHostMetrics struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data []SystemHostMetrics `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseListKeySummary is generated from the OpenAPI specification.
+type BaseApiResponseListKeySummary struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data []TagKeySummary `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseListMonitor is generated from the OpenAPI specification.
+type BaseApiResponseListMonitor struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data []MonitorMonitor `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseListRoleBinding is generated from the OpenAPI specification.
+type BaseApiResponseListRoleBinding struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data []RbacRoleBinding `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseListRule is generated from the OpenAPI specification.
+type BaseApiResponseListRule struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data []LogalertRule `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseListRun is generated from the OpenAPI specification.
+type BaseApiResponseListRun struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data []ContainertaskRun `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseListSavedFilter is generated from the OpenAPI specification.
+type BaseApiResponseListSavedFilter struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data []TagSavedFilter `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseListSecret is generated from the OpenAPI specification.
+type BaseApiResponseListSecret struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data []SecretSecret `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseListSettingDto is generated from the OpenAPI specification.
+type BaseApiResponseListSettingDto struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data []SettingsSettingDto `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseListSnapshot is generated from the OpenAPI specification.
+type BaseApiResponseListSnapshot struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data []ContainerSnapshot `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseListSnooze is generated from the OpenAPI specification.
+type BaseApiResponseListSnooze struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data []ImageupdateSnooze `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseListString is generated from the OpenAPI specification.
+type BaseApiResponseListString struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data []string `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseListSubscription is generated from the OpenAPI specification.
+type BaseApiResponseListSubscription struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data []NotificationSubscription `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseListTask is generated from the OpenAPI specification.
+type BaseApiResponseListTask struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data []ContainertaskTask `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseListTemplate is generated from the OpenAPI specification.
+type BaseApiResponseListTemplate struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data []TemplateTemplate `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseListTemplateRegistry is generated from the OpenAPI specification.
+type BaseApiResponseListTemplateRegistry struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data []TemplateTemplateRegistry `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseListVariable is generated from the OpenAPI specification.
+type BaseApiResponseListVariable struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data []EnvVariable `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseListVolumeSizeInfo is generated from the OpenAPI specification.
+type BaseApiResponseListVolumeSizeInfo struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data []VolumeSizeInfo `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseListWebhook is generated from the OpenAPI specification.
+type BaseApiResponseListWebhook struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data []CommandwebhookWebhook `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseLoadResult is generated from the OpenAPI specification.
+type BaseApiResponseLoadResult struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data ImageLoadResult `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseLogForwarder is generated from the OpenAPI specification.
+type BaseApiResponseLogForwarder struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data LogforwardLogForwarder `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseLoginResponse is generated from the OpenAPI specification.
+type BaseApiResponseLoginResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data AuthLoginResponse `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseMapStringString is generated from the OpenAPI specification.
+type BaseApiResponseMapStringString struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data map[string]string `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseMessageResponse is generated from the OpenAPI specification.
+type BaseApiResponseMessageResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data BaseMessageResponse `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseMonitor is generated from the OpenAPI specification.
+type BaseApiResponseMonitor struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data MonitorMonitor `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseOutcome is generated from the OpenAPI specification.
+type BaseApiResponseOutcome struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data NotificationactionOutcome `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponsePathMappingDiagnostics is generated from the OpenAPI specification.
+type BaseApiResponsePathMappingDiagnostics struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data ProjectPathMappingDiagnostics `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseProgress is generated from the OpenAPI specification.
+type BaseApiResponseProgress struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data SetupProgress `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseProjectsDirectoryResult is generated from the OpenAPI specification.
+type BaseApiResponseProjectsDirectoryResult struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data SetupProjectsDirectoryResult `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponsePromoteResult is generated from the OpenAPI specification.
+type BaseApiResponsePromoteResult struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data ImagePromoteResult `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponsePruneAllResult is generated from the OpenAPI specification.
+type BaseApiResponsePruneAllResult struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data SystemPruneAllResult `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponsePruneReport is generated from the OpenAPI specification.
+type BaseApiResponsePruneReport struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data ImagePruneReport `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseQuotaStatus is generated from the OpenAPI specification.
+type BaseApiResponseQuotaStatus struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data ProjectQuotaStatus `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseRegistries is generated from the OpenAPI specification.
+type BaseApiResponseRegistries struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data ProjectRegistries `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseRemoteRegistry is generated from the OpenAPI specification.
+type BaseApiResponseRemoteRegistry struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data TemplateRemoteRegistry `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseReport is generated from the OpenAPI specification.
+type BaseApiResponseReport struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data SecurityauditReport `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseResponse is generated from the OpenAPI specification.
+type BaseApiResponseResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data ImageupdateResponse `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseRestoreSnapshotResult is generated from the OpenAPI specification.
+type BaseApiResponseRestoreSnapshotResult struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data ContainerRestoreSnapshotResult `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseResult is generated from the OpenAPI specification.
+type BaseApiResponseResult struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data UpdaterResult `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseRoleBinding is generated from the OpenAPI specification.
+type BaseApiResponseRoleBinding struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data RbacRoleBinding `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseRollout is generated from the OpenAPI specification.
+type BaseApiResponseRollout struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data UpdaterRollout `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseRule is generated from the OpenAPI specification.
+type BaseApiResponseRule struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data LogalertRule `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseRun is generated from the OpenAPI specification.
+type BaseApiResponseRun struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data ContainertaskRun `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseRunDetail is generated from the OpenAPI specification.
+type BaseApiResponseRunDetail struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data UpdaterRunDetail `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseSavedFilter is generated from the OpenAPI specification.
+type BaseApiResponseSavedFilter struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data TagSavedFilter `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseScanResult is generated from the OpenAPI specification.
+type BaseApiResponseScanResult struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data VulnerabilityScanResult `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseScanSummariesResponse is generated from the OpenAPI specification.
+type BaseApiResponseScanSummariesResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data VulnerabilityScanSummariesResponse `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseScanSummary is generated from the OpenAPI specification.
+type BaseApiResponseScanSummary struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data VulnerabilityScanSummary `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseScannerStatus is generated from the OpenAPI specification.
+type BaseApiResponseScannerStatus struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data ScannerStatus `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseSecret is generated from the OpenAPI specification.
+type BaseApiResponseSecret struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data SecretSecret `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseServicePatchResult is generated from the OpenAPI specification.
+type BaseApiResponseServicePatchResult struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data ProjectServicePatchResult `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseSettingsImportResult is generated from the OpenAPI specification.
+type BaseApiResponseSettingsImportResult struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data NotificationSettingsImportResult `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseSnapshot is generated from the OpenAPI specification.
+type BaseApiResponseSnapshot struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data ContainerSnapshot `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseSnooze is generated from the OpenAPI specification.
+type BaseApiResponseSnooze struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data ImageupdateSnooze `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseStatus is generated from the OpenAPI specification.
+type BaseApiResponseStatus struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data UpdaterStatus `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseStatusCounts is generated from the OpenAPI specification.
+type BaseApiResponseStatusCounts struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data ProjectStatusCounts `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseStruct is generated from the OpenAPI specification.
+type BaseApiResponseStruct struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data ApiResponseStructDataStruct `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseSubscription is generated from the OpenAPI specification.
+type BaseApiResponseSubscription struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data NotificationSubscription `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseSummary is generated from the OpenAPI specification.
+type BaseApiResponseSummary struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data ImageupdateSummary `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseSyncResult is generated from the OpenAPI specification.
+type BaseApiResponseSyncResult struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data GitopsSyncResult `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseSyncStatus is generated from the OpenAPI specification.
+type BaseApiResponseSyncStatus struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data GitopsSyncStatus `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseTagBumpResult is generated from the OpenAPI specification.
+type BaseApiResponseTagBumpResult struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data GitopsTagBumpResult `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseTask is generated from the OpenAPI specification.
+type BaseApiResponseTask struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data ContainertaskTask `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseTemplate is generated from the OpenAPI specification.
+type BaseApiResponseTemplate struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data TemplateTemplate `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseTemplateContent is generated from the OpenAPI specification.
+type BaseApiResponseTemplateContent struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data TemplateTemplateContent `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseTemplateRegistry is generated from the OpenAPI specification.
+type BaseApiResponseTemplateRegistry struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data TemplateTemplateRegistry `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseTest is generated from the OpenAPI specification.
+type BaseApiResponseTest struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data EnvironmentTest `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseTokenRefreshResponse is generated from the OpenAPI specification.
+type BaseApiResponseTokenRefreshResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data AuthTokenRefreshResponse `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseUpdateDaemonJSONResult is generated from the OpenAPI specification.
+type BaseApiResponseUpdateDaemonJSONResult struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data DockerinfoUpdateDaemonJSONResult `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseUser is generated from the OpenAPI specification.
+type BaseApiResponseUser struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data UserUser `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseVolume is generated from the OpenAPI specification.
+type BaseApiResponseVolume struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data VolumeVolume `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseVolumeBackup is generated from the OpenAPI specification.
+type BaseApiResponseVolumeBackup struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data VolumeBackup `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseVolumePruneReportData is generated from the OpenAPI specification.
+type BaseApiResponseVolumePruneReportData struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data VolumePruneReportData `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseVolumeUsageCountsData is generated from the OpenAPI specification.
+type BaseApiResponseVolumeUsageCountsData struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data VolumeUsageCountsData `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseVolumeUsageResponse is generated from the OpenAPI specification.
+type BaseApiResponseVolumeUsageResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data VolumeUsageResponse `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseWebhook is generated from the OpenAPI specification.
+type BaseApiResponseWebhook struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data CommandwebhookWebhook `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseWebhookCreated is generated from the OpenAPI specification.
+type BaseApiResponseWebhookCreated struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data CommandwebhookWebhookCreated `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseApiResponseWidgetData is generated from the OpenAPI specification.
+type BaseApiResponseWidgetData struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Response data
+	Data DashboardWidgetData `json:"data"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BaseMessageResponse is generated from the OpenAPI specification.
+type BaseMessageResponse struct {
+	// Response message
+	Message string `json:"message"`
+}
+
+// BasePaginatedIgnoredVulnerability is generated from the OpenAPI specification.
+type BasePaginatedIgnoredVulnerability struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Array of items for the current page
+	Data []VulnerabilityIgnoredVulnerability `json:"data"`
+	// Pagination metadata
+	Pagination BasePaginationResponse `json:"pagination"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BasePaginatedVulnerability is generated from the OpenAPI specification.
+type BasePaginatedVulnerability struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Array of items for the current page
+	Data []VulnerabilityVulnerability `json:"data"`
+	// Pagination metadata
+	Pagination BasePaginationResponse `json:"pagination"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BasePaginatedVulnerabilityWithImage is generated from the OpenAPI specification.
+type BasePaginatedVulnerabilityWithImage struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Array of items for the current page
+	Data []VulnerabilityVulnerabilityWithImage `json:"data"`
+	// Pagination metadata
+	Pagination BasePaginationResponse `json:"pagination"`
+	// Whether the request was successful
+	Success bool `json:"success"`
+}
+
+// BasePaginationResponse is generated from the OpenAPI specification.
+type BasePaginationResponse struct {
+	// Current page number (1-indexed)
+	CurrentPage int64 `json:"currentPage"`
+	// Total number of items without filters
+	GrandTotalItems *int64 `json:"grandTotalItems,omitempty"`
+	// Number of items per page
+	ItemsPerPage int64 `json:"itemsPerPage"`
+	// Total number of items in the current filtered set
+	TotalItems int64 `json:"totalItems"`
+	// Total number of pages
+	TotalPages int64 `json:"totalPages"`
+}
+
+// BlkioConfig is generated from the OpenAPI specification.
+type BlkioConfig struct {
+	DeviceReadBps   []DockerTypesThrottleDevice `json:"device_read_bps,omitempty"`
+	DeviceReadIops  []DockerTypesThrottleDevice `json:"device_read_iops,omitempty"`
+	DeviceWriteBps  []DockerTypesThrottleDevice `json:"device_write_bps,omitempty"`
+	DeviceWriteIops []DockerTypesThrottleDevice `json:"device_write_iops,omitempty"`
+	Weight          *int32                      `json:"weight,omitempty"`
+	WeightDevice    []DockerTypesWeightDevice   `json:"weight_device,omitempty"`
+}
+
+// BrowseGitOpsSyncFilesParams holds the query and header parameters of BrowseGitOpsSyncFiles.
+type BrowseGitOpsSyncFilesParams struct {
+	// Path to browse (optional)
+	Path string
+}
+
+func (p *BrowseGitOpsSyncFilesParams) apply(query url.Values, header http.Header) {
+	setString(query.Set, "path", p.Path)
+}
+
+// BrowseGitRepositoryFilesParams holds the query and header parameters of BrowseGitRepositoryFiles.
+type BrowseGitRepositoryFilesParams struct {
+	// Branch to browse
+	Branch string
+	// Path within repository (optional)
+	Path string
+}
+
+func (p *BrowseGitRepositoryFilesParams) apply(query url.Values, header http.Header) {
+	setString(query.Set, "branch", p.Branch)
+	setString(query.Set, "path", p.Path)
+}
+
+// BrowseVolumeDirectoryParams holds the query and header parameters of BrowseVolumeDirectory.
+type BrowseVolumeDirectoryParams struct {
+	// Directory path to browse
+	Path string
+}
+
+func (p *BrowseVolumeDirectoryParams) apply(query url.Values, header http.Header) {
+	setString(query.Set, "path", p.Path)
+}
+
+// BuildConfig is generated from the OpenAPI specification.
+type BuildConfig struct {
+	AdditionalContexts map[string]string                `json:"additional_contexts,omitempty"`
+	Args               map[string]*string               `json:"args,omitempty"`
+	CacheFrom          []string                         `json:"cache_from,omitempty"`
+	CacheTo            []string                         `json:"cache_to,omitempty"`
+	Context            *string                          `json:"context,omitempty"`
+	Dockerfile         *string                          `json:"dockerfile,omitempty"`
+	DockerfileInline   *string                          `json:"dockerfile_inline,omitempty"`
+	Entitlements       []string                         `json:"entitlements,omitempty"`
+	ExtraHosts         map[string][]string              `json:"extra_hosts,omitempty"`
+	Isolation          *string                          `json:"isolation,omitempty"`
+	Labels             map[string]string                `json:"labels,omitempty"`
+	Network            *string                          `json:"network,omitempty"`
+	NoCache            *bool                            `json:"no_cache,omitempty"`
+	NoCacheFilter      []string                         `json:"no_cache_filter,omitempty"`
+	Platforms          []string                         `json:"platforms,omitempty"`
+	Privileged         *bool                            `json:"privileged,omitempty"`
+	Provenance         *string                          `json:"provenance,omitempty"`
+	Pull               *bool                            `json:"pull,omitempty"`
+	Sbom               *string                          `json:"sbom,omitempty"`
+	Secrets            []DockerTypesServiceSecretConfig `json:"secrets,omitempty"`
+	ShmSize            *int64                           `json:"shm_size,omitempty"`
+	SSH                []DockerTypesSSHKey              `json:"ssh,omitempty"`
+	Tags               []string                         `json:"tags,omitempty"`
+	Target             *string                          `json:"target,omitempty"`
+	Ulimits            map[string]UlimitsConfig         `json:"ulimits,omitempty"`
+}
+
+// BuildFileContentResponse is generated from the OpenAPI specification.
+type BuildFileContentResponse struct {
+	Content  string `json:"content"`
+	MimeType string `json:"mimeType"`
+}
+
+// BuildProjectInputBody is generated from the OpenAPI specification.
+type BuildProjectInputBody struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Load images into Docker
+	Load *bool `json:"load,omitempty"`
+	// Build provider override
+	Provider *string `json:"provider,omitempty"`
+	// Push images
+	Push *bool `json:"push,omitempty"`
+	// Service names to build
+	Services []string `json:"services,omitempty"`
+}
+
+// BuildsBrowseContentParams holds the query and header parameters of BuildsBrowseContent.
+type BuildsBrowseContentParams struct {
+	// Maximum bytes to read (default 1MB)
+	MaxBytes *int64
+	// File path
+	Path string
+}
+
+func (p *BuildsBrowseContentParams) apply(query url.Values, header http.Header) {
+	setValue(query.Set, "maxBytes", p.MaxBytes)
+	setString(query.Set, "path", p.Path)
+}
+
+// BuildsBrowseDeleteParams holds the query and header parameters of BuildsBrowseDelete.
+type BuildsBrowseDeleteParams struct {
+	// File or directory path to delete
+	Path string
+}
+
+func (p *BuildsBrowseDeleteParams) apply(query url.Values, header http.Header) {
+	setString(query.Set, "path", p.Path)
+}
+
+// BuildsBrowseDownloadParams holds the query and header parameters of BuildsBrowseDownload.
+type BuildsBrowseDownloadParams struct {
+	// File path
+	Path string
+}
+
+func (p *BuildsBrowseDownloadParams) apply(query url.Values, header http.Header) {
+	setString(query.Set, "path", p.Path)
+}
+
+// BuildsBrowseMkdirParams holds the query and header parameters of BuildsBrowseMkdir.
+type BuildsBrowseMkdirParams struct {
+	// Directory path to create
+	Path string
+}
+
+func (p *BuildsBrowseMkdirParams) apply(query url.Values, header http.Header) {
+	setString(query.Set, "path", p.Path)
+}
+
+// BuildsBrowseParams holds the query and header parameters of BuildsBrowse.
+type BuildsBrowseParams struct {
+	// Directory path to browse
+	Path string
+}
+
+func (p *BuildsBrowseParams) apply(query url.Values, header http.Header) {
+	setString(query.Set, "path", p.Path)
+}
+
+// BuildsBrowseUploadParams holds the query and header parameters of BuildsBrowseUpload.
+type BuildsBrowseUploadParams struct {
+	// Destination path
+	Path string
+}
+
+func (p *BuildsBrowseUploadParams) apply(query url.Values, header http.Header) {
+	setString(query.Set, "path", p.Path)
+}
+
+// CacertificateCACertificate is generated from the OpenAPI specification.
+type CacertificateCACertificate struct {
+	Certificate string    `json:"certificate"`
+	CreatedAt   time.Time `json:"createdAt"`
+	Fingerprint string    `json:"fingerprint"`
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	NotAfter    time.Time `json:"notAfter"`
+	Subject     string    `json:"subject"`
+}
+
+// CacertificateCreateRequest is generated from the OpenAPI specification.
+type CacertificateCreateRequest struct {
+	// A URL to the JSON Schema for this object.
+	Schema      *string `json:"$schema,omitempty"`
+	Certificate string  `json:"certificate"`
+	Name        string  `json:"name"`
+}
+
+// CategoryCategory is generated from the OpenAPI specification.
+type CategoryCategory struct {
+	Description      string         `json:"description"`
+	Icon             string         `json:"icon"`
+	ID               string         `json:"id"`
+	Keywords         []string       `json:"keywords"`
+	MatchingSettings []MetaMetadata `json:"matchingSettings,omitempty"`
+	RelevanceScore   *int64         `json:"relevanceScore,omitempty"`
+	Settings         []MetaMetadata `json:"settings"`
+	Title            string         `json:"title"`
+	URL              string         `json:"url"`
+}
+
+// CheckImageUpdateParams holds the query and header parameters of CheckImageUpdate.
+type CheckImageUpdateParams struct {
+	// Image reference
+	ImageRef string
+}
+
+func (p *CheckImageUpdateParams) apply(query url.Values, header http.Header) {
+	setString(query.Set, "imageRef", p.ImageRef)
+}
+
+// CommandwebhookCreateWebhook is generated from the OpenAPI specification.
+type CommandwebhookCreateWebhook struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Actions the webhook may run: project.redeploy, container.update, updater.apply or system.prune
+	Actions []string `json:"actions"`
+	// Whether the webhook accepts requests (default true)
+	Enabled *bool `json:"enabled,omitempty"`
+	// Display name of the webhook
+	Name string `json:"name"`
+}
+
+// CommandwebhookTriggerResult is generated from the OpenAPI specification.
+type CommandwebhookTriggerResult struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Action that was started
+	Action string `json:"action"`
+	// Slack response type
+	ResponseType string `json:"response_type"`
+	// Project or container the action applies to
+	Target *string `json:"target,omitempty"`
+	// Human-readable summary of the accepted command
+	Text string `json:"text"`
+}
+
+// CommandwebhookUpdateWebhook is generated from the OpenAPI specification.
+type CommandwebhookUpdateWebhook struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Actions the webhook may run
+	Actions []string `json:"actions,omitempty"`
+	// Whether the webhook accepts requests
+	Enabled *bool `json:"enabled,omitempty"`
+	// Display name of the webhook
+	Name *string `json:"name,omitempty"`
+}
+
+// CommandwebhookWebhook is generated from the OpenAPI specification.
+type CommandwebhookWebhook struct {
+	// Actions the webhook may run
+	Actions []string `json:"actions"`
+	// Creation timestamp
+	CreatedAt time.Time `json:"createdAt"`
+	// Whether the webhook accepts requests
+	Enabled bool `json:"enabled"`
+	// Unique identifier of the webhook
+	ID string `json:"id"`
+	// Time of the last accepted request
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	// Display name of the webhook
+	Name string `json:"name"`
+	// First characters of the token, to tell webhooks apart
+	TokenPrefix string `json:"tokenPrefix"`
+	// Last update timestamp
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+// CommandwebhookWebhookCreated is generated from the OpenAPI specification.
+type CommandwebhookWebhookCreated struct {
+	// Actions the webhook may run
+	Actions []string `json:"actions"`
+	// Creation timestamp
+	CreatedAt time.Time `json:"createdAt"`
+	// Whether the webhook accepts requests
+	Enabled bool `json:"enabled"`
+	// Unique identifier of the webhook
+	ID string `json:"id"`
+	// Time of the last accepted request
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	// Display name of the webhook
+	Name string `json:"name"`
+	// Secret token; it is part of the trigger URL and cannot be retrieved again
+	Token string `json:"token"`
+	// First characters of the token, to tell webhooks apart
+	TokenPrefix string `json:"tokenPrefix"`
+	// Last update timestamp
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+// ContainerActionResponse is generated from the OpenAPI specification.
+type ContainerActionResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema  *string             `json:"$schema,omitempty"`
+	Data    BaseMessageResponse `json:"data"`
+	Success bool                `json:"success"`
+}
+
+// ContainerActionResult is generated from the OpenAPI specification.
+type ContainerActionResult struct {
+	Errors  []string `json:"errors,omitempty"`
+	Failed  []string `json:"failed,omitempty"`
+	Started []string `json:"started,omitempty"`
+	Stopped []string `json:"stopped,omitempty"`
+	Success bool     `json:"success"`
+}
+
+// ContainerCloneRequest is generated from the OpenAPI specification.
+type ContainerCloneRequest struct {
+	// A URL to the JSON Schema for this object.
+	Schema              *string `json:"$schema,omitempty"`
+	ContainerID         string  `json:"containerId"`
+	CopyVolumes         *bool   `json:"copyVolumes,omitempty"`
+	Name                *string `json:"name,omitempty"`
+	SourceEnvironmentID *string `json:"sourceEnvironmentId,omitempty"`
+	Start               *bool   `json:"start,omitempty"`
+	TargetEnvironmentID string  `json:"targetEnvironmentId"`
+}
+
+// ContainerClonedContainer is generated from the OpenAPI specification.
+type ContainerClonedContainer struct {
+	ContainerID     string   `json:"containerId"`
+	ContainerName   string   `json:"containerName"`
+	EnvironmentID   string   `json:"environmentId"`
+	EnvironmentName string   `json:"environmentName"`
+	Image           string   `json:"image"`
+	Volumes         []string `json:"volumes"`
+	Warnings        []string `json:"warnings"`
+}
+
+// ContainerConfig is generated from the OpenAPI specification.
+type ContainerConfig struct {
+	Cmd        []string `json:"cmd,omitempty"`
+	Entrypoint []string `json:"entrypoint,omitempty"`
+	Env        []string `json:"env,omitempty"`
+	User       *string  `json:"user,omitempty"`
+	WorkingDir *string  `json:"workingDir,omitempty"`
+}
+
+// ContainerConfigChange is generated from the OpenAPI specification.
+type ContainerConfigChange struct {
+	Added   []string `json:"added,omitempty"`
+	Field   string   `json:"field"`
+	Removed []string `json:"removed,omitempty"`
+}
+
+// ContainerCreate is generated from the OpenAPI specification.
+type ContainerCreate struct {
+	// A URL to the JSON Schema for this object.
+	Schema           *string                          `json:"$schema,omitempty"`
+	AttachStderr     *bool                            `json:"attachStderr,omitempty"`
+	AttachStdin      *bool                            `json:"attachStdin,omitempty"`
+	AttachStdout     *bool                            `json:"attachStdout,omitempty"`
+	AutoRemove       *bool                            `json:"autoRemove,omitempty"`
+	Cmd              []string                         `json:"cmd,omitempty"`
+	Command          []string                         `json:"command,omitempty"`
+	Cpus             *float64                         `json:"cpus,omitempty"`
+	Credentials      []ContainerregistryCredential    `json:"credentials,omitempty"`
+	Domainname       *string                          `json:"domainname,omitempty"`
+	Entrypoint       []string                         `json:"entrypoint,omitempty"`
+	Env              []string                         `json:"env,omitempty"`
+	Environment      []string                         `json:"environment,omitempty"`
+	ExposedPorts     map[string]Value                 `json:"exposedPorts,omitempty"`
+	Healthcheck      *ContainerHealthcheckCreate      `json:"healthcheck,omitempty"`
+	HostConfig       *ContainerHostConfigCreate       `json:"hostConfig,omitempty"`
+	Hostname         *string                          `json:"hostname,omitempty"`
+	Image            string                           `json:"image"`
+	Labels           map[string]string                `json:"labels,omitempty"`
+	Memory           *int64                           `json:"memory,omitempty"`
+	Name             string                           `json:"name"`
+	NetworkDisabled  *bool                            `json:"networkDisabled,omitempty"`
+	NetworkingConfig *ContainerNetworkingConfigCreate `json:"networkingConfig,omitempty"`
+	Networks         []string                         `json:"networks,omitempty"`
+	OpenStdin        *bool                            `json:"openStdin,omitempty"`
+	Ports            map[string]string                `json:"ports,omitempty"`
+	Privileged       *bool                            `json:"privileged,omitempty"`
+	RestartPolicy    *string                          `json:"restartPolicy,omitempty"`
+	StdinOnce        *bool                            `json:"stdinOnce,omitempty"`
+	Tty              *bool                            `json:"tty,omitempty"`
+	User             *string                          `json:"user,omitempty"`
+	Volumes          []string                         `json:"volumes,omitempty"`
+	WorkingDir       *string                          `json:"workingDir,omitempty"`
+}
+
+// ContainerCreateIssue is generated from the OpenAPI specification.
+type ContainerCreateIssue struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ContainerCreatePreview is generated from the OpenAPI specification.
+type ContainerCreatePreview struct {
+	DockerRun string                 `json:"dockerRun"`
+	Errors    []ContainerCreateIssue `json:"errors,omitempty"`
+	Valid     bool                   `json:"valid"`
+	Warnings  []ContainerCreateIssue `json:"warnings,omitempty"`
+}
+
+// ContainerCreateSnapshotRequest is generated from the OpenAPI specification.
+type ContainerCreateSnapshotRequest struct {
+	// A URL to the JSON Schema for this object.
+	Schema    *string `json:"$schema,omitempty"`
+	Comment   *string `json:"comment,omitempty"`
+	NoPause   *bool   `json:"noPause,omitempty"`
+	Push      *bool   `json:"push,omitempty"`
+	Reference *string `json:"reference,omitempty"`
+}
+
+// ContainerCreated is generated from the OpenAPI specification.
+type ContainerCreated struct {
+	Created string `json:"created"`
+	ID      string `json:"id"`
+	Image   string `json:"image"`
+	Name    string `json:"name"`
+	Status  string `json:"status"`
+}
+
+// ContainerCreatedResponse is generated from the OpenAPI specification.
+type ContainerCreatedResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema  *string          `json:"$schema,omitempty"`
+	Data    ContainerCreated `json:"data"`
+	Success bool             `json:"success"`
+}
+
+// ContainerDetails is generated from the OpenAPI specification.
+type ContainerDetails struct {
+	Config          ContainerConfig          `json:"config"`
+	Created         string                   `json:"created"`
+	HostConfig      ContainerHostConfig      `json:"hostConfig"`
+	ID              string                   `json:"id"`
+	Image           string                   `json:"image"`
+	ImageID         string                   `json:"imageId"`
+	Labels          map[string]string        `json:"labels,omitempty"`
+	Mounts          []ContainerMount         `json:"mounts"`
+	Name            string                   `json:"name"`
+	NetworkSettings ContainerNetworkSettings `json:"networkSettings"`
+	Ports           []ContainerPort          `json:"ports"`
+	State           ContainerState           `json:"state"`
+}
+
+// ContainerDetailsResponse is generated from the OpenAPI specification.
+type ContainerDetailsResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema  *string          `json:"$schema,omitempty"`
+	Data    ContainerDetails `json:"data"`
+	Success bool             `json:"success"`
+}
+
+// ContainerDeviceMapping is generated from the OpenAPI specification.
+type ContainerDeviceMapping struct {
+	CgroupPermissions *string `json:"cgroupPermissions,omitempty"`
+	PathInContainer   *string `json:"pathInContainer,omitempty"`
+	PathOnHost        string  `json:"pathOnHost"`
+}
+
+// ContainerDeviceRequest is generated from the OpenAPI specification.
+type ContainerDeviceRequest struct {
+	Capabilities [][]string `json:"capabilities,omitempty"`
+	Count        *int64     `json:"count,omitempty"`
+	DeviceIds    []string   `json:"deviceIds,omitempty"`
+	Driver       *string    `json:"driver,omitempty"`
+}
+
+// ContainerEditRequest is generated from the OpenAPI specification.
+type ContainerEditRequest struct {
+	// A URL to the JSON Schema for this object.
+	Schema       *string                                 `json:"$schema,omitempty"`
+	Binds        []string                                `json:"binds,omitempty"`
+	Env          []string                                `json:"env,omitempty"`
+	Image        *string                                 `json:"image,omitempty"`
+	PortBindings map[string][]ContainerPortBindingCreate `json:"portBindings,omitempty"`
+}
+
+// ContainerEditResult is generated from the OpenAPI specification.
+type ContainerEditResult struct {
+	Applied       bool                    `json:"applied"`
+	Changes       []ContainerConfigChange `json:"changes"`
+	ContainerID   string                  `json:"containerId"`
+	ContainerName string                  `json:"containerName"`
+}
+
+// ContainerEndpointSettingsCreate is generated from the OpenAPI specification.
+type ContainerEndpointSettingsCreate struct {
+	Aliases []string `json:"aliases,omitempty"`
+}
+
+// ContainerHealthcheckCreate is generated from the OpenAPI specification.
+type ContainerHealthcheckCreate struct {
+	Interval    *string  `json:"interval,omitempty"`
+	Retries     *int64   `json:"retries,omitempty"`
+	StartPeriod *string  `json:"startPeriod,omitempty"`
+	Test        []string `json:"test"`
+	Timeout     *string  `json:"timeout,omitempty"`
+}
+
+// ContainerHostConfig is generated from the OpenAPI specification.
+type ContainerHostConfig struct {
+	AutoRemove     *bool                    `json:"autoRemove,omitempty"`
+	DeviceRequests []ContainerDeviceRequest `json:"deviceRequests,omitempty"`
+	Devices        []ContainerDeviceMapping `json:"devices,omitempty"`
+	Memory         *int64                   `json:"memory,omitempty"`
+	NanoCpus       *int64                   `json:"nanoCpus,omitempty"`
+	NetworkMode    *string                  `json:"networkMode,omitempty"`
+	Privileged     *bool                    `json:"privileged,omitempty"`
+	RestartPolicy  *string                  `json:"restartPolicy,omitempty"`
+	Runtime        *string                  `json:"runtime,omitempty"`
+}
+
+// ContainerHostConfigCreate is generated from the OpenAPI specification.
+type ContainerHostConfigCreate struct {
+	AutoRemove      *bool                                   `json:"autoRemove,omitempty"`
+	Binds           []string                                `json:"binds,omitempty"`
+	CPUShares       *int64                                  `json:"cpuShares,omitempty"`
+	Memory          *int64                                  `json:"memory,omitempty"`
+	MemorySwap      *int64                                  `json:"memorySwap,omitempty"`
+	NanoCpus        *int64                                  `json:"nanoCpus,omitempty"`
+	NetworkMode     *string                                 `json:"networkMode,omitempty"`
+	PortBindings    map[string][]ContainerPortBindingCreate `json:"portBindings,omitempty"`
+	Privileged      *bool                                   `json:"privileged,omitempty"`
+	PublishAllPorts *bool                                   `json:"publishAllPorts,omitempty"`
+	ReadonlyRootfs  *bool                                   `json:"readonlyRootfs,omitempty"`
+	RestartPolicy   *ContainerRestartPolicyCreate           `json:"restartPolicy,omitempty"`
+}
+
+// ContainerImportRequest is generated from the OpenAPI specification.
+type ContainerImportRequest struct {
+	// A URL to the JSON Schema for this object.
+	Schema           *string                        `json:"$schema,omitempty"`
+	Config           DockerContainerConfig          `json:"config"`
+	HostConfig       DockerContainerHostConfig      `json:"hostConfig"`
+	Name             string                         `json:"name"`
+	NetworkingConfig *DockerNetworkNetworkingConfig `json:"networkingConfig,omitempty"`
+	Start            *bool                          `json:"start,omitempty"`
+}
+
+// ContainerImportedContainer is generated from the OpenAPI specification.
+type ContainerImportedContainer struct {
+	ContainerID   string   `json:"containerId"`
+	ContainerName string   `json:"containerName"`
+	Warnings      []string `json:"warnings"`
+}
+
+// ContainerLogLine is generated from the OpenAPI specification.
+type ContainerLogLine struct {
+	Message   string  `json:"message"`
+	Stream    string  `json:"stream"`
+	Timestamp *string `json:"timestamp,omitempty"`
+}
+
+// ContainerLogSearchResult is generated from the OpenAPI specification.
+type ContainerLogSearchResult struct {
+	Lines     []ContainerLogLine `json:"lines"`
+	Matched   int64              `json:"matched"`
+	Scanned   int64              `json:"scanned"`
+	Truncated bool               `json:"truncated"`
+}
+
+// ContainerLogsResponse is generated from the OpenAPI specification.
+type ContainerLogsResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema  *string                  `json:"$schema,omitempty"`
+	Data    ContainerLogSearchResult `json:"data"`
+	Success bool                     `json:"success"`
+}
+
+// ContainerMount is generated from the OpenAPI specification.
+type ContainerMount struct {
+	Destination string  `json:"destination"`
+	Driver      *string `json:"driver,omitempty"`
+	Mode        *string `json:"mode,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	Propagation *string `json:"propagation,omitempty"`
+	Rw          *bool   `json:"rw,omitempty"`
+	Source      *string `json:"source,omitempty"`
+	Type        string  `json:"type"`
+}
+
+// ContainerNetworkEndpoint is generated from the OpenAPI specification.
+type ContainerNetworkEndpoint struct {
+	Aliases             []string          `json:"aliases,omitempty"`
+	DNSNames            []string          `json:"dnsNames,omitempty"`
+	DriverOpts          map[string]string `json:"driverOpts,omitempty"`
+	EndpointID          *string           `json:"endpointId,omitempty"`
+	Gateway             *string           `json:"gateway,omitempty"`
+	GlobalIPv6Address   *string           `json:"globalIPv6Address,omitempty"`
+	GlobalIPv6PrefixLen *int64            `json:"globalIPv6PrefixLen,omitempty"`
+	GwPriority          *int64            `json:"gwPriority,omitempty"`
+	IPAddress           *string           `json:"ipAddress,omitempty"`
+	IPPrefixLen         *int64            `json:"ipPrefixLen,omitempty"`
+	IpamConfig          any               `json:"ipamConfig,omitempty"`
+	Ipv6Gateway         *string           `json:"ipv6Gateway,omitempty"`
+	Links               []string          `json:"links,omitempty"`
+	MacAddress          *string           `json:"macAddress,omitempty"`
+	NetworkID           *string           `json:"networkId,omitempty"`
+}
+
+// ContainerNetworkSettings is generated from the OpenAPI specification.
+type ContainerNetworkSettings struct {
+	Networks map[string]ContainerNetworkEndpoint `json:"networks"`
+}
+
+// ContainerNetworkingConfigCreate is generated from the OpenAPI specification.
+type ContainerNetworkingConfigCreate struct {
+	EndpointsConfig map[string]ContainerEndpointSettingsCreate `json:"endpointsConfig,omitempty"`
+}
+
+// ContainerPaginatedResponse is generated from the OpenAPI specification.
+type ContainerPaginatedResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema     *string                `json:"$schema,omitempty"`
+	Counts     ContainerStatusCounts  `json:"counts"`
+	Data       []ContainerSummary     `json:"data"`
+	Pagination BasePaginationResponse `json:"pagination"`
+	Success    bool                   `json:"success"`
+}
+
+// ContainerPort is generated from the OpenAPI specification.
+type ContainerPort struct {
+	IP          *string `json:"ip,omitempty"`
+	PrivatePort int64   `json:"privatePort"`
+	PublicPort  *int64  `json:"publicPort,omitempty"`
+	Type        string  `json:"type"`
+}
+
+// ContainerPortBindingCreate is generated from the OpenAPI specification.
+type ContainerPortBindingCreate struct {
+	HostIP   *string `json:"hostIp,omitempty"`
+	HostPort *string `json:"hostPort,omitempty"`
+}
+
+// ContainerRegistryPaginatedResponse is generated from the OpenAPI specification.
+type ContainerRegistryPaginatedResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema     *string                              `json:"$schema,omitempty"`
+	Data       []ContainerregistryContainerRegistry `json:"data"`
+	Pagination BasePaginationResponse               `json:"pagination"`
+	Success    bool                                 `json:"success"`
+}
+
+// ContainerRestartPolicyCreate is generated from the OpenAPI specification.
+type ContainerRestartPolicyCreate struct {
+	MaximumRetryCount *int64  `json:"maximumRetryCount,omitempty"`
+	Name              *string `json:"name,omitempty"`
+}
+
+// ContainerRestoreSnapshotResult is generated from the OpenAPI specification.
+type ContainerRestoreSnapshotResult struct {
+	ContainerID   string            `json:"containerId"`
+	ContainerName string            `json:"containerName"`
+	Snapshot      ContainerSnapshot `json:"snapshot"`
+}
+
+// ContainerSnapshot is generated from the OpenAPI specification.
+type ContainerSnapshot struct {
+	Comment       *string   `json:"comment,omitempty"`
+	ContainerID   string    `json:"containerId"`
+	ContainerName string    `json:"containerName"`
+	CreatedAt     time.Time `json:"createdAt"`
+	ID            string    `json:"id"`
+	Pushed        bool      `json:"pushed"`
+	Reference     string    `json:"reference"`
+	Size          int64     `json:"size"`
+	SourceImage   *string   `json:"sourceImage,omitempty"`
+}
+
+// ContainerState is generated from the OpenAPI specification.
+type ContainerState struct {
+	ExitCode   *int64  `json:"exitCode,omitempty"`
+	FinishedAt *string `json:"finishedAt,omitempty"`
+	Running    bool    `json:"running"`
+	StartedAt  *string `json:"startedAt,omitempty"`
+	Status     string  `json:"status"`
+}
+
+// ContainerStatusCounts is generated from the OpenAPI specification.
+type ContainerStatusCounts struct {
+	RunningContainers int64 `json:"runningContainers"`
+	StoppedContainers int64 `json:"stoppedContainers"`
+	TotalContainers   int64 `json:"totalContainers"`
+}
+
+// ContainerStatusCountsParams holds the query and header parameters of ContainerStatusCounts.
+type ContainerStatusCountsParams struct {
+	// Include internal containers
+	IncludeInternal *bool
+}
+
+func (p *ContainerStatusCountsParams) apply(query url.Values, header http.Header) {
+	setValue(query.Set, "includeInternal", p.IncludeInternal)
+}
+
+// ContainerStatusCountsResponse is generated from the OpenAPI specification.
+type ContainerStatusCountsResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema  *string               `json:"$schema,omitempty"`
+	Data    ContainerStatusCounts `json:"data"`
+	Success bool                  `json:"success"`
+}
+
+// ContainerSummary is generated from the OpenAPI specification.
+type ContainerSummary struct {
+	Command         string                   `json:"command"`
+	Created         int64                    `json:"created"`
+	HostConfig      ContainerHostConfig      `json:"hostConfig"`
+	ID              string                   `json:"id"`
+	Image           string                   `json:"image"`
+	ImageID         string                   `json:"imageId"`
+	Labels          map[string]string        `json:"labels"`
+	Mounts          []ContainerMount         `json:"mounts"`
+	Names           []string                 `json:"names"`
+	NetworkSettings ContainerNetworkSettings `json:"networkSettings"`
+	Ports           []ContainerPort          `json:"ports"`
+	State           string                   `json:"state"`
+	Status          string                   `json:"status"`
+	Tags            map[string]string        `json:"tags,omitempty"`
+	UpdateInfo      *ImageUpdateInfo         `json:"updateInfo,omitempty"`
+}
+
+// ContainerToDockerRunResponse is generated from the OpenAPI specification.
+type ContainerToDockerRunResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema           *string `json:"$schema,omitempty"`
+	DockerCompose    string  `json:"dockerCompose"`
+	DockerRunCommand string  `json:"dockerRunCommand"`
+	EnvVars          string  `json:"envVars"`
+	ServiceName      string  `json:"serviceName"`
+	Success          bool    `json:"success"`
+}
+
+// ContainerregistryContainerRegistry is generated from the OpenAPI specification.
+type ContainerregistryContainerRegistry struct {
+	CreatedAt         time.Time `json:"createdAt"`
+	Description       *string   `json:"description,omitempty"`
+	Enabled           bool      `json:"enabled"`
+	ID                string    `json:"id"`
+	Insecure          bool      `json:"insecure"`
+	SignatureIdentity *string   `json:"signatureIdentity,omitempty"`
+	SignatureIssuer   *string   `json:"signatureIssuer,omitempty"`
+	SignatureMode     *string   `json:"signatureMode,omitempty"`
+	SignatureTrust    *string   `json:"signatureTrust,omitempty"`
+	UpdatedAt         time.Time `json:"updatedAt"`
+	URL               string    `json:"url"`
+	Username          string    `json:"username"`
+}
+
+// ContainerregistryCredential is generated from the OpenAPI specification.
+type ContainerregistryCredential struct {
+	Enabled  bool   `json:"enabled"`
+	Token    string `json:"token"`
+	URL      string `json:"url"`
+	Username string `json:"username"`
+}
+
+// ContainerregistrySync is generated from the OpenAPI specification.
+type ContainerregistrySync struct {
+	CreatedAt         time.Time `json:"createdAt"`
+	Description       *string   `json:"description,omitempty"`
+	Enabled           bool      `json:"enabled"`
+	ID                string    `json:"id"`
+	Insecure          bool      `json:"insecure"`
+	SignatureIdentity *string   `json:"signatureIdentity,omitempty"`
+	SignatureIssuer   *string   `json:"signatureIssuer,omitempty"`
+	SignatureMode     *string   `json:"signatureMode,omitempty"`
+	SignatureTrust    *string   `json:"signatureTrust,omitempty"`
+	Token             string    `json:"token"`
+	UpdatedAt         time.Time `json:"updatedAt"`
+	URL               string    `json:"url"`
+	Username          string    `json:"username"`
+}
+
+// ContainerregistrySyncRequest is generated from the OpenAPI specification.
+type ContainerregistrySyncRequest struct {
+	// A URL to the JSON Schema for this object.
+	Schema     *string                 `json:"$schema,omitempty"`
+	Registries []ContainerregistrySync `json:"registries"`
+}
+
+// ContainertaskCreateTask is generated from the OpenAPI specification.
+type ContainertaskCreateTask struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Command run with /bin/sh -c inside the container
+	Command string `json:"command"`
+	// Name of the container the command runs in
+	ContainerName string `json:"containerName"`
+	// Whether the task runs on its schedule (default true)
+	Enabled *bool `json:"enabled,omitempty"`
+	// Display name of the task
+	Name string `json:"name"`
+	// Whether failed runs send a notification (default true)
+	NotifyOnFailure *bool `json:"notifyOnFailure,omitempty"`
+	// Cron expression with optional seconds field, e.g. '0 3 * * *'
+	Schedule string `json:"schedule"`
+	// Seconds before a run is considered timed out (default 300)
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty"`
+	// User the command runs as
+	User *string `json:"user,omitempty"`
+}
+
+// ContainertaskRun is generated from the OpenAPI specification.
+type ContainertaskRun struct {
+	// Duration of the run in milliseconds
+	DurationMs *int64 `json:"durationMs,omitempty"`
+	// Why the run failed, if it did not exit normally
+	Error *string `json:"error,omitempty"`
+	// Exit code of the command
+	ExitCode *int64 `json:"exitCode,omitempty"`
+	// End time of the run
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+	// Unique identifier of the run
+	ID string `json:"id"`
+	// Combined stdout and stderr, truncated to the last 64 KiB
+	Output string `json:"output"`
+	// Start time of the run
+	StartedAt time.Time `json:"startedAt"`
+	// Outcome of the run
+	Status string `json:"status"`
+	// Task that was run
+	TaskID string `json:"taskId"`
+	// What started the run
+	Trigger string `json:"trigger"`
+}
+
+// ContainertaskTask is generated from the OpenAPI specification.
+type ContainertaskTask struct {
+	// Command run with /bin/sh -c inside the container
+	Command string `json:"command"`
+	// Name of the container the command runs in
+	ContainerName string `json:"containerName"`
+	// Creation timestamp
+	CreatedAt time.Time `json:"createdAt"`
+	// Whether the task runs on its schedule
+	Enabled bool `json:"enabled"`
+	// Unique identifier of the task
+	ID string `json:"id"`
+	// Start time of the last run
+	LastRunAt *time.Time `json:"lastRunAt,omitempty"`
+	// Status of the last run
+	LastStatus *string `json:"lastStatus,omitempty"`
+	// Display name of the task
+	Name string `json:"name"`
+	// Time of the next scheduled run
+	NextRunAt *time.Time `json:"nextRunAt,omitempty"`
+	// Whether failed runs send a notification
+	NotifyOnFailure bool `json:"notifyOnFailure"`
+	// Cron expression with optional seconds field
+	Schedule string `json:"schedule"`
+	// Seconds before a run is considered timed out
+	TimeoutSeconds int64 `json:"timeoutSeconds"`
+	// Last update timestamp
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+	// User the command runs as, defaults to the container's user
+	User *string `json:"user,omitempty"`
+}
+
+// ContainertaskUpdateTask is generated from the OpenAPI specification.
+type ContainertaskUpdateTask struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Command run with /bin/sh -c inside the container
+	Command *string `json:"command,omitempty"`
+	// Name of the container the command runs in
+	ContainerName *string `json:"containerName,omitempty"`
+	// Whether the task runs on its schedule
+	Enabled *bool `json:"enabled,omitempty"`
+	// Display name of the task
+	Name *string `json:"name,omitempty"`
+	// Whether failed runs send a notification
+	NotifyOnFailure *bool `json:"notifyOnFailure,omitempty"`
+	// Cron expression with optional seconds field
+	Schedule *string `json:"schedule,omitempty"`
+	// Seconds before a run is considered timed out
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty"`
+	// User the command runs as, empty to use the container's user
+	User *string `json:"user,omitempty"`
+}
+
+// ConvertDockerRunRequest is generated from the OpenAPI specification.
+type ConvertDockerRunRequest struct {
+	// A URL to the JSON Schema for this object.
+	Schema           *string `json:"$schema,omitempty"`
+	DockerRunCommand string  `json:"dockerRunCommand"`
+}
+
+// ConvertDockerRunResponse is generated from the OpenAPI specification.
+type ConvertDockerRunResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema        *string `json:"$schema,omitempty"`
+	DockerCompose string  `json:"dockerCompose"`
+	EnvVars       string  `json:"envVars"`
+	ServiceName   string  `json:"serviceName"`
+	Success       bool    `json:"success"`
+}
+
+// CreateContainerRegistryRequest is generated from the OpenAPI specification.
+type CreateContainerRegistryRequest struct {
+	// A URL to the JSON Schema for this object.
+	Schema            *string `json:"$schema,omitempty"`
+	Description       *string `json:"description"`
+	Enabled           *bool   `json:"enabled"`
+	Insecure          *bool   `json:"insecure"`
+	SignatureIdentity *string `json:"signatureIdentity,omitempty"`
+	SignatureIssuer   *string `json:"signatureIssuer,omitempty"`
+	SignatureMode     *string `json:"signatureMode,omitempty"`
+	SignatureTrust    *string `json:"signatureTrust,omitempty"`
+	Token             string  `json:"token"`
+	URL               string  `json:"url"`
+	Username          string  `json:"username"`
+}
+
+// CreateEventParams holds the query and header parameters of CreateEvent.
+type CreateEventParams struct {
+	// API key for environment-scoped event forwarding
+	XAPIKey string
+}
+
+func (p *CreateEventParams) apply(query url.Values, header http.Header) {
+	setString(header.Set, "X-API-Key", p.XAPIKey)
+}
+
+// CreateGitRepositoryRequest is generated from the OpenAPI specification.
+type CreateGitRepositoryRequest struct {
+	// A URL to the JSON Schema for this object.
+	Schema                 *string `json:"$schema,omitempty"`
+	AuthType               string  `json:"authType"`
+	Description            *string `json:"description,omitempty"`
+	Enabled                *bool   `json:"enabled,omitempty"`
+	Name                   string  `json:"name"`
+	SSHHostKeyVerification *string `json:"sshHostKeyVerification,omitempty"`
+	SSHKey                 *string `json:"sshKey,omitempty"`
+	Token                  *string `json:"token,omitempty"`
+	URL                    string  `json:"url"`
+	Username               *string `json:"username,omitempty"`
+}
+
+// CreateProjectCustomFileParams holds the query and header parameters of CreateProjectCustomFile.
+type CreateProjectCustomFileParams struct {
+	// Edit the project even though it is managed by GitOps
+	OverrideGitOps *bool
+}
+
+func (p *CreateProjectCustomFileParams) apply(query url.Values, header http.Header) {
+	setValue(query.Set, "overrideGitOps", p.OverrideGitOps)
+}
+
+// CreateVolumeDirectoryParams holds the query and header parameters of CreateVolumeDirectory.
+type CreateVolumeDirectoryParams struct {
+	// Directory path to create
+	Path string
+}
+
+func (p *CreateVolumeDirectoryParams) apply(query url.Values, header http.Header) {
+	setString(query.Set, "path", p.Path)
+}
+
+// CredentialSpecConfig is generated from the OpenAPI specification.
+type CredentialSpecConfig struct {
+	Config   *string `json:"config,omitempty"`
+	File     *string `json:"file,omitempty"`
+	Registry *string `json:"registry,omitempty"`
+}
+
+// DashboardActionItem is generated from the OpenAPI specification.
+type DashboardActionItem struct {
+	Count    int64  `json:"count"`
+	Kind     string `json:"kind"`
+	Severity string `json:"severity"`
+}
+
+// DashboardActionItems is generated from the OpenAPI specification.
+type DashboardActionItems struct {
+	Items []DashboardActionItem `json:"items"`
+}
+
+// DashboardDiskUsageWidget is generated from the OpenAPI specification.
+type DashboardDiskUsageWidget struct {
+	Path        string  `json:"path"`
+	Total       int64   `json:"total"`
+	Used        int64   `json:"used"`
+	UsedPercent float64 `json:"usedPercent"`
+}
+
+// DashboardEnvironmentHealth is generated from the OpenAPI specification.
+type DashboardEnvironmentHealth struct {
+	ID       string     `json:"id"`
+	LastSeen *time.Time `json:"lastSeen,omitempty"`
+	Name     string     `json:"name"`
+	Status   string     `json:"status"`
+}
+
+// DashboardEnvironmentHealthWidget is generated from the OpenAPI specification.
+type DashboardEnvironmentHealthWidget struct {
+	Online    int64                        `json:"online"`
+	Total     int64                        `json:"total"`
+	Unhealthy []DashboardEnvironmentHealth `json:"unhealthy"`
+}
+
+// DashboardLayout is generated from the OpenAPI specification.
+type DashboardLayout struct {
+	Custom    bool              `json:"custom"`
+	UpdatedAt *time.Time        `json:"updatedAt,omitempty"`
+	Widgets   []DashboardWidget `json:"widgets"`
+}
+
+// DashboardPendingUpdate is generated from the OpenAPI specification.
+type DashboardPendingUpdate struct {
+	CurrentVersion *string `json:"currentVersion,omitempty"`
+	ImageID        string  `json:"imageId"`
+	LatestVersion  *string `json:"latestVersion,omitempty"`
+	Repository     string  `json:"repository"`
+	Tag            string  `json:"tag"`
+	UpdateType     *string `json:"updateType,omitempty"`
+}
+
+// DashboardRecentEventsWidget is generated from the OpenAPI specification.
+type DashboardRecentEventsWidget struct {
+	Events []EventEvent `json:"events"`
+}
+
+// DashboardUpdateLayout is generated from the OpenAPI specification.
+type DashboardUpdateLayout struct {
+	// A URL to the JSON Schema for this object.
+	Schema  *string           `json:"$schema,omitempty"`
+	Widgets []DashboardWidget `json:"widgets"`
+}
+
+// DashboardUpdatesPendingWidget is generated from the OpenAPI specification.
+type DashboardUpdatesPendingWidget struct {
+	Count  int64                    `json:"count"`
+	Images []DashboardPendingUpdate `json:"images"`
+}
+
+// DashboardVulnerableImage is generated from the OpenAPI specification.
+type DashboardVulnerableImage struct {
+	Critical  int64  `json:"critical"`
+	High      int64  `json:"high"`
+	ImageID   string `json:"imageId"`
+	ImageName string `json:"imageName"`
+}
+
+// DashboardVulnerableImagesWidget is generated from the OpenAPI specification.
+type DashboardVulnerableImagesWidget struct {
+	Count  int64                      `json:"count"`
+	Images []DashboardVulnerableImage `json:"images"`
+}
+
+// DashboardWidget is generated from the OpenAPI specification.
+type DashboardWidget struct {
+	Height int64  `json:"height"`
+	ID     string `json:"id"`
+	Kind   string `json:"kind"`
+	Width  int64  `json:"width"`
+	X      int64  `json:"x"`
+	Y      int64  `json:"y"`
+}
+
+// DashboardWidgetData is generated from the OpenAPI specification.
+type DashboardWidgetData struct {
+	ActionItems       *DashboardActionItems             `json:"actionItems,omitempty"`
+	DiskUsage         *DashboardDiskUsageWidget         `json:"diskUsage,omitempty"`
+	EnvironmentHealth *DashboardEnvironmentHealthWidget `json:"environmentHealth,omitempty"`
+	Errors            map[string]string                 `json:"errors,omitempty"`
+	RecentEvents      *DashboardRecentEventsWidget      `json:"recentEvents,omitempty"`
+	UpdatesPending    *DashboardUpdatesPendingWidget    `json:"updatesPending,omitempty"`
+	VulnerableImages  *DashboardVulnerableImagesWidget  `json:"vulnerableImages,omitempty"`
+}
+
+// DeleteContainerParams holds the query and header parameters of DeleteContainer.
+type DeleteContainerParams struct {
+	// Force delete running container
+	Force *bool
+	// Remove associated volumes
+	Volumes *bool
+}
+
+func (p *DeleteContainerParams) apply(query url.Values, header http.Header) {
+	setValue(query.Set, "force", p.Force)
+	setValue(query.Set, "volumes", p.Volumes)
+}
+
+// DeleteProjectFileParams holds the query and header parameters of DeleteProjectFile.
+type DeleteProjectFileParams struct {
+	// Delete the file even if the compose configuration references it
+	Force *bool
+	// Edit the project even though it is managed by GitOps
+	OverrideGitOps *bool
+	// File path relative to the project directory
+	Path string
+}
+
+func (p *DeleteProjectFileParams) apply(query url.Values, header http.Header) {
+	setValue(query.Set, "force", p.Force)
+	setValue(query.Set, "overrideGitOps", p.OverrideGitOps)
+	setString(query.Set, "path", p.Path)
+}
+
+// DeleteVolumeFileParams holds the query and header parameters of DeleteVolumeFile.
+type DeleteVolumeFileParams struct {
+	// File or directory path to delete
+	Path string
+}
+
+func (p *DeleteVolumeFileParams) apply(query url.Values, header http.Header) {
+	setString(query.Set, "path", p.Path)
+}
+
+// DeployConfig is generated from the OpenAPI specification.
+type DeployConfig struct {
+	EndpointMode   *string               `json:"endpoint_mode,omitempty"`
+	Labels         map[string]string     `json:"labels,omitempty"`
+	Mode           *string               `json:"mode,omitempty"`
+	Placement      *DockerTypesPlacement `json:"placement,omitempty"`
+	Replicas       *int64                `json:"replicas,omitempty"`
+	Resources      *DockerTypesResources `json:"resources,omitempty"`
+	RestartPolicy  *RestartPolicy        `json:"restart_policy,omitempty"`
+	RollbackConfig *UpdateConfig         `json:"rollback_config,omitempty"`
+	UpdateConfig   *UpdateConfig         `json:"update_config,omitempty"`
+}
+
+// DeploymentSnippet is generated from the OpenAPI specification.
+type DeploymentSnippet struct {
+	// Docker compose YAML snippet
+	DockerCompose string `json:"dockerCompose"`
+	// Docker run command snippet
+	DockerRun string `json:"dockerRun"`
+	// systemd unit running the agent container
+	SystemdUnit string `json:"systemdUnit"`
+}
+
+// DetailSummaryConfigStruct is generated from the OpenAPI specification.
+type DetailSummaryConfigStruct struct {
+	ArgsEscaped  *bool            `json:"argsEscaped,omitempty"`
+	Cmd          []string         `json:"cmd,omitempty"`
+	Env          []string         `json:"env,omitempty"`
+	ExposedPorts map[string]Value `json:"exposedPorts,omitempty"`
+	Volumes      map[string]Value `json:"volumes,omitempty"`
+	WorkingDir   *string          `json:"workingDir,omitempty"`
+}
+
+// DetailSummaryDescriptorStruct is generated from the OpenAPI specification.
+type DetailSummaryDescriptorStruct struct {
+	Digest    string `json:"digest"`
+	MediaType string `json:"mediaType"`
+	Size      int64  `json:"size"`
+}
+
+// DetailSummaryGraphDriverStruct is generated from the OpenAPI specification.
+type DetailSummaryGraphDriverStruct struct {
+	Data any    `json:"data"`
+	Name string `json:"name"`
+}
+
+// DetailSummaryMetadataStruct is generated from the OpenAPI specification.
+type DetailSummaryMetadataStruct struct {
+	LastTagTime string `json:"lastTagTime"`
+}
+
+// DetailSummaryRootFsStruct is generated from the OpenAPI specification.
+type DetailSummaryRootFsStruct struct {
+	Layers []string `json:"layers"`
+	Type   string   `json:"type"`
+}
+
+// DevelopConfig is generated from the OpenAPI specification.
+type DevelopConfig struct {
+	Watch []DockerTypesTrigger `json:"watch,omitempty"`
+}
+
+// DiscreteGenericResource is generated from the OpenAPI specification.
+type DiscreteGenericResource struct {
+	Kind  string `json:"kind"`
+	Value int64  `json:"value"`
+}
+
+// DockerBlkiodevThrottleDevice is generated from the OpenAPI specification.
+type DockerBlkiodevThrottleDevice struct {
+	Path string `json:"Path"`
+	Rate int64  `json:"Rate"`
+}
+
+// DockerBlkiodevWeightDevice is generated from the OpenAPI specification.
+type DockerBlkiodevWeightDevice struct {
+	Path   string `json:"Path"`
+	Weight int32  `json:"Weight"`
+}
+
+// DockerContainerConfig is generated from the OpenAPI specification.
+type DockerContainerConfig struct {
+	ArgsEscaped     *bool              `json:"ArgsEscaped,omitempty"`
+	AttachStderr    bool               `json:"AttachStderr"`
+	AttachStdin     bool               `json:"AttachStdin"`
+	AttachStdout    bool               `json:"AttachStdout"`
+	Cmd             []string           `json:"Cmd"`
+	Domainname      string             `json:"Domainname"`
+	Entrypoint      []string           `json:"Entrypoint"`
+	Env             []string           `json:"Env"`
+	ExposedPorts    map[string]Value   `json:"ExposedPorts,omitempty"`
+	Healthcheck     *HealthcheckConfig `json:"Healthcheck,omitempty"`
+	Hostname        string             `json:"Hostname"`
+	Image           string             `json:"Image"`
+	Labels          map[string]string  `json:"Labels"`
+	NetworkDisabled *bool              `json:"NetworkDisabled,omitempty"`
+	OnBuild         []string           `json:"OnBuild,omitempty"`
+	OpenStdin       bool               `json:"OpenStdin"`
+	Shell           []string           `json:"Shell,omitempty"`
+	StdinOnce       bool               `json:"StdinOnce"`
+	StopSignal      *string            `json:"StopSignal,omitempty"`
+	StopTimeout     *int64             `json:"StopTimeout,omitempty"`
+	Tty             bool               `json:"Tty"`
+	User            string             `json:"User"`
+	Volumes         map[string]Value   `json:"Volumes"`
+	WorkingDir      string             `json:"WorkingDir"`
+}
+
+// DockerContainerDeviceMapping is generated from the OpenAPI specification.
+type DockerContainerDeviceMapping struct {
+	CgroupPermissions string `json:"CgroupPermissions"`
+	PathInContainer   string `json:"PathInContainer"`
+	PathOnHost        string `json:"PathOnHost"`
+}
+
+// DockerContainerDeviceRequest is generated from the OpenAPI specification.
+type DockerContainerDeviceRequest struct {
+	Capabilities [][]string        `json:"Capabilities"`
+	Count        int64             `json:"Count"`
+	DeviceIDs    []string          `json:"DeviceIDs"`
+	Driver       string            `json:"Driver"`
+	Options      map[string]string `json:"Options"`
+}
+
+// DockerContainerHostConfig is generated from the OpenAPI specification.
+type DockerContainerHostConfig struct {
+	Annotations          map[string]string                     `json:"Annotations,omitempty"`
+	AutoRemove           bool                                  `json:"AutoRemove"`
+	Binds                []string                              `json:"Binds"`
+	BlkioDeviceReadBps   []DockerBlkiodevThrottleDevice        `json:"BlkioDeviceReadBps"`
+	BlkioDeviceReadIOps  []DockerBlkiodevThrottleDevice        `json:"BlkioDeviceReadIOps"`
+	BlkioDeviceWriteBps  []DockerBlkiodevThrottleDevice        `json:"BlkioDeviceWriteBps"`
+	BlkioDeviceWriteIOps []DockerBlkiodevThrottleDevice        `json:"BlkioDeviceWriteIOps"`
+	BlkioWeight          int32                                 `json:"BlkioWeight"`
+	BlkioWeightDevice    []DockerBlkiodevWeightDevice          `json:"BlkioWeightDevice"`
+	CapAdd               []string                              `json:"CapAdd"`
+	CapDrop              []string                              `json:"CapDrop"`
+	Cgroup               string                                `json:"Cgroup"`
+	CgroupParent         string                                `json:"CgroupParent"`
+	CgroupnsMode         string                                `json:"CgroupnsMode"`
+	ConsoleSize          []int64                               `json:"ConsoleSize"`
+	ContainerIDFile      string                                `json:"ContainerIDFile"`
+	CPUCount             int64                                 `json:"CpuCount"`
+	CPUPercent           int64                                 `json:"CpuPercent"`
+	CPUPeriod            int64                                 `json:"CpuPeriod"`
+	CPUQuota             int64                                 `json:"CpuQuota"`
+	CPURealtimePeriod    int64                                 `json:"CpuRealtimePeriod"`
+	CPURealtimeRuntime   int64                                 `json:"CpuRealtimeRuntime"`
+	CPUShares            int64                                 `json:"CpuShares"`
+	CpusetCpus           string                                `json:"CpusetCpus"`
+	CpusetMems           string                                `json:"CpusetMems"`
+	DeviceCgroupRules    []string                              `json:"DeviceCgroupRules"`
+	DeviceRequests       []DockerContainerDeviceRequest        `json:"DeviceRequests"`
+	Devices              []DockerContainerDeviceMapping        `json:"Devices"`
+	DNS                  []string                              `json:"Dns"`
+	DNSOptions           []string                              `json:"DnsOptions"`
+	DNSSearch            []string                              `json:"DnsSearch"`
+	ExtraHosts           []string                              `json:"ExtraHosts"`
+	GroupAdd             []string                              `json:"GroupAdd"`
+	IOMaximumBandwidth   int64                                 `json:"IOMaximumBandwidth"`
+	IOMaximumIOps        int64                                 `json:"IOMaximumIOps"`
+	Init                 *bool                                 `json:"Init,omitempty"`
+	IpcMode              string                                `json:"IpcMode"`
+	Isolation            string                                `json:"Isolation"`
+	Links                []string                              `json:"Links"`
+	LogConfig            DockerContainerLogConfig              `json:"LogConfig"`
+	MaskedPaths          []string                              `json:"MaskedPaths"`
+	Memory               int64                                 `json:"Memory"`
+	MemoryReservation    int64                                 `json:"MemoryReservation"`
+	MemorySwap           int64                                 `json:"MemorySwap"`
+	MemorySwappiness     *int64                                `json:"MemorySwappiness"`
+	Mounts               []DockerMountMount                    `json:"Mounts,omitempty"`
+	NanoCpus             int64                                 `json:"NanoCpus"`
+	NetworkMode          string                                `json:"NetworkMode"`
+	OomKillDisable       *bool                                 `json:"OomKillDisable"`
+	OomScoreAdj          int64                                 `json:"OomScoreAdj"`
+	PidMode              string                                `json:"PidMode"`
+	PidsLimit            *int64                                `json:"PidsLimit"`
+	PortBindings         map[string][]DockerNetworkPortBinding `json:"PortBindings"`
+	Privileged           bool                                  `json:"Privileged"`
+	PublishAllPorts      bool                                  `json:"PublishAllPorts"`
+	ReadonlyPaths        []string                              `json:"ReadonlyPaths"`
+	ReadonlyRootfs       bool                                  `json:"ReadonlyRootfs"`
+	RestartPolicy        DockerContainerRestartPolicy          `json:"RestartPolicy"`
+	Runtime              *string                               `json:"Runtime,omitempty"`
+	SecurityOpt          []string                              `json:"SecurityOpt"`
+	ShmSize              int64                                 `json:"ShmSize"`
+	StorageOpt           map[string]string                     `json:"StorageOpt,omitempty"`
+	Sysctls              map[string]string                     `json:"Sysctls,omitempty"`
+	Tmpfs                map[string]string                     `json:"Tmpfs,omitempty"`
+	UTSMode              string                                `json:"UTSMode"`
+	Ulimits              []Ulimit                              `json:"Ulimits"`
+	UsernsMode           string                                `json:"UsernsMode"`
+	VolumeDriver         string                                `json:"VolumeDriver"`
+	VolumesFrom          []string                              `json:"VolumesFrom"`
+}
+
+// DockerContainerLogConfig is generated from the OpenAPI specification.
+type DockerContainerLogConfig struct {
+	Config map[string]string `json:"Config"`
+	Type   string            `json:"Type"`
+}
+
+// DockerContainerRestartPolicy is generated from the OpenAPI specification.
+type DockerContainerRestartPolicy struct {
+	MaximumRetryCount int64  `json:"MaximumRetryCount"`
+	Name              string `json:"Name"`
+}
+
+// DockerMountBindOptions is generated from the OpenAPI specification.
+type DockerMountBindOptions struct {
+	CreateMountpoint       *bool   `json:"CreateMountpoint,omitempty"`
+	NonRecursive           *bool   `json:"NonRecursive,omitempty"`
+	Propagation            *string `json:"Propagation,omitempty"`
+	ReadOnlyForceRecursive *bool   `json:"ReadOnlyForceRecursive,omitempty"`
+	ReadOnlyNonRecursive   *bool   `json:"ReadOnlyNonRecursive,omitempty"`
+}
+
+// DockerMountClusterOptions is generated from the OpenAPI specification.
+type DockerMountClusterOptions = map[string]any
+
+// DockerMountDriver is generated from the OpenAPI specification.
+type DockerMountDriver struct {
+	Name    *string           `json:"Name,omitempty"`
+	Options map[string]string `json:"Options,omitempty"`
+}
+
+// DockerMountImageOptions is generated from the OpenAPI specification.
+type DockerMountImageOptions struct {
+	Subpath *string `json:"Subpath,omitempty"`
+}
+
+// DockerMountMount is generated from the OpenAPI specification.
+type DockerMountMount struct {
+	BindOptions    *DockerMountBindOptions   `json:"BindOptions,omitempty"`
+	ClusterOptions DockerMountClusterOptions `json:"ClusterOptions,omitempty"`
+	Consistency    *string                   `json:"Consistency,omitempty"`
+	ImageOptions   *DockerMountImageOptions  `json:"ImageOptions,omitempty"`
+	ReadOnly       *bool                     `json:"ReadOnly,omitempty"`
+	Source         *string                   `json:"Source,omitempty"`
+	Target         *string                   `json:"Target,omitempty"`
+	TmpfsOptions   *DockerMountTmpfsOptions  `json:"TmpfsOptions,omitempty"`
+	Type           *string                   `json:"Type,omitempty"`
+	VolumeOptions  *DockerMountVolumeOptions `json:"VolumeOptions,omitempty"`
+}
+
+// DockerMountTmpfsOptions is generated from the OpenAPI specification.
+type DockerMountTmpfsOptions struct {
+	Mode      *int32     `json:"Mode,omitempty"`
+	Options   [][]string `json:"Options,omitempty"`
+	SizeBytes *int64     `json:"SizeBytes,omitempty"`
+}
+
+// DockerMountVolumeOptions is generated from the OpenAPI specification.
+type DockerMountVolumeOptions struct {
+	DriverConfig *DockerMountDriver `json:"DriverConfig,omitempty"`
+	Labels       map[string]string  `json:"Labels,omitempty"`
+	NoCopy       *bool              `json:"NoCopy,omitempty"`
+	Subpath      *string            `json:"Subpath,omitempty"`
+}
+
+// DockerNetworkConfigReference is generated from the OpenAPI specification.
+type DockerNetworkConfigReference struct {
+	Network string `json:"Network"`
+}
+
+// DockerNetworkEndpointIPAMConfig is generated from the OpenAPI specification.
+type DockerNetworkEndpointIPAMConfig struct {
+	IPv4Address  *string  `json:"IPv4Address,omitempty"`
+	IPv6Address  *string  `json:"IPv6Address,omitempty"`
+	LinkLocalIPs []string `json:"LinkLocalIPs,omitempty"`
+}
+
+// DockerNetworkEndpointResource is generated from the OpenAPI specification.
+type DockerNetworkEndpointResource struct {
+	EndpointID  string `json:"EndpointID"`
+	IPv4Address string `json:"IPv4Address"`
+	IPv6Address string `json:"IPv6Address"`
+	MacAddress  string `json:"MacAddress"`
+	Name        string `json:"Name"`
+}
+
+// DockerNetworkEndpointSettings is generated from the OpenAPI specification.
+type DockerNetworkEndpointSettings struct {
+	Aliases             []string                        `json:"Aliases"`
+	DNSNames            []string                        `json:"DNSNames"`
+	DriverOpts          map[string]string               `json:"DriverOpts"`
+	EndpointID          string                          `json:"EndpointID"`
+	Gateway             string                          `json:"Gateway"`
+	GlobalIPv6Address   string                          `json:"GlobalIPv6Address"`
+	GlobalIPv6PrefixLen int64                           `json:"GlobalIPv6PrefixLen"`
+	GwPriority          int64                           `json:"GwPriority"`
+	IPAMConfig          DockerNetworkEndpointIPAMConfig `json:"IPAMConfig"`
+	IPAddress           string                          `json:"IPAddress"`
+	IPPrefixLen         int64                           `json:"IPPrefixLen"`
+	IPv6Gateway         string                          `json:"IPv6Gateway"`
+	Links               []string                        `json:"Links"`
+	MacAddress          string                          `json:"MacAddress"`
+	NetworkID           string                          `json:"NetworkID"`
+}
+
+// DockerNetworkIPAM is generated from the OpenAPI specification.
+type DockerNetworkIPAM struct {
+	Config  []DockerNetworkIPAMConfig `json:"Config"`
+	Driver  string                    `json:"Driver"`
+	Options map[string]string         `json:"Options"`
+}
+
+// DockerNetworkIPAMConfig is generated from the OpenAPI specification.
+type DockerNetworkIPAMConfig struct {
+	AuxiliaryAddresses map[string]string `json:"AuxiliaryAddresses,omitempty"`
+	Gateway            *string           `json:"Gateway,omitempty"`
+	IPRange            *string           `json:"IPRange,omitempty"`
+	Subnet             *string           `json:"Subnet,omitempty"`
+}
+
+// DockerNetworkNetworkingConfig is generated from the OpenAPI specification.
+type DockerNetworkNetworkingConfig struct {
+	EndpointsConfig map[string]DockerNetworkEndpointSettings `json:"EndpointsConfig"`
+}
+
+// DockerNetworkPeerInfo is generated from the OpenAPI specification.
+type DockerNetworkPeerInfo struct {
+	IP   string `json:"IP"`
+	Name string `json:"Name"`
+}
+
+// DockerNetworkPortBinding is generated from the OpenAPI specification.
+type DockerNetworkPortBinding struct {
+	HostIP   string `json:"HostIp"`
+	HostPort string `json:"HostPort"`
+}
+
+// DockerNetworkServiceInfo is generated from the OpenAPI specification.
+type DockerNetworkServiceInfo struct {
+	LocalLBIndex int64               `json:"LocalLBIndex"`
+	Ports        []string            `json:"Ports"`
+	Tasks        []DockerNetworkTask `json:"Tasks"`
+	VIP          string              `json:"VIP"`
+}
+
+// DockerNetworkTask is generated from the OpenAPI specification.
+type DockerNetworkTask struct {
+	EndpointID string            `json:"EndpointID"`
+	EndpointIP string            `json:"EndpointIP"`
+	Info       map[string]string `json:"Info"`
+	Name       string            `json:"Name"`
+}
+
+// DockerRegistryIndexInfo is generated from the OpenAPI specification.
+type DockerRegistryIndexInfo struct {
+	Mirrors  []string `json:"Mirrors"`
+	Name     string   `json:"Name"`
+	Official bool     `json:"Official"`
+	Secure   bool     `json:"Secure"`
+}
+
+// DockerRegistryServiceConfig is generated from the OpenAPI specification.
+type DockerRegistryServiceConfig struct {
+	IndexConfigs          map[string]DockerRegistryIndexInfo `json:"IndexConfigs"`
+	InsecureRegistryCIDRs []string                           `json:"InsecureRegistryCIDRs"`
+	Mirrors               []string                           `json:"Mirrors"`
+}
+
+// DockerSwarmCAConfig is generated from the OpenAPI specification.
+type DockerSwarmCAConfig struct {
+	ExternalCAs    []DockerSwarmExternalCA `json:"ExternalCAs,omitempty"`
+	ForceRotate    *int64                  `json:"ForceRotate,omitempty"`
+	NodeCertExpiry *int64                  `json:"NodeCertExpiry,omitempty"`
+	SigningCACert  *string                 `json:"SigningCACert,omitempty"`
+	SigningCAKey   *string                 `json:"SigningCAKey,omitempty"`
+}
+
+// DockerSwarmClusterInfo is generated from the OpenAPI specification.
+type DockerSwarmClusterInfo struct {
+	CreatedAt              *time.Time          `json:"CreatedAt,omitempty"`
+	DataPathPort           int32               `json:"DataPathPort"`
+	DefaultAddrPool        []string            `json:"DefaultAddrPool"`
+	ID                     string              `json:"ID"`
+	RootRotationInProgress bool                `json:"RootRotationInProgress"`
+	Spec                   DockerSwarmSpec     `json:"Spec"`
+	SubnetSize             int32               `json:"SubnetSize"`
+	TLSInfo                DockerSwarmTLSInfo  `json:"TLSInfo"`
+	UpdatedAt              *time.Time          `json:"UpdatedAt,omitempty"`
+	Version                *DockerSwarmVersion `json:"Version,omitempty"`
+}
+
+// DockerSwarmDiscreteGenericResource is generated from the OpenAPI specification.
+type DockerSwarmDiscreteGenericResource struct {
+	Kind  *string `json:"Kind,omitempty"`
+	Value *int64  `json:"Value,omitempty"`
+}
+
+// DockerSwarmDispatcherConfig is generated from the OpenAPI specification.
+type DockerSwarmDispatcherConfig struct {
+	HeartbeatPeriod *int64 `json:"HeartbeatPeriod,omitempty"`
+}
+
+// DockerSwarmDriver is generated from the OpenAPI specification.
+type DockerSwarmDriver struct {
+	Name    *string           `json:"Name,omitempty"`
+	Options map[string]string `json:"Options,omitempty"`
+}
+
+// DockerSwarmEncryptionConfig is generated from the OpenAPI specification.
+type DockerSwarmEncryptionConfig struct {
+	AutoLockManagers bool `json:"AutoLockManagers"`
+}
+
+// DockerSwarmExternalCA is generated from the OpenAPI specification.
+type DockerSwarmExternalCA struct {
+	CACert   string            `json:"CACert"`
+	Options  map[string]string `json:"Options,omitempty"`
+	Protocol string            `json:"Protocol"`
+	URL      string            `json:"URL"`
+}
+
+// DockerSwarmGenericResource is generated from the OpenAPI specification.
+type DockerSwarmGenericResource struct {
+	DiscreteResourceSpec *DockerSwarmDiscreteGenericResource `json:"DiscreteResourceSpec,omitempty"`
+	NamedResourceSpec    *DockerSwarmNamedGenericResource    `json:"NamedResourceSpec,omitempty"`
+}
+
+// DockerSwarmInfo is generated from the OpenAPI specification.
+type DockerSwarmInfo struct {
+	Cluster          *DockerSwarmClusterInfo `json:"Cluster,omitempty"`
+	ControlAvailable bool                    `json:"ControlAvailable"`
+	Error            string                  `json:"Error"`
+	LocalNodeState   string                  `json:"LocalNodeState"`
+	Managers         *int64                  `json:"Managers,omitempty"`
+	NodeAddr         string                  `json:"NodeAddr"`
+	NodeID           string                  `json:"NodeID"`
+	Nodes            *int64                  `json:"Nodes,omitempty"`
+	RemoteManagers   []DockerSwarmPeer       `json:"RemoteManagers"`
+	Warnings         []string                `json:"Warnings,omitempty"`
+}
+
+// DockerSwarmNamedGenericResource is generated from the OpenAPI specification.
+type DockerSwarmNamedGenericResource struct {
+	Kind  *string `json:"Kind,omitempty"`
+	Value *string `json:"Value,omitempty"`
+}
+
+// DockerSwarmOrchestrationConfig is generated from the OpenAPI specification.
+type DockerSwarmOrchestrationConfig struct {
+	TaskHistoryRetentionLimit *int64 `json:"TaskHistoryRetentionLimit,omitempty"`
+}
+
+// DockerSwarmPeer is generated from the OpenAPI specification.
+type DockerSwarmPeer struct {
+	Addr   string `json:"Addr"`
+	NodeID string `json:"NodeID"`
+}
+
+// DockerSwarmRaftConfig is generated from the OpenAPI specification.
+type DockerSwarmRaftConfig struct {
+	ElectionTick               int64  `json:"ElectionTick"`
+	HeartbeatTick              int64  `json:"HeartbeatTick"`
+	KeepOldSnapshots           *int64 `json:"KeepOldSnapshots,omitempty"`
+	LogEntriesForSlowFollowers *int64 `json:"LogEntriesForSlowFollowers,omitempty"`
+	SnapshotInterval           *int64 `json:"SnapshotInterval,omitempty"`
+}
+
+// DockerSwarmSpec is generated from the OpenAPI specification.
+type DockerSwarmSpec struct {
+	CAConfig         *DockerSwarmCAConfig            `json:"CAConfig,omitempty"`
+	Dispatcher       *DockerSwarmDispatcherConfig    `json:"Dispatcher,omitempty"`
+	EncryptionConfig *DockerSwarmEncryptionConfig    `json:"EncryptionConfig,omitempty"`
+	Labels           map[string]string               `json:"Labels"`
+	Name             *string                         `json:"Name,omitempty"`
+	Orchestration    *DockerSwarmOrchestrationConfig `json:"Orchestration,omitempty"`
+	Raft             *DockerSwarmRaftConfig          `json:"Raft,omitempty"`
+	TaskDefaults     *DockerSwarmTaskDefaults        `json:"TaskDefaults,omitempty"`
+}
+
+// DockerSwarmTLSInfo is generated from the OpenAPI specification.
+type DockerSwarmTLSInfo struct {
+	CertIssuerPublicKey *string `json:"CertIssuerPublicKey,omitempty"`
+	CertIssuerSubject   *string `json:"CertIssuerSubject,omitempty"`
+	TrustRoot           *string `json:"TrustRoot,omitempty"`
+}
+
+// DockerSwarmTaskDefaults is generated from the OpenAPI specification.
+type DockerSwarmTaskDefaults struct {
+	LogDriver *DockerSwarmDriver `json:"LogDriver,omitempty"`
+}
+
+// DockerSwarmVersion is generated from the OpenAPI specification.
+type DockerSwarmVersion struct {
+	Index *int64 `json:"Index,omitempty"`
+}
+
+// DockerSystemCommit is generated from the OpenAPI specification.
+type DockerSystemCommit struct {
+	ID string `json:"ID"`
+}
+
+// DockerSystemContainerdInfo is generated from the OpenAPI specification.
+type DockerSystemContainerdInfo struct {
+	Address    *string                          `json:"Address,omitempty"`
+	Namespaces DockerSystemContainerdNamespaces `json:"Namespaces"`
+}
+
+// DockerSystemContainerdNamespaces is generated from the OpenAPI specification.
+type DockerSystemContainerdNamespaces struct {
+	Containers string `json:"Containers"`
+	Plugins    string `json:"Plugins"`
+}
+
+// DockerSystemDeviceInfo is generated from the OpenAPI specification.
+type DockerSystemDeviceInfo struct {
+	ID     string `json:"ID"`
+	Source string `json:"Source"`
+}
+
+// DockerSystemFirewallInfo is generated from the OpenAPI specification.
+type DockerSystemFirewallInfo struct {
+	Driver string     `json:"Driver"`
+	Info   [][]string `json:"Info,omitempty"`
+}
+
+// DockerSystemNRIInfo is generated from the OpenAPI specification.
+type DockerSystemNRIInfo struct {
+	Info [][]string `json:"Info,omitempty"`
+}
+
+// DockerSystemNetworkAddressPool is generated from the OpenAPI specification.
+type DockerSystemNetworkAddressPool struct {
+	Base string `json:"Base"`
+	Size int64  `json:"Size"`
+}
+
+// DockerSystemPluginsInfo is generated from the OpenAPI specification.
+type DockerSystemPluginsInfo struct {
+	Authorization []string `json:"Authorization"`
+	Log           []string `json:"Log"`
+	Network       []string `json:"Network"`
+	Volume        []string `json:"Volume"`
+}
+
+// DockerSystemRuntimeWithStatus is generated from the OpenAPI specification.
+type DockerSystemRuntimeWithStatus struct {
+	Options     map[string]any    `json:"options,omitempty"`
+	Path        *string           `json:"path,omitempty"`
+	RuntimeArgs []string          `json:"runtimeArgs,omitempty"`
+	RuntimeType *string           `json:"runtimeType,omitempty"`
+	Status      map[string]string `json:"status,omitempty"`
+}
+
+// DockerTypesDeviceMapping is generated from the OpenAPI specification.
+type DockerTypesDeviceMapping struct {
+	Permissions *string `json:"permissions,omitempty"`
+	Source      *string `json:"source,omitempty"`
+	Target      *string `json:"target,omitempty"`
+}
+
+// DockerTypesDeviceRequest is generated from the OpenAPI specification.
+type DockerTypesDeviceRequest struct {
+	Capabilities []string          `json:"capabilities,omitempty"`
+	Count        *int64            `json:"count,omitempty"`
+	DeviceIds    []string          `json:"device_ids,omitempty"`
+	Driver       *string           `json:"driver,omitempty"`
+	Options      map[string]string `json:"options,omitempty"`
+}
+
+// DockerTypesEnvFile is generated from the OpenAPI specification.
+type DockerTypesEnvFile struct {
+	Format   *string `json:"format,omitempty"`
+	Path     *string `json:"path,omitempty"`
+	Required *bool   `json:"required,omitempty"`
+}
+
+// DockerTypesGenericResource is generated from the OpenAPI specification.
+type DockerTypesGenericResource struct {
+	DiscreteResourceSpec *DiscreteGenericResource `json:"discrete_resource_spec,omitempty"`
+}
+
+// DockerTypesPlacement is generated from the OpenAPI specification.
+type DockerTypesPlacement struct {
+	Constraints        []string                          `json:"constraints,omitempty"`
+	MaxReplicasPerNode *int64                            `json:"max_replicas_per_node,omitempty"`
+	Preferences        []DockerTypesPlacementPreferences `json:"preferences,omitempty"`
+}
+
+// DockerTypesPlacementPreferences is generated from the OpenAPI specification.
+type DockerTypesPlacementPreferences struct {
+	Spread *string `json:"spread,omitempty"`
+}
+
+// DockerTypesResources is generated from the OpenAPI specification.
+type DockerTypesResources struct {
+	Limits       *Resource `json:"limits,omitempty"`
+	Reservations *Resource `json:"reservations,omitempty"`
+}
+
+// DockerTypesSSHKey is generated from the OpenAPI specification.
+type DockerTypesSSHKey struct {
+	ID   *string `json:"id,omitempty"`
+	Path *string `json:"path,omitempty"`
+}
+
+// DockerTypesServiceConfig is generated from the OpenAPI specification.
+type DockerTypesServiceConfig struct {
+	Annotations       map[string]string                       `json:"annotations,omitempty"`
+	Attach            *bool                                   `json:"attach,omitempty"`
+	BlkioConfig       *BlkioConfig                            `json:"blkio_config,omitempty"`
+	Build             *BuildConfig                            `json:"build,omitempty"`
+	CapAdd            []string                                `json:"cap_add,omitempty"`
+	CapDrop           []string                                `json:"cap_drop,omitempty"`
+	Cgroup            *string                                 `json:"cgroup,omitempty"`
+	CgroupParent      *string                                 `json:"cgroup_parent,omitempty"`
+	Command           []string                                `json:"command"`
+	Configs           []DockerTypesServiceConfigObjConfig     `json:"configs,omitempty"`
+	ContainerName     *string                                 `json:"container_name,omitempty"`
+	CPUCount          *int64                                  `json:"cpu_count,omitempty"`
+	CPUPercent        *float64                                `json:"cpu_percent,omitempty"`
+	CPUPeriod         *int64                                  `json:"cpu_period,omitempty"`
+	CPUQuota          *int64                                  `json:"cpu_quota,omitempty"`
+	CPURtPeriod       *int64                                  `json:"cpu_rt_period,omitempty"`
+	CPURtRuntime      *int64                                  `json:"cpu_rt_runtime,omitempty"`
+	CPUShares         *int64                                  `json:"cpu_shares,omitempty"`
+	Cpus              *float64                                `json:"cpus,omitempty"`
+	Cpuset            *string                                 `json:"cpuset,omitempty"`
+	CredentialSpec    *CredentialSpecConfig                   `json:"credential_spec,omitempty"`
+	DependsOn         map[string]DockerTypesServiceDependency `json:"depends_on,omitempty"`
+	Deploy            *DeployConfig                           `json:"deploy,omitempty"`
+	Develop           *DevelopConfig                          `json:"develop,omitempty"`
+	DeviceCgroupRules []string                                `json:"device_cgroup_rules,omitempty"`
+	Devices           []DockerTypesDeviceMapping              `json:"devices,omitempty"`
+	DNS               []string                                `json:"dns,omitempty"`
+	DNSOpt            []string                                `json:"dns_opt,omitempty"`
+	DNSSearch         []string                                `json:"dns_search,omitempty"`
+	Dockerfile        *string                                 `json:"dockerfile,omitempty"`
+	Domainname        *string                                 `json:"domainname,omitempty"`
+	Entrypoint        []string                                `json:"entrypoint"`
+	EnvFile           []DockerTypesEnvFile                    `json:"env_file,omitempty"`
+	Environment       map[string]*string                      `json:"environment,omitempty"`
+	Expose            []string                                `json:"expose,omitempty"`
+	Extends           *ExtendsConfig                          `json:"extends,omitempty"`
+	ExternalLinks     []string                                `json:"external_links,omitempty"`
+	ExtraHosts        map[string][]string                     `json:"extra_hosts,omitempty"`
+	Gpus              []DockerTypesDeviceRequest              `json:"gpus,omitempty"`
+	GroupAdd          []string                                `json:"group_add,omitempty"`
+	Healthcheck       *HealthCheckConfig                      `json:"healthcheck,omitempty"`
+	Hostname          *string                                 `json:"hostname,omitempty"`
+	Image             *string                                 `json:"image,omitempty"`
+	Init              *bool                                   `json:"init,omitempty"`
+	Ipc               *string                                 `json:"ipc,omitempty"`
+	Isolation         *string                                 `json:"isolation,omitempty"`
+	LabelFile         []string                                `json:"label_file,omitempty"`
+	Labels            map[string]string                       `json:"labels,omitempty"`
+	Links             []string                                `json:"links,omitempty"`
+	LogDriver         *string                                 `json:"log_driver,omitempty"`
+	LogOpt            map[string]string                       `json:"log_opt,omitempty"`
+	Logging           *LoggingConfig                          `json:"logging,omitempty"`
+	MacAddress        *string                                 `json:"mac_address,omitempty"`
+	MemLimit          *int64                                  `json:"mem_limit,omitempty"`
+	MemReservation    *int64                                  `json:"mem_reservation,omitempty"`
+	MemSwappiness     *int64                                  `json:"mem_swappiness,omitempty"`
+	MemswapLimit      *int64                                  `json:"memswap_limit,omitempty"`
+	Models            map[string]ServiceModelConfig           `json:"models,omitempty"`
+	Net               *string                                 `json:"net,omitempty"`
+	NetworkMode       *string                                 `json:"network_mode,omitempty"`
+	Networks          map[string]ServiceNetworkConfig         `json:"networks,omitempty"`
+	OomKillDisable    *bool                                   `json:"oom_kill_disable,omitempty"`
+	OomScoreAdj       *int64                                  `json:"oom_score_adj,omitempty"`
+	Pid               *string                                 `json:"pid,omitempty"`
+	PidsLimit         *int64                                  `json:"pids_limit,omitempty"`
+	Platform          *string                                 `json:"platform,omitempty"`
+	Ports             []DockerTypesServicePortConfig          `json:"ports,omitempty"`
+	PostStart         []DockerTypesServiceHook                `json:"post_start,omitempty"`
+	PreStop           []DockerTypesServiceHook                `json:"pre_stop,omitempty"`
+	Privileged        *bool                                   `json:"privileged,omitempty"`
+	Profiles          []string                                `json:"profiles,omitempty"`
+	Provider          *ServiceProviderConfig                  `json:"provider,omitempty"`
+	PullPolicy        *string                                 `json:"pull_policy,omitempty"`
+	ReadOnly          *bool                                   `json:"read_only,omitempty"`
+	Restart           *string                                 `json:"restart,omitempty"`
+	Runtime           *string                                 `json:"runtime,omitempty"`
+	Scale             *int64                                  `json:"scale,omitempty"`
+	Secrets           []DockerTypesServiceSecretConfig        `json:"secrets,omitempty"`
+	SecurityOpt       []string                                `json:"security_opt,omitempty"`
+	ShmSize           *int64                                  `json:"shm_size,omitempty"`
+	StdinOpen         *bool                                   `json:"stdin_open,omitempty"`
+	StopGracePeriod   *int64                                  `json:"stop_grace_period,omitempty"`
+	StopSignal        *string                                 `json:"stop_signal,omitempty"`
+	StorageOpt        map[string]string                       `json:"storage_opt,omitempty"`
+	Sysctls           map[string]string                       `json:"sysctls,omitempty"`
+	Tmpfs             []string                                `json:"tmpfs,omitempty"`
+	Tty               *bool                                   `json:"tty,omitempty"`
+	Ulimits           map[string]UlimitsConfig                `json:"ulimits,omitempty"`
+	UseAPISocket      *bool                                   `json:"use_api_socket,omitempty"`
+	User              *string                                 `json:"user,omitempty"`
+	UsernsMode        *string                                 `json:"userns_mode,omitempty"`
+	Uts               *string                                 `json:"uts,omitempty"`
+	VolumeDriver      *string                                 `json:"volume_driver,omitempty"`
+	Volumes           []DockerTypesServiceVolumeConfig        `json:"volumes,omitempty"`
+	VolumesFrom       []string                                `json:"volumes_from,omitempty"`
+	WorkingDir        *string                                 `json:"working_dir,omitempty"`
+}
+
+// DockerTypesServiceConfigObjConfig is generated from the OpenAPI specification.
+type DockerTypesServiceConfigObjConfig struct {
+	Gid    *string `json:"gid,omitempty"`
+	Mode   *int64  `json:"mode,omitempty"`
+	Source *string `json:"source,omitempty"`
+	Target *string `json:"target,omitempty"`
+	Uid    *string `json:"uid,omitempty"`
+}
+
+// DockerTypesServiceDependency is generated from the OpenAPI specification.
+type DockerTypesServiceDependency struct {
+	Condition *string `json:"condition,omitempty"`
+	Required  bool    `json:"required"`
+	Restart   *bool   `json:"restart,omitempty"`
+}
+
+// DockerTypesServiceHook is generated from the OpenAPI specification.
+type DockerTypesServiceHook struct {
+	Command     []string           `json:"command"`
+	Environment map[string]*string `json:"environment,omitempty"`
+	Privileged  *bool              `json:"privileged,omitempty"`
+	User        *string            `json:"user,omitempty"`
+	WorkingDir  *string            `json:"working_dir,omitempty"`
+}
+
+// DockerTypesServicePortConfig is generated from the OpenAPI specification.
+type DockerTypesServicePortConfig struct {
+	AppProtocol *string `json:"app_protocol,omitempty"`
+	HostIP      *string `json:"host_ip,omitempty"`
+	Mode        *string `json:"mode,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	Protocol    *string `json:"protocol,omitempty"`
+	Published   *string `json:"published,omitempty"`
+	Target      *int32  `json:"target,omitempty"`
+}
+
+// DockerTypesServiceSecretConfig is generated from the OpenAPI specification.
+type DockerTypesServiceSecretConfig struct {
+	Gid    *string `json:"gid,omitempty"`
+	Mode   *int64  `json:"mode,omitempty"`
+	Source *string `json:"source,omitempty"`
+	Target *string `json:"target,omitempty"`
+	Uid    *string `json:"uid,omitempty"`
+}
+
+// DockerTypesServiceVolumeConfig is generated from the OpenAPI specification.
+type DockerTypesServiceVolumeConfig struct {
+	Bind        *ServiceVolumeBind   `json:"bind,omitempty"`
+	Consistency *string              `json:"consistency,omitempty"`
+	Image       *ServiceVolumeImage  `json:"image,omitempty"`
+	ReadOnly    *bool                `json:"read_only,omitempty"`
+	Source      *string              `json:"source,omitempty"`
+	Target      *string              `json:"target,omitempty"`
+	Tmpfs       *ServiceVolumeTmpfs  `json:"tmpfs,omitempty"`
+	Type        *string              `json:"type,omitempty"`
+	Volume      *ServiceVolumeVolume `json:"volume,omitempty"`
+}
+
+// DockerTypesThrottleDevice is generated from the OpenAPI specification.
+type DockerTypesThrottleDevice struct {
+	Path string `json:"Path"`
+	Rate int64  `json:"Rate"`
+}
+
+// DockerTypesTrigger is generated from the OpenAPI specification.
+type DockerTypesTrigger struct {
+	Action      string                  `json:"action"`
+	Exec        *DockerTypesServiceHook `json:"exec,omitempty"`
+	Ignore      []string                `json:"ignore,omitempty"`
+	Include     []string                `json:"include,omitempty"`
+	InitialSync *bool                   `json:"initial_sync,omitempty"`
+	Path        string                  `json:"path"`
+	Target      *string                 `json:"target,omitempty"`
+}
+
+// DockerTypesWeightDevice is generated from the OpenAPI specification.
+type DockerTypesWeightDevice struct {
+	Path   string `json:"Path"`
+	Weight int32  `json:"Weight"`
+}
+
+// DockerVolumeUsageData is generated from the OpenAPI specification.
+type DockerVolumeUsageData struct {
+	RefCount int64 `json:"RefCount"`
+	Size     int64 `json:"Size"`
+}
+
+// DockerinfoDaemonConfig is generated from the OpenAPI specification.
+type DockerinfoDaemonConfig struct {
+	CgroupDriver       string                 `json:"cgroupDriver"`
+	CgroupVersion      string                 `json:"cgroupVersion"`
+	DaemonJSON         *string                `json:"daemonJson,omitempty"`
+	DaemonJSONPath     string                 `json:"daemonJsonPath"`
+	DockerRootDir      string                 `json:"dockerRootDir"`
+	Editable           bool                   `json:"editable"`
+	InsecureRegistries []string               `json:"insecureRegistries"`
+	LiveRestoreEnabled bool                   `json:"liveRestoreEnabled"`
+	LoggingDriver      string                 `json:"loggingDriver"`
+	RegistryMirrors    []string               `json:"registryMirrors"`
+	Risks              []DockerinfoDaemonRisk `json:"risks"`
+	ServerVersion      string                 `json:"serverVersion"`
+	StorageDriver      string                 `json:"storageDriver"`
+}
+
+// DockerinfoDaemonRisk is generated from the OpenAPI specification.
+type DockerinfoDaemonRisk struct {
+	ID          string  `json:"id"`
+	Message     string  `json:"message"`
+	Remediation *string `json:"remediation,omitempty"`
+	Severity    string  `json:"severity"`
+}
+
+// DockerinfoInfo is generated from the OpenAPI specification.
+type DockerinfoInfo struct {
+	// A URL to the JSON Schema for this object.
+	Schema              *string                                  `json:"$schema,omitempty"`
+	Architecture        string                                   `json:"Architecture"`
+	CDISpecDirs         []string                                 `json:"CDISpecDirs"`
+	CPUSet              bool                                     `json:"CPUSet"`
+	CPUShares           bool                                     `json:"CPUShares"`
+	CgroupDriver        string                                   `json:"CgroupDriver"`
+	CgroupVersion       *string                                  `json:"CgroupVersion,omitempty"`
+	Containerd          *DockerSystemContainerdInfo              `json:"Containerd,omitempty"`
+	ContainerdCommit    DockerSystemCommit                       `json:"ContainerdCommit"`
+	Containers          int64                                    `json:"Containers"`
+	ContainersPaused    int64                                    `json:"ContainersPaused"`
+	ContainersRunning   int64                                    `json:"ContainersRunning"`
+	ContainersStopped   int64                                    `json:"ContainersStopped"`
+	CPUCfsPeriod        bool                                     `json:"CpuCfsPeriod"`
+	CPUCfsQuota         bool                                     `json:"CpuCfsQuota"`
+	Debug               bool                                     `json:"Debug"`
+	DefaultAddressPools []DockerSystemNetworkAddressPool         `json:"DefaultAddressPools,omitempty"`
+	DefaultRuntime      string                                   `json:"DefaultRuntime"`
+	DiscoveredDevices   []DockerSystemDeviceInfo                 `json:"DiscoveredDevices,omitempty"`
+	DockerRootDir       string                                   `json:"DockerRootDir"`
+	Driver              string                                   `json:"Driver"`
+	DriverStatus        [][]string                               `json:"DriverStatus"`
+	ExperimentalBuild   bool                                     `json:"ExperimentalBuild"`
+	FirewallBackend     *DockerSystemFirewallInfo                `json:"FirewallBackend,omitempty"`
+	GenericResources    []DockerSwarmGenericResource             `json:"GenericResources"`
+	HTTPProxy           string                                   `json:"HttpProxy"`
+	HTTPSProxy          string                                   `json:"HttpsProxy"`
+	ID                  string                                   `json:"ID"`
+	IPv4Forwarding      bool                                     `json:"IPv4Forwarding"`
+	Images              int64                                    `json:"Images"`
+	IndexServerAddress  string                                   `json:"IndexServerAddress"`
+	InitBinary          string                                   `json:"InitBinary"`
+	InitCommit          DockerSystemCommit                       `json:"InitCommit"`
+	Isolation           string                                   `json:"Isolation"`
+	KernelVersion       string                                   `json:"KernelVersion"`
+	Labels              []string                                 `json:"Labels"`
+	LiveRestoreEnabled  bool                                     `json:"LiveRestoreEnabled"`
+	LoggingDriver       string                                   `json:"LoggingDriver"`
+	MemTotal            int64                                    `json:"MemTotal"`
+	MemoryLimit         bool                                     `json:"MemoryLimit"`
+	NCPU                int64                                    `json:"NCPU"`
+	NEventsListener     int64                                    `json:"NEventsListener"`
+	NFd                 int64                                    `json:"NFd"`
+	NGoroutines         int64                                    `json:"NGoroutines"`
+	NRI                 *DockerSystemNRIInfo                     `json:"NRI,omitempty"`
+	Name                string                                   `json:"Name"`
+	NoProxy             string                                   `json:"NoProxy"`
+	OSType              string                                   `json:"OSType"`
+	OSVersion           string                                   `json:"OSVersion"`
+	OomKillDisable      bool                                     `json:"OomKillDisable"`
+	OperatingSystem     string                                   `json:"OperatingSystem"`
+	PidsLimit           bool                                     `json:"PidsLimit"`
+	Plugins             DockerSystemPluginsInfo                  `json:"Plugins"`
+	ProductLicense      *string                                  `json:"ProductLicense,omitempty"`
+	RegistryConfig      DockerRegistryServiceConfig              `json:"RegistryConfig"`
+	RuncCommit          DockerSystemCommit                       `json:"RuncCommit"`
+	Runtimes            map[string]DockerSystemRuntimeWithStatus `json:"Runtimes"`
+	SecurityOptions     []string                                 `json:"SecurityOptions"`
+	ServerVersion       string                                   `json:"ServerVersion"`
+	SwapLimit           bool                                     `json:"SwapLimit"`
+	Swarm               DockerSwarmInfo                          `json:"Swarm"`
+	SystemStatus        [][]string                               `json:"SystemStatus,omitempty"`
+	SystemTime          string                                   `json:"SystemTime"`
+	Warnings            []string                                 `json:"Warnings"`
+	APIVersion          string                                   `json:"apiVersion"`
+	Arch                string                                   `json:"arch"`
+	BuildTime           string                                   `json:"buildTime"`
+	GitCommit           string                                   `json:"gitCommit"`
+	GoVersion           string                                   `json:"goVersion"`
+	Os                  string                                   `json:"os"`
+	Success             bool                                     `json:"success"`
+}
+
+// DockerinfoUpdateDaemonJSONRequest is generated from the OpenAPI specification.
+type DockerinfoUpdateDaemonJSONRequest struct {
+	// A URL to the JSON Schema for this object.
+	Schema  *string `json:"$schema,omitempty"`
+	Content string  `json:"content"`
+	DryRun  *bool   `json:"dryRun,omitempty"`
+}
+
+// DockerinfoUpdateDaemonJSONResult is generated from the OpenAPI specification.
+type DockerinfoUpdateDaemonJSONResult struct {
+	ApplyCommand   *string  `json:"applyCommand,omitempty"`
+	BackupPath     *string  `json:"backupPath,omitempty"`
+	ChangedKeys    []string `json:"changedKeys"`
+	ReloadableKeys []string `json:"reloadableKeys"`
+	RestartKeys    []string `json:"restartKeys"`
+	Written        bool     `json:"written"`
+}
+
+// DownloadContainerLogsParams holds the query and header parameters of DownloadContainerLogs.
+type DownloadContainerLogsParams struct {
+	// Match q case sensitively
+	CaseSensitive *bool
+	// Only return lines containing this text
+	Q string
+	// Treat q as a regular expression
+	Regex *bool
+	// Only return lines written at or after this time (RFC 3339)
+	Since string
+	// Output stream to include
+	Stream string
+	// Only return lines written before this time (RFC 3339)
+	Until string
+}
+
+func (p *DownloadContainerLogsParams) apply(query url.Values, header http.Header) {
+	setValue(query.Set, "caseSensitive", p.CaseSensitive)
+	setString(query.Set, "q", p.Q)
+	setValue(query.Set, "regex", p.Regex)
+	setString(query.Set, "since", p.Since)
+	setString(query.Set, "stream", p.Stream)
+	setString(query.Set, "until", p.Until)
+}
+
+// DownloadVolumeFileParams holds the query and header parameters of DownloadVolumeFile.
+type DownloadVolumeFileParams struct {
+	// File path
+	Path string
+}
+
+func (p *DownloadVolumeFileParams) apply(query url.Values, header http.Header) {
+	setString(query.Set, "path", p.Path)
+}
+
+// EditContainerParams holds the query and header parameters of EditContainer.
+type EditContainerParams struct {
+	// Only return the diff, without recreating the container
+	DryRun *bool
+}
+
+func (p *EditContainerParams) apply(query url.Values, header http.Header) {
+	setValue(query.Set, "dryRun", p.DryRun)
+}
+
+// EnvSummary is generated from the OpenAPI specification.
+type EnvSummary struct {
+	// A URL to the JSON Schema for this object.
+	Schema    *string       `json:"$schema,omitempty"`
+	Variables []EnvVariable `json:"variables"`
+}
+
+// EnvVariable is generated from the OpenAPI specification.
+type EnvVariable struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// EnvironmentAgentPairRequest is generated from the OpenAPI specification.
+type EnvironmentAgentPairRequest struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	Rotate *bool   `json:"rotate,omitempty"`
+}
+
+// EnvironmentAgentPairResponse is generated from the OpenAPI specification.
+type EnvironmentAgentPairResponse struct {
+	Token string `json:"token"`
+}
+
+// EnvironmentAggregateSource is generated from the OpenAPI specification.
+type EnvironmentAggregateSource struct {
+	EnvironmentID   string  `json:"environmentId"`
+	EnvironmentName string  `json:"environmentName"`
+	Error           *string `json:"error,omitempty"`
+	ItemCount       int64   `json:"itemCount"`
+	Status          string  `json:"status"`
+}
+
+// EnvironmentAggregatedItemContainerSummary is generated from the OpenAPI specification.
+type EnvironmentAggregatedItemContainerSummary struct {
+	EnvironmentID   string           `json:"environmentId"`
+	EnvironmentName string           `json:"environmentName"`
+	Item            ContainerSummary `json:"item"`
+}
+
+// EnvironmentAggregatedItemImageSummary is generated from the OpenAPI specification.
+type EnvironmentAggregatedItemImageSummary struct {
+	EnvironmentID   string       `json:"environmentId"`
+	EnvironmentName string       `json:"environmentName"`
+	Item            ImageSummary `json:"item"`
+}
+
+// EnvironmentAggregatedItemProjectDetails is generated from the OpenAPI specification.
+type EnvironmentAggregatedItemProjectDetails struct {
+	EnvironmentID   string         `json:"environmentId"`
+	EnvironmentName string         `json:"environmentName"`
+	Item            ProjectDetails `json:"item"`
+}
+
+// EnvironmentCapabilities is generated from the OpenAPI specification.
+type EnvironmentCapabilities struct {
+	// A URL to the JSON Schema for this object.
+	Schema         *string    `json:"$schema,omitempty"`
+	CheckedAt      *time.Time `json:"checkedAt,omitempty"`
+	ComposeVersion *string    `json:"composeVersion,omitempty"`
+	Features       []string   `json:"features"`
+	Legacy         *bool      `json:"legacy,omitempty"`
+	SwarmEnabled   bool       `json:"swarmEnabled"`
+	Version        string     `json:"version"`
+}
+
+// EnvironmentCircuitBreaker is generated from the OpenAPI specification.
+type EnvironmentCircuitBreaker struct {
+	ConsecutiveFailures *int64     `json:"consecutiveFailures,omitempty"`
+	LastError           *string    `json:"lastError,omitempty"`
+	OpenedAt            *time.Time `json:"openedAt,omitempty"`
+	RetryAt             *time.Time `json:"retryAt,omitempty"`
+	State               string     `json:"state"`
+}
+
+// EnvironmentConfigSyncStatus is generated from the OpenAPI specification.
+type EnvironmentConfigSyncStatus struct {
+	Completed     int64                   `json:"completed"`
+	EnvironmentID string                  `json:"environmentId"`
+	FinishedAt    *time.Time              `json:"finishedAt,omitempty"`
+	StartedAt     time.Time               `json:"startedAt"`
+	State         string                  `json:"state"`
+	Targets       []EnvironmentSyncTarget `json:"targets"`
+	Total         int64                   `json:"total"`
+	Trigger       string                  `json:"trigger"`
+}
+
+// EnvironmentCreate is generated from the OpenAPI specification.
+type EnvironmentCreate struct {
+	// A URL to the JSON Schema for this object.
+	Schema                 *string `json:"$schema,omitempty"`
+	AccessToken            *string `json:"accessToken,omitempty"`
+	APIURL                 string  `json:"apiUrl"`
+	BootstrapToken         *string `json:"bootstrapToken,omitempty"`
+	Enabled                *bool   `json:"enabled,omitempty"`
+	IsEdge                 *bool   `json:"isEdge,omitempty"`
+	Name                   *string `json:"name,omitempty"`
+	SSHHostKey             *string `json:"sshHostKey,omitempty"`
+	SSHHostKeyVerification *string `json:"sshHostKeyVerification,omitempty"`
+	SSHKey                 *string `json:"sshKey,omitempty"`
+	UseAPIKey              *bool   `json:"useApiKey,omitempty"`
+}
+
+// EnvironmentEnvironment is generated from the OpenAPI specification.
+type EnvironmentEnvironment struct {
+	APIKey                 *string                      `json:"apiKey,omitempty"`
+	APIURL                 string                       `json:"apiUrl"`
+	Breaker                *EnvironmentCircuitBreaker   `json:"breaker,omitempty"`
+	Capabilities           *EnvironmentCapabilities     `json:"capabilities,omitempty"`
+	Connected              *bool                        `json:"connected,omitempty"`
+	ConnectedAt            *time.Time                   `json:"connectedAt,omitempty"`
+	EdgeTransport          *string                      `json:"edgeTransport,omitempty"`
+	Enabled                bool                         `json:"enabled"`
+	ID                     string                       `json:"id"`
+	IsEdge                 bool                         `json:"isEdge"`
+	LastHeartbeat          *time.Time                   `json:"lastHeartbeat,omitempty"`
+	Name                   *string                      `json:"name,omitempty"`
+	PairingFingerprint     *string                      `json:"pairingFingerprint,omitempty"`
+	PairingOriginIP        *string                      `json:"pairingOriginIp,omitempty"`
+	PairingRequestedAt     *time.Time                   `json:"pairingRequestedAt,omitempty"`
+	SSHHostKey             *string                      `json:"sshHostKey,omitempty"`
+	SSHHostKeyVerification *string                      `json:"sshHostKeyVerification,omitempty"`
+	Status                 string                       `json:"status"`
+	Sync                   *EnvironmentConfigSyncStatus `json:"sync,omitempty"`
+	Tags                   map[string]string            `json:"tags,omitempty"`
+}
+
+// EnvironmentPaginatedResponse is generated from the OpenAPI specification.
+type EnvironmentPaginatedResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema     *string                  `json:"$schema,omitempty"`
+	Data       []EnvironmentEnvironment `json:"data"`
+	Pagination BasePaginationResponse   `json:"pagination"`
+	Success    bool                     `json:"success"`
+}
+
+// EnvironmentSyncTarget is generated from the OpenAPI specification.
+type EnvironmentSyncTarget struct {
+	Attempts      int64      `json:"attempts"`
+	Error         *string    `json:"error,omitempty"`
+	FinishedAt    *time.Time `json:"finishedAt,omitempty"`
+	LastSuccessAt *time.Time `json:"lastSuccessAt,omitempty"`
+	State         string     `json:"state"`
+	Target        string     `json:"target"`
+}
+
+// EnvironmentTest is generated from the OpenAPI specification.
+type EnvironmentTest struct {
+	Message *string `json:"message,omitempty"`
+	Status  string  `json:"status"`
+}
+
+// EnvironmentTestConnectionRequest is generated from the OpenAPI specification.
+type EnvironmentTestConnectionRequest struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	APIURL *string `json:"apiUrl,omitempty"`
+}
+
+// EnvironmentUpdate is generated from the OpenAPI specification.
+type EnvironmentUpdate struct {
+	// A URL to the JSON Schema for this object.
+	Schema                 *string `json:"$schema,omitempty"`
+	AccessToken            *string `json:"accessToken,omitempty"`
+	APIURL                 *string `json:"apiUrl,omitempty"`
+	BootstrapToken         *string `json:"bootstrapToken,omitempty"`
+	Enabled                *bool   `json:"enabled,omitempty"`
+	Name                   *string `json:"name,omitempty"`
+	RegenerateAPIKey       *bool   `json:"regenerateApiKey,omitempty"`
+	SSHHostKey             *string `json:"sshHostKey,omitempty"`
+	SSHHostKeyVerification *string `json:"sshHostKeyVerification,omitempty"`
+	SSHKey                 *string `json:"sshKey,omitempty"`
+}
+
+// EnvironmentWithApiKey is generated from the OpenAPI specification.
+type EnvironmentWithApiKey struct {
+	// API key for pairing (only shown once during creation)
+	APIKey                 *string                      `json:"apiKey,omitempty"`
+	APIURL                 string                       `json:"apiUrl"`
+	Breaker                *EnvironmentCircuitBreaker   `json:"breaker,omitempty"`
+	Capabilities           *EnvironmentCapabilities     `json:"capabilities,omitempty"`
+	Connected              *bool                        `json:"connected,omitempty"`
+	ConnectedAt            *time.Time                   `json:"connectedAt,omitempty"`
+	EdgeTransport          *string                      `json:"edgeTransport,omitempty"`
+	Enabled                bool                         `json:"enabled"`
+	ID                     string                       `json:"id"`
+	IsEdge                 bool                         `json:"isEdge"`
+	LastHeartbeat          *time.Time                   `json:"lastHeartbeat,omitempty"`
+	Name                   *string                      `json:"name,omitempty"`
+	PairingFingerprint     *string                      `json:"pairingFingerprint,omitempty"`
+	PairingOriginIP        *string                      `json:"pairingOriginIp,omitempty"`
+	PairingRequestedAt     *time.Time                   `json:"pairingRequestedAt,omitempty"`
+	SSHHostKey             *string                      `json:"sshHostKey,omitempty"`
+	SSHHostKeyVerification *string                      `json:"sshHostKeyVerification,omitempty"`
+	Status                 string                       `json:"status"`
+	Sync                   *EnvironmentConfigSyncStatus `json:"sync,omitempty"`
+	Tags                   map[string]string            `json:"tags,omitempty"`
+}
+
+// ErrorModel is generated from the OpenAPI specification.
+type ErrorModel struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// A human-readable explanation specific to this occurrence of the problem.
+	Detail *string `json:"detail,omitempty"`
+	// Optional list of individual error details
+	Errors []ErrorDetail `json:"errors,omitempty"`
+	// A URI reference that identifies the specific occurrence of the problem.
+	Instance *string `json:"instance,omitempty"`
+	// HTTP status code
+	Status *int64 `json:"status,omitempty"`
+	// A short, human-readable summary of the problem type. This value should not change between occurrences of the error.
+	Title *string `json:"title,omitempty"`
+	// A URI reference to human-readable documentation for the error.
+	Type *string `json:"type,omitempty"`
+}
+
+// EventCreateEvent is generated from the OpenAPI specification.
+type EventCreateEvent struct {
+	// A URL to the JSON Schema for this object.
+	Schema        *string        `json:"$schema,omitempty"`
+	Description   *string        `json:"description,omitempty"`
+	EnvironmentID *string        `json:"environmentId,omitempty"`
+	Metadata      map[string]any `json:"metadata,omitempty"`
+	ResourceID    *string        `json:"resourceId,omitempty"`
+	ResourceName  *string        `json:"resourceName,omitempty"`
+	ResourceType  *string        `json:"resourceType,omitempty"`
+	Severity      *string        `json:"severity,omitempty"`
+	Title         string         `json:"title"`
+	Type          string         `json:"type"`
+	UserID        *string        `json:"userId,omitempty"`
+	Username      *string        `json:"username,omitempty"`
+}
+
+// EventEvent is generated from the OpenAPI specification.
+type EventEvent struct {
+	CreatedAt     time.Time      `json:"createdAt"`
+	Description   *string        `json:"description,omitempty"`
+	EnvironmentID *string        `json:"environmentId,omitempty"`
+	ID            string         `json:"id"`
+	Metadata      map[string]any `json:"metadata,omitempty"`
+	ResourceID    *string        `json:"resourceId,omitempty"`
+	ResourceName  *string        `json:"resourceName,omitempty"`
+	ResourceType  *string        `json:"resourceType,omitempty"`
+	Severity      string         `json:"severity"`
+	Timestamp     time.Time      `json:"timestamp"`
+	Title         string         `json:"title"`
+	Type          string         `json:"type"`
+	UpdatedAt     *time.Time     `json:"updatedAt,omitempty"`
+	UserID        *string        `json:"userId,omitempty"`
+	Username      *string        `json:"username,omitempty"`
+}
+
+// EventPaginatedResponse is generated from the OpenAPI specification.
+type EventPaginatedResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema     *string                `json:"$schema,omitempty"`
+	Data       []EventEvent           `json:"data"`
+	Pagination BasePaginationResponse `json:"pagination"`
+	Success    bool                   `json:"success"`
+}
+
+// ExportEventsParams holds the query and header parameters of ExportEvents.
+type ExportEventsParams struct {
+	// Only export events for this environment
+	EnvironmentID string
+	// Export format
+	Format string
+	// Inclusive start of the range (RFC 3339)
+	From string
+	// Exclusive end of the range (RFC 3339)
+	To string
+}
+
+func (p *ExportEventsParams) apply(query url.Values, header http.Header) {
+	setString(query.Set, "environmentId", p.EnvironmentID)
+	setString(query.Set, "format", p.Format)
+	setString(query.Set, "from", p.From)
+	setString(query.Set, "to", p.To)
+}
+
+// ExportSecurityAuditParams holds the query and header parameters of ExportSecurityAudit.
+type ExportSecurityAuditParams struct {
+	// Export format
+	Format string
+}
+
+func (p *ExportSecurityAuditParams) apply(query url.Values, header http.Header) {
+	setString(query.Set, "format", p.Format)
+}
+
+// ExtendsConfig is generated from the OpenAPI specification.
+type ExtendsConfig struct {
+	File    *string `json:"file,omitempty"`
+	Service *string `json:"service,omitempty"`
+}
+
+// FetchTemplateRegistryParams holds the query and header parameters of FetchTemplateRegistry.
+type FetchTemplateRegistryParams struct {
+	// Registry URL
+	URL string
+}
+
+func (p *FetchTemplateRegistryParams) apply(query url.Values, header http.Header) {
+	setString(query.Set, "url", p.URL)
+}
+
+// FileContentResponse is generated from the OpenAPI specification.
+type FileContentResponse struct {
+	Content  string `json:"content"`
+	MimeType string `json:"mimeType"`
+}
+
+// GetDashboardActionItemsParams holds the query and header parameters of GetDashboardActionItems.
+type GetDashboardActionItemsParams struct {
+	// Debug mode: force an empty action item list
+	DebugAllGood *bool
+}
+
+func (p *GetDashboardActionItemsParams) apply(query url.Values, header http.Header) {
+	setValue(query.Set, "debugAllGood", p.DebugAllGood)
+}
+
+// GetDashboardWidgetsParams holds the query and header parameters of GetDashboardWidgets.
+type GetDashboardWidgetsParams struct {
+	// Maximum number of rows returned by list widgets
+	Limit *int64
+	// Comma separated widget kinds to load; defaults to the widgets in the caller's layout
+	Widgets string
+}
+
+func (p *GetDashboardWidgetsParams) apply(query url.Values, header http.Header) {
+	setValue(query.Set, "limit", p.Limit)
+	setString(query.Set, "widgets", p.Widgets)
+}
+
+// GetDeploymentSnippetsParams holds the query and header parameters of GetDeploymentSnippets.
+type GetDeploymentSnippetsParams struct {
+	// Named volume or absolute host path for the agent data directory (default arcane-data)
+	DataVolume string
+	// Host path of the Docker socket (default /var/run/docker.sock)
+	DockerSocket string
+	// Additional KEY=VALUE environment variables
+	Env []string
+	// Agent image tag (default latest)
+	ImageTag string
+	// Existing Docker network to attach the agent to
+	Network string
+	// Host port the agent is published on (default 3553, ignored for edge agents)
+	Port *int64
+	// Absolute host path of the projects directory, mounted at the same path
+	ProjectsPath string
+	// Serve the agent API over HTTPS (ignored for edge agents)
+	TLS *bool
+	// Absolute host path of the TLS certificate, required with tls
+	TLSCertFile string
+	// Absolute host path of the TLS private key, required with tls
+	TLSKeyFile string
+}
+
+func (p *GetDeploymentSnippetsParams) apply(query url.Values, header http.Header) {
+	setString(query.Set, "dataVolume", p.DataVolume)
+	setString(query.Set, "dockerSocket", p.DockerSocket)
+	addValues(query.Add, "env", p.Env)
+	setString(query.Set, "imageTag", p.ImageTag)
+	setString(query.Set, "network", p.Network)
+	setValue(query.Set, "port", p.Port)
+	setString(query.Set, "projectsPath", p.ProjectsPath)
+	setValue(query.Set, "tls", p.TLS)
+	setString(query.Set, "tlsCertFile", p.TLSCertFile)
+	setString(query.Set, "tlsKeyFile", p.TLSKeyFile)
+}
+
+// GetEffectiveRoleParams holds the query and header parameters of GetEffectiveRole.
+type GetEffectiveRoleParams struct {
+	// Environment to evaluate (global scope when empty)
+	EnvironmentID string
+	// Project to evaluate within the environment
+	ProjectID string
+}
+
+func (p *GetEffectiveRoleParams) apply(query url.Values, header http.Header) {
+	setString(query.Set, "environmentId", p.EnvironmentID)
+	setString(query.Set, "projectId", p.ProjectID)
+}
+
+// GetEventsByEnvironmentParams holds the query and header parameters of GetEventsByEnvironment.
+type GetEventsByEnvironmentParams struct {
+	// Limit
+	Limit *int64
+	// Sort direction
+	Order string
+	// Search query
+	Search string
+	// Filter by severity
+	Severity string
+	// Column to sort by
+	Sort string
+	// Start index
+	Start *int64
+	// Filter by event type
+	Type string
+}
+
+func (p *GetEventsByEnvironmentParams) apply(query url.Values, header http.Header) {
+	setValue(query.Set, "limit", p.Limit)
+	setString(query.Set, "order", p.Order)
+	setString(query.Set, "search", p.Search)
+	setString(query.Set, "severity", p.Severity)
+	setString(query.Set, "sort", p.Sort)
+	setValue(query.Set, "start", p.Start)
+	setString(query.Set, "type", p.Type)
+}
+
+// GetHostMetricsHistoryParams holds the query and header parameters of GetHostMetricsHistory.
+type GetHostMetricsHistoryParams struct {
+	// How many hours of history to return
+	Hours *int64
+}
+
+func (p *GetHostMetricsHistoryParams) apply(query url.Values, header http.Header) {
+	setValue(query.Set, "hours", p.Hours)
+}
+
+// GetLogoParams holds the query and header parameters of GetLogo.
+type GetLogoParams struct {
+	// Optional accent color override for preview (e.g., 'oklch(0.65 0.2 150)')
+	Color string
+	// Return full logo instead of icon
+	Full *bool
+}
+
+func (p *GetLogoParams) apply(query url.Values, header http.Header) {
+	setString(query.Set, "color", p.Color)
+	setValue(query.Set, "full", p.Full)
+}
+
+// GetNetworkParams holds the query and header parameters of GetNetwork.
+type GetNetworkParams struct {
+	Order string
+	Sort  string
+}
+
+func (p *GetNetworkParams) apply(query url.Values, header http.Header) {
+	setString(query.Set, "order", p.Order)
+	setString(query.Set, "sort", p.Sort)
+}
+
+// GetOidcAuthUrlParams holds the query and header parameters of GetOidcAuthUrl.
+type GetOidcAuthUrlParams struct {
+	Host            string
+	Origin          string
+	XForwardedHost  string
+	XForwardedProto string
+}
+
+func (p *GetOidcAuthUrlParams) apply(query url.Values, header http.Header) {
+	setString(header.Set, "Host", p.Host)
+	setString(header.Set, "Origin", p.Origin)
+	setString(header.Set, "X-Forwarded-Host", p.XForwardedHost)
+	setString(header.Set, "X-Forwarded-Proto", p.XForwardedProto)
+}
+
+// GetOidcConfigParams holds the query and header parameters of GetOidcConfig.
+type GetOidcConfigParams struct {
+	Host            string
+	Origin          string
+	XForwardedHost  string
+	XForwardedProto string
+}
+
+func (p *GetOidcConfigParams) apply(query url.Values, header http.Header) {
+	setString(header.Set, "Host", p.Host)
+	setString(header.Set, "Origin", p.Origin)
+	setString(header.Set, "X-Forwarded-Host", p.XForwardedHost)
+	setString(header.Set, "X-Forwarded-Proto", p.XForwardedProto)
+}
+
+// GetUpdaterHistoryParams holds the query and header parameters of GetUpdaterHistory.
+type GetUpdaterHistoryParams struct {
+	// Number of history entries to return
+	Limit *int64
+}
+
+func (p *GetUpdaterHistoryParams) apply(query url.Values, header http.Header) {
+	setValue(query.Set, "limit", p.Limit)
+}
+
+// GetVersionParams holds the query and header parameters of GetVersion.
+type GetVersionParams struct {
+	// Current version to compare against
+	Current string
+}
+
+func (p *GetVersionParams) apply(query url.Values, header http.Header) {
+	setString(query.Set, "current", p.Current)
+}
+
+// GetVolumeFileContentParams holds the query and header parameters of GetVolumeFileContent.
+type GetVolumeFileContentParams struct {
+	// Maximum bytes to read (default 1MB)
+	MaxBytes *int64
+	// File path
+	Path string
+}
+
+func (p *GetVolumeFileContentParams) apply(query url.Values, header http.Header) {
+	setValue(query.Set, "maxBytes", p.MaxBytes)
+	setString(query.Set, "path", p.Path)
+}
+
+// GetVolumeUsageCountsParams holds the query and header parameters of GetVolumeUsageCounts.
+type GetVolumeUsageCountsParams struct {
+	// Include internal volumes
+	IncludeInternal *bool
+}
+
+func (p *GetVolumeUsageCountsParams) apply(query url.Values, header http.Header) {
+	setValue(query.Set, "includeInternal", p.IncludeInternal)
+}
+
+// GitOpsSyncPaginatedResponse is generated from the OpenAPI specification.
+type GitOpsSyncPaginatedResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema     *string                `json:"$schema,omitempty"`
+	Counts     GitopsSyncCounts       `json:"counts"`
+	Data       []GitopsGitOpsSync     `json:"data"`
+	Pagination BasePaginationResponse `json:"pagination"`
+	Success    bool                   `json:"success"`
+}
+
+// GitRepositoryPaginatedResponse is generated from the OpenAPI specification.
+type GitRepositoryPaginatedResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema     *string                `json:"$schema,omitempty"`
+	Data       []GitopsGitRepository  `json:"data"`
+	Pagination BasePaginationResponse `json:"pagination"`
+	Success    bool                   `json:"success"`
+}
+
+// GitopsBranchInfo is generated from the OpenAPI specification.
+type GitopsBranchInfo struct {
+	IsDefault bool   `json:"isDefault"`
+	Name      string `json:"name"`
+}
+
+// GitopsBranchesResponse is generated from the OpenAPI specification.
+type GitopsBranchesResponse struct {
+	Branches []GitopsBranchInfo `json:"branches"`
+}
+
+// GitopsBrowseResponse is generated from the OpenAPI specification.
+type GitopsBrowseResponse struct {
+	Files []GitopsFileTreeNode `json:"files"`
+	Path  string               `json:"path"`
+}
+
+// GitopsCreateSyncRequest is generated from the OpenAPI specification.
+type GitopsCreateSyncRequest struct {
+	// A URL to the JSON Schema for this object.
+	Schema       *string `json:"$schema,omitempty"`
+	AutoSync     *bool   `json:"autoSync,omitempty"`
+	Branch       string  `json:"branch"`
+	ComposePath  string  `json:"composePath"`
+	Name         string  `json:"name"`
+	ProjectName  *string `json:"projectName,omitempty"`
+	RepositoryID string  `json:"repositoryId"`
+	SyncInterval *int64  `json:"syncInterval,omitempty"`
+	TagBumpMode  *string `json:"tagBumpMode,omitempty"`
+}
+
+// GitopsFileTreeNode is generated from the OpenAPI specification.
+type GitopsFileTreeNode struct {
+	Children []GitopsFileTreeNode `json:"children,omitempty"`
+	Name     string               `json:"name"`
+	Path     string               `json:"path"`
+	Size     *int64               `json:"size,omitempty"`
+	Type     string               `json:"type"`
+}
+
+// GitopsGitOpsSync is generated from the OpenAPI specification.
+type GitopsGitOpsSync struct {
+	AutoSync           bool                 `json:"autoSync"`
+	Branch             string               `json:"branch"`
+	ComposePath        string               `json:"composePath"`
+	CreatedAt          time.Time            `json:"createdAt"`
+	EnvironmentID      string               `json:"environmentId"`
+	ID                 string               `json:"id"`
+	LastSyncAt         *time.Time           `json:"lastSyncAt,omitempty"`
+	LastSyncCommit     *string              `json:"lastSyncCommit,omitempty"`
+	LastSyncError      *string              `json:"lastSyncError,omitempty"`
+	LastSyncStatus     *string              `json:"lastSyncStatus,omitempty"`
+	LastTagBumpAt      *time.Time           `json:"lastTagBumpAt,omitempty"`
+	LastTagBumpMessage *string              `json:"lastTagBumpMessage,omitempty"`
+	LastTagBumpStatus  *string              `json:"lastTagBumpStatus,omitempty"`
+	LastTagBumpURL     *string              `json:"lastTagBumpUrl,omitempty"`
+	Name               string               `json:"name"`
+	ProjectID          *string              `json:"projectId,omitempty"`
+	ProjectName        string               `json:"projectName"`
+	Repository         *GitopsGitRepository `json:"repository,omitempty"`
+	RepositoryID       string               `json:"repositoryId"`
+	SyncInterval       int64                `json:"syncInterval"`
+	TagBumpMode        string               `json:"tagBumpMode"`
+	UpdatedAt          time.Time            `json:"updatedAt"`
+}
+
+// GitopsGitRepository is generated from the OpenAPI specification.
+type GitopsGitRepository struct {
+	AuthType               string    `json:"authType"`
+	CreatedAt              time.Time `json:"createdAt"`
+	Description            *string   `json:"description,omitempty"`
+	Enabled                bool      `json:"enabled"`
+	ID                     string    `json:"id"`
+	Name                   string    `json:"name"`
+	SSHHostKeyVerification *string   `json:"sshHostKeyVerification,omitempty"`
+	UpdatedAt              time.Time `json:"updatedAt"`
+	URL                    string    `json:"url"`
+	Username               *string   `json:"username,omitempty"`
+}
+
+// GitopsImageTagBump is generated from the OpenAPI specification.
+type GitopsImageTagBump struct {
+	CurrentTag  string   `json:"currentTag"`
+	Image       string   `json:"image"`
+	NewTag      string   `json:"newTag"`
+	Service     string   `json:"service"`
+	SkippedTags []string `json:"skippedTags,omitempty"`
+}
+
+// GitopsImportGitOpsSyncRequest is generated from the OpenAPI specification.
+type GitopsImportGitOpsSyncRequest struct {
+	AutoSync          bool   `json:"autoSync"`
+	Branch            string `json:"branch"`
+	DockerComposePath string `json:"dockerComposePath"`
+	GitRepo           string `json:"gitRepo"`
+	SyncInterval      int64  `json:"syncInterval"`
+	SyncName          string `json:"syncName"`
+}
+
+// GitopsImportGitOpsSyncResponse is generated from the OpenAPI specification.
+type GitopsImportGitOpsSyncResponse struct {
+	Errors       []string `json:"errors"`
+	FailedCount  int64    `json:"failedCount"`
+	SuccessCount int64    `json:"successCount"`
+}
+
+// GitopsRepositorySync is generated from the OpenAPI specification.
+type GitopsRepositorySync struct {
+	AuthType               string    `json:"authType"`
+	CreatedAt              time.Time `json:"createdAt"`
+	Description            *string   `json:"description,omitempty"`
+	Enabled                bool      `json:"enabled"`
+	ID                     string    `json:"id"`
+	Name                   string    `json:"name"`
+	SSHHostKeyVerification *string   `json:"sshHostKeyVerification,omitempty"`
+	SSHKey                 *string   `json:"sshKey,omitempty"`
+	Token                  *string   `json:"token,omitempty"`
+	UpdatedAt              time.Time `json:"updatedAt"`
+	URL                    string    `json:"url"`
+	Username               *string   `json:"username,omitempty"`
+}
+
+// GitopsRepositorySyncRequest is generated from the OpenAPI specification.
+type GitopsRepositorySyncRequest struct {
+	// A URL to the JSON Schema for this object.
+	Schema       *string                `json:"$schema,omitempty"`
+	Repositories []GitopsRepositorySync `json:"repositories"`
+}
+
+// GitopsSyncCounts is generated from the OpenAPI specification.
+type GitopsSyncCounts struct {
+	ActiveSyncs     int64 `json:"activeSyncs"`
+	SuccessfulSyncs int64 `json:"successfulSyncs"`
+	TotalSyncs      int64 `json:"totalSyncs"`
+}
+
+// GitopsSyncResult is generated from the OpenAPI specification.
+type GitopsSyncResult struct {
+	Error    *string   `json:"error,omitempty"`
+	Message  string    `json:"message"`
+	Success  bool      `json:"success"`
+	SyncedAt time.Time `json:"syncedAt"`
+	Warnings []string  `json:"warnings,omitempty"`
+}
+
+// GitopsSyncStatus is generated from the OpenAPI specification.
+type GitopsSyncStatus struct {
+	AutoSync       bool       `json:"autoSync"`
+	ID             string     `json:"id"`
+	LastSyncAt     *time.Time `json:"lastSyncAt,omitempty"`
+	LastSyncCommit *string    `json:"lastSyncCommit,omitempty"`
+	LastSyncError  *string    `json:"lastSyncError,omitempty"`
+	LastSyncStatus *string    `json:"lastSyncStatus,omitempty"`
+	NextSyncAt     *time.Time `json:"nextSyncAt,omitempty"`
+}
+
+// GitopsTagBumpResult is generated from the OpenAPI specification.
+type GitopsTagBumpResult struct {
+	Branch         *string              `json:"branch,omitempty"`
+	Bumps          []GitopsImageTagBump `json:"bumps"`
+	Commit         *string              `json:"commit,omitempty"`
+	Message        string               `json:"message"`
+	PullRequestURL *string              `json:"pullRequestUrl,omitempty"`
+	Status         string               `json:"status"`
+}
+
+// GitopsUpdateSyncRequest is generated from the OpenAPI specification.
+type GitopsUpdateSyncRequest struct {
+	// A URL to the JSON Schema for this object.
+	Schema       *string `json:"$schema,omitempty"`
+	AutoSync     *bool   `json:"autoSync,omitempty"`
+	Branch       *string `json:"branch,omitempty"`
+	ComposePath  *string `json:"composePath,omitempty"`
+	Name         *string `json:"name,omitempty"`
+	ProjectName  *string `json:"projectName,omitempty"`
+	RepositoryID *string `json:"repositoryId,omitempty"`
+	SyncInterval *int64  `json:"syncInterval,omitempty"`
+	TagBumpMode  *string `json:"tagBumpMode,omitempty"`
+}
+
+// HandleOidcCallbackParams holds the query and header parameters of HandleOidcCallback.
+type HandleOidcCallbackParams struct {
+	Host            string
+	Origin          string
+	XForwardedHost  string
+	XForwardedProto string
+}
+
+func (p *HandleOidcCallbackParams) apply(query url.Values, header http.Header) {
+	setString(header.Set, "Host", p.Host)
+	setString(header.Set, "Origin", p.Origin)
+	setString(header.Set, "X-Forwarded-Host", p.XForwardedHost)
+	setString(header.Set, "X-Forwarded-Proto", p.XForwardedProto)
+}
+
+// HealthCheckConfig is generated from the OpenAPI specification.
+type HealthCheckConfig struct {
+	Disable       *bool    `json:"disable,omitempty"`
+	Interval      *int64   `json:"interval,omitempty"`
+	Retries       *int64   `json:"retries,omitempty"`
+	StartInterval *int64   `json:"start_interval,omitempty"`
+	StartPeriod   *int64   `json:"start_period,omitempty"`
+	Test          []string `json:"test,omitempty"`
+	Timeout       *int64   `json:"timeout,omitempty"`
+}
+
+// HealthcheckConfig is generated from the OpenAPI specification.
+type HealthcheckConfig struct {
+	Interval      *int64   `json:"Interval,omitempty"`
+	Retries       *int64   `json:"Retries,omitempty"`
+	StartInterval *int64   `json:"StartInterval,omitempty"`
+	StartPeriod   *int64   `json:"StartPeriod,omitempty"`
+	Test          []string `json:"Test,omitempty"`
+	Timeout       *int64   `json:"Timeout,omitempty"`
+}
+
+// ImageBuildPaginatedResponse is generated from the OpenAPI specification.
+type ImageBuildPaginatedResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema     *string                `json:"$schema,omitempty"`
+	Data       []ImageBuildRecord     `json:"data"`
+	Pagination BasePaginationResponse `json:"pagination"`
+	Success    bool                   `json:"success"`
+}
+
+// ImageBuildRecord is generated from the OpenAPI specification.
+type ImageBuildRecord struct {
+	BuildArgs       map[string]string `json:"buildArgs,omitempty"`
+	CacheFrom       []string          `json:"cacheFrom,omitempty"`
+	CacheTo         []string          `json:"cacheTo,omitempty"`
+	CompletedAt     *time.Time        `json:"completedAt,omitempty"`
+	ContextDir      string            `json:"contextDir"`
+	CreatedAt       time.Time         `json:"createdAt"`
+	Digest          *string           `json:"digest,omitempty"`
+	Dockerfile      *string           `json:"dockerfile,omitempty"`
+	DurationMs      *int64            `json:"durationMs,omitempty"`
+	Entitlements    []string          `json:"entitlements,omitempty"`
+	EnvironmentID   string            `json:"environmentId"`
+	ErrorMessage    *string           `json:"errorMessage,omitempty"`
+	ExtraHosts      []string          `json:"extraHosts,omitempty"`
+	ID              string            `json:"id"`
+	Isolation       *string           `json:"isolation,omitempty"`
+	Labels          map[string]string `json:"labels,omitempty"`
+	Load            bool              `json:"load"`
+	Network         *string           `json:"network,omitempty"`
+	NoCache         bool              `json:"noCache"`
+	Output          *string           `json:"output,omitempty"`
+	OutputTruncated bool              `json:"outputTruncated"`
+	Platforms       []string          `json:"platforms,omitempty"`
+	Privileged      bool              `json:"privileged"`
+	Provider        *string           `json:"provider,omitempty"`
+	Pull            bool              `json:"pull"`
+	Push            bool              `json:"push"`
+	ShmSize         *int64            `json:"shmSize,omitempty"`
+	Status          string            `json:"status"`
+	Tags            []string          `json:"tags,omitempty"`
+	Target          *string           `json:"target,omitempty"`
+	Ulimits         map[string]string `json:"ulimits,omitempty"`
+	UserID          *string           `json:"userId,omitempty"`
+	Username        *string           `json:"username,omitempty"`
+}
+
+// ImageBuildRequest is generated from the OpenAPI specification.
+type ImageBuildRequest struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Build arguments
+	BuildArgs map[string]string `json:"buildArgs,omitempty"`
+	// Build cache sources
+	CacheFrom []string `json:"cacheFrom,omitempty"`
+	// Build cache targets
+	CacheTo []string `json:"cacheTo,omitempty"`
+	// Build context directory or Git URL
+	ContextDir string `json:"contextDir"`
+	// Dockerfile path
+	Dockerfile *string `json:"dockerfile,omitempty"`
+	// Inline Dockerfile content
+	DockerfileInline *string `json:"dockerfileInline,omitempty"`
+	// Build entitlements
+	Entitlements []string `json:"entitlements,omitempty"`
+	// Build extra host mappings
+	ExtraHosts []string `json:"extraHosts,omitempty"`
+	// Build isolation mode
+	Isolation *string `json:"isolation,omitempty"`
+	// Build labels
+	Labels map[string]string `json:"labels,omitempty"`
+	// Load image into local Docker
+	Load *bool `json:"load,omitempty"`
+	// Build network mode
+	Network *string `json:"network,omitempty"`
+	// Disable build cache
+	NoCache *bool `json:"noCache,omitempty"`
+	// Target platforms
+	Platforms []string `json:"platforms,omitempty"`
+	// Enable privileged build
+	Privileged *bool `json:"privileged,omitempty"`
+	// Build provider override
+	Provider *string `json:"provider,omitempty"`
+	// Always pull referenced base images
+	Pull *bool `json:"pull,omitempty"`
+	// Push image
+	Push *bool `json:"push,omitempty"`
+	// Build shared memory size in bytes
+	ShmSize *int64 `json:"shmSize,omitempty"`
+	// Image tags
+	Tags []string `json:"tags,omitempty"`
+	// Target stage
+	Target *string `json:"target,omitempty"`
+	// Build ulimits
+	Ulimits map[string]string `json:"ulimits,omitempty"`
+}
+
+// ImageDetailSummary is generated from the OpenAPI specification.
+type ImageDetailSummary struct {
+	Architecture string                         `json:"architecture"`
+	Author       string                         `json:"author"`
+	Comment      string                         `json:"comment"`
+	Config       DetailSummaryConfigStruct      `json:"config"`
+	Created      string                         `json:"created"`
+	Descriptor   DetailSummaryDescriptorStruct  `json:"descriptor"`
+	GraphDriver  DetailSummaryGraphDriverStruct `json:"graphDriver"`
+	ID           string                         `json:"id"`
+	Metadata     DetailSummaryMetadataStruct    `json:"metadata"`
+	Os           string                         `json:"os"`
+	RepoDigests  []string                       `json:"repoDigests"`
+	RepoTags     []string                       `json:"repoTags"`
+	RootFs       DetailSummaryRootFsStruct      `json:"rootFs"`
+	Size         int64                          `json:"size"`
+}
+
+// ImageDistributeRequest is generated from the OpenAPI specification.
+type ImageDistributeRequest struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Image reference or ID to copy
+	Image string `json:"image"`
+	// stream copies the image directly; registry pushes it and pulls it on each target
+	Method *string `json:"method,omitempty"`
+	// Environment that has the image. Defaults to the local environment
+	SourceEnvironmentID *string `json:"sourceEnvironmentId,omitempty"`
+	// Environments to copy the image to
+	TargetEnvironmentIds []string `json:"targetEnvironmentIds"`
+}
+
+// ImageDistributeResult is generated from the OpenAPI specification.
+type ImageDistributeResult struct {
+	Image   string                        `json:"image"`
+	Method  string                        `json:"method"`
+	Targets []ImageDistributeTargetResult `json:"targets"`
+}
+
+// ImageDistributeTargetResult is generated from the OpenAPI specification.
+type ImageDistributeTargetResult struct {
+	DurationMs      int64   `json:"durationMs"`
+	EnvironmentID   string  `json:"environmentId"`
+	EnvironmentName string  `json:"environmentName"`
+	Error           *string `json:"error,omitempty"`
+	Success         bool    `json:"success"`
+}
+
+// ImageLoadResult is generated from the OpenAPI specification.
+type ImageLoadResult struct {
+	Stream string `json:"stream"`
+}
+
+// ImagePaginatedResponse is generated from the OpenAPI specification.
+type ImagePaginatedResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema     *string                `json:"$schema,omitempty"`
+	Data       []ImageSummary         `json:"data"`
+	Pagination BasePaginationResponse `json:"pagination"`
+	Success    bool                   `json:"success"`
+}
+
+// ImagePromoteRequest is generated from the OpenAPI specification.
+type ImagePromoteRequest struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Digest the source image must have, so that exactly the tested build is promoted
+	SourceDigest *string `json:"sourceDigest,omitempty"`
+	// Environment whose Docker host pulls and pushes the image. Defaults to the local environment
+	SourceEnvironmentID *string `json:"sourceEnvironmentId,omitempty"`
+	// Image reference to promote, including its registry
+	SourceImage string `json:"sourceImage"`
+	// Configured container registry to push to
+	TargetRegistryID string `json:"targetRegistryId"`
+	// Repository in the target registry. Defaults to the source repository path
+	TargetRepository *string `json:"targetRepository,omitempty"`
+	// Tag in the target registry. Defaults to the source tag
+	TargetTag *string `json:"targetTag,omitempty"`
+}
+
+// ImagePromoteResult is generated from the OpenAPI specification.
+type ImagePromoteResult struct {
+	// Digest of the image pushed to the target registry
+	Digest     string `json:"digest"`
+	DurationMs int64  `json:"durationMs"`
+	// Digest of the source image in its registry
+	SourceDigest *string `json:"sourceDigest,omitempty"`
+	SourceImage  string  `json:"sourceImage"`
+	TargetImage  string  `json:"targetImage"`
+	// Whether the target registry reported the pushed digest for the new tag
+	Verified bool `json:"verified"`
+}
+
+// ImagePruneReport is generated from the OpenAPI specification.
+type ImagePruneReport struct {
+	ImagesDeleted  []string `json:"imagesDeleted"`
+	SpaceReclaimed int64    `json:"spaceReclaimed"`
+}
+
+// ImagePullOptions is generated from the OpenAPI specification.
+type ImagePullOptions struct {
+	// A URL to the JSON Schema for this object.
+	Schema      *string                       `json:"$schema,omitempty"`
+	Auth        *ContainerregistryCredential  `json:"auth,omitempty"`
+	Credentials []ContainerregistryCredential `json:"credentials,omitempty"`
+	// Name of the image to pull (e.g., nginx)
+	ImageName string `json:"imageName"`
+	// Tag of the image to pull (e.g., latest)
+	Tag *string `json:"tag,omitempty"`
+}
+
+// ImagePullPaginatedResponse is generated from the OpenAPI specification.
+type ImagePullPaginatedResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema     *string                `json:"$schema,omitempty"`
+	Data       []ImagePullRecord      `json:"data"`
+	Pagination BasePaginationResponse `json:"pagination"`
+	Success    bool                   `json:"success"`
+}
+
+// ImagePullRecord is generated from the OpenAPI specification.
+type ImagePullRecord struct {
+	// Compressed size of the downloaded layers
+	Bytes        int64      `json:"bytes"`
+	CompletedAt  *time.Time `json:"completedAt,omitempty"`
+	CreatedAt    time.Time  `json:"createdAt"`
+	DurationMs   int64      `json:"durationMs"`
+	ErrorMessage *string    `json:"errorMessage,omitempty"`
+	ID           string     `json:"id"`
+	ImageName    string     `json:"imageName"`
+	// Number of layers in the image
+	Layers int64 `json:"layers"`
+	// Number of layers that were not already present
+	LayersDownloaded int64  `json:"layersDownloaded"`
+	Status           string `json:"status"`
+	// What started the pull: user, updater or system
+	Trigger  string  `json:"trigger"`
+	UserID   *string `json:"userId,omitempty"`
+	Username *string `json:"username,omitempty"`
+}
+
+// ImageSummary is generated from the OpenAPI specification.
+type ImageSummary struct {
+	Created           int64                     `json:"created"`
+	ID                string                    `json:"id"`
+	InUse             bool                      `json:"inUse"`
+	Labels            map[string]any            `json:"labels"`
+	Repo              string                    `json:"repo"`
+	RepoDigests       []string                  `json:"repoDigests"`
+	RepoTags          []string                  `json:"repoTags"`
+	Size              int64                     `json:"size"`
+	Tag               string                    `json:"tag"`
+	UpdateInfo        *ImageUpdateInfo          `json:"updateInfo,omitempty"`
+	UsedBy            []ImageUsedBy             `json:"usedBy,omitempty"`
+	VirtualSize       int64                     `json:"virtualSize"`
+	VulnerabilityScan *VulnerabilityScanSummary `json:"vulnerabilityScan,omitempty"`
+}
+
+// ImageUpdateInfo is generated from the OpenAPI specification.
+type ImageUpdateInfo struct {
+	AuthMethod     *string   `json:"authMethod,omitempty"`
+	AuthRegistry   *string   `json:"authRegistry,omitempty"`
+	AuthUsername   *string   `json:"authUsername,omitempty"`
+	CheckTime      time.Time `json:"checkTime"`
+	CurrentDigest  string    `json:"currentDigest"`
+	CurrentVersion string    `json:"currentVersion"`
+	Error          string    `json:"error"`
+	HasUpdate      bool      `json:"hasUpdate"`
+	LatestDigest   string    `json:"latestDigest"`
+	LatestVersion  string    `json:"latestVersion"`
+	ResponseTimeMs int64     `json:"responseTimeMs"`
+	UpdateType     string    `json:"updateType"`
+	UsedCredential *bool     `json:"usedCredential,omitempty"`
+}
+
+// ImageUsageCounts is generated from the OpenAPI specification.
+type ImageUsageCounts struct {
+	ImagesInuse    int64 `json:"imagesInuse"`
+	ImagesUnused   int64 `json:"imagesUnused"`
+	TotalImageSize int64 `json:"totalImageSize"`
+	TotalImages    int64 `json:"totalImages"`
+}
+
+// ImageUsageCountsResponse is generated from the OpenAPI specification.
+type ImageUsageCountsResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema  *string          `json:"$schema,omitempty"`
+	Data    ImageUsageCounts `json:"data"`
+	Success bool             `json:"success"`
+}
+
+// ImageUsedBy is generated from the OpenAPI specification.
+type ImageUsedBy struct {
+	ID   *string `json:"id,omitempty"`
+	Name string  `json:"name"`
+	Type string  `json:"type"`
+}
+
+// ImageupdateBatchImageUpdateRequest is generated from the OpenAPI specification.
+type ImageupdateBatchImageUpdateRequest struct {
+	// A URL to the JSON Schema for this object.
+	Schema      *string                       `json:"$schema,omitempty"`
+	Credentials []ContainerregistryCredential `json:"credentials,omitempty"`
+	ImageRefs   []string                      `json:"imageRefs"`
+}
+
+// ImageupdateCheckAllImagesRequest is generated from the OpenAPI specification.
+type ImageupdateCheckAllImagesRequest struct {
+	// A URL to the JSON Schema for this object.
+	Schema      *string                       `json:"$schema,omitempty"`
+	Credentials []ContainerregistryCredential `json:"credentials,omitempty"`
+}
+
+// ImageupdateRemoteTag is generated from the OpenAPI specification.
+type ImageupdateRemoteTag struct {
+	Semver *string `json:"semver,omitempty"`
+	Tag    string  `json:"tag"`
+}
+
+// ImageupdateResponse is generated from the OpenAPI specification.
+type ImageupdateResponse struct {
+	AuthMethod          *string   `json:"authMethod,omitempty"`
+	AuthRegistry        *string   `json:"authRegistry,omitempty"`
+	AuthUsername        *string   `json:"authUsername,omitempty"`
+	CheckTime           time.Time `json:"checkTime"`
+	CurrentDigest       *string   `json:"currentDigest,omitempty"`
+	CurrentVersion      string    `json:"currentVersion"`
+	Error               *string   `json:"error,omitempty"`
+	HasUpdate           bool      `json:"hasUpdate"`
+	LatestDigest        *string   `json:"latestDigest,omitempty"`
+	LatestVersion       *string   `json:"latestVersion,omitempty"`
+	Platform            *string   `json:"platform,omitempty"`
+	PlatformUnsupported *bool     `json:"platformUnsupported,omitempty"`
+	ResponseTimeMs      int64     `json:"responseTimeMs"`
+	UpdateType          string    `json:"updateType"`
+	UsedCredential      *bool     `json:"usedCredential,omitempty"`
+}
+
+// ImageupdateSnooze is generated from the OpenAPI specification.
+type ImageupdateSnooze struct {
+	Repository   string    `json:"repository"`
+	SnoozedUntil time.Time `json:"snoozedUntil"`
+	Tag          string    `json:"tag"`
+}
+
+// ImageupdateSnoozeRequest is generated from the OpenAPI specification.
+type ImageupdateSnoozeRequest struct {
+	// A URL to the JSON Schema for this object.
+	Schema   *string   `json:"$schema,omitempty"`
+	ImageRef string    `json:"imageRef"`
+	Until    time.Time `json:"until"`
+}
+
+// ImageupdateSummary is generated from the OpenAPI specification.
+type ImageupdateSummary struct {
+	DigestUpdates     int64 `json:"digestUpdates"`
+	ErrorsCount       int64 `json:"errorsCount"`
+	ImagesWithUpdates int64 `json:"imagesWithUpdates"`
+	TotalImages       int64 `json:"totalImages"`
+}
+
+// IngressAnalysis is generated from the OpenAPI specification.
+type IngressAnalysis struct {
+	Conflicts   []IngressConflict `json:"conflicts"`
+	EntryPoints []string          `json:"entryPoints,omitempty"`
+	Routes      []IngressRoute    `json:"routes"`
+}
+
+// IngressConflict is generated from the OpenAPI specification.
+type IngressConflict struct {
+	ContainerNames []string `json:"containerNames"`
+	Host           *string  `json:"host,omitempty"`
+	Kind           string   `json:"kind"`
+	Message        string   `json:"message"`
+	Provider       string   `json:"provider"`
+	Routes         []string `json:"routes"`
+}
+
+// IngressGenerateLabelsRequest is generated from the OpenAPI specification.
+type IngressGenerateLabelsRequest struct {
+	// A URL to the JSON Schema for this object.
+	Schema       *string `json:"$schema,omitempty"`
+	CertResolver *string `json:"certResolver,omitempty"`
+	Domain       string  `json:"domain"`
+	EntryPoint   *string `json:"entryPoint,omitempty"`
+	Network      *string `json:"network,omitempty"`
+	PathPrefix   *string `json:"pathPrefix,omitempty"`
+	Port         int64   `json:"port"`
+	Provider     string  `json:"provider"`
+	Service      string  `json:"service"`
+	TLS          *bool   `json:"tls,omitempty"`
+}
+
+// IngressGeneratedLabels is generated from the OpenAPI specification.
+type IngressGeneratedLabels struct {
+	Compose   string            `json:"compose"`
+	Conflicts []IngressConflict `json:"conflicts"`
+	Labels    map[string]string `json:"labels"`
+}
+
+// IngressRoute is generated from the OpenAPI specification.
+type IngressRoute struct {
+	ContainerID   string   `json:"containerId"`
+	ContainerName string   `json:"containerName"`
+	EntryPoints   []string `json:"entryPoints,omitempty"`
+	Hosts         []string `json:"hosts"`
+	Name          string   `json:"name"`
+	PathPrefixes  []string `json:"pathPrefixes,omitempty"`
+	Port          *string  `json:"port,omitempty"`
+	Project       *string  `json:"project,omitempty"`
+	Provider      string   `json:"provider"`
+	Rule          *string  `json:"rule,omitempty"`
+	Service       *string  `json:"service,omitempty"`
+	TLS           bool     `json:"tls"`
+}
+
+// JobscheduleConfig is generated from the OpenAPI specification.
+type JobscheduleConfig struct {
+	// A URL to the JSON Schema for this object.
+	Schema                     *string `json:"$schema,omitempty"`
+	AnalyticsHeartbeatInterval string  `json:"analyticsHeartbeatInterval"`
+	AutoHealInterval           string  `json:"autoHealInterval"`
+	AutoUpdateInterval         string  `json:"autoUpdateInterval"`
+	ConfigBackupInterval       string  `json:"configBackupInterval"`
+	EnvironmentHealthInterval  string  `json:"environmentHealthInterval"`
+	EventCleanupInterval       string  `json:"eventCleanupInterval"`
+	GitopsSyncInterval         string  `json:"gitopsSyncInterval"`
+	ImageRetentionInterval     string  `json:"imageRetentionInterval"`
+	PollingInterval            string  `json:"pollingInterval"`
+	ScheduledPruneInterval     string  `json:"scheduledPruneInterval"`
+	StaleResourcesInterval     string  `json:"staleResourcesInterval"`
+	VulnerabilityScanInterval  string  `json:"vulnerabilityScanInterval"`
+}
+
+// JobscheduleJobListResponse is generated from the OpenAPI specification.
+type JobscheduleJobListResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema  *string                `json:"$schema,omitempty"`
+	IsAgent bool                   `json:"isAgent"`
+	Jobs    []JobscheduleJobStatus `json:"jobs"`
+}
+
+// JobscheduleJobPrerequisite is generated from the OpenAPI specification.
+type JobscheduleJobPrerequisite struct {
+	IsMet       bool    `json:"isMet"`
+	Label       string  `json:"label"`
+	SettingKey  string  `json:"settingKey"`
+	SettingsURL *string `json:"settingsUrl,omitempty"`
+}
+
+// JobscheduleJobRunResponse is generated from the OpenAPI specification.
+type JobscheduleJobRunResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema  *string `json:"$schema,omitempty"`
+	Message string  `json:"message"`
+	Success bool    `json:"success"`
+}
+
+// JobscheduleJobStatus is generated from the OpenAPI specification.
+type JobscheduleJobStatus struct {
+	CanRunManually bool                         `json:"canRunManually"`
+	Category       string                       `json:"category"`
+	Description    string                       `json:"description"`
+	Enabled        bool                         `json:"enabled"`
+	ID             string                       `json:"id"`
+	IsContinuous   bool                         `json:"isContinuous"`
+	ManagerOnly    bool                         `json:"managerOnly"`
+	Name           string                       `json:"name"`
+	NextRun        *time.Time                   `json:"nextRun,omitempty"`
+	Prerequisites  []JobscheduleJobPrerequisite `json:"prerequisites"`
+	Schedule       string                       `json:"schedule"`
+	SettingsKey    *string                      `json:"settingsKey,omitempty"`
+}
+
+// JobscheduleUpdate is generated from the OpenAPI specification.
+type JobscheduleUpdate struct {
+	// A URL to the JSON Schema for this object.
+	Schema                     *string `json:"$schema,omitempty"`
+	AnalyticsHeartbeatInterval *string `json:"analyticsHeartbeatInterval,omitempty"`
+	AutoHealInterval           *string `json:"autoHealInterval,omitempty"`
+	AutoUpdateInterval         *string `json:"autoUpdateInterval,omitempty"`
+	ConfigBackupInterval       *string `json:"configBackupInterval,omitempty"`
+	EnvironmentHealthInterval  *string `json:"environmentHealthInterval,omitempty"`
+	EventCleanupInterval       *string `json:"eventCleanupInterval,omitempty"`
+	GitopsSyncInterval         *string `json:"gitopsSyncInterval,omitempty"`
+	ImageRetentionInterval     *string `json:"imageRetentionInterval,omitempty"`
+	PollingInterval            *string `json:"pollingInterval,omitempty"`
+	ScheduledPruneInterval     *string `json:"scheduledPruneInterval,omitempty"`
+	StaleResourcesInterval     *string `json:"staleResourcesInterval,omitempty"`
+	VulnerabilityScanInterval  *string `json:"vulnerabilityScanInterval,omitempty"`
+}
+
+// ListAllContainersParams holds the query and header parameters of ListAllContainers.
+type ListAllContainersParams struct {
+	// Comma-separated environment IDs to include (default: all enabled environments)
+	EnvironmentIds string
+	// Include internal containers
+	IncludeInternal *bool
+	// Limit
+	Limit *int64
+	// Sort direction
+	Order string
+	// Search query, forwarded to every environment
+	Search string
+	// Column to sort by (environment or name)
+	Sort string
+	// Start index
+	Start *int64
+	// Filter by update status (has_update, up_to_date, error, unknown)
+	Updates string
+}
+
+func (p *ListAllContainersParams) apply(query url.Values, header http.Header) {
+	setString(query.Set, "environmentIds", p.EnvironmentIds)
+	setValue(query.Set, "includeInternal", p.IncludeInternal)
+	setValue(query.Set, "limit", p.Limit)
+	setString(query.Set, "order", p.Order)
+	setString(query.Set, "search", p.Search)
+	setString(query.Set, "sort", p.Sort)
+	setValue(query.Set, "start", p.Start)
+	setString(query.Set, "updates", p.Updates)
+}
+
+// ListAllImagesParams holds the query and header parameters of ListAllImages.
+type ListAllImagesParams struct {
+	// Comma-separated environment IDs to include (default: all enabled environments)
+	EnvironmentIds string
+	// Limit
+	Limit *int64
+	// Sort direction
+	Order string
+	// Search query, forwarded to every environment
+	Search string
+	// Column to sort by (environment or name)
+	Sort string
+	// Start index
+	Start *int64
+}
+
+func (p *ListAllImagesParams) apply(query url.Values, header http.Header) {
+	setString(query.Set, "environmentIds", p.EnvironmentIds)
+	setValue(query.Set, "limit", p.Limit)
+	setString(query.Set, "order", p.Order)
+	setString(query.Set, "search", p.Search)
+	setString(query.Set, "sort", p.Sort)
+	setValue(query.Set, "start", p.Start)
+}
+
+// ListAllProjectsParams holds the query and header parameters of ListAllProjects.
+type ListAllProjectsParams struct {
+	// Comma-separated environment IDs to include (default: all enabled environments)
+	EnvironmentIds string
+	// Limit
+	Limit *int64
+	// Sort direction
+	Order string
+	// Search query, forwarded to every environment
+	Search string
+	// Column to sort by (environment or name)
+	Sort string
+	// Start index
+	Start *int64
+	// Filter by project status
+	Status string
+}
+
+func (p *ListAllProjectsParams) apply(query url.Values, header http.Header) {
+	setString(query.Set, "environmentIds", p.EnvironmentIds)
+	setValue(query.Set, "limit", p.Limit)
+	setString(query.Set, "order", p.Order)
+	setString(query.Set, "search", p.Search)
+	setString(query.Set, "sort", p.Sort)
+	setValue(query.Set, "start", p.Start)
+	setString(query.Set, "status", p.Status)
+}
+
+// ListApiKeysParams holds the query and header parameters of ListApiKeys.
+type ListApiKeysParams struct {
+	// Number of items per page
+	Limit *int64
+	// Sort direction (asc or desc)
+	Order string
+	// Search query for filtering by name or description
+	Search string
+	// Column to sort by
+	Sort string
+	// Start index for pagination
+	Start *int64
+}
+
+func (p *ListApiKeysParams) apply(query url.Values, header http.Header) {
+	setValue(query.Set, "limit", p.Limit)
+	setString(query.Set, "order", p.Order)
+	setString(query.Set, "search", p.Search)
+	setString(query.Set, "sort", p.Sort)
+	setValue(query.Set, "start", p.Start)
+}
+
+// ListContainerRegistriesParams holds the query and header parameters of ListContainerRegistries.
+type ListContainerRegistriesParams struct {
+	// Items per page
+	Limit *int64
+	// Sort direction
+	Order string
+	// Search query
+	Search string
+	// Column to sort by
+	Sort string
+	// Start index
+	Start *int64
+}
+
+func (p *ListContainerRegistriesParams) apply(query url.Values, header http.Header) {
+	setValue(query.Set, "limit", p.Limit)
+	setString(query.Set, "order", p.Order)
+	setString(query.Set, "search", p.Search)
+	setString(query.Set, "sort", p.Sort)
+	setValue(query.Set, "start", p.Start)
+}
+
+// ListContainerTaskRunsParams holds the query and header parameters of ListContainerTaskRuns.
+type ListContainerTaskRunsParams struct {
+	// Maximum number of runs to return
+	Limit *int64
+}
+
+func (p *ListContainerTaskRunsParams) apply(query url.Values, header http.Header) {
+	setValue(query.Set, "limit", p.Limit)
+}
+
+// ListContainersParams holds the query and header parameters of ListContainers.
+type ListContainersParams struct {
+	// Include internal containers
+	IncludeInternal *bool
+	// Limit
+	Limit *int64
+	// Sort direction
+	Order string
+	// Search query
+	Search string
+	// Column to sort by
+	Sort string
+	// Start index
+	Start *int64
+	// Only list containers with every tag, comma-separated as key or key=value
+	Tags string
+	// Filter by update status (has_update, up_to_date, error, unknown)
+	Updates string
+}
+
+func (p *ListContainersParams) apply(query url.Values, header http.Header) {
+	setValue(query.Set, "includeInternal", p.IncludeInternal)
+	setValue(query.Set, "limit", p.Limit)
+	setString(query.Set, "order", p.Order)
+	setString(query.Set, "search", p.Search)
+	setString(query.Set, "sort", p.Sort)
+	setValue(query.Set, "start", p.Start)
+	setString(query.Set, "tags", p.Tags)
+	setString(query.Set, "updates", p.Updates)
+}
+
+// ListEnvironmentVulnerabilitiesParams holds the query and header parameters of ListEnvironmentVulnerabilities.
+type ListEnvironmentVulnerabilitiesParams struct {
+	// Filter by image/repo name (substring)
+	ImageName string
+	// Limit
+	Limit *int64
+	// Sort order
+	Order string
+	// Page number
+	Page *int64
+	// Search query
+	Search string
+	// Comma-separated severity filter
+	Severity string
+	// Sort field
+	Sort string
+	// Start offset
+	Start *int64
+}
+
+func (p *ListEnvironmentVulnerabilitiesParams) apply(query url.Values, header http.Header) {
+	setString(query.Set, "imageName", p.ImageName)
+	setValue(query.Set, "limit", p.Limit)
+	setString(query.Set, "order", p.Order)
+	setValue(query.Set, "page", p.Page)
+	setString(query.Set, "search", p.Search)
+	setString(query.Set, "severity", p.Severity)
+	setString(query.Set, "sort", p.Sort)
+	setValue(query.Set, "start", p.Start)
+}
+
+// ListEnvironmentVulnerabilityImageOptionsParams holds the query and header parameters of ListEnvironmentVulnerabilityImageOptions.
+type ListEnvironmentVulnerabilityImageOptionsParams struct {
+	// Comma-separated severity filter
+	Severity string
+}
+
+func (p *ListEnvironmentVulnerabilityImageOptionsParams) apply(query url.Values, header http.Header) {
+	setString(query.Set, "severity", p.Severity)
+}
+
+// ListEnvironmentsParams holds the query and header parameters of ListEnvironments.
+type ListEnvironmentsParams struct {
+	// Items per page
+	Limit *int64
+	// Sort direction (asc or desc)
+	Order string
+	// Search query for filtering by name or API URL
+	Search string
+	// Column to sort by
+	Sort string
+	// Start index for pagination
+	Start *int64
+	// Only list environments with every tag, comma-separated as key or key=value
+	Tags string
+}
+
+func (p *ListEnvironmentsParams) apply(query url.Values, header http.Header) {
+	setValue(query.Set, "limit", p.Limit)
+	setString(query.Set, "order", p.Order)
+	setString(query.Set, "search", p.Search)
+	setString(query.Set, "sort", p.Sort)
+	setValue(query.Set, "start", p.Start)
+	setString(query.Set, "tags", p.Tags)
+}
+
+// ListEventsParams holds the query and header parameters of ListEvents.
+type ListEventsParams struct {
+	// Limit
+	Limit *int64
+	// Sort direction
+	Order string
+	// Search query
+	Search string
+	// Filter by severity
+	Severity string
+	// Column to sort by
+	Sort string
+	// Start index
+	Start *int64
+	// Filter by event type
+	Type string
+}
+
+func (p *ListEventsParams) apply(query url.Values, header http.Header) {
+	setValue(query.Set, "limit", p.Limit)
+	setString(query.Set, "order", p.Order)
+	setString(query.Set, "search", p.Search)
+	setString(query.Set, "severity", p.Severity)
+	setString(query.Set, "sort", p.Sort)
+	setValue(query.Set, "start", p.Start)
+	setString(query.Set, "type", p.Type)
+}
+
+// ListGitOpsSyncsParams holds the query and header parameters of ListGitOpsSyncs.
+type ListGitOpsSyncsParams struct {
+	// Items per page
+	Limit *int64
+	// Sort direction
+	Order string
+	// Search query
+	Search string
+	// Column to sort by
+	Sort string
+	// Start index
+	Start *int64
+}
+
+func (p *ListGitOpsSyncsParams) apply(query url.Values, header http.Header) {
+	setValue(query.Set, "limit", p.Limit)
+	setString(query.Set, "order", p.Order)
+	setString(query.Set, "search", p.Search)
+	setString(query.Set, "sort", p.Sort)
+	setValue(query.Set, "start", p.Start)
+}
+
+// ListGitRepositoriesParams holds the query and header parameters of ListGitRepositories.
+type ListGitRepositoriesParams struct {
+	// Items per page
+	Limit *int64
+	// Sort direction
+	Order string
+	// Search query
+	Search string
+	// Column to sort by
+	Sort string
+	// Start index
+	Start *int64
+}
+
+func (p *ListGitRepositoriesParams) apply(query url.Values, header http.Header) {
+	setValue(query.Set, "limit", p.Limit)
+	setString(query.Set, "order", p.Order)
+	setString(query.Set, "search", p.Search)
+	setString(query.Set, "sort", p.Sort)
+	setValue(query.Set, "start", p.Start)
+}
+
+// ListIgnoredVulnerabilitiesParams holds the query and header parameters of ListIgnoredVulnerabilities.
+type ListIgnoredVulnerabilitiesParams struct {
+	// Limit
+	Limit *int64
+	// Sort order
+	Order string
+	// Page number
+	Page *int64
+	// Search query
+	Search string
+	// Sort field
+	Sort string
+	// Start offset
+	Start *int64
+}
+
+func (p *ListIgnoredVulnerabilitiesParams) apply(query url.Values, header http.Header) {
+	setValue(query.Set, "limit", p.Limit)
+	setString(query.Set, "order", p.Order)
+	setValue(query.Set, "page", p.Page)
+	setString(query.Set, "search", p.Search)
+	setString(query.Set, "sort", p.Sort)
+	setValue(query.Set, "start", p.Start)
+}
+
+// ListImageBuildsParams holds the query and header parameters of ListImageBuilds.
+type ListImageBuildsParams struct {
+	// Number of items per page
+	Limit *int64
+	// Sort direction (asc or desc)
+	Order string
+	// Filter by provider
+	Provider string
+	// Search query
+	Search string
+	// Column to sort by
+	Sort string
+	// Start index for pagination
+	Start *int64
+	// Filter by status
+	Status string
+}
+
+func (p *ListImageBuildsParams) apply(query url.Values, header http.Header) {
+	setValue(query.Set, "limit", p.Limit)
+	setString(query.Set, "order", p.Order)
+	setString(query.Set, "provider", p.Provider)
+	setString(query.Set, "search", p.Search)
+	setString(query.Set, "sort", p.Sort)
+	setValue(query.Set, "start", p.Start)
+	setString(query.Set, "status", p.Status)
+}
+
+// ListImagePullsParams holds the query and header parameters of ListImagePulls.
+type ListImagePullsParams struct {
+	// Number of items per page
+	Limit *int64
+	// Sort direction (asc or desc)
+	Order string
+	// Search query
+	Search string
+	// Column to sort by
+	Sort string
+	// Start index for pagination
+	Start *int64
+	// Filter by status
+	Status string
+	// Filter by trigger (user, updater or system)
+	Trigger string
+}
+
+func (p *ListImagePullsParams) apply(query url.Values, header http.Header) {
+	setValue(query.Set, "limit", p.Limit)
+	setString(query.Set, "order", p.Order)
+	setString(query.Set, "search", p.Search)
+	setString(query.Set, "sort", p.Sort)
+	setValue(query.Set, "start", p.Start)
+	setString(query.Set, "status", p.Status)
+	setString(query.Set, "trigger", p.Trigger)
+}
+
+// ListImageVulnerabilitiesParams holds the query and header parameters of ListImageVulnerabilities.
+type ListImageVulnerabilitiesParams struct {
+	// Limit
+	Limit *int64
+	// Sort order
+	Order string
+	// Page number
+	Page *int64
+	// Search query
+	Search string
+	// Comma-separated severity filter
+	Severity string
+	// Sort field
+	Sort string
+	// Start offset
+	Start *int64
+}
+
+func (p *ListImageVulnerabilitiesParams) apply(query url.Values, header http.Header) {
+	setValue(query.Set, "limit", p.Limit)
+	setString(query.Set, "order", p.Order)
+	setValue(query.Set, "page", p.Page)
+	setString(query.Set, "search", p.Search)
+	setString(query.Set, "severity", p.Severity)
+	setString(query.Set, "sort", p.Sort)
+	setValue(query.Set, "start", p.Start)
+}
+
+// ListImagesParams holds the query and header parameters of ListImages.
+type ListImagesParams struct {
+	// Filter by in-use status (true/false)
+	InUse string
+	// Number of items per page
+	Limit *int64
+	// Sort direction (asc or desc)
+	Order string
+	// Search query
+	Search string
+	// Column to sort by
+	Sort string
+	// Start index for pagination
+	Start *int64
+	// Filter by update availability (true/false)
+	Updates string
+}
+
+func (p *ListImagesParams) apply(query url.Values, header http.Header) {
+	setString(query.Set, "inUse", p.InUse)
+	setValue(query.Set, "limit", p.Limit)
+	setString(query.Set, "order", p.Order)
+	setString(query.Set, "search", p.Search)
+	setString(query.Set, "sort", p.Sort)
+	setValue(query.Set, "start", p.Start)
+	setString(query.Set, "updates", p.Updates)
+}
+
+// ListMonitorsParams holds the query and header parameters of ListMonitors.
+type ListMonitorsParams struct {
+	// Only return monitors for this environment
+	EnvironmentID string
+}
+
+func (p *ListMonitorsParams) apply(query url.Values, header http.Header) {
+	setString(query.Set, "environmentId", p.EnvironmentID)
+}
+
+// ListNetworksParams holds the query and header parameters of ListNetworks.
+type ListNetworksParams struct {
+	// Filter by in-use status (true/false)
+	InUse string
+	// Number of items per page
+	Limit *int64
+	// Sort direction (asc or desc)
+	Order string
+	// Search query
+	Search string
+	// Column to sort by
+	Sort string
+	// Start index for pagination
+	Start *int64
+}
+
+func (p *ListNetworksParams) apply(query url.Values, header http.Header) {
+	setString(query.Set, "inUse", p.InUse)
+	setValue(query.Set, "limit", p.Limit)
+	setString(query.Set, "order", p.Order)
+	setString(query.Set, "search", p.Search)
+	setString(query.Set, "sort", p.Sort)
+	setValue(query.Set, "start", p.Start)
+}
+
+// ListProjectsParams holds the query and header parameters of ListProjects.
+type ListProjectsParams struct {
+	// Number of items per page
+	Limit *int64
+	// Sort direction (asc or desc)
+	Order string
+	// Search query
+	Search string
+	// Column to sort by
+	Sort string
+	// Start index for pagination
+	Start *int64
+	// Filter by status (comma-separated: running,stopped,partially running)
+	Status string
+	// Only list projects with every tag, comma-separated as key or key=value
+	Tags string
+}
+
+func (p *ListProjectsParams) apply(query url.Values, header http.Header) {
+	setValue(query.Set, "limit", p.Limit)
+	setString(query.Set, "order", p.Order)
+	setString(query.Set, "search", p.Search)
+	setString(query.Set, "sort", p.Sort)
+	setValue(query.Set, "start", p.Start)
+	setString(query.Set, "status", p.Status)
+	setString(query.Set, "tags", p.Tags)
+}
+
+// ListRemoteTagsParams holds the query and header parameters of ListRemoteTags.
+type ListRemoteTagsParams struct {
+	// Image reference; its tag is ignored
+	ImageRef string
+	// Number of items per page
+	Limit *int64
+	// Sort direction (asc or desc)
+	Order string
+	// Only return tags containing this text
+	Search string
+	// Sort by semantic version or tag name
+	Sort string
+	// Start index for pagination
+	Start *int64
+}
+
+func (p *ListRemoteTagsParams) apply(query url.Values, header http.Header) {
+	setString(query.Set, "imageRef", p.ImageRef)
+	setValue(query.Set, "limit", p.Limit)
+	setString(query.Set, "order", p.Order)
+	setString(query.Set, "search", p.Search)
+	setString(query.Set, "sort", p.Sort)
+	setValue(query.Set, "start", p.Start)
+}
+
+// ListRoleBindingsParams holds the query and header parameters of ListRoleBindings.
+type ListRoleBindingsParams struct {
+	// Only return bindings for this user
+	UserID string
+}
+
+func (p *ListRoleBindingsParams) apply(query url.Values, header http.Header) {
+	setString(query.Set, "userId", p.UserID)
+}
+
+// ListSavedFiltersParams holds the query and header parameters of ListSavedFilters.
+type ListSavedFiltersParams struct {
+	// Only return saved filters for this resource type
+	ResourceType string
+}
+
+func (p *ListSavedFiltersParams) apply(query url.Values, header http.Header) {
+	setString(query.Set, "resourceType", p.ResourceType)
+}
+
+// ListTagKeysParams holds the query and header parameters of ListTagKeys.
+type ListTagKeysParams struct {
+	// Resource type to list tag keys for
+	ResourceType string
+}
+
+func (p *ListTagKeysParams) apply(query url.Values, header http.Header) {
+	setString(query.Set, "resourceType", p.ResourceType)
+}
+
+// ListTemplatesPaginatedParams holds the query and header parameters of ListTemplatesPaginated.
+type ListTemplatesPaginatedParams struct {
+	// Items per page
+	Limit *int64
+	// Sort direction
+	Order string
+	// Search query
+	Search string
+	// Column to sort by
+	Sort string
+	// Start index
+	Start *int64
+}
+
+func (p *ListTemplatesPaginatedParams) apply(query url.Values, header http.Header) {
+	setValue(query.Set, "limit", p.Limit)
+	setString(query.Set, "order", p.Order)
+	setString(query.Set, "search", p.Search)
+	setString(query.Set, "sort", p.Sort)
+	setValue(query.Set, "start", p.Start)
+}
+
+// ListUpdaterRunsParams holds the query and header parameters of ListUpdaterRuns.
+type ListUpdaterRunsParams struct {
+	// Number of items per page
+	Limit *int64
+	// Sort direction (asc or desc)
+	Order string
+	// Column to sort by
+	Sort string
+	// Start index for pagination
+	Start *int64
+	// Filter by status
+	Status string
+	// Filter by trigger (schedule, manual or webhook)
+	Trigger string
+}
+
+func (p *ListUpdaterRunsParams) apply(query url.Values, header http.Header) {
+	setValue(query.Set, "limit", p.Limit)
+	setString(query.Set, "order", p.Order)
+	setString(query.Set, "sort", p.Sort)
+	setValue(query.Set, "start", p.Start)
+	setString(query.Set, "status", p.Status)
+	setString(query.Set, "trigger", p.Trigger)
+}
+
+// ListUsersParams holds the query and header parameters of ListUsers.
+type ListUsersParams struct {
+	// Items per page
+	Limit *int64
+	// Sort direction
+	Order string
+	// Search query
+	Search string
+	// Column to sort by
+	Sort string
+	// Start index
+	Start *int64
+}
+
+func (p *ListUsersParams) apply(query url.Values, header http.Header) {
+	setValue(query.Set, "limit", p.Limit)
+	setString(query.Set, "order", p.Order)
+	setString(query.Set, "search", p.Search)
+	setString(query.Set, "sort", p.Sort)
+	setValue(query.Set, "start", p.Start)
+}
+
+// ListVolumeBackupsParams holds the query and header parameters of ListVolumeBackups.
+type ListVolumeBackupsParams struct {
+	// Limit
+	Limit *int64
+	// Sort direction
+	Order string
+	// Search query
+	Search string
+	// Column to sort by
+	Sort string
+	// Start index
+	Start *int64
+}
+
+func (p *ListVolumeBackupsParams) apply(query url.Values, header http.Header) {
+	setValue(query.Set, "limit", p.Limit)
+	setString(query.Set, "order", p.Order)
+	setString(query.Set, "search", p.Search)
+	setString(query.Set, "sort", p.Sort)
+	setValue(query.Set, "start", p.Start)
+}
+
+// ListVolumesParams holds the query and header parameters of ListVolumes.
+type ListVolumesParams struct {
+	// Filter by in-use status (true/false)
+	InUse string
+	// Include internal volumes
+	IncludeInternal *bool
+	// Number of items per page
+	Limit *int64
+	// Sort direction (asc or desc)
+	Order string
+	// Search query
+	Search string
+	// Column to sort by
+	Sort string
+	// Start index for pagination
+	Start *int64
+}
+
+func (p *ListVolumesParams) apply(query url.Values, header http.Header) {
+	setString(query.Set, "inUse", p.InUse)
+	setValue(query.Set, "includeInternal", p.IncludeInternal)
+	setValue(query.Set, "limit", p.Limit)
+	setString(query.Set, "order", p.Order)
+	setString(query.Set, "search", p.Search)
+	setString(query.Set, "sort", p.Sort)
+	setValue(query.Set, "start", p.Start)
+}
+
+// LogalertCreateRule is generated from the OpenAPI specification.
+type LogalertCreateRule struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Name of a single container to watch
+	ContainerName *string `json:"containerName,omitempty"`
+	// Minimum seconds between two alerts of the rule (default 300, minimum 10)
+	CooldownSeconds *int64 `json:"cooldownSeconds,omitempty"`
+	// Whether log lines are matched (default true)
+	Enabled *bool `json:"enabled,omitempty"`
+	// Display name of the rule
+	Name string `json:"name"`
+	// Regular expression (RE2 syntax) matched against each log line, e.g. '(?i)out of memory'
+	Pattern string `json:"pattern"`
+	// Compose project whose containers are watched
+	ProjectID *string `json:"projectId,omitempty"`
+	// Compose services of the project to watch; all services when empty
+	Services []string `json:"services,omitempty"`
+}
+
+// LogalertRule is generated from the OpenAPI specification.
+type LogalertRule struct {
+	// Number of containers whose logs are currently watched
+	AttachedContainers int64 `json:"attachedContainers"`
+	// Name of a single container to watch
+	ContainerName *string `json:"containerName,omitempty"`
+	// Minimum seconds between two alerts of the rule
+	CooldownSeconds int64 `json:"cooldownSeconds"`
+	// Creation timestamp
+	CreatedAt time.Time `json:"createdAt"`
+	// Whether log lines are matched
+	Enabled bool `json:"enabled"`
+	// Unique identifier of the rule
+	ID string `json:"id"`
+	// Why the watched containers could not be resolved, if they could not
+	LastError *string `json:"lastError,omitempty"`
+	// Excerpt of the log line that last triggered the rule
+	LastExcerpt *string `json:"lastExcerpt,omitempty"`
+	// Time the rule last sent an alert
+	LastFiredAt *time.Time `json:"lastFiredAt,omitempty"`
+	// Display name of the rule
+	Name string `json:"name"`
+	// Regular expression (RE2 syntax) matched against each log line
+	Pattern string `json:"pattern"`
+	// Compose project whose containers are watched
+	ProjectID *string `json:"projectId,omitempty"`
+	// Compose services of the project to watch; all services when empty
+	Services []string `json:"services"`
+	// Last update timestamp
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+// LogalertUpdateRule is generated from the OpenAPI specification.
+type LogalertUpdateRule struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Name of a single container to watch
+	ContainerName *string `json:"containerName,omitempty"`
+	// Minimum seconds between two alerts of the rule
+	CooldownSeconds *int64 `json:"cooldownSeconds,omitempty"`
+	// Whether log lines are matched
+	Enabled *bool `json:"enabled,omitempty"`
+	// Display name of the rule
+	Name *string `json:"name,omitempty"`
+	// Regular expression (RE2 syntax) matched against each log line
+	Pattern *string `json:"pattern,omitempty"`
+	// Compose project whose containers are watched
+	ProjectID *string `json:"projectId,omitempty"`
+	// Compose services of the project to watch; all services when empty
+	Services []string `json:"services,omitempty"`
+}
+
+// LogforwardLogForwarder is generated from the OpenAPI specification.
+type LogforwardLogForwarder struct {
+	// Number of containers whose logs are currently forwarded
+	AttachedContainers int64 `json:"attachedContainers"`
+	// Creation timestamp
+	CreatedAt time.Time `json:"createdAt"`
+	// Whether logs are forwarded
+	Enabled bool `json:"enabled"`
+	// Number of rotated log files to keep
+	FileMaxFiles int64 `json:"fileMaxFiles"`
+	// Size in MB at which the log file is rotated
+	FileMaxSizeMb int64 `json:"fileMaxSizeMb"`
+	// Whether a Loki credential is stored
+	HasToken bool `json:"hasToken"`
+	// Unique identifier of the forwarder
+	ID string `json:"id"`
+	// Error from the last failed delivery
+	LastError *string `json:"lastError,omitempty"`
+	// Time logs were last delivered
+	LastShippedAt *time.Time `json:"lastShippedAt,omitempty"`
+	// Project whose containers are forwarded
+	ProjectID string `json:"projectId"`
+	// Compose services to forward; all services when empty
+	Services []string `json:"services"`
+	// Destination type
+	SinkType string `json:"sinkType"`
+	// Loki push URL, syslog host:port or absolute file path
+	Target string `json:"target"`
+	// Last update timestamp
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+// LogforwardUpsertLogForwarder is generated from the OpenAPI specification.
+type LogforwardUpsertLogForwarder struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Whether logs are forwarded (default true)
+	Enabled *bool `json:"enabled,omitempty"`
+	// Number of rotated log files to keep (default 5)
+	FileMaxFiles *int64 `json:"fileMaxFiles,omitempty"`
+	// Size in MB at which the log file is rotated (default 10)
+	FileMaxSizeMb *int64 `json:"fileMaxSizeMb,omitempty"`
+	// Compose services to forward; all services when empty
+	Services []string `json:"services,omitempty"`
+	// Destination type
+	SinkType string `json:"sinkType"`
+	// Loki push URL, syslog host:port or absolute file path
+	Target string `json:"target"`
+	// Loki bearer token, or user:password for basic auth. Omit to keep the stored value, send an empty string to clear it
+	Token *string `json:"token,omitempty"`
+}
+
+// LoggingConfig is generated from the OpenAPI specification.
+type LoggingConfig struct {
+	Driver  *string           `json:"driver,omitempty"`
+	Options map[string]string `json:"options,omitempty"`
+}
+
+// MetaMetadata is generated from the OpenAPI specification.
+type MetaMetadata struct {
+	Description *string  `json:"description,omitempty"`
+	Key         string   `json:"key"`
+	Keywords    []string `json:"keywords,omitempty"`
+	Label       string   `json:"label"`
+	Type        string   `json:"type"`
+}
+
+// MetaTemplateMeta is generated from the OpenAPI specification.
+type MetaTemplateMeta struct {
+	Author           *string  `json:"author,omitempty"`
+	DocumentationURL *string  `json:"documentationUrl,omitempty"`
+	EnvURL           *string  `json:"envUrl,omitempty"`
+	IconURL          *string  `json:"iconUrl,omitempty"`
+	RemoteURL        *string  `json:"remoteUrl,omitempty"`
+	Tags             []string `json:"tags,omitempty"`
+	UpdatedAt        *string  `json:"updatedAt,omitempty"`
+	Version          *string  `json:"version,omitempty"`
+}
+
+// MonitorCheckResult is generated from the OpenAPI specification.
+type MonitorCheckResult struct {
+	// Time of the check
+	CheckedAt time.Time `json:"checkedAt"`
+	// Failure reason
+	Error *string `json:"error,omitempty"`
+	// Check latency in milliseconds
+	LatencyMs int64 `json:"latencyMs"`
+	// Monitor that was checked
+	MonitorID string `json:"monitorId"`
+	// HTTP status code returned by the target
+	StatusCode *int64 `json:"statusCode,omitempty"`
+	// Whether the check succeeded
+	Up bool `json:"up"`
+}
+
+// MonitorCreateMonitor is generated from the OpenAPI specification.
+type MonitorCreateMonitor struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Container the monitor is tied to
+	ContainerID *string `json:"containerId,omitempty"`
+	// Whether the monitor is checked (default true)
+	Enabled *bool `json:"enabled,omitempty"`
+	// Environment the monitored service runs in (default local)
+	EnvironmentID *string `json:"environmentId,omitempty"`
+	// HTTP status code that counts as up (any 2xx/3xx when 0)
+	ExpectedStatus *int64 `json:"expectedStatus,omitempty"`
+	// Consecutive failures before alerting (default 1)
+	FailureThreshold *int64 `json:"failureThreshold,omitempty"`
+	// Seconds between checks (default 60, minimum 15)
+	IntervalSeconds *int64 `json:"intervalSeconds,omitempty"`
+	// Display name of the monitor
+	Name string `json:"name"`
+	// Compose project the monitor is tied to
+	ProjectID *string `json:"projectId,omitempty"`
+	// Compose service the monitor is tied to
+	ServiceName *string `json:"serviceName,omitempty"`
+	// URL for HTTP checks or host:port for TCP checks
+	Target string `json:"target"`
+	// Seconds before a check fails (default 10)
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty"`
+	// Check type
+	Type string `json:"type"`
+}
+
+// MonitorMonitor is generated from the OpenAPI specification.
+type MonitorMonitor struct {
+	// Number of failed checks in a row
+	ConsecutiveFailures int64 `json:"consecutiveFailures"`
+	// Container the monitor is tied to
+	ContainerID *string `json:"containerId,omitempty"`
+	// Creation timestamp
+	CreatedAt time.Time `json:"createdAt"`
+	// Whether the monitor is checked
+	Enabled bool `json:"enabled"`
+	// Environment the monitored service runs in
+	EnvironmentID string `json:"environmentId"`
+	// HTTP status code that counts as up (any 2xx/3xx when 0)
+	ExpectedStatus *int64 `json:"expectedStatus,omitempty"`
+	// Consecutive failures before the monitor is reported down
+	FailureThreshold int64 `json:"failureThreshold"`
+	// Unique identifier of the monitor
+	ID string `json:"id"`
+	// Seconds between checks
+	IntervalSeconds int64 `json:"intervalSeconds"`
+	// Time of the last check
+	LastCheckedAt *time.Time `json:"lastCheckedAt,omitempty"`
+	// Error returned by the last failed check
+	LastError *string `json:"lastError,omitempty"`
+	// Latency of the last check in milliseconds
+	LastLatencyMs *int64 `json:"lastLatencyMs,omitempty"`
+	// Time the status last changed
+	LastStatusChangeAt *time.Time `json:"lastStatusChangeAt,omitempty"`
+	// Display name of the monitor
+	Name string `json:"name"`
+	// Compose project the monitor is tied to
+	ProjectID *string `json:"projectId,omitempty"`
+	// Compose service the monitor is tied to
+	ServiceName *string `json:"serviceName,omitempty"`
+	// Current status
+	Status string `json:"status"`
+	// URL for HTTP checks or host:port for TCP checks
+	Target string `json:"target"`
+	// Seconds before a check is considered failed
+	TimeoutSeconds int64 `json:"timeoutSeconds"`
+	// Check type
+	Type string `json:"type"`
+	// Last update timestamp
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+// MonitorUpdateMonitor is generated from the OpenAPI specification.
+type MonitorUpdateMonitor struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Container the monitor is tied to
+	ContainerID *string `json:"containerId,omitempty"`
+	// Whether the monitor is checked
+	Enabled *bool `json:"enabled,omitempty"`
+	// HTTP status code that counts as up
+	ExpectedStatus *int64 `json:"expectedStatus,omitempty"`
+	// Consecutive failures before alerting
+	FailureThreshold *int64 `json:"failureThreshold,omitempty"`
+	// Seconds between checks
+	IntervalSeconds *int64 `json:"intervalSeconds,omitempty"`
+	// Display name of the monitor
+	Name *string `json:"name,omitempty"`
+	// Compose project the monitor is tied to
+	ProjectID *string `json:"projectId,omitempty"`
+	// Compose service the monitor is tied to
+	ServiceName *string `json:"serviceName,omitempty"`
+	// URL for HTTP checks or host:port for TCP checks
+	Target *string `json:"target,omitempty"`
+	// Seconds before a check fails
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty"`
+}
+
+// NetworkContainerEndpoint is generated from the OpenAPI specification.
+type NetworkContainerEndpoint struct {
+	EndpointID  string `json:"endpointId"`
+	ID          string `json:"id"`
+	Ipv4Address string `json:"ipv4Address"`
+	Ipv6Address string `json:"ipv6Address"`
+	MacAddress  string `json:"macAddress"`
+	Name        string `json:"name"`
+}
+
+// NetworkCountsApiResponse is generated from the OpenAPI specification.
+type NetworkCountsApiResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema  *string            `json:"$schema,omitempty"`
+	Data    NetworkUsageCounts `json:"data"`
+	Success bool               `json:"success"`
+}
+
+// NetworkCreateOptions is generated from the OpenAPI specification.
+type NetworkCreateOptions struct {
+	// Allow manual container attachment
+	Attachable *bool `json:"attachable,omitempty"`
+	// Check for duplicate network names
+	CheckDuplicate *bool `json:"checkDuplicate,omitempty"`
+	// Network driver (e.g., bridge, overlay)
+	Driver *string `json:"driver,omitempty"`
+	// Enable IPv6 networking
+	EnableIPv6 *bool `json:"enableIPv6,omitempty"`
+	// Enable routing-mesh for swarm cluster
+	Ingress *bool `json:"ingress,omitempty"`
+	// Restrict external access to the network
+	Internal *bool `json:"internal,omitempty"`
+	// IP Address Management configuration
+	Ipam *NetworkIPAM `json:"ipam,omitempty"`
+	// User-defined labels
+	Labels map[string]string `json:"labels,omitempty"`
+	// Driver-specific options
+	Options map[string]string `json:"options,omitempty"`
+}
+
+// NetworkCreateRequest is generated from the OpenAPI specification.
+type NetworkCreateRequest struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Name of the network
+	Name string `json:"name"`
+	// Network creation options
+	Options NetworkCreateOptions `json:"options"`
+}
+
+// NetworkCreateResponse is generated from the OpenAPI specification.
+type NetworkCreateResponse struct {
+	ID      string  `json:"id"`
+	Warning *string `json:"warning,omitempty"`
+}
+
+// NetworkCreatedApiResponse is generated from the OpenAPI specification.
+type NetworkCreatedApiResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema  *string               `json:"$schema,omitempty"`
+	Data    NetworkCreateResponse `json:"data"`
+	Success bool                  `json:"success"`
+}
+
+// NetworkIPAM is generated from the OpenAPI specification.
+type NetworkIPAM struct {
+	Config  []NetworkIPAMConfig `json:"config,omitempty"`
+	Driver  *string             `json:"driver,omitempty"`
+	Options map[string]string   `json:"options,omitempty"`
+}
+
+// NetworkIPAMConfig is generated from the OpenAPI specification.
+type NetworkIPAMConfig struct {
+	AuxAddress map[string]string `json:"auxAddress,omitempty"`
+	Gateway    *string           `json:"gateway,omitempty"`
+	IPRange    *string           `json:"ipRange,omitempty"`
+	Subnet     *string           `json:"subnet,omitempty"`
+}
+
+// NetworkInspect is generated from the OpenAPI specification.
+type NetworkInspect struct {
+	Attachable     bool                                     `json:"attachable"`
+	ConfigFrom     DockerNetworkConfigReference             `json:"configFrom"`
+	ConfigOnly     bool                                     `json:"configOnly"`
+	Containers     map[string]DockerNetworkEndpointResource `json:"containers"`
+	ContainersList []NetworkContainerEndpoint               `json:"containersList"`
+	Created        time.Time                                `json:"created"`
+	Driver         string                                   `json:"driver"`
+	EnableIPv4     bool                                     `json:"enableIPv4"`
+	EnableIPv6     bool                                     `json:"enableIPv6"`
+	ID             string                                   `json:"id"`
+	Ingress        bool                                     `json:"ingress"`
+	Internal       bool                                     `json:"internal"`
+	Ipam           DockerNetworkIPAM                        `json:"ipam"`
+	Labels         map[string]string                        `json:"labels"`
+	Name           string                                   `json:"name"`
+	Options        map[string]string                        `json:"options"`
+	Peers          []DockerNetworkPeerInfo                  `json:"peers,omitempty"`
+	Scope          string                                   `json:"scope"`
+	Services       map[string]DockerNetworkServiceInfo      `json:"services,omitempty"`
+}
+
+// NetworkInspectApiResponse is generated from the OpenAPI specification.
+type NetworkInspectApiResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema  *string        `json:"$schema,omitempty"`
+	Data    NetworkInspect `json:"data"`
+	Success bool           `json:"success"`
+}
+
+// NetworkMessageApiResponse is generated from the OpenAPI specification.
+type NetworkMessageApiResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema  *string             `json:"$schema,omitempty"`
+	Data    BaseMessageResponse `json:"data"`
+	Success bool                `json:"success"`
+}
+
+// NetworkPaginatedResponse is generated from the OpenAPI specification.
+type NetworkPaginatedResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema     *string                `json:"$schema,omitempty"`
+	Counts     NetworkUsageCounts     `json:"counts"`
+	Data       []NetworkSummary       `json:"data"`
+	Pagination BasePaginationResponse `json:"pagination"`
+	Success    bool                   `json:"success"`
+}
+
+// NetworkPruneReport is generated from the OpenAPI specification.
+type NetworkPruneReport struct {
+	NetworksDeleted []string `json:"networksDeleted"`
+	SpaceReclaimed  int64    `json:"spaceReclaimed"`
+}
+
+// NetworkPruneResponse is generated from the OpenAPI specification.
+type NetworkPruneResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema  *string            `json:"$schema,omitempty"`
+	Data    NetworkPruneReport `json:"data"`
+	Success bool               `json:"success"`
+}
+
+// NetworkSummary is generated from the OpenAPI specification.
+type NetworkSummary struct {
+	Created   time.Time         `json:"created"`
+	Driver    string            `json:"driver"`
+	ID        string            `json:"id"`
+	InUse     bool              `json:"inUse"`
+	IsDefault bool              `json:"isDefault"`
+	Labels    map[string]string `json:"labels"`
+	Name      string            `json:"name"`
+	Options   map[string]string `json:"options"`
+	Scope     string            `json:"scope"`
+}
+
+// NetworkUsageCounts is generated from the OpenAPI specification.
+type NetworkUsageCounts struct {
+	Inuse  int64 `json:"inuse"`
+	Total  int64 `json:"total"`
+	Unused int64 `json:"unused"`
+}
+
+// NotificationAppriseResponse is generated from the OpenAPI specification.
+type NotificationAppriseResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema             *string `json:"$schema,omitempty"`
+	APIURL             string  `json:"apiUrl"`
+	ContainerUpdateTag string  `json:"containerUpdateTag"`
+	Enabled            bool    `json:"enabled"`
+	ID                 int64   `json:"id"`
+	ImageUpdateTag     string  `json:"imageUpdateTag"`
+}
+
+// NotificationAppriseUpdate is generated from the OpenAPI specification.
+type NotificationAppriseUpdate struct {
+	// A URL to the JSON Schema for this object.
+	Schema             *string `json:"$schema,omitempty"`
+	APIURL             string  `json:"apiUrl"`
+	ContainerUpdateTag string  `json:"containerUpdateTag"`
+	Enabled            bool    `json:"enabled"`
+	ImageUpdateTag     string  `json:"imageUpdateTag"`
+}
+
+// NotificationBundle is generated from the OpenAPI specification.
+type NotificationBundle struct {
+	ExportedAt time.Time                    `json:"exportedAt"`
+	Providers  []NotificationBundleProvider `json:"providers"`
+	Salt       *string                      `json:"salt,omitempty"`
+	Version    int64                        `json:"version"`
+}
+
+// NotificationBundleProvider is generated from the OpenAPI specification.
+type NotificationBundleProvider struct {
+	Config     map[string]any `json:"config"`
+	Enabled    bool           `json:"enabled"`
+	Provider   string         `json:"provider"`
+	SecretKeys []string       `json:"secretKeys,omitempty"`
+}
+
+// NotificationExportRequest is generated from the OpenAPI specification.
+type NotificationExportRequest struct {
+	// A URL to the JSON Schema for this object.
+	Schema     *string `json:"$schema,omitempty"`
+	Passphrase *string `json:"passphrase,omitempty"`
+}
+
+// NotificationImportRequest is generated from the OpenAPI specification.
+type NotificationImportRequest struct {
+	// A URL to the JSON Schema for this object.
+	Schema     *string            `json:"$schema,omitempty"`
+	Bundle     NotificationBundle `json:"bundle"`
+	Passphrase *string            `json:"passphrase,omitempty"`
+}
+
+// NotificationResponse is generated from the OpenAPI specification.
+type NotificationResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema              *string        `json:"$schema,omitempty"`
+	Config              map[string]any `json:"config"`
+	CredentialCheckedAt *time.Time     `json:"credentialCheckedAt,omitempty"`
+	CredentialError     *string        `json:"credentialError,omitempty"`
+	CredentialStatus    *string        `json:"credentialStatus,omitempty"`
+	Enabled             bool           `json:"enabled"`
+	ID                  int64          `json:"id"`
+	Provider            string         `json:"provider"`
+}
+
+// NotificationSettingsImportResult is generated from the OpenAPI specification.
+type NotificationSettingsImportResult struct {
+	Imported []string `json:"imported"`
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// NotificationSubscription is generated from the OpenAPI specification.
+type NotificationSubscription struct {
+	Enabled           bool       `json:"enabled"`
+	Events            []string   `json:"events"`
+	ID                string     `json:"id"`
+	Provider          string     `json:"provider"`
+	ProviderAvailable bool       `json:"providerAvailable"`
+	Target            string     `json:"target"`
+	UpdatedAt         *time.Time `json:"updatedAt,omitempty"`
+}
+
+// NotificationSubscriptionUpdate is generated from the OpenAPI specification.
+type NotificationSubscriptionUpdate struct {
+	// A URL to the JSON Schema for this object.
+	Schema  *string  `json:"$schema,omitempty"`
+	Enabled bool     `json:"enabled"`
+	Events  []string `json:"events"`
+	Target  string   `json:"target"`
+}
+
+// NotificationUpdate is generated from the OpenAPI specification.
+type NotificationUpdate struct {
+	// A URL to the JSON Schema for this object.
+	Schema   *string        `json:"$schema,omitempty"`
+	Config   map[string]any `json:"config"`
+	Enabled  bool           `json:"enabled"`
+	Provider string         `json:"provider"`
+}
+
+// NotificationactionAction is generated from the OpenAPI specification.
+type NotificationactionAction struct {
+	// Action the link performs: image.apply-update or image.snooze
+	Action string `json:"action"`
+	// Time the link stops working
+	ExpiresAt time.Time `json:"expiresAt"`
+	// Image the action applies to
+	ImageRef string `json:"imageRef"`
+	// Days notifications are snoozed for
+	SnoozeDays *int64 `json:"snoozeDays,omitempty"`
+}
+
+// NotificationactionOutcome is generated from the OpenAPI specification.
+type NotificationactionOutcome struct {
+	// Action that was performed
+	Action string `json:"action"`
+	// Image the action applies to
+	ImageRef string `json:"imageRef"`
+	// Human-readable summary of the outcome
+	Text string `json:"text"`
+}
+
+// PairEnvironmentParams holds the query and header parameters of PairEnvironment.
+type PairEnvironmentParams struct {
+	// API key for environment pairing
+	XAPIKey string
+	// Fingerprint of the agent host shown to admins for approval
+	XAgentFingerprint string
+}
+
+func (p *PairEnvironmentParams) apply(query url.Values, header http.Header) {
+	setString(header.Set, "X-API-Key", p.XAPIKey)
+	setString(header.Set, "X-Agent-Fingerprint", p.XAgentFingerprint)
+}
+
+// PatchProjectServiceParams holds the query and header parameters of PatchProjectService.
+type PatchProjectServiceParams struct {
+	// Edit the project even though it is managed by GitOps
+	OverrideGitOps *bool
+}
+
+func (p *PatchProjectServiceParams) apply(query url.Values, header http.Header) {
+	setValue(query.Set, "overrideGitOps", p.OverrideGitOps)
+}
+
+// ProjectAdoptContainers is generated from the OpenAPI specification.
+type ProjectAdoptContainers struct {
+	// A URL to the JSON Schema for this object.
+	Schema       *string  `json:"$schema,omitempty"`
+	ContainerIds []string `json:"containerIds"`
+	Name         string   `json:"name"`
+	Recreate     *bool    `json:"recreate,omitempty"`
+}
+
+// ProjectClone is generated from the OpenAPI specification.
+type ProjectClone struct {
+	// A URL to the JSON Schema for this object.
+	Schema   *string `json:"$schema,omitempty"`
+	CopyData *bool   `json:"copyData,omitempty"`
+	Name     string  `json:"name"`
+}
+
+// ProjectCloneResult is generated from the OpenAPI specification.
+type ProjectCloneResult struct {
+	Changes []string       `json:"changes"`
+	Project ProjectDetails `json:"project"`
+}
+
+// ProjectCreateCustomFile is generated from the OpenAPI specification.
+type ProjectCreateCustomFile struct {
+	// A URL to the JSON Schema for this object.
+	Schema       *string `json:"$schema,omitempty"`
+	Content      *string `json:"content,omitempty"`
+	RelativePath string  `json:"relativePath"`
+	Type         *string `json:"type,omitempty"`
+}
+
+// ProjectCreateProject is generated from the OpenAPI specification.
+type ProjectCreateProject struct {
+	// A URL to the JSON Schema for this object.
+	Schema         *string `json:"$schema,omitempty"`
+	ComposeContent string  `json:"composeContent"`
+	EnvContent     *string `json:"envContent,omitempty"`
+	Name           string  `json:"name"`
+}
+
+// ProjectCreateReponse is generated from the OpenAPI specification.
+type ProjectCreateReponse struct {
+	CreatedAt       string  `json:"createdAt"`
+	DirName         *string `json:"dirName,omitempty"`
+	GitOpsManagedBy *string `json:"gitOpsManagedBy,omitempty"`
+	ID              string  `json:"id"`
+	Name            string  `json:"name"`
+	Path            string  `json:"path"`
+	RunningCount    int64   `json:"runningCount"`
+	ServiceCount    int64   `json:"serviceCount"`
+	Status          string  `json:"status"`
+	StatusReason    *string `json:"statusReason,omitempty"`
+	UpdatedAt       string  `json:"updatedAt"`
+}
+
+// ProjectCustomFileTemplate is generated from the OpenAPI specification.
+type ProjectCustomFileTemplate struct {
+	Content     string `json:"content"`
+	DefaultName string `json:"defaultName"`
+	Type        string `json:"type"`
+}
+
+// ProjectDeleteFileResult is generated from the OpenAPI specification.
+type ProjectDeleteFileResult struct {
+	References   []ProjectFileReference `json:"references,omitempty"`
+	RelativePath string                 `json:"relativePath"`
+}
+
+// ProjectDeployOptions is generated from the OpenAPI specification.
+type ProjectDeployOptions struct {
+	// A URL to the JSON Schema for this object.
+	Schema        *string `json:"$schema,omitempty"`
+	ForceRecreate *bool   `json:"forceRecreate,omitempty"`
+	PullPolicy    *string `json:"pullPolicy,omitempty"`
+}
+
+// ProjectDestroy is generated from the OpenAPI specification.
+type ProjectDestroy struct {
+	// A URL to the JSON Schema for this object.
+	Schema        *string `json:"$schema,omitempty"`
+	RemoveFiles   *bool   `json:"removeFiles,omitempty"`
+	RemoveVolumes *bool   `json:"removeVolumes,omitempty"`
+}
+
+// ProjectDetails is generated from the OpenAPI specification.
+type ProjectDetails struct {
+	ComposeContent       *string                    `json:"composeContent,omitempty"`
+	CreatedAt            string                     `json:"createdAt"`
+	DirName              *string                    `json:"dirName,omitempty"`
+	EnvContent           *string                    `json:"envContent,omitempty"`
+	EnvWarnings          []string                   `json:"envWarnings,omitempty"`
+	GitOpsManagedBy      *string                    `json:"gitOpsManagedBy,omitempty"`
+	GitRepositoryURL     *string                    `json:"gitRepositoryURL,omitempty"`
+	HasBuildDirective    *bool                      `json:"hasBuildDirective,omitempty"`
+	IconURL              *string                    `json:"iconUrl,omitempty"`
+	ID                   string                     `json:"id"`
+	IncludeFiles         []ProjectIncludeFile       `json:"includeFiles,omitempty"`
+	LastSyncCommit       *string                    `json:"lastSyncCommit,omitempty"`
+	LintFindings         []ProjectLintFinding       `json:"lintFindings,omitempty"`
+	LockReason           *string                    `json:"lockReason,omitempty"`
+	Locked               *bool                      `json:"locked,omitempty"`
+	LockedAt             *string                    `json:"lockedAt,omitempty"`
+	LockedBy             *string                    `json:"lockedBy,omitempty"`
+	Maintenance          *bool                      `json:"maintenance,omitempty"`
+	MaintenanceMessage   *string                    `json:"maintenanceMessage,omitempty"`
+	MaintenanceStartedAt *string                    `json:"maintenanceStartedAt,omitempty"`
+	Name                 string                     `json:"name"`
+	Path                 string                     `json:"path"`
+	RunningCount         int64                      `json:"runningCount"`
+	RuntimeServices      []ProjectRuntimeService    `json:"runtimeServices,omitempty"`
+	ServiceCount         int64                      `json:"serviceCount"`
+	Services             []DockerTypesServiceConfig `json:"services,omitempty"`
+	Status               string                     `json:"status"`
+	StatusReason         *string                    `json:"statusReason,omitempty"`
+	Tags                 map[string]string          `json:"tags,omitempty"`
+	UpdatedAt            string                     `json:"updatedAt"`
+	Urls                 []string                   `json:"urls,omitempty"`
+}
+
+// ProjectEnvCheck is generated from the OpenAPI specification.
+type ProjectEnvCheck struct {
+	Empty     []string             `json:"empty"`
+	Missing   []string             `json:"missing"`
+	Unused    []string             `json:"unused"`
+	Variables []ProjectEnvVariable `json:"variables"`
+}
+
+// ProjectEnvVariable is generated from the OpenAPI specification.
+type ProjectEnvVariable struct {
+	Defined    bool               `json:"defined"`
+	Files      map[string][]int64 `json:"files"`
+	HasDefault bool               `json:"hasDefault"`
+	Name       string             `json:"name"`
+	Required   bool               `json:"required"`
+	Source     *string            `json:"source,omitempty"`
+}
+
+// ProjectFileReference is generated from the OpenAPI specification.
+type ProjectFileReference struct {
+	ComposeFile string  `json:"composeFile"`
+	Kind        string  `json:"kind"`
+	Service     *string `json:"service,omitempty"`
+	Value       string  `json:"value"`
+}
+
+// ProjectIncludeFile is generated from the OpenAPI specification.
+type ProjectIncludeFile struct {
+	Content      string `json:"content"`
+	Path         string `json:"path"`
+	RelativePath string `json:"relativePath"`
+}
+
+// ProjectLintFinding is generated from the OpenAPI specification.
+type ProjectLintFinding struct {
+	Message  string `json:"message"`
+	Rule     string `json:"rule"`
+	Service  string `json:"service"`
+	Severity string `json:"severity"`
+}
+
+// ProjectLock is generated from the OpenAPI specification.
+type ProjectLock struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	Reason *string `json:"reason,omitempty"`
+}
+
+// ProjectPaginatedResponse is generated from the OpenAPI specification.
+type ProjectPaginatedResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema     *string                `json:"$schema,omitempty"`
+	Data       []ProjectDetails       `json:"data"`
+	Pagination BasePaginationResponse `json:"pagination"`
+	Success    bool                   `json:"success"`
+}
+
+// ProjectPathMappingDiagnostics is generated from the OpenAPI specification.
+type ProjectPathMappingDiagnostics struct {
+	ConfiguredValue     string   `json:"configuredValue"`
+	ContainerPath       string   `json:"containerPath"`
+	Decision            string   `json:"decision"`
+	DiscoveryError      *string  `json:"discoveryError,omitempty"`
+	HostPath            *string  `json:"hostPath,omitempty"`
+	HostPathSource      string   `json:"hostPathSource"`
+	MountDestination    *string  `json:"mountDestination,omitempty"`
+	MountSource         *string  `json:"mountSource,omitempty"`
+	MountType           *string  `json:"mountType,omitempty"`
+	SampleContainerPath string   `json:"sampleContainerPath"`
+	SampleHostPath      string   `json:"sampleHostPath"`
+	TranslationActive   bool     `json:"translationActive"`
+	Warnings            []string `json:"warnings,omitempty"`
+}
+
+// ProjectQuotaAllocation is generated from the OpenAPI specification.
+type ProjectQuotaAllocation struct {
+	Cpus        float64                    `json:"cpus"`
+	MemoryBytes int64                      `json:"memoryBytes"`
+	Services    []ProjectServiceAllocation `json:"services"`
+	Warnings    []string                   `json:"warnings"`
+}
+
+// ProjectQuotaStatus is generated from the OpenAPI specification.
+type ProjectQuotaStatus struct {
+	Allocation ProjectQuotaAllocation `json:"allocation"`
+	Quota      *ProjectResourceQuota  `json:"quota,omitempty"`
+	Usage      ProjectResourceUsage   `json:"usage"`
+}
+
+// ProjectRegistries is generated from the OpenAPI specification.
+type ProjectRegistries struct {
+	// A URL to the JSON Schema for this object.
+	Schema      *string  `json:"$schema,omitempty"`
+	RegistryIds []string `json:"registryIds"`
+}
+
+// ProjectResourceQuota is generated from the OpenAPI specification.
+type ProjectResourceQuota struct {
+	// A URL to the JSON Schema for this object.
+	Schema      *string  `json:"$schema,omitempty"`
+	Cpus        *float64 `json:"cpus,omitempty"`
+	MemoryBytes *int64   `json:"memoryBytes,omitempty"`
+}
+
+// ProjectResourceUsage is generated from the OpenAPI specification.
+type ProjectResourceUsage struct {
+	Containers  int64   `json:"containers"`
+	Cpus        float64 `json:"cpus"`
+	MemoryBytes int64   `json:"memoryBytes"`
+}
+
+// ProjectRuntimeService is generated from the OpenAPI specification.
+type ProjectRuntimeService struct {
+	ContainerID    *string                  `json:"containerId,omitempty"`
+	ContainerName  *string                  `json:"containerName,omitempty"`
+	DeviceRequests []ContainerDeviceRequest `json:"deviceRequests,omitempty"`
+	Devices        []ContainerDeviceMapping `json:"devices,omitempty"`
+	Health         *string                  `json:"health,omitempty"`
+	IconURL        *string                  `json:"iconUrl,omitempty"`
+	Image          string                   `json:"image"`
+	Name           string                   `json:"name"`
+	Ports          []string                 `json:"ports,omitempty"`
+	Runtime        *string                  `json:"runtime,omitempty"`
+	ServiceConfig  *ServiceConfig           `json:"serviceConfig,omitempty"`
+	Status         string                   `json:"status"`
+}
+
+// ProjectServiceAllocation is generated from the OpenAPI specification.
+type ProjectServiceAllocation struct {
+	Cpus           float64 `json:"cpus"`
+	CpusInjected   bool    `json:"cpusInjected"`
+	MemoryBytes    int64   `json:"memoryBytes"`
+	MemoryInjected bool    `json:"memoryInjected"`
+	Replicas       int64   `json:"replicas"`
+	Service        string  `json:"service"`
+}
+
+// ProjectServicePatch is generated from the OpenAPI specification.
+type ProjectServicePatch struct {
+	// A URL to the JSON Schema for this object.
+	Schema      *string                   `json:"$schema,omitempty"`
+	Environment map[string]string         `json:"environment,omitempty"`
+	Image       *string                   `json:"image,omitempty"`
+	Ports       []ProjectServicePortPatch `json:"ports,omitempty"`
+	Redeploy    *bool                     `json:"redeploy,omitempty"`
+	Replicas    *int64                    `json:"replicas,omitempty"`
+	Tag         *string                   `json:"tag,omitempty"`
+}
+
+// ProjectServicePatchResult is generated from the OpenAPI specification.
+type ProjectServicePatchResult struct {
+	Changes    []string `json:"changes"`
+	Redeployed bool     `json:"redeployed"`
+}
+
+// ProjectServicePortPatch is generated from the OpenAPI specification.
+type ProjectServicePortPatch struct {
+	Protocol  *string `json:"protocol,omitempty"`
+	Published int64   `json:"published"`
+	Target    int64   `json:"target"`
+}
+
+// ProjectStartMaintenance is generated from the OpenAPI specification.
+type ProjectStartMaintenance struct {
+	// A URL to the JSON Schema for this object.
+	Schema  *string `json:"$schema,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
+
+// ProjectStatusCounts is generated from the OpenAPI specification.
+type ProjectStatusCounts struct {
+	RunningProjects int64 `json:"runningProjects"`
+	StoppedProjects int64 `json:"stoppedProjects"`
+	TotalProjects   int64 `json:"totalProjects"`
+}
+
+// ProjectUnlock is generated from the OpenAPI specification.
+type ProjectUnlock struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	Reason string  `json:"reason"`
+}
+
+// ProjectUpdateIncludeFile is generated from the OpenAPI specification.
+type ProjectUpdateIncludeFile struct {
+	// A URL to the JSON Schema for this object.
+	Schema       *string `json:"$schema,omitempty"`
+	Content      string  `json:"content"`
+	RelativePath string  `json:"relativePath"`
+}
+
+// ProjectUpdateProject is generated from the OpenAPI specification.
+type ProjectUpdateProject struct {
+	// A URL to the JSON Schema for this object.
+	Schema         *string `json:"$schema,omitempty"`
+	ComposeContent *string `json:"composeContent,omitempty"`
+	EnvContent     *string `json:"envContent,omitempty"`
+	Name           *string `json:"name,omitempty"`
+}
+
+// PruneImagesInputBody is generated from the OpenAPI specification.
+type PruneImagesInputBody struct {
+	// A URL to the JSON Schema for this object.
+	Schema   *string             `json:"$schema,omitempty"`
+	Dangling *bool               `json:"dangling,omitempty"`
+	Filters  map[string][]string `json:"filters,omitempty"`
+}
+
+// PruneImagesParams holds the query and header parameters of PruneImages.
+type PruneImagesParams struct {
+	// Only remove dangling images
+	Dangling *bool
+}
+
+func (p *PruneImagesParams) apply(query url.Values, header http.Header) {
+	setValue(query.Set, "dangling", p.Dangling)
+}
+
+// RbacCreateRoleBinding is generated from the OpenAPI specification.
+type RbacCreateRoleBinding struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Environment to scope the binding to (global when empty)
+	EnvironmentID *string `json:"environmentId,omitempty"`
+	// Project to scope the binding to; requires environmentId
+	ProjectID *string `json:"projectId,omitempty"`
+	// Role to assign
+	Role string `json:"role"`
+	// ID of the user the role is assigned to
+	UserID string `json:"userId"`
+}
+
+// RbacEffectiveRole is generated from the OpenAPI specification.
+type RbacEffectiveRole struct {
+	// Environment scope that was evaluated
+	EnvironmentID *string `json:"environmentId,omitempty"`
+	// Project scope that was evaluated
+	ProjectID *string `json:"projectId,omitempty"`
+	// Effective role within the requested scope
+	Role string `json:"role"`
+	// Which binding level produced the role
+	Source string `json:"source"`
+	// ID of the user
+	UserID string `json:"userId"`
+}
+
+// RbacRoleBinding is generated from the OpenAPI specification.
+type RbacRoleBinding struct {
+	// Creation timestamp
+	CreatedAt time.Time `json:"createdAt"`
+	// Environment the binding is scoped to (global when empty)
+	EnvironmentID *string `json:"environmentId,omitempty"`
+	// Unique identifier of the role binding
+	ID string `json:"id"`
+	// Project the binding is scoped to (environment-wide when empty)
+	ProjectID *string `json:"projectId,omitempty"`
+	// Assigned role
+	Role string `json:"role"`
+	// Last update timestamp
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+	// ID of the user the role is assigned to
+	UserID string `json:"userId"`
+}
+
+// RemoteTagPaginatedResponse is generated from the OpenAPI specification.
+type RemoteTagPaginatedResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema     *string                `json:"$schema,omitempty"`
+	Data       []ImageupdateRemoteTag `json:"data"`
+	Pagination BasePaginationResponse `json:"pagination"`
+	Success    bool                   `json:"success"`
+}
+
+// RemoveImageParams holds the query and header parameters of RemoveImage.
+type RemoveImageParams struct {
+	// Force removal
+	Force *bool
+}
+
+func (p *RemoveImageParams) apply(query url.Values, header http.Header) {
+	setValue(query.Set, "force", p.Force)
+}
+
+// RemoveVolumeParams holds the query and header parameters of RemoveVolume.
+type RemoveVolumeParams struct {
+	// Force removal
+	Force *bool
+}
+
+func (p *RemoveVolumeParams) apply(query url.Values, header http.Header) {
+	setValue(query.Set, "force", p.Force)
+}
+
+// Resource is generated from the OpenAPI specification.
+type Resource struct {
+	Cpus             *float64                     `json:"cpus,omitempty"`
+	Devices          []DockerTypesDeviceRequest   `json:"devices,omitempty"`
+	GenericResources []DockerTypesGenericResource `json:"generic_resources,omitempty"`
+	Memory           *int64                       `json:"memory,omitempty"`
+	Pids             *int64                       `json:"pids,omitempty"`
+}
+
+// RestartPolicy is generated from the OpenAPI specification.
+type RestartPolicy struct {
+	Condition   *string `json:"condition,omitempty"`
+	Delay       *int64  `json:"delay,omitempty"`
+	MaxAttempts *int64  `json:"max_attempts,omitempty"`
+	Window      *int64  `json:"window,omitempty"`
+}
+
+// RestoreBackupFilesInputBody is generated from the OpenAPI specification.
+type RestoreBackupFilesInputBody struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Paths to restore from backup
+	Paths []string `json:"paths"`
+}
+
+// ScannerStatus is generated from the OpenAPI specification.
+type ScannerStatus struct {
+	Available bool    `json:"available"`
+	Version   *string `json:"version,omitempty"`
+}
+
+// SearchContainerLogsParams holds the query and header parameters of SearchContainerLogs.
+type SearchContainerLogsParams struct {
+	// Match q case sensitively
+	CaseSensitive *bool
+	// Maximum number of lines to return, keeping the most recent
+	Limit *int64
+	// Only return lines containing this text
+	Q string
+	// Treat q as a regular expression
+	Regex *bool
+	// Only return lines written at or after this time (RFC 3339)
+	Since string
+	// Output stream to include
+	Stream string
+	// Only return lines written before this time (RFC 3339)
+	Until string
+}
+
+func (p *SearchContainerLogsParams) apply(query url.Values, header http.Header) {
+	setValue(query.Set, "caseSensitive", p.CaseSensitive)
+	setValue(query.Set, "limit", p.Limit)
+	setString(query.Set, "q", p.Q)
+	setValue(query.Set, "regex", p.Regex)
+	setString(query.Set, "since", p.Since)
+	setString(query.Set, "stream", p.Stream)
+	setString(query.Set, "until", p.Until)
+}
+
+// SearchRequest is generated from the OpenAPI specification.
+type SearchRequest struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	Query  string  `json:"query"`
+}
+
+// SearchResponse is generated from the OpenAPI specification.
+type SearchResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema  *string            `json:"$schema,omitempty"`
+	Count   int64              `json:"count"`
+	Query   string             `json:"query"`
+	Results []CategoryCategory `json:"results"`
+}
+
+// SecretCreateRequest is generated from the OpenAPI specification.
+type SecretCreateRequest struct {
+	// A URL to the JSON Schema for this object.
+	Schema      *string `json:"$schema,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Name        string  `json:"name"`
+	Value       string  `json:"value"`
+}
+
+// SecretSecret is generated from the OpenAPI specification.
+type SecretSecret struct {
+	CreatedAt   time.Time  `json:"createdAt"`
+	Description *string    `json:"description,omitempty"`
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	UpdatedAt   *time.Time `json:"updatedAt,omitempty"`
+}
+
+// SecretUpdateRequest is generated from the OpenAPI specification.
+type SecretUpdateRequest struct {
+	// A URL to the JSON Schema for this object.
+	Schema      *string `json:"$schema,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Value       *string `json:"value,omitempty"`
+}
+
+// SecurityauditContainerReport is generated from the OpenAPI specification.
+type SecurityauditContainerReport struct {
+	Findings []SecurityauditFinding `json:"findings"`
+	ID       string                 `json:"id"`
+	Image    string                 `json:"image"`
+	Name     string                 `json:"name"`
+	Project  *string                `json:"project,omitempty"`
+	Running  bool                   `json:"running"`
+	Score    int64                  `json:"score"`
+}
+
+// SecurityauditFinding is generated from the OpenAPI specification.
+type SecurityauditFinding struct {
+	Check       string `json:"check"`
+	Message     string `json:"message"`
+	Remediation string `json:"remediation"`
+	Severity    string `json:"severity"`
+}
+
+// SecurityauditProjectReport is generated from the OpenAPI specification.
+type SecurityauditProjectReport struct {
+	Containers int64  `json:"containers"`
+	Findings   int64  `json:"findings"`
+	Name       string `json:"name"`
+	Score      int64  `json:"score"`
+}
+
+// SecurityauditReport is generated from the OpenAPI specification.
+type SecurityauditReport struct {
+	Containers     []SecurityauditContainerReport `json:"containers"`
+	GeneratedAt    time.Time                      `json:"generatedAt"`
+	Projects       []SecurityauditProjectReport   `json:"projects"`
+	Score          int64                          `json:"score"`
+	SeverityCounts map[string]int64               `json:"severityCounts"`
+}
+
+// ServiceConfig is generated from the OpenAPI specification.
+type ServiceConfig struct {
+	Annotations       map[string]string                       `json:"annotations,omitempty"`
+	Attach            *bool                                   `json:"attach,omitempty"`
+	BlkioConfig       *BlkioConfig                            `json:"blkio_config,omitempty"`
+	Build             *BuildConfig                            `json:"build,omitempty"`
+	CapAdd            []string                                `json:"cap_add,omitempty"`
+	CapDrop           []string                                `json:"cap_drop,omitempty"`
+	Cgroup            *string                                 `json:"cgroup,omitempty"`
+	CgroupParent      *string                                 `json:"cgroup_parent,omitempty"`
+	Command           []string                                `json:"command"`
+	Configs           []DockerTypesServiceConfigObjConfig     `json:"configs,omitempty"`
+	ContainerName     *string                                 `json:"container_name,omitempty"`
+	CPUCount          *int64                                  `json:"cpu_count,omitempty"`
+	CPUPercent        *float64                                `json:"cpu_percent,omitempty"`
+	CPUPeriod         *int64                                  `json:"cpu_period,omitempty"`
+	CPUQuota          *int64                                  `json:"cpu_quota,omitempty"`
+	CPURtPeriod       *int64                                  `json:"cpu_rt_period,omitempty"`
+	CPURtRuntime      *int64                                  `json:"cpu_rt_runtime,omitempty"`
+	CPUShares         *int64                                  `json:"cpu_shares,omitempty"`
+	Cpus              *float64                                `json:"cpus,omitempty"`
+	Cpuset            *string                                 `json:"cpuset,omitempty"`
+	CredentialSpec    *CredentialSpecConfig                   `json:"credential_spec,omitempty"`
+	DependsOn         map[string]DockerTypesServiceDependency `json:"depends_on,omitempty"`
+	Deploy            *DeployConfig                           `json:"deploy,omitempty"`
+	Develop           *DevelopConfig                          `json:"develop,omitempty"`
+	DeviceCgroupRules []string                                `json:"device_cgroup_rules,omitempty"`
+	Devices           []DockerTypesDeviceMapping              `json:"devices,omitempty"`
+	DNS               []string                                `json:"dns,omitempty"`
+	DNSOpt            []string                                `json:"dns_opt,omitempty"`
+	DNSSearch         []string                                `json:"dns_search,omitempty"`
+	Dockerfile        *string                                 `json:"dockerfile,omitempty"`
+	Domainname        *string                                 `json:"domainname,omitempty"`
+	Entrypoint        []string                                `json:"entrypoint"`
+	EnvFile           []DockerTypesEnvFile                    `json:"env_file,omitempty"`
+	Environment       map[string]*string                      `json:"environment,omitempty"`
+	Expose            []string                                `json:"expose,omitempty"`
+	Extends           *ExtendsConfig                          `json:"extends,omitempty"`
+	ExternalLinks     []string                                `json:"external_links,omitempty"`
+	ExtraHosts        map[string][]string                     `json:"extra_hosts,omitempty"`
+	Gpus              []DockerTypesDeviceRequest              `json:"gpus,omitempty"`
+	GroupAdd          []string                                `json:"group_add,omitempty"`
+	Healthcheck       *HealthCheckConfig                      `json:"healthcheck,omitempty"`
+	Hostname          *string                                 `json:"hostname,omitempty"`
+	Image             *string                                 `json:"image,omitempty"`
+	Init              *bool                                   `json:"init,omitempty"`
+	Ipc               *string                                 `json:"ipc,omitempty"`
+	Isolation         *string                                 `json:"isolation,omitempty"`
+	LabelFile         []string                                `json:"label_file,omitempty"`
+	Labels            map[string]string                       `json:"labels,omitempty"`
+	Links             []string                                `json:"links,omitempty"`
+	LogDriver         *string                                 `json:"log_driver,omitempty"`
+	LogOpt            map[string]string                       `json:"log_opt,omitempty"`
+	Logging           *LoggingConfig                          `json:"logging,omitempty"`
+	MacAddress        *string                                 `json:"mac_address,omitempty"`
+	MemLimit          *int64                                  `json:"mem_limit,omitempty"`
+	MemReservation    *int64                                  `json:"mem_reservation,omitempty"`
+	MemSwappiness     *int64                                  `json:"mem_swappiness,omitempty"`
+	MemswapLimit      *int64                                  `json:"memswap_limit,omitempty"`
+	Models            map[string]ServiceModelConfig           `json:"models,omitempty"`
+	Net               *string                                 `json:"net,omitempty"`
+	NetworkMode       *string                                 `json:"network_mode,omitempty"`
+	Networks          map[string]ServiceNetworkConfig         `json:"networks,omitempty"`
+	OomKillDisable    *bool                                   `json:"oom_kill_disable,omitempty"`
+	OomScoreAdj       *int64                                  `json:"oom_score_adj,omitempty"`
+	Pid               *string                                 `json:"pid,omitempty"`
+	PidsLimit         *int64                                  `json:"pids_limit,omitempty"`
+	Platform          *string                                 `json:"platform,omitempty"`
+	Ports             []DockerTypesServicePortConfig          `json:"ports,omitempty"`
+	PostStart         []DockerTypesServiceHook                `json:"post_start,omitempty"`
+	PreStop           []DockerTypesServiceHook                `json:"pre_stop,omitempty"`
+	Privileged        *bool                                   `json:"privileged,omitempty"`
+	Profiles          []string                                `json:"profiles,omitempty"`
+	Provider          *ServiceProviderConfig                  `json:"provider,omitempty"`
+	PullPolicy        *string                                 `json:"pull_policy,omitempty"`
+	ReadOnly          *bool                                   `json:"read_only,omitempty"`
+	Restart           *string                                 `json:"restart,omitempty"`
+	Runtime           *string                                 `json:"runtime,omitempty"`
+	Scale             *int64                                  `json:"scale,omitempty"`
+	Secrets           []DockerTypesServiceSecretConfig        `json:"secrets,omitempty"`
+	SecurityOpt       []string                                `json:"security_opt,omitempty"`
+	ShmSize           *int64                                  `json:"shm_size,omitempty"`
+	StdinOpen         *bool                                   `json:"stdin_open,omitempty"`
+	StopGracePeriod   *int64                                  `json:"stop_grace_period,omitempty"`
+	StopSignal        *string                                 `json:"stop_signal,omitempty"`
+	StorageOpt        map[string]string                       `json:"storage_opt,omitempty"`
+	Sysctls           map[string]string                       `json:"sysctls,omitempty"`
+	Tmpfs             []string                                `json:"tmpfs,omitempty"`
+	Tty               *bool                                   `json:"tty,omitempty"`
+	Ulimits           map[string]UlimitsConfig                `json:"ulimits,omitempty"`
+	UseAPISocket      *bool                                   `json:"use_api_socket,omitempty"`
+	User              *string                                 `json:"user,omitempty"`
+	UsernsMode        *string                                 `json:"userns_mode,omitempty"`
+	Uts               *string                                 `json:"uts,omitempty"`
+	VolumeDriver      *string                                 `json:"volume_driver,omitempty"`
+	Volumes           []DockerTypesServiceVolumeConfig        `json:"volumes,omitempty"`
+	VolumesFrom       []string                                `json:"volumes_from,omitempty"`
+	WorkingDir        *string                                 `json:"working_dir,omitempty"`
+}
+
+// ServiceModelConfig is generated from the OpenAPI specification.
+type ServiceModelConfig struct {
+	EndpointVar *string `json:"endpoint_var,omitempty"`
+	ModelVar    *string `json:"model_var,omitempty"`
+}
+
+// ServiceNetworkConfig is generated from the OpenAPI specification.
+type ServiceNetworkConfig struct {
+	Aliases       []string          `json:"aliases,omitempty"`
+	DriverOpts    map[string]string `json:"driver_opts,omitempty"`
+	GwPriority    *int64            `json:"gw_priority,omitempty"`
+	InterfaceName *string           `json:"interface_name,omitempty"`
+	Ipv4Address   *string           `json:"ipv4_address,omitempty"`
+	Ipv6Address   *string           `json:"ipv6_address,omitempty"`
+	LinkLocalIps  []string          `json:"link_local_ips,omitempty"`
+	MacAddress    *string           `json:"mac_address,omitempty"`
+	Priority      *int64            `json:"priority,omitempty"`
+}
+
+// ServiceProviderConfig is generated from the OpenAPI specification.
+type ServiceProviderConfig struct {
+	Options map[string][]string `json:"options,omitempty"`
+	Type    *string             `json:"type,omitempty"`
+}
+
+// ServiceVolumeBind is generated from the OpenAPI specification.
+type ServiceVolumeBind struct {
+	CreateHostPath *bool   `json:"create_host_path,omitempty"`
+	Propagation    *string `json:"propagation,omitempty"`
+	Recursive      *string `json:"recursive,omitempty"`
+	Selinux        *string `json:"selinux,omitempty"`
+}
+
+// ServiceVolumeImage is generated from the OpenAPI specification.
+type ServiceVolumeImage struct {
+	Subpath *string `json:"subpath,omitempty"`
+}
+
+// ServiceVolumeTmpfs is generated from the OpenAPI specification.
+type ServiceVolumeTmpfs struct {
+	Mode *int32 `json:"mode,omitempty"`
+	Size *int64 `json:"size,omitempty"`
+}
+
+// ServiceVolumeVolume is generated from the OpenAPI specification.
+type ServiceVolumeVolume struct {
+	Labels  map[string]string `json:"labels,omitempty"`
+	Nocopy  *bool             `json:"nocopy,omitempty"`
+	Subpath *string           `json:"subpath,omitempty"`
+}
+
+// SettingsChange is generated from the OpenAPI specification.
+type SettingsChange struct {
+	Effects  []string `json:"effects,omitempty"`
+	Key      string   `json:"key"`
+	NewValue *string  `json:"newValue,omitempty"`
+	OldValue *string  `json:"oldValue,omitempty"`
+}
+
+// SettingsDryApplyResult is generated from the OpenAPI specification.
+type SettingsDryApplyResult struct {
+	Changes []SettingsChange          `json:"changes,omitempty"`
+	Issues  []SettingsValidationIssue `json:"issues,omitempty"`
+	Valid   bool                      `json:"valid"`
+}
+
+// SettingsPublicSetting is generated from the OpenAPI specification.
+type SettingsPublicSetting struct {
+	Key   string `json:"key"`
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// SettingsSettingDto is generated from the OpenAPI specification.
+type SettingsSettingDto struct {
+	IsPublic bool   `json:"isPublic"`
+	Key      string `json:"key"`
+	Type     string `json:"type"`
+	Value    string `json:"value"`
+}
+
+// SettingsUpdate is generated from the OpenAPI specification.
+type SettingsUpdate struct {
+	// A URL to the JSON Schema for this object.
+	Schema                        *string `json:"$schema,omitempty"`
+	AccentColor                   *string `json:"accentColor,omitempty"`
+	AggregateRequestTimeout       *string `json:"aggregateRequestTimeout,omitempty"`
+	AuditForwardEnabled           *string `json:"auditForwardEnabled,omitempty"`
+	AuditForwardProtocol          *string `json:"auditForwardProtocol,omitempty"`
+	AuditForwardTarget            *string `json:"auditForwardTarget,omitempty"`
+	AuditForwardToken             *string `json:"auditForwardToken,omitempty"`
+	AuthLocalEnabled              *string `json:"authLocalEnabled,omitempty"`
+	AuthOIDCConfig                *string `json:"authOidcConfig,omitempty"`
+	AuthPasswordPolicy            *string `json:"authPasswordPolicy,omitempty"`
+	AuthSessionTimeout            *string `json:"authSessionTimeout,omitempty"`
+	AutoHealEnabled               *string `json:"autoHealEnabled,omitempty"`
+	AutoHealExcludedContainers    *string `json:"autoHealExcludedContainers,omitempty"`
+	AutoHealInterval              *string `json:"autoHealInterval,omitempty"`
+	AutoHealMaxRestarts           *string `json:"autoHealMaxRestarts,omitempty"`
+	AutoHealRestartWindow         *string `json:"autoHealRestartWindow,omitempty"`
+	AutoInjectEnv                 *string `json:"autoInjectEnv,omitempty"`
+	AutoUpdate                    *string `json:"autoUpdate,omitempty"`
+	AutoUpdateExcludedContainers  *string `json:"autoUpdateExcludedContainers,omitempty"`
+	AutoUpdateInterval            *string `json:"autoUpdateInterval,omitempty"`
+	AutoUpdateMaxLoad             *string `json:"autoUpdateMaxLoad,omitempty"`
+	AutoUpdateMinFreeDisk         *string `json:"autoUpdateMinFreeDisk,omitempty"`
+	AutoUpdateMonitorOnly         *string `json:"autoUpdateMonitorOnly,omitempty"`
+	AutoUpdateRegistryCheck       *string `json:"autoUpdateRegistryCheck,omitempty"`
+	AutoUpdateRollbackWindow      *string `json:"autoUpdateRollbackWindow,omitempty"`
+	AutoUpdateRolloutStages       *string `json:"autoUpdateRolloutStages,omitempty"`
+	BaseServerURL                 *string `json:"baseServerUrl,omitempty"`
+	BuildProvider                 *string `json:"buildProvider,omitempty"`
+	BuildTimeout                  *string `json:"buildTimeout,omitempty"`
+	BuildsDirectory               *string `json:"buildsDirectory,omitempty"`
+	ComposeLintRules              *string `json:"composeLintRules,omitempty"`
+	ComposeTemplateVariables      *string `json:"composeTemplateVariables,omitempty"`
+	ConfigBackupDestination       *string `json:"configBackupDestination,omitempty"`
+	ConfigBackupEnabled           *string `json:"configBackupEnabled,omitempty"`
+	ConfigBackupPassphrase        *string `json:"configBackupPassphrase,omitempty"`
+	ConfigBackupPath              *string `json:"configBackupPath,omitempty"`
+	ConfigBackupRetention         *string `json:"configBackupRetention,omitempty"`
+	ConfigBackupS3AccessKey       *string `json:"configBackupS3AccessKey,omitempty"`
+	ConfigBackupS3Bucket          *string `json:"configBackupS3Bucket,omitempty"`
+	ConfigBackupS3Endpoint        *string `json:"configBackupS3Endpoint,omitempty"`
+	ConfigBackupS3Prefix          *string `json:"configBackupS3Prefix,omitempty"`
+	ConfigBackupS3Region          *string `json:"configBackupS3Region,omitempty"`
+	ConfigBackupS3SecretKey       *string `json:"configBackupS3SecretKey,omitempty"`
+	ContainerCrashCooldown        *string `json:"containerCrashCooldown,omitempty"`
+	ContainerCrashThreshold       *string `json:"containerCrashThreshold,omitempty"`
+	ContainerCrashWindow          *string `json:"containerCrashWindow,omitempty"`
+	ContainerSnapshotRetention    *string `json:"containerSnapshotRetention,omitempty"`
+	CorsAllowedOrigins            *string `json:"corsAllowedOrigins,omitempty"`
+	DefaultDeployPullPolicy       *string `json:"defaultDeployPullPolicy,omitempty"`
+	DefaultShell                  *string `json:"defaultShell,omitempty"`
+	DepotProjectID                *string `json:"depotProjectId,omitempty"`
+	DepotToken                    *string `json:"depotToken,omitempty"`
+	DiskUsagePath                 *string `json:"diskUsagePath,omitempty"`
+	DockerAPITimeout              *string `json:"dockerApiTimeout,omitempty"`
+	DockerHost                    *string `json:"dockerHost,omitempty"`
+	DockerImagePullTimeout        *string `json:"dockerImagePullTimeout,omitempty"`
+	DockerPruneMode               *string `json:"dockerPruneMode,omitempty"`
+	EnableGravatar                *string `json:"enableGravatar,omitempty"`
+	EnvironmentBreakerCooldown    *string `json:"environmentBreakerCooldown,omitempty"`
+	EnvironmentBreakerThreshold   *string `json:"environmentBreakerThreshold,omitempty"`
+	EnvironmentFlapWindow         *string `json:"environmentFlapWindow,omitempty"`
+	EnvironmentHealthInterval     *string `json:"environmentHealthInterval,omitempty"`
+	EnvironmentHeartbeatTimeout   *string `json:"environmentHeartbeatTimeout,omitempty"`
+	GitOperationTimeout           *string `json:"gitOperationTimeout,omitempty"`
+	HostMetricsCPUThreshold       *string `json:"hostMetricsCpuThreshold,omitempty"`
+	HostMetricsDiskThreshold      *string `json:"hostMetricsDiskThreshold,omitempty"`
+	HostMetricsMemoryThreshold    *string `json:"hostMetricsMemoryThreshold,omitempty"`
+	HostMetricsRetentionHours     *string `json:"hostMetricsRetentionHours,omitempty"`
+	HTTPClientTimeout             *string `json:"httpClientTimeout,omitempty"`
+	ImageRetentionEnabled         *string `json:"imageRetentionEnabled,omitempty"`
+	ImageRetentionKeepCount       *string `json:"imageRetentionKeepCount,omitempty"`
+	KeyboardShortcutsEnabled      *string `json:"keyboardShortcutsEnabled,omitempty"`
+	MaintenanceModeEnabled        *string `json:"maintenanceModeEnabled,omitempty"`
+	MaintenanceModeMessage        *string `json:"maintenanceModeMessage,omitempty"`
+	MaxImageUploadSize            *string `json:"maxImageUploadSize,omitempty"`
+	MobileNavigationMode          *string `json:"mobileNavigationMode,omitempty"`
+	MobileNavigationShowLabels    *string `json:"mobileNavigationShowLabels,omitempty"`
+	NotificationActionLinkHours   *string `json:"notificationActionLinkHours,omitempty"`
+	NotificationActionLinks       *string `json:"notificationActionLinks,omitempty"`
+	OIDCAdminClaim                *string `json:"oidcAdminClaim,omitempty"`
+	OIDCAdminValue                *string `json:"oidcAdminValue,omitempty"`
+	OIDCAutoRedirectToProvider    *string `json:"oidcAutoRedirectToProvider,omitempty"`
+	OIDCClientID                  *string `json:"oidcClientId,omitempty"`
+	OIDCClientSecret              *string `json:"oidcClientSecret,omitempty"`
+	OIDCEnabled                   *string `json:"oidcEnabled,omitempty"`
+	OIDCIssuerURL                 *string `json:"oidcIssuerUrl,omitempty"`
+	OIDCMergeAccounts             *string `json:"oidcMergeAccounts,omitempty"`
+	OIDCProviderLogoURL           *string `json:"oidcProviderLogoUrl,omitempty"`
+	OIDCProviderName              *string `json:"oidcProviderName,omitempty"`
+	OIDCScopes                    *string `json:"oidcScopes,omitempty"`
+	OIDCSkipTLSVerify             *string `json:"oidcSkipTlsVerify,omitempty"`
+	OledMode                      *string `json:"oledMode,omitempty"`
+	OutboundNoProxy               *string `json:"outboundNoProxy,omitempty"`
+	OutboundProxyURL              *string `json:"outboundProxyUrl,omitempty"`
+	PollingEnabled                *string `json:"pollingEnabled,omitempty"`
+	PollingInterval               *string `json:"pollingInterval,omitempty"`
+	ProjectsDirectory             *string `json:"projectsDirectory,omitempty"`
+	ProxyRequestRetries           *string `json:"proxyRequestRetries,omitempty"`
+	ProxyRequestTimeout           *string `json:"proxyRequestTimeout,omitempty"`
+	PruneProtectionLabel          *string `json:"pruneProtectionLabel,omitempty"`
+	RegistryTimeout               *string `json:"registryTimeout,omitempty"`
+	ScheduledPruneBuildCache      *string `json:"scheduledPruneBuildCache,omitempty"`
+	ScheduledPruneContainers      *string `json:"scheduledPruneContainers,omitempty"`
+	ScheduledPruneEnabled         *string `json:"scheduledPruneEnabled,omitempty"`
+	ScheduledPruneImages          *string `json:"scheduledPruneImages,omitempty"`
+	ScheduledPruneInterval        *string `json:"scheduledPruneInterval,omitempty"`
+	ScheduledPruneNetworks        *string `json:"scheduledPruneNetworks,omitempty"`
+	ScheduledPruneVolumes         *string `json:"scheduledPruneVolumes,omitempty"`
+	SidebarHoverExpansion         *string `json:"sidebarHoverExpansion,omitempty"`
+	StaleContainerDays            *string `json:"staleContainerDays,omitempty"`
+	StaleImageDays                *string `json:"staleImageDays,omitempty"`
+	StaleResourcesEnabled         *string `json:"staleResourcesEnabled,omitempty"`
+	StaleResourcesInterval        *string `json:"staleResourcesInterval,omitempty"`
+	TrivyConcurrentScanContainers *string `json:"trivyConcurrentScanContainers,omitempty"`
+	TrivyCPULimit                 *string `json:"trivyCpuLimit,omitempty"`
+	TrivyImage                    *string `json:"trivyImage,omitempty"`
+	TrivyMemoryLimitMb            *string `json:"trivyMemoryLimitMb,omitempty"`
+	TrivyNetwork                  *string `json:"trivyNetwork,omitempty"`
+	TrivyResourceLimitsEnabled    *string `json:"trivyResourceLimitsEnabled,omitempty"`
+	TrivyScanTimeout              *string `json:"trivyScanTimeout,omitempty"`
+	UnmanagedChangeDetection      *string `json:"unmanagedChangeDetection,omitempty"`
+	VulnerabilityScanEnabled      *string `json:"vulnerabilityScanEnabled,omitempty"`
+	VulnerabilityScanInterval     *string `json:"vulnerabilityScanInterval,omitempty"`
+}
+
+// SettingsValidationIssue is generated from the OpenAPI specification.
+type SettingsValidationIssue struct {
+	Key      string `json:"key"`
+	Message  string `json:"message"`
+	Severity string `json:"severity"`
+}
+
+// SetupAdmin is generated from the OpenAPI specification.
+type SetupAdmin struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Display name of the administrator
+	DisplayName *string `json:"displayName,omitempty"`
+	// Email address of the administrator
+	Email *string `json:"email,omitempty"`
+	// Password of the administrator
+	Password string `json:"password"`
+	// Username of the administrator
+	Username string `json:"username"`
+}
+
+// SetupDockerCheck is generated from the OpenAPI specification.
+type SetupDockerCheck struct {
+	APIVersion      *string `json:"apiVersion,omitempty"`
+	Connected       bool    `json:"connected"`
+	Error           *string `json:"error,omitempty"`
+	Host            string  `json:"host"`
+	OperatingSystem *string `json:"operatingSystem,omitempty"`
+	ServerVersion   *string `json:"serverVersion,omitempty"`
+}
+
+// SetupProgress is generated from the OpenAPI specification.
+type SetupProgress struct {
+	CurrentStep *string     `json:"currentStep,omitempty"`
+	Required    bool        `json:"required"`
+	Steps       []SetupStep `json:"steps"`
+}
+
+// SetupProjectsDirectory is generated from the OpenAPI specification.
+type SetupProjectsDirectory struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Projects directory, either a path or container_path:host_path
+	ProjectsDirectory string `json:"projectsDirectory"`
+}
+
+// SetupProjectsDirectoryResult is generated from the OpenAPI specification.
+type SetupProjectsDirectoryResult struct {
+	ProjectsDirectory string                    `json:"projectsDirectory"`
+	Warnings          []SettingsValidationIssue `json:"warnings,omitempty"`
+}
+
+// SetupStep is generated from the OpenAPI specification.
+type SetupStep struct {
+	ID       string `json:"id"`
+	Optional bool   `json:"optional"`
+	Status   string `json:"status"`
+}
+
+// StaleresourceCleanupFailure is generated from the OpenAPI specification.
+type StaleresourceCleanupFailure struct {
+	// Why the resource was not removed
+	Error string `json:"error"`
+	// Resource ID
+	ID string `json:"id"`
+	// Resource type
+	Kind string `json:"kind"`
+}
+
+// StaleresourceCleanupRequest is generated from the OpenAPI specification.
+type StaleresourceCleanupRequest struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Resources to remove; each must be part of the current report
+	Items []StaleresourceItemRef `json:"items"`
+}
+
+// StaleresourceCleanupResult is generated from the OpenAPI specification.
+type StaleresourceCleanupResult struct {
+	// Resources that could not be removed
+	Failed []StaleresourceCleanupFailure `json:"failed"`
+	// Resources that were removed
+	Removed []StaleresourceItemRef `json:"removed"`
+}
+
+// StaleresourceInventory is generated from the OpenAPI specification.
+type StaleresourceInventory struct {
+	// Days a container must have been stopped to be reported
+	ContainerDays int64 `json:"containerDays"`
+	// When the analysis ran
+	GeneratedAt time.Time `json:"generatedAt"`
+	// Days an unused image must have existed to be reported
+	ImageDays int64 `json:"imageDays"`
+	// Stale resources, grouped by kind
+	Items []StaleresourceItem `json:"items"`
+}
+
+// StaleresourceItem is generated from the OpenAPI specification.
+type StaleresourceItem struct {
+	// Container, network or image ID, or volume name
+	ID string `json:"id"`
+	// Resource type
+	Kind string `json:"kind"`
+	// Display name of the resource
+	Name string `json:"name"`
+	// Why the resource is considered stale
+	Reason string `json:"reason"`
+	// When the container stopped, or when the resource was created
+	Since *time.Time `json:"since,omitempty"`
+	// Disk space used by the resource, when known
+	SizeBytes *int64 `json:"sizeBytes,omitempty"`
+}
+
+// StaleresourceItemRef is generated from the OpenAPI specification.
+type StaleresourceItemRef struct {
+	// Container, network or image ID, or volume name
+	ID string `json:"id"`
+	// Resource type
+	Kind string `json:"kind"`
+}
+
+// SystemComponentHealth is generated from the OpenAPI specification.
+type SystemComponentHealth struct {
+	Error     *string `json:"error,omitempty"`
+	LatencyMs int64   `json:"latencyMs"`
+	Name      string  `json:"name"`
+	Optional  *bool   `json:"optional,omitempty"`
+	Status    string  `json:"status"`
+}
+
+// SystemEnvironmentHostMetrics is generated from the OpenAPI specification.
+type SystemEnvironmentHostMetrics struct {
+	EnvironmentID   string             `json:"environmentId"`
+	EnvironmentName string             `json:"environmentName"`
+	Latest          *SystemHostMetrics `json:"latest,omitempty"`
+}
+
+// SystemEnvironmentsHealthResponse is generated from the OpenAPI specification.
+type SystemEnvironmentsHealthResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema     *string                 `json:"$schema,omitempty"`
+	Components []SystemComponentHealth `json:"components"`
+}
+
+// SystemHealthResponse is generated from the OpenAPI specification.
+type SystemHealthResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	Status string  `json:"status"`
+}
+
+// SystemHostMetrics is generated from the OpenAPI specification.
+type SystemHostMetrics struct {
+	CollectedAt   time.Time `json:"collectedAt"`
+	CPUCount      int64     `json:"cpuCount"`
+	CPUPercent    float64   `json:"cpuPercent"`
+	DiskPercent   float64   `json:"diskPercent"`
+	DiskTotal     int64     `json:"diskTotal"`
+	DiskUsed      int64     `json:"diskUsed"`
+	EnvironmentID string    `json:"environmentId"`
+	Load1         float64   `json:"load1"`
+	Load15        float64   `json:"load15"`
+	Load5         float64   `json:"load5"`
+	MemoryPercent float64   `json:"memoryPercent"`
+	MemoryTotal   int64     `json:"memoryTotal"`
+	MemoryUsed    int64     `json:"memoryUsed"`
+}
+
+// SystemPruneAllRequest is generated from the OpenAPI specification.
+type SystemPruneAllRequest struct {
+	// A URL to the JSON Schema for this object.
+	Schema     *string `json:"$schema,omitempty"`
+	BuildCache bool    `json:"buildCache"`
+	Containers bool    `json:"containers"`
+	Dangling   bool    `json:"dangling"`
+	Images     bool    `json:"images"`
+	Networks   bool    `json:"networks"`
+	Volumes    bool    `json:"volumes"`
+}
+
+// SystemPruneAllResult is generated from the OpenAPI specification.
+type SystemPruneAllResult struct {
+	BuildCacheSpaceReclaimed *int64   `json:"buildCacheSpaceReclaimed,omitempty"`
+	ContainerSpaceReclaimed  *int64   `json:"containerSpaceReclaimed,omitempty"`
+	ContainersPruned         []string `json:"containersPruned,omitempty"`
+	DryRun                   *bool    `json:"dryRun,omitempty"`
+	Errors                   []string `json:"errors,omitempty"`
+	ImageSpaceReclaimed      *int64   `json:"imageSpaceReclaimed,omitempty"`
+	ImagesDeleted            []string `json:"imagesDeleted,omitempty"`
+	NetworksDeleted          []string `json:"networksDeleted,omitempty"`
+	SpaceReclaimed           int64    `json:"spaceReclaimed"`
+	Success                  bool     `json:"success"`
+	VolumeSpaceReclaimed     *int64   `json:"volumeSpaceReclaimed,omitempty"`
+	VolumesDeleted           []string `json:"volumesDeleted,omitempty"`
+}
+
+// SystemReadinessResponse is generated from the OpenAPI specification.
+type SystemReadinessResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema     *string                 `json:"$schema,omitempty"`
+	Components []SystemComponentHealth `json:"components"`
+	Status     string                  `json:"status"`
+}
+
+// TagCreateSavedFilter is generated from the OpenAPI specification.
+type TagCreateSavedFilter struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// List query parameters by name, such as tags=team=web,critical
+	Filters map[string]string `json:"filters,omitempty"`
+	// Display name of the saved filter
+	Name string `json:"name"`
+	// List the filter applies to
+	ResourceType string `json:"resourceType"`
+	// Search query
+	Search *string `json:"search,omitempty"`
+}
+
+// TagKeySummary is generated from the OpenAPI specification.
+type TagKeySummary struct {
+	// Tag key
+	Key string `json:"key"`
+	// Number of resources tagged with the key
+	Resources int64 `json:"resources"`
+	// Distinct values used with the key, sorted
+	Values []string `json:"values"`
+}
+
+// TagSavedFilter is generated from the OpenAPI specification.
+type TagSavedFilter struct {
+	// Creation timestamp
+	CreatedAt time.Time `json:"createdAt"`
+	// List query parameters by name
+	Filters map[string]string `json:"filters"`
+	// Unique identifier of the saved filter
+	ID string `json:"id"`
+	// Display name of the saved filter
+	Name string `json:"name"`
+	// List the filter applies to
+	ResourceType string `json:"resourceType"`
+	// Search query
+	Search *string `json:"search,omitempty"`
+	// Last update timestamp
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+// TagUpdateSavedFilter is generated from the OpenAPI specification.
+type TagUpdateSavedFilter struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// List query parameters by name, replacing the current ones
+	Filters map[string]string `json:"filters,omitempty"`
+	// Display name of the saved filter
+	Name *string `json:"name,omitempty"`
+	// Search query
+	Search *string `json:"search,omitempty"`
+}
+
+// TagUpdateTags is generated from the OpenAPI specification.
+type TagUpdateTags struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Tags to set on the resource, replacing the current ones. An empty value tags the resource with the key alone
+	Tags map[string]string `json:"tags"`
+}
+
+// TemplateCreateRegistryRequest is generated from the OpenAPI specification.
+type TemplateCreateRegistryRequest struct {
+	// A URL to the JSON Schema for this object.
+	Schema      *string `json:"$schema,omitempty"`
+	Description string  `json:"description"`
+	Enabled     bool    `json:"enabled"`
+	Name        string  `json:"name"`
+	URL         string  `json:"url"`
+}
+
+// TemplateCreateRequest is generated from the OpenAPI specification.
+type TemplateCreateRequest struct {
+	// A URL to the JSON Schema for this object.
+	Schema      *string `json:"$schema,omitempty"`
+	Content     string  `json:"content"`
+	Description string  `json:"description"`
+	EnvContent  string  `json:"envContent"`
+	Name        string  `json:"name"`
+}
+
+// TemplateDefaultTemplatesResponse is generated from the OpenAPI specification.
+type TemplateDefaultTemplatesResponse struct {
+	ComposeTemplate string `json:"composeTemplate"`
+	EnvTemplate     string `json:"envTemplate"`
+}
+
+// TemplatePaginatedResponse is generated from the OpenAPI specification.
+type TemplatePaginatedResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema     *string                `json:"$schema,omitempty"`
+	Data       []TemplateTemplate     `json:"data"`
+	Pagination BasePaginationResponse `json:"pagination"`
+	Success    bool                   `json:"success"`
+}
+
+// TemplateRemoteRegistry is generated from the OpenAPI specification.
+type TemplateRemoteRegistry struct {
+	Schema      *string                  `json:"$schema,omitempty"`
+	Author      string                   `json:"author"`
+	Description string                   `json:"description"`
+	Name        string                   `json:"name"`
+	Templates   []TemplateRemoteTemplate `json:"templates"`
+	URL         string                   `json:"url"`
+	Version     string                   `json:"version"`
+}
+
+// TemplateRemoteTemplate is generated from the OpenAPI specification.
+type TemplateRemoteTemplate struct {
+	Author           string   `json:"author"`
+	ComposeURL       string   `json:"compose_url"`
+	Description      string   `json:"description"`
+	DocumentationURL string   `json:"documentation_url"`
+	EnvURL           string   `json:"env_url"`
+	ID               string   `json:"id"`
+	Name             string   `json:"name"`
+	Tags             []string `json:"tags"`
+	Version          string   `json:"version"`
+}
+
+// TemplateSaveDefaultTemplatesRequest is generated from the OpenAPI specification.
+type TemplateSaveDefaultTemplatesRequest struct {
+	// A URL to the JSON Schema for this object.
+	Schema         *string `json:"$schema,omitempty"`
+	ComposeContent string  `json:"composeContent"`
+	EnvContent     string  `json:"envContent"`
+}
+
+// TemplateTemplate is generated from the OpenAPI specification.
+type TemplateTemplate struct {
+	Content     string                    `json:"content"`
+	Description string                    `json:"description"`
+	EnvContent  *string                   `json:"envContent,omitempty"`
+	ID          string                    `json:"id"`
+	IsCustom    bool                      `json:"isCustom"`
+	IsRemote    bool                      `json:"isRemote"`
+	Metadata    *MetaTemplateMeta         `json:"metadata,omitempty"`
+	Name        string                    `json:"name"`
+	Registry    *TemplateTemplateRegistry `json:"registry,omitempty"`
+	RegistryID  *string                   `json:"registryId,omitempty"`
+}
+
+// TemplateTemplateContent is generated from the OpenAPI specification.
+type TemplateTemplateContent struct {
+	Content      string           `json:"content"`
+	EnvContent   string           `json:"envContent"`
+	EnvVariables []EnvVariable    `json:"envVariables"`
+	Services     []string         `json:"services"`
+	Template     TemplateTemplate `json:"template"`
+}
+
+// TemplateTemplateRegistry is generated from the OpenAPI specification.
+type TemplateTemplateRegistry struct {
+	Description string `json:"description"`
+	Enabled     bool   `json:"enabled"`
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	URL         string `json:"url"`
+}
+
+// TemplateUpdateRegistryRequest is generated from the OpenAPI specification.
+type TemplateUpdateRegistryRequest struct {
+	// A URL to the JSON Schema for this object.
+	Schema      *string `json:"$schema,omitempty"`
+	Description string  `json:"description"`
+	Enabled     bool    `json:"enabled"`
+	Name        string  `json:"name"`
+	URL         string  `json:"url"`
+}
+
+// TemplateUpdateRequest is generated from the OpenAPI specification.
+type TemplateUpdateRequest struct {
+	// A URL to the JSON Schema for this object.
+	Schema      *string `json:"$schema,omitempty"`
+	Content     string  `json:"content"`
+	Description string  `json:"description"`
+	EnvContent  string  `json:"envContent"`
+	Name        string  `json:"name"`
+}
+
+// TestAppriseNotificationParams holds the query and header parameters of TestAppriseNotification.
+type TestAppriseNotificationParams struct {
+	Type string
+}
+
+func (p *TestAppriseNotificationParams) apply(query url.Values, header http.Header) {
+	setString(query.Set, "type", p.Type)
+}
+
+// TestGitRepositoryParams holds the query and header parameters of TestGitRepository.
+type TestGitRepositoryParams struct {
+	// Branch to test (optional, uses repository default branch when omitted)
+	Branch string
+}
+
+func (p *TestGitRepositoryParams) apply(query url.Values, header http.Header) {
+	setString(query.Set, "branch", p.Branch)
+}
+
+// TestNotificationParams holds the query and header parameters of TestNotification.
+type TestNotificationParams struct {
+	Type string
+}
+
+func (p *TestNotificationParams) apply(query url.Values, header http.Header) {
+	setString(query.Set, "type", p.Type)
+}
+
+// TopologyEdge is generated from the OpenAPI specification.
+type TopologyEdge struct {
+	Source string `json:"source"`
+	Target string `json:"target"`
+	Type   string `json:"type"`
+}
+
+// TopologyGraph is generated from the OpenAPI specification.
+type TopologyGraph struct {
+	Edges []TopologyEdge `json:"edges"`
+	Nodes []TopologyNode `json:"nodes"`
+}
+
+// TopologyNode is generated from the OpenAPI specification.
+type TopologyNode struct {
+	ID         string  `json:"id"`
+	Image      *string `json:"image,omitempty"`
+	Label      string  `json:"label"`
+	Project    *string `json:"project,omitempty"`
+	ResourceID string  `json:"resourceId"`
+	Service    *string `json:"service,omitempty"`
+	State      *string `json:"state,omitempty"`
+	Type       string  `json:"type"`
+}
+
+// TriggerCommandWebhookParams holds the query and header parameters of TriggerCommandWebhook.
+type TriggerCommandWebhookParams struct {
+	ContentType string
+}
+
+func (p *TriggerCommandWebhookParams) apply(query url.Values, header http.Header) {
+	setString(header.Set, "Content-Type", p.ContentType)
+}
+
+// Ulimit is generated from the OpenAPI specification.
+type Ulimit struct {
+	Hard int64  `json:"Hard"`
+	Name string `json:"Name"`
+	Soft int64  `json:"Soft"`
+}
+
+// UlimitsConfig is generated from the OpenAPI specification.
+type UlimitsConfig struct {
+	Hard   *int64 `json:"hard,omitempty"`
+	Single *int64 `json:"single,omitempty"`
+	Soft   *int64 `json:"soft,omitempty"`
+}
+
+// UnsnoozeImageUpdatesParams holds the query and header parameters of UnsnoozeImageUpdates.
+type UnsnoozeImageUpdatesParams struct {
+	// Repository and tag to unsnooze
+	ImageRef string
+}
+
+func (p *UnsnoozeImageUpdatesParams) apply(query url.Values, header http.Header) {
+	setString(query.Set, "imageRef", p.ImageRef)
+}
+
+// UpdateConfig is generated from the OpenAPI specification.
+type UpdateConfig struct {
+	Delay           *int64   `json:"delay,omitempty"`
+	FailureAction   *string  `json:"failure_action,omitempty"`
+	MaxFailureRatio *float64 `json:"max_failure_ratio,omitempty"`
+	Monitor         *int64   `json:"monitor,omitempty"`
+	Order           *string  `json:"order,omitempty"`
+	Parallelism     *int64   `json:"parallelism,omitempty"`
+}
+
+// UpdateContainerRegistryRequest is generated from the OpenAPI specification.
+type UpdateContainerRegistryRequest struct {
+	// A URL to the JSON Schema for this object.
+	Schema            *string `json:"$schema,omitempty"`
+	Description       *string `json:"description"`
+	Enabled           *bool   `json:"enabled"`
+	Insecure          *bool   `json:"insecure"`
+	SignatureIdentity *string `json:"signatureIdentity,omitempty"`
+	SignatureIssuer   *string `json:"signatureIssuer,omitempty"`
+	SignatureMode     *string `json:"signatureMode,omitempty"`
+	SignatureTrust    *string `json:"signatureTrust,omitempty"`
+	Token             *string `json:"token"`
+	URL               *string `json:"url"`
+	Username          *string `json:"username"`
+}
+
+// UpdateGitRepositoryRequest is generated from the OpenAPI specification.
+type UpdateGitRepositoryRequest struct {
+	// A URL to the JSON Schema for this object.
+	Schema                 *string `json:"$schema,omitempty"`
+	AuthType               *string `json:"authType,omitempty"`
+	Description            *string `json:"description,omitempty"`
+	Enabled                *bool   `json:"enabled,omitempty"`
+	Name                   *string `json:"name,omitempty"`
+	SSHHostKeyVerification *string `json:"sshHostKeyVerification,omitempty"`
+	SSHKey                 *string `json:"sshKey,omitempty"`
+	Token                  *string `json:"token,omitempty"`
+	URL                    *string `json:"url,omitempty"`
+	Username               *string `json:"username,omitempty"`
+}
+
+// UpdateProjectIncludeParams holds the query and header parameters of UpdateProjectInclude.
+type UpdateProjectIncludeParams struct {
+	// Edit the project even though it is managed by GitOps
+	OverrideGitOps *bool
+}
+
+func (p *UpdateProjectIncludeParams) apply(query url.Values, header http.Header) {
+	setValue(query.Set, "overrideGitOps", p.OverrideGitOps)
+}
+
+// UpdateProjectParams holds the query and header parameters of UpdateProject.
+type UpdateProjectParams struct {
+	// Edit the project even though it is managed by GitOps
+	OverrideGitOps *bool
+}
+
+func (p *UpdateProjectParams) apply(query url.Values, header http.Header) {
+	setValue(query.Set, "overrideGitOps", p.OverrideGitOps)
+}
+
+// UpdaterContainerRollback is generated from the OpenAPI specification.
+type UpdaterContainerRollback struct {
+	ContainerID     string  `json:"containerId"`
+	ContainerName   string  `json:"containerName"`
+	Image           string  `json:"image"`
+	Pulled          bool    `json:"pulled"`
+	RecordID        string  `json:"recordId"`
+	ReplacedImageID *string `json:"replacedImageId,omitempty"`
+	RunID           *string `json:"runId,omitempty"`
+}
+
+// UpdaterContainerUpdatePreview is generated from the OpenAPI specification.
+type UpdaterContainerUpdatePreview struct {
+	CheckedViaAPI bool     `json:"checkedViaApi"`
+	ContainerID   string   `json:"containerId"`
+	ContainerName string   `json:"containerName"`
+	CurrentDigest *string  `json:"currentDigest,omitempty"`
+	Dependents    []string `json:"dependents"`
+	ImageRef      *string  `json:"imageRef,omitempty"`
+	MonitorOnly   bool     `json:"monitorOnly"`
+	Platform      *string  `json:"platform,omitempty"`
+	Reason        *string  `json:"reason,omitempty"`
+	TargetDigest  *string  `json:"targetDigest,omitempty"`
+	WouldUpdate   bool     `json:"wouldUpdate"`
+}
+
+// UpdaterOptions is generated from the OpenAPI specification.
+type UpdaterOptions struct {
+	// A URL to the JSON Schema for this object.
+	Schema      *string  `json:"$schema,omitempty"`
+	DryRun      *bool    `json:"dryRun,omitempty"`
+	ForceUpdate *bool    `json:"forceUpdate,omitempty"`
+	ResourceIds []string `json:"resourceIds,omitempty"`
+	Type        *string  `json:"type,omitempty"`
+}
+
+// UpdaterPreconditionCheck is generated from the OpenAPI specification.
+type UpdaterPreconditionCheck struct {
+	Message *string `json:"message,omitempty"`
+	Name    string  `json:"name"`
+	Status  string  `json:"status"`
+}
+
+// UpdaterResourceResult is generated from the OpenAPI specification.
+type UpdaterResourceResult struct {
+	Details         map[string]any    `json:"details,omitempty"`
+	Error           *string           `json:"error,omitempty"`
+	NewImages       map[string]string `json:"newImages,omitempty"`
+	OldImages       map[string]string `json:"oldImages,omitempty"`
+	ResourceID      string            `json:"resourceId"`
+	ResourceName    *string           `json:"resourceName,omitempty"`
+	ResourceType    string            `json:"resourceType"`
+	Status          string            `json:"status"`
+	UpdateApplied   *bool             `json:"updateApplied,omitempty"`
+	UpdateAvailable *bool             `json:"updateAvailable,omitempty"`
+}
+
+// UpdaterResult is generated from the OpenAPI specification.
+type UpdaterResult struct {
+	Blocked       *int64                     `json:"blocked,omitempty"`
+	Checked       int64                      `json:"checked"`
+	Duration      string                     `json:"duration"`
+	EndTime       *string                    `json:"endTime,omitempty"`
+	Failed        int64                      `json:"failed"`
+	Items         []UpdaterResourceResult    `json:"items"`
+	Preconditions []UpdaterPreconditionCheck `json:"preconditions,omitempty"`
+	Skipped       int64                      `json:"skipped"`
+	StartTime     *string                    `json:"startTime,omitempty"`
+	Success       *bool                      `json:"success,omitempty"`
+	Updated       int64                      `json:"updated"`
+}
+
+// UpdaterRollout is generated from the OpenAPI specification.
+type UpdaterRollout struct {
+	FinishedAt *time.Time                  `json:"finishedAt,omitempty"`
+	HaltReason *string                     `json:"haltReason,omitempty"`
+	ID         string                      `json:"id"`
+	Stages     []UpdaterRolloutStageStatus `json:"stages"`
+	StartedAt  time.Time                   `json:"startedAt"`
+	State      string                      `json:"state"`
+	Trigger    string                      `json:"trigger"`
+}
+
+// UpdaterRolloutEnvironmentResult is generated from the OpenAPI specification.
+type UpdaterRolloutEnvironmentResult struct {
+	EnvironmentID   string   `json:"environmentId"`
+	EnvironmentName string   `json:"environmentName"`
+	Error           *string  `json:"error,omitempty"`
+	Failed          int64    `json:"failed"`
+	Unhealthy       []string `json:"unhealthy,omitempty"`
+	Updated         int64    `json:"updated"`
+}
+
+// UpdaterRolloutStageStatus is generated from the OpenAPI specification.
+type UpdaterRolloutStageStatus struct {
+	EnvironmentIds []string                          `json:"environmentIds"`
+	Environments   []UpdaterRolloutEnvironmentResult `json:"environments"`
+	FinishedAt     *time.Time                        `json:"finishedAt,omitempty"`
+	Name           string                            `json:"name"`
+	SoakMinutes    *int64                            `json:"soakMinutes,omitempty"`
+	StartedAt      *time.Time                        `json:"startedAt,omitempty"`
+	State          string                            `json:"state"`
+}
+
+// UpdaterRun is generated from the OpenAPI specification.
+type UpdaterRun struct {
+	Blocked int64      `json:"blocked"`
+	Checked int64      `json:"checked"`
+	DryRun  bool       `json:"dryRun"`
+	EndTime *time.Time `json:"endTime,omitempty"`
+	Error   *string    `json:"error,omitempty"`
+	Failed  int64      `json:"failed"`
+	ID      string     `json:"id"`
+	// Previous images kept for rollback: image ID to the reference it was used by
+	RetainedImages map[string]string `json:"retainedImages,omitempty"`
+	// When the previous images kept for rollback may be pruned
+	RetainedUntil *time.Time `json:"retainedUntil,omitempty"`
+	// ID of the run whose failed items this run retried
+	RetryOfRunID *string   `json:"retryOfRunId,omitempty"`
+	Skipped      int64     `json:"skipped"`
+	StartTime    time.Time `json:"startTime"`
+	// updating while the run is in progress, then completed or failed
+	Status string `json:"status"`
+	// What started the run: schedule, manual, webhook or notification
+	Trigger string `json:"trigger"`
+	Updated int64  `json:"updated"`
+}
+
+// UpdaterRunDetail is generated from the OpenAPI specification.
+type UpdaterRunDetail struct {
+	Blocked int64            `json:"blocked"`
+	Checked int64            `json:"checked"`
+	DryRun  bool             `json:"dryRun"`
+	EndTime *time.Time       `json:"endTime,omitempty"`
+	Error   *string          `json:"error,omitempty"`
+	Failed  int64            `json:"failed"`
+	ID      string           `json:"id"`
+	Items   []UpdaterRunItem `json:"items"`
+	// Previous images kept for rollback: image ID to the reference it was used by
+	RetainedImages map[string]string `json:"retainedImages,omitempty"`
+	// When the previous images kept for rollback may be pruned
+	RetainedUntil *time.Time `json:"retainedUntil,omitempty"`
+	// ID of the run whose failed items this run retried
+	RetryOfRunID *string   `json:"retryOfRunId,omitempty"`
+	Skipped      int64     `json:"skipped"`
+	StartTime    time.Time `json:"startTime"`
+	// updating while the run is in progress, then completed or failed
+	Status string `json:"status"`
+	// What started the run: schedule, manual, webhook or notification
+	Trigger string `json:"trigger"`
+	Updated int64  `json:"updated"`
+}
+
+// UpdaterRunItem is generated from the OpenAPI specification.
+type UpdaterRunItem struct {
+	Error           *string           `json:"error,omitempty"`
+	ID              string            `json:"id"`
+	NewImages       map[string]string `json:"newImages,omitempty"`
+	OldImages       map[string]string `json:"oldImages,omitempty"`
+	ResourceID      string            `json:"resourceId"`
+	ResourceName    string            `json:"resourceName"`
+	ResourceType    string            `json:"resourceType"`
+	Status          string            `json:"status"`
+	Time            time.Time         `json:"time"`
+	UpdateApplied   bool              `json:"updateApplied"`
+	UpdateAvailable bool              `json:"updateAvailable"`
+}
+
+// UpdaterRunPaginatedResponse is generated from the OpenAPI specification.
+type UpdaterRunPaginatedResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema     *string                `json:"$schema,omitempty"`
+	Data       []UpdaterRun           `json:"data"`
+	Pagination BasePaginationResponse `json:"pagination"`
+	Success    bool                   `json:"success"`
+}
+
+// UpdaterStatus is generated from the OpenAPI specification.
+type UpdaterStatus struct {
+	ContainerIds       []string `json:"containerIds"`
+	ProjectIds         []string `json:"projectIds"`
+	UpdatingContainers int64    `json:"updatingContainers"`
+	UpdatingProjects   int64    `json:"updatingProjects"`
+}
+
+// UpgradeCheckResultData is generated from the OpenAPI specification.
+type UpgradeCheckResultData struct {
+	// A URL to the JSON Schema for this object.
+	Schema     *string `json:"$schema,omitempty"`
+	CanUpgrade bool    `json:"canUpgrade"`
+	Error      bool    `json:"error"`
+	Message    string  `json:"message"`
+}
+
+// UploadVolumeFileParams holds the query and header parameters of UploadVolumeFile.
+type UploadVolumeFileParams struct {
+	// Destination path
+	Path string
+}
+
+func (p *UploadVolumeFileParams) apply(query url.Values, header http.Header) {
+	setString(query.Set, "path", p.Path)
+}
+
+// UserCreateUser is generated from the OpenAPI specification.
+type UserCreateUser struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Display name of the user
+	DisplayName *string `json:"displayName,omitempty"`
+	// Email address of the user
+	Email *string `json:"email,omitempty"`
+	// Locale preference of the user
+	Locale *string `json:"locale,omitempty"`
+	// Password of the user
+	Password string `json:"password"`
+	// Roles assigned to the user
+	Roles []string `json:"roles,omitempty"`
+	// Username of the user
+	Username string `json:"username"`
+}
+
+// UserPaginatedResponse is generated from the OpenAPI specification.
+type UserPaginatedResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema     *string                `json:"$schema,omitempty"`
+	Data       []UserUser             `json:"data"`
+	Pagination BasePaginationResponse `json:"pagination"`
+	Success    bool                   `json:"success"`
+}
+
+// UserUpdateUser is generated from the OpenAPI specification.
+type UserUpdateUser struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Display name of the user
+	DisplayName *string `json:"displayName,omitempty"`
+	// Email address of the user
+	Email *string `json:"email,omitempty"`
+	// Locale preference of the user
+	Locale *string `json:"locale,omitempty"`
+	// New password for the user
+	Password *string `json:"password,omitempty"`
+	// Roles assigned to the user
+	Roles []string `json:"roles,omitempty"`
+	// Username of the user
+	Username *string `json:"username,omitempty"`
+}
+
+// UserUser is generated from the OpenAPI specification.
+type UserUser struct {
+	// Date and time when the user was created
+	CreatedAt *string `json:"createdAt,omitempty"`
+	// Display name of the user
+	DisplayName *string `json:"displayName,omitempty"`
+	// Email address of the user
+	Email *string `json:"email,omitempty"`
+	// Unique identifier of the user
+	ID string `json:"id"`
+	// Locale preference of the user
+	Locale *string `json:"locale,omitempty"`
+	// OIDC subject identifier for SSO users
+	OIDCSubjectID *string `json:"oidcSubjectId,omitempty"`
+	// Whether the user must change their password
+	RequiresPasswordChange bool `json:"requiresPasswordChange"`
+	// Roles assigned to the user
+	Roles []string `json:"roles"`
+	// Date and time when the user was last updated
+	UpdatedAt *string `json:"updatedAt,omitempty"`
+	// Username of the user
+	Username string `json:"username"`
+}
+
+// Value is generated from the OpenAPI specification.
+type Value = map[string]any
+
+// VersionChangelog is generated from the OpenAPI specification.
+type VersionChangelog struct {
+	CanUpgrade      bool             `json:"canUpgrade"`
+	CurrentVersion  string           `json:"currentVersion"`
+	NewestVersion   *string          `json:"newestVersion,omitempty"`
+	Releases        []VersionRelease `json:"releases,omitempty"`
+	UpdateAvailable bool             `json:"updateAvailable"`
+	UpgradeMessage  *string          `json:"upgradeMessage,omitempty"`
+}
+
+// VersionCheck is generated from the OpenAPI specification.
+type VersionCheck struct {
+	// A URL to the JSON Schema for this object.
+	Schema          *string `json:"$schema,omitempty"`
+	CurrentVersion  string  `json:"currentVersion"`
+	NewestVersion   *string `json:"newestVersion,omitempty"`
+	ReleaseURL      *string `json:"releaseUrl,omitempty"`
+	UpdateAvailable bool    `json:"updateAvailable"`
+}
+
+// VersionInfo is generated from the OpenAPI specification.
+type VersionInfo struct {
+	// A URL to the JSON Schema for this object.
+	Schema          *string  `json:"$schema,omitempty"`
+	BuildTime       *string  `json:"buildTime,omitempty"`
+	CurrentDigest   *string  `json:"currentDigest,omitempty"`
+	CurrentTag      *string  `json:"currentTag,omitempty"`
+	CurrentVersion  string   `json:"currentVersion"`
+	DisplayVersion  string   `json:"displayVersion"`
+	EnabledFeatures []string `json:"enabledFeatures,omitempty"`
+	GoVersion       string   `json:"goVersion"`
+	IsSemverVersion bool     `json:"isSemverVersion"`
+	NewestDigest    *string  `json:"newestDigest,omitempty"`
+	NewestVersion   *string  `json:"newestVersion,omitempty"`
+	ReleaseURL      *string  `json:"releaseUrl,omitempty"`
+	Revision        string   `json:"revision"`
+	ShortRevision   string   `json:"shortRevision"`
+	UpdateAvailable bool     `json:"updateAvailable"`
+}
+
+// VersionRelease is generated from the OpenAPI specification.
+type VersionRelease struct {
+	Name        *string    `json:"name,omitempty"`
+	Notes       *string    `json:"notes,omitempty"`
+	PublishedAt *time.Time `json:"publishedAt,omitempty"`
+	URL         string     `json:"url"`
+	Version     string     `json:"version"`
+}
+
+// VolumeBackup is generated from the OpenAPI specification.
+type VolumeBackup struct {
+	CreatedAt  time.Time  `json:"createdAt"`
+	ID         string     `json:"id"`
+	Size       int64      `json:"size"`
+	UpdatedAt  *time.Time `json:"updatedAt,omitempty"`
+	VolumeName string     `json:"volumeName"`
+}
+
+// VolumeBackupPaginatedResponse is generated from the OpenAPI specification.
+type VolumeBackupPaginatedResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema     *string                `json:"$schema,omitempty"`
+	Data       []VolumeBackup         `json:"data"`
+	Pagination BasePaginationResponse `json:"pagination"`
+	Success    bool                   `json:"success"`
+	Warnings   []string               `json:"warnings,omitempty"`
+}
+
+// VolumeCreate is generated from the OpenAPI specification.
+type VolumeCreate struct {
+	// A URL to the JSON Schema for this object.
+	Schema *string `json:"$schema,omitempty"`
+	// Volume driver (e.g., local, nfs)
+	Driver *string `json:"driver,omitempty"`
+	// Driver-specific options
+	DriverOpts map[string]string `json:"driverOpts,omitempty"`
+	// User-defined labels
+	Labels map[string]string `json:"labels,omitempty"`
+	// Name of the volume
+	Name string `json:"name"`
+}
+
+// VolumeFileEntry is generated from the OpenAPI specification.
+type VolumeFileEntry struct {
+	// Whether this entry is a directory
+	IsDirectory bool `json:"isDirectory"`
+	// Whether this entry is a symbolic link
+	IsSymlink bool `json:"isSymlink"`
+	// Target of the symbolic link
+	LinkTarget *string `json:"linkTarget,omitempty"`
+	// Last modification time
+	ModTime time.Time `json:"modTime"`
+	// File mode/permissions
+	Mode string `json:"mode"`
+	// Name of the file or directory
+	Name string `json:"name"`
+	// Full path to the file
+	Path string `json:"path"`
+	// Size of the file in bytes
+	Size int64 `json:"size"`
+}
+
+// VolumePaginatedResponse is generated from the OpenAPI specification.
+type VolumePaginatedResponse struct {
+	// A URL to the JSON Schema for this object.
+	Schema     *string                `json:"$schema,omitempty"`
+	Counts     VolumeUsageCountsData  `json:"counts"`
+	Data       []VolumeVolume         `json:"data"`
+	Pagination BasePaginationResponse `json:"pagination"`
+	Success    bool                   `json:"success"`
+}
+
+// VolumePruneReportData is generated from the OpenAPI specification.
+type VolumePruneReportData struct {
+	SpaceReclaimed int64    `json:"spaceReclaimed"`
+	VolumesDeleted []string `json:"volumesDeleted,omitempty"`
+}
+
+// VolumeSizeInfo is generated from the OpenAPI specification.
+type VolumeSizeInfo struct {
+	Name     string `json:"name"`
+	RefCount int64  `json:"refCount"`
+	Size     int64  `json:"size"`
+}
+
+// VolumeUsageCountsData is generated from the OpenAPI specification.
+type VolumeUsageCountsData struct {
+	Inuse  int64 `json:"inuse"`
+	Total  int64 `json:"total"`
+	Unused int64 `json:"unused"`
+}
+
+// VolumeUsageResponse is generated from the OpenAPI specification.
+type VolumeUsageResponse struct {
+	Containers []string `json:"containers"`
+	InUse      bool     `json:"inUse"`
+}
+
+// VolumeVolume is generated from the OpenAPI specification.
+type VolumeVolume struct {
+	Containers []string               `json:"containers"`
+	CreatedAt  string                 `json:"createdAt"`
+	Driver     string                 `json:"driver"`
+	ID         string                 `json:"id"`
+	InUse      bool                   `json:"inUse"`
+	Labels     map[string]string      `json:"labels"`
+	Mountpoint string                 `json:"mountpoint"`
+	Name       string                 `json:"name"`
+	Options    map[string]string      `json:"options"`
+	Scope      string                 `json:"scope"`
+	Size       int64                  `json:"size"`
+	UsageData  *DockerVolumeUsageData `json:"usageData,omitempty"`
+}
+
+// VulnerabilityCVSSInfo is generated from the OpenAPI specification.
+type VulnerabilityCVSSInfo struct {
+	V2Score  *float64 `json:"v2Score,omitempty"`
+	V2Vector *string  `json:"v2Vector,omitempty"`
+	V3Score  *float64 `json:"v3Score,omitempty"`
+	V3Vector *string  `json:"v3Vector,omitempty"`
+}
+
+// VulnerabilityEnvironmentVulnerabilitySummary is generated from the OpenAPI specification.
+type VulnerabilityEnvironmentVulnerabilitySummary struct {
+	ScannedImages int64                         `json:"scannedImages"`
+	Summary       *VulnerabilitySeveritySummary `json:"summary,omitempty"`
+	TotalImages   int64                         `json:"totalImages"`
+}
+
+// VulnerabilityIgnorePayload is generated from the OpenAPI specification.
+type VulnerabilityIgnorePayload struct {
+	// A URL to the JSON Schema for this object.
+	Schema           *string `json:"$schema,omitempty"`
+	CreatedBy        *string `json:"createdBy,omitempty"`
+	ImageID          string  `json:"imageId"`
+	InstalledVersion *string `json:"installedVersion,omitempty"`
+	PkgName          string  `json:"pkgName"`
+	Reason           *string `json:"reason,omitempty"`
+	VulnerabilityID  string  `json:"vulnerabilityId"`
+}
+
+// VulnerabilityIgnoredVulnerability is generated from the OpenAPI specification.
+type VulnerabilityIgnoredVulnerability struct {
+	CreatedAt        time.Time `json:"createdAt"`
+	CreatedBy        string    `json:"createdBy"`
+	EnvironmentID    string    `json:"environmentId"`
+	ID               string    `json:"id"`
+	ImageID          string    `json:"imageId"`
+	InstalledVersion string    `json:"installedVersion"`
+	PkgName          string    `json:"pkgName"`
+	Reason           *string   `json:"reason,omitempty"`
+	VulnerabilityID  string    `json:"vulnerabilityId"`
+}
+
+// VulnerabilityScanResult is generated from the OpenAPI specification.
+type VulnerabilityScanResult struct {
+	Duration        *int64                        `json:"duration,omitempty"`
+	Error           *string                       `json:"error,omitempty"`
+	ImageID         string                        `json:"imageId"`
+	ImageName       string                        `json:"imageName"`
+	ScanPhase       *string                       `json:"scanPhase,omitempty"`
+	ScanTime        time.Time                     `json:"scanTime"`
+	ScannerVersion  *string                       `json:"scannerVersion,omitempty"`
+	Status          string                        `json:"status"`
+	Summary         *VulnerabilitySeveritySummary `json:"summary,omitempty"`
+	Vulnerabilities []VulnerabilityVulnerability  `json:"vulnerabilities,omitempty"`
+}
+
+// VulnerabilityScanSummariesRequest is generated from the OpenAPI specification.
+type VulnerabilityScanSummariesRequest struct {
+	// A URL to the JSON Schema for this object.
+	Schema   *string  `json:"$schema,omitempty"`
+	ImageIds []string `json:"imageIds"`
+}
+
+// VulnerabilityScanSummariesResponse is generated from the OpenAPI specification.
+type VulnerabilityScanSummariesResponse struct {
+	Summaries map[string]VulnerabilityScanSummary `json:"summaries"`
+}
+
+// VulnerabilityScanSummary is generated from the OpenAPI specification.
+type VulnerabilityScanSummary struct {
+	Error     *string                       `json:"error,omitempty"`
+	ImageID   string                        `json:"imageId"`
+	ScanPhase *string                       `json:"scanPhase,omitempty"`
+	ScanTime  time.Time                     `json:"scanTime"`
+	Status    string                        `json:"status"`
+	Summary   *VulnerabilitySeveritySummary `json:"summary,omitempty"`
+}
+
+// VulnerabilitySeveritySummary is generated from the OpenAPI specification.
+type VulnerabilitySeveritySummary struct {
+	Critical int64 `json:"critical"`
+	High     int64 `json:"high"`
+	Low      int64 `json:"low"`
+	Medium   int64 `json:"medium"`
+	Total    int64 `json:"total"`
+	Unknown  int64 `json:"unknown"`
+}
+
+// VulnerabilityVulnerability is generated from the OpenAPI specification.
+type VulnerabilityVulnerability struct {
+	Cvss             *VulnerabilityCVSSInfo `json:"cvss,omitempty"`
+	Description      *string                `json:"description,omitempty"`
+	FixedVersion     *string                `json:"fixedVersion,omitempty"`
+	InstalledVersion string                 `json:"installedVersion"`
+	LastModifiedDate *time.Time             `json:"lastModifiedDate,omitempty"`
+	PkgName          string                 `json:"pkgName"`
+	PublishedDate    *time.Time             `json:"publishedDate,omitempty"`
+	References       []string               `json:"references,omitempty"`
+	Severity         string                 `json:"severity"`
+	Title            *string                `json:"title,omitempty"`
+	VulnerabilityID  string                 `json:"vulnerabilityId"`
+}
+
+// VulnerabilityVulnerabilityWithImage is generated from the OpenAPI specification.
+type VulnerabilityVulnerabilityWithImage struct {
+	Cvss             *VulnerabilityCVSSInfo `json:"cvss,omitempty"`
+	Description      *string                `json:"description,omitempty"`
+	FixedVersion     *string                `json:"fixedVersion,omitempty"`
+	ImageID          string                 `json:"imageId"`
+	ImageName        string                 `json:"imageName"`
+	InstalledVersion string                 `json:"installedVersion"`
+	LastModifiedDate *time.Time             `json:"lastModifiedDate,omitempty"`
+	PkgName          string                 `json:"pkgName"`
+	PublishedDate    *time.Time             `json:"publishedDate,omitempty"`
+	References       []string               `json:"references,omitempty"`
+	Severity         string                 `json:"severity"`
+	Title            *string                `json:"title,omitempty"`
+	VulnerabilityID  string                 `json:"vulnerabilityId"`
+}
+
+// ListAllContainers calls GET /api/aggregate/containers: list containers across environments.
+func (c *Client) ListAllContainers(ctx context.Context, params *ListAllContainersParams) (*AggregatedPaginatedResponseContainerSummary, error) {
+	path := "/api/aggregate/containers"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out AggregatedPaginatedResponseContainerSummary
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListAllImages calls GET /api/aggregate/images: list images across environments.
+func (c *Client) ListAllImages(ctx context.Context, params *ListAllImagesParams) (*AggregatedPaginatedResponseImageSummary, error) {
+	path := "/api/aggregate/images"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out AggregatedPaginatedResponseImageSummary
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListAllProjects calls GET /api/aggregate/projects: list projects across environments.
+func (c *Client) ListAllProjects(ctx context.Context, params *ListAllProjectsParams) (*AggregatedPaginatedResponseProjectDetails, error) {
+	path := "/api/aggregate/projects"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out AggregatedPaginatedResponseProjectDetails
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListApiKeys calls GET /api/api-keys: list API keys.
+func (c *Client) ListApiKeys(ctx context.Context, params *ListApiKeysParams) (*ApiKeyPaginatedResponse, error) {
+	path := "/api/api-keys"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out ApiKeyPaginatedResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateApiKey calls POST /api/api-keys: create an API key.
+func (c *Client) CreateApiKey(ctx context.Context, body ApikeyCreateApiKey) (*BaseApiResponseApiKeyCreatedDto, error) {
+	path := "/api/api-keys"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseApiKeyCreatedDto
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetApiKey calls GET /api/api-keys/{id}: get an API key.
+func (c *Client) GetApiKey(ctx context.Context, id string) (*BaseApiResponseApiKey, error) {
+	path := "/api/api-keys/" + url.PathEscape(id)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseApiKey
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateApiKey calls PUT /api/api-keys/{id}: update an API key.
+func (c *Client) UpdateApiKey(ctx context.Context, id string, body ApikeyUpdateApiKey) (*BaseApiResponseApiKey, error) {
+	path := "/api/api-keys/" + url.PathEscape(id)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseApiKey
+	if err := c.doJSON(ctx, http.MethodPut, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteApiKey calls DELETE /api/api-keys/{id}: delete an API key.
+func (c *Client) DeleteApiKey(ctx context.Context, id string) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/api-keys/" + url.PathEscape(id)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodDelete, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetFavicon calls GET /api/app-images/favicon: get application favicon.
+func (c *Client) GetFavicon(ctx context.Context) (*string, error) {
+	path := "/api/app-images/favicon"
+	query := url.Values{}
+	header := http.Header{}
+	var out string
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetLogo calls GET /api/app-images/logo: get application logo.
+func (c *Client) GetLogo(ctx context.Context, params *GetLogoParams) (*string, error) {
+	path := "/api/app-images/logo"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out string
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetLogoEmail calls GET /api/app-images/logo-email: get application logo for email.
+func (c *Client) GetLogoEmail(ctx context.Context) (*string, error) {
+	path := "/api/app-images/logo-email"
+	query := url.Values{}
+	header := http.Header{}
+	var out string
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetDefaultProfile calls GET /api/app-images/profile: get default profile image.
+func (c *Client) GetDefaultProfile(ctx context.Context) (*string, error) {
+	path := "/api/app-images/profile"
+	query := url.Values{}
+	header := http.Header{}
+	var out string
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAppVersion calls GET /api/app-version: get app version.
+func (c *Client) GetAppVersion(ctx context.Context) (*VersionInfo, error) {
+	path := "/api/app-version"
+	query := url.Values{}
+	header := http.Header{}
+	var out VersionInfo
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Login calls POST /api/auth/login: login.
+func (c *Client) Login(ctx context.Context, body AuthLogin) (*BaseApiResponseLoginResponse, error) {
+	path := "/api/auth/login"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseLoginResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Logout calls POST /api/auth/logout: logout.
+func (c *Client) Logout(ctx context.Context) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/auth/logout"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetCurrentUser calls GET /api/auth/me: get current user.
+func (c *Client) GetCurrentUser(ctx context.Context) (*BaseApiResponseUser, error) {
+	path := "/api/auth/me"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseUser
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ChangePassword calls POST /api/auth/password: change password.
+func (c *Client) ChangePassword(ctx context.Context, body AuthPasswordChange) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/auth/password"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RefreshToken calls POST /api/auth/refresh: refresh token.
+func (c *Client) RefreshToken(ctx context.Context, body AuthRefresh) (*BaseApiResponseTokenRefreshResponse, error) {
+	path := "/api/auth/refresh"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseTokenRefreshResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListCaCertificates calls GET /api/ca-certificates: list CA certificates.
+func (c *Client) ListCaCertificates(ctx context.Context) (*BaseApiResponseListCACertificate, error) {
+	path := "/api/ca-certificates"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseListCACertificate
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateCaCertificate calls POST /api/ca-certificates: upload a CA certificate.
+func (c *Client) CreateCaCertificate(ctx context.Context, body CacertificateCreateRequest) (*BaseApiResponseCACertificate, error) {
+	path := "/api/ca-certificates"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseCACertificate
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteCaCertificate calls DELETE /api/ca-certificates/{id}: delete a CA certificate.
+func (c *Client) DeleteCaCertificate(ctx context.Context, id string) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/ca-certificates/" + url.PathEscape(id)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodDelete, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetCapabilities calls GET /api/capabilities: get capabilities.
+func (c *Client) GetCapabilities(ctx context.Context) (*EnvironmentCapabilities, error) {
+	path := "/api/capabilities"
+	query := url.Values{}
+	header := http.Header{}
+	var out EnvironmentCapabilities
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListCommandWebhooks calls GET /api/command-webhooks: list command webhooks.
+func (c *Client) ListCommandWebhooks(ctx context.Context) (*BaseApiResponseListWebhook, error) {
+	path := "/api/command-webhooks"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseListWebhook
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateCommandWebhook calls POST /api/command-webhooks: create a command webhook.
+func (c *Client) CreateCommandWebhook(ctx context.Context, body CommandwebhookCreateWebhook) (*BaseApiResponseWebhookCreated, error) {
+	path := "/api/command-webhooks"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseWebhookCreated
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateCommandWebhook calls PUT /api/command-webhooks/{webhookId}: update a command webhook.
+func (c *Client) UpdateCommandWebhook(ctx context.Context, webhookID string, body CommandwebhookUpdateWebhook) (*BaseApiResponseWebhook, error) {
+	path := "/api/command-webhooks/" + url.PathEscape(webhookID)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseWebhook
+	if err := c.doJSON(ctx, http.MethodPut, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteCommandWebhook calls DELETE /api/command-webhooks/{webhookId}: delete a command webhook.
+func (c *Client) DeleteCommandWebhook(ctx context.Context, webhookID string) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/command-webhooks/" + url.PathEscape(webhookID)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodDelete, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListContainerRegistries calls GET /api/container-registries: list container registries.
+func (c *Client) ListContainerRegistries(ctx context.Context, params *ListContainerRegistriesParams) (*ContainerRegistryPaginatedResponse, error) {
+	path := "/api/container-registries"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out ContainerRegistryPaginatedResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateContainerRegistry calls POST /api/container-registries: create a container registry.
+func (c *Client) CreateContainerRegistry(ctx context.Context, body CreateContainerRegistryRequest) (*BaseApiResponseContainerRegistry, error) {
+	path := "/api/container-registries"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseContainerRegistry
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SyncContainerRegistries calls POST /api/container-registries/sync: sync container registries.
+func (c *Client) SyncContainerRegistries(ctx context.Context, body ContainerregistrySyncRequest) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/container-registries/sync"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetContainerRegistry calls GET /api/container-registries/{id}: get a container registry.
+func (c *Client) GetContainerRegistry(ctx context.Context, id string) (*BaseApiResponseContainerRegistry, error) {
+	path := "/api/container-registries/" + url.PathEscape(id)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseContainerRegistry
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateContainerRegistry calls PUT /api/container-registries/{id}: update a container registry.
+func (c *Client) UpdateContainerRegistry(ctx context.Context, id string, body UpdateContainerRegistryRequest) (*BaseApiResponseContainerRegistry, error) {
+	path := "/api/container-registries/" + url.PathEscape(id)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseContainerRegistry
+	if err := c.doJSON(ctx, http.MethodPut, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteContainerRegistry calls DELETE /api/container-registries/{id}: delete a container registry.
+func (c *Client) DeleteContainerRegistry(ctx context.Context, id string) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/container-registries/" + url.PathEscape(id)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodDelete, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// TestContainerRegistry calls POST /api/container-registries/{id}/test: test a container registry.
+func (c *Client) TestContainerRegistry(ctx context.Context, id string) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/container-registries/" + url.PathEscape(id) + "/test"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CloneContainerToEnvironment calls POST /api/containers/clone: clone a container to another environment.
+func (c *Client) CloneContainerToEnvironment(ctx context.Context, body ContainerCloneRequest) (*BaseApiResponseClonedContainer, error) {
+	path := "/api/containers/clone"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseClonedContainer
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetCustomizeCategories calls GET /api/customize/categories: get customization categories.
+func (c *Client) GetCustomizeCategories(ctx context.Context) (*[]CategoryCategory, error) {
+	path := "/api/customize/categories"
+	query := url.Values{}
+	header := http.Header{}
+	var out []CategoryCategory
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListGitRepositories calls GET /api/customize/git-repositories: list git repositories.
+func (c *Client) ListGitRepositories(ctx context.Context, params *ListGitRepositoriesParams) (*GitRepositoryPaginatedResponse, error) {
+	path := "/api/customize/git-repositories"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out GitRepositoryPaginatedResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateGitRepository calls POST /api/customize/git-repositories: create a git repository.
+func (c *Client) CreateGitRepository(ctx context.Context, body CreateGitRepositoryRequest) (*BaseApiResponseGitRepository, error) {
+	path := "/api/customize/git-repositories"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseGitRepository
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetGitRepository calls GET /api/customize/git-repositories/{id}: get a git repository.
+func (c *Client) GetGitRepository(ctx context.Context, id string) (*BaseApiResponseGitRepository, error) {
+	path := "/api/customize/git-repositories/" + url.PathEscape(id)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseGitRepository
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateGitRepository calls PUT /api/customize/git-repositories/{id}: update a git repository.
+func (c *Client) UpdateGitRepository(ctx context.Context, id string, body UpdateGitRepositoryRequest) (*BaseApiResponseGitRepository, error) {
+	path := "/api/customize/git-repositories/" + url.PathEscape(id)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseGitRepository
+	if err := c.doJSON(ctx, http.MethodPut, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteGitRepository calls DELETE /api/customize/git-repositories/{id}: delete a git repository.
+func (c *Client) DeleteGitRepository(ctx context.Context, id string) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/customize/git-repositories/" + url.PathEscape(id)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodDelete, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListGitRepositoryBranches calls GET /api/customize/git-repositories/{id}/branches: list repository branches.
+func (c *Client) ListGitRepositoryBranches(ctx context.Context, id string) (*BaseApiResponseBranchesResponse, error) {
+	path := "/api/customize/git-repositories/" + url.PathEscape(id) + "/branches"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseBranchesResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// BrowseGitRepositoryFiles calls GET /api/customize/git-repositories/{id}/files: browse repository files.
+func (c *Client) BrowseGitRepositoryFiles(ctx context.Context, id string, params *BrowseGitRepositoryFilesParams) (*BaseApiResponseBrowseResponse, error) {
+	path := "/api/customize/git-repositories/" + url.PathEscape(id) + "/files"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out BaseApiResponseBrowseResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// TestGitRepository calls POST /api/customize/git-repositories/{id}/test: test a git repository.
+func (c *Client) TestGitRepository(ctx context.Context, id string, params *TestGitRepositoryParams) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/customize/git-repositories/" + url.PathEscape(id) + "/test"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SearchCustomize calls POST /api/customize/search: search customization options.
+func (c *Client) SearchCustomize(ctx context.Context, body SearchRequest) (*SearchResponse, error) {
+	path := "/api/customize/search"
+	query := url.Values{}
+	header := http.Header{}
+	var out SearchResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetDashboardLayout calls GET /api/dashboard/layout: get dashboard layout.
+func (c *Client) GetDashboardLayout(ctx context.Context) (*BaseApiResponseLayout, error) {
+	path := "/api/dashboard/layout"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseLayout
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateDashboardLayout calls PUT /api/dashboard/layout: update dashboard layout.
+func (c *Client) UpdateDashboardLayout(ctx context.Context, body DashboardUpdateLayout) (*BaseApiResponseLayout, error) {
+	path := "/api/dashboard/layout"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseLayout
+	if err := c.doJSON(ctx, http.MethodPut, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ResetDashboardLayout calls DELETE /api/dashboard/layout: reset dashboard layout.
+func (c *Client) ResetDashboardLayout(ctx context.Context) (*BaseApiResponseLayout, error) {
+	path := "/api/dashboard/layout"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseLayout
+	if err := c.doJSON(ctx, http.MethodDelete, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListEnvironments calls GET /api/environments: list environments.
+func (c *Client) ListEnvironments(ctx context.Context, params *ListEnvironmentsParams) (*EnvironmentPaginatedResponse, error) {
+	path := "/api/environments"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out EnvironmentPaginatedResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateEnvironment calls POST /api/environments: create an environment.
+func (c *Client) CreateEnvironment(ctx context.Context, body EnvironmentCreate) (*BaseApiResponseEnvironmentWithApiKey, error) {
+	path := "/api/environments"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseEnvironmentWithApiKey
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PairEnvironment calls POST /api/environments/pair: pair agent with manager.
+func (c *Client) PairEnvironment(ctx context.Context, params *PairEnvironmentParams, body *EnvironmentCapabilities) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/environments/pair"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var reqBody any
+	if body != nil {
+		reqBody = body
+	}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, reqBody, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetEnvironment calls GET /api/environments/{id}: get an environment.
+func (c *Client) GetEnvironment(ctx context.Context, id string) (*BaseApiResponseEnvironment, error) {
+	path := "/api/environments/" + url.PathEscape(id)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseEnvironment
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateEnvironment calls PUT /api/environments/{id}: update an environment.
+func (c *Client) UpdateEnvironment(ctx context.Context, id string, body EnvironmentUpdate) (*BaseApiResponseEnvironment, error) {
+	path := "/api/environments/" + url.PathEscape(id)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseEnvironment
+	if err := c.doJSON(ctx, http.MethodPut, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteEnvironment calls DELETE /api/environments/{id}: delete an environment.
+func (c *Client) DeleteEnvironment(ctx context.Context, id string) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodDelete, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PairAgent calls POST /api/environments/{id}/agent/pair: pair with local agent.
+func (c *Client) PairAgent(ctx context.Context, id string, body *EnvironmentAgentPairRequest) (*BaseApiResponseAgentPairResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/agent/pair"
+	query := url.Values{}
+	header := http.Header{}
+	var reqBody any
+	if body != nil {
+		reqBody = body
+	}
+	var out BaseApiResponseAgentPairResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, reqBody, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// BuildsBrowse calls GET /api/environments/{id}/builds/browse: browse build workspace files.
+func (c *Client) BuildsBrowse(ctx context.Context, id string, params *BuildsBrowseParams) (*BaseApiResponseListFileEntry, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/builds/browse"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out BaseApiResponseListFileEntry
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// BuildsBrowseDelete calls DELETE /api/environments/{id}/builds/browse: delete build workspace file.
+func (c *Client) BuildsBrowseDelete(ctx context.Context, id string, params *BuildsBrowseDeleteParams) error {
+	path := "/api/environments/" + url.PathEscape(id) + "/builds/browse"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	return c.doJSON(ctx, http.MethodDelete, path, query, header, nil, nil)
+}
+
+// BuildsBrowseContent calls GET /api/environments/{id}/builds/browse/content: get build workspace file content.
+func (c *Client) BuildsBrowseContent(ctx context.Context, id string, params *BuildsBrowseContentParams) (*BaseApiResponseBuildFileContentResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/builds/browse/content"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out BaseApiResponseBuildFileContentResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// BuildsBrowseDownload calls GET /api/environments/{id}/builds/browse/download: download build workspace file.
+func (c *Client) BuildsBrowseDownload(ctx context.Context, id string, params *BuildsBrowseDownloadParams) (*any, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/builds/browse/download"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out any
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// BuildsBrowseMkdir calls POST /api/environments/{id}/builds/browse/mkdir: create build workspace directory.
+func (c *Client) BuildsBrowseMkdir(ctx context.Context, id string, params *BuildsBrowseMkdirParams) error {
+	path := "/api/environments/" + url.PathEscape(id) + "/builds/browse/mkdir"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	return c.doJSON(ctx, http.MethodPost, path, query, header, nil, nil)
+}
+
+// BuildsBrowseUpload calls POST /api/environments/{id}/builds/browse/upload: upload build workspace file.
+func (c *Client) BuildsBrowseUpload(ctx context.Context, id string, params *BuildsBrowseUploadParams, body io.Reader, contentType string) error {
+	path := "/api/environments/" + url.PathEscape(id) + "/builds/browse/upload"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	header.Set("Content-Type", contentType)
+	return c.doJSON(ctx, http.MethodPost, path, query, header, body, nil)
+}
+
+// ListContainerTasks calls GET /api/environments/{id}/container-tasks: list container tasks.
+func (c *Client) ListContainerTasks(ctx context.Context, id string) (*BaseApiResponseListTask, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/container-tasks"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseListTask
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateContainerTask calls POST /api/environments/{id}/container-tasks: create a container task.
+func (c *Client) CreateContainerTask(ctx context.Context, id string, body ContainertaskCreateTask) (*BaseApiResponseTask, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/container-tasks"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseTask
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetContainerTask calls GET /api/environments/{id}/container-tasks/{taskId}: get a container task.
+func (c *Client) GetContainerTask(ctx context.Context, id string, taskID string) (*BaseApiResponseTask, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/container-tasks/" + url.PathEscape(taskID)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseTask
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateContainerTask calls PUT /api/environments/{id}/container-tasks/{taskId}: update a container task.
+func (c *Client) UpdateContainerTask(ctx context.Context, id string, taskID string, body ContainertaskUpdateTask) (*BaseApiResponseTask, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/container-tasks/" + url.PathEscape(taskID)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseTask
+	if err := c.doJSON(ctx, http.MethodPut, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteContainerTask calls DELETE /api/environments/{id}/container-tasks/{taskId}: delete a container task.
+func (c *Client) DeleteContainerTask(ctx context.Context, id string, taskID string) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/container-tasks/" + url.PathEscape(taskID)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodDelete, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RunContainerTask calls POST /api/environments/{id}/container-tasks/{taskId}/run: run a container task now.
+func (c *Client) RunContainerTask(ctx context.Context, id string, taskID string) (*BaseApiResponseRun, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/container-tasks/" + url.PathEscape(taskID) + "/run"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseRun
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListContainerTaskRuns calls GET /api/environments/{id}/container-tasks/{taskId}/runs: list container task runs.
+func (c *Client) ListContainerTaskRuns(ctx context.Context, id string, taskID string, params *ListContainerTaskRunsParams) (*BaseApiResponseListRun, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/container-tasks/" + url.PathEscape(taskID) + "/runs"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out BaseApiResponseListRun
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListContainers calls GET /api/environments/{id}/containers: list containers.
+func (c *Client) ListContainers(ctx context.Context, id string, params *ListContainersParams) (*ContainerPaginatedResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/containers"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out ContainerPaginatedResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateContainer calls POST /api/environments/{id}/containers: create container.
+func (c *Client) CreateContainer(ctx context.Context, id string, body ContainerCreate) (*ContainerCreatedResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/containers"
+	query := url.Values{}
+	header := http.Header{}
+	var out ContainerCreatedResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ContainerStatusCounts calls GET /api/environments/{id}/containers/counts: container status counts.
+func (c *Client) ContainerStatusCounts(ctx context.Context, id string, params *ContainerStatusCountsParams) (*ContainerStatusCountsResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/containers/counts"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out ContainerStatusCountsResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ImportContainer calls POST /api/environments/{id}/containers/import: import a container.
+func (c *Client) ImportContainer(ctx context.Context, id string, body ContainerImportRequest) (*BaseApiResponseImportedContainer, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/containers/import"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseImportedContainer
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PreviewContainer calls POST /api/environments/{id}/containers/preview: preview container.
+func (c *Client) PreviewContainer(ctx context.Context, id string, body ContainerCreate) (*BaseApiResponseCreatePreview, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/containers/preview"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseCreatePreview
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetContainer calls GET /api/environments/{id}/containers/{containerId}: get container.
+func (c *Client) GetContainer(ctx context.Context, id string, containerID string) (*ContainerDetailsResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/containers/" + url.PathEscape(containerID)
+	query := url.Values{}
+	header := http.Header{}
+	var out ContainerDetailsResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteContainer calls DELETE /api/environments/{id}/containers/{containerId}: delete container.
+func (c *Client) DeleteContainer(ctx context.Context, id string, containerID string, params *DeleteContainerParams) (*ContainerActionResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/containers/" + url.PathEscape(containerID)
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out ContainerActionResponse
+	if err := c.doJSON(ctx, http.MethodDelete, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// EditContainer calls POST /api/environments/{id}/containers/{containerId}/edit: edit container.
+func (c *Client) EditContainer(ctx context.Context, id string, containerID string, params *EditContainerParams, body ContainerEditRequest) (*BaseApiResponseEditResult, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/containers/" + url.PathEscape(containerID) + "/edit"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out BaseApiResponseEditResult
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SearchContainerLogs calls GET /api/environments/{id}/containers/{containerId}/logs: search container logs.
+func (c *Client) SearchContainerLogs(ctx context.Context, id string, containerID string, params *SearchContainerLogsParams) (*ContainerLogsResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/containers/" + url.PathEscape(containerID) + "/logs"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out ContainerLogsResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DownloadContainerLogs calls GET /api/environments/{id}/containers/{containerId}/logs/download: download container logs.
+// The caller must close the returned body.
+func (c *Client) DownloadContainerLogs(ctx context.Context, id string, containerID string, params *DownloadContainerLogsParams) (io.ReadCloser, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/containers/" + url.PathEscape(containerID) + "/logs/download"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	return c.doRaw(ctx, http.MethodGet, path, query, header, nil)
+}
+
+// RestartContainer calls POST /api/environments/{id}/containers/{containerId}/restart: restart container.
+func (c *Client) RestartContainer(ctx context.Context, id string, containerID string) (*ContainerActionResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/containers/" + url.PathEscape(containerID) + "/restart"
+	query := url.Values{}
+	header := http.Header{}
+	var out ContainerActionResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RollbackContainer calls POST /api/environments/{id}/containers/{containerId}/rollback: roll back a container update.
+func (c *Client) RollbackContainer(ctx context.Context, id string, containerID string) (*BaseApiResponseContainerRollback, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/containers/" + url.PathEscape(containerID) + "/rollback"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseContainerRollback
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListContainerSnapshots calls GET /api/environments/{id}/containers/{containerId}/snapshots: list snapshots of a container.
+func (c *Client) ListContainerSnapshots(ctx context.Context, id string, containerID string) (*BaseApiResponseListSnapshot, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/containers/" + url.PathEscape(containerID) + "/snapshots"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseListSnapshot
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateContainerSnapshot calls POST /api/environments/{id}/containers/{containerId}/snapshots: snapshot a container.
+func (c *Client) CreateContainerSnapshot(ctx context.Context, id string, containerID string, body ContainerCreateSnapshotRequest) (*BaseApiResponseSnapshot, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/containers/" + url.PathEscape(containerID) + "/snapshots"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseSnapshot
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// StartContainer calls POST /api/environments/{id}/containers/{containerId}/start: start container.
+func (c *Client) StartContainer(ctx context.Context, id string, containerID string) (*ContainerActionResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/containers/" + url.PathEscape(containerID) + "/start"
+	query := url.Values{}
+	header := http.Header{}
+	var out ContainerActionResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// StopContainer calls POST /api/environments/{id}/containers/{containerId}/stop: stop container.
+func (c *Client) StopContainer(ctx context.Context, id string, containerID string) (*ContainerActionResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/containers/" + url.PathEscape(containerID) + "/stop"
+	query := url.Values{}
+	header := http.Header{}
+	var out ContainerActionResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetContainerTags calls GET /api/environments/{id}/containers/{containerId}/tags: get container tags.
+func (c *Client) GetContainerTags(ctx context.Context, id string, containerID string) (*BaseApiResponseMapStringString, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/containers/" + url.PathEscape(containerID) + "/tags"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMapStringString
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateContainerTags calls PUT /api/environments/{id}/containers/{containerId}/tags: set container tags.
+func (c *Client) UpdateContainerTags(ctx context.Context, id string, containerID string, body TagUpdateTags) (*BaseApiResponseMapStringString, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/containers/" + url.PathEscape(containerID) + "/tags"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMapStringString
+	if err := c.doJSON(ctx, http.MethodPut, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateContainer calls POST /api/environments/{id}/containers/{containerId}/update: update a single container.
+func (c *Client) UpdateContainer(ctx context.Context, id string, containerID string) (*BaseApiResponseResult, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/containers/" + url.PathEscape(containerID) + "/update"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseResult
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PreviewContainerUpdate calls GET /api/environments/{id}/containers/{containerId}/update/preview: preview a single container update.
+func (c *Client) PreviewContainerUpdate(ctx context.Context, id string, containerID string) (*BaseApiResponseContainerUpdatePreview, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/containers/" + url.PathEscape(containerID) + "/update/preview"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseContainerUpdatePreview
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetDashboardActionItems calls GET /api/environments/{id}/dashboard/action-items: get dashboard action items.
+func (c *Client) GetDashboardActionItems(ctx context.Context, id string, params *GetDashboardActionItemsParams) (*BaseApiResponseActionItems, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/dashboard/action-items"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out BaseApiResponseActionItems
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetDashboardWidgets calls GET /api/environments/{id}/dashboard/widgets: get dashboard widget data.
+func (c *Client) GetDashboardWidgets(ctx context.Context, id string, params *GetDashboardWidgetsParams) (*BaseApiResponseWidgetData, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/dashboard/widgets"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out BaseApiResponseWidgetData
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetDeploymentSnippets calls GET /api/environments/{id}/deployment: get deployment snippets.
+func (c *Client) GetDeploymentSnippets(ctx context.Context, id string, params *GetDeploymentSnippetsParams) (*BaseApiResponseDeploymentSnippet, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/deployment"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out BaseApiResponseDeploymentSnippet
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListGitOpsSyncs calls GET /api/environments/{id}/gitops-syncs: list GitOps syncs.
+func (c *Client) ListGitOpsSyncs(ctx context.Context, id string, params *ListGitOpsSyncsParams) (*GitOpsSyncPaginatedResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/gitops-syncs"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out GitOpsSyncPaginatedResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateGitOpsSync calls POST /api/environments/{id}/gitops-syncs: create a GitOps sync.
+func (c *Client) CreateGitOpsSync(ctx context.Context, id string, body GitopsCreateSyncRequest) (*BaseApiResponseGitOpsSync, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/gitops-syncs"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseGitOpsSync
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ImportGitOpsSyncs calls POST /api/environments/{id}/gitops-syncs/import: import GitOps syncs.
+func (c *Client) ImportGitOpsSyncs(ctx context.Context, id string, body []GitopsImportGitOpsSyncRequest) (*BaseApiResponseImportGitOpsSyncResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/gitops-syncs/import"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseImportGitOpsSyncResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetGitOpsSync calls GET /api/environments/{id}/gitops-syncs/{syncId}: get a GitOps sync.
+func (c *Client) GetGitOpsSync(ctx context.Context, id string, syncID string) (*BaseApiResponseGitOpsSync, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/gitops-syncs/" + url.PathEscape(syncID)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseGitOpsSync
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateGitOpsSync calls PUT /api/environments/{id}/gitops-syncs/{syncId}: update a GitOps sync.
+func (c *Client) UpdateGitOpsSync(ctx context.Context, id string, syncID string, body GitopsUpdateSyncRequest) (*BaseApiResponseGitOpsSync, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/gitops-syncs/" + url.PathEscape(syncID)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseGitOpsSync
+	if err := c.doJSON(ctx, http.MethodPut, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteGitOpsSync calls DELETE /api/environments/{id}/gitops-syncs/{syncId}: delete a GitOps sync.
+func (c *Client) DeleteGitOpsSync(ctx context.Context, id string, syncID string) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/gitops-syncs/" + url.PathEscape(syncID)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodDelete, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// BrowseGitOpsSyncFiles calls GET /api/environments/{id}/gitops-syncs/{syncId}/files: browse GitOps sync files.
+func (c *Client) BrowseGitOpsSyncFiles(ctx context.Context, id string, syncID string, params *BrowseGitOpsSyncFilesParams) (*BaseApiResponseBrowseResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/gitops-syncs/" + url.PathEscape(syncID) + "/files"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out BaseApiResponseBrowseResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetGitOpsSyncStatus calls GET /api/environments/{id}/gitops-syncs/{syncId}/status: get GitOps sync status.
+func (c *Client) GetGitOpsSyncStatus(ctx context.Context, id string, syncID string) (*BaseApiResponseSyncStatus, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/gitops-syncs/" + url.PathEscape(syncID) + "/status"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseSyncStatus
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PerformGitOpsSync calls POST /api/environments/{id}/gitops-syncs/{syncId}/sync: perform a GitOps sync.
+func (c *Client) PerformGitOpsSync(ctx context.Context, id string, syncID string) (*BaseApiResponseSyncResult, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/gitops-syncs/" + url.PathEscape(syncID) + "/sync"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseSyncResult
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ProposeGitOpsTagBumps calls POST /api/environments/{id}/gitops-syncs/{syncId}/tag-bump: propose image tag bumps.
+func (c *Client) ProposeGitOpsTagBumps(ctx context.Context, id string, syncID string) (*BaseApiResponseTagBumpResult, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/gitops-syncs/" + url.PathEscape(syncID) + "/tag-bump"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseTagBumpResult
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateHeartbeat calls POST /api/environments/{id}/heartbeat: update environment heartbeat.
+func (c *Client) UpdateHeartbeat(ctx context.Context, id string) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/heartbeat"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetHostMetricsHistory calls GET /api/environments/{id}/host-metrics: get host metrics history.
+func (c *Client) GetHostMetricsHistory(ctx context.Context, id string, params *GetHostMetricsHistoryParams) (*BaseApiResponseListHostMetrics, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/host-metrics"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out BaseApiResponseListHostMetrics
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CheckImageUpdate calls GET /api/environments/{id}/image-updates/check: check image update by reference.
+func (c *Client) CheckImageUpdate(ctx context.Context, id string, params *CheckImageUpdateParams) (*BaseApiResponseResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/image-updates/check"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out BaseApiResponseResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CheckAllImages calls POST /api/environments/{id}/image-updates/check-all: check all images.
+func (c *Client) CheckAllImages(ctx context.Context, id string, body ImageupdateCheckAllImagesRequest) (*BaseApiResponseBatchResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/image-updates/check-all"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseBatchResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CheckMultipleImages calls POST /api/environments/{id}/image-updates/check-batch: check multiple images.
+func (c *Client) CheckMultipleImages(ctx context.Context, id string, body ImageupdateBatchImageUpdateRequest) (*BaseApiResponseBatchResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/image-updates/check-batch"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseBatchResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CheckImageUpdateById calls GET /api/environments/{id}/image-updates/check/{imageId}: check image update by ID.
+func (c *Client) CheckImageUpdateById(ctx context.Context, id string, imageID string) (*BaseApiResponseResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/image-updates/check/" + url.PathEscape(imageID)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CheckImageUpdateByIdPost calls POST /api/environments/{id}/image-updates/check/{imageId}: check image update by ID (POST).
+func (c *Client) CheckImageUpdateByIdPost(ctx context.Context, id string, imageID string) (*BaseApiResponseResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/image-updates/check/" + url.PathEscape(imageID)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListImageUpdateSnoozes calls GET /api/environments/{id}/image-updates/snoozes: list snoozed image updates.
+func (c *Client) ListImageUpdateSnoozes(ctx context.Context, id string) (*BaseApiResponseListSnooze, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/image-updates/snoozes"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseListSnooze
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SnoozeImageUpdates calls PUT /api/environments/{id}/image-updates/snoozes: snooze image updates.
+func (c *Client) SnoozeImageUpdates(ctx context.Context, id string, body ImageupdateSnoozeRequest) (*BaseApiResponseSnooze, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/image-updates/snoozes"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseSnooze
+	if err := c.doJSON(ctx, http.MethodPut, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UnsnoozeImageUpdates calls DELETE /api/environments/{id}/image-updates/snoozes: unsnooze image updates.
+func (c *Client) UnsnoozeImageUpdates(ctx context.Context, id string, params *UnsnoozeImageUpdatesParams) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/image-updates/snoozes"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodDelete, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetUpdateSummary calls GET /api/environments/{id}/image-updates/summary: get update summary.
+func (c *Client) GetUpdateSummary(ctx context.Context, id string) (*BaseApiResponseSummary, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/image-updates/summary"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseSummary
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListRemoteTags calls GET /api/environments/{id}/image-updates/tags: list registry tags.
+func (c *Client) ListRemoteTags(ctx context.Context, id string, params *ListRemoteTagsParams) (*RemoteTagPaginatedResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/image-updates/tags"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out RemoteTagPaginatedResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListImages calls GET /api/environments/{id}/images: list images.
+func (c *Client) ListImages(ctx context.Context, id string, params *ListImagesParams) (*ImagePaginatedResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/images"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out ImagePaginatedResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// BuildImage calls POST /api/environments/{id}/images/build: build an image.
+// Progress messages are passed to onMessage as they arrive.
+func (c *Client) BuildImage(ctx context.Context, id string, body ImageBuildRequest, onMessage func(json.RawMessage) error) error {
+	path := "/api/environments/" + url.PathEscape(id) + "/images/build"
+	query := url.Values{}
+	header := http.Header{}
+	return c.doStream(ctx, http.MethodPost, path, query, header, body, onMessage)
+}
+
+// ListImageBuilds calls GET /api/environments/{id}/images/builds: list image builds.
+func (c *Client) ListImageBuilds(ctx context.Context, id string, params *ListImageBuildsParams) (*ImageBuildPaginatedResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/images/builds"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out ImageBuildPaginatedResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetImageBuild calls GET /api/environments/{id}/images/builds/{buildId}: get image build.
+func (c *Client) GetImageBuild(ctx context.Context, id string, buildID string) (*BaseApiResponseBuildRecord, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/images/builds/" + url.PathEscape(buildID)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseBuildRecord
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetImageUsageCounts calls GET /api/environments/{id}/images/counts: get image usage counts.
+func (c *Client) GetImageUsageCounts(ctx context.Context, id string) (*ImageUsageCountsResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/images/counts"
+	query := url.Values{}
+	header := http.Header{}
+	var out ImageUsageCountsResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PruneImages calls POST /api/environments/{id}/images/prune: prune unused images.
+func (c *Client) PruneImages(ctx context.Context, id string, params *PruneImagesParams, body *PruneImagesInputBody) (*BaseApiResponsePruneReport, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/images/prune"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var reqBody any
+	if body != nil {
+		reqBody = body
+	}
+	var out BaseApiResponsePruneReport
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, reqBody, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PullImage calls POST /api/environments/{id}/images/pull: pull an image.
+// Progress messages are passed to onMessage as they arrive.
+func (c *Client) PullImage(ctx context.Context, id string, body ImagePullOptions, onMessage func(json.RawMessage) error) error {
+	path := "/api/environments/" + url.PathEscape(id) + "/images/pull"
+	query := url.Values{}
+	header := http.Header{}
+	return c.doStream(ctx, http.MethodPost, path, query, header, body, onMessage)
+}
+
+// ListImagePulls calls GET /api/environments/{id}/images/pulls: list image pulls.
+func (c *Client) ListImagePulls(ctx context.Context, id string, params *ListImagePullsParams) (*ImagePullPaginatedResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/images/pulls"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out ImagePullPaginatedResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UploadImage calls POST /api/environments/{id}/images/upload: upload an image.
+func (c *Client) UploadImage(ctx context.Context, id string, body io.Reader, contentType string) (*BaseApiResponseLoadResult, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/images/upload"
+	query := url.Values{}
+	header := http.Header{}
+	header.Set("Content-Type", contentType)
+	var out BaseApiResponseLoadResult
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetImageVulnerabilitySummaries calls POST /api/environments/{id}/images/vulnerabilities/summaries: get vulnerability scan summaries.
+func (c *Client) GetImageVulnerabilitySummaries(ctx context.Context, id string, body VulnerabilityScanSummariesRequest) (*BaseApiResponseScanSummariesResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/images/vulnerabilities/summaries"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseScanSummariesResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetImage calls GET /api/environments/{id}/images/{imageId}: get image by ID.
+func (c *Client) GetImage(ctx context.Context, id string, imageID string) (*BaseApiResponseDetailSummary, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/images/" + url.PathEscape(imageID)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseDetailSummary
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RemoveImage calls DELETE /api/environments/{id}/images/{imageId}: remove an image.
+func (c *Client) RemoveImage(ctx context.Context, id string, imageID string, params *RemoveImageParams) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/images/" + url.PathEscape(imageID)
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodDelete, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetImageVulnerabilities calls GET /api/environments/{id}/images/{imageId}/vulnerabilities: get vulnerability scan result.
+func (c *Client) GetImageVulnerabilities(ctx context.Context, id string, imageID string) (*BaseApiResponseScanResult, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/images/" + url.PathEscape(imageID) + "/vulnerabilities"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseScanResult
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListImageVulnerabilities calls GET /api/environments/{id}/images/{imageId}/vulnerabilities/list: list image vulnerabilities.
+func (c *Client) ListImageVulnerabilities(ctx context.Context, id string, imageID string, params *ListImageVulnerabilitiesParams) (*BasePaginatedVulnerability, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/images/" + url.PathEscape(imageID) + "/vulnerabilities/list"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out BasePaginatedVulnerability
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ScanImageVulnerabilities calls POST /api/environments/{id}/images/{imageId}/vulnerabilities/scan: scan image for vulnerabilities.
+func (c *Client) ScanImageVulnerabilities(ctx context.Context, id string, imageID string) (*BaseApiResponseScanResult, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/images/" + url.PathEscape(imageID) + "/vulnerabilities/scan"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseScanResult
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetImageVulnerabilitySummary calls GET /api/environments/{id}/images/{imageId}/vulnerabilities/summary: get vulnerability scan summary.
+func (c *Client) GetImageVulnerabilitySummary(ctx context.Context, id string, imageID string) (*BaseApiResponseScanSummary, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/images/" + url.PathEscape(imageID) + "/vulnerabilities/summary"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseScanSummary
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GenerateIngressLabels calls POST /api/environments/{id}/ingress/labels: generate reverse proxy labels.
+func (c *Client) GenerateIngressLabels(ctx context.Context, id string, body IngressGenerateLabelsRequest) (*BaseApiResponseGeneratedLabels, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/ingress/labels"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseGeneratedLabels
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetIngressRoutes calls GET /api/environments/{id}/ingress/routes: get reverse proxy routes.
+func (c *Client) GetIngressRoutes(ctx context.Context, id string) (*BaseApiResponseAnalysis, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/ingress/routes"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseAnalysis
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetJobSchedules calls GET /api/environments/{id}/job-schedules: get job schedules.
+func (c *Client) GetJobSchedules(ctx context.Context, id string) (*JobscheduleConfig, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/job-schedules"
+	query := url.Values{}
+	header := http.Header{}
+	var out JobscheduleConfig
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateJobSchedules calls PUT /api/environments/{id}/job-schedules: update job schedules.
+func (c *Client) UpdateJobSchedules(ctx context.Context, id string, body JobscheduleUpdate) (*BaseApiResponseConfig, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/job-schedules"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseConfig
+	if err := c.doJSON(ctx, http.MethodPut, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListJobs calls GET /api/environments/{id}/jobs: list all background jobs.
+func (c *Client) ListJobs(ctx context.Context, id string) (*JobscheduleJobListResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/jobs"
+	query := url.Values{}
+	header := http.Header{}
+	var out JobscheduleJobListResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RunJob calls POST /api/environments/{id}/jobs/{jobId}/run: run a job now.
+func (c *Client) RunJob(ctx context.Context, id string, jobID string) (*JobscheduleJobRunResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/jobs/" + url.PathEscape(jobID) + "/run"
+	query := url.Values{}
+	header := http.Header{}
+	var out JobscheduleJobRunResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListLogAlertRules calls GET /api/environments/{id}/log-alerts: list log alert rules.
+func (c *Client) ListLogAlertRules(ctx context.Context, id string) (*BaseApiResponseListRule, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/log-alerts"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseListRule
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateLogAlertRule calls POST /api/environments/{id}/log-alerts: create a log alert rule.
+func (c *Client) CreateLogAlertRule(ctx context.Context, id string, body LogalertCreateRule) (*BaseApiResponseRule, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/log-alerts"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseRule
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetLogAlertRule calls GET /api/environments/{id}/log-alerts/{ruleId}: get a log alert rule.
+func (c *Client) GetLogAlertRule(ctx context.Context, id string, ruleID string) (*BaseApiResponseRule, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/log-alerts/" + url.PathEscape(ruleID)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseRule
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateLogAlertRule calls PUT /api/environments/{id}/log-alerts/{ruleId}: update a log alert rule.
+func (c *Client) UpdateLogAlertRule(ctx context.Context, id string, ruleID string, body LogalertUpdateRule) (*BaseApiResponseRule, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/log-alerts/" + url.PathEscape(ruleID)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseRule
+	if err := c.doJSON(ctx, http.MethodPut, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteLogAlertRule calls DELETE /api/environments/{id}/log-alerts/{ruleId}: delete a log alert rule.
+func (c *Client) DeleteLogAlertRule(ctx context.Context, id string, ruleID string) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/log-alerts/" + url.PathEscape(ruleID)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodDelete, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListNetworks calls GET /api/environments/{id}/networks: list networks.
+func (c *Client) ListNetworks(ctx context.Context, id string, params *ListNetworksParams) (*NetworkPaginatedResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/networks"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out NetworkPaginatedResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateNetwork calls POST /api/environments/{id}/networks: create network.
+func (c *Client) CreateNetwork(ctx context.Context, id string, body NetworkCreateRequest) (*NetworkCreatedApiResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/networks"
+	query := url.Values{}
+	header := http.Header{}
+	var out NetworkCreatedApiResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// NetworkCounts calls GET /api/environments/{id}/networks/counts: network counts.
+func (c *Client) NetworkCounts(ctx context.Context, id string) (*NetworkCountsApiResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/networks/counts"
+	query := url.Values{}
+	header := http.Header{}
+	var out NetworkCountsApiResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PruneNetworks calls POST /api/environments/{id}/networks/prune: prune networks.
+func (c *Client) PruneNetworks(ctx context.Context, id string) (*NetworkPruneResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/networks/prune"
+	query := url.Values{}
+	header := http.Header{}
+	var out NetworkPruneResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetNetwork calls GET /api/environments/{id}/networks/{networkId}: get network.
+func (c *Client) GetNetwork(ctx context.Context, id string, networkID string, params *GetNetworkParams) (*NetworkInspectApiResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/networks/" + url.PathEscape(networkID)
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out NetworkInspectApiResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteNetwork calls DELETE /api/environments/{id}/networks/{networkId}: delete network.
+func (c *Client) DeleteNetwork(ctx context.Context, id string, networkID string) (*NetworkMessageApiResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/networks/" + url.PathEscape(networkID)
+	query := url.Values{}
+	header := http.Header{}
+	var out NetworkMessageApiResponse
+	if err := c.doJSON(ctx, http.MethodDelete, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAppriseSettings calls GET /api/environments/{id}/notifications/apprise: get Apprise settings.
+func (c *Client) GetAppriseSettings(ctx context.Context, id string) (*NotificationAppriseResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/notifications/apprise"
+	query := url.Values{}
+	header := http.Header{}
+	var out NotificationAppriseResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateOrUpdateAppriseSettings calls POST /api/environments/{id}/notifications/apprise: create or update Apprise settings.
+func (c *Client) CreateOrUpdateAppriseSettings(ctx context.Context, id string, body NotificationAppriseUpdate) (*NotificationAppriseResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/notifications/apprise"
+	query := url.Values{}
+	header := http.Header{}
+	var out NotificationAppriseResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// TestAppriseNotification calls POST /api/environments/{id}/notifications/apprise/test: test Apprise notification.
+func (c *Client) TestAppriseNotification(ctx context.Context, id string, params *TestAppriseNotificationParams) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/notifications/apprise/test"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAllNotificationSettings calls GET /api/environments/{id}/notifications/settings: get all notification settings.
+func (c *Client) GetAllNotificationSettings(ctx context.Context, id string) (*[]NotificationResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/notifications/settings"
+	query := url.Values{}
+	header := http.Header{}
+	var out []NotificationResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateOrUpdateNotificationSettings calls POST /api/environments/{id}/notifications/settings: create or update notification settings.
+func (c *Client) CreateOrUpdateNotificationSettings(ctx context.Context, id string, body NotificationUpdate) (*NotificationResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/notifications/settings"
+	query := url.Values{}
+	header := http.Header{}
+	var out NotificationResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ExportNotificationSettings calls POST /api/environments/{id}/notifications/settings/export: export notification settings.
+func (c *Client) ExportNotificationSettings(ctx context.Context, id string, body NotificationExportRequest) (*BaseApiResponseBundle, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/notifications/settings/export"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseBundle
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ImportNotificationSettings calls POST /api/environments/{id}/notifications/settings/import: import notification settings.
+func (c *Client) ImportNotificationSettings(ctx context.Context, id string, body NotificationImportRequest) (*BaseApiResponseSettingsImportResult, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/notifications/settings/import"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseSettingsImportResult
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetNotificationSettings calls GET /api/environments/{id}/notifications/settings/{provider}: get notification settings by provider.
+func (c *Client) GetNotificationSettings(ctx context.Context, id string, provider string) (*NotificationResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/notifications/settings/" + url.PathEscape(provider)
+	query := url.Values{}
+	header := http.Header{}
+	var out NotificationResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteNotificationSettings calls DELETE /api/environments/{id}/notifications/settings/{provider}: delete notification settings.
+func (c *Client) DeleteNotificationSettings(ctx context.Context, id string, provider string) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/notifications/settings/" + url.PathEscape(provider)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodDelete, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// TestNotification calls POST /api/environments/{id}/notifications/test/{provider}: test notification.
+func (c *Client) TestNotification(ctx context.Context, id string, provider string, params *TestNotificationParams) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/notifications/test/" + url.PathEscape(provider)
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ApproveEnvironmentPairing calls POST /api/environments/{id}/pairing/approve: approve pairing request.
+func (c *Client) ApproveEnvironmentPairing(ctx context.Context, id string) (*BaseApiResponseEnvironment, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/pairing/approve"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseEnvironment
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RejectEnvironmentPairing calls POST /api/environments/{id}/pairing/reject: reject pairing request.
+func (c *Client) RejectEnvironmentPairing(ctx context.Context, id string) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/pairing/reject"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListProjects calls GET /api/environments/{id}/projects: list projects.
+func (c *Client) ListProjects(ctx context.Context, id string, params *ListProjectsParams) (*ProjectPaginatedResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/projects"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out ProjectPaginatedResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateProject calls POST /api/environments/{id}/projects: create a project.
+func (c *Client) CreateProject(ctx context.Context, id string, body ProjectCreateProject) (*BaseApiResponseCreateReponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/projects"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseCreateReponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AdoptContainers calls POST /api/environments/{id}/projects/adopt: adopt containers into a project.
+func (c *Client) AdoptContainers(ctx context.Context, id string, body ProjectAdoptContainers) (*BaseApiResponseCreateReponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/projects/adopt"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseCreateReponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetProjectStatusCounts calls GET /api/environments/{id}/projects/counts: get project status counts.
+func (c *Client) GetProjectStatusCounts(ctx context.Context, id string) (*BaseApiResponseStatusCounts, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/projects/counts"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseStatusCounts
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListCustomFileTemplates calls GET /api/environments/{id}/projects/custom-file-templates: list custom file templates.
+func (c *Client) ListCustomFileTemplates(ctx context.Context, id string) (*BaseApiResponseListCustomFileTemplate, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/projects/custom-file-templates"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseListCustomFileTemplate
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetProjectPathMapping calls GET /api/environments/{id}/projects/path-mapping: get project path mapping diagnostics.
+func (c *Client) GetProjectPathMapping(ctx context.Context, id string) (*BaseApiResponsePathMappingDiagnostics, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/projects/path-mapping"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponsePathMappingDiagnostics
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetProject calls GET /api/environments/{id}/projects/{projectId}: get a project.
+func (c *Client) GetProject(ctx context.Context, id string, projectID string) (*BaseApiResponseDetails, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/projects/" + url.PathEscape(projectID)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseDetails
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateProject calls PUT /api/environments/{id}/projects/{projectId}: update a project.
+func (c *Client) UpdateProject(ctx context.Context, id string, projectID string, params *UpdateProjectParams, body ProjectUpdateProject) (*BaseApiResponseDetails, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/projects/" + url.PathEscape(projectID)
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out BaseApiResponseDetails
+	if err := c.doJSON(ctx, http.MethodPut, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ArchiveProject calls POST /api/environments/{id}/projects/{projectId}/archive: archive a project.
+func (c *Client) ArchiveProject(ctx context.Context, id string, projectID string) (*BaseApiResponseDetails, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/projects/" + url.PathEscape(projectID) + "/archive"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseDetails
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// BuildProjectImages calls POST /api/environments/{id}/projects/{projectId}/build: build project images.
+// Progress messages are passed to onMessage as they arrive.
+func (c *Client) BuildProjectImages(ctx context.Context, id string, projectID string, body *BuildProjectInputBody, onMessage func(json.RawMessage) error) error {
+	path := "/api/environments/" + url.PathEscape(id) + "/projects/" + url.PathEscape(projectID) + "/build"
+	query := url.Values{}
+	header := http.Header{}
+	var reqBody any
+	if body != nil {
+		reqBody = body
+	}
+	return c.doStream(ctx, http.MethodPost, path, query, header, reqBody, onMessage)
+}
+
+// CloneProject calls POST /api/environments/{id}/projects/{projectId}/clone: clone a project.
+func (c *Client) CloneProject(ctx context.Context, id string, projectID string, body ProjectClone) (*BaseApiResponseCloneResult, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/projects/" + url.PathEscape(projectID) + "/clone"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseCloneResult
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DestroyProject calls DELETE /api/environments/{id}/projects/{projectId}/destroy: destroy a project.
+func (c *Client) DestroyProject(ctx context.Context, id string, projectID string, body *ProjectDestroy) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/projects/" + url.PathEscape(projectID) + "/destroy"
+	query := url.Values{}
+	header := http.Header{}
+	var reqBody any
+	if body != nil {
+		reqBody = body
+	}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodDelete, path, query, header, reqBody, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DownProject calls POST /api/environments/{id}/projects/{projectId}/down: bring down a project.
+func (c *Client) DownProject(ctx context.Context, id string, projectID string) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/projects/" + url.PathEscape(projectID) + "/down"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CheckProjectEnv calls GET /api/environments/{id}/projects/{projectId}/env-check: check project environment variables.
+func (c *Client) CheckProjectEnv(ctx context.Context, id string, projectID string) (*BaseApiResponseEnvCheck, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/projects/" + url.PathEscape(projectID) + "/env-check"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseEnvCheck
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateProjectCustomFile calls POST /api/environments/{id}/projects/{projectId}/files: create a project custom file.
+func (c *Client) CreateProjectCustomFile(ctx context.Context, id string, projectID string, params *CreateProjectCustomFileParams, body ProjectCreateCustomFile) (*BaseApiResponseDetails, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/projects/" + url.PathEscape(projectID) + "/files"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out BaseApiResponseDetails
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteProjectFile calls DELETE /api/environments/{id}/projects/{projectId}/files: delete a project file.
+func (c *Client) DeleteProjectFile(ctx context.Context, id string, projectID string, params *DeleteProjectFileParams) (*BaseApiResponseDeleteFileResult, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/projects/" + url.PathEscape(projectID) + "/files"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out BaseApiResponseDeleteFileResult
+	if err := c.doJSON(ctx, http.MethodDelete, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateProjectInclude calls PUT /api/environments/{id}/projects/{projectId}/includes: update project include file.
+func (c *Client) UpdateProjectInclude(ctx context.Context, id string, projectID string, params *UpdateProjectIncludeParams, body ProjectUpdateIncludeFile) (*BaseApiResponseDetails, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/projects/" + url.PathEscape(projectID) + "/includes"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out BaseApiResponseDetails
+	if err := c.doJSON(ctx, http.MethodPut, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// LockProject calls POST /api/environments/{id}/projects/{projectId}/lock: lock a project.
+func (c *Client) LockProject(ctx context.Context, id string, projectID string, body *ProjectLock) (*BaseApiResponseDetails, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/projects/" + url.PathEscape(projectID) + "/lock"
+	query := url.Values{}
+	header := http.Header{}
+	var reqBody any
+	if body != nil {
+		reqBody = body
+	}
+	var out BaseApiResponseDetails
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, reqBody, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetProjectLogForwarding calls GET /api/environments/{id}/projects/{projectId}/log-forwarding: get project log forwarding.
+func (c *Client) GetProjectLogForwarding(ctx context.Context, id string, projectID string) (*BaseApiResponseLogForwarder, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/projects/" + url.PathEscape(projectID) + "/log-forwarding"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseLogForwarder
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateProjectLogForwarding calls PUT /api/environments/{id}/projects/{projectId}/log-forwarding: configure project log forwarding.
+func (c *Client) UpdateProjectLogForwarding(ctx context.Context, id string, projectID string, body LogforwardUpsertLogForwarder) (*BaseApiResponseLogForwarder, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/projects/" + url.PathEscape(projectID) + "/log-forwarding"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseLogForwarder
+	if err := c.doJSON(ctx, http.MethodPut, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteProjectLogForwarding calls DELETE /api/environments/{id}/projects/{projectId}/log-forwarding: remove project log forwarding.
+func (c *Client) DeleteProjectLogForwarding(ctx context.Context, id string, projectID string) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/projects/" + url.PathEscape(projectID) + "/log-forwarding"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodDelete, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// StartProjectMaintenance calls POST /api/environments/{id}/projects/{projectId}/maintenance: start project maintenance.
+func (c *Client) StartProjectMaintenance(ctx context.Context, id string, projectID string, body *ProjectStartMaintenance) (*BaseApiResponseDetails, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/projects/" + url.PathEscape(projectID) + "/maintenance"
+	query := url.Values{}
+	header := http.Header{}
+	var reqBody any
+	if body != nil {
+		reqBody = body
+	}
+	var out BaseApiResponseDetails
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, reqBody, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// EndProjectMaintenance calls DELETE /api/environments/{id}/projects/{projectId}/maintenance: end project maintenance.
+func (c *Client) EndProjectMaintenance(ctx context.Context, id string, projectID string) (*BaseApiResponseDetails, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/projects/" + url.PathEscape(projectID) + "/maintenance"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseDetails
+	if err := c.doJSON(ctx, http.MethodDelete, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PullProjectImages calls POST /api/environments/{id}/projects/{projectId}/pull: pull project images.
+// Progress messages are passed to onMessage as they arrive.
+func (c *Client) PullProjectImages(ctx context.Context, id string, projectID string, onMessage func(json.RawMessage) error) error {
+	path := "/api/environments/" + url.PathEscape(id) + "/projects/" + url.PathEscape(projectID) + "/pull"
+	query := url.Values{}
+	header := http.Header{}
+	return c.doStream(ctx, http.MethodPost, path, query, header, nil, onMessage)
+}
+
+// GetProjectQuota calls GET /api/environments/{id}/projects/{projectId}/quota: get project resource quota.
+func (c *Client) GetProjectQuota(ctx context.Context, id string, projectID string) (*BaseApiResponseQuotaStatus, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/projects/" + url.PathEscape(projectID) + "/quota"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseQuotaStatus
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateProjectQuota calls PUT /api/environments/{id}/projects/{projectId}/quota: update project resource quota.
+func (c *Client) UpdateProjectQuota(ctx context.Context, id string, projectID string, body ProjectResourceQuota) (*BaseApiResponseQuotaStatus, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/projects/" + url.PathEscape(projectID) + "/quota"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseQuotaStatus
+	if err := c.doJSON(ctx, http.MethodPut, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteProjectQuota calls DELETE /api/environments/{id}/projects/{projectId}/quota: delete project resource quota.
+func (c *Client) DeleteProjectQuota(ctx context.Context, id string, projectID string) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/projects/" + url.PathEscape(projectID) + "/quota"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodDelete, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RedeployProject calls POST /api/environments/{id}/projects/{projectId}/redeploy: redeploy a project.
+func (c *Client) RedeployProject(ctx context.Context, id string, projectID string) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/projects/" + url.PathEscape(projectID) + "/redeploy"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetProjectRegistries calls GET /api/environments/{id}/projects/{projectId}/registries: get project registries.
+func (c *Client) GetProjectRegistries(ctx context.Context, id string, projectID string) (*BaseApiResponseRegistries, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/projects/" + url.PathEscape(projectID) + "/registries"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseRegistries
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateProjectRegistries calls PUT /api/environments/{id}/projects/{projectId}/registries: update project registries.
+func (c *Client) UpdateProjectRegistries(ctx context.Context, id string, projectID string, body ProjectRegistries) (*BaseApiResponseRegistries, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/projects/" + url.PathEscape(projectID) + "/registries"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseRegistries
+	if err := c.doJSON(ctx, http.MethodPut, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RestartProject calls POST /api/environments/{id}/projects/{projectId}/restart: restart a project.
+func (c *Client) RestartProject(ctx context.Context, id string, projectID string) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/projects/" + url.PathEscape(projectID) + "/restart"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetProjectSecurityAudit calls GET /api/environments/{id}/projects/{projectId}/security/audit: audit project security.
+func (c *Client) GetProjectSecurityAudit(ctx context.Context, id string, projectID string) (*BaseApiResponseReport, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/projects/" + url.PathEscape(projectID) + "/security/audit"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseReport
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PatchProjectService calls PATCH /api/environments/{id}/projects/{projectId}/services/{serviceName}: patch a project service.
+func (c *Client) PatchProjectService(ctx context.Context, id string, projectID string, serviceName string, params *PatchProjectServiceParams, body ProjectServicePatch) (*BaseApiResponseServicePatchResult, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/projects/" + url.PathEscape(projectID) + "/services/" + url.PathEscape(serviceName)
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out BaseApiResponseServicePatchResult
+	if err := c.doJSON(ctx, http.MethodPatch, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetProjectTags calls GET /api/environments/{id}/projects/{projectId}/tags: get project tags.
+func (c *Client) GetProjectTags(ctx context.Context, id string, projectID string) (*BaseApiResponseMapStringString, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/projects/" + url.PathEscape(projectID) + "/tags"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMapStringString
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateProjectTags calls PUT /api/environments/{id}/projects/{projectId}/tags: set project tags.
+func (c *Client) UpdateProjectTags(ctx context.Context, id string, projectID string, body TagUpdateTags) (*BaseApiResponseMapStringString, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/projects/" + url.PathEscape(projectID) + "/tags"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMapStringString
+	if err := c.doJSON(ctx, http.MethodPut, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetProjectTopology calls GET /api/environments/{id}/projects/{projectId}/topology: get project topology.
+func (c *Client) GetProjectTopology(ctx context.Context, id string, projectID string) (*BaseApiResponseGraph, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/projects/" + url.PathEscape(projectID) + "/topology"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseGraph
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UnarchiveProject calls POST /api/environments/{id}/projects/{projectId}/unarchive: unarchive a project.
+func (c *Client) UnarchiveProject(ctx context.Context, id string, projectID string) (*BaseApiResponseDetails, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/projects/" + url.PathEscape(projectID) + "/unarchive"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseDetails
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UnlockProject calls POST /api/environments/{id}/projects/{projectId}/unlock: unlock a project.
+func (c *Client) UnlockProject(ctx context.Context, id string, projectID string, body ProjectUnlock) (*BaseApiResponseDetails, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/projects/" + url.PathEscape(projectID) + "/unlock"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseDetails
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeployProject calls POST /api/environments/{id}/projects/{projectId}/up: deploy a project.
+// Progress messages are passed to onMessage as they arrive.
+func (c *Client) DeployProject(ctx context.Context, id string, projectID string, body *ProjectDeployOptions, onMessage func(json.RawMessage) error) error {
+	path := "/api/environments/" + url.PathEscape(id) + "/projects/" + url.PathEscape(projectID) + "/up"
+	query := url.Values{}
+	header := http.Header{}
+	var reqBody any
+	if body != nil {
+		reqBody = body
+	}
+	return c.doStream(ctx, http.MethodPost, path, query, header, reqBody, onMessage)
+}
+
+// GetHostSecurityAudit calls GET /api/environments/{id}/security/audit: audit container security.
+func (c *Client) GetHostSecurityAudit(ctx context.Context, id string) (*BaseApiResponseReport, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/security/audit"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseReport
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ExportSecurityAudit calls GET /api/environments/{id}/security/audit/export: export container security audit.
+func (c *Client) ExportSecurityAudit(ctx context.Context, id string, params *ExportSecurityAuditParams) (*string, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/security/audit/export"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out string
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetSettings calls GET /api/environments/{id}/settings: get settings.
+func (c *Client) GetSettings(ctx context.Context, id string) (*[]SettingsPublicSetting, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/settings"
+	query := url.Values{}
+	header := http.Header{}
+	var out []SettingsPublicSetting
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateSettings calls PUT /api/environments/{id}/settings: update settings.
+func (c *Client) UpdateSettings(ctx context.Context, id string, body SettingsUpdate) (*BaseApiResponseListSettingDto, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/settings"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseListSettingDto
+	if err := c.doJSON(ctx, http.MethodPut, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DryApplySettings calls POST /api/environments/{id}/settings/dry-apply: dry-apply settings.
+func (c *Client) DryApplySettings(ctx context.Context, id string, body SettingsUpdate) (*BaseApiResponseDryApplyResult, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/settings/dry-apply"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseDryApplyResult
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetPublicSettings calls GET /api/environments/{id}/settings/public: get public settings.
+func (c *Client) GetPublicSettings(ctx context.Context, id string) (*[]SettingsPublicSetting, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/settings/public"
+	query := url.Values{}
+	header := http.Header{}
+	var out []SettingsPublicSetting
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListSnapshots calls GET /api/environments/{id}/snapshots: list container snapshots.
+func (c *Client) ListSnapshots(ctx context.Context, id string) (*BaseApiResponseListSnapshot, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/snapshots"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseListSnapshot
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteSnapshot calls DELETE /api/environments/{id}/snapshots/{snapshotId}: delete a container snapshot.
+func (c *Client) DeleteSnapshot(ctx context.Context, id string, snapshotID string) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/snapshots/" + url.PathEscape(snapshotID)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodDelete, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RestoreSnapshot calls POST /api/environments/{id}/snapshots/{snapshotId}/restore: restore a container snapshot.
+func (c *Client) RestoreSnapshot(ctx context.Context, id string, snapshotID string) (*BaseApiResponseRestoreSnapshotResult, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/snapshots/" + url.PathEscape(snapshotID) + "/restore"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseRestoreSnapshotResult
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetStaleResourceReport calls GET /api/environments/{id}/stale-resources: get stale resource report.
+func (c *Client) GetStaleResourceReport(ctx context.Context, id string) (*BaseApiResponseInventory, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/stale-resources"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseInventory
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AnalyzeStaleResources calls POST /api/environments/{id}/stale-resources/analyze: refresh stale resource report.
+func (c *Client) AnalyzeStaleResources(ctx context.Context, id string) (*BaseApiResponseInventory, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/stale-resources/analyze"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseInventory
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CleanupStaleResources calls POST /api/environments/{id}/stale-resources/cleanup: remove stale resources.
+func (c *Client) CleanupStaleResources(ctx context.Context, id string, body StaleresourceCleanupRequest) (*BaseApiResponseCleanupResult, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/stale-resources/cleanup"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseCleanupResult
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetEnvironmentSyncStatus calls GET /api/environments/{id}/sync: get environment sync status.
+func (c *Client) GetEnvironmentSyncStatus(ctx context.Context, id string) (*BaseApiResponseConfigSyncStatus, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/sync"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseConfigSyncStatus
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SyncEnvironment calls POST /api/environments/{id}/sync: sync environment.
+func (c *Client) SyncEnvironment(ctx context.Context, id string) (*BaseApiResponseConfigSyncStatus, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/sync"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseConfigSyncStatus
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// StartAllContainers calls POST /api/environments/{id}/system/containers/start-all: start all containers.
+func (c *Client) StartAllContainers(ctx context.Context, id string) (*BaseApiResponseActionResult, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/system/containers/start-all"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseActionResult
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// StartAllStoppedContainers calls POST /api/environments/{id}/system/containers/start-stopped: start all stopped containers.
+func (c *Client) StartAllStoppedContainers(ctx context.Context, id string) (*BaseApiResponseActionResult, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/system/containers/start-stopped"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseActionResult
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// StopAllContainers calls POST /api/environments/{id}/system/containers/stop-all: stop all containers.
+func (c *Client) StopAllContainers(ctx context.Context, id string) (*BaseApiResponseActionResult, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/system/containers/stop-all"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseActionResult
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ConvertDockerRun calls POST /api/environments/{id}/system/convert: convert docker run command.
+func (c *Client) ConvertDockerRun(ctx context.Context, id string, body ConvertDockerRunRequest) (*ConvertDockerRunResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/system/convert"
+	query := url.Values{}
+	header := http.Header{}
+	var out ConvertDockerRunResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ConvertContainerToDockerRun calls GET /api/environments/{id}/system/convert/containers/{containerId}: convert container to docker run command.
+func (c *Client) ConvertContainerToDockerRun(ctx context.Context, id string, containerID string) (*ContainerToDockerRunResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/system/convert/containers/" + url.PathEscape(containerID)
+	query := url.Values{}
+	header := http.Header{}
+	var out ContainerToDockerRunResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetDaemonConfig calls GET /api/environments/{id}/system/daemon-config: get Docker daemon configuration.
+func (c *Client) GetDaemonConfig(ctx context.Context, id string) (*BaseApiResponseDaemonConfig, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/system/daemon-config"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseDaemonConfig
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateDaemonJson calls PUT /api/environments/{id}/system/daemon-config: update daemon.json.
+func (c *Client) UpdateDaemonJson(ctx context.Context, id string, body DockerinfoUpdateDaemonJSONRequest) (*BaseApiResponseUpdateDaemonJSONResult, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/system/daemon-config"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseUpdateDaemonJSONResult
+	if err := c.doJSON(ctx, http.MethodPut, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetDockerInfo calls GET /api/environments/{id}/system/docker/info: get Docker info.
+func (c *Client) GetDockerInfo(ctx context.Context, id string) (*DockerinfoInfo, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/system/docker/info"
+	query := url.Values{}
+	header := http.Header{}
+	var out DockerinfoInfo
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SystemHealth calls HEAD /api/environments/{id}/system/health: check system health.
+func (c *Client) SystemHealth(ctx context.Context, id string) error {
+	path := "/api/environments/" + url.PathEscape(id) + "/system/health"
+	query := url.Values{}
+	header := http.Header{}
+	return c.doJSON(ctx, http.MethodHead, path, query, header, nil, nil)
+}
+
+// GetHostMetrics calls GET /api/environments/{id}/system/host-metrics: get live host metrics.
+func (c *Client) GetHostMetrics(ctx context.Context, id string) (*BaseApiResponseHostMetrics, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/system/host-metrics"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseHostMetrics
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PruneAll calls POST /api/environments/{id}/system/prune: prune Docker resources.
+func (c *Client) PruneAll(ctx context.Context, id string, body SystemPruneAllRequest) (*BaseApiResponsePruneAllResult, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/system/prune"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponsePruneAllResult
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PreviewPruneAll calls POST /api/environments/{id}/system/prune/preview: preview a Docker prune.
+func (c *Client) PreviewPruneAll(ctx context.Context, id string, body SystemPruneAllRequest) (*BaseApiResponsePruneAllResult, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/system/prune/preview"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponsePruneAllResult
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// TriggerUpgrade calls POST /api/environments/{id}/system/upgrade: trigger system upgrade.
+func (c *Client) TriggerUpgrade(ctx context.Context, id string) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/system/upgrade"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CheckUpgrade calls GET /api/environments/{id}/system/upgrade/check: check for system upgrade.
+func (c *Client) CheckUpgrade(ctx context.Context, id string) (*UpgradeCheckResultData, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/system/upgrade/check"
+	query := url.Values{}
+	header := http.Header{}
+	var out UpgradeCheckResultData
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListTagKeys calls GET /api/environments/{id}/tag-keys: list tag keys.
+func (c *Client) ListTagKeys(ctx context.Context, id string, params *ListTagKeysParams) (*BaseApiResponseListKeySummary, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/tag-keys"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out BaseApiResponseListKeySummary
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetEnvironmentTags calls GET /api/environments/{id}/tags: get environment tags.
+func (c *Client) GetEnvironmentTags(ctx context.Context, id string) (*BaseApiResponseMapStringString, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/tags"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMapStringString
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateEnvironmentTags calls PUT /api/environments/{id}/tags: set environment tags.
+func (c *Client) UpdateEnvironmentTags(ctx context.Context, id string, body TagUpdateTags) (*BaseApiResponseMapStringString, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/tags"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMapStringString
+	if err := c.doJSON(ctx, http.MethodPut, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// TestConnection calls POST /api/environments/{id}/test: test environment connection.
+func (c *Client) TestConnection(ctx context.Context, id string, body *EnvironmentTestConnectionRequest) (*BaseApiResponseTest, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/test"
+	query := url.Values{}
+	header := http.Header{}
+	var reqBody any
+	if body != nil {
+		reqBody = body
+	}
+	var out BaseApiResponseTest
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, reqBody, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetHostTopology calls GET /api/environments/{id}/topology: get host topology.
+func (c *Client) GetHostTopology(ctx context.Context, id string) (*BaseApiResponseGraph, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/topology"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseGraph
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetUpdaterHistory calls GET /api/environments/{id}/updater/history: get updater history.
+func (c *Client) GetUpdaterHistory(ctx context.Context, id string, params *GetUpdaterHistoryParams) (*BaseApiResponseListAutoUpdateRecord, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/updater/history"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out BaseApiResponseListAutoUpdateRecord
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RunUpdater calls POST /api/environments/{id}/updater/run: run updater.
+func (c *Client) RunUpdater(ctx context.Context, id string, body *UpdaterOptions) (*BaseApiResponseResult, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/updater/run"
+	query := url.Values{}
+	header := http.Header{}
+	var reqBody any
+	if body != nil {
+		reqBody = body
+	}
+	var out BaseApiResponseResult
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, reqBody, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListUpdaterRuns calls GET /api/environments/{id}/updater/runs: list updater runs.
+func (c *Client) ListUpdaterRuns(ctx context.Context, id string, params *ListUpdaterRunsParams) (*UpdaterRunPaginatedResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/updater/runs"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out UpdaterRunPaginatedResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetUpdaterRun calls GET /api/environments/{id}/updater/runs/{runId}: get updater run.
+func (c *Client) GetUpdaterRun(ctx context.Context, id string, runID string) (*BaseApiResponseRunDetail, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/updater/runs/" + url.PathEscape(runID)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseRunDetail
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RetryUpdaterRun calls POST /api/environments/{id}/updater/runs/{runId}/retry: retry failed items of an updater run.
+func (c *Client) RetryUpdaterRun(ctx context.Context, id string, runID string) (*BaseApiResponseRunDetail, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/updater/runs/" + url.PathEscape(runID) + "/retry"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseRunDetail
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetUpdaterStatus calls GET /api/environments/{id}/updater/status: get updater status.
+func (c *Client) GetUpdaterStatus(ctx context.Context, id string) (*BaseApiResponseStatus, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/updater/status"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseStatus
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetEnvironmentVersion calls GET /api/environments/{id}/version: get environment version.
+func (c *Client) GetEnvironmentVersion(ctx context.Context, id string) (*BaseApiResponseInfo, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/version"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseInfo
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListVolumes calls GET /api/environments/{id}/volumes: list volumes.
+func (c *Client) ListVolumes(ctx context.Context, id string, params *ListVolumesParams) (*VolumePaginatedResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/volumes"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out VolumePaginatedResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateVolume calls POST /api/environments/{id}/volumes: create a volume.
+func (c *Client) CreateVolume(ctx context.Context, id string, body VolumeCreate) (*BaseApiResponseVolume, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/volumes"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseVolume
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteVolumeBackup calls DELETE /api/environments/{id}/volumes/backups/{backupId}: delete volume backup.
+func (c *Client) DeleteVolumeBackup(ctx context.Context, id string, backupID string) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/volumes/backups/" + url.PathEscape(backupID)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodDelete, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DownloadVolumeBackup calls GET /api/environments/{id}/volumes/backups/{backupId}/download: download volume backup.
+// The caller must close the returned body.
+func (c *Client) DownloadVolumeBackup(ctx context.Context, id string, backupID string) (io.ReadCloser, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/volumes/backups/" + url.PathEscape(backupID) + "/download"
+	query := url.Values{}
+	header := http.Header{}
+	return c.doRaw(ctx, http.MethodGet, path, query, header, nil)
+}
+
+// ListBackupFiles calls GET /api/environments/{id}/volumes/backups/{backupId}/files: list files in a volume backup.
+func (c *Client) ListBackupFiles(ctx context.Context, id string, backupID string) (*BaseApiResponseListString, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/volumes/backups/" + url.PathEscape(backupID) + "/files"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseListString
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// BackupHasPath calls GET /api/environments/{id}/volumes/backups/{backupId}/has-path: check if backup contains path.
+func (c *Client) BackupHasPath(ctx context.Context, id string, backupID string, params *BackupHasPathParams) (*BaseApiResponseBackupHasPathResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/volumes/backups/" + url.PathEscape(backupID) + "/has-path"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out BaseApiResponseBackupHasPathResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetVolumeUsageCounts calls GET /api/environments/{id}/volumes/counts: get volume usage counts.
+func (c *Client) GetVolumeUsageCounts(ctx context.Context, id string, params *GetVolumeUsageCountsParams) (*BaseApiResponseVolumeUsageCountsData, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/volumes/counts"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out BaseApiResponseVolumeUsageCountsData
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PruneVolumes calls POST /api/environments/{id}/volumes/prune: prune unused volumes.
+func (c *Client) PruneVolumes(ctx context.Context, id string) (*BaseApiResponseVolumePruneReportData, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/volumes/prune"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseVolumePruneReportData
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetVolumeSizes calls GET /api/environments/{id}/volumes/sizes: get volume sizes.
+func (c *Client) GetVolumeSizes(ctx context.Context, id string) (*BaseApiResponseListVolumeSizeInfo, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/volumes/sizes"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseListVolumeSizeInfo
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetVolume calls GET /api/environments/{id}/volumes/{volumeName}: get volume by name.
+func (c *Client) GetVolume(ctx context.Context, id string, volumeName string) (*BaseApiResponseVolume, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/volumes/" + url.PathEscape(volumeName)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseVolume
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RemoveVolume calls DELETE /api/environments/{id}/volumes/{volumeName}: remove a volume.
+func (c *Client) RemoveVolume(ctx context.Context, id string, volumeName string, params *RemoveVolumeParams) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/volumes/" + url.PathEscape(volumeName)
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodDelete, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListVolumeBackups calls GET /api/environments/{id}/volumes/{volumeName}/backups: list volume backups.
+func (c *Client) ListVolumeBackups(ctx context.Context, id string, volumeName string, params *ListVolumeBackupsParams) (*VolumeBackupPaginatedResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/volumes/" + url.PathEscape(volumeName) + "/backups"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out VolumeBackupPaginatedResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateVolumeBackup calls POST /api/environments/{id}/volumes/{volumeName}/backups: create volume backup.
+func (c *Client) CreateVolumeBackup(ctx context.Context, id string, volumeName string) (*BaseApiResponseVolumeBackup, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/volumes/" + url.PathEscape(volumeName) + "/backups"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseVolumeBackup
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UploadVolumeBackup calls POST /api/environments/{id}/volumes/{volumeName}/backups/upload: upload and restore volume backup.
+func (c *Client) UploadVolumeBackup(ctx context.Context, id string, volumeName string, body io.Reader, contentType string) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/volumes/" + url.PathEscape(volumeName) + "/backups/upload"
+	query := url.Values{}
+	header := http.Header{}
+	header.Set("Content-Type", contentType)
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RestoreVolumeBackup calls POST /api/environments/{id}/volumes/{volumeName}/backups/{backupId}/restore: restore volume backup.
+func (c *Client) RestoreVolumeBackup(ctx context.Context, id string, volumeName string, backupID string) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/volumes/" + url.PathEscape(volumeName) + "/backups/" + url.PathEscape(backupID) + "/restore"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RestoreVolumeBackupFiles calls POST /api/environments/{id}/volumes/{volumeName}/backups/{backupId}/restore-files: restore specific files from a volume backup.
+func (c *Client) RestoreVolumeBackupFiles(ctx context.Context, id string, volumeName string, backupID string, body RestoreBackupFilesInputBody) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/volumes/" + url.PathEscape(volumeName) + "/backups/" + url.PathEscape(backupID) + "/restore-files"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// BrowseVolumeDirectory calls GET /api/environments/{id}/volumes/{volumeName}/browse: list volume directory.
+func (c *Client) BrowseVolumeDirectory(ctx context.Context, id string, volumeName string, params *BrowseVolumeDirectoryParams) (*BaseApiResponseListFileEntry, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/volumes/" + url.PathEscape(volumeName) + "/browse"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out BaseApiResponseListFileEntry
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteVolumeFile calls DELETE /api/environments/{id}/volumes/{volumeName}/browse: delete file or directory in volume.
+func (c *Client) DeleteVolumeFile(ctx context.Context, id string, volumeName string, params *DeleteVolumeFileParams) error {
+	path := "/api/environments/" + url.PathEscape(id) + "/volumes/" + url.PathEscape(volumeName) + "/browse"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	return c.doJSON(ctx, http.MethodDelete, path, query, header, nil, nil)
+}
+
+// GetVolumeFileContent calls GET /api/environments/{id}/volumes/{volumeName}/browse/content: get file content preview.
+func (c *Client) GetVolumeFileContent(ctx context.Context, id string, volumeName string, params *GetVolumeFileContentParams) (*BaseApiResponseFileContentResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/volumes/" + url.PathEscape(volumeName) + "/browse/content"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out BaseApiResponseFileContentResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DownloadVolumeFile calls GET /api/environments/{id}/volumes/{volumeName}/browse/download: download file from volume.
+// The caller must close the returned body.
+func (c *Client) DownloadVolumeFile(ctx context.Context, id string, volumeName string, params *DownloadVolumeFileParams) (io.ReadCloser, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/volumes/" + url.PathEscape(volumeName) + "/browse/download"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	return c.doRaw(ctx, http.MethodGet, path, query, header, nil)
+}
+
+// CreateVolumeDirectory calls POST /api/environments/{id}/volumes/{volumeName}/browse/mkdir: create directory in volume.
+func (c *Client) CreateVolumeDirectory(ctx context.Context, id string, volumeName string, params *CreateVolumeDirectoryParams) error {
+	path := "/api/environments/" + url.PathEscape(id) + "/volumes/" + url.PathEscape(volumeName) + "/browse/mkdir"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	return c.doJSON(ctx, http.MethodPost, path, query, header, nil, nil)
+}
+
+// UploadVolumeFile calls POST /api/environments/{id}/volumes/{volumeName}/browse/upload: upload file to volume.
+func (c *Client) UploadVolumeFile(ctx context.Context, id string, volumeName string, params *UploadVolumeFileParams, body io.Reader, contentType string) error {
+	path := "/api/environments/" + url.PathEscape(id) + "/volumes/" + url.PathEscape(volumeName) + "/browse/upload"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	header.Set("Content-Type", contentType)
+	return c.doJSON(ctx, http.MethodPost, path, query, header, body, nil)
+}
+
+// GetVolumeUsage calls GET /api/environments/{id}/volumes/{volumeName}/usage: get volume usage.
+func (c *Client) GetVolumeUsage(ctx context.Context, id string, volumeName string) (*BaseApiResponseVolumeUsageResponse, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/volumes/" + url.PathEscape(volumeName) + "/usage"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseVolumeUsageResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListEnvironmentVulnerabilities calls GET /api/environments/{id}/vulnerabilities/all: list environment vulnerabilities.
+func (c *Client) ListEnvironmentVulnerabilities(ctx context.Context, id string, params *ListEnvironmentVulnerabilitiesParams) (*BasePaginatedVulnerabilityWithImage, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/vulnerabilities/all"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out BasePaginatedVulnerabilityWithImage
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// IgnoreVulnerability calls POST /api/environments/{id}/vulnerabilities/ignore: ignore a vulnerability.
+func (c *Client) IgnoreVulnerability(ctx context.Context, id string, body VulnerabilityIgnorePayload) (*BaseApiResponseIgnoredVulnerability, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/vulnerabilities/ignore"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseIgnoredVulnerability
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UnignoreVulnerability calls DELETE /api/environments/{id}/vulnerabilities/ignore/{ignoreId}: unignore a vulnerability.
+func (c *Client) UnignoreVulnerability(ctx context.Context, id string, ignoreID string) (*BaseApiResponseStruct, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/vulnerabilities/ignore/" + url.PathEscape(ignoreID)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseStruct
+	if err := c.doJSON(ctx, http.MethodDelete, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListIgnoredVulnerabilities calls GET /api/environments/{id}/vulnerabilities/ignored: list ignored vulnerabilities.
+func (c *Client) ListIgnoredVulnerabilities(ctx context.Context, id string, params *ListIgnoredVulnerabilitiesParams) (*BasePaginatedIgnoredVulnerability, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/vulnerabilities/ignored"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out BasePaginatedIgnoredVulnerability
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListEnvironmentVulnerabilityImageOptions calls GET /api/environments/{id}/vulnerabilities/image-options: list vulnerability image options.
+func (c *Client) ListEnvironmentVulnerabilityImageOptions(ctx context.Context, id string, params *ListEnvironmentVulnerabilityImageOptionsParams) (*BaseApiResponseListString, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/vulnerabilities/image-options"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out BaseApiResponseListString
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetVulnerabilityScannerStatus calls GET /api/environments/{id}/vulnerabilities/scanner-status: get vulnerability scanner status.
+func (c *Client) GetVulnerabilityScannerStatus(ctx context.Context, id string) (*BaseApiResponseScannerStatus, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/vulnerabilities/scanner-status"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseScannerStatus
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetEnvironmentVulnerabilitySummary calls GET /api/environments/{id}/vulnerabilities/summary: get environment vulnerability summary.
+func (c *Client) GetEnvironmentVulnerabilitySummary(ctx context.Context, id string) (*BaseApiResponseEnvironmentVulnerabilitySummary, error) {
+	path := "/api/environments/" + url.PathEscape(id) + "/vulnerabilities/summary"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseEnvironmentVulnerabilitySummary
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListEvents calls GET /api/events: list events.
+func (c *Client) ListEvents(ctx context.Context, params *ListEventsParams) (*EventPaginatedResponse, error) {
+	path := "/api/events"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out EventPaginatedResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateEvent calls POST /api/events: create an event.
+func (c *Client) CreateEvent(ctx context.Context, params *CreateEventParams, body EventCreateEvent) (*BaseApiResponseEvent, error) {
+	path := "/api/events"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out BaseApiResponseEvent
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetEventsByEnvironment calls GET /api/events/environment/{environmentId}: get events by environment.
+func (c *Client) GetEventsByEnvironment(ctx context.Context, environmentID string, params *GetEventsByEnvironmentParams) (*EventPaginatedResponse, error) {
+	path := "/api/events/environment/" + url.PathEscape(environmentID)
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out EventPaginatedResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ExportEvents calls GET /api/events/export: export events.
+func (c *Client) ExportEvents(ctx context.Context, params *ExportEventsParams) (*string, error) {
+	path := "/api/events/export"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out string
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// TestEventForwarding calls POST /api/events/forwarding/test: test audit forwarding.
+func (c *Client) TestEventForwarding(ctx context.Context) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/events/forwarding/test"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteEvent calls DELETE /api/events/{eventId}: delete an event.
+func (c *Client) DeleteEvent(ctx context.Context, eventID string) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/events/" + url.PathEscape(eventID)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodDelete, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetMonoFont calls GET /api/fonts/mono: get monospace font.
+func (c *Client) GetMonoFont(ctx context.Context) (*string, error) {
+	path := "/api/fonts/mono"
+	query := url.Values{}
+	header := http.Header{}
+	var out string
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetSansFont calls GET /api/fonts/sans: get sans-serif font.
+func (c *Client) GetSansFont(ctx context.Context) (*string, error) {
+	path := "/api/fonts/sans"
+	query := url.Values{}
+	header := http.Header{}
+	var out string
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetSerifFont calls GET /api/fonts/serif: get serif font.
+func (c *Client) GetSerifFont(ctx context.Context) (*string, error) {
+	path := "/api/fonts/serif"
+	query := url.Values{}
+	header := http.Header{}
+	var out string
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SyncGitRepositories calls POST /api/git-repositories/sync: sync git repositories.
+func (c *Client) SyncGitRepositories(ctx context.Context, body GitopsRepositorySyncRequest) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/git-repositories/sync"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// HealthCheck calls GET /api/health: health check.
+func (c *Client) HealthCheck(ctx context.Context) (*SystemHealthResponse, error) {
+	path := "/api/health"
+	query := url.Values{}
+	header := http.Header{}
+	var out SystemHealthResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// HealthCheckHead calls HEAD /api/health: health check (HEAD).
+func (c *Client) HealthCheckHead(ctx context.Context) error {
+	path := "/api/health"
+	query := url.Values{}
+	header := http.Header{}
+	return c.doJSON(ctx, http.MethodHead, path, query, header, nil, nil)
+}
+
+// EnvironmentsHealthCheck calls GET /api/health/environments: remote environment probe.
+func (c *Client) EnvironmentsHealthCheck(ctx context.Context) (*SystemEnvironmentsHealthResponse, error) {
+	path := "/api/health/environments"
+	query := url.Values{}
+	header := http.Header{}
+	var out SystemEnvironmentsHealthResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// LivenessCheck calls GET /api/healthz: liveness probe.
+func (c *Client) LivenessCheck(ctx context.Context) (*SystemHealthResponse, error) {
+	path := "/api/healthz"
+	query := url.Values{}
+	header := http.Header{}
+	var out SystemHealthResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// TriggerCommandWebhook calls POST /api/hooks/commands/{token}: run a webhook command.
+func (c *Client) TriggerCommandWebhook(ctx context.Context, token string, params *TriggerCommandWebhookParams, body io.Reader, contentType string) (*CommandwebhookTriggerResult, error) {
+	path := "/api/hooks/commands/" + url.PathEscape(token)
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	header.Set("Content-Type", contentType)
+	var out CommandwebhookTriggerResult
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListHostMetrics calls GET /api/host-metrics: list latest host metrics.
+func (c *Client) ListHostMetrics(ctx context.Context) (*BaseApiResponseListEnvironmentHostMetrics, error) {
+	path := "/api/host-metrics"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseListEnvironmentHostMetrics
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DistributeImage calls POST /api/images/distribute: distribute an image.
+func (c *Client) DistributeImage(ctx context.Context, body ImageDistributeRequest) (*BaseApiResponseDistributeResult, error) {
+	path := "/api/images/distribute"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseDistributeResult
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PromoteImage calls POST /api/images/promote: promote an image.
+func (c *Client) PromoteImage(ctx context.Context, body ImagePromoteRequest) (*BaseApiResponsePromoteResult, error) {
+	path := "/api/images/promote"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponsePromoteResult
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListMonitors calls GET /api/monitors: list uptime monitors.
+func (c *Client) ListMonitors(ctx context.Context, params *ListMonitorsParams) (*BaseApiResponseListMonitor, error) {
+	path := "/api/monitors"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out BaseApiResponseListMonitor
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateMonitor calls POST /api/monitors: create an uptime monitor.
+func (c *Client) CreateMonitor(ctx context.Context, body MonitorCreateMonitor) (*BaseApiResponseMonitor, error) {
+	path := "/api/monitors"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMonitor
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetMonitor calls GET /api/monitors/{monitorId}: get an uptime monitor.
+func (c *Client) GetMonitor(ctx context.Context, monitorID string) (*BaseApiResponseMonitor, error) {
+	path := "/api/monitors/" + url.PathEscape(monitorID)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMonitor
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateMonitor calls PUT /api/monitors/{monitorId}: update an uptime monitor.
+func (c *Client) UpdateMonitor(ctx context.Context, monitorID string, body MonitorUpdateMonitor) (*BaseApiResponseMonitor, error) {
+	path := "/api/monitors/" + url.PathEscape(monitorID)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMonitor
+	if err := c.doJSON(ctx, http.MethodPut, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteMonitor calls DELETE /api/monitors/{monitorId}: delete an uptime monitor.
+func (c *Client) DeleteMonitor(ctx context.Context, monitorID string) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/monitors/" + url.PathEscape(monitorID)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodDelete, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CheckMonitor calls POST /api/monitors/{monitorId}/check: run an uptime check now.
+func (c *Client) CheckMonitor(ctx context.Context, monitorID string) (*BaseApiResponseCheckResult, error) {
+	path := "/api/monitors/" + url.PathEscape(monitorID) + "/check"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseCheckResult
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DescribeNotificationAction calls GET /api/notification-actions/{token}: describe a notification action.
+func (c *Client) DescribeNotificationAction(ctx context.Context, token string) (*BaseApiResponseAction, error) {
+	path := "/api/notification-actions/" + url.PathEscape(token)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseAction
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PerformNotificationAction calls POST /api/notification-actions/{token}: perform a notification action.
+func (c *Client) PerformNotificationAction(ctx context.Context, token string) (*BaseApiResponseOutcome, error) {
+	path := "/api/notification-actions/" + url.PathEscape(token)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseOutcome
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListNotificationSubscriptions calls GET /api/notifications/subscriptions: list my notification subscriptions.
+func (c *Client) ListNotificationSubscriptions(ctx context.Context) (*BaseApiResponseListSubscription, error) {
+	path := "/api/notifications/subscriptions"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseListSubscription
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetNotificationSubscription calls PUT /api/notifications/subscriptions/{provider}: set my notification subscription.
+func (c *Client) SetNotificationSubscription(ctx context.Context, provider string, body NotificationSubscriptionUpdate) (*BaseApiResponseSubscription, error) {
+	path := "/api/notifications/subscriptions/" + url.PathEscape(provider)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseSubscription
+	if err := c.doJSON(ctx, http.MethodPut, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteNotificationSubscription calls DELETE /api/notifications/subscriptions/{provider}: delete my notification subscription.
+func (c *Client) DeleteNotificationSubscription(ctx context.Context, provider string) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/notifications/subscriptions/" + url.PathEscape(provider)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodDelete, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// HandleOidcCallback calls POST /api/oidc/callback: handle OIDC callback.
+func (c *Client) HandleOidcCallback(ctx context.Context, params *HandleOidcCallbackParams, body AuthOidcCallbackRequest) (*AuthOidcCallbackResponse, error) {
+	path := "/api/oidc/callback"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out AuthOidcCallbackResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetOidcConfig calls GET /api/oidc/config: get OIDC config.
+func (c *Client) GetOidcConfig(ctx context.Context, params *GetOidcConfigParams) (*AuthOidcConfigResponse, error) {
+	path := "/api/oidc/config"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out AuthOidcConfigResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// InitiateOidcDeviceAuth calls POST /api/oidc/device/code: initiate OIDC device authorization.
+func (c *Client) InitiateOidcDeviceAuth(ctx context.Context) (*AuthOidcDeviceAuthResponse, error) {
+	path := "/api/oidc/device/code"
+	query := url.Values{}
+	header := http.Header{}
+	var out AuthOidcDeviceAuthResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ExchangeOidcDeviceToken calls POST /api/oidc/device/token: exchange device code for tokens.
+func (c *Client) ExchangeOidcDeviceToken(ctx context.Context, body AuthOidcDeviceTokenRequest) (*AuthOidcDeviceTokenResponse, error) {
+	path := "/api/oidc/device/token"
+	query := url.Values{}
+	header := http.Header{}
+	var out AuthOidcDeviceTokenResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetOidcStatus calls GET /api/oidc/status: get OIDC status.
+func (c *Client) GetOidcStatus(ctx context.Context) (*AuthOidcStatusInfo, error) {
+	path := "/api/oidc/status"
+	query := url.Values{}
+	header := http.Header{}
+	var out AuthOidcStatusInfo
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetOidcAuthUrl calls POST /api/oidc/url: get OIDC auth URL.
+func (c *Client) GetOidcAuthUrl(ctx context.Context, params *GetOidcAuthUrlParams, body AuthOidcAuthUrlRequest) (*AuthOidcAuthUrlResponse, error) {
+	path := "/api/oidc/url"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out AuthOidcAuthUrlResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ReadinessCheck calls GET /api/readyz: readiness probe.
+func (c *Client) ReadinessCheck(ctx context.Context) (*SystemReadinessResponse, error) {
+	path := "/api/readyz"
+	query := url.Values{}
+	header := http.Header{}
+	var out SystemReadinessResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListRoleBindings calls GET /api/role-bindings: list role bindings.
+func (c *Client) ListRoleBindings(ctx context.Context, params *ListRoleBindingsParams) (*BaseApiResponseListRoleBinding, error) {
+	path := "/api/role-bindings"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out BaseApiResponseListRoleBinding
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateRoleBinding calls POST /api/role-bindings: assign a role.
+func (c *Client) CreateRoleBinding(ctx context.Context, body RbacCreateRoleBinding) (*BaseApiResponseRoleBinding, error) {
+	path := "/api/role-bindings"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseRoleBinding
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetEffectiveRole calls GET /api/role-bindings/me: get my effective role.
+func (c *Client) GetEffectiveRole(ctx context.Context, params *GetEffectiveRoleParams) (*BaseApiResponseEffectiveRole, error) {
+	path := "/api/role-bindings/me"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out BaseApiResponseEffectiveRole
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteRoleBinding calls DELETE /api/role-bindings/{bindingId}: remove a role assignment.
+func (c *Client) DeleteRoleBinding(ctx context.Context, bindingID string) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/role-bindings/" + url.PathEscape(bindingID)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodDelete, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListSavedFilters calls GET /api/saved-filters: list saved filters.
+func (c *Client) ListSavedFilters(ctx context.Context, params *ListSavedFiltersParams) (*BaseApiResponseListSavedFilter, error) {
+	path := "/api/saved-filters"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out BaseApiResponseListSavedFilter
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateSavedFilter calls POST /api/saved-filters: create a saved filter.
+func (c *Client) CreateSavedFilter(ctx context.Context, body TagCreateSavedFilter) (*BaseApiResponseSavedFilter, error) {
+	path := "/api/saved-filters"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseSavedFilter
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateSavedFilter calls PUT /api/saved-filters/{filterId}: update a saved filter.
+func (c *Client) UpdateSavedFilter(ctx context.Context, filterID string, body TagUpdateSavedFilter) (*BaseApiResponseSavedFilter, error) {
+	path := "/api/saved-filters/" + url.PathEscape(filterID)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseSavedFilter
+	if err := c.doJSON(ctx, http.MethodPut, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteSavedFilter calls DELETE /api/saved-filters/{filterId}: delete a saved filter.
+func (c *Client) DeleteSavedFilter(ctx context.Context, filterID string) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/saved-filters/" + url.PathEscape(filterID)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodDelete, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListSecrets calls GET /api/secrets: list secrets.
+func (c *Client) ListSecrets(ctx context.Context) (*BaseApiResponseListSecret, error) {
+	path := "/api/secrets"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseListSecret
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateSecret calls POST /api/secrets: create a secret.
+func (c *Client) CreateSecret(ctx context.Context, body SecretCreateRequest) (*BaseApiResponseSecret, error) {
+	path := "/api/secrets"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseSecret
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateSecret calls PUT /api/secrets/{id}: update a secret.
+func (c *Client) UpdateSecret(ctx context.Context, id string, body SecretUpdateRequest) (*BaseApiResponseSecret, error) {
+	path := "/api/secrets/" + url.PathEscape(id)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseSecret
+	if err := c.doJSON(ctx, http.MethodPut, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteSecret calls DELETE /api/secrets/{id}: delete a secret.
+func (c *Client) DeleteSecret(ctx context.Context, id string) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/secrets/" + url.PathEscape(id)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodDelete, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetSettingsCategories calls GET /api/settings/categories: get settings categories.
+func (c *Client) GetSettingsCategories(ctx context.Context) (*[]CategoryCategory, error) {
+	path := "/api/settings/categories"
+	query := url.Values{}
+	header := http.Header{}
+	var out []CategoryCategory
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SearchSettings calls POST /api/settings/search: search settings.
+func (c *Client) SearchSettings(ctx context.Context, body SearchRequest) (*SearchResponse, error) {
+	path := "/api/settings/search"
+	query := url.Values{}
+	header := http.Header{}
+	var out SearchResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetupAdmin calls POST /api/setup/admin: create administrator.
+func (c *Client) SetupAdmin(ctx context.Context, body SetupAdmin) (*BaseApiResponseLoginResponse, error) {
+	path := "/api/setup/admin"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseLoginResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetupComplete calls POST /api/setup/complete: complete setup.
+func (c *Client) SetupComplete(ctx context.Context) (*BaseApiResponseProgress, error) {
+	path := "/api/setup/complete"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseProgress
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetupDockerCheck calls POST /api/setup/docker-check: check Docker connection.
+func (c *Client) SetupDockerCheck(ctx context.Context) (*BaseApiResponseDockerCheck, error) {
+	path := "/api/setup/docker-check"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseDockerCheck
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetupEnvironment calls POST /api/setup/environment: add environment.
+func (c *Client) SetupEnvironment(ctx context.Context, body EnvironmentCreate) (*BaseApiResponseEnvironmentWithApiKey, error) {
+	path := "/api/setup/environment"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseEnvironmentWithApiKey
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetupNotification calls POST /api/setup/notification: add notification provider.
+func (c *Client) SetupNotification(ctx context.Context, body NotificationUpdate) (*NotificationResponse, error) {
+	path := "/api/setup/notification"
+	query := url.Values{}
+	header := http.Header{}
+	var out NotificationResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetupProjectsDirectory calls POST /api/setup/projects-directory: set projects directory.
+func (c *Client) SetupProjectsDirectory(ctx context.Context, body SetupProjectsDirectory) (*BaseApiResponseProjectsDirectoryResult, error) {
+	path := "/api/setup/projects-directory"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseProjectsDirectoryResult
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetSetupStatus calls GET /api/setup/status: get setup status.
+func (c *Client) GetSetupStatus(ctx context.Context) (*BaseApiResponseProgress, error) {
+	path := "/api/setup/status"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseProgress
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetupSkipStep calls POST /api/setup/steps/{step}/skip: skip setup step.
+func (c *Client) SetupSkipStep(ctx context.Context, step string) (*BaseApiResponseProgress, error) {
+	path := "/api/setup/steps/" + url.PathEscape(step) + "/skip"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseProgress
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ExportConfigBackup calls POST /api/system/backup/export: export configuration backup.
+func (c *Client) ExportConfigBackup(ctx context.Context, body BackupExportRequest) (*string, error) {
+	path := "/api/system/backup/export"
+	query := url.Values{}
+	header := http.Header{}
+	var out string
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ImportConfigBackup calls POST /api/system/backup/import: import configuration backup.
+func (c *Client) ImportConfigBackup(ctx context.Context, body BackupImportRequest) (*BaseApiResponseImportResult, error) {
+	path := "/api/system/backup/import"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseImportResult
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListTemplatesPaginated calls GET /api/templates: list templates (paginated).
+func (c *Client) ListTemplatesPaginated(ctx context.Context, params *ListTemplatesPaginatedParams) (*TemplatePaginatedResponse, error) {
+	path := "/api/templates"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out TemplatePaginatedResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateTemplate calls POST /api/templates: create a template.
+func (c *Client) CreateTemplate(ctx context.Context, body TemplateCreateRequest) (*BaseApiResponseTemplate, error) {
+	path := "/api/templates"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseTemplate
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAllTemplates calls GET /api/templates/all: list all templates.
+func (c *Client) GetAllTemplates(ctx context.Context) (*BaseApiResponseListTemplate, error) {
+	path := "/api/templates/all"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseListTemplate
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetDefaultTemplates calls GET /api/templates/default: get default templates.
+func (c *Client) GetDefaultTemplates(ctx context.Context) (*BaseApiResponseDefaultTemplatesResponse, error) {
+	path := "/api/templates/default"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseDefaultTemplatesResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SaveDefaultTemplates calls POST /api/templates/default: save default templates.
+func (c *Client) SaveDefaultTemplates(ctx context.Context, body TemplateSaveDefaultTemplatesRequest) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/templates/default"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// FetchTemplateRegistry calls GET /api/templates/fetch: fetch remote registry.
+func (c *Client) FetchTemplateRegistry(ctx context.Context, params *FetchTemplateRegistryParams) (*BaseApiResponseRemoteRegistry, error) {
+	path := "/api/templates/fetch"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out BaseApiResponseRemoteRegistry
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetTemplateRegistries calls GET /api/templates/registries: list template registries.
+func (c *Client) GetTemplateRegistries(ctx context.Context) (*BaseApiResponseListTemplateRegistry, error) {
+	path := "/api/templates/registries"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseListTemplateRegistry
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateTemplateRegistry calls POST /api/templates/registries: create a template registry.
+func (c *Client) CreateTemplateRegistry(ctx context.Context, body TemplateCreateRegistryRequest) (*BaseApiResponseTemplateRegistry, error) {
+	path := "/api/templates/registries"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseTemplateRegistry
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateTemplateRegistry calls PUT /api/templates/registries/{id}: update a template registry.
+func (c *Client) UpdateTemplateRegistry(ctx context.Context, id string, body TemplateUpdateRegistryRequest) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/templates/registries/" + url.PathEscape(id)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodPut, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteTemplateRegistry calls DELETE /api/templates/registries/{id}: delete a template registry.
+func (c *Client) DeleteTemplateRegistry(ctx context.Context, id string) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/templates/registries/" + url.PathEscape(id)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodDelete, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetGlobalVariables calls GET /api/templates/variables: get global variables.
+func (c *Client) GetGlobalVariables(ctx context.Context) (*BaseApiResponseListVariable, error) {
+	path := "/api/templates/variables"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseListVariable
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateGlobalVariables calls PUT /api/templates/variables: update global variables.
+func (c *Client) UpdateGlobalVariables(ctx context.Context, body EnvSummary) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/templates/variables"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodPut, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetTemplate calls GET /api/templates/{id}: get a template.
+func (c *Client) GetTemplate(ctx context.Context, id string) (*BaseApiResponseTemplate, error) {
+	path := "/api/templates/" + url.PathEscape(id)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseTemplate
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateTemplate calls PUT /api/templates/{id}: update a template.
+func (c *Client) UpdateTemplate(ctx context.Context, id string, body TemplateUpdateRequest) (*BaseApiResponseTemplate, error) {
+	path := "/api/templates/" + url.PathEscape(id)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseTemplate
+	if err := c.doJSON(ctx, http.MethodPut, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteTemplate calls DELETE /api/templates/{id}: delete a template.
+func (c *Client) DeleteTemplate(ctx context.Context, id string) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/templates/" + url.PathEscape(id)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodDelete, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetTemplateContent calls GET /api/templates/{id}/content: get template content.
+func (c *Client) GetTemplateContent(ctx context.Context, id string) (*BaseApiResponseTemplateContent, error) {
+	path := "/api/templates/" + url.PathEscape(id) + "/content"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseTemplateContent
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DownloadTemplate calls POST /api/templates/{id}/download: download a template.
+func (c *Client) DownloadTemplate(ctx context.Context, id string) (*BaseApiResponseTemplate, error) {
+	path := "/api/templates/" + url.PathEscape(id) + "/download"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseTemplate
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetUpdateRollout calls GET /api/updater/rollout: get update rollout.
+func (c *Client) GetUpdateRollout(ctx context.Context) (*BaseApiResponseRollout, error) {
+	path := "/api/updater/rollout"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseRollout
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// StartUpdateRollout calls POST /api/updater/rollout: start update rollout.
+func (c *Client) StartUpdateRollout(ctx context.Context) (*BaseApiResponseRollout, error) {
+	path := "/api/updater/rollout"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseRollout
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListUsers calls GET /api/users: list users.
+func (c *Client) ListUsers(ctx context.Context, params *ListUsersParams) (*UserPaginatedResponse, error) {
+	path := "/api/users"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out UserPaginatedResponse
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateUser calls POST /api/users: create a user.
+func (c *Client) CreateUser(ctx context.Context, body UserCreateUser) (*BaseApiResponseUser, error) {
+	path := "/api/users"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseUser
+	if err := c.doJSON(ctx, http.MethodPost, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetUser calls GET /api/users/{userId}: get a user.
+func (c *Client) GetUser(ctx context.Context, userID string) (*BaseApiResponseUser, error) {
+	path := "/api/users/" + url.PathEscape(userID)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseUser
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateUser calls PUT /api/users/{userId}: update a user.
+func (c *Client) UpdateUser(ctx context.Context, userID string, body UserUpdateUser) (*BaseApiResponseUser, error) {
+	path := "/api/users/" + url.PathEscape(userID)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseUser
+	if err := c.doJSON(ctx, http.MethodPut, path, query, header, body, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteUser calls DELETE /api/users/{userId}: delete a user.
+func (c *Client) DeleteUser(ctx context.Context, userID string) (*BaseApiResponseMessageResponse, error) {
+	path := "/api/users/" + url.PathEscape(userID)
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseMessageResponse
+	if err := c.doJSON(ctx, http.MethodDelete, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetVersion calls GET /api/version: get version information.
+func (c *Client) GetVersion(ctx context.Context, params *GetVersionParams) (*VersionCheck, error) {
+	path := "/api/version"
+	query := url.Values{}
+	header := http.Header{}
+	if params != nil {
+		params.apply(query, header)
+	}
+	var out VersionCheck
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetChangelog calls GET /api/version/changelog: get changelog.
+func (c *Client) GetChangelog(ctx context.Context) (*BaseApiResponseChangelog, error) {
+	path := "/api/version/changelog"
+	query := url.Values{}
+	header := http.Header{}
+	var out BaseApiResponseChangelog
+	if err := c.doJSON(ctx, http.MethodGet, path, query, header, nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -62,12 +62,20 @@ async function buildTemplateFile(Component: any, templateName: string, isPlainTe
       /IMAGELIST_PLACEHOLDER/g,
       '{{range .ImageList}}• {{.}}\n{{end}}'
     );
+    normalized = normalized.replace(
+      /ACTIONS_PLACEHOLDER/g,
+      '{{range .Actions}}{{.Label}}: {{.URL}}\n{{end}}'
+    );
   } else {
     // For HTML, wrap each item in a paragraph tag with proper styling
     normalized = normalized.replace(
       /<p[^>]*>IMAGELIST_PLACEHOLDER<\/p>/g,
       '{{range .ImageList}}<p style="font-size:13px;line-height:20px;color:#cbd5e1;margin:4px 0;font-family:monospace">• {{.}}</p>{{end}}'
     );
+    normalized = normalized.replace(
+      /<p[^>]*>ACTIONS_PLACEHOLDER<\/p>/g,
+      '{{range .Actions}}<p style="font-size:14px;line-height:20px;margin:8px 0"><a href="{{.URL}}" style="color:#a78bfa;font-weight:600;text-decoration:none" target="_blank">{{.Label}} →</a></p>{{end}}'
+    );
   }
 
   // Enforce line length: prefer tag boundaries, never spaces
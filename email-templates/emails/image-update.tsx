@@ -1,4 +1,4 @@
-import { Column, Hr, Row, Section, Text } from '@react-email/components';
+import { Column, Hr, Link, Row, Section, Text } from '@react-email/components';
 import { BaseTemplate } from '../components/base-template';
 import CardHeader from '../components/card-header';
 import { sharedPreviewProps, sharedTemplateProps } from '../props';
@@ -12,6 +12,7 @@ interface ImageUpdateEmailProps {
   currentDigest: string;
   latestDigest: string;
   checkTime: string;
+  actions?: { label: string; url: string }[];
 }
 
 export const ImageUpdateEmail = ({
@@ -23,6 +24,7 @@ export const ImageUpdateEmail = ({
   currentDigest,
   latestDigest,
   checkTime,
+  actions = [],
 }: ImageUpdateEmailProps) => {
   const truncateDigest = (digest: string) => {
     if (digest.length > 19) {
@@ -123,6 +125,20 @@ export const ImageUpdateEmail = ({
         )}
       </Section>
 
+      {actions.length > 0 && (
+        <Section style={{ marginTop: '24px' }}>
+          {Array.isArray(actions) && actions.length > 0 ?
+            actions.map((action, index) => (
+              <Text key={index} style={actionStyle}>
+                <Link href={action.url} style={actionLinkStyle}>
+                  {action.label} →
+                </Link>
+              </Text>
+            ))
+          : <Text style={actionStyle}>ACTIONS_PLACEHOLDER</Text>}
+        </Section>
+      )}
+
       <Section style={{ marginTop: '24px' }}>
         <Text style={footerStyle}>
           This is an automated notification from Arcane.
@@ -200,6 +216,18 @@ const dividerStyle = {
   margin: '4px 0',
 };
 
+const actionStyle = {
+  fontSize: '14px',
+  lineHeight: '20px',
+  margin: '8px 0',
+};
+
+const actionLinkStyle = {
+  color: '#a78bfa',
+  fontWeight: '600' as const,
+  textDecoration: 'none',
+};
+
 const footerStyle = {
   fontSize: '13px',
   lineHeight: '20px',
@@ -215,6 +243,7 @@ ImageUpdateEmail.TemplateProps = {
   currentDigest: '{{.CurrentDigest}}',
   latestDigest: '{{.LatestDigest}}',
   checkTime: '{{.CheckTime}}',
+  actions: 'ACTIONS_PLACEHOLDER',
 };
 
 ImageUpdateEmail.PreviewProps = {
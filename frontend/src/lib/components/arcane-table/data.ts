@@ -9,7 +9,8 @@ import {
 	CheckIcon,
 	UpdateIcon,
 	StartIcon,
-	StopIcon
+	StopIcon,
+	ArchiveIcon
 } from '$lib/icons';
 
 export interface FilterOption {
@@ -135,6 +136,11 @@ export const projectStatusFilters = [
 		label: m.projects_status_partial(),
 		icon: AlertIcon
 	},
+	{
+		value: 'archived',
+		label: m.projects_status_archived(),
+		icon: ArchiveIcon
+	},
 	{
 		value: 'unknown',
 		label: m.common_unknown(),
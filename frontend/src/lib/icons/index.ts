@@ -72,6 +72,7 @@ export { default as GpuIcon } from 'virtual:icons/gravity-ui/gpu';
 export { default as VerifiedCheckIcon } from 'virtual:icons/solar/verified-check-bold';
 export { default as CircleArrowUpIcon } from 'virtual:icons/solar/round-alt-arrow-up-linear';
 export { default as BoxIcon } from 'virtual:icons/solar/box-minimalistic-linear';
+export { default as ArchiveIcon } from 'virtual:icons/solar/archive-linear';
 export { default as StartIcon } from 'virtual:icons/solar/play-linear';
 export { default as StopIcon } from 'virtual:icons/solar/stop-bold';
 export { default as SmartphoneIcon } from 'virtual:icons/solar/smartphone-linear';
@@ -72,7 +72,8 @@ export const queryKeys = {
 		upgradeAvailable: (scope: 'mobile-nav' | 'sidebar') => ['system', 'upgrade-available', scope] as const,
 		upgradeHealth: (environmentId: string) => ['system', 'upgrade-health', environmentId] as const,
 		versionInfo: (environmentId: string) => ['system', 'version-info', environmentId] as const,
-		dockerInfo: (environmentId: string) => ['system', 'docker-info', environmentId] as const
+		dockerInfo: (environmentId: string) => ['system', 'docker-info', environmentId] as const,
+		hostMetricsHistory: (environmentId: string, hours: number) => ['system', 'host-metrics', environmentId, hours] as const
 	},
 	dashboard: {
 		actionItems: (environmentId: string, debugAllGood = false) =>
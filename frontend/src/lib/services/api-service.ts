@@ -1,5 +1,6 @@
 import axios, { type AxiosResponse } from 'axios';
 import { toast } from 'svelte-sonner';
+import { CSRF_COOKIE_NAME, CSRF_ERROR_CODE, CSRF_HEADER_NAME } from '$lib/utils/csrf.util';
 
 function extractServerMessage(data: any, includeErrors = false): string | undefined {
 	const inner = (data && typeof data === 'object' ? ((data as any).data ?? data) : data) as any;
@@ -20,7 +21,9 @@ function extractServerMessage(data: any, includeErrors = false): string | undefi
 abstract class BaseAPIService {
 	api = axios.create({
 		baseURL: '/api',
-		withCredentials: true
+		withCredentials: true,
+		xsrfCookieName: CSRF_COOKIE_NAME,
+		xsrfHeaderName: CSRF_HEADER_NAME
 	});
 
 	private static tokenRefreshHandler: (() => Promise<string | null>) | null = null;
@@ -40,6 +43,18 @@ abstract class BaseAPIService {
 				const status = error?.response?.status;
 				const originalRequest = error.config;
 
+				// The CSRF cookie is issued with the rejection when it was missing, so one
+				// retry picks it up.
+				if (
+					status === 403 &&
+					error?.response?.data?.code === CSRF_ERROR_CODE &&
+					originalRequest &&
+					!originalRequest._csrfRetry
+				) {
+					originalRequest._csrfRetry = true;
+					return this.api(originalRequest);
+				}
+
 				if (status === 401 && typeof window !== 'undefined' && !originalRequest._retry) {
 					originalRequest._retry = true;
 
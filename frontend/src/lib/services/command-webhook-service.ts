@@ -0,0 +1,27 @@
+import BaseAPIService from './api-service';
+import type {
+	CommandWebhook,
+	CommandWebhookCreated,
+	CreateCommandWebhook,
+	UpdateCommandWebhook
+} from '$lib/types/command-webhook.type';
+
+export default class CommandWebhookAPIService extends BaseAPIService {
+	async list(): Promise<CommandWebhook[]> {
+		return this.handleResponse(this.api.get('/command-webhooks')) as Promise<CommandWebhook[]>;
+	}
+
+	async create(webhook: CreateCommandWebhook): Promise<CommandWebhookCreated> {
+		return this.handleResponse(this.api.post('/command-webhooks', webhook)) as Promise<CommandWebhookCreated>;
+	}
+
+	async update(id: string, webhook: UpdateCommandWebhook): Promise<CommandWebhook> {
+		return this.handleResponse(this.api.put(`/command-webhooks/${id}`, webhook)) as Promise<CommandWebhook>;
+	}
+
+	async delete(id: string): Promise<void> {
+		return this.handleResponse(this.api.delete(`/command-webhooks/${id}`)) as Promise<void>;
+	}
+}
+
+export const commandWebhookService = new CommandWebhookAPIService();
@@ -4,9 +4,23 @@ import type {
 	ContainerStatusCounts,
 	ContainerSummaryDto,
 	ContainerStats,
-	ContainerCreateRequest
+	ContainerCreateRequest,
+	ContainerCreatePreview,
+	ContainerEditRequest,
+	ContainerEditResult,
+	ContainerLogSearchOptions,
+	ContainerLogSearchResult,
+	ContainerSnapshot,
+	ContainerSnapshotCreateRequest,
+	ContainerSnapshotRestoreResult,
+	ContainerCloneRequest,
+	ContainerCloneResult
 } from '$lib/types/container.type';
 import type { SearchPaginationSortRequest, Paginated } from '$lib/types/pagination.type';
+import type { TopologyGraph } from '$lib/types/topology.type';
+import type { SecurityAuditReport } from '$lib/types/security-audit.type';
+import type { ContainerRollback, ContainerUpdatePreview } from '$lib/types/auto-update.type';
+import type { IngressAnalysis, GenerateIngressLabelsRequest, GeneratedIngressLabels } from '$lib/types/ingress.type';
 import { transformPaginationParams } from '$lib/utils/params.util';
 
 export type ContainersPaginatedResponse = Paginated<ContainerSummaryDto, ContainerStatusCounts>;
@@ -21,6 +35,44 @@ export class ContainerService extends BaseAPIService {
 		return this.getContainersForEnvironment(envId, options);
 	}
 
+	async getHostTopology(environmentId?: string): Promise<TopologyGraph> {
+		const envId = await this.resolveEnvironmentId(environmentId);
+		return this.handleResponse<TopologyGraph>(this.api.get(`/environments/${envId}/topology`));
+	}
+
+	async getSecurityAudit(environmentId?: string): Promise<SecurityAuditReport> {
+		const envId = await this.resolveEnvironmentId(environmentId);
+		return this.handleResponse<SecurityAuditReport>(this.api.get(`/environments/${envId}/security/audit`));
+	}
+
+	async downloadSecurityAudit(format: 'json' | 'csv' = 'json', environmentId?: string): Promise<void> {
+		const envId = await this.resolveEnvironmentId(environmentId);
+		const res = await this.api.get(`/environments/${envId}/security/audit/export`, {
+			params: { format },
+			responseType: 'blob'
+		});
+
+		const disposition: string = res.headers['content-disposition'] ?? '';
+		const fileName = /filename=([^;]+)/.exec(disposition)?.[1] ?? `arcane-security-audit.${format}`;
+		const url = window.URL.createObjectURL(new Blob([res.data]));
+		const link = document.createElement('a');
+		link.href = url;
+		link.setAttribute('download', fileName);
+		document.body.appendChild(link);
+		link.click();
+		link.remove();
+	}
+
+	async getIngressRoutes(environmentId?: string): Promise<IngressAnalysis> {
+		const envId = await this.resolveEnvironmentId(environmentId);
+		return this.handleResponse<IngressAnalysis>(this.api.get(`/environments/${envId}/ingress/routes`));
+	}
+
+	async generateIngressLabels(request: GenerateIngressLabelsRequest, environmentId?: string): Promise<GeneratedIngressLabels> {
+		const envId = await this.resolveEnvironmentId(environmentId);
+		return this.handleResponse<GeneratedIngressLabels>(this.api.post(`/environments/${envId}/ingress/labels`, request));
+	}
+
 	async getContainersForEnvironment(
 		environmentId: string,
 		options?: SearchPaginationSortRequest
@@ -59,6 +111,20 @@ export class ContainerService extends BaseAPIService {
 		return this.handleResponse(this.api.post(`/environments/${envId}/containers`, options));
 	}
 
+	async previewContainer(options: ContainerCreateRequest): Promise<ContainerCreatePreview> {
+		const envId = await environmentStore.getCurrentEnvironmentId();
+		const res = await this.api.post(`/environments/${envId}/containers/preview`, options);
+		return res.data.data;
+	}
+
+	async editContainer(containerId: string, changes: ContainerEditRequest, dryRun = false): Promise<ContainerEditResult> {
+		const envId = await environmentStore.getCurrentEnvironmentId();
+		const res = await this.api.post(`/environments/${envId}/containers/${containerId}/edit`, changes, {
+			params: { dryRun: String(dryRun) }
+		});
+		return res.data.data;
+	}
+
 	async stopContainer(containerId: string): Promise<any> {
 		const envId = await environmentStore.getCurrentEnvironmentId();
 		return this.handleResponse(this.api.post(`/environments/${envId}/containers/${containerId}/stop`));
@@ -78,10 +144,70 @@ export class ContainerService extends BaseAPIService {
 		return this.handleResponse(this.api.delete(`/environments/${envId}/containers/${containerId}`, { params }));
 	}
 
+	async searchContainerLogs(containerId: string, options?: ContainerLogSearchOptions): Promise<ContainerLogSearchResult> {
+		const envId = await environmentStore.getCurrentEnvironmentId();
+		return this.handleResponse<ContainerLogSearchResult>(
+			this.api.get(`/environments/${envId}/containers/${containerId}/logs`, { params: options })
+		);
+	}
+
+	async downloadContainerLogs(containerId: string, options?: Omit<ContainerLogSearchOptions, 'limit'>): Promise<void> {
+		const envId = await environmentStore.getCurrentEnvironmentId();
+		const res = await this.api.get(`/environments/${envId}/containers/${containerId}/logs/download`, {
+			params: options,
+			responseType: 'blob'
+		});
+
+		const disposition: string = res.headers['content-disposition'] ?? '';
+		const fileName = /filename=([^;]+)/.exec(disposition)?.[1] ?? `${containerId}-logs.log.gz`;
+		const url = window.URL.createObjectURL(new Blob([res.data]));
+		const link = document.createElement('a');
+		link.href = url;
+		link.setAttribute('download', fileName);
+		document.body.appendChild(link);
+		link.click();
+		link.remove();
+	}
+
 	async updateContainer(containerId: string): Promise<any> {
 		const envId = await environmentStore.getCurrentEnvironmentId();
 		return this.handleResponse(this.api.post(`/environments/${envId}/containers/${containerId}/update`));
 	}
+
+	async previewContainerUpdate(containerId: string): Promise<ContainerUpdatePreview> {
+		const envId = await environmentStore.getCurrentEnvironmentId();
+		return this.handleResponse(this.api.get(`/environments/${envId}/containers/${containerId}/update/preview`));
+	}
+
+	async rollbackContainer(containerId: string): Promise<ContainerRollback> {
+		const envId = await environmentStore.getCurrentEnvironmentId();
+		return this.handleResponse(this.api.post(`/environments/${envId}/containers/${containerId}/rollback`));
+	}
+
+	async getSnapshots(containerId?: string): Promise<ContainerSnapshot[]> {
+		const envId = await environmentStore.getCurrentEnvironmentId();
+		const path = containerId ? `/environments/${envId}/containers/${containerId}/snapshots` : `/environments/${envId}/snapshots`;
+		return this.handleResponse(this.api.get(path));
+	}
+
+	async createSnapshot(containerId: string, options: ContainerSnapshotCreateRequest = {}): Promise<ContainerSnapshot> {
+		const envId = await environmentStore.getCurrentEnvironmentId();
+		return this.handleResponse(this.api.post(`/environments/${envId}/containers/${containerId}/snapshots`, options));
+	}
+
+	async deleteSnapshot(snapshotId: string): Promise<void> {
+		const envId = await environmentStore.getCurrentEnvironmentId();
+		await this.handleResponse(this.api.delete(`/environments/${envId}/snapshots/${snapshotId}`));
+	}
+
+	async restoreSnapshot(snapshotId: string): Promise<ContainerSnapshotRestoreResult> {
+		const envId = await environmentStore.getCurrentEnvironmentId();
+		return this.handleResponse(this.api.post(`/environments/${envId}/snapshots/${snapshotId}/restore`));
+	}
+
+	async cloneContainer(request: ContainerCloneRequest): Promise<ContainerCloneResult> {
+		return this.handleResponse(this.api.post('/containers/clone', request));
+	}
 }
 
 export const containerService = new ContainerService();
@@ -0,0 +1,46 @@
+import BaseAPIService from './api-service';
+import { environmentStore } from '$lib/stores/environment.store.svelte';
+import type {
+	ContainerTask,
+	ContainerTaskCreate,
+	ContainerTaskRun,
+	ContainerTaskUpdate
+} from '$lib/types/container-task.type';
+
+class ContainerTaskService extends BaseAPIService {
+	private async basePath(environmentId?: string): Promise<string> {
+		const envId = environmentId ?? (await environmentStore.getCurrentEnvironmentId());
+		return `/environments/${envId}/container-tasks`;
+	}
+
+	async listTasks(environmentId?: string): Promise<ContainerTask[]> {
+		return this.handleResponse(this.api.get(await this.basePath(environmentId)));
+	}
+
+	async getTask(taskId: string, environmentId?: string): Promise<ContainerTask> {
+		return this.handleResponse(this.api.get(`${await this.basePath(environmentId)}/${taskId}`));
+	}
+
+	async createTask(task: ContainerTaskCreate, environmentId?: string): Promise<ContainerTask> {
+		return this.handleResponse(this.api.post(await this.basePath(environmentId), task));
+	}
+
+	async updateTask(taskId: string, update: ContainerTaskUpdate, environmentId?: string): Promise<ContainerTask> {
+		return this.handleResponse(this.api.put(`${await this.basePath(environmentId)}/${taskId}`, update));
+	}
+
+	async deleteTask(taskId: string, environmentId?: string): Promise<void> {
+		await this.handleResponse(this.api.delete(`${await this.basePath(environmentId)}/${taskId}`));
+	}
+
+	async runTask(taskId: string, environmentId?: string): Promise<ContainerTaskRun> {
+		return this.handleResponse(this.api.post(`${await this.basePath(environmentId)}/${taskId}/run`));
+	}
+
+	async listRuns(taskId: string, limit = 20, environmentId?: string): Promise<ContainerTaskRun[]> {
+		return this.handleResponse(this.api.get(`${await this.basePath(environmentId)}/${taskId}/runs`, { params: { limit } }));
+	}
+}
+
+export const containerTaskService = new ContainerTaskService();
+export default ContainerTaskService;
@@ -1,11 +1,22 @@
 import BaseAPIService from './api-service';
 import { environmentStore } from '$lib/stores/environment.store.svelte';
-import type { DashboardActionItems } from '$lib/types/dashboard.type';
+import type {
+	DashboardActionItems,
+	DashboardLayout,
+	DashboardWidget,
+	DashboardWidgetData,
+	DashboardWidgetKind
+} from '$lib/types/dashboard.type';
 
 interface GetDashboardActionItemsOptions {
 	debugAllGood?: boolean;
 }
 
+interface GetDashboardWidgetsOptions {
+	widgets?: DashboardWidgetKind[];
+	limit?: number;
+}
+
 export class DashboardService extends BaseAPIService {
 	async getActionItems(): Promise<DashboardActionItems> {
 		const envId = await environmentStore.getCurrentEnvironmentId();
@@ -19,6 +30,25 @@ export class DashboardService extends BaseAPIService {
 		const params = options?.debugAllGood ? { debugAllGood: 'true' } : undefined;
 		return this.handleResponse(this.api.get(`/environments/${environmentId}/dashboard/action-items`, { params }));
 	}
+
+	async getWidgets(environmentId: string, options?: GetDashboardWidgetsOptions): Promise<DashboardWidgetData> {
+		const params: Record<string, string | number> = {};
+		if (options?.widgets?.length) params.widgets = options.widgets.join(',');
+		if (options?.limit) params.limit = options.limit;
+		return this.handleResponse(this.api.get(`/environments/${environmentId}/dashboard/widgets`, { params }));
+	}
+
+	async getLayout(): Promise<DashboardLayout> {
+		return this.handleResponse(this.api.get('/dashboard/layout'));
+	}
+
+	async updateLayout(widgets: DashboardWidget[]): Promise<DashboardLayout> {
+		return this.handleResponse(this.api.put('/dashboard/layout', { widgets }));
+	}
+
+	async resetLayout(): Promise<DashboardLayout> {
+		return this.handleResponse(this.api.delete('/dashboard/layout'));
+	}
 }
 
 export const dashboardService = new DashboardService();
@@ -1,6 +1,12 @@
 import BaseAPIService from './api-service';
 import type { Environment } from '$lib/types/environment.type';
-import type { CreateEnvironmentDTO, UpdateEnvironmentDTO } from '$lib/types/environment.type';
+import type {
+	CreateEnvironmentDTO,
+	DeploymentOptions,
+	DeploymentSnippets,
+	EnvironmentSyncStatus,
+	UpdateEnvironmentDTO
+} from '$lib/types/environment.type';
 import type { Paginated, SearchPaginationSortRequest } from '$lib/types/pagination.type';
 import type { AppVersionInformation } from '$lib/types/application-configuration';
 import { transformPaginationParams } from '$lib/utils/params.util';
@@ -36,13 +42,31 @@ export default class EnvironmentManagementService extends BaseAPIService {
 		return res.data.data as { status: 'online' | 'offline'; message?: string };
 	}
 
-	async sync(environmentId: string): Promise<void> {
-		await this.api.post(`/environments/${environmentId}/sync`);
+	async approvePairing(environmentId: string): Promise<Environment> {
+		const res = await this.api.post(`/environments/${environmentId}/pairing/approve`);
+		return res.data.data as Environment;
+	}
+
+	async rejectPairing(environmentId: string): Promise<void> {
+		await this.api.post(`/environments/${environmentId}/pairing/reject`);
+	}
+
+	async sync(environmentId: string): Promise<EnvironmentSyncStatus> {
+		const res = await this.api.post(`/environments/${environmentId}/sync`);
+		return res.data.data as EnvironmentSyncStatus;
+	}
+
+	async getSyncStatus(environmentId: string): Promise<EnvironmentSyncStatus> {
+		const res = await this.api.get(`/environments/${environmentId}/sync`);
+		return res.data.data as EnvironmentSyncStatus;
 	}
 
-	async getDeploymentSnippets(environmentId: string): Promise<{ dockerRun: string; dockerCompose: string }> {
-		const res = await this.api.get(`/environments/${environmentId}/deployment`);
-		return res.data.data as { dockerRun: string; dockerCompose: string };
+	async getDeploymentSnippets(environmentId: string, options?: DeploymentOptions): Promise<DeploymentSnippets> {
+		const res = await this.api.get(`/environments/${environmentId}/deployment`, {
+			params: options,
+			paramsSerializer: { indexes: null }
+		});
+		return res.data.data as DeploymentSnippets;
 	}
 
 	async getVersion(environmentId: string): Promise<AppVersionInformation> {
@@ -6,6 +6,7 @@ import type {
 	GitOpsSyncCounts,
 	SyncResult,
 	SyncStatus,
+	TagBumpResult,
 	BrowseResponse,
 	ImportGitOpsSyncRequest,
 	ImportGitOpsSyncResponse
@@ -40,6 +41,10 @@ export default class GitOpsSyncService extends BaseAPIService {
 		return this.handleResponse(this.api.post(`/environments/${environmentId}/gitops-syncs/${syncId}/sync`));
 	}
 
+	async proposeTagBumps(environmentId: string, syncId: string): Promise<TagBumpResult> {
+		return this.handleResponse(this.api.post(`/environments/${environmentId}/gitops-syncs/${syncId}/tag-bump`));
+	}
+
 	async getSyncStatus(environmentId: string, syncId: string): Promise<SyncStatus> {
 		return this.handleResponse(this.api.get(`/environments/${environmentId}/gitops-syncs/${syncId}/status`));
 	}
@@ -1,8 +1,21 @@
 import BaseAPIService from './api-service';
 import { environmentStore } from '$lib/stores/environment.store.svelte';
-import type { ImageSummaryDto, ImageUsageCounts, ImageUpdateInfoDto, ImageBuildRecord } from '$lib/types/image.type';
+import type {
+	ImageSummaryDto,
+	ImageUsageCounts,
+	ImageUpdateInfoDto,
+	ImageBuildRecord,
+	ImagePullRecord,
+	RemoteTag,
+	ImageUpdateSnooze,
+	ImageUpdateSnoozeRequest,
+	ImageDistributeRequest,
+	ImageDistributeResult,
+	ImagePromoteRequest,
+	ImagePromoteResult
+} from '$lib/types/image.type';
 import type { SearchPaginationSortRequest, Paginated } from '$lib/types/pagination.type';
-import type { AutoUpdateCheck, AutoUpdateResult } from '$lib/types/auto-update.type';
+import type { AutoUpdateCheck, AutoUpdateResult, Rollout, UpdaterRun, UpdaterRunDetail } from '$lib/types/auto-update.type';
 import { transformPaginationParams } from '$lib/utils/params.util';
 
 export class ImageService extends BaseAPIService {
@@ -64,6 +77,28 @@ export class ImageService extends BaseAPIService {
 		return this.handleResponse(this.api.post(`/environments/${envId}/image-updates/check/${imageId}`, {}));
 	}
 
+	async getRemoteTags(imageRef: string, options?: SearchPaginationSortRequest): Promise<Paginated<RemoteTag>> {
+		const envId = await environmentStore.getCurrentEnvironmentId();
+		const params = { ...transformPaginationParams(options), imageRef };
+		const res = await this.api.get(`/environments/${envId}/image-updates/tags`, { params });
+		return res.data;
+	}
+
+	async getUpdateSnoozes(): Promise<ImageUpdateSnooze[]> {
+		const envId = await environmentStore.getCurrentEnvironmentId();
+		return this.handleResponse(this.api.get(`/environments/${envId}/image-updates/snoozes`));
+	}
+
+	async snoozeUpdates(request: ImageUpdateSnoozeRequest): Promise<ImageUpdateSnooze> {
+		const envId = await environmentStore.getCurrentEnvironmentId();
+		return this.handleResponse(this.api.put(`/environments/${envId}/image-updates/snoozes`, request));
+	}
+
+	async unsnoozeUpdates(imageRef: string): Promise<void> {
+		const envId = await environmentStore.getCurrentEnvironmentId();
+		await this.handleResponse(this.api.delete(`/environments/${envId}/image-updates/snoozes`, { params: { imageRef } }));
+	}
+
 	async checkAllImages(): Promise<Record<string, ImageUpdateInfoDto>> {
 		const envId = await environmentStore.getCurrentEnvironmentId();
 		return this.handleResponse(this.api.post(`/environments/${envId}/image-updates/check-all`, {}));
@@ -74,6 +109,31 @@ export class ImageService extends BaseAPIService {
 		return this.handleResponse(this.api.post(`/environments/${envId}/updater/run`, options));
 	}
 
+	async getUpdaterRuns(options?: SearchPaginationSortRequest): Promise<Paginated<UpdaterRun>> {
+		const envId = await environmentStore.getCurrentEnvironmentId();
+		const params = transformPaginationParams(options);
+		const res = await this.api.get(`/environments/${envId}/updater/runs`, { params });
+		return res.data;
+	}
+
+	async getUpdaterRun(runId: string): Promise<UpdaterRunDetail> {
+		const envId = await environmentStore.getCurrentEnvironmentId();
+		return this.handleResponse(this.api.get(`/environments/${envId}/updater/runs/${runId}`));
+	}
+
+	async retryUpdaterRun(runId: string): Promise<UpdaterRunDetail> {
+		const envId = await environmentStore.getCurrentEnvironmentId();
+		return this.handleResponse(this.api.post(`/environments/${envId}/updater/runs/${runId}/retry`));
+	}
+
+	async getUpdateRollout(): Promise<Rollout | null> {
+		return this.handleResponse(this.api.get('/updater/rollout'));
+	}
+
+	async startUpdateRollout(): Promise<Rollout> {
+		return this.handleResponse(this.api.post('/updater/rollout', {}));
+	}
+
 	async uploadImage(file: File): Promise<any> {
 		const envId = await environmentStore.getCurrentEnvironmentId();
 		const formData = new FormData();
@@ -87,6 +147,14 @@ export class ImageService extends BaseAPIService {
 		);
 	}
 
+	async distributeImage(request: ImageDistributeRequest): Promise<ImageDistributeResult> {
+		return this.handleResponse(this.api.post('/images/distribute', request));
+	}
+
+	async promoteImage(request: ImagePromoteRequest): Promise<ImagePromoteResult> {
+		return this.handleResponse(this.api.post('/images/promote', request));
+	}
+
 	async getImageBuilds(options?: SearchPaginationSortRequest): Promise<Paginated<ImageBuildRecord>> {
 		const envId = await environmentStore.getCurrentEnvironmentId();
 		const params = transformPaginationParams(options);
@@ -98,6 +166,13 @@ export class ImageService extends BaseAPIService {
 		const envId = await environmentStore.getCurrentEnvironmentId();
 		return this.handleResponse(this.api.get(`/environments/${envId}/images/builds/${buildId}`));
 	}
+
+	async getImagePulls(options?: SearchPaginationSortRequest): Promise<Paginated<ImagePullRecord>> {
+		const envId = await environmentStore.getCurrentEnvironmentId();
+		const params = transformPaginationParams(options);
+		const res = await this.api.get(`/environments/${envId}/images/pulls`, { params });
+		return res.data;
+	}
 }
 
 export const imageService = new ImageService();
@@ -0,0 +1,33 @@
+import BaseAPIService from './api-service';
+import { environmentStore } from '$lib/stores/environment.store.svelte';
+import type { LogAlertRule, LogAlertRuleCreate, LogAlertRuleUpdate } from '$lib/types/log-alert.type';
+
+class LogAlertService extends BaseAPIService {
+	private async basePath(environmentId?: string): Promise<string> {
+		const envId = environmentId ?? (await environmentStore.getCurrentEnvironmentId());
+		return `/environments/${envId}/log-alerts`;
+	}
+
+	async listRules(environmentId?: string): Promise<LogAlertRule[]> {
+		return this.handleResponse(this.api.get(await this.basePath(environmentId)));
+	}
+
+	async getRule(ruleId: string, environmentId?: string): Promise<LogAlertRule> {
+		return this.handleResponse(this.api.get(`${await this.basePath(environmentId)}/${ruleId}`));
+	}
+
+	async createRule(rule: LogAlertRuleCreate, environmentId?: string): Promise<LogAlertRule> {
+		return this.handleResponse(this.api.post(await this.basePath(environmentId), rule));
+	}
+
+	async updateRule(ruleId: string, update: LogAlertRuleUpdate, environmentId?: string): Promise<LogAlertRule> {
+		return this.handleResponse(this.api.put(`${await this.basePath(environmentId)}/${ruleId}`, update));
+	}
+
+	async deleteRule(ruleId: string, environmentId?: string): Promise<void> {
+		await this.handleResponse(this.api.delete(`${await this.basePath(environmentId)}/${ruleId}`));
+	}
+}
+
+export const logAlertService = new LogAlertService();
+export default LogAlertService;
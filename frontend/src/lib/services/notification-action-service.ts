@@ -0,0 +1,16 @@
+import BaseAPIService from './api-service';
+import type { NotificationAction, NotificationActionResult } from '$lib/types/notification-action.type';
+
+export default class NotificationActionAPIService extends BaseAPIService {
+	async describe(token: string): Promise<NotificationAction> {
+		return this.handleResponse(this.api.get(`/notification-actions/${encodeURIComponent(token)}`)) as Promise<NotificationAction>;
+	}
+
+	async perform(token: string): Promise<NotificationActionResult> {
+		return this.handleResponse(
+			this.api.post(`/notification-actions/${encodeURIComponent(token)}`)
+		) as Promise<NotificationActionResult>;
+	}
+}
+
+export const notificationActionService = new NotificationActionAPIService();
@@ -1,5 +1,12 @@
 import BaseAPIService from './api-service';
-import type { NotificationSettings, TestNotificationResponse, AppriseSettings } from '$lib/types/notification.type';
+import type {
+	NotificationSettings,
+	TestNotificationResponse,
+	AppriseSettings,
+	NotificationSubscription,
+	NotificationSubscriptionUpdate,
+	PersonalNotificationProvider
+} from '$lib/types/notification.type';
 import { environmentStore } from '$lib/stores/environment.store.svelte';
 
 export default class NotificationService extends BaseAPIService {
@@ -37,6 +44,21 @@ export default class NotificationService extends BaseAPIService {
 		const envId = await environmentStore.getCurrentEnvironmentId();
 		return this.handleResponse(this.api.post(`/environments/${envId}/notifications/apprise/test?type=${type}`));
 	}
+
+	async getMySubscriptions(): Promise<NotificationSubscription[]> {
+		return this.handleResponse(this.api.get('/notifications/subscriptions'));
+	}
+
+	async setMySubscription(
+		provider: PersonalNotificationProvider,
+		subscription: NotificationSubscriptionUpdate
+	): Promise<NotificationSubscription> {
+		return this.handleResponse(this.api.put(`/notifications/subscriptions/${provider}`, subscription));
+	}
+
+	async deleteMySubscription(provider: PersonalNotificationProvider): Promise<void> {
+		await this.api.delete(`/notifications/subscriptions/${provider}`);
+	}
 }
 
 export const notificationService = new NotificationService();
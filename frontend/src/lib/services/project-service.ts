@@ -1,8 +1,26 @@
 import { m } from '$lib/paraglide/messages';
 import { environmentStore } from '$lib/stores/environment.store.svelte';
 import type { Paginated, SearchPaginationSortRequest } from '$lib/types/pagination.type';
-import type { Project, ProjectStatusCounts } from '$lib/types/project.type';
+import type {
+	CreateCustomFileRequest,
+	CustomFileTemplate,
+	Project,
+	ProjectCloneRequest,
+	ProjectCloneResult,
+	ProjectDeleteFileResult,
+	ProjectEnvCheck,
+	ProjectQuotaStatus,
+	ProjectRegistries,
+	ProjectResourceQuota,
+	ProjectServicePatch,
+	ProjectServicePatchResult,
+	ProjectStatusCounts
+} from '$lib/types/project.type';
+import type { TopologyGraph } from '$lib/types/topology.type';
+import type { SecurityAuditReport } from '$lib/types/security-audit.type';
+import type { LogForwarder, UpsertLogForwarderRequest } from '$lib/types/log-forwarding.type';
 import { transformPaginationParams } from '$lib/utils/params.util';
+import { csrfHeaders } from '$lib/utils/csrf.util';
 import BaseAPIService from './api-service';
 
 export type DeployProjectOptions = {
@@ -10,6 +28,11 @@ export type DeployProjectOptions = {
 	forceRecreate?: boolean;
 };
 
+// GitOps-managed projects are read-only unless the edit explicitly overrides it.
+function gitOpsOverrideParams(overrideGitOps: boolean): Record<string, boolean> | undefined {
+	return overrideGitOps ? { overrideGitOps } : undefined;
+}
+
 export class ProjectService extends BaseAPIService {
 	private async resolveEnvironmentId(environmentId?: string): Promise<string> {
 		return environmentId ?? (await environmentStore.getCurrentEnvironmentId());
@@ -41,7 +64,8 @@ export class ProjectService extends BaseAPIService {
 		const res = await fetch(url, {
 			method: 'POST',
 			headers: {
-				'Content-Type': 'application/json'
+				'Content-Type': 'application/json',
+				...csrfHeaders()
 			},
 			body: JSON.stringify(options ?? {})
 		});
@@ -98,6 +122,13 @@ export class ProjectService extends BaseAPIService {
 		return this.handleResponse(this.api.post(`/environments/${envId}/projects`, payload));
 	}
 
+	async adoptContainers(projectName: string, containerIds: string[], recreate = false): Promise<Project> {
+		const envId = await environmentStore.getCurrentEnvironmentId();
+		return this.handleResponse(
+			this.api.post(`/environments/${envId}/projects/adopt`, { name: projectName, containerIds, recreate })
+		);
+	}
+
 	async getProject(projectId: string): Promise<Project> {
 		const envId = await this.resolveEnvironmentId();
 		return this.getProjectForEnvironment(envId, projectId);
@@ -111,6 +142,67 @@ export class ProjectService extends BaseAPIService {
 		return response.project ? response.project : (response as Project);
 	}
 
+	async checkProjectEnv(projectId: string, environmentId?: string): Promise<ProjectEnvCheck> {
+		const envId = await this.resolveEnvironmentId(environmentId);
+		return this.handleResponse<ProjectEnvCheck>(this.api.get(`/environments/${envId}/projects/${projectId}/env-check`));
+	}
+
+	async getProjectQuota(projectId: string, environmentId?: string): Promise<ProjectQuotaStatus> {
+		const envId = await this.resolveEnvironmentId(environmentId);
+		return this.handleResponse<ProjectQuotaStatus>(this.api.get(`/environments/${envId}/projects/${projectId}/quota`));
+	}
+
+	async updateProjectQuota(projectId: string, quota: ProjectResourceQuota, environmentId?: string): Promise<ProjectQuotaStatus> {
+		const envId = await this.resolveEnvironmentId(environmentId);
+		return this.handleResponse<ProjectQuotaStatus>(this.api.put(`/environments/${envId}/projects/${projectId}/quota`, quota));
+	}
+
+	async deleteProjectQuota(projectId: string, environmentId?: string): Promise<void> {
+		const envId = await this.resolveEnvironmentId(environmentId);
+		await this.handleResponse(this.api.delete(`/environments/${envId}/projects/${projectId}/quota`));
+	}
+
+	async getProjectRegistries(projectId: string, environmentId?: string): Promise<ProjectRegistries> {
+		const envId = await this.resolveEnvironmentId(environmentId);
+		return this.handleResponse<ProjectRegistries>(this.api.get(`/environments/${envId}/projects/${projectId}/registries`));
+	}
+
+	async updateProjectRegistries(projectId: string, registryIds: string[], environmentId?: string): Promise<ProjectRegistries> {
+		const envId = await this.resolveEnvironmentId(environmentId);
+		return this.handleResponse<ProjectRegistries>(
+			this.api.put(`/environments/${envId}/projects/${projectId}/registries`, { registryIds })
+		);
+	}
+
+	async getProjectTopology(projectId: string, environmentId?: string): Promise<TopologyGraph> {
+		const envId = await this.resolveEnvironmentId(environmentId);
+		return this.handleResponse<TopologyGraph>(this.api.get(`/environments/${envId}/projects/${projectId}/topology`));
+	}
+
+	async getProjectSecurityAudit(projectId: string, environmentId?: string): Promise<SecurityAuditReport> {
+		const envId = await this.resolveEnvironmentId(environmentId);
+		return this.handleResponse<SecurityAuditReport>(this.api.get(`/environments/${envId}/projects/${projectId}/security/audit`));
+	}
+
+	async getProjectLogForwarding(projectId: string, environmentId?: string): Promise<LogForwarder> {
+		const envId = await this.resolveEnvironmentId(environmentId);
+		return this.handleResponse<LogForwarder>(this.api.get(`/environments/${envId}/projects/${projectId}/log-forwarding`));
+	}
+
+	async updateProjectLogForwarding(
+		projectId: string,
+		request: UpsertLogForwarderRequest,
+		environmentId?: string
+	): Promise<LogForwarder> {
+		const envId = await this.resolveEnvironmentId(environmentId);
+		return this.handleResponse<LogForwarder>(this.api.put(`/environments/${envId}/projects/${projectId}/log-forwarding`, request));
+	}
+
+	async deleteProjectLogForwarding(projectId: string, environmentId?: string): Promise<void> {
+		const envId = await this.resolveEnvironmentId(environmentId);
+		await this.api.delete(`/environments/${envId}/projects/${projectId}/log-forwarding`);
+	}
+
 	async getProjectStatusCounts(): Promise<ProjectStatusCounts> {
 		const envId = await this.resolveEnvironmentId();
 		return this.getProjectStatusCountsForEnvironment(envId);
@@ -121,7 +213,13 @@ export class ProjectService extends BaseAPIService {
 		return res.data.data;
 	}
 
-	async updateProject(projectId: string, name?: string, composeContent?: string, envContent?: string): Promise<Project> {
+	async updateProject(
+		projectId: string,
+		name?: string,
+		composeContent?: string,
+		envContent?: string,
+		overrideGitOps = false
+	): Promise<Project> {
 		const envId = await environmentStore.getCurrentEnvironmentId();
 		const payload: Record<string, string> = {};
 		if (name !== undefined) {
@@ -133,16 +231,69 @@ export class ProjectService extends BaseAPIService {
 		if (envContent !== undefined) {
 			payload.envContent = envContent;
 		}
-		return this.handleResponse(this.api.put(`/environments/${envId}/projects/${projectId}`, payload));
+		return this.handleResponse(
+			this.api.put(`/environments/${envId}/projects/${projectId}`, payload, { params: gitOpsOverrideParams(overrideGitOps) })
+		);
+	}
+
+	async patchProjectService(
+		projectId: string,
+		serviceName: string,
+		patch: ProjectServicePatch,
+		overrideGitOps = false
+	): Promise<ProjectServicePatchResult> {
+		const envId = await environmentStore.getCurrentEnvironmentId();
+		return this.handleResponse<ProjectServicePatchResult>(
+			this.api.patch(`/environments/${envId}/projects/${projectId}/services/${encodeURIComponent(serviceName)}`, patch, {
+				params: gitOpsOverrideParams(overrideGitOps)
+			})
+		);
 	}
 
-	async updateProjectIncludeFile(projectId: string, relativePath: string, content: string): Promise<Project> {
+	async updateProjectIncludeFile(
+		projectId: string,
+		relativePath: string,
+		content: string,
+		overrideGitOps = false
+	): Promise<Project> {
 		const envId = await environmentStore.getCurrentEnvironmentId();
 		const payload = {
 			relativePath,
 			content
 		};
-		return this.handleResponse(this.api.put(`/environments/${envId}/projects/${projectId}/includes`, payload));
+		return this.handleResponse(
+			this.api.put(`/environments/${envId}/projects/${projectId}/includes`, payload, {
+				params: gitOpsOverrideParams(overrideGitOps)
+			})
+		);
+	}
+
+	async getCustomFileTemplates(): Promise<CustomFileTemplate[]> {
+		const envId = await environmentStore.getCurrentEnvironmentId();
+		return this.handleResponse<CustomFileTemplate[]>(this.api.get(`/environments/${envId}/projects/custom-file-templates`));
+	}
+
+	async createProjectCustomFile(projectId: string, request: CreateCustomFileRequest, overrideGitOps = false): Promise<Project> {
+		const envId = await environmentStore.getCurrentEnvironmentId();
+		return this.handleResponse(
+			this.api.post(`/environments/${envId}/projects/${projectId}/files`, request, {
+				params: gitOpsOverrideParams(overrideGitOps)
+			})
+		);
+	}
+
+	async deleteProjectFile(
+		projectId: string,
+		relativePath: string,
+		force = false,
+		overrideGitOps = false
+	): Promise<ProjectDeleteFileResult> {
+		const envId = await environmentStore.getCurrentEnvironmentId();
+		return this.handleResponse<ProjectDeleteFileResult>(
+			this.api.delete(`/environments/${envId}/projects/${projectId}/files`, {
+				params: { path: relativePath, force, ...gitOpsOverrideParams(overrideGitOps) }
+			})
+		);
 	}
 
 	async restartProject(projectId: string): Promise<Project> {
@@ -155,11 +306,46 @@ export class ProjectService extends BaseAPIService {
 		return this.handleResponse(this.api.post(`/environments/${envId}/projects/${projectName}/redeploy`));
 	}
 
+	async cloneProject(projectId: string, request: ProjectCloneRequest): Promise<ProjectCloneResult> {
+		const envId = await environmentStore.getCurrentEnvironmentId();
+		return this.handleResponse<ProjectCloneResult>(this.api.post(`/environments/${envId}/projects/${projectId}/clone`, request));
+	}
+
+	async lockProject(projectId: string, reason?: string): Promise<Project> {
+		const envId = await environmentStore.getCurrentEnvironmentId();
+		return this.handleResponse(this.api.post(`/environments/${envId}/projects/${projectId}/lock`, { reason }));
+	}
+
+	async unlockProject(projectId: string, reason: string): Promise<Project> {
+		const envId = await environmentStore.getCurrentEnvironmentId();
+		return this.handleResponse(this.api.post(`/environments/${envId}/projects/${projectId}/unlock`, { reason }));
+	}
+
+	async archiveProject(projectId: string): Promise<Project> {
+		const envId = await environmentStore.getCurrentEnvironmentId();
+		return this.handleResponse(this.api.post(`/environments/${envId}/projects/${projectId}/archive`));
+	}
+
+	async unarchiveProject(projectId: string): Promise<Project> {
+		const envId = await environmentStore.getCurrentEnvironmentId();
+		return this.handleResponse(this.api.post(`/environments/${envId}/projects/${projectId}/unarchive`));
+	}
+
+	async startProjectMaintenance(projectId: string, message?: string): Promise<Project> {
+		const envId = await environmentStore.getCurrentEnvironmentId();
+		return this.handleResponse(this.api.post(`/environments/${envId}/projects/${projectId}/maintenance`, { message }));
+	}
+
+	async endProjectMaintenance(projectId: string): Promise<Project> {
+		const envId = await environmentStore.getCurrentEnvironmentId();
+		return this.handleResponse(this.api.delete(`/environments/${envId}/projects/${projectId}/maintenance`));
+	}
+
 	private async streamProjectPull(projectId: string, onLine?: (data: any) => void): Promise<void> {
 		const envId = await environmentStore.getCurrentEnvironmentId();
 		const url = `/api/environments/${envId}/projects/${projectId}/pull`;
 
-		const res = await fetch(url, { method: 'POST' });
+		const res = await fetch(url, { method: 'POST', headers: csrfHeaders() });
 		if (!res.ok || !res.body) {
 			throw new Error(`Failed to start project image pull (${res.status})`);
 		}
@@ -209,7 +395,8 @@ export class ProjectService extends BaseAPIService {
 		const res = await fetch(url, {
 			method: 'POST',
 			headers: {
-				'Content-Type': 'application/json'
+				'Content-Type': 'application/json',
+				...csrfHeaders()
 			},
 			body: JSON.stringify(options || {})
 		});
@@ -1,5 +1,5 @@
 import BaseAPIService from './api-service';
-import type { Settings, OidcStatusInfo } from '$lib/types/settings.type';
+import type { Settings, OidcStatusInfo, SettingsDryApplyResult } from '$lib/types/settings.type';
 import { environmentStore } from '$lib/stores/environment.store.svelte';
 import { isLocalSetting, extractLocalSettings, extractEnvironmentSettings } from '$lib/utils/settings.util';
 
@@ -95,6 +95,15 @@ export default class SettingsService extends BaseAPIService {
 		return this.getSettings();
 	}
 
+	async dryApplySettingsForEnvironment(envId: string, settings: Partial<Settings>): Promise<SettingsDryApplyResult> {
+		const payload: Record<string, string> = {};
+		for (const key in settings) {
+			const v = settings[key as keyof Settings];
+			payload[key] = typeof v === 'object' && v !== null ? JSON.stringify(v) : String(v);
+		}
+		return this.handleResponse(this.api.post(`/environments/${envId}/settings/dry-apply`, payload));
+	}
+
 	async getOidcStatus(): Promise<OidcStatusInfo> {
 		return this.handleResponse(this.api.get('/oidc/status'));
 	}
@@ -0,0 +1,49 @@
+import BaseAPIService from './api-service';
+import type { LoginResponseData } from '$lib/types/auth.type';
+import type { CreateEnvironmentDTO, Environment } from '$lib/types/environment.type';
+import type { NotificationSettings } from '$lib/types/notification.type';
+import type {
+	SetupAdmin,
+	SetupDockerCheck,
+	SetupProjectsDirectoryResult,
+	SetupStatus,
+	SetupStepId
+} from '$lib/types/setup.type';
+
+export default class SetupAPIService extends BaseAPIService {
+	async getStatus(): Promise<SetupStatus> {
+		return this.handleResponse(this.api.get('/setup/status')) as Promise<SetupStatus>;
+	}
+
+	async createAdmin(admin: SetupAdmin): Promise<LoginResponseData> {
+		return this.handleResponse(this.api.post('/setup/admin', admin)) as Promise<LoginResponseData>;
+	}
+
+	async setProjectsDirectory(projectsDirectory: string): Promise<SetupProjectsDirectoryResult> {
+		return this.handleResponse(
+			this.api.post('/setup/projects-directory', { projectsDirectory })
+		) as Promise<SetupProjectsDirectoryResult>;
+	}
+
+	async checkDocker(): Promise<SetupDockerCheck> {
+		return this.handleResponse(this.api.post('/setup/docker-check')) as Promise<SetupDockerCheck>;
+	}
+
+	async createNotification(settings: NotificationSettings): Promise<NotificationSettings> {
+		return this.handleResponse(this.api.post('/setup/notification', settings)) as Promise<NotificationSettings>;
+	}
+
+	async createEnvironment(dto: CreateEnvironmentDTO): Promise<Environment> {
+		return this.handleResponse(this.api.post('/setup/environment', dto)) as Promise<Environment>;
+	}
+
+	async skipStep(step: SetupStepId): Promise<SetupStatus> {
+		return this.handleResponse(this.api.post(`/setup/steps/${step}/skip`)) as Promise<SetupStatus>;
+	}
+
+	async complete(): Promise<SetupStatus> {
+		return this.handleResponse(this.api.post('/setup/complete')) as Promise<SetupStatus>;
+	}
+}
+
+export const setupService = new SetupAPIService();
@@ -0,0 +1,25 @@
+import BaseAPIService from './api-service';
+import { environmentStore } from '$lib/stores/environment.store.svelte';
+import type { StaleResourceCleanupResult, StaleResourceRef, StaleResourceReport } from '$lib/types/stale-resource.type';
+
+class StaleResourceService extends BaseAPIService {
+	private async basePath(environmentId?: string): Promise<string> {
+		const envId = environmentId ?? (await environmentStore.getCurrentEnvironmentId());
+		return `/environments/${envId}/stale-resources`;
+	}
+
+	async getReport(environmentId?: string): Promise<StaleResourceReport> {
+		return this.handleResponse(this.api.get(await this.basePath(environmentId)));
+	}
+
+	async analyze(environmentId?: string): Promise<StaleResourceReport> {
+		return this.handleResponse(this.api.post(`${await this.basePath(environmentId)}/analyze`));
+	}
+
+	async cleanup(items: StaleResourceRef[], environmentId?: string): Promise<StaleResourceCleanupResult> {
+		return this.handleResponse(this.api.post(`${await this.basePath(environmentId)}/cleanup`, { items }));
+	}
+}
+
+export const staleResourceService = new StaleResourceService();
+export default StaleResourceService;
@@ -1,6 +1,15 @@
 import BaseAPIService from './api-service';
 import { environmentStore } from '$lib/stores/environment.store.svelte';
-import type { DockerInfo } from '$lib/types/docker-info.type';
+import type { DaemonConfig, DockerInfo, UpdateDaemonJsonResult } from '$lib/types/docker-info.type';
+import type { EnvironmentHostMetrics, HostMetrics } from '$lib/types/host-metrics.type';
+
+export interface ContainerDockerRunConversion {
+	success: boolean;
+	dockerRunCommand: string;
+	dockerCompose: string;
+	envVars: string;
+	serviceName: string;
+}
 
 export class SystemService extends BaseAPIService {
 	async pruneAll(options: {
@@ -35,6 +44,24 @@ export class SystemService extends BaseAPIService {
 		return this.handleResponse(this.api.get(`/environments/${environmentId}/system/docker/info`));
 	}
 
+	async getHostMetricsHistoryForEnvironment(environmentId: string, hours = 24): Promise<HostMetrics[]> {
+		return this.handleResponse(this.api.get(`/environments/${environmentId}/host-metrics`, { params: { hours } }));
+	}
+
+	async listHostMetrics(): Promise<EnvironmentHostMetrics[]> {
+		return this.handleResponse(this.api.get('/host-metrics'));
+	}
+
+	async getDaemonConfig(): Promise<DaemonConfig> {
+		const envId = await environmentStore.getCurrentEnvironmentId();
+		return this.handleResponse(this.api.get(`/environments/${envId}/system/daemon-config`));
+	}
+
+	async updateDaemonJson(content: string, dryRun = false): Promise<UpdateDaemonJsonResult> {
+		const envId = await environmentStore.getCurrentEnvironmentId();
+		return this.handleResponse(this.api.put(`/environments/${envId}/system/daemon-config`, { content, dryRun }));
+	}
+
 	async convert(dockerRunCommand: string) {
 		const envId = await environmentStore.getCurrentEnvironmentId();
 		const res = await this.api.post(`/environments/${envId}/system/convert`, {
@@ -42,6 +69,12 @@ export class SystemService extends BaseAPIService {
 		});
 		return res.data;
 	}
+
+	async convertContainer(containerId: string): Promise<ContainerDockerRunConversion> {
+		const envId = await environmentStore.getCurrentEnvironmentId();
+		const res = await this.api.get(`/environments/${envId}/system/convert/containers/${containerId}`);
+		return res.data;
+	}
 }
 
 export const systemService = new SystemService();
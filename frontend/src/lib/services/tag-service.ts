@@ -0,0 +1,64 @@
+import BaseAPIService from './api-service';
+import { environmentStore } from '$lib/stores/environment.store.svelte';
+import type {
+	ResourceTags,
+	SavedFilter,
+	SavedFilterCreate,
+	SavedFilterUpdate,
+	TagKeySummary,
+	TagResourceType
+} from '$lib/types/tag.type';
+
+class TagService extends BaseAPIService {
+	private async envPath(environmentId?: string): Promise<string> {
+		const envId = environmentId ?? (await environmentStore.getCurrentEnvironmentId());
+		return `/environments/${envId}`;
+	}
+
+	async getContainerTags(containerId: string, environmentId?: string): Promise<ResourceTags> {
+		return this.handleResponse(this.api.get(`${await this.envPath(environmentId)}/containers/${containerId}/tags`));
+	}
+
+	async setContainerTags(containerId: string, tags: ResourceTags, environmentId?: string): Promise<ResourceTags> {
+		return this.handleResponse(this.api.put(`${await this.envPath(environmentId)}/containers/${containerId}/tags`, { tags }));
+	}
+
+	async getProjectTags(projectId: string, environmentId?: string): Promise<ResourceTags> {
+		return this.handleResponse(this.api.get(`${await this.envPath(environmentId)}/projects/${projectId}/tags`));
+	}
+
+	async setProjectTags(projectId: string, tags: ResourceTags, environmentId?: string): Promise<ResourceTags> {
+		return this.handleResponse(this.api.put(`${await this.envPath(environmentId)}/projects/${projectId}/tags`, { tags }));
+	}
+
+	async getEnvironmentTags(environmentId: string): Promise<ResourceTags> {
+		return this.handleResponse(this.api.get(`/environments/${environmentId}/tags`));
+	}
+
+	async setEnvironmentTags(environmentId: string, tags: ResourceTags): Promise<ResourceTags> {
+		return this.handleResponse(this.api.put(`/environments/${environmentId}/tags`, { tags }));
+	}
+
+	async listTagKeys(resourceType: Exclude<TagResourceType, 'environment'>, environmentId?: string): Promise<TagKeySummary[]> {
+		return this.handleResponse(this.api.get(`${await this.envPath(environmentId)}/tag-keys`, { params: { resourceType } }));
+	}
+
+	async listSavedFilters(resourceType?: TagResourceType): Promise<SavedFilter[]> {
+		return this.handleResponse(this.api.get('/saved-filters', { params: resourceType ? { resourceType } : undefined }));
+	}
+
+	async createSavedFilter(filter: SavedFilterCreate): Promise<SavedFilter> {
+		return this.handleResponse(this.api.post('/saved-filters', filter));
+	}
+
+	async updateSavedFilter(filterId: string, update: SavedFilterUpdate): Promise<SavedFilter> {
+		return this.handleResponse(this.api.put(`/saved-filters/${filterId}`, update));
+	}
+
+	async deleteSavedFilter(filterId: string): Promise<void> {
+		await this.handleResponse(this.api.delete(`/saved-filters/${filterId}`));
+	}
+}
+
+export const tagService = new TagService();
+export default TagService;
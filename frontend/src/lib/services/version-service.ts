@@ -1,6 +1,6 @@
 import { version as currentVersion } from '$app/environment';
 import axios from 'axios';
-import type { AppVersionInformation } from '$lib/types/application-configuration';
+import type { AppVersionInformation, ArcaneChangelog } from '$lib/types/application-configuration';
 
 function getCurrentVersion() {
 	return currentVersion;
@@ -69,8 +69,16 @@ async function getReleaseUrl(): Promise<string | undefined> {
 	return info.releaseUrl;
 }
 
+async function getChangelog(): Promise<ArcaneChangelog> {
+	const res = await axios.get<{ success: boolean; data: ArcaneChangelog }>('/api/version/changelog', {
+		timeout: 20000
+	});
+	return res.data.data;
+}
+
 export default {
 	getVersionInformation,
+	getChangelog,
 	getNewestVersion,
 	getReleaseUrl,
 	getCurrentVersion
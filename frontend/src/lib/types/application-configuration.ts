@@ -15,3 +15,20 @@ export interface AppVersionInformation {
 	releaseUrl?: string;
 	releaseNotes?: string;
 }
+
+export interface ArcaneRelease {
+	version: string;
+	name?: string;
+	notes?: string;
+	url: string;
+	publishedAt?: string;
+}
+
+export interface ArcaneChangelog {
+	currentVersion: string;
+	newestVersion?: string;
+	updateAvailable: boolean;
+	canUpgrade: boolean;
+	upgradeMessage?: string;
+	releases?: ArcaneRelease[];
+}
@@ -13,15 +13,23 @@ export interface AutoUpdateResult {
 	updated: number;
 	skipped: number;
 	failed: number;
+	blocked?: number;
 	items: AutoUpdateResourceResult[];
 	duration: string;
+	preconditions?: AutoUpdatePreconditionCheck[];
+}
+
+export interface AutoUpdatePreconditionCheck {
+	name: 'concurrent_run' | 'disk_space' | 'registries' | 'host_load';
+	status: 'passed' | 'failed' | 'skipped';
+	message?: string;
 }
 
 export interface AutoUpdateResourceResult {
 	resourceId: string;
 	resourceName: string;
 	resourceType: AutoUpdateResourceType;
-	status: 'checked' | 'up_to_date' | 'update_available' | 'updated' | 'failed' | 'skipped';
+	status: 'checked' | 'up_to_date' | 'update_available' | 'updated' | 'failed' | 'skipped' | 'blocked';
 	updateAvailable: boolean;
 	updateApplied: boolean;
 	oldImages?: Record<string, string>;
@@ -29,3 +37,99 @@ export interface AutoUpdateResourceResult {
 	error?: string;
 	details?: Record<string, any>;
 }
+
+export type RolloutState = 'pending' | 'running' | 'soaking' | 'succeeded' | 'failed' | 'halted' | 'skipped';
+
+export interface RolloutStage {
+	name: string;
+	environmentIds: string[];
+	soakMinutes?: number;
+}
+
+export interface RolloutEnvironmentResult {
+	environmentId: string;
+	environmentName: string;
+	updated: number;
+	failed: number;
+	unhealthy?: string[];
+	error?: string;
+}
+
+export interface RolloutStageStatus extends RolloutStage {
+	state: RolloutState;
+	startedAt?: string;
+	finishedAt?: string;
+	environments: RolloutEnvironmentResult[];
+}
+
+export interface Rollout {
+	id: string;
+	trigger: 'manual' | 'scheduled';
+	state: RolloutState;
+	startedAt: string;
+	finishedAt?: string;
+	haltReason?: string;
+	stages: RolloutStageStatus[];
+}
+
+export type UpdaterRunTrigger = 'schedule' | 'manual' | 'webhook' | 'notification';
+
+export interface UpdaterRun {
+	id: string;
+	trigger: UpdaterRunTrigger;
+	status: 'updating' | 'completed' | 'failed';
+	dryRun: boolean;
+	retryOfRunId?: string;
+	startTime: string;
+	endTime?: string;
+	checked: number;
+	updated: number;
+	skipped: number;
+	failed: number;
+	blocked: number;
+	error?: string;
+	retainedImages?: Record<string, string>;
+	retainedUntil?: string;
+}
+
+export interface UpdaterRunItem {
+	id: string;
+	resourceId: string;
+	resourceType: AutoUpdateResourceType;
+	resourceName: string;
+	status: string;
+	updateAvailable: boolean;
+	updateApplied: boolean;
+	oldImages?: Record<string, string>;
+	newImages?: Record<string, string>;
+	error?: string;
+	time: string;
+}
+
+export interface UpdaterRunDetail extends UpdaterRun {
+	items: UpdaterRunItem[];
+}
+
+export interface ContainerUpdatePreview {
+	containerId: string;
+	containerName: string;
+	imageRef?: string;
+	wouldUpdate: boolean;
+	reason?: string;
+	currentDigest?: string;
+	targetDigest?: string;
+	platform?: string;
+	checkedViaApi: boolean;
+	monitorOnly: boolean;
+	dependents: string[];
+}
+
+export interface ContainerRollback {
+	containerId: string;
+	containerName: string;
+	image: string;
+	replacedImageId?: string;
+	pulled: boolean;
+	runId?: string;
+	recordId: string;
+}
@@ -0,0 +1,28 @@
+export type CommandWebhookAction = 'project.redeploy' | 'container.update' | 'updater.apply' | 'system.prune';
+
+export type CommandWebhook = {
+	id: string;
+	name: string;
+	tokenPrefix: string;
+	actions: CommandWebhookAction[];
+	enabled: boolean;
+	lastUsedAt?: string;
+	createdAt: string;
+	updatedAt?: string;
+};
+
+export type CommandWebhookCreated = CommandWebhook & {
+	token: string;
+};
+
+export type CreateCommandWebhook = {
+	name: string;
+	actions: CommandWebhookAction[];
+	enabled?: boolean;
+};
+
+export type UpdateCommandWebhook = {
+	name?: string;
+	actions?: CommandWebhookAction[];
+	enabled?: boolean;
+};
@@ -1,4 +1,13 @@
-export interface ContainerRegistryCreateDto {
+export type SignatureMode = '' | 'cosign-key' | 'cosign-keyless' | 'notation';
+
+export interface RegistrySignaturePolicy {
+	signatureMode?: SignatureMode;
+	signatureTrust?: string;
+	signatureIdentity?: string;
+	signatureIssuer?: string;
+}
+
+export interface ContainerRegistryCreateDto extends RegistrySignaturePolicy {
 	url: string;
 	username: string;
 	token: string;
@@ -7,7 +16,7 @@ export interface ContainerRegistryCreateDto {
 	enabled?: boolean;
 }
 
-export interface ContainerRegistryUpdateDto {
+export interface ContainerRegistryUpdateDto extends RegistrySignaturePolicy {
 	url?: string;
 	username?: string;
 	token?: string;
@@ -16,7 +25,7 @@ export interface ContainerRegistryUpdateDto {
 	enabled?: boolean;
 }
 
-export interface ContainerRegistry {
+export interface ContainerRegistry extends RegistrySignaturePolicy {
 	id: string;
 	url: string;
 	username: string;
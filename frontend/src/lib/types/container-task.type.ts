@@ -0,0 +1,46 @@
+export type ContainerTaskRunStatus = 'running' | 'success' | 'failed' | 'timeout';
+
+export type ContainerTaskTrigger = 'schedule' | 'manual';
+
+export interface ContainerTask {
+	id: string;
+	name: string;
+	containerName: string;
+	command: string;
+	user?: string;
+	schedule: string;
+	timeoutSeconds: number;
+	notifyOnFailure: boolean;
+	enabled: boolean;
+	lastRunAt?: string;
+	lastStatus?: ContainerTaskRunStatus;
+	nextRunAt?: string;
+	createdAt: string;
+	updatedAt?: string;
+}
+
+export interface ContainerTaskCreate {
+	name: string;
+	containerName: string;
+	command: string;
+	user?: string;
+	schedule: string;
+	timeoutSeconds?: number;
+	notifyOnFailure?: boolean;
+	enabled?: boolean;
+}
+
+export type ContainerTaskUpdate = Partial<ContainerTaskCreate>;
+
+export interface ContainerTaskRun {
+	id: string;
+	taskId: string;
+	trigger: ContainerTaskTrigger;
+	status: ContainerTaskRunStatus;
+	exitCode?: number;
+	output: string;
+	error?: string;
+	startedAt: string;
+	finishedAt?: string;
+	durationMs?: number;
+}
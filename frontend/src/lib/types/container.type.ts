@@ -44,6 +44,14 @@ export interface NetworkingConfig {
 	endpointsConfig?: Record<string, { aliases?: string[] }>;
 }
 
+export interface HealthcheckCreate {
+	test: string[];
+	interval?: string;
+	timeout?: string;
+	startPeriod?: string;
+	retries?: number;
+}
+
 export interface ContainerCreateRequest {
 	name: string;
 	image: string;
@@ -65,6 +73,39 @@ export interface ContainerCreateRequest {
 	tty?: boolean;
 	openStdin?: boolean;
 	stdinOnce?: boolean;
+	healthcheck?: HealthcheckCreate;
+}
+
+export interface ContainerCreateIssue {
+	field: string;
+	message: string;
+}
+
+export interface ContainerCreatePreview {
+	valid: boolean;
+	errors?: ContainerCreateIssue[];
+	warnings?: ContainerCreateIssue[];
+	dockerRun: string;
+}
+
+export interface ContainerEditRequest {
+	image?: string;
+	env?: string[];
+	portBindings?: Record<string, PortBinding[]>;
+	binds?: string[];
+}
+
+export interface ContainerConfigChange {
+	field: 'image' | 'env' | 'ports' | 'mounts';
+	removed?: string[];
+	added?: string[];
+}
+
+export interface ContainerEditResult {
+	containerId: string;
+	containerName: string;
+	changes: ContainerConfigChange[];
+	applied: boolean;
 }
 
 export interface ContainerSummaryDto extends BaseContainer {
@@ -73,6 +114,7 @@ export interface ContainerSummaryDto extends BaseContainer {
 	networkSettings: ContainerNetworkSettings;
 	mounts: ContainerMounts[];
 	updateInfo?: ImageUpdateInfoDto;
+	tags?: Record<string, string>;
 }
 
 export interface ContainerPorts {
@@ -89,6 +131,22 @@ export interface ContainerHostConfig {
 	autoRemove?: boolean;
 	nanoCpus?: number;
 	memory?: number;
+	runtime?: string;
+	devices?: ContainerDeviceMapping[];
+	deviceRequests?: ContainerDeviceRequest[];
+}
+
+export interface ContainerDeviceMapping {
+	pathOnHost: string;
+	pathInContainer?: string;
+	cgroupPermissions?: string;
+}
+
+export interface ContainerDeviceRequest {
+	driver?: string;
+	count?: number;
+	deviceIds?: string[];
+	capabilities?: string[][];
 }
 
 export interface ContainerNetworkSettings {
@@ -132,6 +190,29 @@ export interface ContainerStatusCounts {
 	totalContainers: number;
 }
 
+export interface ContainerLogLine {
+	timestamp?: string;
+	stream: 'stdout' | 'stderr';
+	message: string;
+}
+
+export interface ContainerLogSearchResult {
+	lines: ContainerLogLine[];
+	matched: number;
+	scanned: number;
+	truncated: boolean;
+}
+
+export interface ContainerLogSearchOptions {
+	q?: string;
+	regex?: boolean;
+	caseSensitive?: boolean;
+	stream?: 'all' | 'stdout' | 'stderr';
+	since?: string;
+	until?: string;
+	limit?: number;
+}
+
 export interface ContainerStateDto {
 	status: string;
 	running: boolean;
@@ -293,3 +374,47 @@ export interface ContainerStats {
 	networks: Record<string, NetworkStats>;
 	storage_stats: StorageStats;
 }
+
+export interface ContainerSnapshotCreateRequest {
+	reference?: string;
+	comment?: string;
+	noPause?: boolean;
+	push?: boolean;
+}
+
+export interface ContainerSnapshot {
+	id: string;
+	reference: string;
+	containerName: string;
+	containerId: string;
+	sourceImage?: string;
+	comment?: string;
+	pushed: boolean;
+	size: number;
+	createdAt: string;
+}
+
+export interface ContainerSnapshotRestoreResult {
+	containerId: string;
+	containerName: string;
+	snapshot: ContainerSnapshot;
+}
+
+export interface ContainerCloneRequest {
+	sourceEnvironmentId?: string;
+	containerId: string;
+	targetEnvironmentId: string;
+	name?: string;
+	copyVolumes?: boolean;
+	start?: boolean;
+}
+
+export interface ContainerCloneResult {
+	environmentId: string;
+	environmentName: string;
+	containerId: string;
+	containerName: string;
+	image: string;
+	volumes: string[];
+	warnings: string[];
+}
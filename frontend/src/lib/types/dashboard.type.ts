@@ -1,3 +1,5 @@
+import type { Event } from './event.type';
+
 export type DashboardActionItemKind = 'stopped_containers' | 'image_updates' | 'actionable_vulnerabilities' | 'expiring_keys';
 
 export type DashboardActionItemSeverity = 'warning' | 'critical';
@@ -11,3 +13,59 @@ export interface DashboardActionItem {
 export interface DashboardActionItems {
 	items: DashboardActionItem[];
 }
+
+export type DashboardWidgetKind =
+	| 'action_items'
+	| 'updates_pending'
+	| 'vulnerable_images'
+	| 'disk_usage'
+	| 'recent_events'
+	| 'environment_health';
+
+export interface DashboardWidget {
+	id: string;
+	kind: DashboardWidgetKind;
+	x: number;
+	y: number;
+	width: number;
+	height: number;
+}
+
+export interface DashboardLayout {
+	widgets: DashboardWidget[];
+	custom: boolean;
+	updatedAt?: string;
+}
+
+export interface DashboardPendingUpdate {
+	imageId: string;
+	repository: string;
+	tag: string;
+	updateType?: string;
+	currentVersion?: string;
+	latestVersion?: string;
+}
+
+export interface DashboardVulnerableImage {
+	imageId: string;
+	imageName: string;
+	critical: number;
+	high: number;
+}
+
+export interface DashboardEnvironmentHealth {
+	id: string;
+	name: string;
+	status: string;
+	lastSeen?: string;
+}
+
+export interface DashboardWidgetData {
+	actionItems?: DashboardActionItems;
+	updatesPending?: { count: number; images: DashboardPendingUpdate[] };
+	vulnerableImages?: { count: number; images: DashboardVulnerableImage[] };
+	diskUsage?: { path: string; used: number; total: number; usedPercent: number };
+	recentEvents?: { events: Event[] };
+	environmentHealth?: { total: number; online: number; unhealthy: DashboardEnvironmentHealth[] };
+	errors?: Partial<Record<DashboardWidgetKind, string>>;
+}
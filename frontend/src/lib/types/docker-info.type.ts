@@ -91,3 +91,35 @@ export interface Commit {
 	ID: string;
 	Expected: string;
 }
+
+export interface DaemonRisk {
+	id: string;
+	severity: 'info' | 'warning';
+	message: string;
+	remediation?: string;
+}
+
+export interface DaemonConfig {
+	serverVersion: string;
+	storageDriver: string;
+	cgroupVersion: string;
+	cgroupDriver: string;
+	loggingDriver: string;
+	liveRestoreEnabled: boolean;
+	registryMirrors: string[];
+	insecureRegistries: string[];
+	dockerRootDir: string;
+	risks: DaemonRisk[];
+	daemonJsonPath: string;
+	daemonJson?: string;
+	editable: boolean;
+}
+
+export interface UpdateDaemonJsonResult {
+	written: boolean;
+	changedKeys: string[];
+	reloadableKeys: string[];
+	restartKeys: string[];
+	applyCommand?: string;
+	backupPath?: string;
+}
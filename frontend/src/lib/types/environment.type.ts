@@ -1,5 +1,83 @@
 export type EnvironmentStatus = 'online' | 'offline' | 'error' | 'pending';
 
+export type AgentFeature =
+	| 'containers'
+	| 'images'
+	| 'imageUpdates'
+	| 'volumes'
+	| 'networks'
+	| 'projects'
+	| 'builds'
+	| 'gitops'
+	| 'vulnerabilities'
+	| 'updater'
+	| 'system'
+	| 'dashboard'
+	| 'notifications'
+	| 'settings'
+	| 'jobs'
+	| 'containerTasks'
+	| 'containerPreview'
+	| 'dashboardWidgets'
+	| 'hostMetrics'
+	| 'imageTags'
+	| 'imagePulls'
+	| 'ingress'
+	| 'projectAdoption'
+	| 'customFileTemplates'
+	| 'pathMapping'
+	| 'securityAudit'
+	| 'settingsDryApply'
+	| 'snapshots'
+	| 'daemonConfig'
+	| 'tags'
+	| 'topology'
+	| 'updateRuns'
+	| 'logAlerts'
+	| 'staleResources'
+	| 'containerImport';
+
+export type AgentCapabilities = {
+	version: string;
+	composeVersion?: string;
+	swarmEnabled: boolean;
+	features: AgentFeature[];
+	legacy?: boolean;
+	checkedAt?: string;
+};
+
+export type CircuitBreakerState = 'closed' | 'open' | 'halfOpen';
+
+export type CircuitBreaker = {
+	state: CircuitBreakerState;
+	consecutiveFailures?: number;
+	lastError?: string;
+	openedAt?: string;
+	retryAt?: string;
+};
+
+export type EnvironmentSyncState = 'pending' | 'running' | 'succeeded' | 'failed';
+
+export type EnvironmentSyncTarget = {
+	target: 'registries' | 'repositories';
+	state: EnvironmentSyncState;
+	attempts: number;
+	error?: string;
+	finishedAt?: string;
+	lastSuccessAt?: string;
+};
+
+export type EnvironmentSyncStatus = {
+	environmentId: string;
+	state: Exclude<EnvironmentSyncState, 'pending'>;
+	trigger: string;
+	startedAt: string;
+	finishedAt?: string;
+	completed: number;
+	total: number;
+	targets: EnvironmentSyncTarget[];
+};
+
 export type Environment = {
 	id: string;
 	name: string;
@@ -12,7 +90,14 @@ export type Environment = {
 	connectedAt?: string;
 	lastHeartbeat?: string;
 	lastSeen?: string;
+	breaker?: CircuitBreaker;
+	sync?: EnvironmentSyncStatus;
+	capabilities?: AgentCapabilities;
+	pairingFingerprint?: string;
+	pairingOriginIp?: string;
+	pairingRequestedAt?: string;
 	apiKey?: string;
+	tags?: Record<string, string>;
 };
 
 export interface CreateEnvironmentDTO {
@@ -35,4 +120,18 @@ export interface UpdateEnvironmentDTO {
 export interface DeploymentSnippets {
 	dockerRun: string;
 	dockerCompose: string;
+	systemdUnit: string;
+}
+
+export interface DeploymentOptions {
+	imageTag?: string;
+	port?: number;
+	dataVolume?: string;
+	projectsPath?: string;
+	dockerSocket?: string;
+	network?: string;
+	tls?: boolean;
+	tlsCertFile?: string;
+	tlsKeyFile?: string;
+	env?: string[];
 }
@@ -35,6 +35,10 @@ export interface GitRepository {
 	updatedAt: string;
 }
 
+export type TagBumpMode = '' | 'commit' | 'pull_request';
+
+export type TagBumpStatus = 'up_to_date' | 'committed' | 'proposed' | 'already_proposed' | 'failed';
+
 export interface GitOpsSyncCreateDto {
 	name: string;
 	repositoryId: string;
@@ -43,6 +47,7 @@ export interface GitOpsSyncCreateDto {
 	projectName?: string;
 	autoSync?: boolean;
 	syncInterval?: number;
+	tagBumpMode?: TagBumpMode;
 }
 
 export interface GitOpsSyncUpdateDto {
@@ -53,6 +58,7 @@ export interface GitOpsSyncUpdateDto {
 	projectName?: string;
 	autoSync?: boolean;
 	syncInterval?: number;
+	tagBumpMode?: TagBumpMode;
 }
 
 export interface GitOpsSync {
@@ -71,6 +77,11 @@ export interface GitOpsSync {
 	lastSyncStatus?: string;
 	lastSyncError?: string;
 	lastSyncCommit?: string;
+	tagBumpMode: TagBumpMode;
+	lastTagBumpAt?: string;
+	lastTagBumpStatus?: TagBumpStatus;
+	lastTagBumpMessage?: string;
+	lastTagBumpUrl?: string;
 	createdAt: string;
 	updatedAt: string;
 }
@@ -85,9 +96,27 @@ export interface SyncResult {
 	success: boolean;
 	message: string;
 	error?: string;
+	warnings?: string[];
 	syncedAt: string;
 }
 
+export interface ImageTagBump {
+	service: string;
+	image: string;
+	currentTag: string;
+	newTag: string;
+	skippedTags?: string[];
+}
+
+export interface TagBumpResult {
+	status: TagBumpStatus;
+	message: string;
+	bumps: ImageTagBump[];
+	branch?: string;
+	commit?: string;
+	pullRequestUrl?: string;
+}
+
 export interface FileTreeNode {
 	name: string;
 	path: string;
@@ -0,0 +1,21 @@
+export interface HostMetrics {
+	environmentId: string;
+	cpuPercent: number;
+	cpuCount: number;
+	memoryUsed: number;
+	memoryTotal: number;
+	memoryPercent: number;
+	diskUsed: number;
+	diskTotal: number;
+	diskPercent: number;
+	load1: number;
+	load5: number;
+	load15: number;
+	collectedAt: string;
+}
+
+export interface EnvironmentHostMetrics {
+	environmentId: string;
+	environmentName: string;
+	latest?: HostMetrics;
+}
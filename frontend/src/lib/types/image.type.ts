@@ -14,6 +14,8 @@ export interface ImageUpdateInfoDto {
 	authUsername?: string;
 	authRegistry?: string;
 	usedCredential?: boolean;
+	platform?: string;
+	platformUnsupported?: boolean;
 }
 
 export interface ImageUsageCounts {
@@ -122,3 +124,80 @@ export interface ImageBuildRecord {
 	durationMs?: number;
 	createdAt: string;
 }
+
+export type ImagePullStatus = 'success' | 'failed' | 'canceled';
+
+export type ImagePullTrigger = 'user' | 'updater' | 'system';
+
+export interface ImagePullRecord {
+	id: string;
+	imageName: string;
+	userId?: string;
+	username?: string;
+	trigger: ImagePullTrigger;
+	status: ImagePullStatus;
+	layers: number;
+	layersDownloaded: number;
+	bytes: number;
+	errorMessage?: string;
+	completedAt?: string;
+	durationMs: number;
+	createdAt: string;
+}
+
+export interface RemoteTag {
+	tag: string;
+	semver?: string;
+}
+
+export interface ImageUpdateSnoozeRequest {
+	imageRef: string;
+	until: string;
+}
+
+export interface ImageUpdateSnooze {
+	repository: string;
+	tag: string;
+	snoozedUntil: string;
+}
+
+export type ImageDistributeMethod = 'stream' | 'registry';
+
+export interface ImageDistributeRequest {
+	sourceEnvironmentId?: string;
+	image: string;
+	targetEnvironmentIds: string[];
+	method?: ImageDistributeMethod;
+}
+
+export interface ImageDistributeTargetResult {
+	environmentId: string;
+	environmentName: string;
+	success: boolean;
+	error?: string;
+	durationMs: number;
+}
+
+export interface ImageDistributeResult {
+	image: string;
+	method: ImageDistributeMethod;
+	targets: ImageDistributeTargetResult[];
+}
+
+export interface ImagePromoteRequest {
+	sourceEnvironmentId?: string;
+	sourceImage: string;
+	sourceDigest?: string;
+	targetRegistryId: string;
+	targetRepository?: string;
+	targetTag?: string;
+}
+
+export interface ImagePromoteResult {
+	sourceImage: string;
+	sourceDigest?: string;
+	targetImage: string;
+	digest: string;
+	verified: boolean;
+	durationMs: number;
+}
@@ -0,0 +1,51 @@
+export type IngressProvider = 'traefik' | 'caddy';
+
+export type IngressConflictKind = 'duplicate_host' | 'duplicate_router' | 'missing_entrypoint' | 'missing_rule';
+
+export interface IngressRoute {
+	provider: IngressProvider;
+	name: string;
+	containerId: string;
+	containerName: string;
+	project?: string;
+	service?: string;
+	rule?: string;
+	hosts: string[];
+	pathPrefixes?: string[];
+	entryPoints?: string[];
+	port?: string;
+	tls: boolean;
+}
+
+export interface IngressConflict {
+	kind: IngressConflictKind;
+	message: string;
+	provider: IngressProvider;
+	host?: string;
+	routes: string[];
+	containerNames: string[];
+}
+
+export interface IngressAnalysis {
+	routes: IngressRoute[];
+	conflicts: IngressConflict[];
+	entryPoints?: string[];
+}
+
+export interface GenerateIngressLabelsRequest {
+	provider: IngressProvider;
+	service: string;
+	domain: string;
+	port: number;
+	pathPrefix?: string;
+	tls?: boolean;
+	entryPoint?: string;
+	certResolver?: string;
+	network?: string;
+}
+
+export interface GeneratedIngressLabels {
+	labels: Record<string, string>;
+	compose: string;
+	conflicts: IngressConflict[];
+}
@@ -8,6 +8,8 @@ export type JobSchedules = {
 	gitopsSyncInterval: string;
 	vulnerabilityScanInterval: string;
 	autoHealInterval: string;
+	imageRetentionInterval: string;
+	staleResourcesInterval: string;
 };
 
 export type JobSchedulesUpdate = Partial<JobSchedules>;
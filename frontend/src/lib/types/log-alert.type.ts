@@ -0,0 +1,28 @@
+export interface LogAlertRule {
+	id: string;
+	name: string;
+	pattern: string;
+	projectId?: string;
+	services: string[];
+	containerName?: string;
+	cooldownSeconds: number;
+	enabled: boolean;
+	attachedContainers: number;
+	lastFiredAt?: string;
+	lastExcerpt?: string;
+	lastError?: string;
+	createdAt: string;
+	updatedAt?: string;
+}
+
+export interface LogAlertRuleCreate {
+	name: string;
+	pattern: string;
+	projectId?: string;
+	services?: string[];
+	containerName?: string;
+	cooldownSeconds?: number;
+	enabled?: boolean;
+}
+
+export type LogAlertRuleUpdate = Partial<LogAlertRuleCreate>;
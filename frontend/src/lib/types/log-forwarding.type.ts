@@ -0,0 +1,28 @@
+export type LogSinkType = 'loki' | 'syslog-udp' | 'syslog-tcp' | 'file';
+
+export interface LogForwarder {
+	id: string;
+	projectId: string;
+	sinkType: LogSinkType;
+	target: string;
+	hasToken: boolean;
+	services: string[];
+	fileMaxSizeMb: number;
+	fileMaxFiles: number;
+	enabled: boolean;
+	attachedContainers: number;
+	lastError?: string;
+	lastShippedAt?: string;
+	createdAt: string;
+	updatedAt?: string;
+}
+
+export interface UpsertLogForwarderRequest {
+	sinkType: LogSinkType;
+	target: string;
+	token?: string;
+	services?: string[];
+	fileMaxSizeMb?: number;
+	fileMaxFiles?: number;
+	enabled?: boolean;
+}
@@ -0,0 +1,14 @@
+export type NotificationActionKind = 'image.apply-update' | 'image.snooze';
+
+export type NotificationAction = {
+	action: NotificationActionKind;
+	imageRef: string;
+	snoozeDays?: number;
+	expiresAt: string;
+};
+
+export type NotificationActionResult = {
+	action: NotificationActionKind;
+	imageRef: string;
+	text: string;
+};
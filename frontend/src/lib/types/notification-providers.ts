@@ -1,4 +1,10 @@
-import type { NotificationSettings, AppriseSettings, EmailTLSMode } from './notification.type';
+import type {
+	NotificationSettings,
+	AppriseSettings,
+	EmailTLSMode,
+	NotificationProviderRole,
+	NotificationTLSConfig
+} from './notification.type';
 
 // Provider keys - this is the source of truth for all providers (alphabetically sorted)
 export const NOTIFICATION_PROVIDER_KEYS = [
@@ -18,6 +24,7 @@ export type NotificationProviderKey = (typeof NOTIFICATION_PROVIDER_KEYS)[number
 // Base form values that all providers share
 export interface BaseProviderFormValues {
 	enabled: boolean;
+	role: NotificationProviderRole;
 	eventImageUpdate: boolean;
 	eventContainerUpdate: boolean;
 	eventVulnerabilityFound: boolean;
@@ -31,6 +38,7 @@ export interface DiscordFormValues extends BaseProviderFormValues {
 	token: string;
 	username: string;
 	avatarUrl: string;
+	plainText: boolean;
 }
 
 export interface EmailFormValues extends BaseProviderFormValues {
@@ -41,6 +49,7 @@ export interface EmailFormValues extends BaseProviderFormValues {
 	fromAddress: string;
 	toAddresses: string;
 	tlsMode: EmailTLSMode;
+	tls?: NotificationTLSConfig;
 }
 
 export interface TelegramFormValues extends BaseProviderFormValues {
@@ -49,6 +58,8 @@ export interface TelegramFormValues extends BaseProviderFormValues {
 	preview: boolean;
 	notification: boolean;
 	title: string;
+	topics: string;
+	eventSettings: string;
 }
 
 export interface SignalFormValues extends BaseProviderFormValues {
@@ -70,6 +81,7 @@ export interface SlackFormValues extends BaseProviderFormValues {
 	title: string;
 	channel: string;
 	threadTs: string;
+	plainText: boolean;
 }
 
 export interface NtfyFormValues extends BaseProviderFormValues {
@@ -85,6 +97,8 @@ export interface NtfyFormValues extends BaseProviderFormValues {
 	cache: boolean;
 	firebase: boolean;
 	disableTlsVerification: boolean;
+	tls?: NotificationTLSConfig;
+	eventSettings: string;
 }
 
 export interface PushoverFormValues extends BaseProviderFormValues {
@@ -92,7 +106,9 @@ export interface PushoverFormValues extends BaseProviderFormValues {
 	user: string;
 	devices: string;
 	priority: number;
+	sound: string;
 	title: string;
+	eventSettings: string;
 }
 
 export interface GotifyFormValues extends BaseProviderFormValues {
@@ -103,6 +119,8 @@ export interface GotifyFormValues extends BaseProviderFormValues {
 	priority: number;
 	title: string;
 	disableTls: boolean;
+	tls?: NotificationTLSConfig;
+	eventSettings: string;
 }
 
 export interface MatrixFormValues extends BaseProviderFormValues {
@@ -111,7 +129,10 @@ export interface MatrixFormValues extends BaseProviderFormValues {
 	rooms: string;
 	username: string;
 	password: string;
+	accessToken: string;
+	encryption: boolean;
 	disableTlsVerification: boolean;
+	tls?: NotificationTLSConfig;
 }
 
 export interface GenericFormValues extends BaseProviderFormValues {
@@ -121,6 +142,7 @@ export interface GenericFormValues extends BaseProviderFormValues {
 	titleKey: string;
 	messageKey: string;
 	customHeaders: string;
+	tls?: NotificationTLSConfig;
 }
 
 export interface AppriseFormValues {
@@ -172,10 +194,12 @@ export function discordSettingsToFormValues(settings?: NotificationSettings): Di
 	const events = (cfg?.events ?? {}) as Record<string, boolean>;
 	return {
 		enabled: settings?.enabled ?? false,
+		role: cfg?.role === 'fallback' ? 'fallback' : 'primary',
 		webhookId: (cfg?.webhookId as string) || '',
 		token: (cfg?.token as string) || '',
 		username: (cfg?.username as string) || 'Arcane',
 		avatarUrl: (cfg?.avatarUrl as string) || '',
+		plainText: (cfg?.plainText as boolean) ?? false,
 		eventImageUpdate: events?.image_update ?? true,
 		eventContainerUpdate: events?.container_update ?? true,
 		eventVulnerabilityFound: events?.vulnerability_found ?? true,
@@ -189,6 +213,7 @@ export function emailSettingsToFormValues(settings?: NotificationSettings): Emai
 	const events = (cfg?.events ?? {}) as Record<string, boolean>;
 	return {
 		enabled: settings?.enabled ?? false,
+		role: cfg?.role === 'fallback' ? 'fallback' : 'primary',
 		smtpHost: (cfg?.smtpHost as string) || '',
 		smtpPort: (cfg?.smtpPort as number) || 587,
 		smtpUsername: (cfg?.smtpUsername as string) || '',
@@ -196,6 +221,7 @@ export function emailSettingsToFormValues(settings?: NotificationSettings): Emai
 		fromAddress: (cfg?.fromAddress as string) || '',
 		toAddresses: Array.isArray(cfg?.toAddresses) ? (cfg.toAddresses as string[]).join(', ') : '',
 		tlsMode: ((cfg?.tlsMode as string) || 'starttls') as EmailTLSMode,
+		tls: cfg?.tls as NotificationTLSConfig | undefined,
 		eventImageUpdate: events?.image_update ?? true,
 		eventContainerUpdate: events?.container_update ?? true,
 		eventVulnerabilityFound: events?.vulnerability_found ?? true,
@@ -209,11 +235,14 @@ export function telegramSettingsToFormValues(settings?: NotificationSettings): T
 	const events = (cfg?.events ?? {}) as Record<string, boolean>;
 	return {
 		enabled: settings?.enabled ?? false,
+		role: cfg?.role === 'fallback' ? 'fallback' : 'primary',
 		botToken: (cfg?.botToken as string) || '',
 		chatIds: Array.isArray(cfg?.chatIds) ? (cfg.chatIds as string[]).join(', ') : '',
 		preview: (cfg?.preview as boolean) ?? true,
 		notification: (cfg?.notification as boolean) ?? true,
 		title: (cfg?.title as string) || '',
+		topics: telegramTopicsToText(cfg?.topics),
+		eventSettings: eventSettingsToText(cfg?.eventSettings),
 		eventImageUpdate: events?.image_update ?? true,
 		eventContainerUpdate: events?.container_update ?? true,
 		eventVulnerabilityFound: events?.vulnerability_found ?? true,
@@ -227,6 +256,7 @@ export function signalSettingsToFormValues(settings?: NotificationSettings): Sig
 	const events = (cfg?.events ?? {}) as Record<string, boolean>;
 	return {
 		enabled: settings?.enabled ?? false,
+		role: cfg?.role === 'fallback' ? 'fallback' : 'primary',
 		host: (cfg?.host as string) || 'localhost',
 		port: (cfg?.port as number) || 8080,
 		user: (cfg?.user as string) || '',
@@ -248,6 +278,7 @@ export function slackSettingsToFormValues(settings?: NotificationSettings): Slac
 	const events = (cfg?.events ?? {}) as Record<string, boolean>;
 	return {
 		enabled: settings?.enabled ?? false,
+		role: cfg?.role === 'fallback' ? 'fallback' : 'primary',
 		token: (cfg?.token as string) || '',
 		botName: (cfg?.botName as string) || 'Arcane',
 		icon: (cfg?.icon as string) || '',
@@ -255,6 +286,7 @@ export function slackSettingsToFormValues(settings?: NotificationSettings): Slac
 		title: (cfg?.title as string) || '',
 		channel: (cfg?.channel as string) || '',
 		threadTs: (cfg?.threadTs as string) || '',
+		plainText: (cfg?.plainText as boolean) ?? false,
 		eventImageUpdate: events?.image_update ?? true,
 		eventContainerUpdate: events?.container_update ?? true,
 		eventVulnerabilityFound: events?.vulnerability_found ?? true,
@@ -282,6 +314,8 @@ export function discordFormValuesToSettings(values: DiscordFormValues): Notifica
 			token: values.token,
 			username: values.username,
 			avatarUrl: values.avatarUrl,
+			plainText: values.plainText,
+			role: values.role,
 			events: {
 				image_update: values.eventImageUpdate,
 				container_update: values.eventContainerUpdate,
@@ -308,6 +342,8 @@ export function emailFormValuesToSettings(values: EmailFormValues): Notification
 				.map((addr) => addr.trim())
 				.filter((addr) => addr.length > 0),
 			tlsMode: values.tlsMode,
+			tls: values.tls,
+			role: values.role,
 			events: {
 				image_update: values.eventImageUpdate,
 				container_update: values.eventContainerUpdate,
@@ -332,6 +368,9 @@ export function telegramFormValuesToSettings(values: TelegramFormValues): Notifi
 			preview: values.preview,
 			notification: values.notification,
 			title: values.title,
+			topics: parseTelegramTopicsText(values.topics),
+			eventSettings: parseEventSettingsText(values.eventSettings),
+			role: values.role,
 			events: {
 				image_update: values.eventImageUpdate,
 				container_update: values.eventContainerUpdate,
@@ -359,6 +398,7 @@ export function signalFormValuesToSettings(values: SignalFormValues): Notificati
 				.map((recipient) => recipient.trim())
 				.filter((recipient) => recipient.length > 0),
 			disableTls: values.disableTls,
+			role: values.role,
 			events: {
 				image_update: values.eventImageUpdate,
 				container_update: values.eventContainerUpdate,
@@ -382,6 +422,8 @@ export function slackFormValuesToSettings(values: SlackFormValues): Notification
 			title: values.title,
 			channel: values.channel,
 			threadTs: values.threadTs,
+			plainText: values.plainText,
+			role: values.role,
 			events: {
 				image_update: values.eventImageUpdate,
 				container_update: values.eventContainerUpdate,
@@ -393,11 +435,52 @@ export function slackFormValuesToSettings(values: SlackFormValues): Notification
 	};
 }
 
+// Per-event settings (priority, sound, tags keyed by event type) are edited as
+// JSON, since each provider supports a different set of keys.
+function eventSettingsToText(value: unknown): string {
+	if (!value || typeof value !== 'object' || Object.keys(value).length === 0) return '';
+	return JSON.stringify(value, null, 2);
+}
+
+export function parseEventSettingsText(text: string): Record<string, Record<string, unknown>> | undefined {
+	if (!text.trim()) return undefined;
+	const parsed = JSON.parse(text);
+	if (!parsed || typeof parsed !== 'object' || Array.isArray(parsed)) {
+		throw new Error('Per-event settings must be a JSON object keyed by event type');
+	}
+	return parsed as Record<string, Record<string, unknown>>;
+}
+
+function telegramTopicsToText(value: unknown): string {
+	if (!value || typeof value !== 'object') return '';
+	return Object.entries(value as Record<string, number>)
+		.map(([chatId, topic]) => `${chatId}:${topic}`)
+		.join(', ');
+}
+
+// parseTelegramTopicsText reads "chatId:topicId" pairs separated by commas.
+export function parseTelegramTopicsText(text: string): Record<string, number> | undefined {
+	const topics: Record<string, number> = {};
+	for (const entry of text.split(',')) {
+		const trimmed = entry.trim();
+		if (!trimmed) continue;
+		const separator = trimmed.lastIndexOf(':');
+		const chatId = trimmed.slice(0, separator).trim();
+		const topic = Number(trimmed.slice(separator + 1).trim());
+		if (separator <= 0 || !chatId || !Number.isInteger(topic) || topic <= 0) {
+			throw new Error(`Invalid topic "${trimmed}", expected chatId:topicId`);
+		}
+		topics[chatId] = topic;
+	}
+	return Object.keys(topics).length > 0 ? topics : undefined;
+}
+
 export function ntfySettingsToFormValues(settings?: NotificationSettings): NtfyFormValues {
 	const cfg = (settings?.config ?? {}) as Record<string, unknown>;
 	const events = (cfg?.events ?? {}) as Record<string, boolean>;
 	return {
 		enabled: settings?.enabled ?? false,
+		role: cfg?.role === 'fallback' ? 'fallback' : 'primary',
 		host: (cfg?.host as string) || 'ntfy.sh',
 		port: (cfg?.port as number) || 0,
 		topic: (cfg?.topic as string) || '',
@@ -410,6 +493,8 @@ export function ntfySettingsToFormValues(settings?: NotificationSettings): NtfyF
 		cache: (cfg?.cache as boolean) ?? true,
 		firebase: (cfg?.firebase as boolean) ?? true,
 		disableTlsVerification: (cfg?.disableTlsVerification as boolean) ?? false,
+		tls: cfg?.tls as NotificationTLSConfig | undefined,
+		eventSettings: eventSettingsToText(cfg?.eventSettings),
 		eventImageUpdate: events?.image_update ?? true,
 		eventContainerUpdate: events?.container_update ?? true,
 		eventVulnerabilityFound: events?.vulnerability_found ?? true,
@@ -423,11 +508,14 @@ export function pushoverSettingsToFormValues(settings?: NotificationSettings): P
 	const events = (cfg?.events ?? {}) as Record<string, boolean>;
 	return {
 		enabled: settings?.enabled ?? false,
+		role: cfg?.role === 'fallback' ? 'fallback' : 'primary',
 		token: (cfg?.token as string) || '',
 		user: (cfg?.user as string) || '',
 		devices: Array.isArray(cfg?.devices) ? (cfg.devices as string[]).join(', ') : '',
 		priority: Number(cfg?.priority ?? 0),
+		sound: (cfg?.sound as string) || '',
 		title: (cfg?.title as string) || '',
+		eventSettings: eventSettingsToText(cfg?.eventSettings),
 		eventImageUpdate: events?.image_update ?? true,
 		eventContainerUpdate: events?.container_update ?? true,
 		eventVulnerabilityFound: events?.vulnerability_found ?? true,
@@ -441,6 +529,7 @@ export function gotifySettingsToFormValues(settings?: NotificationSettings): Got
 	const events = (cfg?.events ?? {}) as Record<string, boolean>;
 	return {
 		enabled: settings?.enabled ?? false,
+		role: cfg?.role === 'fallback' ? 'fallback' : 'primary',
 		host: (cfg?.host as string) || '',
 		port: (cfg?.port as number) || 0,
 		token: (cfg?.token as string) || '',
@@ -448,6 +537,8 @@ export function gotifySettingsToFormValues(settings?: NotificationSettings): Got
 		priority: Number(cfg?.priority ?? 0),
 		title: (cfg?.title as string) || '',
 		disableTls: (cfg?.disableTls as boolean) ?? false,
+		tls: cfg?.tls as NotificationTLSConfig | undefined,
+		eventSettings: eventSettingsToText(cfg?.eventSettings),
 		eventImageUpdate: events?.image_update ?? true,
 		eventContainerUpdate: events?.container_update ?? true,
 		eventVulnerabilityFound: events?.vulnerability_found ?? true,
@@ -461,12 +552,16 @@ export function matrixSettingsToFormValues(settings?: NotificationSettings): Mat
 	const events = (cfg?.events ?? {}) as Record<string, boolean>;
 	return {
 		enabled: settings?.enabled ?? false,
+		role: cfg?.role === 'fallback' ? 'fallback' : 'primary',
 		host: (cfg?.host as string) || '',
 		port: (cfg?.port as number) || 0,
 		rooms: (cfg?.rooms as string) || '',
 		username: (cfg?.username as string) || '',
 		password: (cfg?.password as string) || '',
+		accessToken: (cfg?.accessToken as string) || '',
+		encryption: (cfg?.encryption as boolean) ?? false,
 		disableTlsVerification: (cfg?.disableTlsVerification as boolean) ?? false,
+		tls: cfg?.tls as NotificationTLSConfig | undefined,
 		eventImageUpdate: events?.image_update ?? true,
 		eventContainerUpdate: events?.container_update ?? true,
 		eventVulnerabilityFound: events?.vulnerability_found ?? true,
@@ -487,12 +582,14 @@ export function genericSettingsToFormValues(settings?: NotificationSettings): Ge
 
 	return {
 		enabled: settings?.enabled ?? false,
+		role: cfg?.role === 'fallback' ? 'fallback' : 'primary',
 		webhookUrl: (cfg?.webhookUrl as string) || '',
 		method: (cfg?.method as string) || 'POST',
 		contentType: (cfg?.contentType as string) || 'application/json',
 		titleKey: (cfg?.titleKey as string) || 'title',
 		messageKey: (cfg?.messageKey as string) || 'message',
 		customHeaders: customHeadersStr,
+		tls: cfg?.tls as NotificationTLSConfig | undefined,
 		eventImageUpdate: events?.image_update ?? true,
 		eventContainerUpdate: events?.container_update ?? true,
 		eventVulnerabilityFound: events?.vulnerability_found ?? true,
@@ -521,6 +618,9 @@ export function ntfyFormValuesToSettings(values: NtfyFormValues): NotificationSe
 			cache: values.cache,
 			firebase: values.firebase,
 			disableTlsVerification: values.disableTlsVerification,
+			tls: values.tls,
+			eventSettings: parseEventSettingsText(values.eventSettings),
+			role: values.role,
 			events: {
 				image_update: values.eventImageUpdate,
 				container_update: values.eventContainerUpdate,
@@ -544,7 +644,10 @@ export function pushoverFormValuesToSettings(values: PushoverFormValues): Notifi
 				.map((device) => device.trim())
 				.filter((device) => device.length > 0),
 			priority: values.priority,
+			sound: values.sound.trim(),
 			title: values.title,
+			eventSettings: parseEventSettingsText(values.eventSettings),
+			role: values.role,
 			events: {
 				image_update: values.eventImageUpdate,
 				container_update: values.eventContainerUpdate,
@@ -568,6 +671,9 @@ export function gotifyFormValuesToSettings(values: GotifyFormValues): Notificati
 			priority: values.priority,
 			title: values.title,
 			disableTls: values.disableTls,
+			tls: values.tls,
+			eventSettings: parseEventSettingsText(values.eventSettings),
+			role: values.role,
 			events: {
 				image_update: values.eventImageUpdate,
 				container_update: values.eventContainerUpdate,
@@ -589,7 +695,11 @@ export function matrixFormValuesToSettings(values: MatrixFormValues): Notificati
 			rooms: values.rooms,
 			username: values.username,
 			password: values.password,
+			accessToken: values.accessToken,
+			encryption: values.encryption,
 			disableTlsVerification: values.disableTlsVerification,
+			tls: values.tls,
+			role: values.role,
 			events: {
 				image_update: values.eventImageUpdate,
 				container_update: values.eventContainerUpdate,
@@ -627,6 +737,8 @@ export function genericFormValuesToSettings(values: GenericFormValues): Notifica
 			titleKey: values.titleKey,
 			messageKey: values.messageKey,
 			customHeaders: customHeaders,
+			tls: values.tls,
+			role: values.role,
 			events: {
 				image_update: values.eventImageUpdate,
 				container_update: values.eventContainerUpdate,
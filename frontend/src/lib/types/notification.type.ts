@@ -11,12 +11,40 @@ export type NotificationProvider =
 	| 'generic';
 export type EmailTLSMode = 'none' | 'starttls' | 'ssl';
 
+// Fallback providers only receive a notification when a primary provider
+// failed to deliver it.
+export type NotificationProviderRole = 'primary' | 'fallback';
+
+export interface NotificationTLSConfig {
+	caCertificate?: string;
+	insecureSkipVerify?: boolean;
+	minVersion?: '1.2' | '1.3';
+}
+
 export interface NotificationSettings {
 	provider: NotificationProvider;
 	enabled: boolean;
 	config?: Record<string, any>;
 }
 
+export type PersonalNotificationProvider = 'email' | 'telegram';
+
+export interface NotificationSubscription {
+	id: string;
+	provider: PersonalNotificationProvider;
+	target: string;
+	events: string[];
+	enabled: boolean;
+	providerAvailable: boolean;
+	updatedAt?: string;
+}
+
+export interface NotificationSubscriptionUpdate {
+	target: string;
+	events: string[];
+	enabled: boolean;
+}
+
 export interface AppriseSettings {
 	id?: number;
 	apiUrl: string;
@@ -1,3 +1,5 @@
+import type { ContainerDeviceMapping, ContainerDeviceRequest } from './container.type';
+
 export interface NetworkSettings {
 	Networks: Record<
 		string,
@@ -51,6 +53,38 @@ export interface IncludeFile {
 	content: string;
 }
 
+export type CustomFileType = 'nginx.conf' | 'caddyfile' | 'prometheus.yml' | 'json' | 'yaml' | 'plain';
+
+export interface CreateCustomFileRequest {
+	relativePath: string;
+	type?: CustomFileType;
+	content?: string;
+}
+
+export interface CustomFileTemplate {
+	type: CustomFileType;
+	defaultName: string;
+	content: string;
+}
+
+export interface FileSyntaxIssue {
+	line: number;
+	column: number;
+	message: string;
+}
+
+export interface ProjectFileReference {
+	kind: 'include' | 'env_file' | 'volume' | 'config' | 'secret';
+	composeFile: string;
+	service?: string;
+	value: string;
+}
+
+export interface ProjectDeleteFileResult {
+	relativePath: string;
+	references?: ProjectFileReference[];
+}
+
 // RuntimeService contains live container status information
 export interface RuntimeService {
 	name: string;
@@ -62,6 +96,9 @@ export interface RuntimeService {
 	health?: string;
 	iconUrl?: string;
 	serviceConfig?: ProjectService;
+	runtime?: string;
+	devices?: ContainerDeviceMapping[];
+	deviceRequests?: ContainerDeviceRequest[];
 }
 
 export interface Project {
@@ -85,6 +122,97 @@ export interface Project {
 	composeContent?: string;
 	envContent?: string;
 	includeFiles?: IncludeFile[];
+	envWarnings?: string[];
+	lintFindings?: ProjectLintFinding[];
+	locked?: boolean;
+	lockReason?: string;
+	lockedBy?: string;
+	lockedAt?: string;
+	maintenance?: boolean;
+	maintenanceMessage?: string;
+	maintenanceStartedAt?: string;
+	tags?: Record<string, string>;
+}
+
+export type ProjectEnvVariableSource = 'project' | 'global' | 'process' | 'builtin';
+
+export interface ProjectEnvVariable {
+	name: string;
+	defined: boolean;
+	source?: ProjectEnvVariableSource;
+	hasDefault: boolean;
+	required: boolean;
+	files: Record<string, number[]>;
+}
+
+export interface ProjectEnvCheck {
+	variables: ProjectEnvVariable[];
+	missing: string[];
+	empty: string[];
+	unused: string[];
+}
+
+export interface ProjectCloneRequest {
+	name: string;
+	copyData?: boolean;
+}
+
+export interface ProjectCloneResult {
+	project: Project;
+	changes: string[];
+}
+
+export interface ProjectServicePortPatch {
+	target: number;
+	published: number;
+	protocol?: 'tcp' | 'udp';
+}
+
+export interface ProjectServicePatch {
+	image?: string;
+	tag?: string;
+	environment?: Record<string, string>;
+	replicas?: number;
+	ports?: ProjectServicePortPatch[];
+	redeploy?: boolean;
+}
+
+export interface ProjectServicePatchResult {
+	changes: string[];
+	redeployed: boolean;
+}
+
+export interface ProjectResourceQuota {
+	cpus?: number;
+	memoryBytes?: number;
+}
+
+export interface ProjectRegistries {
+	registryIds: string[];
+}
+
+export interface ProjectServiceAllocation {
+	service: string;
+	replicas: number;
+	cpus: number;
+	memoryBytes: number;
+	cpusInjected: boolean;
+	memoryInjected: boolean;
+}
+
+export interface ProjectQuotaStatus {
+	quota?: ProjectResourceQuota;
+	allocation: {
+		services: ProjectServiceAllocation[];
+		cpus: number;
+		memoryBytes: number;
+		warnings: string[];
+	};
+	usage: {
+		cpus: number;
+		memoryBytes: number;
+		containers: number;
+	};
 }
 
 export interface ProjectStatusCounts {
@@ -92,3 +220,10 @@ export interface ProjectStatusCounts {
 	stoppedProjects: number;
 	totalProjects: number;
 }
+
+export interface ProjectLintFinding {
+	rule: string;
+	severity: 'warning' | 'error';
+	service: string;
+	message: string;
+}
@@ -0,0 +1,33 @@
+export type SecurityAuditSeverity = 'low' | 'medium' | 'high' | 'critical';
+
+export interface SecurityAuditFinding {
+	check: string;
+	severity: SecurityAuditSeverity;
+	message: string;
+	remediation: string;
+}
+
+export interface SecurityAuditContainerReport {
+	id: string;
+	name: string;
+	image: string;
+	project?: string;
+	running: boolean;
+	score: number;
+	findings: SecurityAuditFinding[];
+}
+
+export interface SecurityAuditProjectReport {
+	name: string;
+	score: number;
+	containers: number;
+	findings: number;
+}
+
+export interface SecurityAuditReport {
+	generatedAt: string;
+	score: number;
+	severityCounts: Record<SecurityAuditSeverity, number>;
+	containers: SecurityAuditContainerReport[];
+	projects: SecurityAuditProjectReport[];
+}
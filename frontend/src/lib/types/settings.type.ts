@@ -6,10 +6,19 @@ export type Settings = {
 	autoUpdate: boolean;
 	autoUpdateInterval: number;
 	autoUpdateExcludedContainers?: string;
+	autoUpdateMonitorOnly?: string;
+	autoUpdateMinFreeDisk?: number;
+	autoUpdateRegistryCheck?: boolean;
+	autoUpdateMaxLoad?: number;
+	autoUpdateRollbackWindow?: number;
+	autoUpdateRolloutStages?: string;
+	imageRetentionEnabled?: boolean;
+	imageRetentionKeepCount?: number;
 	pollingEnabled: boolean;
 	pollingInterval: number;
 	environmentHealthInterval: number;
 	dockerPruneMode: 'all' | 'dangling';
+	pruneProtectionLabel?: string;
 	defaultDeployPullPolicy: 'missing' | 'always' | 'never';
 	scheduledPruneEnabled?: boolean;
 	scheduledPruneInterval?: number;
@@ -18,26 +27,41 @@ export type Settings = {
 	scheduledPruneVolumes?: boolean;
 	scheduledPruneNetworks?: boolean;
 	scheduledPruneBuildCache?: boolean;
+	staleResourcesEnabled?: boolean;
+	staleContainerDays?: number;
+	staleImageDays?: number;
 	vulnerabilityScanEnabled?: boolean;
 	vulnerabilityScanInterval?: number;
 	autoHealEnabled?: boolean;
 	autoHealExcludedContainers?: string;
 	autoHealMaxRestarts?: number;
 	autoHealRestartWindow?: number;
+	containerSnapshotRetention?: number;
+	containerCrashThreshold?: number;
+	containerCrashWindow?: number;
+	containerCrashCooldown?: number;
+	unmanagedChangeDetection?: boolean;
+	notificationActionLinks?: boolean;
+	notificationActionLinkHours?: number;
 	maxImageUploadSize: number;
 	baseServerUrl: string;
 	enableGravatar: boolean;
 	uiConfigDisabled: boolean;
 	defaultShell: string;
+	outboundProxyUrl?: string;
+	outboundNoProxy?: string;
 	dockerHost: string;
 	accentColor: string;
 	oledMode: boolean;
 	autoInjectEnv: boolean;
+	composeTemplateVariables?: string;
+	composeLintRules?: string;
 	backupVolumeName?: string;
 
 	authLocalEnabled: boolean;
 	authSessionTimeout: number;
 	authPasswordPolicy: 'basic' | 'standard' | 'strong';
+	corsAllowedOrigins?: string;
 	trivyImage: string;
 	trivyNetwork: string;
 	trivyResourceLimitsEnabled: boolean;
@@ -69,6 +93,10 @@ export type Settings = {
 	httpClientTimeout: number;
 	registryTimeout: number;
 	proxyRequestTimeout: number;
+	aggregateRequestTimeout: number;
+	proxyRequestRetries: number;
+	environmentBreakerThreshold: number;
+	environmentBreakerCooldown: number;
 	buildProvider: 'local' | 'depot';
 	buildsDirectory: string;
 	buildTimeout: number;
@@ -93,3 +121,22 @@ export interface OidcStatusInfo {
 	providerName: string;
 	providerLogoUrl: string;
 }
+
+export interface SettingsValidationIssue {
+	key: string;
+	severity: 'error' | 'warning';
+	message: string;
+}
+
+export interface SettingsChange {
+	key: string;
+	oldValue?: string;
+	newValue?: string;
+	effects?: string[];
+}
+
+export interface SettingsDryApplyResult {
+	valid: boolean;
+	issues?: SettingsValidationIssue[];
+	changes?: SettingsChange[];
+}
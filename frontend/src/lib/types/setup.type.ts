@@ -0,0 +1,38 @@
+import type { SettingsValidationIssue } from './settings.type';
+
+export type SetupStepId = 'admin' | 'projectsDirectory' | 'docker' | 'notification' | 'environment';
+
+export type SetupStepStatus = 'pending' | 'completed' | 'skipped';
+
+export type SetupStep = {
+	id: SetupStepId;
+	optional: boolean;
+	status: SetupStepStatus;
+};
+
+export type SetupStatus = {
+	required: boolean;
+	currentStep?: SetupStepId;
+	steps: SetupStep[];
+};
+
+export type SetupAdmin = {
+	username: string;
+	password: string;
+	displayName?: string;
+	email?: string;
+};
+
+export type SetupProjectsDirectoryResult = {
+	projectsDirectory: string;
+	warnings?: SettingsValidationIssue[];
+};
+
+export type SetupDockerCheck = {
+	connected: boolean;
+	host: string;
+	serverVersion?: string;
+	apiVersion?: string;
+	operatingSystem?: string;
+	error?: string;
+};
@@ -0,0 +1,31 @@
+export type StaleResourceKind = 'container' | 'volume' | 'network' | 'image';
+
+export interface StaleResourceItem {
+	kind: StaleResourceKind;
+	id: string;
+	name: string;
+	reason: string;
+	since?: string;
+	sizeBytes?: number;
+}
+
+export interface StaleResourceReport {
+	generatedAt: string;
+	containerDays: number;
+	imageDays: number;
+	items: StaleResourceItem[];
+}
+
+export interface StaleResourceRef {
+	kind: StaleResourceKind;
+	id: string;
+}
+
+export interface StaleResourceCleanupFailure extends StaleResourceRef {
+	error: string;
+}
+
+export interface StaleResourceCleanupResult {
+	removed: StaleResourceRef[];
+	failed: StaleResourceCleanupFailure[];
+}
@@ -0,0 +1,32 @@
+export type TagResourceType = 'project' | 'container' | 'environment';
+
+export type ResourceTags = Record<string, string>;
+
+export interface TagKeySummary {
+	key: string;
+	values: string[];
+	resources: number;
+}
+
+export interface SavedFilter {
+	id: string;
+	name: string;
+	resourceType: TagResourceType;
+	search?: string;
+	filters: Record<string, string>;
+	createdAt: string;
+	updatedAt?: string;
+}
+
+export interface SavedFilterCreate {
+	name: string;
+	resourceType: TagResourceType;
+	search?: string;
+	filters?: Record<string, string>;
+}
+
+export interface SavedFilterUpdate {
+	name?: string;
+	search?: string;
+	filters?: Record<string, string>;
+}
@@ -0,0 +1,25 @@
+export type TopologyNodeType = 'container' | 'network' | 'volume';
+
+export type TopologyEdgeType = 'depends_on' | 'link' | 'network_mode' | 'network' | 'volume';
+
+export interface TopologyNode {
+	id: string;
+	type: TopologyNodeType;
+	label: string;
+	resourceId: string;
+	state?: string;
+	image?: string;
+	project?: string;
+	service?: string;
+}
+
+export interface TopologyEdge {
+	source: string;
+	target: string;
+	type: TopologyEdgeType;
+}
+
+export interface TopologyGraph {
+	nodes: TopologyNode[];
+	edges: TopologyEdge[];
+}
@@ -0,0 +1,13 @@
+export const CSRF_COOKIE_NAME = 'csrf_token';
+export const CSRF_HEADER_NAME = 'X-CSRF-Token';
+export const CSRF_ERROR_CODE = 'CSRF_INVALID';
+
+// State-changing requests made with the session cookie must echo the CSRF
+// cookie in a header. Axios does this on its own; raw fetch calls spread
+// csrfHeaders() into their headers.
+export function csrfHeaders(): Record<string, string> {
+	if (typeof document === 'undefined') return {};
+	const prefix = `${CSRF_COOKIE_NAME}=`;
+	const entry = document.cookie.split('; ').find((cookie) => cookie.startsWith(prefix));
+	return entry ? { [CSRF_HEADER_NAME]: decodeURIComponent(entry.slice(prefix.length)) } : {};
+}
@@ -1,4 +1,4 @@
-import type { Environment, EnvironmentStatus } from '$lib/types/environment.type';
+import type { AgentFeature, Environment, EnvironmentStatus } from '$lib/types/environment.type';
 
 type RuntimeEnvironmentState = Pick<Environment, 'isEdge' | 'connected' | 'status'>;
 
@@ -41,3 +41,11 @@ export function getEnvironmentStatusVariant(status: EnvironmentStatus): 'green'
 			return 'red';
 	}
 }
+
+// Environments whose agent capabilities are not known yet are assumed to support everything.
+export function environmentSupports(environment: Pick<Environment, 'capabilities'>, feature: AgentFeature): boolean {
+	if (!environment.capabilities) {
+		return true;
+	}
+	return environment.capabilities.features.includes(feature);
+}
@@ -17,6 +17,7 @@ const LOCAL_SETTING_KEYS = new Set([
 	'authLocalEnabled',
 	'authSessionTimeout',
 	'authPasswordPolicy',
+	'corsAllowedOrigins',
 	'authOidcConfig',
 	'oidcEnabled',
 	'oidcMergeAccounts',
@@ -1,4 +1,5 @@
 import type { SystemStats } from '$lib/types/system-stats.type';
+import type { Event as ActivityEvent } from '$lib/types/event.type';
 
 export interface ReconnectWSOptions<T> {
 	buildUrl: () => string | Promise<string>;
@@ -255,3 +256,39 @@ export function createContainerStatsWebSocket(opts: {
 		shouldReconnect: opts.shouldReconnect
 	});
 }
+
+export interface EventsWebSocketFilter {
+	types?: string[];
+	severities?: string[];
+	environmentId?: string;
+}
+
+export function createEventsWebSocket(opts: {
+	getFilter?: () => EventsWebSocketFilter;
+	onMessage: (event: ActivityEvent) => void;
+	onOpen?: () => void;
+	onClose?: () => void;
+	onError?: (err: Event | Error) => void;
+	maxBackoff?: number;
+}) {
+	const buildUrl = () => {
+		const filter = opts.getFilter?.() ?? {};
+		const params = new URLSearchParams();
+		if (filter.types?.length) params.set('type', filter.types.join(','));
+		if (filter.severities?.length) params.set('severity', filter.severities.join(','));
+		if (filter.environmentId) params.set('environmentId', filter.environmentId);
+		const query = params.toString();
+		const protocol = location.protocol === 'https:' ? 'wss' : 'ws';
+		return `${protocol}://${location.host}/api/events/ws${query ? `?${query}` : ''}`;
+	};
+
+	return new ReconnectingWebSocket<ActivityEvent>({
+		buildUrl,
+		parseMessage: (evt) => JSON.parse(evt.data as string) as ActivityEvent,
+		onMessage: opts.onMessage,
+		onOpen: opts.onOpen,
+		onClose: opts.onClose,
+		onError: opts.onError,
+		maxBackoff: opts.maxBackoff
+	});
+}
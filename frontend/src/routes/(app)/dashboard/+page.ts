@@ -9,6 +9,8 @@ import type { SearchPaginationSortRequest } from '$lib/types/pagination.type';
 import { throwPageLoadError } from '$lib/utils/page-load-error.util';
 import type { PageLoad } from './$types';
 
+const hostMetricsHours = 24;
+
 export const load: PageLoad = async ({ parent, url }) => {
 	const { queryClient } = await parent();
 	const envId = await environmentStore.getCurrentEnvironmentId();
@@ -57,7 +59,7 @@ export const load: PageLoad = async ({ parent, url }) => {
 		throwPageLoadError(err, 'Failed to load dashboard data');
 	}
 
-	const [dockerInfoResult, settingsResult, imageUsageCountsResult, dashboardActionItemsResult] = await Promise.allSettled([
+	const [dockerInfoResult, settingsResult, imageUsageCountsResult, dashboardActionItemsResult, hostMetricsResult] = await Promise.allSettled([
 		queryClient.fetchQuery({
 			queryKey: queryKeys.system.dockerInfo(envId),
 			queryFn: () => systemService.getDockerInfoForEnvironment(envId)
@@ -73,6 +75,10 @@ export const load: PageLoad = async ({ parent, url }) => {
 		queryClient.fetchQuery({
 			queryKey: queryKeys.dashboard.actionItems(envId, debugAllGood),
 			queryFn: () => dashboardService.getActionItemsForEnvironment(envId, { debugAllGood })
+		}),
+		queryClient.fetchQuery({
+			queryKey: queryKeys.system.hostMetricsHistory(envId, hostMetricsHours),
+			queryFn: () => systemService.getHostMetricsHistoryForEnvironment(envId, hostMetricsHours)
 		})
 	]);
 
@@ -80,6 +86,7 @@ export const load: PageLoad = async ({ parent, url }) => {
 	const settings = settingsResult.status === 'fulfilled' ? settingsResult.value : null;
 	const imageUsageCounts = imageUsageCountsResult.status === 'fulfilled' ? imageUsageCountsResult.value : null;
 	const dashboardActionItems = dashboardActionItemsResult.status === 'fulfilled' ? dashboardActionItemsResult.value : null;
+	const hostMetrics = hostMetricsResult.status === 'fulfilled' ? hostMetricsResult.value : [];
 
 	return {
 		dockerInfo,
@@ -88,6 +95,7 @@ export const load: PageLoad = async ({ parent, url }) => {
 		settings,
 		imageUsageCounts,
 		dashboardActionItems,
+		hostMetrics,
 		debugAllGood,
 		containerRequestOptions,
 		imageRequestOptions,
@@ -2,6 +2,7 @@ import { redirect } from '@sveltejs/kit';
 import type { PageLoad } from './$types';
 import { authService } from '$lib/services/auth-service';
 import { queryKeys } from '$lib/query/query-keys';
+import { csrfHeaders } from '$lib/utils/csrf.util';
 
 export const load: PageLoad = async ({ fetch, parent }) => {
 	const { queryClient } = await parent();
@@ -9,7 +10,8 @@ export const load: PageLoad = async ({ fetch, parent }) => {
 	try {
 		await fetch('/api/auth/logout', {
 			method: 'POST',
-			credentials: 'include'
+			credentials: 'include',
+			headers: csrfHeaders()
 		});
 	} catch (error) {
 		console.error('Logout error:', error);
@@ -0,0 +1,16 @@
+import type { PageLoad } from './$types';
+import { notificationActionService } from '$lib/services/notification-action-service';
+import { tryCatch } from '$lib/utils/try-catch';
+import { extractApiErrorMessage } from '$lib/utils/api.util';
+
+export const load: PageLoad = async ({ params, parent }) => {
+	const data = await parent();
+	const result = await tryCatch(notificationActionService.describe(params.token));
+
+	return {
+		token: params.token,
+		action: result.data,
+		error: result.error ? extractApiErrorMessage(result.error) : null,
+		settings: data.settings
+	};
+};
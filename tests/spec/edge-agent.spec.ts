@@ -1,4 +1,5 @@
 import { test, expect, type Page } from "@playwright/test";
+import { csrfHeaders } from "../utils/playwright.util";
 
 const ROUTES = {
   environments: "/environments",
@@ -133,7 +134,9 @@ test.describe("Edge Agent Environment", () => {
       await expect(page.getByText("Tunnel", { exact: true })).toBeVisible();
     } finally {
       if (createdEnvironmentId) {
-        await page.request.delete(`/api/environments/${createdEnvironmentId}`);
+        await page.request.delete(`/api/environments/${createdEnvironmentId}`, {
+          headers: await csrfHeaders(page),
+        });
       }
     }
   });
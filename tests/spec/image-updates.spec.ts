@@ -1,5 +1,6 @@
 import { test, expect, type Page } from "@playwright/test";
 import { fetchImagesWithRetry } from "../utils/fetch.util";
+import { csrfHeaders } from "../utils/playwright.util";
 
 const ROUTES = {
   page: "/images",
@@ -228,6 +229,7 @@ test.describe("Image Update API Endpoints", () => {
     const imageRefs = ["nginx:latest", "alpine:latest"];
 
     const res = await page.request.post(ROUTES.apiImageUpdatesCheckBatch, {
+      headers: await csrfHeaders(page),
       data: {
         imageRefs,
       },
@@ -243,6 +245,7 @@ test.describe("Image Update API Endpoints", () => {
 
   test("should check all images for updates via API", async ({ page }) => {
     const res = await page.request.post(ROUTES.apiImageUpdatesCheckAll, {
+      headers: await csrfHeaders(page),
       data: {},
     });
 
@@ -310,6 +313,7 @@ test.describe("Image Update UI Integration", () => {
 test.describe("Batch Update Checks", () => {
   test("should handle empty batch request", async ({ page }) => {
     const res = await page.request.post(ROUTES.apiImageUpdatesCheckBatch, {
+      headers: await csrfHeaders(page),
       data: {
         imageRefs: [],
       },
@@ -326,6 +330,7 @@ test.describe("Batch Update Checks", () => {
     const imageRefs = ["nginx:latest", "alpine:latest", "busybox:latest"];
 
     const res = await page.request.post(ROUTES.apiImageUpdatesCheckBatch, {
+      headers: await csrfHeaders(page),
       data: {
         imageRefs,
       },
@@ -346,6 +351,7 @@ test.describe("Batch Update Checks", () => {
     const imageRefs = ["nginx:latest", "invalid-registry.example.com/nonexistent:latest"];
 
     const res = await page.request.post(ROUTES.apiImageUpdatesCheckBatch, {
+      headers: await csrfHeaders(page),
       data: {
         imageRefs,
       },
@@ -1,5 +1,14 @@
+import type { Page } from '@playwright/test';
 import playwrightConfig from '../playwright.config';
 
+// csrfHeaders echoes the CSRF cookie so state-changing API calls made with the
+// logged-in session are accepted.
+export async function csrfHeaders(page: Page): Promise<Record<string, string>> {
+  const cookies = await page.context().cookies();
+  const token = cookies.find((cookie) => cookie.name === 'csrf_token')?.value;
+  return token ? { 'X-CSRF-Token': token } : {};
+}
+
 export async function createTestApiKeys(count: number = 2) {
   const url = new URL('/api/playwright/create-test-api-keys', playwrightConfig.use!.baseURL);
 
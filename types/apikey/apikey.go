@@ -7,6 +7,7 @@ type CreateApiKey struct {
 	Name        string     `json:"name" minLength:"1" maxLength:"255" doc:"Name of the API key" example:"My API Key"`
 	Description *string    `json:"description,omitempty" maxLength:"1000" doc:"Optional description of the API key"`
 	ExpiresAt   *time.Time `json:"expiresAt,omitempty" doc:"Optional expiration date for the API key"`
+	Scopes      []string   `json:"scopes,omitempty" doc:"Scopes granted to the API key (read, projects:deploy, environments:manage or *). Empty means full access"`
 }
 
 // ApiKey represents an API key without the secret.
@@ -17,6 +18,7 @@ type ApiKey struct {
 	KeyPrefix   string     `json:"keyPrefix" doc:"Prefix of the API key for identification"`
 	UserID      string     `json:"userId" doc:"ID of the user who owns the API key"`
 	ExpiresAt   *time.Time `json:"expiresAt,omitempty" doc:"Expiration date of the API key"`
+	Scopes      []string   `json:"scopes" doc:"Scopes granted to the API key. Empty means full access"`
 	LastUsedAt  *time.Time `json:"lastUsedAt,omitempty" doc:"Last time the API key was used"`
 	CreatedAt   time.Time  `json:"createdAt" doc:"Creation timestamp"`
 	UpdatedAt   *time.Time `json:"updatedAt,omitempty" doc:"Last update timestamp"`
@@ -33,4 +35,5 @@ type UpdateApiKey struct {
 	Name        *string    `json:"name,omitempty" maxLength:"255" doc:"New name for the API key"`
 	Description *string    `json:"description,omitempty" maxLength:"1000" doc:"New description for the API key"`
 	ExpiresAt   *time.Time `json:"expiresAt,omitempty" doc:"New expiration date for the API key"`
+	Scopes      *[]string  `json:"scopes,omitempty" doc:"New scopes for the API key. An empty list restores full access"`
 }
@@ -0,0 +1,69 @@
+package apikey
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+)
+
+const (
+	// ScopeAll grants full access, matching the behaviour of keys created before scopes existed.
+	ScopeAll = "*"
+	// ScopeRead allows read-only (GET/HEAD) requests.
+	ScopeRead = "read"
+	// ScopeProjectsDeploy allows deploying, redeploying and stopping projects.
+	ScopeProjectsDeploy = "projects:deploy"
+	// ScopeEnvironmentsManage allows creating, updating and removing environments.
+	ScopeEnvironmentsManage = "environments:manage"
+)
+
+// Scopes lists every scope that can be assigned to an API key.
+var Scopes = []string{ScopeAll, ScopeRead, ScopeProjectsDeploy, ScopeEnvironmentsManage}
+
+// IsValidScope reports whether scope is a known API key scope.
+func IsValidScope(scope string) bool {
+	return slices.Contains(Scopes, scope)
+}
+
+// RequiredScope returns the scope an API key needs to perform the request.
+// The path may be a route template or a concrete path, with or without the
+// "/api" prefix. Reads need ScopeRead, project mutations need
+// ScopeProjectsDeploy, environment mutations need ScopeEnvironmentsManage and
+// everything else (including terminals) needs ScopeAll.
+func RequiredScope(method, path string) string {
+	path = strings.TrimPrefix(path, "/api")
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	if slices.Contains(segments, "terminal") || slices.Contains(segments, "exec") {
+		return ScopeAll
+	}
+
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return ScopeRead
+	}
+
+	if len(segments) == 0 || segments[0] != "environments" {
+		return ScopeAll
+	}
+	if len(segments) >= 3 && segments[2] == "projects" {
+		return ScopeProjectsDeploy
+	}
+	if len(segments) <= 2 {
+		return ScopeEnvironmentsManage
+	}
+	return ScopeAll
+}
+
+// ScopesAllow reports whether a key holding the given scopes may use the
+// required scope. Keys without scopes are treated as full-access keys.
+func ScopesAllow(scopes []string, required string) bool {
+	if len(scopes) == 0 || slices.Contains(scopes, ScopeAll) {
+		return true
+	}
+	if required == ScopeRead {
+		// Any scoped key can read; write scopes imply read access.
+		return true
+	}
+	return slices.Contains(scopes, required)
+}
@@ -0,0 +1,87 @@
+package backup
+
+import "time"
+
+const (
+	// DestinationLocal writes scheduled backups to a directory on the host.
+	DestinationLocal = "local"
+	// DestinationS3 uploads scheduled backups to an S3-compatible bucket.
+	DestinationS3 = "s3"
+)
+
+// ExportRequest is the request body used to export the Arcane configuration.
+type ExportRequest struct {
+	// Passphrase used to encrypt the archive. It is required again to import it.
+	//
+	// Required: true
+	Passphrase string `json:"passphrase" minLength:"8"`
+}
+
+// ImportRequest is the request body used to restore an exported archive.
+type ImportRequest struct {
+	// Archive is the encrypted archive produced by an export.
+	//
+	// Required: true
+	Archive []byte `json:"archive"`
+
+	// Passphrase the archive was encrypted with.
+	//
+	// Required: true
+	Passphrase string `json:"passphrase"`
+}
+
+// Manifest describes the contents of a configuration archive.
+type Manifest struct {
+	// Version of the archive payload format.
+	//
+	// Required: true
+	Version int `json:"version"`
+
+	// ArcaneVersion is the version of Arcane that produced the archive.
+	//
+	// Required: true
+	ArcaneVersion string `json:"arcaneVersion"`
+
+	// CreatedAt is when the archive was produced.
+	//
+	// Required: true
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Settings is the number of settings in the archive.
+	//
+	// Required: true
+	Settings int `json:"settings"`
+
+	// NotificationProviders is the number of notification providers in the archive.
+	//
+	// Required: true
+	NotificationProviders int `json:"notificationProviders"`
+
+	// Environments is the number of remote environments in the archive.
+	//
+	// Required: true
+	Environments int `json:"environments"`
+
+	// Registries is the number of container registries in the archive.
+	//
+	// Required: true
+	Registries int `json:"registries"`
+
+	// Projects is the number of projects in the archive.
+	//
+	// Required: true
+	Projects int `json:"projects"`
+}
+
+// ImportResult summarizes what was restored from an archive.
+type ImportResult struct {
+	// Manifest of the imported archive.
+	//
+	// Required: true
+	Manifest Manifest `json:"manifest"`
+
+	// Warnings lists items that were skipped or only partially restored.
+	//
+	// Required: false
+	Warnings []string `json:"warnings,omitempty"`
+}
@@ -0,0 +1,54 @@
+package cacertificate
+
+import "time"
+
+// CACertificate describes a custom CA certificate trusted for outbound TLS.
+type CACertificate struct {
+	// ID of the certificate.
+	//
+	// Required: true
+	ID string `json:"id"`
+
+	// Name given to the certificate when it was uploaded.
+	//
+	// Required: true
+	Name string `json:"name"`
+
+	// Certificate is the PEM encoded certificate.
+	//
+	// Required: true
+	Certificate string `json:"certificate"`
+
+	// Subject is the certificate subject distinguished name.
+	//
+	// Required: true
+	Subject string `json:"subject"`
+
+	// Fingerprint is the SHA-256 fingerprint of the certificate, hex encoded.
+	//
+	// Required: true
+	Fingerprint string `json:"fingerprint"`
+
+	// NotAfter is the date and time at which the certificate expires.
+	//
+	// Required: true
+	NotAfter time.Time `json:"notAfter"`
+
+	// CreatedAt is the date and time at which the certificate was uploaded.
+	//
+	// Required: true
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// CreateRequest is the request body for uploading a CA certificate.
+type CreateRequest struct {
+	// Name identifies the certificate in the list.
+	//
+	// Required: true
+	Name string `json:"name" minLength:"1" maxLength:"128"`
+
+	// Certificate is a single PEM encoded certificate.
+	//
+	// Required: true
+	Certificate string `json:"certificate" minLength:"1"`
+}
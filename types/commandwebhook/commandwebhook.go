@@ -0,0 +1,80 @@
+package commandwebhook
+
+import (
+	"slices"
+	"time"
+)
+
+const (
+	// ActionProjectRedeploy pulls and redeploys the project named by the target.
+	ActionProjectRedeploy = "project.redeploy"
+	// ActionContainerUpdate updates the container named by the target to its
+	// pending image update.
+	ActionContainerUpdate = "container.update"
+	// ActionUpdaterApply applies every pending image update.
+	ActionUpdaterApply = "updater.apply"
+	// ActionSystemPrune prunes the resource types selected for scheduled prunes.
+	ActionSystemPrune = "system.prune"
+)
+
+// Actions lists every action a webhook can be allowed to run.
+var Actions = []string{ActionProjectRedeploy, ActionContainerUpdate, ActionUpdaterApply, ActionSystemPrune}
+
+// IsValidAction reports whether action is a known webhook action.
+func IsValidAction(action string) bool {
+	return slices.Contains(Actions, action)
+}
+
+// ActionNeedsTarget reports whether action acts on a named project or container.
+func ActionNeedsTarget(action string) bool {
+	return action == ActionProjectRedeploy || action == ActionContainerUpdate
+}
+
+// Webhook is an inbound endpoint that external systems call to run actions.
+type Webhook struct {
+	ID          string     `json:"id" doc:"Unique identifier of the webhook"`
+	Name        string     `json:"name" doc:"Display name of the webhook"`
+	TokenPrefix string     `json:"tokenPrefix" doc:"First characters of the token, to tell webhooks apart"`
+	Actions     []string   `json:"actions" doc:"Actions the webhook may run"`
+	Enabled     bool       `json:"enabled" doc:"Whether the webhook accepts requests"`
+	LastUsedAt  *time.Time `json:"lastUsedAt,omitempty" doc:"Time of the last accepted request"`
+	CreatedAt   time.Time  `json:"createdAt" doc:"Creation timestamp"`
+	UpdatedAt   *time.Time `json:"updatedAt,omitempty" doc:"Last update timestamp"`
+}
+
+// WebhookCreated is a webhook together with its token, which is only
+// returned when the webhook is created.
+type WebhookCreated struct {
+	Webhook
+	Token string `json:"token" doc:"Secret token; it is part of the trigger URL and cannot be retrieved again"`
+}
+
+// CreateWebhook is the request body for creating a webhook.
+type CreateWebhook struct {
+	Name    string   `json:"name" minLength:"1" maxLength:"255" doc:"Display name of the webhook"`
+	Actions []string `json:"actions" minItems:"1" doc:"Actions the webhook may run: project.redeploy, container.update, updater.apply or system.prune"`
+	Enabled *bool    `json:"enabled,omitempty" doc:"Whether the webhook accepts requests (default true)"`
+}
+
+// UpdateWebhook is the request body for updating a webhook. Omitted fields are left unchanged.
+type UpdateWebhook struct {
+	Name    *string  `json:"name,omitempty" maxLength:"255" doc:"Display name of the webhook"`
+	Actions []string `json:"actions,omitempty" doc:"Actions the webhook may run"`
+	Enabled *bool    `json:"enabled,omitempty" doc:"Whether the webhook accepts requests"`
+}
+
+// Command is an action requested through a webhook.
+type Command struct {
+	Action string `json:"action" doc:"Action to run"`
+	Target string `json:"target,omitempty" doc:"Project or container the action applies to"`
+}
+
+// TriggerResult reports that a command was accepted. Text and ResponseType
+// follow Slack's slash command response format so the reply shows up in the
+// channel the command was sent from.
+type TriggerResult struct {
+	Action       string `json:"action" doc:"Action that was started"`
+	Target       string `json:"target,omitempty" doc:"Project or container the action applies to"`
+	Text         string `json:"text" doc:"Human-readable summary of the accepted command"`
+	ResponseType string `json:"response_type" doc:"Slack response type"`
+}
@@ -0,0 +1,131 @@
+package container
+
+import (
+	dockercontainer "github.com/moby/moby/api/types/container"
+	"github.com/moby/moby/api/types/network"
+)
+
+// CloneRequest recreates a standalone container on another environment.
+type CloneRequest struct {
+	// SourceEnvironmentID is the environment that runs the container. It
+	// must be the local environment or an SSH environment. Defaults to the
+	// local environment.
+	//
+	// Required: false
+	SourceEnvironmentID string `json:"sourceEnvironmentId,omitempty"`
+
+	// ContainerID is the ID or name of the container to clone.
+	//
+	// Required: true
+	ContainerID string `json:"containerId" minLength:"1"`
+
+	// TargetEnvironmentID is the environment to create the copy on.
+	//
+	// Required: true
+	TargetEnvironmentID string `json:"targetEnvironmentId" minLength:"1"`
+
+	// Name is the name of the copy. Defaults to the container's name.
+	//
+	// Required: false
+	Name string `json:"name,omitempty"`
+
+	// CopyVolumes copies the contents of the container's named volumes.
+	// Without it the volumes are created empty. Stop the container first for
+	// a consistent copy.
+	//
+	// Required: false
+	CopyVolumes bool `json:"copyVolumes,omitempty"`
+
+	// Start starts the copy once it is created.
+	//
+	// Required: false
+	Start bool `json:"start,omitempty"`
+}
+
+// ClonedContainer is the container created by a clone.
+type ClonedContainer struct {
+	// EnvironmentID is the environment the copy was created on.
+	//
+	// Required: true
+	EnvironmentID string `json:"environmentId"`
+
+	// EnvironmentName is the name of that environment.
+	//
+	// Required: true
+	EnvironmentName string `json:"environmentName"`
+
+	// ContainerID is the ID of the copy.
+	//
+	// Required: true
+	ContainerID string `json:"containerId"`
+
+	// ContainerName is the name of the copy.
+	//
+	// Required: true
+	ContainerName string `json:"containerName"`
+
+	// Image is the image the copy runs.
+	//
+	// Required: true
+	Image string `json:"image"`
+
+	// Volumes are the named volumes created on the target.
+	//
+	// Required: true
+	Volumes []string `json:"volumes"`
+
+	// Warnings describe settings that could not be carried over, such as
+	// bind mounts of host paths.
+	//
+	// Required: true
+	Warnings []string `json:"warnings"`
+}
+
+// ImportRequest creates a container from the full Docker configuration of a
+// container read on another host. The manager sends it to agents when it
+// clones a container to them.
+type ImportRequest struct {
+	// Name is the name of the container.
+	//
+	// Required: true
+	Name string `json:"name" minLength:"1"`
+
+	// Config is the container configuration.
+	//
+	// Required: true
+	Config *dockercontainer.Config `json:"config"`
+
+	// HostConfig is the host configuration.
+	//
+	// Required: true
+	HostConfig *dockercontainer.HostConfig `json:"hostConfig"`
+
+	// NetworkingConfig holds the networks to connect to. Networks that do not
+	// exist are created with the bridge driver.
+	//
+	// Required: false
+	NetworkingConfig *network.NetworkingConfig `json:"networkingConfig,omitempty"`
+
+	// Start starts the container once it is created.
+	//
+	// Required: false
+	Start bool `json:"start,omitempty"`
+}
+
+// ImportedContainer is the container created by an import.
+type ImportedContainer struct {
+	// ContainerID is the ID of the container.
+	//
+	// Required: true
+	ContainerID string `json:"containerId"`
+
+	// ContainerName is the name of the container.
+	//
+	// Required: true
+	ContainerName string `json:"containerName"`
+
+	// Warnings describe problems that did not stop the import.
+	//
+	// Required: true
+	Warnings []string `json:"warnings"`
+}
@@ -37,6 +37,35 @@ type PortBindingCreate struct {
 	HostPort string `json:"hostPort,omitempty"`
 }
 
+// HealthcheckCreate represents the healthcheck of a container being created.
+type HealthcheckCreate struct {
+	// Test is the check to run, such as ["CMD-SHELL", "curl -f http://localhost/"],
+	// or ["NONE"] to disable the image's healthcheck.
+	//
+	// Required: true
+	Test []string `json:"test"`
+
+	// Interval is the time between checks, as a Go duration such as 30s.
+	//
+	// Required: false
+	Interval string `json:"interval,omitempty"`
+
+	// Timeout is how long a check may run, as a Go duration.
+	//
+	// Required: false
+	Timeout string `json:"timeout,omitempty"`
+
+	// StartPeriod is the grace period after start during which failures don't count.
+	//
+	// Required: false
+	StartPeriod string `json:"startPeriod,omitempty"`
+
+	// Retries is the number of consecutive failures before the container is unhealthy.
+	//
+	// Required: false
+	Retries int `json:"retries,omitempty"`
+}
+
 // HostConfigCreate represents host configuration for container creation.
 type HostConfigCreate struct {
 	// Binds is a list of volume bindings.
@@ -268,12 +297,54 @@ type Create struct {
 	// Required: false
 	CPUs float64 `json:"cpus,omitempty"`
 
+	// Healthcheck overrides the image's healthcheck.
+	//
+	// Required: false
+	Healthcheck *HealthcheckCreate `json:"healthcheck,omitempty"`
+
 	// Credentials for pulling images from private registries.
 	//
 	// Required: false
 	Credentials []containerregistry.Credential `json:"credentials,omitempty"`
 }
 
+// CreateIssue is a problem found while validating a container create request.
+type CreateIssue struct {
+	// Field is the JSON path of the offending field, such as hostConfig.portBindings.
+	//
+	// Required: true
+	Field string `json:"field"`
+
+	// Message describes the problem.
+	//
+	// Required: true
+	Message string `json:"message"`
+}
+
+// CreatePreview is the outcome of validating a container create request
+// without creating the container.
+type CreatePreview struct {
+	// Valid reports whether the request has no errors.
+	//
+	// Required: true
+	Valid bool `json:"valid"`
+
+	// Errors are the problems that would make the create fail.
+	//
+	// Required: false
+	Errors []CreateIssue `json:"errors,omitempty"`
+
+	// Warnings are settings that work but are likely mistakes or risky.
+	//
+	// Required: false
+	Warnings []CreateIssue `json:"warnings,omitempty"`
+
+	// DockerRun is the docker run command equivalent to the request.
+	//
+	// Required: true
+	DockerRun string `json:"dockerRun"`
+}
+
 // StatusCounts contains counts of containers by status.
 type StatusCounts struct {
 	// RunningContainers is the number of running containers.
@@ -559,6 +630,65 @@ type HostConfig struct {
 	//
 	// Required: false
 	Memory int64 `json:"memory,omitempty"`
+
+	// Runtime is the OCI runtime the container runs with, e.g. "nvidia".
+	//
+	// Required: false
+	Runtime string `json:"runtime,omitempty"`
+
+	// Devices are the host devices mapped into the container.
+	//
+	// Required: false
+	Devices []DeviceMapping `json:"devices,omitempty"`
+
+	// DeviceRequests are the devices, such as GPUs, requested from device
+	// drivers.
+	//
+	// Required: false
+	DeviceRequests []DeviceRequest `json:"deviceRequests,omitempty"`
+}
+
+// DeviceMapping is a host device made available inside a container.
+type DeviceMapping struct {
+	// PathOnHost is the path of the device on the Docker host.
+	//
+	// Required: true
+	PathOnHost string `json:"pathOnHost"`
+
+	// PathInContainer is the path of the device inside the container.
+	//
+	// Required: false
+	PathInContainer string `json:"pathInContainer,omitempty"`
+
+	// CgroupPermissions are the cgroup permissions of the device, e.g. "rwm".
+	//
+	// Required: false
+	CgroupPermissions string `json:"cgroupPermissions,omitempty"`
+}
+
+// DeviceRequest is a request for devices, such as GPUs, from a device driver.
+type DeviceRequest struct {
+	// Driver is the device driver, e.g. "nvidia" or "cdi". Empty lets Docker
+	// pick a driver by capabilities.
+	//
+	// Required: false
+	Driver string `json:"driver,omitempty"`
+
+	// Count is the number of devices requested; -1 requests all devices.
+	//
+	// Required: false
+	Count int `json:"count,omitempty"`
+
+	// DeviceIDs are the devices requested by ID.
+	//
+	// Required: false
+	DeviceIDs []string `json:"deviceIds,omitempty"`
+
+	// Capabilities is an OR list of AND lists of device capabilities, e.g.
+	// [["gpu"]].
+	//
+	// Required: false
+	Capabilities [][]string `json:"capabilities,omitempty"`
 }
 
 // Summary represents a container summary.
@@ -632,6 +762,11 @@ type Summary struct {
 	//
 	// Required: false
 	UpdateInfo *imagetypes.UpdateInfo `json:"updateInfo,omitempty"`
+
+	// Tags are the user-defined tags Arcane stores for the container.
+	//
+	// Required: false
+	Tags map[string]string `json:"tags,omitempty"`
 }
 
 // Details represents detailed container information.
@@ -725,6 +860,48 @@ type Created struct {
 	Created string `json:"created"`
 }
 
+// LogLine is a single line of container output.
+type LogLine struct {
+	// Timestamp is when the container wrote the line, in RFC 3339 format.
+	//
+	// Required: false
+	Timestamp string `json:"timestamp,omitempty"`
+
+	// Stream is the output stream the line was written to (stdout or stderr).
+	//
+	// Required: true
+	Stream string `json:"stream"`
+
+	// Message is the line content without the timestamp.
+	//
+	// Required: true
+	Message string `json:"message"`
+}
+
+// LogSearchResult is the result of searching a container's logs.
+type LogSearchResult struct {
+	// Lines are the matching lines, oldest first. When more lines match than
+	// the limit, only the most recent ones are returned.
+	//
+	// Required: true
+	Lines []LogLine `json:"lines"`
+
+	// Matched is the number of lines that matched the filter.
+	//
+	// Required: true
+	Matched int `json:"matched"`
+
+	// Scanned is the number of lines read in the requested time range.
+	//
+	// Required: true
+	Scanned int `json:"scanned"`
+
+	// Truncated indicates that older matches were dropped to respect the limit.
+	//
+	// Required: true
+	Truncated bool `json:"truncated"`
+}
+
 // NewSummary creates a Summary from a docker container.Summary.
 func NewSummary(c container.Summary) Summary {
 	names := make([]string, 0, len(c.Names))
@@ -841,6 +1018,22 @@ func NewDetails(c *container.InspectResponse) Details {
 			AutoRemove:    c.HostConfig.AutoRemove,
 			NanoCPUs:      c.HostConfig.NanoCPUs,
 			Memory:        c.HostConfig.Memory,
+			Runtime:       c.HostConfig.Runtime,
+		}
+		for _, d := range c.HostConfig.Devices {
+			host.Devices = append(host.Devices, DeviceMapping{
+				PathOnHost:        d.PathOnHost,
+				PathInContainer:   d.PathInContainer,
+				CgroupPermissions: d.CgroupPermissions,
+			})
+		}
+		for _, r := range c.HostConfig.DeviceRequests {
+			host.DeviceRequests = append(host.DeviceRequests, DeviceRequest{
+				Driver:       r.Driver,
+				Count:        r.Count,
+				DeviceIDs:    append([]string{}, r.DeviceIDs...),
+				Capabilities: r.Capabilities,
+			})
 		}
 	}
 
@@ -0,0 +1,71 @@
+package container
+
+// EditRequest lists changes to a standalone container's configuration. Fields
+// that are left out keep their current value; lists replace the current list.
+type EditRequest struct {
+	// Image is the image reference to recreate the container from, usually
+	// the current image with a different tag.
+	//
+	// Required: false
+	Image *string `json:"image,omitempty"`
+
+	// Env replaces the container's environment, as KEY=value entries.
+	//
+	// Required: false
+	Env []string `json:"env,omitempty"`
+
+	// PortBindings replaces the container's port bindings, keyed by container
+	// port such as 80/tcp.
+	//
+	// Required: false
+	PortBindings map[string][]PortBindingCreate `json:"portBindings,omitempty"`
+
+	// Binds replaces the container's bind and named volume mounts, as
+	// source:destination[:options] entries.
+	//
+	// Required: false
+	Binds []string `json:"binds,omitempty"`
+}
+
+// ConfigChange is the difference in one configuration field between the
+// current container and the edited one.
+type ConfigChange struct {
+	// Field is the changed field: image, env, ports or mounts.
+	//
+	// Required: true
+	Field string `json:"field"`
+
+	// Removed are the current values that the edit drops.
+	//
+	// Required: false
+	Removed []string `json:"removed,omitempty"`
+
+	// Added are the values that the edit introduces.
+	//
+	// Required: false
+	Added []string `json:"added,omitempty"`
+}
+
+// EditResult describes an edit of a container and whether it was applied.
+type EditResult struct {
+	// ContainerID is the ID of the container after the edit. It is the
+	// original ID when the edit was not applied.
+	//
+	// Required: true
+	ContainerID string `json:"containerId"`
+
+	// ContainerName is the name of the container.
+	//
+	// Required: true
+	ContainerName string `json:"containerName"`
+
+	// Changes is the diff between the current and the edited configuration.
+	//
+	// Required: true
+	Changes []ConfigChange `json:"changes"`
+
+	// Applied reports whether the container was recreated.
+	//
+	// Required: true
+	Applied bool `json:"applied"`
+}
@@ -0,0 +1,94 @@
+package container
+
+import "time"
+
+// CreateSnapshotRequest commits a container's filesystem to an image.
+type CreateSnapshotRequest struct {
+	// Reference is the image reference to tag the snapshot with. Defaults to
+	// arcane-snapshot/<container>:<timestamp>.
+	//
+	// Required: false
+	Reference string `json:"reference,omitempty"`
+
+	// Comment describes the snapshot.
+	//
+	// Required: false
+	Comment string `json:"comment,omitempty"`
+
+	// NoPause commits without pausing the container. The snapshot may then
+	// be inconsistent if the container writes during the commit.
+	//
+	// Required: false
+	NoPause bool `json:"noPause,omitempty"`
+
+	// Push pushes the snapshot to its registry after committing it.
+	//
+	// Required: false
+	Push bool `json:"push,omitempty"`
+}
+
+// Snapshot is an image committed from a container by Arcane.
+type Snapshot struct {
+	// ID is the image ID of the snapshot.
+	//
+	// Required: true
+	ID string `json:"id"`
+
+	// Reference is the image reference the snapshot is tagged with.
+	//
+	// Required: true
+	Reference string `json:"reference"`
+
+	// ContainerName is the name of the container the snapshot was taken from.
+	//
+	// Required: true
+	ContainerName string `json:"containerName"`
+
+	// ContainerID is the ID the container had when the snapshot was taken.
+	//
+	// Required: true
+	ContainerID string `json:"containerId"`
+
+	// SourceImage is the image the container was running.
+	//
+	// Required: false
+	SourceImage string `json:"sourceImage,omitempty"`
+
+	// Comment describes the snapshot.
+	//
+	// Required: false
+	Comment string `json:"comment,omitempty"`
+
+	// Pushed reports whether the snapshot was pushed to its registry.
+	//
+	// Required: true
+	Pushed bool `json:"pushed"`
+
+	// Size is the size of the snapshot image in bytes.
+	//
+	// Required: true
+	Size int64 `json:"size"`
+
+	// CreatedAt is when the snapshot was taken.
+	//
+	// Required: true
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// RestoreSnapshotResult is the container recreated from a snapshot.
+type RestoreSnapshotResult struct {
+	// ContainerID is the ID of the recreated container.
+	//
+	// Required: true
+	ContainerID string `json:"containerId"`
+
+	// ContainerName is the name of the recreated container.
+	//
+	// Required: true
+	ContainerName string `json:"containerName"`
+
+	// Snapshot is the snapshot the container was recreated from.
+	//
+	// Required: true
+	Snapshot Snapshot `json:"snapshot"`
+}
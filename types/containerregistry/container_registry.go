@@ -34,6 +34,30 @@ type ContainerRegistry struct {
 	// Required: true
 	Enabled bool `json:"enabled"`
 
+	// SignatureMode is the signature verification applied before updates
+	// ("" | "cosign-key" | "cosign-keyless" | "notation").
+	//
+	// Required: false
+	SignatureMode string `json:"signatureMode,omitempty"`
+
+	// SignatureTrust holds the trusted PEM public keys (cosign-key) or root
+	// certificates (cosign-keyless, notation).
+	//
+	// Required: false
+	SignatureTrust *string `json:"signatureTrust,omitempty"`
+
+	// SignatureIdentity is a regular expression the signer identity must
+	// match: the certificate email or URI for keyless signatures, the subject
+	// DN for Notation.
+	//
+	// Required: false
+	SignatureIdentity *string `json:"signatureIdentity,omitempty"`
+
+	// SignatureIssuer is the OIDC issuer required for keyless signatures.
+	//
+	// Required: false
+	SignatureIssuer *string `json:"signatureIssuer,omitempty"`
+
 	// CreatedAt is the date and time at which the registry was created.
 	//
 	// Required: true
@@ -81,6 +105,30 @@ type Sync struct {
 	// Required: true
 	Enabled bool `json:"enabled"`
 
+	// SignatureMode is the signature verification applied before updates
+	// ("" | "cosign-key" | "cosign-keyless" | "notation").
+	//
+	// Required: false
+	SignatureMode string `json:"signatureMode,omitempty"`
+
+	// SignatureTrust holds the trusted PEM public keys (cosign-key) or root
+	// certificates (cosign-keyless, notation).
+	//
+	// Required: false
+	SignatureTrust *string `json:"signatureTrust,omitempty"`
+
+	// SignatureIdentity is a regular expression the signer identity must
+	// match: the certificate email or URI for keyless signatures, the subject
+	// DN for Notation.
+	//
+	// Required: false
+	SignatureIdentity *string `json:"signatureIdentity,omitempty"`
+
+	// SignatureIssuer is the OIDC issuer required for keyless signatures.
+	//
+	// Required: false
+	SignatureIssuer *string `json:"signatureIssuer,omitempty"`
+
 	// CreatedAt is the date and time at which the registry was created.
 	//
 	// Required: true
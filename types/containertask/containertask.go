@@ -0,0 +1,71 @@
+package containertask
+
+import "time"
+
+const (
+	RunStatusRunning = "running"
+	RunStatusSuccess = "success"
+	RunStatusFailed  = "failed"
+	RunStatusTimeout = "timeout"
+
+	// TriggerSchedule marks runs started by the task's cron schedule.
+	TriggerSchedule = "schedule"
+	// TriggerManual marks runs started from the API.
+	TriggerManual = "manual"
+)
+
+// Task is a command run inside a container on a cron schedule.
+type Task struct {
+	ID              string     `json:"id" doc:"Unique identifier of the task"`
+	Name            string     `json:"name" doc:"Display name of the task"`
+	ContainerName   string     `json:"containerName" doc:"Name of the container the command runs in"`
+	Command         string     `json:"command" doc:"Command run with /bin/sh -c inside the container"`
+	User            *string    `json:"user,omitempty" doc:"User the command runs as, defaults to the container's user"`
+	Schedule        string     `json:"schedule" doc:"Cron expression with optional seconds field"`
+	TimeoutSeconds  int        `json:"timeoutSeconds" doc:"Seconds before a run is considered timed out"`
+	NotifyOnFailure bool       `json:"notifyOnFailure" doc:"Whether failed runs send a notification"`
+	Enabled         bool       `json:"enabled" doc:"Whether the task runs on its schedule"`
+	LastRunAt       *time.Time `json:"lastRunAt,omitempty" doc:"Start time of the last run"`
+	LastStatus      *string    `json:"lastStatus,omitempty" enum:"running,success,failed,timeout" doc:"Status of the last run"`
+	NextRunAt       *time.Time `json:"nextRunAt,omitempty" doc:"Time of the next scheduled run"`
+	CreatedAt       time.Time  `json:"createdAt" doc:"Creation timestamp"`
+	UpdatedAt       *time.Time `json:"updatedAt,omitempty" doc:"Last update timestamp"`
+}
+
+// CreateTask is the request body for creating a task.
+type CreateTask struct {
+	Name            string  `json:"name" minLength:"1" maxLength:"255" doc:"Display name of the task"`
+	ContainerName   string  `json:"containerName" minLength:"1" doc:"Name of the container the command runs in"`
+	Command         string  `json:"command" minLength:"1" doc:"Command run with /bin/sh -c inside the container"`
+	User            *string `json:"user,omitempty" doc:"User the command runs as"`
+	Schedule        string  `json:"schedule" minLength:"1" doc:"Cron expression with optional seconds field, e.g. '0 3 * * *'"`
+	TimeoutSeconds  int     `json:"timeoutSeconds,omitempty" minimum:"0" doc:"Seconds before a run is considered timed out (default 300)"`
+	NotifyOnFailure *bool   `json:"notifyOnFailure,omitempty" doc:"Whether failed runs send a notification (default true)"`
+	Enabled         *bool   `json:"enabled,omitempty" doc:"Whether the task runs on its schedule (default true)"`
+}
+
+// UpdateTask is the request body for updating a task. Omitted fields are left unchanged.
+type UpdateTask struct {
+	Name            *string `json:"name,omitempty" maxLength:"255" doc:"Display name of the task"`
+	ContainerName   *string `json:"containerName,omitempty" doc:"Name of the container the command runs in"`
+	Command         *string `json:"command,omitempty" doc:"Command run with /bin/sh -c inside the container"`
+	User            *string `json:"user,omitempty" doc:"User the command runs as, empty to use the container's user"`
+	Schedule        *string `json:"schedule,omitempty" doc:"Cron expression with optional seconds field"`
+	TimeoutSeconds  *int    `json:"timeoutSeconds,omitempty" minimum:"0" doc:"Seconds before a run is considered timed out"`
+	NotifyOnFailure *bool   `json:"notifyOnFailure,omitempty" doc:"Whether failed runs send a notification"`
+	Enabled         *bool   `json:"enabled,omitempty" doc:"Whether the task runs on its schedule"`
+}
+
+// Run is a single execution of a task.
+type Run struct {
+	ID         string     `json:"id" doc:"Unique identifier of the run"`
+	TaskID     string     `json:"taskId" doc:"Task that was run"`
+	Trigger    string     `json:"trigger" enum:"schedule,manual" doc:"What started the run"`
+	Status     string     `json:"status" enum:"running,success,failed,timeout" doc:"Outcome of the run"`
+	ExitCode   *int       `json:"exitCode,omitempty" doc:"Exit code of the command"`
+	Output     string     `json:"output" doc:"Combined stdout and stderr, truncated to the last 64 KiB"`
+	Error      *string    `json:"error,omitempty" doc:"Why the run failed, if it did not exit normally"`
+	StartedAt  time.Time  `json:"startedAt" doc:"Start time of the run"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty" doc:"End time of the run"`
+	DurationMs *int64     `json:"durationMs,omitempty" doc:"Duration of the run in milliseconds"`
+}
@@ -1,5 +1,11 @@
 package dashboard
 
+import (
+	"time"
+
+	"github.com/getarcaneapp/arcane/types/event"
+)
+
 type ActionItemKind string
 
 const (
@@ -39,3 +45,236 @@ type ActionItems struct {
 	// Required: true
 	Items []ActionItem `json:"items"`
 }
+
+// WidgetKind identifies a dashboard widget and the data it renders.
+type WidgetKind string
+
+const (
+	WidgetKindActionItems       WidgetKind = "action_items"
+	WidgetKindUpdatesPending    WidgetKind = "updates_pending"
+	WidgetKindVulnerableImages  WidgetKind = "vulnerable_images"
+	WidgetKindDiskUsage         WidgetKind = "disk_usage"
+	WidgetKindRecentEvents      WidgetKind = "recent_events"
+	WidgetKindEnvironmentHealth WidgetKind = "environment_health"
+)
+
+// WidgetKinds lists every supported widget kind in default display order.
+var WidgetKinds = []WidgetKind{
+	WidgetKindActionItems,
+	WidgetKindUpdatesPending,
+	WidgetKindVulnerableImages,
+	WidgetKindDiskUsage,
+	WidgetKindRecentEvents,
+	WidgetKindEnvironmentHealth,
+}
+
+// LayoutColumns is the width of the dashboard grid.
+const LayoutColumns = 12
+
+type Widget struct {
+	// ID uniquely identifies the widget within a layout.
+	//
+	// Required: true
+	ID string `json:"id"`
+
+	// Kind is the type of widget.
+	//
+	// Required: true
+	Kind WidgetKind `json:"kind"`
+
+	// X is the zero-based grid column of the widget's left edge.
+	//
+	// Required: true
+	X int `json:"x"`
+
+	// Y is the zero-based grid row of the widget's top edge.
+	//
+	// Required: true
+	Y int `json:"y"`
+
+	// Width is the number of grid columns the widget spans.
+	//
+	// Required: true
+	Width int `json:"width"`
+
+	// Height is the number of grid rows the widget spans.
+	//
+	// Required: true
+	Height int `json:"height"`
+}
+
+type Layout struct {
+	// Widgets are the widgets on the dashboard.
+	//
+	// Required: true
+	Widgets []Widget `json:"widgets"`
+
+	// Custom is false when the user has not saved a layout and the default
+	// layout is returned.
+	//
+	// Required: true
+	Custom bool `json:"custom"`
+
+	// UpdatedAt is when the layout was last saved.
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+type UpdateLayout struct {
+	// Widgets replace the current dashboard widgets.
+	//
+	// Required: true
+	Widgets []Widget `json:"widgets"`
+}
+
+type PendingUpdate struct {
+	// ImageID is the ID of the local image with an update available.
+	//
+	// Required: true
+	ImageID string `json:"imageId"`
+
+	// Repository is the image repository.
+	//
+	// Required: true
+	Repository string `json:"repository"`
+
+	// Tag is the image tag.
+	//
+	// Required: true
+	Tag string `json:"tag"`
+
+	// UpdateType is the kind of update detected (digest or tag).
+	UpdateType string `json:"updateType,omitempty"`
+
+	// CurrentVersion is the version currently in use.
+	CurrentVersion string `json:"currentVersion,omitempty"`
+
+	// LatestVersion is the newest available version.
+	LatestVersion string `json:"latestVersion,omitempty"`
+}
+
+type UpdatesPendingWidget struct {
+	// Count is the total number of images with updates available.
+	//
+	// Required: true
+	Count int `json:"count"`
+
+	// Images are the most recently checked images with updates available.
+	//
+	// Required: true
+	Images []PendingUpdate `json:"images"`
+}
+
+type VulnerableImage struct {
+	// ImageID is the ID of the scanned image.
+	//
+	// Required: true
+	ImageID string `json:"imageId"`
+
+	// ImageName is the image name with tag.
+	//
+	// Required: true
+	ImageName string `json:"imageName"`
+
+	// Critical is the number of critical vulnerabilities.
+	//
+	// Required: true
+	Critical int `json:"critical"`
+
+	// High is the number of high severity vulnerabilities.
+	//
+	// Required: true
+	High int `json:"high"`
+}
+
+type VulnerableImagesWidget struct {
+	// Count is the total number of images with critical or high vulnerabilities.
+	//
+	// Required: true
+	Count int `json:"count"`
+
+	// Images are the worst affected images.
+	//
+	// Required: true
+	Images []VulnerableImage `json:"images"`
+}
+
+type DiskUsageWidget struct {
+	// Path is the filesystem path the usage was measured for.
+	//
+	// Required: true
+	Path string `json:"path"`
+
+	// Used is the number of bytes in use.
+	//
+	// Required: true
+	Used uint64 `json:"used"`
+
+	// Total is the size of the filesystem in bytes.
+	//
+	// Required: true
+	Total uint64 `json:"total"`
+
+	// UsedPercent is Used as a percentage of Total.
+	//
+	// Required: true
+	UsedPercent float64 `json:"usedPercent"`
+}
+
+type RecentEventsWidget struct {
+	// Events are the newest events for the environment.
+	//
+	// Required: true
+	Events []event.Event `json:"events"`
+}
+
+type EnvironmentHealth struct {
+	// ID is the environment ID.
+	//
+	// Required: true
+	ID string `json:"id"`
+
+	// Name is the environment name.
+	//
+	// Required: true
+	Name string `json:"name"`
+
+	// Status is the last known environment status.
+	//
+	// Required: true
+	Status string `json:"status"`
+
+	// LastSeen is when the environment was last reachable.
+	LastSeen *time.Time `json:"lastSeen,omitempty"`
+}
+
+type EnvironmentHealthWidget struct {
+	// Total is the number of enabled environments.
+	//
+	// Required: true
+	Total int `json:"total"`
+
+	// Online is the number of online environments.
+	//
+	// Required: true
+	Online int `json:"online"`
+
+	// Unhealthy lists enabled environments that are not online.
+	//
+	// Required: true
+	Unhealthy []EnvironmentHealth `json:"unhealthy"`
+}
+
+// WidgetData holds the data for the requested widgets. Widgets that were not
+// requested are omitted, and widgets that failed to load are reported in
+// Errors instead of failing the whole request.
+type WidgetData struct {
+	ActionItems       *ActionItems             `json:"actionItems,omitempty"`
+	UpdatesPending    *UpdatesPendingWidget    `json:"updatesPending,omitempty"`
+	VulnerableImages  *VulnerableImagesWidget  `json:"vulnerableImages,omitempty"`
+	DiskUsage         *DiskUsageWidget         `json:"diskUsage,omitempty"`
+	RecentEvents      *RecentEventsWidget      `json:"recentEvents,omitempty"`
+	EnvironmentHealth *EnvironmentHealthWidget `json:"environmentHealth,omitempty"`
+
+	// Errors maps widget kinds to the error that prevented loading them.
+	Errors map[WidgetKind]string `json:"errors,omitempty"`
+}
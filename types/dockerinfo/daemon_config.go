@@ -0,0 +1,153 @@
+package dockerinfo
+
+// DaemonRiskSeverity is how urgently a daemon configuration risk should be
+// addressed.
+type DaemonRiskSeverity string
+
+const (
+	DaemonRiskInfo    DaemonRiskSeverity = "info"
+	DaemonRiskWarning DaemonRiskSeverity = "warning"
+)
+
+// DaemonRisk is a daemon setting that is likely to cause problems.
+type DaemonRisk struct {
+	// ID identifies the check, such as no-log-rotation.
+	//
+	// Required: true
+	ID string `json:"id"`
+
+	// Severity is how urgently the risk should be addressed.
+	//
+	// Required: true
+	Severity DaemonRiskSeverity `json:"severity" enum:"info,warning"`
+
+	// Message describes the risk.
+	//
+	// Required: true
+	Message string `json:"message"`
+
+	// Remediation describes the daemon.json change that fixes the risk.
+	//
+	// Required: false
+	Remediation string `json:"remediation,omitempty"`
+}
+
+// DaemonConfig is the Docker daemon configuration that matters for running
+// containers reliably.
+type DaemonConfig struct {
+	// ServerVersion is the Docker Engine version.
+	//
+	// Required: true
+	ServerVersion string `json:"serverVersion"`
+
+	// StorageDriver is the storage driver in use, such as overlay2.
+	//
+	// Required: true
+	StorageDriver string `json:"storageDriver"`
+
+	// CgroupVersion is the cgroup version of the host, 1 or 2.
+	//
+	// Required: true
+	CgroupVersion string `json:"cgroupVersion"`
+
+	// CgroupDriver is the cgroup driver, cgroupfs or systemd.
+	//
+	// Required: true
+	CgroupDriver string `json:"cgroupDriver"`
+
+	// LoggingDriver is the default logging driver for containers.
+	//
+	// Required: true
+	LoggingDriver string `json:"loggingDriver"`
+
+	// LiveRestoreEnabled reports whether containers keep running while the
+	// daemon restarts.
+	//
+	// Required: true
+	LiveRestoreEnabled bool `json:"liveRestoreEnabled"`
+
+	// RegistryMirrors are the Docker Hub mirrors the daemon pulls through.
+	//
+	// Required: true
+	RegistryMirrors []string `json:"registryMirrors"`
+
+	// InsecureRegistries are registries the daemon talks to without TLS
+	// verification.
+	//
+	// Required: true
+	InsecureRegistries []string `json:"insecureRegistries"`
+
+	// DockerRootDir is the daemon's data directory.
+	//
+	// Required: true
+	DockerRootDir string `json:"dockerRootDir"`
+
+	// Risks are settings that are likely to cause problems.
+	//
+	// Required: true
+	Risks []DaemonRisk `json:"risks"`
+
+	// DaemonJSONPath is where Arcane looks for daemon.json.
+	//
+	// Required: true
+	DaemonJSONPath string `json:"daemonJsonPath"`
+
+	// DaemonJSON is the content of daemon.json, when Arcane can read it.
+	//
+	// Required: false
+	DaemonJSON *string `json:"daemonJson,omitempty"`
+
+	// Editable reports whether daemon.json can be edited through Arcane. Only
+	// agents with the file mounted can edit it.
+	//
+	// Required: true
+	Editable bool `json:"editable"`
+}
+
+// UpdateDaemonJSONRequest replaces the content of daemon.json.
+type UpdateDaemonJSONRequest struct {
+	// Content is the new daemon.json content.
+	//
+	// Required: true
+	Content string `json:"content"`
+
+	// DryRun validates the content and reports the changes without writing
+	// the file.
+	//
+	// Required: false
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+// UpdateDaemonJSONResult describes what applying a daemon.json change takes.
+type UpdateDaemonJSONResult struct {
+	// Written reports whether daemon.json was written.
+	//
+	// Required: true
+	Written bool `json:"written"`
+
+	// ChangedKeys are the options that differ from the current file.
+	//
+	// Required: true
+	ChangedKeys []string `json:"changedKeys"`
+
+	// ReloadableKeys are the changed options dockerd applies on reload.
+	//
+	// Required: true
+	ReloadableKeys []string `json:"reloadableKeys"`
+
+	// RestartKeys are the changed options that only apply after the daemon
+	// restarts.
+	//
+	// Required: true
+	RestartKeys []string `json:"restartKeys"`
+
+	// ApplyCommand is the command to run on the host to apply the change.
+	//
+	// Required: false
+	ApplyCommand string `json:"applyCommand,omitempty"`
+
+	// BackupPath is where the previous daemon.json was saved.
+	//
+	// Required: false
+	BackupPath string `json:"backupPath,omitempty"`
+}
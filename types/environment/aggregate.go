@@ -0,0 +1,56 @@
+package environment
+
+const (
+	// AggregateSourceOK means the environment's items were included.
+	AggregateSourceOK = "ok"
+	// AggregateSourceError means the environment could not be queried.
+	AggregateSourceError = "error"
+)
+
+// AggregatedItem wraps a resource returned by an all-environments list with
+// the environment it belongs to.
+type AggregatedItem[T any] struct {
+	// EnvironmentID is the ID of the environment the item belongs to.
+	//
+	// Required: true
+	EnvironmentID string `json:"environmentId"`
+
+	// EnvironmentName is the display name of the environment the item belongs to.
+	//
+	// Required: true
+	EnvironmentName string `json:"environmentName"`
+
+	// Item is the resource as returned by the environment's own list endpoint.
+	//
+	// Required: true
+	Item T `json:"item"`
+}
+
+// AggregateSource reports how a single environment contributed to an
+// all-environments list.
+type AggregateSource struct {
+	// EnvironmentID is the ID of the environment.
+	//
+	// Required: true
+	EnvironmentID string `json:"environmentId"`
+
+	// EnvironmentName is the display name of the environment.
+	//
+	// Required: true
+	EnvironmentName string `json:"environmentName"`
+
+	// Status is "ok" when the environment answered, otherwise "error".
+	//
+	// Required: true
+	Status string `json:"status"`
+
+	// Error describes why the environment could not be queried.
+	//
+	// Required: false
+	Error string `json:"error,omitempty"`
+
+	// ItemCount is the number of items the environment returned.
+	//
+	// Required: true
+	ItemCount int `json:"itemCount"`
+}
@@ -0,0 +1,78 @@
+package environment
+
+import "time"
+
+// Features an agent can advertise. Each feature covers a group of endpoints
+// under /environments/{id}; the UI hides operations whose feature is missing.
+const (
+	FeatureContainers          = "containers"
+	FeatureImages              = "images"
+	FeatureImageUpdates        = "imageUpdates"
+	FeatureVolumes             = "volumes"
+	FeatureNetworks            = "networks"
+	FeatureProjects            = "projects"
+	FeatureBuilds              = "builds"
+	FeatureGitOps              = "gitops"
+	FeatureVulnerabilities     = "vulnerabilities"
+	FeatureUpdater             = "updater"
+	FeatureSystem              = "system"
+	FeatureDashboard           = "dashboard"
+	FeatureNotifications       = "notifications"
+	FeatureSettings            = "settings"
+	FeatureJobs                = "jobs"
+	FeatureContainerTasks      = "containerTasks"
+	FeatureContainerPreview    = "containerPreview"
+	FeatureDashboardWidgets    = "dashboardWidgets"
+	FeatureHostMetrics         = "hostMetrics"
+	FeatureImageTags           = "imageTags"
+	FeatureImagePulls          = "imagePulls"
+	FeatureIngress             = "ingress"
+	FeatureProjectAdoption     = "projectAdoption"
+	FeatureCustomFileTemplates = "customFileTemplates"
+	FeaturePathMapping         = "pathMapping"
+	FeatureSecurityAudit       = "securityAudit"
+	FeatureSettingsDryApply    = "settingsDryApply"
+	FeatureSnapshots           = "snapshots"
+	FeatureDaemonConfig        = "daemonConfig"
+	FeatureTags                = "tags"
+	FeatureTopology            = "topology"
+	FeatureUpdateRuns          = "updateRuns"
+	FeatureLogAlerts           = "logAlerts"
+	FeatureStaleResources      = "staleResources"
+	FeatureContainerImport     = "containerImport"
+)
+
+// Capabilities describes what an agent supports. Agents advertise them when
+// pairing and the manager refreshes them during environment health checks.
+type Capabilities struct {
+	// Version is the Arcane version of the agent.
+	//
+	// Required: true
+	Version string `json:"version"`
+
+	// ComposeVersion is the Docker Compose library version of the agent.
+	//
+	// Required: false
+	ComposeVersion string `json:"composeVersion,omitempty"`
+
+	// SwarmEnabled reports whether the agent's Docker host is an active swarm node.
+	//
+	// Required: true
+	SwarmEnabled bool `json:"swarmEnabled"`
+
+	// Features are the feature groups the agent serves.
+	//
+	// Required: true
+	Features []string `json:"features"`
+
+	// Legacy is true for agents that predate capability negotiation. Their
+	// features are assumed to be the ones every agent supports.
+	//
+	// Required: false
+	Legacy bool `json:"legacy,omitempty"`
+
+	// CheckedAt is when the manager last received the capabilities.
+	//
+	// Required: false
+	CheckedAt *time.Time `json:"checkedAt,omitempty"`
+}
@@ -37,6 +37,21 @@ type Create struct {
 	//
 	// Required: false
 	IsEdge *bool `json:"isEdge,omitempty"`
+
+	// SSHKey is the private key used to reach an ssh:// environment's Docker socket.
+	//
+	// Required: false
+	SSHKey *string `json:"sshKey,omitempty"`
+
+	// SSHHostKeyVerification controls host key checking for ssh:// environments (strict, accept_new, skip).
+	//
+	// Required: false
+	SSHHostKeyVerification *string `json:"sshHostKeyVerification,omitempty" enum:"strict,accept_new,skip"`
+
+	// SSHHostKey pins the expected host key of an ssh:// environment in authorized_keys format.
+	//
+	// Required: false
+	SSHHostKey *string `json:"sshHostKey,omitempty"`
 }
 
 type Update struct {
@@ -69,6 +84,21 @@ type Update struct {
 	//
 	// Required: false
 	RegenerateApiKey *bool `json:"regenerateApiKey,omitempty"`
+
+	// SSHKey is the private key used to reach an ssh:// environment's Docker socket.
+	//
+	// Required: false
+	SSHKey *string `json:"sshKey,omitempty"`
+
+	// SSHHostKeyVerification controls host key checking for ssh:// environments (strict, accept_new, skip).
+	//
+	// Required: false
+	SSHHostKeyVerification *string `json:"sshHostKeyVerification,omitempty" enum:"strict,accept_new,skip"`
+
+	// SSHHostKey pins the expected host key of an ssh:// environment in authorized_keys format.
+	//
+	// Required: false
+	SSHHostKey *string `json:"sshHostKey,omitempty"`
 }
 
 type Test struct {
@@ -123,6 +153,16 @@ type Environment struct {
 	// Required: false
 	IsEdge bool `json:"isEdge"`
 
+	// SSHHostKeyVerification is the host key checking mode of an ssh:// environment.
+	//
+	// Required: false
+	SSHHostKeyVerification string `json:"sshHostKeyVerification,omitempty"`
+
+	// SSHHostKey is the pinned host key of an ssh:// environment.
+	//
+	// Required: false
+	SSHHostKey *string `json:"sshHostKey,omitempty"`
+
 	// EdgeTransport indicates the active tunnel transport for an edge environment.
 	// Values are "grpc" or "websocket" when connected.
 	//
@@ -145,10 +185,50 @@ type Environment struct {
 	// Required: false
 	LastHeartbeat *time.Time `json:"lastHeartbeat,omitempty"`
 
+	// Breaker is the state of the circuit breaker guarding requests to a
+	// remote environment.
+	//
+	// Required: false
+	Breaker *CircuitBreaker `json:"breaker,omitempty"`
+
+	// Sync is the state of the latest sync of container registries and git
+	// repositories to a remote environment. It is unset until a sync ran
+	// since the manager started.
+	//
+	// Required: false
+	Sync *ConfigSyncStatus `json:"sync,omitempty"`
+
+	// Capabilities are the features the agent of a remote environment
+	// advertised. They are unset until the agent was reached once.
+	//
+	// Required: false
+	Capabilities *Capabilities `json:"capabilities,omitempty"`
+
+	// PairingFingerprint is the fingerprint of the agent whose pairing
+	// request waits for approval.
+	//
+	// Required: false
+	PairingFingerprint *string `json:"pairingFingerprint,omitempty"`
+
+	// PairingOriginIP is the address the pending pairing request came from.
+	//
+	// Required: false
+	PairingOriginIP *string `json:"pairingOriginIp,omitempty"`
+
+	// PairingRequestedAt is when the agent last sent the pending pairing request.
+	//
+	// Required: false
+	PairingRequestedAt *time.Time `json:"pairingRequestedAt,omitempty"`
+
 	// ApiKey is returned only when creating or regenerating
 	//
 	// Required: false
 	ApiKey *string `json:"apiKey,omitempty"` //nolint:gosec // API schema requires apiKey field name
+
+	// Tags are the user-defined tags Arcane stores for the environment.
+	//
+	// Required: false
+	Tags map[string]string `json:"tags,omitempty"`
 }
 
 // AgentPairRequest is the request body for pairing with an agent.
@@ -166,3 +246,133 @@ type AgentPairResponse struct {
 	// Required: true
 	Token string `json:"token"`
 }
+
+// Circuit breaker states of a remote environment.
+const (
+	BreakerClosed   = "closed"
+	BreakerOpen     = "open"
+	BreakerHalfOpen = "halfOpen"
+)
+
+// CircuitBreaker describes the circuit breaker guarding requests to a remote
+// environment. An open breaker fails requests immediately until RetryAt,
+// after which a single trial request decides whether it closes again.
+type CircuitBreaker struct {
+	// State is closed, open or halfOpen.
+	//
+	// Required: true
+	State string `json:"state" enum:"closed,open,halfOpen"`
+
+	// ConsecutiveFailures is the number of failed requests since the last success.
+	//
+	// Required: false
+	ConsecutiveFailures int `json:"consecutiveFailures,omitempty"`
+
+	// LastError is the error of the most recent failed request.
+	//
+	// Required: false
+	LastError string `json:"lastError,omitempty"`
+
+	// OpenedAt is when the breaker opened.
+	//
+	// Required: false
+	OpenedAt *time.Time `json:"openedAt,omitempty"`
+
+	// RetryAt is when the breaker lets a trial request through.
+	//
+	// Required: false
+	RetryAt *time.Time `json:"retryAt,omitempty"`
+}
+
+// Sync run and target states.
+const (
+	SyncPending   = "pending"
+	SyncRunning   = "running"
+	SyncSucceeded = "succeeded"
+	SyncFailed    = "failed"
+)
+
+// Shared configuration the manager syncs to remote environments.
+const (
+	SyncTargetRegistries   = "registries"
+	SyncTargetRepositories = "repositories"
+)
+
+// ConfigSyncStatus describes the latest sync of container registries and git
+// repositories to a remote environment.
+type ConfigSyncStatus struct {
+	// EnvironmentID is the ID of the synced environment.
+	//
+	// Required: true
+	EnvironmentID string `json:"environmentId"`
+
+	// State is running, succeeded or failed. A run fails when any target
+	// still fails after its retries.
+	//
+	// Required: true
+	State string `json:"state" enum:"running,succeeded,failed"`
+
+	// Trigger is what started the run, e.g. environment creation or a manual sync.
+	//
+	// Required: true
+	Trigger string `json:"trigger"`
+
+	// StartedAt is when the run started.
+	//
+	// Required: true
+	StartedAt time.Time `json:"startedAt"`
+
+	// FinishedAt is when the last target finished.
+	//
+	// Required: false
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+
+	// Completed is the number of targets that finished, successfully or not.
+	//
+	// Required: true
+	Completed int `json:"completed"`
+
+	// Total is the number of targets in the run.
+	//
+	// Required: true
+	Total int `json:"total"`
+
+	// Targets are the per-target results of the run.
+	//
+	// Required: true
+	Targets []SyncTarget `json:"targets"`
+}
+
+// SyncTarget is the result of syncing one kind of shared configuration.
+type SyncTarget struct {
+	// Target is registries or repositories.
+	//
+	// Required: true
+	Target string `json:"target" enum:"registries,repositories"`
+
+	// State is pending, running, succeeded or failed.
+	//
+	// Required: true
+	State string `json:"state" enum:"pending,running,succeeded,failed"`
+
+	// Attempts is the number of attempts made in this run.
+	//
+	// Required: true
+	Attempts int `json:"attempts"`
+
+	// Error is the error of the last failed attempt.
+	//
+	// Required: false
+	Error string `json:"error,omitempty"`
+
+	// FinishedAt is when the target finished in this run.
+	//
+	// Required: false
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+
+	// LastSuccessAt is when the target last synced successfully, in this or
+	// an earlier run.
+	//
+	// Required: false
+	LastSuccessAt *time.Time `json:"lastSuccessAt,omitempty"`
+}
@@ -173,3 +173,44 @@ type ListReponse struct {
 	// Required: true
 	HasPrevious bool `json:"hasPrevious"`
 }
+
+const (
+	// ExportFormatJSONL exports one JSON-encoded event per line.
+	ExportFormatJSONL = "jsonl"
+	// ExportFormatCSV exports events as comma-separated values with a header row.
+	ExportFormatCSV = "csv"
+)
+
+// ExportManifest describes an exported batch of events.
+type ExportManifest struct {
+	// Format of the exported batch (jsonl or csv).
+	//
+	// Required: true
+	Format string `json:"format"`
+
+	// From is the inclusive start of the exported time range.
+	//
+	// Required: false
+	From *time.Time `json:"from,omitempty"`
+
+	// To is the exclusive end of the exported time range.
+	//
+	// Required: false
+	To *time.Time `json:"to,omitempty"`
+
+	// Count is the number of events in the batch.
+	//
+	// Required: true
+	Count int `json:"count"`
+
+	// SHA256 is the hex-encoded SHA-256 digest of the exported bytes, used to
+	// verify the batch was not altered after export.
+	//
+	// Required: true
+	SHA256 string `json:"sha256"`
+
+	// GeneratedAt is when the export was produced.
+	//
+	// Required: true
+	GeneratedAt time.Time `json:"generatedAt"`
+}
@@ -132,6 +132,33 @@ type GitOpsSync struct {
 	// Required: false
 	LastSyncCommit *string `json:"lastSyncCommit,omitempty"`
 
+	// TagBumpMode is how newer image tags found by the update checker are
+	// proposed: empty when disabled, "commit" to push to the sync branch or
+	// "pull_request" to open a pull request.
+	//
+	// Required: true
+	TagBumpMode string `json:"tagBumpMode"`
+
+	// LastTagBumpAt is the date and time of the last tag bump check.
+	//
+	// Required: false
+	LastTagBumpAt *time.Time `json:"lastTagBumpAt,omitempty"`
+
+	// LastTagBumpStatus is the status of the last tag bump check.
+	//
+	// Required: false
+	LastTagBumpStatus *string `json:"lastTagBumpStatus,omitempty"`
+
+	// LastTagBumpMessage describes the outcome of the last tag bump check.
+	//
+	// Required: false
+	LastTagBumpMessage *string `json:"lastTagBumpMessage,omitempty"`
+
+	// LastTagBumpURL links to the last pull request opened for a tag bump.
+	//
+	// Required: false
+	LastTagBumpURL *string `json:"lastTagBumpUrl,omitempty"`
+
 	// CreatedAt is the date and time at which the sync was created.
 	//
 	// Required: true
@@ -298,6 +325,12 @@ type CreateSyncRequest struct {
 	//
 	// Required: false
 	SyncInterval *int `json:"syncInterval,omitempty"`
+
+	// TagBumpMode is how newer image tags are proposed: empty to disable,
+	// "commit" or "pull_request".
+	//
+	// Required: false
+	TagBumpMode *string `json:"tagBumpMode,omitempty"`
 }
 
 // UpdateSyncRequest represents the request to update a gitops sync.
@@ -336,6 +369,12 @@ type UpdateSyncRequest struct {
 	//
 	// Required: false
 	SyncInterval *int `json:"syncInterval,omitempty"`
+
+	// TagBumpMode is how newer image tags are proposed: empty to disable,
+	// "commit" or "pull_request".
+	//
+	// Required: false
+	TagBumpMode *string `json:"tagBumpMode,omitempty"`
 }
 
 // SyncResult represents the result of a sync operation.
@@ -355,12 +394,89 @@ type SyncResult struct {
 	// Required: false
 	Error *string `json:"error,omitempty"`
 
+	// Warnings lists compose lint warnings for the synced project.
+	//
+	// Required: false
+	Warnings []string `json:"warnings,omitempty"`
+
 	// SyncedAt is the timestamp of the sync.
 	//
 	// Required: true
 	SyncedAt time.Time `json:"syncedAt"`
 }
 
+// Tag bump statuses reported in TagBumpResult.Status.
+const (
+	TagBumpStatusUpToDate        = "up_to_date"
+	TagBumpStatusCommitted       = "committed"
+	TagBumpStatusProposed        = "proposed"
+	TagBumpStatusAlreadyProposed = "already_proposed"
+	TagBumpStatusFailed          = "failed"
+)
+
+// ImageTagBump is a newer tag proposed for the image of one service.
+type ImageTagBump struct {
+	// Service is the compose service using the image.
+	//
+	// Required: true
+	Service string `json:"service"`
+
+	// Image is the image repository, without a tag.
+	//
+	// Required: true
+	Image string `json:"image"`
+
+	// CurrentTag is the tag in the compose file.
+	//
+	// Required: true
+	CurrentTag string `json:"currentTag"`
+
+	// NewTag is the newest tag with the same shape as CurrentTag.
+	//
+	// Required: true
+	NewTag string `json:"newTag"`
+
+	// SkippedTags are the versions released between CurrentTag and NewTag.
+	//
+	// Required: false
+	SkippedTags []string `json:"skippedTags,omitempty"`
+}
+
+// TagBumpResult is the outcome of checking a GitOps sync for newer image
+// tags.
+type TagBumpResult struct {
+	// Status is one of up_to_date, committed, proposed, already_proposed or
+	// failed.
+	//
+	// Required: true
+	Status string `json:"status"`
+
+	// Message describes the outcome.
+	//
+	// Required: true
+	Message string `json:"message"`
+
+	// Bumps are the tag changes that were found.
+	//
+	// Required: true
+	Bumps []ImageTagBump `json:"bumps"`
+
+	// Branch is the branch the change was pushed to.
+	//
+	// Required: false
+	Branch string `json:"branch,omitempty"`
+
+	// Commit is the hash of the pushed commit.
+	//
+	// Required: false
+	Commit string `json:"commit,omitempty"`
+
+	// PullRequestURL links to the opened pull request.
+	//
+	// Required: false
+	PullRequestURL string `json:"pullRequestUrl,omitempty"`
+}
+
 // FileTreeNodeType represents the type of a file tree node.
 type FileTreeNodeType string
 
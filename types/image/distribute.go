@@ -0,0 +1,37 @@
+package image
+
+// DistributeMethod is how an image is copied to other environments.
+type DistributeMethod string
+
+const (
+	// DistributeMethodStream streams `docker save` output from the source
+	// into `docker load` on each target.
+	DistributeMethodStream DistributeMethod = "stream"
+	// DistributeMethodRegistry pushes the image from the source to its
+	// registry and pulls it on each target.
+	DistributeMethodRegistry DistributeMethod = "registry"
+)
+
+// DistributeRequest copies an image from one environment to others.
+type DistributeRequest struct {
+	SourceEnvironmentID  string           `json:"sourceEnvironmentId,omitempty" doc:"Environment that has the image. Defaults to the local environment"`
+	Image                string           `json:"image" minLength:"1" doc:"Image reference or ID to copy"`
+	TargetEnvironmentIDs []string         `json:"targetEnvironmentIds" minItems:"1" doc:"Environments to copy the image to"`
+	Method               DistributeMethod `json:"method,omitempty" enum:"stream,registry" default:"stream" doc:"stream copies the image directly; registry pushes it and pulls it on each target"`
+}
+
+// DistributeTargetResult is the outcome for one target environment.
+type DistributeTargetResult struct {
+	EnvironmentID   string  `json:"environmentId"`
+	EnvironmentName string  `json:"environmentName"`
+	Success         bool    `json:"success"`
+	Error           *string `json:"error,omitempty"`
+	DurationMs      int64   `json:"durationMs"`
+}
+
+// DistributeResult is the outcome of copying an image to other environments.
+type DistributeResult struct {
+	Image   string                   `json:"image"`
+	Method  DistributeMethod         `json:"method"`
+	Targets []DistributeTargetResult `json:"targets"`
+}
@@ -0,0 +1,23 @@
+package image
+
+// PromoteRequest copies an image from its registry to a configured registry
+// under a new name, such as promoting a tested build from a development
+// registry to the production one.
+type PromoteRequest struct {
+	SourceEnvironmentID string `json:"sourceEnvironmentId,omitempty" doc:"Environment whose Docker host pulls and pushes the image. Defaults to the local environment"`
+	SourceImage         string `json:"sourceImage" minLength:"1" doc:"Image reference to promote, including its registry"`
+	SourceDigest        string `json:"sourceDigest,omitempty" doc:"Digest the source image must have, so that exactly the tested build is promoted"`
+	TargetRegistryID    string `json:"targetRegistryId" minLength:"1" doc:"Configured container registry to push to"`
+	TargetRepository    string `json:"targetRepository,omitempty" doc:"Repository in the target registry. Defaults to the source repository path"`
+	TargetTag           string `json:"targetTag,omitempty" doc:"Tag in the target registry. Defaults to the source tag"`
+}
+
+// PromoteResult is the outcome of promoting an image.
+type PromoteResult struct {
+	SourceImage  string `json:"sourceImage"`
+	SourceDigest string `json:"sourceDigest,omitempty" doc:"Digest of the source image in its registry"`
+	TargetImage  string `json:"targetImage"`
+	Digest       string `json:"digest" doc:"Digest of the image pushed to the target registry"`
+	Verified     bool   `json:"verified" doc:"Whether the target registry reported the pushed digest for the new tag"`
+	DurationMs   int64  `json:"durationMs"`
+}
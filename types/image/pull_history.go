@@ -0,0 +1,20 @@
+package image
+
+import "time"
+
+// PullRecord represents a historical image pull entry.
+type PullRecord struct {
+	ID               string     `json:"id" sortable:"true"`
+	ImageName        string     `json:"imageName" sortable:"true"`
+	UserID           *string    `json:"userId,omitempty"`
+	Username         *string    `json:"username,omitempty"`
+	Trigger          string     `json:"trigger" doc:"What started the pull: user, updater or system"`
+	Status           string     `json:"status" sortable:"true"`
+	Layers           int        `json:"layers" doc:"Number of layers in the image"`
+	LayersDownloaded int        `json:"layersDownloaded" doc:"Number of layers that were not already present"`
+	Bytes            int64      `json:"bytes" sortable:"true" doc:"Compressed size of the downloaded layers"`
+	ErrorMessage     *string    `json:"errorMessage,omitempty"`
+	CompletedAt      *time.Time `json:"completedAt,omitempty" sortable:"true"`
+	DurationMs       int64      `json:"durationMs" sortable:"true"`
+	CreatedAt        time.Time  `json:"createdAt" sortable:"true"`
+}
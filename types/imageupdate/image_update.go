@@ -71,6 +71,17 @@ type Response struct {
 	//
 	// Required: false
 	UsedCredential bool `json:"usedCredential,omitempty"`
+
+	// Platform is the os/arch[/variant] the remote tag was resolved for.
+	//
+	// Required: false
+	Platform string `json:"platform,omitempty"`
+
+	// PlatformUnsupported indicates the remote tag no longer provides the
+	// local image's platform, so the update cannot be applied.
+	//
+	// Required: false
+	PlatformUnsupported bool `json:"platformUnsupported,omitempty"`
 }
 
 type Summary struct {
@@ -115,3 +126,49 @@ type CheckAllImagesRequest struct {
 }
 
 type BatchResponse map[string]*Response
+
+// RemoteTag is a tag available for a repository in its registry.
+type RemoteTag struct {
+	// Tag is the tag name.
+	//
+	// Required: true
+	Tag string `json:"tag"`
+
+	// Semver is the canonical semantic version of the tag, e.g. "v1.2.0", or
+	// empty when the tag is not a version.
+	//
+	// Required: false
+	Semver string `json:"semver,omitempty"`
+}
+
+// SnoozeRequest holds back the updates of a repository and tag.
+type SnoozeRequest struct {
+	// ImageRef is the repository and tag to snooze, e.g. "nginx:1.27".
+	//
+	// Required: true
+	ImageRef string `json:"imageRef" binding:"required"`
+
+	// Until is the time notifications and automatic updates resume.
+	//
+	// Required: true
+	Until time.Time `json:"until" binding:"required"`
+}
+
+// Snooze is a repository and tag whose update notifications and automatic
+// updates are held back.
+type Snooze struct {
+	// Repository is the repository of the snoozed image.
+	//
+	// Required: true
+	Repository string `json:"repository"`
+
+	// Tag is the tag of the snoozed image.
+	//
+	// Required: true
+	Tag string `json:"tag"`
+
+	// SnoozedUntil is the time notifications and automatic updates resume.
+	//
+	// Required: true
+	SnoozedUntil time.Time `json:"snoozedUntil"`
+}
@@ -0,0 +1,181 @@
+package ingress
+
+// Provider identifies the reverse proxy a set of labels is written for.
+type Provider string
+
+const (
+	ProviderTraefik Provider = "traefik"
+	ProviderCaddy   Provider = "caddy"
+)
+
+// ConflictKind identifies the kind of problem found in proxy labels.
+type ConflictKind string
+
+const (
+	// ConflictDuplicateHost means more than one container claims the same
+	// host and path with the same proxy.
+	ConflictDuplicateHost ConflictKind = "duplicate_host"
+
+	// ConflictDuplicateRouter means more than one container defines a
+	// Traefik router with the same name.
+	ConflictDuplicateRouter ConflictKind = "duplicate_router"
+
+	// ConflictMissingEntryPoint means a Traefik router has no entrypoints or
+	// references one that the Traefik container does not define.
+	ConflictMissingEntryPoint ConflictKind = "missing_entrypoint"
+
+	// ConflictMissingRule means a route does not match any host.
+	ConflictMissingRule ConflictKind = "missing_rule"
+)
+
+type Route struct {
+	// Provider is the reverse proxy the route is configured for.
+	//
+	// Required: true
+	Provider Provider `json:"provider"`
+
+	// Name is the Traefik router name, or the Caddy label prefix.
+	//
+	// Required: true
+	Name string `json:"name"`
+
+	// ContainerID is the ID of the container carrying the labels.
+	//
+	// Required: true
+	ContainerID string `json:"containerId"`
+
+	// ContainerName is the name of the container carrying the labels.
+	//
+	// Required: true
+	ContainerName string `json:"containerName"`
+
+	// Project is the compose project of the container, if any.
+	Project string `json:"project,omitempty"`
+
+	// Service is the compose service of the container, if any.
+	Service string `json:"service,omitempty"`
+
+	// Rule is the raw Traefik rule or Caddy site address.
+	Rule string `json:"rule,omitempty"`
+
+	// Hosts are the hostnames matched by the route.
+	//
+	// Required: true
+	Hosts []string `json:"hosts"`
+
+	// PathPrefixes are the path prefixes matched by the route.
+	PathPrefixes []string `json:"pathPrefixes,omitempty"`
+
+	// EntryPoints are the Traefik entrypoints the router listens on.
+	EntryPoints []string `json:"entryPoints,omitempty"`
+
+	// Port is the container port traffic is forwarded to, if set.
+	Port string `json:"port,omitempty"`
+
+	// TLS reports whether the route terminates TLS.
+	//
+	// Required: true
+	TLS bool `json:"tls"`
+}
+
+type Conflict struct {
+	// Kind is the kind of problem.
+	//
+	// Required: true
+	Kind ConflictKind `json:"kind"`
+
+	// Message describes the problem.
+	//
+	// Required: true
+	Message string `json:"message"`
+
+	// Provider is the reverse proxy the problem applies to.
+	//
+	// Required: true
+	Provider Provider `json:"provider"`
+
+	// Host is the conflicting hostname, for duplicate host conflicts.
+	Host string `json:"host,omitempty"`
+
+	// Routes are the names of the routes involved.
+	//
+	// Required: true
+	Routes []string `json:"routes"`
+
+	// ContainerNames are the containers involved.
+	//
+	// Required: true
+	ContainerNames []string `json:"containerNames"`
+}
+
+type Analysis struct {
+	// Routes are every route found in container labels.
+	//
+	// Required: true
+	Routes []Route `json:"routes"`
+
+	// Conflicts are the problems found across routes.
+	//
+	// Required: true
+	Conflicts []Conflict `json:"conflicts"`
+
+	// EntryPoints are the entrypoints defined by running Traefik containers,
+	// when they can be read from the container command.
+	EntryPoints []string `json:"entryPoints,omitempty"`
+}
+
+type GenerateLabelsRequest struct {
+	// Provider is the reverse proxy to generate labels for.
+	//
+	// Required: true
+	Provider Provider `json:"provider" enum:"traefik,caddy"`
+
+	// Service is the compose service name, used as the router name.
+	//
+	// Required: true
+	Service string `json:"service" minLength:"1"`
+
+	// Domain is the hostname to route to the service.
+	//
+	// Required: true
+	Domain string `json:"domain" minLength:"1"`
+
+	// Port is the container port the service listens on.
+	//
+	// Required: true
+	Port int `json:"port" minimum:"1" maximum:"65535"`
+
+	// PathPrefix optionally restricts the route to a path prefix.
+	PathPrefix string `json:"pathPrefix,omitempty"`
+
+	// TLS enables HTTPS for the route.
+	TLS bool `json:"tls,omitempty"`
+
+	// EntryPoint is the Traefik entrypoint. Defaults to websecure with TLS
+	// and web without.
+	EntryPoint string `json:"entryPoint,omitempty"`
+
+	// CertResolver is the Traefik certificate resolver used with TLS.
+	CertResolver string `json:"certResolver,omitempty"`
+
+	// Network is the Docker network the proxy reaches the service on.
+	Network string `json:"network,omitempty"`
+}
+
+type GeneratedLabels struct {
+	// Labels are the generated container labels.
+	//
+	// Required: true
+	Labels map[string]string `json:"labels"`
+
+	// Compose is the labels rendered as a compose labels block.
+	//
+	// Required: true
+	Compose string `json:"compose"`
+
+	// Conflicts are problems the generated route would cause with the
+	// containers currently running.
+	//
+	// Required: true
+	Conflicts []Conflict `json:"conflicts"`
+}
@@ -16,6 +16,9 @@ type Config struct {
 	GitopsSyncInterval         string `json:"gitopsSyncInterval"`
 	VulnerabilityScanInterval  string `json:"vulnerabilityScanInterval"`
 	AutoHealInterval           string `json:"autoHealInterval"`
+	ConfigBackupInterval       string `json:"configBackupInterval"`
+	ImageRetentionInterval     string `json:"imageRetentionInterval"`
+	StaleResourcesInterval     string `json:"staleResourcesInterval"`
 }
 
 // Update is used to update job schedule intervals (in minutes).
@@ -31,6 +34,9 @@ type Update struct {
 	GitopsSyncInterval         *string `json:"gitopsSyncInterval,omitempty"`
 	VulnerabilityScanInterval  *string `json:"vulnerabilityScanInterval,omitempty"`
 	AutoHealInterval           *string `json:"autoHealInterval,omitempty"`
+	ConfigBackupInterval       *string `json:"configBackupInterval,omitempty"`
+	ImageRetentionInterval     *string `json:"imageRetentionInterval,omitempty"`
+	StaleResourcesInterval     *string `json:"staleResourcesInterval,omitempty"`
 }
 
 // JobStatus represents the current status and metadata for a background job.
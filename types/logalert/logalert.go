@@ -0,0 +1,46 @@
+package logalert
+
+import "time"
+
+// Rule matches the log lines of a container or compose project against a
+// regular expression and sends an alert when a line matches.
+type Rule struct {
+	ID                 string     `json:"id" doc:"Unique identifier of the rule"`
+	Name               string     `json:"name" doc:"Display name of the rule"`
+	Pattern            string     `json:"pattern" doc:"Regular expression (RE2 syntax) matched against each log line"`
+	ProjectID          *string    `json:"projectId,omitempty" doc:"Compose project whose containers are watched"`
+	Services           []string   `json:"services" doc:"Compose services of the project to watch; all services when empty"`
+	ContainerName      *string    `json:"containerName,omitempty" doc:"Name of a single container to watch"`
+	CooldownSeconds    int        `json:"cooldownSeconds" doc:"Minimum seconds between two alerts of the rule"`
+	Enabled            bool       `json:"enabled" doc:"Whether log lines are matched"`
+	AttachedContainers int        `json:"attachedContainers" doc:"Number of containers whose logs are currently watched"`
+	LastFiredAt        *time.Time `json:"lastFiredAt,omitempty" doc:"Time the rule last sent an alert"`
+	LastExcerpt        *string    `json:"lastExcerpt,omitempty" doc:"Excerpt of the log line that last triggered the rule"`
+	LastError          *string    `json:"lastError,omitempty" doc:"Why the watched containers could not be resolved, if they could not"`
+	CreatedAt          time.Time  `json:"createdAt" doc:"Creation timestamp"`
+	UpdatedAt          *time.Time `json:"updatedAt,omitempty" doc:"Last update timestamp"`
+}
+
+// CreateRule is the request body for creating a rule. Exactly one of
+// ProjectID and ContainerName must be set.
+type CreateRule struct {
+	Name            string   `json:"name" minLength:"1" maxLength:"255" doc:"Display name of the rule"`
+	Pattern         string   `json:"pattern" minLength:"1" maxLength:"1024" doc:"Regular expression (RE2 syntax) matched against each log line, e.g. '(?i)out of memory'"`
+	ProjectID       *string  `json:"projectId,omitempty" doc:"Compose project whose containers are watched"`
+	Services        []string `json:"services,omitempty" doc:"Compose services of the project to watch; all services when empty"`
+	ContainerName   *string  `json:"containerName,omitempty" doc:"Name of a single container to watch"`
+	CooldownSeconds int      `json:"cooldownSeconds,omitempty" minimum:"0" doc:"Minimum seconds between two alerts of the rule (default 300, minimum 10)"`
+	Enabled         *bool    `json:"enabled,omitempty" doc:"Whether log lines are matched (default true)"`
+}
+
+// UpdateRule is the request body for updating a rule. Omitted fields are
+// left unchanged; an empty projectId or containerName clears it.
+type UpdateRule struct {
+	Name            *string   `json:"name,omitempty" maxLength:"255" doc:"Display name of the rule"`
+	Pattern         *string   `json:"pattern,omitempty" maxLength:"1024" doc:"Regular expression (RE2 syntax) matched against each log line"`
+	ProjectID       *string   `json:"projectId,omitempty" doc:"Compose project whose containers are watched"`
+	Services        *[]string `json:"services,omitempty" doc:"Compose services of the project to watch; all services when empty"`
+	ContainerName   *string   `json:"containerName,omitempty" doc:"Name of a single container to watch"`
+	CooldownSeconds *int      `json:"cooldownSeconds,omitempty" minimum:"0" doc:"Minimum seconds between two alerts of the rule"`
+	Enabled         *bool     `json:"enabled,omitempty" doc:"Whether log lines are matched"`
+}
@@ -0,0 +1,43 @@
+package logforward
+
+import "time"
+
+const (
+	// SinkLoki pushes logs to a Loki push API URL.
+	SinkLoki = "loki"
+	// SinkSyslogUDP sends RFC 5424 messages to host:port over UDP.
+	SinkSyslogUDP = "syslog-udp"
+	// SinkSyslogTCP sends RFC 5424 messages to host:port over TCP.
+	SinkSyslogTCP = "syslog-tcp"
+	// SinkFile appends JSON lines to a file with size based rotation.
+	SinkFile = "file"
+)
+
+// LogForwarder is the log forwarding configuration of a project.
+type LogForwarder struct {
+	ID                 string     `json:"id" doc:"Unique identifier of the forwarder"`
+	ProjectID          string     `json:"projectId" doc:"Project whose containers are forwarded"`
+	SinkType           string     `json:"sinkType" enum:"loki,syslog-udp,syslog-tcp,file" doc:"Destination type"`
+	Target             string     `json:"target" doc:"Loki push URL, syslog host:port or absolute file path"`
+	HasToken           bool       `json:"hasToken" doc:"Whether a Loki credential is stored"`
+	Services           []string   `json:"services" doc:"Compose services to forward; all services when empty"`
+	FileMaxSizeMB      int        `json:"fileMaxSizeMb" doc:"Size in MB at which the log file is rotated"`
+	FileMaxFiles       int        `json:"fileMaxFiles" doc:"Number of rotated log files to keep"`
+	Enabled            bool       `json:"enabled" doc:"Whether logs are forwarded"`
+	AttachedContainers int        `json:"attachedContainers" doc:"Number of containers whose logs are currently forwarded"`
+	LastError          *string    `json:"lastError,omitempty" doc:"Error from the last failed delivery"`
+	LastShippedAt      *time.Time `json:"lastShippedAt,omitempty" doc:"Time logs were last delivered"`
+	CreatedAt          time.Time  `json:"createdAt" doc:"Creation timestamp"`
+	UpdatedAt          *time.Time `json:"updatedAt,omitempty" doc:"Last update timestamp"`
+}
+
+// UpsertLogForwarder is the request body for configuring log forwarding for a project.
+type UpsertLogForwarder struct {
+	SinkType      string   `json:"sinkType" enum:"loki,syslog-udp,syslog-tcp,file" doc:"Destination type"`
+	Target        string   `json:"target" minLength:"1" doc:"Loki push URL, syslog host:port or absolute file path"`
+	Token         *string  `json:"token,omitempty" doc:"Loki bearer token, or user:password for basic auth. Omit to keep the stored value, send an empty string to clear it"`
+	Services      []string `json:"services,omitempty" doc:"Compose services to forward; all services when empty"`
+	FileMaxSizeMB int      `json:"fileMaxSizeMb,omitempty" minimum:"0" maximum:"1024" doc:"Size in MB at which the log file is rotated (default 10)"`
+	FileMaxFiles  int      `json:"fileMaxFiles,omitempty" minimum:"0" maximum:"100" doc:"Number of rotated log files to keep (default 5)"`
+	Enabled       *bool    `json:"enabled,omitempty" doc:"Whether logs are forwarded (default true)"`
+}
@@ -189,6 +189,126 @@ var jobMetadataRegistry = map[string]JobMetadata{
 			},
 		},
 	},
+	"uptime-monitor": {
+		ID:             "uptime-monitor",
+		Name:           "Uptime Monitor",
+		Description:    "Runs HTTP and TCP uptime checks and alerts when a monitor goes down",
+		Category:       "monitoring",
+		SettingsKey:    "",
+		ManagerOnly:    false,
+		IsContinuous:   true,
+		CanRunManually: true,
+		Prerequisites:  []JobPrerequisiteMetadata{},
+	},
+	"log-forwarding": {
+		ID:             "log-forwarding",
+		Name:           "Log Forwarding",
+		Description:    "Attaches project containers to their configured log sinks and applies configuration changes",
+		Category:       "monitoring",
+		SettingsKey:    "",
+		ManagerOnly:    false,
+		IsContinuous:   true,
+		CanRunManually: true,
+		Prerequisites:  []JobPrerequisiteMetadata{},
+	},
+	"container-tasks": {
+		ID:             "container-tasks",
+		Name:           "Container Tasks",
+		Description:    "Runs scheduled commands inside containers and notifies when a run fails",
+		Category:       "maintenance",
+		SettingsKey:    "",
+		ManagerOnly:    false,
+		IsContinuous:   true,
+		CanRunManually: true,
+		Prerequisites:  []JobPrerequisiteMetadata{},
+	},
+	"host-metrics": {
+		ID:             "host-metrics",
+		Name:           "Host Metrics",
+		Description:    "Collects CPU, memory, disk and load from every environment and alerts on thresholds",
+		Category:       "monitoring",
+		SettingsKey:    "",
+		ManagerOnly:    true,
+		IsContinuous:   true,
+		CanRunManually: true,
+		Prerequisites:  []JobPrerequisiteMetadata{},
+	},
+	"environment-heartbeat": {
+		ID:             "environment-heartbeat",
+		Name:           "Environment Heartbeat",
+		Description:    "Marks environments offline when heartbeats stop and notifies on offline/online changes",
+		Category:       "monitoring",
+		SettingsKey:    "",
+		ManagerOnly:    true,
+		IsContinuous:   true,
+		CanRunManually: true,
+		Prerequisites:  []JobPrerequisiteMetadata{},
+	},
+	"notification-credential-check": {
+		ID:             "notification-credential-check",
+		Name:           "Notification Credential Check",
+		Description:    "Verifies stored notification provider credentials and alerts when they stop working",
+		Category:       "monitoring",
+		SettingsKey:    "",
+		ManagerOnly:    true,
+		IsContinuous:   false,
+		CanRunManually: true,
+		Prerequisites:  []JobPrerequisiteMetadata{},
+	},
+	"config-backup": {
+		ID:             "config-backup",
+		Name:           "Configuration Backup",
+		Description:    "Writes an encrypted backup of the Arcane configuration to a local path or S3",
+		Category:       "maintenance",
+		SettingsKey:    "configBackupInterval",
+		EnabledKey:     "configBackupEnabled",
+		ManagerOnly:    true,
+		IsContinuous:   false,
+		CanRunManually: true,
+		Prerequisites: []JobPrerequisiteMetadata{
+			{
+				SettingKey:  "configBackupEnabled",
+				Label:       "Scheduled configuration backup enabled",
+				SettingsURL: "/settings/general",
+			},
+		},
+	},
+	"image-retention": {
+		ID:             "image-retention",
+		Name:           "Image Retention",
+		Description:    "Keeps the most recent previous versions of updated images and removes older ones",
+		Category:       "updates",
+		SettingsKey:    "imageRetentionInterval",
+		EnabledKey:     "imageRetentionEnabled",
+		ManagerOnly:    false,
+		IsContinuous:   false,
+		CanRunManually: true,
+		Prerequisites: []JobPrerequisiteMetadata{
+			{
+				SettingKey:  "imageRetentionEnabled",
+				Label:       "Image retention enabled",
+				SettingsURL: "/settings/updates",
+			},
+		},
+	},
+	"stale-resources": {
+		ID:             "stale-resources",
+		Name:           "Stale Resource Report",
+		Description:    "Finds long-stopped containers, unmounted volumes, empty networks and old unused images for review",
+		Category:       "maintenance",
+		SettingsKey:    "staleResourcesInterval",
+		EnabledKey:     "staleResourcesEnabled",
+		ManagerOnly:    false,
+		IsContinuous:   false,
+		CanRunManually: true,
+		Prerequisites: []JobPrerequisiteMetadata{
+			{
+				SettingKey:  "staleResourcesEnabled",
+				Label:       "Stale resource report enabled",
+				SettingsURL: "/settings/general",
+			},
+		},
+	},
 }
 
 func GetJobMetadata(jobID string) (JobMetadata, bool) {
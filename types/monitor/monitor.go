@@ -0,0 +1,79 @@
+package monitor
+
+import "time"
+
+const (
+	// TypeHTTP checks that an HTTP(S) URL answers with the expected status code.
+	TypeHTTP = "http"
+	// TypeTCP checks that a host:port accepts TCP connections.
+	TypeTCP = "tcp"
+
+	StatusUnknown = "unknown"
+	StatusUp      = "up"
+	StatusDown    = "down"
+)
+
+// Monitor is an uptime check for an endpoint exposed by a deployed service.
+type Monitor struct {
+	ID                  string     `json:"id" doc:"Unique identifier of the monitor"`
+	Name                string     `json:"name" doc:"Display name of the monitor"`
+	Type                string     `json:"type" enum:"http,tcp" doc:"Check type"`
+	Target              string     `json:"target" doc:"URL for HTTP checks or host:port for TCP checks"`
+	ExpectedStatus      int        `json:"expectedStatus,omitempty" doc:"HTTP status code that counts as up (any 2xx/3xx when 0)"`
+	IntervalSeconds     int        `json:"intervalSeconds" doc:"Seconds between checks"`
+	TimeoutSeconds      int        `json:"timeoutSeconds" doc:"Seconds before a check is considered failed"`
+	FailureThreshold    int        `json:"failureThreshold" doc:"Consecutive failures before the monitor is reported down"`
+	EnvironmentID       string     `json:"environmentId" doc:"Environment the monitored service runs in"`
+	ContainerID         *string    `json:"containerId,omitempty" doc:"Container the monitor is tied to"`
+	ProjectID           *string    `json:"projectId,omitempty" doc:"Compose project the monitor is tied to"`
+	ServiceName         *string    `json:"serviceName,omitempty" doc:"Compose service the monitor is tied to"`
+	Enabled             bool       `json:"enabled" doc:"Whether the monitor is checked"`
+	Status              string     `json:"status" enum:"unknown,up,down" doc:"Current status"`
+	ConsecutiveFailures int        `json:"consecutiveFailures" doc:"Number of failed checks in a row"`
+	LastCheckedAt       *time.Time `json:"lastCheckedAt,omitempty" doc:"Time of the last check"`
+	LastLatencyMs       *int64     `json:"lastLatencyMs,omitempty" doc:"Latency of the last check in milliseconds"`
+	LastError           *string    `json:"lastError,omitempty" doc:"Error returned by the last failed check"`
+	LastStatusChangeAt  *time.Time `json:"lastStatusChangeAt,omitempty" doc:"Time the status last changed"`
+	CreatedAt           time.Time  `json:"createdAt" doc:"Creation timestamp"`
+	UpdatedAt           *time.Time `json:"updatedAt,omitempty" doc:"Last update timestamp"`
+}
+
+// CreateMonitor is the request body for registering a monitor.
+type CreateMonitor struct {
+	Name             string  `json:"name" minLength:"1" maxLength:"255" doc:"Display name of the monitor"`
+	Type             string  `json:"type" enum:"http,tcp" doc:"Check type"`
+	Target           string  `json:"target" minLength:"1" doc:"URL for HTTP checks or host:port for TCP checks"`
+	ExpectedStatus   int     `json:"expectedStatus,omitempty" minimum:"0" maximum:"599" doc:"HTTP status code that counts as up (any 2xx/3xx when 0)"`
+	IntervalSeconds  int     `json:"intervalSeconds,omitempty" minimum:"0" doc:"Seconds between checks (default 60, minimum 15)"`
+	TimeoutSeconds   int     `json:"timeoutSeconds,omitempty" minimum:"0" doc:"Seconds before a check fails (default 10)"`
+	FailureThreshold int     `json:"failureThreshold,omitempty" minimum:"0" doc:"Consecutive failures before alerting (default 1)"`
+	EnvironmentID    string  `json:"environmentId,omitempty" doc:"Environment the monitored service runs in (default local)"`
+	ContainerID      *string `json:"containerId,omitempty" doc:"Container the monitor is tied to"`
+	ProjectID        *string `json:"projectId,omitempty" doc:"Compose project the monitor is tied to"`
+	ServiceName      *string `json:"serviceName,omitempty" doc:"Compose service the monitor is tied to"`
+	Enabled          *bool   `json:"enabled,omitempty" doc:"Whether the monitor is checked (default true)"`
+}
+
+// UpdateMonitor is the request body for updating a monitor. Omitted fields are left unchanged.
+type UpdateMonitor struct {
+	Name             *string `json:"name,omitempty" maxLength:"255" doc:"Display name of the monitor"`
+	Target           *string `json:"target,omitempty" doc:"URL for HTTP checks or host:port for TCP checks"`
+	ExpectedStatus   *int    `json:"expectedStatus,omitempty" minimum:"0" maximum:"599" doc:"HTTP status code that counts as up"`
+	IntervalSeconds  *int    `json:"intervalSeconds,omitempty" minimum:"0" doc:"Seconds between checks"`
+	TimeoutSeconds   *int    `json:"timeoutSeconds,omitempty" minimum:"0" doc:"Seconds before a check fails"`
+	FailureThreshold *int    `json:"failureThreshold,omitempty" minimum:"0" doc:"Consecutive failures before alerting"`
+	ContainerID      *string `json:"containerId,omitempty" doc:"Container the monitor is tied to"`
+	ProjectID        *string `json:"projectId,omitempty" doc:"Compose project the monitor is tied to"`
+	ServiceName      *string `json:"serviceName,omitempty" doc:"Compose service the monitor is tied to"`
+	Enabled          *bool   `json:"enabled,omitempty" doc:"Whether the monitor is checked"`
+}
+
+// CheckResult is the outcome of a single monitor check.
+type CheckResult struct {
+	MonitorID  string    `json:"monitorId" doc:"Monitor that was checked"`
+	Up         bool      `json:"up" doc:"Whether the check succeeded"`
+	LatencyMs  int64     `json:"latencyMs" doc:"Check latency in milliseconds"`
+	StatusCode int       `json:"statusCode,omitempty" doc:"HTTP status code returned by the target"`
+	Error      string    `json:"error,omitempty" doc:"Failure reason"`
+	CheckedAt  time.Time `json:"checkedAt" doc:"Time of the check"`
+}
@@ -1,6 +1,10 @@
 package notification
 
-import "github.com/getarcaneapp/arcane/types/base"
+import (
+	"time"
+
+	"github.com/getarcaneapp/arcane/types/base"
+)
 
 // Provider is the type for notification provider identifiers.
 type Provider string
@@ -71,6 +75,22 @@ type Response struct {
 	//
 	// Required: true
 	Config base.JsonObject `json:"config"`
+
+	// CredentialStatus is the result of the last credential check
+	// (valid, invalid, error or unsupported).
+	//
+	// Required: false
+	CredentialStatus *string `json:"credentialStatus,omitempty"`
+
+	// CredentialError describes why the last credential check failed.
+	//
+	// Required: false
+	CredentialError *string `json:"credentialError,omitempty"`
+
+	// CredentialCheckedAt is when the credentials were last checked.
+	//
+	// Required: false
+	CredentialCheckedAt *time.Time `json:"credentialCheckedAt,omitempty"`
 }
 
 type AppriseUpdate struct {
@@ -121,3 +141,141 @@ type AppriseResponse struct {
 	// Required: false
 	ContainerUpdateTag string `json:"containerUpdateTag"`
 }
+
+// ExportRequest is the request body used to export all notification provider settings.
+type ExportRequest struct {
+	// Passphrase used to encrypt provider secrets in the bundle. When empty,
+	// secrets are left out and must be re-entered after import.
+	//
+	// Required: false
+	Passphrase string `json:"passphrase,omitempty"`
+}
+
+// Bundle is a portable export of notification provider settings.
+type Bundle struct {
+	// Version of the bundle format.
+	//
+	// Required: true
+	Version int `json:"version"`
+
+	// ExportedAt is when the bundle was produced.
+	//
+	// Required: true
+	ExportedAt time.Time `json:"exportedAt"`
+
+	// Salt is the base64 encoded salt used to derive the secret encryption key.
+	// It is empty when the bundle contains no secrets.
+	//
+	// Required: false
+	Salt string `json:"salt,omitempty"`
+
+	// Providers contains the exported provider settings.
+	//
+	// Required: true
+	Providers []BundleProvider `json:"providers"`
+}
+
+// BundleProvider is a single provider entry in a Bundle.
+type BundleProvider struct {
+	// Provider is the notification provider type.
+	//
+	// Required: true
+	Provider Provider `json:"provider"`
+
+	// Enabled indicates if the notification provider is enabled.
+	//
+	// Required: true
+	Enabled bool `json:"enabled"`
+
+	// Config contains the provider-specific configuration. Secret values are
+	// encrypted with the bundle passphrase, or omitted when none was given.
+	//
+	// Required: true
+	Config base.JsonObject `json:"config"`
+
+	// SecretKeys lists the config keys that hold secrets.
+	//
+	// Required: false
+	SecretKeys []string `json:"secretKeys,omitempty"`
+}
+
+// ImportRequest is the request body used to import a Bundle.
+type ImportRequest struct {
+	// Bundle produced by an export.
+	//
+	// Required: true
+	Bundle Bundle `json:"bundle"`
+
+	// Passphrase the bundle secrets were encrypted with.
+	//
+	// Required: false
+	Passphrase string `json:"passphrase,omitempty"`
+}
+
+// SettingsImportResult summarizes a notification settings import.
+type SettingsImportResult struct {
+	// Imported lists the providers that were created or updated.
+	//
+	// Required: true
+	Imported []Provider `json:"imported"`
+
+	// Warnings lists providers or secrets that were skipped.
+	//
+	// Required: false
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+type Subscription struct {
+	// ID is the unique identifier of the subscription.
+	//
+	// Required: true
+	ID string `json:"id"`
+
+	// Provider is the global provider used for delivery (email or telegram).
+	//
+	// Required: true
+	Provider Provider `json:"provider"`
+
+	// Target is the user's email address or Telegram chat ID.
+	//
+	// Required: true
+	Target string `json:"target"`
+
+	// Events lists the notification events the user is subscribed to.
+	//
+	// Required: true
+	Events []string `json:"events"`
+
+	// Enabled indicates if the subscription is active.
+	//
+	// Required: true
+	Enabled bool `json:"enabled"`
+
+	// ProviderAvailable is false when the global provider is not enabled, in
+	// which case nothing is delivered to the subscription.
+	//
+	// Required: true
+	ProviderAvailable bool `json:"providerAvailable"`
+
+	// UpdatedAt is when the subscription was last changed.
+	//
+	// Required: false
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+type SubscriptionUpdate struct {
+	// Target is the user's email address or Telegram chat ID.
+	//
+	// Required: true
+	Target string `json:"target"`
+
+	// Events lists the notification events to subscribe to.
+	//
+	// Required: true
+	Events []string `json:"events"`
+
+	// Enabled indicates if the subscription is active.
+	//
+	// Required: true
+	Enabled bool `json:"enabled"`
+}
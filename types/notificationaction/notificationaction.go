@@ -0,0 +1,28 @@
+package notificationaction
+
+import "time"
+
+const (
+	// ActionApplyUpdate applies the pending update of the image to the
+	// containers and projects that use it.
+	ActionApplyUpdate = "image.apply-update"
+	// ActionSnooze holds back update notifications and automatic updates for
+	// the image for a number of days.
+	ActionSnooze = "image.snooze"
+)
+
+// Action describes what a notification action link does, so the page it opens
+// can ask for confirmation before performing it.
+type Action struct {
+	Action     string    `json:"action" doc:"Action the link performs: image.apply-update or image.snooze"`
+	ImageRef   string    `json:"imageRef" doc:"Image the action applies to"`
+	SnoozeDays int       `json:"snoozeDays,omitempty" doc:"Days notifications are snoozed for"`
+	ExpiresAt  time.Time `json:"expiresAt" doc:"Time the link stops working"`
+}
+
+// Outcome reports that an action was performed or started.
+type Outcome struct {
+	Action   string `json:"action" doc:"Action that was performed"`
+	ImageRef string `json:"imageRef" doc:"Image the action applies to"`
+	Text     string `json:"text" doc:"Human-readable summary of the outcome"`
+}
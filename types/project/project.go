@@ -2,6 +2,7 @@ package project
 
 import (
 	composetypes "github.com/compose-spec/compose-go/v2/types"
+	"github.com/getarcaneapp/arcane/types/container"
 	"github.com/getarcaneapp/arcane/types/containerregistry"
 )
 
@@ -41,6 +42,26 @@ type CreateProject struct {
 	EnvContent *string `json:"envContent,omitempty"`
 }
 
+// AdoptContainers is used to move standalone containers into a new project.
+type AdoptContainers struct {
+	// Name of the project to create.
+	//
+	// Required: true
+	Name string `json:"name" binding:"required"`
+
+	// ContainerIDs are the IDs or names of the containers to adopt. They must
+	// not already belong to a compose project.
+	//
+	// Required: true
+	ContainerIDs []string `json:"containerIds" binding:"required" minItems:"1"`
+
+	// Recreate replaces the original containers with ones managed by the new
+	// project. When false only the project is created.
+	//
+	// Required: false
+	Recreate bool `json:"recreate,omitempty"`
+}
+
 // UpdateProject is used to update a project.
 type UpdateProject struct {
 	// Name of the project.
@@ -85,6 +106,112 @@ type UpdateIncludeFile struct {
 	Content string `json:"content" binding:"required"`
 }
 
+// CustomFileType is a kind of custom project file Arcane can template and
+// validate.
+type CustomFileType string
+
+const (
+	CustomFileTypeNginx      CustomFileType = "nginx.conf"
+	CustomFileTypeCaddyfile  CustomFileType = "caddyfile"
+	CustomFileTypePrometheus CustomFileType = "prometheus.yml"
+	CustomFileTypeJSON       CustomFileType = "json"
+	CustomFileTypeYAML       CustomFileType = "yaml"
+	CustomFileTypePlain      CustomFileType = "plain"
+)
+
+// CreateCustomFile is used to create a custom file within a project.
+type CreateCustomFile struct {
+	// RelativePath is the path of the new file relative to the project.
+	//
+	// Required: true
+	RelativePath string `json:"relativePath" binding:"required"`
+
+	// Type selects the template and syntax validation. Defaults to the type
+	// detected from the file name, or plain.
+	//
+	// Required: false
+	Type CustomFileType `json:"type,omitempty" enum:"nginx.conf,caddyfile,prometheus.yml,json,yaml,plain"`
+
+	// Content is the file content. When omitted, the type's template is used.
+	//
+	// Required: false
+	Content *string `json:"content,omitempty"`
+}
+
+// CustomFileTemplate is the starting point for a custom file type.
+type CustomFileTemplate struct {
+	// Type is the custom file type.
+	//
+	// Required: true
+	Type CustomFileType `json:"type"`
+
+	// DefaultName is the file name suggested for the type.
+	//
+	// Required: true
+	DefaultName string `json:"defaultName"`
+
+	// Content is the template content.
+	//
+	// Required: true
+	Content string `json:"content"`
+}
+
+// FileSyntaxIssue is a syntax problem found in a custom file.
+type FileSyntaxIssue struct {
+	// Line is the 1-based line of the problem, or 0 when unknown.
+	//
+	// Required: true
+	Line int `json:"line"`
+
+	// Column is the 1-based column of the problem, or 0 when unknown.
+	//
+	// Required: true
+	Column int `json:"column"`
+
+	// Message describes the problem.
+	//
+	// Required: true
+	Message string `json:"message"`
+}
+
+// FileReference is a place in a project's compose configuration that points
+// at a file.
+type FileReference struct {
+	// Kind is what references the file: include, env_file, volume, config or secret.
+	//
+	// Required: true
+	Kind string `json:"kind"`
+
+	// ComposeFile is the compose file holding the reference, relative to the project.
+	//
+	// Required: true
+	ComposeFile string `json:"composeFile"`
+
+	// Service is the service holding the reference, for env_file and volume references.
+	//
+	// Required: false
+	Service string `json:"service,omitempty"`
+
+	// Value is the path as written in the compose file.
+	//
+	// Required: true
+	Value string `json:"value"`
+}
+
+// DeleteFileResult is the outcome of deleting a file from a project.
+type DeleteFileResult struct {
+	// RelativePath is the deleted file, relative to the project.
+	//
+	// Required: true
+	RelativePath string `json:"relativePath"`
+
+	// References are the compose references to the file that were overridden
+	// with force. The next deploy may fail until they are removed.
+	//
+	// Required: false
+	References []FileReference `json:"references,omitempty"`
+}
+
 // RuntimeService contains live container status information for a service.
 type RuntimeService struct {
 	// Name is the service name from the compose file.
@@ -131,6 +258,22 @@ type RuntimeService struct {
 	//
 	// Required: false
 	ServiceConfig *composetypes.ServiceConfig `json:"serviceConfig,omitempty"`
+
+	// Runtime is the OCI runtime the service runs with, e.g. "nvidia".
+	//
+	// Required: false
+	Runtime string `json:"runtime,omitempty"`
+
+	// Devices are the host devices mapped into the service's containers.
+	//
+	// Required: false
+	Devices []container.DeviceMapping `json:"devices,omitempty"`
+
+	// DeviceRequests are the devices, such as GPUs, the service requests
+	// through gpus or deploy.resources.reservations.devices.
+	//
+	// Required: false
+	DeviceRequests []container.DeviceRequest `json:"deviceRequests,omitempty"`
 }
 
 // CreateReponse is the response when a project is created.
@@ -297,6 +440,400 @@ type Details struct {
 	//
 	// Required: false
 	GitRepositoryURL string `json:"gitRepositoryURL,omitempty"`
+
+	// EnvWarnings lists problems with the variables referenced by the compose
+	// file, such as variables that are not defined anywhere.
+	//
+	// Required: false
+	EnvWarnings []string `json:"envWarnings,omitempty"`
+
+	// LintFindings are the compose lint rules the project's services break.
+	//
+	// Required: false
+	LintFindings []LintFinding `json:"lintFindings,omitempty"`
+
+	// Locked indicates the project is protected from deploy, down, destroy
+	// and update operations.
+	//
+	// Required: false
+	Locked bool `json:"locked,omitempty"`
+
+	// LockReason is why the project was locked.
+	//
+	// Required: false
+	LockReason *string `json:"lockReason,omitempty"`
+
+	// LockedBy is the username of the user who locked the project.
+	//
+	// Required: false
+	LockedBy *string `json:"lockedBy,omitempty"`
+
+	// LockedAt is the date and time when the project was locked.
+	//
+	// Required: false
+	LockedAt *string `json:"lockedAt,omitempty"`
+
+	// Maintenance indicates the project's services are stopped and a
+	// placeholder page is served in their place.
+	//
+	// Required: false
+	Maintenance bool `json:"maintenance,omitempty"`
+
+	// MaintenanceMessage is the message shown on the placeholder page.
+	//
+	// Required: false
+	MaintenanceMessage *string `json:"maintenanceMessage,omitempty"`
+
+	// MaintenanceStartedAt is the date and time when maintenance started.
+	//
+	// Required: false
+	MaintenanceStartedAt *string `json:"maintenanceStartedAt,omitempty"`
+
+	// Tags are the user-defined tags Arcane stores for the project.
+	//
+	// Required: false
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// EnvVariableSource is where a referenced variable's value comes from.
+type EnvVariableSource string
+
+const (
+	EnvVariableSourceProject EnvVariableSource = "project"
+	EnvVariableSourceGlobal  EnvVariableSource = "global"
+	EnvVariableSourceProcess EnvVariableSource = "process"
+	EnvVariableSourceBuiltin EnvVariableSource = "builtin"
+)
+
+// EnvVariable describes a variable referenced by ${VAR} interpolation.
+type EnvVariable struct {
+	// Name of the variable.
+	//
+	// Required: true
+	Name string `json:"name"`
+
+	// Defined reports whether any environment source sets the variable.
+	//
+	// Required: true
+	Defined bool `json:"defined"`
+
+	// Source is the environment source the value is taken from.
+	//
+	// Required: false
+	Source EnvVariableSource `json:"source,omitempty"`
+
+	// HasDefault reports whether every reference supplies a fallback, as in
+	// ${VAR:-default}.
+	//
+	// Required: true
+	HasDefault bool `json:"hasDefault"`
+
+	// Required reports whether a reference uses ${VAR:?message}, which makes
+	// compose fail when the variable is unset.
+	//
+	// Required: true
+	Required bool `json:"required"`
+
+	// Files maps each compose file that references the variable to the line
+	// numbers of the references.
+	//
+	// Required: true
+	Files map[string][]int `json:"files"`
+}
+
+// EnvCheck compares the variables referenced by a project's compose files
+// with the variables defined for it.
+type EnvCheck struct {
+	// Variables are all referenced variables, sorted by name.
+	//
+	// Required: true
+	Variables []EnvVariable `json:"variables"`
+
+	// Missing are referenced variables without a default that are not
+	// defined anywhere. They render as empty strings, or fail the deploy
+	// when marked required.
+	//
+	// Required: true
+	Missing []string `json:"missing"`
+
+	// Empty are referenced variables without a default that are defined with
+	// an empty value.
+	//
+	// Required: true
+	Empty []string `json:"empty"`
+
+	// Unused are variables in the project .env that no compose file
+	// references. They are still injected into containers when automatic
+	// env injection is enabled, so this is empty in that case.
+	//
+	// Required: true
+	Unused []string `json:"unused"`
+}
+
+// LintSeverity is how a compose lint rule is enforced.
+type LintSeverity string
+
+const (
+	// LintSeverityOff disables the rule.
+	LintSeverityOff LintSeverity = "off"
+	// LintSeverityWarning reports findings without blocking the save.
+	LintSeverityWarning LintSeverity = "warning"
+	// LintSeverityError blocks saving or syncing the compose file.
+	LintSeverityError LintSeverity = "error"
+)
+
+// LintFinding is one service breaking one compose lint rule.
+type LintFinding struct {
+	// Rule is the ID of the broken rule, such as no-latest-tag.
+	//
+	// Required: true
+	Rule string `json:"rule"`
+
+	// Severity is the severity the rule is configured with.
+	//
+	// Required: true
+	Severity LintSeverity `json:"severity" enum:"warning,error"`
+
+	// Service is the compose service that breaks the rule.
+	//
+	// Required: true
+	Service string `json:"service"`
+
+	// Message describes the problem.
+	//
+	// Required: true
+	Message string `json:"message"`
+}
+
+// ResourceQuota caps the total CPU and memory limits of a project's
+// containers. A zero value leaves that resource unlimited.
+type ResourceQuota struct {
+	// CPUs is the number of CPU cores the project may use in total.
+	//
+	// Required: false
+	CPUs float64 `json:"cpus,omitempty" minimum:"0"`
+
+	// MemoryBytes is the memory the project may use in total.
+	//
+	// Required: false
+	MemoryBytes int64 `json:"memoryBytes,omitempty" minimum:"0"`
+}
+
+// ServiceAllocation is the share of a quota taken by one compose service.
+type ServiceAllocation struct {
+	// Service is the compose service name.
+	//
+	// Required: true
+	Service string `json:"service"`
+
+	// Replicas is the number of containers the service runs.
+	//
+	// Required: true
+	Replicas int `json:"replicas"`
+
+	// CPUs is the CPU limit of each container, or zero when unlimited.
+	//
+	// Required: true
+	CPUs float64 `json:"cpus"`
+
+	// MemoryBytes is the memory limit of each container, or zero when
+	// unlimited.
+	//
+	// Required: true
+	MemoryBytes int64 `json:"memoryBytes"`
+
+	// CPUsInjected reports whether the CPU limit comes from the quota rather
+	// than the compose file.
+	//
+	// Required: true
+	CPUsInjected bool `json:"cpusInjected"`
+
+	// MemoryInjected reports whether the memory limit comes from the quota
+	// rather than the compose file.
+	//
+	// Required: true
+	MemoryInjected bool `json:"memoryInjected"`
+}
+
+// QuotaAllocation is how a quota is divided between a project's services.
+type QuotaAllocation struct {
+	// Services are the limits of each service.
+	//
+	// Required: true
+	Services []ServiceAllocation `json:"services"`
+
+	// CPUs is the sum of the CPU limits of all containers.
+	//
+	// Required: true
+	CPUs float64 `json:"cpus"`
+
+	// MemoryBytes is the sum of the memory limits of all containers.
+	//
+	// Required: true
+	MemoryBytes int64 `json:"memoryBytes"`
+
+	// Warnings describe limits that exceed the quota, or services left
+	// without a limit because the quota is used up.
+	//
+	// Required: true
+	Warnings []string `json:"warnings"`
+}
+
+// ResourceUsage is the current resource use of a project's containers.
+type ResourceUsage struct {
+	// CPUs is the number of CPU cores in use.
+	//
+	// Required: true
+	CPUs float64 `json:"cpus"`
+
+	// MemoryBytes is the memory in use, excluding page cache.
+	//
+	// Required: true
+	MemoryBytes int64 `json:"memoryBytes"`
+
+	// Containers is the number of running containers measured.
+	//
+	// Required: true
+	Containers int `json:"containers"`
+}
+
+// QuotaStatus reports a project's quota against its configured limits and
+// current usage.
+type QuotaStatus struct {
+	// Quota is the configured quota, or nil when the project has none.
+	//
+	// Required: false
+	Quota *ResourceQuota `json:"quota,omitempty"`
+
+	// Allocation is how the quota is divided between services on deploy.
+	//
+	// Required: true
+	Allocation QuotaAllocation `json:"allocation"`
+
+	// Usage is the current use of the running containers.
+	//
+	// Required: true
+	Usage ResourceUsage `json:"usage"`
+}
+
+// Lock is used to lock a project.
+type Lock struct {
+	// Reason explains why the project is locked.
+	//
+	// Required: false
+	Reason string `json:"reason,omitempty" maxLength:"500"`
+}
+
+// Unlock is used to unlock a project.
+type Unlock struct {
+	// Reason explains why the project is unlocked. It is recorded in the
+	// project's events.
+	//
+	// Required: true
+	Reason string `json:"reason" minLength:"1" maxLength:"500"`
+}
+
+// StartMaintenance is used to put a project into maintenance mode.
+type StartMaintenance struct {
+	// Message is shown on the placeholder page.
+	//
+	// Required: false
+	Message string `json:"message,omitempty" maxLength:"1000"`
+}
+
+// Clone is used to copy a project into a new one.
+type Clone struct {
+	// Name is the name of the new project.
+	//
+	// Required: true
+	Name string `json:"name" minLength:"1"`
+
+	// CopyData copies the contents of bind-mounted directories inside the
+	// project. Without it they are created empty.
+	//
+	// Required: false
+	CopyData bool `json:"copyData,omitempty"`
+}
+
+// CloneResult is the project created by a clone.
+type CloneResult struct {
+	// Project is the new project.
+	//
+	// Required: true
+	Project Details `json:"project"`
+
+	// Changes describe the values rewritten in the compose file so the copy
+	// can run next to the original.
+	//
+	// Required: true
+	Changes []string `json:"changes"`
+}
+
+// ServicePatch changes single fields of one service in a project's compose
+// file. Fields that are not set are left alone.
+type ServicePatch struct {
+	// Image replaces the full image reference of the service.
+	//
+	// Required: false
+	Image *string `json:"image,omitempty" minLength:"1"`
+
+	// Tag replaces only the tag of the service's current image.
+	//
+	// Required: false
+	Tag *string `json:"tag,omitempty" minLength:"1"`
+
+	// Environment sets environment variables of the service, adding the ones
+	// that are missing.
+	//
+	// Required: false
+	Environment map[string]string `json:"environment,omitempty"`
+
+	// Replicas sets the number of containers of the service.
+	//
+	// Required: false
+	Replicas *int `json:"replicas,omitempty" minimum:"0"`
+
+	// Ports sets published ports by container port, adding the ones that
+	// are not published yet.
+	//
+	// Required: false
+	Ports []ServicePortPatch `json:"ports,omitempty"`
+
+	// Redeploy recreates the service right after the compose file is saved.
+	//
+	// Required: false
+	Redeploy bool `json:"redeploy,omitempty"`
+}
+
+// ServicePortPatch publishes a container port of a service on a host port.
+type ServicePortPatch struct {
+	// Target is the port inside the container.
+	//
+	// Required: true
+	Target int `json:"target" minimum:"1" maximum:"65535"`
+
+	// Published is the port on the host.
+	//
+	// Required: true
+	Published int `json:"published" minimum:"1" maximum:"65535"`
+
+	// Protocol is the port's protocol. Defaults to tcp.
+	//
+	// Required: false
+	Protocol string `json:"protocol,omitempty" enum:"tcp,udp"`
+}
+
+// ServicePatchResult is the outcome of a service patch.
+type ServicePatchResult struct {
+	// Changes describe each value changed in the compose file.
+	//
+	// Required: true
+	Changes []string `json:"changes"`
+
+	// Redeployed reports whether the service was recreated.
+	//
+	// Required: true
+	Redeployed bool `json:"redeployed"`
 }
 
 // Destroy is used to destroy a project.
@@ -337,3 +874,101 @@ type ImagePullRequest struct {
 	// Required: false
 	Credentials []containerregistry.Credential `json:"credentials,omitempty"`
 }
+
+// Registries lists the container registries whose credentials are scoped to a
+// project. Scoped credentials are only used to pull that project's images.
+type Registries struct {
+	// RegistryIDs are the IDs of the scoped registries.
+	//
+	// Required: true
+	RegistryIDs []string `json:"registryIds"`
+}
+
+// PathMappingHostSource is where the host side of the projects directory
+// mapping comes from.
+type PathMappingHostSource string
+
+const (
+	// PathMappingHostSourceSetting is a host path given in the
+	// "container:host" form of the projectsDirectory setting.
+	PathMappingHostSourceSetting PathMappingHostSource = "setting"
+	// PathMappingHostSourceDiscovered is a host path found from the bind
+	// mount of the Arcane container.
+	PathMappingHostSourceDiscovered PathMappingHostSource = "discovered"
+	// PathMappingHostSourceNone means no host path is known, so paths are
+	// passed to Docker unchanged.
+	PathMappingHostSourceNone PathMappingHostSource = "none"
+)
+
+// PathMappingDiagnostics explains how paths in the projects directory are
+// translated to host paths before compose files are sent to Docker.
+type PathMappingDiagnostics struct {
+	// ConfiguredValue is the projectsDirectory setting as stored.
+	//
+	// Required: true
+	ConfiguredValue string `json:"configuredValue"`
+
+	// ContainerPath is the resolved projects directory inside Arcane.
+	//
+	// Required: true
+	ContainerPath string `json:"containerPath"`
+
+	// HostPath is the projects directory on the Docker host. Empty when no
+	// host path is known.
+	//
+	// Required: false
+	HostPath string `json:"hostPath,omitempty"`
+
+	// HostPathSource is where HostPath comes from.
+	//
+	// Required: true
+	HostPathSource PathMappingHostSource `json:"hostPathSource"`
+
+	// MountType is the type of the Arcane container mount that holds the
+	// projects directory, such as "bind" or "volume".
+	//
+	// Required: false
+	MountType string `json:"mountType,omitempty"`
+
+	// MountSource is the host source of that mount.
+	//
+	// Required: false
+	MountSource string `json:"mountSource,omitempty"`
+
+	// MountDestination is where that mount is attached inside Arcane.
+	//
+	// Required: false
+	MountDestination string `json:"mountDestination,omitempty"`
+
+	// DiscoveryError is why the Arcane container mounts could not be read,
+	// for example because Arcane does not run in a container.
+	//
+	// Required: false
+	DiscoveryError string `json:"discoveryError,omitempty"`
+
+	// TranslationActive reports whether paths are rewritten, which happens
+	// when the container and host paths differ.
+	//
+	// Required: true
+	TranslationActive bool `json:"translationActive"`
+
+	// Decision explains the outcome in plain words.
+	//
+	// Required: true
+	Decision string `json:"decision"`
+
+	// SampleContainerPath is an example compose file path inside Arcane.
+	//
+	// Required: true
+	SampleContainerPath string `json:"sampleContainerPath"`
+
+	// SampleHostPath is the path Docker is given for SampleContainerPath.
+	//
+	// Required: true
+	SampleHostPath string `json:"sampleHostPath"`
+
+	// Warnings lists likely misconfigurations.
+	//
+	// Required: false
+	Warnings []string `json:"warnings,omitempty"`
+}
@@ -0,0 +1,67 @@
+package rbac
+
+import "time"
+
+const (
+	// RoleViewer grants read-only access to the scoped resources.
+	RoleViewer = "viewer"
+	// RoleOperator grants read access plus lifecycle and deploy operations.
+	RoleOperator = "operator"
+	// RoleAdmin grants full access to the scoped resources, including configuration.
+	RoleAdmin = "admin"
+)
+
+// Roles lists all assignable roles ordered from least to most privileged.
+var Roles = []string{RoleViewer, RoleOperator, RoleAdmin}
+
+// RoleRank returns the privilege rank of a role. Unknown roles rank below viewer.
+func RoleRank(role string) int {
+	switch role {
+	case RoleViewer:
+		return 1
+	case RoleOperator:
+		return 2
+	case RoleAdmin:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// RoleSatisfies reports whether the granted role is at least as privileged as the required role.
+func RoleSatisfies(granted, required string) bool {
+	return RoleRank(granted) >= RoleRank(required) && RoleRank(granted) > 0
+}
+
+// IsValidRole reports whether role is one of the assignable roles.
+func IsValidRole(role string) bool {
+	return RoleRank(role) > 0
+}
+
+// RoleBinding represents a role assigned to a user, optionally scoped to an environment or project.
+type RoleBinding struct {
+	ID            string     `json:"id" doc:"Unique identifier of the role binding"`
+	UserID        string     `json:"userId" doc:"ID of the user the role is assigned to"`
+	Role          string     `json:"role" enum:"viewer,operator,admin" doc:"Assigned role"`
+	EnvironmentID *string    `json:"environmentId,omitempty" doc:"Environment the binding is scoped to (global when empty)"`
+	ProjectID     *string    `json:"projectId,omitempty" doc:"Project the binding is scoped to (environment-wide when empty)"`
+	CreatedAt     time.Time  `json:"createdAt" doc:"Creation timestamp"`
+	UpdatedAt     *time.Time `json:"updatedAt,omitempty" doc:"Last update timestamp"`
+}
+
+// CreateRoleBinding represents the request body for assigning a role to a user.
+type CreateRoleBinding struct {
+	UserID        string  `json:"userId" minLength:"1" doc:"ID of the user the role is assigned to"`
+	Role          string  `json:"role" enum:"viewer,operator,admin" doc:"Role to assign"`
+	EnvironmentID *string `json:"environmentId,omitempty" doc:"Environment to scope the binding to (global when empty)"`
+	ProjectID     *string `json:"projectId,omitempty" doc:"Project to scope the binding to; requires environmentId"`
+}
+
+// EffectiveRole describes the role resolved for a user within a scope.
+type EffectiveRole struct {
+	UserID        string `json:"userId" doc:"ID of the user"`
+	Role          string `json:"role" doc:"Effective role within the requested scope"`
+	EnvironmentID string `json:"environmentId,omitempty" doc:"Environment scope that was evaluated"`
+	ProjectID     string `json:"projectId,omitempty" doc:"Project scope that was evaluated"`
+	Source        string `json:"source" enum:"project,environment,global,user" doc:"Which binding level produced the role"`
+}
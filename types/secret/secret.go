@@ -0,0 +1,62 @@
+package secret
+
+import "time"
+
+// Secret describes a stored secret. The value is never returned by the API.
+type Secret struct {
+	// ID of the secret.
+	//
+	// Required: true
+	ID string `json:"id"`
+
+	// Name used to reference the secret as ${arcane:name}.
+	//
+	// Required: true
+	Name string `json:"name"`
+
+	// Description of the secret.
+	//
+	// Required: false
+	Description *string `json:"description,omitempty"`
+
+	// CreatedAt is the date and time at which the secret was created.
+	//
+	// Required: true
+	CreatedAt time.Time `json:"createdAt"`
+
+	// UpdatedAt is the date and time at which the secret was last updated.
+	//
+	// Required: false
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+// CreateRequest is the request body for creating a secret.
+type CreateRequest struct {
+	// Name may contain letters, digits, dots, dashes and underscores.
+	//
+	// Required: true
+	Name string `json:"name" minLength:"1" maxLength:"128"`
+
+	// Value is the plaintext secret value. It is encrypted before storage.
+	//
+	// Required: true
+	Value string `json:"value" minLength:"1"`
+
+	// Description of the secret.
+	//
+	// Required: false
+	Description *string `json:"description,omitempty"`
+}
+
+// UpdateRequest is the request body for updating a secret.
+type UpdateRequest struct {
+	// Value replaces the stored secret value when set.
+	//
+	// Required: false
+	Value *string `json:"value,omitempty"`
+
+	// Description of the secret.
+	//
+	// Required: false
+	Description *string `json:"description,omitempty"`
+}
@@ -0,0 +1,144 @@
+package securityaudit
+
+import "time"
+
+// Severity is how much a failed check weakens a container's isolation.
+type Severity string
+
+const (
+	SeverityLow      Severity = "low"
+	SeverityMedium   Severity = "medium"
+	SeverityHigh     Severity = "high"
+	SeverityCritical Severity = "critical"
+)
+
+// Check IDs.
+const (
+	CheckPrivileged     = "privileged"
+	CheckHostNetwork    = "host-network"
+	CheckDockerSocket   = "docker-socket"
+	CheckWritableRootfs = "writable-rootfs"
+	CheckAddedCaps      = "added-capabilities"
+	CheckLatestTag      = "latest-tag"
+)
+
+// ExportFormat is the file format of an exported audit summary.
+type ExportFormat string
+
+const (
+	ExportFormatJSON ExportFormat = "json"
+	ExportFormatCSV  ExportFormat = "csv"
+)
+
+// Finding is one best practice a container does not follow.
+type Finding struct {
+	// Check is the ID of the failed check, such as privileged.
+	//
+	// Required: true
+	Check string `json:"check"`
+
+	// Severity is how much the finding weakens the container's isolation.
+	//
+	// Required: true
+	Severity Severity `json:"severity" enum:"low,medium,high,critical"`
+
+	// Message describes what was found.
+	//
+	// Required: true
+	Message string `json:"message"`
+
+	// Remediation describes how to fix the finding in a compose file.
+	//
+	// Required: true
+	Remediation string `json:"remediation"`
+}
+
+// ContainerReport is the audit result of a single container.
+type ContainerReport struct {
+	// ID is the container ID.
+	//
+	// Required: true
+	ID string `json:"id"`
+
+	// Name is the container name.
+	//
+	// Required: true
+	Name string `json:"name"`
+
+	// Image is the image reference the container was created from.
+	//
+	// Required: true
+	Image string `json:"image"`
+
+	// Project is the compose project the container belongs to.
+	//
+	// Required: false
+	Project string `json:"project,omitempty"`
+
+	// Running reports whether the container is running.
+	//
+	// Required: true
+	Running bool `json:"running"`
+
+	// Score is 100 minus the weight of each finding, floored at 0.
+	//
+	// Required: true
+	Score int `json:"score"`
+
+	// Findings are the failed checks, most severe first.
+	//
+	// Required: true
+	Findings []Finding `json:"findings"`
+}
+
+// ProjectReport summarises the containers of one compose project.
+type ProjectReport struct {
+	// Name is the compose project name.
+	//
+	// Required: true
+	Name string `json:"name"`
+
+	// Score is the lowest score of the project's containers.
+	//
+	// Required: true
+	Score int `json:"score"`
+
+	// Containers is the number of audited containers in the project.
+	//
+	// Required: true
+	Containers int `json:"containers"`
+
+	// Findings is the number of findings across the project's containers.
+	//
+	// Required: true
+	Findings int `json:"findings"`
+}
+
+// Report is the audit of the containers on a host or in a project.
+type Report struct {
+	// GeneratedAt is when the audit ran.
+	//
+	// Required: true
+	GeneratedAt time.Time `json:"generatedAt"`
+
+	// Score is the average container score, or 100 with no containers.
+	//
+	// Required: true
+	Score int `json:"score"`
+
+	// SeverityCounts is the number of findings per severity.
+	//
+	// Required: true
+	SeverityCounts map[Severity]int `json:"severityCounts"`
+
+	// Containers are the audited containers, lowest score first.
+	//
+	// Required: true
+	Containers []ContainerReport `json:"containers"`
+
+	// Projects summarises the containers per compose project, lowest score
+	// first.
+	//
+	// Required: true
+	Projects []ProjectReport `json:"projects"`
+}
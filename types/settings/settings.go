@@ -67,6 +67,18 @@ type Update struct {
 	// Required: false
 	AutoInjectEnv *string `json:"autoInjectEnv,omitempty"`
 
+	// ComposeTemplateVariables holds NAME=value lines that compose files can
+	// reference as {{arcane.NAME}}.
+	//
+	// Required: false
+	ComposeTemplateVariables *string `json:"composeTemplateVariables,omitempty"`
+
+	// ComposeLintRules is the JSON configuration of the compose lint rules
+	// checked when a project is saved or synced from Git.
+	//
+	// Required: false
+	ComposeLintRules *string `json:"composeLintRules,omitempty"`
+
 	// EnvironmentHealthInterval is the interval for checking environment health.
 	//
 	// Required: false
@@ -77,6 +89,11 @@ type Update struct {
 	// Required: false
 	PruneMode *string `json:"dockerPruneMode,omitempty" binding:"omitempty,oneof=all dangling"`
 
+	// PruneProtectionLabel is the label key that keeps containers, images, volumes and networks out of prune-all (empty disables).
+	//
+	// Required: false
+	PruneProtectionLabel *string `json:"pruneProtectionLabel,omitempty"`
+
 	// DefaultDeployPullPolicy is the default image pull policy used for project deploys.
 	//
 	// Required: false
@@ -117,6 +134,30 @@ type Update struct {
 	// Required: false
 	ScheduledPruneBuildCache *string `json:"scheduledPruneBuildCache,omitempty"`
 
+	// StaleResourcesEnabled indicates if the stale resource report is refreshed
+	// on a schedule.
+	//
+	// Required: false
+	StaleResourcesEnabled *string `json:"staleResourcesEnabled,omitempty"`
+
+	// StaleResourcesInterval is the cron expression for how often to refresh the
+	// stale resource report.
+	//
+	// Required: false
+	StaleResourcesInterval *string `json:"staleResourcesInterval,omitempty"`
+
+	// StaleContainerDays is the number of days a container must have been
+	// stopped before it is reported as stale.
+	//
+	// Required: false
+	StaleContainerDays *string `json:"staleContainerDays,omitempty"`
+
+	// StaleImageDays is the number of days an unused image must have existed
+	// before it is reported as stale.
+	//
+	// Required: false
+	StaleImageDays *string `json:"staleImageDays,omitempty"`
+
 	// VulnerabilityScanEnabled indicates if scheduled vulnerability scanning is enabled.
 	//
 	// Required: false
@@ -147,6 +188,27 @@ type Update struct {
 	// Required: false
 	DefaultShell *string `json:"defaultShell,omitempty"`
 
+	// OutboundProxyUrl is the HTTP(S) or SOCKS5 proxy used for outbound requests.
+	// Empty falls back to the HTTP_PROXY and HTTPS_PROXY environment variables.
+	//
+	// Required: false
+	OutboundProxyUrl *string `json:"outboundProxyUrl,omitempty"`
+
+	// OutboundNoProxy lists hosts, domains or CIDR ranges that bypass the outbound proxy.
+	//
+	// Required: false
+	OutboundNoProxy *string `json:"outboundNoProxy,omitempty"`
+
+	// MaintenanceModeEnabled indicates if Arcane rejects mutating requests during host maintenance.
+	//
+	// Required: false
+	MaintenanceModeEnabled *string `json:"maintenanceModeEnabled,omitempty"`
+
+	// MaintenanceModeMessage is the message returned while maintenance mode is active.
+	//
+	// Required: false
+	MaintenanceModeMessage *string `json:"maintenanceModeMessage,omitempty"`
+
 	// DockerHost is the Docker host connection string.
 	//
 	// Required: false
@@ -177,6 +239,12 @@ type Update struct {
 	// Required: false
 	AuthSessionTimeout *string `json:"authSessionTimeout,omitempty"`
 
+	// CorsAllowedOrigins lists extra origins, separated by commas or new lines,
+	// that browsers may call the API from. The application URL is always allowed.
+	//
+	// Required: false
+	CorsAllowedOrigins *string `json:"corsAllowedOrigins,omitempty"`
+
 	// AuthPasswordPolicy is the password policy rules.
 	//
 	// Required: false
@@ -271,6 +339,26 @@ type Update struct {
 	// Required: false
 	OidcProviderLogoUrl *string `json:"oidcProviderLogoUrl,omitempty"`
 
+	// AuditForwardEnabled indicates if events are forwarded to an external SIEM.
+	//
+	// Required: false
+	AuditForwardEnabled *string `json:"auditForwardEnabled,omitempty"`
+
+	// AuditForwardProtocol is the forwarding transport (syslog-udp|syslog-tcp|http).
+	//
+	// Required: false
+	AuditForwardProtocol *string `json:"auditForwardProtocol,omitempty"`
+
+	// AuditForwardTarget is the syslog host:port or HTTP collector URL.
+	//
+	// Required: false
+	AuditForwardTarget *string `json:"auditForwardTarget,omitempty"`
+
+	// AuditForwardToken is the bearer token sent to HTTP collectors.
+	//
+	// Required: false
+	AuditForwardToken *string `json:"auditForwardToken,omitempty"`
+
 	// MobileNavigationMode is the navigation mode for mobile devices.
 	//
 	// Required: false
@@ -326,11 +414,84 @@ type Update struct {
 	// Required: false
 	ProxyRequestTimeout *string `json:"proxyRequestTimeout,omitempty"`
 
+	// AggregateRequestTimeout is the timeout per environment in seconds when
+	// listing resources across all environments.
+	//
+	// Required: false
+	AggregateRequestTimeout *string `json:"aggregateRequestTimeout,omitempty"`
+
+	// ProxyRequestRetries is the number of retries for read requests to
+	// remote environments that fail to connect.
+	//
+	// Required: false
+	ProxyRequestRetries *string `json:"proxyRequestRetries,omitempty"`
+
+	// EnvironmentBreakerThreshold is the number of consecutive failed requests
+	// before requests to a remote environment are paused. 0 disables it.
+	//
+	// Required: false
+	EnvironmentBreakerThreshold *string `json:"environmentBreakerThreshold,omitempty"`
+
+	// EnvironmentBreakerCooldown is how long in seconds requests to an
+	// unreachable remote environment stay paused.
+	//
+	// Required: false
+	EnvironmentBreakerCooldown *string `json:"environmentBreakerCooldown,omitempty"`
+
 	// AutoUpdateExcludedContainers is a comma-separated list of container names to exclude from auto-update.
 	//
 	// Required: false
 	AutoUpdateExcludedContainers *string `json:"autoUpdateExcludedContainers,omitempty"`
 
+	// AutoUpdateMonitorOnly is a comma-separated list of container or project names
+	// whose updates are detected and reported but never applied.
+	//
+	// Required: false
+	AutoUpdateMonitorOnly *string `json:"autoUpdateMonitorOnly,omitempty"`
+
+	// AutoUpdateMinFreeDisk is the free space in MB the Docker data directory
+	// needs before an update run pulls images (0 disables).
+	//
+	// Required: false
+	AutoUpdateMinFreeDisk *string `json:"autoUpdateMinFreeDisk,omitempty"`
+
+	// AutoUpdateRegistryCheck indicates if update runs check that the
+	// registries of pending updates are reachable before pulling images.
+	//
+	// Required: false
+	AutoUpdateRegistryCheck *string `json:"autoUpdateRegistryCheck,omitempty"`
+
+	// AutoUpdateMaxLoad is the host load average, as a percentage of the CPU
+	// count, above which update runs do not start (0 disables).
+	//
+	// Required: false
+	AutoUpdateMaxLoad *string `json:"autoUpdateMaxLoad,omitempty"`
+
+	// AutoUpdateRollbackWindow is the number of hours the previous image of
+	// each updated container is kept before it may be pruned (0 disables).
+	//
+	// Required: false
+	AutoUpdateRollbackWindow *string `json:"autoUpdateRollbackWindow,omitempty"`
+
+	// AutoUpdateRolloutStages is the JSON list of environment stages that
+	// scheduled updates roll out to in order.
+	//
+	// Required: false
+	AutoUpdateRolloutStages *string `json:"autoUpdateRolloutStages,omitempty"`
+
+	// ImageRetentionEnabled indicates if previous image versions of updated
+	// repositories are kept and trimmed on a schedule instead of being removed
+	// right after an update.
+	//
+	// Required: false
+	ImageRetentionEnabled *string `json:"imageRetentionEnabled,omitempty"`
+
+	// ImageRetentionKeepCount is the number of previous image versions to keep
+	// per repository.
+	//
+	// Required: false
+	ImageRetentionKeepCount *string `json:"imageRetentionKeepCount,omitempty"`
+
 	// AutoHealEnabled indicates if automatic container healing is enabled.
 	//
 	// Required: false
@@ -351,11 +512,131 @@ type Update struct {
 	// Required: false
 	AutoHealMaxRestarts *string `json:"autoHealMaxRestarts,omitempty"`
 
+	// ContainerSnapshotRetention is the number of snapshots kept per container.
+	//
+	// Required: false
+	ContainerSnapshotRetention *string `json:"containerSnapshotRetention,omitempty"`
+
 	// AutoHealRestartWindow is the time window in minutes for counting auto-heal restarts.
 	//
 	// Required: false
 	AutoHealRestartWindow *string `json:"autoHealRestartWindow,omitempty"`
 
+	// ContainerCrashThreshold is the number of non-zero container exits within the crash window that triggers a crash alert (0 disables).
+	//
+	// Required: false
+	ContainerCrashThreshold *string `json:"containerCrashThreshold,omitempty"`
+
+	// ContainerCrashWindow is the time window in minutes for counting container crashes.
+	//
+	// Required: false
+	ContainerCrashWindow *string `json:"containerCrashWindow,omitempty"`
+
+	// ContainerCrashCooldown is the number of minutes before another crash alert is sent for the same container.
+	//
+	// Required: false
+	ContainerCrashCooldown *string `json:"containerCrashCooldown,omitempty"`
+
+	// UnmanagedChangeDetection indicates if containers created, changed or removed outside Arcane raise an alert.
+	//
+	// Required: false
+	UnmanagedChangeDetection *string `json:"unmanagedChangeDetection,omitempty"`
+
+	// NotificationActionLinks indicates if image update notifications carry signed links that apply the update or snooze its notifications.
+	//
+	// Required: false
+	NotificationActionLinks *string `json:"notificationActionLinks,omitempty"`
+
+	// NotificationActionLinkHours is the number of hours a notification action link stays valid.
+	//
+	// Required: false
+	NotificationActionLinkHours *string `json:"notificationActionLinkHours,omitempty"`
+
+	// HostMetricsCpuThreshold is the host CPU usage percentage that triggers a notification (0 disables).
+	//
+	// Required: false
+	HostMetricsCpuThreshold *string `json:"hostMetricsCpuThreshold,omitempty"`
+
+	// HostMetricsMemoryThreshold is the host memory usage percentage that triggers a notification (0 disables).
+	//
+	// Required: false
+	HostMetricsMemoryThreshold *string `json:"hostMetricsMemoryThreshold,omitempty"`
+
+	// HostMetricsDiskThreshold is the host disk usage percentage that triggers a notification (0 disables).
+	//
+	// Required: false
+	HostMetricsDiskThreshold *string `json:"hostMetricsDiskThreshold,omitempty"`
+
+	// HostMetricsRetentionHours is how many hours of host metrics history are kept.
+	//
+	// Required: false
+	HostMetricsRetentionHours *string `json:"hostMetricsRetentionHours,omitempty"`
+
+	// EnvironmentHeartbeatTimeout is how many seconds without a heartbeat mark an environment offline (0 disables).
+	//
+	// Required: false
+	EnvironmentHeartbeatTimeout *string `json:"environmentHeartbeatTimeout,omitempty"`
+
+	// EnvironmentFlapWindow is the window in minutes used to suppress notifications for flapping environments.
+	//
+	// Required: false
+	EnvironmentFlapWindow *string `json:"environmentFlapWindow,omitempty"`
+
+	// ConfigBackupEnabled indicates if scheduled configuration backups are enabled.
+	//
+	// Required: false
+	ConfigBackupEnabled *string `json:"configBackupEnabled,omitempty"`
+
+	// ConfigBackupDestination is where scheduled backups are written (local|s3).
+	//
+	// Required: false
+	ConfigBackupDestination *string `json:"configBackupDestination,omitempty"`
+
+	// ConfigBackupPath is the directory for local scheduled backups.
+	//
+	// Required: false
+	ConfigBackupPath *string `json:"configBackupPath,omitempty"`
+
+	// ConfigBackupRetention is the number of local backups to keep.
+	//
+	// Required: false
+	ConfigBackupRetention *string `json:"configBackupRetention,omitempty"`
+
+	// ConfigBackupPassphrase is the passphrase used to encrypt scheduled backups.
+	//
+	// Required: false
+	ConfigBackupPassphrase *string `json:"configBackupPassphrase,omitempty"`
+
+	// ConfigBackupS3Endpoint is the base URL of the S3-compatible service.
+	//
+	// Required: false
+	ConfigBackupS3Endpoint *string `json:"configBackupS3Endpoint,omitempty"`
+
+	// ConfigBackupS3Region is the region of the S3 bucket.
+	//
+	// Required: false
+	ConfigBackupS3Region *string `json:"configBackupS3Region,omitempty"`
+
+	// ConfigBackupS3Bucket is the bucket scheduled backups are uploaded to.
+	//
+	// Required: false
+	ConfigBackupS3Bucket *string `json:"configBackupS3Bucket,omitempty"`
+
+	// ConfigBackupS3Prefix is the object key prefix for uploaded backups.
+	//
+	// Required: false
+	ConfigBackupS3Prefix *string `json:"configBackupS3Prefix,omitempty"`
+
+	// ConfigBackupS3AccessKey is the access key ID used to upload backups.
+	//
+	// Required: false
+	ConfigBackupS3AccessKey *string `json:"configBackupS3AccessKey,omitempty"`
+
+	// ConfigBackupS3SecretKey is the secret access key used to upload backups.
+	//
+	// Required: false
+	ConfigBackupS3SecretKey *string `json:"configBackupS3SecretKey,omitempty"`
+
 	// BuildProvider is the default build provider (local|depot).
 	//
 	// Required: false
@@ -0,0 +1,67 @@
+package settings
+
+const (
+	IssueSeverityError   = "error"
+	IssueSeverityWarning = "warning"
+)
+
+// ValidationIssue is a problem found with a setting value before it is saved.
+type ValidationIssue struct {
+	// Key is the setting the issue applies to.
+	//
+	// Required: true
+	Key string `json:"key"`
+
+	// Severity is "error" when the value cannot be saved, or "warning" when it
+	// can be saved but is likely a mistake.
+	//
+	// Required: true
+	Severity string `json:"severity"`
+
+	// Message describes the problem.
+	//
+	// Required: true
+	Message string `json:"message"`
+}
+
+// Change describes one setting a settings update would change.
+type Change struct {
+	// Key is the setting that would change.
+	//
+	// Required: true
+	Key string `json:"key"`
+
+	// OldValue is the current value. Empty for sensitive settings.
+	//
+	// Required: false
+	OldValue string `json:"oldValue,omitempty"`
+
+	// NewValue is the value that would be saved. Empty for sensitive settings.
+	//
+	// Required: false
+	NewValue string `json:"newValue,omitempty"`
+
+	// Effects describes what Arcane would do when the change is applied, such
+	// as rescheduling a job or recalculating the project path mapping.
+	//
+	// Required: false
+	Effects []string `json:"effects,omitempty"`
+}
+
+// DryApplyResult is the outcome of checking a settings update without saving it.
+type DryApplyResult struct {
+	// Valid indicates the update has no errors and can be saved.
+	//
+	// Required: true
+	Valid bool `json:"valid"`
+
+	// Issues lists the errors and warnings found in the update.
+	//
+	// Required: false
+	Issues []ValidationIssue `json:"issues,omitempty"`
+
+	// Changes lists the settings whose values would change.
+	//
+	// Required: false
+	Changes []Change `json:"changes,omitempty"`
+}
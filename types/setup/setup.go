@@ -0,0 +1,115 @@
+package setup
+
+import "github.com/getarcaneapp/arcane/types/settings"
+
+// Steps of the first-run setup, in the order they are presented.
+const (
+	StepAdmin             = "admin"
+	StepProjectsDirectory = "projectsDirectory"
+	StepDocker            = "docker"
+	StepNotification      = "notification"
+	StepEnvironment       = "environment"
+)
+
+const (
+	StepStatusPending   = "pending"
+	StepStatusCompleted = "completed"
+	StepStatusSkipped   = "skipped"
+)
+
+// Step is one step of the first-run setup.
+type Step struct {
+	// ID identifies the step.
+	//
+	// Required: true
+	ID string `json:"id" enum:"admin,projectsDirectory,docker,notification,environment"`
+
+	// Optional steps can be skipped.
+	//
+	// Required: true
+	Optional bool `json:"optional"`
+
+	// Status is pending, completed or skipped.
+	//
+	// Required: true
+	Status string `json:"status" enum:"pending,completed,skipped"`
+}
+
+// Progress describes how far the first-run setup has got.
+type Progress struct {
+	// Required reports whether setup still has to be finished. The setup
+	// endpoints are only available while it is true.
+	//
+	// Required: true
+	Required bool `json:"required"`
+
+	// CurrentStep is the first step that is still pending.
+	//
+	// Required: false
+	CurrentStep string `json:"currentStep,omitempty"`
+
+	// Steps are all setup steps in order.
+	//
+	// Required: true
+	Steps []Step `json:"steps"`
+}
+
+// Admin is the request body for creating the administrator account. It
+// replaces the default account created on first start.
+type Admin struct {
+	Username    string  `json:"username" minLength:"1" maxLength:"255" doc:"Username of the administrator" example:"admin"`
+	Password    string  `json:"password" minLength:"8" doc:"Password of the administrator"` //nolint:gosec // API schema requires password field name
+	DisplayName *string `json:"displayName,omitempty" doc:"Display name of the administrator"`
+	Email       *string `json:"email,omitempty" format:"email" doc:"Email address of the administrator"`
+}
+
+// ProjectsDirectory is the request body for choosing the projects directory.
+type ProjectsDirectory struct {
+	ProjectsDirectory string `json:"projectsDirectory" minLength:"1" doc:"Projects directory, either a path or container_path:host_path" example:"/app/data/projects"`
+}
+
+// ProjectsDirectoryResult is the outcome of saving the projects directory.
+type ProjectsDirectoryResult struct {
+	// ProjectsDirectory is the saved value.
+	//
+	// Required: true
+	ProjectsDirectory string `json:"projectsDirectory"`
+
+	// Warnings are problems that did not prevent saving.
+	//
+	// Required: false
+	Warnings []settings.ValidationIssue `json:"warnings,omitempty"`
+}
+
+// DockerCheck is the outcome of checking the connection to the Docker daemon.
+type DockerCheck struct {
+	// Connected reports whether the Docker daemon answered.
+	//
+	// Required: true
+	Connected bool `json:"connected"`
+
+	// Host is the Docker host Arcane connects to.
+	//
+	// Required: true
+	Host string `json:"host"`
+
+	// ServerVersion is the version of the Docker daemon.
+	//
+	// Required: false
+	ServerVersion string `json:"serverVersion,omitempty"`
+
+	// APIVersion is the Docker API version in use.
+	//
+	// Required: false
+	APIVersion string `json:"apiVersion,omitempty"`
+
+	// OperatingSystem is the operating system of the Docker host.
+	//
+	// Required: false
+	OperatingSystem string `json:"operatingSystem,omitempty"`
+
+	// Error explains why the connection failed.
+	//
+	// Required: false
+	Error string `json:"error,omitempty"`
+}
@@ -0,0 +1,53 @@
+package staleresource
+
+import "time"
+
+// Kinds of resources the analyzer reports.
+const (
+	KindContainer = "container"
+	KindVolume    = "volume"
+	KindNetwork   = "network"
+	KindImage     = "image"
+)
+
+// Item is a resource that looks abandoned.
+type Item struct {
+	Kind      string     `json:"kind" enum:"container,volume,network,image" doc:"Resource type"`
+	ID        string     `json:"id" doc:"Container, network or image ID, or volume name"`
+	Name      string     `json:"name" doc:"Display name of the resource"`
+	Reason    string     `json:"reason" doc:"Why the resource is considered stale"`
+	Since     *time.Time `json:"since,omitempty" doc:"When the container stopped, or when the resource was created"`
+	SizeBytes int64      `json:"sizeBytes,omitempty" doc:"Disk space used by the resource, when known"`
+}
+
+// Inventory lists the stale resources found by the last analysis.
+type Inventory struct {
+	GeneratedAt   time.Time `json:"generatedAt" doc:"When the analysis ran"`
+	ContainerDays int       `json:"containerDays" doc:"Days a container must have been stopped to be reported"`
+	ImageDays     int       `json:"imageDays" doc:"Days an unused image must have existed to be reported"`
+	Items         []Item    `json:"items" doc:"Stale resources, grouped by kind"`
+}
+
+// ItemRef identifies a reported resource.
+type ItemRef struct {
+	Kind string `json:"kind" enum:"container,volume,network,image" doc:"Resource type"`
+	ID   string `json:"id" minLength:"1" doc:"Container, network or image ID, or volume name"`
+}
+
+// CleanupRequest is the request body for removing reported resources.
+type CleanupRequest struct {
+	Items []ItemRef `json:"items" minItems:"1" doc:"Resources to remove; each must be part of the current report"`
+}
+
+// CleanupFailure is a resource that could not be removed.
+type CleanupFailure struct {
+	Kind  string `json:"kind" doc:"Resource type"`
+	ID    string `json:"id" doc:"Resource ID"`
+	Error string `json:"error" doc:"Why the resource was not removed"`
+}
+
+// CleanupResult is the outcome of removing reported resources.
+type CleanupResult struct {
+	Removed []ItemRef        `json:"removed" doc:"Resources that were removed"`
+	Failed  []CleanupFailure `json:"failed" doc:"Resources that could not be removed"`
+}
@@ -7,3 +7,52 @@ type HealthResponse struct {
 	// Required: true
 	Status string `json:"status"`
 }
+
+// ComponentHealth describes the health of a single dependency checked by the readiness probe.
+type ComponentHealth struct {
+	// Name identifies the component (e.g., "database", "docker", "environment:<id>").
+	//
+	// Required: true
+	Name string `json:"name"`
+
+	// Status is "UP" or "DOWN".
+	//
+	// Required: true
+	Status string `json:"status"`
+
+	// Error describes why the component is unhealthy.
+	//
+	// Required: false
+	Error string `json:"error,omitempty"`
+
+	// LatencyMs is how long the check took in milliseconds.
+	//
+	// Required: true
+	LatencyMs int64 `json:"latencyMs"`
+
+	// Optional indicates that a failure of this component does not mark the service as not ready.
+	//
+	// Required: false
+	Optional bool `json:"optional,omitempty"`
+}
+
+// ReadinessResponse contains the aggregated readiness status and per-component results.
+type ReadinessResponse struct {
+	// Status is "UP" when every required component is healthy, otherwise "DOWN".
+	//
+	// Required: true
+	Status string `json:"status"`
+
+	// Components lists the result of each dependency check.
+	//
+	// Required: true
+	Components []ComponentHealth `json:"components"`
+}
+
+// EnvironmentsHealthResponse contains the connection test result of every enabled remote environment.
+type EnvironmentsHealthResponse struct {
+	// Components holds one optional component per environment, named "environment:<id>".
+	//
+	// Required: true
+	Components []ComponentHealth `json:"components"`
+}
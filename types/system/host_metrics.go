@@ -0,0 +1,89 @@
+package system
+
+import "time"
+
+// HostMetrics is a point-in-time sample of host resource usage for an environment.
+type HostMetrics struct {
+	// EnvironmentID identifies the environment the sample was taken from.
+	//
+	// Required: true
+	EnvironmentID string `json:"environmentId"`
+
+	// CPUPercent is the total CPU usage percentage.
+	//
+	// Required: true
+	CPUPercent float64 `json:"cpuPercent"`
+
+	// CPUCount is the number of logical CPUs available.
+	//
+	// Required: true
+	CPUCount int `json:"cpuCount"`
+
+	// MemoryUsed is the used memory, in bytes.
+	//
+	// Required: true
+	MemoryUsed uint64 `json:"memoryUsed"`
+
+	// MemoryTotal is the total memory, in bytes.
+	//
+	// Required: true
+	MemoryTotal uint64 `json:"memoryTotal"`
+
+	// MemoryPercent is the memory usage percentage.
+	//
+	// Required: true
+	MemoryPercent float64 `json:"memoryPercent"`
+
+	// DiskUsed is the used disk space of the Docker data volume, in bytes.
+	//
+	// Required: true
+	DiskUsed uint64 `json:"diskUsed"`
+
+	// DiskTotal is the total disk space of the Docker data volume, in bytes.
+	//
+	// Required: true
+	DiskTotal uint64 `json:"diskTotal"`
+
+	// DiskPercent is the disk usage percentage.
+	//
+	// Required: true
+	DiskPercent float64 `json:"diskPercent"`
+
+	// Load1 is the 1-minute load average.
+	//
+	// Required: false
+	Load1 float64 `json:"load1"`
+
+	// Load5 is the 5-minute load average.
+	//
+	// Required: false
+	Load5 float64 `json:"load5"`
+
+	// Load15 is the 15-minute load average.
+	//
+	// Required: false
+	Load15 float64 `json:"load15"`
+
+	// CollectedAt is when the sample was taken.
+	//
+	// Required: true
+	CollectedAt time.Time `json:"collectedAt"`
+}
+
+// EnvironmentHostMetrics is the latest host metrics sample of a single environment.
+type EnvironmentHostMetrics struct {
+	// EnvironmentID identifies the environment.
+	//
+	// Required: true
+	EnvironmentID string `json:"environmentId"`
+
+	// EnvironmentName is the display name of the environment.
+	//
+	// Required: true
+	EnvironmentName string `json:"environmentName"`
+
+	// Latest is the most recent sample, or nil if none has been collected yet.
+	//
+	// Required: false
+	Latest *HostMetrics `json:"latest,omitempty"`
+}
@@ -34,6 +34,7 @@ type PruneAllRequest struct {
 }
 
 // PruneAllResult is the result of a prune operation on Docker system resources.
+// A preview fills the same fields with what a prune would remove.
 type PruneAllResult struct {
 	// ContainersPruned is a list of container IDs that were pruned.
 	//
@@ -80,6 +81,11 @@ type PruneAllResult struct {
 	// Required: false
 	BuildCacheSpaceReclaimed uint64 `json:"buildCacheSpaceReclaimed,omitempty"`
 
+	// DryRun indicates the result is a preview and nothing was removed.
+	//
+	// Required: false
+	DryRun bool `json:"dryRun,omitempty"`
+
 	// Success indicates if the prune operation was successful.
 	//
 	// Required: true
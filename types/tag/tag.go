@@ -0,0 +1,51 @@
+package tag
+
+import "time"
+
+const (
+	ResourceProject     = "project"
+	ResourceContainer   = "container"
+	ResourceEnvironment = "environment"
+)
+
+// UpdateTags is the request body for replacing the tags on a resource.
+type UpdateTags struct {
+	Tags map[string]string `json:"tags" doc:"Tags to set on the resource, replacing the current ones. An empty value tags the resource with the key alone"`
+}
+
+// KeySummary lists a tag key and the values it is used with, for building
+// filter pickers.
+type KeySummary struct {
+	Key       string   `json:"key" doc:"Tag key"`
+	Values    []string `json:"values" doc:"Distinct values used with the key, sorted"`
+	Resources int      `json:"resources" doc:"Number of resources tagged with the key"`
+}
+
+// SavedFilter is a named set of list query parameters. Search and Filters are
+// passed to the list endpoint for ResourceType as the search parameter and as
+// query parameters of the same name, such as tags or status.
+type SavedFilter struct {
+	ID           string            `json:"id" doc:"Unique identifier of the saved filter"`
+	Name         string            `json:"name" doc:"Display name of the saved filter"`
+	ResourceType string            `json:"resourceType" enum:"project,container,environment" doc:"List the filter applies to"`
+	Search       string            `json:"search,omitempty" doc:"Search query"`
+	Filters      map[string]string `json:"filters" doc:"List query parameters by name"`
+	CreatedAt    time.Time         `json:"createdAt" doc:"Creation timestamp"`
+	UpdatedAt    *time.Time        `json:"updatedAt,omitempty" doc:"Last update timestamp"`
+}
+
+// CreateSavedFilter is the request body for saving a filter.
+type CreateSavedFilter struct {
+	Name         string            `json:"name" minLength:"1" maxLength:"255" doc:"Display name of the saved filter"`
+	ResourceType string            `json:"resourceType" enum:"project,container,environment" doc:"List the filter applies to"`
+	Search       string            `json:"search,omitempty" doc:"Search query"`
+	Filters      map[string]string `json:"filters,omitempty" doc:"List query parameters by name, such as tags=team=web,critical"`
+}
+
+// UpdateSavedFilter is the request body for updating a saved filter. Omitted
+// fields are left unchanged.
+type UpdateSavedFilter struct {
+	Name    *string           `json:"name,omitempty" maxLength:"255" doc:"Display name of the saved filter"`
+	Search  *string           `json:"search,omitempty" doc:"Search query"`
+	Filters map[string]string `json:"filters,omitempty" doc:"List query parameters by name, replacing the current ones"`
+}
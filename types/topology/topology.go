@@ -0,0 +1,106 @@
+package topology
+
+// NodeType identifies what a graph node represents.
+type NodeType string
+
+const (
+	NodeTypeContainer NodeType = "container"
+	NodeTypeNetwork   NodeType = "network"
+	NodeTypeVolume    NodeType = "volume"
+)
+
+// EdgeType identifies the relationship an edge represents.
+type EdgeType string
+
+const (
+	// EdgeTypeDependsOn points from a container to a container it depends on,
+	// from compose depends_on or the depends-on label.
+	EdgeTypeDependsOn EdgeType = "depends_on"
+
+	// EdgeTypeLink points from a container to a container it links to.
+	EdgeTypeLink EdgeType = "link"
+
+	// EdgeTypeNetworkMode points from a container to the container whose
+	// network namespace it shares.
+	EdgeTypeNetworkMode EdgeType = "network_mode"
+
+	// EdgeTypeNetwork connects a container to a network it is attached to.
+	EdgeTypeNetwork EdgeType = "network"
+
+	// EdgeTypeVolume connects a container to a named volume it mounts.
+	EdgeTypeVolume EdgeType = "volume"
+)
+
+type Node struct {
+	// ID is unique within the graph and prefixed with the node type,
+	// e.g. "container:<id>" or "network:<name>".
+	//
+	// Required: true
+	ID string `json:"id"`
+
+	// Type is the kind of resource the node represents.
+	//
+	// Required: true
+	Type NodeType `json:"type"`
+
+	// Label is the display name of the resource.
+	//
+	// Required: true
+	Label string `json:"label"`
+
+	// ResourceID is the Docker ID of a container, or the name of a network or
+	// volume.
+	//
+	// Required: true
+	ResourceID string `json:"resourceId"`
+
+	// State is the container state, e.g. running or exited.
+	//
+	// Required: false
+	State string `json:"state,omitempty"`
+
+	// Image is the container image.
+	//
+	// Required: false
+	Image string `json:"image,omitempty"`
+
+	// Project is the compose project the container belongs to.
+	//
+	// Required: false
+	Project string `json:"project,omitempty"`
+
+	// Service is the compose service the container runs.
+	//
+	// Required: false
+	Service string `json:"service,omitempty"`
+}
+
+type Edge struct {
+	// Source is the ID of the node the edge starts from.
+	//
+	// Required: true
+	Source string `json:"source"`
+
+	// Target is the ID of the node the edge points to.
+	//
+	// Required: true
+	Target string `json:"target"`
+
+	// Type is the relationship the edge represents.
+	//
+	// Required: true
+	Type EdgeType `json:"type"`
+}
+
+// Graph is the topology of a project or a whole host.
+type Graph struct {
+	// Nodes are the containers, networks and volumes in the graph.
+	//
+	// Required: true
+	Nodes []Node `json:"nodes"`
+
+	// Edges are the relationships between nodes.
+	//
+	// Required: true
+	Edges []Edge `json:"edges"`
+}
@@ -0,0 +1,64 @@
+package updater
+
+// ContainerUpdatePreview describes what updating a single container would do,
+// worked out from the registry without pulling or changing anything.
+type ContainerUpdatePreview struct {
+	// ContainerID is the ID of the container.
+	//
+	// Required: true
+	ContainerID string `json:"containerId"`
+
+	// ContainerName is the name of the container.
+	//
+	// Required: true
+	ContainerName string `json:"containerName"`
+
+	// ImageRef is the image reference the update would pull, normalized.
+	//
+	// Required: false
+	ImageRef string `json:"imageRef,omitempty"`
+
+	// WouldUpdate reports whether updating the container would recreate it.
+	//
+	// Required: true
+	WouldUpdate bool `json:"wouldUpdate"`
+
+	// Reason explains why the container would not be updated, or why the
+	// outcome could not be determined without pulling.
+	//
+	// Required: false
+	Reason string `json:"reason,omitempty"`
+
+	// CurrentDigest is the digest of the local image for ImageRef.
+	//
+	// Required: false
+	CurrentDigest string `json:"currentDigest,omitempty"`
+
+	// TargetDigest is the digest the registry currently serves for ImageRef.
+	//
+	// Required: false
+	TargetDigest string `json:"targetDigest,omitempty"`
+
+	// Platform is the os/arch[/variant] the target digest was resolved for.
+	//
+	// Required: false
+	Platform string `json:"platform,omitempty"`
+
+	// CheckedViaAPI is false when the registry could not be asked for the
+	// digest, in which case an update has to pull to find out.
+	//
+	// Required: true
+	CheckedViaAPI bool `json:"checkedViaApi"`
+
+	// MonitorOnly reports that the container is in monitor-only mode, so an
+	// available update is reported but never applied.
+	//
+	// Required: true
+	MonitorOnly bool `json:"monitorOnly"`
+
+	// Dependents are the names of the running containers that would be
+	// restarted along with this one because they depend on it.
+	//
+	// Required: true
+	Dependents []string `json:"dependents"`
+}
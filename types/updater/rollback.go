@@ -0,0 +1,43 @@
+package updater
+
+// ContainerRollback is the outcome of rolling a container back to the image
+// it used before its most recent update by Arcane.
+type ContainerRollback struct {
+	// ContainerID is the ID of the recreated container.
+	//
+	// Required: true
+	ContainerID string `json:"containerId"`
+
+	// ContainerName is the name of the container.
+	//
+	// Required: true
+	ContainerName string `json:"containerName"`
+
+	// Image is the reference the container was recreated from, pinned by
+	// digest when the previous image has one.
+	//
+	// Required: true
+	Image string `json:"image"`
+
+	// ReplacedImageID is the ID of the image the container used before the
+	// rollback.
+	//
+	// Required: false
+	ReplacedImageID string `json:"replacedImageId,omitempty"`
+
+	// Pulled reports that the previous image was no longer present locally
+	// and was pulled again by digest.
+	//
+	// Required: true
+	Pulled bool `json:"pulled"`
+
+	// RunID is the ID of the updater run that made the update rolled back.
+	//
+	// Required: false
+	RunID *string `json:"runId,omitempty"`
+
+	// RecordID is the ID of the update record that was rolled back.
+	//
+	// Required: true
+	RecordID string `json:"recordId"`
+}
@@ -0,0 +1,134 @@
+package updater
+
+import "time"
+
+// RolloutState is the progress of a staged rollout or one of its stages.
+type RolloutState string
+
+const (
+	RolloutStatePending   RolloutState = "pending"
+	RolloutStateRunning   RolloutState = "running"
+	RolloutStateSoaking   RolloutState = "soaking"
+	RolloutStateSucceeded RolloutState = "succeeded"
+	RolloutStateFailed    RolloutState = "failed"
+	RolloutStateHalted    RolloutState = "halted"
+	RolloutStateSkipped   RolloutState = "skipped"
+)
+
+// RolloutStage is one group of environments in a staged rollout. Stages are
+// updated in order; the next stage only starts after this one has been
+// updated and stayed healthy for the soak period.
+type RolloutStage struct {
+	// Name labels the stage, such as canary.
+	//
+	// Required: true
+	Name string `json:"name"`
+
+	// EnvironmentIDs are the environments updated in this stage.
+	//
+	// Required: true
+	EnvironmentIDs []string `json:"environmentIds"`
+
+	// SoakMinutes is how long updated containers must stay healthy before
+	// the rollout moves on.
+	//
+	// Required: false
+	SoakMinutes int `json:"soakMinutes,omitempty"`
+}
+
+// RolloutEnvironmentResult is the outcome of a rollout on one environment.
+type RolloutEnvironmentResult struct {
+	// EnvironmentID is the ID of the environment.
+	//
+	// Required: true
+	EnvironmentID string `json:"environmentId"`
+
+	// EnvironmentName is the display name of the environment.
+	//
+	// Required: true
+	EnvironmentName string `json:"environmentName"`
+
+	// Updated is the number of resources the updater updated.
+	//
+	// Required: true
+	Updated int `json:"updated"`
+
+	// Failed is the number of resources the updater failed to update.
+	//
+	// Required: true
+	Failed int `json:"failed"`
+
+	// Unhealthy are the updated containers found unhealthy or restarting
+	// after the soak period.
+	//
+	// Required: false
+	Unhealthy []string `json:"unhealthy,omitempty"`
+
+	// Error describes why the environment could not be updated or checked.
+	//
+	// Required: false
+	Error string `json:"error,omitempty"`
+}
+
+// RolloutStageStatus is the progress of one stage of a rollout.
+type RolloutStageStatus struct {
+	RolloutStage
+
+	// State is the progress of the stage.
+	//
+	// Required: true
+	State RolloutState `json:"state"`
+
+	// StartedAt is when the stage started updating.
+	//
+	// Required: false
+	StartedAt *time.Time `json:"startedAt,omitempty"`
+
+	// FinishedAt is when the stage finished, including the soak period.
+	//
+	// Required: false
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+
+	// Environments are the per-environment outcomes.
+	//
+	// Required: true
+	Environments []RolloutEnvironmentResult `json:"environments"`
+}
+
+// Rollout is a staged update rollout across environments.
+type Rollout struct {
+	// ID identifies the rollout.
+	//
+	// Required: true
+	ID string `json:"id"`
+
+	// Trigger is what started the rollout, "manual" or "scheduled".
+	//
+	// Required: true
+	Trigger string `json:"trigger"`
+
+	// State is the progress of the rollout.
+	//
+	// Required: true
+	State RolloutState `json:"state"`
+
+	// StartedAt is when the rollout started.
+	//
+	// Required: true
+	StartedAt time.Time `json:"startedAt"`
+
+	// FinishedAt is when the rollout finished or halted.
+	//
+	// Required: false
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+
+	// HaltReason explains why the rollout stopped before the last stage.
+	//
+	// Required: false
+	HaltReason string `json:"haltReason,omitempty"`
+
+	// Stages are the stages in rollout order.
+	//
+	// Required: true
+	Stages []RolloutStageStatus `json:"stages"`
+}
@@ -0,0 +1,43 @@
+package updater
+
+import "time"
+
+// Run summarizes one updater run.
+type Run struct {
+	ID             string            `json:"id"`
+	Trigger        string            `json:"trigger" doc:"What started the run: schedule, manual, webhook or notification"`
+	Status         string            `json:"status" doc:"updating while the run is in progress, then completed or failed"`
+	DryRun         bool              `json:"dryRun"`
+	RetryOfRunID   *string           `json:"retryOfRunId,omitempty" doc:"ID of the run whose failed items this run retried"`
+	StartTime      time.Time         `json:"startTime" sortable:"true"`
+	EndTime        *time.Time        `json:"endTime,omitempty"`
+	Checked        int               `json:"checked"`
+	Updated        int               `json:"updated" sortable:"true"`
+	Skipped        int               `json:"skipped"`
+	Failed         int               `json:"failed" sortable:"true"`
+	Blocked        int               `json:"blocked"`
+	Error          *string           `json:"error,omitempty"`
+	RetainedImages map[string]string `json:"retainedImages,omitempty" doc:"Previous images kept for rollback: image ID to the reference it was used by"`
+	RetainedUntil  *time.Time        `json:"retainedUntil,omitempty" doc:"When the previous images kept for rollback may be pruned"`
+}
+
+// RunItem is the outcome for one resource in an updater run.
+type RunItem struct {
+	ID              string            `json:"id"`
+	ResourceID      string            `json:"resourceId"`
+	ResourceType    string            `json:"resourceType"`
+	ResourceName    string            `json:"resourceName"`
+	Status          string            `json:"status"`
+	UpdateAvailable bool              `json:"updateAvailable"`
+	UpdateApplied   bool              `json:"updateApplied"`
+	OldImages       map[string]string `json:"oldImages,omitempty"`
+	NewImages       map[string]string `json:"newImages,omitempty"`
+	Error           *string           `json:"error,omitempty"`
+	Time            time.Time         `json:"time"`
+}
+
+// RunDetail is an updater run with the outcome for each resource it touched.
+type RunDetail struct {
+	Run
+	Items []RunItem `json:"items"`
+}
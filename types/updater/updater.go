@@ -31,7 +31,9 @@ type ResourceResult struct {
 	// Required: true
 	ResourceType string `json:"resourceType"`
 
-	// Status is the current status ("checked" | "updated" | "skipped" | "failed" | "up_to_date" | "update_available").
+	// Status is the current status ("checked" | "updated" | "skipped" | "failed" | "blocked" | "up_to_date" | "update_available" | "monitor_only").
+	// "monitor_only" means an update is available but the resource is configured to never have it applied.
+	// "blocked" means the update was refused because the image signature could not be verified.
 	//
 	// Required: true
 	Status string `json:"status"`
@@ -94,6 +96,11 @@ type Result struct {
 	// Required: true
 	Failed int `json:"failed"`
 
+	// Blocked is the number of updates refused by signature verification.
+	//
+	// Required: false
+	Blocked int `json:"blocked,omitempty"`
+
 	// StartTime is the time when the update operation started.
 	//
 	// Required: false
@@ -113,6 +120,39 @@ type Result struct {
 	//
 	// Required: true
 	Items []ResourceResult `json:"items"`
+
+	// Preconditions are the checks made before pulling images. A run that
+	// fails one of them stops before pulling anything.
+	//
+	// Required: false
+	Preconditions []PreconditionCheck `json:"preconditions,omitempty"`
+}
+
+// Precondition check statuses.
+const (
+	PreconditionPassed  = "passed"
+	PreconditionFailed  = "failed"
+	PreconditionSkipped = "skipped"
+)
+
+// PreconditionCheck is the outcome of one check made before an update run
+// pulls images.
+type PreconditionCheck struct {
+	// Name identifies the check ("concurrent_run" | "disk_space" | "registries" | "host_load").
+	//
+	// Required: true
+	Name string `json:"name"`
+
+	// Status is the outcome ("passed" | "failed" | "skipped").
+	// "skipped" means the check is disabled or could not be made.
+	//
+	// Required: true
+	Status string `json:"status"`
+
+	// Message describes what was measured.
+	//
+	// Required: false
+	Message string `json:"message,omitempty"`
 }
 
 // Status represents the current status of the updater.
@@ -137,3 +177,37 @@ type Status struct {
 	// Required: true
 	ProjectIds []string `json:"projectIds"`
 }
+
+// RetentionResult summarizes a run of the image retention policy.
+type RetentionResult struct {
+	// KeepCount is the number of previous image versions kept per repository.
+	//
+	// Required: true
+	KeepCount int `json:"keepCount"`
+
+	// Repositories is the number of tracked repositories that had previous
+	// image versions.
+	//
+	// Required: true
+	Repositories int `json:"repositories"`
+
+	// Kept is the number of previous image versions that were kept.
+	//
+	// Required: true
+	Kept int `json:"kept"`
+
+	// Removed lists the IDs of the removed images.
+	//
+	// Required: true
+	Removed []string `json:"removed"`
+
+	// SpaceReclaimed is the combined size in bytes of the removed images.
+	//
+	// Required: true
+	SpaceReclaimed int64 `json:"spaceReclaimed"`
+
+	// Errors lists the images that could not be removed.
+	//
+	// Required: false
+	Errors []string `json:"errors,omitempty"`
+}
@@ -1,5 +1,7 @@
 package version
 
+import "time"
+
 // Info contains detailed version information about the application.
 type Info struct {
 	// CurrentVersion is the current version string.
@@ -95,3 +97,66 @@ type Check struct {
 	// Required: false
 	ReleaseURL string `json:"releaseUrl,omitempty"`
 }
+
+// Release is a published Arcane release.
+type Release struct {
+	// Version is the release tag.
+	//
+	// Required: true
+	Version string `json:"version"`
+
+	// Name is the release title.
+	//
+	// Required: false
+	Name string `json:"name,omitempty"`
+
+	// Notes are the release notes in Markdown.
+	//
+	// Required: false
+	Notes string `json:"notes,omitempty"`
+
+	// URL is the URL of the release page.
+	//
+	// Required: true
+	URL string `json:"url"`
+
+	// PublishedAt is when the release was published.
+	//
+	// Required: false
+	PublishedAt *time.Time `json:"publishedAt,omitempty"`
+}
+
+// Changelog lists the releases published since the running version.
+type Changelog struct {
+	// CurrentVersion is the running version.
+	//
+	// Required: true
+	CurrentVersion string `json:"currentVersion"`
+
+	// NewestVersion is the newest published release.
+	//
+	// Required: false
+	NewestVersion string `json:"newestVersion,omitempty"`
+
+	// UpdateAvailable indicates the newest release is newer than the running
+	// version.
+	//
+	// Required: true
+	UpdateAvailable bool `json:"updateAvailable"`
+
+	// CanUpgrade indicates Arcane can upgrade itself to the newest release.
+	//
+	// Required: true
+	CanUpgrade bool `json:"canUpgrade"`
+
+	// UpgradeMessage explains why Arcane cannot upgrade itself.
+	//
+	// Required: false
+	UpgradeMessage string `json:"upgradeMessage,omitempty"`
+
+	// Releases are the releases newer than the running version, newest first.
+	// For development builds only the newest release is listed.
+	//
+	// Required: false
+	Releases []Release `json:"releases,omitempty"`
+}